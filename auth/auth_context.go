@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
@@ -18,6 +19,7 @@ import (
 	"github.com/flyteorg/flyteadmin/auth/interfaces"
 	"github.com/flyteorg/flytestdlib/errors"
 	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
 	"golang.org/x/oauth2"
 )
 
@@ -33,16 +35,27 @@ var (
 	rootRelativeURL     = config.MustParseURL("/")
 )
 
+// The secrets the cookie manager and the OAuth2 client config of a Context are built from.
+type contextSecrets struct {
+	hashKeyBase64  string
+	blockKeyBase64 string
+	cookieManager  interfaces.CookieHandler
+	oauth2Client   *oauth2.Config
+}
+
 // Please see the comment on the corresponding AuthenticationContext for more information.
 type Context struct {
-	oauth2Client         *oauth2.Config
-	cookieManager        interfaces.CookieHandler
+	// Holds the current contextSecrets, which are swapped atomically by ReloadSecrets.
+	secrets              *atomic.Value
+	secretManager        core.SecretManager
 	oidcProvider         *oidc.Provider
 	options              *config.Config
 	oauth2Provider       interfaces.OAuth2Provider
 	oauth2ResourceServer interfaces.OAuth2ResourceServer
 	authServiceImpl      service.AuthMetadataServiceServer
 	identityServiceIml   service.IdentityServiceServer
+	workloadServer       interfaces.OAuth2ResourceServer
+	metrics              interfaces.AuthenticationMetrics
 
 	userInfoURL       *url.URL
 	oauth2MetadataURL *url.URL
@@ -54,17 +67,26 @@ func (c Context) OAuth2Provider() interfaces.OAuth2Provider {
 	return c.oauth2Provider
 }
 
+func (c Context) currentSecrets() contextSecrets {
+	if c.secrets == nil {
+		return contextSecrets{}
+	}
+
+	return c.secrets.Load().(contextSecrets)
+}
+
 func (c Context) OAuth2ClientConfig(requestURL *url.URL) *oauth2.Config {
-	if requestURL == nil || strings.HasPrefix(c.oauth2Client.RedirectURL, requestURL.ResolveReference(rootRelativeURL).String()) {
-		return c.oauth2Client
+	oauth2Client := c.currentSecrets().oauth2Client
+	if requestURL == nil || strings.HasPrefix(oauth2Client.RedirectURL, requestURL.ResolveReference(rootRelativeURL).String()) {
+		return oauth2Client
 	}
 
 	return &oauth2.Config{
 		RedirectURL:  requestURL.ResolveReference(callbackRelativeURL).String(),
-		ClientID:     c.oauth2Client.ClientID,
-		ClientSecret: c.oauth2Client.ClientSecret,
-		Scopes:       c.oauth2Client.Scopes,
-		Endpoint:     c.oauth2Client.Endpoint,
+		ClientID:     oauth2Client.ClientID,
+		ClientSecret: oauth2Client.ClientSecret,
+		Scopes:       oauth2Client.Scopes,
+		Endpoint:     oauth2Client.Endpoint,
 	}
 }
 
@@ -73,7 +95,7 @@ func (c Context) OidcProvider() *oidc.Provider {
 }
 
 func (c Context) CookieManager() interfaces.CookieHandler {
-	return c.cookieManager
+	return c.currentSecrets().cookieManager
 }
 
 func (c Context) Options() *config.Config {
@@ -107,26 +129,20 @@ func (c Context) IdentityService() service.IdentityServiceServer {
 func (c Context) OAuth2ResourceServer() interfaces.OAuth2ResourceServer {
 	return c.oauth2ResourceServer
 }
-func NewAuthenticationContext(ctx context.Context, sm core.SecretManager, oauth2Provider interfaces.OAuth2Provider,
-	oauth2ResourceServer interfaces.OAuth2ResourceServer, authMetadataService service.AuthMetadataServiceServer,
-	identityService service.IdentityServiceServer, options *config.Config) (Context, error) {
 
-	// Construct the cookie manager object.
-	hashKeyBase64, err := sm.Get(ctx, options.UserAuth.CookieHashKeySecretName)
-	if err != nil {
-		return Context{}, errors.Wrapf(ErrConfigFileRead, err, "Could not read hash key file")
-	}
+func (c Context) Metrics() interfaces.AuthenticationMetrics {
+	return c.metrics
+}
 
-	blockKeyBase64, err := sm.Get(ctx, options.UserAuth.CookieBlockKeySecretName)
-	if err != nil {
-		return Context{}, errors.Wrapf(ErrConfigFileRead, err, "Could not read hash key file")
-	}
+func (c Context) WorkloadIdentityResourceServer() interfaces.OAuth2ResourceServer {
+	return c.workloadServer
+}
 
-	cookieManager, err := NewCookieManager(ctx, hashKeyBase64, blockKeyBase64)
-	if err != nil {
-		logger.Errorf(ctx, "Error creating cookie manager %s", err)
-		return Context{}, errors.Wrapf(ErrauthCtx, err, "Error creating cookie manager")
-	}
+func NewAuthenticationContext(ctx context.Context, sm core.SecretManager, oauth2Provider interfaces.OAuth2Provider,
+	oauth2ResourceServer interfaces.OAuth2ResourceServer, authMetadataService service.AuthMetadataServiceServer,
+	identityService service.IdentityServiceServer, options *config.Config, scope promutils.Scope) (Context, error) {
+
+	metrics := NewAuthenticationMetrics(scope)
 
 	// Construct an http client for interacting with the IDP if necessary.
 	httpClient := &http.Client{
@@ -141,12 +157,6 @@ func NewAuthenticationContext(ctx context.Context, sm core.SecretManager, oauth2
 		return Context{}, errors.Wrapf(ErrauthCtx, err, "Error creating oidc provider w/ issuer [%v]", baseURL)
 	}
 
-	// Construct the golang OAuth2 library's own internal configuration object from this package's config
-	oauth2Config, err := GetOAuth2ClientConfig(ctx, options.UserAuth.OpenID, provider.Endpoint(), sm)
-	if err != nil {
-		return Context{}, errors.Wrapf(ErrauthCtx, err, "Error creating OAuth2 library configuration")
-	}
-
 	logger.Infof(ctx, "Base IDP URL is %s", options.UserAuth.OpenID.BaseURL)
 
 	oauth2MetadataURL, err := url.Parse(OAuth2MetadataEndpoint)
@@ -166,23 +176,100 @@ func NewAuthenticationContext(ctx context.Context, sm core.SecretManager, oauth2
 	logger.Infof(ctx, "Metadata endpoint is %s", oidcMetadataURL)
 
 	authCtx := Context{
+		secrets:              &atomic.Value{},
+		secretManager:        sm,
 		options:              options,
 		oidcMetadataURL:      oidcMetadataURL,
 		oauth2MetadataURL:    oauth2MetadataURL,
-		oauth2Client:         &oauth2Config,
 		oidcProvider:         provider,
 		httpClient:           httpClient,
-		cookieManager:        cookieManager,
 		oauth2Provider:       oauth2Provider,
 		oauth2ResourceServer: oauth2ResourceServer,
+		metrics:              metrics,
+	}
+
+	secrets, err := authCtx.readSecrets(ctx, contextSecrets{})
+	if err != nil {
+		return Context{}, err
 	}
 
+	authCtx.secrets.Store(secrets)
+
 	authCtx.authServiceImpl = authMetadataService
 	authCtx.identityServiceIml = identityService
 
+	if options.WorkloadIdentity.Enabled {
+		workloadServer, err := NewWorkloadIdentityResourceServer(ctx, options.WorkloadIdentity, httpClient)
+		if err != nil {
+			logger.Errorf(ctx, "Error creating workload identity resource server %s", err)
+			return Context{}, errors.Wrapf(ErrauthCtx, err, "Error creating workload identity resource server")
+		}
+
+		authCtx.workloadServer = workloadServer
+	}
+
 	return authCtx, nil
 }
 
+// Reads the cookie keys and the OAuth2 client secret, and builds the cookie manager and OAuth2 client config from them
+// unless they're unchanged from those of previous.
+func (c Context) readSecrets(ctx context.Context, previous contextSecrets) (contextSecrets, error) {
+	hashKeyBase64, err := c.secretManager.Get(ctx, c.options.UserAuth.CookieHashKeySecretName)
+	if err != nil {
+		return contextSecrets{}, errors.Wrapf(ErrConfigFileRead, err, "Could not read hash key file")
+	}
+
+	blockKeyBase64, err := c.secretManager.Get(ctx, c.options.UserAuth.CookieBlockKeySecretName)
+	if err != nil {
+		return contextSecrets{}, errors.Wrapf(ErrConfigFileRead, err, "Could not read block key file")
+	}
+
+	secrets := previous
+	if hashKeyBase64 != previous.hashKeyBase64 || blockKeyBase64 != previous.blockKeyBase64 {
+		// Construct the cookie manager object.
+		cookieManager, err := NewCookieManager(ctx, hashKeyBase64, blockKeyBase64, c.metrics)
+		if err != nil {
+			logger.Errorf(ctx, "Error creating cookie manager %s", err)
+			return contextSecrets{}, errors.Wrapf(ErrauthCtx, err, "Error creating cookie manager")
+		}
+
+		secrets.hashKeyBase64 = hashKeyBase64
+		secrets.blockKeyBase64 = blockKeyBase64
+		secrets.cookieManager = cookieManager
+	}
+
+	// Construct the golang OAuth2 library's own internal configuration object from this package's config
+	oauth2Config, err := GetOAuth2ClientConfig(ctx, c.options.UserAuth.OpenID, c.oidcProvider.Endpoint(), c.secretManager)
+	if err != nil {
+		return contextSecrets{}, errors.Wrapf(ErrauthCtx, err, "Error creating OAuth2 library configuration")
+	}
+
+	if previous.oauth2Client == nil || oauth2Config.ClientSecret != previous.oauth2Client.ClientSecret {
+		secrets.oauth2Client = &oauth2Config
+	}
+
+	return secrets, nil
+}
+
+// ReloadSecrets reads the cookie keys and the OAuth2 client secret again and swaps in those which were rotated.
+// Cookies encrypted with rotated cookie keys can't be read anymore, so users sign in again.
+func (c Context) ReloadSecrets(ctx context.Context) error {
+	previous := c.currentSecrets()
+	secrets, err := c.readSecrets(ctx, previous)
+	if err != nil {
+		return err
+	}
+
+	if secrets.hashKeyBase64 == previous.hashKeyBase64 && secrets.blockKeyBase64 == previous.blockKeyBase64 &&
+		secrets.oauth2Client == previous.oauth2Client {
+		return nil
+	}
+
+	c.secrets.Store(secrets)
+	logger.Infof(ctx, "Reloaded rotated cookie keys or OAuth2 client secret")
+	return nil
+}
+
 // This creates a oauth2 library config object, with values from the Flyte Admin config
 func GetOAuth2ClientConfig(ctx context.Context, options config.OpenIDOptions, providerEndpoints oauth2.Endpoint, sm core.SecretManager) (cfg oauth2.Config, err error) {
 	var secret string
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
+)
+
+// ResolveRoles returns the set of roles bound to the identity's user id, email or app id.
+func ResolveRoles(identityContext interfaces.IdentityContext, cfg config.AuthorizationConfig) sets.String {
+	roles := sets.NewString()
+	for _, principal := range []string{identityContext.UserID(), identityContext.UserInfo().GetEmail(), identityContext.AppID()} {
+		if len(principal) == 0 {
+			continue
+		}
+
+		roles.Insert(cfg.RoleBindings[principal]...)
+	}
+
+	return roles
+}
+
+// ResolveVisibleProjects returns the set of projects the given roles grant visibility to. If any of the roles grants
+// visibility to all projects, unrestricted is set to true and the returned set should be ignored.
+func ResolveVisibleProjects(roles sets.String, cfg config.AuthorizationConfig) (projects sets.String, unrestricted bool) {
+	projects = sets.NewString()
+	for _, roleName := range roles.List() {
+		role, found := cfg.Roles[roleName]
+		if !found {
+			continue
+		}
+
+		for _, project := range role.Projects {
+			if project == config.ProjectWildcard {
+				return projects, true
+			}
+
+			projects.Insert(project)
+		}
+	}
+
+	return projects, false
+}
+
+// ResolveOrgs returns the set of orgs the identity belongs to. These are the orgs listed in the configured claim of the
+// token the identity was authenticated with, along with the orgs its roles belong to.
+func ResolveOrgs(identityContext interfaces.IdentityContext, roles sets.String, cfg config.AuthorizationConfig) sets.String {
+	orgs := sets.NewString()
+	for _, roleName := range roles.List() {
+		orgs.Insert(cfg.Roles[roleName].Orgs...)
+	}
+
+	if len(cfg.OrgsClaim) == 0 {
+		return orgs
+	}
+
+	switch claim := identityContext.Claims()[cfg.OrgsClaim].(type) {
+	case string:
+		if len(claim) > 0 {
+			orgs.Insert(claim)
+		}
+	case []interface{}:
+		for _, value := range claim {
+			if org, ok := value.(string); ok && len(org) > 0 {
+				orgs.Insert(org)
+			}
+		}
+	}
+
+	return orgs
+}
+
+func WithRoles(ctx context.Context, roles sets.String) context.Context {
+	return context.WithValue(ctx, ContextKeyRoles, roles)
+}
+
+// RolesFromContext returns the roles held by the caller, or an empty set if none were resolved.
+func RolesFromContext(ctx context.Context) sets.String {
+	if roles, ok := ctx.Value(ContextKeyRoles).(sets.String); ok {
+		return roles
+	}
+
+	return sets.NewString()
+}
+
+func WithOrgs(ctx context.Context, orgs sets.String) context.Context {
+	return context.WithValue(ctx, ContextKeyOrgs, orgs)
+}
+
+// OrgsFromContext returns the orgs the caller belongs to, or an empty set if none were resolved.
+func OrgsFromContext(ctx context.Context) sets.String {
+	if orgs, ok := ctx.Value(ContextKeyOrgs).(sets.String); ok {
+		return orgs
+	}
+
+	return sets.NewString()
+}
+
+func WithVisibleProjects(ctx context.Context, projects sets.String) context.Context {
+	return context.WithValue(ctx, ContextKeyVisibleProjects, projects)
+}
+
+// VisibleProjectsFromContext returns the set of projects the caller is allowed to view and whether visibility is
+// restricted at all. Callers should only apply the returned set when restricted is true.
+func VisibleProjectsFromContext(ctx context.Context) (projects sets.String, restricted bool) {
+	projects, restricted = ctx.Value(ContextKeyVisibleProjects).(sets.String)
+	return projects, restricted
+}
+
+// setContextForAuthorizedIdentity sets the identity on the context along with the roles it holds, the orgs it belongs
+// to and, when project visibility is enforced, the set of projects it may view. The projects of its orgs are added to
+// the set once the orgs are looked up by the org visibility interceptor. If the caller impersonates another user, the impersonated
+// user's identity and roles are used instead and the caller is recorded as the impersonator.
+func setContextForAuthorizedIdentity(ctx context.Context, identityContext interfaces.IdentityContext,
+	cfg config.AuthorizationConfig) (context.Context, error) {
+
+	roles := ResolveRoles(identityContext, cfg)
+	impersonated, isImpersonated, err := impersonate(ctx, identityContext, roles, cfg)
+	if err != nil {
+		return ctx, err
+	}
+
+	if isImpersonated {
+		ctx = WithImpersonator(ctx, identityContext)
+		identityContext = impersonated
+		roles = ResolveRoles(identityContext, cfg)
+	}
+
+	newCtx := SetContextForIdentity(ctx, identityContext)
+	newCtx = WithRoles(newCtx, roles)
+	newCtx = WithOrgs(newCtx, ResolveOrgs(identityContext, roles, cfg))
+	if !cfg.EnforceProjectVisibility {
+		return newCtx, nil
+	}
+
+	if projects, unrestricted := ResolveVisibleProjects(roles, cfg); !unrestricted {
+		newCtx = WithVisibleProjects(newCtx, projects)
+	}
+
+	return newCtx, nil
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+var testAuthorizationConfig = config.AuthorizationConfig{
+	EnforceProjectVisibility: true,
+	RoleBindings: map[string][]string{
+		"alice@example.com": {"flytesnacks-viewer"},
+		"flytepropeller":    {"admin"},
+	},
+	Roles: map[string]config.Role{
+		"flytesnacks-viewer": {Projects: []string{"flytesnacks"}, Orgs: []string{"research"}},
+		"admin":              {Projects: []string{config.ProjectWildcard}},
+	},
+}
+
+func TestResolveRoles(t *testing.T) {
+	identity := NewIdentityContext("aud", "user-id", "", time.Now(), nil,
+		&service.UserInfoResponse{Email: "alice@example.com"})
+	assert.Equal(t, sets.NewString("flytesnacks-viewer"), ResolveRoles(identity, testAuthorizationConfig))
+
+	identity = NewIdentityContext("aud", "user-id", "flytepropeller", time.Now(), nil, nil)
+	assert.Equal(t, sets.NewString("admin"), ResolveRoles(identity, testAuthorizationConfig))
+}
+
+func TestResolveVisibleProjects(t *testing.T) {
+	projects, unrestricted := ResolveVisibleProjects(sets.NewString("flytesnacks-viewer"), testAuthorizationConfig)
+	assert.False(t, unrestricted)
+	assert.Equal(t, sets.NewString("flytesnacks"), projects)
+
+	_, unrestricted = ResolveVisibleProjects(sets.NewString("flytesnacks-viewer", "admin"), testAuthorizationConfig)
+	assert.True(t, unrestricted)
+
+	projects, unrestricted = ResolveVisibleProjects(sets.NewString("unknown"), testAuthorizationConfig)
+	assert.False(t, unrestricted)
+	assert.Empty(t, projects)
+}
+
+func TestResolveOrgs(t *testing.T) {
+	cfg := testAuthorizationConfig
+	cfg.OrgsClaim = "groups"
+	identity := NewIdentityContext("aud", "user-id", "", time.Now(), nil, nil).WithClaims(map[string]interface{}{
+		"groups": []interface{}{"finance", "", 3},
+	})
+	assert.Equal(t, sets.NewString("finance", "research"),
+		ResolveOrgs(identity, sets.NewString("flytesnacks-viewer"), cfg))
+
+	identity = identity.WithClaims(map[string]interface{}{"groups": "finance"})
+	assert.Equal(t, sets.NewString("finance"), ResolveOrgs(identity, sets.NewString(), cfg))
+
+	// Claims are ignored unless the claim listing the orgs is configured.
+	assert.Empty(t, ResolveOrgs(identity, sets.NewString(), testAuthorizationConfig))
+}
+
+func TestSetContextForAuthorizedIdentity(t *testing.T) {
+	identity := NewIdentityContext("aud", "user-id", "", time.Now(), nil,
+		&service.UserInfoResponse{Email: "alice@example.com"})
+
+	t.Run("enforced", func(t *testing.T) {
+		ctx, err := setContextForAuthorizedIdentity(context.Background(), identity, testAuthorizationConfig)
+		assert.NoError(t, err)
+		assert.True(t, RolesFromContext(ctx).Has("flytesnacks-viewer"))
+		assert.Equal(t, sets.NewString("research"), OrgsFromContext(ctx))
+		projects, restricted := VisibleProjectsFromContext(ctx)
+		assert.True(t, restricted)
+		assert.Equal(t, sets.NewString("flytesnacks"), projects)
+	})
+
+	t.Run("not enforced", func(t *testing.T) {
+		cfg := testAuthorizationConfig
+		cfg.EnforceProjectVisibility = false
+		ctx, err := setContextForAuthorizedIdentity(context.Background(), identity, cfg)
+		assert.NoError(t, err)
+		_, restricted := VisibleProjectsFromContext(ctx)
+		assert.False(t, restricted)
+	})
+}
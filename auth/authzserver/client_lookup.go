@@ -0,0 +1,69 @@
+package authzserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/ory/fosite"
+)
+
+// ClientLookup finds the clients registered at runtime, which the authorization server accepts along with the static
+// clients of its config. It returns fosite.ErrNotFound for unknown clients.
+type ClientLookup interface {
+	GetClient(ctx context.Context, id string) (fosite.Client, error)
+}
+
+type cachedClient struct {
+	client    fosite.Client
+	expiresAt time.Time
+}
+
+// cachingClientLookup caches the clients its delegate finds, since clients are looked up for every token request.
+// Unknown clients aren't cached, so that newly registered clients can be used right away. Deleted clients and rotated
+// secrets take effect once cached entries expire.
+type cachingClientLookup struct {
+	delegate ClientLookup
+	cache    *lru.Cache
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+func (l cachingClientLookup) GetClient(ctx context.Context, id string) (fosite.Client, error) {
+	if cached, found := l.cache.Get(id); found {
+		entry := cached.(cachedClient)
+		if l.now().Before(entry.expiresAt) {
+			return entry.client, nil
+		}
+
+		l.cache.Remove(id)
+	}
+
+	client, err := l.delegate.GetClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	l.cache.Add(id, cachedClient{
+		client:    client,
+		expiresAt: l.now().Add(l.ttl),
+	})
+
+	return client, nil
+}
+
+// NewCachingClientLookup returns a ClientLookup which caches up to size clients found by delegate for ttl.
+func NewCachingClientLookup(delegate ClientLookup, size int, ttl time.Duration) (ClientLookup, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client cache. Error: %w", err)
+	}
+
+	return cachingClientLookup{
+		delegate: delegate,
+		cache:    cache,
+		ttl:      ttl,
+		now:      time.Now,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package authzserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ory/fosite"
+	"github.com/ory/fosite/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockClientLookup struct {
+	clients map[string]fosite.Client
+	lookups int
+}
+
+func (m *mockClientLookup) GetClient(ctx context.Context, id string) (fosite.Client, error) {
+	m.lookups++
+	if client, found := m.clients[id]; found {
+		return client, nil
+	}
+
+	return nil, fosite.ErrNotFound
+}
+
+func TestCachingClientLookup(t *testing.T) {
+	delegate := &mockClientLookup{
+		clients: map[string]fosite.Client{
+			"etl-bot": &fosite.DefaultClient{ID: "etl-bot"},
+		},
+	}
+	lookup, err := NewCachingClientLookup(delegate, 10, time.Minute)
+	assert.NoError(t, err)
+	now := time.Now()
+	cachingLookup := lookup.(cachingClientLookup)
+	cachingLookup.now = func() time.Time {
+		return now
+	}
+
+	ctx := context.Background()
+	client, err := cachingLookup.GetClient(ctx, "etl-bot")
+	assert.NoError(t, err)
+	assert.Equal(t, "etl-bot", client.GetID())
+	_, err = cachingLookup.GetClient(ctx, "etl-bot")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delegate.lookups)
+
+	// Expired clients are looked up again.
+	now = now.Add(2 * time.Minute)
+	_, err = cachingLookup.GetClient(ctx, "etl-bot")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, delegate.lookups)
+
+	// Unknown clients aren't cached.
+	_, err = cachingLookup.GetClient(ctx, "unknown")
+	assert.Equal(t, fosite.ErrNotFound, err)
+	_, err = cachingLookup.GetClient(ctx, "unknown")
+	assert.Equal(t, fosite.ErrNotFound, err)
+	assert.Equal(t, 4, delegate.lookups)
+}
+
+func TestStatelessTokenStore_GetClient(t *testing.T) {
+	ctx := context.Background()
+	store := StatelessTokenStore{
+		MemoryStore: &storage.MemoryStore{
+			Clients: map[string]fosite.Client{
+				"flytectl": &fosite.DefaultClient{ID: "flytectl", Public: true},
+			},
+		},
+	}
+	_, err := store.GetClient(ctx, "etl-bot")
+	assert.Equal(t, fosite.ErrNotFound, err)
+
+	store.clients = &mockClientLookup{
+		clients: map[string]fosite.Client{
+			"etl-bot":  &fosite.DefaultClient{ID: "etl-bot"},
+			"flytectl": &fosite.DefaultClient{ID: "flytectl"},
+		},
+	}
+	client, err := store.GetClient(ctx, "etl-bot")
+	assert.NoError(t, err)
+	assert.Equal(t, "etl-bot", client.GetID())
+
+	// Static clients take precedence.
+	client, err = store.GetClient(ctx, "flytectl")
+	assert.NoError(t, err)
+	assert.True(t, client.IsPublic())
+}
@@ -42,25 +42,26 @@ const (
 	KeyIDClaim    = "key_id"
 )
 
-// Provider implements OAuth2 Authorization Server.
+// Provider implements OAuth2 Authorization Server. The secrets it encrypts and signs tokens with are read again by
+// ReloadSecrets, which swaps them in once they're rotated.
 type Provider struct {
-	fosite.OAuth2Provider
-	cfg       config.AuthorizationServer
-	publicKey []rsa.PublicKey
-	keySet    jwk.Set
+	*reloadingOAuth2Provider
+	cfg     config.AuthorizationServer
+	sm      core.SecretManager
+	clients ClientLookup
 }
 
 func (p Provider) PublicKeys() []rsa.PublicKey {
-	return p.publicKey
+	return p.current().publicKeys
 }
 
 func (p Provider) KeySet() jwk.Set {
-	return p.keySet
+	return p.current().keySet
 }
 
 // NewJWTSessionToken is a helper function for creating a new session.
 func (p Provider) NewJWTSessionToken(subject, appID, issuer, audience string, userInfoClaims *service.UserInfoResponse) *fositeOAuth2.JWTSession {
-	key, found := p.keySet.Get(0)
+	key, found := p.KeySet().Get(0)
 	keyID := ""
 	if found {
 		keyID = key.KeyID()
@@ -138,7 +139,7 @@ func verifyClaims(expectedAudience sets.String, claimsRaw map[string]interface{}
 	}
 
 	if !expectedAudience.Has(claims.Audience[0]) {
-		return nil, fmt.Errorf("invalid audience [%v]", claims.Audience[0])
+		return nil, fmt.Errorf("%w [%v]", auth.ErrInvalidAudience, claims.Audience[0])
 	}
 
 	userInfo := &service.UserInfoResponse{}
@@ -170,15 +171,46 @@ func verifyClaims(expectedAudience sets.String, claimsRaw map[string]interface{}
 		scopes.Insert(auth.ScopeAll)
 	}
 
-	return auth.NewIdentityContext(claims.Audience[0], claims.Subject, clientID, claims.IssuedAt, scopes, userInfo), nil
+	return auth.NewIdentityContext(claims.Audience[0], claims.Subject, clientID, claims.IssuedAt, scopes,
+		userInfo).WithClaims(claimsRaw), nil
 }
 
-// NewProvider creates a new OAuth2 Provider that is able to do OAuth 2-legged and 3-legged flows. It'll lookup
-// config.SecretNameClaimSymmetricKey and config.SecretNameTokenSigningRSAKey secrets from the secret manager to use to
-// sign and generate hashes for tokens. The RSA Private key is expected to be in PEM format with the public key embedded.
-// Use auth.GetInitSecretsCommand() to generate new valid secrets that will be accepted by this provider.
-// The config.SecretNameClaimSymmetricKey must be a 32-bytes long key in Base64Encoding.
-func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.SecretManager) (Provider, error) {
+// The secrets an OAuth2 Provider is built from.
+type providerSecrets struct {
+	tokenHashBase64  string
+	privateKeyPEM    string
+	oldPrivateKeyPEM string
+}
+
+func readProviderSecrets(ctx context.Context, cfg config.AuthorizationServer, sm core.SecretManager) (
+	providerSecrets, error) {
+	// This secret is used to encryptString/decrypt challenge code to maintain a stateless authcode token.
+	tokenHashBase64, err := sm.Get(ctx, cfg.ClaimSymmetricEncryptionKeySecretName)
+	if err != nil {
+		return providerSecrets{}, fmt.Errorf("failed to read secretTokenHash file. Error: %w", err)
+	}
+
+	// privateKey is used to sign JWT tokens. The default strategy uses RS256 (RSA Signature with SHA-256)
+	privateKeyPEM, err := sm.Get(ctx, cfg.TokenSigningRSAKeySecretName)
+	if err != nil {
+		return providerSecrets{}, fmt.Errorf("failed to read token signing RSA Key. Error: %w", err)
+	}
+
+	// The old key is optional, and only used to validate tokens signed before the key was rotated.
+	oldPrivateKeyPEM, err := sm.Get(ctx, cfg.OldTokenSigningRSAKeySecretName)
+	if err != nil {
+		oldPrivateKeyPEM = ""
+	}
+
+	return providerSecrets{
+		tokenHashBase64:  tokenHashBase64,
+		privateKeyPEM:    privateKeyPEM,
+		oldPrivateKeyPEM: oldPrivateKeyPEM,
+	}, nil
+}
+
+func newProviderState(cfg config.AuthorizationServer, secrets providerSecrets, clients ClientLookup) (
+	providerState, error) {
 	// fosite requires four parameters for the server to get up and running:
 	// 1. config - for any enforcement you may desire, you can do this using `compose.Config`. You like PKCE, enforce it!
 	// 2. store - no auth service is generally useful unless it can remember clients and users.
@@ -193,31 +225,18 @@ func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.Se
 		RefreshTokenScopes:    []string{refreshTokenScope},
 	}
 
-	// This secret is used to encryptString/decrypt challenge code to maintain a stateless authcode token.
-	tokenHashBase64, err := sm.Get(ctx, cfg.ClaimSymmetricEncryptionKeySecretName)
-	if err != nil {
-		return Provider{}, fmt.Errorf("failed to read secretTokenHash file. Error: %w", err)
-	}
-
-	secret, err := base64.RawStdEncoding.DecodeString(tokenHashBase64)
+	secret, err := base64.RawStdEncoding.DecodeString(secrets.tokenHashBase64)
 	if err != nil {
-		return Provider{}, fmt.Errorf("failed to decode token hash using base64 encoding. Error: %w", err)
+		return providerState{}, fmt.Errorf("failed to decode token hash using base64 encoding. Error: %w", err)
 	}
 
-	// privateKey is used to sign JWT tokens. The default strategy uses RS256 (RSA Signature with SHA-256)
-	privateKeyPEM, err := sm.Get(ctx, cfg.TokenSigningRSAKeySecretName)
+	privateKey, err := parsePrivateKey(secrets.privateKeyPEM)
 	if err != nil {
-		return Provider{}, fmt.Errorf("failed to read token signing RSA Key. Error: %w", err)
+		return providerState{}, err
 	}
 
-	block, _ := pem.Decode([]byte(privateKeyPEM))
-	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
-	if err != nil {
-		return Provider{}, fmt.Errorf("failed to parse PKCS1PrivateKey. Error: %w", err)
-	}
-
-	// Build an in-memory store with static clients defined in Config. This gives us the potential to move the clients
-	// storage into DB and allow registration of new clients to users.
+	// Build an in-memory store with static clients defined in Config. Clients registered by users are stored in the DB
+	// and found through the client lookup.
 	store := &StatelessTokenStore{
 		MemoryStore: &storage.MemoryStore{
 			IDSessions:             make(map[string]fosite.Requester),
@@ -230,6 +249,7 @@ func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.Se
 			RefreshTokenRequestIDs: map[string]string{},
 			IssuerPublicKeys:       map[string]storage.IssuerPublicKeys{},
 		},
+		clients: clients,
 	}
 
 	sec := [auth.SymmetricKeyLength]byte{}
@@ -245,13 +265,11 @@ func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.Se
 
 	publicKeys := []rsa.PublicKey{privateKey.PublicKey}
 
-	// Try to load old key to validate tokens using it to support key rotation.
-	privateKeyPEM, err = sm.Get(ctx, cfg.OldTokenSigningRSAKeySecretName)
-	if err == nil {
-		block, _ = pem.Decode([]byte(privateKeyPEM))
-		oldPrivateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	// Load the old key, if any, to validate tokens using it to support key rotation.
+	if len(secrets.oldPrivateKeyPEM) > 0 {
+		oldPrivateKey, err := parsePrivateKey(secrets.oldPrivateKeyPEM)
 		if err != nil {
-			return Provider{}, fmt.Errorf("failed to parse PKCS1PrivateKey. Error: %w", err)
+			return providerState{}, err
 		}
 
 		publicKeys = append(publicKeys, oldPrivateKey.PublicKey)
@@ -259,12 +277,77 @@ func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.Se
 
 	keysSet, err := newJSONWebKeySet(publicKeys)
 	if err != nil {
-		return Provider{}, err
+		return providerState{}, err
 	}
 
-	return Provider{
+	return providerState{
 		OAuth2Provider: oauth2Provider,
-		publicKey:      publicKeys,
+		publicKeys:     publicKeys,
 		keySet:         keysSet,
+		secrets:        secrets,
+	}, nil
+}
+
+func parsePrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode PEM encoded private key")
+	}
+
+	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PKCS1PrivateKey. Error: %w", err)
+	}
+
+	return privateKey, nil
+}
+
+// ReloadSecrets reads the provider's secrets again and, if any of them were rotated, swaps in a provider built from
+// them. Tokens are issued and validated with the previous secrets until the swap, and if the new secrets are invalid.
+func (p Provider) ReloadSecrets(ctx context.Context) error {
+	secrets, err := readProviderSecrets(ctx, p.cfg, p.sm)
+	if err != nil {
+		return err
+	}
+
+	if secrets == p.current().secrets {
+		return nil
+	}
+
+	state, err := newProviderState(p.cfg, secrets, p.clients)
+	if err != nil {
+		return err
+	}
+
+	p.state.Store(state)
+	logger.Infof(ctx, "Reloaded rotated authorization server secrets")
+	return nil
+}
+
+// NewProvider creates a new OAuth2 Provider that is able to do OAuth 2-legged and 3-legged flows. It'll lookup
+// config.SecretNameClaimSymmetricKey and config.SecretNameTokenSigningRSAKey secrets from the secret manager to use to
+// sign and generate hashes for tokens. The RSA Private key is expected to be in PEM format with the public key embedded.
+// Use auth.GetInitSecretsCommand() to generate new valid secrets that will be accepted by this provider.
+// The config.SecretNameClaimSymmetricKey must be a 32-bytes long key in Base64Encoding.
+// Clients which aren't defined in the config are looked up with clients, unless it's nil.
+func NewProvider(ctx context.Context, cfg config.AuthorizationServer, sm core.SecretManager, clients ClientLookup) (
+	Provider, error) {
+	secrets, err := readProviderSecrets(ctx, cfg, sm)
+	if err != nil {
+		return Provider{}, err
+	}
+
+	state, err := newProviderState(cfg, secrets, clients)
+	if err != nil {
+		return Provider{}, err
+	}
+
+	reloadingProvider := &reloadingOAuth2Provider{}
+	reloadingProvider.state.Store(state)
+	return Provider{
+		reloadingOAuth2Provider: reloadingProvider,
+		cfg:                     cfg,
+		sm:                      sm,
+		clients:                 clients,
 	}, nil
 }
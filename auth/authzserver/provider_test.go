@@ -39,7 +39,7 @@ func newMockProvider(t testing.TB) (Provider, auth.SecretsSet) {
 	sm.OnGet(ctx, config.SecretNameTokenSigningRSAKey).Return(buf.String(), nil)
 	sm.OnGet(ctx, config.SecretNameOldTokenSigningRSAKey).Return("", fmt.Errorf("not found"))
 
-	p, err := NewProvider(ctx, config.DefaultConfig.AppAuth.SelfAuthServer, sm)
+	p, err := NewProvider(ctx, config.DefaultConfig.AppAuth.SelfAuthServer, sm, nil)
 	assert.NoError(t, err)
 	return p, secrets
 }
@@ -70,6 +70,57 @@ func TestProvider_PublicKeys(t *testing.T) {
 	assert.Len(t, p.PublicKeys(), 1)
 }
 
+func TestProvider_ReloadSecrets(t *testing.T) {
+	ctx := context.Background()
+	encodeKey := func(key *rsa.PrivateKey) string {
+		var buf bytes.Buffer
+		assert.NoError(t, pem.Encode(&buf, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+		return buf.String()
+	}
+
+	mockSecrets := func(sm *mocks.SecretManager, secrets auth.SecretsSet, signingKey, oldSigningKey string) {
+		sm.ExpectedCalls = nil
+		sm.OnGet(ctx, config.SecretNameClaimSymmetricKey).Return(base64.RawStdEncoding.EncodeToString(secrets.TokenHashKey), nil)
+		sm.OnGet(ctx, config.SecretNameTokenSigningRSAKey).Return(signingKey, nil)
+		if len(oldSigningKey) > 0 {
+			sm.OnGet(ctx, config.SecretNameOldTokenSigningRSAKey).Return(oldSigningKey, nil)
+		} else {
+			sm.OnGet(ctx, config.SecretNameOldTokenSigningRSAKey).Return("", fmt.Errorf("not found"))
+		}
+	}
+
+	secrets, err := auth.NewSecrets()
+	assert.NoError(t, err)
+	sm := &mocks.SecretManager{}
+	mockSecrets(sm, secrets, encodeKey(secrets.TokenSigningRSAPrivateKey), "")
+
+	p, err := NewProvider(ctx, config.DefaultConfig.AppAuth.SelfAuthServer, sm, nil)
+	assert.NoError(t, err)
+	keySet := p.KeySet()
+
+	t.Run("Unchanged", func(t *testing.T) {
+		assert.NoError(t, p.ReloadSecrets(ctx))
+		assert.Equal(t, keySet, p.KeySet())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		mockSecrets(sm, secrets, "not a key", "")
+		assert.Error(t, p.ReloadSecrets(ctx))
+		assert.Equal(t, keySet, p.KeySet())
+	})
+
+	t.Run("Rotated", func(t *testing.T) {
+		rotated, err := auth.NewSecrets()
+		assert.NoError(t, err)
+		mockSecrets(sm, rotated, encodeKey(rotated.TokenSigningRSAPrivateKey), encodeKey(secrets.TokenSigningRSAPrivateKey))
+
+		assert.NoError(t, p.ReloadSecrets(ctx))
+		assert.NotEqual(t, keySet, p.KeySet())
+		assert.Equal(t, []rsa.PublicKey{rotated.TokenSigningRSAPrivateKey.PublicKey, secrets.TokenSigningRSAPrivateKey.PublicKey},
+			p.PublicKeys())
+	})
+}
+
 type CustomClaimsExample struct {
 	*jwtgo.StandardClaims
 	ClientID string   `json:"client_id"`
@@ -180,7 +231,7 @@ func TestProvider_ValidateAccessToken(t *testing.T) {
 		sm.OnGet(ctx, config.SecretNameTokenSigningRSAKey).Return(buf.String(), nil)
 		sm.OnGet(ctx, config.SecretNameOldTokenSigningRSAKey).Return("", fmt.Errorf("not found"))
 
-		p, err := NewProvider(ctx, config.DefaultConfig.AppAuth.SelfAuthServer, sm)
+		p, err := NewProvider(ctx, config.DefaultConfig.AppAuth.SelfAuthServer, sm, nil)
 		assert.NoError(t, err)
 
 		// create a signer for rsa 256
@@ -235,5 +286,6 @@ func Test_verifyClaims(t *testing.T) {
 		assert.Equal(t, sets.NewString("all", "offline"), identityCtx.Scopes())
 		assert.Equal(t, "my-client", identityCtx.AppID())
 		assert.Equal(t, "123", identityCtx.UserID())
+		assert.Equal(t, "my-client", identityCtx.Claims()["client_id"])
 	})
 }
@@ -0,0 +1,96 @@
+package authzserver
+
+import (
+	"context"
+	"crypto/rsa"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/ory/fosite"
+)
+
+// An OAuth2 provider built from a set of secrets, along with the keys tokens it signs are validated with.
+type providerState struct {
+	fosite.OAuth2Provider
+	publicKeys []rsa.PublicKey
+	keySet     jwk.Set
+	secrets    providerSecrets
+}
+
+// Delegates to the OAuth2 provider built from the current secrets, which is swapped atomically when they're rotated,
+// so requests in flight finish with the provider they started with.
+type reloadingOAuth2Provider struct {
+	state atomic.Value
+}
+
+func (p *reloadingOAuth2Provider) current() providerState {
+	return p.state.Load().(providerState)
+}
+
+func (p *reloadingOAuth2Provider) NewAuthorizeRequest(ctx context.Context, req *http.Request) (
+	fosite.AuthorizeRequester, error) {
+	return p.current().NewAuthorizeRequest(ctx, req)
+}
+
+func (p *reloadingOAuth2Provider) NewAuthorizeResponse(ctx context.Context, requester fosite.AuthorizeRequester,
+	session fosite.Session) (fosite.AuthorizeResponder, error) {
+	return p.current().NewAuthorizeResponse(ctx, requester, session)
+}
+
+func (p *reloadingOAuth2Provider) WriteAuthorizeError(rw http.ResponseWriter, requester fosite.AuthorizeRequester,
+	err error) {
+	p.current().WriteAuthorizeError(rw, requester, err)
+}
+
+func (p *reloadingOAuth2Provider) WriteAuthorizeResponse(rw http.ResponseWriter, requester fosite.AuthorizeRequester,
+	responder fosite.AuthorizeResponder) {
+	p.current().WriteAuthorizeResponse(rw, requester, responder)
+}
+
+func (p *reloadingOAuth2Provider) NewAccessRequest(ctx context.Context, req *http.Request, session fosite.Session) (
+	fosite.AccessRequester, error) {
+	return p.current().NewAccessRequest(ctx, req, session)
+}
+
+func (p *reloadingOAuth2Provider) NewAccessResponse(ctx context.Context, requester fosite.AccessRequester) (
+	fosite.AccessResponder, error) {
+	return p.current().NewAccessResponse(ctx, requester)
+}
+
+func (p *reloadingOAuth2Provider) WriteAccessError(rw http.ResponseWriter, requester fosite.AccessRequester,
+	err error) {
+	p.current().WriteAccessError(rw, requester, err)
+}
+
+func (p *reloadingOAuth2Provider) WriteAccessResponse(rw http.ResponseWriter, requester fosite.AccessRequester,
+	responder fosite.AccessResponder) {
+	p.current().WriteAccessResponse(rw, requester, responder)
+}
+
+func (p *reloadingOAuth2Provider) NewRevocationRequest(ctx context.Context, r *http.Request) error {
+	return p.current().NewRevocationRequest(ctx, r)
+}
+
+func (p *reloadingOAuth2Provider) WriteRevocationResponse(rw http.ResponseWriter, err error) {
+	p.current().WriteRevocationResponse(rw, err)
+}
+
+func (p *reloadingOAuth2Provider) IntrospectToken(ctx context.Context, token string, tokenUse fosite.TokenUse,
+	session fosite.Session, scope ...string) (fosite.TokenUse, fosite.AccessRequester, error) {
+	return p.current().IntrospectToken(ctx, token, tokenUse, session, scope...)
+}
+
+func (p *reloadingOAuth2Provider) NewIntrospectionRequest(ctx context.Context, r *http.Request,
+	session fosite.Session) (fosite.IntrospectionResponder, error) {
+	return p.current().NewIntrospectionRequest(ctx, r, session)
+}
+
+func (p *reloadingOAuth2Provider) WriteIntrospectionError(rw http.ResponseWriter, err error) {
+	p.current().WriteIntrospectionError(rw, err)
+}
+
+func (p *reloadingOAuth2Provider) WriteIntrospectionResponse(rw http.ResponseWriter,
+	r fosite.IntrospectionResponder) {
+	p.current().WriteIntrospectionResponse(rw, r)
+}
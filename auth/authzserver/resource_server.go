@@ -15,6 +15,7 @@ import (
 	"github.com/flyteorg/flytestdlib/config"
 
 	"github.com/coreos/go-oidc"
+	"github.com/flyteorg/flyteadmin/auth"
 	authConfig "github.com/flyteorg/flyteadmin/auth/config"
 	"github.com/flyteorg/flyteadmin/auth/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
@@ -30,7 +31,7 @@ type ResourceServer struct {
 func (r ResourceServer) ValidateAccessToken(ctx context.Context, expectedAudience, tokenStr string) (interfaces.IdentityContext, error) {
 	raw, err := r.signatureVerifier.VerifySignature(ctx, tokenStr)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", auth.ErrInvalidSignature, err)
 	}
 
 	claimsRaw := map[string]interface{}{}
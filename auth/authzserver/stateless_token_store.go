@@ -2,6 +2,7 @@ package authzserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"reflect"
@@ -35,6 +36,18 @@ type StatelessTokenStore struct {
 	*storage.MemoryStore
 	jwt.JWTStrategy
 	encryptor Encryptor
+	// Finds the clients registered at runtime. Optional.
+	clients ClientLookup
+}
+
+// GetClient returns the static client with the given id, or else the registered client with that id.
+func (s StatelessTokenStore) GetClient(ctx context.Context, id string) (fosite.Client, error) {
+	client, err := s.MemoryStore.GetClient(ctx, id)
+	if err == nil || s.clients == nil || !errors.Is(err, fosite.ErrNotFound) {
+		return client, err
+	}
+
+	return s.clients.GetClient(ctx, id)
 }
 
 func (s StatelessTokenStore) rehydrateSession(ctx context.Context, token string) (request *fosite.Request, err error) {
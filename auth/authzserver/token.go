@@ -1,6 +1,7 @@
 package authzserver
 
 import (
+	"errors"
 	"net/http"
 	"reflect"
 	"strings"
@@ -35,6 +36,11 @@ func tokenEndpoint(authCtx interfaces.AuthenticationContext, rw http.ResponseWri
 	accessRequest, err := oauth2Provider.NewAccessRequest(ctx, req, emptySession)
 	if err != nil {
 		logger.Infof(ctx, "Error occurred in NewAccessRequest: %+v", err)
+		if errors.Is(err, fosite.ErrInactiveToken) {
+			// Fosite reports redeeming an already used refresh token as an inactive token.
+			authCtx.Metrics().RefreshTokenReuse.Inc()
+		}
+
 		oauth2Provider.WriteAccessError(rw, accessRequest, err)
 		return
 	}
@@ -76,6 +82,7 @@ func tokenEndpoint(authCtx interfaces.AuthenticationContext, rw http.ResponseWri
 
 	// All done, send the response.
 	oauth2Provider.WriteAccessResponse(rw, fositeAccessRequest, response)
+	authCtx.Metrics().TokensIssued.WithLabelValues(strings.Join(fositeAccessRequest.GetGrantTypes(), " ")).Inc()
 
 	// The client now has a valid access token
 }
@@ -14,8 +14,11 @@ import (
 	"time"
 
 	jwtgo "github.com/golang-jwt/jwt/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
+	"github.com/flyteorg/flyteadmin/auth"
 	"github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flytestdlib/promutils"
 
 	"github.com/stretchr/testify/assert"
 
@@ -63,9 +66,11 @@ func Test_tokenEndpoint(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/token", bytes.NewReader([]byte(payload.Encode())))
 		req.PostForm = payload
 		req.Header.Set("authorization", basicAuth("flytectl", "foobar"))
+		metrics := auth.NewAuthenticationMetrics(promutils.NewTestScope())
 		mockAuthCtx := &mocks.AuthenticationContext{}
 		mockAuthCtx.OnOAuth2Provider().Return(oauth2Provider)
 		mockAuthCtx.OnOptions().Return(&config.Config{})
+		mockAuthCtx.OnMetrics().Return(metrics)
 
 		rw := httptest.NewRecorder()
 		tokenEndpoint(mockAuthCtx, rw, req)
@@ -73,6 +78,8 @@ func Test_tokenEndpoint(t *testing.T) {
 			t.FailNow()
 		}
 
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.TokensIssued.WithLabelValues("authorization_code")))
+
 		m := map[string]interface{}{}
 		assert.NoError(t, json.Unmarshal(rw.Body.Bytes(), &m))
 		assert.Equal(t, 0.5*time.Hour.Seconds()-1, m["expires_in"])
@@ -83,6 +83,8 @@ var (
 				ClaimSymmetricEncryptionKeySecretName: SecretNameClaimSymmetricKey,
 				TokenSigningRSAKeySecretName:          SecretNameTokenSigningRSAKey,
 				OldTokenSigningRSAKeySecretName:       SecretNameOldTokenSigningRSAKey,
+				ClientCacheTTL:                        config.Duration{Duration: time.Minute},
+				ClientCacheSize:                       1000,
 				StaticClients: map[string]*fosite.DefaultClient{
 					"flyte-cli": {
 						ID:            "flyte-cli",
@@ -111,6 +113,23 @@ var (
 				},
 			},
 		},
+		Authorization: AuthorizationConfig{
+			External: ExternalAuthorizerConfig{
+				Timeout:   config.Duration{Duration: 2 * time.Second},
+				CacheTTL:  config.Duration{Duration: 30 * time.Second},
+				CacheSize: 1000,
+			},
+		},
+		SecretsProvider: SecretsProviderConfig{
+			Type:            SecretsProviderTypeFile,
+			RefreshInterval: config.Duration{Duration: 5 * time.Minute},
+			Kubernetes: KubernetesSecretsProviderConfig{
+				MountPath: "/etc/secrets",
+			},
+			Vault: VaultSecretsProviderConfig{
+				Mount: "secret",
+			},
+		},
 	}
 
 	cfgSection = config.MustRegisterSection("auth", DefaultConfig)
@@ -146,6 +165,177 @@ type Config struct {
 
 	// AppAuth settings used to authenticate and control/limit access scopes for apps.
 	AppAuth OAuth2Options `json:"appAuth" pflag:",Defines Auth options for apps. UserAuth must be enabled for AppAuth to work."`
+
+	// WorkloadIdentity settings used to authenticate dataplane components (e.g. flytepropeller) that present SPIFFE
+	// JWT-SVIDs or Kubernetes projected service account tokens instead of OAuth2 access tokens.
+	WorkloadIdentity WorkloadIdentityConfig `json:"workloadIdentity" pflag:",Defines Auth options for dataplane workloads presenting SPIFFE or Kubernetes service account tokens."`
+
+	// Authorization settings used to restrict what authenticated identities are allowed to access.
+	Authorization AuthorizationConfig `json:"authorization" pflag:",Defines authorization options for authenticated identities."`
+
+	// SecretsProvider settings determine where the secrets referenced by name in this config (e.g. client secrets and
+	// cookie keys) are read from.
+	SecretsProvider SecretsProviderConfig `json:"secretsProvider" pflag:",Defines where secrets referenced by this config are read from."`
+}
+
+// SecretsProviderType defines the backend auth secrets are read from.
+type SecretsProviderType = string
+
+const (
+	// SecretsProviderTypeFile reads secrets from environment variables or files using the secrets section config.
+	SecretsProviderTypeFile SecretsProviderType = "file"
+
+	// SecretsProviderTypeKubernetes reads secrets from a mounted kubernetes secret and picks up rotated values without
+	// restarting.
+	SecretsProviderTypeKubernetes SecretsProviderType = "kubernetes"
+
+	// SecretsProviderTypeAWS reads secrets from AWS Secrets Manager.
+	SecretsProviderTypeAWS SecretsProviderType = "aws"
+
+	// SecretsProviderTypeVault reads secrets from a HashiCorp Vault KV version 2 secrets engine.
+	SecretsProviderTypeVault SecretsProviderType = "vault"
+)
+
+type SecretsProviderConfig struct {
+	// Type determines the backend secrets are read from.
+	Type SecretsProviderType `json:"type" pflag:",Defines the secrets provider to use. One of file, kubernetes, aws or vault."`
+
+	// RefreshInterval determines how long secrets read from kubernetes, aws or vault are cached before being read
+	// again. It's also how often admin reloads the cookie keys, the OAuth2 client secret and the authorization server's
+	// secrets, so rotated secrets are used without restarting.
+	RefreshInterval config.Duration `json:"refreshInterval" pflag:",Defines how long secrets are cached before being read again and how often auth secrets are reloaded."`
+
+	Kubernetes KubernetesSecretsProviderConfig `json:"kubernetes" pflag:",Defines options for the kubernetes secrets provider."`
+	AWS        AWSSecretsProviderConfig        `json:"aws" pflag:",Defines options for the AWS Secrets Manager secrets provider."`
+	Vault      VaultSecretsProviderConfig      `json:"vault" pflag:",Defines options for the Vault secrets provider."`
+}
+
+type KubernetesSecretsProviderConfig struct {
+	// MountPath is the directory the kubernetes secret is mounted at. Each key of the secret is expected to be a file
+	// named after the secret name.
+	MountPath string `json:"mountPath" pflag:",Directory the kubernetes secret is mounted at."`
+}
+
+type AWSSecretsProviderConfig struct {
+	// Region is the AWS region to read secrets from.
+	Region string `json:"region" pflag:",AWS region to read secrets from."`
+
+	// SecretIDPrefix is prepended to secret names to build the id of the secret in AWS Secrets Manager.
+	SecretIDPrefix string `json:"secretIdPrefix" pflag:",Prefix prepended to secret names to build AWS Secrets Manager secret ids."`
+}
+
+type VaultSecretsProviderConfig struct {
+	// Address is the base url of the Vault server.
+	Address config.URL `json:"address" pflag:",Base url of the Vault server."`
+
+	// Mount is the path the KV version 2 secrets engine is mounted at.
+	Mount string `json:"mount" pflag:",Path the KV version 2 secrets engine is mounted at."`
+
+	// Path is the path of the Vault secret holding the auth secrets. Each key of the secret is a secret name.
+	Path string `json:"path" pflag:",Path of the Vault secret holding the auth secrets."`
+
+	// TokenFilePath is optional and points to a file holding the Vault token. If not set, the token is read from the
+	// VAULT_TOKEN environment variable.
+	TokenFilePath string `json:"tokenFilePath" pflag:",Optional: Path to a file holding the Vault token. Defaults to the VAULT_TOKEN environment variable."`
+}
+
+// ProjectWildcard, when listed in a role's projects, grants visibility to all projects.
+const ProjectWildcard = "*"
+
+type Role struct {
+	// Projects lists the project ids holders of this role are allowed to view.
+	Projects []string `json:"projects"`
+
+	// Orgs lists the orgs holders of this role belong to. Holders are allowed to view the projects of their orgs.
+	Orgs []string `json:"orgs"`
+}
+
+type AuthorizationConfig struct {
+	// EnforceProjectVisibility restricts list endpoints to the projects the caller's roles grant visibility to.
+	EnforceProjectVisibility bool `json:"enforceProjectVisibility" pflag:",Restricts list endpoints to the projects the caller's roles grant visibility to."`
+
+	// RoleBindings maps identities (user subjects, emails or app ids) to the names of the roles they hold.
+	RoleBindings map[string][]string `json:"roleBindings" pflag:"-,Maps identities to the roles they hold."`
+
+	// Roles defines the roles that can be bound to identities.
+	Roles map[string]Role `json:"roles" pflag:"-,Defines the roles that can be bound to identities."`
+
+	// ImpersonationRole is optional and names the role that allows callers to perform requests on behalf of other users
+	// by setting the flyte-act-as header. Impersonation is disabled if not set.
+	ImpersonationRole string `json:"impersonationRole" pflag:",Optional: Role that allows callers to perform requests on behalf of other users."`
+
+	// OAuthClientAdminRole is optional and names the role that allows callers to register OAuth2 clients with the all
+	// and events:write scopes. Such clients can't be registered if not set.
+	OAuthClientAdminRole string `json:"oauthClientAdminRole" pflag:",Optional: Role that allows callers to register OAuth2 clients with the all and events:write scopes."`
+
+	// OrgsClaim is optional and names the claim of ID and access tokens which lists the orgs of the identity, e.g. a
+	// groups claim the IdP maps orgs into. The claim may hold a single org or a list of them.
+	OrgsClaim string `json:"orgsClaim" pflag:",Optional: Token claim listing the orgs of the identity."`
+
+	// External settings for an optional policy endpoint (e.g. an OPA sidecar) consulted on every request.
+	External ExternalAuthorizerConfig `json:"external" pflag:",Defines an optional external authorizer consulted on every request."`
+}
+
+type ExternalAuthorizerConfig struct {
+	// Enabled turns on consulting the external authorizer for every gRPC request.
+	Enabled bool `json:"enabled" pflag:",Enables consulting the external authorizer."`
+
+	// URL is the policy endpoint requests are posted to. For OPA this is the data API path of the decision, e.g.
+	// http://localhost:8181/v1/data/flyte/authz/allow
+	URL config.URL `json:"url" pflag:",Policy endpoint authorization requests are posted to."`
+
+	// Timeout bounds the time spent waiting for a decision.
+	Timeout config.Duration `json:"timeout" pflag:",Defines how long to wait for a decision from the external authorizer."`
+
+	// CacheTTL determines how long decisions are cached for identical requests.
+	CacheTTL config.Duration `json:"cacheTtl" pflag:",Defines how long decisions are cached."`
+
+	// CacheSize bounds the number of cached decisions.
+	CacheSize int `json:"cacheSize" pflag:",Defines the maximum number of cached decisions."`
+
+	// FailOpen allows requests through when the external authorizer can't be reached. Requests are denied otherwise.
+	FailOpen bool `json:"failOpen" pflag:",Allows requests when the external authorizer can't be reached."`
+}
+
+// WorkloadIdentityType defines the kind of workload identity token an issuer mints.
+type WorkloadIdentityType = string
+
+const (
+	// WorkloadIdentityTypeSpiffe identifies SPIFFE JWT-SVIDs. Subjects take the form spiffe://<trust-domain>/<path>.
+	WorkloadIdentityTypeSpiffe WorkloadIdentityType = "spiffe"
+
+	// WorkloadIdentityTypeKubernetes identifies Kubernetes projected service account tokens. Subjects take the form
+	// system:serviceaccount:<namespace>:<name>.
+	WorkloadIdentityTypeKubernetes WorkloadIdentityType = "kubernetes"
+)
+
+type WorkloadIdentityConfig struct {
+	// Enabled turns on validation of workload identity tokens. Authenticated workloads are only granted the
+	// permission to write execution events.
+	Enabled bool `json:"enabled" pflag:",Enables authenticating dataplane workloads using workload identity tokens."`
+
+	// Issuers defines the set of trusted workload identity token issuers.
+	Issuers []WorkloadIdentityIssuer `json:"issuers" pflag:"-,Defines the set of trusted workload identity token issuers."`
+}
+
+type WorkloadIdentityIssuer struct {
+	// Type determines the format of subjects minted by this issuer.
+	Type WorkloadIdentityType `json:"type"`
+
+	// Issuer is the expected value of the iss claim. For Kubernetes this is the cluster's service account issuer,
+	// for SPIFFE this is the issuer advertised by the SPIRE OIDC discovery provider.
+	Issuer string `json:"issuer"`
+
+	// JWKSURL is optional. If not provided, it'll be discovered from <issuer>/.well-known/openid-configuration.
+	JWKSURL config.URL `json:"jwksUrl"`
+
+	// Audience is optional and defines the set of accepted audiences. If not provided, the audience is expected to be
+	// the public Uri of the service.
+	Audience []string `json:"audience"`
+
+	// AllowedSubjects maps workload subjects (SPIFFE IDs or system:serviceaccount:<namespace>:<name>) to the machine
+	// identity they authenticate as. Tokens with subjects not present in this map are rejected.
+	AllowedSubjects map[string]string `json:"allowedSubjects"`
 }
 
 type AuthorizationServer struct {
@@ -168,6 +358,11 @@ type AuthorizationServer struct {
 
 	// A list of clients to grant access to.
 	StaticClients map[string]*fosite.DefaultClient `json:"staticClients" pflag:"-,Defines statically defined list of clients to allow."`
+
+	// Clients registered by users are stored in the DB and cached, so deleted clients and rotated secrets take effect
+	// once cached clients expire.
+	ClientCacheTTL  config.Duration `json:"clientCacheTtl" pflag:",Defines how long registered clients are cached."`
+	ClientCacheSize int             `json:"clientCacheSize" pflag:",Defines the maximum number of cached registered clients."`
 }
 
 type ExternalAuthorizationServer struct {
@@ -70,11 +70,33 @@ func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.claimSymmetricEncryptionKeySecretName"), DefaultConfig.AppAuth.SelfAuthServer.ClaimSymmetricEncryptionKeySecretName, "OPTIONAL: Secret name to use to encrypt claims in authcode token.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.tokenSigningRSAKeySecretName"), DefaultConfig.AppAuth.SelfAuthServer.TokenSigningRSAKeySecretName, "OPTIONAL: Secret name to use to retrieve RSA Signing Key.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.oldTokenSigningRSAKeySecretName"), DefaultConfig.AppAuth.SelfAuthServer.OldTokenSigningRSAKeySecretName, "OPTIONAL: Secret name to use to retrieve Old RSA Signing Key. This can be useful during key rotation to continue to accept older tokens.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.clientCacheTtl"), DefaultConfig.AppAuth.SelfAuthServer.ClientCacheTTL.String(), "Defines how long registered clients are cached.")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "appAuth.selfAuthServer.clientCacheSize"), DefaultConfig.AppAuth.SelfAuthServer.ClientCacheSize, "Defines the maximum number of cached registered clients.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.externalAuthServer.baseUrl"), DefaultConfig.AppAuth.ExternalAuthServer.BaseURL.String(), "This should be the base url of the authorization server that you are trying to hit. With Okta for instance,  it will look something like https://company.okta.com/oauth2/abcdef123456789/")
 	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "appAuth.externalAuthServer.allowedAudience"), []string{}, "Optional: A list of allowed audiences. If not provided,  the audience is expected to be the public Uri of the service.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.externalAuthServer.metadataUrl"), DefaultConfig.AppAuth.ExternalAuthServer.MetadataEndpointURL.String(), "Optional: If the server doesn't support /.well-known/oauth-authorization-server,  you can set a custom metadata url here.'")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.thirdPartyConfig.flyteClient.clientId"), DefaultConfig.AppAuth.ThirdParty.FlyteClientConfig.ClientID, "public identifier for the app which handles authorization for a Flyte deployment")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "appAuth.thirdPartyConfig.flyteClient.redirectUri"), DefaultConfig.AppAuth.ThirdParty.FlyteClientConfig.RedirectURI, "This is the callback uri registered with the app which handles authorization for a Flyte deployment")
 	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "appAuth.thirdPartyConfig.flyteClient.scopes"), []string{}, "Recommended scopes for the client to request.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "workloadIdentity.enabled"), DefaultConfig.WorkloadIdentity.Enabled, "Enables authenticating dataplane workloads using workload identity tokens.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "authorization.enforceProjectVisibility"), DefaultConfig.Authorization.EnforceProjectVisibility, "Restricts list endpoints to the projects the caller's roles grant visibility to.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "secretsProvider.type"), DefaultConfig.SecretsProvider.Type, "Defines the secrets provider to use. One of file, kubernetes, aws or vault.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "secretsProvider.refreshInterval"), DefaultConfig.SecretsProvider.RefreshInterval.String(), "Defines how long secrets are cached before being read again and how often auth secrets are reloaded.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "secretsProvider.kubernetes.mountPath"), DefaultConfig.SecretsProvider.Kubernetes.MountPath, "Directory the kubernetes secret is mounted at.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "secretsProvider.aws.region"), DefaultConfig.SecretsProvider.AWS.Region, "AWS region to read secrets from.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "secretsProvider.aws.secretIdPrefix"), DefaultConfig.SecretsProvider.AWS.SecretIDPrefix, "Prefix prepended to secret names to build AWS Secrets Manager secret ids.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "secretsProvider.vault.address"), DefaultConfig.SecretsProvider.Vault.Address.String(), "Base url of the Vault server.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "secretsProvider.vault.mount"), DefaultConfig.SecretsProvider.Vault.Mount, "Path the KV version 2 secrets engine is mounted at.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "secretsProvider.vault.path"), DefaultConfig.SecretsProvider.Vault.Path, "Path of the Vault secret holding the auth secrets.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "secretsProvider.vault.tokenFilePath"), DefaultConfig.SecretsProvider.Vault.TokenFilePath, "Optional: Path to a file holding the Vault token. Defaults to the VAULT_TOKEN environment variable.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authorization.impersonationRole"), DefaultConfig.Authorization.ImpersonationRole, "Optional: Role that allows callers to perform requests on behalf of other users.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authorization.oauthClientAdminRole"), DefaultConfig.Authorization.OAuthClientAdminRole, "Optional: Role that allows callers to register OAuth2 clients with the all and events:write scopes.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authorization.orgsClaim"), DefaultConfig.Authorization.OrgsClaim, "Optional: Token claim listing the orgs of the identity.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "authorization.external.enabled"), DefaultConfig.Authorization.External.Enabled, "Enables consulting the external authorizer.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authorization.external.url"), DefaultConfig.Authorization.External.URL.String(), "Policy endpoint authorization requests are posted to.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authorization.external.timeout"), DefaultConfig.Authorization.External.Timeout.String(), "Defines how long to wait for a decision from the external authorizer.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authorization.external.cacheTtl"), DefaultConfig.Authorization.External.CacheTTL.String(), "Defines how long decisions are cached.")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "authorization.external.cacheSize"), DefaultConfig.Authorization.External.CacheSize, "Defines the maximum number of cached decisions.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "authorization.external.failOpen"), DefaultConfig.Authorization.External.FailOpen, "Allows requests when the external authorizer can't be reached.")
 	return cmdFlags
 }
@@ -379,6 +379,34 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_appAuth.selfAuthServer.clientCacheTtl", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := DefaultConfig.AppAuth.SelfAuthServer.ClientCacheTTL.String()
+
+			cmdFlags.Set("appAuth.selfAuthServer.clientCacheTtl", testValue)
+			if vString, err := cmdFlags.GetString("appAuth.selfAuthServer.clientCacheTtl"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.AppAuth.SelfAuthServer.ClientCacheTTL)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_appAuth.selfAuthServer.clientCacheSize", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("appAuth.selfAuthServer.clientCacheSize", testValue)
+			if vInt, err := cmdFlags.GetInt("appAuth.selfAuthServer.clientCacheSize"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vInt), &actual.AppAuth.SelfAuthServer.ClientCacheSize)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 	t.Run("Test_appAuth.externalAuthServer.baseUrl", func(t *testing.T) {
 
 		t.Run("Override", func(t *testing.T) {
@@ -463,4 +491,284 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_workloadIdentity.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("workloadIdentity.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("workloadIdentity.enabled"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.WorkloadIdentity.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.enforceProjectVisibility", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authorization.enforceProjectVisibility", testValue)
+			if vBool, err := cmdFlags.GetBool("authorization.enforceProjectVisibility"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.Authorization.EnforceProjectVisibility)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_secretsProvider.type", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("secretsProvider.type", testValue)
+			if vString, err := cmdFlags.GetString("secretsProvider.type"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.SecretsProvider.Type)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_secretsProvider.refreshInterval", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := DefaultConfig.SecretsProvider.RefreshInterval.String()
+
+			cmdFlags.Set("secretsProvider.refreshInterval", testValue)
+			if vString, err := cmdFlags.GetString("secretsProvider.refreshInterval"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.SecretsProvider.RefreshInterval)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_secretsProvider.kubernetes.mountPath", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("secretsProvider.kubernetes.mountPath", testValue)
+			if vString, err := cmdFlags.GetString("secretsProvider.kubernetes.mountPath"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.SecretsProvider.Kubernetes.MountPath)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_secretsProvider.aws.region", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("secretsProvider.aws.region", testValue)
+			if vString, err := cmdFlags.GetString("secretsProvider.aws.region"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.SecretsProvider.AWS.Region)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_secretsProvider.aws.secretIdPrefix", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("secretsProvider.aws.secretIdPrefix", testValue)
+			if vString, err := cmdFlags.GetString("secretsProvider.aws.secretIdPrefix"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.SecretsProvider.AWS.SecretIDPrefix)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_secretsProvider.vault.address", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := DefaultConfig.SecretsProvider.Vault.Address.String()
+
+			cmdFlags.Set("secretsProvider.vault.address", testValue)
+			if vString, err := cmdFlags.GetString("secretsProvider.vault.address"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.SecretsProvider.Vault.Address)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_secretsProvider.vault.mount", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("secretsProvider.vault.mount", testValue)
+			if vString, err := cmdFlags.GetString("secretsProvider.vault.mount"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.SecretsProvider.Vault.Mount)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_secretsProvider.vault.path", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("secretsProvider.vault.path", testValue)
+			if vString, err := cmdFlags.GetString("secretsProvider.vault.path"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.SecretsProvider.Vault.Path)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_secretsProvider.vault.tokenFilePath", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("secretsProvider.vault.tokenFilePath", testValue)
+			if vString, err := cmdFlags.GetString("secretsProvider.vault.tokenFilePath"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.SecretsProvider.Vault.TokenFilePath)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.impersonationRole", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authorization.impersonationRole", testValue)
+			if vString, err := cmdFlags.GetString("authorization.impersonationRole"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Authorization.ImpersonationRole)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.oauthClientAdminRole", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authorization.oauthClientAdminRole", testValue)
+			if vString, err := cmdFlags.GetString("authorization.oauthClientAdminRole"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Authorization.OAuthClientAdminRole)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.orgsClaim", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authorization.orgsClaim", testValue)
+			if vString, err := cmdFlags.GetString("authorization.orgsClaim"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Authorization.OrgsClaim)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.external.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authorization.external.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("authorization.external.enabled"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.Authorization.External.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.external.url", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := DefaultConfig.Authorization.External.URL.String()
+
+			cmdFlags.Set("authorization.external.url", testValue)
+			if vString, err := cmdFlags.GetString("authorization.external.url"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Authorization.External.URL)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.external.timeout", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := DefaultConfig.Authorization.External.Timeout.String()
+
+			cmdFlags.Set("authorization.external.timeout", testValue)
+			if vString, err := cmdFlags.GetString("authorization.external.timeout"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Authorization.External.Timeout)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.external.cacheTtl", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := DefaultConfig.Authorization.External.CacheTTL.String()
+
+			cmdFlags.Set("authorization.external.cacheTtl", testValue)
+			if vString, err := cmdFlags.GetString("authorization.external.cacheTtl"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Authorization.External.CacheTTL)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.external.cacheSize", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authorization.external.cacheSize", testValue)
+			if vInt, err := cmdFlags.GetInt("authorization.external.cacheSize"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vInt), &actual.Authorization.External.CacheSize)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authorization.external.failOpen", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authorization.external.failOpen", testValue)
+			if vBool, err := cmdFlags.GetBool("authorization.external.failOpen"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.Authorization.External.FailOpen)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
@@ -20,5 +20,14 @@ const (
 	OIdCMetadataEndpoint = ".well-known/openid-configuration"
 
 	ContextKeyIdentityContext = contextutils.Key("identity_context")
+	ContextKeyRoles           = contextutils.Key("roles")
+	ContextKeyVisibleProjects = contextutils.Key("visible_projects")
+	ContextKeyOrgs            = contextutils.Key("orgs")
+	ContextKeyImpersonator    = contextutils.Key("impersonator")
 	ScopeAll                  = "all"
+	ScopeEventsWrite          = "events:write"
+
+	// ImpersonationHeader is set by callers holding the impersonation role to perform requests on behalf of the named
+	// user.
+	ImpersonationHeader = "flyte-act-as"
 )
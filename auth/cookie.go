@@ -81,7 +81,7 @@ func retrieveSecureCookie(ctx context.Context, request *http.Request, cookieName
 	token, err := ReadSecureCookie(ctx, *cookie, hashKey, blockKey)
 	if err != nil {
 		logger.Errorf(ctx, "Error reading existing secure cookie [%v]. Error: %s", cookieName, err)
-		return "", errors.Errorf(ErrTokenNil, "Error reading existing secure cookie [%v]. Error: %s", cookieName, err)
+		return "", errors.Wrapf(ErrTokenNil, err, "Error reading existing secure cookie [%v]", cookieName)
 	}
 
 	if len(token) == 0 {
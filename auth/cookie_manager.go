@@ -12,12 +12,16 @@ import (
 
 	"github.com/flyteorg/flytestdlib/errors"
 	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/oauth2"
+
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
 )
 
 type CookieManager struct {
-	hashKey  []byte
-	blockKey []byte
+	hashKey            []byte
+	blockKey           []byte
+	decryptionFailures *prometheus.CounterVec
 }
 
 const (
@@ -28,7 +32,8 @@ const (
 	ErrNoIDToken errors.ErrorCode = "NO_ID_TOKEN_IN_RESPONSE"
 )
 
-func NewCookieManager(ctx context.Context, hashKeyEncoded, blockKeyEncoded string) (CookieManager, error) {
+func NewCookieManager(ctx context.Context, hashKeyEncoded, blockKeyEncoded string,
+	metrics interfaces.AuthenticationMetrics) (CookieManager, error) {
 	logger.Infof(ctx, "Instantiating cookie manager")
 
 	hashKey, err := base64.RawStdEncoding.DecodeString(hashKeyEncoded)
@@ -42,11 +47,22 @@ func NewCookieManager(ctx context.Context, hashKeyEncoded, blockKeyEncoded strin
 	}
 
 	return CookieManager{
-		hashKey:  hashKey,
-		blockKey: blockKey,
+		hashKey:            hashKey,
+		blockKey:           blockKey,
+		decryptionFailures: metrics.CookieDecryptionFailures,
 	}, nil
 }
 
+// readSecureCookie retrieves and decodes the named cookie, counting cookies that are present but fail to decode.
+func (c CookieManager) readSecureCookie(ctx context.Context, request *http.Request, cookieName string) (string, error) {
+	value, err := retrieveSecureCookie(ctx, request, cookieName, c.hashKey, c.blockKey)
+	if err != nil && errors.IsCausedBy(err, ErrSecureCookie) {
+		c.decryptionFailures.WithLabelValues(cookieName).Inc()
+	}
+
+	return value, err
+}
+
 // TODO: Separate refresh token from access token, remove named returns, and use stdlib errors.
 // RetrieveTokenValues retrieves id, access and refresh tokens from cookies if they exist. The existence of a refresh token
 // in a cookie is optional and hence failure to find or read that cookie is tolerated. An error is returned in case of failure
@@ -54,17 +70,17 @@ func NewCookieManager(ctx context.Context, hashKeyEncoded, blockKeyEncoded strin
 func (c CookieManager) RetrieveTokenValues(ctx context.Context, request *http.Request) (idToken, accessToken,
 	refreshToken string, err error) {
 
-	idToken, err = retrieveSecureCookie(ctx, request, idTokenCookieName, c.hashKey, c.blockKey)
+	idToken, err = c.readSecureCookie(ctx, request, idTokenCookieName)
 	if err != nil {
 		return "", "", "", err
 	}
 
-	accessToken, err = retrieveSecureCookie(ctx, request, accessTokenCookieName, c.hashKey, c.blockKey)
+	accessToken, err = c.readSecureCookie(ctx, request, accessTokenCookieName)
 	if err != nil {
 		return "", "", "", err
 	}
 
-	refreshToken, err = retrieveSecureCookie(ctx, request, refreshTokenCookieName, c.hashKey, c.blockKey)
+	refreshToken, err = c.readSecureCookie(ctx, request, refreshTokenCookieName)
 	if err != nil {
 		// Refresh tokens are optional. Depending on the auth url (IdP specific) we might or might not receive a refresh
 		// token. In case we do not, we will just have to redirect to IdP whenever access/id tokens expire.
@@ -94,7 +110,7 @@ func (c CookieManager) SetUserInfoCookie(ctx context.Context, writer http.Respon
 }
 
 func (c CookieManager) RetrieveUserInfo(ctx context.Context, request *http.Request) (*service.UserInfoResponse, error) {
-	userInfoCookie, err := retrieveSecureCookie(ctx, request, userInfoCookieName, c.hashKey, c.blockKey)
+	userInfoCookie, err := c.readSecureCookie(ctx, request, userInfoCookieName)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +126,7 @@ func (c CookieManager) RetrieveUserInfo(ctx context.Context, request *http.Reque
 }
 
 func (c CookieManager) RetrieveAuthCodeRequest(ctx context.Context, request *http.Request) (authRequestURL string, err error) {
-	authCodeCookie, err := retrieveSecureCookie(ctx, request, authCodeCookieName, c.hashKey, c.blockKey)
+	authCodeCookie, err := c.readSecureCookie(ctx, request, authCodeCookieName)
 	if err != nil {
 		return "", err
 	}
@@ -8,17 +8,21 @@ import (
 	"testing"
 	"time"
 
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/oauth2"
 )
 
+var testAuthenticationMetrics = NewAuthenticationMetrics(promutils.NewTestScope())
+
 func TestCookieManager_SetTokenCookies(t *testing.T) {
 	ctx := context.Background()
 	// These were generated for unit testing only.
 	hashKeyEncoded := "wG4pE1ccdw/pHZ2ml8wrD5VJkOtLPmBpWbKHmezWXktGaFbRoAhXidWs8OpbA3y7N8vyZhz1B1E37+tShWC7gA" //nolint:goconst
 	blockKeyEncoded := "afyABVgGOvWJFxVyOvCWCupoTn6BkNl4SOHmahho16Q"                                           //nolint:goconst
 
-	manager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded)
+	manager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded, testAuthenticationMetrics)
 	assert.NoError(t, err)
 
 	token := &oauth2.Token{
@@ -46,7 +50,7 @@ func TestCookieManager_RetrieveTokenValues(t *testing.T) {
 	hashKeyEncoded := "wG4pE1ccdw/pHZ2ml8wrD5VJkOtLPmBpWbKHmezWXktGaFbRoAhXidWs8OpbA3y7N8vyZhz1B1E37+tShWC7gA" //nolint:goconst
 	blockKeyEncoded := "afyABVgGOvWJFxVyOvCWCupoTn6BkNl4SOHmahho16Q"                                           //nolint:goconst
 
-	manager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded)
+	manager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded, testAuthenticationMetrics)
 	assert.NoError(t, err)
 
 	token := &oauth2.Token{
@@ -76,6 +80,28 @@ func TestCookieManager_RetrieveTokenValues(t *testing.T) {
 	assert.Equal(t, "refresh", refresh)
 }
 
+func TestCookieManager_RetrieveTokenValues_DecryptionFailure(t *testing.T) {
+	ctx := context.Background()
+	// These were generated for unit testing only.
+	hashKeyEncoded := "wG4pE1ccdw/pHZ2ml8wrD5VJkOtLPmBpWbKHmezWXktGaFbRoAhXidWs8OpbA3y7N8vyZhz1B1E37+tShWC7gA" //nolint:goconst
+	blockKeyEncoded := "afyABVgGOvWJFxVyOvCWCupoTn6BkNl4SOHmahho16Q"                                           //nolint:goconst
+
+	metrics := NewAuthenticationMetrics(promutils.NewTestScope())
+	manager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded, metrics)
+	assert.NoError(t, err)
+
+	req, err := http.NewRequest("GET", "/api/v1/projects", nil)
+	assert.NoError(t, err)
+	req.AddCookie(&http.Cookie{
+		Name:  idTokenCookieName,
+		Value: "tampered",
+	})
+
+	_, _, _, err = manager.RetrieveTokenValues(ctx, req)
+	assert.Error(t, err)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.CookieDecryptionFailures.WithLabelValues(idTokenCookieName)))
+}
+
 func TestGetLogoutAccessCookie(t *testing.T) {
 	cookie := getLogoutAccessCookie()
 	assert.True(t, time.Now().After(cookie.Expires))
@@ -93,7 +119,7 @@ func TestCookieManager_DeleteCookies(t *testing.T) {
 	hashKeyEncoded := "wG4pE1ccdw/pHZ2ml8wrD5VJkOtLPmBpWbKHmezWXktGaFbRoAhXidWs8OpbA3y7N8vyZhz1B1E37+tShWC7gA" //nolint:goconst
 	blockKeyEncoded := "afyABVgGOvWJFxVyOvCWCupoTn6BkNl4SOHmahho16Q"                                           //nolint:goconst
 
-	manager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded)
+	manager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded, testAuthenticationMetrics)
 	assert.NoError(t, err)
 
 	w := httptest.NewRecorder()
@@ -0,0 +1,262 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/errors"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+const (
+	ErrExternalAuthorizer errors.ErrorCode = "EXTERNAL_AUTHORIZER_ERROR"
+)
+
+// The getters, in order of preference, used to find the identifier carrying the target project and domain of a request.
+var targetIdentifierGetters = []string{"GetId", "GetWorkflowExecutionId", "GetExecutionId", "GetNodeExecutionId"}
+
+type projectDomainGetter interface {
+	GetProject() string
+	GetDomain() string
+}
+
+// ExternalAuthorizationIdentity describes the caller of a request in the input sent to the external authorizer.
+type ExternalAuthorizationIdentity struct {
+	Subject      string   `json:"subject,omitempty"`
+	AppID        string   `json:"appId,omitempty"`
+	Email        string   `json:"email,omitempty"`
+	Roles        []string `json:"roles,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+	Impersonator string   `json:"impersonator,omitempty"`
+}
+
+// ExternalAuthorizationRequest summarizes the request being authorized.
+type ExternalAuthorizationRequest struct {
+	Type string `json:"type,omitempty"`
+}
+
+// ExternalAuthorizationInput is the document sent to the external authorizer. It's wrapped in an "input" field so that
+// it can be posted directly to an OPA data API endpoint.
+type ExternalAuthorizationInput struct {
+	Identity ExternalAuthorizationIdentity `json:"identity"`
+	RPC      string                        `json:"rpc"`
+	Project  string                        `json:"project,omitempty"`
+	Domain   string                        `json:"domain,omitempty"`
+	Request  ExternalAuthorizationRequest  `json:"request"`
+}
+
+type externalAuthorizationDecision struct {
+	allowed   bool
+	expiresAt time.Time
+}
+
+// ExternalAuthorizer consults an external policy endpoint (e.g. an OPA sidecar) to decide whether a request is allowed.
+// Decisions are cached for identical inputs.
+type ExternalAuthorizer struct {
+	cfg    config.ExternalAuthorizerConfig
+	client *http.Client
+	cache  *lru.Cache
+	now    func() time.Time
+}
+
+// Authorize returns whether the external authorizer allows the request described by input.
+func (a ExternalAuthorizer) Authorize(ctx context.Context, input ExternalAuthorizationInput) (bool, error) {
+	body, err := json.Marshal(map[string]interface{}{"input": input})
+	if err != nil {
+		return false, errors.Wrapf(ErrExternalAuthorizer, err, "failed to marshal authorization input")
+	}
+
+	cacheKey := string(body)
+	if cached, found := a.cache.Get(cacheKey); found {
+		decision := cached.(externalAuthorizationDecision)
+		if a.now().Before(decision.expiresAt) {
+			return decision.allowed, nil
+		}
+
+		a.cache.Remove(cacheKey)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, a.cfg.URL.String(), bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrapf(ErrExternalAuthorizer, err, "failed to create authorization request")
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	response, err := a.client.Do(request)
+	if err != nil {
+		return false, errors.Wrapf(ErrExternalAuthorizer, err, "failed to reach external authorizer")
+	}
+
+	defer response.Body.Close()
+	raw, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return false, errors.Wrapf(ErrExternalAuthorizer, err, "failed to read external authorizer response")
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return false, errors.Errorf(ErrExternalAuthorizer, "external authorizer responded with status [%v]: %s",
+			response.StatusCode, raw)
+	}
+
+	allowed, err := parseExternalAuthorizationDecision(raw)
+	if err != nil {
+		return false, err
+	}
+
+	a.cache.Add(cacheKey, externalAuthorizationDecision{
+		allowed:   allowed,
+		expiresAt: a.now().Add(a.cfg.CacheTTL.Duration),
+	})
+
+	return allowed, nil
+}
+
+// parseExternalAuthorizationDecision accepts OPA responses whose result is either a boolean ({"result": true}) or an
+// object with an allow field ({"result": {"allow": true}}), as well as plain {"allow": true} documents returned by
+// generic policy endpoints. Missing decisions are treated as denials.
+func parseExternalAuthorizationDecision(raw []byte) (bool, error) {
+	type allowDocument struct {
+		Allow bool `json:"allow"`
+	}
+
+	document := struct {
+		allowDocument
+		Result json.RawMessage `json:"result"`
+	}{}
+
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return false, errors.Wrapf(ErrExternalAuthorizer, err, "failed to unmarshal external authorizer response")
+	}
+
+	if len(document.Result) == 0 {
+		return document.Allow, nil
+	}
+
+	var allowed bool
+	if err := json.Unmarshal(document.Result, &allowed); err == nil {
+		return allowed, nil
+	}
+
+	result := allowDocument{}
+	if err := json.Unmarshal(document.Result, &result); err != nil {
+		return false, errors.Wrapf(ErrExternalAuthorizer, err, "unexpected external authorizer result [%s]",
+			document.Result)
+	}
+
+	return result.Allow, nil
+}
+
+// TargetProjectDomain finds the project and domain a request targets, either set directly on the request or on one of
+// its identifiers.
+func TargetProjectDomain(req interface{}) (project, domain string) {
+	return findProjectDomain(reflect.ValueOf(req), 3)
+}
+
+func findProjectDomain(value reflect.Value, depth int) (project, domain string) {
+	if depth == 0 || !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil()) {
+		return "", ""
+	}
+
+	if getter, ok := value.Interface().(projectDomainGetter); ok {
+		return getter.GetProject(), getter.GetDomain()
+	}
+
+	for _, getterName := range targetIdentifierGetters {
+		method := value.MethodByName(getterName)
+		if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+			continue
+		}
+
+		if project, domain = findProjectDomain(method.Call(nil)[0], depth-1); len(project) > 0 {
+			return project, domain
+		}
+	}
+
+	return "", ""
+}
+
+// NewExternalAuthorizationInput describes the request and its caller for the external authorizer.
+func NewExternalAuthorizationInput(ctx context.Context, fullMethod string, req interface{}) ExternalAuthorizationInput {
+	identityContext := IdentityContextFromContext(ctx)
+	input := ExternalAuthorizationInput{
+		Identity: ExternalAuthorizationIdentity{
+			Subject: identityContext.UserID(),
+			AppID:   identityContext.AppID(),
+			Email:   identityContext.UserInfo().GetEmail(),
+			Roles:   RolesFromContext(ctx).List(),
+			Scopes:  identityContext.Scopes().List(),
+		},
+		RPC: fullMethod,
+	}
+
+	if impersonator, found := ImpersonatorFromContext(ctx); found {
+		input.Identity.Impersonator = impersonator.UserID()
+	}
+
+	input.Project, input.Domain = TargetProjectDomain(req)
+	if message, ok := req.(proto.Message); ok {
+		input.Request.Type = proto.MessageName(message)
+	} else if req != nil {
+		input.Request.Type = fmt.Sprintf("%T", req)
+	}
+
+	return input
+}
+
+// GetExternalAuthorizationInterceptor returns a unary interceptor that denies requests the external authorizer
+// doesn't allow. If the external authorizer can't be reached, requests are denied unless FailOpen is set.
+func GetExternalAuthorizationInterceptor(authorizer ExternalAuthorizer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		resp interface{}, err error) {
+
+		input := NewExternalAuthorizationInput(ctx, info.FullMethod, req)
+		allowed, err := authorizer.Authorize(ctx, input)
+		if err != nil {
+			if authorizer.cfg.FailOpen {
+				logger.Warnf(ctx, "Allowing [%v] as the external authorizer failed: %v", info.FullMethod, err)
+				return handler(ctx, req)
+			}
+
+			logger.Errorf(ctx, "Failed to consult external authorizer for [%v]: %v", info.FullMethod, err)
+			return nil, status.Errorf(codes.Unavailable, "failed to authorize request")
+		}
+
+		if !allowed {
+			logger.Infof(ctx, "External authorizer denied [%v] for [%v]", info.FullMethod, input.Identity.Subject)
+			return nil, status.Errorf(codes.PermissionDenied, "request denied by external authorizer")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func NewExternalAuthorizer(cfg config.ExternalAuthorizerConfig) (ExternalAuthorizer, error) {
+	if len(cfg.URL.String()) == 0 {
+		return ExternalAuthorizer{}, errors.Errorf(ErrExternalAuthorizer, "external authorizer url must be set")
+	}
+
+	cache, err := lru.New(cfg.CacheSize)
+	if err != nil {
+		return ExternalAuthorizer{}, errors.Wrapf(ErrExternalAuthorizer, err, "failed to create decision cache")
+	}
+
+	return ExternalAuthorizer{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout.Duration},
+		cache:  cache,
+		now:    time.Now,
+	}, nil
+}
@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
+	stdlibConfig "github.com/flyteorg/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+func newTestExternalAuthorizer(t *testing.T, handler http.HandlerFunc) (ExternalAuthorizer, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	authorizer, err := NewExternalAuthorizer(config.ExternalAuthorizerConfig{
+		Enabled:   true,
+		URL:       stdlibConfig.URL{URL: *serverURL},
+		Timeout:   stdlibConfig.Duration{Duration: time.Second},
+		CacheTTL:  stdlibConfig.Duration{Duration: time.Minute},
+		CacheSize: 10,
+	})
+	assert.NoError(t, err)
+	return authorizer, server
+}
+
+func TestParseExternalAuthorizationDecision(t *testing.T) {
+	for _, tc := range []struct {
+		body    string
+		allowed bool
+	}{
+		{`{"result": true}`, true},
+		{`{"result": false}`, false},
+		{`{"result": {"allow": true}}`, true},
+		{`{"allow": true}`, true},
+		{`{}`, false},
+	} {
+		allowed, err := parseExternalAuthorizationDecision([]byte(tc.body))
+		assert.NoError(t, err, tc.body)
+		assert.Equal(t, tc.allowed, allowed, tc.body)
+	}
+
+	_, err := parseExternalAuthorizationDecision([]byte(`{"result": "yes"}`))
+	assert.Error(t, err)
+}
+
+func TestNewExternalAuthorizationInput(t *testing.T) {
+	identity := NewIdentityContext("aud", "user-id", "flytectl", time.Now(), sets.NewString(ScopeAll),
+		&service.UserInfoResponse{Email: "alice@example.com"})
+	ctx := WithRoles(identity.WithContext(context.Background()), sets.NewString("viewer"))
+
+	input := NewExternalAuthorizationInput(ctx, "/flyteidl.service.AdminService/GetExecution",
+		&admin.WorkflowExecutionGetRequest{Id: &core.WorkflowExecutionIdentifier{Project: "p", Domain: "d", Name: "n"}})
+	assert.Equal(t, ExternalAuthorizationInput{
+		Identity: ExternalAuthorizationIdentity{
+			Subject: "user-id",
+			AppID:   "flytectl",
+			Email:   "alice@example.com",
+			Roles:   []string{"viewer"},
+			Scopes:  []string{ScopeAll},
+		},
+		RPC:     "/flyteidl.service.AdminService/GetExecution",
+		Project: "p",
+		Domain:  "d",
+		Request: ExternalAuthorizationRequest{Type: "flyteidl.admin.WorkflowExecutionGetRequest"},
+	}, input)
+
+	input = NewExternalAuthorizationInput(ctx, "", &admin.NodeExecutionListRequest{
+		WorkflowExecutionId: &core.WorkflowExecutionIdentifier{Project: "p2", Domain: "d2"}})
+	assert.Equal(t, "p2", input.Project)
+	assert.Equal(t, "d2", input.Domain)
+
+	input = NewExternalAuthorizationInput(ctx, "", &admin.ExecutionCreateRequest{Project: "p3", Domain: "d3"})
+	assert.Equal(t, "p3", input.Project)
+	assert.Equal(t, "d3", input.Domain)
+}
+
+func TestExternalAuthorizer_Authorize(t *testing.T) {
+	calls := 0
+	authorizer, _ := newTestExternalAuthorizer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body := struct {
+			Input ExternalAuthorizationInput `json:"input"`
+		}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_, err := w.Write([]byte(`{"result": ` + map[bool]string{true: "true", false: "false"}[body.Input.Project == "allowed"] + `}`))
+		assert.NoError(t, err)
+	})
+
+	ctx := context.Background()
+	allowed, err := authorizer.Authorize(ctx, ExternalAuthorizationInput{Project: "allowed"})
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = authorizer.Authorize(ctx, ExternalAuthorizationInput{Project: "denied"})
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	t.Run("cached", func(t *testing.T) {
+		allowed, err = authorizer.Authorize(ctx, ExternalAuthorizationInput{Project: "allowed"})
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		authorizer.now = func() time.Time {
+			return time.Now().Add(2 * time.Minute)
+		}
+
+		allowed, err = authorizer.Authorize(ctx, ExternalAuthorizationInput{Project: "allowed"})
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, 3, calls)
+	})
+}
+
+func TestGetExternalAuthorizationInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/flyteidl.service.AdminService/CreateExecution"}
+
+	t.Run("denied", func(t *testing.T) {
+		authorizer, _ := newTestExternalAuthorizer(t, func(w http.ResponseWriter, r *http.Request) {
+			_, err := w.Write([]byte(`{"result": {"allow": false}}`))
+			assert.NoError(t, err)
+		})
+
+		_, err := GetExternalAuthorizationInterceptor(authorizer)(context.Background(), &admin.ExecutionCreateRequest{}, info, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		authorizer, _ := newTestExternalAuthorizer(t, func(w http.ResponseWriter, r *http.Request) {
+			_, err := w.Write([]byte(`{"result": {"allow": true}}`))
+			assert.NoError(t, err)
+		})
+
+		resp, err := GetExternalAuthorizationInterceptor(authorizer)(context.Background(), &admin.ExecutionCreateRequest{}, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("unavailable", func(t *testing.T) {
+		authorizer, server := newTestExternalAuthorizer(t, func(w http.ResponseWriter, r *http.Request) {})
+		server.Close()
+
+		_, err := GetExternalAuthorizationInterceptor(authorizer)(context.Background(), &admin.ExecutionCreateRequest{}, info, handler)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+
+		authorizer.cfg.FailOpen = true
+		resp, err := GetExternalAuthorizationInterceptor(authorizer)(context.Background(), &admin.ExecutionCreateRequest{}, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
@@ -135,10 +135,13 @@ func GetCallbackHandler(ctx context.Context, authCtx interfaces.AuthenticationCo
 	return func(writer http.ResponseWriter, request *http.Request) {
 		logger.Debugf(ctx, "Running callback handler... for RequestURI %v", request.RequestURI)
 		authorizationCode := request.FormValue(AuthorizationResponseCodeType)
+		idp := authCtx.Options().UserAuth.OpenID.BaseURL.Host
+		failedLogins := authCtx.Metrics().Logins.WithLabelValues(idp, LoginOutcomeFailure)
 
 		err := VerifyCsrfCookie(ctx, request)
 		if err != nil {
 			logger.Errorf(ctx, "Invalid CSRF token cookie %s", err)
+			failedLogins.Inc()
 			writer.WriteHeader(http.StatusUnauthorized)
 			return
 		}
@@ -146,6 +149,7 @@ func GetCallbackHandler(ctx context.Context, authCtx interfaces.AuthenticationCo
 		token, err := authCtx.OAuth2ClientConfig(GetPublicURL(ctx, request, authCtx.Options())).Exchange(ctx, authorizationCode)
 		if err != nil {
 			logger.Errorf(ctx, "Error when exchanging code %s", err)
+			failedLogins.Inc()
 			writer.WriteHeader(http.StatusForbidden)
 			return
 		}
@@ -153,6 +157,7 @@ func GetCallbackHandler(ctx context.Context, authCtx interfaces.AuthenticationCo
 		err = authCtx.CookieManager().SetTokenCookies(ctx, writer, token)
 		if err != nil {
 			logger.Errorf(ctx, "Error setting encrypted JWT cookie %s", err)
+			failedLogins.Inc()
 			writer.WriteHeader(http.StatusForbidden)
 			return
 		}
@@ -160,6 +165,7 @@ func GetCallbackHandler(ctx context.Context, authCtx interfaces.AuthenticationCo
 		userInfo, err := QueryUserInfoUsingAccessToken(ctx, request, authCtx, token.AccessToken)
 		if err != nil {
 			logger.Errorf(ctx, "Failed to query user info. Error: %v", err)
+			failedLogins.Inc()
 			writer.WriteHeader(http.StatusForbidden)
 			return
 		}
@@ -167,10 +173,12 @@ func GetCallbackHandler(ctx context.Context, authCtx interfaces.AuthenticationCo
 		err = authCtx.CookieManager().SetUserInfoCookie(ctx, writer, userInfo)
 		if err != nil {
 			logger.Errorf(ctx, "Error setting encrypted user info cookie. Error: %v", err)
+			failedLogins.Inc()
 			writer.WriteHeader(http.StatusForbidden)
 			return
 		}
 
+		authCtx.Metrics().Logins.WithLabelValues(idp, LoginOutcomeSuccess).Inc()
 		redirectURL := getAuthFlowEndRedirect(ctx, authCtx, request)
 		http.Redirect(writer, request, redirectURL, http.StatusTemporaryRedirect)
 	}
@@ -229,20 +237,37 @@ func GetAuthenticationInterceptor(authCtx interfaces.AuthenticationContext) func
 		fromHTTP := metautils.ExtractIncoming(ctx).Get(FromHTTPKey)
 		isFromHTTP := fromHTTP == FromHTTPVal
 
-		identityContext, err := GRPCGetIdentityFromAccessToken(ctx, authCtx)
-		if err == nil {
-			return SetContextForIdentity(ctx, identityContext), nil
+		identityContext, accessTokenErr := GRPCGetIdentityFromAccessToken(ctx, authCtx)
+		if accessTokenErr == nil {
+			return setContextForAuthorizedIdentity(ctx, identityContext, authCtx.Options().Authorization)
 		}
 
-		logger.Infof(ctx, "Failed to parse Access Token from context. Will attempt to find IDToken. Error: %v", err)
+		logger.Infof(ctx, "Failed to parse Access Token from context. Will attempt to find IDToken. Error: %v", accessTokenErr)
+
+		if authCtx.WorkloadIdentityResourceServer() != nil {
+			workloadIdentityContext, workloadErr := GRPCGetIdentityFromWorkloadIdentityToken(ctx, authCtx)
+			if workloadErr == nil {
+				return setContextForAuthorizedIdentity(ctx, workloadIdentityContext, authCtx.Options().Authorization)
+			}
+
+			logger.Debugf(ctx, "Failed to validate workload identity token. Error: %v", workloadErr)
+		}
 
-		identityContext, err = GRPCGetIdentityFromIDToken(ctx, authCtx.Options().UserAuth.OpenID.ClientID,
+		identityContext, err := GRPCGetIdentityFromIDToken(ctx, authCtx.Options().UserAuth.OpenID.ClientID,
 			authCtx.OidcProvider())
 
 		if err == nil {
-			return SetContextForIdentity(ctx, identityContext), nil
+			return setContextForAuthorizedIdentity(ctx, identityContext, authCtx.Options().Authorization)
 		}
 
+		// Report the reason the access token was rejected, unless none was presented in which case the id token's
+		// failure is the more relevant one.
+		reason := GetValidationFailureReason(accessTokenErr)
+		if reason == ValidationFailureMissingToken {
+			reason = GetValidationFailureReason(err)
+		}
+		authCtx.Metrics().TokenValidationFailures.WithLabelValues(reason).Inc()
+
 		// Only enforcement logic is present. The default case is to let things through.
 		if (isFromHTTP && !authCtx.Options().DisableForHTTP) ||
 			(!isFromHTTP && !authCtx.Options().DisableForGrpc) {
@@ -263,11 +288,16 @@ func WithAuditFields(ctx context.Context, subject string, clientIds []string, to
 	if ok {
 		clientIP = peerInfo.Addr.String()
 	}
+	var impersonator string
+	if impersonatorIdentity, found := ImpersonatorFromContext(ctx); found {
+		impersonator = impersonatorIdentity.UserID()
+	}
 	return context.WithValue(ctx, common.AuditFieldsContextKey, audit.AuthenticatedClientMeta{
 		ClientIds:     clientIds,
 		TokenIssuedAt: tokenIssuedAt,
 		ClientIP:      clientIP,
 		Subject:       subject,
+		Impersonator:  impersonator,
 	})
 }
 
@@ -324,9 +354,16 @@ func GetHTTPRequestCookieToMetadataHandler(authCtx interfaces.AuthenticationCont
 // See the enforceHTTP/Grpc options for more information.
 func GetHTTPMetadataTaggingHandler() HTTPRequestToMetadataAnnotator {
 	return func(ctx context.Context, request *http.Request) metadata.MD {
-		return metadata.MD{
+		md := metadata.MD{
 			FromHTTPKey: []string{FromHTTPVal},
 		}
+
+		// Forward the impersonation header since the gateway only passes through a fixed set of headers.
+		if actAs := request.Header.Get(ImpersonationHeader); len(actAs) > 0 {
+			md.Set(ImpersonationHeader, actAs)
+		}
+
+		return md
 	}
 }
 
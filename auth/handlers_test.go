@@ -33,6 +33,7 @@ func TestWithUserEmail(t *testing.T) {
 func setupMockedAuthContextAtEndpoint(endpoint string) *mocks.AuthenticationContext {
 	mockAuthCtx := &mocks.AuthenticationContext{}
 	mockAuthCtx.OnOptions().Return(&config.Config{})
+	mockAuthCtx.OnMetrics().Return(testAuthenticationMetrics)
 	mockCookieHandler := new(mocks.CookieHandler)
 	dummyOAuth2Config := oauth2.Config{
 		ClientID: "abc",
@@ -212,7 +213,7 @@ func TestGetHTTPRequestCookieToMetadataHandler(t *testing.T) {
 	// These were generated for unit testing only.
 	hashKeyEncoded := "wG4pE1ccdw/pHZ2ml8wrD5VJkOtLPmBpWbKHmezWXktGaFbRoAhXidWs8OpbA3y7N8vyZhz1B1E37+tShWC7gA" //nolint:goconst
 	blockKeyEncoded := "afyABVgGOvWJFxVyOvCWCupoTn6BkNl4SOHmahho16Q"                                           //nolint:goconst
-	cookieManager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded)
+	cookieManager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded, testAuthenticationMetrics)
 	assert.NoError(t, err)
 	mockAuthCtx := mocks.AuthenticationContext{}
 	mockAuthCtx.OnCookieManager().Return(&cookieManager)
@@ -246,7 +247,7 @@ func TestGetHTTPRequestCookieToMetadataHandler_CustomHeader(t *testing.T) {
 	// These were generated for unit testing only.
 	hashKeyEncoded := "wG4pE1ccdw/pHZ2ml8wrD5VJkOtLPmBpWbKHmezWXktGaFbRoAhXidWs8OpbA3y7N8vyZhz1B1E37+tShWC7gA" //nolint:goconst
 	blockKeyEncoded := "afyABVgGOvWJFxVyOvCWCupoTn6BkNl4SOHmahho16Q"                                           //nolint:goconst
-	cookieManager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded)
+	cookieManager, err := NewCookieManager(ctx, hashKeyEncoded, blockKeyEncoded, testAuthenticationMetrics)
 	assert.NoError(t, err)
 	mockAuthCtx := mocks.AuthenticationContext{}
 	mockAuthCtx.On("CookieManager").Return(&cookieManager)
@@ -25,6 +25,7 @@ type IdentityContext struct {
 	userInfo        *service.UserInfoResponse
 	// Set to pointer just to keep this struct go-simple to support equal operator
 	scopes *sets.String
+	claims *map[string]interface{}
 }
 
 func (c IdentityContext) Audience() string {
@@ -59,6 +60,20 @@ func (c IdentityContext) Scopes() sets.String {
 	return sets.NewString()
 }
 
+func (c IdentityContext) Claims() map[string]interface{} {
+	if c.claims != nil {
+		return *c.claims
+	}
+
+	return map[string]interface{}{}
+}
+
+// WithClaims returns a copy of the identity which carries the raw claims of the token it was authenticated with.
+func (c IdentityContext) WithClaims(claims map[string]interface{}) IdentityContext {
+	c.claims = &claims
+	return c
+}
+
 func (c IdentityContext) WithContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, ContextKeyIdentityContext, c)
 }
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
+)
+
+func WithImpersonator(ctx context.Context, impersonator interfaces.IdentityContext) context.Context {
+	return context.WithValue(ctx, ContextKeyImpersonator, impersonator)
+}
+
+// ImpersonatorFromContext returns the identity of the caller acting on behalf of the identity set on the context, if
+// the request is impersonated.
+func ImpersonatorFromContext(ctx context.Context) (impersonator interfaces.IdentityContext, found bool) {
+	impersonator, found = ctx.Value(ContextKeyImpersonator).(interfaces.IdentityContext)
+	return impersonator, found
+}
+
+// impersonate returns the identity of the user named in the impersonation header, if set. Only callers holding the
+// configured impersonation role and the "all" scope are allowed to act on behalf of other users. The returned identity
+// retains the impersonator's app but holds the scopes users signing in are granted rather than the impersonator's.
+func impersonate(ctx context.Context, identityContext interfaces.IdentityContext, roles sets.String,
+	cfg config.AuthorizationConfig) (impersonated interfaces.IdentityContext, isImpersonated bool, err error) {
+
+	target := metautils.ExtractIncoming(ctx).Get(ImpersonationHeader)
+	if len(target) == 0 {
+		return identityContext, false, nil
+	}
+
+	if len(cfg.ImpersonationRole) == 0 || !roles.Has(cfg.ImpersonationRole) {
+		return nil, false, status.Errorf(codes.PermissionDenied, "[%v] is not allowed to impersonate other users",
+			identityContext.UserID())
+	}
+
+	// Otherwise a token restricted to some scopes could be used to act with all of them.
+	if !identityContext.Scopes().Has(ScopeAll) {
+		return nil, false, status.Errorf(codes.PermissionDenied,
+			"[%v] must hold the [%v] scope to impersonate other users", identityContext.UserID(), ScopeAll)
+	}
+
+	var audience string
+	if concrete, ok := identityContext.(IdentityContext); ok {
+		audience = concrete.Audience()
+	}
+
+	logger.Infof(ctx, "[%v] is acting on behalf of [%v]", identityContext.UserID(), target)
+	return NewIdentityContext(audience, target, identityContext.AppID(), identityContext.AuthenticatedAt(),
+		sets.NewString(ScopeAll), &service.UserInfoResponse{Subject: target}), true, nil
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/pkg/audit"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+)
+
+func TestSetContextForAuthorizedIdentity_Impersonation(t *testing.T) {
+	cfg := config.AuthorizationConfig{
+		EnforceProjectVisibility: true,
+		ImpersonationRole:        "support",
+		RoleBindings: map[string][]string{
+			"operator": {"support"},
+			"user":     {"flytesnacks-viewer"},
+		},
+		Roles: map[string]config.Role{
+			"support":            {Projects: []string{config.ProjectWildcard}},
+			"flytesnacks-viewer": {Projects: []string{"flytesnacks"}},
+		},
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ImpersonationHeader, "user"))
+
+	t.Run("allowed", func(t *testing.T) {
+		operator := NewIdentityContext("aud", "operator", "flytectl", time.Now(), sets.NewString(ScopeAll), nil)
+		newCtx, err := setContextForAuthorizedIdentity(ctx, operator, cfg)
+		assert.NoError(t, err)
+		assert.Equal(t, "user", IdentityContextFromContext(newCtx).UserID())
+		assert.Equal(t, "flytectl", IdentityContextFromContext(newCtx).AppID())
+		assert.Equal(t, sets.NewString(ScopeAll), IdentityContextFromContext(newCtx).Scopes())
+
+		impersonator, found := ImpersonatorFromContext(newCtx)
+		assert.True(t, found)
+		assert.Equal(t, "operator", impersonator.UserID())
+
+		// The impersonated user's visibility applies, not the operator's.
+		projects, restricted := VisibleProjectsFromContext(newCtx)
+		assert.True(t, restricted)
+		assert.True(t, projects.Has("flytesnacks"))
+
+		auditMeta := newCtx.Value(common.AuditFieldsContextKey).(audit.AuthenticatedClientMeta)
+		assert.Equal(t, "user", auditMeta.Subject)
+		assert.Equal(t, "operator", auditMeta.Impersonator)
+	})
+
+	t.Run("not allowed", func(t *testing.T) {
+		user := NewIdentityContext("aud", "user", "flytectl", time.Now(), nil, nil)
+		_, err := setContextForAuthorizedIdentity(ctx, user, cfg)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("restricted scopes", func(t *testing.T) {
+		operator := NewIdentityContext("aud", "operator", "flytectl", time.Now(), sets.NewString(ScopeEventsWrite), nil)
+		_, err := setContextForAuthorizedIdentity(ctx, operator, cfg)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("no header", func(t *testing.T) {
+		operator := NewIdentityContext("aud", "operator", "flytectl", time.Now(), sets.NewString(ScopeAll), nil)
+		newCtx, err := setContextForAuthorizedIdentity(context.Background(), operator, cfg)
+		assert.NoError(t, err)
+		assert.Equal(t, "operator", IdentityContextFromContext(newCtx).UserID())
+		_, found := ImpersonatorFromContext(newCtx)
+		assert.False(t, found)
+	})
+}
@@ -53,6 +53,10 @@ type AuthenticationContext interface {
 	GetHTTPClient() *http.Client
 	AuthMetadataService() service.AuthMetadataServiceServer
 	IdentityService() service.IdentityServiceServer
+	Metrics() AuthenticationMetrics
+	// WorkloadIdentityResourceServer returns the resource server used to validate workload identity tokens presented by
+	// dataplane components. It returns nil if workload identity is not enabled.
+	WorkloadIdentityResourceServer() OAuth2ResourceServer
 }
 
 // IdentityContext represents the authenticated identity and can be used to abstract the way the user/app authenticated
@@ -63,6 +67,8 @@ type IdentityContext interface {
 	UserInfo() *service.UserInfoResponse
 	AuthenticatedAt() time.Time
 	Scopes() sets.String
+	// Claims returns the raw claims of the token the identity was authenticated with, if any.
+	Claims() map[string]interface{}
 
 	IsEmpty() bool
 	WithContext(ctx context.Context) context.Context
@@ -0,0 +1,22 @@
+package interfaces
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// AuthenticationMetrics holds the counters used to monitor authentication outcomes and alert on anomalous patterns
+// such as credential stuffing.
+type AuthenticationMetrics struct {
+	// Logins counts completed user login flows labeled by idp and outcome.
+	Logins *prometheus.CounterVec
+
+	// TokensIssued counts tokens issued by the authorization server labeled by grant_type.
+	TokensIssued *prometheus.CounterVec
+
+	// TokenValidationFailures counts requests whose credentials failed validation labeled by reason.
+	TokenValidationFailures *prometheus.CounterVec
+
+	// RefreshTokenReuse counts attempts to redeem a refresh token that has already been used.
+	RefreshTokenReuse prometheus.Counter
+
+	// CookieDecryptionFailures counts secure cookies that could not be decoded labeled by cookie name.
+	CookieDecryptionFailures *prometheus.CounterVec
+}
@@ -229,6 +229,38 @@ func (_m *AuthenticationContext) IdentityService() service.IdentityServiceServer
 	return r0
 }
 
+type AuthenticationContext_Metrics struct {
+	*mock.Call
+}
+
+func (_m AuthenticationContext_Metrics) Return(_a0 interfaces.AuthenticationMetrics) *AuthenticationContext_Metrics {
+	return &AuthenticationContext_Metrics{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *AuthenticationContext) OnMetrics() *AuthenticationContext_Metrics {
+	c := _m.On("Metrics")
+	return &AuthenticationContext_Metrics{Call: c}
+}
+
+func (_m *AuthenticationContext) OnMetricsMatch(matchers ...interface{}) *AuthenticationContext_Metrics {
+	c := _m.On("Metrics", matchers...)
+	return &AuthenticationContext_Metrics{Call: c}
+}
+
+// Metrics provides a mock function with given fields:
+func (_m *AuthenticationContext) Metrics() interfaces.AuthenticationMetrics {
+	ret := _m.Called()
+
+	var r0 interfaces.AuthenticationMetrics
+	if rf, ok := ret.Get(0).(func() interfaces.AuthenticationMetrics); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(interfaces.AuthenticationMetrics)
+	}
+
+	return r0
+}
+
 type AuthenticationContext_OAuth2ClientConfig struct {
 	*mock.Call
 }
@@ -398,3 +430,37 @@ func (_m *AuthenticationContext) Options() *config.Config {
 
 	return r0
 }
+
+type AuthenticationContext_WorkloadIdentityResourceServer struct {
+	*mock.Call
+}
+
+func (_m AuthenticationContext_WorkloadIdentityResourceServer) Return(_a0 interfaces.OAuth2ResourceServer) *AuthenticationContext_WorkloadIdentityResourceServer {
+	return &AuthenticationContext_WorkloadIdentityResourceServer{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *AuthenticationContext) OnWorkloadIdentityResourceServer() *AuthenticationContext_WorkloadIdentityResourceServer {
+	c := _m.On("WorkloadIdentityResourceServer")
+	return &AuthenticationContext_WorkloadIdentityResourceServer{Call: c}
+}
+
+func (_m *AuthenticationContext) OnWorkloadIdentityResourceServerMatch(matchers ...interface{}) *AuthenticationContext_WorkloadIdentityResourceServer {
+	c := _m.On("WorkloadIdentityResourceServer", matchers...)
+	return &AuthenticationContext_WorkloadIdentityResourceServer{Call: c}
+}
+
+// WorkloadIdentityResourceServer provides a mock function with given fields:
+func (_m *AuthenticationContext) WorkloadIdentityResourceServer() interfaces.OAuth2ResourceServer {
+	ret := _m.Called()
+
+	var r0 interfaces.OAuth2ResourceServer
+	if rf, ok := ret.Get(0).(func() interfaces.OAuth2ResourceServer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.OAuth2ResourceServer)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"errors"
+
+	jwtgo "github.com/golang-jwt/jwt/v4"
+
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
+	flyteErrors "github.com/flyteorg/flytestdlib/errors"
+	"github.com/flyteorg/flytestdlib/promutils"
+)
+
+const (
+	LoginOutcomeSuccess = "success"
+	LoginOutcomeFailure = "failure"
+
+	ValidationFailureMissingToken     = "missing_token"
+	ValidationFailureExpired          = "expired"
+	ValidationFailureInvalidSignature = "invalid_signature"
+	ValidationFailureInvalidAudience  = "invalid_audience"
+	ValidationFailureOther            = "other"
+)
+
+// Causes of token validation errors, which GetValidationFailureReason classifies.
+var (
+	ErrMissingToken     = errors.New("no token was presented")
+	ErrInvalidSignature = errors.New("invalid token signature")
+	ErrInvalidAudience  = errors.New("invalid audience")
+)
+
+func NewAuthenticationMetrics(scope promutils.Scope) interfaces.AuthenticationMetrics {
+	return interfaces.AuthenticationMetrics{
+		Logins: scope.MustNewCounterVec("logins",
+			"number of completed user logins per identity provider", "idp", "outcome"),
+		TokensIssued: scope.MustNewCounterVec("tokens_issued",
+			"number of tokens issued by the authorization server per grant type", "grant_type"),
+		TokenValidationFailures: scope.MustNewCounterVec("token_validation_failures",
+			"number of requests whose credentials failed validation per reason", "reason"),
+		RefreshTokenReuse: scope.MustNewCounter("refresh_token_reuse",
+			"number of attempts to redeem an already used refresh token"),
+		CookieDecryptionFailures: scope.MustNewCounterVec("cookie_decryption_failures",
+			"number of secure cookies that failed to decode per cookie name", "cookie"),
+	}
+}
+
+// GetValidationFailureReason classifies token validation errors into a small set of reasons suitable for use as a
+// metric label.
+func GetValidationFailureReason(err error) string {
+	if err == nil {
+		return ValidationFailureOther
+	}
+
+	if flyteErrors.IsCausedBy(err, ErrTokenExpired) {
+		return ValidationFailureExpired
+	}
+
+	var validationErr *jwtgo.ValidationError
+	if errors.As(err, &validationErr) {
+		switch {
+		case validationErr.Errors&jwtgo.ValidationErrorExpired != 0:
+			return ValidationFailureExpired
+		case validationErr.Errors&jwtgo.ValidationErrorSignatureInvalid != 0:
+			return ValidationFailureInvalidSignature
+		}
+	}
+
+	switch {
+	case errors.Is(err, ErrMissingToken):
+		return ValidationFailureMissingToken
+	case errors.Is(err, ErrInvalidSignature):
+		return ValidationFailureInvalidSignature
+	case errors.Is(err, ErrInvalidAudience):
+		return ValidationFailureInvalidAudience
+	}
+
+	return ValidationFailureOther
+}
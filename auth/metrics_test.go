@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+
+	jwtgo "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flytestdlib/errors"
+)
+
+func TestGetValidationFailureReason(t *testing.T) {
+	assert.Equal(t, ValidationFailureMissingToken, GetValidationFailureReason(
+		errors.Wrapf(ErrJwtValidation, ErrMissingToken, "%v token is blank", BearerScheme)))
+	assert.Equal(t, ValidationFailureExpired, GetValidationFailureReason(
+		errors.Wrapf(ErrTokenExpired, fmt.Errorf("boom"), "token is expired")))
+	assert.Equal(t, ValidationFailureExpired, GetValidationFailureReason(
+		jwtgo.NewValidationError("token is expired", jwtgo.ValidationErrorExpired)))
+	assert.Equal(t, ValidationFailureInvalidSignature, GetValidationFailureReason(
+		jwtgo.NewValidationError("crypto/rsa: verification error", jwtgo.ValidationErrorSignatureInvalid)))
+	assert.Equal(t, ValidationFailureInvalidSignature, GetValidationFailureReason(
+		fmt.Errorf("%w: failed to verify id token signature", ErrInvalidSignature)))
+	assert.Equal(t, ValidationFailureInvalidAudience, GetValidationFailureReason(
+		fmt.Errorf("%w [foo]", ErrInvalidAudience)))
+	// Errors are classified by their causes rather than their messages.
+	assert.Equal(t, ValidationFailureOther, GetValidationFailureReason(fmt.Errorf("invalid audience [foo]")))
+	assert.Equal(t, ValidationFailureOther, GetValidationFailureReason(fmt.Errorf("boom")))
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/logger"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// SecretsReloader is implemented by auth components that hold secrets which can be rotated while admin is running.
+type SecretsReloader interface {
+	// ReloadSecrets reads the secrets again and swaps in those which were rotated. The previous secrets are kept if
+	// reading or using the new ones fails.
+	ReloadSecrets(ctx context.Context) error
+}
+
+// RunSecretsReloaders reloads the secrets of each of reloaders every interval until ctx is done. It returns right away
+// if interval isn't positive.
+func RunSecretsReloaders(ctx context.Context, interval time.Duration, reloaders ...SecretsReloader) {
+	if interval <= 0 || len(reloaders) == 0 {
+		return
+	}
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		for _, reloader := range reloaders {
+			if err := reloader.ReloadSecrets(ctx); err != nil {
+				logger.Errorf(ctx, "Failed to reload auth secrets, keeping the previous ones. Error: %v", err)
+			}
+		}
+	}, interval)
+}
@@ -0,0 +1,63 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/flyteorg/flytestdlib/logger"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+// AWSSecretManager reads secrets from AWS Secrets Manager. Secret values are cached for the configured refresh interval
+// so rotated secrets are picked up without a restart.
+type AWSSecretManager struct {
+	client         secretsmanageriface.SecretsManagerAPI
+	secretIDPrefix string
+	cache          *secretCache
+}
+
+func (a AWSSecretManager) Get(ctx context.Context, key string) (string, error) {
+	return a.cache.getOrFetch(ctx, key, a.fetch)
+}
+
+func (a AWSSecretManager) fetch(ctx context.Context, key string) (string, error) {
+	secretID := a.secretIDPrefix + key
+	logger.Debugf(ctx, "Reading secret [%v] from AWS Secrets Manager", secretID)
+	output, err := a.client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret [%v] from AWS Secrets Manager. Error: %w", secretID, err)
+	}
+
+	if output.SecretString != nil {
+		return *output.SecretString, nil
+	}
+
+	return string(output.SecretBinary), nil
+}
+
+func newAWSSecretManager(client secretsmanageriface.SecretsManagerAPI, cfg config.AWSSecretsProviderConfig,
+	refreshInterval time.Duration) AWSSecretManager {
+
+	return AWSSecretManager{
+		client:         client,
+		secretIDPrefix: cfg.SecretIDPrefix,
+		cache:          newSecretCache(refreshInterval),
+	}
+}
+
+func NewAWSSecretManager(cfg config.AWSSecretsProviderConfig, refreshInterval time.Duration) (AWSSecretManager, error) {
+	awsSession, err := session.NewSession(aws.NewConfig().WithRegion(cfg.Region))
+	if err != nil {
+		return AWSSecretManager{}, fmt.Errorf("failed to create AWS session. Error: %w", err)
+	}
+
+	return newAWSSecretManager(secretsmanager.New(awsSession), cfg, refreshInterval), nil
+}
@@ -0,0 +1,73 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+type mockSecretsManagerClient struct {
+	secretsmanageriface.SecretsManagerAPI
+	secrets map[string]string
+	calls   int
+}
+
+func (m *mockSecretsManagerClient) GetSecretValueWithContext(_ aws.Context, input *secretsmanager.GetSecretValueInput,
+	_ ...request.Option) (*secretsmanager.GetSecretValueOutput, error) {
+
+	m.calls++
+	value, found := m.secrets[*input.SecretId]
+	if !found {
+		return nil, fmt.Errorf("secret [%v] not found", *input.SecretId)
+	}
+
+	return &secretsmanager.GetSecretValueOutput{
+		SecretString: aws.String(value),
+	}, nil
+}
+
+func TestAWSSecretManager_Get(t *testing.T) {
+	ctx := context.Background()
+	client := &mockSecretsManagerClient{
+		secrets: map[string]string{
+			"flyteadmin/" + config.SecretNameOIdCClientSecret: "secret",
+		},
+	}
+
+	sm := newAWSSecretManager(client, config.AWSSecretsProviderConfig{SecretIDPrefix: "flyteadmin/"}, time.Minute)
+	value, err := sm.Get(ctx, config.SecretNameOIdCClientSecret)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", value)
+
+	t.Run("cached", func(t *testing.T) {
+		client.secrets["flyteadmin/"+config.SecretNameOIdCClientSecret] = "rotated"
+		value, err := sm.Get(ctx, config.SecretNameOIdCClientSecret)
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", value)
+		assert.Equal(t, 1, client.calls)
+	})
+
+	t.Run("refreshed", func(t *testing.T) {
+		sm.cache.now = func() time.Time {
+			return time.Now().Add(2 * time.Minute)
+		}
+
+		value, err := sm.Get(ctx, config.SecretNameOIdCClientSecret)
+		assert.NoError(t, err)
+		assert.Equal(t, "rotated", value)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := sm.Get(ctx, config.SecretNameCookieHashKey)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,68 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/logger"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+type mountedSecret struct {
+	value   string
+	modTime time.Time
+}
+
+// KubernetesSecretManager reads secrets from a mounted kubernetes secret. Kubernetes atomically swaps the mounted files
+// when the secret is updated, so each read checks whether the file changed and reloads it if it did.
+type KubernetesSecretManager struct {
+	mountPath string
+	lock      *sync.Mutex
+	secrets   map[string]mountedSecret
+}
+
+func (k KubernetesSecretManager) Get(ctx context.Context, key string) (string, error) {
+	secretFile := filepath.Join(k.mountPath, key)
+	info, err := os.Stat(secretFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("secret not found - file [%s]", secretFile)
+		}
+
+		return "", err
+	}
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if cached, found := k.secrets[key]; found && cached.modTime.Equal(info.ModTime()) {
+		return cached.value, nil
+	}
+
+	logger.Infof(ctx, "Reloading secret [%v] from [%v]", key, secretFile)
+	raw, err := ioutil.ReadFile(secretFile)
+	if err != nil {
+		return "", err
+	}
+
+	k.secrets[key] = mountedSecret{
+		value:   string(raw),
+		modTime: info.ModTime(),
+	}
+
+	return string(raw), nil
+}
+
+func NewKubernetesSecretManager(cfg config.KubernetesSecretsProviderConfig) KubernetesSecretManager {
+	return KubernetesSecretManager{
+		mountPath: cfg.MountPath,
+		lock:      &sync.Mutex{},
+		secrets:   map[string]mountedSecret{},
+	}
+}
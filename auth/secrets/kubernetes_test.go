@@ -0,0 +1,44 @@
+package secrets
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+func TestKubernetesSecretManager_Get(t *testing.T) {
+	ctx := context.Background()
+	mountPath, err := ioutil.TempDir("", "secrets")
+	assert.NoError(t, err)
+	defer os.RemoveAll(mountPath)
+
+	secretFile := filepath.Join(mountPath, config.SecretNameCookieHashKey)
+	assert.NoError(t, ioutil.WriteFile(secretFile, []byte("old"), 0600))
+
+	sm := NewKubernetesSecretManager(config.KubernetesSecretsProviderConfig{MountPath: mountPath})
+	value, err := sm.Get(ctx, config.SecretNameCookieHashKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "old", value)
+
+	t.Run("rotated", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(secretFile, []byte("new"), 0600))
+		rotatedAt := time.Now().Add(time.Minute)
+		assert.NoError(t, os.Chtimes(secretFile, rotatedAt, rotatedAt))
+
+		value, err := sm.Get(ctx, config.SecretNameCookieHashKey)
+		assert.NoError(t, err)
+		assert.Equal(t, "new", value)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := sm.Get(ctx, config.SecretNameCookieBlockKey)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,78 @@
+// Package secrets provides the secret managers used to read the secrets referenced by name in the auth config, such as
+// OAuth2 client secrets and cookie keys, from the configured backend.
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flytepropeller/pkg/controller/nodes/task/secretmanager"
+	"github.com/flyteorg/flytestdlib/logger"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+type cachedSecret struct {
+	value     string
+	fetchedAt time.Time
+}
+
+// secretCache memoizes secrets read from a remote backend for the configured refresh interval so that rotated values
+// are picked up without issuing a request on every read.
+type secretCache struct {
+	refreshInterval time.Duration
+	lock            sync.Mutex
+	secrets         map[string]cachedSecret
+	now             func() time.Time
+}
+
+func (c *secretCache) getOrFetch(ctx context.Context, key string, fetch func(ctx context.Context, key string) (string, error)) (
+	string, error) {
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if cached, found := c.secrets[key]; found && c.now().Sub(cached.fetchedAt) < c.refreshInterval {
+		return cached.value, nil
+	}
+
+	value, err := fetch(ctx, key)
+	if err != nil {
+		return "", err
+	}
+
+	c.secrets[key] = cachedSecret{
+		value:     value,
+		fetchedAt: c.now(),
+	}
+
+	return value, nil
+}
+
+func newSecretCache(refreshInterval time.Duration) *secretCache {
+	return &secretCache{
+		refreshInterval: refreshInterval,
+		secrets:         map[string]cachedSecret{},
+		now:             time.Now,
+	}
+}
+
+// NewSecretManager constructs the secret manager for the configured secrets provider.
+func NewSecretManager(ctx context.Context, cfg config.SecretsProviderConfig) (core.SecretManager, error) {
+	logger.Infof(ctx, "Using [%v] secrets provider", cfg.Type)
+	switch cfg.Type {
+	case config.SecretsProviderTypeFile, "":
+		return secretmanager.NewFileEnvSecretManager(secretmanager.GetConfig()), nil
+	case config.SecretsProviderTypeKubernetes:
+		return NewKubernetesSecretManager(cfg.Kubernetes), nil
+	case config.SecretsProviderTypeAWS:
+		return NewAWSSecretManager(cfg.AWS, cfg.RefreshInterval.Duration)
+	case config.SecretsProviderTypeVault:
+		return NewVaultSecretManager(cfg.Vault, cfg.RefreshInterval.Duration)
+	}
+
+	return nil, fmt.Errorf("unsupported secrets provider type [%v]", cfg.Type)
+}
@@ -0,0 +1,116 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/logger"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+const (
+	vaultTokenHeader = "X-Vault-Token"
+	vaultTokenEnvVar = "VAULT_TOKEN"
+	vaultTimeout     = 10 * time.Second
+)
+
+// VaultSecretManager reads secrets from a single secret stored in a HashiCorp Vault KV version 2 secrets engine. Each
+// key of the Vault secret is a secret name. Secret values are cached for the configured refresh interval so rotated
+// secrets are picked up without a restart.
+type VaultSecretManager struct {
+	cfg        config.VaultSecretsProviderConfig
+	httpClient *http.Client
+	cache      *secretCache
+}
+
+func (v VaultSecretManager) Get(ctx context.Context, key string) (string, error) {
+	return v.cache.getOrFetch(ctx, key, v.fetch)
+}
+
+func (v VaultSecretManager) getToken() (string, error) {
+	if len(v.cfg.TokenFilePath) == 0 {
+		return os.Getenv(vaultTokenEnvVar), nil
+	}
+
+	// Re-read the token file on every request since it's typically renewed by a sidecar.
+	raw, err := ioutil.ReadFile(v.cfg.TokenFilePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read vault token file [%v]. Error: %w", v.cfg.TokenFilePath, err)
+	}
+
+	return strings.TrimSpace(string(raw)), nil
+}
+
+func (v VaultSecretManager) fetch(ctx context.Context, key string) (string, error) {
+	token, err := v.getToken()
+	if err != nil {
+		return "", err
+	}
+
+	secretURL := fmt.Sprintf("%v/v1/%v/data/%v", strings.TrimSuffix(v.cfg.Address.String(), "/"),
+		strings.Trim(v.cfg.Mount, "/"), strings.Trim(v.cfg.Path, "/"))
+	logger.Debugf(ctx, "Reading secret [%v] from vault [%v]", key, secretURL)
+	req, err := http.NewRequest(http.MethodGet, secretURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set(vaultTokenHeader, token)
+	resp, err := v.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret from vault. Error: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("unable to read response body: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to read secret from vault. %s: %s", resp.Status, body)
+	}
+
+	secret := struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}{}
+
+	if err = json.Unmarshal(body, &secret); err != nil {
+		return "", fmt.Errorf("failed to decode vault secret. Error: %w", err)
+	}
+
+	value, found := secret.Data.Data[key]
+	if !found {
+		return "", fmt.Errorf("secret [%v] not found in vault secret [%v]", key, v.cfg.Path)
+	}
+
+	return value, nil
+}
+
+func NewVaultSecretManager(cfg config.VaultSecretsProviderConfig, refreshInterval time.Duration) (VaultSecretManager, error) {
+	if len(cfg.Address.String()) == 0 {
+		return VaultSecretManager{}, fmt.Errorf("vault address is required")
+	}
+
+	if len(cfg.Path) == 0 {
+		return VaultSecretManager{}, fmt.Errorf("vault secret path is required")
+	}
+
+	return VaultSecretManager{
+		cfg: cfg,
+		httpClient: &http.Client{
+			Timeout: vaultTimeout,
+		},
+		cache: newSecretCache(refreshInterval),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	stdlibConfig "github.com/flyteorg/flytestdlib/config"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+func TestVaultSecretManager_Get(t *testing.T) {
+	ctx := context.Background()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/data/flyteadmin/auth" || r.Header.Get(vaultTokenHeader) != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"data": map[string]string{
+					config.SecretNameCookieHashKey: "hash",
+				},
+			},
+		}))
+	}))
+	defer s.Close()
+
+	t.Setenv(vaultTokenEnvVar, "token")
+	sm, err := NewVaultSecretManager(config.VaultSecretsProviderConfig{
+		Address: stdlibConfig.URL{URL: *config.MustParseURL(s.URL)},
+		Mount:   "secret",
+		Path:    "flyteadmin/auth",
+	}, time.Minute)
+	assert.NoError(t, err)
+
+	value, err := sm.Get(ctx, config.SecretNameCookieHashKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "hash", value)
+
+	_, err = sm.Get(ctx, config.SecretNameCookieBlockKey)
+	assert.Error(t, err)
+}
+
+func TestNewVaultSecretManager_MissingAddress(t *testing.T) {
+	_, err := NewVaultSecretManager(config.VaultSecretsProviderConfig{Path: "flyteadmin/auth"}, time.Minute)
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -82,18 +83,41 @@ func GRPCGetIdentityFromAccessToken(ctx context.Context, authCtx interfaces.Auth
 	tokenStr, err := grpcauth.AuthFromMD(ctx, BearerScheme)
 	if err != nil {
 		logger.Debugf(ctx, "Could not retrieve bearer token from metadata %v", err)
-		return nil, errors.Wrapf(ErrJwtValidation, err, "Could not retrieve bearer token from metadata")
+		return nil, errors.Wrapf(ErrJwtValidation, fmt.Errorf("%w: %v", ErrMissingToken, err),
+			"Could not retrieve bearer token from metadata")
 	}
 
 	if tokenStr == "" {
 		logger.Debugf(ctx, "Found Bearer scheme but token was blank")
-		return nil, errors.Errorf(ErrJwtValidation, "%v token is blank", IDTokenScheme)
+		return nil, errors.Wrapf(ErrJwtValidation, ErrMissingToken, "%v token is blank", IDTokenScheme)
 	}
 
 	expectedAudience := GetPublicURL(ctx, nil, authCtx.Options()).String()
 	return authCtx.OAuth2ResourceServer().ValidateAccessToken(ctx, expectedAudience, tokenStr)
 }
 
+// GRPCGetIdentityFromWorkloadIdentityToken attempts to extract a workload identity token (SPIFFE JWT-SVID or Kubernetes
+// projected service account token) from the context, and will then call the validation function, passing up any
+// errors.
+func GRPCGetIdentityFromWorkloadIdentityToken(ctx context.Context, authCtx interfaces.AuthenticationContext) (
+	interfaces.IdentityContext, error) {
+
+	tokenStr, err := grpcauth.AuthFromMD(ctx, BearerScheme)
+	if err != nil {
+		logger.Debugf(ctx, "Could not retrieve bearer token from metadata %v", err)
+		return nil, errors.Wrapf(ErrJwtValidation, fmt.Errorf("%w: %v", ErrMissingToken, err),
+			"Could not retrieve bearer token from metadata")
+	}
+
+	if tokenStr == "" {
+		logger.Debugf(ctx, "Found Bearer scheme but token was blank")
+		return nil, errors.Wrapf(ErrJwtValidation, ErrMissingToken, "%v token is blank", BearerScheme)
+	}
+
+	expectedAudience := GetPublicURL(ctx, nil, authCtx.Options()).String()
+	return authCtx.WorkloadIdentityResourceServer().ValidateAccessToken(ctx, expectedAudience, tokenStr)
+}
+
 // GRPCGetIdentityFromIDToken attempts to extract a token from the context, and will then call the validation function,
 // passing up any errors.
 func GRPCGetIdentityFromIDToken(ctx context.Context, clientID string, provider *oidc.Provider) (
@@ -102,12 +126,13 @@ func GRPCGetIdentityFromIDToken(ctx context.Context, clientID string, provider *
 	tokenStr, err := grpcauth.AuthFromMD(ctx, IDTokenScheme)
 	if err != nil {
 		logger.Debugf(ctx, "Could not retrieve id token from metadata %v", err)
-		return nil, errors.Wrapf(ErrJwtValidation, err, "Could not retrieve id token from metadata")
+		return nil, errors.Wrapf(ErrJwtValidation, fmt.Errorf("%w: %v", ErrMissingToken, err),
+			"Could not retrieve id token from metadata")
 	}
 
 	if tokenStr == "" {
 		logger.Debugf(ctx, "Found Bearer scheme but token was blank")
-		return nil, errors.Errorf(ErrJwtValidation, "%v token is blank", IDTokenScheme)
+		return nil, errors.Wrapf(ErrJwtValidation, ErrMissingToken, "%v token is blank", IDTokenScheme)
 	}
 
 	meta := metautils.ExtractIncoming(ctx)
@@ -131,7 +156,12 @@ func IdentityContextFromIDTokenToken(ctx context.Context, tokenStr, clientID str
 		return nil, err
 	}
 
+	claims := map[string]interface{}{}
+	if err = idToken.Claims(&claims); err != nil {
+		logger.Infof(ctx, "Could not unmarshal the claims of the id token %v", err)
+	}
+
 	// TODO: Document why automatically specify "all" scope
 	return NewIdentityContext(idToken.Audience[0], idToken.Subject, "", idToken.IssuedAt,
-		sets.NewString(ScopeAll), userInfo), nil
+		sets.NewString(ScopeAll), userInfo).WithClaims(claims), nil
 }
@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/coreos/go-oidc"
+	jwtgo "github.com/golang-jwt/jwt/v4"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+	"github.com/flyteorg/flyteadmin/auth/interfaces"
+	"github.com/flyteorg/flytestdlib/errors"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+const (
+	spiffeIDPrefix                 = "spiffe://"
+	kubernetesServiceAccountPrefix = "system:serviceaccount:"
+	kubernetesServiceAccountParts  = 4
+)
+
+// Workload identities are only allowed to write execution events.
+var eventWritingMethods = sets.NewString(
+	"/flyteidl.service.AdminService/CreateWorkflowEvent",
+	"/flyteidl.service.AdminService/CreateNodeEvent",
+	"/flyteidl.service.AdminService/CreateTaskEvent",
+)
+
+// IsEventWritingMethod returns true if the fully qualified gRPC method is one that workload identities are permitted
+// to call.
+func IsEventWritingMethod(fullMethod string) bool {
+	return eventWritingMethods.Has(fullMethod)
+}
+
+type workloadIdentityIssuer struct {
+	cfg      config.WorkloadIdentityIssuer
+	verifier *oidc.IDTokenVerifier
+}
+
+// WorkloadIdentityResourceServer authenticates dataplane components (e.g. flytepropeller) that present SPIFFE JWT-SVIDs
+// or Kubernetes projected service account tokens. Tokens are validated against the JWKS of the trusted issuer and the
+// subject is mapped to a machine identity that is only granted the events:write scope.
+type WorkloadIdentityResourceServer struct {
+	issuers map[string]workloadIdentityIssuer
+}
+
+func (w WorkloadIdentityResourceServer) ValidateAccessToken(ctx context.Context, expectedAudience, tokenStr string) (
+	interfaces.IdentityContext, error) {
+
+	// The issuer is read from the unverified token only to pick the key set to verify the signature with.
+	unverifiedClaims := jwtgo.MapClaims{}
+	if _, _, err := new(jwtgo.Parser).ParseUnverified(tokenStr, unverifiedClaims); err != nil {
+		return nil, errors.Wrapf(ErrJwtValidation, err, "failed to parse workload identity token")
+	}
+
+	issuerStr, _ := unverifiedClaims["iss"].(string)
+	issuer, found := w.issuers[issuerStr]
+	if !found {
+		return nil, errors.Errorf(ErrJwtValidation, "untrusted workload identity issuer [%v]", issuerStr)
+	}
+
+	idToken, err := issuer.verifier.Verify(ctx, tokenStr)
+	if err != nil {
+		logger.Debugf(ctx, "Workload identity token verification failed %s", err)
+		return nil, errors.Wrapf(ErrJwtValidation, err, "failed to verify workload identity token")
+	}
+
+	allowedAudience := sets.NewString(issuer.cfg.Audience...)
+	if allowedAudience.Len() == 0 {
+		allowedAudience.Insert(expectedAudience)
+	}
+
+	if !allowedAudience.HasAny(idToken.Audience...) {
+		return nil, errors.Wrapf(ErrJwtValidation, ErrInvalidAudience, "invalid audience %v, wanted one of %v",
+			idToken.Audience, allowedAudience.List())
+	}
+
+	if err = validateWorkloadSubject(issuer.cfg.Type, idToken.Subject); err != nil {
+		return nil, err
+	}
+
+	machineIdentity, found := issuer.cfg.AllowedSubjects[idToken.Subject]
+	if !found {
+		return nil, errors.Errorf(ErrJwtValidation, "workload subject [%v] is not allowed", idToken.Subject)
+	}
+
+	return NewIdentityContext(idToken.Audience[0], idToken.Subject, machineIdentity, idToken.IssuedAt,
+		sets.NewString(ScopeEventsWrite), nil), nil
+}
+
+func validateWorkloadSubject(identityType config.WorkloadIdentityType, subject string) error {
+	switch identityType {
+	case config.WorkloadIdentityTypeSpiffe:
+		if !strings.HasPrefix(subject, spiffeIDPrefix) {
+			return errors.Errorf(ErrJwtValidation, "subject [%v] is not a valid SPIFFE ID", subject)
+		}
+	case config.WorkloadIdentityTypeKubernetes:
+		if !strings.HasPrefix(subject, kubernetesServiceAccountPrefix) ||
+			len(strings.Split(subject, ":")) != kubernetesServiceAccountParts {
+			return errors.Errorf(ErrJwtValidation, "subject [%v] is not a valid kubernetes service account", subject)
+		}
+	default:
+		return errors.Errorf(ErrJwtValidation, "unsupported workload identity type [%v]", identityType)
+	}
+
+	return nil
+}
+
+// discoverJwksURL reads the jwks_uri advertised by the issuer's OpenID discovery document. Both the Kubernetes service
+// account issuer and the SPIRE OIDC discovery provider serve this document.
+func discoverJwksURL(ctx context.Context, httpClient *http.Client, issuer string) (string, error) {
+	issuerURL, err := url.Parse(strings.TrimSuffix(issuer, "/") + "/")
+	if err != nil {
+		return "", err
+	}
+
+	wellKnown := issuerURL.ResolveReference(config.MustParseURL(OIdCMetadataEndpoint))
+	req, err := http.NewRequest(http.MethodGet, wellKnown.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("unable to read response body: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s: %s", resp.Status, body)
+	}
+
+	discovery := struct {
+		JwksURI string `json:"jwks_uri"`
+	}{}
+
+	if err = json.Unmarshal(body, &discovery); err != nil {
+		return "", fmt.Errorf("failed to decode issuer discovery document: %v", err)
+	}
+
+	if len(discovery.JwksURI) == 0 {
+		return "", fmt.Errorf("issuer [%v] doesn't advertise a jwks_uri", issuer)
+	}
+
+	return discovery.JwksURI, nil
+}
+
+// NewWorkloadIdentityResourceServer initializes a resource server that validates workload identity tokens minted by the
+// configured issuers.
+func NewWorkloadIdentityResourceServer(ctx context.Context, cfg config.WorkloadIdentityConfig, httpClient *http.Client) (
+	WorkloadIdentityResourceServer, error) {
+
+	issuers := make(map[string]workloadIdentityIssuer, len(cfg.Issuers))
+	for _, issuerCfg := range cfg.Issuers {
+		if issuerCfg.Type != config.WorkloadIdentityTypeSpiffe && issuerCfg.Type != config.WorkloadIdentityTypeKubernetes {
+			return WorkloadIdentityResourceServer{}, errors.Errorf(ErrauthCtx,
+				"unsupported workload identity type [%v] for issuer [%v]", issuerCfg.Type, issuerCfg.Issuer)
+		}
+
+		jwksURL := issuerCfg.JWKSURL.String()
+		if len(jwksURL) == 0 {
+			discovered, err := discoverJwksURL(ctx, httpClient, issuerCfg.Issuer)
+			if err != nil {
+				return WorkloadIdentityResourceServer{}, errors.Wrapf(ErrauthCtx, err,
+					"failed to discover jwks for workload identity issuer [%v]", issuerCfg.Issuer)
+			}
+
+			jwksURL = discovered
+		}
+
+		logger.Infof(ctx, "Trusting workload identity issuer [%v] with jwks [%v]", issuerCfg.Issuer, jwksURL)
+		keySet := oidc.NewRemoteKeySet(oidc.ClientContext(ctx, httpClient), jwksURL)
+		issuers[issuerCfg.Issuer] = workloadIdentityIssuer{
+			cfg: issuerCfg,
+			verifier: oidc.NewVerifier(issuerCfg.Issuer, keySet, &oidc.Config{
+				// Audience is validated against the configured allowed audience instead.
+				SkipClientIDCheck: true,
+			}),
+		}
+	}
+
+	return WorkloadIdentityResourceServer{
+		issuers: issuers,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jwtgo "github.com/golang-jwt/jwt/v4"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyteadmin/auth/config"
+)
+
+const testKeyID = "test-key"
+
+func newTestIssuer(t *testing.T) (*httptest.Server, *rsa.PrivateKey) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	key, err := jwk.New(&privateKey.PublicKey)
+	assert.NoError(t, err)
+	assert.NoError(t, key.Set(jwk.KeyIDKey, testKeyID))
+	assert.NoError(t, key.Set(jwk.AlgorithmKey, "RS256"))
+	keySet := jwk.NewSet()
+	keySet.Add(key)
+
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   s.URL,
+			"jwks_uri": s.URL + "/keys",
+		}))
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewEncoder(w).Encode(keySet))
+	})
+
+	return s, privateKey
+}
+
+func signWorkloadToken(t *testing.T, privateKey *rsa.PrivateKey, issuer, subject, audience string) string {
+	tok := jwtgo.NewWithClaims(jwtgo.SigningMethodRS256, jwtgo.StandardClaims{
+		Audience:  audience,
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		IssuedAt:  time.Now().Unix(),
+		Issuer:    issuer,
+		Subject:   subject,
+	})
+	tok.Header["kid"] = testKeyID
+
+	tokenStr, err := tok.SignedString(privateKey)
+	assert.NoError(t, err)
+	return tokenStr
+}
+
+func TestWorkloadIdentityResourceServer_ValidateAccessToken(t *testing.T) {
+	ctx := context.Background()
+	issuerServer, privateKey := newTestIssuer(t)
+	defer issuerServer.Close()
+
+	const propellerSA = "system:serviceaccount:flyte:flytepropeller"
+	resourceServer, err := NewWorkloadIdentityResourceServer(ctx, config.WorkloadIdentityConfig{
+		Enabled: true,
+		Issuers: []config.WorkloadIdentityIssuer{
+			{
+				Type:   config.WorkloadIdentityTypeKubernetes,
+				Issuer: issuerServer.URL,
+				AllowedSubjects: map[string]string{
+					propellerSA: "flytepropeller",
+				},
+			},
+		},
+	}, http.DefaultClient)
+	assert.NoError(t, err)
+
+	t.Run("allowed subject", func(t *testing.T) {
+		tokenStr := signWorkloadToken(t, privateKey, issuerServer.URL, propellerSA, "https://flyte.example.com")
+		identity, err := resourceServer.ValidateAccessToken(ctx, "https://flyte.example.com", tokenStr)
+		assert.NoError(t, err)
+		assert.Equal(t, propellerSA, identity.UserID())
+		assert.Equal(t, "flytepropeller", identity.AppID())
+		assert.True(t, identity.Scopes().Has(ScopeEventsWrite))
+		assert.False(t, identity.Scopes().Has(ScopeAll))
+	})
+
+	t.Run("unknown subject", func(t *testing.T) {
+		tokenStr := signWorkloadToken(t, privateKey, issuerServer.URL, "system:serviceaccount:flyte:other", "https://flyte.example.com")
+		_, err := resourceServer.ValidateAccessToken(ctx, "https://flyte.example.com", tokenStr)
+		assert.Error(t, err)
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		tokenStr := signWorkloadToken(t, privateKey, issuerServer.URL, propellerSA, "https://elsewhere.example.com")
+		_, err := resourceServer.ValidateAccessToken(ctx, "https://flyte.example.com", tokenStr)
+		assert.Error(t, err)
+	})
+
+	t.Run("untrusted issuer", func(t *testing.T) {
+		tokenStr := signWorkloadToken(t, privateKey, "https://untrusted.example.com", propellerSA, "https://flyte.example.com")
+		_, err := resourceServer.ValidateAccessToken(ctx, "https://flyte.example.com", tokenStr)
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateWorkloadSubject(t *testing.T) {
+	assert.NoError(t, validateWorkloadSubject(config.WorkloadIdentityTypeSpiffe, "spiffe://flyte.example.com/ns/flyte/sa/flytepropeller"))
+	assert.Error(t, validateWorkloadSubject(config.WorkloadIdentityTypeSpiffe, "system:serviceaccount:flyte:flytepropeller"))
+	assert.NoError(t, validateWorkloadSubject(config.WorkloadIdentityTypeKubernetes, "system:serviceaccount:flyte:flytepropeller"))
+	assert.Error(t, validateWorkloadSubject(config.WorkloadIdentityTypeKubernetes, "system:serviceaccount:flytepropeller"))
+	assert.Error(t, validateWorkloadSubject("saml", "anything"))
+}
+
+func TestIsEventWritingMethod(t *testing.T) {
+	assert.True(t, IsEventWritingMethod("/flyteidl.service.AdminService/CreateNodeEvent"))
+	assert.False(t, IsEventWritingMethod("/flyteidl.service.AdminService/CreateExecution"))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/clusterresource"
 	executioncluster "github.com/flyteorg/flyteadmin/pkg/executioncluster/impl"
+	"github.com/flyteorg/flyteadmin/pkg/leaderelection"
 
 	"github.com/flyteorg/flyteadmin/pkg/runtime"
 
@@ -40,19 +41,9 @@ var controllerRunCmd = &cobra.Command{
 		configuration := runtime.NewConfigurationProvider()
 		scope := promutils.NewScope(configuration.ApplicationConfiguration().GetTopLevelConfig().MetricsScope).NewSubScope("clusterresource")
 		dbConfigValues := configuration.ApplicationConfiguration().GetDbConfig()
-		dbConfig := repositoryConfig.DbConfig{
-			BaseConfig: repositoryConfig.BaseConfig{
-				IsDebug: dbConfigValues.Debug,
-			},
-			Host:         dbConfigValues.Host,
-			Port:         dbConfigValues.Port,
-			DbName:       dbConfigValues.DbName,
-			User:         dbConfigValues.User,
-			Password:     dbConfigValues.Password,
-			ExtraOptions: dbConfigValues.ExtraOptions,
-		}
+		dbConfig := repositoryConfig.NewDbConfig(dbConfigValues)
 		db := repositories.GetRepository(
-			repositories.POSTGRES, dbConfig, scope.NewSubScope("database"))
+			repositories.GetRepoConfig(dbConfig), dbConfig, scope.NewSubScope("database"))
 
 		cfg := config.GetConfig()
 		executionCluster := executioncluster.GetExecutionCluster(
@@ -62,8 +53,13 @@ var controllerRunCmd = &cobra.Command{
 			configuration,
 			db)
 
+		elector, err := leaderelection.NewElector(cfg, dbConfig, leaderelection.RoleClusterResource,
+			scope.NewSubScope("leader_election"))
+		if err != nil {
+			logger.Fatalf(ctx, "Failed to create the leader elector [%+v]", err)
+		}
 		clusterResourceController := clusterresource.NewClusterResourceController(db, executionCluster, scope)
-		clusterResourceController.Run()
+		leaderelection.RunWhileLeading(ctx, elector, leaderelection.RoleClusterResource, clusterResourceController.Run)
 		logger.Infof(ctx, "ClusterResourceController started successfully")
 	},
 }
@@ -76,19 +72,9 @@ var controllerSyncCmd = &cobra.Command{
 		configuration := runtime.NewConfigurationProvider()
 		scope := promutils.NewScope(configuration.ApplicationConfiguration().GetTopLevelConfig().MetricsScope).NewSubScope("clusterresource")
 		dbConfigValues := configuration.ApplicationConfiguration().GetDbConfig()
-		dbConfig := repositoryConfig.DbConfig{
-			BaseConfig: repositoryConfig.BaseConfig{
-				IsDebug: dbConfigValues.Debug,
-			},
-			Host:         dbConfigValues.Host,
-			Port:         dbConfigValues.Port,
-			DbName:       dbConfigValues.DbName,
-			User:         dbConfigValues.User,
-			Password:     dbConfigValues.Password,
-			ExtraOptions: dbConfigValues.ExtraOptions,
-		}
+		dbConfig := repositoryConfig.NewDbConfig(dbConfigValues)
 		db := repositories.GetRepository(
-			repositories.POSTGRES, dbConfig, scope.NewSubScope("database"))
+			repositories.GetRepoConfig(dbConfig), dbConfig, scope.NewSubScope("database"))
 
 		cfg := config.GetConfig()
 		executionCluster := executioncluster.GetExecutionCluster(
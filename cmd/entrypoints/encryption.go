@@ -0,0 +1,60 @@
+package entrypoints
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/encryption"
+	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
+	"github.com/spf13/cobra"
+)
+
+var rotateBatchSize int
+
+var parentEncryptionCmd = &cobra.Command{
+	Use:   "encryption",
+	Short: "This command administers the encryption of stored fields. Please choose a subcommand.",
+}
+
+var encryptionRotateCmd = &cobra.Command{
+	Use: "rotate",
+	Short: "This command wraps the data keys of encrypted fields with the current keys of their projects and orgs, " +
+		"and encrypts fields stored before encryption was enabled",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		configuration := runtime.NewConfigurationProvider()
+		scope := promutils.NewScope(configuration.ApplicationConfiguration().GetTopLevelConfig().MetricsScope).NewSubScope("encryption")
+		dbConfig := repositoryConfig.NewDbConfig(configuration.ApplicationConfiguration().GetDbConfig())
+		db := repositories.GetRepository(
+			repositories.GetRepoConfig(dbConfig), dbConfig, scope.NewSubScope("database"))
+		rotators := []struct {
+			name string
+			repo interface{}
+		}{
+			{name: "executions", repo: db.ExecutionRepo()},
+			{name: "triggers", repo: db.TriggerRepo()},
+		}
+		for _, r := range rotators {
+			rotator, ok := r.repo.(encryption.KeyRotator)
+			if !ok {
+				logger.Fatalf(ctx, "Encryption isn't enabled in the database config")
+			}
+			rotated, err := rotator.RotateKeys(ctx, rotateBatchSize)
+			if err != nil {
+				logger.Fatalf(ctx, "Failed to rotate keys after rotating %d %s [%+v]", rotated, r.name, err)
+			}
+			logger.Infof(ctx, "Successfully rotated the keys of %d %s", rotated, r.name)
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(parentEncryptionCmd)
+	parentEncryptionCmd.AddCommand(encryptionRotateCmd)
+	encryptionRotateCmd.Flags().IntVar(&rotateBatchSize, "batchSize", 100,
+		"The number of records read at a time.")
+}
@@ -31,37 +31,30 @@ var migrateCmd = &cobra.Command{
 	Short: "This command will run all the migrations for the database",
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := context.Background()
-		configuration := runtime.NewConfigurationProvider()
-		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
-		postgresConfigProvider := config.NewPostgresConfigProvider(config.DbConfig{
-			BaseConfig: config.BaseConfig{
-				IsDebug: databaseConfig.Debug,
-			},
-			Host:         databaseConfig.Host,
-			Port:         databaseConfig.Port,
-			DbName:       databaseConfig.DbName,
-			User:         databaseConfig.User,
-			Password:     databaseConfig.Password,
-			ExtraOptions: databaseConfig.ExtraOptions,
-		}, migrateScope)
-		db, err := gorm.Open(postgresConfigProvider.GetType(), postgresConfigProvider.GetArgs())
-		if err != nil {
-			logger.Fatal(ctx, err)
-		}
-		defer db.Close()
-		db.LogMode(true)
-		if err = db.DB().Ping(); err != nil {
-			logger.Fatal(ctx, err)
-		}
-
-		m := gormigrate.New(db, gormigrate.DefaultOptions, config.Migrations)
-		if err = m.Migrate(); err != nil {
+		if err := runMigrations(ctx); err != nil {
 			logger.Fatalf(ctx, "Could not migrate: %v", err)
 		}
 		logger.Infof(ctx, "Migration ran successfully")
 	},
 }
 
+func runMigrations(ctx context.Context) error {
+	configuration := runtime.NewConfigurationProvider()
+	databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
+	dbConfigProvider := config.NewDbConnectionConfigProvider(config.NewDbConfig(databaseConfig), migrateScope)
+	db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	db.LogMode(true)
+	if err = db.DB().Ping(); err != nil {
+		return err
+	}
+
+	return config.Migrate(ctx, db)
+}
+
 // Rollback the latest migration
 var rollbackCmd = &cobra.Command{
 	Use:   "rollback",
@@ -70,19 +63,9 @@ var rollbackCmd = &cobra.Command{
 		ctx := context.Background()
 		configuration := runtime.NewConfigurationProvider()
 		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
-		postgresConfigProvider := config.NewPostgresConfigProvider(config.DbConfig{
-			BaseConfig: config.BaseConfig{
-				IsDebug: databaseConfig.Debug,
-			},
-			Host:         databaseConfig.Host,
-			Port:         databaseConfig.Port,
-			DbName:       databaseConfig.DbName,
-			User:         databaseConfig.User,
-			Password:     databaseConfig.Password,
-			ExtraOptions: databaseConfig.ExtraOptions,
-		}, rollbackScope)
-
-		db, err := gorm.Open(postgresConfigProvider.GetType(), postgresConfigProvider.GetArgs())
+		dbConfigProvider := config.NewDbConnectionConfigProvider(config.NewDbConfig(databaseConfig), rollbackScope)
+
+		db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
 		if err != nil {
 			logger.Fatal(ctx, err)
 		}
@@ -109,25 +92,12 @@ var seedProjectsCmd = &cobra.Command{
 		ctx := context.Background()
 		configuration := runtime.NewConfigurationProvider()
 		databaseConfig := configuration.ApplicationConfiguration().GetDbConfig()
-		postgresConfigProvider := config.NewPostgresConfigProvider(config.DbConfig{
-			BaseConfig: config.BaseConfig{
-				IsDebug: databaseConfig.Debug,
-			},
-			Host:         databaseConfig.Host,
-			Port:         databaseConfig.Port,
-			DbName:       databaseConfig.DbName,
-			User:         databaseConfig.User,
-			Password:     databaseConfig.Password,
-			ExtraOptions: databaseConfig.ExtraOptions,
-		}, migrateScope)
-		db, err := gorm.Open(postgresConfigProvider.GetType(), postgresConfigProvider.GetArgs())
-		if err != nil {
-			logger.Fatal(ctx, err)
-		}
+		dbConfigProvider := config.NewDbConnectionConfigProvider(config.NewDbConfig(databaseConfig), migrateScope)
+		db := config.OpenDbConnection(dbConfigProvider)
 		defer db.Close()
 		db.LogMode(true)
 
-		if err = config.SeedProjects(db, args); err != nil {
+		if err := config.SeedProjects(db, args); err != nil {
 			logger.Fatalf(ctx, "Could not add projects to database with err: %v", err)
 		}
 		logger.Infof(ctx, "Successfully added projects to database")
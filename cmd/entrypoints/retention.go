@@ -0,0 +1,75 @@
+package entrypoints
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	"github.com/flyteorg/flyteadmin/pkg/leaderelection"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/retention"
+	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
+	"github.com/spf13/cobra"
+)
+
+var parentRetentionCmd = &cobra.Command{
+	Use:   "retention",
+	Short: "This command administers the retention pruner. Please choose a subcommand.",
+}
+
+func getPruner(ctx context.Context) retention.Pruner {
+	configuration := runtime.NewConfigurationProvider()
+	scope := promutils.NewScope(configuration.ApplicationConfiguration().GetTopLevelConfig().MetricsScope).NewSubScope("retention")
+	dbConfigValues := configuration.ApplicationConfiguration().GetDbConfig()
+	dbConfig := repositoryConfig.NewDbConfig(dbConfigValues)
+	db := repositories.GetRepository(
+		repositories.GetRepoConfig(dbConfig), dbConfig, scope.NewSubScope("database"))
+
+	dataStorageClient, err := storage.NewDataStore(storage.GetConfig(), scope.NewSubScope("storage"))
+	if err != nil {
+		logger.Fatalf(ctx, "Failed to initialize storage config [%+v]", err)
+	}
+	return retention.NewPruner(db, configuration, dataStorageClient, scope)
+}
+
+var retentionRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "This command will start a retention pruner to periodically archive and delete expired records",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		configuration := runtime.NewConfigurationProvider()
+		scope := promutils.NewScope(configuration.ApplicationConfiguration().GetTopLevelConfig().MetricsScope).NewSubScope("retention")
+		elector, err := leaderelection.NewElector(config.GetConfig(),
+			repositoryConfig.NewDbConfig(configuration.ApplicationConfiguration().GetDbConfig()),
+			leaderelection.RoleRetention, scope.NewSubScope("leader_election"))
+		if err != nil {
+			logger.Fatalf(ctx, "Failed to create the leader elector [%+v]", err)
+		}
+		pruner := getPruner(ctx)
+		logger.Infof(ctx, "Retention pruner started successfully")
+		leaderelection.RunWhileLeading(ctx, elector, leaderelection.RoleRetention, pruner.Run)
+	},
+}
+
+var retentionPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "This command will archive and delete expired records once",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		err := getPruner(ctx).Prune(ctx)
+		if err != nil {
+			logger.Fatalf(ctx, "Failed to prune expired records [%+v]", err)
+		}
+		logger.Infof(ctx, "Successfully pruned expired records")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(parentRetentionCmd)
+	parentRetentionCmd.AddCommand(retentionRunCmd)
+	parentRetentionCmd.AddCommand(retentionPruneCmd)
+}
@@ -7,11 +7,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	"github.com/flyteorg/flytepropeller/pkg/controller/nodes/task/secretmanager"
-
 	authConfig "github.com/flyteorg/flyteadmin/auth/config"
 
 	"github.com/flyteorg/flyteadmin/auth/authzserver"
+	"github.com/flyteorg/flyteadmin/auth/secrets"
 
 	"github.com/gorilla/handlers"
 
@@ -26,6 +25,7 @@ import (
 	"strings"
 
 	"github.com/flyteorg/flyteadmin/pkg/server"
+	"github.com/flyteorg/flyteadmin/pkg/tracing"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
@@ -37,10 +37,14 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/flyteorg/flyteadmin/pkg/config"
+	manager "github.com/flyteorg/flyteadmin/pkg/manager/impl"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/rpc/adminservice"
+	runtimeConfig "github.com/flyteorg/flyteadmin/pkg/runtime"
 	"github.com/spf13/cobra"
 
 	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/flyteorg/flytestdlib/promutils/labeled"
 	grpcPrometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"google.golang.org/grpc"
@@ -57,6 +61,25 @@ var serveCmd = &cobra.Command{
 		ctx := context.Background()
 		serverConfig := config.GetConfig()
 
+		if runtimeConfig.NewConfigurationProvider().ApplicationConfiguration().GetDbConfig().AutoMigrate {
+			if err := runMigrations(ctx); err != nil {
+				return errors.Wrap(err, "failed to migrate the database")
+			}
+			logger.Infof(ctx, "Migration ran successfully")
+		}
+
+		stopTracing, err := tracing.Init(ctx, serverConfig.Tracing)
+		if err != nil {
+			return errors.Wrap(err, "failed to initialize tracing")
+		}
+		defer stopTracing()
+
+		if standalone {
+			if err := startLeaderProcesses(ctx, serverConfig); err != nil {
+				return errors.Wrap(err, "failed to start the scheduler and cluster resource controller")
+			}
+		}
+
 		if serverConfig.Security.Secure {
 			return serveGatewaySecure(ctx, serverConfig, authConfig.GetConfig())
 		}
@@ -68,6 +91,8 @@ var serveCmd = &cobra.Command{
 func init() {
 	// Command information
 	RootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().BoolVar(&standalone, "standalone", false,
+		"Also runs the scheduler and cluster resource controller, on the replica elected the leader.")
 	RootCmd.AddCommand(secretsCmd)
 
 	// Set Keys
@@ -76,7 +101,7 @@ func init() {
 		contextutils.TaskTypeKey, common.RuntimeTypeKey, common.RuntimeVersionKey)
 }
 
-func blanketAuthorization(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+func blanketAuthorization(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
 	resp interface{}, err error) {
 
 	identityContext := auth.IdentityContextFromContext(ctx)
@@ -84,31 +109,69 @@ func blanketAuthorization(ctx context.Context, req interface{}, _ *grpc.UnarySer
 		return handler(ctx, req)
 	}
 
-	if !identityContext.Scopes().Has(auth.ScopeAll) {
-		return nil, status.Errorf(codes.Unauthenticated, "authenticated user doesn't have required scope")
+	if identityContext.Scopes().Has(auth.ScopeAll) {
+		return handler(ctx, req)
 	}
 
-	return handler(ctx, req)
+	// Workload identities (e.g. flytepropeller authenticating with a SPIFFE SVID) may only write execution events.
+	if identityContext.Scopes().Has(auth.ScopeEventsWrite) && auth.IsEventWritingMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	return nil, status.Errorf(codes.Unauthenticated, "authenticated user doesn't have required scope")
 }
 
 // Creates a new gRPC Server with all the configuration
 func newGRPCServer(ctx context.Context, cfg *config.ServerConfig, authCtx interfaces.AuthenticationContext,
-	opts ...grpc.ServerOption) (*grpc.Server, error) {
+	adminServer *adminservice.AdminService, opts ...grpc.ServerOption) (*grpc.Server, error) {
 	// Not yet implemented for streaming
-	var chainedUnaryInterceptors grpc.UnaryServerInterceptor
+	interceptors := []grpc.UnaryServerInterceptor{grpcPrometheus.UnaryServerInterceptor}
+	if cfg.Tracing.Enabled {
+		interceptors = append(interceptors, tracing.UnaryServerInterceptor)
+	}
+	if cfg.Security.NetworkPolicy.Enabled {
+		logger.Infof(ctx, "Enforcing network policy")
+		networkPolicy, err := server.NewNetworkPolicy(cfg.Security.NetworkPolicy)
+		if err != nil {
+			return nil, err
+		}
+
+		interceptors = append(interceptors, networkPolicy.UnaryServerInterceptor)
+	}
+
 	if cfg.Security.UseAuth {
 		logger.Infof(ctx, "Creating gRPC server with authentication")
-		chainedUnaryInterceptors = grpc_middleware.ChainUnaryServer(grpcPrometheus.UnaryServerInterceptor,
+		interceptors = append(interceptors,
 			auth.GetAuthenticationCustomMetadataInterceptor(authCtx),
 			grpcauth.UnaryServerInterceptor(auth.GetAuthenticationInterceptor(authCtx)),
 			auth.AuthenticationLoggingInterceptor,
 			blanketAuthorization,
 		)
+
+		if authCtx.Options().Authorization.EnforceProjectVisibility {
+			interceptors = append(interceptors, server.NewOrgVisibilityInterceptor(adminServer.OrgManager))
+		}
+
+		if externalCfg := authCtx.Options().Authorization.External; externalCfg.Enabled {
+			logger.Infof(ctx, "Consulting external authorizer at [%v]", externalCfg.URL.String())
+			authorizer, err := auth.NewExternalAuthorizer(externalCfg)
+			if err != nil {
+				return nil, err
+			}
+
+			interceptors = append(interceptors, auth.GetExternalAuthorizationInterceptor(authorizer))
+		}
 	} else {
 		logger.Infof(ctx, "Creating gRPC server without authentication")
-		chainedUnaryInterceptors = grpc_middleware.ChainUnaryServer(grpcPrometheus.UnaryServerInterceptor)
 	}
 
+	if runtimeConfig.NewConfigurationProvider().ApplicationConfiguration().GetTopLevelConfig().GetAPIUsageConfig().Enabled {
+		logger.Infof(ctx, "Recording api usage")
+		interceptors = append(interceptors, server.NewAPIUsageInterceptor(adminServer.APIUsageManager))
+	}
+
+	chainedUnaryInterceptors := grpc_middleware.ChainUnaryServer(interceptors...)
+
 	serverOpts := []grpc.ServerOption{
 		grpc.StreamInterceptor(grpcPrometheus.StreamServerInterceptor),
 		grpc.UnaryInterceptor(chainedUnaryInterceptors),
@@ -116,7 +179,7 @@ func newGRPCServer(ctx context.Context, cfg *config.ServerConfig, authCtx interf
 	serverOpts = append(serverOpts, opts...)
 	grpcServer := grpc.NewServer(serverOpts...)
 	grpcPrometheus.Register(grpcServer)
-	flyteService.RegisterAdminServiceServer(grpcServer, adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master))
+	flyteService.RegisterAdminServiceServer(grpcServer, adminServer)
 	if cfg.Security.UseAuth {
 		flyteService.RegisterAuthMetadataServiceServer(grpcServer, authCtx.AuthMetadataService())
 		flyteService.RegisterIdentityServiceServer(grpcServer, authCtx.IdentityService())
@@ -152,7 +215,7 @@ func healthCheckFunc(w http.ResponseWriter, r *http.Request) {
 }
 
 func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authCfg *authConfig.Config, authCtx interfaces.AuthenticationContext,
-	grpcAddress string, grpcConnectionOpts ...grpc.DialOption) (*http.ServeMux, error) {
+	adminServer *adminservice.AdminService, grpcAddress string, grpcConnectionOpts ...grpc.DialOption) (*http.ServeMux, error) {
 
 	// Register the server that will serve HTTP/REST Traffic
 	mux := http.NewServeMux()
@@ -164,6 +227,10 @@ func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authCfg *authC
 	// This endpoint will serve the OpenAPI2 spec generated by the swagger protoc plugin, and bundled by go-bindata
 	mux.HandleFunc("/api/v1/openapi", GetHandleOpenapiSpec(ctx))
 
+	// Register the endpoint event sources post payloads to in order to fire triggers. It isn't served by the gateway,
+	// since payloads are arbitrary JSON authenticated by their signature rather than the user's credentials.
+	mux.HandleFunc(adminservice.TriggerPathPrefix, adminServer.HandleFireTrigger)
+
 	var gwmuxOptions = make([]runtime.ServeMuxOption, 0)
 	// This option means that http requests are served with protobufs, instead of json. We always want this.
 	gwmuxOptions = append(gwmuxOptions, runtime.WithMarshalerOption("application/octet-stream", &runtime.ProtoMarshaller{}))
@@ -183,6 +250,10 @@ func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authCfg *authC
 		gwmuxOptions = append(gwmuxOptions, runtime.WithMetadata(auth.GetHTTPMetadataTaggingHandler()))
 	}
 
+	if cfg.Tracing.Enabled {
+		gwmuxOptions = append(gwmuxOptions, runtime.WithMetadata(tracing.ForwardTraceparent))
+	}
+
 	// Create the grpc-gateway server with the options specified
 	gwmux := runtime.NewServeMux(gwmuxOptions...)
 
@@ -206,9 +277,38 @@ func newHTTPServer(ctx context.Context, cfg *config.ServerConfig, authCfg *authC
 	return mux, nil
 }
 
+// newMetricsSubScope returns the scope of a component's metrics, nested in the configured top level scope.
+func newMetricsSubScope(name string) promutils.Scope {
+	configuration := runtimeConfig.NewConfigurationProvider()
+	return promutils.NewScope(configuration.ApplicationConfiguration().GetTopLevelConfig().MetricsScope).NewSubScope(name)
+}
+
+// newOAuthClientLookup returns the lookup of the clients users registered with the internal authorization server,
+// backed by the admin server's client manager so both share the same database connection.
+func newOAuthClientLookup(cfg authConfig.AuthorizationServer, clientManager managerInterfaces.OAuthClientInterface) (
+	authzserver.ClientLookup, error) {
+	return authzserver.NewCachingClientLookup(manager.NewOAuthClientLookup(clientManager), cfg.ClientCacheSize,
+		cfg.ClientCacheTTL.Duration)
+}
+
+// Periodically reloads the secrets of the auth context and, when admin is its own authorization server, of the
+// authorization server so rotated secrets are used without restarting.
+func reloadAuthSecrets(ctx context.Context, authCfg *authConfig.Config, authCtx interfaces.AuthenticationContext,
+	oauth2Provider interfaces.OAuth2Provider) {
+	var reloaders []auth.SecretsReloader
+	for _, candidate := range []interface{}{authCtx, oauth2Provider} {
+		if reloader, ok := candidate.(auth.SecretsReloader); ok {
+			reloaders = append(reloaders, reloader)
+		}
+	}
+
+	auth.RunSecretsReloaders(ctx, authCfg.SecretsProvider.RefreshInterval.Duration, reloaders...)
+}
+
 func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg *authConfig.Config) error {
 	logger.Infof(ctx, "Serving Flyte Admin Insecure")
 
+	adminServer := adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master)
 	// This will parse configuration and create the necessary objects for dealing with auth
 	var authCtx interfaces.AuthenticationContext
 	var err error
@@ -217,11 +317,22 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 	// Warning: Running authentication without SSL in any other topology is a severe security flaw.
 	// See the auth.Config object for additional settings as well.
 	if cfg.Security.UseAuth {
-		sm := secretmanager.NewFileEnvSecretManager(secretmanager.GetConfig())
+		sm, err := secrets.NewSecretManager(ctx, authCfg.SecretsProvider)
+		if err != nil {
+			logger.Errorf(ctx, "Error creating secret manager %s", err)
+			return err
+		}
+
 		var oauth2Provider interfaces.OAuth2Provider
 		var oauth2ResourceServer interfaces.OAuth2ResourceServer
 		if authCfg.AppAuth.AuthServerType == authConfig.AuthorizationServerTypeSelf {
-			oauth2Provider, err = authzserver.NewProvider(ctx, authCfg.AppAuth.SelfAuthServer, sm)
+			clientLookup, err := newOAuthClientLookup(authCfg.AppAuth.SelfAuthServer, adminServer.OAuthClientManager)
+			if err != nil {
+				logger.Errorf(ctx, "Error creating oauth client lookup %s", err)
+				return err
+			}
+
+			oauth2Provider, err = authzserver.NewProvider(ctx, authCfg.AppAuth.SelfAuthServer, sm, clientLookup)
 			if err != nil {
 				logger.Errorf(ctx, "Error creating authorization server %s", err)
 				return err
@@ -239,14 +350,16 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 		oauth2MetadataProvider := authzserver.NewService(authCfg)
 		oidcUserInfoProvider := auth.NewUserInfoProvider()
 
-		authCtx, err = auth.NewAuthenticationContext(ctx, sm, oauth2Provider, oauth2ResourceServer, oauth2MetadataProvider, oidcUserInfoProvider, authCfg)
+		authCtx, err = auth.NewAuthenticationContext(ctx, sm, oauth2Provider, oauth2ResourceServer, oauth2MetadataProvider, oidcUserInfoProvider, authCfg, newMetricsSubScope("auth"))
 		if err != nil {
 			logger.Errorf(ctx, "Error creating auth context %s", err)
 			return err
 		}
+
+		go reloadAuthSecrets(ctx, authCfg, authCtx, oauth2Provider)
 	}
 
-	grpcServer, err := newGRPCServer(ctx, cfg, authCtx)
+	grpcServer, err := newGRPCServer(ctx, cfg, authCtx, adminServer)
 	if err != nil {
 		return errors.Wrap(err, "failed to create GRPC server")
 	}
@@ -263,7 +376,7 @@ func serveGatewayInsecure(ctx context.Context, cfg *config.ServerConfig, authCfg
 	}()
 
 	logger.Infof(ctx, "Starting HTTP/1 Gateway server on %s", cfg.GetHostAddress())
-	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, cfg.GetGrpcHostAddress(), grpc.WithInsecure(),
+	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, adminServer, cfg.GetGrpcHostAddress(), grpc.WithInsecure(),
 		grpc.WithMaxHeaderListSize(common.MaxResponseStatusBytes))
 	if err != nil {
 		return err
@@ -308,14 +421,26 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 	if err != nil {
 		return err
 	}
+	adminServer := adminservice.NewAdminServer(cfg.KubeConfig, cfg.Master)
 	// This will parse configuration and create the necessary objects for dealing with auth
 	var authCtx interfaces.AuthenticationContext
 	if cfg.Security.UseAuth {
-		sm := secretmanager.NewFileEnvSecretManager(secretmanager.GetConfig())
+		sm, err := secrets.NewSecretManager(ctx, authCfg.SecretsProvider)
+		if err != nil {
+			logger.Errorf(ctx, "Error creating secret manager %s", err)
+			return err
+		}
+
 		var oauth2Provider interfaces.OAuth2Provider
 		var oauth2ResourceServer interfaces.OAuth2ResourceServer
 		if authCfg.AppAuth.AuthServerType == authConfig.AuthorizationServerTypeSelf {
-			oauth2Provider, err = authzserver.NewProvider(ctx, authCfg.AppAuth.SelfAuthServer, sm)
+			clientLookup, err := newOAuthClientLookup(authCfg.AppAuth.SelfAuthServer, adminServer.OAuthClientManager)
+			if err != nil {
+				logger.Errorf(ctx, "Error creating oauth client lookup %s", err)
+				return err
+			}
+
+			oauth2Provider, err = authzserver.NewProvider(ctx, authCfg.AppAuth.SelfAuthServer, sm, clientLookup)
 			if err != nil {
 				logger.Errorf(ctx, "Error creating authorization server %s", err)
 				return err
@@ -333,14 +458,16 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 		oauth2MetadataProvider := authzserver.NewService(authCfg)
 		oidcUserInfoProvider := auth.NewUserInfoProvider()
 
-		authCtx, err = auth.NewAuthenticationContext(ctx, sm, oauth2Provider, oauth2ResourceServer, oauth2MetadataProvider, oidcUserInfoProvider, authCfg)
+		authCtx, err = auth.NewAuthenticationContext(ctx, sm, oauth2Provider, oauth2ResourceServer, oauth2MetadataProvider, oidcUserInfoProvider, authCfg, newMetricsSubScope("auth"))
 		if err != nil {
 			logger.Errorf(ctx, "Error creating auth context %s", err)
 			return err
 		}
+
+		go reloadAuthSecrets(ctx, authCfg, authCtx, oauth2Provider)
 	}
 
-	grpcServer, err := newGRPCServer(ctx, cfg, authCtx,
+	grpcServer, err := newGRPCServer(ctx, cfg, authCtx, adminServer,
 		grpc.Creds(credentials.NewServerTLSFromCert(cert)))
 	if err != nil {
 		return errors.Wrap(err, "failed to create GRPC server")
@@ -351,7 +478,8 @@ func serveGatewaySecure(ctx context.Context, cfg *config.ServerConfig, authCfg *
 		ServerName: cfg.GetHostAddress(),
 		RootCAs:    certPool,
 	})
-	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, cfg.GetHostAddress(), grpc.WithTransportCredentials(dialCreds))
+	httpServer, err := newHTTPServer(ctx, cfg, authCfg, authCtx, adminServer, cfg.GetHostAddress(),
+		grpc.WithTransportCredentials(dialCreds))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,109 @@
+package entrypoints
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/clusterresource"
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	executioncluster "github.com/flyteorg/flyteadmin/pkg/executioncluster/impl"
+	"github.com/flyteorg/flyteadmin/pkg/leaderelection"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	runtimeConfig "github.com/flyteorg/flyteadmin/pkg/runtime"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler"
+	schedulerRepoConfig "github.com/flyteorg/flyteadmin/scheduler/repositories"
+	"github.com/flyteorg/flyteidl/clients/go/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+)
+
+// Serves the scheduler and cluster resource controller along with admin, so that small installs can run a single
+// deployment. HA installs can serve several replicas this way, or keep running the processes as deployments of their
+// own.
+var standalone bool
+
+func newScheduleExecutor(ctx context.Context, configuration runtimeInterfaces.Configuration,
+	scope promutils.Scope) (scheduler.ScheduledExecutor, error) {
+	dbConfig := repositoryConfig.NewDbConfig(configuration.ApplicationConfiguration().GetDbConfig())
+	db := schedulerRepoConfig.GetRepository(
+		schedulerRepoConfig.GetRepoConfig(dbConfig), dbConfig, scope.NewSubScope("database"))
+
+	// The scheduler launches executions through the admin client config, which is expected to point at this server.
+	clientSet, err := admin.ClientSetBuilder().WithConfig(admin.GetConfig(ctx)).Build(ctx)
+	if err != nil {
+		return scheduler.ScheduledExecutor{}, err
+	}
+	return scheduler.NewScheduledExecutor(db,
+		configuration.ApplicationConfiguration().GetSchedulerConfig().GetWorkflowExecutorConfig(), scope,
+		clientSet.AdminClient()), nil
+}
+
+func newClusterResourceController(cfg *config.ServerConfig, configuration runtimeInterfaces.Configuration,
+	scope promutils.Scope) clusterresource.Controller {
+	dbConfig := repositoryConfig.NewDbConfig(configuration.ApplicationConfiguration().GetDbConfig())
+	db := repositories.GetRepository(repositories.GetRepoConfig(dbConfig), dbConfig, scope.NewSubScope("database"))
+	executionCluster := executioncluster.GetExecutionCluster(
+		scope.NewSubScope("cluster"),
+		cfg.KubeConfig,
+		cfg.Master,
+		configuration,
+		db)
+	return clusterresource.NewClusterResourceController(db, executionCluster, scope)
+}
+
+// Runs the scheduler and cluster resource controller on whichever replicas are elected their leaders. The scheduler is
+// created once elected, since its admin client may need this server to be up. Its leaders exit once they lose the
+// leadership rather than campaigning again, since the scheduler can't be started twice in one process, and are
+// restarted by their deployment.
+func startLeaderProcesses(ctx context.Context, cfg *config.ServerConfig) error {
+	configuration := runtimeConfig.NewConfigurationProvider()
+	scope := promutils.NewScope(configuration.ApplicationConfiguration().GetTopLevelConfig().MetricsScope)
+	electionConfig := cfg.LeaderElection
+	electionConfig.Enabled = true
+	if cfg.Sandbox {
+		// Kubernetes leases aren't available in sandbox mode.
+		electionConfig.LockType = config.LeaderElectionLockTypeDatabase
+	}
+	electorFactory, err := leaderelection.NewElectorFactory(electionConfig, cfg.KubeConfig, cfg.Master,
+		repositoryConfig.NewDbConfig(configuration.ApplicationConfiguration().GetDbConfig()),
+		scope.NewSubScope("leader_election"))
+	if err != nil {
+		return err
+	}
+	schedulerElector, err := electorFactory.NewElector(leaderelection.RoleScheduler)
+	if err != nil {
+		return err
+	}
+	// There are no clusters to sync resources to in sandbox mode.
+	if !cfg.Sandbox {
+		clusterResourceElector, err := electorFactory.NewElector(leaderelection.RoleClusterResource)
+		if err != nil {
+			return err
+		}
+		clusterResourceController := newClusterResourceController(cfg, configuration,
+			scope.NewSubScope("clusterresource"))
+		go leaderelection.RunWhileLeading(ctx, clusterResourceElector, leaderelection.RoleClusterResource,
+			clusterResourceController.Run)
+	}
+	go func() {
+		err := schedulerElector.Run(ctx, func(ctx context.Context) {
+			scheduleExecutor, err := newScheduleExecutor(ctx, configuration, scope.NewSubScope("flytescheduler"))
+			if err != nil {
+				logger.Fatalf(ctx, "Failed to create the scheduler: %v", err)
+			}
+			if err := scheduleExecutor.Run(ctx); err != nil && ctx.Err() == nil {
+				logger.Fatalf(ctx, "Failed to run the scheduler: %v", err)
+			}
+		})
+		if err != nil {
+			logger.Fatalf(ctx, "Stopped running the scheduler: %v", err)
+		}
+	}()
+	if cfg.Sandbox {
+		logger.Infof(ctx, "Running the scheduler once elected its leader, without the cluster resource controller")
+		return nil
+	}
+	logger.Infof(ctx, "Running the scheduler and cluster resource controller once elected their leaders")
+	return nil
+}
@@ -3,9 +3,14 @@ package entrypoints
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"runtime/debug"
 
+	"github.com/flyteorg/flyteadmin/pkg/async/lag"
 	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	"github.com/flyteorg/flyteadmin/pkg/leaderelection"
+	"github.com/flyteorg/flyteadmin/pkg/logging"
 	repositoryCommonConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/runtime"
 	"github.com/flyteorg/flyteadmin/scheduler"
@@ -42,10 +47,15 @@ var schedulerRunCmd = &cobra.Command{
 			}
 		}()
 
+		if err := lag.Initialize(schedulerScope.NewSubScope("async")); err != nil {
+			logger.Fatalf(ctx, "Flyte native scheduler failed to register the lag metrics due to %v", err)
+			return err
+		}
+
 		dbConfigValues := configuration.ApplicationConfiguration().GetDbConfig()
 		dbConfig := repositoryCommonConfig.NewDbConfig(dbConfigValues)
 		db := schdulerRepoConfig.GetRepository(
-			schdulerRepoConfig.POSTGRES, dbConfig, schedulerScope.NewSubScope("database"))
+			schdulerRepoConfig.GetRepoConfig(dbConfig), dbConfig, schedulerScope.NewSubScope("database"))
 
 		clientSet, err := admin.ClientSetBuilder().WithConfig(admin.GetConfig(ctx)).Build(ctx)
 		if err != nil {
@@ -62,15 +72,30 @@ var schedulerRunCmd = &cobra.Command{
 		// Serve profiling endpoints.
 		go func() {
 			err := profutils.StartProfilingServerWithDefaultHandlers(
-				ctx, schedulerConfiguration.ProfilerPort.Port, nil)
+				ctx, schedulerConfiguration.ProfilerPort.Port,
+				map[string]http.Handler{
+					logging.LevelsPath: http.HandlerFunc(logging.HandleLevels),
+					lag.LagPath:        http.HandlerFunc(lag.HandleLag),
+				})
 			if err != nil {
 				logger.Panicf(ctx, "Failed to Start profiling and Metrics server. Error, %v", err)
 			}
 		}()
 
-		err = scheduleExecutor.Run(ctx)
+		// Replicas which lose the leadership exit, since the scheduler can't be started twice in one process.
+		elector, err := leaderelection.NewElector(config.GetConfig(), dbConfig, leaderelection.RoleScheduler,
+			schedulerScope.NewSubScope("leader_election"))
 		if err != nil {
-			logger.Fatalf(ctx, "Flyte native scheduler failed to start due to %v", err)
+			logger.Fatalf(ctx, "Flyte native scheduler failed to create its leader elector due to %v", err)
+			return err
+		}
+		err = elector.Run(ctx, func(ctx context.Context) {
+			if err := scheduleExecutor.Run(ctx); err != nil && ctx.Err() == nil {
+				logger.Fatalf(ctx, "Flyte native scheduler failed to start due to %v", err)
+			}
+		})
+		if err != nil {
+			logger.Fatalf(ctx, "Flyte native scheduler stopped due to %v", err)
 			return err
 		}
 		return nil
@@ -0,0 +1,104 @@
+package cloudevent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	gizmoAWS "github.com/NYTimes/gizmo/pubsub/aws"
+	gizmoGCP "github.com/NYTimes/gizmo/pubsub/gcp"
+	gizmoKafka "github.com/NYTimes/gizmo/pubsub/kafka"
+	"github.com/flyteorg/flyteadmin/pkg/async"
+	"github.com/flyteorg/flyteadmin/pkg/async/cloudevent/implementations"
+	notificationImplementations "github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+)
+
+const (
+	Kafka   = "kafka"
+	Webhook = "webhook"
+)
+
+func newSink(name string, config runtimeInterfaces.CloudEventsSinkConfig, reconnectAttempts int,
+	reconnectDelay time.Duration) pubsub.Publisher {
+	var publisher pubsub.Publisher
+	var err error
+	switch config.Type {
+	case string(common.AWS):
+		snsConfig := gizmoAWS.SNSConfig{
+			Topic: config.TopicName,
+		}
+		snsConfig.Region = config.AWSConfig.Region
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+			publisher, err = gizmoAWS.NewPublisher(snsConfig)
+			return err
+		})
+	case string(common.GCP):
+		pubsubConfig := gizmoGCP.Config{
+			Topic: config.TopicName,
+		}
+		pubsubConfig.ProjectID = config.GCPConfig.ProjectID
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+			publisher, err = gizmoGCP.NewPublisher(context.TODO(), pubsubConfig)
+			return err
+		})
+	case Kafka:
+		if len(config.KafkaConfig.Brokers) == 0 {
+			panic(fmt.Errorf("cloud events sink [%s] is missing kafka brokers", name))
+		}
+		kafkaConfig := gizmoKafka.Config{
+			BrokerHosts: config.KafkaConfig.Brokers,
+			Topic:       config.TopicName,
+		}
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+			publisher, err = gizmoKafka.NewPublisher(&kafkaConfig)
+			return err
+		})
+	case Webhook:
+		if len(config.WebhookConfig.URL) == 0 {
+			panic(fmt.Errorf("cloud events sink [%s] is missing a webhook url", name))
+		}
+		publisher = implementations.NewWebhookPublisher(config.WebhookConfig)
+	default:
+		panic(fmt.Errorf("unsupported type [%s] for cloud events sink [%s]", config.Type, name))
+	}
+	// Any persistent errors creating a sink's client results in a failed start up.
+	if err != nil {
+		panic(err)
+	}
+	return publisher
+}
+
+// Returns a publisher which publishes execution events as CloudEvents to the configured sinks, or a no-op publisher
+// when cloud events are disabled.
+func NewCloudEventsPublisher(config runtimeInterfaces.CloudEventsConfig, scope promutils.Scope) interfaces.Publisher {
+	if !config.Enable {
+		return notificationImplementations.NewNoopPublish()
+	}
+	reconnectAttempts := config.ReconnectAttempts
+	reconnectDelay := time.Duration(config.ReconnectDelaySeconds) * time.Second
+	routes := implementations.CloudEventsRoutes{
+		DefaultSinks: config.DefaultSinks,
+		ProjectSinks: config.ProjectSinks,
+	}
+	routedSinks := append([]string{}, routes.DefaultSinks...)
+	for _, projectSinks := range routes.ProjectSinks {
+		routedSinks = append(routedSinks, projectSinks...)
+	}
+	for _, sinkName := range routedSinks {
+		if _, ok := config.Sinks[sinkName]; !ok {
+			panic(fmt.Errorf("cloud events are routed to unknown sink [%s]", sinkName))
+		}
+	}
+	sinks := make(map[string]pubsub.Publisher, len(config.Sinks))
+	for name, sinkConfig := range config.Sinks {
+		logger.Infof(context.Background(), "Publishing cloud events to [%s] sink [%s]", sinkConfig.Type, name)
+		sinks[name] = newSink(name, sinkConfig, reconnectAttempts, reconnectDelay)
+	}
+	return implementations.NewCloudEventsPublisher(config.Source, sinks, routes, config.EventTypes, scope)
+}
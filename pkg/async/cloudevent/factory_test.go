@@ -0,0 +1,80 @@
+package cloudevent
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/cloudevent/implementations"
+	notificationImplementations "github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCloudEventsPublisher_Disabled(t *testing.T) {
+	publisher := NewCloudEventsPublisher(runtimeInterfaces.CloudEventsConfig{}, promutils.NewTestScope())
+	assert.IsType(t, &notificationImplementations.NoopPublish{}, publisher)
+}
+
+func TestNewCloudEventsPublisher_Webhook(t *testing.T) {
+	publisher := NewCloudEventsPublisher(runtimeInterfaces.CloudEventsConfig{
+		Enable:     true,
+		EventTypes: []string{"all"},
+		Sinks: map[string]runtimeInterfaces.CloudEventsSinkConfig{
+			"lineage": {
+				Type: Webhook,
+				WebhookConfig: runtimeInterfaces.WebhookConfig{
+					URL: "http://lineage.example.com/events",
+				},
+			},
+		},
+		DefaultSinks: []string{"lineage"},
+	}, promutils.NewTestScope())
+	assert.IsType(t, &implementations.CloudEventsPublisher{}, publisher)
+}
+
+func TestNewCloudEventsPublisher_UnknownSink(t *testing.T) {
+	defer func() { r := recover(); assert.NotNil(t, r) }()
+	NewCloudEventsPublisher(runtimeInterfaces.CloudEventsConfig{
+		Enable: true,
+		ProjectSinks: map[string][]string{
+			"flytesnacks": {"catalog"},
+		},
+	}, promutils.NewTestScope())
+
+	// shouldn't reach here
+	t.Errorf("did not panic")
+}
+
+func TestNewCloudEventsPublisher_UnsupportedSinkType(t *testing.T) {
+	defer func() { r := recover(); assert.NotNil(t, r) }()
+	NewCloudEventsPublisher(runtimeInterfaces.CloudEventsConfig{
+		Enable: true,
+		Sinks: map[string]runtimeInterfaces.CloudEventsSinkConfig{
+			"catalog": {
+				Type: "azure",
+			},
+		},
+	}, promutils.NewTestScope())
+
+	// shouldn't reach here
+	t.Errorf("did not panic")
+}
+
+func TestNewCloudEventsPublisher_KafkaMissingBrokers(t *testing.T) {
+	defer func() {
+		r := recover()
+		assert.EqualError(t, r.(error), "cloud events sink [catalog] is missing kafka brokers")
+	}()
+	NewCloudEventsPublisher(runtimeInterfaces.CloudEventsConfig{
+		Enable: true,
+		Sinks: map[string]runtimeInterfaces.CloudEventsSinkConfig{
+			"catalog": {
+				Type:      Kafka,
+				TopicName: "flyte-events",
+			},
+		},
+	}, promutils.NewTestScope())
+
+	// shouldn't reach here
+	t.Errorf("did not panic")
+}
@@ -0,0 +1,108 @@
+package implementations
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/google/uuid"
+)
+
+const cloudEventsSpecVersion = "1.0"
+const cloudEventsDataContentType = "application/json"
+
+// A CloudEvent in the structured JSON format described by https://github.com/cloudevents/spec.
+type CloudEvent struct {
+	SpecVersion     string `json:"specversion"`
+	ID              string `json:"id"`
+	Source          string `json:"source"`
+	Type            string `json:"type"`
+	Subject         string `json:"subject,omitempty"`
+	Time            string `json:"time,omitempty"`
+	DataContentType string `json:"datacontenttype"`
+	// Extension attributes, which let subscribers filter events without parsing their data.
+	FlyteProject   string `json:"flyteproject"`
+	FlyteDomain    string `json:"flytedomain"`
+	FlyteExecution string `json:"flyteexecution"`
+	// The workflow, node or task execution event serialized as JSON.
+	Data json.RawMessage `json:"data"`
+}
+
+// Returns the type of an event for an execution phase transition, for example
+// com.flyte.workflow_execution.succeeded.
+func getCloudEventType(resource, phase string) string {
+	return fmt.Sprintf("com.flyte.%s.%s", resource, strings.ToLower(phase))
+}
+
+func getCloudEventTime(occurredAt *timestamp.Timestamp) string {
+	t, err := ptypes.Timestamp(occurredAt)
+	if err != nil {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// Request ids are optional, so events without one are given a random id instead.
+func getCloudEventID(requestID string) string {
+	if len(requestID) > 0 {
+		return requestID
+	}
+	return uuid.New().String()
+}
+
+func newCloudEvent(source, requestID, resource, phase string, executionID *core.WorkflowExecutionIdentifier,
+	subject string, occurredAt *timestamp.Timestamp, event proto.Message) (*CloudEvent, error) {
+	data, err := (&jsonpb.Marshaler{}).MarshalToString(event)
+	if err != nil {
+		return nil, err
+	}
+	return &CloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              getCloudEventID(requestID),
+		Source:          source,
+		Type:            getCloudEventType(resource, phase),
+		Subject:         subject,
+		Time:            getCloudEventTime(occurredAt),
+		DataContentType: cloudEventsDataContentType,
+		FlyteProject:    executionID.GetProject(),
+		FlyteDomain:     executionID.GetDomain(),
+		FlyteExecution:  executionID.GetName(),
+		Data:            json.RawMessage(data),
+	}, nil
+}
+
+func getExecutionSubject(executionID *core.WorkflowExecutionIdentifier) string {
+	return fmt.Sprintf("%s/%s/%s", executionID.GetProject(), executionID.GetDomain(), executionID.GetName())
+}
+
+// Converts a workflow, node or task execution event request to a CloudEvent.
+func NewCloudEvent(source string, msg proto.Message) (*CloudEvent, error) {
+	switch request := msg.(type) {
+	case *admin.WorkflowExecutionEventRequest:
+		executionID := request.GetEvent().GetExecutionId()
+		return newCloudEvent(source, request.RequestId, "workflow_execution", request.GetEvent().GetPhase().String(),
+			executionID, getExecutionSubject(executionID), request.GetEvent().GetOccurredAt(), request.Event)
+	case *admin.NodeExecutionEventRequest:
+		nodeExecutionID := request.GetEvent().GetId()
+		return newCloudEvent(source, request.RequestId, "node_execution", request.GetEvent().GetPhase().String(),
+			nodeExecutionID.GetExecutionId(),
+			fmt.Sprintf("%s/%s", getExecutionSubject(nodeExecutionID.GetExecutionId()), nodeExecutionID.GetNodeId()),
+			request.GetEvent().GetOccurredAt(), request.Event)
+	case *admin.TaskExecutionEventRequest:
+		nodeExecutionID := request.GetEvent().GetParentNodeExecutionId()
+		return newCloudEvent(source, request.RequestId, "task_execution", request.GetEvent().GetPhase().String(),
+			nodeExecutionID.GetExecutionId(),
+			fmt.Sprintf("%s/%s/%d", getExecutionSubject(nodeExecutionID.GetExecutionId()), nodeExecutionID.GetNodeId(),
+				request.GetEvent().GetRetryAttempt()),
+			request.GetEvent().GetOccurredAt(), request.Event)
+	default:
+		return nil, fmt.Errorf("unsupported cloud event message [%s]", proto.MessageName(msg))
+	}
+}
@@ -0,0 +1,139 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	Task          = "task"
+	Node          = "node"
+	Workflow      = "workflow"
+	AllTypes      = "all"
+	AllTypesShort = "*"
+)
+
+var supportedEvents = map[string]string{
+	Task:     proto.MessageName(&admin.TaskExecutionEventRequest{}),
+	Node:     proto.MessageName(&admin.NodeExecutionEventRequest{}),
+	Workflow: proto.MessageName(&admin.WorkflowExecutionEventRequest{}),
+}
+
+type cloudEventsPublisherMetrics struct {
+	Scope          promutils.Scope
+	PublishTotal   prometheus.Counter
+	PublishSuccess *prometheus.CounterVec
+	PublishError   *prometheus.CounterVec
+	ConvertError   prometheus.Counter
+}
+
+// Routes the events to publish to the sinks configured for their project.
+type CloudEventsRoutes struct {
+	DefaultSinks []string
+	ProjectSinks map[string][]string
+}
+
+func (r CloudEventsRoutes) getSinks(project string) []string {
+	if sinks, ok := r.ProjectSinks[project]; ok {
+		return sinks
+	}
+	return r.DefaultSinks
+}
+
+// Publishes workflow, node and task execution events as CloudEvents to each of the sinks routed to their project.
+type CloudEventsPublisher struct {
+	source  string
+	sinks   map[string]pubsub.Publisher
+	routes  CloudEventsRoutes
+	events  sets.String
+	metrics cloudEventsPublisherMetrics
+}
+
+// The key is the proto message name of the event request.
+func (p *CloudEventsPublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	if !p.events.Has(notificationType) {
+		return nil
+	}
+	p.metrics.PublishTotal.Inc()
+	cloudEvent, err := NewCloudEvent(p.source, msg)
+	if err != nil {
+		p.metrics.ConvertError.Inc()
+		logger.Errorf(ctx, "Failed to convert message with key [%s] to a cloud event with err: %v", notificationType, err)
+		return err
+	}
+	data, err := json.Marshal(cloudEvent)
+	if err != nil {
+		p.metrics.ConvertError.Inc()
+		logger.Errorf(ctx, "Failed to marshal cloud event [%s] with err: %v", cloudEvent.ID, err)
+		return err
+	}
+	var failedSinks []string
+	for _, sinkName := range p.routes.getSinks(cloudEvent.FlyteProject) {
+		sink, ok := p.sinks[sinkName]
+		if !ok {
+			continue
+		}
+		logger.Debugf(ctx, "Publishing cloud event [%s] of type [%s] to sink [%s]", cloudEvent.ID, cloudEvent.Type, sinkName)
+		if err := sink.PublishRaw(ctx, cloudEvent.ID, data); err != nil {
+			p.metrics.PublishError.WithLabelValues(sinkName).Inc()
+			logger.Errorf(ctx, "Failed to publish cloud event [%s] to sink [%s] with err: %v", cloudEvent.ID, sinkName, err)
+			failedSinks = append(failedSinks, sinkName)
+			continue
+		}
+		p.metrics.PublishSuccess.WithLabelValues(sinkName).Inc()
+	}
+	// Every sink is attempted even when an earlier one fails, so one unavailable sink doesn't hold up the others.
+	if len(failedSinks) > 0 {
+		return fmt.Errorf("failed to publish cloud event [%s] to sinks [%s]", cloudEvent.ID, strings.Join(failedSinks, ","))
+	}
+	return nil
+}
+
+func newCloudEventsPublisherMetrics(scope promutils.Scope) cloudEventsPublisherMetrics {
+	return cloudEventsPublisherMetrics{
+		Scope:        scope,
+		PublishTotal: scope.MustNewCounter("publish_total", "overall count of cloud events published"),
+		PublishSuccess: scope.MustNewCounterVec("publish_success",
+			"count of cloud events published to each sink", "sink"),
+		PublishError: scope.MustNewCounterVec("publish_errors",
+			"count of cloud events which failed to publish to each sink", "sink"),
+		ConvertError: scope.MustNewCounter("convert_errors",
+			"count of messages which could not be converted to cloud events"),
+	}
+}
+
+func NewCloudEventsPublisher(source string, sinks map[string]pubsub.Publisher, routes CloudEventsRoutes,
+	eventTypes []string, scope promutils.Scope) interfaces.Publisher {
+	eventSet := sets.NewString()
+	for _, event := range eventTypes {
+		if event == AllTypes || event == AllTypesShort {
+			for _, e := range supportedEvents {
+				eventSet = eventSet.Insert(e)
+			}
+			break
+		}
+		if e, found := supportedEvents[event]; found {
+			eventSet = eventSet.Insert(e)
+		} else {
+			logger.Errorf(context.Background(), "Unsupported cloud event type [%s] in the config", event)
+		}
+	}
+	return &CloudEventsPublisher{
+		source:  source,
+		sinks:   sinks,
+		routes:  routes,
+		events:  eventSet,
+		metrics: newCloudEventsPublisherMetrics(scope.NewSubScope("cloud_events_publisher")),
+	}
+}
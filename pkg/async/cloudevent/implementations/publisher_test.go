@@ -0,0 +1,170 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	"github.com/NYTimes/gizmo/pubsub/pubsubtest"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var occurredAt = time.Date(2021, time.October, 1, 12, 0, 0, 0, time.UTC)
+var occurredAtProto, _ = ptypes.TimestampProto(occurredAt)
+
+var executionID = &core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+var workflowRequest = &admin.WorkflowExecutionEventRequest{
+	RequestId: "request id",
+	Event: &event.WorkflowExecutionEvent{
+		ExecutionId: executionID,
+		Phase:       core.WorkflowExecution_SUCCEEDED,
+		OccurredAt:  occurredAtProto,
+	},
+}
+
+var taskRequest = &admin.TaskExecutionEventRequest{
+	Event: &event.TaskExecutionEvent{
+		ParentNodeExecutionId: &core.NodeExecutionIdentifier{
+			NodeId:      "node",
+			ExecutionId: executionID,
+		},
+		RetryAttempt: 1,
+		Phase:        core.TaskExecution_FAILED,
+		OccurredAt:   occurredAtProto,
+	},
+}
+
+func getPublishedCloudEvents(t *testing.T, publisher *pubsubtest.TestPublisher) []CloudEvent {
+	cloudEvents := make([]CloudEvent, len(publisher.Published))
+	for idx, published := range publisher.Published {
+		assert.NoError(t, json.Unmarshal(published.Body, &cloudEvents[idx]))
+		assert.Equal(t, cloudEvents[idx].ID, published.Key)
+	}
+	return cloudEvents
+}
+
+func TestNewCloudEvent_Workflow(t *testing.T) {
+	cloudEvent, err := NewCloudEvent("flyteadmin", workflowRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0", cloudEvent.SpecVersion)
+	assert.Equal(t, "request id", cloudEvent.ID)
+	assert.Equal(t, "flyteadmin", cloudEvent.Source)
+	assert.Equal(t, "com.flyte.workflow_execution.succeeded", cloudEvent.Type)
+	assert.Equal(t, "project/domain/name", cloudEvent.Subject)
+	assert.Equal(t, "2021-10-01T12:00:00Z", cloudEvent.Time)
+	assert.Equal(t, "project", cloudEvent.FlyteProject)
+	assert.Equal(t, "domain", cloudEvent.FlyteDomain)
+	assert.Equal(t, "name", cloudEvent.FlyteExecution)
+
+	var data map[string]interface{}
+	assert.NoError(t, json.Unmarshal(cloudEvent.Data, &data))
+	assert.Equal(t, "SUCCEEDED", data["phase"])
+}
+
+func TestNewCloudEvent_Node(t *testing.T) {
+	cloudEvent, err := NewCloudEvent("flyteadmin", &admin.NodeExecutionEventRequest{
+		RequestId: "request id",
+		Event: &event.NodeExecutionEvent{
+			Id: &core.NodeExecutionIdentifier{
+				NodeId:      "node",
+				ExecutionId: executionID,
+			},
+			Phase: core.NodeExecution_RUNNING,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "com.flyte.node_execution.running", cloudEvent.Type)
+	assert.Equal(t, "project/domain/name/node", cloudEvent.Subject)
+	// Node execution events aren't required to set when they occurred.
+	assert.Empty(t, cloudEvent.Time)
+}
+
+func TestNewCloudEvent_Task(t *testing.T) {
+	cloudEvent, err := NewCloudEvent("flyteadmin", taskRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, "com.flyte.task_execution.failed", cloudEvent.Type)
+	assert.Equal(t, "project/domain/name/node/1", cloudEvent.Subject)
+	// Events without a request id are given a generated one.
+	assert.NotEmpty(t, cloudEvent.ID)
+}
+
+func TestNewCloudEvent_Unsupported(t *testing.T) {
+	_, err := NewCloudEvent("flyteadmin", &admin.ExecutionCreateRequest{})
+	assert.Error(t, err)
+}
+
+func TestCloudEventsPublisher_Routing(t *testing.T) {
+	var lineage, catalog pubsubtest.TestPublisher
+	publisher := NewCloudEventsPublisher("flyteadmin", map[string]pubsub.Publisher{
+		"lineage": &lineage,
+		"catalog": &catalog,
+	}, CloudEventsRoutes{
+		DefaultSinks: []string{"lineage"},
+		ProjectSinks: map[string][]string{
+			"project": {"lineage", "catalog"},
+		},
+	}, []string{Workflow}, promutils.NewTestScope())
+
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(workflowRequest), workflowRequest))
+	otherProjectRequest := proto.Clone(workflowRequest).(*admin.WorkflowExecutionEventRequest)
+	otherProjectRequest.Event.ExecutionId = &core.WorkflowExecutionIdentifier{
+		Project: "other",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(otherProjectRequest), otherProjectRequest))
+
+	lineageEvents := getPublishedCloudEvents(t, &lineage)
+	assert.Len(t, lineageEvents, 2)
+	assert.Equal(t, "project", lineageEvents[0].FlyteProject)
+	assert.Equal(t, "other", lineageEvents[1].FlyteProject)
+	catalogEvents := getPublishedCloudEvents(t, &catalog)
+	assert.Len(t, catalogEvents, 1)
+	assert.Equal(t, "project", catalogEvents[0].FlyteProject)
+}
+
+func TestCloudEventsPublisher_EventTypes(t *testing.T) {
+	var sink pubsubtest.TestPublisher
+	publisher := NewCloudEventsPublisher("flyteadmin", map[string]pubsub.Publisher{
+		"sink": &sink,
+	}, CloudEventsRoutes{
+		DefaultSinks: []string{"sink"},
+	}, []string{Task}, promutils.NewTestScope())
+
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(workflowRequest), workflowRequest))
+	assert.NoError(t, publisher.Publish(context.Background(), proto.MessageName(taskRequest), taskRequest))
+	cloudEvents := getPublishedCloudEvents(t, &sink)
+	assert.Len(t, cloudEvents, 1)
+	assert.Equal(t, "com.flyte.task_execution.failed", cloudEvents[0].Type)
+}
+
+func TestCloudEventsPublisher_SinkError(t *testing.T) {
+	failing := pubsubtest.TestPublisher{
+		GivenError: errors.New("foo"),
+	}
+	var succeeding pubsubtest.TestPublisher
+	publisher := NewCloudEventsPublisher("flyteadmin", map[string]pubsub.Publisher{
+		"failing":    &failing,
+		"succeeding": &succeeding,
+	}, CloudEventsRoutes{
+		DefaultSinks: []string{"failing", "succeeding"},
+	}, []string{AllTypes}, promutils.NewTestScope())
+
+	err := publisher.Publish(context.Background(), proto.MessageName(workflowRequest), workflowRequest)
+	assert.EqualError(t, err, "failed to publish cloud event [request id] to sinks [failing]")
+	assert.Len(t, succeeding.Published, 1)
+}
@@ -0,0 +1,69 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/golang/protobuf/proto"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+const cloudEventsContentType = "application/cloudevents+json; charset=UTF-8"
+
+// Posts each message to an HTTP endpoint. Messages are expected to be structured mode cloud events.
+type WebhookPublisher struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, key string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return p.PublishRaw(ctx, key, data)
+}
+
+func (p *WebhookPublisher) PublishRaw(ctx context.Context, key string, msg []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(msg))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", cloudEventsContentType)
+	for name, value := range p.headers {
+		request.Header.Set(name, value)
+	}
+	response, err := p.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("webhook [%s] responded to event [%s] with status %d", p.url, key, response.StatusCode)
+	}
+	return nil
+}
+
+func NewWebhookPublisher(config runtimeInterfaces.WebhookConfig) pubsub.Publisher {
+	timeout := defaultWebhookTimeout
+	if config.TimeoutSeconds > 0 {
+		timeout = time.Duration(config.TimeoutSeconds) * time.Second
+	}
+	return &WebhookPublisher{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		url:     config.URL,
+		headers: config.Headers,
+	}
+}
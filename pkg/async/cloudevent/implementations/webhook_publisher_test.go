@@ -0,0 +1,46 @@
+package implementations
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookPublisher(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/cloudevents+json; charset=UTF-8", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	publisher := NewWebhookPublisher(runtimeInterfaces.WebhookConfig{
+		URL: server.URL,
+		Headers: map[string]string{
+			"Authorization": "Bearer token",
+		},
+	})
+	assert.NoError(t, publisher.PublishRaw(context.Background(), "id", []byte(`{"id":"id"}`)))
+	assert.Equal(t, `{"id":"id"}`, string(body))
+}
+
+func TestWebhookPublisher_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	publisher := NewWebhookPublisher(runtimeInterfaces.WebhookConfig{
+		URL: server.URL,
+	})
+	err := publisher.PublishRaw(context.Background(), "id", []byte(`{"id":"id"}`))
+	assert.EqualError(t, err, "webhook ["+server.URL+"] responded to event [id] with status 503")
+}
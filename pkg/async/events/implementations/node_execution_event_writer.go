@@ -5,6 +5,7 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flytestdlib/logger"
@@ -21,22 +22,65 @@ func (w *nodeExecutionEventWriter) Write(event admin.NodeExecutionEventRequest)
 	w.events <- event
 }
 
+// The most events written to the database in a single transaction.
+const maxEventWriteBatchSize = 100
+
 func (w *nodeExecutionEventWriter) Run() {
 	for event := range w.events {
+		batch := []admin.NodeExecutionEventRequest{event}
+		// Drain whatever else is already buffered so bursts of events, such as those of large fan-out workflows,
+		// are written together rather than one transaction at a time.
+	drain:
+		for len(batch) < maxEventWriteBatchSize {
+			select {
+			case event, ok := <-w.events:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, event)
+			default:
+				break drain
+			}
+		}
+		w.writeBatch(batch)
+	}
+}
+
+func (w *nodeExecutionEventWriter) writeBatch(batch []admin.NodeExecutionEventRequest) {
+	eventModels := make([]models.NodeExecutionEvent, 0, len(batch))
+	for _, event := range batch {
 		eventModel, err := transformers.CreateNodeExecutionEventModel(event)
 		if err != nil {
 			logger.Warnf(context.TODO(), "Failed to transform event [%+v] to database model with err [%+v]", event, err)
 			continue
 		}
-		err = w.db.NodeExecutionEventRepo().Create(context.TODO(), *eventModel)
-		if err != nil {
-			// It's okay to be lossy here. These events aren't used to fetch execution state but rather as a convenience
-			// to replay and understand the event execution timeline.
-			logger.Warnf(context.TODO(), "Failed to write event [%+v] to database with err [%+v]", event, err)
+		eventModels = append(eventModels, *eventModel)
+	}
+	if len(eventModels) == 0 {
+		return
+	}
+	if len(eventModels) == 1 {
+		w.write(eventModels[0])
+		return
+	}
+	if err := w.db.NodeExecutionEventRepo().BatchCreate(context.TODO(), eventModels); err != nil {
+		// A single bad event fails the whole transaction, so fall back to writing the events one by one.
+		logger.Infof(context.TODO(), "Failed to write batch of [%d] events to database with err [%+v]", len(eventModels), err)
+		for _, eventModel := range eventModels {
+			w.write(eventModel)
 		}
 	}
 }
 
+func (w *nodeExecutionEventWriter) write(eventModel models.NodeExecutionEvent) {
+	err := w.db.NodeExecutionEventRepo().Create(context.TODO(), eventModel)
+	if err != nil {
+		// It's okay to be lossy here. These events aren't used to fetch execution state but rather as a convenience
+		// to replay and understand the event execution timeline.
+		logger.Warnf(context.TODO(), "Failed to write event [%+v] to database with err [%+v]", eventModel, err)
+	}
+}
+
 func NewNodeExecutionEventWriter(db repositories.RepositoryInterface, bufferSize int) interfaces.NodeExecutionEventWriter {
 	return &nodeExecutionEventWriter{
 		db:     db,
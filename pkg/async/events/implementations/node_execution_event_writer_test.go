@@ -1,12 +1,18 @@
 package implementations
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	event2 "github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNodeExecutionEventWriter(t *testing.T) {
@@ -35,3 +41,81 @@ func TestNodeExecutionEventWriter(t *testing.T) {
 	go func() { writer.Run() }()
 	close(writer.(*nodeExecutionEventWriter).events)
 }
+
+func getNodeExecutionEventRequest(requestID string) admin.NodeExecutionEventRequest {
+	return admin.NodeExecutionEventRequest{
+		RequestId: requestID,
+		Event: &event2.NodeExecutionEvent{
+			Id: &core.NodeExecutionIdentifier{
+				NodeId: "node_id",
+				ExecutionId: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "exec_name",
+				},
+			},
+			Phase:      core.NodeExecution_RUNNING,
+			OccurredAt: ptypes.TimestampNow(),
+		},
+	}
+}
+
+func TestNodeExecutionEventWriter_WritesBufferedEventsInBatches(t *testing.T) {
+	db := mocks.NewMockRepository()
+	nodeExecEventRepo := mocks.NodeExecutionEventRepoInterface{}
+	var written []string
+	nodeExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		for _, eventModel := range args.Get(1).([]models.NodeExecutionEvent) {
+			written = append(written, eventModel.RequestID)
+		}
+	}).Return(nil)
+	db.(*mocks.MockRepository).NodeExecutionEventRepoIface = &nodeExecEventRepo
+	writer := NewNodeExecutionEventWriter(db, maxEventWriteBatchSize+1)
+	for _, requestID := range []string{"a", "b", "c"} {
+		writer.Write(getNodeExecutionEventRequest(requestID))
+	}
+	close(writer.(*nodeExecutionEventWriter).events)
+	writer.Run()
+
+	nodeExecEventRepo.AssertNumberOfCalls(t, "BatchCreate", 1)
+	nodeExecEventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	assert.Equal(t, []string{"a", "b", "c"}, written)
+}
+
+func TestNodeExecutionEventWriter_FallsBackToSingleWrites(t *testing.T) {
+	db := mocks.NewMockRepository()
+	nodeExecEventRepo := mocks.NodeExecutionEventRepoInterface{}
+	nodeExecEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Return(errors.New("duplicate key"))
+	var written []string
+	nodeExecEventRepo.OnCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		written = append(written, args.Get(1).(models.NodeExecutionEvent).RequestID)
+	}).Return(nil)
+	db.(*mocks.MockRepository).NodeExecutionEventRepoIface = &nodeExecEventRepo
+	writer := NewNodeExecutionEventWriter(db, maxEventWriteBatchSize+1)
+	writer.Write(getNodeExecutionEventRequest("a"))
+	writer.Write(getNodeExecutionEventRequest("b"))
+	close(writer.(*nodeExecutionEventWriter).events)
+	writer.Run()
+
+	nodeExecEventRepo.AssertNumberOfCalls(t, "BatchCreate", 1)
+	assert.Equal(t, []string{"a", "b"}, written)
+}
+
+func TestNodeExecutionEventWriter_SkipsInvalidEvents(t *testing.T) {
+	db := mocks.NewMockRepository()
+	nodeExecEventRepo := mocks.NodeExecutionEventRepoInterface{}
+	nodeExecEventRepo.OnCreateMatch(context.TODO(), mock.MatchedBy(func(eventModel models.NodeExecutionEvent) bool {
+		return eventModel.RequestID == "valid"
+	})).Return(nil)
+	db.(*mocks.MockRepository).NodeExecutionEventRepoIface = &nodeExecEventRepo
+	writer := NewNodeExecutionEventWriter(db, maxEventWriteBatchSize+1)
+	invalid := getNodeExecutionEventRequest("invalid")
+	invalid.Event.OccurredAt = nil
+	writer.Write(invalid)
+	writer.Write(getNodeExecutionEventRequest("valid"))
+	close(writer.(*nodeExecutionEventWriter).events)
+	writer.Run()
+
+	nodeExecEventRepo.AssertNumberOfCalls(t, "Create", 1)
+	nodeExecEventRepo.AssertNotCalled(t, "BatchCreate", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,52 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// This event writer acts to asynchronously persist the history of every event received, so that recording the history
+// doesn't hold up event processing.
+type recordedEventWriter struct {
+	db     repositories.RepositoryInterface
+	events chan models.RecordedEvent
+}
+
+func (w *recordedEventWriter) Write(event models.RecordedEvent) {
+	w.events <- event
+}
+
+func (w *recordedEventWriter) Run() {
+	for event := range w.events {
+		batch := []models.RecordedEvent{event}
+		// Drain whatever else is already buffered so bursts of events are written together.
+	drain:
+		for len(batch) < maxEventWriteBatchSize {
+			select {
+			case event, ok := <-w.events:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, event)
+			default:
+				break drain
+			}
+		}
+		if err := w.db.RecordedEventRepo().BatchCreate(context.TODO(), batch); err != nil {
+			// Like the other event writers this is lossy, since the history isn't used to fetch execution state.
+			logger.Warnf(context.TODO(), "Failed to write batch of [%d] recorded events to database with err [%+v]",
+				len(batch), err)
+		}
+	}
+}
+
+func NewRecordedEventWriter(db repositories.RepositoryInterface, bufferSize int) interfaces.RecordedEventWriter {
+	return &recordedEventWriter{
+		db:     db,
+		events: make(chan models.RecordedEvent, bufferSize),
+	}
+}
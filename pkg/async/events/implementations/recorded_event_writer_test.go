@@ -0,0 +1,49 @@
+package implementations
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecordedEventWriter(t *testing.T) {
+	db := mocks.NewMockRepository()
+	recordedEventRepo := mocks.RecordedEventRepoInterface{}
+	var written [][]string
+	recordedEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		var requestIDs []string
+		for _, event := range args.Get(1).([]models.RecordedEvent) {
+			requestIDs = append(requestIDs, event.RequestID)
+		}
+		written = append(written, requestIDs)
+	}).Return(nil)
+	db.(*mocks.MockRepository).RecordedEventRepoIface = &recordedEventRepo
+	writer := NewRecordedEventWriter(db, maxEventWriteBatchSize+2)
+	for i := 0; i < maxEventWriteBatchSize+1; i++ {
+		writer.Write(models.RecordedEvent{RequestID: "a"})
+	}
+	close(writer.(*recordedEventWriter).events)
+	writer.Run()
+
+	assert.Len(t, written, 2)
+	assert.Len(t, written[0], maxEventWriteBatchSize)
+	assert.Len(t, written[1], 1)
+}
+
+func TestRecordedEventWriter_WriteFailure(t *testing.T) {
+	db := mocks.NewMockRepository()
+	recordedEventRepo := mocks.RecordedEventRepoInterface{}
+	recordedEventRepo.OnBatchCreateMatch(mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+	db.(*mocks.MockRepository).RecordedEventRepoIface = &recordedEventRepo
+	writer := NewRecordedEventWriter(db, 2)
+	writer.Write(models.RecordedEvent{RequestID: "a"})
+	writer.Write(models.RecordedEvent{RequestID: "b"})
+	close(writer.(*recordedEventWriter).events)
+	writer.Run()
+
+	recordedEventRepo.AssertNumberOfCalls(t, "BatchCreate", 1)
+}
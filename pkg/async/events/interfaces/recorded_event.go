@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=RecordedEventWriter -output=../mocks -case=underscore
+
+type RecordedEventWriter interface {
+	Run()
+	Write(recordedEvent models.RecordedEvent)
+}
@@ -0,0 +1,24 @@
+package lag
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// The path the lag of the processors is served on by the profiling server.
+const LagPath = "/debug/lag"
+
+// Serves the lag of the processors in this process on GET.
+func HandleLag(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "the lag is read with a GET", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(GetLags()); err != nil {
+		logger.Warningf(r.Context(), "failed to write the processor lag with err: %v", err)
+	}
+}
@@ -0,0 +1,326 @@
+// Package lag tracks how far behind the asynchronous processors are: how many messages wait for each of them, how old
+// the oldest of those is, how long processing a message takes and how many messages were dead lettered. A processor
+// with messages waiting which hasn't processed any for a while is reported as stalled, which is how consumers which
+// silently stopped are caught.
+package lag
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The processors whose lag is tracked.
+const (
+	// Sends the notifications published for executions.
+	NotificationsProcessor = "notifications"
+	// Publishes execution events to the configured external topic.
+	EventsProcessor = "events"
+	// Fires the executions of schedules.
+	SchedulerProcessor = "scheduler"
+)
+
+const processorLabel = "processor"
+
+// A processor with messages waiting which hasn't processed any for this long is stalled.
+const stallThreshold = 10 * time.Minute
+
+// How far behind a processor is.
+type Lag struct {
+	Processor string `json:"processor"`
+	// The messages waiting for the processor, including those it's processing.
+	QueueDepth int64 `json:"queueDepth"`
+	// How long the oldest of the waiting messages has waited. Zero when none are.
+	OldestMessageAge time.Duration `json:"oldestMessageAge"`
+	Processed        int64         `json:"processed"`
+	// Messages which were given up on, or which failed too many times to expect them to ever be processed.
+	DeadLetters     int64      `json:"deadLetters"`
+	LastProcessedAt *time.Time `json:"lastProcessedAt,omitempty"`
+	// How long processing the last message processed took.
+	LastProcessingLatency time.Duration `json:"lastProcessingLatency"`
+	// Whether messages are waiting but none were processed for a while.
+	Stalled bool `json:"stalled"`
+}
+
+type lagMetrics struct {
+	Scope             promutils.Scope
+	ProcessingLatency *promutils.StopWatchVec
+	Processed         *prometheus.CounterVec
+	DeadLetters       *prometheus.CounterVec
+	// The queue depth and oldest message age are collected on every scrape, so that the age of a message nobody
+	// processes keeps growing.
+	QueueDepth       *prometheus.Desc
+	OldestMessageAge *prometheus.Desc
+}
+
+// Tracks the lag of a set of processors, which share the metrics they're exported with.
+type Registry struct {
+	mu       sync.Mutex
+	clock    clock.Clock
+	trackers map[string]*Tracker
+	// Nil until the registry is registered.
+	metrics *lagMetrics
+}
+
+// Tracks the lag of a single processor. Processors which observe their queue set its backlog, while those which
+// receive messages one at a time track each of them from Begin until it's done.
+type Tracker struct {
+	registry  *Registry
+	processor string
+	createdAt time.Time
+
+	backlog       int64
+	backlogOldest time.Time
+	inFlight      map[uint64]time.Time
+	nextID        uint64
+
+	processed       int64
+	deadLetters     int64
+	lastProcessedAt time.Time
+	lastLatency     time.Duration
+}
+
+// A message being processed, which ends with exactly one of Done, Failed or DeadLetter.
+type Message struct {
+	tracker   *Tracker
+	id        uint64
+	startedAt time.Time
+}
+
+// Records the backlog last observed in the processor's queue: the messages which wait in it and when the oldest of
+// them was enqueued.
+func (t *Tracker) SetBacklog(depth int64, oldestEnqueuedAt time.Time) {
+	t.registry.mu.Lock()
+	defer t.registry.mu.Unlock()
+	t.backlog = depth
+	t.backlogOldest = oldestEnqueuedAt
+}
+
+// Starts processing a message enqueued at the given time. A zero time means the message was enqueued just now.
+func (t *Tracker) Begin(enqueuedAt time.Time) *Message {
+	t.registry.mu.Lock()
+	defer t.registry.mu.Unlock()
+	now := t.registry.clock.Now()
+	if enqueuedAt.IsZero() {
+		enqueuedAt = now
+	}
+	t.nextID++
+	t.inFlight[t.nextID] = enqueuedAt
+	return &Message{
+		tracker:   t,
+		id:        t.nextID,
+		startedAt: now,
+	}
+}
+
+// Counts a message which won't be processed, without it having been tracked by Begin.
+func (t *Tracker) DeadLettered() {
+	t.registry.mu.Lock()
+	defer t.registry.mu.Unlock()
+	t.deadLetters++
+	if t.registry.metrics != nil {
+		t.registry.metrics.DeadLetters.WithLabelValues(t.processor).Inc()
+	}
+}
+
+// Must be called with the registry locked. Returns false if the message already ended.
+func (m *Message) end() bool {
+	if _, ok := m.tracker.inFlight[m.id]; !ok {
+		return false
+	}
+	delete(m.tracker.inFlight, m.id)
+	return true
+}
+
+// Ends a message which was processed.
+func (m *Message) Done() {
+	t := m.tracker
+	t.registry.mu.Lock()
+	defer t.registry.mu.Unlock()
+	if !m.end() {
+		return
+	}
+	now := t.registry.clock.Now()
+	t.processed++
+	t.lastProcessedAt = now
+	t.lastLatency = now.Sub(m.startedAt)
+	if t.registry.metrics != nil {
+		t.registry.metrics.Processed.WithLabelValues(t.processor).Inc()
+		t.registry.metrics.ProcessingLatency.WithLabelValues(t.processor).Observe(m.startedAt, now)
+	}
+}
+
+// Ends a message which failed to be processed, but which is retried or whose failure is handled elsewhere.
+func (m *Message) Failed() {
+	m.tracker.registry.mu.Lock()
+	defer m.tracker.registry.mu.Unlock()
+	m.end()
+}
+
+// Ends a message which was given up on.
+func (m *Message) DeadLetter() {
+	m.tracker.registry.mu.Lock()
+	ended := m.end()
+	m.tracker.registry.mu.Unlock()
+	if ended {
+		m.tracker.DeadLettered()
+	}
+}
+
+// Must be called with the registry locked.
+func (t *Tracker) getLag(now time.Time) Lag {
+	lag := Lag{
+		Processor:             t.processor,
+		QueueDepth:            t.backlog + int64(len(t.inFlight)),
+		Processed:             t.processed,
+		DeadLetters:           t.deadLetters,
+		LastProcessingLatency: t.lastLatency,
+	}
+	var oldest time.Time
+	if t.backlog > 0 && !t.backlogOldest.IsZero() {
+		oldest = t.backlogOldest
+	}
+	for _, enqueuedAt := range t.inFlight {
+		if oldest.IsZero() || enqueuedAt.Before(oldest) {
+			oldest = enqueuedAt
+		}
+	}
+	if !oldest.IsZero() && now.After(oldest) {
+		lag.OldestMessageAge = now.Sub(oldest)
+	}
+	lastProgress := t.createdAt
+	if !t.lastProcessedAt.IsZero() {
+		lastProcessedAt := t.lastProcessedAt
+		lag.LastProcessedAt = &lastProcessedAt
+		lastProgress = lastProcessedAt
+	}
+	lag.Stalled = lag.QueueDepth > 0 && now.Sub(lastProgress) > stallThreshold
+	return lag
+}
+
+// Returns how far behind the processor is.
+func (t *Tracker) GetLag() Lag {
+	t.registry.mu.Lock()
+	defer t.registry.mu.Unlock()
+	return t.getLag(t.registry.clock.Now())
+}
+
+// Returns the tracker of a processor, which is created on first use.
+func (r *Registry) Tracker(processor string) *Tracker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if tracker, ok := r.trackers[processor]; ok {
+		return tracker
+	}
+	tracker := &Tracker{
+		registry:  r,
+		processor: processor,
+		createdAt: r.clock.Now(),
+		inFlight:  make(map[uint64]time.Time),
+	}
+	r.trackers[processor] = tracker
+	return tracker
+}
+
+// Returns the lag of every tracked processor, ordered by processor.
+func (r *Registry) GetLags() []Lag {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.clock.Now()
+	lags := make([]Lag, 0, len(r.trackers))
+	for _, tracker := range r.trackers {
+		lags = append(lags, tracker.getLag(now))
+	}
+	sort.Slice(lags, func(i, j int) bool {
+		return lags[i].Processor < lags[j].Processor
+	})
+	return lags
+}
+
+// Implements prometheus.Collector for the queue depths and oldest message ages.
+func (r *Registry) Describe(ch chan<- *prometheus.Desc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.metrics == nil {
+		return
+	}
+	ch <- r.metrics.QueueDepth
+	ch <- r.metrics.OldestMessageAge
+}
+
+func (r *Registry) Collect(ch chan<- prometheus.Metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.metrics == nil {
+		return
+	}
+	now := r.clock.Now()
+	for _, tracker := range r.trackers {
+		lag := tracker.getLag(now)
+		ch <- prometheus.MustNewConstMetric(r.metrics.QueueDepth, prometheus.GaugeValue,
+			float64(lag.QueueDepth), tracker.processor)
+		ch <- prometheus.MustNewConstMetric(r.metrics.OldestMessageAge, prometheus.GaugeValue,
+			lag.OldestMessageAge.Seconds(), tracker.processor)
+	}
+}
+
+// Exports the lag of the registry's processors as metrics labeled by processor in the given scope.
+func (r *Registry) Register(scope promutils.Scope) error {
+	processingLatency, err := scope.NewStopWatchVec("processing_latency",
+		"time taken to process a message", time.Millisecond, processorLabel)
+	if err != nil {
+		return err
+	}
+	processed, err := scope.NewCounterVec("processed", "overall count of messages processed", processorLabel)
+	if err != nil {
+		return err
+	}
+	deadLetters, err := scope.NewCounterVec("dead_letters",
+		"overall count of messages given up on or which failed too many times to be processed", processorLabel)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.metrics = &lagMetrics{
+		Scope:             scope,
+		ProcessingLatency: processingLatency,
+		Processed:         processed,
+		DeadLetters:       deadLetters,
+		QueueDepth: prometheus.NewDesc(scope.NewScopedMetricName("queue_depth"),
+			"messages waiting to be processed, including those being processed", []string{processorLabel}, nil),
+		OldestMessageAge: prometheus.NewDesc(scope.NewScopedMetricName("oldest_message_age_seconds"),
+			"how long the oldest message waiting to be processed has waited", []string{processorLabel}, nil),
+	}
+	r.mu.Unlock()
+	return prometheus.Register(r)
+}
+
+func NewRegistry(clk clock.Clock) *Registry {
+	return &Registry{
+		clock:    clk,
+		trackers: make(map[string]*Tracker),
+	}
+}
+
+// The registry the processors in this process are tracked by.
+var defaultRegistry = NewRegistry(clock.New())
+
+// Exports the lag of the processors in this process in the given scope. Processors are tracked whether or not their
+// lag is exported.
+func Initialize(scope promutils.Scope) error {
+	return defaultRegistry.Register(scope)
+}
+
+// Returns the tracker of a processor in this process.
+func GetTracker(processor string) *Tracker {
+	return defaultRegistry.Tracker(processor)
+}
+
+// Returns the lag of the processors in this process.
+func GetLags() []Lag {
+	return defaultRegistry.GetLags()
+}
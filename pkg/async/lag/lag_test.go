@@ -0,0 +1,109 @@
+package lag
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var lagNow = time.Date(2021, time.November, 12, 12, 0, 0, 0, time.UTC)
+
+func newRegistryForTest() (*Registry, *clock.Mock) {
+	mockClock := clock.NewMock()
+	mockClock.Set(lagNow)
+	return NewRegistry(mockClock), mockClock
+}
+
+func TestTracker_Messages(t *testing.T) {
+	registry, mockClock := newRegistryForTest()
+	tracker := registry.Tracker(SchedulerProcessor)
+	assert.Same(t, tracker, registry.Tracker(SchedulerProcessor))
+
+	first := tracker.Begin(lagNow.Add(-time.Minute))
+	second := tracker.Begin(time.Time{})
+	mockClock.Add(2 * time.Second)
+	assert.Equal(t, []Lag{
+		{
+			Processor:        SchedulerProcessor,
+			QueueDepth:       2,
+			OldestMessageAge: time.Minute + 2*time.Second,
+		},
+	}, registry.GetLags())
+
+	first.Done()
+	// Ending a message again has no effect.
+	first.DeadLetter()
+	second.DeadLetter()
+	lags := registry.GetLags()
+	assert.Len(t, lags, 1)
+	assert.Equal(t, int64(0), lags[0].QueueDepth)
+	assert.Equal(t, time.Duration(0), lags[0].OldestMessageAge)
+	assert.Equal(t, int64(1), lags[0].Processed)
+	assert.Equal(t, int64(1), lags[0].DeadLetters)
+	assert.Equal(t, lagNow.Add(2*time.Second), *lags[0].LastProcessedAt)
+	assert.Equal(t, 2*time.Second, lags[0].LastProcessingLatency)
+	assert.False(t, lags[0].Stalled)
+
+	failed := tracker.Begin(time.Time{})
+	failed.Failed()
+	assert.Equal(t, int64(1), registry.GetLags()[0].Processed)
+}
+
+func TestTracker_Stalled(t *testing.T) {
+	registry, mockClock := newRegistryForTest()
+	tracker := registry.Tracker(EventsProcessor)
+	tracker.SetBacklog(3, lagNow.Add(-time.Hour))
+	assert.Equal(t, time.Hour, registry.GetLags()[0].OldestMessageAge)
+	assert.False(t, registry.GetLags()[0].Stalled)
+
+	mockClock.Add(stallThreshold + time.Second)
+	assert.True(t, registry.GetLags()[0].Stalled)
+
+	tracker.Begin(time.Time{}).Done()
+	assert.False(t, registry.GetLags()[0].Stalled)
+
+	// Processors without messages waiting aren't stalled however long ago they last processed one.
+	tracker.SetBacklog(0, time.Time{})
+	mockClock.Add(2 * stallThreshold)
+	assert.False(t, registry.GetLags()[0].Stalled)
+}
+
+func TestRegistry_Register(t *testing.T) {
+	registry, _ := newRegistryForTest()
+	registry.Tracker(NotificationsProcessor).DeadLettered()
+	registry.Tracker(EventsProcessor).SetBacklog(2, lagNow.Add(-time.Minute))
+	assert.NoError(t, registry.Register(promutils.NewTestScope()))
+
+	registry.Tracker(NotificationsProcessor).Begin(time.Time{}).Done()
+	registry.Tracker(NotificationsProcessor).DeadLettered()
+	assert.Equal(t, 1.0, testutil.ToFloat64(registry.metrics.Processed.WithLabelValues(NotificationsProcessor)))
+	// Dead letters counted before the metrics were registered are only reported by the lag.
+	assert.Equal(t, 1.0, testutil.ToFloat64(registry.metrics.DeadLetters.WithLabelValues(NotificationsProcessor)))
+	assert.Equal(t, int64(2), registry.GetLags()[1].DeadLetters)
+	// A queue depth and an oldest message age for each processor.
+	assert.Equal(t, 4, testutil.CollectAndCount(registry))
+}
+
+func TestHandleLag(t *testing.T) {
+	GetTracker(SchedulerProcessor).SetBacklog(1, time.Now())
+
+	recorder := httptest.NewRecorder()
+	HandleLag(recorder, httptest.NewRequest(http.MethodGet, LagPath, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var lags []Lag
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &lags))
+	assert.Len(t, lags, 1)
+	assert.Equal(t, SchedulerProcessor, lags[0].Processor)
+	assert.Equal(t, int64(1), lags[0].QueueDepth)
+
+	recorder = httptest.NewRecorder()
+	HandleLag(recorder, httptest.NewRequest(http.MethodPost, LagPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
@@ -0,0 +1,128 @@
+package notifications
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/golang/protobuf/ptypes"
+)
+
+const defaultDigestInterval = time.Hour
+
+// Identifies the digest an execution is summarized in, which is sent to the same recipients for either its launch plan
+// or its whole project and domain.
+func getDigestGroup(subscription runtimeInterfaces.ProjectDomainDigest, execution *admin.Execution,
+	recipients []string) string {
+	group := fmt.Sprintf("%s/%s", execution.Id.Project, execution.Id.Domain)
+	if subscription.GroupBy != runtimeInterfaces.DigestByProject {
+		group = fmt.Sprintf("%s/%s", group, execution.GetSpec().GetLaunchPlan().GetName())
+	}
+	sortedRecipients := append([]string{}, recipients...)
+	sort.Strings(sortedRecipients)
+	return fmt.Sprintf("%s|%s", group, strings.Join(sortedRecipients, ","))
+}
+
+// Converts a terminal execution event and existing execution model to an entry in the digest its email is batched
+// into. The digest is due at the end of the subscription's interval the execution finished in.
+func ToDigestEntryMessageFromWorkflowExecutionEvent(
+	config runtimeInterfaces.NotificationsDigestsConfig,
+	subscription runtimeInterfaces.ProjectDomainDigest,
+	emailNotification admin.EmailNotification,
+	request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) *interfaces.DigestEntryMessage {
+	occurredAt, err := ptypes.Timestamp(request.Event.GetOccurredAt())
+	if err != nil {
+		occurredAt = time.Now()
+	}
+	occurredAt = occurredAt.UTC()
+	interval := subscription.Interval.Duration
+	if interval <= 0 {
+		interval = defaultDigestInterval
+	}
+	return &interfaces.DigestEntryMessage{
+		Project:       execution.Id.Project,
+		Domain:        execution.Id.Domain,
+		LaunchPlan:    execution.GetSpec().GetLaunchPlan().GetName(),
+		ExecutionName: execution.Id.Name,
+		Phase:         getPhase(request, execution),
+		Error:         request.Event.GetError().GetMessage(),
+		Link:          substituteEmailParameters(config.ExecutionLink, request, execution),
+		OccurredAt:    occurredAt,
+		Recipients:    emailNotification.GetRecipientsEmail(),
+		Group:         getDigestGroup(subscription, execution, emailNotification.GetRecipientsEmail()),
+		DueAt:         occurredAt.Truncate(interval).Add(interval),
+	}
+}
+
+func getDigestSubject(entries []models.NotificationDigestEntry) string {
+	first := entries[0]
+	executions := "executions"
+	if len(entries) == 1 {
+		executions = "execution"
+	}
+	for _, entry := range entries[1:] {
+		if entry.LaunchPlan != first.LaunchPlan {
+			return fmt.Sprintf("Flyte digest: %d %s in project %s, domain %s", len(entries), executions,
+				first.Project, first.Domain)
+		}
+	}
+	return fmt.Sprintf("Flyte digest: %d %s of launch plan %s in project %s, domain %s", len(entries), executions,
+		first.LaunchPlan, first.Project, first.Domain)
+}
+
+// Summarizes how many executions ended in each phase, e.g. "3 succeeded, 1 failed".
+func getDigestPhaseCounts(entries []models.NotificationDigestEntry) string {
+	counts := make(map[string]int)
+	var phases []string
+	for _, entry := range entries {
+		if counts[entry.Phase] == 0 {
+			phases = append(phases, entry.Phase)
+		}
+		counts[entry.Phase]++
+	}
+	summaries := make([]string, len(phases))
+	for i, phase := range phases {
+		summaries[i] = fmt.Sprintf("%d %s", counts[phase], phase)
+	}
+	return strings.Join(summaries, ", ")
+}
+
+func getDigestEntryLine(entry models.NotificationDigestEntry) string {
+	name := html.EscapeString(entry.ExecutionName)
+	if len(entry.Link) > 0 {
+		name = fmt.Sprintf("<a href=\"%s\">%s</a>", html.EscapeString(entry.Link), name)
+	}
+	line := fmt.Sprintf("<li>%s of launch plan %s %s at %s", name, html.EscapeString(entry.LaunchPlan),
+		html.EscapeString(entry.Phase), entry.OccurredAt.UTC().Format(time.RFC3339))
+	if len(entry.Error) > 0 {
+		line += fmt.Sprintf(": %s", html.EscapeString(entry.Error))
+	}
+	return line + "</li>"
+}
+
+// Converts the entries of a digest, which share their group, to a single email summarizing their executions.
+func ToDigestEmailMessage(
+	config runtimeInterfaces.NotificationsConfig, entries []models.NotificationDigestEntry) *admin.EmailMessage {
+	if len(entries) == 0 {
+		return nil
+	}
+	var body strings.Builder
+	body.WriteString(fmt.Sprintf("<p>%s.</p><ul>", html.EscapeString(getDigestPhaseCounts(entries))))
+	for _, entry := range entries {
+		body.WriteString(getDigestEntryLine(entry))
+	}
+	body.WriteString("</ul>")
+	return &admin.EmailMessage{
+		SubjectLine:     getDigestSubject(entries),
+		SenderEmail:     config.NotificationsEmailerConfig.Sender,
+		RecipientsEmail: strings.Split(entries[0].Recipients, ","),
+		Body:            body.String(),
+	}
+}
@@ -0,0 +1,107 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToDigestEntryMessageFromWorkflowExecutionEvent(t *testing.T) {
+	occurredAt := time.Date(2021, time.October, 27, 9, 30, 0, 0, time.UTC)
+	occurredAtProto, _ := ptypes.TimestampProto(occurredAt)
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:      core.WorkflowExecution_FAILED,
+			OccurredAt: occurredAtProto,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Message: "uh-oh",
+				},
+			},
+		},
+	}
+	digestsConfig := runtimeInterfaces.NotificationsDigestsConfig{
+		ExecutionLink: "https://flyte.example.com/console/projects/{{ project }}/domains/{{ domain }}/executions/{{ name }}",
+	}
+	emailNotification := admin.EmailNotification{
+		RecipientsEmail: []string{"b@example.com", "a@example.com"},
+	}
+
+	message := ToDigestEntryMessageFromWorkflowExecutionEvent(digestsConfig, runtimeInterfaces.ProjectDomainDigest{},
+		emailNotification, request, workflowExecution)
+	assert.Equal(t, &interfaces.DigestEntryMessage{
+		Project:       executionProjectValue,
+		Domain:        executionDomainValue,
+		LaunchPlan:    launchPlanNameValue,
+		ExecutionName: executionNameValue,
+		Phase:         "failed",
+		Error:         "uh-oh",
+		Link:          "https://flyte.example.com/console/projects/proj/domains/prod/executions/e124",
+		OccurredAt:    occurredAt,
+		Recipients:    []string{"b@example.com", "a@example.com"},
+		Group:         "proj/prod/lp_name|a@example.com,b@example.com",
+		DueAt:         time.Date(2021, time.October, 27, 10, 0, 0, 0, time.UTC),
+	}, message)
+
+	message = ToDigestEntryMessageFromWorkflowExecutionEvent(digestsConfig, runtimeInterfaces.ProjectDomainDigest{
+		GroupBy:  runtimeInterfaces.DigestByProject,
+		Interval: config.Duration{Duration: 15 * time.Minute},
+	}, emailNotification, request, workflowExecution)
+	assert.Equal(t, "proj/prod|a@example.com,b@example.com", message.Group)
+	assert.Equal(t, time.Date(2021, time.October, 27, 9, 45, 0, 0, time.UTC), message.DueAt)
+}
+
+func TestToDigestEmailMessage(t *testing.T) {
+	notificationsConfig := runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Sender: "flyte@example.com",
+		},
+	}
+	entries := []models.NotificationDigestEntry{
+		{
+			Project:       "proj",
+			Domain:        "prod",
+			LaunchPlan:    "lp",
+			ExecutionName: "e1",
+			Phase:         "succeeded",
+			Link:          "https://flyte.example.com/e1",
+			OccurredAt:    time.Date(2021, time.October, 27, 9, 30, 0, 0, time.UTC),
+			Recipients:    "a@example.com,b@example.com",
+		},
+		{
+			Project:       "proj",
+			Domain:        "prod",
+			LaunchPlan:    "lp",
+			ExecutionName: "e2",
+			Phase:         "failed",
+			Error:         "<oom>",
+			OccurredAt:    time.Date(2021, time.October, 27, 9, 45, 0, 0, time.UTC),
+			Recipients:    "a@example.com,b@example.com",
+		},
+	}
+
+	assert.Equal(t, &admin.EmailMessage{
+		SubjectLine:     "Flyte digest: 2 executions of launch plan lp in project proj, domain prod",
+		SenderEmail:     "flyte@example.com",
+		RecipientsEmail: []string{"a@example.com", "b@example.com"},
+		Body: "<p>1 succeeded, 1 failed.</p><ul>" +
+			"<li><a href=\"https://flyte.example.com/e1\">e1</a> of launch plan lp succeeded at 2021-10-27T09:30:00Z</li>" +
+			"<li>e2 of launch plan lp failed at 2021-10-27T09:45:00Z: &lt;oom&gt;</li></ul>",
+	}, ToDigestEmailMessage(notificationsConfig, entries))
+
+	entries[1].LaunchPlan = "other_lp"
+	assert.Equal(t, "Flyte digest: 2 executions in project proj, domain prod",
+		ToDigestEmailMessage(notificationsConfig, entries).SubjectLine)
+	assert.Equal(t, "Flyte digest: 1 execution of launch plan lp in project proj, domain prod",
+		ToDigestEmailMessage(notificationsConfig, entries[:1]).SubjectLine)
+	assert.Nil(t, ToDigestEmailMessage(notificationsConfig, nil))
+}
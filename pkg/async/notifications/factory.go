@@ -9,6 +9,7 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flytestdlib/logger"
 
@@ -48,6 +49,8 @@ func GetEmailer(config runtimeInterfaces.NotificationsConfig, scope promutils.Sc
 		switch config.NotificationsEmailerConfig.EmailerConfig.ServiceName {
 		case implementations.Sendgrid:
 			return implementations.NewSendGridEmailer(config, scope)
+		case implementations.SMTP:
+			return implementations.NewSMTPEmailer(config, scope)
 		default:
 			panic(fmt.Errorf("No matching email implementation for %s", config.NotificationsEmailerConfig.EmailerConfig.ServiceName))
 		}
@@ -74,7 +77,42 @@ func GetEmailer(config runtimeInterfaces.NotificationsConfig, scope promutils.Sc
 	}
 }
 
-func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Processor {
+// Returns a sender for each chat platform, which post the webhook messages published for their platform.
+func GetWebhookSenders(config runtimeInterfaces.NotificationsConfig) map[string]interfaces.WebhookSender {
+	return map[string]interfaces.WebhookSender{
+		implementations.Slack: implementations.NewSlackSender(config.NotificationsWebhooksConfig),
+		implementations.Teams: implementations.NewTeamsSender(config.NotificationsWebhooksConfig),
+	}
+}
+
+// Returns a sender for each paging service, which open and resolve the incidents published for their service.
+func GetIncidentSenders(config runtimeInterfaces.NotificationsConfig) map[string]interfaces.IncidentSender {
+	return map[string]interfaces.IncidentSender{
+		implementations.PagerDuty: implementations.NewPagerDutySender(config.NotificationsIncidentsConfig),
+		implementations.Opsgenie:  implementations.NewOpsgenieSender(config.NotificationsIncidentsConfig),
+	}
+}
+
+// Returns the deliverer of executions to registered webhooks, if delivering to them is enabled.
+func GetWebhookDeliverer(
+	config runtimeInterfaces.NotificationsConfig, db repositories.RepositoryInterface) interfaces.WebhookDeliverer {
+	if !config.RegisteredWebhooksConfig.Enabled {
+		return nil
+	}
+	return implementations.NewWebhookDeliverer(db.WebhookRepo(), config.RegisteredWebhooksConfig)
+}
+
+// Returns the recorder of executions batched into digests, if any emails are batched into digests.
+func GetDigestRecorder(
+	config runtimeInterfaces.NotificationsConfig, db repositories.RepositoryInterface) interfaces.DigestRecorder {
+	if len(config.NotificationsDigestsConfig.Subscriptions) == 0 {
+		return nil
+	}
+	return implementations.NewDigestRecorder(db.NotificationDigestRepo())
+}
+
+func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, db repositories.RepositoryInterface,
+	scope promutils.Scope) interfaces.Processor {
 	reconnectAttempts := config.ReconnectAttempts
 	reconnectDelay := time.Duration(config.ReconnectDelaySeconds) * time.Second
 	var sub pubsub.Subscriber
@@ -103,7 +141,8 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 			panic(err)
 		}
 		emailer = GetEmailer(config, scope)
-		return implementations.NewProcessor(sub, emailer, scope)
+		return implementations.NewProcessor(sub, emailer, GetWebhookSenders(config), GetIncidentSenders(config),
+			GetWebhookDeliverer(config, db), db.NotificationDeliveryRepo(), GetDigestRecorder(config, db), scope)
 	case common.GCP:
 		projectID := config.GCPConfig.ProjectID
 		subscription := config.NotificationsProcessorConfig.QueueName
@@ -119,7 +158,8 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 			panic(err)
 		}
 		emailer = GetEmailer(config, scope)
-		return implementations.NewGcpProcessor(sub, emailer, scope)
+		return implementations.NewGcpProcessor(sub, emailer, GetWebhookSenders(config), GetIncidentSenders(config),
+			GetWebhookDeliverer(config, db), db.NotificationDeliveryRepo(), GetDigestRecorder(config, db), scope)
 	case common.Local:
 		fallthrough
 	default:
@@ -8,23 +8,24 @@ import (
 
 	"github.com/NYTimes/gizmo/pubsub"
 	"github.com/flyteorg/flyteadmin/pkg/async"
+	"github.com/flyteorg/flyteadmin/pkg/async/lag"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
-	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/promutils"
-	"github.com/golang/protobuf/proto"
 )
 
 // TODO: Add a counter that encompasses the publisher stats grouped by project and domain.
 type Processor struct {
 	sub           pubsub.Subscriber
-	email         interfaces.Emailer
+	sender        notificationSender
 	systemMetrics processorSystemMetrics
+	lag           *lag.Tracker
 }
 
-// Currently only email is the supported notification because slack and pagerduty both use
-// email client to trigger those notifications.
-// When Pagerduty and other notifications are supported, a publisher per type should be created.
+// Notifications are sent as emails, except for those published as webhook or incident messages, which are sent by the
+// sender for their chat platform or paging service, and deliveries to registered webhooks. The delivery of tracked
+// notifications is recorded.
 func (p *Processor) StartProcessing() {
 	for {
 		logger.Warningf(context.Background(), "Starting notifications processor")
@@ -35,10 +36,10 @@ func (p *Processor) StartProcessing() {
 }
 
 func (p *Processor) run() error {
-	var emailMessage admin.EmailMessage
 	var err error
 	for msg := range p.sub.Start() {
 		p.systemMetrics.MessageTotal.Inc()
+		message := p.lag.Begin(time.Time{})
 		// Currently this is safe because Gizmo takes a string and casts it to a byte array.
 		stringMsg := string(msg.Message())
 
@@ -51,6 +52,7 @@ func (p *Processor) run() error {
 			p.systemMetrics.MessageDecodingError.Inc()
 			logger.Errorf(context.Background(), "failed to unmarshall JSON message [%s] from processor with err: %v", stringMsg, err)
 			p.markMessageDone(msg)
+			message.DeadLetter()
 			continue
 		}
 
@@ -62,6 +64,7 @@ func (p *Processor) run() error {
 			logger.Errorf(context.Background(), "failed to retrieve message from unmarshalled JSON object [%s]", stringMsg)
 			p.systemMetrics.MessageDataError.Inc()
 			p.markMessageDone(msg)
+			message.DeadLetter()
 			continue
 		}
 
@@ -69,6 +72,7 @@ func (p *Processor) run() error {
 			p.systemMetrics.MessageDataError.Inc()
 			logger.Errorf(context.Background(), "failed to retrieve notification message (in string format) from unmarshalled JSON object for message [%s]", stringMsg)
 			p.markMessageDone(msg)
+			message.DeadLetter()
 			continue
 		}
 
@@ -79,21 +83,26 @@ func (p *Processor) run() error {
 			logger.Errorf(context.Background(), "failed to Base64 decode from message string [%s] from message [%s] with err: %v", valueString, stringMsg, err)
 			p.systemMetrics.MessageDecodingError.Inc()
 			p.markMessageDone(msg)
+			message.DeadLetter()
 			continue
 		}
 
-		if err = proto.Unmarshal(notificationBytes, &emailMessage); err != nil {
+		notification, err := decodeNotification(notificationBytes)
+		if err != nil {
 			logger.Debugf(context.Background(), "failed to unmarshal to notification object from decoded string[%s] from message [%s] with err: %v", valueString, stringMsg, err)
 			p.systemMetrics.MessageDecodingError.Inc()
 			p.markMessageDone(msg)
+			message.DeadLetter()
 			continue
 		}
 
-		if err = p.email.SendEmail(context.Background(), emailMessage); err != nil {
+		if err = p.sender.send(context.Background(), notification); err != nil {
 			p.systemMetrics.MessageProcessorError.Inc()
-			logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v", emailMessage.String(), err)
+			logger.Errorf(context.Background(), "Error sending a notification for message [%s] with err: %v", stringMsg, err)
+			message.DeadLetter()
 		} else {
 			p.systemMetrics.MessageSuccess.Inc()
+			message.Done()
 		}
 
 		p.markMessageDone(msg)
@@ -129,10 +138,21 @@ func (p *Processor) StopProcessing() error {
 	return err
 }
 
-func NewProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, scope promutils.Scope) interfaces.Processor {
+func NewProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer,
+	webhookSenders map[string]interfaces.WebhookSender, incidentSenders map[string]interfaces.IncidentSender,
+	webhookDeliverer interfaces.WebhookDeliverer, deliveryRepo repoInterfaces.NotificationDeliveryRepoInterface,
+	digestRecorder interfaces.DigestRecorder, scope promutils.Scope) interfaces.Processor {
 	return &Processor{
-		sub:           sub,
-		email:         emailer,
+		sub: sub,
+		sender: notificationSender{
+			email:            emailer,
+			webhookSenders:   webhookSenders,
+			incidentSenders:  incidentSenders,
+			webhookDeliverer: webhookDeliverer,
+			deliveryRepo:     deliveryRepo,
+			digestRecorder:   digestRecorder,
+		},
 		systemMetrics: newProcessorSystemMetrics(scope.NewSubScope("processor")),
+		lag:           lag.GetTracker(lag.NotificationsProcessor),
 	}
 }
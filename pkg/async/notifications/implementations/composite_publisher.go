@@ -0,0 +1,30 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/golang/protobuf/proto"
+)
+
+// Publishes each message with every one of a set of publishers.
+type CompositePublisher struct {
+	publishers []interfaces.Publisher
+}
+
+// Every publisher is attempted, and the first error encountered is returned.
+func (p *CompositePublisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	var firstErr error
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, notificationType, msg); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func NewCompositePublisher(publishers ...interfaces.Publisher) interfaces.Publisher {
+	return &CompositePublisher{
+		publishers: publishers,
+	}
+}
@@ -0,0 +1,30 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompositePublisher(t *testing.T) {
+	var published []string
+	failing := mocks.MockPublisher{}
+	failing.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = append(published, "failing")
+		return errors.New("foo")
+	})
+	succeeding := mocks.MockPublisher{}
+	succeeding.SetPublishCallback(func(ctx context.Context, key string, msg proto.Message) error {
+		published = append(published, "succeeding")
+		return nil
+	})
+
+	publisher := NewCompositePublisher(&failing, &succeeding)
+	err := publisher.Publish(context.Background(), proto.MessageName(taskRequest), taskRequest)
+	assert.EqualError(t, err, "foo")
+	assert.Equal(t, []string{"failing", "succeeding"}, published)
+}
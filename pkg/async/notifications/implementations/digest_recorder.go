@@ -0,0 +1,37 @@
+package implementations
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Records executions batched into digests, which are sent once they're due.
+type digestRecorder struct {
+	repo repoInterfaces.NotificationDigestRepoInterface
+}
+
+func (r *digestRecorder) Record(ctx context.Context, message interfaces.DigestEntryMessage) error {
+	return r.repo.Create(ctx, models.NotificationDigestEntry{
+		Project:       message.Project,
+		Domain:        message.Domain,
+		LaunchPlan:    message.LaunchPlan,
+		ExecutionName: message.ExecutionName,
+		Phase:         message.Phase,
+		Error:         message.Error,
+		Link:          message.Link,
+		OccurredAt:    message.OccurredAt,
+		Recipients:    strings.Join(message.Recipients, ","),
+		GroupKey:      message.Group,
+		DueAt:         message.DueAt,
+	})
+}
+
+func NewDigestRecorder(repo repoInterfaces.NotificationDigestRepoInterface) interfaces.DigestRecorder {
+	return &digestRecorder{
+		repo: repo,
+	}
+}
@@ -4,4 +4,5 @@ type ExternalEmailer = string
 
 const (
 	Sendgrid ExternalEmailer = "sendgrid"
+	SMTP     ExternalEmailer = "smtp"
 )
@@ -2,11 +2,13 @@ package implementations
 
 import (
 	"context"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 
+	"github.com/flyteorg/flyteadmin/pkg/async/lag"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 
 	"github.com/NYTimes/gizmo/pubsub"
@@ -28,6 +30,7 @@ type EventPublisher struct {
 	pub           pubsub.Publisher
 	systemMetrics eventPublisherSystemMetrics
 	events        sets.String
+	lag           *lag.Tracker
 }
 
 var taskExecutionReq admin.TaskExecutionEventRequest
@@ -57,12 +60,16 @@ func (p *EventPublisher) Publish(ctx context.Context, notificationType string, m
 	}
 	logger.Debugf(ctx, "Publishing the following message [%+v]", msg)
 
+	message := p.lag.Begin(time.Time{})
 	err := p.pub.Publish(ctx, notificationType, msg)
 	if err != nil {
 		p.systemMetrics.PublishError.Inc()
 		logger.Errorf(ctx, "Failed to publish a message with key [%s] and message [%s] and error: %v", notificationType, msg.String(), err)
+		// Events relayed from the outbox are retried, and the relay counts those which fail too often.
+		message.Failed()
 	} else {
 		p.systemMetrics.PublishSuccess.Inc()
+		message.Done()
 	}
 	return err
 }
@@ -101,5 +108,6 @@ func NewEventsPublisher(pub pubsub.Publisher, scope promutils.Scope, eventTypes
 		pub:           pub,
 		systemMetrics: newEventPublisherSystemMetrics(scope.NewSubScope("events_publisher")),
 		events:        eventSet,
+		lag:           lag.GetTracker(lag.EventsProcessor),
 	}
 }
@@ -6,25 +6,37 @@ import (
 
 	"github.com/NYTimes/gizmo/pubsub"
 	"github.com/flyteorg/flyteadmin/pkg/async"
+	"github.com/flyteorg/flyteadmin/pkg/async/lag"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
-	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/promutils"
-	"github.com/golang/protobuf/proto"
 )
 
 // TODO: Add a counter that encompasses the publisher stats grouped by project and domain.
 type GcpProcessor struct {
 	sub           pubsub.Subscriber
-	email         interfaces.Emailer
+	sender        notificationSender
 	systemMetrics processorSystemMetrics
+	lag           *lag.Tracker
 }
 
-func NewGcpProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer, scope promutils.Scope) interfaces.Processor {
+func NewGcpProcessor(sub pubsub.Subscriber, emailer interfaces.Emailer,
+	webhookSenders map[string]interfaces.WebhookSender, incidentSenders map[string]interfaces.IncidentSender,
+	webhookDeliverer interfaces.WebhookDeliverer, deliveryRepo repoInterfaces.NotificationDeliveryRepoInterface,
+	digestRecorder interfaces.DigestRecorder, scope promutils.Scope) interfaces.Processor {
 	return &GcpProcessor{
-		sub:           sub,
-		email:         emailer,
+		sub: sub,
+		sender: notificationSender{
+			email:            emailer,
+			webhookSenders:   webhookSenders,
+			incidentSenders:  incidentSenders,
+			webhookDeliverer: webhookDeliverer,
+			deliveryRepo:     deliveryRepo,
+			digestRecorder:   digestRecorder,
+		},
 		systemMetrics: newProcessorSystemMetrics(scope.NewSubScope("gcp_processor")),
+		lag:           lag.GetTracker(lag.NotificationsProcessor),
 	}
 }
 
@@ -38,23 +50,26 @@ func (p *GcpProcessor) StartProcessing() {
 }
 
 func (p *GcpProcessor) run() error {
-	var emailMessage admin.EmailMessage
-
 	for msg := range p.sub.Start() {
 		p.systemMetrics.MessageTotal.Inc()
+		message := p.lag.Begin(time.Time{})
 
-		if err := proto.Unmarshal(msg.Message(), &emailMessage); err != nil {
+		notification, err := decodeNotification(msg.Message())
+		if err != nil {
 			logger.Debugf(context.Background(), "failed to unmarshal to notification object message [%s] with err: %v", string(msg.Message()), err)
 			p.systemMetrics.MessageDecodingError.Inc()
 			p.markMessageDone(msg)
+			message.DeadLetter()
 			continue
 		}
 
-		if err := p.email.SendEmail(context.Background(), emailMessage); err != nil {
+		if err := p.sender.send(context.Background(), notification); err != nil {
 			p.systemMetrics.MessageProcessorError.Inc()
-			logger.Errorf(context.Background(), "Error sending an email message for message [%s] with emailM with err: %v", emailMessage.String(), err)
+			logger.Errorf(context.Background(), "Error sending a notification for message [%s] with err: %v", string(msg.Message()), err)
+			message.DeadLetter()
 		} else {
 			p.systemMetrics.MessageSuccess.Inc()
+			message.Done()
 		}
 
 		p.markMessageDone(msg)
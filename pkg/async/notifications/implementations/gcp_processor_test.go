@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/NYTimes/gizmo/pubsub/pubsubtest"
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/lag"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flytestdlib/promutils"
@@ -31,7 +34,7 @@ func TestGcpProcessor_StartProcessing(t *testing.T) {
 	initializeGcpSubscriber()
 	testGcpSubscriber.ProtoMessages = append(testGcpSubscriber.ProtoMessages, testSubscriberProtoMessages...)
 
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, nil, nil, nil, nil, promutils.NewTestScope())
 
 	sendEmailValidationFunc := func(ctx context.Context, email admin.EmailMessage) error {
 		assert.Equal(t, email.Body, testEmail.Body)
@@ -50,10 +53,35 @@ func TestGcpProcessor_StartProcessing(t *testing.T) {
 	assert.Equal(t, "counter:<value:1 > ", m.String())
 }
 
+func TestGcpProcessor_StartProcessingWebhookMessage(t *testing.T) {
+	initializeGcpSubscriber()
+	message := testWebhookMessage
+	message.Type = Slack
+	wrapped, err := NewWebhookNotification(message)
+	assert.Nil(t, err)
+	testGcpSubscriber.ProtoMessages = append(testGcpSubscriber.ProtoMessages, wrapped)
+
+	slackSender := &testWebhookSender{}
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, map[string]interfaces.WebhookSender{
+		Slack: slackSender,
+	}, nil, nil, nil, nil, promutils.NewTestScope())
+	mockGcpEmailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		t.Fatal("webhook messages shouldn't be emailed")
+		return nil
+	})
+	assert.Nil(t, testGcpProcessor.(*GcpProcessor).run())
+	assert.Equal(t, []interfaces.WebhookMessage{message}, slackSender.messages)
+
+	m := &dto.Metric{}
+	err = testGcpProcessor.(*GcpProcessor).systemMetrics.MessageSuccess.Write(m)
+	assert.Nil(t, err)
+	assert.Equal(t, "counter:<value:1 > ", m.String())
+}
+
 func TestGcpProcessor_StartProcessingNoMessages(t *testing.T) {
 	initializeGcpSubscriber()
 
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, nil, nil, nil, nil, promutils.NewTestScope())
 
 	// Expect no errors are returned.
 	assert.Nil(t, testGcpProcessor.(*GcpProcessor).run())
@@ -73,7 +101,7 @@ func TestGcpProcessor_StartProcessingError(t *testing.T) {
 	// Err() is checked before Run() returning.
 	testGcpSubscriber.GivenErrError = ret
 
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, nil, nil, nil, nil, promutils.NewTestScope())
 	assert.Equal(t, ret, testGcpProcessor.(*GcpProcessor).run())
 }
 
@@ -86,7 +114,9 @@ func TestGcpProcessor_StartProcessingEmailError(t *testing.T) {
 	mockGcpEmailer.SetSendEmailFunc(sendEmailErrorFunc)
 	testGcpSubscriber.ProtoMessages = append(testGcpSubscriber.ProtoMessages, testSubscriberProtoMessages...)
 
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, nil, nil, nil, nil, promutils.NewTestScope())
+	tracker := lag.NewRegistry(clock.NewMock()).Tracker(lag.NotificationsProcessor)
+	testGcpProcessor.(*GcpProcessor).lag = tracker
 
 	// Even if there is an error in sending an email StartProcessing will return no errors.
 	assert.Nil(t, testGcpProcessor.(*GcpProcessor).run())
@@ -96,11 +126,14 @@ func TestGcpProcessor_StartProcessingEmailError(t *testing.T) {
 	err := testGcpProcessor.(*GcpProcessor).systemMetrics.MessageProcessorError.Write(m)
 	assert.Nil(t, err)
 	assert.Equal(t, "counter:<value:1 > ", m.String())
+	// Notifications which fail to send aren't retried.
+	assert.Equal(t, int64(1), tracker.GetLag().DeadLetters)
+	assert.Equal(t, int64(0), tracker.GetLag().QueueDepth)
 }
 
 func TestGcpProcessor_StopProcessing(t *testing.T) {
 	initializeGcpSubscriber()
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, nil, nil, nil, nil, promutils.NewTestScope())
 	assert.Nil(t, testGcpProcessor.StopProcessing())
 }
 
@@ -108,6 +141,6 @@ func TestGcpProcessor_StopProcessingError(t *testing.T) {
 	initializeGcpSubscriber()
 	stopError := errors.New("stop() returns an error")
 	testGcpSubscriber.GivenStopError = stopError
-	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, promutils.NewTestScope())
+	testGcpProcessor := NewGcpProcessor(&testGcpSubscriber, &mockGcpEmailer, nil, nil, nil, nil, nil, promutils.NewTestScope())
 	assert.Equal(t, stopError, testGcpProcessor.StopProcessing())
 }
@@ -0,0 +1,240 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+type IncidentType = string
+
+const (
+	PagerDuty IncidentType = "pagerDuty"
+	Opsgenie  IncidentType = "opsgenie"
+)
+
+const (
+	defaultDeduplicationWindow = time.Hour
+	defaultIncidentTimeout     = 10 * time.Second
+	defaultPagerDutyURL        = "https://events.pagerduty.com"
+	defaultOpsgenieURL         = "https://api.opsgenie.com"
+	incidentSource             = "flyteadmin"
+	// Opsgenie truncates longer alert messages.
+	opsgenieMaxMessageLength = 130
+)
+
+// Builds the request which opens or resolves an incident with a paging service's API.
+type newIncidentRequest func(ctx context.Context, baseURL, apiKey string, message interfaces.IncidentMessage) (
+	*http.Request, error)
+
+// Opens and resolves incidents in a single paging service configured for their project and domain.
+type incidentSender struct {
+	incidentType        IncidentType
+	defaultURL          string
+	client              *http.Client
+	config              runtimeInterfaces.NotificationsIncidentsConfig
+	newRequest          newIncidentRequest
+	clock               clock.Clock
+	deduplicationWindow time.Duration
+	// When each incident key last paged.
+	lastTriggered map[string]time.Time
+	mutex         sync.Mutex
+}
+
+// Returns whether an incident key paged within the deduplication window.
+func (s *incidentSender) isDuplicate(key string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	lastTriggered, ok := s.lastTriggered[key]
+	return ok && s.clock.Since(lastTriggered) < s.deduplicationWindow
+}
+
+func (s *incidentSender) recordTriggered(key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.clock.Now()
+	// Forget keys outside of the window, so that keys which stop failing don't accumulate.
+	for otherKey, lastTriggered := range s.lastTriggered {
+		if now.Sub(lastTriggered) >= s.deduplicationWindow {
+			delete(s.lastTriggered, otherKey)
+		}
+	}
+	s.lastTriggered[key] = now
+}
+
+func readIncidentAPIKey(endpoint runtimeInterfaces.ProjectDomainIncidents) (string, error) {
+	if endpoint.APIKeyEnvVar != "" {
+		return os.Getenv(endpoint.APIKeyEnvVar), nil
+	}
+	apiKeyFile, err := ioutil.ReadFile(endpoint.APIKeyFilePath)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(apiKeyFile)), nil
+}
+
+func (s *incidentSender) Send(ctx context.Context, message interfaces.IncidentMessage) error {
+	endpoint, ok := s.config.GetEndpoint(message.Project, message.Domain)
+	if !ok || endpoint.Type != s.incidentType {
+		return fmt.Errorf("no %s incidents configured for project [%s] and domain [%s]",
+			s.incidentType, message.Project, message.Domain)
+	}
+	if message.Action == interfaces.TriggerIncident && s.isDuplicate(message.Key) {
+		logger.Debugf(ctx, "not paging for incident [%s] which paged within the last %v",
+			message.Key, s.deduplicationWindow)
+		return nil
+	}
+	apiKey, err := readIncidentAPIKey(endpoint)
+	if err != nil {
+		return err
+	}
+	baseURL := s.defaultURL
+	if len(endpoint.URL) > 0 {
+		baseURL = strings.TrimSuffix(endpoint.URL, "/")
+	}
+	request, err := s.newRequest(ctx, baseURL, apiKey, message)
+	if err != nil {
+		return err
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with status %d to %s incident [%s]",
+			s.incidentType, response.StatusCode, message.Action, message.Key)
+	}
+	if message.Action == interfaces.TriggerIncident {
+		s.recordTriggered(message.Key)
+	}
+	return nil
+}
+
+func newJSONRequest(ctx context.Context, url string, payload interface{}) (*http.Request, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return request, nil
+}
+
+// Sends an event to the PagerDuty Events API v2, which deduplicates events by their key.
+func newPagerDutyRequest(ctx context.Context, baseURL, apiKey string, message interfaces.IncidentMessage) (
+	*http.Request, error) {
+	event := map[string]interface{}{
+		"routing_key":  apiKey,
+		"event_action": message.Action,
+		"dedup_key":    message.Key,
+	}
+	if message.Action == interfaces.TriggerIncident {
+		event["payload"] = map[string]interface{}{
+			"summary":  message.Summary,
+			"source":   incidentSource,
+			"severity": "error",
+			"custom_details": map[string]interface{}{
+				"project": message.Project,
+				"domain":  message.Domain,
+			},
+		}
+		if len(message.Link) > 0 {
+			event["links"] = []map[string]interface{}{
+				{"href": message.Link, "text": "View execution"},
+			}
+		}
+	}
+	return newJSONRequest(ctx, baseURL+"/v2/enqueue", event)
+}
+
+// Creates or closes an Opsgenie alert, using the incident key as the alert's alias, which Opsgenie deduplicates
+// alerts by.
+func newOpsgenieRequest(ctx context.Context, baseURL, apiKey string, message interfaces.IncidentMessage) (
+	*http.Request, error) {
+	var request *http.Request
+	var err error
+	if message.Action == interfaces.TriggerIncident {
+		alertMessage := message.Summary
+		if len(alertMessage) > opsgenieMaxMessageLength {
+			alertMessage = alertMessage[:opsgenieMaxMessageLength]
+		}
+		details := map[string]string{
+			"project": message.Project,
+			"domain":  message.Domain,
+		}
+		if len(message.Link) > 0 {
+			details["execution"] = message.Link
+		}
+		request, err = newJSONRequest(ctx, baseURL+"/v2/alerts", map[string]interface{}{
+			"message":     alertMessage,
+			"alias":       message.Key,
+			"description": message.Summary,
+			"source":      incidentSource,
+			"details":     details,
+		})
+	} else {
+		request, err = newJSONRequest(ctx,
+			fmt.Sprintf("%s/v2/alerts/%s/close?identifierType=alias", baseURL, url.PathEscape(message.Key)),
+			map[string]interface{}{
+				"source": incidentSource,
+			})
+	}
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", "GenieKey "+apiKey)
+	return request, nil
+}
+
+func newIncidentSender(incidentType IncidentType, defaultURL string,
+	config runtimeInterfaces.NotificationsIncidentsConfig, newRequest newIncidentRequest,
+	clock clock.Clock) interfaces.IncidentSender {
+	timeout := defaultIncidentTimeout
+	if config.TimeoutSeconds > 0 {
+		timeout = time.Duration(config.TimeoutSeconds) * time.Second
+	}
+	deduplicationWindow := defaultDeduplicationWindow
+	if config.DeduplicationWindow.Duration > 0 {
+		deduplicationWindow = config.DeduplicationWindow.Duration
+	}
+	return &incidentSender{
+		incidentType: incidentType,
+		defaultURL:   defaultURL,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		config:              config,
+		newRequest:          newRequest,
+		clock:               clock,
+		deduplicationWindow: deduplicationWindow,
+		lastTriggered:       make(map[string]time.Time),
+	}
+}
+
+func NewPagerDutySender(config runtimeInterfaces.NotificationsIncidentsConfig) interfaces.IncidentSender {
+	return newIncidentSender(PagerDuty, defaultPagerDutyURL, config, newPagerDutyRequest, clock.New())
+}
+
+func NewOpsgenieSender(config runtimeInterfaces.NotificationsIncidentsConfig) interfaces.IncidentSender {
+	return newIncidentSender(Opsgenie, defaultOpsgenieURL, config, newOpsgenieRequest, clock.New())
+}
@@ -0,0 +1,191 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const testIncidentAPIKeyEnvVar = "FLYTE_TEST_INCIDENT_API_KEY"
+
+var testIncidentMessage = interfaces.IncidentMessage{
+	Type:    PagerDuty,
+	Action:  interfaces.TriggerIncident,
+	Project: "project",
+	Domain:  "production",
+	Key:     "flyte/project/production/lp",
+	Summary: "Launch plan lp has failed in project project and domain production, in execution name.",
+	Link:    "https://flyte.example.com/console/projects/project/domains/production/executions/name",
+}
+
+type incidentRequest struct {
+	path          string
+	authorization string
+	payload       map[string]interface{}
+}
+
+// Starts a paging service which records the requests made to it.
+func newTestIncidentServer(t *testing.T, status int, requests *[]incidentRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		data, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		request := incidentRequest{
+			path:          r.URL.RequestURI(),
+			authorization: r.Header.Get("Authorization"),
+		}
+		assert.Nil(t, json.Unmarshal(data, &request.payload))
+		*requests = append(*requests, request)
+		w.WriteHeader(status)
+	}))
+}
+
+func getIncidentsConfig(incidentType IncidentType, url string) runtimeInterfaces.NotificationsIncidentsConfig {
+	return runtimeInterfaces.NotificationsIncidentsConfig{
+		DeduplicationWindow: config.Duration{Duration: 30 * time.Minute},
+		Endpoints: []runtimeInterfaces.ProjectDomainIncidents{
+			{
+				Domain:       "production",
+				Type:         incidentType,
+				APIKeyEnvVar: testIncidentAPIKeyEnvVar,
+				URL:          url,
+			},
+		},
+	}
+}
+
+func TestPagerDutySender_Send(t *testing.T) {
+	assert.Nil(t, os.Setenv(testIncidentAPIKeyEnvVar, "routing-key"))
+	defer os.Unsetenv(testIncidentAPIKeyEnvVar)
+	var requests []incidentRequest
+	server := newTestIncidentServer(t, http.StatusAccepted, &requests)
+	defer server.Close()
+
+	sender := NewPagerDutySender(getIncidentsConfig(PagerDuty, server.URL))
+	assert.Nil(t, sender.Send(context.Background(), testIncidentMessage))
+	resolve := testIncidentMessage
+	resolve.Action = interfaces.ResolveIncident
+	assert.Nil(t, sender.Send(context.Background(), resolve))
+
+	assert.Len(t, requests, 2)
+	assert.Equal(t, "/v2/enqueue", requests[0].path)
+	assert.Equal(t, "routing-key", requests[0].payload["routing_key"])
+	assert.Equal(t, "trigger", requests[0].payload["event_action"])
+	assert.Equal(t, testIncidentMessage.Key, requests[0].payload["dedup_key"])
+	payload := requests[0].payload["payload"].(map[string]interface{})
+	assert.Equal(t, testIncidentMessage.Summary, payload["summary"])
+	assert.Equal(t, "flyteadmin", payload["source"])
+	assert.Equal(t, "error", payload["severity"])
+	link := requests[0].payload["links"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, testIncidentMessage.Link, link["href"])
+
+	assert.Equal(t, "resolve", requests[1].payload["event_action"])
+	assert.Equal(t, testIncidentMessage.Key, requests[1].payload["dedup_key"])
+	assert.Nil(t, requests[1].payload["payload"])
+}
+
+func TestOpsgenieSender_Send(t *testing.T) {
+	apiKeyFile := filepath.Join(t.TempDir(), "api_key")
+	assert.Nil(t, ioutil.WriteFile(apiKeyFile, []byte("genie-key\n"), 0600))
+	var requests []incidentRequest
+	server := newTestIncidentServer(t, http.StatusAccepted, &requests)
+	defer server.Close()
+
+	incidentsConfig := getIncidentsConfig(Opsgenie, server.URL+"/")
+	incidentsConfig.Endpoints[0].APIKeyEnvVar = ""
+	incidentsConfig.Endpoints[0].APIKeyFilePath = apiKeyFile
+	sender := NewOpsgenieSender(incidentsConfig)
+	message := testIncidentMessage
+	message.Type = Opsgenie
+	message.Summary = strings.Repeat("failed ", 20)
+	assert.Nil(t, sender.Send(context.Background(), message))
+	message.Action = interfaces.ResolveIncident
+	assert.Nil(t, sender.Send(context.Background(), message))
+
+	assert.Len(t, requests, 2)
+	assert.Equal(t, "/v2/alerts", requests[0].path)
+	assert.Equal(t, "GenieKey genie-key", requests[0].authorization)
+	assert.Equal(t, testIncidentMessage.Key, requests[0].payload["alias"])
+	// Opsgenie truncates long messages, so the summary is also sent as the alert's description.
+	assert.Equal(t, message.Summary, requests[0].payload["description"])
+	assert.Equal(t, message.Summary[:opsgenieMaxMessageLength], requests[0].payload["message"])
+	details := requests[0].payload["details"].(map[string]interface{})
+	assert.Equal(t, testIncidentMessage.Link, details["execution"])
+
+	assert.Equal(t, "/v2/alerts/flyte%2Fproject%2Fproduction%2Flp/close?identifierType=alias", requests[1].path)
+	assert.Equal(t, "GenieKey genie-key", requests[1].authorization)
+}
+
+func TestIncidentSender_Deduplication(t *testing.T) {
+	var requests []incidentRequest
+	server := newTestIncidentServer(t, http.StatusAccepted, &requests)
+	defer server.Close()
+
+	mockClock := clock.NewMock()
+	sender := newIncidentSender(PagerDuty, "", getIncidentsConfig(PagerDuty, server.URL), newPagerDutyRequest,
+		mockClock)
+	resolve := testIncidentMessage
+	resolve.Action = interfaces.ResolveIncident
+	otherLaunchPlan := testIncidentMessage
+	otherLaunchPlan.Key = "flyte/project/production/other"
+
+	assert.Nil(t, sender.Send(context.Background(), testIncidentMessage))
+	assert.Nil(t, sender.Send(context.Background(), resolve))
+	mockClock.Add(29 * time.Minute)
+	// Failing again within the window doesn't page, but other launch plans still do.
+	assert.Nil(t, sender.Send(context.Background(), testIncidentMessage))
+	assert.Nil(t, sender.Send(context.Background(), otherLaunchPlan))
+	mockClock.Add(time.Minute)
+	assert.Nil(t, sender.Send(context.Background(), testIncidentMessage))
+
+	assert.Len(t, requests, 4)
+	assert.Equal(t, "trigger", requests[0].payload["event_action"])
+	assert.Equal(t, "resolve", requests[1].payload["event_action"])
+	assert.Equal(t, otherLaunchPlan.Key, requests[2].payload["dedup_key"])
+	assert.Equal(t, testIncidentMessage.Key, requests[3].payload["dedup_key"])
+}
+
+func TestIncidentSender_SendErrorStatus(t *testing.T) {
+	var requests []incidentRequest
+	server := newTestIncidentServer(t, http.StatusBadRequest, &requests)
+	defer server.Close()
+
+	sender := NewPagerDutySender(getIncidentsConfig(PagerDuty, server.URL))
+	assert.EqualError(t, sender.Send(context.Background(), testIncidentMessage),
+		"pagerDuty responded with status 400 to trigger incident [flyte/project/production/lp]")
+	// Failed pages aren't deduplicated, so that they're retried by the next failure.
+	assert.Error(t, sender.Send(context.Background(), testIncidentMessage))
+	assert.Len(t, requests, 2)
+}
+
+func TestIncidentSender_SendNotConfigured(t *testing.T) {
+	sender := NewPagerDutySender(getIncidentsConfig(Opsgenie, "https://api.opsgenie.com"))
+	assert.EqualError(t, sender.Send(context.Background(), testIncidentMessage),
+		"no pagerDuty incidents configured for project [project] and domain [production]")
+
+	message := testIncidentMessage
+	message.Domain = "development"
+	assert.EqualError(t, NewPagerDutySender(getIncidentsConfig(PagerDuty, "")).Send(context.Background(), message),
+		"no pagerDuty incidents configured for project [project] and domain [development]")
+}
+
+func TestIncidentSender_MissingAPIKeyFile(t *testing.T) {
+	incidentsConfig := getIncidentsConfig(PagerDuty, "")
+	incidentsConfig.Endpoints[0].APIKeyEnvVar = ""
+	incidentsConfig.Endpoints[0].APIKeyFilePath = filepath.Join(t.TempDir(), "missing")
+	assert.Error(t, NewPagerDutySender(incidentsConfig).Send(context.Background(), testIncidentMessage))
+}
@@ -0,0 +1,228 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+// Webhook, incident, webhook delivery and tracked messages are published wrapped in an Any with these type URLs, so
+// that processors can tell them apart from emails, which are published unwrapped.
+const (
+	webhookMessageTypeURL         = "type.flyte.org/flyteadmin.notifications.WebhookMessage"
+	incidentMessageTypeURL        = "type.flyte.org/flyteadmin.notifications.IncidentMessage"
+	webhookDeliveryMessageTypeURL = "type.flyte.org/flyteadmin.notifications.WebhookDeliveryMessage"
+	trackedNotificationTypeURL    = "type.flyte.org/flyteadmin.notifications.TrackedNotification"
+	digestEntryMessageTypeURL     = "type.flyte.org/flyteadmin.notifications.DigestEntryMessage"
+)
+
+// The types recorded for delivered emails and deliveries to registered webhooks. Webhook and incident messages are
+// recorded with the type of their chat platform or paging service.
+const (
+	emailDeliveryType             = "email"
+	registeredWebhookDeliveryType = "registeredWebhook"
+	digestDeliveryType            = "digest"
+)
+
+func wrapNotification(typeURL string, message interface{}) (*any.Any, error) {
+	value, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	return &any.Any{
+		TypeUrl: typeURL,
+		Value:   value,
+	}, nil
+}
+
+// Wraps a webhook message so that it can be published alongside email messages.
+func NewWebhookNotification(message interfaces.WebhookMessage) (*any.Any, error) {
+	return wrapNotification(webhookMessageTypeURL, message)
+}
+
+// Wraps an incident message so that it can be published alongside email messages.
+func NewIncidentNotification(message interfaces.IncidentMessage) (*any.Any, error) {
+	return wrapNotification(incidentMessageTypeURL, message)
+}
+
+// Wraps a delivery to a registered webhook so that it can be published alongside email messages.
+func NewWebhookDeliveryNotification(message interfaces.WebhookDeliveryMessage) (*any.Any, error) {
+	return wrapNotification(webhookDeliveryMessageTypeURL, message)
+}
+
+// Wraps a notification whose delivery is recorded so that it can be published alongside email messages.
+func NewTrackedNotification(message interfaces.TrackedNotification) (*any.Any, error) {
+	return wrapNotification(trackedNotificationTypeURL, message)
+}
+
+// Wraps an execution batched into a digest so that it can be published alongside email messages.
+func NewDigestEntryNotification(message interfaces.DigestEntryMessage) (*any.Any, error) {
+	return wrapNotification(digestEntryMessageTypeURL, message)
+}
+
+// A published notification, of which exactly one message is set.
+type notification struct {
+	email           *admin.EmailMessage
+	webhook         *interfaces.WebhookMessage
+	incident        *interfaces.IncidentMessage
+	webhookDelivery *interfaces.WebhookDeliveryMessage
+	tracked         *interfaces.TrackedNotification
+	digestEntry     *interfaces.DigestEntryMessage
+}
+
+// Returns how a notification is sent, which its delivery is recorded with.
+func (n notification) deliveryType() string {
+	switch {
+	case n.webhook != nil:
+		return n.webhook.Type
+	case n.incident != nil:
+		return n.incident.Type
+	case n.webhookDelivery != nil:
+		return registeredWebhookDeliveryType
+	case n.digestEntry != nil:
+		return digestDeliveryType
+	default:
+		return emailDeliveryType
+	}
+}
+
+// Sends notifications with the emailer, sender or deliverer they're meant for, and records the deliveries of tracked
+// notifications. Executions batched into digests are recorded until their digests are sent.
+type notificationSender struct {
+	email            interfaces.Emailer
+	webhookSenders   map[string]interfaces.WebhookSender
+	incidentSenders  map[string]interfaces.IncidentSender
+	webhookDeliverer interfaces.WebhookDeliverer
+	deliveryRepo     repoInterfaces.NotificationDeliveryRepoInterface
+	digestRecorder   interfaces.DigestRecorder
+}
+
+// Decodes a published notification, which is either an email or a wrapped webhook, incident, webhook delivery,
+// tracked or digest entry message.
+func decodeNotification(data []byte) (notification, error) {
+	var wrapped any.Any
+	// An email's first field is its recipients, which are never one of these type URLs.
+	if err := proto.Unmarshal(data, &wrapped); err == nil {
+		switch wrapped.TypeUrl {
+		case webhookMessageTypeURL:
+			var webhookMessage interfaces.WebhookMessage
+			if err := json.Unmarshal(wrapped.Value, &webhookMessage); err != nil {
+				return notification{}, err
+			}
+			return notification{webhook: &webhookMessage}, nil
+		case incidentMessageTypeURL:
+			var incidentMessage interfaces.IncidentMessage
+			if err := json.Unmarshal(wrapped.Value, &incidentMessage); err != nil {
+				return notification{}, err
+			}
+			return notification{incident: &incidentMessage}, nil
+		case webhookDeliveryMessageTypeURL:
+			var deliveryMessage interfaces.WebhookDeliveryMessage
+			if err := json.Unmarshal(wrapped.Value, &deliveryMessage); err != nil {
+				return notification{}, err
+			}
+			return notification{webhookDelivery: &deliveryMessage}, nil
+		case trackedNotificationTypeURL:
+			var trackedNotification interfaces.TrackedNotification
+			if err := json.Unmarshal(wrapped.Value, &trackedNotification); err != nil {
+				return notification{}, err
+			}
+			return notification{tracked: &trackedNotification}, nil
+		case digestEntryMessageTypeURL:
+			var digestEntry interfaces.DigestEntryMessage
+			if err := json.Unmarshal(wrapped.Value, &digestEntry); err != nil {
+				return notification{}, err
+			}
+			return notification{digestEntry: &digestEntry}, nil
+		}
+	}
+	var emailMessage admin.EmailMessage
+	if err := proto.Unmarshal(data, &emailMessage); err != nil {
+		return notification{}, err
+	}
+	return notification{email: &emailMessage}, nil
+}
+
+// Sends a tracked notification, recording the attempt in its delivery, which is created the first time it's sent.
+func (s *notificationSender) sendTracked(ctx context.Context, tracked interfaces.TrackedNotification) error {
+	if s.deliveryRepo == nil {
+		return fmt.Errorf("no repository to record notification deliveries in")
+	}
+	notification, err := decodeNotification(tracked.Notification)
+	if err != nil {
+		return err
+	}
+	if notification.tracked != nil {
+		return fmt.Errorf("tracked notification for execution [%s/%s/%s] wraps another tracked notification",
+			tracked.Project, tracked.Domain, tracked.ExecutionName)
+	}
+	deliveryID := tracked.DeliveryID
+	if deliveryID == 0 {
+		deliveryID, err = s.deliveryRepo.Create(ctx, models.NotificationDelivery{
+			Project:       tracked.Project,
+			Domain:        tracked.Domain,
+			ExecutionName: tracked.ExecutionName,
+			Type:          notification.deliveryType(),
+			Payload:       tracked.Notification,
+			Status:        common.DeliveryPending,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	sendErr := s.send(ctx, notification)
+	status := common.DeliverySucceeded
+	var lastError string
+	if sendErr != nil {
+		status = common.DeliveryFailed
+		lastError = sendErr.Error()
+	}
+	if err := s.deliveryRepo.RecordAttempt(ctx, deliveryID, status, lastError); err != nil {
+		logger.Errorf(ctx, "failed to record %s attempt of notification delivery [%d] with err: %v",
+			status, deliveryID, err)
+		if sendErr == nil {
+			return err
+		}
+	}
+	return sendErr
+}
+
+func (s *notificationSender) send(ctx context.Context, notification notification) error {
+	switch {
+	case notification.tracked != nil:
+		return s.sendTracked(ctx, *notification.tracked)
+	case notification.webhook != nil:
+		sender, ok := s.webhookSenders[notification.webhook.Type]
+		if !ok {
+			return fmt.Errorf("no sender for webhook messages of type [%s]", notification.webhook.Type)
+		}
+		return sender.Send(ctx, *notification.webhook)
+	case notification.incident != nil:
+		sender, ok := s.incidentSenders[notification.incident.Type]
+		if !ok {
+			return fmt.Errorf("no sender for incident messages of type [%s]", notification.incident.Type)
+		}
+		return sender.Send(ctx, *notification.incident)
+	case notification.webhookDelivery != nil:
+		if s.webhookDeliverer == nil {
+			return fmt.Errorf("no deliverer for registered webhooks")
+		}
+		return s.webhookDeliverer.Deliver(ctx, *notification.webhookDelivery)
+	case notification.digestEntry != nil:
+		if s.digestRecorder == nil {
+			return fmt.Errorf("no recorder for executions batched into digests")
+		}
+		return s.digestRecorder.Record(ctx, *notification.digestEntry)
+	default:
+		return s.email.SendEmail(ctx, *notification.email)
+	}
+}
@@ -0,0 +1,268 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repoMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type testWebhookSender struct {
+	messages []interfaces.WebhookMessage
+	err      error
+}
+
+func (s *testWebhookSender) Send(ctx context.Context, message interfaces.WebhookMessage) error {
+	s.messages = append(s.messages, message)
+	return s.err
+}
+
+type testIncidentSender struct {
+	messages []interfaces.IncidentMessage
+}
+
+func (s *testIncidentSender) Send(ctx context.Context, message interfaces.IncidentMessage) error {
+	s.messages = append(s.messages, message)
+	return nil
+}
+
+func TestDecodeNotification_Email(t *testing.T) {
+	notification, err := decodeNotification(msg)
+	assert.Nil(t, err)
+	assert.Nil(t, notification.webhook)
+	assert.Nil(t, notification.incident)
+	assert.True(t, proto.Equal(&testEmail, notification.email))
+}
+
+func TestDecodeNotification_Webhook(t *testing.T) {
+	message := testWebhookMessage
+	message.Type = Teams
+	wrapped, err := NewWebhookNotification(message)
+	assert.Nil(t, err)
+	data, err := proto.Marshal(wrapped)
+	assert.Nil(t, err)
+
+	notification, err := decodeNotification(data)
+	assert.Nil(t, err)
+	assert.Nil(t, notification.email)
+	assert.Equal(t, message, *notification.webhook)
+}
+
+func TestDecodeNotification_Incident(t *testing.T) {
+	wrapped, err := NewIncidentNotification(testIncidentMessage)
+	assert.Nil(t, err)
+	data, err := proto.Marshal(wrapped)
+	assert.Nil(t, err)
+
+	notification, err := decodeNotification(data)
+	assert.Nil(t, err)
+	assert.Nil(t, notification.email)
+	assert.Nil(t, notification.webhook)
+	assert.Equal(t, testIncidentMessage, *notification.incident)
+}
+
+func TestDecodeNotification_WebhookDelivery(t *testing.T) {
+	message := interfaces.WebhookDeliveryMessage{
+		Project:       "project",
+		Domain:        "domain",
+		WebhookName:   "alerts",
+		ExecutionName: "name",
+		Phase:         "FAILED",
+		Payload:       `{"execution": "name"}`,
+	}
+	wrapped, err := NewWebhookDeliveryNotification(message)
+	assert.Nil(t, err)
+	data, err := proto.Marshal(wrapped)
+	assert.Nil(t, err)
+
+	notification, err := decodeNotification(data)
+	assert.Nil(t, err)
+	assert.Nil(t, notification.email)
+	assert.Equal(t, message, *notification.webhookDelivery)
+}
+
+func TestDecodeNotification_Tracked(t *testing.T) {
+	tracked := interfaces.TrackedNotification{
+		DeliveryID:    3,
+		Project:       "project",
+		Domain:        "domain",
+		ExecutionName: "name",
+		Notification:  msg,
+	}
+	wrapped, err := NewTrackedNotification(tracked)
+	assert.Nil(t, err)
+	data, err := proto.Marshal(wrapped)
+	assert.Nil(t, err)
+
+	notification, err := decodeNotification(data)
+	assert.Nil(t, err)
+	assert.Nil(t, notification.email)
+	assert.Equal(t, tracked, *notification.tracked)
+}
+
+var testDigestEntryMessage = interfaces.DigestEntryMessage{
+	Project:       "project",
+	Domain:        "domain",
+	LaunchPlan:    "lp",
+	ExecutionName: "name",
+	Phase:         "failed",
+	Error:         "oom",
+	OccurredAt:    time.Date(2021, time.October, 27, 9, 30, 0, 0, time.UTC),
+	Recipients:    []string{"a@example.com", "b@example.com"},
+	Group:         "project/domain/lp|a@example.com,b@example.com",
+	DueAt:         time.Date(2021, time.October, 27, 10, 0, 0, 0, time.UTC),
+}
+
+func TestDecodeNotification_DigestEntry(t *testing.T) {
+	wrapped, err := NewDigestEntryNotification(testDigestEntryMessage)
+	assert.Nil(t, err)
+	data, err := proto.Marshal(wrapped)
+	assert.Nil(t, err)
+
+	notification, err := decodeNotification(data)
+	assert.Nil(t, err)
+	assert.Nil(t, notification.email)
+	assert.Equal(t, testDigestEntryMessage, *notification.digestEntry)
+}
+
+func TestDecodeNotification_Error(t *testing.T) {
+	_, err := decodeNotification([]byte("not a proto"))
+	assert.NotNil(t, err)
+}
+
+func TestNotificationSender_Send(t *testing.T) {
+	var sentEmail *admin.EmailMessage
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		sentEmail = &email
+		return nil
+	})
+	slackSender := &testWebhookSender{}
+	teamsSender := &testWebhookSender{err: errors.New("foo")}
+	pagerDutySender := &testIncidentSender{}
+	sender := notificationSender{
+		email: &emailer,
+		webhookSenders: map[string]interfaces.WebhookSender{
+			Slack: slackSender,
+			Teams: teamsSender,
+		},
+		incidentSenders: map[string]interfaces.IncidentSender{
+			PagerDuty: pagerDutySender,
+		},
+	}
+
+	assert.Nil(t, sender.send(context.Background(), notification{email: &testEmail}))
+	assert.True(t, proto.Equal(&testEmail, sentEmail))
+
+	message := testWebhookMessage
+	message.Type = Slack
+	assert.Nil(t, sender.send(context.Background(), notification{webhook: &message}))
+	assert.Equal(t, []interfaces.WebhookMessage{message}, slackSender.messages)
+
+	message.Type = Teams
+	assert.EqualError(t, sender.send(context.Background(), notification{webhook: &message}), "foo")
+
+	message.Type = "carrier pigeon"
+	assert.EqualError(t, sender.send(context.Background(), notification{webhook: &message}),
+		"no sender for webhook messages of type [carrier pigeon]")
+
+	incidentMessage := testIncidentMessage
+	assert.Nil(t, sender.send(context.Background(), notification{incident: &incidentMessage}))
+	assert.Equal(t, []interfaces.IncidentMessage{incidentMessage}, pagerDutySender.messages)
+
+	incidentMessage.Type = Opsgenie
+	assert.EqualError(t, sender.send(context.Background(), notification{incident: &incidentMessage}),
+		"no sender for incident messages of type [opsgenie]")
+}
+
+func TestNotificationSender_SendTracked(t *testing.T) {
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		return errors.New("refused")
+	})
+	deliveryRepo := &repoMocks.NotificationDeliveryRepoInterface{}
+	deliveryRepo.OnCreateMatch(mock.Anything, models.NotificationDelivery{
+		Project:       "project",
+		Domain:        "domain",
+		ExecutionName: "name",
+		Type:          "email",
+		Payload:       msg,
+		Status:        common.DeliveryPending,
+	}).Return(uint(3), nil)
+	deliveryRepo.OnRecordAttemptMatch(mock.Anything, uint(3), common.DeliveryFailed, "refused").Return(nil)
+	deliveryRepo.OnRecordAttemptMatch(mock.Anything, uint(3), common.DeliverySucceeded, "").Return(nil)
+	sender := notificationSender{
+		email:        &emailer,
+		deliveryRepo: deliveryRepo,
+	}
+	tracked := interfaces.TrackedNotification{
+		Project:       "project",
+		Domain:        "domain",
+		ExecutionName: "name",
+		Notification:  msg,
+	}
+
+	assert.EqualError(t, sender.send(context.Background(), notification{tracked: &tracked}), "refused")
+	deliveryRepo.AssertCalled(t, "RecordAttempt", mock.Anything, uint(3), common.DeliveryFailed, "refused")
+
+	// Resending the notification records the attempt in the same delivery.
+	emailer.SetSendEmailFunc(func(ctx context.Context, email admin.EmailMessage) error {
+		return nil
+	})
+	tracked.DeliveryID = 3
+	assert.Nil(t, sender.send(context.Background(), notification{tracked: &tracked}))
+	deliveryRepo.AssertNumberOfCalls(t, "Create", 1)
+	deliveryRepo.AssertCalled(t, "RecordAttempt", mock.Anything, uint(3), common.DeliverySucceeded, "")
+}
+
+func TestNotificationSender_SendTrackedWithoutRepo(t *testing.T) {
+	tracked := interfaces.TrackedNotification{Notification: msg}
+	assert.EqualError(t, (&notificationSender{}).send(context.Background(), notification{tracked: &tracked}),
+		"no repository to record notification deliveries in")
+}
+
+func TestNotificationSender_SendDigestEntry(t *testing.T) {
+	digestRepo := &repoMocks.NotificationDigestRepoInterface{}
+	digestRepo.OnCreateMatch(mock.Anything, models.NotificationDigestEntry{
+		Project:       "project",
+		Domain:        "domain",
+		LaunchPlan:    "lp",
+		ExecutionName: "name",
+		Phase:         "failed",
+		Error:         "oom",
+		OccurredAt:    testDigestEntryMessage.OccurredAt,
+		Recipients:    "a@example.com,b@example.com",
+		GroupKey:      "project/domain/lp|a@example.com,b@example.com",
+		DueAt:         testDigestEntryMessage.DueAt,
+	}).Return(nil)
+	sender := notificationSender{
+		digestRecorder: NewDigestRecorder(digestRepo),
+	}
+	message := testDigestEntryMessage
+	assert.Nil(t, sender.send(context.Background(), notification{digestEntry: &message}))
+	digestRepo.AssertNumberOfCalls(t, "Create", 1)
+
+	assert.EqualError(t, (&notificationSender{}).send(context.Background(), notification{digestEntry: &message}),
+		"no recorder for executions batched into digests")
+}
+
+func TestNotificationDeliveryType(t *testing.T) {
+	message := testWebhookMessage
+	message.Type = Teams
+	assert.Equal(t, "teams", notification{webhook: &message}.deliveryType())
+	assert.Equal(t, "pagerDuty", notification{incident: &testIncidentMessage}.deliveryType())
+	assert.Equal(t, "registeredWebhook",
+		notification{webhookDelivery: &interfaces.WebhookDeliveryMessage{}}.deliveryType())
+	assert.Equal(t, "digest", notification{digestEntry: &testDigestEntryMessage}.deliveryType())
+	assert.Equal(t, "email", notification{email: &testEmail}.deliveryType())
+}
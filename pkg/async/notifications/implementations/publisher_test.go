@@ -54,7 +54,7 @@ var (
 	testSubscriber pubsubtest.TestSubscriber
 	mockSub        pubsub.Subscriber = &testSubscriber
 	mockEmail      mocks.MockEmailer
-	testProcessor  = NewProcessor(mockSub, &mockEmail, promutils.NewTestScope())
+	testProcessor  = NewProcessor(mockSub, &mockEmail, nil, nil, nil, nil, nil, promutils.NewTestScope())
 )
 
 // This method should be invoked before every test around Publisher.
@@ -0,0 +1,192 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+)
+
+const (
+	defaultSMTPPort               = 587
+	defaultSMTPImplicitTLSPort    = 465
+	defaultSMTPMaxIdleConnections = 2
+	smtpDialTimeout               = 10 * time.Second
+)
+
+// Sends emails through an SMTP server, reusing idle connections to it.
+type SMTPEmailer struct {
+	config        runtimeInterfaces.SMTPConfig
+	address       string
+	auth          smtp.Auth
+	idleClients   chan *smtp.Client
+	systemMetrics emailMetrics
+}
+
+func getSMTPAddress(config runtimeInterfaces.SMTPConfig) string {
+	port := config.Port
+	if port == 0 {
+		port = defaultSMTPPort
+		if config.TLSMode == runtimeInterfaces.SMTPImplicitTLS {
+			port = defaultSMTPImplicitTLSPort
+		}
+	}
+	return net.JoinHostPort(config.Host, strconv.Itoa(port))
+}
+
+// Formats an email as a MIME message with an HTML body.
+func getSMTPMessage(email admin.EmailMessage, date time.Time) []byte {
+	var message bytes.Buffer
+	headers := [][2]string{
+		{"From", email.SenderEmail},
+		{"To", strings.Join(email.RecipientsEmail, ", ")},
+		{"Subject", mime.QEncoding.Encode("utf-8", email.SubjectLine)},
+		{"Date", date.Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/html; charset=UTF-8"},
+	}
+	for _, header := range headers {
+		message.WriteString(fmt.Sprintf("%s: %s\r\n", header[0], header[1]))
+	}
+	message.WriteString("\r\n")
+	message.WriteString(email.Body)
+	return message.Bytes()
+}
+
+// Opens a connection to the server, secured as configured, and authenticates if a username is set.
+func (s *SMTPEmailer) dial() (*smtp.Client, error) {
+	tlsConfig := &tls.Config{
+		ServerName: s.config.Host,
+		// #nosec G402
+		InsecureSkipVerify: s.config.InsecureSkipVerify,
+	}
+	dialer := &net.Dialer{Timeout: smtpDialTimeout}
+	var conn net.Conn
+	var err error
+	if s.config.TLSMode == runtimeInterfaces.SMTPImplicitTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", s.address, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", s.address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if s.config.TLSMode == "" || s.config.TLSMode == runtimeInterfaces.SMTPStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			_ = client.Close()
+			return nil, fmt.Errorf("smtp server [%s] doesn't support STARTTLS", s.address)
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+	}
+	if s.auth != nil {
+		if err := client.Auth(s.auth); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// Returns an idle connection which is still open, or a new one.
+func (s *SMTPEmailer) getClient() (*smtp.Client, error) {
+	for {
+		select {
+		case client := <-s.idleClients:
+			if err := client.Reset(); err == nil {
+				return client, nil
+			}
+			_ = client.Close()
+		default:
+			return s.dial()
+		}
+	}
+}
+
+// Keeps a connection open for the next email, unless there are enough idle connections already.
+func (s *SMTPEmailer) releaseClient(client *smtp.Client) {
+	select {
+	case s.idleClients <- client:
+	default:
+		_ = client.Quit()
+	}
+}
+
+func (s *SMTPEmailer) send(client *smtp.Client, email admin.EmailMessage) error {
+	if err := client.Mail(email.SenderEmail); err != nil {
+		return err
+	}
+	for _, recipient := range email.RecipientsEmail {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(getSMTPMessage(email, time.Now())); err != nil {
+		_ = writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+func (s *SMTPEmailer) SendEmail(ctx context.Context, email admin.EmailMessage) error {
+	s.systemMetrics.SendTotal.Inc()
+	client, err := s.getClient()
+	if err == nil {
+		err = s.send(client, email)
+		if err == nil {
+			s.releaseClient(client)
+		} else {
+			_ = client.Close()
+		}
+	}
+	if err != nil {
+		logger.Errorf(ctx, "SMTP error sending email to %v: %v", email.RecipientsEmail, err)
+		s.systemMetrics.SendError.Inc()
+		return err
+	}
+	s.systemMetrics.SendSuccess.Inc()
+	return nil
+}
+
+func NewSMTPEmailer(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Emailer {
+	emailerConfig := config.NotificationsEmailerConfig.EmailerConfig
+	smtpConfig := emailerConfig.SMTPConfig
+	var auth smtp.Auth
+	if len(smtpConfig.Username) > 0 {
+		auth = smtp.PlainAuth("", smtpConfig.Username, getAPIKey(emailerConfig), smtpConfig.Host)
+	}
+	maxIdleConnections := smtpConfig.MaxIdleConnections
+	if maxIdleConnections <= 0 {
+		maxIdleConnections = defaultSMTPMaxIdleConnections
+	}
+	return &SMTPEmailer{
+		config:        smtpConfig,
+		address:       getSMTPAddress(smtpConfig),
+		auth:          auth,
+		idleClients:   make(chan *smtp.Client, maxIdleConnections),
+		systemMetrics: newEmailMetrics(scope.NewSubScope("smtp")),
+	}
+}
@@ -0,0 +1,71 @@
+package implementations
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSMTPAddress(t *testing.T) {
+	assert.Equal(t, "smtp.example.com:587", getSMTPAddress(runtimeInterfaces.SMTPConfig{
+		Host: "smtp.example.com",
+	}))
+	assert.Equal(t, "smtp.example.com:465", getSMTPAddress(runtimeInterfaces.SMTPConfig{
+		Host:    "smtp.example.com",
+		TLSMode: runtimeInterfaces.SMTPImplicitTLS,
+	}))
+	assert.Equal(t, "smtp.example.com:25", getSMTPAddress(runtimeInterfaces.SMTPConfig{
+		Host:    "smtp.example.com",
+		Port:    25,
+		TLSMode: runtimeInterfaces.SMTPNoTLS,
+	}))
+}
+
+func TestGetSMTPMessage(t *testing.T) {
+	email := admin.EmailMessage{
+		SubjectLine:     `Notice: Execution "name" has succeeded in "domain".`,
+		SenderEmail:     "no-reply@example.com",
+		RecipientsEmail: []string{"my@example.com", "john@example.com"},
+		Body:            `Execution "name" has succeeded in "domain".`,
+	}
+	date := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	parts := strings.SplitN(string(getSMTPMessage(email, date)), "\r\n\r\n", 2)
+	assert.Len(t, parts, 2)
+	headers, body := parts[0], parts[1]
+	assert.Contains(t, headers, "From: no-reply@example.com\r\n")
+	assert.Contains(t, headers, "To: my@example.com, john@example.com\r\n")
+	assert.Contains(t, headers, "Subject: Notice: Execution \"name\" has succeeded in \"domain\".\r\n")
+	assert.Contains(t, headers, "Date: Sat, 02 Jan 2021 03:04:05 +0000\r\n")
+	assert.Contains(t, headers, "Content-Type: text/html; charset=UTF-8")
+	assert.Equal(t, `Execution "name" has succeeded in "domain".`, body)
+}
+
+func TestGetSMTPMessage_EncodesSubject(t *testing.T) {
+	email := admin.EmailMessage{
+		SubjectLine: "Exécution réussie",
+	}
+	message := string(getSMTPMessage(email, time.Now()))
+	assert.Contains(t, message, "Subject: =?utf-8?q?")
+	assert.NotContains(t, message, "Exécution")
+}
+
+func TestCreateSMTPEmailer(t *testing.T) {
+	cfg := getNotificationsConfig()
+	cfg.NotificationsEmailerConfig.EmailerConfig.ServiceName = SMTP
+	cfg.NotificationsEmailerConfig.EmailerConfig.SMTPConfig = runtimeInterfaces.SMTPConfig{
+		Host: "smtp.example.com",
+	}
+
+	emailer := NewSMTPEmailer(cfg, promutils.NewTestScope())
+	assert.NotNil(t, emailer)
+	smtpEmailer := emailer.(*SMTPEmailer)
+	assert.Equal(t, "smtp.example.com:587", smtpEmailer.address)
+	assert.Nil(t, smtpEmailer.auth)
+	assert.Equal(t, defaultSMTPMaxIdleConnections, cap(smtpEmailer.idleClients))
+}
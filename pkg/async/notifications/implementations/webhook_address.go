@@ -0,0 +1,85 @@
+package implementations
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"syscall"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// The shared address space isn't private, but is internal to providers, e.g. Alibaba Cloud serves instance metadata
+// from it.
+var _, sharedAddressSpace, _ = net.ParseCIDR("100.64.0.0/10")
+
+// Returns whether a webhook may be delivered to an address, which excludes loopback, private and link-local addresses,
+// and so cloud instance metadata endpoints, unless private addresses are allowed.
+func isAllowedWebhookIP(config runtimeInterfaces.RegisteredWebhooksConfig, ip net.IP) bool {
+	if config.AllowPrivateAddresses {
+		return true
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	return !sharedAddressSpace.Contains(ip)
+}
+
+// Returns whether a host matches a pattern, which is either a host name or, when prefixed with "*.", any subdomain of
+// one.
+func matchesWebhookHost(pattern, host string) bool {
+	pattern = strings.ToLower(pattern)
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.HasSuffix(host, pattern[1:])
+	}
+	return host == pattern
+}
+
+// Validates that webhooks may be delivered to a host, according to the allowed and denied hosts configured. Hosts which
+// are IP addresses are also validated not to be internal. Host names are resolved when webhooks are delivered, and
+// their addresses are validated then.
+func ValidateWebhookHost(config runtimeInterfaces.RegisteredWebhooksConfig, host string) error {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, denied := range config.DeniedHosts {
+		if matchesWebhookHost(denied, host) {
+			return fmt.Errorf("webhooks can't be delivered to denied host %s", host)
+		}
+	}
+	if len(config.AllowedHosts) > 0 {
+		allowed := false
+		for _, pattern := range config.AllowedHosts {
+			if matchesWebhookHost(pattern, host) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("webhooks can't be delivered to host %s, which isn't allowed", host)
+		}
+	}
+	if ip := net.ParseIP(host); ip != nil && !isAllowedWebhookIP(config, ip) {
+		return fmt.Errorf("webhooks can't be delivered to internal address %s", host)
+	}
+	return nil
+}
+
+// Returns a dialer control which refuses to connect to internal addresses. Since it's called with the address host
+// names resolved to, it also covers host names which resolve to internal addresses, or whose records change after the
+// webhook was registered.
+func NewWebhookDialControl(
+	config runtimeInterfaces.RegisteredWebhooksConfig) func(network, address string, conn syscall.RawConn) error {
+	return func(network, address string, conn syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return fmt.Errorf("webhooks can't be delivered to unresolved address %s", address)
+		}
+		if !isAllowedWebhookIP(config, ip) {
+			return fmt.Errorf("webhooks can't be delivered to internal address %s", host)
+		}
+		return nil
+	}
+}
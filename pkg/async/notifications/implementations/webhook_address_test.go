@@ -0,0 +1,60 @@
+package implementations
+
+import (
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWebhookHost(t *testing.T) {
+	webhooksConfig := runtimeInterfaces.RegisteredWebhooksConfig{
+		AllowedHosts: []string{"hooks.example.com", "*.internal.example.com", "8.8.8.8"},
+		DeniedHosts:  []string{"admin.internal.example.com"},
+	}
+	for _, test := range []struct {
+		host string
+		err  string
+	}{
+		{host: "hooks.example.com"},
+		{host: "HOOKS.example.com."},
+		{host: "ci.internal.example.com"},
+		{host: "8.8.8.8"},
+		{host: "example.com", err: "webhooks can't be delivered to host example.com, which isn't allowed"},
+		{host: "internal.example.com", err: "webhooks can't be delivered to host internal.example.com, which isn't allowed"},
+		{host: "admin.internal.example.com", err: "webhooks can't be delivered to denied host admin.internal.example.com"},
+	} {
+		t.Run(test.host, func(t *testing.T) {
+			err := ValidateWebhookHost(webhooksConfig, test.host)
+			if len(test.err) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestValidateWebhookHost_InternalAddress(t *testing.T) {
+	for _, host := range []string{
+		"127.0.0.1", "::1", "10.0.0.1", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.100.100.200",
+		"fd00:ec2::254", "fe80::1", "0.0.0.0", "::ffff:127.0.0.1",
+	} {
+		t.Run(host, func(t *testing.T) {
+			assert.EqualError(t, ValidateWebhookHost(runtimeInterfaces.RegisteredWebhooksConfig{}, host),
+				"webhooks can't be delivered to internal address "+host)
+			assert.NoError(t, ValidateWebhookHost(runtimeInterfaces.RegisteredWebhooksConfig{
+				AllowPrivateAddresses: true,
+			}, host))
+		})
+	}
+	assert.NoError(t, ValidateWebhookHost(runtimeInterfaces.RegisteredWebhooksConfig{}, "93.184.216.34"))
+}
+
+func TestNewWebhookDialControl(t *testing.T) {
+	control := NewWebhookDialControl(runtimeInterfaces.RegisteredWebhooksConfig{})
+	assert.NoError(t, control("tcp4", "93.184.216.34:443", nil))
+	assert.EqualError(t, control("tcp4", "169.254.169.254:80", nil),
+		"webhooks can't be delivered to internal address 169.254.169.254")
+	assert.EqualError(t, control("tcp6", "[::1]:80", nil), "webhooks can't be delivered to internal address ::1")
+}
@@ -0,0 +1,190 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	defaultWebhookDeliveryAttempts = 3
+	defaultWebhookRetryDelay       = time.Second
+	// The hex encoded HMAC-SHA256 of the payload, keyed with the webhook's secret and prefixed with sha256=.
+	webhookSignatureHeader = "X-Flyte-Signature"
+	// The id of the delivery, which is the same for each attempt, so that receivers can ignore retried deliveries.
+	webhookDeliveryHeader = "X-Flyte-Delivery"
+)
+
+// Delivers executions to registered webhooks, recording each delivery.
+type webhookDeliverer struct {
+	repo        repoInterfaces.WebhookRepoInterface
+	config      runtimeInterfaces.RegisteredWebhooksConfig
+	client      *http.Client
+	maxAttempts int
+	retryDelay  time.Duration
+}
+
+func signWebhookPayload(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Makes a single attempt to post a payload to a webhook, recording the response in the delivery. Returns whether a
+// failed attempt should be retried.
+func (d *webhookDeliverer) post(ctx context.Context, webhook models.Webhook, payload string,
+	delivery *models.WebhookDelivery) bool {
+	delivery.ResponseCode = 0
+	delivery.LastError = ""
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader([]byte(payload)))
+	if err != nil {
+		delivery.LastError = err.Error()
+		return false
+	}
+	// Hosts are checked again, since the allowed and denied hosts may have changed since the webhook was registered.
+	if err := ValidateWebhookHost(d.config, request.URL.Hostname()); err != nil {
+		delivery.LastError = err.Error()
+		return false
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(webhookDeliveryHeader, strconv.FormatUint(uint64(delivery.ID), 10))
+	request.Header.Set(webhookSignatureHeader, signWebhookPayload(webhook.Secret, payload))
+	response, err := d.client.Do(request)
+	if err != nil {
+		delivery.LastError = err.Error()
+		return true
+	}
+	defer response.Body.Close()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	delivery.ResponseCode = response.StatusCode
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return false
+	}
+	delivery.LastError = fmt.Sprintf("webhook responded with status %d", response.StatusCode)
+	// Other client errors won't succeed when retried.
+	return response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500
+}
+
+// Waits before retrying a delivery, unless ctx is done first.
+func waitForRetry(ctx context.Context, delay time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+func (d *webhookDeliverer) Deliver(ctx context.Context, message interfaces.WebhookDeliveryMessage) error {
+	webhook, err := d.repo.Get(ctx, repoInterfaces.Identifier{
+		Project: message.Project,
+		Domain:  message.Domain,
+		Name:    message.WebhookName,
+	})
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+			logger.Infof(ctx, "not delivering execution [%s] to webhook [%s/%s/%s] which was deleted",
+				message.ExecutionName, message.Project, message.Domain, message.WebhookName)
+			return nil
+		}
+		return err
+	}
+	delivery := models.WebhookDelivery{
+		Project:       message.Project,
+		Domain:        message.Domain,
+		WebhookName:   message.WebhookName,
+		ExecutionName: message.ExecutionName,
+		Phase:         message.Phase,
+		Status:        common.DeliveryPending,
+	}
+	delivery.ID, err = d.repo.CreateDelivery(ctx, delivery)
+	if err != nil {
+		return err
+	}
+	for delivery.Attempts = 1; ; delivery.Attempts++ {
+		retry := d.post(ctx, webhook, message.Payload, &delivery)
+		if len(delivery.LastError) == 0 {
+			delivery.Status = common.DeliverySucceeded
+			break
+		}
+		if !retry || delivery.Attempts >= d.maxAttempts {
+			delivery.Status = common.DeliveryFailed
+			break
+		}
+		logger.Debugf(ctx, "retrying delivery [%d] to webhook [%s/%s/%s] which failed with: %s", delivery.ID,
+			message.Project, message.Domain, message.WebhookName, delivery.LastError)
+		if err := waitForRetry(ctx, d.retryDelay<<uint(delivery.Attempts-1)); err != nil {
+			delivery.LastError = err.Error()
+			delivery.Status = common.DeliveryFailed
+			break
+		}
+	}
+	if err := d.repo.UpdateDelivery(ctx, delivery); err != nil {
+		return err
+	}
+	if delivery.Status == common.DeliveryFailed {
+		return fmt.Errorf("failed to deliver execution [%s] to webhook [%s/%s/%s] after %d attempts: %s",
+			message.ExecutionName, message.Project, message.Domain, message.WebhookName, delivery.Attempts,
+			delivery.LastError)
+	}
+	return nil
+}
+
+func NewWebhookDeliverer(
+	repo repoInterfaces.WebhookRepoInterface, config runtimeInterfaces.RegisteredWebhooksConfig) interfaces.WebhookDeliverer {
+	timeout := defaultWebhookTimeout
+	if config.TimeoutSeconds > 0 {
+		timeout = time.Duration(config.TimeoutSeconds) * time.Second
+	}
+	maxAttempts := defaultWebhookDeliveryAttempts
+	if config.MaxAttempts > 0 {
+		maxAttempts = config.MaxAttempts
+	}
+	retryDelay := defaultWebhookRetryDelay
+	if config.RetryDelay.Duration > 0 {
+		retryDelay = config.RetryDelay.Duration
+	}
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: NewWebhookDialControl(config),
+	}
+	return &webhookDeliverer{
+		repo:   repo,
+		config: config,
+		client: &http.Client{
+			// Webhooks are dialed directly rather than through a proxy, so that the addresses dialed can be checked.
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			// Redirects aren't followed, since they could point webhooks at other hosts.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+			Timeout: timeout,
+		},
+		maxAttempts: maxAttempts,
+		retryDelay:  retryDelay,
+	}
+}
@@ -0,0 +1,243 @@
+package implementations
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repoMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+var testWebhookDeliveryMessage = interfaces.WebhookDeliveryMessage{
+	Project:       "project",
+	Domain:        "domain",
+	WebhookName:   "alerts",
+	ExecutionName: "name",
+	Phase:         "FAILED",
+	Payload:       `{"execution": "name"}`,
+}
+
+// Returns a webhook repo which has registered a webhook posting to url, and records the final state of deliveries.
+func getWebhookRepoForTest(url string, delivered *models.WebhookDelivery) *repoMocks.WebhookRepoInterface {
+	repo := &repoMocks.WebhookRepoInterface{}
+	repo.OnGetMatch(mock.Anything, repoInterfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "alerts",
+	}).Return(models.Webhook{
+		WebhookKey: models.WebhookKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "alerts",
+		},
+		URL:    url,
+		Secret: "secret",
+	}, nil)
+	repo.OnCreateDeliveryMatch(mock.Anything, mock.MatchedBy(func(delivery models.WebhookDelivery) bool {
+		return delivery.Status == common.DeliveryPending && delivery.ExecutionName == "name"
+	})).Return(uint(7), nil)
+	repo.OnUpdateDeliveryMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		*delivered = args.Get(1).(models.WebhookDelivery)
+	})
+	return repo
+}
+
+// Starts a webhook which responds to each request with the next of statuses.
+func newTestDeliveryServer(t *testing.T, statuses []int, requests *[]*http.Request) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, testWebhookDeliveryMessage.Payload, string(data))
+		*requests = append(*requests, r)
+		w.WriteHeader(statuses[len(*requests)-1])
+	}))
+}
+
+func getRegisteredWebhooksConfig() runtimeInterfaces.RegisteredWebhooksConfig {
+	return runtimeInterfaces.RegisteredWebhooksConfig{
+		Enabled:    true,
+		RetryDelay: config.Duration{Duration: time.Millisecond},
+		// Test webhooks listen on the loopback interface.
+		AllowPrivateAddresses: true,
+	}
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	assert.Equal(t, "sha256=51922b5d82e3302b2ad466c5bb70f3f0fd8f691def875b59e00f649242315c31",
+		signWebhookPayload("secret", `{"execution": "name"}`))
+}
+
+func TestWebhookDeliverer_Deliver(t *testing.T) {
+	var requests []*http.Request
+	server := newTestDeliveryServer(t, []int{http.StatusOK}, &requests)
+	defer server.Close()
+	var delivered models.WebhookDelivery
+	deliverer := NewWebhookDeliverer(getWebhookRepoForTest(server.URL, &delivered), getRegisteredWebhooksConfig())
+
+	assert.Nil(t, deliverer.Deliver(context.Background(), testWebhookDeliveryMessage))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, "application/json", requests[0].Header.Get("Content-Type"))
+	assert.Equal(t, "7", requests[0].Header.Get("X-Flyte-Delivery"))
+	assert.Equal(t, signWebhookPayload("secret", testWebhookDeliveryMessage.Payload),
+		requests[0].Header.Get("X-Flyte-Signature"))
+	assert.Equal(t, uint(7), delivered.ID)
+	assert.Equal(t, common.DeliverySucceeded, delivered.Status)
+	assert.Equal(t, 1, delivered.Attempts)
+	assert.Equal(t, http.StatusOK, delivered.ResponseCode)
+	assert.Empty(t, delivered.LastError)
+}
+
+func TestWebhookDeliverer_Retry(t *testing.T) {
+	var requests []*http.Request
+	server := newTestDeliveryServer(t, []int{http.StatusServiceUnavailable, http.StatusTooManyRequests,
+		http.StatusNoContent}, &requests)
+	defer server.Close()
+	var delivered models.WebhookDelivery
+	deliverer := NewWebhookDeliverer(getWebhookRepoForTest(server.URL, &delivered), getRegisteredWebhooksConfig())
+
+	assert.Nil(t, deliverer.Deliver(context.Background(), testWebhookDeliveryMessage))
+	assert.Len(t, requests, 3)
+	// Each attempt is the same delivery.
+	assert.Equal(t, "7", requests[2].Header.Get("X-Flyte-Delivery"))
+	assert.Equal(t, common.DeliverySucceeded, delivered.Status)
+	assert.Equal(t, 3, delivered.Attempts)
+}
+
+func TestWebhookDeliverer_Failed(t *testing.T) {
+	var requests []*http.Request
+	server := newTestDeliveryServer(t, []int{http.StatusBadGateway, http.StatusBadGateway}, &requests)
+	defer server.Close()
+	var delivered models.WebhookDelivery
+	webhooksConfig := getRegisteredWebhooksConfig()
+	webhooksConfig.MaxAttempts = 2
+	deliverer := NewWebhookDeliverer(getWebhookRepoForTest(server.URL, &delivered), webhooksConfig)
+
+	assert.EqualError(t, deliverer.Deliver(context.Background(), testWebhookDeliveryMessage),
+		"failed to deliver execution [name] to webhook [project/domain/alerts] after 2 attempts: "+
+			"webhook responded with status 502")
+	assert.Len(t, requests, 2)
+	assert.Equal(t, common.DeliveryFailed, delivered.Status)
+	assert.Equal(t, 2, delivered.Attempts)
+	assert.Equal(t, http.StatusBadGateway, delivered.ResponseCode)
+}
+
+func TestWebhookDeliverer_ClientError(t *testing.T) {
+	var requests []*http.Request
+	server := newTestDeliveryServer(t, []int{http.StatusBadRequest}, &requests)
+	defer server.Close()
+	var delivered models.WebhookDelivery
+	deliverer := NewWebhookDeliverer(getWebhookRepoForTest(server.URL, &delivered), getRegisteredWebhooksConfig())
+
+	// Client errors aren't retried.
+	assert.Error(t, deliverer.Deliver(context.Background(), testWebhookDeliveryMessage))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, common.DeliveryFailed, delivered.Status)
+	assert.Equal(t, 1, delivered.Attempts)
+}
+
+func TestWebhookDeliverer_DeletedWebhook(t *testing.T) {
+	repo := &repoMocks.WebhookRepoInterface{}
+	repo.OnGetMatch(mock.Anything, mock.Anything).Return(
+		models.Webhook{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found"))
+	deliverer := NewWebhookDeliverer(repo, getRegisteredWebhooksConfig())
+
+	assert.Nil(t, deliverer.Deliver(context.Background(), testWebhookDeliveryMessage))
+	repo.AssertNotCalled(t, "CreateDelivery", mock.Anything, mock.Anything)
+}
+
+func TestWebhookDeliverer_InternalAddress(t *testing.T) {
+	var requests []*http.Request
+	server := newTestDeliveryServer(t, []int{http.StatusOK}, &requests)
+	defer server.Close()
+	webhooksConfig := getRegisteredWebhooksConfig()
+	webhooksConfig.AllowPrivateAddresses = false
+
+	t.Run("address", func(t *testing.T) {
+		var delivered models.WebhookDelivery
+		deliverer := NewWebhookDeliverer(getWebhookRepoForTest(server.URL, &delivered), webhooksConfig)
+
+		assert.Error(t, deliverer.Deliver(context.Background(), testWebhookDeliveryMessage))
+		assert.Empty(t, requests)
+		assert.Equal(t, common.DeliveryFailed, delivered.Status)
+		assert.Equal(t, 1, delivered.Attempts)
+		assert.Contains(t, delivered.LastError, "webhooks can't be delivered to internal address 127.0.0.1")
+	})
+	t.Run("host name", func(t *testing.T) {
+		// Host names are checked once they're resolved, when the webhook is dialed.
+		serverURL, err := url.Parse(server.URL)
+		assert.NoError(t, err)
+		serverURL.Host = net.JoinHostPort("localhost", serverURL.Port())
+		var delivered models.WebhookDelivery
+		deliverer := NewWebhookDeliverer(getWebhookRepoForTest(serverURL.String(), &delivered), webhooksConfig)
+
+		assert.Error(t, deliverer.Deliver(context.Background(), testWebhookDeliveryMessage))
+		assert.Empty(t, requests)
+		assert.Equal(t, common.DeliveryFailed, delivered.Status)
+		assert.Contains(t, delivered.LastError, "webhooks can't be delivered to internal address")
+	})
+}
+
+func TestWebhookDeliverer_DeniedHost(t *testing.T) {
+	var requests []*http.Request
+	server := newTestDeliveryServer(t, []int{http.StatusOK}, &requests)
+	defer server.Close()
+	var delivered models.WebhookDelivery
+	webhooksConfig := getRegisteredWebhooksConfig()
+	webhooksConfig.DeniedHosts = []string{"127.0.0.1"}
+	deliverer := NewWebhookDeliverer(getWebhookRepoForTest(server.URL, &delivered), webhooksConfig)
+
+	assert.Error(t, deliverer.Deliver(context.Background(), testWebhookDeliveryMessage))
+	assert.Empty(t, requests)
+	assert.Equal(t, "webhooks can't be delivered to denied host 127.0.0.1", delivered.LastError)
+}
+
+func TestWebhookDeliverer_Redirect(t *testing.T) {
+	var redirected bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer server.Close()
+	var delivered models.WebhookDelivery
+	deliverer := NewWebhookDeliverer(getWebhookRepoForTest(server.URL, &delivered), getRegisteredWebhooksConfig())
+
+	// Redirects aren't followed, and fail the delivery.
+	assert.Error(t, deliverer.Deliver(context.Background(), testWebhookDeliveryMessage))
+	assert.False(t, redirected)
+	assert.Equal(t, common.DeliveryFailed, delivered.Status)
+	assert.Equal(t, http.StatusTemporaryRedirect, delivered.ResponseCode)
+}
+
+func TestWebhookDeliverer_Cancelled(t *testing.T) {
+	var requests []*http.Request
+	server := newTestDeliveryServer(t, []int{http.StatusServiceUnavailable}, &requests)
+	defer server.Close()
+	var delivered models.WebhookDelivery
+	webhooksConfig := getRegisteredWebhooksConfig()
+	webhooksConfig.RetryDelay = config.Duration{Duration: time.Hour}
+	deliverer := NewWebhookDeliverer(getWebhookRepoForTest(server.URL, &delivered), webhooksConfig)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Retries stop waiting once the context is done.
+	assert.Error(t, deliverer.Deliver(ctx, testWebhookDeliveryMessage))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, common.DeliveryFailed, delivered.Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), delivered.LastError)
+}
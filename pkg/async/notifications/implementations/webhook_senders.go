@@ -0,0 +1,143 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+type WebhookType = string
+
+const (
+	Slack WebhookType = "slack"
+	Teams WebhookType = "teams"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// Formats a message as the JSON payload a chat platform's webhooks accept.
+type formatWebhookPayload func(message interfaces.WebhookMessage) interface{}
+
+// Posts messages to the webhook of a single chat platform configured for their project and domain.
+type webhookSender struct {
+	webhookType WebhookType
+	client      *http.Client
+	config      runtimeInterfaces.NotificationsWebhooksConfig
+	format      formatWebhookPayload
+}
+
+func (s *webhookSender) Send(ctx context.Context, message interfaces.WebhookMessage) error {
+	webhook, ok := s.config.GetWebhook(message.Project, message.Domain)
+	if !ok || webhook.Type != s.webhookType {
+		return fmt.Errorf("no %s webhook configured for project [%s] and domain [%s]",
+			s.webhookType, message.Project, message.Domain)
+	}
+	data, err := json.Marshal(s.format(message))
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		// Webhook URLs embed their credentials, so they aren't logged.
+		return fmt.Errorf("%s webhook for project [%s] and domain [%s] responded with status %d",
+			s.webhookType, message.Project, message.Domain, response.StatusCode)
+	}
+	return nil
+}
+
+// Formats a message as Slack blocks: a header, the body and, if there's a link, a button opening the execution.
+func formatSlackPayload(message interfaces.WebhookMessage) interface{} {
+	blocks := []map[string]interface{}{
+		{
+			"type": "header",
+			"text": map[string]interface{}{"type": "plain_text", "text": message.Title},
+		},
+		{
+			"type": "section",
+			"text": map[string]interface{}{"type": "mrkdwn", "text": message.Body},
+		},
+	}
+	if len(message.Link) > 0 {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "actions",
+			"elements": []map[string]interface{}{
+				{
+					"type": "button",
+					"text": map[string]interface{}{"type": "plain_text", "text": "View execution"},
+					"url":  message.Link,
+				},
+			},
+		})
+	}
+	return map[string]interface{}{
+		// Shown in notifications, which don't render blocks.
+		"text":   message.Title,
+		"blocks": blocks,
+	}
+}
+
+// Formats a message as a Microsoft Teams message card, with an action opening the execution if there's a link.
+func formatTeamsPayload(message interfaces.WebhookMessage) interface{} {
+	payload := map[string]interface{}{
+		"@type":    "MessageCard",
+		"@context": "https://schema.org/extensions",
+		"summary":  message.Title,
+		"title":    message.Title,
+		"text":     message.Body,
+	}
+	if len(message.Link) > 0 {
+		payload["potentialAction"] = []map[string]interface{}{
+			{
+				"@type": "OpenUri",
+				"name":  "View execution",
+				"targets": []map[string]interface{}{
+					{"os": "default", "uri": message.Link},
+				},
+			},
+		}
+	}
+	return payload
+}
+
+func newWebhookSender(webhookType WebhookType, config runtimeInterfaces.NotificationsWebhooksConfig,
+	format formatWebhookPayload) interfaces.WebhookSender {
+	timeout := defaultWebhookTimeout
+	if config.TimeoutSeconds > 0 {
+		timeout = time.Duration(config.TimeoutSeconds) * time.Second
+	}
+	return &webhookSender{
+		webhookType: webhookType,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		config: config,
+		format: format,
+	}
+}
+
+func NewSlackSender(config runtimeInterfaces.NotificationsWebhooksConfig) interfaces.WebhookSender {
+	return newWebhookSender(Slack, config, formatSlackPayload)
+}
+
+func NewTeamsSender(config runtimeInterfaces.NotificationsWebhooksConfig) interfaces.WebhookSender {
+	return newWebhookSender(Teams, config, formatTeamsPayload)
+}
@@ -0,0 +1,115 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+var testWebhookMessage = interfaces.WebhookMessage{
+	Project: "project",
+	Domain:  "development",
+	Title:   "Execution name has failed",
+	Body:    "Execution *name* has failed.",
+	Link:    "https://flyte.example.com/console/projects/project/domains/development/executions/name",
+}
+
+func getWebhooksConfig(webhookType WebhookType, url string) runtimeInterfaces.NotificationsWebhooksConfig {
+	return runtimeInterfaces.NotificationsWebhooksConfig{
+		Endpoints: []runtimeInterfaces.ProjectDomainWebhook{
+			{
+				Project: "project",
+				Type:    webhookType,
+				URL:     url,
+			},
+		},
+	}
+}
+
+// Starts a webhook server which decodes the payloads posted to it.
+func newTestWebhookServer(t *testing.T, status int, payload *map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		data, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Nil(t, json.Unmarshal(data, payload))
+		w.WriteHeader(status)
+	}))
+}
+
+func TestSlackSender_Send(t *testing.T) {
+	var payload map[string]interface{}
+	server := newTestWebhookServer(t, http.StatusOK, &payload)
+	defer server.Close()
+
+	sender := NewSlackSender(getWebhooksConfig(Slack, server.URL))
+	assert.Nil(t, sender.Send(context.Background(), testWebhookMessage))
+
+	assert.Equal(t, testWebhookMessage.Title, payload["text"])
+	blocks := payload["blocks"].([]interface{})
+	assert.Len(t, blocks, 3)
+	assert.Equal(t, "header", blocks[0].(map[string]interface{})["type"])
+	assert.Equal(t, testWebhookMessage.Body, blocks[1].(map[string]interface{})["text"].(map[string]interface{})["text"])
+	button := blocks[2].(map[string]interface{})["elements"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, testWebhookMessage.Link, button["url"])
+}
+
+func TestSlackSender_SendWithoutLink(t *testing.T) {
+	var payload map[string]interface{}
+	server := newTestWebhookServer(t, http.StatusOK, &payload)
+	defer server.Close()
+
+	message := testWebhookMessage
+	message.Link = ""
+	sender := NewSlackSender(getWebhooksConfig(Slack, server.URL))
+	assert.Nil(t, sender.Send(context.Background(), message))
+	assert.Len(t, payload["blocks"], 2)
+}
+
+func TestTeamsSender_Send(t *testing.T) {
+	var payload map[string]interface{}
+	server := newTestWebhookServer(t, http.StatusOK, &payload)
+	defer server.Close()
+
+	sender := NewTeamsSender(getWebhooksConfig(Teams, server.URL))
+	assert.Nil(t, sender.Send(context.Background(), testWebhookMessage))
+
+	assert.Equal(t, "MessageCard", payload["@type"])
+	assert.Equal(t, testWebhookMessage.Title, payload["title"])
+	assert.Equal(t, testWebhookMessage.Body, payload["text"])
+	action := payload["potentialAction"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "OpenUri", action["@type"])
+	target := action["targets"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, testWebhookMessage.Link, target["uri"])
+}
+
+func TestWebhookSender_SendErrorStatus(t *testing.T) {
+	var payload map[string]interface{}
+	server := newTestWebhookServer(t, http.StatusForbidden, &payload)
+	defer server.Close()
+
+	sender := NewSlackSender(getWebhooksConfig(Slack, server.URL))
+	err := sender.Send(context.Background(), testWebhookMessage)
+	assert.EqualError(t, err,
+		"slack webhook for project [project] and domain [development] responded with status 403")
+	assert.NotContains(t, err.Error(), server.URL)
+}
+
+func TestWebhookSender_SendNotConfigured(t *testing.T) {
+	sender := NewSlackSender(getWebhooksConfig(Teams, "https://teams.example.com"))
+	assert.EqualError(t, sender.Send(context.Background(), testWebhookMessage),
+		"no slack webhook configured for project [project] and domain [development]")
+
+	message := testWebhookMessage
+	message.Project = "other"
+	assert.EqualError(t, NewTeamsSender(getWebhooksConfig(Teams, "https://teams.example.com")).Send(
+		context.Background(), message), "no teams webhook configured for project [other] and domain [development]")
+}
@@ -0,0 +1,51 @@
+package notifications
+
+import (
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const defaultIncidentSummary = "Launch plan {{ launch_plan.name }} has {{ phase }} in project {{ project }} and domain " +
+	"{{ domain }}, in execution {{ name }}.{{ error }}"
+
+// Identifies the incident for a launch plan in a domain, so that its failures add to a single incident until one of its
+// executions succeeds.
+func getIncidentKey(execution *admin.Execution) string {
+	launchPlan := execution.GetSpec().GetLaunchPlan()
+	return fmt.Sprintf("flyte/%s/%s/%s", launchPlan.GetProject(), execution.Id.Domain, launchPlan.GetName())
+}
+
+// Converts a terminal execution event and existing execution model to a message which opens an incident for its launch
+// plan when the execution failed or timed out, or resolves it when the execution succeeded. Returns nil for aborted
+// executions, which neither page nor show the launch plan has recovered.
+func ToIncidentMessageFromWorkflowExecutionEvent(
+	config runtimeInterfaces.NotificationsIncidentsConfig,
+	incidentType string,
+	request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) *interfaces.IncidentMessage {
+	message := &interfaces.IncidentMessage{
+		Type:    incidentType,
+		Project: execution.Id.Project,
+		Domain:  execution.Id.Domain,
+		Key:     getIncidentKey(execution),
+	}
+	switch request.Event.Phase {
+	case core.WorkflowExecution_FAILED, core.WorkflowExecution_TIMED_OUT:
+		summary := config.Summary
+		if len(summary) == 0 {
+			summary = defaultIncidentSummary
+		}
+		message.Action = interfaces.TriggerIncident
+		message.Summary = substituteEmailParameters(summary, request, execution)
+		message.Link = substituteEmailParameters(config.ExecutionLink, request, execution)
+	case core.WorkflowExecution_SUCCEEDED:
+		message.Action = interfaces.ResolveIncident
+	default:
+		return nil
+	}
+	return message
+}
@@ -0,0 +1,72 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToIncidentMessageFromWorkflowExecutionEvent_Failed(t *testing.T) {
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_TIMED_OUT,
+		},
+	}
+	message := ToIncidentMessageFromWorkflowExecutionEvent(runtimeInterfaces.NotificationsIncidentsConfig{
+		ExecutionLink: "https://flyte.example.com/console/projects/{{ project }}/domains/{{ domain }}/executions/{{ name }}",
+	}, "pagerDuty", request, workflowExecution)
+	assert.Equal(t, &interfaces.IncidentMessage{
+		Type:    "pagerDuty",
+		Action:  interfaces.TriggerIncident,
+		Project: executionProjectValue,
+		Domain:  executionDomainValue,
+		Key:     "flyte/lp_proj/prod/lp_name",
+		Summary: "Launch plan lp_name has timed_out in project proj and domain prod, in execution e124.",
+		Link:    "https://flyte.example.com/console/projects/proj/domains/prod/executions/e124",
+	}, message)
+
+	request.Event.Phase = core.WorkflowExecution_FAILED
+	request.Event.OutputResult = &event.WorkflowExecutionEvent_Error{
+		Error: &core.ExecutionError{
+			Message: "uh-oh",
+		},
+	}
+	message = ToIncidentMessageFromWorkflowExecutionEvent(runtimeInterfaces.NotificationsIncidentsConfig{
+		Summary: "{{ launch_plan.name }} {{ phase }}",
+	}, "opsgenie", request, workflowExecution)
+	assert.Equal(t, interfaces.TriggerIncident, message.Action)
+	assert.Equal(t, "lp_name failed", message.Summary)
+	assert.Empty(t, message.Link)
+}
+
+func TestToIncidentMessageFromWorkflowExecutionEvent_Succeeded(t *testing.T) {
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_SUCCEEDED,
+		},
+	}
+	message := ToIncidentMessageFromWorkflowExecutionEvent(runtimeInterfaces.NotificationsIncidentsConfig{},
+		"pagerDuty", request, workflowExecution)
+	assert.Equal(t, &interfaces.IncidentMessage{
+		Type:    "pagerDuty",
+		Action:  interfaces.ResolveIncident,
+		Project: executionProjectValue,
+		Domain:  executionDomainValue,
+		Key:     "flyte/lp_proj/prod/lp_name",
+	}, message)
+}
+
+func TestToIncidentMessageFromWorkflowExecutionEvent_Aborted(t *testing.T) {
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_ABORTED,
+		},
+	}
+	assert.Nil(t, ToIncidentMessageFromWorkflowExecutionEvent(runtimeInterfaces.NotificationsIncidentsConfig{},
+		"pagerDuty", request, workflowExecution))
+}
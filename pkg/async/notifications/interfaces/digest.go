@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Records an execution whose email is batched into a digest, instead of emailing it.
+type DigestEntryMessage struct {
+	Project       string    `json:"project"`
+	Domain        string    `json:"domain"`
+	LaunchPlan    string    `json:"launchPlan"`
+	ExecutionName string    `json:"executionName"`
+	Phase         string    `json:"phase"`
+	Error         string    `json:"error"`
+	Link          string    `json:"link"`
+	OccurredAt    time.Time `json:"occurredAt"`
+	Recipients    []string  `json:"recipients"`
+	// Entries in the same group are summarized in the same digest.
+	Group string `json:"group"`
+	// When the digest the entry is summarized in is sent.
+	DueAt time.Time `json:"dueAt"`
+}
+
+// The implementation of DigestRecorder needs to be passed to the implementation of Processor in order for executions
+// to be batched into digests.
+type DigestRecorder interface {
+	// Records an entry until the digest it's summarized in is due.
+	Record(ctx context.Context, message DigestEntryMessage) error
+}
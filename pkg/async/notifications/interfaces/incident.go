@@ -0,0 +1,35 @@
+package interfaces
+
+import (
+	"context"
+)
+
+type IncidentAction = string
+
+const (
+	// Opens an incident, or adds to the one already open with the same key.
+	TriggerIncident IncidentAction = "trigger"
+	// Resolves the incident open with the same key, if any.
+	ResolveIncident IncidentAction = "resolve"
+)
+
+// Opens or resolves an incident in an incident paging service, such as PagerDuty or Opsgenie.
+type IncidentMessage struct {
+	// The paging service the incident is opened in, which selects the sender that sends it.
+	Type    string         `json:"type"`
+	Action  IncidentAction `json:"action"`
+	Project string         `json:"project"`
+	Domain  string         `json:"domain"`
+	// Identifies the incident, so that repeated failures add to the same incident and successes resolve it.
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+	// A link to the execution the incident is about, if configured.
+	Link string `json:"link,omitempty"`
+}
+
+// The implementation of IncidentSender for each paging service needs to be passed to the implementation of Processor
+// in order for incidents to be opened and resolved.
+type IncidentSender interface {
+	// Opens or resolves an incident in the paging service configured for its project and domain.
+	Send(ctx context.Context, message IncidentMessage) error
+}
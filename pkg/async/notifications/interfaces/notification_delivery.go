@@ -0,0 +1,13 @@
+package interfaces
+
+// A notification published for an execution whose delivery is recorded, so that it can be resent if it fails.
+type TrackedNotification struct {
+	// The delivery to record the attempt in. It's zero when the notification is first published, and the delivery is
+	// created when the notification is processed.
+	DeliveryID    uint   `json:"deliveryId"`
+	Project       string `json:"project"`
+	Domain        string `json:"domain"`
+	ExecutionName string `json:"executionName"`
+	// The notification as it would otherwise have been published.
+	Notification []byte `json:"notification"`
+}
@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// A notification posted to a chat webhook, such as a Slack or Microsoft Teams channel.
+type WebhookMessage struct {
+	// The chat platform the message is posted to, which selects the sender that posts it.
+	Type    string `json:"type"`
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Title   string `json:"title"`
+	Body    string `json:"body"`
+	// A link to the execution the notification is about, if configured.
+	Link string `json:"link,omitempty"`
+}
+
+// The implementation of WebhookSender for each chat platform needs to be passed to the implementation of Processor
+// in order for webhook messages to be posted.
+type WebhookSender interface {
+	// Posts a message to the webhook configured for its project and domain.
+	Send(ctx context.Context, message WebhookMessage) error
+}
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// Delivers an execution which reached one of a registered webhook's phases to the webhook.
+type WebhookDeliveryMessage struct {
+	Project       string `json:"project"`
+	Domain        string `json:"domain"`
+	WebhookName   string `json:"webhookName"`
+	ExecutionName string `json:"executionName"`
+	Phase         string `json:"phase"`
+	// The payload rendered from the webhook's template.
+	Payload string `json:"payload"`
+}
+
+// The implementation of WebhookDeliverer needs to be passed to the implementation of Processor in order for
+// executions to be delivered to registered webhooks.
+type WebhookDeliverer interface {
+	// Posts the payload to the webhook, retrying failed attempts, and records the delivery.
+	Deliver(ctx context.Context, message WebhookDeliveryMessage) error
+}
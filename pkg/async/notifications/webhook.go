@@ -0,0 +1,36 @@
+package notifications
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+const defaultWebhookTitle = "Execution {{ name }} has {{ phase }}"
+const defaultWebhookBody = "Execution *{{ name }}* of launch plan {{ launch_plan.name }} has {{ phase }} in project " +
+	"{{ project }} and domain {{ domain }}.{{ error }}"
+
+// Converts a terminal execution event and existing execution model to a message for the chat webhook configured for
+// the execution's project and domain, substituting parameters in the templates set in the notifications config.
+func ToWebhookMessageFromWorkflowExecutionEvent(
+	config runtimeInterfaces.NotificationsWebhooksConfig,
+	webhookType string,
+	request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) *interfaces.WebhookMessage {
+	title := config.Title
+	if len(title) == 0 {
+		title = defaultWebhookTitle
+	}
+	body := config.Body
+	if len(body) == 0 {
+		body = defaultWebhookBody
+	}
+	return &interfaces.WebhookMessage{
+		Type:    webhookType,
+		Project: execution.Id.Project,
+		Domain:  execution.Id.Domain,
+		Title:   substituteEmailParameters(title, request, execution),
+		Body:    substituteEmailParameters(body, request, execution),
+		Link:    substituteEmailParameters(config.ExecutionLink, request, execution),
+	}
+}
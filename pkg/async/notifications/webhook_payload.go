@@ -0,0 +1,124 @@
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// Identifies the launch plan or workflow of an execution in webhook payloads.
+type WebhookPayloadIdentifier struct {
+	Project string
+	Domain  string
+	Name    string
+	Version string
+}
+
+// The data the payload templates of registered webhooks are rendered with, e.g.
+// {"execution": {{ json .Name }}, "phase": {{ json .Phase }}}.
+type WebhookPayloadData struct {
+	Project string
+	Domain  string
+	Name    string
+	// The phase the execution reached, e.g. FAILED.
+	Phase string
+	// The message of the error the execution failed with, if any.
+	Error      string
+	LaunchPlan WebhookPayloadIdentifier
+	Workflow   WebhookPayloadIdentifier
+	OccurredAt time.Time
+	// The user who launched the execution.
+	Principal string
+}
+
+// Besides the builtin functions, templates can use json to render any value as JSON, which quotes and escapes strings.
+var webhookPayloadFuncs = template.FuncMap{
+	"json": func(value interface{}) (string, error) {
+		data, err := json.Marshal(value)
+		return string(data), err
+	},
+}
+
+// Rendered when validating templates, so that templates referring to missing fields are rejected when registered.
+var sampleWebhookPayloadData = WebhookPayloadData{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+	Phase:   core.WorkflowExecution_FAILED.String(),
+	Error:   "error",
+	LaunchPlan: WebhookPayloadIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "launch_plan",
+		Version: "version",
+	},
+	Workflow: WebhookPayloadIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "workflow",
+		Version: "version",
+	},
+	Principal: "principal",
+}
+
+func renderWebhookPayload(payloadTemplate string, data WebhookPayloadData) (string, error) {
+	parsed, err := template.New("payload").Funcs(webhookPayloadFuncs).Parse(payloadTemplate)
+	if err != nil {
+		return "", err
+	}
+	var payload bytes.Buffer
+	if err := parsed.Execute(&payload, data); err != nil {
+		return "", err
+	}
+	return payload.String(), nil
+}
+
+// Checks a webhook's payload template parses, and renders JSON for a sample execution.
+func ValidateWebhookPayloadTemplate(payloadTemplate string) error {
+	payload, err := renderWebhookPayload(payloadTemplate, sampleWebhookPayloadData)
+	if err != nil {
+		return err
+	}
+	if !json.Valid([]byte(payload)) {
+		return fmt.Errorf("payload rendered for a sample execution isn't valid JSON: %s", payload)
+	}
+	return nil
+}
+
+func toWebhookPayloadIdentifier(id *core.Identifier) WebhookPayloadIdentifier {
+	return WebhookPayloadIdentifier{
+		Project: id.GetProject(),
+		Domain:  id.GetDomain(),
+		Name:    id.GetName(),
+		Version: id.GetVersion(),
+	}
+}
+
+// Renders the payload of a registered webhook for an execution which reached one of its phases.
+func RenderWebhookPayload(
+	payloadTemplate string, request admin.WorkflowExecutionEventRequest, execution *admin.Execution) (string, error) {
+	data := WebhookPayloadData{
+		Project:    execution.Id.Project,
+		Domain:     execution.Id.Domain,
+		Name:       execution.Id.Name,
+		Phase:      request.Event.Phase.String(),
+		Error:      request.Event.GetError().GetMessage(),
+		LaunchPlan: toWebhookPayloadIdentifier(execution.GetSpec().GetLaunchPlan()),
+		Workflow:   toWebhookPayloadIdentifier(execution.GetClosure().GetWorkflowId()),
+		Principal:  execution.GetSpec().GetMetadata().GetPrincipal(),
+	}
+	if request.Event.OccurredAt != nil {
+		occurredAt, err := ptypes.Timestamp(request.Event.OccurredAt)
+		if err != nil {
+			return "", err
+		}
+		data.OccurredAt = occurredAt
+	}
+	return renderWebhookPayload(payloadTemplate, data)
+}
@@ -0,0 +1,43 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWebhookPayloadTemplate(t *testing.T) {
+	assert.NoError(t, ValidateWebhookPayloadTemplate(
+		`{"execution": {{ json .Name }}, "launch_plan": {{ json .LaunchPlan.Name }}, "at": {{ json .OccurredAt }}}`))
+	// Templates must render JSON, so strings have to be quoted with json.
+	assert.EqualError(t, ValidateWebhookPayloadTemplate(`{"execution": {{ .Name }}}`),
+		`payload rendered for a sample execution isn't valid JSON: {"execution": name}`)
+	assert.Error(t, ValidateWebhookPayloadTemplate(`{"execution": {{ json .Missing }}}`))
+}
+
+func TestRenderWebhookPayload(t *testing.T) {
+	occurredAt := time.Date(2021, time.October, 22, 12, 0, 0, 0, time.UTC)
+	occurredAtProto, _ := ptypes.TimestampProto(occurredAt)
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:      core.WorkflowExecution_FAILED,
+			OccurredAt: occurredAtProto,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Message: `task "t1" failed`,
+				},
+			},
+		},
+	}
+	payload, err := RenderWebhookPayload(`{"execution": {{ json .Name }}, "phase": {{ json .Phase }}, `+
+		`"error": {{ json .Error }}, "workflow": {{ json .Workflow.Name }}, "at": {{ json .OccurredAt }}}`,
+		request, workflowExecution)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"execution": "e124", "phase": "FAILED", "error": "task \"t1\" failed", `+
+		`"workflow": "wf_name", "at": "2021-10-22T12:00:00Z"}`, payload)
+}
@@ -0,0 +1,49 @@
+package notifications
+
+import (
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToWebhookMessageFromWorkflowExecutionEvent(t *testing.T) {
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Message: "uh-oh",
+				},
+			},
+		},
+	}
+	message := ToWebhookMessageFromWorkflowExecutionEvent(runtimeInterfaces.NotificationsWebhooksConfig{
+		ExecutionLink: "https://flyte.example.com/console/projects/{{ project }}/domains/{{ domain }}/executions/{{ name }}",
+	}, "slack", request, workflowExecution)
+	assert.Equal(t, "slack", message.Type)
+	assert.Equal(t, executionProjectValue, message.Project)
+	assert.Equal(t, executionDomainValue, message.Domain)
+	assert.Equal(t, "Execution e124 has failed", message.Title)
+	assert.Equal(t, "Execution *e124* of launch plan lp_name has failed in project proj and domain prod. "+
+		"The execution failed with error: [uh-oh].", message.Body)
+	assert.Equal(t, "https://flyte.example.com/console/projects/proj/domains/prod/executions/e124", message.Link)
+}
+
+func TestToWebhookMessageFromWorkflowExecutionEvent_CustomTemplates(t *testing.T) {
+	request := admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_SUCCEEDED,
+		},
+	}
+	message := ToWebhookMessageFromWorkflowExecutionEvent(runtimeInterfaces.NotificationsWebhooksConfig{
+		Title: "{{ project }}: {{ name }}",
+		Body:  "{{ phase }}",
+	}, "teams", request, workflowExecution)
+	assert.Equal(t, "proj: e124", message.Title)
+	assert.Equal(t, "succeeded", message.Body)
+	assert.Empty(t, message.Link)
+}
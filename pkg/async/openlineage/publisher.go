@@ -0,0 +1,230 @@
+package openlineage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	producer         = "https://github.com/flyteorg/flyteadmin"
+	schemaURL        = "https://openlineage.io/spec/1-0-2/OpenLineage.json#/definitions/RunEvent"
+	defaultNamespace = "flyte"
+	defaultTimeout   = 10 * time.Second
+)
+
+// OpenLineage run event types.
+const (
+	eventTypeStart    = "START"
+	eventTypeComplete = "COMPLETE"
+	eventTypeFail     = "FAIL"
+	eventTypeAbort    = "ABORT"
+)
+
+type Run struct {
+	RunID string `json:"runId"`
+}
+
+type Job struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+type Dataset struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// An OpenLineage run event, as described by https://openlineage.io/spec/1-0-2/OpenLineage.json.
+type RunEvent struct {
+	EventType string    `json:"eventType"`
+	EventTime string    `json:"eventTime"`
+	Run       Run       `json:"run"`
+	Job       Job       `json:"job"`
+	Inputs    []Dataset `json:"inputs"`
+	Outputs   []Dataset `json:"outputs"`
+	Producer  string    `json:"producer"`
+	SchemaURL string    `json:"schemaURL"`
+}
+
+type publisherMetrics struct {
+	Scope          promutils.Scope
+	PublishSuccess prometheus.Counter
+	PublishError   prometheus.Counter
+}
+
+// Exports task execution runs as OpenLineage run events. Each task is a job, and each task execution attempt is a
+// run which reads its inputs and writes its outputs.
+type Publisher struct {
+	client    *http.Client
+	url       string
+	namespace string
+	headers   map[string]string
+	metrics   publisherMetrics
+}
+
+// Only the transitions OpenLineage models are exported: a task execution starting to run, and terminating.
+func getEventType(phase core.TaskExecution_Phase, phaseVersion uint32) string {
+	switch phase {
+	case core.TaskExecution_RUNNING:
+		if phaseVersion == 0 {
+			return eventTypeStart
+		}
+	case core.TaskExecution_SUCCEEDED:
+		return eventTypeComplete
+	case core.TaskExecution_FAILED:
+		return eventTypeFail
+	case core.TaskExecution_ABORTED:
+		return eventTypeAbort
+	}
+	return ""
+}
+
+// Datasets are namespaced by the scheme and bucket of their URI, e.g. s3://bucket, and named by their path.
+func getDataset(uri string) Dataset {
+	parsed, err := url.Parse(uri)
+	if err != nil || len(parsed.Scheme) == 0 {
+		return Dataset{
+			Namespace: "file",
+			Name:      uri,
+		}
+	}
+	return Dataset{
+		Namespace: fmt.Sprintf("%s://%s", parsed.Scheme, parsed.Host),
+		Name:      strings.TrimPrefix(parsed.Path, "/"),
+	}
+}
+
+// Task execution attempts are identified by a UUID derived from their identifier, so every event of an attempt
+// belongs to the same run.
+func getRunID(request *admin.TaskExecutionEventRequest) string {
+	nodeExecutionID := request.Event.GetParentNodeExecutionId()
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(fmt.Sprintf("flyte://%s/%s/%s/%s/%s/%d",
+		nodeExecutionID.GetExecutionId().GetProject(), nodeExecutionID.GetExecutionId().GetDomain(),
+		nodeExecutionID.GetExecutionId().GetName(), nodeExecutionID.GetNodeId(), request.Event.GetTaskId().GetName(),
+		request.Event.GetRetryAttempt()))).String()
+}
+
+// Returns the run event for a task execution event, or nil if the event isn't one OpenLineage models.
+func (p *Publisher) getRunEvent(request *admin.TaskExecutionEventRequest) *RunEvent {
+	eventType := getEventType(request.Event.GetPhase(), request.Event.GetPhaseVersion())
+	if len(eventType) == 0 {
+		return nil
+	}
+	eventTime := time.Now()
+	if occurredAt, err := ptypes.Timestamp(request.Event.GetOccurredAt()); err == nil {
+		eventTime = occurredAt
+	}
+	taskID := request.Event.GetTaskId()
+	runEvent := &RunEvent{
+		EventType: eventType,
+		EventTime: eventTime.UTC().Format(time.RFC3339Nano),
+		Run: Run{
+			RunID: getRunID(request),
+		},
+		Job: Job{
+			Namespace: p.namespace,
+			Name:      fmt.Sprintf("%s.%s.%s", taskID.GetProject(), taskID.GetDomain(), taskID.GetName()),
+		},
+		Inputs:    []Dataset{},
+		Outputs:   []Dataset{},
+		Producer:  producer,
+		SchemaURL: schemaURL,
+	}
+	if inputURI := request.Event.GetInputUri(); len(inputURI) > 0 {
+		runEvent.Inputs = append(runEvent.Inputs, getDataset(inputURI))
+	}
+	if outputURI := request.Event.GetOutputUri(); len(outputURI) > 0 {
+		runEvent.Outputs = append(runEvent.Outputs, getDataset(outputURI))
+	}
+	return runEvent
+}
+
+func (p *Publisher) post(ctx context.Context, runEvent *RunEvent) error {
+	data, err := json.Marshal(runEvent)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for name, value := range p.headers {
+		request.Header.Set(name, value)
+	}
+	response, err := p.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("OpenLineage endpoint [%s] responded with status %d", p.url, response.StatusCode)
+	}
+	return nil
+}
+
+// Events other than task execution events are ignored.
+func (p *Publisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	request, ok := msg.(*admin.TaskExecutionEventRequest)
+	if !ok {
+		return nil
+	}
+	runEvent := p.getRunEvent(request)
+	if runEvent == nil {
+		return nil
+	}
+	if err := p.post(ctx, runEvent); err != nil {
+		p.metrics.PublishError.Inc()
+		logger.Errorf(ctx, "Failed to export [%s] OpenLineage event for run [%s] with err: %v",
+			runEvent.EventType, runEvent.Run.RunID, err)
+		return err
+	}
+	p.metrics.PublishSuccess.Inc()
+	return nil
+}
+
+func NewPublisher(config runtimeInterfaces.OpenLineageConfig, scope promutils.Scope) interfaces.Publisher {
+	namespace := config.Namespace
+	if len(namespace) == 0 {
+		namespace = defaultNamespace
+	}
+	timeout := defaultTimeout
+	if config.TimeoutSeconds > 0 {
+		timeout = time.Duration(config.TimeoutSeconds) * time.Second
+	}
+	scope = scope.NewSubScope("openlineage_publisher")
+	return &Publisher{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		url:       config.URL,
+		namespace: namespace,
+		headers:   config.Headers,
+		metrics: publisherMetrics{
+			Scope:          scope,
+			PublishSuccess: scope.MustNewCounter("publish_success", "count of OpenLineage events exported"),
+			PublishError:   scope.MustNewCounter("publish_errors", "count of OpenLineage events which failed to export"),
+		},
+	}
+}
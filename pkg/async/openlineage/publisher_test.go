@@ -0,0 +1,119 @@
+package openlineage
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var occurredAt = time.Date(2021, 10, 20, 12, 0, 0, 0, time.UTC)
+
+func getTaskExecutionEventRequest(phase core.TaskExecution_Phase, retryAttempt uint32) *admin.TaskExecutionEventRequest {
+	occurredAtProto, _ := ptypes.TimestampProto(occurredAt)
+	return &admin.TaskExecutionEventRequest{
+		Event: &event.TaskExecutionEvent{
+			TaskId: &core.Identifier{
+				ResourceType: core.ResourceType_TASK,
+				Project:      "project",
+				Domain:       "domain",
+				Name:         "task",
+				Version:      "v1",
+			},
+			ParentNodeExecutionId: &core.NodeExecutionIdentifier{
+				NodeId: "n0",
+				ExecutionId: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+			},
+			RetryAttempt: retryAttempt,
+			Phase:        phase,
+			OccurredAt:   occurredAtProto,
+			InputUri:     "s3://bucket/metadata/inputs.pb",
+			OutputResult: &event.TaskExecutionEvent_OutputUri{
+				OutputUri: "s3://bucket/metadata/outputs.pb",
+			},
+		},
+	}
+}
+
+func TestPublish(t *testing.T) {
+	var runEvents []RunEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		body, _ := ioutil.ReadAll(r.Body)
+		var runEvent RunEvent
+		assert.NoError(t, json.Unmarshal(body, &runEvent))
+		runEvents = append(runEvents, runEvent)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	publisher := NewPublisher(runtimeInterfaces.OpenLineageConfig{
+		URL: server.URL,
+		Headers: map[string]string{
+			"Authorization": "Bearer token",
+		},
+	}, promutils.NewTestScope())
+	for _, phase := range []core.TaskExecution_Phase{
+		core.TaskExecution_QUEUED, core.TaskExecution_RUNNING, core.TaskExecution_SUCCEEDED} {
+		assert.NoError(t, publisher.Publish(context.Background(), "", getTaskExecutionEventRequest(phase, 0)))
+	}
+	assert.NoError(t, publisher.Publish(context.Background(), "", &admin.WorkflowExecutionEventRequest{}))
+
+	assert.Len(t, runEvents, 2)
+	assert.Equal(t, eventTypeStart, runEvents[0].EventType)
+	assert.Equal(t, eventTypeComplete, runEvents[1].EventType)
+	assert.Equal(t, runEvents[0].Run.RunID, runEvents[1].Run.RunID)
+	assert.Equal(t, "2021-10-20T12:00:00Z", runEvents[1].EventTime)
+	assert.Equal(t, Job{Namespace: defaultNamespace, Name: "project.domain.task"}, runEvents[1].Job)
+	assert.Equal(t, []Dataset{{Namespace: "s3://bucket", Name: "metadata/inputs.pb"}}, runEvents[1].Inputs)
+	assert.Equal(t, []Dataset{{Namespace: "s3://bucket", Name: "metadata/outputs.pb"}}, runEvents[1].Outputs)
+}
+
+func TestPublish_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	publisher := NewPublisher(runtimeInterfaces.OpenLineageConfig{
+		URL: server.URL,
+	}, promutils.NewTestScope())
+	err := publisher.Publish(context.Background(), "", getTaskExecutionEventRequest(core.TaskExecution_FAILED, 0))
+	assert.EqualError(t, err, "OpenLineage endpoint ["+server.URL+"] responded with status 400")
+}
+
+func TestGetEventType(t *testing.T) {
+	assert.Equal(t, eventTypeStart, getEventType(core.TaskExecution_RUNNING, 0))
+	assert.Empty(t, getEventType(core.TaskExecution_RUNNING, 1))
+	assert.Equal(t, eventTypeFail, getEventType(core.TaskExecution_FAILED, 0))
+	assert.Equal(t, eventTypeAbort, getEventType(core.TaskExecution_ABORTED, 0))
+	assert.Empty(t, getEventType(core.TaskExecution_QUEUED, 0))
+}
+
+func TestGetRunID(t *testing.T) {
+	runID := getRunID(getTaskExecutionEventRequest(core.TaskExecution_RUNNING, 0))
+	assert.Equal(t, runID, getRunID(getTaskExecutionEventRequest(core.TaskExecution_SUCCEEDED, 0)))
+	assert.NotEqual(t, runID, getRunID(getTaskExecutionEventRequest(core.TaskExecution_RUNNING, 1)))
+}
+
+func TestGetDataset(t *testing.T) {
+	assert.Equal(t, Dataset{Namespace: "gs://bucket", Name: "a/b.pb"}, getDataset("gs://bucket/a/b.pb"))
+	assert.Equal(t, Dataset{Namespace: "file", Name: "/tmp/a.pb"}, getDataset("/tmp/a.pb"))
+}
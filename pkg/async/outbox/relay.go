@@ -0,0 +1,219 @@
+// Package outbox relays the messages written to the outbox alongside execution updates to the configured publishers.
+// Messages are deleted only once they've been published, so they're published at least once even if admin crashes
+// part way through. Messages which fail to publish too many times are kept as dead letters instead.
+//
+// Only workflow execution notifications and events are written to the outbox. Node and task execution events are
+// published directly once their updates are committed, and are lost if publishing them fails.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/lag"
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// The publishers messages are relayed through.
+const (
+	NotificationsPublisher = "notifications"
+	EventsPublisher        = "events"
+)
+
+const publisherLabel = "publisher"
+
+// Returns an outbox message which publishes msg with the given notification type through a publisher.
+func NewMessage(publisher, notificationType string, msg proto.Message) (models.OutboxMessage, error) {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return models.OutboxMessage{}, err
+	}
+	return models.OutboxMessage{
+		Publisher:        publisher,
+		NotificationType: notificationType,
+		MessageType:      proto.MessageName(msg),
+		Payload:          payload,
+	}, nil
+}
+
+func unmarshalMessage(message models.OutboxMessage) (proto.Message, error) {
+	messageType := proto.MessageType(message.MessageType)
+	if messageType == nil {
+		return nil, fmt.Errorf("unknown message type %s", message.MessageType)
+	}
+	msg := reflect.New(messageType.Elem()).Interface().(proto.Message)
+	if err := proto.Unmarshal(message.Payload, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+type Relay interface {
+	// Publishes all of the messages in the outbox which aren't leased by another relay.
+	Relay(ctx context.Context) error
+	// Runs periodically until ctx is done.
+	Run(ctx context.Context)
+}
+
+type relayMetrics struct {
+	Scope             promutils.Scope
+	MessagesPublished *prometheus.CounterVec
+	PublishFailures   *prometheus.CounterVec
+	RelayFailures     prometheus.Counter
+	RelayDuration     promutils.StopWatch
+	Panics            prometheus.Counter
+}
+
+type relay struct {
+	db         repositories.RepositoryInterface
+	config     runtimeInterfaces.OutboxConfig
+	publishers map[string]notificationInterfaces.Publisher
+	metrics    relayMetrics
+	now        func() time.Time
+	// The lag of each publisher's processor, which the relay reports the outbox backlog to.
+	lags map[string]*lag.Tracker
+}
+
+func (r *relay) publish(ctx context.Context, message models.OutboxMessage) error {
+	publisher, ok := r.publishers[message.Publisher]
+	if !ok {
+		return fmt.Errorf("unknown publisher %s", message.Publisher)
+	}
+	msg, err := unmarshalMessage(message)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(ctx, message.NotificationType, msg)
+}
+
+// Reports the messages waiting in the outbox as the backlog of the processors they're published to.
+func (r *relay) observeBacklog(ctx context.Context) {
+	backlogs, err := r.db.OutboxRepo().GetBacklog(ctx)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to get the outbox backlog with err: %v", err)
+		return
+	}
+	for publisher, tracker := range r.lags {
+		backlog := backlogs[publisher]
+		tracker.SetBacklog(backlog.Count, backlog.OldestCreatedAt)
+	}
+}
+
+// Stops retrying a message which failed to publish as many times as configured.
+func (r *relay) deadLetter(ctx context.Context, message models.OutboxMessage, publishErr error) {
+	// The message is retried once its lease expires if it can't be dead lettered.
+	if err := r.db.OutboxRepo().DeadLetter(ctx, message.ID, publishErr.Error(), r.now()); err != nil {
+		logger.Warningf(ctx, "Failed to dead letter outbox message [%d]: %v", message.ID, err)
+		return
+	}
+	logger.Warningf(ctx, "Dead lettered outbox message [%d] after %d attempts", message.ID, message.Attempts+1)
+	if tracker, ok := r.lags[message.Publisher]; ok {
+		tracker.DeadLettered()
+	}
+}
+
+func (r *relay) Relay(ctx context.Context) error {
+	defer func() {
+		if err := recover(); err != nil {
+			r.metrics.Panics.Inc()
+			logger.Warningf(ctx, fmt.Sprintf("caught panic: %v [%+v]", err, string(debug.Stack())))
+		}
+	}()
+	timer := r.metrics.RelayDuration.Start()
+	defer timer.Stop()
+	for {
+		now := r.now()
+		messages, err := r.db.OutboxRepo().Claim(ctx, repositoryInterfaces.ClaimOutboxMessagesInput{
+			Now:         now,
+			LeasedUntil: now.Add(r.config.Lease.Duration),
+			Limit:       r.config.BatchSize,
+		})
+		if err != nil {
+			r.metrics.RelayFailures.Inc()
+			return err
+		}
+		for _, message := range messages {
+			if err := r.publish(ctx, message); err != nil {
+				r.metrics.PublishFailures.WithLabelValues(message.Publisher).Inc()
+				logger.Infof(ctx, "Failed to publish outbox message [%d] after %d attempts with err: %v",
+					message.ID, message.Attempts+1, err)
+				if r.config.DeadLetterAttempts > 0 && message.Attempts+1 >= r.config.DeadLetterAttempts {
+					r.deadLetter(ctx, message, err)
+					continue
+				}
+				// The message is retried once its lease expires, whether or not the failure is recorded.
+				if err := r.db.OutboxRepo().MarkFailed(ctx, message.ID, err.Error()); err != nil {
+					logger.Warningf(ctx, "Failed to record failure publishing outbox message [%d]: %v", message.ID, err)
+				}
+				continue
+			}
+			r.metrics.MessagesPublished.WithLabelValues(message.Publisher).Inc()
+			// The message is published again if it can't be deleted.
+			if err := r.db.OutboxRepo().Delete(ctx, message.ID); err != nil {
+				r.metrics.RelayFailures.Inc()
+				return err
+			}
+		}
+		if len(messages) < r.config.BatchSize {
+			r.observeBacklog(ctx)
+			return nil
+		}
+	}
+}
+
+func (r *relay) Run(ctx context.Context) {
+	logger.Debugf(ctx, "Running outbox relay")
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		err := r.Relay(ctx)
+		if err != nil {
+			logger.Warningf(ctx, "Failed outbox relay loop with: %v", err)
+		}
+	}, r.config.Interval.Duration)
+}
+
+func newMetrics(scope promutils.Scope) relayMetrics {
+	return relayMetrics{
+		Scope: scope,
+		MessagesPublished: scope.MustNewCounterVec("messages_published",
+			"overall count of outbox messages published", publisherLabel),
+		PublishFailures: scope.MustNewCounterVec("publish_failures",
+			"overall count of attempts to publish outbox messages which failed and will be retried", publisherLabel),
+		RelayFailures: scope.MustNewCounter("relay_failures",
+			"overall count of invocations of the outbox relay which failed to claim or delete messages"),
+		RelayDuration: scope.MustNewStopWatch("relay_duration",
+			"time taken to publish all unleased outbox messages", time.Millisecond),
+		Panics: scope.MustNewCounter("panics",
+			"overall count of panics encountered in primary outbox relay loop"),
+	}
+}
+
+// Returns a Relay which publishes outbox messages through the given notifications and events publishers.
+func NewRelay(db repositories.RepositoryInterface, config runtimeInterfaces.OutboxConfig,
+	notificationsPublisher, eventsPublisher notificationInterfaces.Publisher, scope promutils.Scope) Relay {
+	return &relay{
+		db:     db,
+		config: config,
+		publishers: map[string]notificationInterfaces.Publisher{
+			NotificationsPublisher: notificationsPublisher,
+			EventsPublisher:        eventsPublisher,
+		},
+		metrics: newMetrics(scope),
+		now:     time.Now,
+		lags: map[string]*lag.Tracker{
+			NotificationsPublisher: lag.GetTracker(lag.NotificationsProcessor),
+			EventsPublisher:        lag.GetTracker(lag.EventsProcessor),
+		},
+	}
+}
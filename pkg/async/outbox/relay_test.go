@@ -0,0 +1,209 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/lag"
+	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var now = time.Date(2021, 9, 20, 0, 0, 0, 0, time.UTC)
+
+var email = &admin.EmailMessage{
+	RecipientsEmail: []string{"a@example.com"},
+	SubjectLine:     "execution succeeded",
+}
+
+var event = &admin.WorkflowExecutionEventRequest{
+	RequestId: "request",
+}
+
+type published struct {
+	notificationType string
+	msg              proto.Message
+}
+
+func newPublisher(messages *[]published, err error) *notificationMocks.MockPublisher {
+	publisher := &notificationMocks.MockPublisher{}
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		if err != nil {
+			return err
+		}
+		*messages = append(*messages, published{notificationType: notificationType, msg: msg})
+		return nil
+	})
+	return publisher
+}
+
+func newRelay(outboxRepo *repositoryMocks.OutboxRepoInterface,
+	notificationsPublisher, eventsPublisher *notificationMocks.MockPublisher) *relay {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.OutboxRepoIface = outboxRepo
+	r := NewRelay(repository, runtimeInterfaces.OutboxConfig{
+		BatchSize:          2,
+		Lease:              config.Duration{Duration: time.Minute},
+		DeadLetterAttempts: 3,
+	}, notificationsPublisher, eventsPublisher, promutils.NewTestScope()).(*relay)
+	r.now = func() time.Time {
+		return now
+	}
+	mockClock := clock.NewMock()
+	mockClock.Set(now)
+	lags := lag.NewRegistry(mockClock)
+	r.lags = map[string]*lag.Tracker{
+		NotificationsPublisher: lags.Tracker(lag.NotificationsProcessor),
+		EventsPublisher:        lags.Tracker(lag.EventsProcessor),
+	}
+	return r
+}
+
+func newMessages(t *testing.T) []models.OutboxMessage {
+	notification, err := NewMessage(NotificationsPublisher, "flyteidl.admin.EmailNotification", email)
+	assert.NoError(t, err)
+	notification.ID = 1
+	eventMessage, err := NewMessage(EventsPublisher, proto.MessageName(event), event)
+	assert.NoError(t, err)
+	eventMessage.ID = 2
+	return []models.OutboxMessage{notification, eventMessage}
+}
+
+func TestNewMessage(t *testing.T) {
+	message, err := NewMessage(EventsPublisher, "workflow", event)
+	assert.NoError(t, err)
+	assert.Equal(t, EventsPublisher, message.Publisher)
+	assert.Equal(t, "workflow", message.NotificationType)
+	assert.Equal(t, "flyteidl.admin.WorkflowExecutionEventRequest", message.MessageType)
+
+	msg, err := unmarshalMessage(message)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(event, msg))
+}
+
+func TestUnmarshalMessage_UnknownType(t *testing.T) {
+	_, err := unmarshalMessage(models.OutboxMessage{MessageType: "flyteidl.admin.Unknown"})
+	assert.EqualError(t, err, "unknown message type flyteidl.admin.Unknown")
+}
+
+func TestRelay(t *testing.T) {
+	outboxRepo := &repositoryMocks.OutboxRepoInterface{}
+	claim := repositoryInterfaces.ClaimOutboxMessagesInput{
+		Now:         now,
+		LeasedUntil: now.Add(time.Minute),
+		Limit:       2,
+	}
+	// A full batch is followed by another claim.
+	outboxRepo.OnClaimMatch(mock.Anything, claim).Return(newMessages(t), nil).Once()
+	outboxRepo.OnClaimMatch(mock.Anything, claim).Return([]models.OutboxMessage{}, nil).Once()
+	outboxRepo.OnDeleteMatch(mock.Anything, uint(1)).Return(nil)
+	outboxRepo.OnDeleteMatch(mock.Anything, uint(2)).Return(nil)
+	outboxRepo.OnGetBacklogMatch(mock.Anything).Return(map[string]repositoryInterfaces.OutboxBacklog{}, nil)
+
+	var notifications, events []published
+	r := newRelay(outboxRepo, newPublisher(&notifications, nil), newPublisher(&events, nil))
+	assert.NoError(t, r.Relay(context.Background()))
+	outboxRepo.AssertExpectations(t)
+
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, "flyteidl.admin.EmailNotification", notifications[0].notificationType)
+	assert.True(t, proto.Equal(email, notifications[0].msg))
+	assert.Len(t, events, 1)
+	assert.Equal(t, proto.MessageName(event), events[0].notificationType)
+	assert.True(t, proto.Equal(event, events[0].msg))
+	assert.Equal(t, float64(1), testutil.ToFloat64(r.metrics.MessagesPublished.WithLabelValues(EventsPublisher)))
+}
+
+func TestRelay_PublishFailure(t *testing.T) {
+	outboxRepo := &repositoryMocks.OutboxRepoInterface{}
+	outboxRepo.OnClaimMatch(mock.Anything, mock.Anything).Return(newMessages(t)[1:], nil).Once()
+	outboxRepo.OnMarkFailedMatch(mock.Anything, uint(2), "topic not found").Return(nil)
+	outboxRepo.OnGetBacklogMatch(mock.Anything).Return(map[string]repositoryInterfaces.OutboxBacklog{
+		EventsPublisher: {Count: 1, OldestCreatedAt: now.Add(-time.Minute)},
+	}, nil)
+
+	var notifications []published
+	r := newRelay(outboxRepo, newPublisher(&notifications, nil),
+		newPublisher(nil, errors.New("topic not found")))
+	assert.NoError(t, r.Relay(context.Background()))
+	// Messages which fail to publish aren't deleted, and are retried once their lease expires.
+	outboxRepo.AssertExpectations(t)
+	outboxRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	assert.Equal(t, float64(1), testutil.ToFloat64(r.metrics.PublishFailures.WithLabelValues(EventsPublisher)))
+	eventsLag := r.lags[EventsPublisher].GetLag()
+	assert.Equal(t, int64(1), eventsLag.QueueDepth)
+	assert.Equal(t, time.Minute, eventsLag.OldestMessageAge)
+	assert.Equal(t, int64(0), eventsLag.DeadLetters)
+}
+
+func TestRelay_DeadLetter(t *testing.T) {
+	outboxRepo := &repositoryMocks.OutboxRepoInterface{}
+	message := newMessages(t)[1]
+	message.Attempts = 2
+	outboxRepo.OnClaimMatch(mock.Anything, mock.Anything).Return([]models.OutboxMessage{message}, nil).Once()
+	outboxRepo.OnDeadLetterMatch(mock.Anything, uint(2), "topic not found", now).Return(nil)
+	outboxRepo.OnGetBacklogMatch(mock.Anything).Return(map[string]repositoryInterfaces.OutboxBacklog{}, nil)
+
+	r := newRelay(outboxRepo, newPublisher(nil, nil), newPublisher(nil, errors.New("topic not found")))
+	assert.NoError(t, r.Relay(context.Background()))
+	// Messages which failed to publish too many times are dead lettered rather than retried.
+	outboxRepo.AssertExpectations(t)
+	outboxRepo.AssertNotCalled(t, "MarkFailed", mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(t, int64(1), r.lags[EventsPublisher].GetLag().DeadLetters)
+	assert.Equal(t, int64(0), r.lags[NotificationsPublisher].GetLag().DeadLetters)
+}
+
+func TestRelay_DeadLetterFailure(t *testing.T) {
+	outboxRepo := &repositoryMocks.OutboxRepoInterface{}
+	message := newMessages(t)[1]
+	message.Attempts = 2
+	outboxRepo.OnClaimMatch(mock.Anything, mock.Anything).Return([]models.OutboxMessage{message}, nil).Once()
+	outboxRepo.OnDeadLetterMatch(mock.Anything, uint(2), "topic not found", now).Return(
+		errors.New("connection refused"))
+	outboxRepo.OnGetBacklogMatch(mock.Anything).Return(map[string]repositoryInterfaces.OutboxBacklog{}, nil)
+
+	r := newRelay(outboxRepo, newPublisher(nil, nil), newPublisher(nil, errors.New("topic not found")))
+	assert.NoError(t, r.Relay(context.Background()))
+	// The message is retried once its lease expires, so it isn't counted as a dead letter yet.
+	outboxRepo.AssertExpectations(t)
+	assert.Equal(t, int64(0), r.lags[EventsPublisher].GetLag().DeadLetters)
+}
+
+func TestRelay_DeleteFailure(t *testing.T) {
+	outboxRepo := &repositoryMocks.OutboxRepoInterface{}
+	outboxRepo.OnClaimMatch(mock.Anything, mock.Anything).Return(newMessages(t), nil).Once()
+	outboxRepo.OnDeleteMatch(mock.Anything, uint(1)).Return(errors.New("connection refused"))
+
+	var notifications, events []published
+	r := newRelay(outboxRepo, newPublisher(&notifications, nil), newPublisher(&events, nil))
+	assert.EqualError(t, r.Relay(context.Background()), "connection refused")
+	// The relay stops, leaving the remaining messages for the next run.
+	assert.Len(t, notifications, 1)
+	assert.Empty(t, events)
+	assert.Equal(t, float64(1), testutil.ToFloat64(r.metrics.RelayFailures))
+}
+
+func TestRelay_UnknownPublisher(t *testing.T) {
+	outboxRepo := &repositoryMocks.OutboxRepoInterface{}
+	outboxRepo.OnClaimMatch(mock.Anything, mock.Anything).Return(
+		[]models.OutboxMessage{{ID: 3, Publisher: "unknown"}}, nil).Once()
+	outboxRepo.OnMarkFailedMatch(mock.Anything, uint(3), "unknown publisher unknown").Return(nil)
+	outboxRepo.OnGetBacklogMatch(mock.Anything).Return(nil, errors.New("connection refused"))
+
+	r := newRelay(outboxRepo, &notificationMocks.MockPublisher{}, &notificationMocks.MockPublisher{})
+	assert.NoError(t, r.Relay(context.Background()))
+	outboxRepo.AssertExpectations(t)
+}
@@ -9,4 +9,5 @@ type AuthenticatedClientMeta struct {
 	TokenIssuedAt time.Time
 	ClientIP      string
 	Subject       string
+	Impersonator  string
 }
@@ -31,6 +31,7 @@ func (b *logBuilder) WithAuthenticatedCtx(ctx context.Context) LogBuilder {
 		b.auditLog.Principal = Principal{
 			Subject:       m.Subject,
 			TokenIssuedAt: m.TokenIssuedAt,
+			Impersonator:  m.Impersonator,
 		}
 		if len(m.ClientIds) > 0 {
 			b.auditLog.Principal.ClientID = m.ClientIds[0]
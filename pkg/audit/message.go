@@ -10,6 +10,9 @@ type Principal struct {
 	ClientID string
 
 	TokenIssuedAt time.Time
+
+	// Identifies the user acting on behalf of Subject, if the request is impersonated.
+	Impersonator string `json:",omitempty"`
 }
 
 type Client struct {
@@ -2,7 +2,9 @@ package clusterresource
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
+	"reflect"
 
 	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
 	"k8s.io/apimachinery/pkg/types"
@@ -14,6 +16,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime/debug"
+	"sort"
 	"strings"
 	"time"
 
@@ -33,6 +36,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/resources"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
 	managerinterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 
 	"github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
@@ -40,6 +44,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -62,13 +67,22 @@ const namespaceVariable = "namespace"
 const projectVariable = "project"
 const domainVariable = "domain"
 const templateVariableFormat = "{{ %s }}"
+
+// The template variables the quotas of projects and domains are projected into.
+const quotaCPUVariable = "projectQuotaCpu"
+const quotaMemoryVariable = "projectQuotaMemory"
+const quotaGPUVariable = "projectQuotaGpu"
 const replaceAllInstancesOfString = -1
 
+// The drift recorded for resources which were deleted after their templates were applied.
+const resourceMissingDrift = "the resource doesn't exist"
+
 // The clusterresource Controller manages applying desired templatized kubernetes resource files as resources
 // in the execution kubernetes cluster.
 type Controller interface {
 	Sync(ctx context.Context) error
-	Run()
+	// Syncs periodically until ctx is done.
+	Run(ctx context.Context)
 }
 
 type controllerMetrics struct {
@@ -82,6 +96,11 @@ type controllerMetrics struct {
 	TemplateDecodeErrors            prometheus.Counter
 	AppliedTemplateExists           prometheus.Counter
 	TemplateUpdateErrors            prometheus.Counter
+	ResourcesDrifted                prometheus.Counter
+	DriftCheckErrors                prometheus.Counter
+	SyncStatusRecordErrors          prometheus.Counter
+	ResourcesCleanedUp              prometheus.Counter
+	NamespaceCleanupErrors          prometheus.Counter
 	Panics                          prometheus.Counter
 }
 
@@ -102,6 +121,12 @@ type controller struct {
 	lastAppliedTemplateDir string
 	// Map of [namespace -> [templateFileName -> last modified time]]
 	appliedTemplates NamespaceCache
+	// Map of [namespace -> version of the custom template values its templates were applied with]
+	appliedTemplateValues map[NamespaceName]string
+	// Clean up the namespaces of archived projects and removed domains.
+	deleteResource    func(ctx context.Context, target executioncluster.ExecutionTarget, syncModel models.ClusterResourceSync) error
+	cordonNamespace   func(ctx context.Context, target executioncluster.ExecutionTarget, namespace NamespaceName) error
+	uncordonNamespace func(ctx context.Context, target executioncluster.ExecutionTarget, namespace NamespaceName) error
 }
 
 var descCreatedAtSortParam, _ = common.NewSortParameter(admin.Sort{
@@ -229,6 +254,23 @@ func (c *controller) getCustomTemplateValues(
 			customTemplateValues[fmt.Sprintf(templateVariableFormat, templateKey)] = templateValue
 		}
 	}
+	// Quotas set through the admin API take precedence over the template values they replace.
+	quota, err := c.db.DomainQuotaRepo().Get(ctx, project, domain)
+	if err != nil {
+		if _, ok := err.(errors.FlyteAdminError); !ok || err.(errors.FlyteAdminError).Code() != codes.NotFound {
+			collectedErrs = append(collectedErrs, err)
+		}
+	} else {
+		for templateKey, templateValue := range map[string]string{
+			quotaCPUVariable:    quota.CPU,
+			quotaMemoryVariable: quota.Memory,
+			quotaGPUVariable:    quota.GPU,
+		} {
+			if len(templateValue) > 0 {
+				customTemplateValues[fmt.Sprintf(templateVariableFormat, templateKey)] = templateValue
+			}
+		}
+	}
 	if len(collectedErrs) > 0 {
 		return nil, errors.NewCollectedFlyteAdminError(codes.InvalidArgument, collectedErrs)
 	}
@@ -252,6 +294,64 @@ type dynamicResource struct {
 	mapping *meta.RESTMapping
 }
 
+// Returns the version of a template with its values substituted, which changes whenever the resource applied does.
+func getTemplateVersion(config string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(config)))
+}
+
+// Returns the version of a set of template values, which changes whenever any of the values does.
+func getTemplateValuesVersion(templateValues templateValuesType) string {
+	templateKeys := make([]string, 0, len(templateValues))
+	for templateKey := range templateValues {
+		templateKeys = append(templateKeys, templateKey)
+	}
+	sort.Strings(templateKeys)
+	var values strings.Builder
+	for _, templateKey := range templateKeys {
+		values.WriteString(fmt.Sprintf("%s=%s\n", templateKey, templateValues[templateKey]))
+	}
+	return getTemplateVersion(values.String())
+}
+
+// Returns the paths of the fields which differ between a live resource and the resource as a template would make it,
+// leaving out the status and metadata managed by the api server.
+func getDriftedFields(live, desired map[string]interface{}) []string {
+	driftedFields := make([]string, 0)
+	for _, field := range sets.StringKeySet(live).Union(sets.StringKeySet(desired)).List() {
+		switch field {
+		case "status":
+			continue
+		case "metadata":
+			liveMetadata, _ := live[field].(map[string]interface{})
+			desiredMetadata, _ := desired[field].(map[string]interface{})
+			for _, metadataField := range []string{"labels", "annotations"} {
+				driftedFields = append(driftedFields, getDriftedFieldPaths(
+					"metadata."+metadataField, liveMetadata[metadataField], desiredMetadata[metadataField])...)
+			}
+		default:
+			driftedFields = append(driftedFields, getDriftedFieldPaths(field, live[field], desired[field])...)
+		}
+	}
+	return driftedFields
+}
+
+func getDriftedFieldPaths(path string, live, desired interface{}) []string {
+	liveFields, liveIsObject := live.(map[string]interface{})
+	desiredFields, desiredIsObject := desired.(map[string]interface{})
+	if !liveIsObject || !desiredIsObject {
+		if reflect.DeepEqual(live, desired) {
+			return nil
+		}
+		return []string{path}
+	}
+	driftedFields := make([]string, 0)
+	for _, field := range sets.StringKeySet(liveFields).Union(sets.StringKeySet(desiredFields)).List() {
+		driftedFields = append(driftedFields, getDriftedFieldPaths(
+			path+"."+field, liveFields[field], desiredFields[field])...)
+	}
+	return driftedFields
+}
+
 // This function borrows heavily from the excellent example code here:
 // https://ymmt2005.hatenablog.com/entry/2020/04/14/An_example_of_using_dynamic_client_of_k8s.io/client-go#Background-Server-Side-Apply
 // to dynamically discover the GroupVersionResource for the templatized k8s object from the cluster resource config files
@@ -282,12 +382,187 @@ func prepareDynamicCreate(target executioncluster.ExecutionTarget, config string
 	}, nil
 }
 
+// Applies a rendered template to a target cluster, creating the resource or updating it if it already exists.
+func (c *controller) applyTemplate(ctx context.Context, target executioncluster.ExecutionTarget, kind,
+	templateFileName, config string, namespace NamespaceName) error {
+	logger.Debugf(ctx, "Attempting to create resource [%+v] in cluster [%v] for namespace [%s]",
+		kind, target.ID, namespace)
+
+	dynamicObj, err := prepareDynamicCreate(target, config)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to transform kubernetes template file for [%+v] for namespace [%s] "+
+			"into a dynamic unstructured mapping with err: %v", kind, namespace, err)
+		c.metrics.KubernetesResourcesCreateErrors.Inc()
+		return err
+	}
+
+	dr := getDynamicResourceInterface(dynamicObj.mapping, target.DynamicClient, namespace)
+	_, err = dr.Create(ctx, dynamicObj.obj, metav1.CreateOptions{})
+
+	if err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			logger.Debugf(ctx, "Type [%+v] in namespace [%s] already exists - attempting update instead",
+				kind, namespace)
+			c.metrics.AppliedTemplateExists.Inc()
+
+			// Update can be performed in 1 of 2 ways. For specific kinds, like ServiceAccount, we use merge-patch.
+			// For all other kinds we use a simple update.
+			if ok := strategicPatchTypes[kind]; ok {
+				data, err := json.Marshal(dynamicObj.obj)
+				if err != nil {
+					c.metrics.TemplateUpdateErrors.Inc()
+					logger.Warningf(ctx, "Failed to marshal resource [%+v] in namespace [%s] to json with err: %v",
+						kind, namespace, err)
+					return err
+				}
+
+				dr := getDynamicResourceInterface(dynamicObj.mapping, target.DynamicClient, namespace)
+				_, err = dr.Patch(ctx, dynamicObj.obj.GetName(),
+					types.StrategicMergePatchType, data, metav1.PatchOptions{})
+				if err != nil {
+					c.metrics.TemplateUpdateErrors.Inc()
+					logger.Warningf(ctx, "Failed to merge patch resource [%+v] in namespace [%s] with err: %v",
+						kind, namespace, err)
+					return err
+				}
+			} else {
+				dr := getDynamicResourceInterface(dynamicObj.mapping, target.DynamicClient, namespace)
+				_, err = dr.Update(ctx, dynamicObj.obj, metav1.UpdateOptions{})
+				if err != nil && !k8serrors.IsAlreadyExists(err) {
+					c.metrics.TemplateUpdateErrors.Inc()
+					logger.Warningf(ctx, "Failed to dynamically update resource [%+v] in namespace [%s] with err :%v",
+						kind, namespace, err)
+					return err
+				}
+			}
+
+			logger.Debugf(ctx, "Successfully updated resource [%+v] in namespace [%s]",
+				kind, namespace)
+			return nil
+		}
+		// Some error other than AlreadyExists was raised when we tried to Create the k8s object.
+		c.metrics.KubernetesResourcesCreateErrors.Inc()
+		logger.Warningf(ctx, "Failed to create kubernetes object from config template [%s] for namespace [%s] with err: %v",
+			templateFileName, namespace, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal,
+			"Failed to create kubernetes object from config template [%s] for namespace [%s] with err: %v",
+			templateFileName, namespace, err)
+	}
+	logger.Debugf(ctx, "Created resource [%+v] for namespace [%s] in kubernetes",
+		kind, namespace)
+	c.metrics.KubernetesResourcesCreated.Inc()
+	return nil
+}
+
+// Returns the fields of the resource a template was applied as which differ from the template, using a server-side
+// dry-run of the same update applying the template makes so that fields defaulted by the api server don't count as
+// drift.
+func (c *controller) getResourceDrift(ctx context.Context, target executioncluster.ExecutionTarget, kind, config string,
+	namespace NamespaceName) (string, error) {
+	dynamicObj, err := prepareDynamicCreate(target, config)
+	if err != nil {
+		return "", err
+	}
+	dr := getDynamicResourceInterface(dynamicObj.mapping, target.DynamicClient, namespace)
+	live, err := dr.Get(ctx, dynamicObj.obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return resourceMissingDrift, nil
+		}
+		return "", err
+	}
+	var desired *unstructured.Unstructured
+	if strategicPatchTypes[kind] {
+		data, err := json.Marshal(dynamicObj.obj)
+		if err != nil {
+			return "", err
+		}
+		desired, err = dr.Patch(ctx, dynamicObj.obj.GetName(), types.StrategicMergePatchType, data,
+			metav1.PatchOptions{DryRun: []string{metav1.DryRunAll}})
+		if err != nil {
+			return "", err
+		}
+	} else {
+		desired, err = dr.Update(ctx, dynamicObj.obj, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+		if err != nil {
+			return "", err
+		}
+	}
+	driftedFields := getDriftedFields(live.Object, desired.Object)
+	if len(driftedFields) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("fields differ from the template: %s", strings.Join(driftedFields, ", ")), nil
+}
+
+func getClusterResourceSyncKey(project models.Project, domain runtimeInterfaces.Domain, cluster string,
+	templateFileName FileName) models.ClusterResourceSyncKey {
+	return models.ClusterResourceSyncKey{
+		Project:  project.Identifier,
+		Domain:   domain.ID,
+		Cluster:  cluster,
+		Template: templateFileName,
+	}
+}
+
+// Sync statuses are only recorded for operators' benefit, so failing to record one doesn't fail the sync.
+func (c *controller) recordApplied(ctx context.Context, applied models.ClusterResourceSync) {
+	appliedAt := time.Now()
+	applied.AppliedAt = &appliedAt
+	if err := c.db.ClusterResourceSyncRepo().RecordApplied(ctx, applied); err != nil {
+		c.metrics.SyncStatusRecordErrors.Inc()
+		logger.Warningf(ctx, "Failed to record that template [%s] was applied to namespace [%s] in cluster [%s] with err: %v",
+			applied.Template, applied.Namespace, applied.Cluster, err)
+	}
+}
+
+func (c *controller) recordApplyError(ctx context.Context, key models.ClusterResourceSyncKey, namespace NamespaceName,
+	applyErr error) {
+	applyErrorAt := time.Now()
+	if err := c.db.ClusterResourceSyncRepo().RecordApplyError(ctx, models.ClusterResourceSync{
+		ClusterResourceSyncKey: key,
+		Namespace:              namespace,
+		ApplyError:             applyErr.Error(),
+		ApplyErrorAt:           &applyErrorAt,
+	}); err != nil {
+		c.metrics.SyncStatusRecordErrors.Inc()
+		logger.Warningf(ctx, "Failed to record the error applying template [%s] to namespace [%s] in cluster [%s] with err: %v",
+			key.Template, namespace, key.Cluster, err)
+	}
+}
+
+// Records an error which prevented applying a template to any cluster, such as the template failing to decode.
+func (c *controller) recordApplyErrors(ctx context.Context, project models.Project, domain runtimeInterfaces.Domain,
+	templateFileName FileName, namespace NamespaceName, applyErr error) {
+	for _, target := range c.executionCluster.GetAllValidTargets() {
+		c.recordApplyError(ctx, getClusterResourceSyncKey(project, domain, target.ID, templateFileName), namespace,
+			applyErr)
+	}
+}
+
+func (c *controller) recordDrift(ctx context.Context, key models.ClusterResourceSyncKey, namespace NamespaceName,
+	drift string) {
+	driftCheckedAt := time.Now()
+	if err := c.db.ClusterResourceSyncRepo().RecordDrift(ctx, models.ClusterResourceSync{
+		ClusterResourceSyncKey: key,
+		Namespace:              namespace,
+		Drift:                  drift,
+		DriftCheckedAt:         &driftCheckedAt,
+	}); err != nil {
+		c.metrics.SyncStatusRecordErrors.Inc()
+		logger.Warningf(ctx, "Failed to record the drift of template [%s] in namespace [%s] in cluster [%s] with err: %v",
+			key.Template, namespace, key.Cluster, err)
+	}
+}
+
 // This function loops through the kubernetes resource template files in the configured template directory.
 // For each unapplied template file (wrt the namespace) this func attempts to
 //   1) read the template file
 //   2) substitute templatized variables with their resolved values
 //   3) decode the output of the above into a kubernetes resource
 //   4) create the resource on the kubernetes cluster and cache successful outcomes
+// With drift detection enabled, the resources of already applied template files are instead checked for drift.
+// The outcome for each template file and cluster is recorded as its sync status.
 func (c *controller) syncNamespace(ctx context.Context, project models.Project, domain runtimeInterfaces.Domain, namespace NamespaceName,
 	templateValues, customTemplateValues templateValuesType) error {
 	templateDir := c.config.ClusterResourceConfiguration().GetTemplatePath()
@@ -296,12 +571,24 @@ func (c *controller) syncNamespace(ctx context.Context, project models.Project,
 		c.lastAppliedTemplateDir = templateDir
 		c.appliedTemplates = make(NamespaceCache)
 	}
+	if customTemplateValues != nil {
+		// Templates are applied again once their values change, e.g. when the quota of the namespace is set.
+		valuesVersion := getTemplateValuesVersion(customTemplateValues)
+		if c.appliedTemplateValues == nil {
+			c.appliedTemplateValues = make(map[NamespaceName]string)
+		}
+		if appliedVersion, ok := c.appliedTemplateValues[namespace]; ok && appliedVersion != valuesVersion {
+			delete(c.appliedTemplates, namespace)
+		}
+		c.appliedTemplateValues[namespace] = valuesVersion
+	}
 	templateFiles, err := ioutil.ReadDir(templateDir)
 	if err != nil {
 		return errors.NewFlyteAdminErrorf(codes.Internal,
 			"Failed to read config template dir [%s] for namespace [%s] with err: %v",
 			namespace, templateDir, err)
 	}
+	driftDetection := c.config.ClusterResourceConfiguration().GetDriftDetection()
 
 	collectedErrs := make([]error, 0)
 	for _, templateFile := range templateFiles {
@@ -313,7 +600,8 @@ func (c *controller) syncNamespace(ctx context.Context, project models.Project,
 			continue
 		}
 
-		if c.templateAlreadyApplied(namespace, templateFile) {
+		alreadyApplied := c.templateAlreadyApplied(namespace, templateFile)
+		if alreadyApplied && !driftDetection {
 			// nothing to do.
 			logger.Debugf(ctx, "syncing namespace [%s]: templateFile [%s] already applied, nothing to do.", namespace, templateFile.Name())
 			continue
@@ -330,6 +618,7 @@ func (c *controller) syncNamespace(ctx context.Context, project models.Project,
 				templateFileName, namespace, err)
 			collectedErrs = append(collectedErrs, err)
 			c.metrics.TemplateReadErrors.Inc()
+			c.recordApplyErrors(ctx, project, domain, templateFileName, namespace, err)
 			continue
 		}
 		logger.Debugf(ctx, "successfully read template config file [%s]", templateFileName)
@@ -361,89 +650,57 @@ func (c *controller) syncNamespace(ctx context.Context, project models.Project,
 				templateFileName, namespace, err)
 			collectedErrs = append(collectedErrs, err)
 			c.metrics.TemplateDecodeErrors.Inc()
+			c.recordApplyErrors(ctx, project, domain, templateFileName, namespace, err)
+			continue
+		}
+
+		gvk := k8sObj.GetObjectKind().GroupVersionKind()
+		kind := gvk.Kind
+		if alreadyApplied {
+			for _, target := range c.executionCluster.GetAllValidTargets() {
+				drift, err := c.getResourceDrift(ctx, target, kind, config, namespace)
+				if err != nil {
+					// Failing to check for drift doesn't fail the sync, since the template was applied.
+					c.metrics.DriftCheckErrors.Inc()
+					logger.Warningf(ctx, "Failed to check resource [%+v] in namespace [%s] in cluster [%s] for drift with err: %v",
+						kind, namespace, target.ID, err)
+					continue
+				}
+				if len(drift) > 0 {
+					c.metrics.ResourcesDrifted.Inc()
+					logger.Infof(ctx, "Resource [%+v] from template [%s] in namespace [%s] in cluster [%s] drifted: %s",
+						kind, templateFileName, namespace, target.ID, drift)
+				}
+				c.recordDrift(ctx, getClusterResourceSyncKey(project, domain, target.ID, templateFileName), namespace,
+					drift)
+			}
 			continue
 		}
 
 		// 4) create the resource on the kubernetes cluster and cache successful outcomes
+		var resourceName string
+		if k8sObjMeta, err := meta.Accessor(k8sObj); err == nil {
+			resourceName = k8sObjMeta.GetName()
+		}
 		if _, ok := c.appliedTemplates[namespace]; !ok {
 			c.appliedTemplates[namespace] = make(LastModTimeCache)
 		}
 		for _, target := range c.executionCluster.GetAllValidTargets() {
-			k8sObjCopy := k8sObj.DeepCopyObject()
-			logger.Debugf(ctx, "Attempting to create resource [%+v] in cluster [%v] for namespace [%s]",
-				k8sObj.GetObjectKind().GroupVersionKind().Kind, target.ID, namespace)
-
-			dynamicObj, err := prepareDynamicCreate(target, config)
-			if err != nil {
-				logger.Warningf(ctx, "Failed to transform kubernetes template file for [%+v] for namespace [%s] "+
-					"into a dynamic unstructured mapping with err: %v", k8sObj.GetObjectKind().GroupVersionKind().Kind, namespace, err)
+			syncKey := getClusterResourceSyncKey(project, domain, target.ID, templateFileName)
+			if err := c.applyTemplate(ctx, target, kind, templateFileName, config, namespace); err != nil {
 				collectedErrs = append(collectedErrs, err)
-				c.metrics.KubernetesResourcesCreateErrors.Inc()
+				c.recordApplyError(ctx, syncKey, namespace, err)
 				continue
 			}
-
-			dr := getDynamicResourceInterface(dynamicObj.mapping, target.DynamicClient, namespace)
-			_, err = dr.Create(ctx, dynamicObj.obj, metav1.CreateOptions{})
-
-			if err != nil {
-				if k8serrors.IsAlreadyExists(err) {
-					logger.Debugf(ctx, "Type [%+v] in namespace [%s] already exists - attempting update instead",
-						k8sObj.GetObjectKind().GroupVersionKind().Kind, namespace)
-					c.metrics.AppliedTemplateExists.Inc()
-
-					// Update can be performed in 1 of 2 ways. For specific kinds, like ServiceAccount, we use merge-patch.
-					// For all other kinds we use a simple update.
-					if ok := strategicPatchTypes[k8sObjCopy.GetObjectKind().GroupVersionKind().Kind]; ok {
-						data, err := json.Marshal(dynamicObj.obj)
-						if err != nil {
-							c.metrics.TemplateUpdateErrors.Inc()
-							logger.Warningf(ctx, "Failed to marshal resource [%+v] in namespace [%s] to json with err: %v",
-								k8sObj.GetObjectKind().GroupVersionKind().Kind, namespace, err)
-							collectedErrs = append(collectedErrs, err)
-							continue
-						}
-
-						dr := getDynamicResourceInterface(dynamicObj.mapping, target.DynamicClient, namespace)
-						_, err = dr.Patch(ctx, dynamicObj.obj.GetName(),
-							types.StrategicMergePatchType, data, metav1.PatchOptions{})
-						if err != nil {
-							c.metrics.TemplateUpdateErrors.Inc()
-							logger.Warningf(ctx, "Failed to merge patch resource [%+v] in namespace [%s] with err: %v",
-								k8sObj.GetObjectKind().GroupVersionKind().Kind, namespace, err)
-							collectedErrs = append(collectedErrs, err)
-							continue
-						}
-					} else {
-						dr := getDynamicResourceInterface(dynamicObj.mapping, target.DynamicClient, namespace)
-						_, err = dr.Update(ctx, dynamicObj.obj, metav1.UpdateOptions{})
-						if err != nil && !k8serrors.IsAlreadyExists(err) {
-							c.metrics.TemplateUpdateErrors.Inc()
-							logger.Warningf(ctx, "Failed to dynamically update resource [%+v] in namespace [%s] with err :%v",
-								k8sObj.GetObjectKind().GroupVersionKind().Kind, namespace, err)
-							collectedErrs = append(collectedErrs, err)
-							continue
-						}
-					}
-
-					logger.Debugf(ctx, "Successfully updated resource [%+v] in namespace [%s]",
-						k8sObj.GetObjectKind().GroupVersionKind().Kind, namespace)
-					c.appliedTemplates[namespace][templateFile.Name()] = templateFile.ModTime()
-				} else {
-					// Some error other than AlreadyExists was raised when we tried to Create the k8s object.
-					c.metrics.KubernetesResourcesCreateErrors.Inc()
-					logger.Warningf(ctx, "Failed to create kubernetes object from config template [%s] for namespace [%s] with err: %v",
-						templateFileName, namespace, err)
-					err := errors.NewFlyteAdminErrorf(codes.Internal,
-						"Failed to create kubernetes object from config template [%s] for namespace [%s] with err: %v",
-						templateFileName, namespace, err)
-					collectedErrs = append(collectedErrs, err)
-				}
-			} else {
-				logger.Debugf(ctx, "Created resource [%+v] for namespace [%s] in kubernetes",
-					k8sObj.GetObjectKind().GroupVersionKind().Kind, namespace)
-				c.metrics.KubernetesResourcesCreated.Inc()
-				c.appliedTemplates[namespace][templateFile.Name()] = templateFile.ModTime()
-			}
+			c.appliedTemplates[namespace][templateFile.Name()] = templateFile.ModTime()
+			c.recordApplied(ctx, models.ClusterResourceSync{
+				ClusterResourceSyncKey: syncKey,
+				Namespace:              namespace,
+				ResourceAPIVersion:     gvk.GroupVersion().String(),
+				ResourceKind:           kind,
+				ResourceName:           resourceName,
+				AppliedVersion:         getTemplateVersion(config),
+			})
 		}
 	}
 	if len(collectedErrs) > 0 {
@@ -474,7 +731,10 @@ func (c *controller) Sync(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	domains := c.config.ApplicationConfiguration().GetDomainsConfig()
+	domains, err := shared.GetDomains(ctx, c.db, c.config.ApplicationConfiguration())
+	if err != nil {
+		return err
+	}
 	var errs = make([]error, 0)
 	templateValues, err := populateTemplateValues(c.config.ClusterResourceConfiguration().GetTemplateData())
 	if err != nil {
@@ -488,7 +748,7 @@ func (c *controller) Sync(ctx context.Context) error {
 	}
 
 	for _, project := range projects {
-		for _, domain := range *domains {
+		for _, domain := range domains {
 			namespace := common.GetNamespaceName(c.config.NamespaceMappingConfiguration().GetNamespaceTemplate(), project.Identifier, domain.Name)
 			customTemplateValues, err := c.getCustomTemplateValues(
 				ctx, project.Identifier, domain.ID, domainTemplateValues[domain.ID])
@@ -507,17 +767,20 @@ func (c *controller) Sync(ctx context.Context) error {
 			}
 		}
 	}
+	if err := c.cleanupRetiredNamespaces(ctx, projects, domains); err != nil {
+		logger.Warningf(ctx, "Failed to clean up the namespaces of archived projects and removed domains with err: %v", err)
+		errs = append(errs, err)
+	}
 	if len(errs) > 0 {
 		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
 	}
 	return nil
 }
 
-func (c *controller) Run() {
-	ctx := context.Background()
+func (c *controller) Run(ctx context.Context) {
 	logger.Debugf(ctx, "Running ClusterResourceController")
 	interval := c.config.ClusterResourceConfiguration().GetRefreshInterval()
-	wait.Forever(func() {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
 		err := c.Sync(ctx)
 		if err != nil {
 			logger.Warningf(ctx, "Failed cluster resource creation loop with: %v", err)
@@ -548,6 +811,16 @@ func newMetrics(scope promutils.Scope) controllerMetrics {
 		TemplateUpdateErrors: scope.MustNewCounter("template_update_errors",
 			"Number of times an attempt at updating an already existing kubernetes resource with a template"+
 				"file failed"),
+		ResourcesDrifted: scope.MustNewCounter("resources_drifted",
+			"Number of times a resource in kubernetes was found to differ from its already applied template"),
+		DriftCheckErrors: scope.MustNewCounter("drift_check_errors",
+			"Number of times checking a resource in kubernetes for drift from its template failed"),
+		SyncStatusRecordErrors: scope.MustNewCounter("sync_status_record_errors",
+			"Number of times recording the sync status of a template failed"),
+		ResourcesCleanedUp: scope.MustNewCounter("resources_cleaned_up",
+			"overall count of resources deleted or cordoned in the namespaces of archived projects and removed domains"),
+		NamespaceCleanupErrors: scope.MustNewCounter("namespace_cleanup_errors",
+			"errors encountered cleaning up or restoring the namespaces of archived projects and removed domains"),
 		Panics: scope.MustNewCounter("panics",
 			"overall count of panics encountered in primary ClusterResourceController loop"),
 	}
@@ -556,12 +829,16 @@ func newMetrics(scope promutils.Scope) controllerMetrics {
 func NewClusterResourceController(db repositories.RepositoryInterface, executionCluster interfaces.ClusterInterface, scope promutils.Scope) Controller {
 	config := runtime.NewConfigurationProvider()
 	return &controller{
-		db:               db,
-		config:           config,
-		executionCluster: executionCluster,
-		resourceManager:  resources.NewResourceManager(db, config.ApplicationConfiguration()),
-		poller:           make(chan struct{}),
-		metrics:          newMetrics(scope),
-		appliedTemplates: make(map[string]map[string]time.Time),
+		db:                    db,
+		config:                config,
+		executionCluster:      executionCluster,
+		resourceManager:       resources.NewResourceManager(db, config.ApplicationConfiguration()),
+		poller:                make(chan struct{}),
+		metrics:               newMetrics(scope),
+		appliedTemplates:      make(map[string]map[string]time.Time),
+		appliedTemplateValues: make(map[NamespaceName]string),
+		deleteResource:        deleteAppliedResource,
+		cordonNamespace:       cordonNamespace,
+		uncordonNamespace:     uncordonNamespace,
 	}
 }
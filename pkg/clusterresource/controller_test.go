@@ -229,3 +229,94 @@ func TestGetCustomTemplateValues_InvalidDBModel(t *testing.T) {
 	assert.NotNil(t, err,
 		"invalid project-domain combinations in the db should result in the config defaults being applied")
 }
+
+func TestGetCustomTemplateValues_DomainQuota(t *testing.T) {
+	mockRepository := repositoryMocks.NewMockRepository()
+	mockRepository.DomainQuotaRepo().(*repositoryMocks.MockDomainQuotaRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.DomainQuota, error) {
+		assert.Equal(t, "project-foo", project)
+		assert.Equal(t, "domain-bar", domain)
+		return models.DomainQuota{
+			CPU:    "16",
+			Memory: "64Gi",
+		}, nil
+	}
+	testController := controller{
+		db:              mockRepository,
+		resourceManager: resources.NewResourceManager(mockRepository, testutils.GetApplicationConfigWithDefaultDomains()),
+	}
+	customTemplateValues, err := testController.getCustomTemplateValues(context.Background(), "project-foo", "domain-bar", templateValuesType{
+		"{{ projectQuotaCpu }}": "8",
+		"{{ projectQuotaGpu }}": "1",
+	})
+	assert.Nil(t, err)
+	assert.EqualValues(t, templateValuesType{
+		"{{ projectQuotaCpu }}":    "16",
+		"{{ projectQuotaMemory }}": "64Gi",
+		"{{ projectQuotaGpu }}":    "1",
+	}, customTemplateValues, "caps which aren't set should leave the configured values in place")
+}
+
+func TestGetTemplateValuesVersion(t *testing.T) {
+	version := getTemplateValuesVersion(templateValuesType{
+		"{{ projectQuotaCpu }}":    "16",
+		"{{ projectQuotaMemory }}": "64Gi",
+	})
+	assert.Equal(t, version, getTemplateValuesVersion(templateValuesType{
+		"{{ projectQuotaMemory }}": "64Gi",
+		"{{ projectQuotaCpu }}":    "16",
+	}))
+	assert.NotEqual(t, version, getTemplateValuesVersion(templateValuesType{
+		"{{ projectQuotaCpu }}":    "32",
+		"{{ projectQuotaMemory }}": "64Gi",
+	}))
+}
+
+func TestGetDriftedFields(t *testing.T) {
+	live := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ResourceQuota",
+		"metadata": map[string]interface{}{
+			"name":            "project-quota",
+			"resourceVersion": "12",
+			"labels": map[string]interface{}{
+				"team": "ml",
+			},
+		},
+		"spec": map[string]interface{}{
+			"hard": map[string]interface{}{
+				"limits.cpu":    "16",
+				"limits.memory": "64Gi",
+			},
+		},
+		"status": map[string]interface{}{
+			"used": map[string]interface{}{
+				"limits.cpu": "2",
+			},
+		},
+	}
+	desired := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ResourceQuota",
+		"metadata": map[string]interface{}{
+			"name":            "project-quota",
+			"resourceVersion": "13",
+		},
+		"spec": map[string]interface{}{
+			"hard": map[string]interface{}{
+				"limits.cpu":    "8",
+				"limits.memory": "64Gi",
+			},
+		},
+	}
+	assert.Equal(t, []string{"metadata.labels", "spec.hard.limits.cpu"}, getDriftedFields(live, desired))
+
+	delete(live["metadata"].(map[string]interface{}), "labels")
+	live["spec"].(map[string]interface{})["hard"].(map[string]interface{})["limits.cpu"] = "8"
+	assert.Empty(t, getDriftedFields(live, desired))
+}
+
+func TestGetTemplateVersion(t *testing.T) {
+	assert.Equal(t, getTemplateVersion("kind: Namespace"), getTemplateVersion("kind: Namespace"))
+	assert.NotEqual(t, getTemplateVersion("kind: Namespace"), getTemplateVersion("kind: ResourceQuota"))
+}
@@ -0,0 +1,230 @@
+package clusterresource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+)
+
+// The resource quota which prevents new pods in cordoned namespaces.
+const cordonResourceQuotaName = "flyte-namespace-cordon"
+const namespaceKind = "Namespace"
+
+// Deletes the resource a template was applied as from a cluster.
+func deleteAppliedResource(
+	ctx context.Context, target executioncluster.ExecutionTarget, syncModel models.ClusterResourceSync) error {
+	dc, err := discovery.NewDiscoveryClientForConfig(&target.Config)
+	if err != nil {
+		return err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc))
+	gvk := schema.FromAPIVersionAndKind(syncModel.ResourceAPIVersion, syncModel.ResourceKind)
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return err
+	}
+	err = getDynamicResourceInterface(mapping, target.DynamicClient, syncModel.Namespace).Delete(
+		ctx, syncModel.ResourceName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// Creates a resource quota preventing new pods in a namespace.
+func cordonNamespace(ctx context.Context, target executioncluster.ExecutionTarget, namespace NamespaceName) error {
+	err := target.Client.Create(ctx, &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cordonResourceQuotaName,
+			Namespace: namespace,
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{
+				corev1.ResourcePods: resource.MustParse("0"),
+			},
+		},
+	})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func uncordonNamespace(ctx context.Context, target executioncluster.ExecutionTarget, namespace NamespaceName) error {
+	err := target.Client.Delete(ctx, &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cordonResourceQuotaName,
+			Namespace: namespace,
+		},
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func getProjectDomainKey(project, domain string) string {
+	return fmt.Sprintf("%s/%s", project, domain)
+}
+
+func (c *controller) recordCleanup(ctx context.Context, syncModel models.ClusterResourceSync, retiredAt,
+	cleanedUpAt *time.Time, cleanup string) {
+	syncModel.RetiredAt = retiredAt
+	syncModel.CleanedUpAt = cleanedUpAt
+	syncModel.Cleanup = cleanup
+	if err := c.db.ClusterResourceSyncRepo().RecordCleanup(ctx, syncModel); err != nil {
+		c.metrics.SyncStatusRecordErrors.Inc()
+		logger.Warningf(ctx, "Failed to record the cleanup of template [%s] in namespace [%s] in cluster [%s] with err: %v",
+			syncModel.Template, syncModel.Namespace, syncModel.Cluster, err)
+	}
+}
+
+// Restores a namespace whose project or domain was restored after it was found retired. Its templates are applied
+// again on the next sync should they have been cleaned up.
+func (c *controller) restoreNamespace(ctx context.Context, syncModel models.ClusterResourceSync,
+	targets map[string]executioncluster.ExecutionTarget, uncordoned sets.String) error {
+	if syncModel.Cleanup == models.ClusterResourceCleanupCordoned {
+		target, ok := targets[syncModel.Cluster]
+		if !ok {
+			// The cluster was since removed or disabled.
+			return nil
+		}
+		namespaceKey := fmt.Sprintf("%s/%s", target.ID, syncModel.Namespace)
+		if !uncordoned.Has(namespaceKey) {
+			if err := c.uncordonNamespace(ctx, target, syncModel.Namespace); err != nil {
+				return err
+			}
+			uncordoned.Insert(namespaceKey)
+			logger.Infof(ctx, "Uncordoned restored namespace [%s] in cluster [%s]", syncModel.Namespace, target.ID)
+		}
+	}
+	if namespacedAppliedTemplates, ok := c.appliedTemplates[syncModel.Namespace]; ok {
+		delete(namespacedAppliedTemplates, syncModel.Template)
+	}
+	c.recordCleanup(ctx, syncModel, nil, nil, "")
+	return nil
+}
+
+// Cleans up the namespaces of archived projects and removed domains once the configured grace period since they were
+// found retired passes, either deleting the resources created from templates or cordoning the namespaces. What was
+// cleaned up is recorded in the sync statuses of the templates.
+func (c *controller) cleanupRetiredNamespaces(
+	ctx context.Context, projects []models.Project, domains runtimeInterfaces.DomainsConfig) error {
+	cleanupConfig := c.config.ClusterResourceConfiguration().GetNamespaceCleanupConfig()
+	if !cleanupConfig.Enabled {
+		return nil
+	}
+	var cleanup string
+	switch cleanupConfig.Mode {
+	case runtimeInterfaces.NamespaceCleanupModeDelete:
+		cleanup = models.ClusterResourceCleanupDeleted
+	case runtimeInterfaces.NamespaceCleanupModeCordon:
+		cleanup = models.ClusterResourceCleanupCordoned
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"unrecognized namespace cleanup mode [%s]", cleanupConfig.Mode)
+	}
+	activeNamespaces := sets.NewString()
+	for _, project := range projects {
+		for _, domain := range domains {
+			activeNamespaces.Insert(getProjectDomainKey(project.Identifier, domain.ID))
+		}
+	}
+	syncModels, err := c.db.ClusterResourceSyncRepo().ListAll(ctx)
+	if err != nil {
+		return err
+	}
+	targets := make(map[string]executioncluster.ExecutionTarget)
+	for _, target := range c.executionCluster.GetAllValidTargets() {
+		targets[target.ID] = target
+	}
+	// Namespaces are deleted after the resources in them.
+	sort.SliceStable(syncModels, func(i, j int) bool {
+		return syncModels[i].ResourceKind != namespaceKind && syncModels[j].ResourceKind == namespaceKind
+	})
+
+	now := time.Now()
+	cordoned := sets.NewString()
+	uncordoned := sets.NewString()
+	collectedErrs := make([]error, 0)
+	for _, syncModel := range syncModels {
+		if activeNamespaces.Has(getProjectDomainKey(syncModel.Project, syncModel.Domain)) {
+			if syncModel.RetiredAt != nil {
+				if err := c.restoreNamespace(ctx, syncModel, targets, uncordoned); err != nil {
+					c.metrics.NamespaceCleanupErrors.Inc()
+					logger.Warningf(ctx, "Failed to restore namespace [%s] in cluster [%s] with err: %v",
+						syncModel.Namespace, syncModel.Cluster, err)
+					collectedErrs = append(collectedErrs, err)
+				}
+			}
+			continue
+		}
+		if syncModel.CleanedUpAt != nil {
+			continue
+		}
+		if syncModel.RetiredAt == nil {
+			logger.Infof(ctx, "Namespace [%s] in cluster [%s] was retired, it will be cleaned up after %v",
+				syncModel.Namespace, syncModel.Cluster, cleanupConfig.GracePeriod.Duration)
+			c.recordCleanup(ctx, syncModel, &now, nil, "")
+			continue
+		}
+		if now.Sub(*syncModel.RetiredAt) < cleanupConfig.GracePeriod.Duration {
+			continue
+		}
+		target, ok := targets[syncModel.Cluster]
+		if !ok {
+			// The cluster was since removed or disabled.
+			continue
+		}
+		if cleanup == models.ClusterResourceCleanupDeleted {
+			// Templates which failed to apply didn't create anything.
+			if len(syncModel.ResourceKind) > 0 {
+				if err := c.deleteResource(ctx, target, syncModel); err != nil {
+					c.metrics.NamespaceCleanupErrors.Inc()
+					logger.Warningf(ctx, "Failed to delete %s [%s] in retired namespace [%s] in cluster [%s] with err: %v",
+						syncModel.ResourceKind, syncModel.ResourceName, syncModel.Namespace, target.ID, err)
+					collectedErrs = append(collectedErrs, err)
+					continue
+				}
+				logger.Infof(ctx, "Deleted %s [%s] created from template [%s] in retired namespace [%s] in cluster [%s]",
+					syncModel.ResourceKind, syncModel.ResourceName, syncModel.Template, syncModel.Namespace, target.ID)
+			}
+		} else {
+			namespaceKey := fmt.Sprintf("%s/%s", target.ID, syncModel.Namespace)
+			if !cordoned.Has(namespaceKey) {
+				if err := c.cordonNamespace(ctx, target, syncModel.Namespace); err != nil {
+					c.metrics.NamespaceCleanupErrors.Inc()
+					logger.Warningf(ctx, "Failed to cordon retired namespace [%s] in cluster [%s] with err: %v",
+						syncModel.Namespace, target.ID, err)
+					collectedErrs = append(collectedErrs, err)
+					continue
+				}
+				cordoned.Insert(namespaceKey)
+				logger.Infof(ctx, "Cordoned retired namespace [%s] in cluster [%s]", syncModel.Namespace, target.ID)
+			}
+		}
+		c.metrics.ResourcesCleanedUp.Inc()
+		c.recordCleanup(ctx, syncModel, syncModel.RetiredAt, &now, cleanup)
+	}
+	if len(collectedErrs) > 0 {
+		return errors.NewCollectedFlyteAdminError(codes.Internal, collectedErrs)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package clusterresource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	executionclusterMocks "github.com/flyteorg/flyteadmin/pkg/executioncluster/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flytestdlib/config"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getSyncModel(project, template, kind string, retiredAt *time.Time) models.ClusterResourceSync {
+	return models.ClusterResourceSync{
+		ClusterResourceSyncKey: models.ClusterResourceSyncKey{
+			Project:  project,
+			Domain:   "development",
+			Cluster:  "cluster",
+			Template: template,
+		},
+		Namespace:          project + "-development",
+		ResourceAPIVersion: "v1",
+		ResourceKind:       kind,
+		ResourceName:       template,
+		RetiredAt:          retiredAt,
+	}
+}
+
+func getControllerForCleanupTest(mode string, syncModels []models.ClusterResourceSync) (
+	*controller, *[]models.ClusterResourceSync) {
+	mockRepository := repositoryMocks.NewMockRepository()
+	syncRepo := mockRepository.ClusterResourceSyncRepo().(*repositoryMocks.ClusterResourceSyncRepoInterface)
+	syncRepo.OnListAllMatch(mock.Anything).Return(syncModels, nil)
+	recorded := make([]models.ClusterResourceSync, 0)
+	syncRepo.OnRecordCleanupMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		recorded = append(recorded, args.Get(1).(models.ClusterResourceSync))
+	})
+	mockCluster := executionclusterMocks.MockCluster{}
+	mockCluster.SetGetAllValidTargetsCallback(func() []executioncluster.ExecutionTarget {
+		return []executioncluster.ExecutionTarget{{ID: "cluster", Enabled: true}}
+	})
+	mockConfig := runtimeMocks.NewMockConfigurationProvider(
+		testutils.GetApplicationConfigWithDefaultDomains(), nil, nil, nil, nil, nil)
+	mockConfig.(*runtimeMocks.MockConfigurationProvider).AddClusterResourceConfiguration(
+		runtimeMocks.MockClusterResourceConfiguration{
+			NamespaceCleanup: runtimeInterfaces.NamespaceCleanupConfig{
+				Enabled:     true,
+				GracePeriod: config.Duration{Duration: 24 * time.Hour},
+				Mode:        mode,
+			},
+		})
+	return &controller{
+		db:               mockRepository,
+		config:           mockConfig,
+		executionCluster: &mockCluster,
+		metrics:          newMetrics(mockScope.NewTestScope()),
+		appliedTemplates: make(NamespaceCache),
+	}, &recorded
+}
+
+func TestCleanupRetiredNamespaces_Delete(t *testing.T) {
+	longAgo := time.Now().Add(-48 * time.Hour)
+	recently := time.Now().Add(-time.Hour)
+	testController, recorded := getControllerForCleanupTest(runtimeInterfaces.NamespaceCleanupModeDelete,
+		[]models.ClusterResourceSync{
+			getSyncModel("archived", "namespace.yaml", namespaceKind, &longAgo),
+			getSyncModel("archived", "quota.yaml", "ResourceQuota", &longAgo),
+			getSyncModel("recent", "quota.yaml", "ResourceQuota", &recently),
+			getSyncModel("new", "quota.yaml", "ResourceQuota", nil),
+			getSyncModel("active", "quota.yaml", "ResourceQuota", nil),
+		})
+	deleted := make([]string, 0)
+	testController.deleteResource = func(
+		ctx context.Context, target executioncluster.ExecutionTarget, syncModel models.ClusterResourceSync) error {
+		deleted = append(deleted, syncModel.Namespace+"/"+syncModel.ResourceKind)
+		return nil
+	}
+
+	assert.NoError(t, testController.cleanupRetiredNamespaces(context.Background(),
+		[]models.Project{{Identifier: "active"}}, *testutils.GetApplicationConfigWithDefaultDomains().GetDomainsConfig()))
+	// The namespace is deleted after the resources in it.
+	assert.Equal(t, []string{"archived-development/ResourceQuota", "archived-development/Namespace"}, deleted)
+	assert.Len(t, *recorded, 3)
+	for _, syncModel := range []models.ClusterResourceSync{(*recorded)[0], (*recorded)[2]} {
+		assert.Equal(t, "archived", syncModel.Project)
+		assert.Equal(t, models.ClusterResourceCleanupDeleted, syncModel.Cleanup)
+		assert.NotNil(t, syncModel.CleanedUpAt)
+	}
+	assert.Equal(t, "new", (*recorded)[1].Project)
+	assert.NotNil(t, (*recorded)[1].RetiredAt)
+	assert.Nil(t, (*recorded)[1].CleanedUpAt)
+}
+
+func TestCleanupRetiredNamespaces_CordonAndRestore(t *testing.T) {
+	longAgo := time.Now().Add(-48 * time.Hour)
+	restored := getSyncModel("restored", "quota.yaml", "ResourceQuota", &longAgo)
+	restored.CleanedUpAt = &longAgo
+	restored.Cleanup = models.ClusterResourceCleanupCordoned
+	testController, recorded := getControllerForCleanupTest(runtimeInterfaces.NamespaceCleanupModeCordon,
+		[]models.ClusterResourceSync{
+			getSyncModel("archived", "namespace.yaml", namespaceKind, &longAgo),
+			getSyncModel("archived", "quota.yaml", "ResourceQuota", &longAgo),
+			restored,
+		})
+	testController.appliedTemplates["restored-development"] = LastModTimeCache{"quota.yaml": longAgo}
+	cordoned := make([]string, 0)
+	testController.cordonNamespace = func(
+		ctx context.Context, target executioncluster.ExecutionTarget, namespace NamespaceName) error {
+		cordoned = append(cordoned, namespace)
+		return nil
+	}
+	uncordoned := make([]string, 0)
+	testController.uncordonNamespace = func(
+		ctx context.Context, target executioncluster.ExecutionTarget, namespace NamespaceName) error {
+		uncordoned = append(uncordoned, namespace)
+		return nil
+	}
+
+	assert.NoError(t, testController.cleanupRetiredNamespaces(context.Background(),
+		[]models.Project{{Identifier: "restored"}}, *testutils.GetApplicationConfigWithDefaultDomains().GetDomainsConfig()))
+	assert.Equal(t, []string{"archived-development"}, cordoned)
+	assert.Equal(t, []string{"restored-development"}, uncordoned)
+	// The restored namespace's templates are applied again on the next sync.
+	assert.Empty(t, testController.appliedTemplates["restored-development"])
+	assert.Len(t, *recorded, 3)
+	assert.Equal(t, models.ClusterResourceCleanupCordoned, (*recorded)[0].Cleanup)
+	assert.Equal(t, "restored", (*recorded)[1].Project)
+	assert.Nil(t, (*recorded)[1].RetiredAt)
+	assert.Empty(t, (*recorded)[1].Cleanup)
+	assert.Equal(t, models.ClusterResourceCleanupCordoned, (*recorded)[2].Cleanup)
+}
@@ -7,6 +7,7 @@ type CloudProvider = string
 const (
 	AWS   CloudProvider = "aws"
 	GCP   CloudProvider = "gcp"
+	Azure CloudProvider = "azure"
 	Local CloudProvider = "local"
 	None  CloudProvider = "none"
 )
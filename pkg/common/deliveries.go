@@ -0,0 +1,8 @@
+package common
+
+// The statuses of deliveries of notifications and to registered webhooks.
+const (
+	DeliveryPending   = "PENDING"
+	DeliverySucceeded = "SUCCEEDED"
+	DeliveryFailed    = "FAILED"
+)
@@ -7,16 +7,31 @@ import (
 type Entity = string
 
 const (
-	Execution           = "e"
-	LaunchPlan          = "l"
-	NodeExecution       = "ne"
-	NodeExecutionEvent  = "nee"
-	Task                = "t"
-	TaskExecution       = "te"
-	Workflow            = "w"
-	NamedEntity         = "nen"
-	NamedEntityMetadata = "nem"
-	Project             = "p"
+	Backfill                 = "b"
+	DomainQuota              = "dq"
+	Execution                = "e"
+	LaunchPlan               = "l"
+	LaunchPlanRollout        = "lr"
+	NodeExecution            = "ne"
+	NodeExecutionEvent       = "nee"
+	Task                     = "t"
+	TaskExecution            = "te"
+	TaskExecutionUsage       = "teu"
+	APIUsage                 = "au"
+	SkippedEvent             = "se"
+	RecordedEvent            = "re"
+	TaskExecutionArtifact    = "tea"
+	ExecutionRelationship    = "er"
+	Webhook                  = "wh"
+	WebhookDelivery          = "wd"
+	NotificationDelivery     = "nd"
+	NotificationSubscription = "ns"
+	Org                      = "o"
+	Trigger                  = "tr"
+	Workflow                 = "w"
+	NamedEntity              = "nen"
+	NamedEntityMetadata      = "nem"
+	Project                  = "p"
 )
 
 // ResourceTypeToEntity maps a resource type to an entity suitable for use with Database filters
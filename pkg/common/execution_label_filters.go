@@ -0,0 +1,135 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const labelField = "label.%s"
+
+// Selects the labels with a given key of the execution in the outer query's table.
+const executionLabelSubquery = "SELECT 1 FROM execution_labels WHERE " +
+	"execution_labels.execution_project = %[1]s.execution_project AND " +
+	"execution_labels.execution_domain = %[1]s.execution_domain AND " +
+	"execution_labels.execution_name = %[1]s.execution_name AND execution_labels.key = ?"
+
+// Selects the value of the label with a given key of each execution, for ordering executions by it.
+const executionLabelValueSubquery = "(SELECT execution_labels.value FROM execution_labels WHERE " +
+	"execution_labels.execution_project = executions.execution_project AND " +
+	"execution_labels.execution_domain = executions.execution_domain AND " +
+	"execution_labels.execution_name = executions.execution_name AND execution_labels.key = '%s')"
+
+// String formats for label filter queries, given the subquery selecting labels and the table they're stored in.
+const (
+	labelEqualQuery   = "EXISTS (%s AND %s.value = ?)"
+	labelValueInQuery = "EXISTS (%s AND %s.value in (?))"
+	labelExistsQuery  = "EXISTS (%s)"
+	labelMissingQuery = "NOT EXISTS (%s)"
+)
+
+// Set of filters which can be applied to execution and project labels.
+var labelFilters = map[FilterExpression]bool{
+	Equal:     true,
+	ValueIn:   true,
+	IsNull:    true,
+	IsNotNull: true,
+}
+
+// Matches executions on the labels applied to them. Labels are stored in their own table, which is queried with a
+// correlated subquery rather than joined so that executions with several matching labels are only listed once.
+type executionLabelFilter struct {
+	function FilterExpression
+	key      string
+	value    interface{}
+}
+
+func (f *executionLabelFilter) GetEntity() Entity {
+	return Execution
+}
+
+func (f *executionLabelFilter) GetField() string {
+	return fmt.Sprintf(labelField, f.key)
+}
+
+// Label filters must reference the executions table, and are only supported on scoped queries.
+func (f *executionLabelFilter) GetGormQueryExpr() (GormQueryExpr, error) {
+	return GormQueryExpr{}, GetUnsupportedFilterExpressionErr(f.function)
+}
+
+func (f *executionLabelFilter) GetGormJoinTableQueryExpr(tableName string) (GormQueryExpr, error) {
+	return getLabelQueryExpr(
+		f.function, fmt.Sprintf(executionLabelSubquery, tableName), "execution_labels", f.key, f.value)
+}
+
+// Returns the query matching the labels selected by subquery from labelTable.
+func getLabelQueryExpr(function FilterExpression, subquery, labelTable, key string, value interface{}) (
+	GormQueryExpr, error) {
+	switch function {
+	case Equal:
+		return GormQueryExpr{
+			Query:    fmt.Sprintf(labelEqualQuery, subquery, labelTable),
+			ArgsList: []interface{}{key, value},
+		}, nil
+	case ValueIn:
+		return GormQueryExpr{
+			Query:    fmt.Sprintf(labelValueInQuery, subquery, labelTable),
+			ArgsList: []interface{}{key, value},
+		}, nil
+	case IsNotNull:
+		return GormQueryExpr{
+			Query:    fmt.Sprintf(labelExistsQuery, subquery),
+			ArgsList: []interface{}{key},
+		}, nil
+	case IsNull:
+		return GormQueryExpr{
+			Query:    fmt.Sprintf(labelMissingQuery, subquery),
+			ArgsList: []interface{}{key},
+		}, nil
+	}
+	return GormQueryExpr{}, GetUnsupportedFilterExpressionErr(function)
+}
+
+// Returns a filter on the value of the execution label with the given key. Null checks match executions without and
+// with the label respectively, and take no value.
+func NewExecutionLabelFilter(function FilterExpression, key string, value interface{}) (InlineFilter, error) {
+	if !labelFilters[function] {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unsupported execution label filter expression: %s",
+			getFilterExpressionName(function))
+	}
+	return &executionLabelFilter{
+		function: function,
+		key:      key,
+		value:    value,
+	}, nil
+}
+
+// Returns an execution label filter, given the name of its function.
+func NewInlineExecutionLabelFilter(function string, key string, value interface{}) (InlineFilter, error) {
+	expression, ok := filterNameMappings[function]
+	if !ok {
+		logger.Debugf(context.Background(), "can't create filter for unrecognized function: %s", function)
+		return nil, GetUnrecognizedFilterFunctionErr(function)
+	}
+	return NewExecutionLabelFilter(expression, key, value)
+}
+
+// Returns a sort parameter ordering executions by the value of the label with the given key. Executions without the
+// label are ordered as though its value were null. The key is part of the order expression rather than an argument of
+// it, so it must be a valid label key.
+func NewExecutionLabelSortParameter(sort admin.Sort, key string) (SortParameter, error) {
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid label key [%s] to sort by: %s", key,
+			strings.Join(errs, ", "))
+	}
+	return NewSortParameter(admin.Sort{
+		Key:       fmt.Sprintf(executionLabelValueSubquery, key),
+		Direction: sort.Direction,
+	})
+}
@@ -0,0 +1,83 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+const expectedLabelSubquery = "SELECT 1 FROM execution_labels WHERE " +
+	"execution_labels.execution_project = executions.execution_project AND " +
+	"execution_labels.execution_domain = executions.execution_domain AND " +
+	"execution_labels.execution_name = executions.execution_name AND execution_labels.key = ?"
+
+func TestExecutionLabelFilter(t *testing.T) {
+	filter, err := NewInlineExecutionLabelFilter("eq", "team", "ml")
+	assert.NoError(t, err)
+	assert.Equal(t, Execution, filter.GetEntity())
+	assert.Equal(t, "label.team", filter.GetField())
+
+	gormQueryExpr, err := filter.GetGormJoinTableQueryExpr("executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "EXISTS ("+expectedLabelSubquery+" AND execution_labels.value = ?)", gormQueryExpr.Query)
+	assert.Equal(t, []interface{}{"team", "ml"}, gormQueryExpr.GetArgs())
+
+	_, err = filter.GetGormQueryExpr()
+	assert.EqualError(t, err, "unsupported filter expression: equal")
+}
+
+func TestExecutionLabelFilter_ValueIn(t *testing.T) {
+	filter, err := NewExecutionLabelFilter(ValueIn, "team", []string{"ml", "infra"})
+	assert.NoError(t, err)
+
+	gormQueryExpr, err := filter.GetGormJoinTableQueryExpr("executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "EXISTS ("+expectedLabelSubquery+" AND execution_labels.value in (?))", gormQueryExpr.Query)
+	assert.Equal(t, []interface{}{"team", []string{"ml", "infra"}}, gormQueryExpr.GetArgs())
+}
+
+func TestExecutionLabelFilter_NullChecks(t *testing.T) {
+	filter, err := NewInlineExecutionLabelFilter("is_not_null", "team", nil)
+	assert.NoError(t, err)
+
+	gormQueryExpr, err := filter.GetGormJoinTableQueryExpr("executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "EXISTS ("+expectedLabelSubquery+")", gormQueryExpr.Query)
+	assert.Equal(t, []interface{}{"team"}, gormQueryExpr.GetArgs())
+
+	filter, err = NewInlineExecutionLabelFilter("is_null", "team", nil)
+	assert.NoError(t, err)
+
+	gormQueryExpr, err = filter.GetGormJoinTableQueryExpr("executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "NOT EXISTS ("+expectedLabelSubquery+")", gormQueryExpr.Query)
+	assert.Equal(t, []interface{}{"team"}, gormQueryExpr.GetArgs())
+}
+
+func TestExecutionLabelFilter_Unsupported(t *testing.T) {
+	_, err := NewInlineExecutionLabelFilter("gt", "team", "ml")
+	assert.EqualError(t, err, "unsupported execution label filter expression: greater than")
+
+	_, err = NewInlineExecutionLabelFilter("foo", "team", "ml")
+	assert.EqualError(t, err, "unrecognized filter function: foo")
+}
+
+func TestNewExecutionLabelSortParameter(t *testing.T) {
+	sortParameter, err := NewExecutionLabelSortParameter(admin.Sort{
+		Key:       "label.team",
+		Direction: admin.Sort_DESCENDING,
+	}, "team")
+	assert.NoError(t, err)
+	assert.Equal(t, "(SELECT execution_labels.value FROM execution_labels WHERE "+
+		"execution_labels.execution_project = executions.execution_project AND "+
+		"execution_labels.execution_domain = executions.execution_domain AND "+
+		"execution_labels.execution_name = executions.execution_name AND execution_labels.key = 'team') desc",
+		sortParameter.GetGormOrderExpr())
+
+	_, err = NewExecutionLabelSortParameter(admin.Sort{
+		Key:       "label.team' OR '1'='1",
+		Direction: admin.Sort_DESCENDING,
+	}, "team' OR '1'='1")
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"crypto/sha256"
 	"math/rand"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -26,6 +27,19 @@ func GetExecutionName(seed int64) string {
 	return string(executionName)
 }
 
+// Returns the name of the execution created for an idempotency key, so that retried requests all name the same
+// execution.
+func GetIdempotentExecutionName(project, domain, idempotencyKey string) string {
+	// Project and domain names can't contain slashes, so distinct inputs are hashed distinctly.
+	sum := sha256.Sum256([]byte(project + "/" + domain + "/" + idempotencyKey))
+	executionName := make([]rune, ExecutionIDLength)
+	executionName[0] = AllowedExecutionIDStartChars[int(sum[0])%len(AllowedExecutionIDStartChars)]
+	for i := 1; i < len(executionName); i++ {
+		executionName[i] = AllowedExecutionIDChars[int(sum[i])%len(AllowedExecutionIDChars)]
+	}
+	return string(executionName)
+}
+
 var terminalExecutionPhases = map[core.WorkflowExecution_Phase]bool{
 	core.WorkflowExecution_SUCCEEDED: true,
 	core.WorkflowExecution_FAILED:    true,
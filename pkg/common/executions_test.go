@@ -15,3 +15,15 @@ func TestGetExecutionName(t *testing.T) {
 		assert.Contains(t, AllowedExecutionIDChars, rune(randString[i]))
 	}
 }
+
+func TestGetIdempotentExecutionName(t *testing.T) {
+	executionName := GetIdempotentExecutionName("project", "domain", "key")
+	assert.Len(t, executionName, ExecutionIDLength)
+	assert.Contains(t, AllowedExecutionIDStartChars, rune(executionName[0]))
+	for i := 1; i < len(executionName); i++ {
+		assert.Contains(t, AllowedExecutionIDChars, rune(executionName[i]))
+	}
+	assert.Equal(t, executionName, GetIdempotentExecutionName("project", "domain", "key"))
+	assert.NotEqual(t, executionName, GetIdempotentExecutionName("project", "domain", "other"))
+	assert.NotEqual(t, executionName, GetIdempotentExecutionName("project", "other", "key"))
+}
@@ -0,0 +1,63 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Failure causes classify why an execution didn't succeed, so that failures can be filtered and aggregated.
+const (
+	FailureCauseUserError      = "USER_ERROR"
+	FailureCauseSystemError    = "SYSTEM_ERROR"
+	FailureCauseUnknown        = "UNKNOWN"
+	FailureCauseOOM            = "OOM"
+	FailureCauseSpotPreemption = "SPOT_PREEMPTION"
+	FailureCauseTimeout        = "TIMEOUT"
+	FailureCauseAborted        = "ABORTED"
+)
+
+// The code of the error pending executions report while they fail to launch, and fail with once they run out of
+// launch attempts.
+const LaunchFailedErrorCode = "LaunchFailed"
+
+// Substrings of error codes and messages, in lower case, which identify failures more specifically than their kind.
+// Causes are matched in order, so an OOM which led to a retry limit being exceeded is still an OOM.
+var failureCausePatterns = []struct {
+	cause    string
+	patterns []string
+}{
+	{FailureCauseOOM, []string{"oomkilled", "out of memory", "outofmemory"}},
+	{FailureCauseSpotPreemption, []string{"interrupted", "preempt", "spot"}},
+	{FailureCauseTimeout, []string{"timeout", "timedout", "timed out", "deadlineexceeded"}},
+}
+
+// Returns the cause of an execution terminating in the given phase with the given error, or an empty string if the
+// execution succeeded or hasn't terminated.
+func GetFailureCause(phase core.WorkflowExecution_Phase, executionError *core.ExecutionError) string {
+	switch phase {
+	case core.WorkflowExecution_ABORTED:
+		return FailureCauseAborted
+	case core.WorkflowExecution_TIMED_OUT:
+		return FailureCauseTimeout
+	case core.WorkflowExecution_FAILED:
+	default:
+		return ""
+	}
+	code := strings.ToLower(executionError.GetCode())
+	message := strings.ToLower(executionError.GetMessage())
+	for _, failureCause := range failureCausePatterns {
+		for _, pattern := range failureCause.patterns {
+			if strings.Contains(code, pattern) || strings.Contains(message, pattern) {
+				return failureCause.cause
+			}
+		}
+	}
+	switch executionError.GetKind() {
+	case core.ExecutionError_USER:
+		return FailureCauseUserError
+	case core.ExecutionError_SYSTEM:
+		return FailureCauseSystemError
+	}
+	return FailureCauseUnknown
+}
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFailureCause(t *testing.T) {
+	for _, test := range []struct {
+		phase core.WorkflowExecution_Phase
+		err   *core.ExecutionError
+		cause string
+	}{
+		{core.WorkflowExecution_SUCCEEDED, nil, ""},
+		{core.WorkflowExecution_RUNNING, nil, ""},
+		{core.WorkflowExecution_ABORTED, nil, FailureCauseAborted},
+		{core.WorkflowExecution_TIMED_OUT, nil, FailureCauseTimeout},
+		{core.WorkflowExecution_FAILED, nil, FailureCauseUnknown},
+		{core.WorkflowExecution_FAILED, &core.ExecutionError{
+			Code: "OOMKilled", Kind: core.ExecutionError_USER}, FailureCauseOOM},
+		{core.WorkflowExecution_FAILED, &core.ExecutionError{
+			Code: "RetryLimitExceeded", Message: "container ran out of memory", Kind: core.ExecutionError_USER},
+			FailureCauseOOM},
+		{core.WorkflowExecution_FAILED, &core.ExecutionError{
+			Code: "Interrupted", Kind: core.ExecutionError_SYSTEM}, FailureCauseSpotPreemption},
+		{core.WorkflowExecution_FAILED, &core.ExecutionError{
+			Code: "Timeout", Kind: core.ExecutionError_SYSTEM}, FailureCauseTimeout},
+		{core.WorkflowExecution_FAILED, &core.ExecutionError{
+			Code: "ValueError", Kind: core.ExecutionError_USER}, FailureCauseUserError},
+		{core.WorkflowExecution_FAILED, &core.ExecutionError{
+			Code: "ResourceLimitExceeded", Kind: core.ExecutionError_SYSTEM}, FailureCauseSystemError},
+		{core.WorkflowExecution_FAILED, &core.ExecutionError{
+			Code: "Unknown", Kind: core.ExecutionError_UNKNOWN}, FailureCauseUnknown},
+	} {
+		assert.Equal(t, test.cause, GetFailureCause(test.phase, test.err), "%s %v", test.phase, test.err)
+	}
+}
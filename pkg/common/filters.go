@@ -4,6 +4,7 @@ package common
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flytestdlib/logger"
@@ -16,6 +17,16 @@ type FilterExpression int
 type GormQueryExpr struct {
 	Query string
 	Args  interface{}
+	// Set instead of Args for queries which don't have exactly one placeholder, such as null checks and OR groups.
+	ArgsList []interface{}
+}
+
+// GetArgs returns the values to bind to the placeholders in Query.
+func (e GormQueryExpr) GetArgs() []interface{} {
+	if e.ArgsList != nil {
+		return e.ArgsList
+	}
+	return []interface{}{e.Args}
 }
 
 // Complete set of filters available for database queries.
@@ -28,36 +39,60 @@ const (
 	Equal
 	NotEqual
 	ValueIn
+	ValueNotIn
+	IsNull
+	IsNotNull
+	ContainsCaseInsensitive
+	EqualCaseInsensitive
+	StartsWith
 )
 
 // String formats for various filter expression queries
 const (
-	joinArgsFormat          = "%s.%s"
-	containsQuery           = "%s LIKE ?"
-	containsArgs            = "%%%s%%"
-	greaterThanQuery        = "%s > ?"
-	greaterThanOrEqualQuery = "%s >= ?"
-	lessThanQuery           = "%s < ?"
-	lessThanOrEqualQuery    = "%s <= ?"
-	equalQuery              = "%s = ?"
-	notEqualQuery           = "%s <> ?"
-	valueInQuery            = "%s in (?)"
+	joinArgsFormat               = "%s.%s"
+	containsQuery                = "%s LIKE ?"
+	containsArgs                 = "%%%s%%"
+	startsWithArgs               = "%s%%"
+	greaterThanQuery             = "%s > ?"
+	greaterThanOrEqualQuery      = "%s >= ?"
+	lessThanQuery                = "%s < ?"
+	lessThanOrEqualQuery         = "%s <= ?"
+	equalQuery                   = "%s = ?"
+	notEqualQuery                = "%s <> ?"
+	valueInQuery                 = "%s in (?)"
+	valueNotInQuery              = "%s not in (?)"
+	isNullQuery                  = "%s IS NULL"
+	isNotNullQuery               = "%s IS NOT NULL"
+	containsCaseInsensitiveQuery = "LOWER(%s) LIKE LOWER(?)"
+	equalCaseInsensitiveQuery    = "LOWER(%s) = LOWER(?)"
+	orGroupQuery                 = "(%s)"
+	orGroupSeparator             = " OR "
 )
 
 // Set of available filters which exclusively accept a single argument value.
 var singleValueFilters = map[FilterExpression]bool{
-	Contains:           true,
-	GreaterThan:        true,
-	GreaterThanOrEqual: true,
-	LessThan:           true,
-	LessThanOrEqual:    true,
-	Equal:              true,
-	NotEqual:           true,
+	Contains:                true,
+	GreaterThan:             true,
+	GreaterThanOrEqual:      true,
+	LessThan:                true,
+	LessThanOrEqual:         true,
+	Equal:                   true,
+	NotEqual:                true,
+	ContainsCaseInsensitive: true,
+	EqualCaseInsensitive:    true,
+	StartsWith:              true,
 }
 
 // Set of available filters which exclusively accept repeated argument values.
 var repeatedValueFilters = map[FilterExpression]bool{
-	ValueIn: true,
+	ValueIn:    true,
+	ValueNotIn: true,
+}
+
+// Set of available filters which accept no argument value.
+var nullCheckFilters = map[FilterExpression]bool{
+	IsNull:    true,
+	IsNotNull: true,
 }
 
 const EqualExpression = "eq"
@@ -71,6 +106,11 @@ var filterNameMappings = map[string]FilterExpression{
 	EqualExpression: Equal,
 	"ne":            NotEqual,
 	"value_in":      ValueIn,
+	"value_not_in":  ValueNotIn,
+	"is_null":       IsNull,
+	"is_not_null":   IsNotNull,
+	"icontains":     ContainsCaseInsensitive,
+	"ieq":           EqualCaseInsensitive,
 }
 
 var executionIdentifierFields = map[string]bool{
@@ -88,6 +128,7 @@ const unrecognizedFilterFunction = "unrecognized filter function: %s"
 const unsupportedFilterExpression = "unsupported filter expression: %s"
 const invalidSingleValueFilter = "invalid single value filter expression: %s"
 const invalidRepeatedValueFilter = "invalid repeated value filter expression: %s"
+const invalidNullCheckFilter = "invalid null check filter expression: %s"
 
 func getFilterExpressionName(expression FilterExpression) string {
 	switch expression {
@@ -107,6 +148,18 @@ func getFilterExpressionName(expression FilterExpression) string {
 		return "not equal"
 	case ValueIn:
 		return "value in"
+	case ValueNotIn:
+		return "value not in"
+	case IsNull:
+		return "is null"
+	case IsNotNull:
+		return "is not null"
+	case ContainsCaseInsensitive:
+		return "contains case insensitive"
+	case EqualCaseInsensitive:
+		return "equal case insensitive"
+	case StartsWith:
+		return "starts with"
 	default:
 		return ""
 	}
@@ -132,6 +185,11 @@ func GetInvalidRepeatedValueFilterErr(expression FilterExpression) error {
 		getFilterExpressionName(expression))
 }
 
+func GetInvalidNullCheckFilterErr(expression FilterExpression) error {
+	return errors.NewFlyteAdminErrorf(codes.InvalidArgument, invalidNullCheckFilter,
+		getFilterExpressionName(expression))
+}
+
 // Interface for a single filter expression.
 type InlineFilter interface {
 	// Returns the entity for which this filter should be applied.
@@ -165,16 +223,29 @@ func (f *inlineFilterImpl) GetField() string {
 
 func (f *inlineFilterImpl) getGormQueryExpr(formattedField string) (GormQueryExpr, error) {
 
-	// ValueIn is special because it uses repeating values.
-	if f.function == ValueIn {
-		queryStr := fmt.Sprintf(valueInQuery, formattedField)
+	switch f.function {
+	// ValueIn and ValueNotIn are special because they use repeating values.
+	case ValueIn:
 		return GormQueryExpr{
-			Query: queryStr,
+			Query: fmt.Sprintf(valueInQuery, formattedField),
 			Args:  f.repeatedValue,
 		}, nil
-	}
-
-	switch f.function {
+	case ValueNotIn:
+		return GormQueryExpr{
+			Query: fmt.Sprintf(valueNotInQuery, formattedField),
+			Args:  f.repeatedValue,
+		}, nil
+	// Null checks are special because they don't bind any values.
+	case IsNull:
+		return GormQueryExpr{
+			Query:    fmt.Sprintf(isNullQuery, formattedField),
+			ArgsList: []interface{}{},
+		}, nil
+	case IsNotNull:
+		return GormQueryExpr{
+			Query:    fmt.Sprintf(isNotNullQuery, formattedField),
+			ArgsList: []interface{}{},
+		}, nil
 	case Contains:
 		return GormQueryExpr{
 			// WHERE field LIKE %value%
@@ -218,6 +289,24 @@ func (f *inlineFilterImpl) getGormQueryExpr(formattedField string) (GormQueryExp
 			Query: fmt.Sprintf(notEqualQuery, formattedField),
 			Args:  f.value,
 		}, nil
+	case ContainsCaseInsensitive:
+		return GormQueryExpr{
+			// WHERE LOWER(field) LIKE LOWER(%value%)
+			Query: fmt.Sprintf(containsCaseInsensitiveQuery, formattedField),
+			Args:  fmt.Sprintf(containsArgs, f.value),
+		}, nil
+	case EqualCaseInsensitive:
+		return GormQueryExpr{
+			// WHERE LOWER(field) = LOWER(value)
+			Query: fmt.Sprintf(equalCaseInsensitiveQuery, formattedField),
+			Args:  f.value,
+		}, nil
+	case StartsWith:
+		return GormQueryExpr{
+			// WHERE field LIKE value%
+			Query: fmt.Sprintf(containsQuery, formattedField),
+			Args:  fmt.Sprintf(startsWithArgs, f.value),
+		}, nil
 	}
 	logger.Debugf(context.Background(), "can't create gorm query expr for %s", getFilterExpressionName(f.function))
 	return GormQueryExpr{}, GetUnsupportedFilterExpressionErr(f.function)
@@ -278,6 +367,18 @@ func NewRepeatedValueFilter(entity Entity, function FilterExpression, field stri
 	}, nil
 }
 
+// Returns a filter which checks whether a field is null and accepts no argument value.
+func NewNullCheckFilter(entity Entity, function FilterExpression, field string) (InlineFilter, error) {
+	if _, ok := nullCheckFilters[function]; !ok {
+		return nil, GetInvalidNullCheckFilterErr(function)
+	}
+	return &inlineFilterImpl{
+		entity:   customizeEntity(field, entity),
+		function: function,
+		field:    customizeField(field, entity),
+	}, nil
+}
+
 func NewInlineFilter(entity Entity, function string, field string, value interface{}) (InlineFilter, error) {
 	expression, ok := filterNameMappings[function]
 	if !ok {
@@ -290,6 +391,86 @@ func NewInlineFilter(entity Entity, function string, field string, value interfa
 	return NewRepeatedValueFilter(entity, expression, field, value)
 }
 
+// Returns a null check filter, given the name of its function.
+func NewInlineNullCheckFilter(entity Entity, function string, field string) (InlineFilter, error) {
+	expression, ok := filterNameMappings[function]
+	if !ok {
+		logger.Debugf(context.Background(), "can't create filter for unrecognized function: %s", function)
+		return nil, GetUnrecognizedFilterFunctionErr(function)
+	}
+	return NewNullCheckFilter(entity, expression, field)
+}
+
+// Matches rows satisfying any of its filters.
+type orGroupFilter struct {
+	filters []InlineFilter
+}
+
+func (f *orGroupFilter) GetEntity() Entity {
+	return f.filters[0].GetEntity()
+}
+
+// GetField returns the comma separated columns filtered on by the group.
+func (f *orGroupFilter) GetField() string {
+	fields := make([]string, len(f.filters))
+	for idx, filter := range f.filters {
+		fields[idx] = filter.GetField()
+	}
+	return strings.Join(fields, ",")
+}
+
+func (f *orGroupFilter) getGormQueryExpr(getFilterExpr func(filter InlineFilter) (GormQueryExpr, error)) (
+	GormQueryExpr, error) {
+	queries := make([]string, len(f.filters))
+	args := make([]interface{}, 0, len(f.filters))
+	for idx, filter := range f.filters {
+		filterExpr, err := getFilterExpr(filter)
+		if err != nil {
+			return GormQueryExpr{}, err
+		}
+		queries[idx] = filterExpr.Query
+		args = append(args, filterExpr.GetArgs()...)
+	}
+	return GormQueryExpr{
+		// WHERE (query OR query ...)
+		Query:    fmt.Sprintf(orGroupQuery, strings.Join(queries, orGroupSeparator)),
+		ArgsList: args,
+	}, nil
+}
+
+func (f *orGroupFilter) GetGormQueryExpr() (GormQueryExpr, error) {
+	return f.getGormQueryExpr(func(filter InlineFilter) (GormQueryExpr, error) {
+		return filter.GetGormQueryExpr()
+	})
+}
+
+func (f *orGroupFilter) GetGormJoinTableQueryExpr(tableName string) (GormQueryExpr, error) {
+	return f.getGormQueryExpr(func(filter InlineFilter) (GormQueryExpr, error) {
+		return filter.GetGormJoinTableQueryExpr(tableName)
+	})
+}
+
+// Returns a filter matching rows which satisfy any of the given filters. Since the group is applied to a single table,
+// all filters must reference the same entity.
+func NewOrGroupFilter(filters []InlineFilter) (InlineFilter, error) {
+	if len(filters) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "empty OR group filter expression")
+	}
+	for _, filter := range filters[1:] {
+		if filter.GetEntity() != filters[0].GetEntity() {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"filters on %s and %s in an OR group must reference the same entity",
+				filters[0].GetField(), filter.GetField())
+		}
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return &orGroupFilter{
+		filters: filters,
+	}, nil
+}
+
 // Interface for a map filter expression.
 type MapFilter interface {
 	GetFilter() map[string]interface{}
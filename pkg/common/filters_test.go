@@ -97,23 +97,29 @@ func TestGetGormJoinTableQueryExpr(t *testing.T) {
 }
 
 var expectedQueriesForFilters = map[FilterExpression]string{
-	Contains:           "field LIKE ?",
-	GreaterThan:        "field > ?",
-	GreaterThanOrEqual: "field >= ?",
-	LessThan:           "field < ?",
-	LessThanOrEqual:    "field <= ?",
-	Equal:              "field = ?",
-	NotEqual:           "field <> ?",
+	Contains:                "field LIKE ?",
+	GreaterThan:             "field > ?",
+	GreaterThanOrEqual:      "field >= ?",
+	LessThan:                "field < ?",
+	LessThanOrEqual:         "field <= ?",
+	Equal:                   "field = ?",
+	NotEqual:                "field <> ?",
+	ContainsCaseInsensitive: "LOWER(field) LIKE LOWER(?)",
+	EqualCaseInsensitive:    "LOWER(field) = LOWER(?)",
+	StartsWith:              "field LIKE ?",
 }
 
 var expectedArgsForFilters = map[FilterExpression]string{
-	Contains:           "%value%",
-	GreaterThan:        "value",
-	GreaterThanOrEqual: "value",
-	LessThan:           "value",
-	LessThanOrEqual:    "value",
-	Equal:              "value",
-	NotEqual:           "value",
+	Contains:                "%value%",
+	GreaterThan:             "value",
+	GreaterThanOrEqual:      "value",
+	LessThan:                "value",
+	LessThanOrEqual:         "value",
+	Equal:                   "value",
+	NotEqual:                "value",
+	ContainsCaseInsensitive: "%value%",
+	EqualCaseInsensitive:    "value",
+	StartsWith:              "value%",
 }
 
 func TestQueryExpressions(t *testing.T) {
@@ -138,6 +144,78 @@ func TestQueryExpressions(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "field in (?)", gormQueryExpr.Query)
 	assert.EqualValues(t, []string{"value"}, gormQueryExpr.Args)
+
+	filter, err = NewRepeatedValueFilter(Workflow, ValueNotIn, "field", []string{"value"})
+	assert.NoError(t, err)
+
+	gormQueryExpr, err = filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "field not in (?)", gormQueryExpr.Query)
+	assert.EqualValues(t, []interface{}{[]string{"value"}}, gormQueryExpr.GetArgs())
+}
+
+func TestNewNullCheckFilter(t *testing.T) {
+	filter, err := NewNullCheckFilter(Execution, IsNull, "error_kind")
+	assert.NoError(t, err)
+
+	gormQueryExpr, err := filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "error_kind IS NULL", gormQueryExpr.Query)
+	assert.Empty(t, gormQueryExpr.GetArgs())
+
+	filter, err = NewNullCheckFilter(Execution, IsNotNull, "name")
+	assert.NoError(t, err)
+
+	gormQueryExpr, err = filter.GetGormJoinTableQueryExpr("executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "executions.execution_name IS NOT NULL", gormQueryExpr.Query)
+	assert.Empty(t, gormQueryExpr.GetArgs())
+
+	_, err = NewNullCheckFilter(Execution, Equal, "name")
+	assert.EqualError(t, err, "invalid null check filter expression: equal")
+
+	_, err = NewInlineNullCheckFilter(Execution, "is_null", "error_kind")
+	assert.NoError(t, err)
+
+	_, err = NewInlineNullCheckFilter(Execution, "eq", "error_kind")
+	assert.EqualError(t, err, "invalid null check filter expression: equal")
+}
+
+func TestNewOrGroupFilter(t *testing.T) {
+	failedFilter, err := NewSingleValueFilter(Execution, Equal, "phase", "FAILED")
+	assert.NoError(t, err)
+	errorKindFilter, err := NewNullCheckFilter(Execution, IsNotNull, "error_kind")
+	assert.NoError(t, err)
+	phaseFilter, err := NewRepeatedValueFilter(Execution, ValueIn, "phase", []string{"ABORTED", "TIMED_OUT"})
+	assert.NoError(t, err)
+
+	filter, err := NewOrGroupFilter([]InlineFilter{failedFilter, errorKindFilter, phaseFilter})
+	assert.NoError(t, err)
+	assert.Equal(t, Execution, filter.GetEntity())
+	assert.Equal(t, "phase,error_kind,phase", filter.GetField())
+
+	gormQueryExpr, err := filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "(phase = ? OR error_kind IS NOT NULL OR phase in (?))", gormQueryExpr.Query)
+	assert.Equal(t, []interface{}{"FAILED", []string{"ABORTED", "TIMED_OUT"}}, gormQueryExpr.GetArgs())
+
+	gormQueryExpr, err = filter.GetGormJoinTableQueryExpr("executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "(executions.phase = ? OR executions.error_kind IS NOT NULL OR executions.phase in (?))",
+		gormQueryExpr.Query)
+
+	// Groups of a single filter are just the filter.
+	filter, err = NewOrGroupFilter([]InlineFilter{failedFilter})
+	assert.NoError(t, err)
+	assert.Equal(t, failedFilter, filter)
+
+	workflowFilter, err := NewSingleValueFilter(Workflow, Equal, "name", "workflow")
+	assert.NoError(t, err)
+	_, err = NewOrGroupFilter([]InlineFilter{failedFilter, workflowFilter})
+	assert.EqualError(t, err, "filters on phase and name in an OR group must reference the same entity")
+
+	_, err = NewOrGroupFilter(nil)
+	assert.Error(t, err)
 }
 
 func TestMapFilter(t *testing.T) {
@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -45,6 +46,9 @@ func (t *TestDataStore) GetBaseContainerFQN(ctx context.Context) storage.DataRef
 
 // Retrieves a byte array from the Blob store or an error
 func (t *TestDataStore) ReadRaw(ctx context.Context, reference storage.DataReference) (io.ReadCloser, error) {
+	if raw, ok := t.Store[reference]; ok {
+		return NopCloser{bytes.NewReader(raw)}, nil
+	}
 	return NopCloser{}, nil
 }
 
@@ -0,0 +1,8 @@
+package common
+
+// The types of notifications which can be configured or subscribed to outside of launch plans.
+const (
+	NotificationTypeEmail     = "email"
+	NotificationTypeSlack     = "slack"
+	NotificationTypePagerDuty = "pagerDuty"
+)
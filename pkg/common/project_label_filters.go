@@ -0,0 +1,65 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+// Projects are listed from their own table, so their label filters don't need to be scoped.
+const projectTableName = "projects"
+
+// Selects the labels with a given key of the project in the outer query's table.
+const projectLabelSubquery = "SELECT 1 FROM project_labels WHERE " +
+	"project_labels.project = %s.identifier AND project_labels.key = ?"
+
+// Matches projects on the labels applied to them, which are stored in their own table like execution labels.
+type projectLabelFilter struct {
+	function FilterExpression
+	key      string
+	value    interface{}
+}
+
+func (f *projectLabelFilter) GetEntity() Entity {
+	return Project
+}
+
+func (f *projectLabelFilter) GetField() string {
+	return fmt.Sprintf(labelField, f.key)
+}
+
+func (f *projectLabelFilter) GetGormQueryExpr() (GormQueryExpr, error) {
+	return f.GetGormJoinTableQueryExpr(projectTableName)
+}
+
+func (f *projectLabelFilter) GetGormJoinTableQueryExpr(tableName string) (GormQueryExpr, error) {
+	return getLabelQueryExpr(
+		f.function, fmt.Sprintf(projectLabelSubquery, tableName), "project_labels", f.key, f.value)
+}
+
+// Returns a filter on the value of the project label with the given key. Null checks match projects without and
+// with the label respectively, and take no value.
+func NewProjectLabelFilter(function FilterExpression, key string, value interface{}) (InlineFilter, error) {
+	if !labelFilters[function] {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unsupported project label filter expression: %s",
+			getFilterExpressionName(function))
+	}
+	return &projectLabelFilter{
+		function: function,
+		key:      key,
+		value:    value,
+	}, nil
+}
+
+// Returns a project label filter, given the name of its function.
+func NewInlineProjectLabelFilter(function string, key string, value interface{}) (InlineFilter, error) {
+	expression, ok := filterNameMappings[function]
+	if !ok {
+		logger.Debugf(context.Background(), "can't create filter for unrecognized function: %s", function)
+		return nil, GetUnrecognizedFilterFunctionErr(function)
+	}
+	return NewProjectLabelFilter(expression, key, value)
+}
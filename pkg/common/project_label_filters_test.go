@@ -0,0 +1,37 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const expectedProjectLabelSubquery = "SELECT 1 FROM project_labels WHERE " +
+	"project_labels.project = projects.identifier AND project_labels.key = ?"
+
+func TestProjectLabelFilter(t *testing.T) {
+	filter, err := NewInlineProjectLabelFilter("eq", "team", "ml")
+	assert.NoError(t, err)
+	assert.Equal(t, Project, filter.GetEntity())
+	assert.Equal(t, "label.team", filter.GetField())
+
+	gormQueryExpr, err := filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "EXISTS ("+expectedProjectLabelSubquery+" AND project_labels.value = ?)", gormQueryExpr.Query)
+	assert.Equal(t, []interface{}{"team", "ml"}, gormQueryExpr.GetArgs())
+}
+
+func TestProjectLabelFilter_NullChecks(t *testing.T) {
+	filter, err := NewInlineProjectLabelFilter("is_null", "team", nil)
+	assert.NoError(t, err)
+
+	gormQueryExpr, err := filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "NOT EXISTS ("+expectedProjectLabelSubquery+")", gormQueryExpr.Query)
+	assert.Equal(t, []interface{}{"team"}, gormQueryExpr.GetArgs())
+}
+
+func TestProjectLabelFilter_Unsupported(t *testing.T) {
+	_, err := NewInlineProjectLabelFilter("contains", "team", "ml")
+	assert.EqualError(t, err, "unsupported project label filter expression: contains")
+}
@@ -0,0 +1,179 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A semantic version, e.g. v1.2.3 or 1.2.3-rc.1. Missing minor and patch versions are zero, and build metadata is
+// ignored.
+type SemanticVersion struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	PreRelease string
+}
+
+func ParseSemanticVersion(version string) (SemanticVersion, error) {
+	trimmed := strings.TrimPrefix(version, "v")
+	if i := strings.Index(trimmed, "+"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+	var semanticVersion SemanticVersion
+	if i := strings.Index(trimmed, "-"); i >= 0 {
+		semanticVersion.PreRelease = trimmed[i+1:]
+		trimmed = trimmed[:i]
+		if len(semanticVersion.PreRelease) == 0 {
+			return SemanticVersion{}, fmt.Errorf("invalid semantic version [%s]", version)
+		}
+	}
+	parts := strings.Split(trimmed, ".")
+	if len(parts) > 3 {
+		return SemanticVersion{}, fmt.Errorf("invalid semantic version [%s]", version)
+	}
+	numbers := []*uint64{&semanticVersion.Major, &semanticVersion.Minor, &semanticVersion.Patch}
+	for i, part := range parts {
+		number, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return SemanticVersion{}, fmt.Errorf("invalid semantic version [%s]", version)
+		}
+		*numbers[i] = number
+	}
+	return semanticVersion, nil
+}
+
+func compareUint(a, b uint64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// Compares pre-releases by their dot-separated identifiers, numerically when both are numbers. A version without a
+// pre-release is greater than one with.
+func comparePreRelease(a, b string) int {
+	if a == b {
+		return 0
+	}
+	if len(a) == 0 {
+		return 1
+	}
+	if len(b) == 0 {
+		return -1
+	}
+	aIdentifiers, bIdentifiers := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aIdentifiers) && i < len(bIdentifiers); i++ {
+		aNumber, aErr := strconv.ParseUint(aIdentifiers[i], 10, 64)
+		bNumber, bErr := strconv.ParseUint(bIdentifiers[i], 10, 64)
+		if aErr == nil && bErr == nil {
+			if result := compareUint(aNumber, bNumber); result != 0 {
+				return result
+			}
+			continue
+		}
+		if result := strings.Compare(aIdentifiers[i], bIdentifiers[i]); result != 0 {
+			return result
+		}
+	}
+	return compareUint(uint64(len(aIdentifiers)), uint64(len(bIdentifiers)))
+}
+
+// Returns -1, 0 or 1 when the version precedes, equals or follows the other.
+func (v SemanticVersion) Compare(other SemanticVersion) int {
+	if result := compareUint(v.Major, other.Major); result != 0 {
+		return result
+	}
+	if result := compareUint(v.Minor, other.Minor); result != 0 {
+		return result
+	}
+	if result := compareUint(v.Patch, other.Patch); result != 0 {
+		return result
+	}
+	return comparePreRelease(v.PreRelease, other.PreRelease)
+}
+
+type versionComparison struct {
+	operator string
+	version  SemanticVersion
+}
+
+func (c versionComparison) matches(version SemanticVersion) bool {
+	result := version.Compare(c.version)
+	switch c.operator {
+	case "!=":
+		return result != 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	default:
+		return result == 0
+	}
+}
+
+// A constraint on semantic versions, such as ">=1.2.0, <2.0.0", "^1.4" or "~1.4.2". Versions match when they satisfy
+// every comma-separated comparison. ^ allows changes which don't modify the left-most non-zero version number, and ~
+// allows patch changes. Pre-release versions never match, so release candidates aren't picked up by accident.
+type VersionConstraint struct {
+	comparisons []versionComparison
+}
+
+// The longest operators come first, so that they're matched before their prefixes.
+var versionOperators = []string{"!=", ">=", "<=", ">", "<", "=", "^", "~"}
+
+func ParseVersionConstraint(constraint string) (VersionConstraint, error) {
+	var versionConstraint VersionConstraint
+	for _, part := range strings.Split(constraint, ",") {
+		part = strings.TrimSpace(part)
+		operator := "="
+		for _, candidate := range versionOperators {
+			if strings.HasPrefix(part, candidate) {
+				operator = candidate
+				part = strings.TrimSpace(strings.TrimPrefix(part, candidate))
+				break
+			}
+		}
+		version, err := ParseSemanticVersion(part)
+		if err != nil {
+			return VersionConstraint{}, fmt.Errorf("invalid version constraint [%s]: %v", constraint, err)
+		}
+		switch operator {
+		case "^":
+			upper := SemanticVersion{Major: version.Major + 1}
+			if version.Major == 0 {
+				upper = SemanticVersion{Minor: version.Minor + 1}
+			}
+			versionConstraint.comparisons = append(versionConstraint.comparisons,
+				versionComparison{operator: ">=", version: version},
+				versionComparison{operator: "<", version: upper})
+		case "~":
+			versionConstraint.comparisons = append(versionConstraint.comparisons,
+				versionComparison{operator: ">=", version: version},
+				versionComparison{operator: "<", version: SemanticVersion{Major: version.Major, Minor: version.Minor + 1}})
+		default:
+			versionConstraint.comparisons = append(versionConstraint.comparisons,
+				versionComparison{operator: operator, version: version})
+		}
+	}
+	return versionConstraint, nil
+}
+
+func (c VersionConstraint) Matches(version SemanticVersion) bool {
+	if len(version.PreRelease) > 0 {
+		return false
+	}
+	for _, comparison := range c.comparisons {
+		if !comparison.matches(version) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSemanticVersion(t *testing.T) {
+	version, err := ParseSemanticVersion("v1.2.3-rc.1+build.5")
+	assert.NoError(t, err)
+	assert.Equal(t, SemanticVersion{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1"}, version)
+
+	version, err = ParseSemanticVersion("2.1")
+	assert.NoError(t, err)
+	assert.Equal(t, SemanticVersion{Major: 2, Minor: 1}, version)
+
+	for _, invalid := range []string{"", "latest", "1.2.3.4", "1.x", "1.2.3-", "9f3e2c1"} {
+		_, err = ParseSemanticVersion(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSemanticVersionCompare(t *testing.T) {
+	// In ascending order.
+	versions := []string{"0.9.0", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.10.0", "2.0.0"}
+	for i := range versions {
+		a, err := ParseSemanticVersion(versions[i])
+		assert.NoError(t, err)
+		assert.Equal(t, 0, a.Compare(a), versions[i])
+		if i+1 < len(versions) {
+			b, err := ParseSemanticVersion(versions[i+1])
+			assert.NoError(t, err)
+			assert.Equal(t, -1, a.Compare(b), versions[i])
+			assert.Equal(t, 1, b.Compare(a), versions[i])
+		}
+	}
+}
+
+func TestVersionConstraintMatches(t *testing.T) {
+	for _, test := range []struct {
+		constraint string
+		matches    []string
+		misses     []string
+	}{
+		{
+			constraint: ">=1.2.0, <2.0.0",
+			matches:    []string{"1.2.0", "v1.9.9"},
+			misses:     []string{"1.1.9", "2.0.0", "1.5.0-rc.1"},
+		},
+		{
+			constraint: "^1.4",
+			matches:    []string{"1.4.0", "1.99.0"},
+			misses:     []string{"1.3.9", "2.0.0"},
+		},
+		{
+			constraint: "^0.3.1",
+			matches:    []string{"0.3.1", "0.3.9"},
+			misses:     []string{"0.4.0", "0.3.0"},
+		},
+		{
+			constraint: "~1.4.2",
+			matches:    []string{"1.4.2", "1.4.10"},
+			misses:     []string{"1.5.0", "1.4.1"},
+		},
+		{
+			constraint: "1.2.3",
+			matches:    []string{"1.2.3", "v1.2.3"},
+			misses:     []string{"1.2.4"},
+		},
+		{
+			constraint: ">1.0, != 1.2.0",
+			matches:    []string{"1.0.1", "1.3.0"},
+			misses:     []string{"1.0.0", "1.2.0"},
+		},
+	} {
+		constraint, err := ParseVersionConstraint(test.constraint)
+		assert.NoError(t, err, test.constraint)
+		for _, match := range test.matches {
+			version, err := ParseSemanticVersion(match)
+			assert.NoError(t, err)
+			assert.True(t, constraint.Matches(version), "%s should match %s", test.constraint, match)
+		}
+		for _, miss := range test.misses {
+			version, err := ParseSemanticVersion(miss)
+			assert.NoError(t, err)
+			assert.False(t, constraint.Matches(version), "%s shouldn't match %s", test.constraint, miss)
+		}
+	}
+}
+
+func TestParseVersionConstraint_Invalid(t *testing.T) {
+	for _, invalid := range []string{"", ">=", ">=1.0,", "=> 1.0", "latest"} {
+		_, err := ParseVersionConstraint(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
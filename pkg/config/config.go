@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	authConfig "github.com/flyteorg/flyteadmin/auth/config"
 	"github.com/flyteorg/flytestdlib/config"
@@ -17,7 +18,10 @@ type ServerConfig struct {
 	GrpcServerReflection bool                  `json:"grpcServerReflection" pflag:",Enable GRPC Server Reflection"`
 	KubeConfig           string                `json:"kube-config" pflag:",Path to kubernetes client config file."`
 	Master               string                `json:"master" pflag:",The address of the Kubernetes API server."`
+	Sandbox              bool                  `json:"sandbox" pflag:",Serves admin without a kubernetes cluster, recording executions rather than launching them."`
 	Security             ServerSecurityOptions `json:"security"`
+	Tracing              TracingConfig         `json:"tracing"`
+	LeaderElection       LeaderElectionConfig  `json:"leaderElection"`
 
 	// Deprecated: please use auth.AppAuth.ThirdPartyConfig instead.
 	DeprecatedThirdPartyConfig authConfig.ThirdPartyConfigOptions `json:"thirdPartyConfig" pflag:",Deprecated please use auth.appAuth.thirdPartyConfig instead."`
@@ -40,8 +44,73 @@ type ServerSecurityOptions struct {
 	// By default, the server will allow Accept, Accept-Language, Content-Language, and Content-Type.
 	// User this setting to add any additional headers which are needed
 	AllowedHeaders []string `json:"allowedHeaders"`
+	// Restricts which networks are allowed to call which RPCs.
+	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy"`
 }
 
+type NetworkPolicyConfig struct {
+	Enabled bool `json:"enabled"`
+	// CIDRs of proxies (e.g. the grpc-gateway on 127.0.0.1/32 or an ingress) trusted to report the originating client
+	// address in the X-Forwarded-For header. The header is ignored for requests from any other peer.
+	TrustedProxies []string `json:"trustedProxies"`
+	// Rules are evaluated in order and the first rule matching the called RPC is applied. RPCs that match no rule are
+	// allowed.
+	Rules []NetworkPolicyRule `json:"rules"`
+}
+
+type NetworkPolicyRule struct {
+	// Glob patterns (as understood by path.Match) of the fully qualified gRPC methods this rule applies to, e.g.
+	// "/flyteidl.service.AdminService/Create*Event" for all event RPCs.
+	Methods []string `json:"methods"`
+	// CIDRs allowed to call the matching RPCs. If empty, all networks not denied are allowed.
+	Allow []string `json:"allow"`
+	// CIDRs denied from calling the matching RPCs. Deny takes precedence over allow.
+	Deny []string `json:"deny"`
+}
+
+// Traces the RPCs served along with the database statements, blob store operations and workflow launches they issue,
+// and exports them to an OpenTelemetry collector over OTLP/HTTP. Traces are continued from clients which send a W3C
+// traceparent header, and handed on to propeller in the flyte.org/traceparent annotation of the workflows launched.
+type TracingConfig struct {
+	Enabled bool `json:"enabled"`
+	// The OTLP/HTTP traces endpoint of the collector, e.g. http://otel-collector:4318/v1/traces.
+	Endpoint string `json:"endpoint"`
+	// Headers sent with every export, e.g. for authentication.
+	Headers map[string]string `json:"headers"`
+	// The service name traces are exported under.
+	ServiceName string `json:"serviceName"`
+	// The fraction of traces started by admin which are sampled. Traces continued from sampled clients always are.
+	SamplingFraction float64 `json:"samplingFraction"`
+	// How often spans are exported.
+	ExportInterval config.Duration `json:"exportInterval"`
+}
+
+// Elects the replica which runs each of the background processes which mustn't run more than once at a time: the
+// scheduler, cluster resource controller, retention pruner and outbox relay. Each of them has a leader of its own.
+type LeaderElectionConfig struct {
+	// Whether the background processes elect their leader. When disabled, each replica runs the processes it's
+	// started with. Admin served with --standalone always elects the leaders of the processes it runs.
+	Enabled bool `json:"enabled"`
+	// Either "lease", for a kubernetes lease, or "database", for a postgres advisory lock.
+	LockType string `json:"lockType"`
+	// The name and namespace of the kubernetes leases. The leases are named after this name and their process, e.g.
+	// flyteadmin-leader-scheduler.
+	LeaseName      string `json:"leaseName"`
+	LeaseNamespace string `json:"leaseNamespace"`
+	// How long replicas wait for the leader to renew the lease before taking it over.
+	LeaseDuration config.Duration `json:"leaseDuration"`
+	// How long the leader keeps retrying to renew the lease, or to check it still holds the advisory lock, before
+	// giving up the leadership.
+	RenewDeadline config.Duration `json:"renewDeadline"`
+	// How often replicas try to become the leader, and the leader renews its leadership.
+	RetryPeriod config.Duration `json:"retryPeriod"`
+}
+
+const (
+	LeaderElectionLockTypeLease    = "lease"
+	LeaderElectionLockTypeDatabase = "database"
+)
+
 type SslOptions struct {
 	CertificateFile string `json:"certificateFile"`
 	KeyFile         string `json:"keyFile"`
@@ -49,6 +118,19 @@ type SslOptions struct {
 
 var defaultServerConfig = &ServerConfig{
 	Security: ServerSecurityOptions{},
+	Tracing: TracingConfig{
+		ServiceName:      "flyteadmin",
+		SamplingFraction: 1,
+		ExportInterval:   config.Duration{Duration: 5 * time.Second},
+	},
+	LeaderElection: LeaderElectionConfig{
+		LockType:       LeaderElectionLockTypeLease,
+		LeaseName:      "flyteadmin-leader",
+		LeaseNamespace: "flyte",
+		LeaseDuration:  config.Duration{Duration: 15 * time.Second},
+		RenewDeadline:  config.Duration{Duration: 10 * time.Second},
+		RetryPeriod:    config.Duration{Duration: 2 * time.Second},
+	},
 }
 var serverConfig = config.MustRegisterSection(SectionKey, defaultServerConfig)
 
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/graymeta/stow"
+	stowAzure "github.com/graymeta/stow/azure"
 	"github.com/graymeta/stow/s3"
 
 	"github.com/flyteorg/flytestdlib/storage"
@@ -51,7 +52,15 @@ func GetRemoteDataHandler(cfg RemoteDataHandlerConfig) RemoteDataHandler {
 		return &remoteDataHandler{
 			remoteURL: implementations.NewGCPRemoteURL(cfg.SigningPrincipal, signedURLDuration),
 		}
-
+	case common.Azure:
+		// Blobs are signed with the key of the storage account admin reads them from.
+		stowCfg := stow.ConfigMap(storage.GetConfig().Stow.Config)
+		account, _ := stowCfg.Config(stowAzure.ConfigAccount)
+		key, _ := stowCfg.Config(stowAzure.ConfigKey)
+		signedURLDuration := time.Minute * time.Duration(cfg.SignedURLDurationMinutes)
+		return &remoteDataHandler{
+			remoteURL: implementations.NewAzureRemoteURL(account, key, signedURLDuration),
+		}
 	case common.Local:
 		logger.Infof(context.TODO(), "setting up local signer ----- ")
 		// Since minio = aws s3, we are creating the same client but using the config primitives from aws
@@ -92,3 +101,11 @@ func GetRemoteDataHandler(cfg RemoteDataHandlerConfig) RemoteDataHandler {
 		}
 	}
 }
+
+// Returns a RemoteDataDeleterInterface which deletes blobs from the store the storage config points to.
+func GetRemoteDataDeleter(storeConfig *storage.Config) (interfaces.RemoteDataDeleterInterface, error) {
+	if storeConfig.Type == storage.TypeMemory {
+		return implementations.NewNoopRemoteDataDeleter(), nil
+	}
+	return implementations.NewStowRemoteDataDeleter(storeConfig)
+}
@@ -2,6 +2,7 @@ package implementations
 
 import (
 	"context"
+	"encoding/base64"
 	"time"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -23,6 +24,7 @@ const s3Scheme = "s3"
 type s3Interface interface {
 	HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
 	GetObjectRequest(input *s3.GetObjectInput) (req *request.Request, output *s3.GetObjectOutput)
+	PutObjectRequest(input *s3.PutObjectInput) (req *request.Request, output *s3.PutObjectOutput)
 }
 
 // AWS-specific implementation of RemoteURLInterface
@@ -92,6 +94,29 @@ func (a *AWSRemoteURL) Get(ctx context.Context, uri string) (admin.UrlBlob, erro
 	}, nil
 }
 
+func (a *AWSRemoteURL) CreateUploadURL(ctx context.Context, uri string, contentMD5 []byte) (string, error) {
+	logger.Debugf(ctx, "Getting signed upload url for - %s", uri)
+	s3URI, err := a.splitURI(ctx, uri)
+	if err != nil {
+		logger.Debugf(ctx, "failed to extract s3 bucket and key from uri: %s", uri)
+		return "", errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid uri: %s", uri)
+	}
+	// The second return argument here is the PutObjectOutput, which we don't use below.
+	req, _ := a.s3Client.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:     &s3URI.bucket,
+		Key:        &s3URI.key,
+		ContentMD5: aws.String(base64.StdEncoding.EncodeToString(contentMD5)),
+	})
+	urlStr, err := req.Presign(a.presignDuration)
+	if err != nil {
+		logger.Warning(ctx,
+			"failed to presign upload url for uri [%s] for %v with err %v", uri, a.presignDuration, err)
+		return "", errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to presign upload url for uri [%s] for %v with err %v", uri, a.presignDuration, err)
+	}
+	return urlStr, nil
+}
+
 func NewAWSRemoteURL(config *aws.Config, presignDuration time.Duration) interfaces.RemoteURLInterface {
 	sesh, err := session.NewSession(config)
 	if err != nil {
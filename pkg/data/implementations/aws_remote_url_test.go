@@ -36,6 +36,7 @@ func TestSplitURI_InvalidDataReference(t *testing.T) {
 type mockS3Impl struct {
 	headObjectFunc func(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
 	getObjectFunc  func(input *s3.GetObjectInput) (req *request.Request, output *s3.GetObjectOutput)
+	putObjectFunc  func(input *s3.PutObjectInput) (req *request.Request, output *s3.PutObjectOutput)
 }
 
 func (m *mockS3Impl) HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
@@ -46,6 +47,10 @@ func (m *mockS3Impl) GetObjectRequest(input *s3.GetObjectInput) (req *request.Re
 	return m.getObjectFunc(input)
 }
 
+func (m *mockS3Impl) PutObjectRequest(input *s3.PutObjectInput) (req *request.Request, output *s3.PutObjectOutput) {
+	return m.putObjectFunc(input)
+}
+
 func TestAWSGet(t *testing.T) {
 	contentLength := int64(100)
 	presignDuration := 3 * time.Minute
@@ -83,3 +88,29 @@ func TestAWSGet(t *testing.T) {
 	assert.Equal(t, "www://host/path", urlBlob.Url)
 	assert.Equal(t, contentLength, urlBlob.Bytes)
 }
+
+func TestAWSCreateUploadURL(t *testing.T) {
+	mockS3 := mockS3Impl{}
+	mockS3.putObjectFunc = func(input *s3.PutObjectInput) (req *request.Request, output *s3.PutObjectOutput) {
+		assert.Equal(t, "bucket", *input.Bucket)
+		assert.Equal(t, "key", *input.Key)
+		assert.Equal(t, "bWQ1", *input.ContentMD5)
+		return &request.Request{
+			Operation: &request.Operation{},
+			HTTPRequest: &http.Request{
+				URL: &url.URL{
+					Scheme: "www",
+					Host:   "host",
+					Path:   "path",
+				},
+			},
+		}, &s3.PutObjectOutput{}
+	}
+	remoteURL := AWSRemoteURL{
+		s3Client:        &mockS3,
+		presignDuration: 3 * time.Minute,
+	}
+	urlStr, err := remoteURL.CreateUploadURL(context.Background(), "s3://bucket/key", []byte("md5"))
+	assert.Nil(t, err)
+	assert.Equal(t, "www://host/path", urlStr)
+}
@@ -0,0 +1,75 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAzureSplitURI(t *testing.T) {
+	remoteURL := AzureRemoteURL{}
+	blob, err := remoteURL.splitURI(context.Background(), "afs://container/i/am/valid")
+	assert.Nil(t, err)
+	assert.Equal(t, "container", blob.container)
+	assert.Equal(t, "i/am/valid", blob.name)
+}
+
+func TestAzureSplitURI_InvalidScheme(t *testing.T) {
+	remoteURL := AzureRemoteURL{}
+	_, err := remoteURL.splitURI(context.Background(), "s3://i/am/invalid")
+	assert.NotNil(t, err)
+}
+
+// Mock Azure blob service for testing.
+type mockAzureBlobService struct {
+	getSizeFunc   func(container, name string) (int64, error)
+	getSASURIFunc func(container, name string, expiry time.Time) (string, error)
+}
+
+func (m *mockAzureBlobService) GetSize(container, name string) (int64, error) {
+	return m.getSizeFunc(container, name)
+}
+
+func (m *mockAzureBlobService) GetSASURI(container, name string, expiry time.Time) (string, error) {
+	return m.getSASURIFunc(container, name, expiry)
+}
+
+func TestAzureGet(t *testing.T) {
+	signDuration := 3 * time.Minute
+	mockBlobService := mockAzureBlobService{
+		getSizeFunc: func(container, name string) (int64, error) {
+			assert.Equal(t, "container", container)
+			assert.Equal(t, "key", name)
+			return 100, nil
+		},
+		getSASURIFunc: func(container, name string, expiry time.Time) (string, error) {
+			assert.Equal(t, "container", container)
+			assert.Equal(t, "key", name)
+			assert.WithinDuration(t, time.Now().Add(signDuration), expiry, time.Minute)
+			return "https://account.blob.core.windows.net/container/key?sig=signature", nil
+		},
+	}
+	remoteURL := AzureRemoteURL{
+		blobService:  &mockBlobService,
+		signDuration: signDuration,
+	}
+	urlBlob, err := remoteURL.Get(context.Background(), "afs://container/key")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://account.blob.core.windows.net/container/key?sig=signature", urlBlob.Url)
+	assert.Equal(t, int64(100), urlBlob.Bytes)
+}
+
+func TestAzureGet_MissingBlob(t *testing.T) {
+	remoteURL := AzureRemoteURL{
+		blobService: &mockAzureBlobService{
+			getSizeFunc: func(container, name string) (int64, error) {
+				return 0, errors.New("blob not found")
+			},
+		},
+	}
+	_, err := remoteURL.Get(context.Background(), "afs://container/key")
+	assert.EqualError(t, err, "failed to get object size for afs://container/key with blob not found")
+}
@@ -2,6 +2,8 @@ package implementations
 
 import (
 	"context"
+	"encoding/base64"
+	"net/http"
 	"time"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -97,9 +99,12 @@ func (g *GCPRemoteURL) splitURI(ctx context.Context, uri string) (GCPGCSObject,
 	}, nil
 }
 
-func (g *GCPRemoteURL) signURL(ctx context.Context, gcsURI GCPGCSObject) (string, error) {
+// Signs a URL for requests with the given method. A non-empty md5 binds the signature to the base64 encoded Content-MD5
+// header of the request.
+func (g *GCPRemoteURL) signURL(ctx context.Context, gcsURI GCPGCSObject, method, md5 string) (string, error) {
 	opts := &gcs.SignedURLOptions{
-		Method:         "GET",
+		Method:         method,
+		MD5:            md5,
 		GoogleAccessID: g.signingPrincipal,
 		SignBytes: func(b []byte) ([]byte, error) {
 			req := &credentialspb.SignBlobRequest{
@@ -134,7 +139,7 @@ func (g *GCPRemoteURL) Get(ctx context.Context, uri string) (admin.UrlBlob, erro
 			codes.Internal, "failed to get object size for %s with %v", uri, err)
 	}
 
-	urlStr, err := g.signURL(ctx, gcsURI)
+	urlStr, err := g.signURL(ctx, gcsURI, http.MethodGet, "")
 	if err != nil {
 		logger.Warning(ctx,
 			"failed to presign url for uri [%s] for %v with err %v", uri, g.signDuration, err)
@@ -147,6 +152,23 @@ func (g *GCPRemoteURL) Get(ctx context.Context, uri string) (admin.UrlBlob, erro
 	}, nil
 }
 
+func (g *GCPRemoteURL) CreateUploadURL(ctx context.Context, uri string, contentMD5 []byte) (string, error) {
+	logger.Debugf(ctx, "Getting signed upload url for - %s", uri)
+	gcsURI, err := g.splitURI(ctx, uri)
+	if err != nil {
+		logger.Debugf(ctx, "failed to extract gcs bucket and object from uri: %s", uri)
+		return "", errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid uri: %s", uri)
+	}
+	urlStr, err := g.signURL(ctx, gcsURI, http.MethodPut, base64.StdEncoding.EncodeToString(contentMD5))
+	if err != nil {
+		logger.Warning(ctx,
+			"failed to presign upload url for uri [%s] for %v with err %v", uri, g.signDuration, err)
+		return "", errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to presign upload url for uri [%s] for %v with err %v", uri, g.signDuration, err)
+	}
+	return urlStr, nil
+}
+
 func (ts impersonationTokenSource) Token() (*oauth2.Token, error) {
 	req := credentialspb.GenerateAccessTokenRequest{
 		Name:  "projects/-/serviceAccounts/" + ts.signingPrincipal,
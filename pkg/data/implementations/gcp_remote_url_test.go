@@ -2,8 +2,10 @@ package implementations
 
 import (
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -110,6 +112,32 @@ func TestGCPGet(t *testing.T) {
 	assert.Equal(t, int64(100), urlBlob.Bytes)
 }
 
+func TestGCPCreateUploadURL(t *testing.T) {
+	signingPrincipal := "principal@example.com"
+	signedBlob := "signed"
+	contentMD5 := md5.Sum([]byte("content"))
+	encodedContentMD5 := base64.StdEncoding.EncodeToString(contentMD5[:])
+
+	mockIAMCredentials := mockIAMCredentialsImpl{}
+	mockIAMCredentials.signBlobFunc = func(ctx context.Context, req *credentialspb.SignBlobRequest, opts ...gax.CallOption) (*credentialspb.SignBlobResponse, error) {
+		// The signed payload starts with the method and the Content-MD5 header of the request.
+		assert.True(t, strings.HasPrefix(string(req.Payload), "PUT\n"+encodedContentMD5+"\n"))
+		return &credentialspb.SignBlobResponse{SignedBlob: []byte(signedBlob)}, nil
+	}
+
+	remoteURL := GCPRemoteURL{
+		iamCredentialsClient: &mockIAMCredentials,
+		signDuration:         3 * time.Minute,
+		signingPrincipal:     signingPrincipal,
+	}
+	urlStr, err := remoteURL.CreateUploadURL(context.Background(), "gs://bucket/key", contentMD5[:])
+	assert.Nil(t, err)
+
+	u, _ := url.Parse(urlStr)
+	assert.Equal(t, "/bucket/key", u.Path)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(signedBlob)), u.Query().Get("Signature"))
+}
+
 func TestToken(t *testing.T) {
 	token := "token"
 	signingPrincipal := "principal@example.com"
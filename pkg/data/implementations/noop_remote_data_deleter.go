@@ -0,0 +1,19 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/data/interfaces"
+	"github.com/flyteorg/flytestdlib/storage"
+)
+
+// No-op implementation of a RemoteDataDeleterInterface, for in-memory storage whose blobs don't outlive admin.
+type NoopRemoteDataDeleter struct{}
+
+func (n *NoopRemoteDataDeleter) Delete(ctx context.Context, reference storage.DataReference) error {
+	return nil
+}
+
+func NewNoopRemoteDataDeleter() interfaces.RemoteDataDeleterInterface {
+	return &NoopRemoteDataDeleter{}
+}
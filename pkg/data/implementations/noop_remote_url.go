@@ -27,6 +27,11 @@ func (n *NoopRemoteURL) Get(ctx context.Context, uri string) (admin.UrlBlob, err
 	}, nil
 }
 
+func (n *NoopRemoteURL) CreateUploadURL(ctx context.Context, uri string, contentMD5 []byte) (string, error) {
+	return "", errors.NewFlyteAdminErrorf(codes.Unimplemented,
+		"upload urls can't be signed without a remote data scheme which signs urls")
+}
+
 func NewNoopRemoteURL(remoteDataStoreClient storage.DataStore) interfaces.RemoteURLInterface {
 	return &NoopRemoteURL{
 		remoteDataStoreClient: remoteDataStoreClient,
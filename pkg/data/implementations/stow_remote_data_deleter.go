@@ -0,0 +1,86 @@
+package implementations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/data/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/graymeta/stow"
+	"github.com/graymeta/stow/s3"
+	"google.golang.org/grpc/codes"
+)
+
+// Defines the subset of a stow location used to delete blobs, for easy mock-ability in testing.
+type stowItemRemover interface {
+	RemoveItem(container, key string) error
+}
+
+type stowLocationWrapper struct {
+	delegate stow.Location
+}
+
+func (w *stowLocationWrapper) RemoveItem(container, key string) error {
+	c, err := w.delegate.Container(container)
+	if err != nil {
+		return err
+	}
+	return c.RemoveItem(key)
+}
+
+// Implementation of RemoteDataDeleterInterface which deletes blobs from the stow location admin's storage client is
+// configured with.
+type StowRemoteDataDeleter struct {
+	location stowItemRemover
+}
+
+func (d *StowRemoteDataDeleter) Delete(ctx context.Context, reference storage.DataReference) error {
+	_, container, key, err := reference.Split()
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid reference %s: %v", reference, err)
+	}
+	if err := d.location.RemoveItem(container, key); err != nil && err != stow.ErrNotFound {
+		logger.Debugf(ctx, "failed to delete %s with err: %v", reference, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to delete %s with err: %v", reference, err)
+	}
+	return nil
+}
+
+// Returns the stow kind and config the storage client dials, which legacy configs set through the s3 connection.
+func getStowConfig(cfg *storage.Config) (string, stow.ConfigMap) {
+	if len(cfg.Stow.Kind) > 0 && len(cfg.Stow.Config) > 0 {
+		return cfg.Stow.Kind, cfg.Stow.Config
+	}
+	stowConfig := stow.ConfigMap{
+		s3.ConfigAuthType: cfg.Connection.AuthType,
+		s3.ConfigRegion:   cfg.Connection.Region,
+	}
+	if endpoint := cfg.Connection.Endpoint.String(); endpoint != "" {
+		stowConfig[s3.ConfigEndpoint] = endpoint
+	}
+	if accessKey := cfg.Connection.AccessKey; accessKey != "" {
+		stowConfig[s3.ConfigAccessKeyID] = accessKey
+	}
+	if secretKey := cfg.Connection.SecretKey; secretKey != "" {
+		stowConfig[s3.ConfigSecretKey] = secretKey
+	}
+	if cfg.Connection.DisableSSL {
+		stowConfig[s3.ConfigDisableSSL] = "True"
+	}
+	return s3.Kind, stowConfig
+}
+
+// Returns a RemoteDataDeleterInterface which deletes blobs from the same location as a storage client created with the
+// given config.
+func NewStowRemoteDataDeleter(cfg *storage.Config) (interfaces.RemoteDataDeleterInterface, error) {
+	kind, stowConfig := getStowConfig(cfg)
+	location, err := stow.Dial(kind, stowConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to configure deleting blobs from %s with err: %v", kind, err)
+	}
+	return &StowRemoteDataDeleter{
+		location: &stowLocationWrapper{delegate: location},
+	}, nil
+}
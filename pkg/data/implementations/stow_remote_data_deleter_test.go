@@ -0,0 +1,87 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/graymeta/stow"
+	"github.com/graymeta/stow/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockStowItemRemover struct {
+	removeItemFunc func(container, key string) error
+}
+
+func (m *mockStowItemRemover) RemoveItem(container, key string) error {
+	return m.removeItemFunc(container, key)
+}
+
+func TestStowRemoteDataDeleter_Delete(t *testing.T) {
+	var removed []string
+	deleter := StowRemoteDataDeleter{
+		location: &mockStowItemRemover{
+			removeItemFunc: func(container, key string) error {
+				removed = append(removed, container, key)
+				return nil
+			},
+		},
+	}
+	err := deleter.Delete(context.Background(), "s3://bucket/metadata/p/d/n/inputs")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bucket", "metadata/p/d/n/inputs"}, removed)
+}
+
+func TestStowRemoteDataDeleter_NotFound(t *testing.T) {
+	deleter := StowRemoteDataDeleter{
+		location: &mockStowItemRemover{
+			removeItemFunc: func(container, key string) error {
+				return stow.ErrNotFound
+			},
+		},
+	}
+	assert.NoError(t, deleter.Delete(context.Background(), "s3://bucket/key"))
+}
+
+func TestStowRemoteDataDeleter_Error(t *testing.T) {
+	deleter := StowRemoteDataDeleter{
+		location: &mockStowItemRemover{
+			removeItemFunc: func(container, key string) error {
+				return errors.New("access denied")
+			},
+		},
+	}
+	err := deleter.Delete(context.Background(), "s3://bucket/key")
+	assert.EqualError(t, err, "failed to delete s3://bucket/key with err: access denied")
+}
+
+func TestGetStowConfig(t *testing.T) {
+	kind, stowConfig := getStowConfig(&storage.Config{
+		Stow: storage.StowConfig{
+			Kind:   "google",
+			Config: map[string]string{"project_id": "project"},
+		},
+	})
+	assert.Equal(t, "google", kind)
+	assert.Equal(t, stow.ConfigMap{"project_id": "project"}, stowConfig)
+
+	kind, stowConfig = getStowConfig(&storage.Config{
+		Connection: storage.ConnectionConfig{
+			AuthType:   "accesskey",
+			Region:     "us-east-1",
+			AccessKey:  "key",
+			SecretKey:  "secret",
+			DisableSSL: true,
+		},
+	})
+	assert.Equal(t, s3.Kind, kind)
+	assert.Equal(t, stow.ConfigMap{
+		s3.ConfigAuthType:    "accesskey",
+		s3.ConfigRegion:      "us-east-1",
+		s3.ConfigAccessKeyID: "key",
+		s3.ConfigSecretKey:   "secret",
+		s3.ConfigDisableSSL:  "True",
+	}, stowConfig)
+}
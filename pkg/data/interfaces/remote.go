@@ -4,10 +4,20 @@ import (
 	"context"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/storage"
 )
 
 // Defines an interface for fetching pre-signed URLs.
 type RemoteURLInterface interface {
 	// TODO: Refactor for URI to be of type DataReference. We should package a FromString-like function in flytestdlib
 	Get(ctx context.Context, uri string) (admin.UrlBlob, error)
+	// Returns a pre-signed URL which uploads an object to the uri with a PUT request. The request must carry the given
+	// Content-MD5 header, which the signature is bound to so that only the expected content can be uploaded.
+	CreateUploadURL(ctx context.Context, uri string, contentMD5 []byte) (string, error)
+}
+
+// Defines an interface for deleting blobs, which the storage client admin reads and writes data with can't do.
+type RemoteDataDeleterInterface interface {
+	// Deletes the blob at the reference. Deleting a blob which doesn't exist succeeds.
+	Delete(ctx context.Context, reference storage.DataReference) error
 }
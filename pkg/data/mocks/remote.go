@@ -5,11 +5,13 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/data/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/storage"
 )
 
 // Mock implementation of a RemoteURLInterface
 type MockRemoteURL struct {
-	GetCallback func(ctx context.Context, uri string) (admin.UrlBlob, error)
+	GetCallback             func(ctx context.Context, uri string) (admin.UrlBlob, error)
+	CreateUploadURLCallback func(ctx context.Context, uri string, contentMD5 []byte) (string, error)
 }
 
 func (m *MockRemoteURL) Get(ctx context.Context, uri string) (admin.UrlBlob, error) {
@@ -19,6 +21,25 @@ func (m *MockRemoteURL) Get(ctx context.Context, uri string) (admin.UrlBlob, err
 	return admin.UrlBlob{}, nil
 }
 
+func (m *MockRemoteURL) CreateUploadURL(ctx context.Context, uri string, contentMD5 []byte) (string, error) {
+	if m.CreateUploadURLCallback != nil {
+		return m.CreateUploadURLCallback(ctx, uri, contentMD5)
+	}
+	return "", nil
+}
+
 func NewMockRemoteURL() interfaces.RemoteURLInterface {
 	return &MockRemoteURL{}
 }
+
+// Mock implementation of a RemoteDataDeleterInterface
+type MockRemoteDataDeleter struct {
+	DeleteCallback func(ctx context.Context, reference storage.DataReference) error
+}
+
+func (m *MockRemoteDataDeleter) Delete(ctx context.Context, reference storage.DataReference) error {
+	if m.DeleteCallback != nil {
+		return m.DeleteCallback(ctx, reference)
+	}
+	return nil
+}
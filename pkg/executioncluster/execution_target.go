@@ -17,6 +17,8 @@ type ExecutionTargetSpec struct {
 	Domain      string
 	Workflow    string
 	LaunchPlan  string
+	// Overrides the ExecutionClusterLabel matchable attributes of the project, domain, workflow and launch plan.
+	ExecutionClusterLabel string
 }
 
 // Client object of the target execution cluster
@@ -32,3 +34,34 @@ type ExecutionTarget struct {
 func (e ExecutionTarget) Compare(to random.Comparable) bool {
 	return e.ID < to.(ExecutionTarget).ID
 }
+
+// A cluster an execution may run on, along with the chance of it being picked.
+type ExecutionTargetCandidate struct {
+	ID          string
+	Weight      float32
+	Probability float64
+}
+
+// The project, domain, workflow and launch plan of matchable attributes.
+type MatchedAttributes struct {
+	Project    string
+	Domain     string
+	Workflow   string
+	LaunchPlan string
+}
+
+// Explains which target an execution is assigned and why.
+type ExecutionTargetResolution struct {
+	// The target of the execution, which is only resolved for specs with a target or execution id since targets are
+	// otherwise picked at random from the candidates.
+	Target *ExecutionTarget
+	// The ExecutionClusterLabel the candidates were picked by, which names a cluster pool or a label in the cluster
+	// configuration.
+	Label     string
+	LabelPool bool
+	// The matchable attributes which set the label, unless the spec overrode it.
+	LabelAttributes *MatchedAttributes
+	Candidates      []ExecutionTargetCandidate
+	// Why the candidates were picked, in the order the decisions were made.
+	Reasons []string
+}
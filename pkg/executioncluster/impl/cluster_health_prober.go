@@ -0,0 +1,179 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The principal recorded when the prober marks a cluster unhealthy. Only clusters the prober marked unhealthy are marked
+// healthy again by it, so that states set by operators are left alone.
+const healthProberPrincipal = "flyteadmin-health-prober"
+
+// Checks the health of a cluster, returning when flytepropeller last renewed its lease if it was checked.
+type clusterProbe func(ctx context.Context, target executioncluster.ExecutionTarget) (*time.Time, error)
+
+type clusterHealthProberMetrics struct {
+	Scope                   promutils.Scope
+	ProbeFailures           prometheus.Counter
+	ClustersMarkedUnhealthy prometheus.Counter
+	ClustersRecovered       prometheus.Counter
+}
+
+type ClusterHealthProber struct {
+	cluster interfaces.ClusterInterface
+	db      repositories.RepositoryInterface
+	config  runtime.ClusterHealthCheckConfig
+	probe   clusterProbe
+	metrics clusterHealthProberMetrics
+}
+
+// Checks that a cluster's API server is ready and, when a lease is configured, that flytepropeller renewed its lease
+// recently.
+func (p *ClusterHealthProber) probeCluster(ctx context.Context, target executioncluster.ExecutionTarget) (
+	*time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout.Duration)
+	defer cancel()
+	restConfig := target.Config
+	restConfig.Timeout = p.config.Timeout.Duration
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(&restConfig)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := discoveryClient.RESTClient().Get().AbsPath("/readyz").DoRaw(ctx); err != nil {
+		return nil, fmt.Errorf("the api server isn't ready: %v", err)
+	}
+	if len(p.config.PropellerLeaseName) == 0 {
+		return nil, nil
+	}
+	lease := &coordinationv1.Lease{}
+	if err := target.Client.Get(ctx, client.ObjectKey{
+		Namespace: p.config.PropellerLeaseNamespace,
+		Name:      p.config.PropellerLeaseName,
+	}, lease); err != nil {
+		return nil, fmt.Errorf("failed to get the flytepropeller lease: %v", err)
+	}
+	if lease.Spec.RenewTime == nil {
+		return nil, fmt.Errorf("flytepropeller hasn't renewed its lease")
+	}
+	heartbeatAt := lease.Spec.RenewTime.Time
+	if time.Since(heartbeatAt) > p.config.PropellerHeartbeatTimeout.Duration {
+		return &heartbeatAt, fmt.Errorf("flytepropeller last renewed its lease at %s",
+			heartbeatAt.Format(time.RFC3339))
+	}
+	return &heartbeatAt, nil
+}
+
+// Returns the health state of a cluster updated with the result of a probe.
+func (p *ClusterHealthProber) getProbedHealth(health models.ClusterHealth, heartbeatAt *time.Time, probeErr error,
+	probedAt time.Time) models.ClusterHealth {
+	health.ProbedAt = &probedAt
+	if heartbeatAt != nil {
+		health.PropellerHeartbeatAt = heartbeatAt
+	}
+	if probeErr != nil {
+		health.ConsecutiveProbeFailures++
+		health.ProbeError = probeErr.Error()
+	} else {
+		health.ConsecutiveProbeFailures = 0
+		health.ProbeError = ""
+	}
+	switch {
+	case health.State == models.ClusterHealthy && int(health.ConsecutiveProbeFailures) >= p.config.FailureThreshold:
+		health.State = models.ClusterUnhealthy
+		health.Reason = fmt.Sprintf("failed %d consecutive health probes: %s", health.ConsecutiveProbeFailures,
+			health.ProbeError)
+		health.Principal = healthProberPrincipal
+	case health.State == models.ClusterUnhealthy && health.Principal == healthProberPrincipal &&
+		health.ConsecutiveProbeFailures == 0:
+		health.State = models.ClusterHealthy
+		health.Reason = ""
+	}
+	return health
+}
+
+func (p *ClusterHealthProber) probeAndRecord(ctx context.Context, target executioncluster.ExecutionTarget) error {
+	heartbeatAt, probeErr := p.probe(ctx, target)
+	if probeErr != nil {
+		p.metrics.ProbeFailures.Inc()
+		logger.Infof(ctx, "health probe of cluster [%s] failed with err: %v", target.ID, probeErr)
+	}
+	health, err := p.db.ClusterHealthRepo().Get(ctx, target.ID)
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); !ok || flyteAdminErr.Code() != codes.NotFound {
+			return err
+		}
+		health = models.ClusterHealth{
+			Cluster: target.ID,
+			State:   models.ClusterHealthy,
+		}
+	}
+	probed := p.getProbedHealth(health, heartbeatAt, probeErr, time.Now())
+	if err := p.db.ClusterHealthRepo().RecordProbe(ctx, probed); err != nil {
+		return err
+	}
+	if probed.State != health.State {
+		if probed.State == models.ClusterUnhealthy {
+			p.metrics.ClustersMarkedUnhealthy.Inc()
+			logger.Warningf(ctx, "Marked cluster [%s] unhealthy, new executions are routed to other clusters: %s",
+				target.ID, probed.Reason)
+		} else {
+			p.metrics.ClustersRecovered.Inc()
+			logger.Infof(ctx, "Marked cluster [%s] healthy again", target.ID)
+		}
+	}
+	return nil
+}
+
+func (p *ClusterHealthProber) ProbeClusters(ctx context.Context) error {
+	var failed []string
+	for _, target := range p.cluster.GetAllValidTargets() {
+		// Executions aren't routed when admin only runs them in its own cluster, which has no name.
+		if len(target.ID) == 0 {
+			continue
+		}
+		if err := p.probeAndRecord(ctx, target); err != nil {
+			logger.Warningf(ctx, "failed to record the health of cluster [%s] with err: %v", target.ID, err)
+			failed = append(failed, target.ID)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to record the health of clusters [%s]", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func NewClusterHealthProber(cluster interfaces.ClusterInterface, db repositories.RepositoryInterface,
+	config runtime.ClusterHealthCheckConfig, scope promutils.Scope) interfaces.ClusterHealthProberInterface {
+	prober := &ClusterHealthProber{
+		cluster: cluster,
+		db:      db,
+		config:  config,
+		metrics: clusterHealthProberMetrics{
+			Scope: scope,
+			ProbeFailures: scope.MustNewCounter("probe_failures",
+				"overall count of failed cluster health probes"),
+			ClustersMarkedUnhealthy: scope.MustNewCounter("clusters_marked_unhealthy",
+				"overall count of clusters marked unhealthy after failing consecutive health probes"),
+			ClustersRecovered: scope.MustNewCounter("clusters_recovered",
+				"overall count of clusters marked healthy again after passing a health probe"),
+		},
+	}
+	prober.probe = prober.probeCluster
+	return prober
+}
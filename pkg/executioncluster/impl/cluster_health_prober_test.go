@@ -0,0 +1,97 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster/mocks"
+	repo_mock "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func getClusterHealthProberForTest(health models.ClusterHealth, probeErr error) (
+	*ClusterHealthProber, *models.ClusterHealth) {
+	cluster := mocks.MockCluster{}
+	cluster.SetGetAllValidTargetsCallback(func() []executioncluster.ExecutionTarget {
+		return []executioncluster.ExecutionTarget{{ID: "testcluster2", Enabled: true}}
+	})
+	db := repo_mock.NewMockRepository()
+	healthRepo := db.ClusterHealthRepo().(*repo_mock.ClusterHealthRepoInterface)
+	if len(health.Cluster) == 0 {
+		healthRepo.OnGetMatch(mock.Anything, "testcluster2").Return(
+			models.ClusterHealth{}, errors.NewFlyteAdminErrorf(codes.NotFound, "not found"))
+	} else {
+		healthRepo.OnGetMatch(mock.Anything, "testcluster2").Return(health, nil)
+	}
+	recorded := &models.ClusterHealth{}
+	healthRepo.OnRecordProbeMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		*recorded = args.Get(1).(models.ClusterHealth)
+	})
+	prober := NewClusterHealthProber(&cluster, db, runtime.ClusterHealthCheckConfig{
+		FailureThreshold: 3,
+	}, promutils.NewTestScope()).(*ClusterHealthProber)
+	heartbeatAt := time.Now()
+	prober.probe = func(ctx context.Context, target executioncluster.ExecutionTarget) (*time.Time, error) {
+		return &heartbeatAt, probeErr
+	}
+	return prober, recorded
+}
+
+func TestProbeClusters_Healthy(t *testing.T) {
+	prober, recorded := getClusterHealthProberForTest(models.ClusterHealth{}, nil)
+	assert.NoError(t, prober.ProbeClusters(context.Background()))
+	assert.Equal(t, "testcluster2", recorded.Cluster)
+	assert.Equal(t, models.ClusterHealthy, recorded.State)
+	assert.NotNil(t, recorded.ProbedAt)
+	assert.NotNil(t, recorded.PropellerHeartbeatAt)
+	assert.Zero(t, recorded.ConsecutiveProbeFailures)
+}
+
+func TestProbeClusters_MarkedUnhealthy(t *testing.T) {
+	prober, recorded := getClusterHealthProberForTest(models.ClusterHealth{
+		Cluster:                  "testcluster2",
+		State:                    models.ClusterHealthy,
+		ConsecutiveProbeFailures: 2,
+	}, fmt.Errorf("the api server isn't ready"))
+	assert.NoError(t, prober.ProbeClusters(context.Background()))
+	assert.Equal(t, models.ClusterUnhealthy, recorded.State)
+	assert.Equal(t, int32(3), recorded.ConsecutiveProbeFailures)
+	assert.Equal(t, "failed 3 consecutive health probes: the api server isn't ready", recorded.Reason)
+	assert.Equal(t, healthProberPrincipal, recorded.Principal)
+}
+
+func TestProbeClusters_Recovered(t *testing.T) {
+	prober, recorded := getClusterHealthProberForTest(models.ClusterHealth{
+		Cluster:                  "testcluster2",
+		State:                    models.ClusterUnhealthy,
+		Principal:                healthProberPrincipal,
+		ConsecutiveProbeFailures: 5,
+	}, nil)
+	assert.NoError(t, prober.ProbeClusters(context.Background()))
+	assert.Equal(t, models.ClusterHealthy, recorded.State)
+	assert.Empty(t, recorded.Reason)
+}
+
+func TestProbeClusters_LeavesOperatorStates(t *testing.T) {
+	for _, state := range []string{models.ClusterUnhealthy, models.ClusterDraining} {
+		prober, recorded := getClusterHealthProberForTest(models.ClusterHealth{
+			Cluster:   "testcluster2",
+			State:     state,
+			Reason:    "maintenance",
+			Principal: "operator",
+		}, nil)
+		assert.NoError(t, prober.ProbeClusters(context.Background()))
+		assert.Equal(t, state, recorded.State)
+		assert.Equal(t, "maintenance", recorded.Reason)
+		assert.Equal(t, "operator", recorded.Principal)
+	}
+}
@@ -25,6 +25,21 @@ func (i InCluster) GetTarget(ctx context.Context, spec *executioncluster.Executi
 	return &i.target, nil
 }
 
+func (i InCluster) ResolveTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (
+	*executioncluster.ExecutionTargetResolution, error) {
+	target, err := i.GetTarget(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	return &executioncluster.ExecutionTargetResolution{
+		Target: target,
+		Candidates: []executioncluster.ExecutionTargetCandidate{
+			{ID: target.ID, Probability: 1},
+		},
+		Reasons: []string{"executions run in the cluster admin is deployed in"},
+	}, nil
+}
+
 func (i InCluster) GetAllValidTargets() []executioncluster.ExecutionTarget {
 	return []executioncluster.ExecutionTarget{
 		i.target,
@@ -2,9 +2,12 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"hash/fnv"
 	"math/rand"
+	"sort"
+	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -16,6 +19,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/resources"
 	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"google.golang.org/grpc/codes"
 
 	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
@@ -32,7 +36,9 @@ type RandomClusterSelector struct {
 	equalWeightedAllClusters random.WeightedRandomList
 	labelWeightedRandomMap   map[string]random.WeightedRandomList
 	executionTargetMap       map[string]executioncluster.ExecutionTarget
+	labelClusterMap          map[string][]runtime.ClusterEntity
 	resourceManager          managerInterfaces.ResourceInterface
+	db                       repositories.RepositoryInterface
 }
 
 func getRandSource(seed string) (rand.Source, error) {
@@ -100,59 +106,209 @@ func getLabeledWeightedRandomForCluster(ctx context.Context,
 	return labeledWeightedRandomMap, nil
 }
 
-func (s RandomClusterSelector) GetAllValidTargets() []executioncluster.ExecutionTarget {
-	v := make([]executioncluster.ExecutionTarget, 0)
-	for _, value := range s.executionTargetMap {
-		if value.Enabled {
-			v = append(v, value)
+// Sets the chances of candidates being picked by their weights, which are equal when none of the candidates have one.
+func setCandidateProbabilities(candidates []executioncluster.ExecutionTargetCandidate) {
+	var totalWeight float64
+	for _, candidate := range candidates {
+		totalWeight += float64(candidate.Weight)
+	}
+	for i := range candidates {
+		if totalWeight == 0 {
+			candidates[i].Probability = 1 / float64(len(candidates))
+		} else {
+			candidates[i].Probability = float64(candidates[i].Weight) / totalWeight
 		}
 	}
-	return v
 }
 
-func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error) {
-	if spec == nil {
-		return nil, fmt.Errorf("empty executionTargetSpec")
+// Returns the weighted random list of the enabled and healthy clusters in a cluster pool, along with the candidates it
+// picks from.
+func (s RandomClusterSelector) getClusterPoolWeightedRandom(ctx context.Context, pool models.ClusterPool) (
+	random.WeightedRandomList, []executioncluster.ExecutionTargetCandidate, error) {
+	var members []models.ClusterPoolMember
+	if err := json.Unmarshal(pool.Members, &members); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal the members of cluster pool %s: %v", pool.Name, err)
 	}
-	if spec.TargetID != "" {
-		if val, ok := s.executionTargetMap[spec.TargetID]; ok {
-			return &val, nil
+	healthModels, err := s.db.ClusterHealthRepo().ListAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	states := make(map[string]string, len(healthModels))
+	for _, healthModel := range healthModels {
+		states[healthModel.Cluster] = healthModel.State
+	}
+	candidates := make([]executioncluster.ExecutionTargetCandidate, 0, len(members))
+	var totalWeight float32
+	for _, member := range members {
+		cluster, ok := s.executionTargetMap[member.Cluster]
+		if !ok || !cluster.Enabled {
+			continue
 		}
-		return nil, fmt.Errorf("invalid cluster target %s", spec.TargetID)
+		// Clusters without a health state are healthy.
+		if state, ok := states[member.Cluster]; ok && state != models.ClusterHealthy {
+			continue
+		}
+		candidates = append(candidates, executioncluster.ExecutionTargetCandidate{
+			ID:     member.Cluster,
+			Weight: member.Weight,
+		})
+		totalWeight += member.Weight
 	}
-	resource, err := s.resourceManager.GetResource(ctx, managerInterfaces.ResourceRequest{
-		Project:      spec.Project,
-		Domain:       spec.Domain,
-		Workflow:     spec.Workflow,
-		LaunchPlan:   spec.LaunchPlan,
-		ResourceType: admin.MatchableResource_EXECUTION_CLUSTER_LABEL,
-	})
+	// Unlike labels, pools don't fall back to all enabled clusters, since executions may be pooled to keep them on
+	// particular clusters.
+	if len(candidates) == 0 {
+		return nil, nil, errors.NewFlyteAdminErrorf(codes.Unavailable, "cluster pool %s has no healthy clusters", pool.Name)
+	}
+	// Pool weights are relative, whereas weighted random lists only take weights of at most 1.
+	entries := make([]random.Entry, len(candidates))
+	for i, candidate := range candidates {
+		entries[i] = random.Entry{
+			Item:   s.executionTargetMap[candidate.ID],
+			Weight: candidate.Weight / totalWeight,
+		}
+	}
+	weightedRandomList, err := random.NewWeightedRandom(ctx, entries)
 	if err != nil {
-		if flyteAdminError, ok := err.(errors.FlyteAdminError); !ok || flyteAdminError.Code() != codes.NotFound {
-			return nil, err
+		return nil, nil, err
+	}
+	return weightedRandomList, candidates, nil
+}
+
+// Returns the weighted random list of the clusters an ExecutionClusterLabel value routes to, which is either the name
+// of a cluster pool or a label in the cluster configuration, along with the candidates it picks from and whether the
+// value names a pool. Returns nil when the value matches neither.
+func (s RandomClusterSelector) getLabelWeightedRandom(ctx context.Context, label string) (
+	random.WeightedRandomList, []executioncluster.ExecutionTargetCandidate, bool, error) {
+	pool, err := s.db.ClusterPoolRepo().Get(ctx, label)
+	if err == nil {
+		weightedRandomList, candidates, err := s.getClusterPoolWeightedRandom(ctx, pool)
+		return weightedRandomList, candidates, true, err
+	}
+	if flyteAdminError, ok := err.(errors.FlyteAdminError); !ok || flyteAdminError.Code() != codes.NotFound {
+		return nil, nil, false, err
+	}
+	if weightedRandomList, ok := s.labelWeightedRandomMap[label]; ok {
+		candidates := make([]executioncluster.ExecutionTargetCandidate, 0)
+		for _, clusterEntity := range s.labelClusterMap[label] {
+			if !s.executionTargetMap[clusterEntity.ID].Enabled {
+				continue
+			}
+			candidates = append(candidates, executioncluster.ExecutionTargetCandidate{
+				ID:     clusterEntity.ID,
+				Weight: clusterEntity.Weight,
+			})
 		}
+		return weightedRandomList, candidates, false, nil
 	}
-	var weightedRandomList random.WeightedRandomList
-	if resource != nil && resource.Attributes.GetExecutionClusterLabel() != nil {
-		label := resource.Attributes.GetExecutionClusterLabel().Value
+	logger.Debugf(ctx, "No cluster mapping found for the label %s", label)
+	return nil, nil, false, nil
+}
 
-		if _, ok := s.labelWeightedRandomMap[label]; ok {
-			weightedRandomList = s.labelWeightedRandomMap[label]
-		} else {
-			logger.Debugf(ctx, "No cluster mapping found for the label %s", label)
+// Describes where matchable attributes are set, e.g. "project [flytesnacks], domain [development]".
+func describeMatchedAttributes(attributes executioncluster.MatchedAttributes) string {
+	parts := make([]string, 0, 4)
+	for _, part := range []struct {
+		name  string
+		value string
+	}{
+		{name: "project", value: attributes.Project},
+		{name: "domain", value: attributes.Domain},
+		{name: "workflow", value: attributes.Workflow},
+		{name: "launch plan", value: attributes.LaunchPlan},
+	} {
+		if len(part.value) > 0 {
+			parts = append(parts, fmt.Sprintf("%s [%s]", part.name, part.value))
 		}
+	}
+	if len(parts) == 0 {
+		return "the global defaults"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Resolves the weighted random list a spec picks its target from, explaining how it was resolved.
+func (s RandomClusterSelector) resolveWeightedRandom(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (
+	random.WeightedRandomList, *executioncluster.ExecutionTargetResolution, error) {
+	resolution := &executioncluster.ExecutionTargetResolution{
+		Label:   spec.ExecutionClusterLabel,
+		Reasons: make([]string, 0),
+	}
+	if len(resolution.Label) > 0 {
+		resolution.Reasons = append(resolution.Reasons, fmt.Sprintf(
+			"the execution cluster label [%s] was requested", resolution.Label))
 	} else {
-		logger.Debugf(ctx, "No override found for the spec %v", spec)
+		resource, err := s.resourceManager.GetResource(ctx, managerInterfaces.ResourceRequest{
+			Project:      spec.Project,
+			Domain:       spec.Domain,
+			Workflow:     spec.Workflow,
+			LaunchPlan:   spec.LaunchPlan,
+			ResourceType: admin.MatchableResource_EXECUTION_CLUSTER_LABEL,
+		})
+		if err != nil {
+			if flyteAdminError, ok := err.(errors.FlyteAdminError); !ok || flyteAdminError.Code() != codes.NotFound {
+				return nil, nil, err
+			}
+		}
+		if resource != nil && resource.Attributes.GetExecutionClusterLabel() != nil {
+			resolution.Label = resource.Attributes.GetExecutionClusterLabel().Value
+			resolution.LabelAttributes = &executioncluster.MatchedAttributes{
+				Project:    resource.Project,
+				Domain:     resource.Domain,
+				Workflow:   resource.Workflow,
+				LaunchPlan: resource.LaunchPlan,
+			}
+			resolution.Reasons = append(resolution.Reasons, fmt.Sprintf(
+				"the execution cluster label [%s] is set by the matchable attributes of %s", resolution.Label,
+				describeMatchedAttributes(*resolution.LabelAttributes)))
+		} else {
+			logger.Debugf(ctx, "No override found for the spec %v", spec)
+			resolution.Reasons = append(resolution.Reasons, "no execution cluster label is set")
+		}
+	}
+	var weightedRandomList random.WeightedRandomList
+	if len(resolution.Label) > 0 {
+		var err error
+		weightedRandomList, resolution.Candidates, resolution.LabelPool, err = s.getLabelWeightedRandom(
+			ctx, resolution.Label)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resolution.LabelPool {
+			resolution.Reasons = append(resolution.Reasons, fmt.Sprintf(
+				"the label names the cluster pool [%s], whose enabled and healthy clusters are picked by weight",
+				resolution.Label))
+		} else if weightedRandomList != nil {
+			resolution.Reasons = append(resolution.Reasons,
+				"the label maps to clusters in the cluster configuration, whose enabled clusters are picked by weight")
+		} else {
+			resolution.Reasons = append(resolution.Reasons,
+				"the label names neither a cluster pool nor a label with enabled clusters in the cluster configuration")
+		}
 	}
 	// If there is no label associated (or) if the label is invalid, choose from all enabled clusters.
 	// Note that if there is a valid label with zero "Enabled" clusters, we still choose from all enabled ones.
 	if weightedRandomList == nil {
 		weightedRandomList = s.equalWeightedAllClusters
+		resolution.Candidates = make([]executioncluster.ExecutionTargetCandidate, 0)
+		for _, target := range s.GetAllValidTargets() {
+			resolution.Candidates = append(resolution.Candidates, executioncluster.ExecutionTargetCandidate{
+				ID: target.ID,
+			})
+		}
+		resolution.Reasons = append(resolution.Reasons, "all enabled clusters are picked with equal weights")
 	}
+	sort.Slice(resolution.Candidates, func(i, j int) bool {
+		return resolution.Candidates[i].ID < resolution.Candidates[j].ID
+	})
+	setCandidateProbabilities(resolution.Candidates)
+	return weightedRandomList, resolution, nil
+}
 
-	executionName := spec.ExecutionID
-	if executionName != "" {
-		randSrc, err := getRandSource(executionName)
+// Picks a target from a weighted random list, deterministically for executions with an id.
+func pickTarget(weightedRandomList random.WeightedRandomList, executionID string) (
+	*executioncluster.ExecutionTarget, error) {
+	if executionID != "" {
+		randSrc, err := getRandSource(executionID)
 		if err != nil {
 			return nil, err
 		}
@@ -167,6 +323,63 @@ func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executionclu
 	return &execTarget, nil
 }
 
+func (s RandomClusterSelector) GetAllValidTargets() []executioncluster.ExecutionTarget {
+	v := make([]executioncluster.ExecutionTarget, 0)
+	for _, value := range s.executionTargetMap {
+		if value.Enabled {
+			v = append(v, value)
+		}
+	}
+	return v
+}
+
+func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("empty executionTargetSpec")
+	}
+	if spec.TargetID != "" {
+		if val, ok := s.executionTargetMap[spec.TargetID]; ok {
+			return &val, nil
+		}
+		return nil, fmt.Errorf("invalid cluster target %s", spec.TargetID)
+	}
+	weightedRandomList, _, err := s.resolveWeightedRandom(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	return pickTarget(weightedRandomList, spec.ExecutionID)
+}
+
+func (s RandomClusterSelector) ResolveTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (
+	*executioncluster.ExecutionTargetResolution, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("empty executionTargetSpec")
+	}
+	if spec.TargetID != "" {
+		target, err := s.GetTarget(ctx, spec)
+		if err != nil {
+			return nil, err
+		}
+		return &executioncluster.ExecutionTargetResolution{
+			Target: target,
+			Candidates: []executioncluster.ExecutionTargetCandidate{
+				{ID: target.ID, Probability: 1},
+			},
+			Reasons: []string{fmt.Sprintf("the cluster [%s] was requested", target.ID)},
+		}, nil
+	}
+	weightedRandomList, resolution, err := s.resolveWeightedRandom(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	if spec.ExecutionID != "" {
+		if resolution.Target, err = pickTarget(weightedRandomList, spec.ExecutionID); err != nil {
+			return nil, err
+		}
+	}
+	return resolution, nil
+}
+
 func NewRandomClusterSelector(initializationErrorCounter prometheus.Counter, config runtime.Configuration, executionTargetProvider interfaces.ExecutionTargetProvider, db repositories.RepositoryInterface) (interfaces.ClusterInterface, error) {
 	equalWeightedAllClusters, executionTargetMap, err := getExecutionTargets(context.Background(), initializationErrorCounter, executionTargetProvider, config.ClusterConfiguration())
 	if err != nil {
@@ -179,7 +392,9 @@ func NewRandomClusterSelector(initializationErrorCounter prometheus.Counter, con
 	return &RandomClusterSelector{
 		labelWeightedRandomMap:   labelWeightedRandomMap,
 		executionTargetMap:       executionTargetMap,
+		labelClusterMap:          config.ClusterConfiguration().GetLabelClusterMap(),
 		resourceManager:          resources.NewResourceManager(db, config.ApplicationConfiguration()),
 		equalWeightedAllClusters: equalWeightedAllClusters,
+		db:                       db,
 	}, nil
 }
@@ -23,11 +23,13 @@ import (
 	"github.com/flyteorg/flytestdlib/config"
 	"github.com/flyteorg/flytestdlib/config/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 const testProject = "project"
 const testDomain = "domain"
 const testWorkflow = "name"
+const testPooledProject = "pooled"
 
 func initTestConfig(fileName string) error {
 	pwd, err := os.Getwd()
@@ -43,10 +45,22 @@ func initTestConfig(fileName string) error {
 }
 
 func getRandomClusterSelectorForTest(t *testing.T) interfaces2.ClusterInterface {
+	return getRandomClusterSelectorWithHealthForTest(t, nil)
+}
+
+func getRandomClusterSelectorWithHealthForTest(t *testing.T, healths []models.ClusterHealth) interfaces2.ClusterInterface {
 	err := initTestConfig("clusters_config.yaml")
 	assert.NoError(t, err)
 
 	db := repo_mock.NewMockRepository()
+	db.ClusterPoolRepo().(*repo_mock.ClusterPoolRepoInterface).OnGetMatch(mock.Anything, testPooledProject).Return(
+		models.ClusterPool{
+			Name:    testPooledProject,
+			Members: []byte(`[{"cluster": "testcluster2", "weight": 1}, {"cluster": "testcluster3", "weight": 1}]`),
+		}, nil)
+	db.ClusterPoolRepo().(*repo_mock.ClusterPoolRepoInterface).OnGetMatch(mock.Anything, mock.Anything).Return(
+		models.ClusterPool{}, errors.NewFlyteAdminErrorf(codes.NotFound, "not found"))
+	db.ClusterHealthRepo().(*repo_mock.ClusterHealthRepoInterface).OnListAllMatch(mock.Anything).Return(healths, nil)
 	db.ResourceRepo().(*repo_mock.MockResourceRepo).GetFunction = func(ctx context.Context, ID repo_interface.ResourceID) (resource models.Resource, e error) {
 		assert.Equal(t, "EXECUTION_CLUSTER_LABEL", ID.ResourceType)
 		if ID.Project == "" {
@@ -60,7 +74,17 @@ func getRandomClusterSelectorForTest(t *testing.T) interfaces2.ClusterInterface
 			ResourceType: ID.ResourceType,
 			LaunchPlan:   ID.LaunchPlan,
 		}
-		if ID.Project == testProject && ID.Domain == testDomain {
+		if ID.Project == testPooledProject {
+			matchingAttributes := &admin.MatchingAttributes{
+				Target: &admin.MatchingAttributes_ExecutionClusterLabel{
+					ExecutionClusterLabel: &admin.ExecutionClusterLabel{
+						Value: testPooledProject,
+					},
+				},
+			}
+			marshalledMatchingAttributes, _ := proto.Marshal(matchingAttributes)
+			response.Attributes = marshalledMatchingAttributes
+		} else if ID.Project == testProject && ID.Domain == testDomain {
 			matchingAttributes := &admin.MatchingAttributes{
 				Target: &admin.MatchingAttributes_ExecutionClusterLabel{
 					ExecutionClusterLabel: &admin.ExecutionClusterLabel{
@@ -169,3 +193,76 @@ func TestRandomClusterSelectorGetAllValidTargets(t *testing.T) {
 	targets := cluster.GetAllValidTargets()
 	assert.Equal(t, 2, len(targets))
 }
+
+func TestRandomClusterSelectorGetTargetForClusterPool(t *testing.T) {
+	cluster := getRandomClusterSelectorWithHealthForTest(t, []models.ClusterHealth{
+		{Cluster: "testcluster2", State: models.ClusterHealthy},
+		{Cluster: "testcluster3", State: models.ClusterDraining},
+	})
+	for _, executionID := range []string{"e1", "e22", "e333"} {
+		target, err := cluster.GetTarget(context.Background(), &executioncluster.ExecutionTargetSpec{
+			Project:     testPooledProject,
+			Domain:      testDomain,
+			ExecutionID: executionID,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "testcluster2", target.ID)
+	}
+}
+
+func TestRandomClusterSelectorGetTargetForUnhealthyClusterPool(t *testing.T) {
+	cluster := getRandomClusterSelectorWithHealthForTest(t, []models.ClusterHealth{
+		{Cluster: "testcluster2", State: models.ClusterUnhealthy},
+		{Cluster: "testcluster3", State: models.ClusterDraining},
+	})
+	_, err := cluster.GetTarget(context.Background(), &executioncluster.ExecutionTargetSpec{
+		Project:     testPooledProject,
+		Domain:      testDomain,
+		ExecutionID: "e1",
+	})
+	assert.Equal(t, codes.Unavailable, err.(errors.FlyteAdminError).Code())
+}
+
+func TestRandomClusterSelectorResolveTarget(t *testing.T) {
+	cluster := getRandomClusterSelectorForTest(t)
+	resolution, err := cluster.ResolveTarget(context.Background(), &executioncluster.ExecutionTargetSpec{
+		Project:     testProject,
+		Domain:      testDomain,
+		ExecutionID: "e",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "testcluster2", resolution.Target.ID)
+	assert.Equal(t, "test", resolution.Label)
+	assert.False(t, resolution.LabelPool)
+	assert.Equal(t, &executioncluster.MatchedAttributes{
+		Project: testProject,
+		Domain:  testDomain,
+	}, resolution.LabelAttributes)
+	assert.Equal(t, []executioncluster.ExecutionTargetCandidate{
+		{ID: "testcluster2", Probability: 0.5},
+		{ID: "testcluster3", Probability: 0.5},
+	}, resolution.Candidates)
+	assert.Equal(t, []string{
+		"the execution cluster label [test] is set by the matchable attributes of project [project], domain [domain]",
+		"the label names neither a cluster pool nor a label with enabled clusters in the cluster configuration",
+		"all enabled clusters are picked with equal weights",
+	}, resolution.Reasons)
+}
+
+func TestRandomClusterSelectorResolveTargetForRequestedLabel(t *testing.T) {
+	cluster := getRandomClusterSelectorWithHealthForTest(t, []models.ClusterHealth{
+		{Cluster: "testcluster3", State: models.ClusterDraining},
+	})
+	resolution, err := cluster.ResolveTarget(context.Background(), &executioncluster.ExecutionTargetSpec{
+		Project:               testProject,
+		Domain:                testDomain,
+		ExecutionClusterLabel: testPooledProject,
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, resolution.Target, "targets aren't picked without an execution id")
+	assert.True(t, resolution.LabelPool)
+	assert.Nil(t, resolution.LabelAttributes)
+	assert.Equal(t, []executioncluster.ExecutionTargetCandidate{
+		{ID: "testcluster2", Weight: 1, Probability: 1},
+	}, resolution.Candidates)
+}
@@ -0,0 +1,57 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	"github.com/pkg/errors"
+)
+
+// The id of the only target of admin served in sandbox mode.
+const SandboxClusterID = "sandbox"
+
+// Stands in for execution clusters when admin is served without kubernetes. Its target has no clients, so it's only
+// meant to be used along with an executor which doesn't launch executions.
+type SandboxCluster struct {
+	target executioncluster.ExecutionTarget
+}
+
+func (s SandboxCluster) GetTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (
+	*executioncluster.ExecutionTarget, error) {
+	if spec != nil && spec.TargetID != "" && spec.TargetID != SandboxClusterID {
+		return nil, errors.New(fmt.Sprintf("remote target %s is not supported in sandbox mode", spec.TargetID))
+	}
+	return &s.target, nil
+}
+
+func (s SandboxCluster) ResolveTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (
+	*executioncluster.ExecutionTargetResolution, error) {
+	target, err := s.GetTarget(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	return &executioncluster.ExecutionTargetResolution{
+		Target: target,
+		Candidates: []executioncluster.ExecutionTargetCandidate{
+			{ID: target.ID, Probability: 1},
+		},
+		Reasons: []string{"admin is served in sandbox mode, which records executions rather than launching them"},
+	}, nil
+}
+
+func (s SandboxCluster) GetAllValidTargets() []executioncluster.ExecutionTarget {
+	return []executioncluster.ExecutionTarget{
+		s.target,
+	}
+}
+
+func NewSandboxCluster() interfaces.ClusterInterface {
+	return &SandboxCluster{
+		target: executioncluster.ExecutionTarget{
+			ID:      SandboxClusterID,
+			Enabled: true,
+		},
+	}
+}
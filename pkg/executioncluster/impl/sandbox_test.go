@@ -0,0 +1,33 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSandboxClusterGetTarget(t *testing.T) {
+	cluster := NewSandboxCluster()
+	target, err := cluster.GetTarget(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, SandboxClusterID, target.ID)
+	assert.Nil(t, target.FlyteClient)
+
+	target, err = cluster.GetTarget(context.Background(), &executioncluster.ExecutionTargetSpec{
+		TargetID: SandboxClusterID,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, SandboxClusterID, target.ID)
+
+	_, err = cluster.GetTarget(context.Background(), &executioncluster.ExecutionTargetSpec{TargetID: "t1"})
+	assert.EqualError(t, err, "remote target t1 is not supported in sandbox mode")
+}
+
+func TestSandboxClusterGetAllValidTargets(t *testing.T) {
+	targets := NewSandboxCluster().GetAllValidTargets()
+	assert.Equal(t, 1, len(targets))
+	assert.Equal(t, SandboxClusterID, targets[0].ID)
+}
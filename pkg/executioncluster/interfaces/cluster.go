@@ -10,4 +10,6 @@ import (
 type ClusterInterface interface {
 	GetTarget(context.Context, *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error)
 	GetAllValidTargets() []executioncluster.ExecutionTarget
+	// Explains which target GetTarget picks for a spec and why, without launching anything.
+	ResolveTarget(context.Context, *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTargetResolution, error)
 }
@@ -0,0 +1,9 @@
+package interfaces
+
+import "context"
+
+// Periodically probes the health of execution clusters, marking clusters which fail consecutive probes unhealthy so
+// that new executions are routed to the other healthy clusters in their pools.
+type ClusterHealthProberInterface interface {
+	ProbeClusters(ctx context.Context) error
+}
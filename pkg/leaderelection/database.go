@@ -0,0 +1,133 @@
+package leaderelection
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// Identifies the postgres advisory locks leaders hold, along with their role. Any constant works, so long as every admin
+// replica uses the same one.
+const leaderLockSpace = "flyteadmin-leader"
+
+// Returns the advisory lock of a role. Hashing keeps the locks of roles apart without a registry of their IDs.
+func getLeaderLockID(role string) int64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(leaderLockSpace + "/" + role))
+	return int64(hash.Sum64())
+}
+
+// Elects the leader with a postgres advisory lock, which belongs to the session of the leader until it gives up the
+// leadership or its session ends.
+type databaseElector struct {
+	db *sql.DB
+	// Advisory locks are specific to postgres. Other databases, such as sqlite, aren't shared by replicas, so the
+	// only replica leads right away.
+	postgres bool
+	lockID   int64
+	timing   timing
+}
+
+func (e *databaseElector) Run(ctx context.Context, lead func(ctx context.Context)) error {
+	if !e.postgres {
+		lead(ctx)
+		return nil
+	}
+	conn := e.acquire(ctx)
+	if conn == nil {
+		return nil
+	}
+	defer e.release(ctx, conn)
+	logger.Infof(ctx, "Became the leader")
+
+	leadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lead(leadCtx)
+	}()
+	err := e.hold(leadCtx, conn, done)
+	cancel()
+	<-done
+	logger.Infof(ctx, "Stopped leading")
+	return err
+}
+
+// Waits for the lock, and returns the connection of the session which holds it, or nil once ctx is done.
+func (e *databaseElector) acquire(ctx context.Context) *sql.Conn {
+	for {
+		conn, err := e.tryAcquire(ctx)
+		if err != nil {
+			logger.Warningf(ctx, "Failed to try the leader lock: %v", err)
+		} else if conn != nil {
+			return conn
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(e.timing.retryPeriod):
+		}
+	}
+}
+
+// Advisory locks belong to the session which acquired them, so the lock is taken on a connection reserved for it.
+func (e *databaseElector) tryAcquire(ctx context.Context) (*sql.Conn, error) {
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", e.lockID).Scan(&acquired); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if !acquired {
+		_ = conn.Close()
+		return nil, nil
+	}
+	return conn, nil
+}
+
+// Checks the session holding the lock is alive until leading is over, since the lock is released along with the
+// session. Returns an error if the session can't be reached in time.
+func (e *databaseElector) hold(ctx context.Context, conn *sql.Conn, done <-chan struct{}) error {
+	ticker := time.NewTicker(e.timing.retryPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-done:
+			return nil
+		case <-ticker.C:
+			checkCtx, cancel := context.WithTimeout(ctx, e.timing.renewDeadline)
+			_, err := conn.ExecContext(checkCtx, "SELECT 1")
+			cancel()
+			if err != nil && ctx.Err() == nil {
+				return fmt.Errorf("lost the session holding the leader lock: %w", err)
+			}
+		}
+	}
+}
+
+func (e *databaseElector) release(ctx context.Context, conn *sql.Conn) {
+	if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", e.lockID); err != nil {
+		logger.Warningf(ctx, "Failed to release the leader lock: %v", err)
+	}
+	_ = conn.Close()
+}
+
+func NewDatabaseElector(db *sql.DB, postgres bool, cfg config.LeaderElectionConfig, role string) Elector {
+	return &databaseElector{
+		db:       db,
+		postgres: postgres,
+		lockID:   getLeaderLockID(role),
+		timing:   getTiming(cfg),
+	}
+}
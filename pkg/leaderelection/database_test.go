@@ -0,0 +1,82 @@
+package leaderelection
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	flyteConfig "github.com/flyteorg/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+)
+
+var testElectionConfig = config.LeaderElectionConfig{
+	RenewDeadline: flyteConfig.Duration{Duration: time.Second},
+	RetryPeriod:   flyteConfig.Duration{Duration: 10 * time.Millisecond},
+}
+
+func getDbForTest(t *testing.T) *sql.DB {
+	mocket.Catcher.Register()
+	db, err := sql.Open(mocket.DriverName, "fake args")
+	if err != nil {
+		t.Fatalf("Failed to open mock db with err %v", err)
+	}
+	return db
+}
+
+func TestDatabaseElector(t *testing.T) {
+	elector := NewDatabaseElector(getDbForTest(t), true, testElectionConfig, RoleOutbox)
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery("pg_try_advisory_lock").WithReply(
+		[]map[string]interface{}{{"pg_try_advisory_lock": true}})
+	unlock := GlobalMock.NewMock().WithQuery("pg_advisory_unlock")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	led := false
+	err := elector.Run(ctx, func(ctx context.Context) {
+		led = true
+		// Giving up the leadership.
+		cancel()
+		<-ctx.Done()
+	})
+	assert.NoError(t, err)
+	assert.True(t, led)
+	assert.True(t, unlock.Triggered)
+}
+
+func TestDatabaseElector_LostSession(t *testing.T) {
+	elector := NewDatabaseElector(getDbForTest(t), true, testElectionConfig, RoleOutbox)
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery("pg_try_advisory_lock").WithReply(
+		[]map[string]interface{}{{"pg_try_advisory_lock": true}})
+	GlobalMock.NewMock().WithQuery("SELECT 1").WithExecException()
+
+	stopped := false
+	err := elector.Run(context.Background(), func(ctx context.Context) {
+		<-ctx.Done()
+		stopped = true
+	})
+	assert.Error(t, err)
+	assert.True(t, stopped)
+}
+
+func TestDatabaseElector_NotPostgres(t *testing.T) {
+	elector := NewDatabaseElector(getDbForTest(t), false, testElectionConfig, RoleOutbox)
+	acquire := mocket.Catcher.Reset().NewMock().WithQuery("pg_try_advisory_lock")
+
+	led := false
+	err := elector.Run(context.Background(), func(ctx context.Context) {
+		led = true
+	})
+	assert.NoError(t, err)
+	assert.True(t, led)
+	assert.False(t, acquire.Triggered)
+}
+
+func TestGetLeaderLockID(t *testing.T) {
+	// Each role has a leader of its own.
+	assert.NotEqual(t, getLeaderLockID(RoleScheduler), getLeaderLockID(RoleOutbox))
+	assert.Equal(t, getLeaderLockID(RoleScheduler), getLeaderLockID(RoleScheduler))
+}
@@ -0,0 +1,96 @@
+// Package leaderelection elects the one replica, among those serving admin, which runs each of the background processes
+// that mustn't run more than once at a time, such as the scheduler.
+package leaderelection
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	"github.com/flyteorg/flytestdlib/logger"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// The background processes which run on one replica at a time. Each of them has a leader of its own, so they can run
+// on different replicas.
+const (
+	RoleScheduler       = "scheduler"
+	RoleClusterResource = "clusterresource"
+	RoleRetention       = "retention"
+	RoleOutbox          = "outbox"
+	// The periodic processes of serve.
+	RoleExecutionDispatcher = "executiondispatcher"
+	RoleBackfill            = "backfill"
+	RoleRollout             = "rollout"
+	RoleDigest              = "digest"
+	RoleProjectPurge        = "projectpurge"
+	RoleLaunchPlanStats     = "launchplanstats"
+	RoleClusterHealth       = "clusterhealth"
+	RoleOffloadedInputs     = "offloadedinputs"
+)
+
+const (
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewDeadline = 10 * time.Second
+	defaultRetryPeriod   = 2 * time.Second
+)
+
+type Elector interface {
+	// Blocks until this replica is elected, then runs lead with a context which is cancelled once the leadership is
+	// lost. Returns once lead has returned, with an error if the leadership was lost rather than given up because ctx
+	// is done.
+	Run(ctx context.Context, lead func(ctx context.Context)) error
+}
+
+type timing struct {
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+}
+
+func getTiming(cfg config.LeaderElectionConfig) timing {
+	t := timing{
+		leaseDuration: cfg.LeaseDuration.Duration,
+		renewDeadline: cfg.RenewDeadline.Duration,
+		retryPeriod:   cfg.RetryPeriod.Duration,
+	}
+	if t.leaseDuration <= 0 {
+		t.leaseDuration = defaultLeaseDuration
+	}
+	if t.renewDeadline <= 0 {
+		t.renewDeadline = defaultRenewDeadline
+	}
+	if t.retryPeriod <= 0 {
+		t.retryPeriod = defaultRetryPeriod
+	}
+	return t
+}
+
+// Leads right away, for replicas which don't elect their leaders.
+type noopElector struct{}
+
+func (noopElector) Run(ctx context.Context, lead func(ctx context.Context)) error {
+	lead(ctx)
+	return nil
+}
+
+// Runs lead on whichever replica leads, until ctx is done or lead returns. Replicas campaign again once they lose the
+// leadership, so lead must be able to run again, unlike the scheduler.
+func RunWhileLeading(ctx context.Context, elector Elector, role string, lead func(ctx context.Context)) {
+	for {
+		err := elector.Run(ctx, lead)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		logger.Warningf(ctx, "Stopped leading [%s], campaigning again: %v", role, err)
+	}
+}
+
+// Calls process every period on whichever replica leads, until ctx is done. The context process is called with is
+// cancelled once the leadership is lost.
+func RunPeriodicallyWhileLeading(ctx context.Context, elector Elector, role string, period time.Duration,
+	process func(ctx context.Context)) {
+	RunWhileLeading(ctx, elector, role, func(ctx context.Context) {
+		wait.UntilWithContext(ctx, process, period)
+	})
+}
@@ -0,0 +1,88 @@
+package leaderelection
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// Loses the leadership a number of times before leading until lead returns.
+type flakyElector struct {
+	losses int
+	runs   int
+}
+
+func (e *flakyElector) Run(ctx context.Context, lead func(ctx context.Context)) error {
+	e.runs++
+	if e.runs <= e.losses {
+		return errors.New("lost the leadership")
+	}
+	lead(ctx)
+	return nil
+}
+
+func TestRunWhileLeading(t *testing.T) {
+	elector := &flakyElector{losses: 2}
+	led := 0
+	RunWhileLeading(context.Background(), elector, RoleRetention, func(ctx context.Context) {
+		led++
+	})
+	assert.Equal(t, 3, elector.runs)
+	assert.Equal(t, 1, led)
+}
+
+func TestRunWhileLeading_Done(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	elector := &flakyElector{losses: 2}
+	RunWhileLeading(ctx, elector, RoleRetention, func(ctx context.Context) {})
+	assert.Equal(t, 1, elector.runs)
+}
+
+func TestRunPeriodicallyWhileLeading(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	elector := &flakyElector{losses: 1}
+	calls := 0
+	RunPeriodicallyWhileLeading(ctx, elector, RoleBackfill, time.Millisecond, func(ctx context.Context) {
+		calls++
+		if calls == 3 {
+			cancel()
+		}
+	})
+	assert.Equal(t, 2, elector.runs)
+	assert.Equal(t, 3, calls)
+}
+
+func TestNewElectorFactory_Disabled(t *testing.T) {
+	factory, err := NewElectorFactory(config.LeaderElectionConfig{LockType: config.LeaderElectionLockTypeLease},
+		"", "", repositoryConfig.DbConfig{}, mockScope.NewTestScope())
+	assert.NoError(t, err)
+	elector, err := factory.NewElector(RoleScheduler)
+	assert.NoError(t, err)
+
+	led := false
+	assert.NoError(t, elector.Run(context.Background(), func(ctx context.Context) {
+		led = true
+	}))
+	assert.True(t, led)
+}
+
+func TestNewElectorFactory_UnknownLockType(t *testing.T) {
+	_, err := NewElectorFactory(config.LeaderElectionConfig{Enabled: true, LockType: "zookeeper"},
+		"", "", repositoryConfig.DbConfig{}, mockScope.NewTestScope())
+	assert.Error(t, err)
+}
+
+func TestNewLeaseElector_InvalidTiming(t *testing.T) {
+	_, err := NewLeaseElector(nil, config.LeaderElectionConfig{
+		LeaseDuration: testElectionConfig.RenewDeadline,
+		RenewDeadline: testElectionConfig.RenewDeadline,
+	}, RoleScheduler)
+	assert.Error(t, err)
+}
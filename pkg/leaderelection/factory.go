@@ -0,0 +1,85 @@
+package leaderelection
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	"github.com/flyteorg/flyteadmin/pkg/flytek8s"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"k8s.io/client-go/kubernetes"
+	coordinationv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+)
+
+// Creates the electors of the background processes. The electors a factory creates share its clients.
+type ElectorFactory interface {
+	NewElector(role string) (Elector, error)
+}
+
+type electorFactory struct {
+	cfg    config.LeaderElectionConfig
+	leases coordinationv1.LeasesGetter
+	db     *sql.DB
+	// Whether db is a postgres database.
+	postgres bool
+}
+
+func (f *electorFactory) NewElector(role string) (Elector, error) {
+	switch {
+	case !f.cfg.Enabled:
+		return noopElector{}, nil
+	case f.db != nil:
+		return NewDatabaseElector(f.db, f.postgres, f.cfg, role), nil
+	default:
+		return NewLeaseElector(f.leases, f.cfg, role)
+	}
+}
+
+// Returns a factory of the electors the config asks for. When leader election is disabled, the electors lead right
+// away. The kube config and master are those of the cluster the leases are kept in.
+func NewElectorFactory(cfg config.LeaderElectionConfig, kubeConfig, master string, dbConfig repositoryConfig.DbConfig,
+	scope promutils.Scope) (ElectorFactory, error) {
+	if !cfg.Enabled {
+		return &electorFactory{cfg: cfg}, nil
+	}
+	switch cfg.LockType {
+	case config.LeaderElectionLockTypeDatabase:
+		dbConfigProvider := repositoryConfig.NewDbConnectionConfigProvider(dbConfig, scope)
+		db, err := gorm.Open(dbConfigProvider.GetType(), dbConfigProvider.GetArgs())
+		if err != nil {
+			return nil, err
+		}
+		return &electorFactory{
+			cfg:      cfg,
+			db:       db.DB(),
+			postgres: dbConfigProvider.GetType() == repositoryConfig.Postgres,
+		}, nil
+	case "", config.LeaderElectionLockTypeLease:
+		restConfig, err := flytek8s.GetRestClientConfig(kubeConfig, master, nil)
+		if err != nil {
+			return nil, err
+		}
+		kubeClient, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, err
+		}
+		return &electorFactory{
+			cfg:    cfg,
+			leases: kubeClient.CoordinationV1(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown leader election lock type [%s]", cfg.LockType)
+	}
+}
+
+// Returns the elector of a role, for processes which run a single role.
+func NewElector(cfg *config.ServerConfig, dbConfig repositoryConfig.DbConfig, role string,
+	scope promutils.Scope) (Elector, error) {
+	factory, err := NewElectorFactory(cfg.LeaderElection, cfg.KubeConfig, cfg.Master, dbConfig, scope)
+	if err != nil {
+		return nil, err
+	}
+	return factory.NewElector(role)
+}
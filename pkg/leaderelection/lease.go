@@ -0,0 +1,108 @@
+package leaderelection
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// Elects the leader with a kubernetes lease, which the leader renews until it gives up the leadership or fails to renew
+// it in time.
+type leaseElector struct {
+	lock   *resourcelock.LeaseLock
+	timing timing
+}
+
+func (e *leaseElector) Run(ctx context.Context, lead func(ctx context.Context)) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// The elector leads in a goroutine of its own, which it doesn't wait for, so leading is tracked here. Leading doesn't
+	// start once the elector has returned, since the leadership is over by then.
+	var mu sync.Mutex
+	var started, finished, returned bool
+	done := make(chan struct{})
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          e.lock,
+		LeaseDuration: e.timing.leaseDuration,
+		RenewDeadline: e.timing.renewDeadline,
+		RetryPeriod:   e.timing.retryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				mu.Lock()
+				if returned {
+					mu.Unlock()
+					return
+				}
+				started = true
+				mu.Unlock()
+				defer close(done)
+				logger.Infof(ctx, "[%s] became the leader", e.lock.Identity())
+				lead(ctx)
+				// Gives up the lease once lead returns while still leading.
+				mu.Lock()
+				finished = ctx.Err() == nil
+				mu.Unlock()
+				cancel()
+			},
+			OnStoppedLeading: func() {
+				logger.Infof(context.Background(), "[%s] stopped leading", e.lock.Identity())
+			},
+		},
+		ReleaseOnCancel: true,
+		Name:            e.lock.LeaseMeta.Name,
+	})
+	if err != nil {
+		return err
+	}
+	elector.Run(runCtx)
+
+	mu.Lock()
+	returned = true
+	wasStarted := started
+	mu.Unlock()
+	if wasStarted {
+		<-done
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if ctx.Err() != nil || finished {
+		return nil
+	}
+	return fmt.Errorf("lost the lease [%s/%s]", e.lock.LeaseMeta.Namespace, e.lock.LeaseMeta.Name)
+}
+
+func NewLeaseElector(client coordinationv1.LeasesGetter, cfg config.LeaderElectionConfig, role string) (Elector, error) {
+	t := getTiming(cfg)
+	// Checked up front, since the elector is only created once campaigning.
+	if t.leaseDuration <= t.renewDeadline ||
+		float64(t.renewDeadline) <= float64(t.retryPeriod)*leaderelection.JitterFactor {
+		return nil, fmt.Errorf("the lease duration must exceed the renew deadline, which must exceed the retry period")
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	return &leaseElector{
+		lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", cfg.LeaseName, role),
+				Namespace: cfg.LeaseNamespace,
+			},
+			Client: client,
+			LockConfig: resourcelock.ResourceLockConfig{
+				// Replicas restarted on the same host mustn't take over the lease of their previous run.
+				Identity: fmt.Sprintf("%s_%s", hostname, uuid.New().String()),
+			},
+		},
+		timing: t,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package logging
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// The key of the section configuring structured logging, nested in flytestdlib's logger section.
+const sectionKey = "structured"
+
+const (
+	EncoderJSON    = "json"
+	EncoderConsole = "console"
+)
+
+// Replaces the single level of the logger section with a level per module. Lines are attributed to the module whose
+// code issued them, so the existing calls to flytestdlib's logger are filtered without changes.
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// The level of lines issued outside of the modules configured: panic, fatal, error, warning, info, debug or trace.
+	Level string `json:"level"`
+	// The levels of modules, keyed by module: repositories, auth, executions or scheduler.
+	Modules map[string]string `json:"modules"`
+	// Either json or console.
+	Encoder string `json:"encoder"`
+	// Samples the debug and trace lines issued from the same line of code.
+	Sampling SamplingConfig `json:"sampling"`
+}
+
+type SamplingConfig struct {
+	Enabled bool `json:"enabled"`
+	// Within every tick, the first Initial lines issued from a line of code are logged and after that only every
+	// Thereafter-th one.
+	Initial    int             `json:"initial"`
+	Thereafter int             `json:"thereafter"`
+	Tick       config.Duration `json:"tick"`
+}
+
+var defaultConfig = &Config{
+	Level:   "info",
+	Encoder: EncoderJSON,
+	Sampling: SamplingConfig{
+		Initial:    100,
+		Thereafter: 100,
+		Tick:       config.Duration{Duration: time.Second},
+	},
+}
+
+// Registering under the logger section guarantees its update handler has run by the time ours does, since updates are
+// sent to sections before their subsections.
+var configSection = config.GetRootSection().GetSection("logger").MustRegisterSectionWithUpdates(sectionKey,
+	defaultConfig, func(ctx context.Context, newValue config.Config) {
+		if err := apply(*newValue.(*Config)); err != nil {
+			logger.Errorf(ctx, "Failed to apply the structured logging config with err: %v", err)
+		}
+	})
+
+func GetConfig() *Config {
+	return configSection.GetConfig().(*Config)
+}
+
+func SetConfig(cfg *Config) error {
+	if err := configSection.SetConfig(cfg); err != nil {
+		return err
+	}
+	return apply(*cfg)
+}
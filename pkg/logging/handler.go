@@ -0,0 +1,45 @@
+package logging
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// The path the levels are served on by the profiling server.
+const LevelsPath = "/logging/levels"
+
+const maxLevelsBodyBytes = 1 << 16
+
+// Serves the current levels on GET and changes them on PUT, where the body holds the levels to change, e.g.
+// {"modules": {"repositories": "debug"}}. Changes last until the config is next reloaded.
+func HandleLevels(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var update Levels
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxLevelsBodyBytes)).Decode(&update); err != nil {
+			http.Error(w, "failed to decode the levels: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !standardFilter.isEnabled() {
+			http.Error(w, "structured logging isn't enabled", http.StatusPreconditionFailed)
+			return
+		}
+		if err := SetLevels(update); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		logger.Infof(ctx, "Changed the log levels to %+v", update)
+	default:
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPut)
+		http.Error(w, "levels are read with a GET and changed with a PUT", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(GetLevels()); err != nil {
+		logger.Warningf(ctx, "failed to write the log levels with err: %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package logging
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func configureStandardFilter(t *testing.T, cfg Config) {
+	level := logrus.GetLevel()
+	assert.NoError(t, standardFilter.configure(cfg, clock.New()))
+	t.Cleanup(func() {
+		assert.NoError(t, standardFilter.configure(*defaultConfig, clock.New()))
+		logrus.SetLevel(level)
+	})
+}
+
+func serveLevels(method, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	HandleLevels(recorder, httptest.NewRequest(method, LevelsPath, strings.NewReader(body)))
+	return recorder
+}
+
+func TestHandleLevels(t *testing.T) {
+	configureStandardFilter(t, getConfigForTest())
+
+	response := serveLevels(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, response.Code)
+	var levels Levels
+	assert.NoError(t, json.Unmarshal(response.Body.Bytes(), &levels))
+	assert.Equal(t, "debug", levels.Modules[ModuleRepositories])
+	assert.Equal(t, "info", levels.Modules[ModuleScheduler])
+
+	response = serveLevels(http.MethodPut, `{"modules": {"scheduler": "trace"}}`)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.NoError(t, json.Unmarshal(response.Body.Bytes(), &levels))
+	assert.Equal(t, "trace", levels.Modules[ModuleScheduler])
+	assert.Equal(t, logrus.TraceLevel, logrus.GetLevel())
+
+	assert.Equal(t, http.StatusBadRequest, serveLevels(http.MethodPut, `{"level": "verbose"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serveLevels(http.MethodPut, `{`).Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serveLevels(http.MethodPost, "").Code)
+}
+
+func TestHandleLevels_Disabled(t *testing.T) {
+	cfg := getConfigForTest()
+	cfg.Enabled = false
+	configureStandardFilter(t, cfg)
+
+	response := serveLevels(http.MethodPut, `{"level": "debug"}`)
+	assert.Equal(t, http.StatusPreconditionFailed, response.Code)
+	assert.Equal(t, "info", GetLevels().Level)
+}
@@ -0,0 +1,323 @@
+// Package logging filters the lines logged through flytestdlib's logger by the level of the module which issued them,
+// samples high-volume debug lines and allows the levels to be changed at runtime.
+package logging
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	ModuleRepositories = "repositories"
+	ModuleAuth         = "auth"
+	ModuleExecutions   = "executions"
+	ModuleScheduler    = "scheduler"
+)
+
+// The field lines issued by a module are annotated with.
+const moduleKey = "module"
+
+// Deep enough to reach past logrus and flytestdlib to the code which logged a line.
+const maxCallerDepth = 32
+
+// Modules are matched by the prefix of the fully qualified name of the function which logged a line.
+var modulePrefixes = []struct {
+	module string
+	prefix string
+}{
+	{ModuleRepositories, "github.com/flyteorg/flyteadmin/pkg/repositories"},
+	{ModuleAuth, "github.com/flyteorg/flyteadmin/auth"},
+	{ModuleExecutions, "github.com/flyteorg/flyteadmin/pkg/manager/impl.(*ExecutionManager)"},
+	{ModuleExecutions, "github.com/flyteorg/flyteadmin/pkg/manager/impl/executions"},
+	{ModuleExecutions, "github.com/flyteorg/flyteadmin/pkg/workflowengine"},
+	{ModuleExecutions, "github.com/flyteorg/flyteadmin/pkg/executioncluster"},
+	{ModuleScheduler, "github.com/flyteorg/flyteadmin/scheduler"},
+	{ModuleScheduler, "github.com/flyteorg/flyteadmin/pkg/async/schedule"},
+}
+
+var modules = []string{ModuleRepositories, ModuleAuth, ModuleExecutions, ModuleScheduler}
+
+var loggingPackages = []string{"github.com/sirupsen/logrus.", "github.com/flyteorg/flytestdlib/logger."}
+
+func moduleOf(function string) string {
+	for _, modulePrefix := range modulePrefixes {
+		if strings.HasPrefix(function, modulePrefix.prefix) {
+			return modulePrefix.module
+		}
+	}
+	return ""
+}
+
+func isModule(module string) bool {
+	for _, known := range modules {
+		if module == known {
+			return true
+		}
+	}
+	return false
+}
+
+func isLoggingFrame(function string) bool {
+	for _, prefix := range loggingPackages {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the frame of the code which called into the logger, or an empty frame if it can't be found.
+func findCaller() runtime.Frame {
+	pcs := make([]uintptr, maxCallerDepth)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	inLogger := false
+	for {
+		frame, more := frames.Next()
+		if isLoggingFrame(frame.Function) {
+			inLogger = true
+		} else if inLogger {
+			return frame
+		}
+		if !more {
+			return runtime.Frame{}
+		}
+	}
+}
+
+func newEncoder(encoder string) (logrus.Formatter, error) {
+	// The same fields flytestdlib's formatters use, so that switching doesn't break log queries.
+	fieldMap := logrus.FieldMap{
+		logrus.FieldKeyTime: "ts",
+	}
+	switch encoder {
+	case EncoderJSON:
+		return &logrus.JSONFormatter{
+			DataKey:  "json",
+			FieldMap: fieldMap,
+		}, nil
+	case EncoderConsole:
+		return &logrus.TextFormatter{
+			FieldMap: fieldMap,
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown log encoder [%s], expected %s or %s", encoder, EncoderJSON, EncoderConsole)
+}
+
+// The levels of a filter, as set in the config or at runtime.
+type Levels struct {
+	Level   string            `json:"level,omitempty"`
+	Modules map[string]string `json:"modules,omitempty"`
+}
+
+// Formats the lines that pass the level of the module which issued them and the sampler, and drops all others by
+// formatting them as nothing.
+type filter struct {
+	lock    sync.RWMutex
+	enabled bool
+	level   logrus.Level
+	modules map[string]logrus.Level
+	encoder logrus.Formatter
+	sampler *sampler
+}
+
+func (f *filter) configure(cfg Config, clk clock.Clock) error {
+	level, err := logrus.ParseLevel(cfg.Level)
+	if err != nil {
+		return err
+	}
+	moduleLevels := make(map[string]logrus.Level, len(cfg.Modules))
+	for module, moduleLevel := range cfg.Modules {
+		if !isModule(module) {
+			return fmt.Errorf("unknown logging module [%s], expected one of %v", module, modules)
+		}
+		if moduleLevels[module], err = logrus.ParseLevel(moduleLevel); err != nil {
+			return err
+		}
+	}
+	encoder, err := newEncoder(cfg.Encoder)
+	if err != nil {
+		return err
+	}
+	var lineSampler *sampler
+	if cfg.Sampling.Enabled {
+		lineSampler = newSampler(cfg.Sampling, clk)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.enabled = cfg.Enabled
+	f.level = level
+	f.modules = moduleLevels
+	f.encoder = encoder
+	f.sampler = lineSampler
+	return nil
+}
+
+func (f *filter) isEnabled() bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.enabled
+}
+
+// The most verbose of the levels, which the logger has to let through for the filter to see all lines it would log.
+func (f *filter) maxLevel() logrus.Level {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	level := f.level
+	for _, moduleLevel := range f.modules {
+		if moduleLevel > level {
+			level = moduleLevel
+		}
+	}
+	return level
+}
+
+// Returns the level of every module, including those which default to the level of the filter.
+func (f *filter) getLevels() Levels {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	levels := Levels{
+		Level:   f.level.String(),
+		Modules: make(map[string]string, len(modules)),
+	}
+	for _, module := range modules {
+		if moduleLevel, ok := f.modules[module]; ok {
+			levels.Modules[module] = moduleLevel.String()
+		} else {
+			levels.Modules[module] = f.level.String()
+		}
+	}
+	return levels
+}
+
+// Overrides the levels which are set in update, leaving the others as they are.
+func (f *filter) setLevels(update Levels) error {
+	var level *logrus.Level
+	if len(update.Level) > 0 {
+		parsed, err := logrus.ParseLevel(update.Level)
+		if err != nil {
+			return err
+		}
+		level = &parsed
+	}
+	moduleLevels := make(map[string]logrus.Level, len(update.Modules))
+	for module, moduleLevel := range update.Modules {
+		if !isModule(module) {
+			return fmt.Errorf("unknown logging module [%s], expected one of %v", module, modules)
+		}
+		parsed, err := logrus.ParseLevel(moduleLevel)
+		if err != nil {
+			return err
+		}
+		moduleLevels[module] = parsed
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if level != nil {
+		f.level = *level
+	}
+	// The map is replaced rather than updated in place since it may be shared with a previous configuration.
+	merged := make(map[string]logrus.Level, len(f.modules)+len(moduleLevels))
+	for module, moduleLevel := range f.modules {
+		merged[module] = moduleLevel
+	}
+	for module, moduleLevel := range moduleLevels {
+		merged[module] = moduleLevel
+	}
+	f.modules = merged
+	return nil
+}
+
+func (f *filter) Format(entry *logrus.Entry) ([]byte, error) {
+	return f.format(entry, findCaller())
+}
+
+func (f *filter) format(entry *logrus.Entry, caller runtime.Frame) ([]byte, error) {
+	module := moduleOf(caller.Function)
+	f.lock.RLock()
+	level, ok := f.modules[module]
+	if !ok {
+		level = f.level
+	}
+	encoder, lineSampler := f.encoder, f.sampler
+	f.lock.RUnlock()
+
+	if entry.Level > level {
+		return nil, nil
+	}
+	if entry.Level >= logrus.DebugLevel && lineSampler != nil && !lineSampler.sample(caller.PC) {
+		return nil, nil
+	}
+	if len(module) > 0 {
+		entry.Data[moduleKey] = module
+	}
+	return encoder.Format(entry)
+}
+
+// Reinstalls the filter on the standard logger whenever flytestdlib has replaced it, which it does when the config of
+// its logger section is reloaded without ours having changed.
+type reinstallHook struct{}
+
+func (reinstallHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (reinstallHook) Fire(*logrus.Entry) error {
+	if standardFilter.isEnabled() &&
+		(logrus.StandardLogger().Formatter != standardFilter || logrus.GetLevel() != standardFilter.maxLevel()) {
+		install()
+	}
+	return nil
+}
+
+// The filter installed on the standard logger, which all of flytestdlib's logging functions log to.
+var standardFilter = &filter{}
+
+var addHookOnce sync.Once
+
+func install() {
+	logrus.SetLevel(standardFilter.maxLevel())
+	logrus.SetFormatter(standardFilter)
+	addHookOnce.Do(func() {
+		logrus.AddHook(reinstallHook{})
+	})
+}
+
+func apply(cfg Config) error {
+	if err := standardFilter.configure(cfg, clock.New()); err != nil {
+		return err
+	}
+	if cfg.Enabled {
+		install()
+		return nil
+	}
+	// Setting flytestdlib's config again restores its level and formatter.
+	if logrus.StandardLogger().Formatter == standardFilter {
+		return logger.SetConfig(logger.GetConfig())
+	}
+	return nil
+}
+
+// Returns the levels lines are currently filtered by.
+func GetLevels() Levels {
+	return standardFilter.getLevels()
+}
+
+// Changes the levels lines are filtered by until the config is next reloaded.
+func SetLevels(update Levels) error {
+	if !standardFilter.isEnabled() {
+		return fmt.Errorf("structured logging isn't enabled")
+	}
+	if err := standardFilter.setLevels(update); err != nil {
+		return err
+	}
+	logrus.SetLevel(standardFilter.maxLevel())
+	return nil
+}
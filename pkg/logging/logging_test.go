@@ -0,0 +1,159 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const repositoryFunction = "github.com/flyteorg/flyteadmin/pkg/repositories/gormimpl.(*ExecutionRepo).Get"
+
+func getConfigForTest() Config {
+	return Config{
+		Enabled: true,
+		Level:   "info",
+		Modules: map[string]string{
+			ModuleRepositories: "debug",
+			ModuleAuth:         "error",
+		},
+		Encoder: EncoderJSON,
+	}
+}
+
+func TestModuleOf(t *testing.T) {
+	assert.Equal(t, ModuleRepositories, moduleOf(repositoryFunction))
+	assert.Equal(t, ModuleAuth, moduleOf("github.com/flyteorg/flyteadmin/auth/authzserver.NewProvider"))
+	assert.Equal(t, ModuleExecutions,
+		moduleOf("github.com/flyteorg/flyteadmin/pkg/manager/impl.(*ExecutionManager).CreateExecution.func1"))
+	assert.Equal(t, ModuleScheduler, moduleOf("github.com/flyteorg/flyteadmin/scheduler/core.(*GoCronScheduler).Run"))
+	assert.Empty(t, moduleOf("github.com/flyteorg/flyteadmin/pkg/manager/impl.(*ProjectManager).CreateProject"))
+}
+
+type callerRecorder struct {
+	caller runtime.Frame
+}
+
+func (r *callerRecorder) Format(*logrus.Entry) ([]byte, error) {
+	r.caller = findCaller()
+	return nil, nil
+}
+
+func TestFindCaller(t *testing.T) {
+	recorder := &callerRecorder{}
+	log := logrus.New()
+	log.SetFormatter(recorder)
+	log.WithField("key", "value").Infof("message")
+	assert.Equal(t, "github.com/flyteorg/flyteadmin/pkg/logging.TestFindCaller", recorder.caller.Function)
+}
+
+func TestFilter_Format(t *testing.T) {
+	f := &filter{}
+	assert.NoError(t, f.configure(getConfigForTest(), clock.New()))
+	assert.Equal(t, logrus.DebugLevel, f.maxLevel())
+
+	format := func(level logrus.Level, function string) []byte {
+		entry := logrus.NewEntry(logrus.New())
+		entry.Level = level
+		entry.Message = "message"
+		serialized, err := f.format(entry, runtime.Frame{Function: function})
+		assert.NoError(t, err)
+		return serialized
+	}
+	assert.Empty(t, format(logrus.DebugLevel, ""))
+	assert.NotEmpty(t, format(logrus.InfoLevel, ""))
+	assert.NotEmpty(t, format(logrus.DebugLevel, repositoryFunction))
+	assert.Empty(t, format(logrus.TraceLevel, repositoryFunction))
+	assert.Empty(t, format(logrus.WarnLevel, "github.com/flyteorg/flyteadmin/auth.GetAuthenticationInterceptor"))
+
+	var line map[string]interface{}
+	assert.NoError(t, json.Unmarshal(format(logrus.DebugLevel, repositoryFunction), &line))
+	assert.Equal(t, "message", line["msg"])
+	assert.Equal(t, ModuleRepositories, line["json"].(map[string]interface{})[moduleKey])
+	assert.Contains(t, line, "ts")
+}
+
+func TestFilter_FormatSampled(t *testing.T) {
+	cfg := getConfigForTest()
+	cfg.Level = "debug"
+	cfg.Sampling = SamplingConfig{
+		Enabled:    true,
+		Initial:    1,
+		Thereafter: 10,
+		Tick:       config.Duration{Duration: time.Second},
+	}
+	f := &filter{}
+	assert.NoError(t, f.configure(cfg, clock.NewMock()))
+
+	log := logrus.New()
+	log.SetLevel(logrus.DebugLevel)
+	log.SetFormatter(f)
+	var out bytes.Buffer
+	log.SetOutput(&out)
+	for i := 0; i < 3; i++ {
+		log.Warnf("warning")
+		log.Debugf("debug")
+	}
+	// Warnings aren't sampled, while only the first of the debug lines is logged.
+	assert.Equal(t, 3, bytes.Count(out.Bytes(), []byte(`"level":"warning"`)))
+	assert.Equal(t, 1, bytes.Count(out.Bytes(), []byte(`"level":"debug"`)))
+}
+
+func TestFilter_Configure(t *testing.T) {
+	f := &filter{}
+	cfg := getConfigForTest()
+	cfg.Modules["propeller"] = "debug"
+	assert.EqualError(t, f.configure(cfg, clock.New()),
+		"unknown logging module [propeller], expected one of [repositories auth executions scheduler]")
+
+	cfg = getConfigForTest()
+	cfg.Encoder = "xml"
+	assert.EqualError(t, f.configure(cfg, clock.New()), "unknown log encoder [xml], expected json or console")
+
+	cfg = getConfigForTest()
+	cfg.Level = "verbose"
+	assert.Error(t, f.configure(cfg, clock.New()))
+}
+
+func TestFilter_SetLevels(t *testing.T) {
+	f := &filter{}
+	assert.NoError(t, f.configure(getConfigForTest(), clock.New()))
+	assert.Equal(t, Levels{
+		Level: "info",
+		Modules: map[string]string{
+			ModuleRepositories: "debug",
+			ModuleAuth:         "error",
+			ModuleExecutions:   "info",
+			ModuleScheduler:    "info",
+		},
+	}, f.getLevels())
+
+	assert.NoError(t, f.setLevels(Levels{
+		Level: "warning",
+		Modules: map[string]string{
+			ModuleExecutions: "trace",
+		},
+	}))
+	assert.Equal(t, Levels{
+		Level: "warning",
+		Modules: map[string]string{
+			ModuleRepositories: "debug",
+			ModuleAuth:         "error",
+			ModuleExecutions:   "trace",
+			ModuleScheduler:    "warning",
+		},
+	}, f.getLevels())
+	assert.Equal(t, logrus.TraceLevel, f.maxLevel())
+
+	assert.Error(t, f.setLevels(Levels{
+		Modules: map[string]string{
+			"propeller": "debug",
+		},
+	}))
+}
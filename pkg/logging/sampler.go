@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+)
+
+// Counts the lines issued from every line of code within the current tick to decide which of them are logged.
+type sampler struct {
+	initial    int
+	thereafter int
+	tick       time.Duration
+	clock      clock.Clock
+
+	lock      sync.Mutex
+	tickStart time.Time
+	counts    map[uintptr]int
+}
+
+// Returns whether the line issued from the program counter pc is logged.
+func (s *sampler) sample(pc uintptr) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := s.clock.Now()
+	if now.Sub(s.tickStart) >= s.tick {
+		s.tickStart = now
+		s.counts = make(map[uintptr]int)
+	}
+	s.counts[pc]++
+	count := s.counts[pc]
+	if count <= s.initial {
+		return true
+	}
+	return s.thereafter > 0 && (count-s.initial)%s.thereafter == 0
+}
+
+func newSampler(cfg SamplingConfig, clk clock.Clock) *sampler {
+	return &sampler{
+		initial:    cfg.Initial,
+		thereafter: cfg.Thereafter,
+		tick:       cfg.Tick.Duration,
+		clock:      clk,
+		counts:     make(map[uintptr]int),
+	}
+}
@@ -0,0 +1,42 @@
+package logging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampler(t *testing.T) {
+	mockClock := clock.NewMock()
+	s := newSampler(SamplingConfig{
+		Initial:    2,
+		Thereafter: 3,
+		Tick:       config.Duration{Duration: time.Second},
+	}, mockClock)
+
+	var sampled []bool
+	for i := 0; i < 8; i++ {
+		sampled = append(sampled, s.sample(1))
+	}
+	assert.Equal(t, []bool{true, true, false, false, true, false, false, true}, sampled)
+	// Lines from other lines of code are counted separately.
+	assert.True(t, s.sample(2))
+
+	mockClock.Add(time.Second)
+	assert.True(t, s.sample(1))
+	assert.True(t, s.sample(1))
+	assert.False(t, s.sample(1))
+}
+
+func TestSampler_NothingThereafter(t *testing.T) {
+	s := newSampler(SamplingConfig{
+		Initial: 1,
+		Tick:    config.Duration{Duration: time.Second},
+	}, clock.NewMock())
+	assert.True(t, s.sample(1))
+	assert.False(t, s.sample(1))
+	assert.False(t, s.sample(1))
+}
@@ -0,0 +1,216 @@
+package impl
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+var apiUsageGroupByDimensions = map[string]bool{
+	interfaces.APIUsageGroupByMethod:    true,
+	interfaces.APIUsageGroupByPrincipal: true,
+	interfaces.APIUsageGroupByProject:   true,
+}
+
+var defaultAPIUsageGroupBy = []string{interfaces.APIUsageGroupByPrincipal, interfaces.APIUsageGroupByProject}
+
+type apiUsageKey struct {
+	day       time.Time
+	method    string
+	principal string
+	project   string
+}
+
+type apiUsageMetrics struct {
+	Scope         promutils.Scope
+	FlushFailures prometheus.Counter
+}
+
+type APIUsageManager struct {
+	db      repositories.RepositoryInterface
+	metrics apiUsageMetrics
+	_clock  clock.Clock
+
+	lock sync.Mutex
+	// The usage recorded since it was last flushed.
+	usage map[apiUsageKey]models.APIUsage
+}
+
+// Returns the UTC day t falls on.
+func getAPIUsageDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Adds usage to the usage recorded for its day, method, principal and project. The lock must be held.
+func (m *APIUsageManager) add(usage models.APIUsage) {
+	key := apiUsageKey{
+		day:       usage.Day,
+		method:    usage.Method,
+		principal: usage.Principal,
+		project:   usage.Project,
+	}
+	recorded, ok := m.usage[key]
+	if !ok {
+		m.usage[key] = usage
+		return
+	}
+	recorded.Calls += usage.Calls
+	recorded.Errors += usage.Errors
+	recorded.TotalLatencyMs += usage.TotalLatencyMs
+	if usage.MaxLatencyMs > recorded.MaxLatencyMs {
+		recorded.MaxLatencyMs = usage.MaxLatencyMs
+	}
+	m.usage[key] = recorded
+}
+
+func (m *APIUsageManager) RecordCall(ctx context.Context, call interfaces.APIUsageCall) {
+	usage := models.APIUsage{
+		Day:            getAPIUsageDay(m._clock.Now()),
+		Method:         call.Method,
+		Principal:      call.Principal,
+		Project:        call.Project,
+		Calls:          1,
+		TotalLatencyMs: call.Latency.Milliseconds(),
+		MaxLatencyMs:   call.Latency.Milliseconds(),
+	}
+	if call.Failed {
+		usage.Errors = 1
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.add(usage)
+}
+
+func (m *APIUsageManager) Flush(ctx context.Context) error {
+	m.lock.Lock()
+	usage := m.usage
+	m.usage = make(map[apiUsageKey]models.APIUsage)
+	m.lock.Unlock()
+
+	var failures int
+	var lastErr error
+	for _, totals := range usage {
+		if err := m.db.APIUsageRepo().Increment(ctx, totals); err != nil {
+			// Keep the usage which failed to persist so that it's retried with the next flush.
+			logger.Debugf(ctx, "Failed to persist the api usage of [%s] by [%s] with err: %v",
+				totals.Method, totals.Principal, err)
+			m.metrics.FlushFailures.Inc()
+			failures++
+			lastErr = err
+			m.lock.Lock()
+			m.add(totals)
+			m.lock.Unlock()
+		}
+	}
+	if failures > 0 {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to persist %d of %d api usage totals, last with err: %v",
+			failures, len(usage), lastErr)
+	}
+	return nil
+}
+
+func (m *APIUsageManager) GetUsageReport(
+	ctx context.Context, request interfaces.APIUsageReportRequest) (*interfaces.APIUsageReport, error) {
+	if request.StartTime.IsZero() {
+		return nil, shared.GetMissingArgumentError("start_time")
+	}
+	if request.EndTime.IsZero() {
+		return nil, shared.GetMissingArgumentError("end_time")
+	}
+	if !request.EndTime.After(request.StartTime) {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"end time [%v] must be after start time [%v]", request.EndTime, request.StartTime)
+	}
+	if request.Limit < 0 {
+		return nil, shared.GetInvalidArgumentError(shared.Limit)
+	}
+	groupBy := request.GroupBy
+	if len(groupBy) == 0 {
+		groupBy = defaultAPIUsageGroupBy
+	}
+	for _, dimension := range groupBy {
+		if !apiUsageGroupByDimensions[dimension] {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"api usage can't be grouped by [%s]", dimension)
+		}
+	}
+	startFilter, err := common.NewSingleValueFilter(
+		common.APIUsage, common.GreaterThanOrEqual, "day", getAPIUsageDay(request.StartTime))
+	if err != nil {
+		return nil, err
+	}
+	endFilter, err := common.NewSingleValueFilter(common.APIUsage, common.LessThan, "day", request.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	filters := []common.InlineFilter{startFilter, endFilter}
+	for _, field := range []struct{ name, value string }{
+		{"project", request.Project},
+		{"principal", request.Principal},
+		{"method", request.Method},
+	} {
+		if len(field.value) == 0 {
+			continue
+		}
+		filter, err := common.NewSingleValueFilter(common.APIUsage, common.Equal, field.name, field.value)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	sums, err := m.db.APIUsageRepo().Sum(ctx, repoInterfaces.SumAPIUsageInput{
+		InlineFilters: filters,
+		GroupBy:       groupBy,
+		Limit:         request.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	report := &interfaces.APIUsageReport{
+		StartTime: request.StartTime,
+		EndTime:   request.EndTime,
+		Rows:      make([]interfaces.APIUsageRow, len(sums)),
+	}
+	for idx, sum := range sums {
+		row := interfaces.APIUsageRow{
+			Method:     sum.Method,
+			Principal:  sum.Principal,
+			Project:    sum.Project,
+			Calls:      sum.Calls,
+			Errors:     sum.Errors,
+			MaxLatency: time.Duration(sum.MaxLatencyMs) * time.Millisecond,
+		}
+		if sum.Calls > 0 {
+			row.AverageLatency = time.Duration(sum.TotalLatencyMs/sum.Calls) * time.Millisecond
+		}
+		report.Rows[idx] = row
+	}
+	return report, nil
+}
+
+func NewAPIUsageManager(db repositories.RepositoryInterface, scope promutils.Scope) interfaces.APIUsageInterface {
+	return &APIUsageManager{
+		db: db,
+		metrics: apiUsageMetrics{
+			Scope: scope,
+			FlushFailures: scope.MustNewCounter("flush_failures",
+				"api usage totals which failed to persist and are retried with the next flush"),
+		},
+		_clock: clock.New(),
+		usage:  make(map[apiUsageKey]models.APIUsage),
+	}
+}
@@ -0,0 +1,220 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+var apiUsageDay = time.Date(2021, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+func newAPIUsageManagerForTest(repository repositories.RepositoryInterface) (*APIUsageManager, *clock.Mock) {
+	mockClock := clock.NewMock()
+	mockClock.Set(apiUsageDay.Add(12 * time.Hour))
+	usageManager := NewAPIUsageManager(repository, mockScope.NewTestScope()).(*APIUsageManager)
+	usageManager._clock = mockClock
+	return usageManager, mockClock
+}
+
+func TestAPIUsageManager_Flush(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var persisted []models.APIUsage
+	repository.APIUsageRepo().(*repositoryMocks.APIUsageRepoInterface).OnIncrementMatch(
+		mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		persisted = append(persisted, args.Get(1).(models.APIUsage))
+	})
+	usageManager, mockClock := newAPIUsageManagerForTest(repository)
+	ctx := context.Background()
+
+	usageManager.RecordCall(ctx, interfaces.APIUsageCall{
+		Method:    "CreateExecution",
+		Principal: "user",
+		Project:   "project",
+		Latency:   10 * time.Millisecond,
+	})
+	usageManager.RecordCall(ctx, interfaces.APIUsageCall{
+		Method:    "CreateExecution",
+		Principal: "user",
+		Project:   "project",
+		Failed:    true,
+		Latency:   30 * time.Millisecond,
+	})
+	// Calls on the next day are totalled separately.
+	mockClock.Add(12 * time.Hour)
+	usageManager.RecordCall(ctx, interfaces.APIUsageCall{
+		Method:    "CreateExecution",
+		Principal: "user",
+		Project:   "project",
+		Latency:   5 * time.Millisecond,
+	})
+
+	assert.NoError(t, usageManager.Flush(ctx))
+	assert.ElementsMatch(t, []models.APIUsage{
+		{
+			Day:            apiUsageDay,
+			Method:         "CreateExecution",
+			Principal:      "user",
+			Project:        "project",
+			Calls:          2,
+			Errors:         1,
+			TotalLatencyMs: 40,
+			MaxLatencyMs:   30,
+		},
+		{
+			Day:            apiUsageDay.Add(24 * time.Hour),
+			Method:         "CreateExecution",
+			Principal:      "user",
+			Project:        "project",
+			Calls:          1,
+			TotalLatencyMs: 5,
+			MaxLatencyMs:   5,
+		},
+	}, persisted)
+
+	// Usage is only persisted once.
+	persisted = nil
+	assert.NoError(t, usageManager.Flush(ctx))
+	assert.Empty(t, persisted)
+}
+
+func TestAPIUsageManager_FlushFailed(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	usageRepo := repository.APIUsageRepo().(*repositoryMocks.APIUsageRepoInterface)
+	usageRepo.OnIncrementMatch(mock.Anything, mock.Anything).Return(errors.New("connection refused")).Once()
+	usageManager, _ := newAPIUsageManagerForTest(repository)
+	ctx := context.Background()
+	call := interfaces.APIUsageCall{
+		Method:  "ListProjects",
+		Latency: time.Millisecond,
+	}
+
+	usageManager.RecordCall(ctx, call)
+	err := usageManager.Flush(ctx)
+	assert.EqualError(t, err, "failed to persist 1 of 1 api usage totals, last with err: connection refused")
+	assert.Equal(t, float64(1), testutil.ToFloat64(usageManager.metrics.FlushFailures))
+
+	// Usage which failed to persist is retried along with the usage recorded since.
+	usageRepo.OnIncrement(mock.Anything, models.APIUsage{
+		Day:            apiUsageDay,
+		Method:         "ListProjects",
+		Calls:          2,
+		TotalLatencyMs: 2,
+		MaxLatencyMs:   1,
+	}).Return(nil).Once()
+	usageManager.RecordCall(ctx, call)
+	assert.NoError(t, usageManager.Flush(ctx))
+	usageRepo.AssertExpectations(t)
+}
+
+// Returns the queries and args of the filters the api usage repo was summed with.
+func getAPIUsageFilterQueries(t *testing.T, filters []common.InlineFilter) map[string]interface{} {
+	queries := make(map[string]interface{}, len(filters))
+	for _, filter := range filters {
+		assert.Equal(t, common.APIUsage, filter.GetEntity())
+		expr, err := filter.GetGormQueryExpr()
+		assert.NoError(t, err)
+		queries[expr.Query] = expr.Args
+	}
+	return queries
+}
+
+func TestAPIUsageManager_GetUsageReport(t *testing.T) {
+	startTime := apiUsageDay.Add(6 * time.Hour)
+	endTime := apiUsageDay.Add(7 * 24 * time.Hour)
+	repository := repositoryMocks.NewMockRepository()
+	usageRepo := repository.APIUsageRepo().(*repositoryMocks.APIUsageRepoInterface)
+	usageRepo.OnSumMatch(mock.Anything, mock.MatchedBy(func(input repositoryInterfaces.SumAPIUsageInput) bool {
+		assert.Equal(t, map[string]interface{}{
+			"project = ?": "project",
+			"day >= ?":    apiUsageDay,
+			"day < ?":     endTime,
+		}, getAPIUsageFilterQueries(t, input.InlineFilters))
+		assert.Equal(t, []string{"principal", "project"}, input.GroupBy)
+		assert.Equal(t, 10, input.Limit)
+		return true
+	})).Return([]models.APIUsageSum{
+		{
+			Principal:      "flytepropeller",
+			Project:        "project",
+			Calls:          1000,
+			Errors:         10,
+			TotalLatencyMs: 5000,
+			MaxLatencyMs:   250,
+		},
+		{
+			Principal: "user",
+			Project:   "project",
+		},
+	}, nil)
+	usageManager, _ := newAPIUsageManagerForTest(repository)
+
+	report, err := usageManager.GetUsageReport(context.Background(), interfaces.APIUsageReportRequest{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Project:   "project",
+		Limit:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.APIUsageReport{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Rows: []interfaces.APIUsageRow{
+			{
+				Principal:      "flytepropeller",
+				Project:        "project",
+				Calls:          1000,
+				Errors:         10,
+				AverageLatency: 5 * time.Millisecond,
+				MaxLatency:     250 * time.Millisecond,
+			},
+			{
+				Principal: "user",
+				Project:   "project",
+			},
+		},
+	}, report)
+}
+
+func TestAPIUsageManager_GetUsageReport_InvalidRequest(t *testing.T) {
+	usageManager, _ := newAPIUsageManagerForTest(repositoryMocks.NewMockRepository())
+	for _, request := range []interfaces.APIUsageReportRequest{
+		{
+			EndTime: apiUsageDay,
+		},
+		{
+			StartTime: apiUsageDay,
+		},
+		{
+			StartTime: apiUsageDay,
+			EndTime:   apiUsageDay,
+		},
+		{
+			StartTime: apiUsageDay,
+			EndTime:   apiUsageDay.Add(time.Hour),
+			Limit:     -1,
+		},
+		{
+			StartTime: apiUsageDay,
+			EndTime:   apiUsageDay.Add(time.Hour),
+			GroupBy:   []string{"domain"},
+		},
+	} {
+		_, err := usageManager.GetUsageReport(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code(), "%+v", request)
+	}
+}
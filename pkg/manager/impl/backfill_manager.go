@@ -0,0 +1,602 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	scheduleCore "github.com/flyteorg/flyteadmin/scheduler/core"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Each execution a backfill launches is labelled with the backfill's name, so that they can be listed and counted.
+const backfillLabel = "flyte-backfill"
+
+// The number of running backfills advanced at a time.
+const advanceBackfillsBatchSize = 100
+
+type backfillMetrics struct {
+	Scope              promutils.Scope
+	BackfillsCreated   prometheus.Counter
+	ExecutionsLaunched prometheus.Counter
+	AdvanceFailures    prometheus.Counter
+}
+
+type BackfillManager struct {
+	db               repositories.RepositoryInterface
+	config           runtimeInterfaces.Configuration
+	executionManager interfaces.ExecutionInterface
+	metrics          backfillMetrics
+}
+
+// Returns the schedule of a launch plan in the form the native scheduler computes scheduled times with.
+func getSchedulableEntity(launchPlan *admin.LaunchPlan) (schedulerModels.SchedulableEntity, error) {
+	schedule := launchPlan.GetSpec().GetEntityMetadata().GetSchedule()
+	schedulableEntity := schedulerModels.SchedulableEntity{
+		KickoffTimeInputArg: schedule.GetKickoffTimeInputArg(),
+	}
+	switch expression := schedule.GetScheduleExpression().(type) {
+	case *admin.Schedule_CronSchedule:
+		schedulableEntity.CronExpression = expression.CronSchedule.Schedule
+	case *admin.Schedule_Rate:
+		schedulableEntity.FixedRateValue = expression.Rate.Value
+		schedulableEntity.Unit = expression.Rate.Unit
+	default:
+		return schedulerModels.SchedulableEntity{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%+v] doesn't have a cron or fixed rate schedule to backfill", launchPlan.Id)
+	}
+	return schedulableEntity, nil
+}
+
+// Returns the times a launch plan was scheduled after start, up to and including end, in ascending order.
+func getBackfillKickoffTimes(
+	schedulableEntity schedulerModels.SchedulableEntity, start, end time.Time, maxTimes int) ([]time.Time, error) {
+	var kickoffTimes []time.Time
+	for from := start; ; {
+		next, err := scheduleCore.GetScheduledTime(schedulableEntity, from)
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid schedule: %v", err)
+		}
+		// Cron schedules which never match return a zero time.
+		if next.After(end) || !next.After(from) {
+			return kickoffTimes, nil
+		}
+		if len(kickoffTimes) == maxTimes {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"backfills cannot launch more than %d executions", maxTimes)
+		}
+		kickoffTimes = append(kickoffTimes, next)
+		from = next
+	}
+}
+
+func getBackfillModelKickoffTimes(
+	schedulableEntity schedulerModels.SchedulableEntity, backfillModel models.Backfill) ([]time.Time, error) {
+	kickoffTimes, err := getBackfillKickoffTimes(
+		schedulableEntity, backfillModel.StartTime, backfillModel.EndTime, backfillModel.Total)
+	if err != nil {
+		return nil, err
+	}
+	// The times a launch plan version was scheduled never change, so this only happens if the schedule semantics do.
+	if len(kickoffTimes) != backfillModel.Total {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"backfill [%s] was created with %d scheduled times, but found %d", backfillModel.Name,
+			backfillModel.Total, len(kickoffTimes))
+	}
+	return kickoffTimes, nil
+}
+
+func getBackfillLaunchPlanID(backfillModel models.Backfill) core.Identifier {
+	return core.Identifier{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		Project:      backfillModel.Project,
+		Domain:       backfillModel.Domain,
+		Name:         backfillModel.LaunchPlanName,
+		Version:      backfillModel.LaunchPlanVersion,
+	}
+}
+
+// The number of executions a backfill launched which are in each state.
+type backfillExecutionCounts struct {
+	running   int
+	succeeded int
+	failed    int
+}
+
+// Returns the filters selecting the executions a backfill launched.
+func getBackfillExecutionFilters(backfillModel models.Backfill) ([]common.InlineFilter, error) {
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: backfillModel.Project,
+		Domain:  backfillModel.Domain,
+	}, common.Execution)
+	if err != nil {
+		return nil, err
+	}
+	labelFilter, err := common.NewExecutionLabelFilter(common.Equal, backfillLabel, backfillModel.Name)
+	if err != nil {
+		return nil, err
+	}
+	return append(filters, labelFilter), nil
+}
+
+func (m *BackfillManager) countBackfillExecutions(
+	ctx context.Context, backfillModel models.Backfill) (backfillExecutionCounts, error) {
+	filters, err := getBackfillExecutionFilters(backfillModel)
+	if err != nil {
+		return backfillExecutionCounts{}, err
+	}
+	count := func(function common.FilterExpression, phases []string) (int, error) {
+		phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, function, "phase", phases)
+		if err != nil {
+			return 0, err
+		}
+		count, err := m.db.ExecutionRepo().Count(ctx, repoInterfaces.CountResourceInput{
+			InlineFilters: append(filters[:len(filters):len(filters)], phaseFilter),
+		})
+		return int(count), err
+	}
+	var counts backfillExecutionCounts
+	if counts.running, err = count(common.ValueNotIn, getExecutionPhaseNames(true)); err != nil {
+		return backfillExecutionCounts{}, err
+	}
+	if counts.succeeded, err = count(common.ValueIn, []string{core.WorkflowExecution_SUCCEEDED.String()}); err != nil {
+		return backfillExecutionCounts{}, err
+	}
+	if counts.failed, err = count(common.ValueIn, []string{
+		core.WorkflowExecution_FAILED.String(),
+		core.WorkflowExecution_TIMED_OUT.String(),
+		core.WorkflowExecution_ABORTED.String(),
+	}); err != nil {
+		return backfillExecutionCounts{}, err
+	}
+	return counts, nil
+}
+
+func (m *BackfillManager) getBackfill(ctx context.Context, backfillModel models.Backfill) (*interfaces.Backfill, error) {
+	counts, err := m.countBackfillExecutions(ctx, backfillModel)
+	if err != nil {
+		return nil, err
+	}
+	launchPlanID := getBackfillLaunchPlanID(backfillModel)
+	order := interfaces.BackfillOrderOldestFirst
+	if backfillModel.Reverse {
+		order = interfaces.BackfillOrderNewestFirst
+	}
+	return &interfaces.Backfill{
+		Id: &admin.NamedEntityIdentifier{
+			Project: backfillModel.Project,
+			Domain:  backfillModel.Domain,
+			Name:    backfillModel.Name,
+		},
+		LaunchPlan:  &launchPlanID,
+		StartTime:   backfillModel.StartTime,
+		EndTime:     backfillModel.EndTime,
+		Parallelism: uint32(backfillModel.Parallelism),
+		Order:       order,
+		Phase:       core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[backfillModel.Phase]),
+		AbortCause:  backfillModel.AbortCause,
+		Principal:   backfillModel.Principal,
+		CreatedAt:   backfillModel.CreatedAt,
+		Total:       backfillModel.Total,
+		Launched:    backfillModel.Launched,
+		Running:     counts.running,
+		Succeeded:   counts.succeeded,
+		Failed:      counts.failed,
+	}, nil
+}
+
+func (m *BackfillManager) CreateBackfill(
+	ctx context.Context, request interfaces.CreateBackfillRequest) (*interfaces.Backfill, error) {
+	backfillConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetBackfillConfig()
+	if err := validation.ValidateCreateBackfillRequest(request, backfillConfig.MaxParallelism); err != nil {
+		logger.Debugf(ctx, "invalid create backfill request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	launchPlan, err := util.GetLaunchPlan(ctx, m.db, *request.LaunchPlan)
+	if err != nil {
+		return nil, err
+	}
+	schedulableEntity, err := getSchedulableEntity(launchPlan)
+	if err != nil {
+		return nil, err
+	}
+	kickoffTimes, err := getBackfillKickoffTimes(
+		schedulableEntity, request.StartTime, request.EndTime, backfillConfig.MaxExecutions)
+	if err != nil {
+		return nil, err
+	}
+	if len(kickoffTimes) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%+v] wasn't scheduled after %v up to %v", request.LaunchPlan, request.StartTime,
+			request.EndTime)
+	}
+	name := request.Name
+	if len(name) == 0 {
+		name = common.GetExecutionName(time.Now().UnixNano())
+	}
+	backfillModel := models.Backfill{
+		BackfillKey: models.BackfillKey{
+			Project: request.Project,
+			Domain:  request.Domain,
+			Name:    name,
+		},
+		LaunchPlanName:    request.LaunchPlan.Name,
+		LaunchPlanVersion: request.LaunchPlan.Version,
+		StartTime:         request.StartTime,
+		EndTime:           request.EndTime,
+		Parallelism:       int(request.Parallelism),
+		Reverse:           request.Order == interfaces.BackfillOrderNewestFirst,
+		Total:             len(kickoffTimes),
+		Phase:             core.WorkflowExecution_RUNNING.String(),
+		Principal:         getUser(ctx),
+	}
+	if err := m.db.BackfillRepo().Create(ctx, backfillModel); err != nil {
+		logger.Infof(ctx, "failed to create backfill [%s] with err: %v", name, err)
+		return nil, err
+	}
+	m.metrics.BackfillsCreated.Inc()
+	logger.Infof(ctx, "Created backfill [%s] of launch plan [%+v] with %d executions", name, request.LaunchPlan,
+		len(kickoffTimes))
+	return m.getBackfill(ctx, backfillModel)
+}
+
+func (m *BackfillManager) GetBackfill(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.Backfill, error) {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	backfillModel, err := m.db.BackfillRepo().Get(ctx, repoInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.getBackfill(ctx, backfillModel)
+}
+
+func (m *BackfillManager) ListBackfills(
+	ctx context.Context, request interfaces.ListBackfillsRequest) (*interfaces.BackfillList, error) {
+	if err := validation.ValidateEmptyStringField(request.Project, "project"); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateEmptyStringField(request.Domain, "domain"); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: request.Project,
+		Domain:  request.Domain,
+	}, common.Backfill)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListBackfills", request.Token)
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       "created_at",
+		Direction: admin.Sort_DESCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	output, err := m.db.BackfillRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to list backfills for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	backfills := make([]*interfaces.Backfill, 0, len(output.Backfills))
+	for _, backfillModel := range output.Backfills {
+		backfill, err := m.getBackfill(ctx, backfillModel)
+		if err != nil {
+			return nil, err
+		}
+		backfills = append(backfills, backfill)
+	}
+	var token string
+	if len(output.Backfills) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(output.Backfills))
+	}
+	return &interfaces.BackfillList{
+		Backfills: backfills,
+		Token:     token,
+	}, nil
+}
+
+func (m *BackfillManager) TerminateBackfill(ctx context.Context, id admin.NamedEntityIdentifier, cause string) error {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	backfillModel, err := m.db.BackfillRepo().Get(ctx, repoInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	})
+	if err != nil {
+		return err
+	}
+	if backfillModel.Phase != core.WorkflowExecution_RUNNING.String() {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"backfill [%s] can't be terminated once it's %s", id.Name, backfillModel.Phase)
+	}
+	// The backfill is aborted before its executions are terminated, so that no more of them are launched.
+	backfillModel.Phase = core.WorkflowExecution_ABORTED.String()
+	backfillModel.AbortCause = cause
+	if err := m.db.BackfillRepo().Update(ctx, backfillModel); err != nil {
+		return err
+	}
+
+	filters, err := getBackfillExecutionFilters(backfillModel)
+	if err != nil {
+		return err
+	}
+	phaseFilter, err := common.NewRepeatedValueFilter(
+		common.Execution, common.ValueNotIn, "phase", getExecutionPhaseNames(true))
+	if err != nil {
+		return err
+	}
+	// A backfill runs no more executions than it launched.
+	output, err := m.db.ExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         backfillModel.Launched + 1,
+		InlineFilters: append(filters, phaseFilter),
+	})
+	if err != nil {
+		return err
+	}
+	var terminateErr error
+	for _, executionModel := range output.Executions {
+		_, err := m.executionManager.TerminateExecution(ctx, admin.ExecutionTerminateRequest{
+			Id: &core.WorkflowExecutionIdentifier{
+				Project: executionModel.Project,
+				Domain:  executionModel.Domain,
+				Name:    executionModel.Name,
+			},
+			Cause: cause,
+		})
+		if err != nil {
+			logger.Warningf(ctx, "failed to terminate execution [%s] of backfill [%s] with err: %v",
+				executionModel.Name, id.Name, err)
+			terminateErr = err
+		}
+	}
+	logger.Infof(ctx, "Terminated backfill [%s] and its %d running executions", id.Name, len(output.Executions))
+	return terminateErr
+}
+
+// Launches the execution of a backfill for one of the times its launch plan was scheduled. Each is named for the
+// backfill and the time, so that retries after a failure to record the launch don't launch it again.
+func (m *BackfillManager) launchBackfillExecution(ctx context.Context, backfillModel models.Backfill,
+	launchPlan *admin.LaunchPlan, schedulableEntity schedulerModels.SchedulableEntity, kickoffTime time.Time) error {
+	kickoffTimeProto, err := ptypes.TimestampProto(kickoffTime)
+	if err != nil {
+		return err
+	}
+	// The native scheduler only passes the kickoff time to cron schedules.
+	literals := make(map[string]*core.Literal)
+	if len(schedulableEntity.CronExpression) > 0 && len(schedulableEntity.KickoffTimeInputArg) > 0 {
+		literals[schedulableEntity.KickoffTimeInputArg] = &core.Literal{
+			Value: &core.Literal_Scalar{
+				Scalar: &core.Scalar{
+					Value: &core.Scalar_Primitive{
+						Primitive: &core.Primitive{
+							Value: &core.Primitive_Datetime{
+								Datetime: kickoffTimeProto,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	// Labels in the execution spec replace those of the launch plan, so the launch plan's are kept alongside the
+	// backfill's.
+	labels := map[string]string{
+		backfillLabel: backfillModel.Name,
+	}
+	for key, value := range launchPlan.GetSpec().GetLabels().GetValues() {
+		if key != backfillLabel {
+			labels[key] = value
+		}
+	}
+	launchPlanID := getBackfillLaunchPlanID(backfillModel)
+	request := admin.ExecutionCreateRequest{
+		Project: backfillModel.Project,
+		Domain:  backfillModel.Domain,
+		Name: common.GetIdempotentExecutionName(backfillModel.Project, backfillModel.Domain,
+			fmt.Sprintf("backfill/%s/%d", backfillModel.Name, kickoffTime.Unix())),
+		Spec: &admin.ExecutionSpec{
+			LaunchPlan: &launchPlanID,
+			Metadata: &admin.ExecutionMetadata{
+				Mode:        admin.ExecutionMetadata_SCHEDULED,
+				ScheduledAt: kickoffTimeProto,
+			},
+			Labels: &admin.Labels{
+				Values: labels,
+			},
+		},
+		Inputs: &core.LiteralMap{
+			Literals: literals,
+		},
+	}
+	// Executions are launched on behalf of the user who created the backfill.
+	identity := auth.NewIdentityContext("", backfillModel.Principal, "", time.Now(), sets.NewString(), nil)
+	_, err = m.executionManager.CreateExecution(identity.WithContext(ctx), request, time.Now())
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.AlreadyExists {
+			logger.Debugf(ctx, "execution [%s] of backfill [%s] for %v was already launched", request.Name,
+				backfillModel.Name, kickoffTime)
+			return nil
+		}
+		return err
+	}
+	m.metrics.ExecutionsLaunched.Inc()
+	return nil
+}
+
+// Launches as many of a backfill's remaining executions as it may run, or completes it once all of its executions
+// have terminated.
+func (m *BackfillManager) advanceBackfill(ctx context.Context, backfillModel models.Backfill) error {
+	ctx = contextutils.WithProjectDomain(ctx, backfillModel.Project, backfillModel.Domain)
+	counts, err := m.countBackfillExecutions(ctx, backfillModel)
+	if err != nil {
+		return err
+	}
+	if backfillModel.Launched >= backfillModel.Total {
+		if counts.running > 0 {
+			return nil
+		}
+		backfillModel.Phase = core.WorkflowExecution_SUCCEEDED.String()
+		if counts.failed > 0 {
+			backfillModel.Phase = core.WorkflowExecution_FAILED.String()
+		}
+		logger.Infof(ctx, "Backfill [%s] %s with %d of %d executions succeeded", backfillModel.Name,
+			backfillModel.Phase, counts.succeeded, backfillModel.Total)
+		return m.updateBackfillProgress(ctx, backfillModel)
+	}
+	slots := backfillModel.Parallelism - counts.running
+	if slots <= 0 {
+		return nil
+	}
+	launchPlan, err := util.GetLaunchPlan(ctx, m.db, getBackfillLaunchPlanID(backfillModel))
+	if err != nil {
+		return err
+	}
+	schedulableEntity, err := getSchedulableEntity(launchPlan)
+	if err != nil {
+		return err
+	}
+	kickoffTimes, err := getBackfillModelKickoffTimes(schedulableEntity, backfillModel)
+	if err != nil {
+		return err
+	}
+	launched := backfillModel.Launched
+	for ; slots > 0 && backfillModel.Launched < backfillModel.Total; slots-- {
+		index := backfillModel.Launched
+		if backfillModel.Reverse {
+			index = backfillModel.Total - 1 - backfillModel.Launched
+		}
+		err = m.launchBackfillExecution(ctx, backfillModel, launchPlan, schedulableEntity, kickoffTimes[index])
+		if err != nil {
+			break
+		}
+		backfillModel.Launched++
+	}
+	// The executions which were launched are recorded even if launching the next one failed.
+	if backfillModel.Launched > launched {
+		if updateErr := m.updateBackfillProgress(ctx, backfillModel); updateErr != nil {
+			return updateErr
+		}
+	}
+	return err
+}
+
+// Records how many of a backfill's executions were launched and its phase, unless it was terminated since it was
+// read, in which case the abort isn't overwritten.
+func (m *BackfillManager) updateBackfillProgress(ctx context.Context, backfillModel models.Backfill) error {
+	updated, err := m.db.BackfillRepo().UpdateProgress(ctx, backfillModel)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		logger.Infof(ctx, "Backfill [%s] was terminated concurrently, its progress isn't recorded", backfillModel.Name)
+	}
+	return nil
+}
+
+func (m *BackfillManager) AdvanceBackfills(ctx context.Context) error {
+	phaseFilter, err := common.NewSingleValueFilter(
+		common.Backfill, common.Equal, "phase", core.WorkflowExecution_RUNNING.String())
+	if err != nil {
+		return err
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       "id",
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return err
+	}
+	// Backfills are paged through by id, since those which complete are no longer listed.
+	var lastID uint
+	for {
+		idFilter, err := common.NewSingleValueFilter(common.Backfill, common.GreaterThan, "id", lastID)
+		if err != nil {
+			return err
+		}
+		output, err := m.db.BackfillRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         advanceBackfillsBatchSize,
+			InlineFilters: []common.InlineFilter{phaseFilter, idFilter},
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return err
+		}
+		for _, backfillModel := range output.Backfills {
+			if err := m.advanceBackfill(ctx, backfillModel); err != nil {
+				m.metrics.AdvanceFailures.Inc()
+				logger.Warningf(ctx, "failed to advance backfill [%s/%s/%s] with err: %v",
+					backfillModel.Project, backfillModel.Domain, backfillModel.Name, err)
+			}
+			lastID = backfillModel.ID
+		}
+		if len(output.Backfills) < advanceBackfillsBatchSize {
+			return nil
+		}
+	}
+}
+
+func NewBackfillManager(
+	db repositories.RepositoryInterface,
+	config runtimeInterfaces.Configuration,
+	executionManager interfaces.ExecutionInterface,
+	scope promutils.Scope) interfaces.BackfillInterface {
+	metrics := backfillMetrics{
+		Scope: scope,
+		BackfillsCreated: scope.MustNewCounter("backfills_created",
+			"overall count of backfills created"),
+		ExecutionsLaunched: scope.MustNewCounter("executions_launched",
+			"overall count of executions launched by backfills"),
+		AdvanceFailures: scope.MustNewCounter("advance_failures",
+			"overall count of failures launching the next executions of a backfill, which are retried"),
+	}
+	return &BackfillManager{
+		db:               db,
+		config:           config,
+		executionManager: executionManager,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,543 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	managerMocks "github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/config"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var backfillStartTime = time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+var backfillLaunchPlanID = core.Identifier{
+	ResourceType: core.ResourceType_LAUNCH_PLAN,
+	Project:      "project",
+	Domain:       "domain",
+	Name:         "name",
+	Version:      "version",
+}
+
+func getMockBackfillConfigProvider() runtimeInterfaces.Configuration {
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			Backfill: runtimeInterfaces.BackfillConfig{
+				Interval:       config.Duration{Duration: time.Minute},
+				MaxExecutions:  10,
+				MaxParallelism: 5,
+			},
+		})
+	return mockConfig
+}
+
+// Sets a launch plan which is scheduled hourly and passes the kickoff time as an input.
+func setBackfillLaunchPlanCallback(repository repositories.RepositoryInterface, schedule *admin.Schedule) {
+	lpSpec := testutils.GetSampleLpSpecForTest()
+	lpSpec.EntityMetadata.Schedule = schedule
+	lpSpec.Labels = &admin.Labels{
+		Values: map[string]string{
+			"team": "data",
+		},
+	}
+	lpSpecBytes, _ := proto.Marshal(&lpSpec)
+	lpClosureBytes, _ := proto.Marshal(&admin.LaunchPlanClosure{})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input repositoryInterfaces.Identifier) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Spec:    lpSpecBytes,
+				Closure: lpClosureBytes,
+			}, nil
+		})
+}
+
+func getHourlyCronSchedule() *admin.Schedule {
+	return &admin.Schedule{
+		ScheduleExpression: &admin.Schedule_CronSchedule{
+			CronSchedule: &admin.CronSchedule{
+				Schedule: "0 * * * *",
+			},
+		},
+		KickoffTimeInputArg: "kickoff_time",
+	}
+}
+
+// Counts the executions a backfill launched by the phases they're filtered on.
+func setBackfillCountCallback(t *testing.T, repository repositories.RepositoryInterface, running, succeeded, failed int64) {
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).CountFunction = func(
+		ctx context.Context, input repositoryInterfaces.CountResourceInput) (int64, error) {
+		var labelled bool
+		for _, filter := range input.InlineFilters {
+			if filter.GetField() == "label.flyte-backfill" {
+				labelled = true
+			}
+		}
+		assert.True(t, labelled)
+		phaseExpr, err := input.InlineFilters[len(input.InlineFilters)-1].GetGormQueryExpr()
+		assert.NoError(t, err)
+		switch {
+		case strings.Contains(phaseExpr.Query, "not in"):
+			return running, nil
+		case phaseExpr.Args.([]string)[0] == core.WorkflowExecution_SUCCEEDED.String():
+			return succeeded, nil
+		default:
+			return failed, nil
+		}
+	}
+}
+
+func getRunningBackfillModel() models.Backfill {
+	return models.Backfill{
+		BaseModel: models.BaseModel{
+			ID: 1,
+		},
+		BackfillKey: models.BackfillKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "backfill",
+		},
+		LaunchPlanName:    "name",
+		LaunchPlanVersion: "version",
+		StartTime:         backfillStartTime,
+		EndTime:           backfillStartTime.Add(3 * time.Hour),
+		Parallelism:       2,
+		Total:             3,
+		Phase:             core.WorkflowExecution_RUNNING.String(),
+		Principal:         "user",
+	}
+}
+
+func setRunningBackfillsCallback(repository repositories.RepositoryInterface, backfillModels ...models.Backfill) {
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnListMatch(mock.Anything, mock.Anything).Return(
+		repositoryInterfaces.BackfillCollectionOutput{
+			Backfills: backfillModels,
+		}, nil)
+}
+
+func TestGetBackfillKickoffTimes(t *testing.T) {
+	kickoffTimes, err := getBackfillKickoffTimes(schedulerModels.SchedulableEntity{
+		CronExpression: "0 * * * *",
+	}, backfillStartTime.Add(30*time.Minute), backfillStartTime.Add(3*time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		backfillStartTime.Add(time.Hour),
+		backfillStartTime.Add(2 * time.Hour),
+		backfillStartTime.Add(3 * time.Hour),
+	}, kickoffTimes)
+
+	kickoffTimes, err = getBackfillKickoffTimes(schedulerModels.SchedulableEntity{
+		FixedRateValue: 90,
+		Unit:           admin.FixedRateUnit_MINUTE,
+	}, backfillStartTime, backfillStartTime.Add(3*time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		backfillStartTime.Add(90 * time.Minute),
+		backfillStartTime.Add(3 * time.Hour),
+	}, kickoffTimes)
+}
+
+func TestGetBackfillKickoffTimes_TooMany(t *testing.T) {
+	_, err := getBackfillKickoffTimes(schedulerModels.SchedulableEntity{
+		CronExpression: "0 * * * *",
+	}, backfillStartTime, backfillStartTime.Add(24*time.Hour), 10)
+	assert.EqualError(t, err, "backfills cannot launch more than 10 executions")
+}
+
+func TestCreateBackfill(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	setBackfillLaunchPlanCallback(repository, getHourlyCronSchedule())
+	setBackfillCountCallback(t, repository, 0, 0, 0)
+	var created models.Backfill
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnCreateMatch(mock.Anything, mock.Anything).Return(
+		nil).Run(func(args mock.Arguments) {
+		created = args.Get(1).(models.Backfill)
+	})
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(),
+		&managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+	ctx := auth.NewIdentityContext("", "user", "", time.Now(), sets.NewString(), nil).WithContext(context.Background())
+	backfill, err := backfillManager.CreateBackfill(ctx, interfaces.CreateBackfillRequest{
+		Project:     "project",
+		Domain:      "domain",
+		Name:        "backfill",
+		LaunchPlan:  &backfillLaunchPlanID,
+		StartTime:   backfillStartTime,
+		EndTime:     backfillStartTime.Add(3 * time.Hour),
+		Parallelism: 2,
+		Order:       interfaces.BackfillOrderNewestFirst,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "backfill", created.Name)
+	assert.Equal(t, "name", created.LaunchPlanName)
+	assert.Equal(t, "version", created.LaunchPlanVersion)
+	assert.Equal(t, 3, created.Total)
+	assert.True(t, created.Reverse)
+	assert.Equal(t, "RUNNING", created.Phase)
+	assert.Equal(t, "user", created.Principal)
+
+	assert.True(t, proto.Equal(&admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "backfill",
+	}, backfill.Id))
+	assert.True(t, proto.Equal(&backfillLaunchPlanID, backfill.LaunchPlan))
+	assert.Equal(t, core.WorkflowExecution_RUNNING, backfill.Phase)
+	assert.Equal(t, interfaces.BackfillOrderNewestFirst, backfill.Order)
+	assert.Equal(t, 3, backfill.Total)
+	assert.Equal(t, 0, backfill.Launched)
+}
+
+func TestCreateBackfill_GeneratedName(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	setBackfillLaunchPlanCallback(repository, getHourlyCronSchedule())
+	setBackfillCountCallback(t, repository, 0, 0, 0)
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnCreateMatch(mock.Anything, mock.Anything).Return(nil)
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(),
+		&managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+	backfill, err := backfillManager.CreateBackfill(context.Background(), interfaces.CreateBackfillRequest{
+		Project:     "project",
+		Domain:      "domain",
+		LaunchPlan:  &backfillLaunchPlanID,
+		StartTime:   backfillStartTime,
+		EndTime:     backfillStartTime.Add(3 * time.Hour),
+		Parallelism: 2,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, backfill.Id.Name)
+}
+
+func TestCreateBackfill_Unscheduled(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	setBackfillLaunchPlanCallback(repository, nil)
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(),
+		&managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+	_, err := backfillManager.CreateBackfill(context.Background(), interfaces.CreateBackfillRequest{
+		Project:     "project",
+		Domain:      "domain",
+		LaunchPlan:  &backfillLaunchPlanID,
+		StartTime:   backfillStartTime,
+		EndTime:     backfillStartTime.Add(3 * time.Hour),
+		Parallelism: 2,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateBackfill_NoScheduledTimes(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	setBackfillLaunchPlanCallback(repository, getHourlyCronSchedule())
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(),
+		&managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+	_, err := backfillManager.CreateBackfill(context.Background(), interfaces.CreateBackfillRequest{
+		Project:     "project",
+		Domain:      "domain",
+		LaunchPlan:  &backfillLaunchPlanID,
+		StartTime:   backfillStartTime,
+		EndTime:     backfillStartTime.Add(30 * time.Minute),
+		Parallelism: 2,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestAdvanceBackfills_Launch(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	setBackfillLaunchPlanCallback(repository, getHourlyCronSchedule())
+	setBackfillCountCallback(t, repository, 0, 0, 0)
+	backfillModel := getRunningBackfillModel()
+	backfillModel.Reverse = true
+	setRunningBackfillsCallback(repository, backfillModel)
+	var updated models.Backfill
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnUpdateProgressMatch(
+		mock.Anything, mock.Anything).Return(true, nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.Backfill)
+	})
+
+	var requests []admin.ExecutionCreateRequest
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		assert.Equal(t, "user", getUser(ctx))
+		requests = append(requests, request)
+		return &admin.ExecutionCreateResponse{}, nil
+	})
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(), &executionManager,
+		mockScope.NewTestScope())
+
+	assert.NoError(t, backfillManager.AdvanceBackfills(context.Background()))
+	assert.Equal(t, 2, updated.Launched)
+	assert.Equal(t, "RUNNING", updated.Phase)
+	assert.Len(t, requests, 2)
+	// Executions for later scheduled times are launched first.
+	for i, kickoffTime := range []time.Time{
+		backfillStartTime.Add(3 * time.Hour),
+		backfillStartTime.Add(2 * time.Hour),
+	} {
+		kickoffTimeProto, _ := ptypes.TimestampProto(kickoffTime)
+		assert.Equal(t, common.GetIdempotentExecutionName("project", "domain",
+			fmt.Sprintf("backfill/backfill/%d", kickoffTime.Unix())), requests[i].Name)
+		assert.True(t, proto.Equal(&backfillLaunchPlanID, requests[i].Spec.LaunchPlan))
+		assert.Equal(t, admin.ExecutionMetadata_SCHEDULED, requests[i].Spec.Metadata.Mode)
+		assert.True(t, proto.Equal(kickoffTimeProto, requests[i].Spec.Metadata.ScheduledAt))
+		assert.Equal(t, map[string]string{
+			"flyte-backfill": "backfill",
+			"team":           "data",
+		}, requests[i].Spec.Labels.Values)
+		assert.True(t, proto.Equal(kickoffTimeProto,
+			requests[i].Inputs.Literals["kickoff_time"].GetScalar().GetPrimitive().GetDatetime()))
+	}
+	assert.NotEqual(t, requests[0].Name, requests[1].Name)
+}
+
+func TestAdvanceBackfills_RunningAtParallelism(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	setBackfillLaunchPlanCallback(repository, getHourlyCronSchedule())
+	setBackfillCountCallback(t, repository, 2, 0, 0)
+	backfillModel := getRunningBackfillModel()
+	backfillModel.Launched = 2
+	setRunningBackfillsCallback(repository, backfillModel)
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		t.Fatal("launched an execution with parallelism executions running")
+		return nil, nil
+	})
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(), &executionManager,
+		mockScope.NewTestScope())
+
+	assert.NoError(t, backfillManager.AdvanceBackfills(context.Background()))
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).AssertNotCalled(t, "UpdateProgress", mock.Anything,
+		mock.Anything)
+}
+
+func TestAdvanceBackfills_AlreadyLaunched(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	setBackfillLaunchPlanCallback(repository, getHourlyCronSchedule())
+	setBackfillCountCallback(t, repository, 1, 1, 0)
+	backfillModel := getRunningBackfillModel()
+	backfillModel.Launched = 2
+	setRunningBackfillsCallback(repository, backfillModel)
+	var updated models.Backfill
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnUpdateProgressMatch(
+		mock.Anything, mock.Anything).Return(true, nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.Backfill)
+	})
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		return nil, flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "already exists")
+	})
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(), &executionManager,
+		mockScope.NewTestScope())
+
+	assert.NoError(t, backfillManager.AdvanceBackfills(context.Background()))
+	assert.Equal(t, 3, updated.Launched)
+}
+
+func TestAdvanceBackfills_LaunchFailure(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	setBackfillLaunchPlanCallback(repository, getHourlyCronSchedule())
+	setBackfillCountCallback(t, repository, 0, 0, 0)
+	setRunningBackfillsCallback(repository, getRunningBackfillModel())
+	var updated models.Backfill
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnUpdateProgressMatch(
+		mock.Anything, mock.Anything).Return(true, nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.Backfill)
+	})
+	var launched int
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		if launched > 0 {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.Internal, "foo")
+		}
+		launched++
+		return &admin.ExecutionCreateResponse{}, nil
+	})
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(), &executionManager,
+		mockScope.NewTestScope())
+
+	// Failures to advance a backfill are retried on the next pass rather than returned.
+	assert.NoError(t, backfillManager.AdvanceBackfills(context.Background()))
+	assert.Equal(t, 1, updated.Launched)
+}
+
+func TestAdvanceBackfills_Complete(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		failed int64
+		phase  core.WorkflowExecution_Phase
+	}{
+		{
+			name:  "succeeded",
+			phase: core.WorkflowExecution_SUCCEEDED,
+		},
+		{
+			name:   "failed",
+			failed: 1,
+			phase:  core.WorkflowExecution_FAILED,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			repository := repositoryMocks.NewMockRepository()
+			setBackfillCountCallback(t, repository, 0, 3-test.failed, test.failed)
+			backfillModel := getRunningBackfillModel()
+			backfillModel.Launched = 3
+			setRunningBackfillsCallback(repository, backfillModel)
+			var updated models.Backfill
+			repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnUpdateProgressMatch(
+				mock.Anything, mock.Anything).Return(true, nil).Run(func(args mock.Arguments) {
+				updated = args.Get(1).(models.Backfill)
+			})
+			backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(),
+				&managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+			assert.NoError(t, backfillManager.AdvanceBackfills(context.Background()))
+			assert.Equal(t, test.phase.String(), updated.Phase)
+		})
+	}
+}
+
+func TestAdvanceBackfills_TerminatedConcurrently(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	setBackfillLaunchPlanCallback(repository, getHourlyCronSchedule())
+	setBackfillCountCallback(t, repository, 0, 0, 0)
+	setRunningBackfillsCallback(repository, getRunningBackfillModel())
+	// The backfill was aborted after it was listed, so its progress isn't recorded.
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnUpdateProgressMatch(
+		mock.Anything, mock.Anything).Return(false, nil)
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		return &admin.ExecutionCreateResponse{}, nil
+	})
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(), &executionManager,
+		mockScope.NewTestScope())
+
+	assert.NoError(t, backfillManager.AdvanceBackfills(context.Background()))
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).AssertExpectations(t)
+}
+
+func TestTerminateBackfill(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	backfillModel := getRunningBackfillModel()
+	backfillModel.Launched = 2
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnGetMatch(mock.Anything, mock.Anything).Return(
+		backfillModel, nil)
+	var updated models.Backfill
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnUpdateMatch(mock.Anything, mock.Anything).Return(
+		nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.Backfill)
+	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repositoryInterfaces.ListResourceInput) (
+			repositoryInterfaces.ExecutionCollectionOutput, error) {
+			assert.Equal(t, 3, input.Limit)
+			return repositoryInterfaces.ExecutionCollectionOutput{
+				Executions: []models.Execution{
+					{
+						ExecutionKey: models.ExecutionKey{
+							Project: "project",
+							Domain:  "domain",
+							Name:    "running",
+						},
+					},
+				},
+			}, nil
+		})
+	var terminated []string
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetTerminateExecutionCallback(func(ctx context.Context, request admin.ExecutionTerminateRequest) (
+		*admin.ExecutionTerminateResponse, error) {
+		assert.Equal(t, "no longer needed", request.Cause)
+		terminated = append(terminated, request.Id.Name)
+		return &admin.ExecutionTerminateResponse{}, nil
+	})
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(), &executionManager,
+		mockScope.NewTestScope())
+
+	err := backfillManager.TerminateBackfill(context.Background(), admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "backfill",
+	}, "no longer needed")
+	assert.NoError(t, err)
+	assert.Equal(t, "ABORTED", updated.Phase)
+	assert.Equal(t, "no longer needed", updated.AbortCause)
+	assert.Equal(t, []string{"running"}, terminated)
+}
+
+func TestTerminateBackfill_NotRunning(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	backfillModel := getRunningBackfillModel()
+	backfillModel.Phase = core.WorkflowExecution_SUCCEEDED.String()
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnGetMatch(mock.Anything, mock.Anything).Return(
+		backfillModel, nil)
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(),
+		&managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+	err := backfillManager.TerminateBackfill(context.Background(), admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "backfill",
+	}, "no longer needed")
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListBackfills(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	setBackfillCountCallback(t, repository, 1, 2, 3)
+	repository.BackfillRepo().(*repositoryMocks.BackfillRepoInterface).OnListMatch(mock.Anything, mock.MatchedBy(
+		func(input repositoryInterfaces.ListResourceInput) bool {
+			return input.Limit == 1 && input.Offset == 2
+		})).Return(repositoryInterfaces.BackfillCollectionOutput{
+		Backfills: []models.Backfill{getRunningBackfillModel()},
+	}, nil)
+	backfillManager := NewBackfillManager(repository, getMockBackfillConfigProvider(),
+		&managerMocks.MockExecutionManager{}, mockScope.NewTestScope())
+
+	backfills, err := backfillManager.ListBackfills(context.Background(), interfaces.ListBackfillsRequest{
+		Project: "project",
+		Domain:  "domain",
+		Limit:   1,
+		Token:   "2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", backfills.Token)
+	assert.Len(t, backfills.Backfills, 1)
+	assert.Equal(t, "backfill", backfills.Backfills[0].Id.Name)
+	assert.Equal(t, 1, backfills.Backfills[0].Running)
+	assert.Equal(t, 2, backfills.Backfills[0].Succeeded)
+	assert.Equal(t, 3, backfills.Backfills[0].Failed)
+}
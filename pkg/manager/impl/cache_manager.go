@@ -0,0 +1,437 @@
+package impl
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/datacatalog"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/pbhash"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Propeller names the datacatalog datasets and tags of cached task outputs this way.
+const cachedTaskDatasetPrefix = "flyte_task-"
+const cachedOutputsTagPrefix = "flyte_cached-"
+
+// The length the hashes of task interfaces are truncated to in dataset versions.
+const interfaceHashLength = 8
+
+type CacheManager struct {
+	db      repositories.RepositoryInterface
+	config  runtimeInterfaces.Configuration
+	catalog datacatalog.DataCatalogClient
+}
+
+func getInterfaceHash(ctx context.Context, variables *core.VariableMap) (string, error) {
+	if variables == nil {
+		variables = &core.VariableMap{}
+	}
+	hash, err := pbhash.ComputeHash(ctx, variables)
+	if err != nil {
+		return "", err
+	}
+	hashString := base64.RawURLEncoding.EncodeToString(hash)
+	if len(hashString) > interfaceHashLength {
+		hashString = hashString[:interfaceHashLength]
+	}
+	return hashString, nil
+}
+
+// Returns the dataset propeller caches the outputs of a task version in. Datasets are versioned by the cache version
+// and the signature of the task's interface, so that outputs are never reused by tasks which produce other types.
+func getCachedTaskDatasetID(ctx context.Context, taskID *core.Identifier, taskInterface *core.TypedInterface,
+	cacheVersion string) (*datacatalog.DatasetID, error) {
+	inputsHash, err := getInterfaceHash(ctx, taskInterface.GetInputs())
+	if err != nil {
+		return nil, err
+	}
+	outputsHash, err := getInterfaceHash(ctx, taskInterface.GetOutputs())
+	if err != nil {
+		return nil, err
+	}
+	return &datacatalog.DatasetID{
+		Project: taskID.Project,
+		Domain:  taskID.Domain,
+		Name:    cachedTaskDatasetPrefix + taskID.Name,
+		Version: fmt.Sprintf("%s-%s-%s", cacheVersion, inputsHash, outputsHash),
+	}, nil
+}
+
+func (m *CacheManager) checkCachedOutputs(ctx context.Context, query interfaces.CachedOutputsQuery) (
+	interfaces.CachedOutputsStatus, error) {
+	cachedOutputsStatus := interfaces.CachedOutputsStatus{
+		TaskId: query.TaskId,
+	}
+	task, err := util.GetTask(ctx, m.db, *query.TaskId)
+	if err != nil {
+		return interfaces.CachedOutputsStatus{}, err
+	}
+	template := task.GetClosure().GetCompiledTask().GetTemplate()
+	if !template.GetMetadata().GetDiscoverable() {
+		cachedOutputsStatus.Status = core.CatalogCacheStatus_CACHE_DISABLED
+		return cachedOutputsStatus, nil
+	}
+	cacheVersion := query.CacheVersion
+	if len(cacheVersion) == 0 {
+		cacheVersion = template.GetMetadata().GetDiscoveryVersion()
+	}
+	datasetID, err := getCachedTaskDatasetID(ctx, query.TaskId, template.GetInterface(), cacheVersion)
+	if err != nil {
+		return interfaces.CachedOutputsStatus{}, err
+	}
+
+	lookupCtx, cancel := context.WithTimeout(
+		ctx, m.config.ApplicationConfiguration().GetTopLevelConfig().GetDataCatalogConfig().Timeout.Duration)
+	defer cancel()
+	response, err := m.catalog.GetArtifact(lookupCtx, &datacatalog.GetArtifactRequest{
+		Dataset: datasetID,
+		QueryHandle: &datacatalog.GetArtifactRequest_TagName{
+			TagName: cachedOutputsTagPrefix + query.InputsHash,
+		},
+	})
+	if status.Code(err) == codes.NotFound {
+		cachedOutputsStatus.Status = core.CatalogCacheStatus_CACHE_MISS
+		return cachedOutputsStatus, nil
+	} else if err != nil {
+		// The other queries of the request may still be answered.
+		logger.Warningf(ctx, "Failed to look up cached outputs of task [%+v] in dataset [%+v] with err: %v",
+			query.TaskId, datasetID, err)
+		cachedOutputsStatus.Status = core.CatalogCacheStatus_CACHE_LOOKUP_FAILURE
+		return cachedOutputsStatus, nil
+	}
+	cachedOutputsStatus.Status = core.CatalogCacheStatus_CACHE_HIT
+	cachedOutputsStatus.ArtifactId = response.GetArtifact().GetId()
+	if response.GetArtifact().GetCreatedAt() != nil {
+		cachedOutputsStatus.CachedAt, err = ptypes.Timestamp(response.GetArtifact().GetCreatedAt())
+		if err != nil {
+			logger.Debugf(ctx, "Ignoring invalid creation time of artifact [%s]", cachedOutputsStatus.ArtifactId)
+			cachedOutputsStatus.CachedAt = time.Time{}
+		}
+	}
+	return cachedOutputsStatus, nil
+}
+
+func (m *CacheManager) checkEnabled() error {
+	if !m.config.ApplicationConfiguration().GetTopLevelConfig().GetDataCatalogConfig().Enabled {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "looking up cached outputs isn't enabled")
+	}
+	return nil
+}
+
+func (m *CacheManager) CheckCachedOutputs(ctx context.Context, request interfaces.CheckCachedOutputsRequest) (
+	*interfaces.CheckCachedOutputsResponse, error) {
+	if err := m.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateCheckCachedOutputsRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	response := &interfaces.CheckCachedOutputsResponse{
+		Statuses: make([]interfaces.CachedOutputsStatus, 0, len(request.Queries)),
+	}
+	for _, query := range request.Queries {
+		if err := checkProjectVisible(ctx, query.TaskId.Project); err != nil {
+			return nil, err
+		}
+		cachedOutputsStatus, err := m.checkCachedOutputs(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		response.Statuses = append(response.Statuses, cachedOutputsStatus)
+	}
+	return response, nil
+}
+
+func (m *CacheManager) GetExecutionCacheSummary(ctx context.Context, id core.WorkflowExecutionIdentifier) (
+	*interfaces.ExecutionCacheSummary, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(&id); err != nil {
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, &id)
+	if err := checkProjectVisible(ctx, id.Project); err != nil {
+		return nil, err
+	}
+	executionModel, err := util.GetExecutionModel(ctx, m.db, id)
+	if err != nil {
+		return nil, err
+	}
+	// Node executions still running haven't necessarily reported their cache status yet.
+	phase := core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[executionModel.Phase])
+	if !common.IsExecutionTerminal(phase) {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"execution [%+v] is still %s", id, executionModel.Phase)
+	}
+	filters, err := util.GetWorkflowExecutionIdentifierFilters(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	nodeExecutionModels, err := listAllNodeExecutions(ctx, m.db, filters)
+	if err != nil {
+		return nil, err
+	}
+	summary := &interfaces.ExecutionCacheSummary{
+		Id:         &id,
+		NodeCounts: make(map[core.CatalogCacheStatus]int),
+	}
+	for _, nodeExecutionModel := range nodeExecutionModels {
+		if nodeExecutionModel.CacheStatus == nil {
+			continue
+		}
+		summary.NodeCounts[core.CatalogCacheStatus(core.CatalogCacheStatus_value[*nodeExecutionModel.CacheStatus])]++
+	}
+	return summary, nil
+}
+
+func getCacheEvictionModel(ctx context.Context, dataset *core.Identifier, artifactID string,
+	nodeExecutionID *core.NodeExecutionIdentifier, reason string) models.CacheEviction {
+	evictionModel := models.CacheEviction{
+		DatasetProject: dataset.Project,
+		DatasetDomain:  dataset.Domain,
+		DatasetName:    dataset.Name,
+		DatasetVersion: dataset.Version,
+		ArtifactID:     artifactID,
+		Principal:      getUser(ctx),
+		Reason:         reason,
+	}
+	if nodeExecutionID != nil {
+		evictionModel.ExecutionProject = nodeExecutionID.ExecutionId.Project
+		evictionModel.ExecutionDomain = nodeExecutionID.ExecutionId.Domain
+		evictionModel.ExecutionName = nodeExecutionID.ExecutionId.Name
+		evictionModel.NodeID = nodeExecutionID.NodeId
+	}
+	return evictionModel
+}
+
+func fromCacheEvictionModel(evictionModel models.CacheEviction) *interfaces.CacheEviction {
+	eviction := &interfaces.CacheEviction{
+		Dataset: &core.Identifier{
+			ResourceType: core.ResourceType_DATASET,
+			Project:      evictionModel.DatasetProject,
+			Domain:       evictionModel.DatasetDomain,
+			Name:         evictionModel.DatasetName,
+			Version:      evictionModel.DatasetVersion,
+		},
+		ArtifactId: evictionModel.ArtifactID,
+		Principal:  evictionModel.Principal,
+		Reason:     evictionModel.Reason,
+		EvictedAt:  evictionModel.CreatedAt,
+	}
+	if len(evictionModel.NodeID) > 0 {
+		eviction.NodeExecutionId = &core.NodeExecutionIdentifier{
+			NodeId: evictionModel.NodeID,
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Project: evictionModel.ExecutionProject,
+				Domain:  evictionModel.ExecutionDomain,
+				Name:    evictionModel.ExecutionName,
+			},
+		}
+	}
+	return eviction
+}
+
+// Returns the eviction of the outputs a node execution cached or reused, or false when it didn't use the cache.
+func getNodeExecutionCacheEviction(ctx context.Context, nodeExecutionModel models.NodeExecution,
+	reason string) (models.CacheEviction, bool) {
+	var closure admin.NodeExecutionClosure
+	if err := proto.Unmarshal(nodeExecutionModel.Closure, &closure); err != nil {
+		logger.Warningf(ctx, "Failed to unmarshal closure of node execution [%s] with err: %v",
+			nodeExecutionModel.NodeID, err)
+		return models.CacheEviction{}, false
+	}
+	catalogKey := closure.GetTaskNodeMetadata().GetCatalogKey()
+	if catalogKey.GetDatasetId() == nil || len(catalogKey.GetArtifactTag().GetArtifactId()) == 0 {
+		return models.CacheEviction{}, false
+	}
+	nodeExecutionID := &core.NodeExecutionIdentifier{
+		NodeId: nodeExecutionModel.NodeID,
+		ExecutionId: &core.WorkflowExecutionIdentifier{
+			Project: nodeExecutionModel.NodeExecutionKey.ExecutionKey.Project,
+			Domain:  nodeExecutionModel.NodeExecutionKey.ExecutionKey.Domain,
+			Name:    nodeExecutionModel.NodeExecutionKey.ExecutionKey.Name,
+		},
+	}
+	return getCacheEvictionModel(ctx, catalogKey.DatasetId, catalogKey.ArtifactTag.ArtifactId, nodeExecutionID,
+		reason), true
+}
+
+func (m *CacheManager) getTaskExecutionCacheEvictions(ctx context.Context, request interfaces.CacheEvictRequest) (
+	[]models.CacheEviction, error) {
+	if _, err := util.GetTaskExecutionModel(ctx, m.db, request.TaskExecutionId); err != nil {
+		return nil, err
+	}
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.TaskExecutionId.NodeExecutionId)
+	if err != nil {
+		return nil, err
+	}
+	evictionModel, ok := getNodeExecutionCacheEviction(ctx, *nodeExecutionModel, request.Reason)
+	if !ok {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"task execution [%+v] has no cached outputs", request.TaskExecutionId)
+	}
+	return []models.CacheEviction{evictionModel}, nil
+}
+
+func (m *CacheManager) getExecutionCacheEvictions(ctx context.Context, request interfaces.CacheEvictRequest) (
+	[]models.CacheEviction, error) {
+	if _, err := util.GetExecutionModel(ctx, m.db, *request.ExecutionId); err != nil {
+		return nil, err
+	}
+	filters, err := util.GetWorkflowExecutionIdentifierFilters(ctx, *request.ExecutionId)
+	if err != nil {
+		return nil, err
+	}
+	nodeExecutionModels, err := listAllNodeExecutions(ctx, m.db, filters)
+	if err != nil {
+		return nil, err
+	}
+	var evictionModels []models.CacheEviction
+	for _, nodeExecutionModel := range nodeExecutionModels {
+		if evictionModel, ok := getNodeExecutionCacheEviction(ctx, nodeExecutionModel, request.Reason); ok {
+			evictionModels = append(evictionModels, evictionModel)
+		}
+	}
+	return evictionModels, nil
+}
+
+// Evicts every artifact of the dataset the task version caches its outputs in.
+func (m *CacheManager) getTaskCacheEvictions(ctx context.Context, request interfaces.CacheEvictRequest) (
+	[]models.CacheEviction, error) {
+	task, err := util.GetTask(ctx, m.db, *request.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	template := task.GetClosure().GetCompiledTask().GetTemplate()
+	if !template.GetMetadata().GetDiscoverable() {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"task [%+v] doesn't cache its outputs", request.TaskId)
+	}
+	datasetID, err := getCachedTaskDatasetID(
+		ctx, request.TaskId, template.GetInterface(), template.GetMetadata().GetDiscoveryVersion())
+	if err != nil {
+		return nil, err
+	}
+	dataset := &core.Identifier{
+		ResourceType: core.ResourceType_DATASET,
+		Project:      datasetID.Project,
+		Domain:       datasetID.Domain,
+		Name:         datasetID.Name,
+		Version:      datasetID.Version,
+	}
+	return []models.CacheEviction{getCacheEvictionModel(ctx, dataset, "", nil, request.Reason)}, nil
+}
+
+func (m *CacheManager) EvictCache(ctx context.Context, request interfaces.CacheEvictRequest) (
+	*interfaces.CacheEvictResponse, error) {
+	if err := m.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateCacheEvictRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	var evictionModels []models.CacheEviction
+	var err error
+	switch {
+	case request.TaskExecutionId != nil:
+		if err := checkProjectVisible(ctx, request.TaskExecutionId.NodeExecutionId.ExecutionId.Project); err != nil {
+			return nil, err
+		}
+		evictionModels, err = m.getTaskExecutionCacheEvictions(ctx, request)
+	case request.ExecutionId != nil:
+		if err := checkProjectVisible(ctx, request.ExecutionId.Project); err != nil {
+			return nil, err
+		}
+		evictionModels, err = m.getExecutionCacheEvictions(ctx, request)
+	default:
+		if err := checkProjectVisible(ctx, request.TaskId.Project); err != nil {
+			return nil, err
+		}
+		evictionModels, err = m.getTaskCacheEvictions(ctx, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	response := &interfaces.CacheEvictResponse{
+		Evictions: make([]*interfaces.CacheEviction, 0, len(evictionModels)),
+	}
+	if len(evictionModels) == 0 {
+		return response, nil
+	}
+	if err := m.db.CacheEvictionRepo().Create(ctx, evictionModels); err != nil {
+		logger.Debugf(ctx, "Failed to record cache evictions for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	for _, evictionModel := range evictionModels {
+		logger.Infof(ctx, "[%s] evicted artifact [%s] of dataset [%s/%s/%s/%s]: %s", evictionModel.Principal,
+			evictionModel.ArtifactID, evictionModel.DatasetProject, evictionModel.DatasetDomain,
+			evictionModel.DatasetName, evictionModel.DatasetVersion, evictionModel.Reason)
+		response.Evictions = append(response.Evictions, fromCacheEvictionModel(evictionModel))
+	}
+	return response, nil
+}
+
+func (m *CacheManager) ListCacheEvictions(ctx context.Context, request interfaces.CacheEvictionListRequest) (
+	*interfaces.CacheEvictionList, error) {
+	if err := validation.ValidateCacheEvictionListRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	if err := checkProjectVisible(ctx, request.Project); err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListCacheEvictions", request.Token)
+	}
+	evictionModels, err := m.db.CacheEvictionRepo().List(ctx, repoInterfaces.ListCacheEvictionsInput{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Limit:   int(request.Limit),
+		Offset:  offset,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list cache evictions with err: %v", err)
+		return nil, err
+	}
+	evictions := make([]*interfaces.CacheEviction, len(evictionModels))
+	for i, evictionModel := range evictionModels {
+		evictions[i] = fromCacheEvictionModel(evictionModel)
+	}
+	var token string
+	if len(evictions) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(evictions))
+	}
+	return &interfaces.CacheEvictionList{
+		Evictions: evictions,
+		Token:     token,
+	}, nil
+}
+
+func NewCacheManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	catalog datacatalog.DataCatalogClient) interfaces.CacheInterface {
+	return &CacheManager{
+		db:      db,
+		config:  config,
+		catalog: catalog,
+	}
+}
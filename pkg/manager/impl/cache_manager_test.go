@@ -0,0 +1,412 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	datacatalogMocks "github.com/flyteorg/flyteidl/clients/go/datacatalog/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/datacatalog"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const cachedInputsHash = "GQid5LjHbakcW68DS3P2jp80QLbiF0olFHF2hTh5bg8"
+
+var cachedTaskID = &core.Identifier{
+	ResourceType: core.ResourceType_TASK,
+	Project:      "project",
+	Domain:       "domain",
+	Name:         "name",
+	Version:      "version",
+}
+
+func getCacheRepositoryForTest(discoverable bool) *repositoryMocks.MockRepository {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	closure := testutils.GetTaskClosure()
+	closure.CompiledTask.Template.Metadata.Discoverable = discoverable
+	closure.CompiledTask.Template.Metadata.DiscoveryVersion = "1.0"
+	closureBytes, _ := proto.Marshal(closure)
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input repositoryInterfaces.Identifier) (models.Task, error) {
+			return models.Task{
+				TaskKey: models.TaskKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Closure: closureBytes,
+			}, nil
+		})
+	return repository
+}
+
+func newCacheManagerForTest(
+	repository *repositoryMocks.MockRepository, enabled bool) (*CacheManager, *datacatalogMocks.DataCatalogClient) {
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			DataCatalog: runtimeInterfaces.DataCatalogConfig{
+				Enabled: enabled,
+				Timeout: config.Duration{Duration: time.Second},
+			},
+		})
+	catalog := &datacatalogMocks.DataCatalogClient{}
+	return NewCacheManager(repository, mockConfig, catalog).(*CacheManager), catalog
+}
+
+func getCachedOutputsRequest() interfaces.CheckCachedOutputsRequest {
+	return interfaces.CheckCachedOutputsRequest{
+		Queries: []interfaces.CachedOutputsQuery{
+			{
+				TaskId:     cachedTaskID,
+				InputsHash: cachedInputsHash,
+			},
+		},
+	}
+}
+
+func TestCheckCachedOutputs_Hit(t *testing.T) {
+	cacheManager, catalog := newCacheManagerForTest(getCacheRepositoryForTest(true), true)
+	createdAt, _ := ptypes.TimestampProto(metricsStartTime)
+	catalog.OnGetArtifactMatch(mock.Anything, mock.MatchedBy(func(request *datacatalog.GetArtifactRequest) bool {
+		return request.Dataset.Project == "project" && request.Dataset.Domain == "domain" &&
+			request.Dataset.Name == "flyte_task-name" && strings.HasPrefix(request.Dataset.Version, "1.0-") &&
+			request.GetTagName() == "flyte_cached-"+cachedInputsHash
+	})).Return(&datacatalog.GetArtifactResponse{
+		Artifact: &datacatalog.Artifact{
+			Id:        "artifact",
+			CreatedAt: createdAt,
+		},
+	}, nil)
+
+	response, err := cacheManager.CheckCachedOutputs(context.Background(), getCachedOutputsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, []interfaces.CachedOutputsStatus{
+		{
+			TaskId:     cachedTaskID,
+			Status:     core.CatalogCacheStatus_CACHE_HIT,
+			ArtifactId: "artifact",
+			CachedAt:   metricsStartTime,
+		},
+	}, response.Statuses)
+}
+
+func TestCheckCachedOutputs_CacheVersion(t *testing.T) {
+	cacheManager, catalog := newCacheManagerForTest(getCacheRepositoryForTest(true), true)
+	catalog.OnGetArtifactMatch(mock.Anything, mock.MatchedBy(func(request *datacatalog.GetArtifactRequest) bool {
+		return strings.HasPrefix(request.Dataset.Version, "2.0-")
+	})).Return(nil, status.Error(codes.NotFound, "no artifact"))
+	request := getCachedOutputsRequest()
+	request.Queries[0].CacheVersion = "2.0"
+
+	response, err := cacheManager.CheckCachedOutputs(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, core.CatalogCacheStatus_CACHE_MISS, response.Statuses[0].Status)
+}
+
+func TestCheckCachedOutputs_LookupFailure(t *testing.T) {
+	cacheManager, catalog := newCacheManagerForTest(getCacheRepositoryForTest(true), true)
+	catalog.OnGetArtifactMatch(mock.Anything, mock.Anything).Return(nil, status.Error(codes.Unavailable, "down"))
+
+	response, err := cacheManager.CheckCachedOutputs(context.Background(), getCachedOutputsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, core.CatalogCacheStatus_CACHE_LOOKUP_FAILURE, response.Statuses[0].Status)
+}
+
+func TestCheckCachedOutputs_NotDiscoverable(t *testing.T) {
+	cacheManager, catalog := newCacheManagerForTest(getCacheRepositoryForTest(false), true)
+
+	response, err := cacheManager.CheckCachedOutputs(context.Background(), getCachedOutputsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, core.CatalogCacheStatus_CACHE_DISABLED, response.Statuses[0].Status)
+	catalog.AssertNotCalled(t, "GetArtifact", mock.Anything, mock.Anything)
+}
+
+func TestCheckCachedOutputs_Disabled(t *testing.T) {
+	cacheManager, _ := newCacheManagerForTest(getCacheRepositoryForTest(true), false)
+
+	_, err := cacheManager.CheckCachedOutputs(context.Background(), getCachedOutputsRequest())
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetCachedTaskDatasetID(t *testing.T) {
+	taskInterface := &core.TypedInterface{
+		Inputs: &core.VariableMap{
+			Variables: map[string]*core.Variable{
+				"a": {
+					Type: &core.LiteralType{
+						Type: &core.LiteralType_Simple{
+							Simple: core.SimpleType_INTEGER,
+						},
+					},
+				},
+			},
+		},
+	}
+	datasetID, err := getCachedTaskDatasetID(context.Background(), cachedTaskID, taskInterface, "1.0")
+	assert.NoError(t, err)
+	versionParts := strings.Split(datasetID.Version, "-")
+	assert.Len(t, versionParts, 3)
+	assert.Equal(t, "1.0", versionParts[0])
+	assert.Len(t, versionParts[1], interfaceHashLength)
+
+	// Tasks with other interfaces cache their outputs in other datasets.
+	otherDatasetID, err := getCachedTaskDatasetID(context.Background(), cachedTaskID, &core.TypedInterface{}, "1.0")
+	assert.NoError(t, err)
+	assert.NotEqual(t, datasetID.Version, otherDatasetID.Version)
+}
+
+func setCacheSummaryExecutionPhase(repository *repositoryMocks.MockRepository, phase core.WorkflowExecution_Phase) {
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.Identifier) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+				},
+				Phase: phase.String(),
+			}, nil
+		})
+}
+
+func TestGetExecutionCacheSummary(t *testing.T) {
+	repository := getCacheRepositoryForTest(true)
+	setCacheSummaryExecutionPhase(repository, core.WorkflowExecution_SUCCEEDED)
+	cacheHit := core.CatalogCacheStatus_CACHE_HIT.String()
+	cachePopulated := core.CatalogCacheStatus_CACHE_POPULATED.String()
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repositoryInterfaces.ListResourceInput) (
+			repositoryInterfaces.NodeExecutionCollectionOutput, error) {
+			return repositoryInterfaces.NodeExecutionCollectionOutput{
+				NodeExecutions: []models.NodeExecution{
+					{CacheStatus: &cacheHit},
+					{CacheStatus: &cacheHit},
+					{CacheStatus: &cachePopulated},
+					{},
+				},
+			}, nil
+		})
+	cacheManager, _ := newCacheManagerForTest(repository, true)
+
+	summary, err := cacheManager.GetExecutionCacheSummary(context.Background(), metricsExecutionID)
+	assert.NoError(t, err)
+	assert.Equal(t, map[core.CatalogCacheStatus]int{
+		core.CatalogCacheStatus_CACHE_HIT:       2,
+		core.CatalogCacheStatus_CACHE_POPULATED: 1,
+	}, summary.NodeCounts)
+}
+
+func TestGetExecutionCacheSummary_Running(t *testing.T) {
+	repository := getCacheRepositoryForTest(true)
+	setCacheSummaryExecutionPhase(repository, core.WorkflowExecution_RUNNING)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repositoryInterfaces.ListResourceInput) (
+			repositoryInterfaces.NodeExecutionCollectionOutput, error) {
+			return repositoryInterfaces.NodeExecutionCollectionOutput{}, errors.New("listed node executions")
+		})
+	cacheManager, _ := newCacheManagerForTest(repository, true)
+
+	_, err := cacheManager.GetExecutionCacheSummary(context.Background(), metricsExecutionID)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func getCachedNodeExecutionModel(nodeID, artifactID string) models.NodeExecution {
+	closure := &admin.NodeExecutionClosure{}
+	if len(artifactID) > 0 {
+		closure.TargetMetadata = &admin.NodeExecutionClosure_TaskNodeMetadata{
+			TaskNodeMetadata: &admin.TaskNodeMetadata{
+				CacheStatus: core.CatalogCacheStatus_CACHE_HIT,
+				CatalogKey: &core.CatalogMetadata{
+					DatasetId: &core.Identifier{
+						ResourceType: core.ResourceType_DATASET,
+						Project:      "project",
+						Domain:       "domain",
+						Name:         "flyte_task-name",
+						Version:      "1.0-abcdefgh-ijklmnop",
+					},
+					ArtifactTag: &core.CatalogArtifactTag{
+						ArtifactId: artifactID,
+						Name:       "flyte_cached-" + cachedInputsHash,
+					},
+				},
+			},
+		}
+	}
+	closureBytes, _ := proto.Marshal(closure)
+	return models.NodeExecution{
+		NodeExecutionKey: models.NodeExecutionKey{
+			ExecutionKey: models.ExecutionKey{
+				Project: metricsExecutionID.Project,
+				Domain:  metricsExecutionID.Domain,
+				Name:    metricsExecutionID.Name,
+			},
+			NodeID: nodeID,
+		},
+		Closure: closureBytes,
+	}
+}
+
+func TestEvictCache_TaskExecution(t *testing.T) {
+	repository := getCacheRepositoryForTest(true)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.NodeExecutionResource) (models.NodeExecution, error) {
+			return getCachedNodeExecutionModel(input.NodeExecutionIdentifier.NodeId, "artifact"), nil
+		})
+	var created []models.CacheEviction
+	repository.CacheEvictionRepo().(*repositoryMocks.CacheEvictionRepoInterface).OnCreateMatch(
+		mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(1).([]models.CacheEviction)
+	}).Return(nil)
+	cacheManager, _ := newCacheManagerForTest(repository, true)
+
+	response, err := cacheManager.EvictCache(context.Background(), interfaces.CacheEvictRequest{
+		TaskExecutionId: &core.TaskExecutionIdentifier{
+			TaskId: cachedTaskID,
+			NodeExecutionId: &core.NodeExecutionIdentifier{
+				NodeId:      "n0",
+				ExecutionId: &metricsExecutionID,
+			},
+		},
+		Reason: "the upstream data was corrupt",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, created, 1)
+	assert.Equal(t, "artifact", created[0].ArtifactID)
+	assert.Equal(t, "n0", created[0].NodeID)
+	assert.Equal(t, "the upstream data was corrupt", created[0].Reason)
+	assert.Len(t, response.Evictions, 1)
+	assert.Equal(t, "flyte_task-name", response.Evictions[0].Dataset.Name)
+	assert.True(t, proto.Equal(&core.NodeExecutionIdentifier{
+		NodeId:      "n0",
+		ExecutionId: &metricsExecutionID,
+	}, response.Evictions[0].NodeExecutionId))
+}
+
+func TestEvictCache_TaskExecutionNotCached(t *testing.T) {
+	repository := getCacheRepositoryForTest(true)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.NodeExecutionResource) (models.NodeExecution, error) {
+			return getCachedNodeExecutionModel(input.NodeExecutionIdentifier.NodeId, ""), nil
+		})
+	cacheManager, _ := newCacheManagerForTest(repository, true)
+
+	_, err := cacheManager.EvictCache(context.Background(), interfaces.CacheEvictRequest{
+		TaskExecutionId: &core.TaskExecutionIdentifier{
+			TaskId: cachedTaskID,
+			NodeExecutionId: &core.NodeExecutionIdentifier{
+				NodeId:      "n0",
+				ExecutionId: &metricsExecutionID,
+			},
+		},
+	})
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestEvictCache_Execution(t *testing.T) {
+	repository := getCacheRepositoryForTest(true)
+	setCacheSummaryExecutionPhase(repository, core.WorkflowExecution_SUCCEEDED)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repositoryInterfaces.ListResourceInput) (
+			repositoryInterfaces.NodeExecutionCollectionOutput, error) {
+			return repositoryInterfaces.NodeExecutionCollectionOutput{
+				NodeExecutions: []models.NodeExecution{
+					getCachedNodeExecutionModel("n0", "artifact-0"),
+					getCachedNodeExecutionModel("n1", ""),
+					getCachedNodeExecutionModel("n2", "artifact-2"),
+				},
+			}, nil
+		})
+	repository.CacheEvictionRepo().(*repositoryMocks.CacheEvictionRepoInterface).OnCreateMatch(
+		mock.Anything, mock.Anything).Return(nil)
+	cacheManager, _ := newCacheManagerForTest(repository, true)
+
+	response, err := cacheManager.EvictCache(context.Background(), interfaces.CacheEvictRequest{
+		ExecutionId: &metricsExecutionID,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, response.Evictions, 2)
+	assert.Equal(t, "artifact-0", response.Evictions[0].ArtifactId)
+	assert.Equal(t, "artifact-2", response.Evictions[1].ArtifactId)
+}
+
+func TestEvictCache_Task(t *testing.T) {
+	repository := getCacheRepositoryForTest(true)
+	var created []models.CacheEviction
+	repository.CacheEvictionRepo().(*repositoryMocks.CacheEvictionRepoInterface).OnCreateMatch(
+		mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(1).([]models.CacheEviction)
+	}).Return(nil)
+	cacheManager, _ := newCacheManagerForTest(repository, true)
+
+	response, err := cacheManager.EvictCache(context.Background(), interfaces.CacheEvictRequest{
+		TaskId: cachedTaskID,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, created, 1)
+	// Every artifact of the dataset is evicted.
+	assert.Empty(t, created[0].ArtifactID)
+	assert.Empty(t, created[0].NodeID)
+	assert.True(t, strings.HasPrefix(created[0].DatasetVersion, "1.0-"))
+	assert.Nil(t, response.Evictions[0].NodeExecutionId)
+}
+
+func TestEvictCache_TaskNotDiscoverable(t *testing.T) {
+	cacheManager, _ := newCacheManagerForTest(getCacheRepositoryForTest(false), true)
+
+	_, err := cacheManager.EvictCache(context.Background(), interfaces.CacheEvictRequest{
+		TaskId: cachedTaskID,
+	})
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListCacheEvictions(t *testing.T) {
+	repository := getCacheRepositoryForTest(true)
+	repository.CacheEvictionRepo().(*repositoryMocks.CacheEvictionRepoInterface).OnListMatch(
+		mock.Anything, repositoryInterfaces.ListCacheEvictionsInput{
+			Project: "project",
+			Domain:  "domain",
+			Limit:   1,
+			Offset:  1,
+		}).Return([]models.CacheEviction{
+		{
+			CreatedAt:      metricsStartTime,
+			DatasetProject: "project",
+			DatasetDomain:  "domain",
+			DatasetName:    "flyte_task-name",
+			Principal:      "jane",
+		},
+	}, nil)
+	cacheManager, _ := newCacheManagerForTest(repository, true)
+
+	evictions, err := cacheManager.ListCacheEvictions(context.Background(), interfaces.CacheEvictionListRequest{
+		Project: "project",
+		Domain:  "domain",
+		Limit:   1,
+		Token:   "1",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, evictions.Evictions, 1)
+	assert.Equal(t, "jane", evictions.Evictions[0].Principal)
+	assert.Equal(t, metricsStartTime, evictions.Evictions[0].EvictedAt)
+	assert.Equal(t, "2", evictions.Token)
+}
@@ -0,0 +1,273 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type ClusterPoolManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+// Returns the names of the configured execution clusters, which are the only clusters pools can have.
+func (m *ClusterPoolManager) getConfiguredClusters() sets.String {
+	clusters := sets.NewString()
+	for _, cluster := range m.config.ClusterConfiguration().GetClusterConfigs() {
+		clusters.Insert(cluster.Name)
+	}
+	return clusters
+}
+
+func toClusterHealth(healthModel models.ClusterHealth) interfaces.ClusterHealth {
+	return interfaces.ClusterHealth{
+		Cluster:                  healthModel.Cluster,
+		State:                    healthModel.State,
+		Reason:                   healthModel.Reason,
+		Principal:                healthModel.Principal,
+		UpdatedAt:                healthModel.UpdatedAt,
+		ProbedAt:                 healthModel.ProbedAt,
+		ProbeError:               healthModel.ProbeError,
+		ConsecutiveProbeFailures: healthModel.ConsecutiveProbeFailures,
+		PropellerHeartbeatAt:     healthModel.PropellerHeartbeatAt,
+	}
+}
+
+// Returns the health states of clusters which have one, keyed by cluster.
+func (m *ClusterPoolManager) getClusterStates(ctx context.Context) (map[string]string, error) {
+	healthModels, err := m.db.ClusterHealthRepo().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	states := make(map[string]string, len(healthModels))
+	for _, healthModel := range healthModels {
+		states[healthModel.Cluster] = healthModel.State
+	}
+	return states, nil
+}
+
+func toClusterPool(poolModel models.ClusterPool, states map[string]string) (*interfaces.ClusterPool, error) {
+	labels := make(map[string]string)
+	if len(poolModel.Labels) > 0 {
+		if err := json.Unmarshal(poolModel.Labels, &labels); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to unmarshal the labels of cluster pool [%s]: %v", poolModel.Name, err)
+		}
+	}
+	var memberModels []models.ClusterPoolMember
+	if err := json.Unmarshal(poolModel.Members, &memberModels); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal the members of cluster pool [%s]: %v", poolModel.Name, err)
+	}
+	members := make([]interfaces.ClusterPoolMember, len(memberModels))
+	for i, memberModel := range memberModels {
+		state, ok := states[memberModel.Cluster]
+		if !ok {
+			state = models.ClusterHealthy
+		}
+		members[i] = interfaces.ClusterPoolMember{
+			Cluster: memberModel.Cluster,
+			Weight:  memberModel.Weight,
+			State:   state,
+		}
+	}
+	return &interfaces.ClusterPool{
+		Name:        poolModel.Name,
+		Description: poolModel.Description,
+		Labels:      labels,
+		Members:     members,
+		Principal:   poolModel.Principal,
+		CreatedAt:   poolModel.CreatedAt,
+		UpdatedAt:   poolModel.UpdatedAt,
+	}, nil
+}
+
+func (m *ClusterPoolManager) SetClusterPool(
+	ctx context.Context, request interfaces.SetClusterPoolRequest) (*interfaces.ClusterPool, error) {
+	if err := validation.ValidateSetClusterPoolRequest(request, m.getConfiguredClusters()); err != nil {
+		logger.Debugf(ctx, "invalid set cluster pool request [%s]: %v", request.Name, err)
+		return nil, err
+	}
+	labels, err := json.Marshal(request.Labels)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal labels: %v", err)
+	}
+	memberModels := make([]models.ClusterPoolMember, len(request.Members))
+	for i, member := range request.Members {
+		memberModels[i] = models.ClusterPoolMember{
+			Cluster: member.Cluster,
+			Weight:  member.Weight,
+		}
+	}
+	members, err := json.Marshal(memberModels)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal members: %v", err)
+	}
+	poolModel := models.ClusterPool{
+		Name:        request.Name,
+		Description: request.Description,
+		Labels:      labels,
+		Members:     members,
+		Principal:   getUser(ctx),
+	}
+	_, err = m.db.ClusterPoolRepo().Get(ctx, request.Name)
+	if err == nil {
+		err = m.db.ClusterPoolRepo().Update(ctx, poolModel)
+	} else if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+		err = m.db.ClusterPoolRepo().Create(ctx, poolModel)
+	}
+	if err != nil {
+		logger.Debugf(ctx, "failed to set cluster pool [%s] with err: %v", request.Name, err)
+		return nil, err
+	}
+	return m.GetClusterPool(ctx, request.Name)
+}
+
+func (m *ClusterPoolManager) GetClusterPool(ctx context.Context, name string) (*interfaces.ClusterPool, error) {
+	if err := validation.ValidateEmptyStringField(name, shared.Name); err != nil {
+		return nil, err
+	}
+	poolModel, err := m.db.ClusterPoolRepo().Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	states, err := m.getClusterStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toClusterPool(poolModel, states)
+}
+
+func (m *ClusterPoolManager) ListClusterPools(
+	ctx context.Context, request interfaces.ListClusterPoolsRequest) (*interfaces.ClusterPoolList, error) {
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListClusterPools", request.Token)
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       shared.Name,
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	poolModels, err := m.db.ClusterPoolRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to list cluster pools for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	states, err := m.getClusterStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pools := make([]*interfaces.ClusterPool, 0, len(poolModels))
+	for _, poolModel := range poolModels {
+		pool, err := toClusterPool(poolModel, states)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	var token string
+	if len(poolModels) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(poolModels))
+	}
+	return &interfaces.ClusterPoolList{
+		ClusterPools: pools,
+		Token:        token,
+	}, nil
+}
+
+func (m *ClusterPoolManager) DeleteClusterPool(ctx context.Context, name string) error {
+	if err := validation.ValidateEmptyStringField(name, shared.Name); err != nil {
+		return err
+	}
+	return m.db.ClusterPoolRepo().Delete(ctx, name)
+}
+
+func (m *ClusterPoolManager) SetClusterHealth(
+	ctx context.Context, request interfaces.SetClusterHealthRequest) (*interfaces.ClusterHealth, error) {
+	if err := validation.ValidateSetClusterHealthRequest(request, m.getConfiguredClusters()); err != nil {
+		logger.Debugf(ctx, "invalid set cluster health request [%+v]: %v", request, err)
+		return nil, err
+	}
+	if err := m.db.ClusterHealthRepo().CreateOrUpdate(ctx, models.ClusterHealth{
+		Cluster:   request.Cluster,
+		State:     request.State,
+		Reason:    request.Reason,
+		Principal: getUser(ctx),
+	}); err != nil {
+		logger.Debugf(ctx, "failed to set the health of cluster [%s] with err: %v", request.Cluster, err)
+		return nil, err
+	}
+	healthModel, err := m.db.ClusterHealthRepo().Get(ctx, request.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Set the health of cluster [%s] to %s: %s", healthModel.Cluster, healthModel.State,
+		healthModel.Reason)
+	health := toClusterHealth(healthModel)
+	return &health, nil
+}
+
+func (m *ClusterPoolManager) ListClusters(ctx context.Context) (*interfaces.ClusterList, error) {
+	healthModels, err := m.db.ClusterHealthRepo().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	healths := make(map[string]models.ClusterHealth, len(healthModels))
+	for _, healthModel := range healthModels {
+		healths[healthModel.Cluster] = healthModel
+	}
+	clusterConfigs := m.config.ClusterConfiguration().GetClusterConfigs()
+	clusters := make([]*interfaces.Cluster, 0, len(clusterConfigs))
+	for _, clusterConfig := range clusterConfigs {
+		healthModel, ok := healths[clusterConfig.Name]
+		if !ok {
+			// Clusters without a health state are healthy.
+			healthModel = models.ClusterHealth{
+				Cluster: clusterConfig.Name,
+				State:   models.ClusterHealthy,
+			}
+		}
+		clusters = append(clusters, &interfaces.Cluster{
+			Name:    clusterConfig.Name,
+			Enabled: clusterConfig.Enabled,
+			Health:  toClusterHealth(healthModel),
+		})
+	}
+	return &interfaces.ClusterList{
+		Clusters: clusters,
+	}, nil
+}
+
+func NewClusterPoolManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.ClusterPoolInterface {
+	return &ClusterPoolManager{
+		db:     db,
+		config: config,
+	}
+}
@@ -0,0 +1,142 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+type testClusterConfiguration struct {
+	clusters []runtimeInterfaces.ClusterConfig
+}
+
+func (c testClusterConfiguration) GetClusterConfigs() []runtimeInterfaces.ClusterConfig {
+	return c.clusters
+}
+
+func (c testClusterConfiguration) GetLabelClusterMap() map[string][]runtimeInterfaces.ClusterEntity {
+	return nil
+}
+
+func (c testClusterConfiguration) GetHealthCheckConfig() runtimeInterfaces.ClusterHealthCheckConfig {
+	return runtimeInterfaces.ClusterHealthCheckConfig{}
+}
+
+func getClusterPoolManagerForTest(repository *repositoryMocks.MockRepository) interfaces.ClusterPoolInterface {
+	config := runtimeMocks.NewMockConfigurationProvider(testutils.GetApplicationConfigWithDefaultDomains(), nil,
+		testClusterConfiguration{
+			clusters: []runtimeInterfaces.ClusterConfig{
+				{Name: "us-east-1a", Enabled: true},
+				{Name: "us-east-1b", Enabled: true},
+			},
+		}, nil, nil, nil)
+	return NewClusterPoolManager(repository, config)
+}
+
+func getClusterPoolModel() models.ClusterPool {
+	return models.ClusterPool{
+		Name:      "us-east",
+		Labels:    []byte(`{"region": "us-east-1"}`),
+		Members:   []byte(`[{"cluster": "us-east-1a", "weight": 2}, {"cluster": "us-east-1b", "weight": 1}]`),
+		Principal: "user",
+	}
+}
+
+func TestSetClusterPool_Create(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	poolRepo := repository.ClusterPoolRepo().(*repositoryMocks.ClusterPoolRepoInterface)
+	poolRepo.OnGetMatch(mock.Anything, "us-east").Return(
+		models.ClusterPool{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")).Once()
+	poolRepo.OnGetMatch(mock.Anything, "us-east").Return(getClusterPoolModel(), nil)
+	var created models.ClusterPool
+	poolRepo.OnCreateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		created = args.Get(1).(models.ClusterPool)
+	})
+	repository.ClusterHealthRepo().(*repositoryMocks.ClusterHealthRepoInterface).OnListAllMatch(mock.Anything).Return(
+		[]models.ClusterHealth{{Cluster: "us-east-1b", State: models.ClusterDraining}}, nil)
+
+	pool, err := getClusterPoolManagerForTest(repository).SetClusterPool(context.Background(),
+		interfaces.SetClusterPoolRequest{
+			Name:   "us-east",
+			Labels: map[string]string{"region": "us-east-1"},
+			Members: []interfaces.ClusterPoolMember{
+				{Cluster: "us-east-1a", Weight: 2},
+				{Cluster: "us-east-1b", Weight: 1},
+			},
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, "us-east", created.Name)
+	assert.JSONEq(t, `{"region": "us-east-1"}`, string(created.Labels))
+	assert.JSONEq(t, `[{"cluster": "us-east-1a", "weight": 2}, {"cluster": "us-east-1b", "weight": 1}]`,
+		string(created.Members))
+	poolRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+	assert.Equal(t, map[string]string{"region": "us-east-1"}, pool.Labels)
+	assert.Equal(t, []interfaces.ClusterPoolMember{
+		{Cluster: "us-east-1a", Weight: 2, State: models.ClusterHealthy},
+		{Cluster: "us-east-1b", Weight: 1, State: models.ClusterDraining},
+	}, pool.Members)
+}
+
+func TestSetClusterPool_UnconfiguredCluster(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	_, err := getClusterPoolManagerForTest(repository).SetClusterPool(context.Background(),
+		interfaces.SetClusterPoolRequest{
+			Name: "eu-west",
+			Members: []interfaces.ClusterPoolMember{
+				{Cluster: "eu-west-1a", Weight: 1},
+			},
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListClusterPools(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.ClusterPoolRepo().(*repositoryMocks.ClusterPoolRepoInterface).OnListMatch(mock.Anything, mock.Anything).Return(
+		[]models.ClusterPool{getClusterPoolModel()}, nil)
+	repository.ClusterHealthRepo().(*repositoryMocks.ClusterHealthRepoInterface).OnListAllMatch(mock.Anything).Return(
+		[]models.ClusterHealth{}, nil)
+
+	pools, err := getClusterPoolManagerForTest(repository).ListClusterPools(context.Background(),
+		interfaces.ListClusterPoolsRequest{Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, pools.ClusterPools, 1)
+	assert.Equal(t, "us-east", pools.ClusterPools[0].Name)
+	assert.Equal(t, "1", pools.Token)
+}
+
+func TestSetClusterHealth(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	healthRepo := repository.ClusterHealthRepo().(*repositoryMocks.ClusterHealthRepoInterface)
+	var updated models.ClusterHealth
+	healthRepo.OnCreateOrUpdateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.ClusterHealth)
+	})
+	healthRepo.OnGetMatch(mock.Anything, "us-east-1b").Return(models.ClusterHealth{
+		Cluster: "us-east-1b",
+		State:   models.ClusterDraining,
+		Reason:  "node pool upgrade",
+	}, nil)
+
+	health, err := getClusterPoolManagerForTest(repository).SetClusterHealth(context.Background(),
+		interfaces.SetClusterHealthRequest{
+			Cluster: "us-east-1b",
+			State:   models.ClusterDraining,
+			Reason:  "node pool upgrade",
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, "us-east-1b", updated.Cluster)
+	assert.Equal(t, models.ClusterDraining, updated.State)
+	assert.Equal(t, models.ClusterDraining, health.State)
+	assert.Equal(t, "node pool upgrade", health.Reason)
+}
@@ -0,0 +1,105 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+type ClusterResourceSyncManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func toTemplateSyncStatus(syncModel models.ClusterResourceSync) interfaces.TemplateSyncStatus {
+	return interfaces.TemplateSyncStatus{
+		Template:       syncModel.Template,
+		ResourceKind:   syncModel.ResourceKind,
+		ResourceName:   syncModel.ResourceName,
+		AppliedVersion: syncModel.AppliedVersion,
+		AppliedAt:      syncModel.AppliedAt,
+		ApplyError:     syncModel.ApplyError,
+		ApplyErrorAt:   syncModel.ApplyErrorAt,
+		Drift:          syncModel.Drift,
+		DriftCheckedAt: syncModel.DriftCheckedAt,
+		RetiredAt:      syncModel.RetiredAt,
+		CleanedUpAt:    syncModel.CleanedUpAt,
+		Cleanup:        syncModel.Cleanup,
+	}
+}
+
+func (m *ClusterResourceSyncManager) GetClusterResourceSyncStatus(
+	ctx context.Context, request interfaces.ClusterResourceSyncStatusRequest) (
+	*interfaces.ClusterResourceSyncStatus, error) {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	// Archived projects and removed domains still have statuses, reporting the cleanup of their namespaces.
+	if _, err := m.db.ProjectRepo().Get(ctx, request.Project); err != nil {
+		return nil, err
+	}
+	// The controller syncs templates to the enabled clusters, so those are the clusters with a status.
+	clusters := make([]*interfaces.ClusterSyncStatus, 0)
+	clusterStatuses := make(map[string]*interfaces.ClusterSyncStatus)
+	for _, clusterConfig := range m.config.ClusterConfiguration().GetClusterConfigs() {
+		if !clusterConfig.Enabled || (len(request.Cluster) > 0 && clusterConfig.Name != request.Cluster) {
+			continue
+		}
+		clusterStatus := &interfaces.ClusterSyncStatus{
+			Cluster:   clusterConfig.Name,
+			Templates: make([]interfaces.TemplateSyncStatus, 0),
+			InSync:    true,
+		}
+		clusters = append(clusters, clusterStatus)
+		clusterStatuses[clusterConfig.Name] = clusterStatus
+	}
+	if len(request.Cluster) > 0 && len(clusters) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "cluster [%s] isn't enabled", request.Cluster)
+	}
+
+	syncModels, err := m.db.ClusterResourceSyncRepo().List(ctx, request.Project, request.Domain)
+	if err != nil {
+		logger.Debugf(ctx, "failed to list the cluster resource sync statuses of project [%s] and domain [%s] with err: %v",
+			request.Project, request.Domain, err)
+		return nil, err
+	}
+	for _, syncModel := range syncModels {
+		clusterStatus, ok := clusterStatuses[syncModel.Cluster]
+		if !ok {
+			// The status of a cluster which was since removed or disabled.
+			continue
+		}
+		clusterStatus.Namespace = syncModel.Namespace
+		templateStatus := toTemplateSyncStatus(syncModel)
+		clusterStatus.Templates = append(clusterStatus.Templates, templateStatus)
+		if len(templateStatus.ApplyError) > 0 || len(templateStatus.Drift) > 0 {
+			clusterStatus.InSync = false
+		}
+	}
+	return &interfaces.ClusterResourceSyncStatus{
+		Project:  request.Project,
+		Domain:   request.Domain,
+		Clusters: clusters,
+	}, nil
+}
+
+func NewClusterResourceSyncManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.ClusterResourceSyncInterface {
+	return &ClusterResourceSyncManager{
+		db:     db,
+		config: config,
+	}
+}
@@ -0,0 +1,138 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func getClusterResourceSyncManagerForTest(
+	repository *repositoryMocks.MockRepository) interfaces.ClusterResourceSyncInterface {
+	config := runtimeMocks.NewMockConfigurationProvider(testutils.GetApplicationConfigWithDefaultDomains(), nil,
+		testClusterConfiguration{
+			clusters: []runtimeInterfaces.ClusterConfig{
+				{Name: "us-east-1a", Enabled: true},
+				{Name: "us-east-1b", Enabled: true},
+				{Name: "us-east-1c"},
+			},
+		}, nil, nil, nil)
+	return NewClusterResourceSyncManager(repository, config)
+}
+
+func TestGetClusterResourceSyncStatus(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	appliedAt := time.Now()
+	repository.ClusterResourceSyncRepo().(*repositoryMocks.ClusterResourceSyncRepoInterface).OnList(
+		mock.Anything, "project", "development").Return([]models.ClusterResourceSync{
+		{
+			ClusterResourceSyncKey: models.ClusterResourceSyncKey{Cluster: "us-east-1a", Template: "quota.yaml"},
+			Namespace:              "project-development",
+			AppliedVersion:         "abc",
+			AppliedAt:              &appliedAt,
+		},
+		{
+			ClusterResourceSyncKey: models.ClusterResourceSyncKey{Cluster: "us-east-1b", Template: "quota.yaml"},
+			Namespace:              "project-development",
+			AppliedVersion:         "abc",
+			AppliedAt:              &appliedAt,
+			Drift:                  "fields differ from the template: spec.hard.limits.cpu",
+		},
+		{
+			ClusterResourceSyncKey: models.ClusterResourceSyncKey{Cluster: "us-east-1b", Template: "role.yaml"},
+			Namespace:              "project-development",
+			ApplyError:             "forbidden",
+		},
+		{
+			ClusterResourceSyncKey: models.ClusterResourceSyncKey{Cluster: "us-west-2a", Template: "quota.yaml"},
+			Namespace:              "project-development",
+		},
+	}, nil)
+
+	status, err := getClusterResourceSyncManagerForTest(repository).GetClusterResourceSyncStatus(
+		context.Background(), interfaces.ClusterResourceSyncStatusRequest{
+			Project: "project",
+			Domain:  "development",
+		})
+	assert.NoError(t, err)
+	assert.Len(t, status.Clusters, 2)
+	assert.Equal(t, "us-east-1a", status.Clusters[0].Cluster)
+	assert.Equal(t, "project-development", status.Clusters[0].Namespace)
+	assert.True(t, status.Clusters[0].InSync)
+	assert.Equal(t, []interfaces.TemplateSyncStatus{
+		{Template: "quota.yaml", AppliedVersion: "abc", AppliedAt: &appliedAt},
+	}, status.Clusters[0].Templates)
+	assert.Equal(t, "us-east-1b", status.Clusters[1].Cluster)
+	assert.False(t, status.Clusters[1].InSync)
+	assert.Len(t, status.Clusters[1].Templates, 2)
+	assert.Equal(t, "fields differ from the template: spec.hard.limits.cpu", status.Clusters[1].Templates[0].Drift)
+	assert.Equal(t, "forbidden", status.Clusters[1].Templates[1].ApplyError)
+}
+
+func TestGetClusterResourceSyncStatus_Cluster(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.ClusterResourceSyncRepo().(*repositoryMocks.ClusterResourceSyncRepoInterface).OnListMatch(
+		mock.Anything, mock.Anything, mock.Anything).Return([]models.ClusterResourceSync{}, nil)
+	manager := getClusterResourceSyncManagerForTest(repository)
+
+	status, err := manager.GetClusterResourceSyncStatus(context.Background(),
+		interfaces.ClusterResourceSyncStatusRequest{
+			Project: "project",
+			Domain:  "development",
+			Cluster: "us-east-1b",
+		})
+	assert.NoError(t, err)
+	assert.Len(t, status.Clusters, 1)
+	assert.Equal(t, "us-east-1b", status.Clusters[0].Cluster)
+	assert.Empty(t, status.Clusters[0].Templates)
+
+	// Templates aren't synced to disabled clusters.
+	_, err = manager.GetClusterResourceSyncStatus(context.Background(),
+		interfaces.ClusterResourceSyncStatusRequest{
+			Project: "project",
+			Domain:  "development",
+			Cluster: "us-east-1c",
+		})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetClusterResourceSyncStatus_ArchivedProject(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+		ctx context.Context, projectID string) (models.Project, error) {
+		archivedState := int32(admin.Project_ARCHIVED)
+		return models.Project{Identifier: projectID, State: &archivedState}, nil
+	}
+	cleanedUpAt := time.Now()
+	repository.ClusterResourceSyncRepo().(*repositoryMocks.ClusterResourceSyncRepoInterface).OnListMatch(
+		mock.Anything, mock.Anything, mock.Anything).Return([]models.ClusterResourceSync{
+		{
+			ClusterResourceSyncKey: models.ClusterResourceSyncKey{Cluster: "us-east-1a", Template: "namespace.yaml"},
+			ResourceKind:           "Namespace",
+			ResourceName:           "project-development",
+			RetiredAt:              &cleanedUpAt,
+			CleanedUpAt:            &cleanedUpAt,
+			Cleanup:                models.ClusterResourceCleanupDeleted,
+		},
+	}, nil)
+
+	status, err := getClusterResourceSyncManagerForTest(repository).GetClusterResourceSyncStatus(
+		context.Background(), interfaces.ClusterResourceSyncStatusRequest{
+			Project: "project",
+			Domain:  "development",
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, models.ClusterResourceCleanupDeleted, status.Clusters[0].Templates[0].Cleanup)
+	assert.Equal(t, "Namespace", status.Clusters[0].Templates[0].ResourceKind)
+}
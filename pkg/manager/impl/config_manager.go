@@ -0,0 +1,117 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/config"
+)
+
+const redactedConfigValue = "[redacted]"
+
+// Config values whose keys contain any of these words are secrets, unless their keys end with one of
+// notSecretConfigKeySuffixes.
+var secretConfigKeyWords = []string{
+	"password", "secret", "apikey", "privatekey", "credential", "token", "authorization",
+}
+
+// Keys of config values which locate secrets rather than hold them, such as passwordPath, or which configure how
+// they're used, such as accessTokenLifespan.
+var notSecretConfigKeySuffixes = []string{
+	"path", "file", "location", "envvar", "name", "prefix", "provider", "endpoint", "url", "lifespan",
+}
+
+func isSecretConfigKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range notSecretConfigKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return false
+		}
+	}
+	for _, word := range secretConfigKeyWords {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// Replaces the non-empty values of secret keys in the config values decoded from JSON.
+func redactConfigValues(values interface{}) interface{} {
+	switch typed := values.(type) {
+	case map[string]interface{}:
+		for key, value := range typed {
+			if isSecretConfigKey(key) && value != nil && value != "" {
+				typed[key] = redactedConfigValue
+			} else {
+				typed[key] = redactConfigValues(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range typed {
+			typed[i] = redactConfigValues(value)
+		}
+	}
+	return values
+}
+
+// Returns the redacted values of the config of a section, along with those of its subsections keyed by their keys.
+func getSectionValues(key string, section config.Section) (interface{}, error) {
+	var values interface{}
+	if section.GetConfig() != nil {
+		serialized, err := json.Marshal(section.GetConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize the config of section [%s]: %v", key, err)
+		}
+		if err := json.Unmarshal(serialized, &values); err != nil {
+			return nil, fmt.Errorf("failed to deserialize the config of section [%s]: %v", key, err)
+		}
+	}
+	if len(section.GetSections()) == 0 {
+		return redactConfigValues(values), nil
+	}
+	// Sections with subsections hold structs, whose fields are merged with their subsections.
+	merged, ok := values.(map[string]interface{})
+	if !ok {
+		merged = make(map[string]interface{})
+	}
+	for subsectionKey, subsection := range section.GetSections() {
+		subsectionValues, err := getSectionValues(key+"."+subsectionKey, subsection)
+		if err != nil {
+			return nil, err
+		}
+		merged[subsectionKey] = subsectionValues
+	}
+	return redactConfigValues(merged), nil
+}
+
+type ConfigManager struct {
+	configSection config.Section
+	reloader      runtimeInterfaces.ConfigReloader
+}
+
+func (m *ConfigManager) GetEffectiveConfig(ctx context.Context) (*interfaces.EffectiveConfig, error) {
+	sections := make(map[string]interface{}, len(m.configSection.GetSections()))
+	for key, section := range m.configSection.GetSections() {
+		values, err := getSectionValues(key, section)
+		if err != nil {
+			return nil, err
+		}
+		sections[key] = values
+	}
+	return &interfaces.EffectiveConfig{
+		ConfigVersion: m.reloader.GetVersion(),
+		Sections:      sections,
+	}, nil
+}
+
+func NewConfigManager(reloader runtimeInterfaces.ConfigReloader) interfaces.ConfigInterface {
+	return &ConfigManager{
+		configSection: config.GetRootSection(),
+		reloader:      reloader,
+	}
+}
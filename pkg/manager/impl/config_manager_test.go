@@ -0,0 +1,101 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type configTestDatabase struct {
+	Host         string `json:"host"`
+	Password     string `json:"password"`
+	PasswordPath string `json:"passwordPath"`
+}
+
+type configTestAuth struct {
+	ClientSecretName    string            `json:"clientSecretName"`
+	AccessTokenLifespan string            `json:"accessTokenLifespan"`
+	Headers             map[string]string `json:"headers"`
+}
+
+type configTestServer struct {
+	Port int `json:"port"`
+}
+
+func TestIsSecretConfigKey(t *testing.T) {
+	for _, key := range []string{"password", "clientSecret", "apiKey", "privateKey", "credentials", "token",
+		"Authorization"} {
+		assert.True(t, isSecretConfigKey(key), key)
+	}
+	for _, key := range []string{"host", "passwordPath", "clientSecretName", "apiKeyEnvVar", "tokenEndpoint",
+		"accessTokenLifespan", "secretIdPrefix", "secretsProvider"} {
+		assert.False(t, isSecretConfigKey(key), key)
+	}
+}
+
+func TestGetEffectiveConfig(t *testing.T) {
+	root := config.NewSection(nil, nil)
+	root.MustRegisterSection("database", &configTestDatabase{
+		Host:         "postgres",
+		Password:     "hunter2",
+		PasswordPath: "/etc/secrets/password",
+	})
+	server := root.MustRegisterSection("server", &configTestServer{Port: 8088})
+	server.MustRegisterSection("auth", &configTestAuth{
+		ClientSecretName:    "client_secret",
+		AccessTokenLifespan: "30m",
+		Headers:             map[string]string{"Authorization": "Bearer abc"},
+	})
+	version := runtimeInterfaces.ConfigVersion{
+		Version:        2,
+		Checksum:       "checksum",
+		LoadedAt:       time.Date(2021, time.November, 24, 12, 0, 0, 0, time.UTC),
+		PendingRestart: []string{"server"},
+	}
+	configManager := ConfigManager{
+		configSection: root,
+		reloader:      &runtimeMocks.MockConfigReloader{Version: version},
+	}
+
+	effectiveConfig, err := configManager.GetEffectiveConfig(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, version, effectiveConfig.ConfigVersion)
+	assert.Equal(t, map[string]interface{}{
+		"database": map[string]interface{}{
+			"host":         "postgres",
+			"password":     redactedConfigValue,
+			"passwordPath": "/etc/secrets/password",
+		},
+		"server": map[string]interface{}{
+			// JSON numbers are decoded as floats.
+			"port": float64(8088),
+			"auth": map[string]interface{}{
+				"clientSecretName":    "client_secret",
+				"accessTokenLifespan": "30m",
+				"headers": map[string]interface{}{
+					"Authorization": redactedConfigValue,
+				},
+			},
+		},
+	}, effectiveConfig.Sections)
+	// The config in use is left as it was.
+	assert.Equal(t, "hunter2", root.GetSection("database").GetConfig().(*configTestDatabase).Password)
+}
+
+func TestGetEffectiveConfig_EmptySecretsAreShown(t *testing.T) {
+	root := config.NewSection(nil, nil)
+	root.MustRegisterSection("database", &configTestDatabase{Host: "postgres"})
+	configManager := ConfigManager{
+		configSection: root,
+		reloader:      runtimeMocks.NewMockConfigReloader(),
+	}
+
+	effectiveConfig, err := configManager.GetEffectiveConfig(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "", effectiveConfig.Sections["database"].(map[string]interface{})["password"])
+}
@@ -0,0 +1,245 @@
+package impl
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	dataInterfaces "github.com/flyteorg/flyteadmin/pkg/data/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+// Decks are rendered next to the outputs of the task which produced them.
+const deckFileName = "deck.html"
+
+// Returns where the deck rendered alongside the outputs stored at outputURI is stored.
+func getDeckURI(outputURI string) string {
+	return outputURI[:strings.LastIndex(outputURI, "/")+1] + deckFileName
+}
+
+type DataProxyManager struct {
+	db      repositories.RepositoryInterface
+	config  runtimeInterfaces.Configuration
+	urlData dataInterfaces.RemoteURLInterface
+	_clock  clock.Clock
+}
+
+func (m *DataProxyManager) validateCreateDownloadLinkRequest(request interfaces.CreateDownloadLinkRequest) error {
+	if (request.ExecutionID == nil) == (request.NodeExecutionID == nil) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"exactly one of an execution id and a node execution id must be set")
+	}
+	switch request.ArtifactType {
+	case interfaces.ArtifactInputs, interfaces.ArtifactOutputs:
+	case interfaces.ArtifactDeck:
+		if request.NodeExecutionID == nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "only node executions have decks")
+		}
+	default:
+		return shared.GetInvalidArgumentError("artifact_type")
+	}
+	if request.ExecutionID != nil {
+		return validation.ValidateWorkflowExecutionIdentifier(request.ExecutionID)
+	}
+	return validation.ValidateNodeExecutionIdentifier(request.NodeExecutionID)
+}
+
+func (m *DataProxyManager) getExecutionArtifactURI(
+	ctx context.Context, id *core.WorkflowExecutionIdentifier, artifactType interfaces.ArtifactType) (string, error) {
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *id)
+	if err != nil {
+		return "", err
+	}
+	if artifactType == interfaces.ArtifactInputs {
+		return executionModel.InputsURI.String(), nil
+	}
+	execution, err := transformers.FromExecutionModel(*executionModel)
+	if err != nil {
+		return "", err
+	}
+	return util.ToExecutionClosureInterface(execution.Closure).GetOutputUri(), nil
+}
+
+func (m *DataProxyManager) getNodeExecutionArtifactURI(
+	ctx context.Context, id *core.NodeExecutionIdentifier, artifactType interfaces.ArtifactType) (string, error) {
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, id)
+	if err != nil {
+		return "", err
+	}
+	if artifactType == interfaces.ArtifactInputs {
+		return nodeExecutionModel.InputURI, nil
+	}
+	nodeExecution, err := transformers.FromNodeExecutionModel(*nodeExecutionModel)
+	if err != nil {
+		return "", err
+	}
+	outputURI := nodeExecution.Closure.GetOutputUri()
+	if artifactType == interfaces.ArtifactOutputs || len(outputURI) == 0 {
+		return outputURI, nil
+	}
+	return getDeckURI(outputURI), nil
+}
+
+func (m *DataProxyManager) CreateDownloadLink(
+	ctx context.Context, request interfaces.CreateDownloadLinkRequest) (*interfaces.CreateDownloadLinkResponse, error) {
+	if err := m.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := m.validateCreateDownloadLinkRequest(request); err != nil {
+		return nil, err
+	}
+	executionID := request.ExecutionID
+	if request.NodeExecutionID != nil {
+		executionID = request.NodeExecutionID.ExecutionId
+	}
+	ctx = getExecutionContext(ctx, executionID)
+	if err := checkProjectVisible(ctx, executionID.Project); err != nil {
+		return nil, err
+	}
+
+	var uri string
+	var err error
+	if request.NodeExecutionID != nil {
+		uri, err = m.getNodeExecutionArtifactURI(ctx, request.NodeExecutionID, request.ArtifactType)
+	} else {
+		uri, err = m.getExecutionArtifactURI(ctx, request.ExecutionID, request.ArtifactType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(uri) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"no %s artifact exists for the execution", request.ArtifactType)
+	}
+
+	urlBlob, err := m.urlData.Get(ctx, uri)
+	if err != nil {
+		logger.Debugf(ctx, "failed to sign a download link for [%s] with err: %v", uri, err)
+		return nil, err
+	}
+	dataProxyConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetDataProxyConfig()
+	if dataProxyConfig.MaxSizeBytes > 0 && urlBlob.Bytes > dataProxyConfig.MaxSizeBytes {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"the %s artifact is %d bytes, which exceeds the limit of %d bytes", request.ArtifactType,
+			urlBlob.Bytes, dataProxyConfig.MaxSizeBytes)
+	}
+	return &interfaces.CreateDownloadLinkResponse{
+		SignedURL: urlBlob.Url,
+		ExpiresAt: m.getExpiresAt(),
+		Bytes:     urlBlob.Bytes,
+	}, nil
+}
+
+func validateCreateUploadLocationRequest(request interfaces.CreateUploadLocationRequest) error {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := validation.ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	if err := validation.ValidateEmptyStringField(request.Filename, "filename"); err != nil {
+		return err
+	}
+	// Uploads are confined to the location of their project, domain and content.
+	if strings.Contains(request.Filename, "/") || request.Filename == "." || request.Filename == ".." {
+		return shared.GetInvalidArgumentError("filename")
+	}
+	if len(request.ContentMD5) != md5.Size {
+		return shared.GetInvalidArgumentError("content_md5")
+	}
+	return nil
+}
+
+func (m *DataProxyManager) CreateUploadLocation(ctx context.Context, request interfaces.CreateUploadLocationRequest) (
+	*interfaces.CreateUploadLocationResponse, error) {
+	if err := m.checkEnabled(); err != nil {
+		return nil, err
+	}
+	uploadPrefix := m.config.ApplicationConfiguration().GetTopLevelConfig().GetDataProxyConfig().UploadPrefix
+	if len(uploadPrefix) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "uploads through the data proxy aren't enabled")
+	}
+	if err := validateCreateUploadLocationRequest(request); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	if err := checkProjectVisible(ctx, request.Project); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateProjectAndDomain(
+		ctx, m.db, m.config.ApplicationConfiguration(), request.Project, request.Domain); err != nil {
+		return nil, err
+	}
+
+	nativeURL := strings.Join([]string{
+		strings.TrimSuffix(uploadPrefix, "/"),
+		request.Project,
+		request.Domain,
+		hex.EncodeToString(request.ContentMD5),
+		request.Filename,
+	}, "/")
+	signedURL, err := m.urlData.CreateUploadURL(ctx, nativeURL, request.ContentMD5)
+	if err != nil {
+		logger.Debugf(ctx, "failed to sign an upload url for [%s] with err: %v", nativeURL, err)
+		return nil, err
+	}
+	return &interfaces.CreateUploadLocationResponse{
+		SignedURL: signedURL,
+		NativeURL: nativeURL,
+		ExpiresAt: m.getExpiresAt(),
+	}, nil
+}
+
+func (m *DataProxyManager) checkEnabled() error {
+	if !m.config.ApplicationConfiguration().GetTopLevelConfig().GetDataProxyConfig().Enabled {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "the data proxy isn't enabled")
+	}
+	scheme := m.config.ApplicationConfiguration().GetRemoteDataConfig().Scheme
+	switch scheme {
+	case common.AWS, common.GCP, common.Azure, common.Local:
+		return nil
+	default:
+		// The noop remote data handler returns the unsigned location of artifacts.
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"urls can't be signed for the remote data scheme [%s]", scheme)
+	}
+}
+
+// Returns when the urls signed now expire.
+func (m *DataProxyManager) getExpiresAt() time.Time {
+	durationMinutes := m.config.ApplicationConfiguration().GetRemoteDataConfig().SignedURL.DurationMinutes
+	return m._clock.Now().Add(time.Minute * time.Duration(durationMinutes))
+}
+
+func checkProjectVisible(ctx context.Context, project string) error {
+	if visibleProjects, restricted := auth.VisibleProjectsFromContext(ctx); restricted && !visibleProjects.Has(project) {
+		return errors.NewFlyteAdminErrorf(codes.PermissionDenied, "project [%s] isn't visible to the caller", project)
+	}
+	return nil
+}
+
+func NewDataProxyManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	urlData dataInterfaces.RemoteURLInterface) interfaces.DataProxyInterface {
+	return &DataProxyManager{
+		db:      db,
+		config:  config,
+		urlData: urlData,
+		_clock:  clock.New(),
+	}
+}
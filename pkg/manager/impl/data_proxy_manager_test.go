@@ -0,0 +1,331 @@
+package impl
+
+import (
+	"context"
+	"crypto/md5"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/auth"
+	dataMocks "github.com/flyteorg/flyteadmin/pkg/data/mocks"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var dataProxyNow = time.Date(2021, time.November, 10, 12, 0, 0, 0, time.UTC)
+
+var dataProxyExecutionID = core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+var dataProxyNodeExecutionID = core.NodeExecutionIdentifier{
+	NodeId:      "node",
+	ExecutionId: &dataProxyExecutionID,
+}
+
+func newDataProxyManagerForTest(
+	repository repositories.RepositoryInterface, enabled bool, scheme string) *DataProxyManager {
+	mockConfig := getMockExecutionsConfigProvider()
+	mockApplicationConfig := mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider)
+	mockApplicationConfig.SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		DataProxy: runtimeInterfaces.DataProxyConfig{
+			Enabled:      enabled,
+			MaxSizeBytes: 1000,
+			UploadPrefix: "s3://bucket/uploads/",
+		},
+	})
+	mockApplicationConfig.SetRemoteDataConfig(runtimeInterfaces.RemoteDataConfig{
+		Scheme: scheme,
+		SignedURL: runtimeInterfaces.SignedURL{
+			DurationMinutes: 3,
+		},
+	})
+	mockRemoteURL := dataMocks.NewMockRemoteURL()
+	mockRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		bytes := int64(100)
+		if uri == "s3://bucket/large/outputs.pb" {
+			bytes = 1001
+		}
+		return admin.UrlBlob{
+			Url:   "https://signed/" + uri,
+			Bytes: bytes,
+		}, nil
+	}
+	mockRemoteURL.(*dataMocks.MockRemoteURL).CreateUploadURLCallback = func(
+		ctx context.Context, uri string, contentMD5 []byte) (string, error) {
+		return "https://signed/" + uri, nil
+	}
+	dataProxyManager := NewDataProxyManager(repository, mockConfig, mockRemoteURL).(*DataProxyManager)
+	mockClock := clock.NewMock()
+	mockClock.Set(dataProxyNow)
+	dataProxyManager._clock = mockClock
+	return dataProxyManager
+}
+
+func getDataProxyRepositoryForTest(t *testing.T, outputURI string) repositories.RepositoryInterface {
+	repository := repositoryMocks.NewMockRepository()
+	executionClosure, err := proto.Marshal(&admin.ExecutionClosure{
+		OutputResult: &admin.ExecutionClosure_Outputs{
+			Outputs: &admin.LiteralMapBlob{
+				Data: &admin.LiteralMapBlob_Uri{
+					Uri: outputURI,
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.Identifier) (models.Execution, error) {
+			assert.Equal(t, "name", input.Name)
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+				},
+				InputsURI: "s3://bucket/inputs.pb",
+				Closure:   executionClosure,
+			}, nil
+		})
+	nodeExecutionClosure, err := proto.Marshal(&admin.NodeExecutionClosure{
+		OutputResult: &admin.NodeExecutionClosure_OutputUri{
+			OutputUri: outputURI,
+		},
+	})
+	assert.NoError(t, err)
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.NodeExecutionResource) (models.NodeExecution, error) {
+			assert.Equal(t, "node", input.NodeExecutionIdentifier.NodeId)
+			return models.NodeExecution{
+				InputURI: "s3://bucket/node/inputs.pb",
+				Closure:  nodeExecutionClosure,
+			}, nil
+		})
+	return repository
+}
+
+func TestCreateDownloadLink(t *testing.T) {
+	repository := getDataProxyRepositoryForTest(t, "s3://bucket/node/0/outputs.pb")
+	dataProxyManager := newDataProxyManagerForTest(repository, true, "aws")
+	for _, tc := range []struct {
+		name        string
+		request     interfaces.CreateDownloadLinkRequest
+		expectedURL string
+	}{
+		{
+			name: "execution inputs",
+			request: interfaces.CreateDownloadLinkRequest{
+				ArtifactType: interfaces.ArtifactInputs,
+				ExecutionID:  &dataProxyExecutionID,
+			},
+			expectedURL: "https://signed/s3://bucket/inputs.pb",
+		},
+		{
+			name: "execution outputs",
+			request: interfaces.CreateDownloadLinkRequest{
+				ArtifactType: interfaces.ArtifactOutputs,
+				ExecutionID:  &dataProxyExecutionID,
+			},
+			expectedURL: "https://signed/s3://bucket/node/0/outputs.pb",
+		},
+		{
+			name: "node execution inputs",
+			request: interfaces.CreateDownloadLinkRequest{
+				ArtifactType:    interfaces.ArtifactInputs,
+				NodeExecutionID: &dataProxyNodeExecutionID,
+			},
+			expectedURL: "https://signed/s3://bucket/node/inputs.pb",
+		},
+		{
+			name: "node execution deck",
+			request: interfaces.CreateDownloadLinkRequest{
+				ArtifactType:    interfaces.ArtifactDeck,
+				NodeExecutionID: &dataProxyNodeExecutionID,
+			},
+			expectedURL: "https://signed/s3://bucket/node/0/deck.html",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := dataProxyManager.CreateDownloadLink(context.Background(), tc.request)
+			assert.NoError(t, err)
+			assert.Equal(t, &interfaces.CreateDownloadLinkResponse{
+				SignedURL: tc.expectedURL,
+				ExpiresAt: dataProxyNow.Add(3 * time.Minute),
+				Bytes:     100,
+			}, response)
+		})
+	}
+}
+
+func TestCreateDownloadLink_Disabled(t *testing.T) {
+	repository := getDataProxyRepositoryForTest(t, "s3://bucket/node/0/outputs.pb")
+	request := interfaces.CreateDownloadLinkRequest{
+		ArtifactType: interfaces.ArtifactInputs,
+		ExecutionID:  &dataProxyExecutionID,
+	}
+	_, err := newDataProxyManagerForTest(repository, false, "aws").CreateDownloadLink(
+		context.Background(), request)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	_, err = newDataProxyManagerForTest(repository, true, "none").CreateDownloadLink(
+		context.Background(), request)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateDownloadLink_InvalidRequest(t *testing.T) {
+	repository := getDataProxyRepositoryForTest(t, "s3://bucket/node/0/outputs.pb")
+	dataProxyManager := newDataProxyManagerForTest(repository, true, "aws")
+	for _, request := range []interfaces.CreateDownloadLinkRequest{
+		{
+			ArtifactType: interfaces.ArtifactInputs,
+		},
+		{
+			ArtifactType:    interfaces.ArtifactInputs,
+			ExecutionID:     &dataProxyExecutionID,
+			NodeExecutionID: &dataProxyNodeExecutionID,
+		},
+		{
+			ArtifactType: interfaces.ArtifactDeck,
+			ExecutionID:  &dataProxyExecutionID,
+		},
+		{
+			ArtifactType: "logs",
+			ExecutionID:  &dataProxyExecutionID,
+		},
+	} {
+		_, err := dataProxyManager.CreateDownloadLink(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
+func TestCreateDownloadLink_ProjectNotVisible(t *testing.T) {
+	repository := getDataProxyRepositoryForTest(t, "s3://bucket/node/0/outputs.pb")
+	dataProxyManager := newDataProxyManagerForTest(repository, true, "aws")
+	request := interfaces.CreateDownloadLinkRequest{
+		ArtifactType:    interfaces.ArtifactInputs,
+		NodeExecutionID: &dataProxyNodeExecutionID,
+	}
+	_, err := dataProxyManager.CreateDownloadLink(
+		auth.WithVisibleProjects(context.Background(), sets.NewString("other")), request)
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	_, err = dataProxyManager.CreateDownloadLink(
+		auth.WithVisibleProjects(context.Background(), sets.NewString("project")), request)
+	assert.NoError(t, err)
+}
+
+func TestCreateDownloadLink_NoOutputs(t *testing.T) {
+	repository := getDataProxyRepositoryForTest(t, "")
+	dataProxyManager := newDataProxyManagerForTest(repository, true, "aws")
+	_, err := dataProxyManager.CreateDownloadLink(context.Background(), interfaces.CreateDownloadLinkRequest{
+		ArtifactType:    interfaces.ArtifactDeck,
+		NodeExecutionID: &dataProxyNodeExecutionID,
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateDownloadLink_TooLarge(t *testing.T) {
+	repository := getDataProxyRepositoryForTest(t, "s3://bucket/large/outputs.pb")
+	dataProxyManager := newDataProxyManagerForTest(repository, true, "aws")
+	_, err := dataProxyManager.CreateDownloadLink(context.Background(), interfaces.CreateDownloadLinkRequest{
+		ArtifactType: interfaces.ArtifactOutputs,
+		ExecutionID:  &dataProxyExecutionID,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+var dataProxyContentMD5 = md5.Sum([]byte("content"))
+
+func TestCreateUploadLocation(t *testing.T) {
+	dataProxyManager := newDataProxyManagerForTest(repositoryMocks.NewMockRepository(), true, "aws")
+	response, err := dataProxyManager.CreateUploadLocation(context.Background(), interfaces.CreateUploadLocationRequest{
+		Project:    "project",
+		Domain:     "domain",
+		Filename:   "fast.tar.gz",
+		ContentMD5: dataProxyContentMD5[:],
+	})
+	assert.NoError(t, err)
+	nativeURL := "s3://bucket/uploads/project/domain/9a0364b9e99bb480dd25e1f0284c8555/fast.tar.gz"
+	assert.Equal(t, &interfaces.CreateUploadLocationResponse{
+		SignedURL: "https://signed/" + nativeURL,
+		NativeURL: nativeURL,
+		ExpiresAt: dataProxyNow.Add(3 * time.Minute),
+	}, response)
+}
+
+func TestCreateUploadLocation_NoUploadPrefix(t *testing.T) {
+	dataProxyManager := newDataProxyManagerForTest(repositoryMocks.NewMockRepository(), true, "aws")
+	dataProxyManager.config.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			DataProxy: runtimeInterfaces.DataProxyConfig{
+				Enabled: true,
+			},
+		})
+	_, err := dataProxyManager.CreateUploadLocation(context.Background(), interfaces.CreateUploadLocationRequest{
+		Project:    "project",
+		Domain:     "domain",
+		Filename:   "fast.tar.gz",
+		ContentMD5: dataProxyContentMD5[:],
+	})
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateUploadLocation_InvalidRequest(t *testing.T) {
+	dataProxyManager := newDataProxyManagerForTest(repositoryMocks.NewMockRepository(), true, "aws")
+	for _, request := range []interfaces.CreateUploadLocationRequest{
+		{
+			Domain:     "domain",
+			Filename:   "fast.tar.gz",
+			ContentMD5: dataProxyContentMD5[:],
+		},
+		{
+			Project:    "project",
+			Domain:     "domain",
+			Filename:   "../fast.tar.gz",
+			ContentMD5: dataProxyContentMD5[:],
+		},
+		{
+			Project:    "project",
+			Domain:     "domain",
+			Filename:   "fast.tar.gz",
+			ContentMD5: []byte("md5"),
+		},
+		{
+			Project:    "project",
+			Domain:     "unknown",
+			Filename:   "fast.tar.gz",
+			ContentMD5: dataProxyContentMD5[:],
+		},
+	} {
+		_, err := dataProxyManager.CreateUploadLocation(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
+func TestCreateUploadLocation_ProjectNotVisible(t *testing.T) {
+	dataProxyManager := newDataProxyManagerForTest(repositoryMocks.NewMockRepository(), true, "aws")
+	_, err := dataProxyManager.CreateUploadLocation(
+		auth.WithVisibleProjects(context.Background(), sets.NewString("other")),
+		interfaces.CreateUploadLocationRequest{
+			Project:    "project",
+			Domain:     "domain",
+			Filename:   "fast.tar.gz",
+			ContentMD5: dataProxyContentMD5[:],
+		})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
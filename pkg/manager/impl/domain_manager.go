@@ -0,0 +1,160 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+type DomainManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func toDomain(domainModel models.Domain) *interfaces.Domain {
+	return &interfaces.Domain{
+		ID:          domainModel.Identifier,
+		Name:        domainModel.Name,
+		Description: domainModel.Description,
+	}
+}
+
+func toDomainModel(domain interfaces.Domain) models.Domain {
+	return models.Domain{
+		Identifier:  domain.ID,
+		Name:        domain.Name,
+		Description: domain.Description,
+	}
+}
+
+func (m *DomainManager) CreateDomain(ctx context.Context, request interfaces.Domain) (*interfaces.Domain, error) {
+	if err := validation.ValidateDomain(request); err != nil {
+		logger.Debugf(ctx, "invalid create domain request [%+v]: %v", request, err)
+		return nil, err
+	}
+	// The configured domains are only used while none are stored, so they're seeded first rather than replaced by the
+	// created domain.
+	if err := m.SeedDomains(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.db.DomainRepo().Create(ctx, toDomainModel(request)); err != nil {
+		logger.Debugf(ctx, "failed to create domain [%s] with err: %v", request.ID, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "Created domain [%s]", request.ID)
+	return m.GetDomain(ctx, request.ID)
+}
+
+func (m *DomainManager) GetDomain(ctx context.Context, id string) (*interfaces.Domain, error) {
+	domains, err := m.ListDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, domain := range domains.Domains {
+		if domain.ID == id {
+			return domain, nil
+		}
+	}
+	return nil, errors.NewFlyteAdminErrorf(codes.NotFound, "domain [%s] not found", id)
+}
+
+func (m *DomainManager) ListDomains(ctx context.Context) (*interfaces.DomainList, error) {
+	domainModels, err := m.db.DomainRepo().List(ctx)
+	if err != nil {
+		logger.Debugf(ctx, "failed to list domains with err: %v", err)
+		return nil, err
+	}
+	if len(domainModels) == 0 {
+		for _, configDomain := range *m.config.ApplicationConfiguration().GetDomainsConfig() {
+			domainModels = append(domainModels, models.Domain{
+				Identifier: configDomain.ID,
+				Name:       configDomain.Name,
+			})
+		}
+	}
+	domains := make([]*interfaces.Domain, len(domainModels))
+	for i, domainModel := range domainModels {
+		domains[i] = toDomain(domainModel)
+	}
+	return &interfaces.DomainList{
+		Domains: domains,
+	}, nil
+}
+
+func (m *DomainManager) UpdateDomain(ctx context.Context, request interfaces.Domain) (*interfaces.Domain, error) {
+	if err := validation.ValidateDomain(request); err != nil {
+		logger.Debugf(ctx, "invalid update domain request [%+v]: %v", request, err)
+		return nil, err
+	}
+	if err := m.SeedDomains(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.db.DomainRepo().Update(ctx, toDomainModel(request)); err != nil {
+		logger.Debugf(ctx, "failed to update domain [%s] with err: %v", request.ID, err)
+		return nil, err
+	}
+	return m.GetDomain(ctx, request.ID)
+}
+
+func (m *DomainManager) DeleteDomain(ctx context.Context, id string) error {
+	if err := validation.ValidateEmptyStringField(id, "domain_id"); err != nil {
+		return err
+	}
+	if err := m.SeedDomains(ctx); err != nil {
+		return err
+	}
+	domainModels, err := m.db.DomainRepo().List(ctx)
+	if err != nil {
+		return err
+	}
+	// The configured domains would be used again once every stored domain is deleted.
+	if len(domainModels) == 1 && domainModels[0].Identifier == id {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "domain [%s] is the last domain", id)
+	}
+	if err := m.db.DomainRepo().Delete(ctx, id); err != nil {
+		logger.Debugf(ctx, "failed to delete domain [%s] with err: %v", id, err)
+		return err
+	}
+	logger.Infof(ctx, "Deleted domain [%s]", id)
+	return nil
+}
+
+func (m *DomainManager) SeedDomains(ctx context.Context) error {
+	domainModels, err := m.db.DomainRepo().List(ctx)
+	if err != nil {
+		return err
+	}
+	if len(domainModels) > 0 {
+		return nil
+	}
+	for _, configDomain := range *m.config.ApplicationConfiguration().GetDomainsConfig() {
+		err := m.db.DomainRepo().Create(ctx, models.Domain{
+			Identifier: configDomain.ID,
+			Name:       configDomain.Name,
+		})
+		// Another replica may be seeding the same domains.
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.AlreadyExists {
+			continue
+		}
+		if err != nil {
+			logger.Warningf(ctx, "failed to seed domain [%s] with err: %v", configDomain.ID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func NewDomainManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.DomainInterface {
+	return &DomainManager{
+		db:     db,
+		config: config,
+	}
+}
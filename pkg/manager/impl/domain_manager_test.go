@@ -0,0 +1,125 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func getDomainManagerForTest(repository *repositoryMocks.MockRepository) interfaces.DomainInterface {
+	config := runtimeMocks.NewMockConfigurationProvider(testutils.GetApplicationConfigWithDefaultDomains(), nil, nil,
+		nil, nil, nil)
+	return NewDomainManager(repository, config)
+}
+
+// Returns a mock repository whose domain repo stores domains in memory.
+func getDomainRepositoryForTest(stored ...models.Domain) *repositoryMocks.MockRepository {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	domainRepo := repository.DomainRepo().(*repositoryMocks.MockDomainRepo)
+	domainRepo.CreateFunction = func(ctx context.Context, domain models.Domain) error {
+		stored = append(stored, domain)
+		return nil
+	}
+	domainRepo.ListFunction = func(ctx context.Context) ([]models.Domain, error) {
+		return stored, nil
+	}
+	domainRepo.UpdateFunction = func(ctx context.Context, domain models.Domain) error {
+		for i := range stored {
+			if stored[i].Identifier == domain.Identifier {
+				stored[i] = domain
+				return nil
+			}
+		}
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "domain [%s] not found", domain.Identifier)
+	}
+	domainRepo.DeleteFunction = func(ctx context.Context, domainID string) error {
+		for i := range stored {
+			if stored[i].Identifier == domainID {
+				stored = append(stored[:i], stored[i+1:]...)
+				return nil
+			}
+		}
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "domain [%s] not found", domainID)
+	}
+	return repository
+}
+
+func TestListDomains_Configured(t *testing.T) {
+	domains, err := getDomainManagerForTest(getDomainRepositoryForTest()).ListDomains(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, domains.Domains, 4)
+	assert.Equal(t, "development", domains.Domains[0].ID)
+}
+
+func TestCreateDomain(t *testing.T) {
+	domainManager := getDomainManagerForTest(getDomainRepositoryForTest())
+	domain, err := domainManager.CreateDomain(context.Background(), interfaces.Domain{
+		ID:          "qa",
+		Name:        "QA",
+		Description: "for testing",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.Domain{ID: "qa", Name: "QA", Description: "for testing"}, domain)
+
+	// The configured domains are seeded before the first domain is created.
+	domains, err := domainManager.ListDomains(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, domains.Domains, 5)
+}
+
+func TestCreateDomain_Invalid(t *testing.T) {
+	repository := getDomainRepositoryForTest()
+	repository.DomainRepo().(*repositoryMocks.MockDomainRepo).CreateFunction = func(
+		ctx context.Context, domain models.Domain) error {
+		assert.Fail(t, "invalid domains shouldn't be saved")
+		return nil
+	}
+
+	_, err := getDomainManagerForTest(repository).CreateDomain(context.Background(), interfaces.Domain{ID: "qa"})
+	assert.EqualError(t, err, "missing domain_name")
+}
+
+func TestUpdateDomain(t *testing.T) {
+	domainManager := getDomainManagerForTest(getDomainRepositoryForTest(
+		models.Domain{Identifier: "development", Name: "development"}))
+	domain, err := domainManager.UpdateDomain(context.Background(), interfaces.Domain{
+		ID:   "development",
+		Name: "Development",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Development", domain.Name)
+}
+
+func TestGetDomain_NotFound(t *testing.T) {
+	_, err := getDomainManagerForTest(getDomainRepositoryForTest()).GetDomain(context.Background(), "qa")
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteDomain(t *testing.T) {
+	domainManager := getDomainManagerForTest(getDomainRepositoryForTest(
+		models.Domain{Identifier: "development", Name: "development"},
+		models.Domain{Identifier: "production", Name: "production"}))
+	assert.NoError(t, domainManager.DeleteDomain(context.Background(), "development"))
+
+	err := domainManager.DeleteDomain(context.Background(), "production")
+	assert.EqualError(t, err, "domain [production] is the last domain")
+}
+
+func TestSeedDomains_AlreadySeeded(t *testing.T) {
+	repository := getDomainRepositoryForTest(models.Domain{Identifier: "development", Name: "development"})
+	repository.DomainRepo().(*repositoryMocks.MockDomainRepo).CreateFunction = func(
+		ctx context.Context, domain models.Domain) error {
+		assert.Fail(t, "domains shouldn't be seeded once stored")
+		return nil
+	}
+
+	assert.NoError(t, getDomainManagerForTest(repository).SeedDomains(context.Background()))
+}
@@ -0,0 +1,51 @@
+package impl
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func getRecordedEventModel(eventType string, executionID *core.WorkflowExecutionIdentifier, requestID, producerID,
+	phase string, occurredAt *timestamp.Timestamp) models.RecordedEvent {
+	recordedEvent := models.RecordedEvent{
+		ExecutionProject: executionID.Project,
+		ExecutionDomain:  executionID.Domain,
+		ExecutionName:    executionID.Name,
+		EventType:        eventType,
+		RequestID:        requestID,
+		ProducerID:       producerID,
+		Phase:            phase,
+	}
+	// Node execution events aren't required to set when they occurred.
+	if occurredAtTime, err := ptypes.Timestamp(occurredAt); err == nil {
+		recordedEvent.OccurredAt = occurredAtTime
+	}
+	return recordedEvent
+}
+
+func getRecordedWorkflowEventModel(request admin.WorkflowExecutionEventRequest) models.RecordedEvent {
+	return getRecordedEventModel(interfaces.WorkflowEventType, request.Event.ExecutionId, request.RequestId,
+		request.Event.ProducerId, request.Event.Phase.String(), request.Event.OccurredAt)
+}
+
+func getRecordedNodeEventModel(request admin.NodeExecutionEventRequest) models.RecordedEvent {
+	recordedEvent := getRecordedEventModel(interfaces.NodeEventType, request.Event.Id.ExecutionId, request.RequestId,
+		request.Event.ProducerId, request.Event.Phase.String(), request.Event.OccurredAt)
+	recordedEvent.NodeID = request.Event.Id.NodeId
+	return recordedEvent
+}
+
+func getRecordedTaskEventModel(request admin.TaskExecutionEventRequest) models.RecordedEvent {
+	recordedEvent := getRecordedEventModel(interfaces.TaskEventType,
+		request.Event.ParentNodeExecutionId.ExecutionId, request.RequestId, request.Event.ProducerId,
+		request.Event.Phase.String(), request.Event.OccurredAt)
+	recordedEvent.NodeID = request.Event.ParentNodeExecutionId.NodeId
+	retryAttempt := request.Event.RetryAttempt
+	recordedEvent.RetryAttempt = &retryAttempt
+	recordedEvent.PhaseVersion = request.Event.PhaseVersion
+	return recordedEvent
+}
@@ -0,0 +1,318 @@
+package impl
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+// The number of skipped events read at a time when building an execution's event consistency report.
+const skippedEventsListBatchSize = 500
+
+type eventMetrics struct {
+	Scope          promutils.Scope
+	BatchSize      prometheus.Summary
+	EventsAccepted prometheus.Counter
+	EventsRejected prometheus.Counter
+}
+
+// Records batches of events through the node and task execution managers, so that events are handled exactly as
+// they are when sent one at a time.
+type EventManager struct {
+	db                   repositories.RepositoryInterface
+	config               runtimeInterfaces.Configuration
+	nodeExecutionManager interfaces.NodeExecutionInterface
+	taskExecutionManager interfaces.TaskExecutionInterface
+	metrics              eventMetrics
+}
+
+func (m *EventManager) validateEvent(event interfaces.Event) error {
+	maxSizeInBytes := m.config.ApplicationConfiguration().GetRemoteDataConfig().MaxSizeInBytes
+	switch {
+	case event.NodeEvent != nil && event.TaskEvent != nil:
+		return errors.NewFlyteAdminError(codes.InvalidArgument, "only one of node_event or task_event may be set")
+	case event.NodeEvent != nil:
+		return validation.ValidateNodeExecutionEventRequest(event.NodeEvent, maxSizeInBytes)
+	case event.TaskEvent != nil:
+		return validation.ValidateTaskExecutionRequest(*event.TaskEvent, maxSizeInBytes)
+	default:
+		return shared.GetMissingArgumentError(shared.Event)
+	}
+}
+
+func (m *EventManager) createEvent(ctx context.Context, event interfaces.Event) error {
+	if event.NodeEvent != nil {
+		_, err := m.nodeExecutionManager.CreateNodeEvent(ctx, *event.NodeEvent)
+		return err
+	}
+	_, err := m.taskExecutionManager.CreateTaskExecutionEvent(ctx, *event.TaskEvent)
+	return err
+}
+
+func (m *EventManager) CreateEvents(
+	ctx context.Context, request interfaces.EventBatchRequest) (*interfaces.EventBatchResponse, error) {
+	if len(request.Events) == 0 {
+		return nil, shared.GetMissingArgumentError("events")
+	}
+	if len(request.Events) > interfaces.MaxEventBatchSize {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"batch of %d events exceeds the limit of %d", len(request.Events), interfaces.MaxEventBatchSize)
+	}
+	m.metrics.BatchSize.Observe(float64(len(request.Events)))
+
+	// Every event is validated before any is recorded, so a malformed event is reported without side effects.
+	response := &interfaces.EventBatchResponse{}
+	invalid := make(map[int]bool)
+	for idx, event := range request.Events {
+		if err := m.validateEvent(event); err != nil {
+			response.Errors = append(response.Errors, interfaces.EventError{Index: idx, Err: err})
+			invalid[idx] = true
+		}
+	}
+	// Events are recorded in the order they were sent, since an execution's later events depend on its earlier ones.
+	for idx, event := range request.Events {
+		if invalid[idx] {
+			continue
+		}
+		if err := m.createEvent(ctx, event); err != nil {
+			logger.Debugf(ctx, "Failed to record event %d of batch with err: %v", idx, err)
+			response.Errors = append(response.Errors, interfaces.EventError{Index: idx, Err: err})
+			continue
+		}
+		response.Accepted++
+	}
+	sort.Slice(response.Errors, func(i, j int) bool {
+		return response.Errors[i].Index < response.Errors[j].Index
+	})
+	m.metrics.EventsAccepted.Add(float64(response.Accepted))
+	m.metrics.EventsRejected.Add(float64(len(response.Errors)))
+	return response, nil
+}
+
+// Lists every skipped event of an execution in the order they were received.
+func (m *EventManager) listAllSkippedEvents(
+	ctx context.Context, filters []common.InlineFilter) ([]models.SkippedEvent, error) {
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       "id",
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var skippedEvents []models.SkippedEvent
+	for {
+		output, err := m.db.SkippedEventRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         skippedEventsListBatchSize,
+			Offset:        len(skippedEvents),
+			InlineFilters: filters,
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		skippedEvents = append(skippedEvents, output...)
+		if len(output) < skippedEventsListBatchSize {
+			return skippedEvents, nil
+		}
+	}
+}
+
+func (m *EventManager) GetEventConsistencyReport(
+	ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.EventConsistencyReport, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(&id); err != nil {
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, &id)
+	if _, err := util.GetExecutionModel(ctx, m.db, id); err != nil {
+		return nil, err
+	}
+	var filters []common.InlineFilter
+	for _, field := range []struct{ name, value string }{
+		{"execution_project", id.Project},
+		{"execution_domain", id.Domain},
+		{"execution_name", id.Name},
+	} {
+		filter, err := common.NewSingleValueFilter(common.SkippedEvent, common.Equal, field.name, field.value)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	skippedEventModels, err := m.listAllSkippedEvents(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	report := &interfaces.EventConsistencyReport{
+		Id:              &id,
+		SkippedEvents:   make([]interfaces.SkippedEvent, len(skippedEventModels)),
+		SkippedByReason: make(map[string]int),
+	}
+	for idx, skippedEventModel := range skippedEventModels {
+		report.SkippedEvents[idx] = interfaces.SkippedEvent{
+			EventType:    skippedEventModel.EventType,
+			NodeId:       skippedEventModel.NodeID,
+			RetryAttempt: skippedEventModel.RetryAttempt,
+			RequestId:    skippedEventModel.RequestID,
+			Phase:        skippedEventModel.Phase,
+			PhaseVersion: skippedEventModel.PhaseVersion,
+			OccurredAt:   skippedEventModel.OccurredAt,
+			CurrentPhase: skippedEventModel.CurrentPhase,
+			Reason:       skippedEventModel.Reason,
+			SkippedAt:    skippedEventModel.CreatedAt,
+		}
+		report.SkippedByReason[skippedEventModel.Reason]++
+	}
+	return report, nil
+}
+
+func validateExecutionEventListRequest(request interfaces.ExecutionEventListRequest) error {
+	if err := validation.ValidateWorkflowExecutionIdentifier(request.Id); err != nil {
+		return err
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return err
+	}
+	switch request.EventType {
+	case "", interfaces.WorkflowEventType, interfaces.NodeEventType, interfaces.TaskEventType:
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unknown event type [%s]", request.EventType)
+	}
+	if !request.OccurredAfter.IsZero() && !request.OccurredBefore.IsZero() &&
+		!request.OccurredBefore.After(request.OccurredAfter) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"occurred before [%v] must be after occurred after [%v]", request.OccurredBefore, request.OccurredAfter)
+	}
+	return nil
+}
+
+func getExecutionEventListFilters(request interfaces.ExecutionEventListRequest) ([]common.InlineFilter, error) {
+	type eventListFilter struct {
+		expression common.FilterExpression
+		field      string
+		value      interface{}
+	}
+	eventListFilters := []eventListFilter{
+		{common.Equal, "execution_project", request.Id.Project},
+		{common.Equal, "execution_domain", request.Id.Domain},
+		{common.Equal, "execution_name", request.Id.Name},
+	}
+	for _, optional := range []struct{ field, value string }{
+		{"event_type", request.EventType},
+		{"node_id", request.NodeId},
+		{"phase", request.Phase},
+	} {
+		if len(optional.value) > 0 {
+			eventListFilters = append(eventListFilters, eventListFilter{common.Equal, optional.field, optional.value})
+		}
+	}
+	if !request.OccurredAfter.IsZero() {
+		eventListFilters = append(eventListFilters,
+			eventListFilter{common.GreaterThanOrEqual, "occurred_at", request.OccurredAfter})
+	}
+	if !request.OccurredBefore.IsZero() {
+		eventListFilters = append(eventListFilters, eventListFilter{common.LessThan, "occurred_at", request.OccurredBefore})
+	}
+	filters := make([]common.InlineFilter, len(eventListFilters))
+	for idx, eventListFilter := range eventListFilters {
+		filter, err := common.NewSingleValueFilter(
+			common.RecordedEvent, eventListFilter.expression, eventListFilter.field, eventListFilter.value)
+		if err != nil {
+			return nil, err
+		}
+		filters[idx] = filter
+	}
+	return filters, nil
+}
+
+func (m *EventManager) ListExecutionEvents(
+	ctx context.Context, request interfaces.ExecutionEventListRequest) (*interfaces.ExecutionEventList, error) {
+	if err := validateExecutionEventListRequest(request); err != nil {
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.Id)
+	if _, err := util.GetExecutionModel(ctx, m.db, *request.Id); err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListExecutionEvents", request.Token)
+	}
+	filters, err := getExecutionEventListFilters(request)
+	if err != nil {
+		return nil, err
+	}
+	// Events are listed in the order they were received.
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       "id",
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	recordedEventModels, err := m.db.RecordedEventRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	eventList := &interfaces.ExecutionEventList{
+		Events: make([]interfaces.RecordedEvent, len(recordedEventModels)),
+	}
+	for idx, recordedEventModel := range recordedEventModels {
+		eventList.Events[idx] = interfaces.RecordedEvent{
+			EventType:    recordedEventModel.EventType,
+			NodeId:       recordedEventModel.NodeID,
+			RetryAttempt: recordedEventModel.RetryAttempt,
+			RequestId:    recordedEventModel.RequestID,
+			ProducerId:   recordedEventModel.ProducerID,
+			Phase:        recordedEventModel.Phase,
+			PhaseVersion: recordedEventModel.PhaseVersion,
+			OccurredAt:   recordedEventModel.OccurredAt,
+			ReceivedAt:   recordedEventModel.CreatedAt,
+		}
+	}
+	if len(recordedEventModels) == int(request.Limit) {
+		eventList.Token = strconv.Itoa(offset + len(recordedEventModels))
+	}
+	return eventList, nil
+}
+
+func NewEventManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration, nodeExecutionManager interfaces.NodeExecutionInterface,
+	taskExecutionManager interfaces.TaskExecutionInterface, scope promutils.Scope) interfaces.EventInterface {
+	return &EventManager{
+		db:                   db,
+		config:               config,
+		nodeExecutionManager: nodeExecutionManager,
+		taskExecutionManager: taskExecutionManager,
+		metrics: eventMetrics{
+			Scope: scope,
+			BatchSize: scope.MustNewSummary("batch_size",
+				"number of events in each batch received"),
+			EventsAccepted: scope.MustNewCounter("events_accepted",
+				"overall count of batched events successfully recorded"),
+			EventsRejected: scope.MustNewCounter("events_rejected",
+				"overall count of batched events which were invalid or failed to be recorded"),
+		},
+	}
+}
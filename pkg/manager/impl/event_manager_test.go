@@ -0,0 +1,417 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+var batchedNodeExecutionID = &core.NodeExecutionIdentifier{
+	NodeId: "node",
+	ExecutionId: &core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	},
+}
+
+func getBatchedNodeEvent(requestID string) interfaces.Event {
+	return interfaces.Event{
+		NodeEvent: &admin.NodeExecutionEventRequest{
+			RequestId: requestID,
+			Event: &event.NodeExecutionEvent{
+				Id:         batchedNodeExecutionID,
+				Phase:      core.NodeExecution_RUNNING,
+				OccurredAt: ptypes.TimestampNow(),
+			},
+		},
+	}
+}
+
+func getBatchedTaskEvent(requestID string) interfaces.Event {
+	return interfaces.Event{
+		TaskEvent: &admin.TaskExecutionEventRequest{
+			RequestId: requestID,
+			Event: &event.TaskExecutionEvent{
+				TaskId: &core.Identifier{
+					ResourceType: core.ResourceType_TASK,
+					Project:      "project",
+					Domain:       "domain",
+					Name:         "task",
+					Version:      "version",
+				},
+				ParentNodeExecutionId: batchedNodeExecutionID,
+				Phase:                 core.TaskExecution_RUNNING,
+				OccurredAt:            ptypes.TimestampNow(),
+			},
+		},
+	}
+}
+
+// Returns an event manager which records the request ids of the events it's asked to create, failing those listed.
+func getEventManagerForTest(recorded *[]string, failing ...string) interfaces.EventInterface {
+	recordOrFail := func(requestID string) error {
+		for _, failingID := range failing {
+			if requestID == failingID {
+				return flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "duplicate event")
+			}
+		}
+		*recorded = append(*recorded, requestID)
+		return nil
+	}
+	nodeExecutionManager := mocks.MockNodeExecutionManager{}
+	nodeExecutionManager.SetCreateNodeEventCallback(func(ctx context.Context, request admin.NodeExecutionEventRequest) (
+		*admin.NodeExecutionEventResponse, error) {
+		if err := recordOrFail(request.RequestId); err != nil {
+			return nil, err
+		}
+		return &admin.NodeExecutionEventResponse{}, nil
+	})
+	taskExecutionManager := mocks.MockTaskExecutionManager{}
+	taskExecutionManager.SetCreateTaskEventCallback(func(ctx context.Context, request admin.TaskExecutionEventRequest) (
+		*admin.TaskExecutionEventResponse, error) {
+		if err := recordOrFail(request.RequestId); err != nil {
+			return nil, err
+		}
+		return &admin.TaskExecutionEventResponse{}, nil
+	})
+	return NewEventManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), &nodeExecutionManager, &taskExecutionManager,
+		mockScope.NewTestScope())
+}
+
+func TestCreateEvents(t *testing.T) {
+	var recorded []string
+	eventManager := getEventManagerForTest(&recorded)
+	response, err := eventManager.CreateEvents(context.Background(), interfaces.EventBatchRequest{
+		Events: []interfaces.Event{
+			getBatchedNodeEvent("a"),
+			getBatchedTaskEvent("b"),
+			getBatchedNodeEvent("c"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.EventBatchResponse{Accepted: 3}, response)
+	assert.Equal(t, []string{"a", "b", "c"}, recorded)
+}
+
+func TestCreateEvents_PartialFailure(t *testing.T) {
+	var recorded []string
+	eventManager := getEventManagerForTest(&recorded, "b")
+	invalid := getBatchedTaskEvent("invalid")
+	invalid.TaskEvent.Event.OccurredAt = nil
+	response, err := eventManager.CreateEvents(context.Background(), interfaces.EventBatchRequest{
+		Events: []interfaces.Event{
+			getBatchedNodeEvent("a"),
+			getBatchedTaskEvent("b"),
+			invalid,
+			{},
+			getBatchedNodeEvent("c"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, response.Accepted)
+	assert.Equal(t, []string{"a", "c"}, recorded)
+	assert.Len(t, response.Errors, 3)
+	for idx, expected := range []struct {
+		index int
+		code  codes.Code
+	}{
+		{1, codes.AlreadyExists},
+		{2, codes.InvalidArgument},
+		{3, codes.InvalidArgument},
+	} {
+		assert.Equal(t, expected.index, response.Errors[idx].Index)
+		assert.Equal(t, expected.code, response.Errors[idx].Err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
+func TestCreateEvents_BothEventsSet(t *testing.T) {
+	var recorded []string
+	eventManager := getEventManagerForTest(&recorded)
+	event := getBatchedNodeEvent("a")
+	event.TaskEvent = getBatchedTaskEvent("a").TaskEvent
+	response, err := eventManager.CreateEvents(context.Background(), interfaces.EventBatchRequest{
+		Events: []interfaces.Event{event},
+	})
+	assert.NoError(t, err)
+	assert.Zero(t, response.Accepted)
+	assert.Len(t, response.Errors, 1)
+	assert.Empty(t, recorded)
+}
+
+func TestCreateEvents_InvalidBatch(t *testing.T) {
+	var recorded []string
+	eventManager := getEventManagerForTest(&recorded)
+	_, err := eventManager.CreateEvents(context.Background(), interfaces.EventBatchRequest{})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	events := make([]interfaces.Event, interfaces.MaxEventBatchSize+1)
+	for idx := range events {
+		events[idx] = getBatchedNodeEvent("a")
+	}
+	_, err = eventManager.CreateEvents(context.Background(), interfaces.EventBatchRequest{Events: events})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Empty(t, recorded)
+}
+
+func TestGetEventConsistencyReport(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	skippedAt := time.Date(2021, time.October, 1, 12, 0, 0, 0, time.UTC)
+	retryAttempt := uint32(1)
+	repository.SkippedEventRepo().(*repositoryMocks.SkippedEventRepoInterface).OnListMatch(
+		mock.Anything, mock.MatchedBy(func(input repositoryInterfaces.ListResourceInput) bool {
+			queries := make(map[string]interface{}, len(input.InlineFilters))
+			for _, filter := range input.InlineFilters {
+				assert.Equal(t, common.SkippedEvent, filter.GetEntity())
+				expr, err := filter.GetGormQueryExpr()
+				assert.NoError(t, err)
+				queries[expr.Query] = expr.Args
+			}
+			assert.Equal(t, map[string]interface{}{
+				"execution_project = ?": "project",
+				"execution_domain = ?":  "domain",
+				"execution_name = ?":    "name",
+			}, queries)
+			assert.Equal(t, "id asc", input.SortParameter.GetGormOrderExpr())
+			return input.Offset == 0
+		})).Return([]models.SkippedEvent{
+		{
+			CreatedAt:    skippedAt,
+			EventType:    interfaces.NodeEventType,
+			NodeID:       "node",
+			RequestID:    "a",
+			Phase:        core.NodeExecution_RUNNING.String(),
+			CurrentPhase: core.NodeExecution_RUNNING.String(),
+			Reason:       interfaces.SkipReasonDuplicate,
+		},
+		{
+			CreatedAt:    skippedAt,
+			EventType:    interfaces.TaskEventType,
+			NodeID:       "node",
+			RetryAttempt: &retryAttempt,
+			RequestID:    "b",
+			Phase:        core.TaskExecution_QUEUED.String(),
+			CurrentPhase: core.TaskExecution_RUNNING.String(),
+			Reason:       interfaces.SkipReasonStale,
+		},
+		{
+			CreatedAt:    skippedAt,
+			EventType:    interfaces.TaskEventType,
+			NodeID:       "node",
+			RetryAttempt: &retryAttempt,
+			RequestID:    "c",
+			Phase:        core.TaskExecution_RUNNING.String(),
+			CurrentPhase: core.TaskExecution_RUNNING.String(),
+			Reason:       interfaces.SkipReasonDuplicate,
+		},
+	}, nil)
+
+	eventManager := NewEventManager(repository, getMockExecutionsConfigProvider(), &mocks.MockNodeExecutionManager{},
+		&mocks.MockTaskExecutionManager{}, mockScope.NewTestScope())
+	report, err := eventManager.GetEventConsistencyReport(context.Background(), usageExecutionID)
+	assert.NoError(t, err)
+	assert.Equal(t, &usageExecutionID, report.Id)
+	assert.Equal(t, map[string]int{
+		interfaces.SkipReasonDuplicate: 2,
+		interfaces.SkipReasonStale:     1,
+	}, report.SkippedByReason)
+	assert.Len(t, report.SkippedEvents, 3)
+	assert.Equal(t, interfaces.SkippedEvent{
+		EventType:    interfaces.TaskEventType,
+		NodeId:       "node",
+		RetryAttempt: &retryAttempt,
+		RequestId:    "b",
+		Phase:        core.TaskExecution_QUEUED.String(),
+		CurrentPhase: core.TaskExecution_RUNNING.String(),
+		Reason:       interfaces.SkipReasonStale,
+		SkippedAt:    skippedAt,
+	}, report.SkippedEvents[1])
+}
+
+func TestGetEventConsistencyReport_MissingExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.Identifier) (models.Execution, error) {
+			return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		})
+	eventManager := NewEventManager(repository, getMockExecutionsConfigProvider(), &mocks.MockNodeExecutionManager{},
+		&mocks.MockTaskExecutionManager{}, mockScope.NewTestScope())
+	_, err := eventManager.GetEventConsistencyReport(context.Background(), usageExecutionID)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListExecutionEvents(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	occurredAt := time.Date(2021, time.October, 1, 12, 0, 0, 0, time.UTC)
+	receivedAt := occurredAt.Add(time.Second)
+	retryAttempt := uint32(0)
+	repository.RecordedEventRepo().(*repositoryMocks.RecordedEventRepoInterface).OnListMatch(
+		mock.Anything, mock.MatchedBy(func(input repositoryInterfaces.ListResourceInput) bool {
+			queries := make(map[string]interface{}, len(input.InlineFilters))
+			for _, filter := range input.InlineFilters {
+				assert.Equal(t, common.RecordedEvent, filter.GetEntity())
+				expr, err := filter.GetGormQueryExpr()
+				assert.NoError(t, err)
+				queries[expr.Query] = expr.Args
+			}
+			assert.Equal(t, map[string]interface{}{
+				"execution_project = ?": "project",
+				"execution_domain = ?":  "domain",
+				"execution_name = ?":    "name",
+				"event_type = ?":        interfaces.TaskEventType,
+				"occurred_at >= ?":      occurredAt,
+			}, queries)
+			assert.Equal(t, "id asc", input.SortParameter.GetGormOrderExpr())
+			return input.Limit == 2 && input.Offset == 2
+		})).Return([]models.RecordedEvent{
+		{
+			CreatedAt:    receivedAt,
+			EventType:    interfaces.TaskEventType,
+			NodeID:       "node",
+			RetryAttempt: &retryAttempt,
+			RequestID:    "a",
+			ProducerID:   "propeller",
+			Phase:        core.TaskExecution_RUNNING.String(),
+			OccurredAt:   occurredAt,
+		},
+		{
+			CreatedAt:    receivedAt,
+			EventType:    interfaces.TaskEventType,
+			NodeID:       "node",
+			RetryAttempt: &retryAttempt,
+			RequestID:    "b",
+			ProducerID:   "propeller",
+			Phase:        core.TaskExecution_RUNNING.String(),
+			PhaseVersion: 1,
+			OccurredAt:   occurredAt,
+		},
+	}, nil)
+
+	eventManager := NewEventManager(repository, getMockExecutionsConfigProvider(), &mocks.MockNodeExecutionManager{},
+		&mocks.MockTaskExecutionManager{}, mockScope.NewTestScope())
+	eventList, err := eventManager.ListExecutionEvents(context.Background(), interfaces.ExecutionEventListRequest{
+		Id:            &usageExecutionID,
+		Limit:         2,
+		Token:         "2",
+		EventType:     interfaces.TaskEventType,
+		OccurredAfter: occurredAt,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ExecutionEventList{
+		Events: []interfaces.RecordedEvent{
+			{
+				EventType:    interfaces.TaskEventType,
+				NodeId:       "node",
+				RetryAttempt: &retryAttempt,
+				RequestId:    "a",
+				ProducerId:   "propeller",
+				Phase:        core.TaskExecution_RUNNING.String(),
+				OccurredAt:   occurredAt,
+				ReceivedAt:   receivedAt,
+			},
+			{
+				EventType:    interfaces.TaskEventType,
+				NodeId:       "node",
+				RetryAttempt: &retryAttempt,
+				RequestId:    "b",
+				ProducerId:   "propeller",
+				Phase:        core.TaskExecution_RUNNING.String(),
+				PhaseVersion: 1,
+				OccurredAt:   occurredAt,
+				ReceivedAt:   receivedAt,
+			},
+		},
+		Token: "4",
+	}, eventList)
+}
+
+func TestListExecutionEvents_LastPage(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	repository.RecordedEventRepo().(*repositoryMocks.RecordedEventRepoInterface).OnListMatch(
+		mock.Anything, mock.Anything).Return([]models.RecordedEvent{
+		{
+			EventType: interfaces.WorkflowEventType,
+			Phase:     core.WorkflowExecution_RUNNING.String(),
+		},
+	}, nil)
+
+	eventManager := NewEventManager(repository, getMockExecutionsConfigProvider(), &mocks.MockNodeExecutionManager{},
+		&mocks.MockTaskExecutionManager{}, mockScope.NewTestScope())
+	eventList, err := eventManager.ListExecutionEvents(context.Background(), interfaces.ExecutionEventListRequest{
+		Id:    &usageExecutionID,
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, eventList.Events, 1)
+	assert.Empty(t, eventList.Token)
+}
+
+func TestListExecutionEvents_InvalidRequest(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	occurredAt := time.Date(2021, time.October, 1, 12, 0, 0, 0, time.UTC)
+	eventManager := NewEventManager(repository, getMockExecutionsConfigProvider(), &mocks.MockNodeExecutionManager{},
+		&mocks.MockTaskExecutionManager{}, mockScope.NewTestScope())
+	for _, request := range []interfaces.ExecutionEventListRequest{
+		{
+			Limit: 10,
+		},
+		{
+			Id: &usageExecutionID,
+		},
+		{
+			Id:    &usageExecutionID,
+			Limit: 10,
+			Token: "foo",
+		},
+		{
+			Id:        &usageExecutionID,
+			Limit:     10,
+			EventType: "launch_plan",
+		},
+		{
+			Id:             &usageExecutionID,
+			Limit:          10,
+			OccurredAfter:  occurredAt,
+			OccurredBefore: occurredAt,
+		},
+	} {
+		_, err := eventManager.ListExecutionEvents(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
+func TestListExecutionEvents_MissingExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.Identifier) (models.Execution, error) {
+			return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		})
+
+	eventManager := NewEventManager(repository, getMockExecutionsConfigProvider(), &mocks.MockNodeExecutionManager{},
+		&mocks.MockTaskExecutionManager{}, mockScope.NewTestScope())
+	_, err := eventManager.ListExecutionEvents(context.Background(), interfaces.ExecutionEventListRequest{
+		Id:    &usageExecutionID,
+		Limit: 10,
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
@@ -0,0 +1,84 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// Describes an event relative to the state of the entity it belongs to, to decide whether the event is applied.
+type eventSequence struct {
+	// Whether the event's phase, and phase version where there is one, was already recorded.
+	duplicate bool
+	// Whether the entity is already in a terminal phase.
+	currentIsTerminal bool
+	// Whether the event moves the entity into a terminal phase.
+	eventIsTerminal bool
+	// When the last event applied to the entity occurred. Nil when no event has been applied yet.
+	lastAppliedAt *time.Time
+	occurredAt    *timestamp.Timestamp
+}
+
+// Returns why an event should be skipped, or an empty string when it should be applied. Events replayed after
+// flytepropeller restarts are skipped, as are events arriving after newer ones were applied, except for terminal events
+// which are always applied. Events occurring after an entity already terminated aren't replays and aren't skipped, so
+// that they're still rejected.
+func getEventSkipReason(sequence eventSequence) string {
+	if sequence.duplicate {
+		return interfaces.SkipReasonDuplicate
+	}
+	if sequence.lastAppliedAt == nil {
+		return ""
+	}
+	occurredAt, err := ptypes.Timestamp(sequence.occurredAt)
+	if err != nil {
+		return ""
+	}
+	if sequence.currentIsTerminal {
+		if !occurredAt.After(*sequence.lastAppliedAt) {
+			return interfaces.SkipReasonAfterTerminal
+		}
+		return ""
+	}
+	if !sequence.eventIsTerminal && occurredAt.Before(*sequence.lastAppliedAt) {
+		return interfaces.SkipReasonStale
+	}
+	return ""
+}
+
+func getSkippedEventModel(
+	eventType string, executionID *core.WorkflowExecutionIdentifier, requestID, phase string,
+	occurredAt *timestamp.Timestamp, currentPhase, reason string) models.SkippedEvent {
+	skippedEvent := models.SkippedEvent{
+		ExecutionProject: executionID.Project,
+		ExecutionDomain:  executionID.Domain,
+		ExecutionName:    executionID.Name,
+		EventType:        eventType,
+		RequestID:        requestID,
+		Phase:            phase,
+		CurrentPhase:     currentPhase,
+		Reason:           reason,
+	}
+	if occurredAtTime, err := ptypes.Timestamp(occurredAt); err == nil {
+		skippedEvent.OccurredAt = occurredAtTime
+	}
+	return skippedEvent
+}
+
+// Records an event which was skipped. Failing to record it is logged rather than returned, since the event has already
+// been handled.
+func recordSkippedEvent(ctx context.Context, db repositories.RepositoryInterface, skippedEvent models.SkippedEvent) {
+	logger.Infof(ctx, "Skipping %s event [%s] in phase [%s] for execution [%s] with reason [%s]",
+		skippedEvent.EventType, skippedEvent.RequestID, skippedEvent.Phase, skippedEvent.ExecutionName, skippedEvent.Reason)
+	if err := db.SkippedEventRepo().Create(ctx, skippedEvent); err != nil {
+		logger.Warningf(ctx, "Failed to record skipped %s event [%s] with err: %v",
+			skippedEvent.EventType, skippedEvent.RequestID, err)
+	}
+}
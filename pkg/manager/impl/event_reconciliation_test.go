@@ -0,0 +1,87 @@
+package impl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getEventReconciliationConfigProvider() runtimeInterfaces.Configuration {
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			EventReconciliation: runtimeInterfaces.EventReconciliationConfig{Enabled: true},
+		})
+	return mockConfig
+}
+
+// Returns the reasons of the skipped events recorded through the repository.
+func recordSkippedEventReasons(repository *repositoryMocks.SkippedEventRepoInterface) *[]string {
+	var reasons []string
+	repository.OnCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		reasons = append(reasons, args.Get(1).(models.SkippedEvent).Reason)
+	}).Return(nil)
+	return &reasons
+}
+
+func TestGetEventSkipReason(t *testing.T) {
+	lastAppliedAt := time.Date(2021, time.October, 1, 12, 0, 0, 0, time.UTC)
+	before, _ := ptypes.TimestampProto(lastAppliedAt.Add(-time.Minute))
+	same, _ := ptypes.TimestampProto(lastAppliedAt)
+	after, _ := ptypes.TimestampProto(lastAppliedAt.Add(time.Minute))
+	for _, test := range []struct {
+		name     string
+		sequence eventSequence
+		reason   string
+	}{
+		{
+			name:     "duplicate",
+			sequence: eventSequence{duplicate: true, lastAppliedAt: &lastAppliedAt, occurredAt: after},
+			reason:   interfaces.SkipReasonDuplicate,
+		},
+		{
+			name:     "in order",
+			sequence: eventSequence{lastAppliedAt: &lastAppliedAt, occurredAt: after},
+		},
+		{
+			name:     "no event applied yet",
+			sequence: eventSequence{occurredAt: before},
+		},
+		{
+			name:     "stale",
+			sequence: eventSequence{lastAppliedAt: &lastAppliedAt, occurredAt: before},
+			reason:   interfaces.SkipReasonStale,
+		},
+		{
+			name:     "same time",
+			sequence: eventSequence{lastAppliedAt: &lastAppliedAt, occurredAt: same},
+		},
+		{
+			name:     "late terminal event",
+			sequence: eventSequence{eventIsTerminal: true, lastAppliedAt: &lastAppliedAt, occurredAt: before},
+		},
+		{
+			name: "replayed after terminal",
+			sequence: eventSequence{
+				currentIsTerminal: true, lastAppliedAt: &lastAppliedAt, occurredAt: same},
+			reason: interfaces.SkipReasonAfterTerminal,
+		},
+		{
+			name: "occurred after terminal",
+			sequence: eventSequence{
+				currentIsTerminal: true, lastAppliedAt: &lastAppliedAt, occurredAt: after},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.reason, getEventSkipReason(test.sequence))
+		})
+	}
+}
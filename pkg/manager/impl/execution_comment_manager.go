@@ -0,0 +1,122 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+// Links are stored newline-separated, since URLs can't contain newlines.
+const commentLinkSeparator = "\n"
+
+type ExecutionCommentManager struct {
+	db repositories.RepositoryInterface
+}
+
+func toExecutionComment(commentModel models.ExecutionComment) *interfaces.ExecutionComment {
+	comment := &interfaces.ExecutionComment{
+		ID: commentModel.ID,
+		ExecutionID: &core.WorkflowExecutionIdentifier{
+			Project: commentModel.ExecutionProject,
+			Domain:  commentModel.ExecutionDomain,
+			Name:    commentModel.ExecutionName,
+		},
+		Author:    commentModel.Author,
+		CreatedAt: commentModel.CreatedAt,
+		Text:      commentModel.Text,
+	}
+	if len(commentModel.Links) > 0 {
+		comment.Links = strings.Split(commentModel.Links, commentLinkSeparator)
+	}
+	return comment
+}
+
+func (m *ExecutionCommentManager) AddExecutionComment(
+	ctx context.Context, request interfaces.ExecutionCommentAddRequest) (*interfaces.ExecutionComment, error) {
+	if err := validation.ValidateExecutionCommentAddRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.ExecutionID)
+	if err := checkProjectVisible(ctx, request.ExecutionID.Project); err != nil {
+		return nil, err
+	}
+	if _, err := util.GetExecutionModel(ctx, m.db, *request.ExecutionID); err != nil {
+		return nil, err
+	}
+	commentModel := &models.ExecutionComment{
+		ExecutionProject: request.ExecutionID.Project,
+		ExecutionDomain:  request.ExecutionID.Domain,
+		ExecutionName:    request.ExecutionID.Name,
+		Author:           getUser(ctx),
+		Text:             request.Text,
+		Links:            strings.Join(request.Links, commentLinkSeparator),
+	}
+	if err := m.db.ExecutionCommentRepo().Create(ctx, commentModel); err != nil {
+		logger.Debugf(ctx, "Failed to comment on execution [%+v] with err %v", request.ExecutionID, err)
+		return nil, err
+	}
+	return toExecutionComment(*commentModel), nil
+}
+
+func (m *ExecutionCommentManager) ListExecutionComments(
+	ctx context.Context, request interfaces.ExecutionCommentListRequest) (*interfaces.ExecutionCommentList, error) {
+	if err := validation.ValidateExecutionCommentListRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.ExecutionID)
+	if err := checkProjectVisible(ctx, request.ExecutionID.Project); err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListExecutionComments", request.Token)
+	}
+	if _, err := util.GetExecutionModel(ctx, m.db, *request.ExecutionID); err != nil {
+		return nil, err
+	}
+	commentModels, err := m.db.ExecutionCommentRepo().List(ctx, repoInterfaces.ListExecutionCommentsInput{
+		Execution: models.ExecutionKey{
+			Project: request.ExecutionID.Project,
+			Domain:  request.ExecutionID.Domain,
+			Name:    request.ExecutionID.Name,
+		},
+		Limit:  int(request.Limit),
+		Offset: offset,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list the comments of [%+v] with err %v", request.ExecutionID, err)
+		return nil, err
+	}
+	comments := make([]*interfaces.ExecutionComment, len(commentModels))
+	for i, commentModel := range commentModels {
+		comments[i] = toExecutionComment(commentModel)
+	}
+	var token string
+	if len(comments) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(comments))
+	}
+	return &interfaces.ExecutionCommentList{
+		Comments: comments,
+		Token:    token,
+	}, nil
+}
+
+func NewExecutionCommentManager(db repositories.RepositoryInterface) interfaces.ExecutionCommentInterface {
+	return &ExecutionCommentManager{
+		db: db,
+	}
+}
@@ -0,0 +1,99 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var commentExecutionID = core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func TestAddExecutionComment(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	commentRepo := repository.ExecutionCommentRepo().(*repositoryMocks.ExecutionCommentRepoInterface)
+	commentRepo.OnCreateMatch(mock.Anything, mock.MatchedBy(func(input *models.ExecutionComment) bool {
+		return input.ExecutionName == "name" && input.Links == "https://status.example.com\nhttps://example.com/dag"
+	})).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.ExecutionComment).ID = 7
+	})
+
+	comment, err := NewExecutionCommentManager(repository).AddExecutionComment(context.Background(),
+		interfaces.ExecutionCommentAddRequest{
+			ExecutionID: &commentExecutionID,
+			Text:        "re-ran manually, upstream data was late",
+			Links:       []string{"https://status.example.com", "https://example.com/dag"},
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(7), comment.ID)
+	assert.True(t, proto.Equal(&commentExecutionID, comment.ExecutionID))
+	assert.Equal(t, "re-ran manually, upstream data was late", comment.Text)
+	assert.Equal(t, []string{"https://status.example.com", "https://example.com/dag"}, comment.Links)
+}
+
+func TestAddExecutionComment_InvisibleProject(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+
+	_, err := NewExecutionCommentManager(repository).AddExecutionComment(
+		auth.WithVisibleProjects(context.Background(), sets.NewString("other")), interfaces.ExecutionCommentAddRequest{
+			ExecutionID: &commentExecutionID,
+			Text:        "re-ran manually",
+		})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+	repository.ExecutionCommentRepo().(*repositoryMocks.ExecutionCommentRepoInterface).AssertNotCalled(
+		t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestListExecutionComments(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.ExecutionCommentRepo().(*repositoryMocks.ExecutionCommentRepoInterface).OnList(mock.Anything,
+		repoInterfaces.ListExecutionCommentsInput{
+			Execution: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			Limit:  2,
+			Offset: 2,
+		}).Return([]models.ExecutionComment{
+		{
+			ID:     3,
+			Author: "jane",
+			Text:   "re-ran manually",
+		},
+		{
+			ID:     4,
+			Author: "joe",
+			Text:   "see the incident",
+			Links:  "https://status.example.com",
+		},
+	}, nil)
+
+	comments, err := NewExecutionCommentManager(repository).ListExecutionComments(context.Background(),
+		interfaces.ExecutionCommentListRequest{
+			ExecutionID: &commentExecutionID,
+			Limit:       2,
+			Token:       "2",
+		})
+	assert.NoError(t, err)
+	assert.Len(t, comments.Comments, 2)
+	assert.Equal(t, "jane", comments.Comments[0].Author)
+	assert.Empty(t, comments.Comments[0].Links)
+	assert.Equal(t, []string{"https://status.example.com"}, comments.Comments[1].Links)
+	assert.Equal(t, "4", comments.Token)
+}
@@ -0,0 +1,147 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type ExecutionIdentityManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.ApplicationConfiguration
+}
+
+// Returns the execution identity attributes which apply to executions in a project and domain, or nil when there are
+// none.
+func getExecutionIdentityAttributes(ctx context.Context, db repositories.RepositoryInterface, project, domain string) (
+	*interfaces.ExecutionIdentityAttributes, error) {
+	resource, err := db.ResourceRepo().Get(ctx, repoInterfaces.ResourceID{
+		Project:      project,
+		Domain:       domain,
+		ResourceType: interfaces.ExecutionIdentityResourceType,
+	})
+	if err != nil {
+		if ec, ok := err.(errors.FlyteAdminError); ok && ec.Code() == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(resource.Attributes) == 0 {
+		return nil, nil
+	}
+	var attributes interfaces.ExecutionIdentityAttributes
+	if err := json.Unmarshal(resource.Attributes, &attributes); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to read the execution identity attributes of [%s/%s]: %v", project, domain, err)
+	}
+	return &attributes, nil
+}
+
+// Fills the permissions an execution in a project and domain runs with from the defaults of its execution identity
+// attributes, and rejects it when it would run as an identity they don't allow.
+func applyExecutionIdentityAttributes(ctx context.Context, db repositories.RepositoryInterface, project, domain string,
+	auth *admin.AuthRole) (*admin.AuthRole, error) {
+	attributes, err := getExecutionIdentityAttributes(ctx, db, project, domain)
+	if err != nil || attributes == nil {
+		return auth, err
+	}
+	resolvedAuth := &admin.AuthRole{
+		AssumableIamRole:         auth.GetAssumableIamRole(),
+		KubernetesServiceAccount: auth.GetKubernetesServiceAccount(),
+	}
+	if len(resolvedAuth.KubernetesServiceAccount) == 0 {
+		resolvedAuth.KubernetesServiceAccount = attributes.DefaultServiceAccount
+	}
+	if len(resolvedAuth.AssumableIamRole) == 0 {
+		resolvedAuth.AssumableIamRole = attributes.DefaultIamRole
+	}
+	if len(resolvedAuth.KubernetesServiceAccount) > 0 && len(attributes.AllowedServiceAccounts) > 0 &&
+		!sets.NewString(attributes.AllowedServiceAccounts...).Has(resolvedAuth.KubernetesServiceAccount) {
+		return nil, errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+			"executions in [%s/%s] aren't allowed to run as service account [%s]", project, domain,
+			resolvedAuth.KubernetesServiceAccount)
+	}
+	if len(resolvedAuth.AssumableIamRole) > 0 && len(attributes.AllowedIamRoles) > 0 &&
+		!sets.NewString(attributes.AllowedIamRoles...).Has(resolvedAuth.AssumableIamRole) {
+		return nil, errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+			"executions in [%s/%s] aren't allowed to assume role [%s]", project, domain, resolvedAuth.AssumableIamRole)
+	}
+	return resolvedAuth, nil
+}
+
+func (m *ExecutionIdentityManager) UpdateExecutionIdentityAttributes(
+	ctx context.Context, project, domain string, attributes interfaces.ExecutionIdentityAttributes) error {
+	if err := validation.ValidateExecutionIdentityAttributes(attributes); err != nil {
+		logger.Debugf(ctx, "invalid execution identity attributes [%+v]: %v", attributes, err)
+		return err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, project, domain)
+	if err := validation.ValidateProjectAndDomain(ctx, m.db, m.config, project, domain); err != nil {
+		return err
+	}
+	serializedAttributes, err := json.Marshal(attributes)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to serialize execution identity attributes: %v", err)
+	}
+	return m.db.ResourceRepo().CreateOrUpdate(ctx, models.Resource{
+		Project:      project,
+		Domain:       domain,
+		ResourceType: interfaces.ExecutionIdentityResourceType,
+		Priority:     models.ResourcePriorityProjectDomainLevel,
+		Attributes:   serializedAttributes,
+	})
+}
+
+func (m *ExecutionIdentityManager) GetExecutionIdentityAttributes(
+	ctx context.Context, project, domain string) (*interfaces.ExecutionIdentityAttributes, error) {
+	ctx = contextutils.WithProjectDomain(ctx, project, domain)
+	if err := validation.ValidateProjectAndDomain(ctx, m.db, m.config, project, domain); err != nil {
+		return nil, err
+	}
+	attributes, err := getExecutionIdentityAttributes(ctx, m.db, project, domain)
+	if err != nil {
+		return nil, err
+	}
+	if attributes == nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"execution identity attributes of [%s/%s] don't exist", project, domain)
+	}
+	return attributes, nil
+}
+
+func (m *ExecutionIdentityManager) DeleteExecutionIdentityAttributes(
+	ctx context.Context, project, domain string) error {
+	ctx = contextutils.WithProjectDomain(ctx, project, domain)
+	if err := validation.ValidateProjectAndDomain(ctx, m.db, m.config, project, domain); err != nil {
+		return err
+	}
+	if err := m.db.ResourceRepo().Delete(ctx, repoInterfaces.ResourceID{
+		Project:      project,
+		Domain:       domain,
+		ResourceType: interfaces.ExecutionIdentityResourceType,
+	}); err != nil {
+		return err
+	}
+	logger.Infof(ctx, "Deleted execution identity attributes for: %s-%s", project, domain)
+	return nil
+}
+
+func NewExecutionIdentityManager(db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration) interfaces.ExecutionIdentityInterface {
+	return &ExecutionIdentityManager{
+		db:     db,
+		config: config,
+	}
+}
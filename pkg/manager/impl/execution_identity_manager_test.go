@@ -0,0 +1,88 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUpdateExecutionIdentityAttributes(t *testing.T) {
+	repository := testutils.GetRepoWithDefaultProject()
+	var created bool
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).CreateOrUpdateFunction = func(
+		ctx context.Context, input models.Resource) error {
+		assert.Equal(t, models.Resource{
+			Project:      "project",
+			Domain:       "domain",
+			ResourceType: interfaces.ExecutionIdentityResourceType,
+			Priority:     models.ResourcePriorityProjectDomainLevel,
+			Attributes:   []byte(`{"default_service_account":"etl-runner","allowed_service_accounts":["etl-runner"]}`),
+		}, input)
+		created = true
+		return nil
+	}
+	manager := NewExecutionIdentityManager(repository, getMockApplicationConfigForProjectManagerTest())
+	err := manager.UpdateExecutionIdentityAttributes(context.Background(), "project", "domain",
+		interfaces.ExecutionIdentityAttributes{
+			DefaultServiceAccount:  "etl-runner",
+			AllowedServiceAccounts: []string{"etl-runner"},
+		})
+	assert.NoError(t, err)
+	assert.True(t, created)
+
+	err = manager.UpdateExecutionIdentityAttributes(context.Background(), "project", "domain",
+		interfaces.ExecutionIdentityAttributes{
+			DefaultServiceAccount:  "admin",
+			AllowedServiceAccounts: []string{"etl-runner"},
+		})
+	assert.EqualError(t, err, "default_service_account [admin] must be one of the allowed_service_accounts")
+}
+
+func TestGetExecutionIdentityAttributes(t *testing.T) {
+	repository := testutils.GetRepoWithDefaultProject()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID repoInterfaces.ResourceID) (models.Resource, error) {
+		if ID.Domain == "staging" {
+			return models.Resource{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "not found")
+		}
+		assert.Equal(t, repoInterfaces.ResourceID{
+			Project:      "project",
+			Domain:       "domain",
+			ResourceType: interfaces.ExecutionIdentityResourceType,
+		}, ID)
+		return models.Resource{
+			Attributes: []byte(`{"default_iam_role":"etl","allowed_iam_roles":["etl"]}`),
+		}, nil
+	}
+	manager := NewExecutionIdentityManager(repository, getMockApplicationConfigForProjectManagerTest())
+	attributes, err := manager.GetExecutionIdentityAttributes(context.Background(), "project", "domain")
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ExecutionIdentityAttributes{
+		DefaultIamRole:  "etl",
+		AllowedIamRoles: []string{"etl"},
+	}, attributes)
+
+	_, err = manager.GetExecutionIdentityAttributes(context.Background(), "project", "staging")
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestApplyExecutionIdentityAttributes_None(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, ID repoInterfaces.ResourceID) (models.Resource, error) {
+		return models.Resource{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "not found")
+	}
+	auth := &admin.AuthRole{KubernetesServiceAccount: "anything"}
+	resolvedAuth, err := applyExecutionIdentityAttributes(context.Background(), repository, "project", "domain", auth)
+	assert.NoError(t, err)
+	assert.Equal(t, auth, resolvedAuth)
+}
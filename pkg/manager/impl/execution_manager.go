@@ -3,7 +3,10 @@ package impl
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/flytek8s"
@@ -19,6 +22,7 @@ import (
 	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
@@ -28,7 +32,9 @@ import (
 
 	eventWriter "github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
 	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/async/outbox"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/executions"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
@@ -42,7 +48,11 @@ import (
 	workflowengineInterfaces "github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/benbjohnson/clock"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
@@ -51,6 +61,53 @@ import (
 
 const childContainerQueueKey = "child_queue"
 
+// Notifications are published as emails unless they are posted to a chat webhook.
+var emailNotificationType = proto.MessageName(&admin.EmailNotification{})
+
+// Slack notifications for projects with a chat webhook are published as webhook messages instead.
+const webhookNotificationType = "flyteadmin.notifications.WebhookMessage"
+
+// Incidents opened and resolved in a paging service are published as incident messages.
+const incidentNotificationType = "flyteadmin.notifications.IncidentMessage"
+
+// Deliveries to the webhooks users register are published as webhook delivery messages.
+const webhookDeliveryNotificationType = "flyteadmin.notifications.WebhookDeliveryMessage"
+
+// Notifications whose delivery is recorded are published as tracked notifications wrapping them.
+const trackedNotificationType = "flyteadmin.notifications.TrackedNotification"
+
+// Emails batched into digests are published as digest entries, which are recorded until their digests are due.
+const digestEntryNotificationType = "flyteadmin.notifications.DigestEntryMessage"
+
+// The number of registered webhooks listed at a time when delivering an execution to them.
+const webhooksBatchSize = 100
+
+// Annotation recording the user that launched an execution on behalf of the execution's principal.
+const impersonatorAnnotationKey = "flyte.org/impersonated-by"
+
+// Annotation listing the only nodes a recovered execution may recover from the original execution.
+const recoverNodesAnnotationKey = "flyte.org/recover-nodes"
+
+// Label (and annotation) carrying an execution's priority class.
+const priorityKey = "flyte.org/priority"
+
+const defaultTerminateExecutionsBatchSize = 100
+
+// How long an idempotency key stays reserved for the request launching its execution, after which another request may
+// launch it in case the admin holding the reservation stopped.
+const idempotencyKeyReservationDuration = 5 * time.Minute
+
+// gRPC metadata clients set when creating an execution so that retrying the request doesn't create another. HTTP
+// clients set it with the Grpc-Metadata-Flyte-Idempotency-Key header.
+const (
+	idempotencyKeyHeader    = "flyte-idempotency-key"
+	runAtHeader             = "flyte-run-at"
+	priorityHeader          = "flyte-priority"
+	maxIdempotencyKeyLength = 255
+	// The gRPC metadata warnings about launching deprecated workflows and tasks are returned in.
+	deprecationWarningHeader = "flyte-deprecation-warning"
+)
+
 // Map of [project] -> map of [domain] -> stop watch
 type projectDomainScopedStopWatchMap = map[string]map[string]*promutils.StopWatch
 
@@ -68,6 +125,15 @@ type executionSystemMetrics struct {
 	ClosureSizeBytes         prometheus.Summary
 	AcceptanceDelay          prometheus.Summary
 	PublishEventError        prometheus.Counter
+	ExecutionsDeferred       prometheus.Counter
+	ExecutionsScheduled      prometheus.Counter
+	ExecutionsDispatched     prometheus.Counter
+	DispatchFailures         prometheus.Counter
+	ExecutionsQueued         prometheus.Counter
+	LaunchFailures           prometheus.Counter
+	LineageRecordFailures    prometheus.Counter
+	DeprecatedExecutions     prometheus.Counter
+	ExecutionFailures        *prometheus.CounterVec
 }
 
 type executionUserMetrics struct {
@@ -84,6 +150,7 @@ type ExecutionManager struct {
 	storageClient             *storage.DataStore
 	workflowExecutor          workflowengineInterfaces.Executor
 	queueAllocator            executions.QueueAllocator
+	executionNamer            executions.ExecutionNamer
 	_clock                    clock.Clock
 	systemMetrics             executionSystemMetrics
 	userMetrics               executionUserMetrics
@@ -95,6 +162,8 @@ type ExecutionManager struct {
 	qualityOfServiceAllocator executions.QualityOfServiceAllocator
 	eventPublisher            notificationInterfaces.Publisher
 	dbEventWriter             eventWriter.WorkflowExecutionEventWriter
+	// Nil unless every event received is recorded.
+	recordedEventWriter eventWriter.RecordedEventWriter
 }
 
 func getExecutionContext(ctx context.Context, id *core.WorkflowExecutionIdentifier) context.Context {
@@ -108,6 +177,74 @@ func getUser(ctx context.Context) string {
 	return identityContext.UserID()
 }
 
+// Returns the user acting on behalf of the authenticated end user, if the request is impersonated.
+func getImpersonator(ctx context.Context) string {
+	impersonator, found := auth.ImpersonatorFromContext(ctx)
+	if !found {
+		return ""
+	}
+	return impersonator.UserID()
+}
+
+// Returns the priority class an execution is launched with, which is the first set of the requested class, the class
+// annotated on the execution or launch plan spec, and the default for the project and domain. Executions have no
+// priority when no classes are configured.
+func (m *ExecutionManager) getPriority(ctx context.Context, project, domain string,
+	requestSpec *admin.ExecutionSpec, launchPlanSpec *admin.LaunchPlanSpec) (string, error) {
+	priorityConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetPriorityConfig()
+	requested := metautils.ExtractIncoming(ctx).Get(priorityHeader)
+	if len(priorityConfig.Classes) == 0 {
+		if len(requested) > 0 {
+			return "", errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"%s can't be set since no priority classes are configured", priorityHeader)
+		}
+		return "", nil
+	}
+	for _, priority := range []string{
+		requested,
+		requestSpec.GetAnnotations().GetValues()[priorityKey],
+		launchPlanSpec.GetAnnotations().GetValues()[priorityKey],
+	} {
+		if len(priority) == 0 {
+			continue
+		}
+		if !priorityConfig.IsClass(priority) {
+			return "", errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"unknown priority class [%s], expected one of %v", priority, priorityConfig.Classes)
+		}
+		return priority, nil
+	}
+	return priorityConfig.GetDefaultClass(project, domain), nil
+}
+
+// Returns a copy of values with the priority class set, unless the execution has no priority.
+func addPriority(values map[string]string, priority string) map[string]string {
+	if len(priority) == 0 {
+		return values
+	}
+	withPriority := make(map[string]string, len(values)+1)
+	for key, value := range values {
+		withPriority[key] = value
+	}
+	withPriority[priorityKey] = priority
+	return withPriority
+}
+
+// Records the impersonating user (if any) in the execution annotations so that it's carried along with the principal.
+func addImpersonatorAnnotation(ctx context.Context, annotations map[string]string) map[string]string {
+	impersonator := getImpersonator(ctx)
+	if len(impersonator) == 0 {
+		return annotations
+	}
+
+	withImpersonator := make(map[string]string, len(annotations)+1)
+	for key, value := range annotations {
+		withImpersonator[key] = value
+	}
+	withImpersonator[impersonatorAnnotationKey] = impersonator
+	return withImpersonator
+}
+
 func (m *ExecutionManager) populateExecutionQueue(
 	ctx context.Context, identifier core.Identifier, compiledWorkflow *core.CompiledWorkflowClosure) {
 	queueConfig := m.queueAllocator.GetQueue(ctx, identifier)
@@ -205,12 +342,36 @@ func (m *ExecutionManager) offloadInputs(ctx context.Context, literalMap *core.L
 	if err != nil {
 		return "", err
 	}
+	// Inputs are tracked before they're written, so that they're collected if their execution is never created.
+	if m.config.ApplicationConfiguration().GetTopLevelConfig().GetLiteralOffloadingConfig().Enabled {
+		if err := m.db.OffloadedLiteralRepo().Create(ctx, models.OffloadedLiteral{
+			ExecutionProject: identifier.Project,
+			ExecutionDomain:  identifier.Domain,
+			ExecutionName:    identifier.Name,
+			URI:              inputsURI.String(),
+		}); err != nil {
+			return "", err
+		}
+	}
 	if err := m.storageClient.WriteProtobuf(ctx, inputsURI, storage.Options{}, literalMap); err != nil {
 		return "", err
 	}
 	return inputsURI, nil
 }
 
+// Clears the inputs older clients set in the spec once they're offloaded, unless they're small enough to keep in the
+// database.
+func (m *ExecutionManager) dropOffloadedSpecInputs(ctx context.Context, spec *admin.ExecutionSpec) {
+	offloadingConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetLiteralOffloadingConfig()
+	if !offloadingConfig.Enabled || spec.Inputs == nil {
+		return
+	}
+	if size := proto.Size(spec.Inputs); int64(size) >= offloadingConfig.MinSizeBytes {
+		logger.Debugf(ctx, "Dropping the offloaded spec inputs of %d bytes", size)
+		spec.Inputs = nil
+	}
+}
+
 type completeTaskResources struct {
 	Defaults runtimeInterfaces.TaskResourceSet
 	Limits   runtimeInterfaces.TaskResourceSet
@@ -359,6 +520,51 @@ func (m *ExecutionManager) setCompiledTaskDefaults(ctx context.Context, task *co
 	}
 }
 
+// Rejects executions with a task whose container requests or limits more cpu, memory or gpu than the quota of the
+// project and domain allows. Projects and domains without a quota are unlimited.
+func (m *ExecutionManager) checkDomainQuota(ctx context.Context, project, domain string,
+	tasks []*core.CompiledTask) error {
+	quota, err := m.db.DomainQuotaRepo().Get(ctx, project, domain)
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+			return nil
+		}
+		return err
+	}
+	quotaCaps := make(map[core.Resources_ResourceName]resource.Quantity)
+	for name, value := range map[core.Resources_ResourceName]string{
+		core.Resources_CPU:    quota.CPU,
+		core.Resources_MEMORY: quota.Memory,
+		core.Resources_GPU:    quota.GPU,
+	} {
+		if len(value) > 0 {
+			quotaCaps[name] = parseQuantityNoError(ctx, "domain quota", name.String(), value)
+		}
+	}
+	for _, task := range tasks {
+		if task == nil || task.Template == nil || task.Template.GetContainer() == nil ||
+			task.Template.GetContainer().Resources == nil {
+			continue
+		}
+		resources := task.Template.GetContainer().Resources
+		for _, entries := range [][]*core.Resources_ResourceEntry{resources.Requests, resources.Limits} {
+			for _, entry := range entries {
+				quotaCap, ok := quotaCaps[entry.Name]
+				if !ok {
+					continue
+				}
+				if quantity, err := resource.ParseQuantity(entry.Value); err == nil && quantity.Cmp(quotaCap) > 0 {
+					return errors.NewFlyteAdminErrorf(codes.ResourceExhausted,
+						"task [%s] asks for %s [%s], which exceeds the %s quota [%s] of project [%s] and domain [%s]",
+						task.Template.GetId().GetName(), strings.ToLower(entry.Name.String()), entry.Value,
+						strings.ToLower(entry.Name.String()), quotaCap.String(), project, domain)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func parseQuantityNoError(ctx context.Context, ownerID, name, value string) resource.Quantity {
 	q, err := resource.ParseQuantity(value)
 	if err != nil {
@@ -495,6 +701,76 @@ func (m *ExecutionManager) getExecutionConfig(ctx context.Context, request *admi
 	}, nil
 }
 
+// Returns the name the request sets, or else a name following the naming policy of the execution's project and domain.
+// Attempt counts the names previously generated for the execution which were taken.
+func (m *ExecutionManager) getExecutionName(ctx context.Context, request admin.ExecutionCreateRequest,
+	referenceName string, requestedAt time.Time, attempt int) (string, error) {
+	if len(request.Name) > 0 {
+		return request.Name, nil
+	}
+	return m.executionNamer.GetExecutionName(ctx, executions.GetExecutionNameInput{
+		Project:       request.Project,
+		Domain:        request.Domain,
+		ReferenceName: referenceName,
+		RequestedAt:   requestedAt,
+		Attempt:       attempt,
+	})
+}
+
+// Checks the name a request sets against the naming policy of the execution's project and domain. Scheduled and child
+// executions are named by admin and propeller rather than by users, so aren't checked.
+func (m *ExecutionManager) validateRequestedExecutionName(request admin.ExecutionCreateRequest) error {
+	metadata := request.GetSpec().GetMetadata()
+	if len(request.Name) == 0 || metadata.GetParentNodeExecution() != nil {
+		return nil
+	}
+	switch metadata.GetMode() {
+	case admin.ExecutionMetadata_SCHEDULED, admin.ExecutionMetadata_CHILD_WORKFLOW:
+		return nil
+	}
+	return m.executionNamer.ValidateExecutionName(request.Project, request.Domain, request.Name)
+}
+
+// Warns about, or rejects, new executions of deprecated workflows and tasks. Schedules and the child workflows and
+// recoveries of existing executions keep running.
+func (m *ExecutionManager) checkDeprecation(ctx context.Context, requestSpec *admin.ExecutionSpec,
+	resourceType core.ResourceType, id *core.Identifier) error {
+	switch requestSpec.GetMetadata().GetMode() {
+	case admin.ExecutionMetadata_SCHEDULED, admin.ExecutionMetadata_CHILD_WORKFLOW, admin.ExecutionMetadata_RECOVERED:
+		return nil
+	}
+	namedEntity, err := m.db.NamedEntityRepo().Get(ctx, repositoryInterfaces.GetNamedEntityInput{
+		ResourceType: resourceType,
+		Project:      id.Project,
+		Domain:       id.Domain,
+		Name:         id.Name,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to get the state of [%+v] with err %v", id, err)
+		return err
+	}
+	if namedEntity.State == nil || *namedEntity.State != int32(interfaces.NamedEntityStateDeprecated) {
+		return nil
+	}
+	m.systemMetrics.DeprecatedExecutions.Inc()
+	message := fmt.Sprintf("%s [%s/%s/%s] is deprecated", strings.ToLower(resourceType.String()), id.Project,
+		id.Domain, id.Name)
+	reject := m.config.ApplicationConfiguration().GetTopLevelConfig().GetDeprecationConfig().RejectExecutions
+	if !reject {
+		if reject, err = isFeatureEnabled(ctx, m.db, m.config.ApplicationConfiguration(),
+			interfaces.FeatureFlagRejectDeprecatedExecutions, id.Project); err != nil {
+			return err
+		}
+	}
+	if reject {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "%s and can't be launched", message)
+	}
+	logger.Infof(ctx, "Launching an execution of %s", message)
+	// Fails outside of a gRPC request, in which case there's no client to warn.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(deprecationWarningHeader, message))
+	return nil
+}
+
 func (m *ExecutionManager) launchSingleTaskExecution(
 	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
 	context.Context, *models.Execution, error) {
@@ -512,6 +788,9 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err = m.checkDeprecation(ctx, request.Spec, core.ResourceType_TASK, request.Spec.LaunchPlan); err != nil {
+		return nil, nil, err
+	}
 
 	// Prepare a skeleton workflow
 	taskIdentifier := request.Spec.LaunchPlan
@@ -525,7 +804,7 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 	if err != nil {
 		return nil, nil, err
 	}
-	closure, err := util.FetchAndGetWorkflowClosure(ctx, m.storageClient, workflowModel.RemoteClosureIdentifier)
+	closure, err := util.GetWorkflowClosure(ctx, m.storageClient, *workflowModel)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -538,7 +817,10 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		return nil, nil, err
 	}
 
-	name := util.GetExecutionName(request)
+	name, err := m.getExecutionName(ctx, request, taskIdentifier.Name, requestedAt, 0)
+	if err != nil {
+		return nil, nil, err
+	}
 	workflowExecutionID := core.WorkflowExecutionIdentifier{
 		Project: request.Project,
 		Domain:  request.Domain,
@@ -565,6 +847,9 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 	for _, t := range workflow.Closure.CompiledWorkflow.Tasks {
 		m.setCompiledTaskDefaults(ctx, t, platformTaskResources)
 	}
+	if err = m.checkDomainQuota(ctx, request.Project, request.Domain, workflow.Closure.CompiledWorkflow.Tasks); err != nil {
+		return nil, nil, err
+	}
 
 	// Dynamically assign execution queues.
 	m.populateExecutionQueue(ctx, *workflow.Id, workflow.Closure.CompiledWorkflow)
@@ -577,6 +862,7 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 	if err != nil {
 		return nil, nil, err
 	}
+	m.dropOffloadedSpecInputs(ctx, requestSpec)
 	qualityOfService, err := m.qualityOfServiceAllocator.GetQualityOfService(ctx, executions.GetQualityOfServiceInput{
 		Workflow:               &workflow,
 		LaunchPlan:             launchPlan,
@@ -586,6 +872,14 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		logger.Errorf(ctx, "Failed to get quality of service for [%+v] with error: %v", workflowExecutionID, err)
 		return nil, nil, err
 	}
+	auth, err := m.applyProjectExecutionDefaults(ctx, request.Project, requestSpec, nil, requestSpec.AuthRole)
+	if err != nil {
+		return nil, nil, err
+	}
+	auth, err = applyExecutionIdentityAttributes(ctx, m.db, request.Project, request.Domain, auth)
+	if err != nil {
+		return nil, nil, err
+	}
 	executionConfig, err := m.getExecutionConfig(ctx, &request, nil)
 	if err != nil {
 		return nil, nil, err
@@ -594,9 +888,10 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		ExecutionID:     &workflowExecutionID,
 		WfClosure:       *workflow.Closure.CompiledWorkflow,
 		Inputs:          request.Inputs,
+		InputsURI:       inputsURI,
 		ReferenceName:   taskIdentifier.Name,
 		AcceptedAt:      requestedAt,
-		Auth:            requestSpec.AuthRole,
+		Auth:            auth,
 		QueueingBudget:  qualityOfService.QueuingBudget,
 		ExecutionConfig: executionConfig,
 		TaskResources:   &platformTaskResources,
@@ -612,6 +907,13 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 	if requestSpec.Annotations != nil {
 		executeTaskInputs.Annotations = requestSpec.Annotations.Values
 	}
+	executeTaskInputs.Annotations = addImpersonatorAnnotation(ctx, executeTaskInputs.Annotations)
+	priority, err := m.getPriority(ctx, request.Project, request.Domain, requestSpec, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	executeTaskInputs.Labels = addPriority(executeTaskInputs.Labels, priority)
+	executeTaskInputs.Annotations = addPriority(executeTaskInputs.Annotations, priority)
 
 	overrides, err := m.addPluginOverrides(ctx, &workflowExecutionID, workflowExecutionID.Name, "")
 	if err != nil {
@@ -621,6 +923,12 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		executeTaskInputs.TaskPluginOverrides = overrides
 	}
 
+	notificationsSettings, err := getExecutionNotifications(
+		m.config.ApplicationConfiguration().GetNotificationsConfig(), &workflowExecutionID, launchPlan, request.Spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	execInfo, err := m.workflowExecutor.ExecuteTask(ctx, executeTaskInputs)
 	if err != nil {
 		m.systemMetrics.PropellerFailures.Inc()
@@ -632,19 +940,6 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 	acceptanceDelay := executionCreatedAt.Sub(requestedAt)
 	m.systemMetrics.AcceptanceDelay.Observe(acceptanceDelay.Seconds())
 
-	// Request notification settings takes precedence over the launch plan settings.
-	// If there is no notification in the request and DisableAll is not true, use the settings from the launch plan.
-	var notificationsSettings []*admin.Notification
-	if launchPlan.Spec.GetEntityMetadata() != nil {
-		notificationsSettings = launchPlan.Spec.EntityMetadata.GetNotifications()
-	}
-	if request.Spec.GetNotifications() != nil && request.Spec.GetNotifications().Notifications != nil &&
-		len(request.Spec.GetNotifications().Notifications) > 0 {
-		notificationsSettings = request.Spec.GetNotifications().Notifications
-	} else if request.Spec.GetDisableAll() {
-		notificationsSettings = make([]*admin.Notification, 0)
-	}
-
 	executionModel, err := transformers.CreateExecutionModel(transformers.CreateExecutionModelInput{
 		WorkflowExecutionID: workflowExecutionID,
 		RequestSpec:         requestSpec,
@@ -660,12 +955,15 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		Cluster:               execInfo.Cluster,
 		InputsURI:             inputsURI,
 		UserInputsURI:         userInputsURI,
+		Impersonator:          getImpersonator(ctx),
+		Labels:                executeTaskInputs.Labels,
 	})
 	if err != nil {
 		logger.Infof(ctx, "Failed to create execution model in transformer for id: [%+v] with err: %v",
 			workflowExecutionID, err)
 		return nil, nil, err
 	}
+	executionModel.Priority = priority
 	m.userMetrics.WorkflowExecutionInputBytes.Observe(float64(proto.Size(request.Inputs)))
 	return ctx, executionModel, nil
 }
@@ -692,19 +990,80 @@ func resolvePermissions(request *admin.ExecutionCreateRequest, launchPlan *admin
 	return &admin.AuthRole{}
 }
 
-func (m *ExecutionManager) launchExecutionAndPrepareModel(
-	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
-	context.Context, *models.Execution, error) {
-	err := validation.ValidateExecutionRequest(ctx, request, m.db, m.config.ApplicationConfiguration())
+// Fills the settings which neither an execution request nor its launch plan set with the execution defaults of its
+// project, and returns the permissions the execution runs with. Single task executions have no launch plan spec.
+func (m *ExecutionManager) applyProjectExecutionDefaults(ctx context.Context, projectID string,
+	requestSpec *admin.ExecutionSpec, launchPlanSpec *admin.LaunchPlanSpec, auth *admin.AuthRole) (
+	*admin.AuthRole, error) {
+	project, err := m.db.ProjectRepo().Get(ctx, projectID)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to validate ExecutionCreateRequest %+v with err %v", request, err)
-		return nil, nil, err
+		logger.Errorf(ctx, "Failed to get project [%s] with error: %v", projectID, err)
+		return nil, err
 	}
-	if request.Spec.LaunchPlan.ResourceType == core.ResourceType_TASK {
-		logger.Debugf(ctx, "Launching single task execution with [%+v]", request.Spec.LaunchPlan)
-		return m.launchSingleTaskExecution(ctx, request, requestedAt)
+	defaults, err := getProjectExecutionDefaults(project)
+	if err != nil {
+		return nil, err
 	}
+	if requestSpec.MaxParallelism == 0 && launchPlanSpec.GetMaxParallelism() == 0 {
+		requestSpec.MaxParallelism = defaults.MaxParallelism
+	}
+	if requestSpec.SecurityContext == nil && launchPlanSpec.GetSecurityContext() == nil {
+		requestSpec.SecurityContext = defaults.SecurityContext
+	}
+	if launchPlanSpec != nil && launchPlanSpec.RawOutputDataConfig == nil && len(defaults.RawOutputDataPrefix) > 0 {
+		launchPlanSpec.RawOutputDataConfig = &admin.RawOutputDataConfig{
+			OutputLocationPrefix: defaults.RawOutputDataPrefix,
+		}
+	}
+
+	// Permissions which are explicitly set take precedence over the run-as identity of the security context, which in
+	// turn takes precedence over the default service account of the project.
+	runAs := requestSpec.SecurityContext.GetRunAs()
+	if runAs == nil {
+		runAs = launchPlanSpec.GetSecurityContext().GetRunAs()
+	}
+	serviceAccount := runAs.GetK8SServiceAccount()
+	if len(serviceAccount) == 0 {
+		serviceAccount = defaults.ServiceAccount
+	}
+	iamRole := runAs.GetIamRole()
+	if (len(auth.GetKubernetesServiceAccount()) > 0 || len(serviceAccount) == 0) &&
+		(len(auth.GetAssumableIamRole()) > 0 || len(iamRole) == 0) {
+		return auth, nil
+	}
+	mergedAuth := &admin.AuthRole{
+		AssumableIamRole:         auth.GetAssumableIamRole(),
+		KubernetesServiceAccount: auth.GetKubernetesServiceAccount(),
+	}
+	if len(mergedAuth.KubernetesServiceAccount) == 0 {
+		mergedAuth.KubernetesServiceAccount = serviceAccount
+	}
+	if len(mergedAuth.AssumableIamRole) == 0 {
+		mergedAuth.AssumableIamRole = iamRole
+	}
+	return mergedAuth, nil
+}
 
+// An execution which is ready to launch, along with everything needed to record it once it's launched.
+type preparedExecution struct {
+	id                    core.WorkflowExecutionIdentifier
+	requestSpec           *admin.ExecutionSpec
+	launchPlanModel       models.LaunchPlan
+	launchPlan            *admin.LaunchPlan
+	workflow              *admin.Workflow
+	parentNodeExecutionID uint
+	sourceExecutionID     uint
+	inputsURI             storage.DataReference
+	userInputsURI         storage.DataReference
+	priority              string
+	executeWorkflowInputs workflowengineInterfaces.ExecuteWorkflowInput
+}
+
+// Resolves and offloads everything a launch plan execution is launched with. Requests must already be validated. Dry
+// runs leave the inputs where they are.
+func (m *ExecutionManager) prepareExecution(
+	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time, dryRun bool) (
+	context.Context, *preparedExecution, error) {
 	launchPlanModel, err := util.GetLaunchPlanModel(ctx, m.db, *request.Spec.LaunchPlan)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to get launch plan model for ExecutionCreateRequest %+v with err %v", request, err)
@@ -734,7 +1093,14 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 		logger.Debugf(ctx, "Failed to get workflow with id %+v with err %v", launchPlan.Spec.WorkflowId, err)
 		return nil, nil, err
 	}
-	name := util.GetExecutionName(request)
+	if err = m.checkDeprecation(
+		ctx, request.Spec, core.ResourceType_WORKFLOW, launchPlan.Spec.WorkflowId); err != nil {
+		return nil, nil, err
+	}
+	name, err := m.getExecutionName(ctx, request, launchPlan.Id.Name, requestedAt, 0)
+	if err != nil {
+		return nil, nil, err
+	}
 	workflowExecutionID := core.WorkflowExecutionIdentifier{
 		Project: request.Project,
 		Domain:  request.Domain,
@@ -760,17 +1126,24 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 	for _, task := range workflow.Closure.CompiledWorkflow.Tasks {
 		m.setCompiledTaskDefaults(ctx, task, platformTaskResources)
 	}
+	if err = m.checkDomainQuota(ctx, request.Project, request.Domain, workflow.Closure.CompiledWorkflow.Tasks); err != nil {
+		return nil, nil, err
+	}
 
 	// Dynamically assign execution queues.
 	m.populateExecutionQueue(ctx, *workflow.Id, workflow.Closure.CompiledWorkflow)
 
-	inputsURI, err := m.offloadInputs(ctx, executionInputs, &workflowExecutionID, shared.Inputs)
-	if err != nil {
-		return nil, nil, err
-	}
-	userInputsURI, err := m.offloadInputs(ctx, request.Inputs, &workflowExecutionID, shared.UserInputs)
-	if err != nil {
-		return nil, nil, err
+	var inputsURI, userInputsURI storage.DataReference
+	if !dryRun {
+		inputsURI, err = m.offloadInputs(ctx, executionInputs, &workflowExecutionID, shared.Inputs)
+		if err != nil {
+			return nil, nil, err
+		}
+		userInputsURI, err = m.offloadInputs(ctx, request.Inputs, &workflowExecutionID, shared.UserInputs)
+		if err != nil {
+			return nil, nil, err
+		}
+		m.dropOffloadedSpecInputs(ctx, requestSpec)
 	}
 
 	qualityOfService, err := m.qualityOfServiceAllocator.GetQualityOfService(ctx, executions.GetQualityOfServiceInput{
@@ -782,31 +1155,47 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 		logger.Errorf(ctx, "Failed to get quality of service for [%+v] with error: %v", workflowExecutionID, err)
 		return nil, nil, err
 	}
+	auth, err := m.applyProjectExecutionDefaults(ctx, request.Project, requestSpec, launchPlan.Spec,
+		resolvePermissions(&request, launchPlan))
+	if err != nil {
+		return nil, nil, err
+	}
+	auth, err = applyExecutionIdentityAttributes(ctx, m.db, request.Project, request.Domain, auth)
+	if err != nil {
+		return nil, nil, err
+	}
 	executionConfig, err := m.getExecutionConfig(ctx, &request, launchPlan)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// TODO: Reduce CRD size and use offloaded input URI to blob store instead.
 	executeWorkflowInputs := workflowengineInterfaces.ExecuteWorkflowInput{
 		ExecutionID:     &workflowExecutionID,
 		WfClosure:       *workflow.Closure.CompiledWorkflow,
 		Inputs:          executionInputs,
+		InputsURI:       inputsURI,
 		Reference:       *launchPlan,
 		AcceptedAt:      requestedAt,
 		QueueingBudget:  qualityOfService.QueuingBudget,
 		ExecutionConfig: executionConfig,
-		Auth:            resolvePermissions(&request, launchPlan),
+		Auth:            auth,
 		TaskResources:   &platformTaskResources,
 	}
 	err = m.addLabelsAndAnnotations(request.Spec, &executeWorkflowInputs)
 	if err != nil {
 		return nil, nil, err
 	}
+	executeWorkflowInputs.Annotations = addImpersonatorAnnotation(ctx, executeWorkflowInputs.Annotations)
 	executeWorkflowInputs.Labels, err = m.addProjectLabels(ctx, request.Project, executeWorkflowInputs.Labels)
 	if err != nil {
 		return nil, nil, err
 	}
+	priority, err := m.getPriority(ctx, request.Project, request.Domain, requestSpec, launchPlan.Spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	executeWorkflowInputs.Labels = addPriority(executeWorkflowInputs.Labels, priority)
+	executeWorkflowInputs.Annotations = addPriority(executeWorkflowInputs.Annotations, priority)
 
 	overrides, err := m.addPluginOverrides(ctx, &workflowExecutionID, launchPlan.GetSpec().WorkflowId.Name, launchPlan.Id.Name)
 	if err != nil {
@@ -819,52 +1208,141 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 		request.Spec.Metadata.Mode == admin.ExecutionMetadata_RECOVERED {
 		executeWorkflowInputs.RecoveryExecution = request.Spec.Metadata.ReferenceExecution
 	}
+	return ctx, &preparedExecution{
+		id:                    workflowExecutionID,
+		requestSpec:           requestSpec,
+		launchPlanModel:       launchPlanModel,
+		launchPlan:            launchPlan,
+		workflow:              workflow,
+		parentNodeExecutionID: parentNodeExecutionID,
+		sourceExecutionID:     sourceExecutionID,
+		inputsURI:             inputsURI,
+		userInputsURI:         userInputsURI,
+		priority:              priority,
+		executeWorkflowInputs: executeWorkflowInputs,
+	}, nil
+}
 
+// Launches a prepared execution and returns the cluster it was launched on.
+func (m *ExecutionManager) executeWorkflow(
+	ctx context.Context, requestedAt time.Time, executeWorkflowInputs workflowengineInterfaces.ExecuteWorkflowInput) (
+	string, error) {
 	execInfo, err := m.workflowExecutor.ExecuteWorkflow(ctx, executeWorkflowInputs)
 	if err != nil {
 		m.systemMetrics.PropellerFailures.Inc()
-		logger.Infof(ctx, "Failed to execute workflow %+v with execution id %+v and inputs %+v with err %v",
-			request, workflowExecutionID, executionInputs, err)
-		return nil, nil, err
+		logger.Infof(ctx, "Failed to execute workflow with execution id %+v and inputs %+v with err %v",
+			executeWorkflowInputs.ExecutionID, executeWorkflowInputs.Inputs, err)
+		return "", err
 	}
 	executionCreatedAt := time.Now()
 	acceptanceDelay := executionCreatedAt.Sub(requestedAt)
 	m.systemMetrics.AcceptanceDelay.Observe(acceptanceDelay.Seconds())
+	return execInfo.Cluster, nil
+}
+
+// Launches the execution unless it's held as pending, either because its project and domain already run as many
+// executions as they may or because runAt is after requestedAt.
+func (m *ExecutionManager) launchExecutionAndPrepareModel(
+	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time, runAt *time.Time) (
+	context.Context, *models.Execution, error) {
+	err := validation.ValidateExecutionRequest(ctx, request, m.db, m.config.ApplicationConfiguration())
+	if err != nil {
+		logger.Debugf(ctx, "Failed to validate ExecutionCreateRequest %+v with err %v", request, err)
+		return nil, nil, err
+	}
+	if request.Spec.LaunchPlan.ResourceType == core.ResourceType_TASK {
+		if runAt != nil {
+			return nil, nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"single task executions can't be scheduled to run at a later time")
+		}
+		logger.Debugf(ctx, "Launching single task execution with [%+v]", request.Spec.LaunchPlan)
+		return m.launchSingleTaskExecution(ctx, request, requestedAt)
+	}
+
+	ctx, prepared, err := m.prepareExecution(ctx, request, requestedAt, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	notificationsSettings, err := getExecutionNotifications(
+		m.config.ApplicationConfiguration().GetNotificationsConfig(), &prepared.id, prepared.launchPlan,
+		prepared.requestSpec)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	// Request notification settings takes precedence over the launch plan settings.
-	// If there is no notification in the request and DisableAll is not true, use the settings from the launch plan.
-	var notificationsSettings []*admin.Notification
-	if launchPlan.Spec.GetEntityMetadata() != nil {
-		notificationsSettings = launchPlan.Spec.EntityMetadata.GetNotifications()
+	scheduled := runAt != nil && runAt.After(requestedAt)
+	pending := scheduled
+	if !pending {
+		pending, err = m.isAdmissionDeferred(ctx, prepared.id, prepared.requestSpec)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
-	if requestSpec.GetNotifications() != nil && requestSpec.GetNotifications().Notifications != nil &&
-		len(requestSpec.GetNotifications().Notifications) > 0 {
-		notificationsSettings = requestSpec.GetNotifications().Notifications
-	} else if requestSpec.GetDisableAll() {
-		notificationsSettings = make([]*admin.Notification, 0)
+	// Executions launched asynchronously are QUEUED until the dispatcher launches them.
+	launchAsync := !pending &&
+		m.config.ApplicationConfiguration().GetTopLevelConfig().GetExecutorConfig().AsyncLaunch.Enabled
+	pending = pending || launchAsync
+	phase := core.WorkflowExecution_UNDEFINED
+	if launchAsync {
+		phase = core.WorkflowExecution_QUEUED
+	}
+	var cluster string
+	if pending {
+		// Pending executions are prepared again from their spec when they're dispatched, so the annotations they're
+		// launched with, including those which only exist for the current request, are kept in it.
+		prepared.requestSpec.Annotations = &admin.Annotations{Values: prepared.executeWorkflowInputs.Annotations}
+		if scheduled {
+			runAtProto, err := ptypes.TimestampProto(*runAt)
+			if err != nil {
+				return nil, nil, err
+			}
+			prepared.requestSpec.Metadata.ScheduledAt = runAtProto
+			m.systemMetrics.ExecutionsScheduled.Inc()
+			logger.Infof(ctx, "Scheduled execution [%+v] to launch at [%v]", prepared.id, *runAt)
+		} else if launchAsync {
+			m.systemMetrics.ExecutionsQueued.Inc()
+			logger.Infof(ctx, "Queued execution [%+v] to launch asynchronously", prepared.id)
+		} else {
+			m.systemMetrics.ExecutionsDeferred.Inc()
+			logger.Infof(ctx, "Deferred launching execution [%+v] until its project and domain run fewer executions",
+				prepared.id)
+		}
+	} else {
+		cluster, err = m.executeWorkflow(ctx, requestedAt, prepared.executeWorkflowInputs)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
+	requestSpec := prepared.requestSpec
 	executionModel, err := transformers.CreateExecutionModel(transformers.CreateExecutionModelInput{
-		WorkflowExecutionID: workflowExecutionID,
+		WorkflowExecutionID: prepared.id,
 		RequestSpec:         requestSpec,
-		LaunchPlanID:        launchPlanModel.ID,
-		WorkflowID:          launchPlanModel.WorkflowID,
+		LaunchPlanID:        prepared.launchPlanModel.ID,
+		WorkflowID:          prepared.launchPlanModel.WorkflowID,
 		// The execution is not considered running until the propeller sends a specific event saying so.
-		Phase:                 core.WorkflowExecution_UNDEFINED,
+		Phase:                 phase,
 		CreatedAt:             m._clock.Now(),
 		Notifications:         notificationsSettings,
-		WorkflowIdentifier:    workflow.Id,
-		ParentNodeExecutionID: parentNodeExecutionID,
-		SourceExecutionID:     sourceExecutionID,
-		Cluster:               execInfo.Cluster,
-		InputsURI:             inputsURI,
-		UserInputsURI:         userInputsURI,
+		WorkflowIdentifier:    prepared.workflow.Id,
+		ParentNodeExecutionID: prepared.parentNodeExecutionID,
+		SourceExecutionID:     prepared.sourceExecutionID,
+		Cluster:               cluster,
+		InputsURI:             prepared.inputsURI,
+		UserInputsURI:         prepared.userInputsURI,
+		Impersonator:          getImpersonator(ctx),
+		Labels:                prepared.executeWorkflowInputs.Labels,
 	})
 	if err != nil {
 		logger.Infof(ctx, "Failed to create execution model in transformer for id: [%+v] with err: %v",
-			workflowExecutionID, err)
+			prepared.id, err)
 		return nil, nil, err
 	}
+	executionModel.Pending = pending
+	executionModel.Priority = prepared.priority
+	if scheduled {
+		executionModel.RunAt = runAt
+	}
 	return ctx, executionModel, nil
 }
 
@@ -886,83 +1364,687 @@ func (m *ExecutionManager) createExecutionModel(
 	m.systemMetrics.ExecutionsCreated.Inc()
 	m.systemMetrics.SpecSizeBytes.Observe(float64(len(executionModel.Spec)))
 	m.systemMetrics.ClosureSizeBytes.Observe(float64(len(executionModel.Closure)))
+	m.recordRelationships(ctx, &workflowExecutionIdentifier, executionModel)
 	return &workflowExecutionIdentifier, nil
 }
 
-func (m *ExecutionManager) CreateExecution(
-	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
-	*admin.ExecutionCreateResponse, error) {
-	// Prior to  flyteidl v0.15.0, Inputs was held in ExecutionSpec. Ensure older clients continue to work.
-	if request.Inputs == nil || len(request.Inputs.Literals) == 0 {
-		request.Inputs = request.GetSpec().GetInputs()
+// Records the executions a newly created execution was launched from, recovered or relaunched, when lineage is
+// enabled. Failures are logged rather than returned, since the execution has already been created.
+func (m *ExecutionManager) recordRelationships(ctx context.Context, executionID *core.WorkflowExecutionIdentifier,
+	executionModel *models.Execution) {
+	if !m.config.ApplicationConfiguration().GetTopLevelConfig().GetLineageConfig().Enabled {
+		return
 	}
-	var executionModel *models.Execution
-	var err error
-	ctx, executionModel, err = m.launchExecutionAndPrepareModel(ctx, request, requestedAt)
-	if err != nil {
-		return nil, err
+	var spec admin.ExecutionSpec
+	if err := proto.Unmarshal(executionModel.Spec, &spec); err != nil {
+		m.systemMetrics.LineageRecordFailures.Inc()
+		logger.Warningf(ctx, "Failed to unmarshal the spec of execution [%+v] with err: %v", executionID, err)
+		return
 	}
-	workflowExecutionIdentifier, err := m.createExecutionModel(ctx, executionModel)
-	if err != nil {
-		return nil, err
+	for _, relationship := range getExecutionRelationshipModels(executionID, &spec) {
+		if err := m.db.LineageRepo().CreateRelationship(ctx, relationship); err != nil {
+			m.systemMetrics.LineageRecordFailures.Inc()
+			logger.Warningf(ctx, "Failed to record the [%s] relationship of execution [%+v] with err: %v",
+				relationship.Kind, executionID, err)
+		}
 	}
-	return &admin.ExecutionCreateResponse{
-		Id: workflowExecutionIdentifier,
-	}, nil
 }
 
-func (m *ExecutionManager) RelaunchExecution(
-	ctx context.Context, request admin.ExecutionRelaunchRequest, requestedAt time.Time) (
-	*admin.ExecutionCreateResponse, error) {
-	existingExecutionModel, err := util.GetExecutionModel(ctx, m.db, *request.Id)
+// Returns the names of the execution phases which are terminal, or those which aren't, in a stable order.
+func getExecutionPhaseNames(terminal bool) []string {
+	phases := make([]string, 0, len(core.WorkflowExecution_Phase_name))
+	for value, name := range core.WorkflowExecution_Phase_name {
+		if common.IsExecutionTerminal(core.WorkflowExecution_Phase(value)) == terminal {
+			phases = append(phases, name)
+		}
+	}
+	sort.Strings(phases)
+	return phases
+}
+
+// Returns the filters selecting the pending executions which are due to run by now, or those which are running, in a
+// project and domain. Executions count as running from when they're launched until they terminate.
+func getAdmissionFilters(project, domain string, pending bool, now time.Time) ([]common.InlineFilter, error) {
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: project,
+		Domain:  domain,
+	}, common.Execution)
 	if err != nil {
-		logger.Debugf(ctx, "Failed to get execution model for request [%+v] with err %v", request, err)
 		return nil, err
 	}
-	existingExecution, err := transformers.FromExecutionModel(*existingExecutionModel)
+	pendingFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, "pending", pending)
 	if err != nil {
 		return nil, err
 	}
-
-	executionSpec := existingExecution.Spec
-	if executionSpec.Metadata == nil {
-		executionSpec.Metadata = &admin.ExecutionMetadata{}
-	}
-	var inputs *core.LiteralMap
-	if len(existingExecutionModel.UserInputsURI) > 0 {
-		inputs = &core.LiteralMap{}
-		if err := m.storageClient.ReadProtobuf(ctx, existingExecutionModel.UserInputsURI, inputs); err != nil {
-			return nil, err
-		}
+	var phaseFilter common.InlineFilter
+	if pending {
+		// Pending executions which were terminated before they were launched are aborted, and those which ran out of
+		// launch attempts failed.
+		phaseFilter, err = common.NewRepeatedValueFilter(common.Execution, common.ValueIn, "phase", []string{
+			core.WorkflowExecution_UNDEFINED.String(), core.WorkflowExecution_QUEUED.String()})
 	} else {
-		// For old data, inputs are held in the spec
-		var spec admin.ExecutionSpec
-		err = proto.Unmarshal(existingExecutionModel.Spec, &spec)
+		phaseFilter, err = common.NewRepeatedValueFilter(
+			common.Execution, common.ValueNotIn, "phase", getExecutionPhaseNames(true))
+	}
+	if err != nil {
+		return nil, err
+	}
+	filters = append(filters, pendingFilter, phaseFilter)
+	if pending {
+		dueFilter, err := getDueFilter(now)
 		if err != nil {
-			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal spec")
+			return nil, err
 		}
-		inputs = spec.Inputs
+		filters = append(filters, dueFilter)
 	}
-	executionSpec.Metadata.Mode = admin.ExecutionMetadata_RELAUNCH
-	executionSpec.Metadata.ReferenceExecution = existingExecution.Id
-	var executionModel *models.Execution
-	ctx, executionModel, err = m.launchExecutionAndPrepareModel(ctx, admin.ExecutionCreateRequest{
-		Project: request.Id.Project,
-		Domain:  request.Id.Domain,
-		Name:    request.Name,
-		Spec:    executionSpec,
-		Inputs:  inputs,
-	}, requestedAt)
+	return filters, nil
+}
+
+// Returns the filter selecting executions which either weren't requested to run at a later time, or are due by now.
+func getDueFilter(now time.Time) (common.InlineFilter, error) {
+	unscheduledFilter, err := common.NewNullCheckFilter(common.Execution, common.IsNull, "run_at")
 	if err != nil {
 		return nil, err
 	}
-	executionModel.SourceExecutionID = existingExecutionModel.ID
-	workflowExecutionIdentifier, err := m.createExecutionModel(ctx, executionModel)
+	dueFilter, err := common.NewSingleValueFilter(common.Execution, common.LessThanOrEqual, "run_at", now)
 	if err != nil {
 		return nil, err
 	}
-	logger.Debugf(ctx, "Successfully relaunched [%+v] as [%+v]", request.Id, workflowExecutionIdentifier)
-	return &admin.ExecutionCreateResponse{
+	return common.NewOrGroupFilter([]common.InlineFilter{unscheduledFilter, dueFilter})
+}
+
+func (m *ExecutionManager) countExecutions(ctx context.Context, project, domain string, pending bool) (int64, error) {
+	filters, err := getAdmissionFilters(project, domain, pending, m._clock.Now())
+	if err != nil {
+		return 0, err
+	}
+	return m.db.ExecutionRepo().Count(ctx, repositoryInterfaces.CountResourceInput{InlineFilters: filters})
+}
+
+// Returns whether admission control holds the executions of a project, which is enabled in config for every project
+// or by the admission_control feature flag for single ones.
+func (m *ExecutionManager) isAdmissionControlled(ctx context.Context, project string) (bool, error) {
+	if m.config.ApplicationConfiguration().GetTopLevelConfig().GetAdmissionConfig().Enabled {
+		return true, nil
+	}
+	return isFeatureEnabled(ctx, m.db, m.config.ApplicationConfiguration(), interfaces.FeatureFlagAdmissionControl,
+		project)
+}
+
+// Returns whether an execution must wait until its project and domain run fewer executions before it's launched.
+func (m *ExecutionManager) isAdmissionDeferred(
+	ctx context.Context, id core.WorkflowExecutionIdentifier, requestSpec *admin.ExecutionSpec) (bool, error) {
+	admissionConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetAdmissionConfig()
+	// Child executions aren't held, since their parents would wait on them while taking up the slots they need.
+	if requestSpec.GetMetadata().GetParentNodeExecution() != nil {
+		return false, nil
+	}
+	maxRunningExecutions := admissionConfig.GetMaxRunningExecutions(id.Project, id.Domain)
+	if maxRunningExecutions <= 0 {
+		return false, nil
+	}
+	if controlled, err := m.isAdmissionControlled(ctx, id.Project); err != nil || !controlled {
+		return false, err
+	}
+	// Executions wait behind those which are already pending, so that they're launched in the order they're created.
+	// Those scheduled to run later don't hold them back.
+	pending, err := m.countExecutions(ctx, id.Project, id.Domain, true)
+	if err != nil {
+		return false, err
+	}
+	if pending > 0 {
+		return true, nil
+	}
+	running, err := m.countExecutions(ctx, id.Project, id.Domain, false)
+	if err != nil {
+		return false, err
+	}
+	return running >= int64(maxRunningExecutions), nil
+}
+
+// Holds the admission lock of a project and domain, when admission control limits the executions they run, until the
+// returned function is called. Admission is decided, and admitted executions are launched and created, under the lock
+// so that concurrent launches aren't all admitted against the same number of running executions.
+func (m *ExecutionManager) lockAdmission(ctx context.Context, project, domain string) (func(), error) {
+	admissionConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetAdmissionConfig()
+	if admissionConfig.GetMaxRunningExecutions(project, domain) <= 0 {
+		return func() {}, nil
+	}
+	if controlled, err := m.isAdmissionControlled(ctx, project); err != nil || !controlled {
+		return func() {}, err
+	}
+	return m.db.ExecutionRepo().LockAdmission(ctx, project, domain)
+}
+
+// Launches the execution unless it's held as pending and creates its model, after customize was applied to it, while
+// holding the admission lock of its project and domain.
+func (m *ExecutionManager) launchAndCreateExecution(
+	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time, runAt *time.Time,
+	customize func(executionModel *models.Execution)) (context.Context, *core.WorkflowExecutionIdentifier, error) {
+	unlock := func() {}
+	// Child executions aren't held, so they needn't wait for the lock either.
+	if request.GetSpec().GetMetadata().GetParentNodeExecution() == nil {
+		var err error
+		if unlock, err = m.lockAdmission(ctx, request.Project, request.Domain); err != nil {
+			return nil, nil, err
+		}
+	}
+	defer unlock()
+	// Generated names can be taken by executions created concurrently, which the unique key on execution names
+	// catches. Propeller leaves workflows which already exist alone, so the execution is launched again under the
+	// next name.
+	generateName := len(request.Name) == 0
+	for attempt := 0; ; attempt++ {
+		if generateName {
+			name, err := m.getExecutionName(ctx, request, request.GetSpec().GetLaunchPlan().GetName(), requestedAt,
+				attempt)
+			if err != nil {
+				return nil, nil, err
+			}
+			request.Name = name
+		}
+		executionCtx, executionModel, err := m.launchExecutionAndPrepareModel(ctx, request, requestedAt, runAt)
+		if err != nil {
+			return nil, nil, err
+		}
+		if customize != nil {
+			customize(executionModel)
+		}
+		workflowExecutionIdentifier, err := m.createExecutionModel(executionCtx, executionModel)
+		if err == nil {
+			return executionCtx, workflowExecutionIdentifier, nil
+		}
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); !generateName || !ok ||
+			flyteAdminErr.Code() != codes.AlreadyExists {
+			return nil, nil, err
+		}
+		logger.Debugf(ctx, "Execution name [%s] is taken in [%s/%s], trying the next", request.Name,
+			request.Project, request.Domain)
+		request.Name = ""
+	}
+}
+
+// Launches a pending execution as it was prepared when it was created.
+func (m *ExecutionManager) dispatchExecution(ctx context.Context, executionModel models.Execution) error {
+	id := transformers.GetExecutionIdentifier(&executionModel)
+	ctx = getExecutionContext(ctx, &id)
+	execution, err := transformers.FromExecutionModel(executionModel)
+	if err != nil {
+		return err
+	}
+	inputs := &core.LiteralMap{}
+	if err := m.storageClient.ReadProtobuf(ctx, executionModel.UserInputsURI, inputs); err != nil {
+		return err
+	}
+	requestedAt := m._clock.Now()
+	ctx, prepared, err := m.prepareExecution(ctx, admin.ExecutionCreateRequest{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+		Spec:    execution.Spec,
+		Inputs:  inputs,
+	}, requestedAt, false)
+	if err != nil {
+		return err
+	}
+	// Executions launched more than once, such as by admins dispatching concurrently, run only once.
+	cluster, err := m.executeWorkflow(ctx, requestedAt, prepared.executeWorkflowInputs)
+	if err != nil {
+		return err
+	}
+	if err := m.db.ExecutionRepo().MarkDispatched(ctx, repositoryInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	}, cluster); err != nil {
+		return err
+	}
+	m.systemMetrics.ExecutionsDispatched.Inc()
+	logger.Infof(ctx, "Dispatched pending execution [%+v] to cluster [%s]", id, cluster)
+	return nil
+}
+
+// Records the error a pending execution failed to launch with, so that it's reported on the execution until it's
+// launched. Executions launched asynchronously fail once they run out of launch attempts.
+func (m *ExecutionManager) recordLaunchFailure(ctx context.Context, executionModel models.Execution, launchErr error) {
+	id := transformers.GetExecutionIdentifier(&executionModel)
+	ctx = getExecutionContext(ctx, &id)
+	asyncLaunchConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetExecutorConfig().AsyncLaunch
+	attempts := executionModel.LaunchAttempts + 1
+	if !asyncLaunchConfig.Enabled || asyncLaunchConfig.MaxAttempts <= 0 || attempts < asyncLaunchConfig.MaxAttempts {
+		if err := m.db.ExecutionRepo().RecordLaunchFailure(ctx, repositoryInterfaces.Identifier{
+			Project: id.Project,
+			Domain:  id.Domain,
+			Name:    id.Name,
+		}, launchErr.Error()); err != nil {
+			logger.Warningf(ctx, "Failed to record the launch failure of execution [%+v] with err: %v", id, err)
+		}
+		return
+	}
+	// Like pending executions which are aborted, those which fail are never launched and remain pending.
+	err := transformers.UpdateExecutionModelState(&executionModel, admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &id,
+			Phase:       core.WorkflowExecution_FAILED,
+			OccurredAt:  ptypes.TimestampNow(),
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Code:    common.LaunchFailedErrorCode,
+					Message: fmt.Sprintf("failed to launch after %d attempts: %v", attempts, launchErr),
+					Kind:    core.ExecutionError_SYSTEM,
+				},
+			},
+		},
+	})
+	if err != nil {
+		logger.Warningf(ctx, "Failed to fail execution [%+v] which ran out of launch attempts with err: %v", id, err)
+		return
+	}
+	executionModel.LaunchAttempts = attempts
+	executionModel.LaunchError = launchErr.Error()
+	if err := m.db.ExecutionRepo().Update(ctx, executionModel); err != nil {
+		logger.Warningf(ctx, "Failed to fail execution [%+v] which ran out of launch attempts with err: %v", id, err)
+		return
+	}
+	m.systemMetrics.ActiveExecutions.Dec()
+	m.systemMetrics.LaunchFailures.Inc()
+	if executionModel.FailureCause != nil {
+		m.systemMetrics.ExecutionFailures.WithLabelValues(*executionModel.FailureCause).Inc()
+	}
+	logger.Infof(ctx, "Failed execution [%+v] after it failed to launch %d times", id, attempts)
+}
+
+func (m *ExecutionManager) DispatchPendingExecutions(ctx context.Context) error {
+	filters, err := getAdmissionFilters("", "", true, m._clock.Now())
+	if err != nil {
+		return err
+	}
+	// Executions of each priority class are dispatched before those of less urgent classes, followed by those without a
+	// configured class.
+	var passes [][]common.InlineFilter
+	priorityClasses := m.config.ApplicationConfiguration().GetTopLevelConfig().GetPriorityConfig().Classes
+	for _, class := range priorityClasses {
+		classFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, "priority", class)
+		if err != nil {
+			return err
+		}
+		passes = append(passes, append(filters[:len(filters):len(filters)], classFilter))
+	}
+	if len(priorityClasses) > 0 {
+		otherClassFilter, err := common.NewRepeatedValueFilter(
+			common.Execution, common.ValueNotIn, "priority", priorityClasses)
+		if err != nil {
+			return err
+		}
+		// Executions created before priorities were recorded have none.
+		noClassFilter, err := common.NewNullCheckFilter(common.Execution, common.IsNull, "priority")
+		if err != nil {
+			return err
+		}
+		unclassifiedFilter, err := common.NewOrGroupFilter([]common.InlineFilter{otherClassFilter, noClassFilter})
+		if err != nil {
+			return err
+		}
+		passes = append(passes, append(filters[:len(filters):len(filters)], unclassifiedFilter))
+	} else {
+		passes = append(passes, filters)
+	}
+	// The number of executions each project and domain may still launch, as last counted.
+	availableSlots := make(map[string]int64)
+	for _, passFilters := range passes {
+		if err := m.dispatchPendingExecutions(ctx, passFilters, availableSlots); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Dispatches the pending executions matching filters in the order they were created, while their project and domain
+// have slots available.
+func (m *ExecutionManager) dispatchPendingExecutions(
+	ctx context.Context, filters []common.InlineFilter, availableSlots map[string]int64) error {
+	admissionConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetAdmissionConfig()
+	cursor := &repositoryInterfaces.ListCursor{}
+	for {
+		output, err := m.db.ExecutionRepo().List(ctx, repositoryInterfaces.ListResourceInput{
+			Limit:         admissionConfig.BatchSize,
+			InlineFilters: filters,
+			Cursor:        cursor,
+		})
+		if err != nil {
+			return err
+		}
+		for _, executionModel := range output.Executions {
+			key := fmt.Sprintf("%s/%s", executionModel.Project, executionModel.Domain)
+			// Projects and domains found to have no slots left aren't counted again in the same round.
+			if slots, ok := availableSlots[key]; ok && slots <= 0 {
+				continue
+			}
+			slots, err := m.dispatchAdmittedExecution(ctx, executionModel)
+			if err != nil {
+				return err
+			}
+			availableSlots[key] = slots
+		}
+		if len(output.Executions) == 0 || len(output.Executions) < admissionConfig.BatchSize {
+			return nil
+		}
+		last := output.Executions[len(output.Executions)-1]
+		cursor = &repositoryInterfaces.ListCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+}
+
+// Dispatches a pending execution if its project and domain have a slot available, counting their running executions
+// under their admission lock, and returns the number of slots they have left.
+func (m *ExecutionManager) dispatchAdmittedExecution(
+	ctx context.Context, executionModel models.Execution) (int64, error) {
+	unlock, err := m.lockAdmission(ctx, executionModel.Project, executionModel.Domain)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	slots := int64(math.MaxInt64)
+	// Executions scheduled to run at a later time are held even when admission control is disabled.
+	admissionConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetAdmissionConfig()
+	if maxRunningExecutions := admissionConfig.GetMaxRunningExecutions(
+		executionModel.Project, executionModel.Domain); maxRunningExecutions > 0 {
+		controlled, err := m.isAdmissionControlled(ctx, executionModel.Project)
+		if err != nil {
+			return 0, err
+		}
+		if controlled {
+			running, err := m.countExecutions(ctx, executionModel.Project, executionModel.Domain, false)
+			if err != nil {
+				return 0, err
+			}
+			slots = int64(maxRunningExecutions) - running
+		}
+	}
+	if slots <= 0 {
+		return slots, nil
+	}
+	if err := m.dispatchExecution(ctx, executionModel); err != nil {
+		m.systemMetrics.DispatchFailures.Inc()
+		logger.Warningf(ctx, "Failed to dispatch pending execution [%s/%s/%s] with err: %v",
+			executionModel.Project, executionModel.Domain, executionModel.Name, err)
+		m.recordLaunchFailure(ctx, executionModel, err)
+		return slots, nil
+	}
+	return slots - 1, nil
+}
+
+func (m *ExecutionManager) CreateExecution(
+	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
+	*admin.ExecutionCreateResponse, error) {
+	// Prior to  flyteidl v0.15.0, Inputs was held in ExecutionSpec. Ensure older clients continue to work.
+	if request.Inputs == nil || len(request.Inputs.Literals) == 0 {
+		request.Inputs = request.GetSpec().GetInputs()
+	}
+	// Only the names users set are checked, not those generated for idempotency keys.
+	if err := m.validateRequestedExecutionName(request); err != nil {
+		return nil, err
+	}
+	idempotencyKey := metautils.ExtractIncoming(ctx).Get(idempotencyKeyHeader)
+	if len(idempotencyKey) > 0 {
+		if err := validation.ValidateMaxLengthStringField(
+			idempotencyKey, idempotencyKeyHeader, maxIdempotencyKeyLength); err != nil {
+			return nil, err
+		}
+		existing, err := m.getExecutionByIdempotencyKey(ctx, request.Project, request.Domain, idempotencyKey)
+		if err != nil || existing != nil {
+			return existing, err
+		}
+		existing, release, err := m.reserveIdempotencyKey(ctx, request.Project, request.Domain, idempotencyKey)
+		if err != nil || existing != nil {
+			return existing, err
+		}
+		defer release()
+		// A retry which takes over the reservation of a request which stopped while launching launches the same
+		// execution.
+		if len(request.Name) == 0 {
+			request.Name = common.GetIdempotentExecutionName(request.Project, request.Domain, idempotencyKey)
+		}
+	}
+	runAt, err := getRunAt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, workflowExecutionIdentifier, err := m.launchAndCreateExecution(ctx, request, requestedAt, runAt,
+		func(executionModel *models.Execution) {
+			if len(idempotencyKey) > 0 {
+				executionModel.IdempotencyKey = &idempotencyKey
+			}
+		})
+	if err != nil {
+		return m.getExecutionCreatedConcurrently(ctx, request, idempotencyKey, err)
+	}
+	return &admin.ExecutionCreateResponse{
+		Id: workflowExecutionIdentifier,
+	}, nil
+}
+
+func (m *ExecutionManager) DryRunExecution(
+	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
+	*interfaces.DryRunExecutionResponse, error) {
+	// Older clients set the inputs in the spec, as they do when creating executions.
+	if request.Inputs == nil || len(request.Inputs.Literals) == 0 {
+		request.Inputs = request.GetSpec().GetInputs()
+	}
+	if err := validation.ValidateExecutionRequest(ctx, request, m.db, m.config.ApplicationConfiguration()); err != nil {
+		logger.Debugf(ctx, "Failed to validate ExecutionCreateRequest %+v with err %v", request, err)
+		return nil, err
+	}
+	if err := m.validateRequestedExecutionName(request); err != nil {
+		return nil, err
+	}
+	// Single task executions register the workflow and launch plan they run, which a dry run mustn't do.
+	if request.Spec.LaunchPlan.ResourceType == core.ResourceType_TASK {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"single task executions can't be dry run, dry run an execution of a launch plan instead")
+	}
+	if len(request.Name) > 0 {
+		exists, err := m.db.ExecutionRepo().Exists(ctx, repositoryInterfaces.Identifier{
+			Project: request.Project,
+			Domain:  request.Domain,
+			Name:    request.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, errors.NewFlyteAdminErrorf(codes.AlreadyExists,
+				"execution [%s/%s/%s] already exists", request.Project, request.Domain, request.Name)
+		}
+	}
+	runAt, err := getRunAt(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, prepared, err := m.prepareExecution(ctx, request, requestedAt, true)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = getExecutionNotifications(m.config.ApplicationConfiguration().GetNotificationsConfig(), &prepared.id,
+		prepared.launchPlan, prepared.requestSpec); err != nil {
+		return nil, err
+	}
+	pending := runAt != nil && runAt.After(requestedAt)
+	if !pending {
+		pending, err = m.isAdmissionDeferred(ctx, prepared.id, prepared.requestSpec)
+		if err != nil {
+			return nil, err
+		}
+	}
+	executeWorkflowInputs := prepared.executeWorkflowInputs
+	executeWorkflowInputs.DryRun = true
+	execInfo, err := m.workflowExecutor.ExecuteWorkflow(ctx, executeWorkflowInputs)
+	if err != nil {
+		logger.Infof(ctx, "Failed to dry run execution [%+v] with err %v", prepared.id, err)
+		return nil, err
+	}
+
+	spec := prepared.requestSpec
+	spec.Labels = &admin.Labels{Values: executeWorkflowInputs.Labels}
+	spec.Annotations = &admin.Annotations{Values: executeWorkflowInputs.Annotations}
+	spec.AuthRole = executeWorkflowInputs.Auth
+	logger.Debugf(ctx, "Dry ran execution [%+v] on cluster [%s]", prepared.id, execInfo.Cluster)
+	return &interfaces.DryRunExecutionResponse{
+		Id:            &prepared.id,
+		Spec:          spec,
+		Cluster:       execInfo.Cluster,
+		Pending:       pending,
+		FlyteWorkflow: execInfo.FlyteWorkflow,
+	}, nil
+}
+
+// Returns the time the execution was requested to run at, if any, as an RFC 3339 timestamp.
+func getRunAt(ctx context.Context) (*time.Time, error) {
+	value := metautils.ExtractIncoming(ctx).Get(runAtHeader)
+	if len(value) == 0 {
+		return nil, nil
+	}
+	runAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid %s [%s], expected an RFC 3339 timestamp: %v", runAtHeader, value, err)
+	}
+	return &runAt, nil
+}
+
+// Returns the response for the execution previously created with an idempotency key, or nil if there is none.
+func (m *ExecutionManager) getExecutionByIdempotencyKey(ctx context.Context, project, domain, idempotencyKey string) (
+	*admin.ExecutionCreateResponse, error) {
+	executionModel, err := m.db.ExecutionRepo().GetByIdempotencyKey(ctx, repositoryInterfaces.IdempotencyKey{
+		Project: project,
+		Domain:  domain,
+		Key:     idempotencyKey,
+	})
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+			return nil, nil
+		}
+		logger.Infof(ctx, "Failed to look up execution with idempotency key [%s] with err: %v", idempotencyKey, err)
+		return nil, err
+	}
+	logger.Debugf(ctx, "Returning execution [%s] previously created with idempotency key [%s]",
+		executionModel.Name, idempotencyKey)
+	return &admin.ExecutionCreateResponse{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: executionModel.Project,
+			Domain:  executionModel.Domain,
+			Name:    executionModel.Name,
+		},
+	}, nil
+}
+
+// Reserves an idempotency key so that concurrent retries of a request don't all launch its execution, and returns a
+// function which releases it once the execution is created or fails to launch. Returns the execution if a concurrent
+// request created it in the meantime, and an Aborted error if one is still creating it.
+func (m *ExecutionManager) reserveIdempotencyKey(ctx context.Context, project, domain, idempotencyKey string) (
+	existing *admin.ExecutionCreateResponse, release func(), err error) {
+	key := repositoryInterfaces.IdempotencyKey{
+		Project: project,
+		Domain:  domain,
+		Key:     idempotencyKey,
+	}
+	reservationID := uuid.New().String()
+	now := m._clock.Now()
+	err = m.db.ExecutionRepo().ReserveIdempotencyKey(ctx, repositoryInterfaces.ReserveIdempotencyKeyInput{
+		IdempotencyKey: key,
+		ReservationID:  reservationID,
+		Now:            now,
+		ReservedUntil:  now.Add(idempotencyKeyReservationDuration),
+	})
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); !ok || flyteAdminErr.Code() != codes.AlreadyExists {
+			logger.Infof(ctx, "Failed to reserve idempotency key [%s] with err: %v", idempotencyKey, err)
+			return nil, nil, err
+		}
+		existing, err = m.getExecutionByIdempotencyKey(ctx, project, domain, idempotencyKey)
+		if err != nil || existing != nil {
+			return existing, nil, err
+		}
+		return nil, nil, errors.NewFlyteAdminErrorf(codes.Aborted,
+			"an execution with idempotency key [%s] is being created, retry the request", idempotencyKey)
+	}
+	return nil, func() {
+		if err := m.db.ExecutionRepo().ReleaseIdempotencyKey(ctx, key, reservationID); err != nil {
+			logger.Warningf(ctx, "Failed to release idempotency key [%s] with err: %v", idempotencyKey, err)
+		}
+	}, nil
+}
+
+// Returns the execution created by a concurrent request with the same idempotency key in place of the error creating
+// it again, if there is one.
+func (m *ExecutionManager) getExecutionCreatedConcurrently(ctx context.Context, request admin.ExecutionCreateRequest,
+	idempotencyKey string, createErr error) (*admin.ExecutionCreateResponse, error) {
+	if len(idempotencyKey) == 0 {
+		return nil, createErr
+	}
+	existing, err := m.getExecutionByIdempotencyKey(ctx, request.Project, request.Domain, idempotencyKey)
+	if err != nil || existing == nil {
+		return nil, createErr
+	}
+	return existing, nil
+}
+
+func (m *ExecutionManager) RelaunchExecution(
+	ctx context.Context, request admin.ExecutionRelaunchRequest, requestedAt time.Time) (
+	*admin.ExecutionCreateResponse, error) {
+	existingExecutionModel, err := util.GetExecutionModel(ctx, m.db, *request.Id)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to get execution model for request [%+v] with err %v", request, err)
+		return nil, err
+	}
+	existingExecution, err := transformers.FromExecutionModel(*existingExecutionModel)
+	if err != nil {
+		return nil, err
+	}
+
+	executionSpec := existingExecution.Spec
+	if executionSpec.Metadata == nil {
+		executionSpec.Metadata = &admin.ExecutionMetadata{}
+	}
+	var inputs *core.LiteralMap
+	if len(existingExecutionModel.UserInputsURI) > 0 {
+		inputs = &core.LiteralMap{}
+		if err := m.storageClient.ReadProtobuf(ctx, existingExecutionModel.UserInputsURI, inputs); err != nil {
+			return nil, err
+		}
+	} else {
+		// For old data, inputs are held in the spec
+		var spec admin.ExecutionSpec
+		err = proto.Unmarshal(existingExecutionModel.Spec, &spec)
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal spec")
+		}
+		inputs = spec.Inputs
+	}
+	executionSpec.Metadata.Mode = admin.ExecutionMetadata_RELAUNCH
+	executionSpec.Metadata.ReferenceExecution = existingExecution.Id
+	createRequest := admin.ExecutionCreateRequest{
+		Project: request.Id.Project,
+		Domain:  request.Id.Domain,
+		Name:    request.Name,
+		Spec:    executionSpec,
+		Inputs:  inputs,
+	}
+	if err = m.validateRequestedExecutionName(createRequest); err != nil {
+		return nil, err
+	}
+	ctx, workflowExecutionIdentifier, err := m.launchAndCreateExecution(ctx, createRequest, requestedAt, nil,
+		func(executionModel *models.Execution) {
+			executionModel.SourceExecutionID = existingExecutionModel.ID
+		})
+	if err != nil {
+		return nil, err
+	}
+	logger.Debugf(ctx, "Successfully relaunched [%+v] as [%+v]", request.Id, workflowExecutionIdentifier)
+	return &admin.ExecutionCreateResponse{
 		Id: workflowExecutionIdentifier,
 	}, nil
 }
@@ -970,6 +2052,54 @@ func (m *ExecutionManager) RelaunchExecution(
 func (m *ExecutionManager) RecoverExecution(
 	ctx context.Context, request admin.ExecutionRecoverRequest, requestedAt time.Time) (
 	*admin.ExecutionCreateResponse, error) {
+	return m.RecoverExecutionWithOverrides(ctx, request, interfaces.RecoverExecutionOverrides{}, requestedAt)
+}
+
+// Returns the annotations of a recovered execution which limit the nodes it recovers to nodeIDs, after checking that
+// they all ran in the original execution.
+func (m *ExecutionManager) addRecoverNodesAnnotation(ctx context.Context,
+	existingExecutionID *core.WorkflowExecutionIdentifier, executionSpec *admin.ExecutionSpec, nodeIDs []string) (
+	*admin.Annotations, error) {
+	for _, nodeID := range nodeIDs {
+		exists, err := m.db.NodeExecutionRepo().Exists(ctx, repositoryInterfaces.NodeExecutionResource{
+			NodeExecutionIdentifier: core.NodeExecutionIdentifier{
+				NodeId:      nodeID,
+				ExecutionId: existingExecutionID,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"node [%s] did not run in execution [%+v] and can't be recovered", nodeID, existingExecutionID)
+		}
+	}
+	// Annotations set on the spec replace rather than add to those of the launch plan.
+	annotations := make(map[string]string)
+	if executionSpec.Annotations != nil && executionSpec.Annotations.Values != nil {
+		annotations = executionSpec.Annotations.Values
+	} else {
+		launchPlan, err := util.GetLaunchPlan(ctx, m.db, *executionSpec.LaunchPlan)
+		if err != nil {
+			return nil, err
+		}
+		if launchPlan.Spec.Annotations != nil {
+			for key, value := range launchPlan.Spec.Annotations.Values {
+				annotations[key] = value
+			}
+		}
+	}
+	annotations[recoverNodesAnnotationKey] = strings.Join(nodeIDs, ",")
+	return &admin.Annotations{Values: annotations}, nil
+}
+
+func (m *ExecutionManager) RecoverExecutionWithOverrides(ctx context.Context, request admin.ExecutionRecoverRequest,
+	overrides interfaces.RecoverExecutionOverrides, requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+	if overrides.Inputs != nil && len(overrides.NodeIDs) > 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"nodes can't be selected for recovery when inputs are replaced, as no outputs are recovered")
+	}
 	existingExecutionModel, err := util.GetExecutionModel(ctx, m.db, *request.Id)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to get execution model for request [%+v] with err %v", request, err)
@@ -991,24 +2121,49 @@ func (m *ExecutionManager) RecoverExecution(
 			return nil, err
 		}
 	}
+	if overrides.Inputs != nil {
+		if inputs == nil {
+			inputs = &core.LiteralMap{}
+		}
+		if inputs.Literals == nil {
+			inputs.Literals = make(map[string]*core.Literal)
+		}
+		// Unknown inputs are rejected when the inputs are checked against the launch plan.
+		for name, literal := range overrides.Inputs.Literals {
+			inputs.Literals[name] = literal
+		}
+	}
+	if len(overrides.NodeIDs) > 0 {
+		executionSpec.Annotations, err = m.addRecoverNodesAnnotation(
+			ctx, existingExecution.Id, executionSpec, overrides.NodeIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if request.Metadata != nil {
 		executionSpec.Metadata.ParentNodeExecution = request.Metadata.ParentNodeExecution
 	}
 	executionSpec.Metadata.Mode = admin.ExecutionMetadata_RECOVERED
+	if overrides.Inputs != nil {
+		// The outputs of the original execution may depend on the replaced inputs, so none are recovered and the
+		// execution is relaunched instead. It still references the original execution.
+		executionSpec.Metadata.Mode = admin.ExecutionMetadata_RELAUNCH
+	}
 	executionSpec.Metadata.ReferenceExecution = existingExecution.Id
-	var executionModel *models.Execution
-	ctx, executionModel, err = m.launchExecutionAndPrepareModel(ctx, admin.ExecutionCreateRequest{
+	createRequest := admin.ExecutionCreateRequest{
 		Project: request.Id.Project,
 		Domain:  request.Id.Domain,
 		Name:    request.Name,
 		Spec:    executionSpec,
 		Inputs:  inputs,
-	}, requestedAt)
-	if err != nil {
+	}
+	if err = m.validateRequestedExecutionName(createRequest); err != nil {
 		return nil, err
 	}
-	executionModel.SourceExecutionID = existingExecutionModel.ID
-	workflowExecutionIdentifier, err := m.createExecutionModel(ctx, executionModel)
+	ctx, workflowExecutionIdentifier, err := m.launchAndCreateExecution(ctx, createRequest, requestedAt, nil,
+		func(executionModel *models.Execution) {
+			executionModel.SourceExecutionID = existingExecutionModel.ID
+		})
 	if err != nil {
 		return nil, err
 	}
@@ -1153,6 +2308,9 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 		logger.Debugf(ctx, "received invalid CreateWorkflowEventRequest [%s]: %v", request.RequestId, err)
 		return nil, err
 	}
+	if m.recordedEventWriter != nil {
+		m.recordedEventWriter.Write(getRecordedWorkflowEventModel(request))
+	}
 	ctx = getExecutionContext(ctx, request.Event.ExecutionId)
 	logger.Debugf(ctx, "Received workflow execution event for [%+v] transitioning to phase [%v]",
 		request.Event.ExecutionId, request.Event.Phase)
@@ -1165,6 +2323,23 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 	}
 
 	wfExecPhase := core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[executionModel.Phase])
+	if m.config.ApplicationConfiguration().GetTopLevelConfig().GetEventReconciliationConfig().Enabled {
+		sequence := eventSequence{
+			duplicate:         wfExecPhase == request.Event.Phase,
+			currentIsTerminal: common.IsExecutionTerminal(wfExecPhase),
+			eventIsTerminal:   common.IsExecutionTerminal(request.Event.Phase),
+			occurredAt:        request.Event.OccurredAt,
+		}
+		// Executions are created before any event is received, so until one is applied there is nothing to be stale.
+		if wfExecPhase != core.WorkflowExecution_UNDEFINED {
+			sequence.lastAppliedAt = executionModel.ExecutionUpdatedAt
+		}
+		if reason := getEventSkipReason(sequence); reason != "" {
+			recordSkippedEvent(ctx, m.db, getSkippedEventModel(interfaces.WorkflowEventType, request.Event.ExecutionId,
+				request.RequestId, request.Event.Phase.String(), request.Event.OccurredAt, wfExecPhase.String(), reason))
+			return &admin.WorkflowExecutionEventResponse{}, nil
+		}
+	}
 	if wfExecPhase == request.Event.Phase {
 		logger.Debugf(ctx, "This phase %s was already recorded for workflow execution %v",
 			wfExecPhase.String(), request.Event.ExecutionId)
@@ -1184,7 +2359,19 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 			request.Event.ExecutionId, err)
 		return nil, err
 	}
-	err = m.db.ExecutionRepo().Update(ctx, *executionModel)
+	useOutbox := m.config.ApplicationConfiguration().GetTopLevelConfig().GetOutboxConfig().Enabled
+	if useOutbox {
+		// Notifications and events are published by the outbox relay once the update is committed.
+		messages, err := m.getOutboxMessages(ctx, request, *executionModel)
+		if err != nil {
+			logger.Debugf(ctx, "failed to create outbox messages for CreateWorkflowEvent [%+v] due to err: %v",
+				request, err)
+			return nil, err
+		}
+		err = m.db.ExecutionRepo().UpdateWithMessages(ctx, *executionModel, messages)
+	} else {
+		err = m.db.ExecutionRepo().Update(ctx, *executionModel)
+	}
 	if err != nil {
 		logger.Debugf(ctx, "Failed to update execution with CreateWorkflowEvent [%+v] with err %v",
 			request, err)
@@ -1201,23 +2388,30 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request admi
 	} else if common.IsExecutionTerminal(request.Event.Phase) {
 		m.systemMetrics.ActiveExecutions.Dec()
 		m.systemMetrics.ExecutionsTerminated.Inc()
+		if executionModel.FailureCause != nil {
+			m.systemMetrics.ExecutionFailures.WithLabelValues(*executionModel.FailureCause).Inc()
+		}
 		go m.emitOverallWorkflowExecutionTime(executionModel, request.Event.OccurredAt)
 		if request.Event.GetOutputData() != nil {
 			m.userMetrics.WorkflowExecutionOutputBytes.Observe(float64(proto.Size(request.Event.GetOutputData())))
 		}
 
-		err = m.publishNotifications(ctx, request, *executionModel)
-		if err != nil {
-			// The only errors that publishNotifications will forward are those related
-			// to unexpected data and transformation errors.
-			logger.Debugf(ctx, "failed to publish notifications for CreateWorkflowEvent [%+v] due to err: %v",
-				request, err)
-			return nil, err
+		if !useOutbox {
+			err = m.publishNotifications(ctx, request, *executionModel)
+			if err != nil {
+				// The only errors that publishNotifications will forward are those related
+				// to unexpected data and transformation errors.
+				logger.Debugf(ctx, "failed to publish notifications for CreateWorkflowEvent [%+v] due to err: %v",
+					request, err)
+				return nil, err
+			}
 		}
 	}
-	if err := m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
-		m.systemMetrics.PublishEventError.Inc()
-		logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
+	if !useOutbox {
+		if err := m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
+			m.systemMetrics.PublishEventError.Inc()
+			logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
+		}
 	}
 
 	m.systemMetrics.ExecutionEventsCreated.Inc()
@@ -1246,36 +2440,54 @@ func (m *ExecutionManager) GetExecution(
 	return execution, nil
 }
 
-func (m *ExecutionManager) GetExecutionData(
-	ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (*admin.WorkflowExecutionGetDataResponse, error) {
-	ctx = getExecutionContext(ctx, request.Id)
+// Returns the model of an execution whose data is fetched, offloading the inputs of legacy executions which are still
+// held in their closure.
+func (m *ExecutionManager) getExecutionForData(ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (
+	*models.Execution, *admin.Execution, error) {
 	executionModel, err := util.GetExecutionModel(ctx, m.db, *request.Id)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to get execution model for request [%+v] with err: %v", request, err)
-		return nil, err
+		return nil, nil, err
 	}
 	execution, err := transformers.FromExecutionModel(*executionModel)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to transform execution model [%+v] to proto object with err: %v", request.Id, err)
-		return nil, err
+		return nil, nil, err
 	}
 	// Prior to flyteidl v0.15.0, Inputs were held in ExecutionClosure and were not offloaded. Ensure we can return the inputs as expected.
 	if len(executionModel.InputsURI) == 0 {
 		closure := &admin.ExecutionClosure{}
 		// We must not use the FromExecutionModel method because it empties deprecated fields.
 		if err := proto.Unmarshal(executionModel.Closure, closure); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		newInputsURI, err := m.offloadInputs(ctx, closure.ComputedInputs, request.Id, shared.Inputs)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		// Update model so as not to offload again.
 		executionModel.InputsURI = newInputsURI
+		offloadingConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetLiteralOffloadingConfig()
+		if offloadingConfig.Enabled && int64(proto.Size(closure.ComputedInputs)) >= offloadingConfig.MinSizeBytes {
+			closure.ComputedInputs = nil
+			if executionModel.Closure, err = proto.Marshal(closure); err != nil {
+				return nil, nil, err
+			}
+		}
 		if err := m.db.ExecutionRepo().Update(ctx, *executionModel); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
+	return executionModel, execution, nil
+}
+
+func (m *ExecutionManager) GetExecutionData(
+	ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (*admin.WorkflowExecutionGetDataResponse, error) {
+	ctx = getExecutionContext(ctx, request.Id)
+	executionModel, execution, err := m.getExecutionForData(ctx, request)
+	if err != nil {
+		return nil, err
+	}
 	inputs, inputURLBlob, err := util.GetInputs(ctx, m.urlData, m.config.ApplicationConfiguration().GetRemoteDataConfig(),
 		m.storageClient, executionModel.InputsURI.String())
 	if err != nil {
@@ -1302,6 +2514,38 @@ func (m *ExecutionManager) GetExecutionData(
 	return response, nil
 }
 
+func (m *ExecutionManager) GetExecutionDataWithOptions(ctx context.Context,
+	request admin.WorkflowExecutionGetDataRequest, options interfaces.GetDataOptions) (
+	*interfaces.GetDataResponse, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(request.Id); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateGetDataOptions(options); err != nil {
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.Id)
+	executionModel, execution, err := m.getExecutionForData(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	remoteDataConfig := m.config.ApplicationConfiguration().GetRemoteDataConfig()
+	selector := util.NewLiteralSelector(options)
+	inputs, err := util.GetPartialInputs(ctx, m.urlData, remoteDataConfig, m.storageClient,
+		executionModel.InputsURI.String(), selector)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := util.GetPartialOutputs(ctx, m.urlData, remoteDataConfig, m.storageClient,
+		util.ToExecutionClosureInterface(execution.Closure), selector)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.GetDataResponse{
+		Inputs:  inputs,
+		Outputs: outputs,
+	}, nil
+}
+
 func (m *ExecutionManager) ListExecutions(
 	ctx context.Context, request admin.ResourceListRequest) (*admin.ExecutionList, error) {
 	// Check required fields
@@ -1319,18 +2563,34 @@ func (m *ExecutionManager) ListExecutions(
 	if err != nil {
 		return nil, err
 	}
+	filters, err = util.AddProjectVisibilityFilter(ctx, common.Execution, filters)
+	if err != nil {
+		return nil, err
+	}
 	var sortParameter common.SortParameter
 	if request.SortBy != nil {
-		sortParameter, err = common.NewSortParameter(*request.SortBy)
+		sortParameter, err = util.GetExecutionSortParameter(*request.SortBy)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	offset, err := validation.ValidateToken(request.Token)
-	if err != nil {
-		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid pagination token %s for ListExecutions",
-			request.Token)
+	// Lists sorted by creation time are paginated with keyset cursors, which stay fast no matter how many executions
+	// precede the requested page.
+	var cursor *repositoryInterfaces.ListCursor
+	if util.SupportsCursorPagination(request.SortBy) {
+		cursor, err = util.ParseCursorToken(request.Token, request.SortBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var offset int
+	if cursor == nil {
+		offset, err = validation.ValidateToken(request.Token)
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid pagination token %s for ListExecutions", request.Token)
+		}
 	}
 	joinTableEntities := make(map[common.Entity]bool)
 	for _, filter := range filters {
@@ -1342,6 +2602,7 @@ func (m *ExecutionManager) ListExecutions(
 		InlineFilters:     filters,
 		SortParameter:     sortParameter,
 		JoinTableEntities: joinTableEntities,
+		Cursor:            cursor,
 	}
 	output, err := m.db.ExecutionRepo().List(ctx, listExecutionsInput)
 	if err != nil {
@@ -1364,7 +2625,16 @@ func (m *ExecutionManager) ListExecutions(
 	// END TO BE DELETED
 	var token string
 	if len(executionList) == int(request.Limit) {
-		token = strconv.Itoa(offset + len(executionList))
+		if cursor != nil {
+			lastExecution := output.Executions[len(output.Executions)-1]
+			token, err = util.NewCursorToken(lastExecution.CreatedAt, lastExecution.ID)
+			if err != nil {
+				return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+					"failed to create pagination token for ListExecutions with err: %v", err)
+			}
+		} else {
+			token = strconv.Itoa(offset + len(executionList))
+		}
 	}
 	return &admin.ExecutionList{
 		Executions: executionList,
@@ -1372,19 +2642,39 @@ func (m *ExecutionManager) ListExecutions(
 	}, nil
 }
 
-// publishNotifications will only forward major errors because the assumption made is all of the objects
-// that are being manipulated have already been validated/manipulated by Flyte itself.
-// Note: This method should be refactored somewhere else once the interaction with pushing to SNS.
-func (m *ExecutionManager) publishNotifications(ctx context.Context, request admin.WorkflowExecutionEventRequest,
-	execution models.Execution) error {
+// A notification to publish, along with the notification type it's published under.
+type notificationMessage struct {
+	notificationType string
+	message          proto.Message
+}
+
+// getNotificationMessages returns the messages for the notifications matching the phase an execution transitioned
+// to. It will only forward major errors because the assumption made is all of the objects that are being manipulated
+// have already been validated/manipulated by Flyte itself.
+func (m *ExecutionManager) getNotificationMessages(ctx context.Context, request admin.WorkflowExecutionEventRequest,
+	execution models.Execution) ([]notificationMessage, error) {
 	// Notifications are stored in the Spec object of an admin.Execution object.
 	adminExecution, err := transformers.FromExecutionModel(execution)
 	if err != nil {
 		// This shouldn't happen because execution manager marshaled the data into models.Execution.
 		m.systemMetrics.TransformerError.Inc()
-		return errors.NewFlyteAdminErrorf(codes.Internal, "Failed to transform execution [%+v] with err: %v", request.Event.ExecutionId, err)
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "Failed to transform execution [%+v] with err: %v", request.Event.ExecutionId, err)
 	}
+	notificationsConfig := m.config.ApplicationConfiguration().GetNotificationsConfig()
 	var notificationsList = adminExecution.Closure.Notifications
+	// Subscribers are notified of the executions of their launch plans and projects, unless an execution disables
+	// notifications.
+	if notificationsConfig.EnableSubscriptions && !adminExecution.GetSpec().GetDisableAll() {
+		subscriptions, err := m.db.NotificationSubscriptionRepo().ListForLaunchPlan(ctx,
+			request.Event.ExecutionId.Project, request.Event.ExecutionId.Domain,
+			adminExecution.GetSpec().GetLaunchPlan().GetName())
+		if err != nil {
+			return nil, err
+		}
+		notificationsList = append(append([]*admin.Notification{}, notificationsList...),
+			getSubscriptionNotifications(notificationsList, subscriptions, request.Event.Phase)...)
+	}
+	messages := make([]notificationMessage, 0)
 	logger.Debugf(ctx, "publishing notifications for execution [%+v] in state [%+v] for notifications [%+v]",
 		request.Event.ExecutionId, request.Event.Phase, notificationsList)
 	for _, notification := range notificationsList {
@@ -1401,7 +2691,29 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request adm
 			continue
 		}
 
-		// Currently all three supported notifications use email underneath to send the notification.
+		// Slack notifications are posted to the chat webhook configured for the execution's project and domain,
+		// if there is one.
+		if notification.GetSlack() != nil {
+			webhook, ok := notificationsConfig.NotificationsWebhooksConfig.GetWebhook(
+				request.Event.ExecutionId.Project, request.Event.ExecutionId.Domain)
+			if ok {
+				webhookNotification, err := implementations.NewWebhookNotification(
+					*notifications.ToWebhookMessageFromWorkflowExecutionEvent(
+						notificationsConfig.NotificationsWebhooksConfig, webhook.Type, request, adminExecution))
+				if err != nil {
+					return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+						"failed to serialize webhook notification for execution [%+v] with err: %v",
+						request.Event.ExecutionId, err)
+				}
+				messages = append(messages, notificationMessage{
+					notificationType: webhookNotificationType,
+					message:          webhookNotification,
+				})
+				continue
+			}
+		}
+
+		// Otherwise all three supported notifications use email underneath to send the notification.
 		// Convert Slack and PagerDuty into an EmailNotification type.
 		var emailNotification admin.EmailNotification
 		if notification.GetEmail() != nil {
@@ -1414,25 +2726,222 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request adm
 			logger.Debugf(ctx, "failed to publish notification, encountered unrecognized type: %v", notification.Type)
 			m.systemMetrics.UnexpectedDataError.Inc()
 			// Unsupported notification types should have been caught when the launch plan was being created.
-			return errors.NewFlyteAdminErrorf(codes.Internal, "Unsupported notification type [%v] for execution [%+v]",
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "Unsupported notification type [%v] for execution [%+v]",
 				notification.Type, request.Event.ExecutionId)
 		}
 
+		// Emails for executions subscribed to digests are batched into a periodic digest instead of sent one by one.
+		subscription, ok := notificationsConfig.NotificationsDigestsConfig.GetSubscription(
+			request.Event.ExecutionId.Project, request.Event.ExecutionId.Domain,
+			adminExecution.GetSpec().GetLaunchPlan().GetName())
+		if ok {
+			digestEntryNotification, err := implementations.NewDigestEntryNotification(
+				*notifications.ToDigestEntryMessageFromWorkflowExecutionEvent(
+					notificationsConfig.NotificationsDigestsConfig, subscription, emailNotification, request,
+					adminExecution))
+			if err != nil {
+				return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+					"failed to serialize digest entry for execution [%+v] with err: %v",
+					request.Event.ExecutionId, err)
+			}
+			messages = append(messages, notificationMessage{
+				notificationType: digestEntryNotificationType,
+				message:          digestEntryNotification,
+			})
+			continue
+		}
+
 		// Convert the email Notification into an email message to be published.
 		// Currently there are no possible errors while creating an email message.
 		// Once customizable content is specified, errors are possible.
-		email := notifications.ToEmailMessageFromWorkflowExecutionEvent(
-			*m.config.ApplicationConfiguration().GetNotificationsConfig(), emailNotification, request, adminExecution)
+		messages = append(messages, notificationMessage{
+			notificationType: emailNotificationType,
+			message: notifications.ToEmailMessageFromWorkflowExecutionEvent(
+				*notificationsConfig, emailNotification, request, adminExecution),
+		})
+	}
+
+	// Independently of the notifications requested for it, an execution in a project and domain with a paging
+	// service configured opens an incident for its launch plan when it fails, and resolves it when it succeeds.
+	incidents, ok := notificationsConfig.NotificationsIncidentsConfig.GetEndpoint(
+		request.Event.ExecutionId.Project, request.Event.ExecutionId.Domain)
+	if ok {
+		incidentMessage := notifications.ToIncidentMessageFromWorkflowExecutionEvent(
+			notificationsConfig.NotificationsIncidentsConfig, incidents.Type, request, adminExecution)
+		if incidentMessage != nil {
+			incidentNotification, err := implementations.NewIncidentNotification(*incidentMessage)
+			if err != nil {
+				return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+					"failed to serialize incident notification for execution [%+v] with err: %v",
+					request.Event.ExecutionId, err)
+			}
+			messages = append(messages, notificationMessage{
+				notificationType: incidentNotificationType,
+				message:          incidentNotification,
+			})
+		}
+	}
+
+	if notificationsConfig.RegisteredWebhooksConfig.Enabled {
+		deliveries, err := m.getWebhookDeliveries(ctx, request, adminExecution)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, deliveries...)
+	}
+	if notificationsConfig.TrackDeliveries {
+		return trackNotificationMessages(request.Event.ExecutionId, messages)
+	}
+	return messages, nil
+}
+
+// Wraps notifications so that the processor records whether they're delivered.
+func trackNotificationMessages(executionID *core.WorkflowExecutionIdentifier, messages []notificationMessage) (
+	[]notificationMessage, error) {
+	trackedMessages := make([]notificationMessage, 0, len(messages))
+	for _, message := range messages {
+		data, err := proto.Marshal(message.message)
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to serialize %s notification for execution [%+v] with err: %v",
+				message.notificationType, executionID, err)
+		}
+		trackedNotification, err := implementations.NewTrackedNotification(notificationInterfaces.TrackedNotification{
+			Project:       executionID.Project,
+			Domain:        executionID.Domain,
+			ExecutionName: executionID.Name,
+			Notification:  data,
+		})
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to serialize tracked %s notification for execution [%+v] with err: %v",
+				message.notificationType, executionID, err)
+		}
+		trackedMessages = append(trackedMessages, notificationMessage{
+			notificationType: trackedNotificationType,
+			message:          trackedNotification,
+		})
+	}
+	return trackedMessages, nil
+}
+
+// Returns a delivery to each webhook registered for the execution's project and domain which the execution reached one
+// of the phases of.
+func (m *ExecutionManager) getWebhookDeliveries(ctx context.Context, request admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) ([]notificationMessage, error) {
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: execution.Id.Project,
+		Domain:  execution.Id.Domain,
+	}, common.Webhook)
+	if err != nil {
+		return nil, err
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       shared.Name,
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]notificationMessage, 0)
+	for offset := 0; ; offset += webhooksBatchSize {
+		output, err := m.db.WebhookRepo().List(ctx, repositoryInterfaces.ListResourceInput{
+			Limit:         webhooksBatchSize,
+			Offset:        offset,
+			InlineFilters: filters,
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, webhook := range output.Webhooks {
+			if !isWebhookPhase(webhook, request.Event.Phase) {
+				continue
+			}
+			// Templates are validated when webhooks are registered, so rendering them fails only unexpectedly.
+			payload, err := notifications.RenderWebhookPayload(webhook.Template, request, execution)
+			if err != nil {
+				m.systemMetrics.UnexpectedDataError.Inc()
+				logger.Warningf(ctx, "failed to render payload of webhook [%s] for execution [%+v] with err: %v",
+					webhook.Name, request.Event.ExecutionId, err)
+				continue
+			}
+			deliveryNotification, err := implementations.NewWebhookDeliveryNotification(
+				notificationInterfaces.WebhookDeliveryMessage{
+					Project:       webhook.Project,
+					Domain:        webhook.Domain,
+					WebhookName:   webhook.Name,
+					ExecutionName: execution.Id.Name,
+					Phase:         request.Event.Phase.String(),
+					Payload:       payload,
+				})
+			if err != nil {
+				return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+					"failed to serialize delivery to webhook [%s] for execution [%+v] with err: %v",
+					webhook.Name, request.Event.ExecutionId, err)
+			}
+			deliveries = append(deliveries, notificationMessage{
+				notificationType: webhookDeliveryNotificationType,
+				message:          deliveryNotification,
+			})
+		}
+		if len(output.Webhooks) < webhooksBatchSize {
+			return deliveries, nil
+		}
+	}
+}
+
+// publishNotifications will only forward major errors because the assumption made is all of the objects
+// that are being manipulated have already been validated/manipulated by Flyte itself.
+// Note: This method should be refactored somewhere else once the interaction with pushing to SNS.
+func (m *ExecutionManager) publishNotifications(ctx context.Context, request admin.WorkflowExecutionEventRequest,
+	execution models.Execution) error {
+	messages, err := m.getNotificationMessages(ctx, request, execution)
+	if err != nil {
+		return err
+	}
+	for _, message := range messages {
 		// Errors seen while publishing a message are considered non-fatal to the method and will not result
 		// in the method returning an error.
-		if err = m.notificationClient.Publish(ctx, proto.MessageName(&emailNotification), email); err != nil {
+		if err = m.notificationClient.Publish(ctx, message.notificationType, message.message); err != nil {
 			m.systemMetrics.PublishNotificationError.Inc()
-			logger.Infof(ctx, "error publishing email notification [%+v] with err: [%v]", notification, err)
+			logger.Infof(ctx, "error publishing %s notification [%+v] with err: [%v]",
+				message.notificationType, message.message, err)
 		}
 	}
 	return nil
 }
 
+// Returns the notifications and event to be published for a workflow execution event, which are written to the
+// outbox along with the execution update.
+func (m *ExecutionManager) getOutboxMessages(ctx context.Context, request admin.WorkflowExecutionEventRequest,
+	execution models.Execution) ([]models.OutboxMessage, error) {
+	messages := make([]models.OutboxMessage, 0)
+	if common.IsExecutionTerminal(request.Event.Phase) {
+		notificationMessages, err := m.getNotificationMessages(ctx, request, execution)
+		if err != nil {
+			return nil, err
+		}
+		for _, notificationMessage := range notificationMessages {
+			message, err := outbox.NewMessage(
+				outbox.NotificationsPublisher, notificationMessage.notificationType, notificationMessage.message)
+			if err != nil {
+				return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+					"failed to serialize %s notification for execution [%+v] with err: %v",
+					notificationMessage.notificationType, request.Event.ExecutionId, err)
+			}
+			messages = append(messages, message)
+		}
+	}
+	message, err := outbox.NewMessage(outbox.EventsPublisher, proto.MessageName(&request), &request)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to serialize event [%s] for execution [%+v] with err: %v",
+			request.RequestId, request.Event.ExecutionId, err)
+	}
+	return append(messages, message), nil
+}
+
 func (m *ExecutionManager) TerminateExecution(
 	ctx context.Context, request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error) {
 	if err := validation.ValidateWorkflowExecutionIdentifier(request.Id); err != nil {
@@ -1451,12 +2960,27 @@ func (m *ExecutionManager) TerminateExecution(
 		return nil, err
 	}
 
-	err = m.workflowExecutor.TerminateWorkflowExecution(ctx, workflowengineInterfaces.TerminateWorkflowInput{
-		ExecutionID: request.Id,
-		Cluster:     executionModel.Cluster,
-	})
-	if err != nil {
-		return nil, err
+	if executionModel.Pending {
+		// Pending executions were never launched, so they're aborted without a workflow to terminate.
+		err = transformers.UpdateExecutionModelState(&executionModel, admin.WorkflowExecutionEventRequest{
+			Event: &event.WorkflowExecutionEvent{
+				ExecutionId: request.Id,
+				Phase:       core.WorkflowExecution_ABORTED,
+				OccurredAt:  ptypes.TimestampNow(),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		m.systemMetrics.ActiveExecutions.Dec()
+	} else {
+		err = m.workflowExecutor.TerminateWorkflowExecution(ctx, workflowengineInterfaces.TerminateWorkflowInput{
+			ExecutionID: request.Id,
+			Cluster:     executionModel.Cluster,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = transformers.SetExecutionAborted(&executionModel, request.Cause, getUser(ctx))
@@ -1472,6 +2996,273 @@ func (m *ExecutionManager) TerminateExecution(
 	return &admin.ExecutionTerminateResponse{}, nil
 }
 
+// Returns the filters selecting the executions to terminate in bulk.
+func getTerminateExecutionsFilters(request interfaces.TerminateExecutionsRequest) ([]common.InlineFilter, error) {
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: request.Project,
+		Domain:  request.Domain,
+	}, common.Execution)
+	if err != nil {
+		return nil, err
+	}
+	phases := make([]string, 0, len(request.Phases))
+	for _, phase := range request.Phases {
+		phases = append(phases, phase.String())
+	}
+	if len(phases) == 0 {
+		phases = getExecutionPhaseNames(false)
+	}
+	phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueIn, "phase", phases)
+	if err != nil {
+		return nil, err
+	}
+	filters = append(filters, phaseFilter)
+	if len(request.LaunchPlanName) > 0 {
+		launchPlanFilter, err := common.NewSingleValueFilter(
+			common.LaunchPlan, common.Equal, shared.Name, request.LaunchPlanName)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, launchPlanFilter)
+	}
+	if request.CreatedBefore != nil {
+		createdAtFilter, err := common.NewSingleValueFilter(
+			common.Execution, common.LessThan, "created_at", *request.CreatedBefore)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, createdAtFilter)
+	}
+	return filters, nil
+}
+
+// The layout of the days executions are counted by.
+const executionCountDayLayout = "2006-01-02"
+
+func fromExecutionGroupCount(count models.ExecutionGroupCount) (interfaces.ExecutionGroupCount, error) {
+	group := interfaces.ExecutionGroupCount{
+		Project: count.Project,
+		Domain:  count.Domain,
+		Count:   count.Count,
+	}
+	if len(count.Phase) > 0 {
+		group.Phase = core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[count.Phase])
+	}
+	if len(count.Day) > 0 {
+		day, err := time.Parse(executionCountDayLayout, count.Day)
+		if err != nil {
+			return interfaces.ExecutionGroupCount{}, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to parse the day [%s] executions were counted on: %v", count.Day, err)
+		}
+		group.Day = day
+	}
+	return group, nil
+}
+
+func (m *ExecutionManager) CountExecutions(ctx context.Context, request interfaces.ExecutionCountRequest) (
+	*interfaces.ExecutionCountResponse, error) {
+	if err := validation.ValidateExecutionCountRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project:        request.Project,
+		Domain:         request.Domain,
+		RequestFilters: request.Filters,
+	}, common.Execution)
+	if err != nil {
+		return nil, err
+	}
+	filters, err = util.AddProjectVisibilityFilter(ctx, common.Execution, filters)
+	if err != nil {
+		return nil, err
+	}
+	joinTableEntities := make(map[common.Entity]bool)
+	for _, filter := range filters {
+		joinTableEntities[filter.GetEntity()] = true
+	}
+	totals, err := m.db.ExecutionRepo().CountGroups(ctx, repositoryInterfaces.CountExecutionGroupsInput{
+		InlineFilters:     filters,
+		JoinTableEntities: joinTableEntities,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to count executions for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	response := &interfaces.ExecutionCountResponse{}
+	if len(totals) > 0 {
+		response.Total = totals[0].Count
+	}
+	if len(request.GroupBy) == 0 {
+		return response, nil
+	}
+	counts, err := m.db.ExecutionRepo().CountGroups(ctx, repositoryInterfaces.CountExecutionGroupsInput{
+		InlineFilters:     filters,
+		JoinTableEntities: joinTableEntities,
+		GroupBy:           request.GroupBy,
+		Limit:             request.Limit,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to count groups of executions for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	response.Groups = make([]interfaces.ExecutionGroupCount, len(counts))
+	for i, count := range counts {
+		if response.Groups[i], err = fromExecutionGroupCount(count); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (m *ExecutionManager) ListExecutionLabels(ctx context.Context, request interfaces.ExecutionLabelListRequest) (
+	*interfaces.ExecutionLabelListResponse, error) {
+	if err := validation.ValidateExecutionLabelListRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	if err := checkProjectVisible(ctx, request.Project); err != nil {
+		return nil, err
+	}
+	counts, err := m.db.ExecutionRepo().ListLabels(ctx, repositoryInterfaces.ListExecutionLabelsInput{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Key:     request.Key,
+		Limit:   request.Limit,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list execution labels for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	response := &interfaces.ExecutionLabelListResponse{
+		Labels: make([]interfaces.ExecutionLabelCount, len(counts)),
+	}
+	for i, count := range counts {
+		response.Labels[i] = interfaces.ExecutionLabelCount{
+			Key:   count.Key,
+			Value: count.Value,
+			Count: count.Count,
+		}
+	}
+	return response, nil
+}
+
+func (m *ExecutionManager) TerminateExecutions(ctx context.Context, request interfaces.TerminateExecutionsRequest) (
+	*interfaces.TerminateExecutionsResponse, error) {
+	if err := validation.ValidateTerminateExecutionsRequest(request); err != nil {
+		logger.Debugf(ctx, "TerminateExecutions request [%+v] failed validation with err: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	filters, err := getTerminateExecutionsFilters(request)
+	if err != nil {
+		return nil, err
+	}
+	joinTableEntities := make(map[common.Entity]bool)
+	for _, filter := range filters {
+		joinTableEntities[filter.GetEntity()] = true
+	}
+	batchSize := int(request.BatchSize)
+	if batchSize == 0 {
+		batchSize = defaultTerminateExecutionsBatchSize
+	}
+	// Executions keep their phase until the workflow engine reports them aborted, so batches are listed with a cursor
+	// rather than an offset, which terminated executions would otherwise shift.
+	cursor := &repositoryInterfaces.ListCursor{}
+	response := &interfaces.TerminateExecutionsResponse{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		output, err := m.db.ExecutionRepo().List(ctx, repositoryInterfaces.ListResourceInput{
+			Limit:             batchSize,
+			InlineFilters:     filters,
+			JoinTableEntities: joinTableEntities,
+			Cursor:            cursor,
+		})
+		if err != nil {
+			logger.Debugf(ctx, "Failed to list executions to terminate for request [%+v] with err: %v", request, err)
+			return nil, err
+		}
+		response.Matched += len(output.Executions)
+		for _, executionModel := range output.Executions {
+			if request.DryRun {
+				continue
+			}
+			id := transformers.GetExecutionIdentifier(&executionModel)
+			if _, err := m.TerminateExecution(ctx, admin.ExecutionTerminateRequest{
+				Id:    &id,
+				Cause: request.Cause,
+			}); err != nil {
+				logger.Warningf(ctx, "Failed to terminate execution [%+v] in bulk with err: %v", id, err)
+				response.Failed = append(response.Failed, &id)
+			}
+		}
+		if len(output.Executions) < batchSize {
+			break
+		}
+		last := output.Executions[len(output.Executions)-1]
+		cursor = &repositoryInterfaces.ListCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+	if request.DryRun {
+		logger.Infof(ctx, "Dry run matched %d executions to terminate for [%+v]", response.Matched, request)
+	} else {
+		logger.Infof(ctx, "Terminated %d of %d executions matching [%+v]",
+			response.Matched-len(response.Failed), response.Matched, request)
+	}
+	return response, nil
+}
+
+func (m *ExecutionManager) DeleteExecution(ctx context.Context, id *core.WorkflowExecutionIdentifier) error {
+	if err := validation.ValidateWorkflowExecutionIdentifier(id); err != nil {
+		logger.Debugf(ctx, "can't delete execution with invalid identifier [%+v]: %v", id, err)
+		return err
+	}
+	ctx = getExecutionContext(ctx, id)
+	identifier := repositoryInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	}
+	executionModel, err := m.db.ExecutionRepo().Get(ctx, identifier)
+	if err != nil {
+		logger.Debugf(ctx, "couldn't find execution [%+v] to delete with err: %v", id, err)
+		return err
+	}
+	// Running executions would otherwise continue to send events for an execution which can't be found.
+	if !common.IsExecutionTerminal(core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[executionModel.Phase])) {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"execution [%+v] is still %s and must be terminated before it's deleted", id, executionModel.Phase)
+	}
+	if err := m.db.ExecutionRepo().Delete(ctx, identifier); err != nil {
+		logger.Debugf(ctx, "failed to delete execution [%+v] with err: %v", id, err)
+		return err
+	}
+	return nil
+}
+
+func (m *ExecutionManager) RestoreExecution(ctx context.Context, id *core.WorkflowExecutionIdentifier) error {
+	if err := validation.ValidateWorkflowExecutionIdentifier(id); err != nil {
+		logger.Debugf(ctx, "can't restore execution with invalid identifier [%+v]: %v", id, err)
+		return err
+	}
+	ctx = getExecutionContext(ctx, id)
+	if err := m.db.ExecutionRepo().Restore(ctx, repositoryInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	}); err != nil {
+		logger.Debugf(ctx, "failed to restore execution [%+v] with err: %v", id, err)
+		return err
+	}
+	return nil
+}
+
 func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 	return executionSystemMetrics{
 		Scope: scope,
@@ -1497,6 +3288,24 @@ func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 			"delay in seconds from when an execution was requested to be created and when it actually was"),
 		PublishEventError: scope.MustNewCounter("publish_event_error",
 			"overall count of publish event errors when invoking publish()"),
+		ExecutionsDeferred: scope.MustNewCounter("executions_deferred",
+			"overall count of executions held as pending until their project and domain run fewer executions"),
+		ExecutionsScheduled: scope.MustNewCounter("executions_scheduled",
+			"overall count of executions held as pending until the time they were requested to run at"),
+		ExecutionsDispatched: scope.MustNewCounter("executions_dispatched",
+			"overall count of pending executions launched by the dispatcher"),
+		DispatchFailures: scope.MustNewCounter("dispatch_failures",
+			"overall count of pending executions which failed to launch and will be retried"),
+		ExecutionsQueued: scope.MustNewCounter("executions_queued",
+			"overall count of executions held as pending until they're launched asynchronously"),
+		LaunchFailures: scope.MustNewCounter("launch_failures",
+			"overall count of pending executions which failed after running out of launch attempts"),
+		LineageRecordFailures: scope.MustNewCounter("lineage_record_failures",
+			"overall count of created executions whose relationships failed to be recorded"),
+		DeprecatedExecutions: scope.MustNewCounter("deprecated_executions",
+			"overall count of new executions of deprecated workflows and tasks, whether created or rejected"),
+		ExecutionFailures: scope.MustNewCounterVec("execution_failures",
+			"overall count of workflow executions which failed, timed out or were aborted, by cause", "cause"),
 	}
 }
 
@@ -1504,7 +3313,8 @@ func NewExecutionManager(db repositories.RepositoryInterface, config runtimeInte
 	storageClient *storage.DataStore, workflowExecutor workflowengineInterfaces.Executor, systemScope promutils.Scope,
 	userScope promutils.Scope, publisher notificationInterfaces.Publisher, urlData dataInterfaces.RemoteURLInterface,
 	workflowManager interfaces.WorkflowInterface, namedEntityManager interfaces.NamedEntityInterface,
-	eventPublisher notificationInterfaces.Publisher, eventWriter eventWriter.WorkflowExecutionEventWriter) interfaces.ExecutionInterface {
+	eventPublisher notificationInterfaces.Publisher, eventWriter eventWriter.WorkflowExecutionEventWriter,
+	recordedEventWriter eventWriter.RecordedEventWriter) interfaces.ExecutionInterface {
 	queueAllocator := executions.NewQueueAllocator(config, db)
 	systemMetrics := newExecutionSystemMetrics(systemScope)
 
@@ -1525,6 +3335,7 @@ func NewExecutionManager(db repositories.RepositoryInterface, config runtimeInte
 		storageClient:             storageClient,
 		workflowExecutor:          workflowExecutor,
 		queueAllocator:            queueAllocator,
+		executionNamer:            executions.NewExecutionNamer(config, db),
 		_clock:                    clock.New(),
 		systemMetrics:             systemMetrics,
 		userMetrics:               userMetrics,
@@ -1536,6 +3347,7 @@ func NewExecutionManager(db repositories.RepositoryInterface, config runtimeInte
 		qualityOfServiceAllocator: executions.NewQualityOfServiceAllocator(config, resourceManager),
 		eventPublisher:            eventPublisher,
 		dbEventWriter:             eventWriter,
+		recordedEventWriter:       recordedEventWriter,
 	}
 }
 
@@ -32,9 +32,11 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 
+	"encoding/json"
 	"fmt"
 	"strings"
 
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
 	commonTestUtils "github.com/flyteorg/flyteadmin/pkg/common/testutils"
 	dataMocks "github.com/flyteorg/flyteadmin/pkg/data/mocks"
@@ -55,10 +57,16 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flytestdlib/config"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 )
 
 var spec = testutils.GetExecutionRequest().Spec
@@ -238,6 +246,15 @@ func TestCreateExecution(t *testing.T) {
 			err := proto.Unmarshal(input.Spec, &spec)
 			assert.NoError(t, err)
 			assert.Equal(t, principal, spec.Metadata.Principal)
+			labelValues := make(map[string]string)
+			for _, label := range input.Labels {
+				labelValues[label.Key] = label.Value
+			}
+			assert.EqualValues(t, map[string]string{
+				"label1": "1",
+				"label2": "2",
+				"label3": "3",
+			}, labelValues)
 			return nil
 		})
 	setDefaultLpCallbackForExecTest(repository)
@@ -306,7 +323,7 @@ func TestCreateExecution(t *testing.T) {
 
 	mockConfig := getMockExecutionsConfigProvider()
 	mockConfig.(*runtimeMocks.MockConfigurationProvider).AddQualityOfServiceConfiguration(qosProvider)
-	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	request := testutils.GetExecutionRequest()
 	request.Spec.Metadata = &admin.ExecutionMetadata{
 		Principal: "unused - populated from authenticated context",
@@ -394,7 +411,7 @@ func TestCreateExecutionFromWorkflowNode(t *testing.T) {
 		},
 	)
 
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	request := testutils.GetExecutionRequest()
 	request.Spec.Metadata = &admin.ExecutionMetadata{
 		Mode:                admin.ExecutionMetadata_CHILD_WORKFLOW,
@@ -430,7 +447,7 @@ func TestCreateExecution_NoAssignedName(t *testing.T) {
 				Cluster: testCluster,
 			}, nil
 		})
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	request := testutils.GetExecutionRequest()
 	request.Name = ""
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
@@ -445,6 +462,314 @@ func TestCreateExecution_NoAssignedName(t *testing.T) {
 	assert.NotEmpty(t, response.Id.Name)
 }
 
+func TestCreateExecution_ProjectExecutionDefaults(t *testing.T) {
+	for _, test := range []struct {
+		name                   string
+		requestAuthRole        *admin.AuthRole
+		requestMaxParallelism  int32
+		expectedServiceAccount string
+		expectedMaxParallelism int32
+	}{
+		{
+			name:                   "defaults",
+			expectedServiceAccount: "project-sa",
+			expectedMaxParallelism: 7,
+		},
+		{
+			name:                   "explicitly set",
+			requestAuthRole:        &admin.AuthRole{KubernetesServiceAccount: "request-sa"},
+			requestMaxParallelism:  3,
+			expectedServiceAccount: "request-sa",
+			expectedMaxParallelism: 3,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			repository := getMockRepositoryForExecTest()
+			setDefaultLpCallbackForExecTest(repository)
+			repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+				ctx context.Context, projectID string) (models.Project, error) {
+				activeState := int32(admin.Project_ACTIVE)
+				return models.Project{
+					Identifier: projectID,
+					State:      &activeState,
+					ExecutionDefaults: []byte(`{"service_account":"project-sa",` +
+						`"raw_output_data_prefix":"s3://bucket/raw","max_parallelism":7}`),
+				}, nil
+			}
+			repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+				func(ctx context.Context, input models.Execution) error {
+					var spec admin.ExecutionSpec
+					assert.NoError(t, proto.Unmarshal(input.Spec, &spec))
+					assert.Equal(t, test.expectedMaxParallelism, spec.MaxParallelism)
+					return nil
+				})
+			mockExecutor := workflowengineMocks.NewMockExecutor()
+			mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+				func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+					assert.Equal(t, test.expectedServiceAccount, inputs.Auth.KubernetesServiceAccount)
+					assert.Equal(t, test.expectedMaxParallelism, inputs.ExecutionConfig.MaxParallelism)
+					assert.Equal(t, "s3://bucket/raw", inputs.Reference.Spec.RawOutputDataConfig.OutputLocationPrefix)
+					return &workflowengineInterfaces.ExecutionInfo{
+						Cluster: testCluster,
+					}, nil
+				})
+			execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(),
+				getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(),
+				mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil,
+				&eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+			request := testutils.GetExecutionRequest()
+			request.Spec.AuthRole = test.requestAuthRole
+			request.Spec.MaxParallelism = test.requestMaxParallelism
+			_, err := execManager.CreateExecution(context.Background(), request, requestedAt)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateExecution_ExecutionIdentityAttributes(t *testing.T) {
+	for _, test := range []struct {
+		name                   string
+		requestAuthRole        *admin.AuthRole
+		expectedServiceAccount string
+		expectedIamRole        string
+		expectedErr            string
+	}{
+		{
+			name:                   "defaults",
+			expectedServiceAccount: "domain-sa",
+			expectedIamRole:        "domain-role",
+		},
+		{
+			name:                   "allowed",
+			requestAuthRole:        &admin.AuthRole{KubernetesServiceAccount: "other-sa"},
+			expectedServiceAccount: "other-sa",
+			expectedIamRole:        "domain-role",
+		},
+		{
+			name:            "disallowed service account",
+			requestAuthRole: &admin.AuthRole{KubernetesServiceAccount: "admin-sa"},
+			expectedErr:     "executions in [project/domain] aren't allowed to run as service account [admin-sa]",
+		},
+		{
+			name:            "disallowed role",
+			requestAuthRole: &admin.AuthRole{AssumableIamRole: "admin-role"},
+			expectedErr:     "executions in [project/domain] aren't allowed to assume role [admin-role]",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			repository := getMockRepositoryForExecTest()
+			setDefaultLpCallbackForExecTest(repository)
+			repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+				ctx context.Context, ID interfaces.ResourceID) (models.Resource, error) {
+				if ID.ResourceType != managerInterfaces.ExecutionIdentityResourceType {
+					return models.Resource{}, nil
+				}
+				assert.Equal(t, "project", ID.Project)
+				assert.Equal(t, "domain", ID.Domain)
+				return models.Resource{
+					Attributes: []byte(`{"default_service_account":"domain-sa","default_iam_role":"domain-role",` +
+						`"allowed_service_accounts":["domain-sa","other-sa"],"allowed_iam_roles":["domain-role"]}`),
+				}, nil
+			}
+			mockExecutor := workflowengineMocks.NewMockExecutor()
+			mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+				func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+					assert.Equal(t, test.expectedServiceAccount, inputs.Auth.KubernetesServiceAccount)
+					assert.Equal(t, test.expectedIamRole, inputs.Auth.AssumableIamRole)
+					return &workflowengineInterfaces.ExecutionInfo{
+						Cluster: testCluster,
+					}, nil
+				})
+			execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(),
+				getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(),
+				mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil,
+				&eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+			request := testutils.GetExecutionRequest()
+			request.Spec.AuthRole = test.requestAuthRole
+			_, err := execManager.CreateExecution(context.Background(), request, requestedAt)
+			if len(test.expectedErr) > 0 {
+				assert.EqualError(t, err, test.expectedErr)
+				assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateExecution_IdempotencyKey(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	var createdName, reservationID string
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).ReserveIdempotencyKeyFunction =
+		func(ctx context.Context, input interfaces.ReserveIdempotencyKeyInput) error {
+			assert.Equal(t, "key", input.Key)
+			assert.NotEmpty(t, input.ReservationID)
+			assert.True(t, input.ReservedUntil.After(input.Now))
+			reservationID = input.ReservationID
+			return nil
+		}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).ReleaseIdempotencyKeyFunction =
+		func(ctx context.Context, input interfaces.IdempotencyKey, id string) error {
+			// The key is only released once the execution is created.
+			assert.NotEmpty(t, createdName)
+			assert.Equal(t, reservationID, id)
+			reservationID = ""
+			return nil
+		}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			assert.NotNil(t, input.IdempotencyKey)
+			assert.Equal(t, "key", *input.IdempotencyKey)
+			createdName = input.Name
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			// The key is reserved before the execution is launched.
+			assert.NotEmpty(t, reservationID)
+			return &workflowengineInterfaces.ExecutionInfo{}, nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	request := testutils.GetExecutionRequest()
+	request.Name = ""
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, "key"))
+	response, err := execManager.CreateExecution(ctx, request, requestedAt)
+	assert.Nil(t, err)
+	expectedName := common.GetIdempotentExecutionName(executionIdentifier.Project, executionIdentifier.Domain, "key")
+	assert.Equal(t, expectedName, createdName)
+	assert.Equal(t, expectedName, response.Id.Name)
+	assert.Empty(t, reservationID)
+}
+
+func TestCreateExecution_IdempotencyKeyReserved(t *testing.T) {
+	newExecManager := func(repository repositories.RepositoryInterface) managerInterfaces.ExecutionInterface {
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).ReserveIdempotencyKeyFunction =
+			func(ctx context.Context, input interfaces.ReserveIdempotencyKeyInput) error {
+				return flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "reserved")
+			}
+		mockExecutor := workflowengineMocks.NewMockExecutor()
+		mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+			func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+				t.Fatal("launched an execution whose idempotency key is reserved by another request")
+				return nil, nil
+			})
+		return NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, "key"))
+
+	t.Run("being created", func(t *testing.T) {
+		repository := getMockRepositoryForExecTest()
+		setDefaultLpCallbackForExecTest(repository)
+		_, err := newExecManager(repository).CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+		assert.Equal(t, codes.Aborted, err.(flyteAdminErrors.FlyteAdminError).Code())
+	})
+
+	t.Run("created concurrently", func(t *testing.T) {
+		repository := getMockRepositoryForExecTest()
+		setDefaultLpCallbackForExecTest(repository)
+		var lookups int
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).GetByIdempotencyKeyFunction =
+			func(ctx context.Context, input interfaces.IdempotencyKey) (models.Execution, error) {
+				lookups++
+				if lookups == 1 {
+					return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+				}
+				return models.Execution{
+					ExecutionKey: models.ExecutionKey{
+						Project: input.Project,
+						Domain:  input.Domain,
+						Name:    "concurrent",
+					},
+				}, nil
+			}
+		response, err := newExecManager(repository).CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+		assert.Nil(t, err)
+		assert.Equal(t, "concurrent", response.Id.Name)
+	})
+}
+
+func TestCreateExecution_IdempotencyKeyReplay(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).GetByIdempotencyKeyFunction =
+		func(ctx context.Context, input interfaces.IdempotencyKey) (models.Execution, error) {
+			assert.Equal(t, interfaces.IdempotencyKey{
+				Project: executionIdentifier.Project,
+				Domain:  executionIdentifier.Domain,
+				Key:     "key",
+			}, input)
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: executionIdentifier.Project,
+					Domain:  executionIdentifier.Domain,
+					Name:    "original",
+				},
+			}, nil
+		}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			t.Fatal("execution created again on replay")
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			t.Fatal("execution launched again on replay")
+			return nil, nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, "key"))
+	response, err := execManager.CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(&core.WorkflowExecutionIdentifier{
+		Project: executionIdentifier.Project,
+		Domain:  executionIdentifier.Domain,
+		Name:    "original",
+	}, response.Id))
+}
+
+func TestCreateExecution_IdempotencyKeyConflict(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	var created bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).GetByIdempotencyKeyFunction =
+		func(ctx context.Context, input interfaces.IdempotencyKey) (models.Execution, error) {
+			if !created {
+				return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+			}
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    "concurrent",
+				},
+			}, nil
+		}
+	// A concurrent request with the same key created the execution after it was first looked up.
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			created = true
+			return flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "already exists")
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, "key"))
+	response, err := execManager.CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.Equal(t, "concurrent", response.Id.Name)
+}
+
+func TestCreateExecution_IdempotencyKeyTooLong(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(idempotencyKeyHeader, strings.Repeat("k", maxIdempotencyKeyLength+1)))
+	_, err := execManager.CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
 func TestCreateExecution_TaggedQueue(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
@@ -479,7 +804,7 @@ func TestCreateExecution_TaggedQueue(t *testing.T) {
 				Cluster: testCluster,
 			}, nil
 		})
-	execManager := NewExecutionManager(repository, configProvider, getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, configProvider, getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	request := testutils.GetExecutionRequest()
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
@@ -495,7 +820,7 @@ func TestCreateExecution_TaggedQueue(t *testing.T) {
 func TestCreateExecutionValidationError(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	request := testutils.GetExecutionRequest()
 	request.Domain = ""
@@ -507,7 +832,7 @@ func TestCreateExecutionValidationError(t *testing.T) {
 func TestCreateExecution_InvalidLpIdentifier(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	request := testutils.GetExecutionRequest()
 	request.Spec.LaunchPlan = nil
@@ -519,7 +844,7 @@ func TestCreateExecution_InvalidLpIdentifier(t *testing.T) {
 func TestCreateExecutionInCompatibleInputs(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	request := testutils.GetExecutionRequest()
 	request.Inputs = &core.LiteralMap{
@@ -543,7 +868,7 @@ func TestCreateExecutionPropellerFailure(t *testing.T) {
 		return nil, expectedErr
 	}
 	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(createFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	request := testutils.GetExecutionRequest()
 
@@ -562,7 +887,7 @@ func TestCreateExecutionDatabaseFailure(t *testing.T) {
 	}
 
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	request := testutils.GetExecutionRequest()
 
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
@@ -570,6 +895,32 @@ func TestCreateExecutionDatabaseFailure(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func TestCreateExecution_ExceedsDomainQuota(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	repository.DomainQuotaRepo().(*repositoryMocks.MockDomainQuotaRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.DomainQuota, error) {
+		assert.Equal(t, "project", project)
+		assert.Equal(t, "domain", domain)
+		return models.DomainQuota{
+			CPU:    "16",
+			Memory: "100Gi",
+		}, nil
+	}
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			assert.Fail(t, "executions exceeding their quota shouldn't be launched")
+			return nil, nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	response, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, response)
+	assert.Equal(t, codes.ResourceExhausted, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "which exceeds the memory quota [100Gi] of project [project] and domain [domain]")
+}
+
 func TestCreateExecutionVerifyDbModel(t *testing.T) {
 	request := testutils.GetExecutionRequest()
 	repository := getMockRepositoryForExecTest()
@@ -625,7 +976,7 @@ func TestCreateExecutionVerifyDbModel(t *testing.T) {
 	}
 
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), storageClient, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), storageClient, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	execManager.(*ExecutionManager)._clock = mockClock
 
@@ -663,7 +1014,7 @@ func TestCreateExecutionDefaultNotifications(t *testing.T) {
 		return nil
 	}
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
 	assert.Nil(t, err)
@@ -697,7 +1048,7 @@ func TestCreateExecutionDisableNotifications(t *testing.T) {
 		return nil
 	}
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
 	assert.Nil(t, err)
@@ -708,6 +1059,47 @@ func TestCreateExecutionDisableNotifications(t *testing.T) {
 	}, response.Id))
 }
 
+func TestCreateExecutionMandatoryNotifications(t *testing.T) {
+	request := testutils.GetExecutionRequest()
+	request.Spec.NotificationOverrides = &admin.ExecutionSpec_DisableAll{
+		DisableAll: true,
+	}
+
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	exCreateFunc := func(ctx context.Context, input models.Execution) error {
+		var closureValue admin.ExecutionClosure
+		err := proto.Unmarshal(input.Closure, &closureValue)
+		if err != nil {
+			return err
+		}
+
+		assert.Equal(t, 1, len(closureValue.Notifications))
+		assert.Equal(t, []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+			closureValue.Notifications[0].Phases)
+		assert.Equal(t, []string{"oncall@example.com"},
+			closureValue.Notifications[0].GetPagerDuty().GetRecipientsEmail())
+		return nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+		runtimeInterfaces.NotificationsConfig{
+			MandatoryNotifications: []runtimeInterfaces.ProjectDomainNotifications{
+				{
+					Domain:     "domain",
+					Phases:     []string{"FAILED"},
+					Type:       "pagerDuty",
+					Recipients: []string{"oncall@example.com"},
+				},
+			},
+		})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	_, err := execManager.CreateExecution(context.Background(), request, requestedAt)
+	assert.Nil(t, err)
+}
+
 func TestCreateExecutionNoNotifications(t *testing.T) {
 	// Remove notifications settings for the CreateExecutionRequest.
 	request := testutils.GetExecutionRequest()
@@ -762,7 +1154,7 @@ func TestCreateExecutionNoNotifications(t *testing.T) {
 		return nil
 	}
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
 	assert.Nil(t, err)
@@ -791,7 +1183,7 @@ func TestCreateExecutionDynamicLabelsAndAnnotations(t *testing.T) {
 				Cluster: testCluster,
 			}, nil
 		})
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	request := testutils.GetExecutionRequest()
 	request.Spec.Labels = &admin.Labels{
 		Values: map[string]string{
@@ -871,7 +1263,7 @@ func TestRelaunchExecution(t *testing.T) {
 	// Set up mocks.
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	startTime := time.Now()
 	startTimeProto, _ := ptypes.TimestampProto(startTime)
 	existingClosure := admin.ExecutionClosure{
@@ -924,11 +1316,49 @@ func TestRelaunchExecution(t *testing.T) {
 	// TODO: Test with inputs
 }
 
+func TestRelaunchExecution_RecordsRelationship(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	startTime := time.Now()
+	existingClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+		Phase: core.WorkflowExecution_FAILED,
+	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, &startTime))
+	lineageRepo := repository.LineageRepo().(*repositoryMocks.LineageRepoInterface)
+	lineageRepo.OnCreateRelationshipMatch(mock.Anything, models.ExecutionRelationship{
+		ExecutionProject: "project",
+		ExecutionDomain:  "domain",
+		ExecutionName:    "relaunchy",
+		RelatedProject:   "project",
+		RelatedDomain:    "domain",
+		RelatedName:      "name",
+		Kind:             managerInterfaces.RelationshipRelaunchedFrom,
+	}).Return(nil)
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			Lineage: runtimeInterfaces.LineageConfig{Enabled: true},
+		})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	_, err := execManager.RelaunchExecution(context.Background(), admin.ExecutionRelaunchRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Name: "relaunchy",
+	}, requestedAt)
+	assert.NoError(t, err)
+	lineageRepo.AssertNumberOfCalls(t, "CreateRelationship", 1)
+}
+
 func TestRelaunchExecution_GetExistingFailure(t *testing.T) {
 	// Set up mocks.
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	expectedErr := errors.New("expected error")
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
@@ -962,7 +1392,7 @@ func TestRelaunchExecution_CreateFailure(t *testing.T) {
 	// Set up mocks.
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	startTime := time.Now()
 	startTimeProto, _ := ptypes.TimestampProto(startTime)
 	existingClosure := admin.ExecutionClosure{
@@ -997,7 +1427,7 @@ func TestRecoverExecution(t *testing.T) {
 	// Set up mocks.
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	startTime := time.Now()
 	startTimeProto, _ := ptypes.TimestampProto(startTime)
 	existingClosure := admin.ExecutionClosure{
@@ -1048,111 +1478,294 @@ func TestRecoverExecution(t *testing.T) {
 	assert.True(t, proto.Equal(expectedResponse, response))
 }
 
-func TestRecoverExecution_RecoveredChildNode(t *testing.T) {
+func TestRecoverExecutionWithOverrides(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-	startTime := time.Now()
-	startTimeProto, _ := ptypes.TimestampProto(startTime)
 	existingClosure := admin.ExecutionClosure{
-		Phase:     core.WorkflowExecution_SUCCEEDED,
-		StartedAt: startTimeProto,
+		Phase: core.WorkflowExecution_FAILED,
 	}
 	existingClosureBytes, _ := proto.Marshal(&existingClosure)
-	referencedExecutionID := uint(123)
-	ignoredExecutionID := uint(456)
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
-		switch input.Name {
-		case "name":
-			return models.Execution{
-				Spec:    specBytes,
-				Closure: existingClosureBytes,
-				BaseModel: models.BaseModel{
-					ID: referencedExecutionID,
-				},
-			}, nil
-		case "orig":
-			return models.Execution{
-				BaseModel: models.BaseModel{
-					ID: ignoredExecutionID,
-				},
-			}, nil
-		default:
-			return models.Execution{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "unexpected get for execution %s", input.Name)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, nil))
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).ExistsFunction =
+		func(ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
+			assert.Equal(t, "name", input.NodeExecutionIdentifier.ExecutionId.Name)
+			return true, nil
 		}
-	})
 
-	parentNodeDatabaseID := uint(12345)
 	var createCalled bool
-	exCreateFunc := func(ctx context.Context, input models.Execution) error {
-		createCalled = true
-		assert.Equal(t, "recovered", input.Name)
-		assert.Equal(t, "domain", input.Domain)
-		assert.Equal(t, "project", input.Project)
-		var spec admin.ExecutionSpec
-		err := proto.Unmarshal(input.Spec, &spec)
-		assert.Nil(t, err)
-		assert.Equal(t, admin.ExecutionMetadata_RECOVERED, spec.Metadata.Mode)
-		assert.Equal(t, int32(admin.ExecutionMetadata_RECOVERED), input.Mode)
-		assert.Equal(t, parentNodeDatabaseID, input.ParentNodeExecutionID)
-		assert.Equal(t, referencedExecutionID, input.SourceExecutionID)
-
-		return nil
-	}
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
-
-	parentNodeExecution := core.NodeExecutionIdentifier{
-		ExecutionId: &core.WorkflowExecutionIdentifier{
-			Project: "p",
-			Domain:  "d",
-			Name:    "orig",
-		},
-		NodeId: "parent",
-	}
-	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(func(ctx context.Context, input interfaces.NodeExecutionResource) (models.NodeExecution, error) {
-		assert.True(t, proto.Equal(&parentNodeExecution, &input.NodeExecutionIdentifier))
-
-		return models.NodeExecution{
-			BaseModel: models.BaseModel{
-				ID: parentNodeDatabaseID,
-			},
-		}, nil
-	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			createCalled = true
+			assert.Equal(t, uint(8), input.SourceExecutionID)
+			var spec admin.ExecutionSpec
+			assert.Nil(t, proto.Unmarshal(input.Spec, &spec))
+			assert.Equal(t, admin.ExecutionMetadata_RECOVERED, spec.Metadata.Mode)
+			assert.Equal(t, "name", spec.Metadata.ReferenceExecution.Name)
+			// The launch plan's annotations are kept alongside the recovered nodes.
+			assert.EqualValues(t, map[string]string{
+				"annotation3":             "3",
+				"annotation4":             "4",
+				recoverNodesAnnotationKey: "n1,n2",
+			}, spec.Annotations.Values)
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			assert.Equal(t, "name", inputs.RecoveryExecution.Name)
+			assert.Equal(t, "n1,n2", inputs.Annotations[recoverNodesAnnotationKey])
+			return &workflowengineInterfaces.ExecutionInfo{}, nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
-	// Issue request.
-	response, err := execManager.RecoverExecution(context.Background(), admin.ExecutionRecoverRequest{
+	response, err := execManager.RecoverExecutionWithOverrides(context.Background(), admin.ExecutionRecoverRequest{
 		Id: &core.WorkflowExecutionIdentifier{
 			Project: "project",
 			Domain:  "domain",
 			Name:    "name",
 		},
 		Name: "recovered",
-		Metadata: &admin.ExecutionMetadata{
-			ParentNodeExecution: &parentNodeExecution,
-		},
+	}, managerInterfaces.RecoverExecutionOverrides{
+		NodeIDs: []string{"n1", "n2"},
 	}, requestedAt)
-
-	// And verify response.
 	assert.Nil(t, err)
-
-	expectedResponse := &admin.ExecutionCreateResponse{
-		Id: &core.WorkflowExecutionIdentifier{
-			Project: "project",
-			Domain:  "domain",
-			Name:    "recovered",
-		},
-	}
 	assert.True(t, createCalled)
-	assert.True(t, proto.Equal(expectedResponse, response))
+	assert.Equal(t, "recovered", response.Id.Name)
 }
 
-func TestRecoverExecution_GetExistingFailure(t *testing.T) {
-	// Set up mocks.
+func TestRecoverExecutionWithOverrides_Inputs(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-
-	expectedErr := errors.New("expected error")
+	existingClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+		Phase: core.WorkflowExecution_FAILED,
+	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, nil))
+	var createCalled bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			createCalled = true
+			assert.Equal(t, uint(8), input.SourceExecutionID)
+			var spec admin.ExecutionSpec
+			assert.Nil(t, proto.Unmarshal(input.Spec, &spec))
+			assert.Equal(t, admin.ExecutionMetadata_RELAUNCH, spec.Metadata.Mode)
+			assert.Equal(t, "name", spec.Metadata.ReferenceExecution.Name)
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			// The original outputs aren't recovered alongside the replaced inputs.
+			assert.Nil(t, inputs.RecoveryExecution)
+			assert.True(t, proto.Equal(coreutils.MustMakeLiteral("bar-value"), inputs.Inputs.Literals["foo"]))
+			return &workflowengineInterfaces.ExecutionInfo{}, nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	response, err := execManager.RecoverExecutionWithOverrides(context.Background(), admin.ExecutionRecoverRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Name: "recovered",
+	}, managerInterfaces.RecoverExecutionOverrides{
+		Inputs: &core.LiteralMap{
+			Literals: map[string]*core.Literal{
+				"foo": coreutils.MustMakeLiteral("bar-value"),
+			},
+		},
+	}, requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, createCalled)
+	assert.Equal(t, "recovered", response.Id.Name)
+}
+
+func TestRecoverExecutionWithOverrides_InputsAndNodes(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			t.Fatal("recovered selected nodes with replaced inputs")
+			return nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	_, err := execManager.RecoverExecutionWithOverrides(context.Background(), admin.ExecutionRecoverRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}, managerInterfaces.RecoverExecutionOverrides{
+		Inputs: &core.LiteralMap{
+			Literals: map[string]*core.Literal{
+				"foo": coreutils.MustMakeLiteral("bar-value"),
+			},
+		},
+		NodeIDs: []string{"n1"},
+	}, requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestRecoverExecutionWithOverrides_UnknownNode(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	existingClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, nil))
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).ExistsFunction =
+		func(ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
+			return input.NodeExecutionIdentifier.NodeId == "n1", nil
+		}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			t.Fatal("recovered an execution with an unknown node")
+			return nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	_, err := execManager.RecoverExecutionWithOverrides(context.Background(), admin.ExecutionRecoverRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}, managerInterfaces.RecoverExecutionOverrides{
+		NodeIDs: []string{"n1", "missing"},
+	}, requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestRecoverExecutionWithOverrides_UnknownInput(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	existingClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, nil))
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	_, err := execManager.RecoverExecutionWithOverrides(context.Background(), admin.ExecutionRecoverRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}, managerInterfaces.RecoverExecutionOverrides{
+		Inputs: &core.LiteralMap{
+			Literals: map[string]*core.Literal{
+				"unknown": coreutils.MustMakeLiteral("value"),
+			},
+		},
+	}, requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestRecoverExecution_RecoveredChildNode(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	startTime := time.Now()
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosure := admin.ExecutionClosure{
+		Phase:     core.WorkflowExecution_SUCCEEDED,
+		StartedAt: startTimeProto,
+	}
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
+	referencedExecutionID := uint(123)
+	ignoredExecutionID := uint(456)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+		switch input.Name {
+		case "name":
+			return models.Execution{
+				Spec:    specBytes,
+				Closure: existingClosureBytes,
+				BaseModel: models.BaseModel{
+					ID: referencedExecutionID,
+				},
+			}, nil
+		case "orig":
+			return models.Execution{
+				BaseModel: models.BaseModel{
+					ID: ignoredExecutionID,
+				},
+			}, nil
+		default:
+			return models.Execution{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "unexpected get for execution %s", input.Name)
+		}
+	})
+
+	parentNodeDatabaseID := uint(12345)
+	var createCalled bool
+	exCreateFunc := func(ctx context.Context, input models.Execution) error {
+		createCalled = true
+		assert.Equal(t, "recovered", input.Name)
+		assert.Equal(t, "domain", input.Domain)
+		assert.Equal(t, "project", input.Project)
+		var spec admin.ExecutionSpec
+		err := proto.Unmarshal(input.Spec, &spec)
+		assert.Nil(t, err)
+		assert.Equal(t, admin.ExecutionMetadata_RECOVERED, spec.Metadata.Mode)
+		assert.Equal(t, int32(admin.ExecutionMetadata_RECOVERED), input.Mode)
+		assert.Equal(t, parentNodeDatabaseID, input.ParentNodeExecutionID)
+		assert.Equal(t, referencedExecutionID, input.SourceExecutionID)
+
+		return nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(exCreateFunc)
+
+	parentNodeExecution := core.NodeExecutionIdentifier{
+		ExecutionId: &core.WorkflowExecutionIdentifier{
+			Project: "p",
+			Domain:  "d",
+			Name:    "orig",
+		},
+		NodeId: "parent",
+	}
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetGetCallback(func(ctx context.Context, input interfaces.NodeExecutionResource) (models.NodeExecution, error) {
+		assert.True(t, proto.Equal(&parentNodeExecution, &input.NodeExecutionIdentifier))
+
+		return models.NodeExecution{
+			BaseModel: models.BaseModel{
+				ID: parentNodeDatabaseID,
+			},
+		}, nil
+	})
+
+	// Issue request.
+	response, err := execManager.RecoverExecution(context.Background(), admin.ExecutionRecoverRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Name: "recovered",
+		Metadata: &admin.ExecutionMetadata{
+			ParentNodeExecution: &parentNodeExecution,
+		},
+	}, requestedAt)
+
+	// And verify response.
+	assert.Nil(t, err)
+
+	expectedResponse := &admin.ExecutionCreateResponse{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "recovered",
+		},
+	}
+	assert.True(t, createCalled)
+	assert.True(t, proto.Equal(expectedResponse, response))
+}
+
+func TestRecoverExecution_GetExistingFailure(t *testing.T) {
+	// Set up mocks.
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	expectedErr := errors.New("expected error")
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
 		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
 			return models.Execution{}, expectedErr
@@ -1191,7 +1804,7 @@ func TestRecoverExecution_GetExistingInputsFailure(t *testing.T) {
 		ctx context.Context, reference storage.DataReference, msg proto.Message) error {
 		return expectedErr
 	}
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	startTime := time.Now()
 	startTimeProto, _ := ptypes.TimestampProto(startTime)
 	existingClosure := admin.ExecutionClosure{
@@ -1274,394 +1887,924 @@ func TestCreateWorkflowEvent(t *testing.T) {
 	}
 	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
 	mockDbEventWriter.On("Write", request)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter, nil)
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
 	assert.Nil(t, err)
 	assert.NotNil(t, resp)
 }
 
-func TestCreateWorkflowEvent_TerminalState(t *testing.T) {
+func TestCreateWorkflowEvent_Outbox(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	executionGetFunc := func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
-		return models.Execution{
-			ExecutionKey: models.ExecutionKey{
-				Project: "project",
-				Domain:  "domain",
-				Name:    "name",
-			},
-			BaseModel: models.BaseModel{
-				ID: uint(8),
+	startTime := time.Now()
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosure := admin.ExecutionClosure{
+		Phase:      core.WorkflowExecution_RUNNING,
+		StartedAt:  startTimeProto,
+		WorkflowId: proto.Clone(&workflowIdentifier).(*core.Identifier),
+		Notifications: []*admin.Notification{
+			{
+				Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_SUCCEEDED},
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{RecipientsEmail: []string{"a@example.com"}},
+				},
 			},
-			Spec:  specBytes,
-			Phase: core.WorkflowExecution_FAILED.String(),
-		}, nil
+		},
 	}
-
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-	updateExecutionFunc := func(context context.Context, execution models.Execution) error {
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, &startTime))
+	var outboxMessages []models.OutboxMessage
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).UpdateWithMessagesFunction = func(
+		ctx context.Context, execution models.Execution, messages []models.OutboxMessage) error {
+		assert.Equal(t, core.WorkflowExecution_SUCCEEDED.String(), execution.Phase)
+		outboxMessages = messages
 		return nil
 	}
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-
-	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+	occurredAt, _ := ptypes.TimestampProto(startTime.Add(time.Second))
+	// Serializing the event caches sizes in the identifier, which is shared with other tests.
+	executionID := proto.Clone(&executionIdentifier).(*core.WorkflowExecutionIdentifier)
+	request := admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
-			ExecutionId: &executionIdentifier,
+			ExecutionId: executionID,
+			OccurredAt:  occurredAt,
 			Phase:       core.WorkflowExecution_SUCCEEDED,
+			OutputResult: &event.WorkflowExecutionEvent_OutputUri{
+				OutputUri: "s3://bucket/outputs.pb",
+			},
 		},
+	}
+	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
+	mockDbEventWriter.On("Write", request)
+
+	// Messages are only published by the outbox relay.
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		t.Errorf("unexpected publish of %s", notificationType)
+		return nil
 	})
-	assert.Nil(t, resp)
-	assert.NotNil(t, err)
-	adminError := err.(flyteAdminErrors.FlyteAdminError)
-	assert.Equal(t, adminError.Code(), codes.FailedPrecondition)
-	details, ok := adminError.GRPCStatus().Details()[0].(*admin.EventFailureReason)
-	assert.True(t, ok)
-	_, ok = details.GetReason().(*admin.EventFailureReason_AlreadyInTerminalState)
-	assert.True(t, ok)
+	mockConfig := getMockExecutionsConfigProvider()
+	applicationConfig := mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider)
+	applicationConfig.SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		Outbox: runtimeInterfaces.OutboxConfig{Enabled: true},
+	})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &publisher, mockExecutionRemoteURL, nil, nil, &publisher, mockDbEventWriter, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+
+	assert.Len(t, outboxMessages, 2)
+	assert.Equal(t, "notifications", outboxMessages[0].Publisher)
+	assert.Equal(t, "flyteidl.admin.EmailNotification", outboxMessages[0].NotificationType)
+	assert.Equal(t, "flyteidl.admin.EmailMessage", outboxMessages[0].MessageType)
+	var email admin.EmailMessage
+	assert.NoError(t, proto.Unmarshal(outboxMessages[0].Payload, &email))
+	assert.Equal(t, []string{"a@example.com"}, email.RecipientsEmail)
+
+	assert.Equal(t, "events", outboxMessages[1].Publisher)
+	assert.Equal(t, "flyteidl.admin.WorkflowExecutionEventRequest", outboxMessages[1].NotificationType)
+	var published admin.WorkflowExecutionEventRequest
+	assert.NoError(t, proto.Unmarshal(outboxMessages[1].Payload, &published))
+	assert.True(t, proto.Equal(&request, &published))
 }
 
-func TestCreateWorkflowEvent_StartedRunning(t *testing.T) {
+func TestCreateWorkflowEvent_SlackWebhook(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	occurredAt := time.Now().UTC()
-	occurredAtProto, _ := ptypes.TimestampProto(occurredAt)
-	executionGetFunc := makeExecutionGetFunc(t, closureBytes, nil)
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-
-	closure := admin.ExecutionClosure{
-		Phase:     core.WorkflowExecution_RUNNING,
-		StartedAt: occurredAtProto,
-		UpdatedAt: occurredAtProto,
+	startTime := time.Now()
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosure := admin.ExecutionClosure{
+		Phase:      core.WorkflowExecution_RUNNING,
+		StartedAt:  startTimeProto,
+		WorkflowId: proto.Clone(&workflowIdentifier).(*core.Identifier),
+		Notifications: []*admin.Notification{
+			{
+				Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+				Type: &admin.Notification_Slack{
+					Slack: &admin.SlackNotification{RecipientsEmail: []string{"channel@example.slack.com"}},
+				},
+			},
+			{
+				Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{RecipientsEmail: []string{"a@example.com"}},
+				},
+			},
+		},
 	}
-	closureBytes, _ := proto.Marshal(&closure)
-	updateExecutionFunc := func(
-		context context.Context, execution models.Execution) error {
-		assert.Equal(t, "project", execution.Project)
-		assert.Equal(t, "domain", execution.Domain)
-		assert.Equal(t, "name", execution.Name)
-		assert.Equal(t, uint(1), execution.LaunchPlanID)
-		assert.Equal(t, uint(2), execution.WorkflowID)
-		assert.Equal(t, core.WorkflowExecution_RUNNING.String(), execution.Phase)
-		assert.Equal(t, closureBytes, execution.Closure)
-		assert.Equal(t, specBytes, execution.Spec)
-		assert.Equal(t, occurredAt, *execution.StartedAt)
-		assert.Equal(t, time.Duration(0), execution.Duration)
-		assert.Equal(t, occurredAt, *execution.ExecutionUpdatedAt)
-		return nil
-	}
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
-	occurredAtTimestamp, _ := ptypes.TimestampProto(occurredAt)
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, &startTime))
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			return nil
+		})
+	occurredAt, _ := ptypes.TimestampProto(startTime.Add(time.Second))
 	request := admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
 			ExecutionId: &executionIdentifier,
-			OccurredAt:  occurredAtTimestamp,
-			Phase:       core.WorkflowExecution_RUNNING,
+			OccurredAt:  occurredAt,
+			Phase:       core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{Message: "oops"},
+			},
 		},
 	}
 	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
 	mockDbEventWriter.On("Write", request)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter)
+
+	published := make(map[string]proto.Message)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		published[notificationType] = msg
+		return nil
+	})
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+		runtimeInterfaces.NotificationsConfig{
+			NotificationsWebhooksConfig: runtimeInterfaces.NotificationsWebhooksConfig{
+				Title: "{{ name }} {{ phase }}",
+				Endpoints: []runtimeInterfaces.ProjectDomainWebhook{
+					{
+						Project: "project",
+						Type:    "teams",
+						URL:     "https://teams.example.com/webhook",
+					},
+				},
+			},
+		})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &publisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter, nil)
 	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
 	assert.Nil(t, err)
 	assert.NotNil(t, resp)
+
+	// The Slack notification is posted to the project's webhook, while the email notification is still emailed.
+	assert.Len(t, published, 2)
+	assert.Equal(t, []string{"a@example.com"},
+		published["flyteidl.admin.EmailNotification"].(*admin.EmailMessage).RecipientsEmail)
+	webhookNotification := published["flyteadmin.notifications.WebhookMessage"].(*any.Any)
+	var webhookMessage notificationInterfaces.WebhookMessage
+	assert.NoError(t, json.Unmarshal(webhookNotification.Value, &webhookMessage))
+	assert.Equal(t, "teams", webhookMessage.Type)
+	assert.Equal(t, "project", webhookMessage.Project)
+	assert.Equal(t, "name failed", webhookMessage.Title)
+	assert.Contains(t, webhookMessage.Body, "oops")
 }
 
-func TestCreateWorkflowEvent_DuplicateRunning(t *testing.T) {
+func TestCreateWorkflowEvent_Incident(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	occurredAt := time.Now().UTC()
-
+	startTime := time.Now()
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosure := admin.ExecutionClosure{
+		Phase:      core.WorkflowExecution_RUNNING,
+		StartedAt:  startTimeProto,
+		WorkflowId: proto.Clone(&workflowIdentifier).(*core.Identifier),
+	}
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
-		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
-			return models.Execution{
-				ExecutionKey: models.ExecutionKey{
-					Project: "project",
-					Domain:  "domain",
-					Name:    "name",
-				},
-				BaseModel: models.BaseModel{
-					ID: uint(8),
-				},
-				Spec:         specBytes,
-				Phase:        core.WorkflowExecution_RUNNING.String(),
-				Closure:      closureBytes,
-				LaunchPlanID: uint(1),
-				WorkflowID:   uint(2),
-				StartedAt:    &occurredAt,
-			}, nil
-		},
-	)
-
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-	occurredAtTimestamp, _ := ptypes.TimestampProto(occurredAt)
-	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		makeExecutionGetFunc(t, existingClosureBytes, &startTime))
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			return nil
+		})
+	occurredAt, _ := ptypes.TimestampProto(startTime.Add(time.Second))
+	request := admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
 			ExecutionId: &executionIdentifier,
-			OccurredAt:  occurredAtTimestamp,
-			Phase:       core.WorkflowExecution_RUNNING,
+			OccurredAt:  occurredAt,
+			Phase:       core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{Message: "oops"},
+			},
 		},
-	})
-	assert.NotNil(t, err)
-	adminError := err.(flyteAdminErrors.FlyteAdminError)
-	assert.Equal(t, adminError.Code(), codes.AlreadyExists)
-	assert.Nil(t, resp)
-}
-
-func TestCreateWorkflowEvent_InvalidPhaseChange(t *testing.T) {
-	repository := repositoryMocks.NewMockRepository()
-	occurredAt := time.Now().UTC()
+	}
+	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
+	mockDbEventWriter.On("Write", request)
 
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
-		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
-			return models.Execution{
-				ExecutionKey: models.ExecutionKey{
-					Project: "project",
-					Domain:  "domain",
-					Name:    "name",
-				},
-				BaseModel: models.BaseModel{
-					ID: uint(8),
+	published := make(map[string]proto.Message)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		published[notificationType] = msg
+		return nil
+	})
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+		runtimeInterfaces.NotificationsConfig{
+			NotificationsIncidentsConfig: runtimeInterfaces.NotificationsIncidentsConfig{
+				Endpoints: []runtimeInterfaces.ProjectDomainIncidents{
+					{
+						Domain: "domain",
+						Type:   "opsgenie",
+					},
 				},
-				Spec:         specBytes,
-				Phase:        core.WorkflowExecution_SUCCEEDED.String(),
-				Closure:      closureBytes,
-				LaunchPlanID: uint(1),
-				WorkflowID:   uint(2),
-				StartedAt:    &occurredAt,
-			}, nil
-		},
-	)
+			},
+		})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &publisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
 
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-	occurredAtTimestamp, _ := ptypes.TimestampProto(occurredAt)
-	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
-		RequestId: "1",
-		Event: &event.WorkflowExecutionEvent{
-			ExecutionId: &executionIdentifier,
-			OccurredAt:  occurredAtTimestamp,
-			Phase:       core.WorkflowExecution_RUNNING,
-		},
-	})
-	assert.NotNil(t, err)
-	adminError := err.(flyteAdminErrors.FlyteAdminError)
-	assert.Equal(t, adminError.Code(), codes.FailedPrecondition)
-	assert.Nil(t, resp)
-	details, ok := adminError.GRPCStatus().Details()[0].(*admin.EventFailureReason)
-	assert.True(t, ok)
-	_, ok = details.GetReason().(*admin.EventFailureReason_AlreadyInTerminalState)
-	assert.True(t, ok)
+	// An incident is opened even though the execution requested no notifications.
+	assert.Len(t, published, 1)
+	incidentNotification := published["flyteadmin.notifications.IncidentMessage"].(*any.Any)
+	var incidentMessage notificationInterfaces.IncidentMessage
+	assert.NoError(t, json.Unmarshal(incidentNotification.Value, &incidentMessage))
+	assert.Equal(t, "opsgenie", incidentMessage.Type)
+	assert.Equal(t, notificationInterfaces.TriggerIncident, incidentMessage.Action)
+	assert.Equal(t, "flyte/project/domain/name", incidentMessage.Key)
+	assert.Contains(t, incidentMessage.Summary, "oops")
 }
 
-func TestCreateWorkflowEvent_InvalidEvent(t *testing.T) {
+func TestCreateWorkflowEvent_TrackDeliveries(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	startTime := time.Now()
-	executionGetFunc := makeExecutionGetFunc(t, closureBytes, &startTime)
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-	executionError := core.ExecutionError{
-		Code:    "foo",
-		Message: "bar baz",
-	}
-	updateExecutionFunc := func(
-		context context.Context, execution models.Execution) error {
-		return nil
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosure := admin.ExecutionClosure{
+		Phase:      core.WorkflowExecution_RUNNING,
+		StartedAt:  startTimeProto,
+		WorkflowId: proto.Clone(&workflowIdentifier).(*core.Identifier),
 	}
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, &startTime))
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			return nil
+		})
+	occurredAt, _ := ptypes.TimestampProto(startTime.Add(time.Second))
+	request := admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
 			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAt,
 			Phase:       core.WorkflowExecution_FAILED,
 			OutputResult: &event.WorkflowExecutionEvent_Error{
-				Error: &executionError,
+				Error: &core.ExecutionError{Message: "oops"},
 			},
 		},
+	}
+	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
+	mockDbEventWriter.On("Write", request)
+
+	published := make(map[string]proto.Message)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		published[notificationType] = msg
+		return nil
 	})
-	assert.NotNil(t, err)
-	assert.Nil(t, resp)
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+		runtimeInterfaces.NotificationsConfig{
+			TrackDeliveries: true,
+			NotificationsIncidentsConfig: runtimeInterfaces.NotificationsIncidentsConfig{
+				Endpoints: []runtimeInterfaces.ProjectDomainIncidents{
+					{
+						Domain: "domain",
+						Type:   "opsgenie",
+					},
+				},
+			},
+		})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &publisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+
+	// The incident is wrapped so that the processor records its delivery.
+	assert.Len(t, published, 1)
+	trackedNotification := published["flyteadmin.notifications.TrackedNotification"].(*any.Any)
+	var tracked notificationInterfaces.TrackedNotification
+	assert.NoError(t, json.Unmarshal(trackedNotification.Value, &tracked))
+	assert.Zero(t, tracked.DeliveryID)
+	assert.Equal(t, "project", tracked.Project)
+	assert.Equal(t, "domain", tracked.Domain)
+	assert.Equal(t, "name", tracked.ExecutionName)
+	var incidentNotification any.Any
+	assert.NoError(t, proto.Unmarshal(tracked.Notification, &incidentNotification))
+	assert.Equal(t, "type.flyte.org/flyteadmin.notifications.IncidentMessage", incidentNotification.TypeUrl)
 }
 
-func TestCreateWorkflowEvent_UpdateModelError(t *testing.T) {
+func TestCreateWorkflowEvent_NotificationDigest(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	startTime := time.Now()
-	executionGetFunc := makeExecutionGetFunc(t, []byte("invalid serialized closure"), &startTime)
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-	duration := time.Second
-	endTime := startTime.Add(duration)
-	occurredAt, _ := ptypes.TimestampProto(endTime)
-	executionError := core.ExecutionError{
-		Code:    "foo",
-		Message: "bar baz",
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosure := admin.ExecutionClosure{
+		Phase:      core.WorkflowExecution_RUNNING,
+		StartedAt:  startTimeProto,
+		WorkflowId: proto.Clone(&workflowIdentifier).(*core.Identifier),
+		Notifications: []*admin.Notification{
+			{
+				Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{RecipientsEmail: []string{"a@example.com"}},
+				},
+			},
+		},
 	}
-
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, &startTime))
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			return nil
+		})
+	occurredAt := time.Date(2021, time.October, 27, 9, 30, 0, 0, time.UTC)
+	occurredAtProto, _ := ptypes.TimestampProto(occurredAt)
+	request := admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
 			ExecutionId: &executionIdentifier,
-			OccurredAt:  occurredAt,
+			OccurredAt:  occurredAtProto,
 			Phase:       core.WorkflowExecution_FAILED,
 			OutputResult: &event.WorkflowExecutionEvent_Error{
-				Error: &executionError,
+				Error: &core.ExecutionError{Message: "oops"},
 			},
 		},
+	}
+	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
+	mockDbEventWriter.On("Write", request)
+
+	published := make(map[string]proto.Message)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		published[notificationType] = msg
+		return nil
 	})
-	assert.Nil(t, resp)
-	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+		runtimeInterfaces.NotificationsConfig{
+			NotificationsDigestsConfig: runtimeInterfaces.NotificationsDigestsConfig{
+				Subscriptions: []runtimeInterfaces.ProjectDomainDigest{
+					{
+						Project:    "project",
+						LaunchPlan: "other",
+						Interval:   config.Duration{Duration: time.Minute},
+					},
+					{
+						Project: "project",
+						Domain:  "domain",
+						GroupBy: runtimeInterfaces.DigestByProject,
+					},
+				},
+			},
+		})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &publisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+
+	// The email is batched into the project's hourly digest instead of sent.
+	assert.Len(t, published, 1)
+	digestEntryNotification := published["flyteadmin.notifications.DigestEntryMessage"].(*any.Any)
+	var digestEntry notificationInterfaces.DigestEntryMessage
+	assert.NoError(t, json.Unmarshal(digestEntryNotification.Value, &digestEntry))
+	assert.Equal(t, "name", digestEntry.ExecutionName)
+	assert.Equal(t, "failed", digestEntry.Phase)
+	assert.Equal(t, "oops", digestEntry.Error)
+	assert.Equal(t, []string{"a@example.com"}, digestEntry.Recipients)
+	assert.Equal(t, "project/domain|a@example.com", digestEntry.Group)
+	assert.Equal(t, time.Date(2021, time.October, 27, 10, 0, 0, 0, time.UTC), digestEntry.DueAt)
 }
 
-func TestCreateWorkflowEvent_DatabaseGetError(t *testing.T) {
+func TestCreateWorkflowEvent_RegisteredWebhooks(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	startTime := time.Now()
-
-	expectedErr := errors.New("expected error")
-	executionGetFunc := func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
-		return models.Execution{}, expectedErr
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosure := admin.ExecutionClosure{
+		Phase:      core.WorkflowExecution_RUNNING,
+		StartedAt:  startTimeProto,
+		WorkflowId: proto.Clone(&workflowIdentifier).(*core.Identifier),
 	}
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-	duration := time.Second
-	endTime := startTime.Add(duration)
-	occurredAt, _ := ptypes.TimestampProto(endTime)
-	executionError := core.ExecutionError{
-		Code:    "foo",
-		Message: "bar baz",
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, &startTime))
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			return nil
+		})
+	webhook := func(name, phases string) models.Webhook {
+		return models.Webhook{
+			WebhookKey: models.WebhookKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    name,
+			},
+			URL:      "https://example.com/hooks/" + name,
+			Template: `{"execution": {{ json .Name }}, "phase": {{ json .Phase }}, "error": {{ json .Error }}}`,
+			Phases:   phases,
+		}
 	}
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+	repository.WebhookRepo().(*repositoryMocks.WebhookRepoInterface).OnListMatch(mock.Anything, mock.Anything).Return(
+		interfaces.WebhookCollectionOutput{
+			Webhooks: []models.Webhook{webhook("failures", "FAILED"), webhook("successes", "SUCCEEDED")},
+		}, nil)
+	occurredAt, _ := ptypes.TimestampProto(startTime.Add(time.Second))
+	request := admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
 			ExecutionId: &executionIdentifier,
 			OccurredAt:  occurredAt,
 			Phase:       core.WorkflowExecution_FAILED,
 			OutputResult: &event.WorkflowExecutionEvent_Error{
-				Error: &executionError,
+				Error: &core.ExecutionError{Message: "oops"},
 			},
 		},
-	})
-	assert.Nil(t, resp)
-	assert.EqualError(t, expectedErr, err.Error())
-}
+	}
+	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
+	mockDbEventWriter.On("Write", request)
 
-func TestCreateWorkflowEvent_DatabaseUpdateError(t *testing.T) {
-	repository := repositoryMocks.NewMockRepository()
-	startTime := time.Now()
-	executionGetFunc := makeExecutionGetFunc(t, closureBytes, &startTime)
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-	duration := time.Second
-	endTime := startTime.Add(duration)
-	occurredAt, _ := ptypes.TimestampProto(endTime)
-	executionError := core.ExecutionError{
-		Code:    "foo",
-		Message: "bar baz",
+	published := make(map[string][]proto.Message)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		published[notificationType] = append(published[notificationType], msg)
+		return nil
+	})
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+		runtimeInterfaces.NotificationsConfig{
+			RegisteredWebhooksConfig: runtimeInterfaces.RegisteredWebhooksConfig{
+				Enabled: true,
+			},
+		})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &publisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+
+	// Only the webhook registered for failures is delivered to.
+	deliveries := published["flyteadmin.notifications.WebhookDeliveryMessage"]
+	assert.Len(t, deliveries, 1)
+	var deliveryMessage notificationInterfaces.WebhookDeliveryMessage
+	assert.NoError(t, json.Unmarshal(deliveries[0].(*any.Any).Value, &deliveryMessage))
+	assert.Equal(t, "failures", deliveryMessage.WebhookName)
+	assert.Equal(t, "name", deliveryMessage.ExecutionName)
+	assert.Equal(t, "FAILED", deliveryMessage.Phase)
+	assert.JSONEq(t, `{"execution": "name", "phase": "FAILED", "error": "oops"}`, deliveryMessage.Payload)
+}
+
+func TestCreateWorkflowEvent_Subscriptions(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosure := admin.ExecutionClosure{
+		Phase:      core.WorkflowExecution_RUNNING,
+		StartedAt:  startTimeProto,
+		WorkflowId: proto.Clone(&workflowIdentifier).(*core.Identifier),
+		Notifications: []*admin.Notification{
+			{
+				Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+				Type: &admin.Notification_Email{
+					Email: &admin.EmailNotification{RecipientsEmail: []string{"owner@example.com"}},
+				},
+			},
+		},
 	}
-	expectedErr := errors.New("expected error")
-	updateExecutionFunc := func(
-		context context.Context, execution models.Execution) error {
-		return expectedErr
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, existingClosureBytes, &startTime))
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			return nil
+		})
+	subscription := func(address, phases string) models.NotificationSubscription {
+		return models.NotificationSubscription{
+			NotificationSubscriptionKey: models.NotificationSubscriptionKey{
+				Project: "project",
+				Domain:  "domain",
+				Type:    "email",
+				Address: address,
+			},
+			Phases: phases,
+		}
 	}
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+	repository.NotificationSubscriptionRepo().(*repositoryMocks.NotificationSubscriptionRepoInterface).
+		OnListForLaunchPlanMatch(mock.Anything, "project", "domain", mock.Anything).Return(
+		[]models.NotificationSubscription{
+			subscription("owner@example.com", "FAILED"),
+			subscription("alice@example.com", "FAILED,TIMED_OUT"),
+			subscription("bob@example.com", "SUCCEEDED"),
+		}, nil)
+	occurredAt, _ := ptypes.TimestampProto(startTime.Add(time.Second))
+	request := admin.WorkflowExecutionEventRequest{
 		RequestId: "1",
 		Event: &event.WorkflowExecutionEvent{
 			ExecutionId: &executionIdentifier,
 			OccurredAt:  occurredAt,
 			Phase:       core.WorkflowExecution_FAILED,
 			OutputResult: &event.WorkflowExecutionEvent_Error{
-				Error: &executionError,
+				Error: &core.ExecutionError{Message: "oops"},
 			},
 		},
+	}
+	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
+	mockDbEventWriter.On("Write", request)
+
+	published := make(map[string][]proto.Message)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		published[notificationType] = append(published[notificationType], msg)
+		return nil
 	})
-	assert.Nil(t, resp)
-	assert.EqualError(t, expectedErr, err.Error())
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+		runtimeInterfaces.NotificationsConfig{
+			EnableSubscriptions: true,
+		})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &publisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+
+	// Only the subscriber to failures who isn't already notified is added.
+	emails := published[emailNotificationType]
+	assert.Len(t, emails, 2)
+	assert.Equal(t, []string{"owner@example.com"}, emails[0].(*admin.EmailMessage).RecipientsEmail)
+	assert.Equal(t, []string{"alice@example.com"}, emails[1].(*admin.EmailMessage).RecipientsEmail)
 }
 
-func TestGetExecution(t *testing.T) {
+func TestCreateWorkflowEvent_TerminalState(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	startedAt := time.Date(2018, 8, 30, 0, 0, 0, 0, time.UTC)
 	executionGetFunc := func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
-		assert.Equal(t, "project", input.Project)
-		assert.Equal(t, "domain", input.Domain)
-		assert.Equal(t, "name", input.Name)
 		return models.Execution{
 			ExecutionKey: models.ExecutionKey{
 				Project: "project",
 				Domain:  "domain",
 				Name:    "name",
 			},
-			Spec:         specBytes,
-			Phase:        phase,
-			Closure:      closureBytes,
-			LaunchPlanID: uint(1),
-			WorkflowID:   uint(2),
-			StartedAt:    &startedAt,
-			// TODO: Input uri
+			BaseModel: models.BaseModel{
+				ID: uint(8),
+			},
+			Spec:  specBytes,
+			Phase: core.WorkflowExecution_FAILED.String(),
 		}, nil
 	}
+
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
-		Id: &executionIdentifier,
+	updateExecutionFunc := func(context context.Context, execution models.Execution) error {
+		return nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			Phase:       core.WorkflowExecution_SUCCEEDED,
+		},
 	})
-	assert.NoError(t, err)
-	assert.True(t, proto.Equal(&executionIdentifier, execution.Id))
-	assert.True(t, proto.Equal(spec, execution.Spec))
-	assert.True(t, proto.Equal(&closure, execution.Closure))
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	adminError := err.(flyteAdminErrors.FlyteAdminError)
+	assert.Equal(t, adminError.Code(), codes.FailedPrecondition)
+	details, ok := adminError.GRPCStatus().Details()[0].(*admin.EventFailureReason)
+	assert.True(t, ok)
+	_, ok = details.GetReason().(*admin.EventFailureReason_AlreadyInTerminalState)
+	assert.True(t, ok)
 }
 
-func TestGetExecution_DatabaseError(t *testing.T) {
+func TestCreateWorkflowEvent_StartedRunning(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	expectedErr := errors.New("expected error")
+	occurredAt := time.Now().UTC()
+	occurredAtProto, _ := ptypes.TimestampProto(occurredAt)
+	executionGetFunc := makeExecutionGetFunc(t, closureBytes, nil)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
 
-	executionGetFunc := func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
-		assert.Equal(t, "project", input.Project)
-		assert.Equal(t, "domain", input.Domain)
-		assert.Equal(t, "name", input.Name)
-		return models.Execution{}, expectedErr
+	closure := admin.ExecutionClosure{
+		Phase:     core.WorkflowExecution_RUNNING,
+		StartedAt: occurredAtProto,
+		UpdatedAt: occurredAtProto,
 	}
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
-		Id: &executionIdentifier,
+	closureBytes, _ := proto.Marshal(&closure)
+	updateExecutionFunc := func(
+		context context.Context, execution models.Execution) error {
+		assert.Equal(t, "project", execution.Project)
+		assert.Equal(t, "domain", execution.Domain)
+		assert.Equal(t, "name", execution.Name)
+		assert.Equal(t, uint(1), execution.LaunchPlanID)
+		assert.Equal(t, uint(2), execution.WorkflowID)
+		assert.Equal(t, core.WorkflowExecution_RUNNING.String(), execution.Phase)
+		assert.Equal(t, closureBytes, execution.Closure)
+		assert.Equal(t, specBytes, execution.Spec)
+		assert.Equal(t, occurredAt, *execution.StartedAt)
+		assert.Equal(t, time.Duration(0), execution.Duration)
+		assert.Equal(t, occurredAt, *execution.ExecutionUpdatedAt)
+		return nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
+	occurredAtTimestamp, _ := ptypes.TimestampProto(occurredAt)
+	request := admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAtTimestamp,
+			Phase:       core.WorkflowExecution_RUNNING,
+		},
+	}
+	mockDbEventWriter := &eventWriterMocks.WorkflowExecutionEventWriter{}
+	mockDbEventWriter.On("Write", request)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, &mockPublisher, mockDbEventWriter, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), request)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+}
+
+func TestCreateWorkflowEvent_DuplicateRunning(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	occurredAt := time.Now().UTC()
+
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+				BaseModel: models.BaseModel{
+					ID: uint(8),
+				},
+				Spec:         specBytes,
+				Phase:        core.WorkflowExecution_RUNNING.String(),
+				Closure:      closureBytes,
+				LaunchPlanID: uint(1),
+				WorkflowID:   uint(2),
+				StartedAt:    &occurredAt,
+			}, nil
+		},
+	)
+
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	occurredAtTimestamp, _ := ptypes.TimestampProto(occurredAt)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAtTimestamp,
+			Phase:       core.WorkflowExecution_RUNNING,
+		},
 	})
-	assert.Nil(t, execution)
-	assert.Equal(t, expectedErr, err)
+	assert.NotNil(t, err)
+	adminError := err.(flyteAdminErrors.FlyteAdminError)
+	assert.Equal(t, adminError.Code(), codes.AlreadyExists)
+	assert.Nil(t, resp)
 }
 
-func TestGetExecution_TransformerError(t *testing.T) {
+func TestCreateWorkflowEvent_InvalidPhaseChange(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	startedAt := time.Date(2018, 8, 30, 0, 0, 0, 0, time.UTC)
-	executionGetFunc := func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
-		assert.Equal(t, "project", input.Project)
-		assert.Equal(t, "domain", input.Domain)
-		assert.Equal(t, "name", input.Name)
-		return models.Execution{
-			ExecutionKey: models.ExecutionKey{
-				Project: "project",
-				Domain:  "domain",
-				Name:    "name",
-			},
-			Spec:         []byte("invalid spec"),
-			Phase:        phase,
-			Closure:      closureBytes,
-			LaunchPlanID: uint(1),
-			WorkflowID:   uint(2),
-			StartedAt:    &startedAt,
-		}, nil
-	}
-	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
-	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
-		Id: &executionIdentifier,
+	occurredAt := time.Now().UTC()
+
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+				BaseModel: models.BaseModel{
+					ID: uint(8),
+				},
+				Spec:         specBytes,
+				Phase:        core.WorkflowExecution_SUCCEEDED.String(),
+				Closure:      closureBytes,
+				LaunchPlanID: uint(1),
+				WorkflowID:   uint(2),
+				StartedAt:    &occurredAt,
+			}, nil
+		},
+	)
+
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	occurredAtTimestamp, _ := ptypes.TimestampProto(occurredAt)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAtTimestamp,
+			Phase:       core.WorkflowExecution_RUNNING,
+		},
 	})
-	assert.Nil(t, execution)
-	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.NotNil(t, err)
+	adminError := err.(flyteAdminErrors.FlyteAdminError)
+	assert.Equal(t, adminError.Code(), codes.FailedPrecondition)
+	assert.Nil(t, resp)
+	details, ok := adminError.GRPCStatus().Details()[0].(*admin.EventFailureReason)
+	assert.True(t, ok)
+	_, ok = details.GetReason().(*admin.EventFailureReason_AlreadyInTerminalState)
+	assert.True(t, ok)
 }
 
-func TestListExecutions(t *testing.T) {
+func TestCreateWorkflowEvent_InvalidEvent(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
-	executionListFunc := func(
+	startTime := time.Now()
+	executionGetFunc := makeExecutionGetFunc(t, closureBytes, &startTime)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
+	executionError := core.ExecutionError{
+		Code:    "foo",
+		Message: "bar baz",
+	}
+	updateExecutionFunc := func(
+		context context.Context, execution models.Execution) error {
+		return nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			Phase:       core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &executionError,
+			},
+		},
+	})
+	assert.NotNil(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestCreateWorkflowEvent_UpdateModelError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	executionGetFunc := makeExecutionGetFunc(t, []byte("invalid serialized closure"), &startTime)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
+	duration := time.Second
+	endTime := startTime.Add(duration)
+	occurredAt, _ := ptypes.TimestampProto(endTime)
+	executionError := core.ExecutionError{
+		Code:    "foo",
+		Message: "bar baz",
+	}
+
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAt,
+			Phase:       core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &executionError,
+			},
+		},
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateWorkflowEvent_DatabaseGetError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+
+	expectedErr := errors.New("expected error")
+	executionGetFunc := func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+		return models.Execution{}, expectedErr
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
+	duration := time.Second
+	endTime := startTime.Add(duration)
+	occurredAt, _ := ptypes.TimestampProto(endTime)
+	executionError := core.ExecutionError{
+		Code:    "foo",
+		Message: "bar baz",
+	}
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAt,
+			Phase:       core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &executionError,
+			},
+		},
+	})
+	assert.Nil(t, resp)
+	assert.EqualError(t, expectedErr, err.Error())
+}
+
+func TestCreateWorkflowEvent_DatabaseUpdateError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	executionGetFunc := makeExecutionGetFunc(t, closureBytes, &startTime)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
+	duration := time.Second
+	endTime := startTime.Add(duration)
+	occurredAt, _ := ptypes.TimestampProto(endTime)
+	executionError := core.ExecutionError{
+		Code:    "foo",
+		Message: "bar baz",
+	}
+	expectedErr := errors.New("expected error")
+	updateExecutionFunc := func(
+		context context.Context, execution models.Execution) error {
+		return expectedErr
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(updateExecutionFunc)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAt,
+			Phase:       core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &executionError,
+			},
+		},
+	})
+	assert.Nil(t, resp)
+	assert.EqualError(t, expectedErr, err.Error())
+}
+
+func TestGetExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startedAt := time.Date(2018, 8, 30, 0, 0, 0, 0, time.UTC)
+	executionGetFunc := func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+		assert.Equal(t, "project", input.Project)
+		assert.Equal(t, "domain", input.Domain)
+		assert.Equal(t, "name", input.Name)
+		return models.Execution{
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			Spec:         specBytes,
+			Phase:        phase,
+			Closure:      closureBytes,
+			LaunchPlanID: uint(1),
+			WorkflowID:   uint(2),
+			StartedAt:    &startedAt,
+			// TODO: Input uri
+		}, nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
+		Id: &executionIdentifier,
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&executionIdentifier, execution.Id))
+	assert.True(t, proto.Equal(spec, execution.Spec))
+	assert.True(t, proto.Equal(&closure, execution.Closure))
+}
+
+func TestGetExecution_DatabaseError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	expectedErr := errors.New("expected error")
+
+	executionGetFunc := func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+		assert.Equal(t, "project", input.Project)
+		assert.Equal(t, "domain", input.Domain)
+		assert.Equal(t, "name", input.Name)
+		return models.Execution{}, expectedErr
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
+		Id: &executionIdentifier,
+	})
+	assert.Nil(t, execution)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestGetExecution_TransformerError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startedAt := time.Date(2018, 8, 30, 0, 0, 0, 0, time.UTC)
+	executionGetFunc := func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+		assert.Equal(t, "project", input.Project)
+		assert.Equal(t, "domain", input.Domain)
+		assert.Equal(t, "name", input.Name)
+		return models.Execution{
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			Spec:         []byte("invalid spec"),
+			Phase:        phase,
+			Closure:      closureBytes,
+			LaunchPlanID: uint(1),
+			WorkflowID:   uint(2),
+			StartedAt:    &startedAt,
+		}, nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
+		Id: &executionIdentifier,
+	})
+	assert.Nil(t, execution)
+	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListExecutions(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	executionListFunc := func(
 		ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
 		var projectFilter, domainFilter, nameFilter bool
 		for _, filter := range input.InlineFilters {
@@ -1711,7 +2854,7 @@ func TestListExecutions(t *testing.T) {
 		}, nil
 	}
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(executionListFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	executionList, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
@@ -1741,8 +2884,102 @@ func TestListExecutions(t *testing.T) {
 	assert.Empty(t, executionList.Token)
 }
 
+func TestListExecutions_CursorPagination(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	createdAt := time.Date(2021, 8, 20, 10, 0, 0, 0, time.UTC)
+	var listInput interfaces.ListResourceInput
+	executionListFunc := func(
+		ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+		listInput = input
+		return interfaces.ExecutionCollectionOutput{
+			Executions: []models.Execution{
+				{
+					BaseModel: models.BaseModel{
+						ID:        7,
+						CreatedAt: createdAt,
+					},
+					ExecutionKey: models.ExecutionKey{
+						Project: projectValue,
+						Domain:  domainValue,
+						Name:    "my awesome execution",
+					},
+					Spec:    specBytes,
+					Closure: closureBytes,
+				},
+			},
+		}, nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(executionListFunc)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	request := admin.ResourceListRequest{
+		Id: &admin.NamedEntityIdentifier{
+			Project: projectValue,
+			Domain:  domainValue,
+		},
+		Limit: 1,
+		SortBy: &admin.Sort{
+			Direction: admin.Sort_DESCENDING,
+			Key:       "created_at",
+		},
+	}
+
+	executionList, err := execManager.ListExecutions(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, executionList.Executions, 1)
+	assert.Equal(t, &interfaces.ListCursor{Descending: true}, listInput.Cursor)
+	assert.NotEmpty(t, executionList.Token)
+
+	request.Token = executionList.Token
+	_, err = execManager.ListExecutions(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ListCursor{
+		Descending: true,
+		CreatedAt:  createdAt,
+		ID:         7,
+	}, listInput.Cursor)
+
+	// Offset tokens issued before switching to cursor pagination are still honored.
+	request.Token = "2"
+	_, err = execManager.ListExecutions(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Nil(t, listInput.Cursor)
+	assert.Equal(t, 2, listInput.Offset)
+
+	request.Token = "not a token"
+	_, err = execManager.ListExecutions(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListExecutions_FailureCauseFilter(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var failureCauseFilter bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(func(
+		ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+		for _, filter := range input.InlineFilters {
+			queryExpr, _ := filter.GetGormQueryExpr()
+			if queryExpr.Query == "failure_cause in (?)" {
+				failureCauseFilter = true
+				assert.Equal(t, []interface{}{common.FailureCauseOOM, common.FailureCauseSpotPreemption}, queryExpr.Args)
+			}
+		}
+		return interfaces.ExecutionCollectionOutput{}, nil
+	})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	_, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
+		Id: &admin.NamedEntityIdentifier{
+			Project: projectValue,
+			Domain:  domainValue,
+		},
+		Limit:   limit,
+		Filters: "value_in(failure_cause,OOM;SPOT_PREEMPTION)",
+	})
+	assert.NoError(t, err)
+	assert.True(t, failureCauseFilter, "Missing failure cause filter")
+}
+
 func TestListExecutions_MissingParameters(t *testing.T) {
-	execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	_, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
 			Domain: domainValue,
@@ -1779,7 +3016,7 @@ func TestListExecutions_DatabaseError(t *testing.T) {
 		return interfaces.ExecutionCollectionOutput{}, expectedErr
 	}
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(executionListFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	_, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
 			Project: projectValue,
@@ -1810,7 +3047,7 @@ func TestListExecutions_TransformerError(t *testing.T) {
 		}, nil
 	}
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(executionListFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	executionList, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
@@ -2117,7 +3354,7 @@ func TestTerminateExecution(t *testing.T) {
 			}, input.ExecutionID))
 			return nil
 		})
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	identity := auth.NewIdentityContext("", principal, "", time.Now(), sets.NewString(), nil)
 	ctx := identity.WithContext(context.Background())
@@ -2134,10 +3371,53 @@ func TestTerminateExecution(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
-func TestTerminateExecution_PropellerError(t *testing.T) {
-	var expectedError = errors.New("expected error")
-
-	mockExecutor := workflowengineMocks.NewMockExecutor()
+func TestTerminateExecution_Pending(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	executionGetFunc := makeExecutionGetFunc(t, []byte{}, &startTime)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			executionModel, err := executionGetFunc(ctx, input)
+			executionModel.Pending = true
+			executionModel.Phase = core.WorkflowExecution_UNDEFINED.String()
+			executionModel.Cluster = ""
+			return executionModel, err
+		})
+	var updated bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(func(
+		context context.Context, execution models.Execution) error {
+		updated = true
+		assert.Equal(t, core.WorkflowExecution_ABORTED.String(), execution.Phase)
+		var closure admin.ExecutionClosure
+		assert.NoError(t, proto.Unmarshal(execution.Closure, &closure))
+		assert.Equal(t, core.WorkflowExecution_ABORTED, closure.Phase)
+		assert.Equal(t, "abort cause", closure.GetAbortMetadata().GetCause())
+		return nil
+	})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
+		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
+			t.Fatal("terminated a pending execution which was never launched")
+			return nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	resp, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Cause: "abort cause",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.True(t, updated)
+}
+
+func TestTerminateExecution_PropellerError(t *testing.T) {
+	var expectedError = errors.New("expected error")
+
+	mockExecutor := workflowengineMocks.NewMockExecutor()
 	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
 		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
 			return expectedError
@@ -2148,7 +3428,7 @@ func TestTerminateExecution_PropellerError(t *testing.T) {
 		t.Fatal("update should not be called when propeller fails to terminate an execution")
 		return nil
 	})
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	resp, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
 		Id: &core.WorkflowExecutionIdentifier{
@@ -2162,6 +3442,134 @@ func TestTerminateExecution_PropellerError(t *testing.T) {
 	assert.EqualError(t, err, expectedError.Error())
 }
 
+func getExecutionModelsForTermination(names ...string) []models.Execution {
+	createdAt := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	executionModels := make([]models.Execution, 0, len(names))
+	for i, name := range names {
+		executionModels = append(executionModels, models.Execution{
+			BaseModel: models.BaseModel{
+				ID:        uint(i + 1),
+				CreatedAt: createdAt.Add(time.Duration(i) * time.Minute),
+			},
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    name,
+			},
+			Phase:   core.WorkflowExecution_RUNNING.String(),
+			Closure: []byte{},
+			Cluster: testCluster,
+		})
+	}
+	return executionModels
+}
+
+func TestTerminateExecutions(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	executionModels := getExecutionModelsForTermination("a", "b", "c")
+	createdBefore := time.Date(2021, 10, 2, 0, 0, 0, 0, time.UTC)
+	var listCalls int
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+			listCalls++
+			assert.Equal(t, 2, input.Limit)
+			assert.True(t, input.JoinTableEntities[common.LaunchPlan])
+			var queries []string
+			for _, filter := range input.InlineFilters {
+				expr, err := filter.GetGormQueryExpr()
+				assert.NoError(t, err)
+				queries = append(queries, expr.Query)
+			}
+			assert.Equal(t, []string{"execution_project = ?", "execution_domain = ?", "phase in (?)", "name = ?",
+				"created_at < ?"}, queries)
+			// Batches are listed after the last execution of the previous batch.
+			if input.Cursor.IsFirstPage() {
+				return interfaces.ExecutionCollectionOutput{Executions: executionModels[:2]}, nil
+			}
+			assert.Equal(t, uint(2), input.Cursor.ID)
+			assert.Equal(t, executionModels[1].CreatedAt, input.Cursor.CreatedAt)
+			return interfaces.ExecutionCollectionOutput{Executions: executionModels[2:]}, nil
+		})
+	var updated []string
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			for _, executionModel := range executionModels {
+				if executionModel.Name == input.Name {
+					return executionModel, nil
+				}
+			}
+			return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			assert.Equal(t, "bad launch plan", execution.AbortCause)
+			updated = append(updated, execution.Name)
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
+		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
+			if input.ExecutionID.Name == "b" {
+				return errors.New("expected error")
+			}
+			return nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	resp, err := execManager.TerminateExecutions(context.Background(), managerInterfaces.TerminateExecutionsRequest{
+		Project:        "project",
+		Domain:         "domain",
+		LaunchPlanName: "lp",
+		CreatedBefore:  &createdBefore,
+		Cause:          "bad launch plan",
+		BatchSize:      2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, listCalls)
+	assert.Equal(t, 3, resp.Matched)
+	assert.Len(t, resp.Failed, 1)
+	assert.Equal(t, "b", resp.Failed[0].Name)
+	assert.Equal(t, []string{"a", "c"}, updated)
+}
+
+func TestTerminateExecutions_DryRun(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+			assert.Equal(t, defaultTerminateExecutionsBatchSize, input.Limit)
+			assert.Len(t, input.InlineFilters, 3)
+			return interfaces.ExecutionCollectionOutput{
+				Executions: getExecutionModelsForTermination("a", "b"),
+			}, nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetTerminateExecutionCallback(
+		func(ctx context.Context, input workflowengineInterfaces.TerminateWorkflowInput) error {
+			t.Fatal("dry run terminated an execution")
+			return nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	resp, err := execManager.TerminateExecutions(context.Background(), managerInterfaces.TerminateExecutionsRequest{
+		Project: "project",
+		Domain:  "domain",
+		DryRun:  true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resp.Matched)
+	assert.Empty(t, resp.Failed)
+}
+
+func TestTerminateExecutions_InvalidPhase(t *testing.T) {
+	execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	_, err := execManager.TerminateExecutions(context.Background(), managerInterfaces.TerminateExecutionsRequest{
+		Project: "project",
+		Domain:  "domain",
+		Phases:  []core.WorkflowExecution_Phase{core.WorkflowExecution_ABORTED},
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
 func TestTerminateExecution_DatabaseError(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	startTime := time.Now()
@@ -2175,7 +3583,7 @@ func TestTerminateExecution_DatabaseError(t *testing.T) {
 	}
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateExecutionCallback(updateExecutionFunc)
 
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	resp, err := execManager.TerminateExecution(context.Background(), admin.ExecutionTerminateRequest{
 		Id: &core.WorkflowExecutionIdentifier{
 			Project: "project",
@@ -2189,6 +3597,64 @@ func TestTerminateExecution_DatabaseError(t *testing.T) {
 	assert.EqualError(t, err, expectedError.Error())
 }
 
+func TestDeleteExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	getFunc := makeExecutionGetFunc(t, []byte{}, &startTime)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			execution, err := getFunc(ctx, input)
+			execution.Phase = core.WorkflowExecution_SUCCEEDED.String()
+			return execution, err
+		})
+	var deleted bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).DeleteFunction = func(
+		ctx context.Context, input interfaces.Identifier) error {
+		assert.Equal(t, "project", input.Project)
+		assert.Equal(t, "domain", input.Domain)
+		assert.Equal(t, "name", input.Name)
+		deleted = true
+		return nil
+	}
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	err := execManager.DeleteExecution(context.Background(), &executionIdentifier)
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+}
+
+func TestDeleteExecution_NotTerminal(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, []byte{}, &startTime))
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).DeleteFunction = func(
+		ctx context.Context, input interfaces.Identifier) error {
+		t.Fatal("running executions should not be deleted")
+		return nil
+	}
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	err := execManager.DeleteExecution(context.Background(), &executionIdentifier)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestRestoreExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	expectedError := errors.New("expected error")
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).RestoreFunction = func(
+		ctx context.Context, input interfaces.Identifier) error {
+		assert.Equal(t, "project", input.Project)
+		assert.Equal(t, "domain", input.Domain)
+		assert.Equal(t, "name", input.Name)
+		return expectedError
+	}
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	err := execManager.RestoreExecution(context.Background(), &executionIdentifier)
+	assert.EqualError(t, err, expectedError.Error())
+}
+
 func TestGetExecutionData(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	startedAt := time.Date(2018, 8, 30, 0, 0, 0, 0, time.UTC)
@@ -2263,7 +3729,7 @@ func TestGetExecutionData(t *testing.T) {
 	}
 
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	dataResponse, err := execManager.GetExecutionData(context.Background(), admin.WorkflowExecutionGetDataRequest{
 		Id: &executionIdentifier,
 	})
@@ -2282,6 +3748,118 @@ func TestGetExecutionData(t *testing.T) {
 	}, dataResponse))
 }
 
+func TestGetExecutionDataWithOptions(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	closure := admin.ExecutionClosure{
+		Phase: core.WorkflowExecution_SUCCEEDED,
+		OutputResult: &admin.ExecutionClosure_Outputs{
+			Outputs: &admin.LiteralMapBlob{
+				Data: &admin.LiteralMapBlob_Uri{
+					Uri: outputURI,
+				},
+			},
+		},
+	}
+	closureBytes, _ := proto.Marshal(&closure)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+				Spec:      specBytes,
+				Phase:     core.WorkflowExecution_SUCCEEDED.String(),
+				Closure:   closureBytes,
+				InputsURI: shared.Inputs,
+			}, nil
+		})
+	mockExecutionRemoteURL := dataMocks.NewMockRemoteURL()
+	mockExecutionRemoteURL.(*dataMocks.MockRemoteURL).GetCallback = func(
+		ctx context.Context, uri string) (admin.UrlBlob, error) {
+		if uri == outputURI {
+			return admin.UrlBlob{
+				Url:   "outputs",
+				Bytes: 200,
+			}, nil
+		} else if uri == shared.Inputs {
+			return admin.UrlBlob{
+				Url:   "inputs",
+				Bytes: 200,
+			}, nil
+		}
+		return admin.UrlBlob{}, errors.New("unexpected input")
+	}
+	fullInputs := &core.LiteralMap{
+		Literals: map[string]*core.Literal{
+			"foo": testutils.MakeStringLiteral("foo-value-1"),
+			"baz": testutils.MakeStringLiteral("baz-value-1"),
+		},
+	}
+	fullOutputs := &core.LiteralMap{
+		Literals: map[string]*core.Literal{
+			"bar": testutils.MakeStringLiteral("bar-value-1"),
+		},
+	}
+	mockStorage := commonMocks.GetMockStorageClient()
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+		if reference.String() == shared.Inputs {
+			marshalled, _ := proto.Marshal(fullInputs)
+			_ = proto.Unmarshal(marshalled, msg)
+			return nil
+		} else if reference.String() == outputURI {
+			marshalled, _ := proto.Marshal(fullOutputs)
+			_ = proto.Unmarshal(marshalled, msg)
+			return nil
+		}
+		return fmt.Errorf("unexpected call to find value in storage [%v]", reference.String())
+	}
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	t.Run("selected keys", func(t *testing.T) {
+		dataResponse, err := execManager.GetExecutionDataWithOptions(context.Background(),
+			admin.WorkflowExecutionGetDataRequest{
+				Id: &executionIdentifier,
+			}, managerInterfaces.GetDataOptions{
+				Keys: []string{"foo", "bar"},
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, "inputs", dataResponse.Inputs.URLBlob.Url)
+		assert.Len(t, dataResponse.Inputs.Literals, 1)
+		assert.True(t, proto.Equal(fullInputs.Literals["foo"], dataResponse.Inputs.Literals["foo"]))
+		assert.False(t, dataResponse.Inputs.Incomplete)
+		assert.Equal(t, "outputs", dataResponse.Outputs.URLBlob.Url)
+		assert.Len(t, dataResponse.Outputs.Literals, 1)
+		assert.True(t, proto.Equal(fullOutputs.Literals["bar"], dataResponse.Outputs.Literals["bar"]))
+		assert.False(t, dataResponse.Outputs.Incomplete)
+	})
+	t.Run("truncated literals", func(t *testing.T) {
+		dataResponse, err := execManager.GetExecutionDataWithOptions(context.Background(),
+			admin.WorkflowExecutionGetDataRequest{
+				Id: &executionIdentifier,
+			}, managerInterfaces.GetDataOptions{
+				MaxLiteralBytes: 1,
+			})
+		assert.NoError(t, err)
+		assert.Empty(t, dataResponse.Inputs.Literals)
+		assert.Equal(t, "inputs", dataResponse.Inputs.Truncated["foo"].FullValueURL)
+		assert.True(t, dataResponse.Inputs.Incomplete)
+		assert.Equal(t, "outputs", dataResponse.Outputs.Truncated["bar"].FullValueURL)
+		assert.True(t, dataResponse.Outputs.Incomplete)
+	})
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := execManager.GetExecutionDataWithOptions(context.Background(),
+			admin.WorkflowExecutionGetDataRequest{
+				Id: &executionIdentifier,
+			}, managerInterfaces.GetDataOptions{
+				MaxTotalBytes: -1,
+			})
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	})
+}
+
 func TestAddLabelsAndAnnotationsRuntimeLimitsObserved(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	setDefaultLpCallbackForExecTest(repository)
@@ -2292,7 +3870,7 @@ func TestAddLabelsAndAnnotationsRuntimeLimitsObserved(t *testing.T) {
 	configProvider := getMockExecutionsConfigProvider()
 	configProvider.(*runtimeMocks.MockConfigurationProvider).AddRegistrationValidationConfiguration(
 		mockRegistrationValidationConfig)
-	execManager := NewExecutionManager(repository, configProvider, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, configProvider, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	request := testutils.GetExecutionRequest()
 	request.Spec.Labels = &admin.Labels{
 		Values: map[string]string{
@@ -2356,7 +3934,7 @@ func TestAddPluginOverrides(t *testing.T) {
 	}
 	partiallyPopulatedInputs := workflowengineInterfaces.ExecuteWorkflowInput{}
 
-	execManager := NewExecutionManager(db, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(db, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	taskPluginOverrides, err := execManager.(*ExecutionManager).addPluginOverrides(
 		context.Background(), executionID, workflowName, launchPlanName)
@@ -2387,7 +3965,7 @@ func TestPluginOverrides_ResourceGetFailure(t *testing.T) {
 		models.Resource, error) {
 		return models.Resource{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.Aborted, "uh oh")
 	}
-	execManager := NewExecutionManager(db, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(db, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	_, err := execManager.(*ExecutionManager).addPluginOverrides(
 		context.Background(), executionID, workflowName, launchPlanName)
@@ -2416,7 +3994,7 @@ func TestGetExecution_Legacy(t *testing.T) {
 		}, nil
 	}
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	execution, err := execManager.GetExecution(context.Background(), admin.WorkflowExecutionGetRequest{
 		Id: &executionIdentifier,
 	})
@@ -2474,7 +4052,7 @@ func TestGetExecutionData_LegacyModel(t *testing.T) {
 
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
 	storageClient := getMockStorageForExecTest(context.Background())
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), storageClient, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), storageClient, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	dataResponse, err := execManager.GetExecutionData(context.Background(), admin.WorkflowExecutionGetDataRequest{
 		Id: &executionIdentifier,
 	})
@@ -2519,7 +4097,7 @@ func TestCreateExecution_LegacyClient(t *testing.T) {
 				Cluster: testCluster,
 			}, nil
 		})
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	response, err := execManager.CreateExecution(context.Background(), *getLegacyExecutionRequest(), requestedAt)
 	assert.Nil(t, err)
 
@@ -2530,12 +4108,56 @@ func TestCreateExecution_LegacyClient(t *testing.T) {
 	assert.Equal(t, expectedResponse, response)
 }
 
+func TestCreateExecution_LiteralOffloading(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	var offloadedURIs []string
+	literalRepo := repository.OffloadedLiteralRepo().(*repositoryMocks.OffloadedLiteralRepoInterface)
+	literalRepo.OnCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		literal := args.Get(1).(models.OffloadedLiteral)
+		assert.Equal(t, "name", literal.ExecutionName)
+		offloadedURIs = append(offloadedURIs, literal.URI)
+	}).Return(nil)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			var spec admin.ExecutionSpec
+			assert.NoError(t, proto.Unmarshal(input.Spec, &spec))
+			assert.Nil(t, spec.Inputs)
+			assert.Equal(t, storage.DataReference("s3://bucket/metadata/project/domain/name/user_inputs"),
+				input.UserInputsURI)
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			assert.Equal(t, storage.DataReference("s3://bucket/metadata/project/domain/name/inputs"), inputs.InputsURI)
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			LiteralOffloading: runtimeInterfaces.LiteralOffloadingConfig{
+				Enabled:      true,
+				MinSizeBytes: 1,
+			},
+		})
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	_, err := execManager.CreateExecution(context.Background(), *getLegacyExecutionRequest(), requestedAt)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"s3://bucket/metadata/project/domain/name/inputs",
+		"s3://bucket/metadata/project/domain/name/user_inputs",
+	}, offloadedURIs)
+}
+
 func TestRelaunchExecution_LegacyModel(t *testing.T) {
 	// Set up mocks.
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
 	storageClient := getMockStorageForExecTest(context.Background())
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), storageClient, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), storageClient, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	startTime := time.Now()
 	startTimeProto, _ := ptypes.TimestampProto(startTime)
 	existingClosure := getLegacyClosure()
@@ -2646,7 +4268,7 @@ func TestListExecutions_LegacyModel(t *testing.T) {
 		}, nil
 	}
 	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(executionListFunc)
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 
 	executionList, err := execManager.ListExecutions(context.Background(), admin.ResourceListRequest{
 		Id: &admin.NamedEntityIdentifier{
@@ -2783,7 +4405,7 @@ func TestSetDefaults(t *testing.T) {
 			},
 		},
 	}
-	execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	execManager.(*ExecutionManager).setCompiledTaskDefaults(context.Background(), task, workflowengineInterfaces.TaskResources{
 		Defaults: runtimeInterfaces.TaskResourceSet{
 			CPU:              resource.MustParse("200m"),
@@ -2865,7 +4487,7 @@ func TestSetDefaults_MissingRequests_ExistingRequestsPreserved(t *testing.T) {
 			},
 		},
 	}
-	execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	execManager.(*ExecutionManager).setCompiledTaskDefaults(context.Background(), task, workflowengineInterfaces.TaskResources{
 		Defaults: runtimeInterfaces.TaskResourceSet{
 			CPU:    resource.MustParse("200m"),
@@ -2941,7 +4563,7 @@ func TestSetDefaults_OptionalRequiredResources(t *testing.T) {
 		},
 	}
 	t.Run("don't inject ephemeral storage or gpu when only the limit is set in config", func(t *testing.T) {
-		execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+		execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 		execManager.(*ExecutionManager).setCompiledTaskDefaults(context.Background(), task, workflowengineInterfaces.TaskResources{
 			Defaults: runtimeInterfaces.TaskResourceSet{
 				CPU:    resource.MustParse("200m"),
@@ -2978,7 +4600,7 @@ func TestSetDefaults_OptionalRequiredResources(t *testing.T) {
 	})
 
 	t.Run("respect non-required resources when defaults exist in config", func(t *testing.T) {
-		execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+		execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 		execManager.(*ExecutionManager).setCompiledTaskDefaults(context.Background(), task, workflowengineInterfaces.TaskResources{
 			Limits: taskConfigLimits,
 			Defaults: runtimeInterfaces.TaskResourceSet{
@@ -3151,7 +4773,7 @@ func TestCreateSingleTaskExecution(t *testing.T) {
 		getMockWorkflowConfigProvider(), getMockWorkflowCompiler(), mockStorage,
 		storagePrefix, mockScope.NewTestScope())
 	namedEntityManager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
-	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, workflowManager, namedEntityManager, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, workflowManager, namedEntityManager, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 	request := admin.ExecutionCreateRequest{
 		Project: "flytekit",
 		Domain:  "production",
@@ -3395,7 +5017,7 @@ func TestGetTaskResources(t *testing.T) {
 		runtimeMocks.NewMockWhitelistConfiguration(), nil)
 
 	t.Run("use runtime application values", func(t *testing.T) {
-		execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+		execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
 		taskResourceAttrs := execManager.(*ExecutionManager).getTaskResources(context.TODO(), &workflowIdentifier)
 		assert.EqualValues(t, taskResourceAttrs, workflowengineInterfaces.TaskResources{
 			Defaults: runtimeInterfaces.TaskResourceSet{
@@ -3487,3 +5109,1024 @@ func TestFromAdminProtoTaskResourceSpec(t *testing.T) {
 		GPU:              resource.MustParse("2"),
 	}, taskResourceSet)
 }
+
+func TestAddImpersonatorAnnotation(t *testing.T) {
+	annotations := map[string]string{"foo": "bar"}
+	assert.Equal(t, annotations, addImpersonatorAnnotation(context.TODO(), annotations))
+
+	operator := auth.NewIdentityContext("", "operator", "", time.Now(), sets.NewString(), nil)
+	ctx := auth.WithImpersonator(context.TODO(), operator)
+	assert.EqualValues(t, map[string]string{
+		"foo":                     "bar",
+		impersonatorAnnotationKey: "operator",
+	}, addImpersonatorAnnotation(ctx, annotations))
+	// The request's annotations must not be modified.
+	assert.Len(t, annotations, 1)
+}
+
+func TestCreateExecution_Impersonated(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	var created bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			assert.Equal(t, "user", input.User)
+			assert.Equal(t, "operator", input.Impersonator)
+			created = true
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			assert.Equal(t, "operator", inputs.Annotations[impersonatorAnnotationKey])
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	operator := auth.NewIdentityContext("", "operator", "", time.Now(), sets.NewString(auth.ScopeAll), nil)
+	user := auth.NewIdentityContext("", "user", "", time.Now(), sets.NewString(auth.ScopeAll), nil)
+	ctx := auth.SetContextForIdentity(auth.WithImpersonator(context.Background(), operator), user)
+	_, err := execManager.CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, created)
+}
+
+// Returns a config provider limiting each project and domain to running a single execution at a time.
+func getMockAdmissionConfigProvider() runtimeInterfaces.Configuration {
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			Admission: runtimeInterfaces.AdmissionConfig{
+				Enabled:                     true,
+				BatchSize:                   10,
+				DefaultMaxRunningExecutions: 1,
+			},
+		})
+	return mockConfig
+}
+
+// Counts running executions as running and pending ones as pending.
+func setAdmissionCountCallback(t *testing.T, repository repositories.RepositoryInterface, running, pending int64) {
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).CountFunction = func(
+		ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+		for _, filter := range input.InlineFilters {
+			expr, err := filter.GetGormQueryExpr()
+			assert.NoError(t, err)
+			if expr.Query == "pending = ?" {
+				if expr.Args.(bool) {
+					return pending, nil
+				}
+				return running, nil
+			}
+		}
+		t.Fatal("counted executions without a pending filter")
+		return 0, nil
+	}
+}
+
+func TestCreateExecution_AdmissionDeferred(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	setAdmissionCountCallback(t, repository, 1, 0)
+	var created bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			created = true
+			assert.True(t, input.Pending)
+			assert.Equal(t, core.WorkflowExecution_UNDEFINED.String(), input.Phase)
+			assert.Empty(t, input.Cluster)
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			t.Fatal("pending execution launched")
+			return nil, nil
+		})
+	execManager := NewExecutionManager(repository, getMockAdmissionConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	response, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(&executionIdentifier, response.Id))
+	assert.True(t, created)
+}
+
+func TestCreateExecution_AdmissionLocked(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	setAdmissionCountCallback(t, repository, 0, 0)
+	var locked, created bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).LockAdmissionFunction = func(
+		ctx context.Context, project, domain string) (func(), error) {
+		assert.Equal(t, executionIdentifier.Project, project)
+		assert.Equal(t, executionIdentifier.Domain, domain)
+		locked = true
+		return func() {
+			locked = false
+		}, nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			// The execution is launched and created before the next launch is admitted.
+			assert.True(t, locked)
+			assert.False(t, input.Pending)
+			created = true
+			return nil
+		})
+	execManager := NewExecutionManager(repository, getMockAdmissionConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, created)
+	assert.False(t, locked)
+}
+
+func TestCreateExecution_AdmissionLockFailed(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).LockAdmissionFunction = func(
+		ctx context.Context, project, domain string) (func(), error) {
+		return nil, flyteAdminErrors.NewFlyteAdminError(codes.Internal, "lock failed")
+	}
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			t.Fatal("launched an execution without the admission lock")
+			return nil, nil
+		})
+	execManager := NewExecutionManager(repository, getMockAdmissionConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.EqualError(t, err, "lock failed")
+}
+
+func TestCreateExecution_AdmissionQueuedBehindPending(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setAdmissionCountCallback(t, repository, 0, 1)
+	execManager := NewExecutionManager(repository, getMockAdmissionConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	deferred, err := execManager.(*ExecutionManager).isAdmissionDeferred(
+		context.Background(), executionIdentifier, &admin.ExecutionSpec{})
+	assert.NoError(t, err)
+	assert.True(t, deferred)
+}
+
+func TestCreateExecution_AdmissionChildExecution(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setAdmissionCountCallback(t, repository, 1, 1)
+	execManager := NewExecutionManager(repository, getMockAdmissionConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	deferred, err := execManager.(*ExecutionManager).isAdmissionDeferred(
+		context.Background(), executionIdentifier, &admin.ExecutionSpec{
+			Metadata: &admin.ExecutionMetadata{
+				ParentNodeExecution: &core.NodeExecutionIdentifier{
+					NodeId:      "node",
+					ExecutionId: &executionIdentifier,
+				},
+			},
+		})
+	assert.NoError(t, err)
+	assert.False(t, deferred)
+}
+
+func TestCreateExecution_AdmissionDisabled(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).CountFunction = func(
+		ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+		t.Fatal("counted executions with admission disabled")
+		return 0, nil
+	}
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	deferred, err := execManager.(*ExecutionManager).isAdmissionDeferred(
+		context.Background(), executionIdentifier, &admin.ExecutionSpec{})
+	assert.NoError(t, err)
+	assert.False(t, deferred)
+}
+
+func TestCreateExecution_AdmissionFeatureFlag(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setAdmissionCountCallback(t, repository, 1, 0)
+	repository.FeatureFlagRepo().(*repositoryMocks.MockFeatureFlagRepo).GetFunction = func(
+		ctx context.Context, name string) ([]models.FeatureFlag, error) {
+		assert.Equal(t, managerInterfaces.FeatureFlagAdmissionControl, name)
+		return []models.FeatureFlag{
+			{Name: name, Project: executionIdentifier.Project, Enabled: true},
+		}, nil
+	}
+	mockConfig := getMockAdmissionConfigProvider()
+	mockConfig.ApplicationConfiguration().GetTopLevelConfig().Admission.Enabled = false
+	execManager := NewExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	// The flag enables admission control for the project alone.
+	deferred, err := execManager.(*ExecutionManager).isAdmissionDeferred(
+		context.Background(), executionIdentifier, &admin.ExecutionSpec{})
+	assert.NoError(t, err)
+	assert.True(t, deferred)
+	otherProject := executionIdentifier
+	otherProject.Project = "other"
+	deferred, err = execManager.(*ExecutionManager).isAdmissionDeferred(
+		context.Background(), otherProject, &admin.ExecutionSpec{})
+	assert.NoError(t, err)
+	assert.False(t, deferred)
+}
+
+func TestDispatchPendingExecutions(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	setAdmissionCountCallback(t, repository, 1, 0)
+	var pendingExecution models.Execution
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			pendingExecution = input
+			return nil
+		})
+	var launched int
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			launched++
+			assert.True(t, proto.Equal(&executionIdentifier, inputs.ExecutionID))
+			assert.NotNil(t, inputs.Inputs.Literals["foo"])
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	execManager := NewExecutionManager(repository, getMockAdmissionConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, pendingExecution.Pending)
+	assert.Zero(t, launched)
+
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+			assert.Equal(t, 10, input.Limit)
+			return interfaces.ExecutionCollectionOutput{
+				Executions: []models.Execution{pendingExecution},
+			}, nil
+		})
+	var dispatchedCluster string
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).MarkDispatchedFunction = func(
+		ctx context.Context, input interfaces.Identifier, cluster string) error {
+		assert.Equal(t, interfaces.Identifier{
+			Project: executionIdentifier.Project,
+			Domain:  executionIdentifier.Domain,
+			Name:    executionIdentifier.Name,
+		}, input)
+		dispatchedCluster = cluster
+		return nil
+	}
+
+	// The project and domain still run as many executions as they may.
+	assert.NoError(t, execManager.DispatchPendingExecutions(context.Background()))
+	assert.Zero(t, launched)
+
+	setAdmissionCountCallback(t, repository, 0, 1)
+	assert.NoError(t, execManager.DispatchPendingExecutions(context.Background()))
+	assert.Equal(t, 1, launched)
+	assert.Equal(t, testCluster, dispatchedCluster)
+}
+
+func TestCreateExecution_RunAt(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).CountFunction = func(
+		ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+		t.Fatal("counted executions for a scheduled execution")
+		return 0, nil
+	}
+	runAt := requestedAt.Add(time.Hour).UTC().Truncate(time.Second)
+	var created bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			created = true
+			assert.True(t, input.Pending)
+			assert.True(t, runAt.Equal(*input.RunAt))
+			var spec admin.ExecutionSpec
+			assert.NoError(t, proto.Unmarshal(input.Spec, &spec))
+			scheduledAt, err := ptypes.Timestamp(spec.Metadata.ScheduledAt)
+			assert.NoError(t, err)
+			assert.True(t, runAt.Equal(scheduledAt))
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			t.Fatal("scheduled execution launched early")
+			return nil, nil
+		})
+	execManager := NewExecutionManager(repository, getMockAdmissionConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(runAtHeader, runAt.Format(time.RFC3339)))
+	_, err := execManager.CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, created)
+}
+
+func TestCreateExecution_RunAtPast(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			assert.False(t, input.Pending)
+			assert.Nil(t, input.RunAt)
+			assert.Equal(t, testCluster, input.Cluster)
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	runAt := requestedAt.Add(-time.Hour).Format(time.RFC3339)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(runAtHeader, runAt))
+	_, err := execManager.CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+}
+
+func TestCreateExecution_InvalidRunAt(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(runAtHeader, "tomorrow"))
+	_, err := execManager.CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestDispatchPendingExecutions_AdmissionDisabled(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).CountFunction = func(
+		ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+		t.Fatal("counted executions with admission disabled")
+		return 0, nil
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+			var queries []string
+			for _, filter := range input.InlineFilters {
+				expr, err := filter.GetGormQueryExpr()
+				assert.NoError(t, err)
+				queries = append(queries, expr.Query)
+			}
+			// Scheduled executions are only listed once they're due.
+			assert.Equal(t, []string{"pending = ?", "phase in (?)", "(run_at IS NULL OR run_at <= ?)"}, queries)
+			return interfaces.ExecutionCollectionOutput{}, nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	assert.NoError(t, execManager.DispatchPendingExecutions(context.Background()))
+}
+
+// Returns a config provider launching executions asynchronously, failing them after two failed launch attempts.
+func getMockAsyncLaunchConfigProvider() runtimeInterfaces.Configuration {
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			Admission: runtimeInterfaces.AdmissionConfig{
+				BatchSize: 10,
+			},
+			Executor: runtimeInterfaces.ExecutorConfig{
+				AsyncLaunch: runtimeInterfaces.AsyncLaunchConfig{
+					Enabled:     true,
+					MaxAttempts: 2,
+				},
+			},
+		})
+	return mockConfig
+}
+
+func TestCreateExecution_AsyncLaunch(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	var pendingExecution models.Execution
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			pendingExecution = input
+			return nil
+		})
+	var launchErr error
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			if launchErr != nil {
+				return nil, launchErr
+			}
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster: testCluster,
+			}, nil
+		})
+	launchErr = errors.New("executions shouldn't launch while they're created")
+	execManager := NewExecutionManager(repository, getMockAsyncLaunchConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+	assert.True(t, pendingExecution.Pending)
+	assert.Equal(t, core.WorkflowExecution_QUEUED.String(), pendingExecution.Phase)
+	assert.Empty(t, pendingExecution.Cluster)
+
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+			return interfaces.ExecutionCollectionOutput{
+				Executions: []models.Execution{pendingExecution},
+			}, nil
+		})
+	var launchErrors []string
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).RecordLaunchFailureFunction = func(
+		ctx context.Context, input interfaces.Identifier, launchError string) error {
+		launchErrors = append(launchErrors, launchError)
+		return nil
+	}
+	var dispatchedCluster string
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).MarkDispatchedFunction = func(
+		ctx context.Context, input interfaces.Identifier, cluster string) error {
+		dispatchedCluster = cluster
+		return nil
+	}
+
+	// Executions which fail to launch record why, and are launched again by the next dispatch.
+	launchErr = errors.New("cluster unavailable")
+	assert.NoError(t, execManager.DispatchPendingExecutions(context.Background()))
+	assert.Equal(t, []string{"cluster unavailable"}, launchErrors)
+	assert.Empty(t, dispatchedCluster)
+
+	launchErr = nil
+	assert.NoError(t, execManager.DispatchPendingExecutions(context.Background()))
+	assert.Equal(t, testCluster, dispatchedCluster)
+}
+
+func TestDispatchPendingExecutions_OutOfLaunchAttempts(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	var pendingExecution models.Execution
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			pendingExecution = input
+			return nil
+		})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			return nil, errors.New("cluster unavailable")
+		})
+	execManager := NewExecutionManager(repository, getMockAsyncLaunchConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Nil(t, err)
+
+	pendingExecution.LaunchAttempts = 1
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+			return interfaces.ExecutionCollectionOutput{
+				Executions: []models.Execution{pendingExecution},
+			}, nil
+		})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).RecordLaunchFailureFunction = func(
+		ctx context.Context, input interfaces.Identifier, launchError string) error {
+		t.Fatal("recorded a launch failure of an execution which ran out of launch attempts")
+		return nil
+	}
+	var failed bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+		func(ctx context.Context, execution models.Execution) error {
+			failed = true
+			assert.Equal(t, core.WorkflowExecution_FAILED.String(), execution.Phase)
+			assert.Equal(t, 2, execution.LaunchAttempts)
+			assert.Equal(t, common.LaunchFailedErrorCode, *execution.ErrorCode)
+			var closure admin.ExecutionClosure
+			assert.NoError(t, proto.Unmarshal(execution.Closure, &closure))
+			assert.Equal(t, "failed to launch after 2 attempts: cluster unavailable", closure.GetError().Message)
+			return nil
+		})
+	assert.NoError(t, execManager.DispatchPendingExecutions(context.Background()))
+	assert.True(t, failed)
+}
+
+func getMockPriorityConfigProvider() runtimeInterfaces.Configuration {
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			Priority: runtimeInterfaces.PriorityConfig{
+				Classes:      []string{"urgent", "standard", "batch"},
+				DefaultClass: "standard",
+				Defaults: []runtimeInterfaces.ProjectDomainPriority{
+					{
+						Domain: "domain",
+						Class:  "batch",
+					},
+				},
+			},
+		})
+	return mockConfig
+}
+
+// Sets the default launch plan, annotated with a priority class.
+func setPriorityLpCallbackForExecTest(repository repositories.RepositoryInterface, priority string) {
+	defaultRepository := repositoryMocks.NewMockRepository()
+	setDefaultLpCallbackForExecTest(defaultRepository)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.LaunchPlan, error) {
+			lpModel, err := defaultRepository.LaunchPlanRepo().Get(context.Background(), input)
+			if err != nil {
+				return models.LaunchPlan{}, err
+			}
+			var lpSpec admin.LaunchPlanSpec
+			if err := proto.Unmarshal(lpModel.Spec, &lpSpec); err != nil {
+				return models.LaunchPlan{}, err
+			}
+			lpSpec.Annotations.Values[priorityKey] = priority
+			lpModel.Spec, err = proto.Marshal(&lpSpec)
+			return lpModel, err
+		})
+}
+
+func TestCreateExecution_Priority(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		requested  string
+		annotated  string
+		launchPlan string
+		priority   string
+	}{
+		{
+			name:     "project and domain default",
+			priority: "batch",
+		},
+		{
+			name:       "launch plan",
+			launchPlan: "standard",
+			priority:   "standard",
+		},
+		{
+			name:       "annotated",
+			annotated:  "urgent",
+			launchPlan: "standard",
+			priority:   "urgent",
+		},
+		{
+			name:       "requested",
+			requested:  "urgent",
+			annotated:  "batch",
+			launchPlan: "standard",
+			priority:   "urgent",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			repository := getMockRepositoryForExecTest()
+			if len(test.launchPlan) > 0 {
+				setPriorityLpCallbackForExecTest(repository, test.launchPlan)
+			} else {
+				setDefaultLpCallbackForExecTest(repository)
+			}
+			var created bool
+			repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+				func(ctx context.Context, input models.Execution) error {
+					created = true
+					assert.Equal(t, test.priority, input.Priority)
+					assert.Contains(t, input.Labels, models.ExecutionLabel{
+						ExecutionKey: input.ExecutionKey,
+						Key:          priorityKey,
+						Value:        test.priority,
+					})
+					return nil
+				})
+			mockExecutor := workflowengineMocks.NewMockExecutor()
+			mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+				func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+					assert.Equal(t, test.priority, inputs.Labels[priorityKey])
+					assert.Equal(t, test.priority, inputs.Annotations[priorityKey])
+					return &workflowengineInterfaces.ExecutionInfo{
+						Cluster: testCluster,
+					}, nil
+				})
+			execManager := NewExecutionManager(repository, getMockPriorityConfigProvider(), getMockStorageForExecTest(context.Background()), mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+			request := testutils.GetExecutionRequest()
+			if len(test.annotated) > 0 {
+				request.Spec.Annotations = &admin.Annotations{
+					Values: map[string]string{
+						priorityKey: test.annotated,
+					},
+				}
+			}
+			ctx := context.Background()
+			if len(test.requested) > 0 {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(priorityHeader, test.requested))
+			}
+			_, err := execManager.CreateExecution(ctx, request, requestedAt)
+			assert.Nil(t, err)
+			assert.True(t, created)
+		})
+	}
+}
+
+func TestCreateExecution_UnknownPriority(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	execManager := NewExecutionManager(repository, getMockPriorityConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(priorityHeader, "asap"))
+	_, err := execManager.CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+	assert.EqualError(t, err, "unknown priority class [asap], expected one of [urgent standard batch]")
+}
+
+func TestCreateExecution_PriorityNotConfigured(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(priorityHeader, "urgent"))
+	_, err := execManager.CreateExecution(ctx, testutils.GetExecutionRequest(), requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestDispatchPendingExecutions_PriorityOrder(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	var listed []string
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+			expr, err := input.InlineFilters[len(input.InlineFilters)-1].GetGormQueryExpr()
+			assert.NoError(t, err)
+			if class, ok := expr.Args.(string); ok {
+				assert.Equal(t, "priority = ?", expr.Query)
+				listed = append(listed, class)
+			} else {
+				assert.Equal(t, "(priority not in (?) OR priority IS NULL)", expr.Query)
+				listed = append(listed, "")
+			}
+			return interfaces.ExecutionCollectionOutput{}, nil
+		})
+	execManager := NewExecutionManager(repository, getMockPriorityConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	assert.NoError(t, execManager.DispatchPendingExecutions(context.Background()))
+	assert.Equal(t, []string{"urgent", "standard", "batch", ""}, listed)
+}
+
+func TestCreateWorkflowEvent_EventReconciliation(t *testing.T) {
+	updatedAt := time.Date(2021, time.October, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		name         string
+		currentPhase core.WorkflowExecution_Phase
+		eventPhase   core.WorkflowExecution_Phase
+		occurredAt   time.Time
+		reason       string
+	}{
+		{"duplicate", core.WorkflowExecution_RUNNING, core.WorkflowExecution_RUNNING, updatedAt, managerInterfaces.SkipReasonDuplicate},
+		{"stale", core.WorkflowExecution_RUNNING, core.WorkflowExecution_QUEUED, updatedAt.Add(-time.Minute), managerInterfaces.SkipReasonStale},
+		{"replayed after terminal", core.WorkflowExecution_SUCCEEDED, core.WorkflowExecution_RUNNING, updatedAt.Add(-time.Minute), managerInterfaces.SkipReasonAfterTerminal},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			repository := repositoryMocks.NewMockRepository()
+			repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+				func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+					return models.Execution{
+						ExecutionKey: models.ExecutionKey{
+							Project: "project",
+							Domain:  "domain",
+							Name:    "name",
+						},
+						Spec:               specBytes,
+						Phase:              test.currentPhase.String(),
+						Closure:            closureBytes,
+						ExecutionUpdatedAt: &updatedAt,
+					}, nil
+				})
+			repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+				func(ctx context.Context, execution models.Execution) error {
+					t.Error("unexpected execution update")
+					return nil
+				})
+			reasons := recordSkippedEventReasons(
+				repository.SkippedEventRepo().(*repositoryMocks.SkippedEventRepoInterface))
+			execManager := NewExecutionManager(repository, getEventReconciliationConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+			occurredAtProto, _ := ptypes.TimestampProto(test.occurredAt)
+			resp, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+				RequestId: "1",
+				Event: &event.WorkflowExecutionEvent{
+					ExecutionId: &executionIdentifier,
+					OccurredAt:  occurredAtProto,
+					Phase:       test.eventPhase,
+				},
+			})
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, []string{test.reason}, *reasons)
+		})
+	}
+}
+
+func TestCreateWorkflowEvent_EventReconciliationAfterTerminal(t *testing.T) {
+	updatedAt := time.Date(2021, time.October, 1, 12, 0, 0, 0, time.UTC)
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+				Spec:               specBytes,
+				Phase:              core.WorkflowExecution_SUCCEEDED.String(),
+				Closure:            closureBytes,
+				ExecutionUpdatedAt: &updatedAt,
+			}, nil
+		})
+	execManager := NewExecutionManager(repository, getEventReconciliationConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	occurredAtProto, _ := ptypes.TimestampProto(updatedAt.Add(time.Minute))
+	_, err := execManager.CreateWorkflowEvent(context.Background(), admin.WorkflowExecutionEventRequest{
+		RequestId: "1",
+		Event: &event.WorkflowExecutionEvent{
+			ExecutionId: &executionIdentifier,
+			OccurredAt:  occurredAtProto,
+			Phase:       core.WorkflowExecution_RUNNING,
+		},
+	})
+	// Events which occurred after the execution terminated aren't replays, so are still rejected.
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+	repository.SkippedEventRepo().(*repositoryMocks.SkippedEventRepoInterface).AssertNotCalled(
+		t, "Create", mock.Anything, mock.Anything)
+}
+
+func getMockDeprecationConfigProvider(rejectExecutions bool) runtimeInterfaces.Configuration {
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			Deprecation: runtimeInterfaces.DeprecationConfig{
+				RejectExecutions: rejectExecutions,
+			},
+		})
+	return mockConfig
+}
+
+// Deprecates the workflow launched by the default launch plan.
+func setDeprecatedWorkflowCallback(repository repositories.RepositoryInterface) {
+	repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo).SetGetCallback(
+		func(input interfaces.GetNamedEntityInput) (models.NamedEntity, error) {
+			state := int32(admin.NamedEntityState_NAMED_ENTITY_ACTIVE)
+			if input.ResourceType == core.ResourceType_WORKFLOW {
+				state = int32(managerInterfaces.NamedEntityStateDeprecated)
+			}
+			return models.NamedEntity{
+				NamedEntityKey: models.NamedEntityKey{
+					ResourceType: input.ResourceType,
+					Project:      input.Project,
+					Domain:       input.Domain,
+					Name:         input.Name,
+				},
+				NamedEntityMetadataFields: models.NamedEntityMetadataFields{
+					State: &state,
+				},
+			}, nil
+		})
+}
+
+func TestCreateExecution_Deprecated(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	setDeprecatedWorkflowCallback(repository)
+	execManager := NewExecutionManager(repository, getMockDeprecationConfigProvider(false), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	response, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&executionIdentifier, response.Id))
+	assert.Equal(t, float64(1),
+		testutil.ToFloat64(execManager.(*ExecutionManager).systemMetrics.DeprecatedExecutions))
+}
+
+func TestCreateExecution_DeprecatedRejected(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	setDeprecatedWorkflowCallback(repository)
+	execManager := NewExecutionManager(repository, getMockDeprecationConfigProvider(true), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	// Schedules keep launching deprecated workflows.
+	request := testutils.GetExecutionRequest()
+	request.Spec.Metadata = &admin.ExecutionMetadata{
+		Mode: admin.ExecutionMetadata_SCHEDULED,
+	}
+	_, err = execManager.CreateExecution(context.Background(), request, requestedAt)
+	assert.NoError(t, err)
+}
+
+func TestCreateExecution_DeprecatedRejectedByFeatureFlag(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	setDeprecatedWorkflowCallback(repository)
+	repository.FeatureFlagRepo().(*repositoryMocks.MockFeatureFlagRepo).GetFunction = func(
+		ctx context.Context, name string) ([]models.FeatureFlag, error) {
+		assert.Equal(t, managerInterfaces.FeatureFlagRejectDeprecatedExecutions, name)
+		return []models.FeatureFlag{{Name: name, Enabled: true}}, nil
+	}
+	execManager := NewExecutionManager(repository, getMockDeprecationConfigProvider(false), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestDryRunExecution(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	executionRepo := repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo)
+	executionRepo.ExistsFunction = func(ctx context.Context, input interfaces.Identifier) (bool, error) {
+		return false, nil
+	}
+	executionRepo.SetCreateCallback(func(ctx context.Context, input models.Execution) error {
+		t.Fatal("dry runs shouldn't create executions")
+		return nil
+	})
+	mockExecutor := workflowengineMocks.NewMockExecutor()
+	mockExecutor.(*workflowengineMocks.MockExecutor).SetExecuteWorkflowCallback(
+		func(inputs workflowengineInterfaces.ExecuteWorkflowInput) (*workflowengineInterfaces.ExecutionInfo, error) {
+			assert.True(t, inputs.DryRun)
+			assert.Empty(t, inputs.InputsURI)
+			return &workflowengineInterfaces.ExecutionInfo{
+				Cluster:       "cluster",
+				FlyteWorkflow: &v1alpha1.FlyteWorkflow{},
+			}, nil
+		})
+	mockStorage := getMockStorageForExecTest(context.Background())
+	stored := len(mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).Store)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, mockExecutor, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	response, err := execManager.DryRunExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&executionIdentifier, response.Id))
+	assert.Equal(t, "cluster", response.Cluster)
+	assert.False(t, response.Pending)
+	assert.NotNil(t, response.FlyteWorkflow)
+	assert.NotNil(t, response.Spec.Labels)
+	// Inputs aren't offloaded.
+	assert.Len(t, mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).Store, stored)
+}
+
+func TestDryRunExecution_AlreadyExists(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	_, err := execManager.DryRunExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestDryRunExecution_SingleTask(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.Task, error) {
+			t.Fatal("single task executions shouldn't be dry run")
+			return models.Task{}, nil
+		})
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	request := testutils.GetExecutionRequest()
+	request.Name = ""
+	request.Spec.LaunchPlan.ResourceType = core.ResourceType_TASK
+
+	_, err := execManager.DryRunExecution(context.Background(), request, requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateExecution_NameNotAllowed(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	configProvider := getMockExecutionsConfigProvider()
+	configProvider.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			ExecutionNaming: runtimeInterfaces.ExecutionNamingConfig{
+				Policies: []runtimeInterfaces.ProjectDomainNamingPolicy{
+					{
+						Project:      "project",
+						AllowedNames: "^etl-[a-z0-9-]+$",
+					},
+				},
+			},
+		})
+	execManager := NewExecutionManager(repository, configProvider, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateExecution_GeneratedNameTaken(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	var createdNames []string
+	// An execution created concurrently took the first name after it was checked.
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			createdNames = append(createdNames, input.Name)
+			if len(createdNames) == 1 {
+				return flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "already exists")
+			}
+			return nil
+		})
+	configProvider := getMockExecutionsConfigProvider()
+	configProvider.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			ExecutionNaming: runtimeInterfaces.ExecutionNamingConfig{
+				Policies: []runtimeInterfaces.ProjectDomainNamingPolicy{
+					{
+						Project:  "project",
+						Template: "nightly-{seq}",
+					},
+				},
+			},
+		})
+	execManager := NewExecutionManager(repository, configProvider, getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+	request := testutils.GetExecutionRequest()
+	request.Name = ""
+
+	response, err := execManager.CreateExecution(context.Background(), request, requestedAt)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"nightly-1", "nightly-2"}, createdNames)
+	assert.Equal(t, "nightly-2", response.Id.Name)
+}
+
+func TestCountExecutions(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var countCalls int
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).CountGroupsFunction = func(
+		ctx context.Context, input interfaces.CountExecutionGroupsInput) ([]models.ExecutionGroupCount, error) {
+		countCalls++
+		assert.True(t, input.JoinTableEntities[common.LaunchPlan])
+		assert.Len(t, input.InlineFilters, 2)
+		if len(input.GroupBy) == 0 {
+			return []models.ExecutionGroupCount{{Count: 7}}, nil
+		}
+		assert.Equal(t, []string{"phase", "day"}, input.GroupBy)
+		assert.Equal(t, 100, input.Limit)
+		return []models.ExecutionGroupCount{
+			{Phase: core.WorkflowExecution_FAILED.String(), Day: "2021-11-26", Count: 2},
+			{Phase: core.WorkflowExecution_SUCCEEDED.String(), Day: "2021-11-26", Count: 5},
+		}, nil
+	}
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	resp, err := execManager.CountExecutions(context.Background(), managerInterfaces.ExecutionCountRequest{
+		Project: "project",
+		Filters: "eq(launch_plan.name,nightly)",
+		GroupBy: []string{managerInterfaces.ExecutionCountGroupByPhase, managerInterfaces.ExecutionCountGroupByDay},
+		Limit:   100,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, countCalls)
+	day := time.Date(2021, 11, 26, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, &managerInterfaces.ExecutionCountResponse{
+		Total: 7,
+		Groups: []managerInterfaces.ExecutionGroupCount{
+			{Phase: core.WorkflowExecution_FAILED, Day: day, Count: 2},
+			{Phase: core.WorkflowExecution_SUCCEEDED, Day: day, Count: 5},
+		},
+	}, resp)
+}
+
+func TestListExecutionLabels(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).ListLabelsFunction = func(
+		ctx context.Context, input interfaces.ListExecutionLabelsInput) ([]models.ExecutionLabelCount, error) {
+		assert.Equal(t, interfaces.ListExecutionLabelsInput{
+			Project: "project",
+			Domain:  "domain",
+			Key:     "team",
+			Limit:   10,
+		}, input)
+		return []models.ExecutionLabelCount{
+			{Key: "team", Value: "ml", Count: 3},
+			{Key: "team", Value: "data", Count: 1},
+		}, nil
+	}
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	request := managerInterfaces.ExecutionLabelListRequest{
+		Project: "project",
+		Domain:  "domain",
+		Key:     "team",
+		Limit:   10,
+	}
+	resp, err := execManager.ListExecutionLabels(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, &managerInterfaces.ExecutionLabelListResponse{
+		Labels: []managerInterfaces.ExecutionLabelCount{
+			{Key: "team", Value: "ml", Count: 3},
+			{Key: "team", Value: "data", Count: 1},
+		},
+	}, resp)
+
+	_, err = execManager.ListExecutionLabels(auth.WithVisibleProjects(context.Background(), sets.NewString("other")),
+		request)
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	_, err = execManager.ListExecutionLabels(context.Background(), managerInterfaces.ExecutionLabelListRequest{
+		Project: "project",
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestCountExecutions_TotalOnly(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).CountGroupsFunction = func(
+		ctx context.Context, input interfaces.CountExecutionGroupsInput) ([]models.ExecutionGroupCount, error) {
+		assert.Empty(t, input.GroupBy)
+		// Executions of every project are counted.
+		assert.Empty(t, input.InlineFilters)
+		return []models.ExecutionGroupCount{{Count: 42}}, nil
+	}
+	execManager := NewExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), workflowengineMocks.NewMockExecutor(), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{}, nil)
+
+	resp, err := execManager.CountExecutions(context.Background(), managerInterfaces.ExecutionCountRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), resp.Total)
+	assert.Empty(t, resp.Groups)
+}
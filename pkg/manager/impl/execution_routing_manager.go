@@ -0,0 +1,159 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	clusterInterfaces "github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/executions"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+type ExecutionRoutingManager struct {
+	db               repositories.RepositoryInterface
+	config           runtimeInterfaces.Configuration
+	executionCluster clusterInterfaces.ClusterInterface
+	queueAllocator   executions.QueueAllocator
+}
+
+// Returns the requested version of a launch plan, or its active version when no version was requested.
+func (m *ExecutionRoutingManager) getLaunchPlanModel(
+	ctx context.Context, request interfaces.ResolveExecutionClusterRequest) (models.LaunchPlan, error) {
+	if len(request.LaunchPlanVersion) > 0 {
+		return util.GetLaunchPlanModel(ctx, m.db, core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      request.Project,
+			Domain:       request.Domain,
+			Name:         request.LaunchPlan,
+			Version:      request.LaunchPlanVersion,
+		})
+	}
+	filters, err := util.GetActiveLaunchPlanVersionFilters(request.Project, request.Domain, request.LaunchPlan)
+	if err != nil {
+		return models.LaunchPlan{}, err
+	}
+	output, err := m.db.LaunchPlanRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         1,
+		InlineFilters: filters,
+	})
+	if err != nil {
+		return models.LaunchPlan{}, err
+	}
+	if len(output.LaunchPlans) != 1 {
+		return models.LaunchPlan{}, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"No active launch plan could be found: %s:%s:%s", request.Project, request.Domain, request.LaunchPlan)
+	}
+	return output.LaunchPlans[0], nil
+}
+
+func toMatchedAttributes(attributes *executioncluster.MatchedAttributes) *interfaces.MatchedAttributes {
+	if attributes == nil {
+		return nil
+	}
+	return &interfaces.MatchedAttributes{
+		Project:    attributes.Project,
+		Domain:     attributes.Domain,
+		Workflow:   attributes.Workflow,
+		LaunchPlan: attributes.LaunchPlan,
+	}
+}
+
+func (m *ExecutionRoutingManager) ResolveExecutionCluster(
+	ctx context.Context, request interfaces.ResolveExecutionClusterRequest) (
+	*interfaces.ExecutionClusterResolution, error) {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateEmptyStringField(request.LaunchPlan, shared.LaunchPlan); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateProjectAndDomain(
+		ctx, m.db, m.config.ApplicationConfiguration(), request.Project, request.Domain); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	launchPlanModel, err := m.getLaunchPlanModel(ctx, request)
+	if err != nil {
+		logger.Debugf(ctx, "failed to get launch plan [%s] version [%s] with err: %v", request.LaunchPlan,
+			request.LaunchPlanVersion, err)
+		return nil, err
+	}
+	launchPlan, err := transformers.FromLaunchPlanModel(launchPlanModel)
+	if err != nil {
+		return nil, err
+	}
+	workflowID := launchPlan.Spec.WorkflowId
+
+	targetResolution, err := m.executionCluster.ResolveTarget(ctx, &executioncluster.ExecutionTargetSpec{
+		Project:               request.Project,
+		Domain:                request.Domain,
+		Workflow:              workflowID.Name,
+		LaunchPlan:            request.LaunchPlan,
+		ExecutionID:           request.ExecutionName,
+		ExecutionClusterLabel: request.ExecutionClusterLabel,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to resolve the cluster of launch plan [%s] with err: %v", request.LaunchPlan, err)
+		return nil, err
+	}
+	queueResolution := m.queueAllocator.ResolveQueue(ctx, *workflowID)
+
+	resolution := &interfaces.ExecutionClusterResolution{
+		LaunchPlanVersion:      launchPlan.Id.Version,
+		ClusterCandidates:      make([]interfaces.ClusterCandidate, len(targetResolution.Candidates)),
+		ClusterLabel:           targetResolution.Label,
+		ClusterPool:            targetResolution.LabelPool,
+		ClusterLabelAttributes: toMatchedAttributes(targetResolution.LabelAttributes),
+		Queue:                  queueResolution.Queue,
+		QueueCandidates:        queueResolution.Candidates,
+		QueueTag:               queueResolution.Tag,
+		Reasons:                append(targetResolution.Reasons, queueResolution.Reasons...),
+	}
+	for i, candidate := range targetResolution.Candidates {
+		resolution.ClusterCandidates[i] = interfaces.ClusterCandidate{
+			Cluster:     candidate.ID,
+			Weight:      candidate.Weight,
+			Probability: candidate.Probability,
+		}
+	}
+	if targetResolution.Target != nil {
+		resolution.Cluster = targetResolution.Target.ID
+	} else if len(targetResolution.Candidates) == 1 {
+		resolution.Cluster = targetResolution.Candidates[0].ID
+	}
+	if queueResolution.Attributes != nil {
+		resolution.QueueTagAttributes = &interfaces.MatchedAttributes{
+			Project:    queueResolution.Attributes.Project,
+			Domain:     queueResolution.Attributes.Domain,
+			Workflow:   queueResolution.Attributes.Workflow,
+			LaunchPlan: queueResolution.Attributes.LaunchPlan,
+		}
+	}
+	return resolution, nil
+}
+
+func NewExecutionRoutingManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	executionCluster clusterInterfaces.ClusterInterface) interfaces.ExecutionRoutingInterface {
+	return &ExecutionRoutingManager{
+		db:               db,
+		config:           config,
+		executionCluster: executionCluster,
+		queueAllocator:   executions.NewQueueAllocator(config, db),
+	}
+}
@@ -0,0 +1,136 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	clusterMocks "github.com/flyteorg/flyteadmin/pkg/executioncluster/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func getExecutionRoutingManagerForTest(t *testing.T) (
+	interfaces.ExecutionRoutingInterface, *clusterMocks.MockCluster) {
+	repository := repositoryMocks.NewMockRepository()
+	lpSpec := testutils.GetSampleLpSpecForTest()
+	lpSpecBytes, err := proto.Marshal(&lpSpec)
+	assert.NoError(t, err)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input repoInterfaces.ListResourceInput) (repoInterfaces.LaunchPlanCollectionOutput, error) {
+			assert.Equal(t, 1, input.Limit)
+			return repoInterfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: []models.LaunchPlan{
+					{
+						LaunchPlanKey: models.LaunchPlanKey{
+							Project: "project",
+							Domain:  "domain",
+							Name:    "lp",
+							Version: "active",
+						},
+						Spec: lpSpecBytes,
+					},
+				},
+			}, nil
+		})
+	config := runtimeMocks.NewMockConfigurationProvider(testutils.GetApplicationConfigWithDefaultDomains(),
+		runtimeMocks.NewMockQueueConfigurationProvider([]runtimeInterfaces.ExecutionQueue{
+			{
+				Dynamic:    "gpu queue",
+				Attributes: []string{"gpu"},
+			},
+		}, []runtimeInterfaces.WorkflowConfig{
+			{
+				Domain: "domain",
+				Tags:   []string{"gpu"},
+			},
+		}), nil, nil, nil, nil)
+	mockCluster := &clusterMocks.MockCluster{}
+	return NewExecutionRoutingManager(repository, config, mockCluster), mockCluster
+}
+
+func TestResolveExecutionCluster(t *testing.T) {
+	routingManager, mockCluster := getExecutionRoutingManagerForTest(t)
+	mockCluster.SetResolveTargetCallback(func(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (
+		*executioncluster.ExecutionTargetResolution, error) {
+		assert.Equal(t, executioncluster.ExecutionTargetSpec{
+			Project:               "project",
+			Domain:                "domain",
+			Workflow:              "name",
+			LaunchPlan:            "lp",
+			ExecutionClusterLabel: "us-east",
+		}, *spec)
+		return &executioncluster.ExecutionTargetResolution{
+			Label:     "us-east",
+			LabelPool: true,
+			Candidates: []executioncluster.ExecutionTargetCandidate{
+				{ID: "us-east-1a", Weight: 3, Probability: 0.75},
+				{ID: "us-east-1b", Weight: 1, Probability: 0.25},
+			},
+			Reasons: []string{"the execution cluster label [us-east] was requested"},
+		}, nil
+	})
+
+	resolution, err := routingManager.ResolveExecutionCluster(context.Background(),
+		interfaces.ResolveExecutionClusterRequest{
+			Project:               "project",
+			Domain:                "domain",
+			LaunchPlan:            "lp",
+			ExecutionClusterLabel: "us-east",
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ExecutionClusterResolution{
+		LaunchPlanVersion: "active",
+		ClusterCandidates: []interfaces.ClusterCandidate{
+			{Cluster: "us-east-1a", Weight: 3, Probability: 0.75},
+			{Cluster: "us-east-1b", Weight: 1, Probability: 0.25},
+		},
+		ClusterLabel:    "us-east",
+		ClusterPool:     true,
+		Queue:           "gpu queue",
+		QueueCandidates: []string{"gpu queue"},
+		QueueTag:        "gpu",
+		Reasons: []string{
+			"the execution cluster label [us-east] was requested",
+			"the execution queue tag [gpu] is set by the workflow config of domain [domain]",
+		},
+	}, resolution)
+}
+
+func TestResolveExecutionCluster_ForExecution(t *testing.T) {
+	routingManager, mockCluster := getExecutionRoutingManagerForTest(t)
+	mockCluster.SetResolveTargetCallback(func(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (
+		*executioncluster.ExecutionTargetResolution, error) {
+		assert.Equal(t, "exec", spec.ExecutionID)
+		return &executioncluster.ExecutionTargetResolution{
+			Target: &executioncluster.ExecutionTarget{ID: "us-east-1b"},
+		}, nil
+	})
+
+	resolution, err := routingManager.ResolveExecutionCluster(context.Background(),
+		interfaces.ResolveExecutionClusterRequest{
+			Project:       "project",
+			Domain:        "domain",
+			LaunchPlan:    "lp",
+			ExecutionName: "exec",
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, "us-east-1b", resolution.Cluster)
+}
+
+func TestResolveExecutionCluster_MissingLaunchPlan(t *testing.T) {
+	routingManager, _ := getExecutionRoutingManagerForTest(t)
+	_, err := routingManager.ResolveExecutionCluster(context.Background(),
+		interfaces.ResolveExecutionClusterRequest{
+			Project: "project",
+			Domain:  "domain",
+		})
+	assert.EqualError(t, err, "missing launch_plan")
+}
@@ -0,0 +1,290 @@
+package executions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	namingTemplateProject    = "{project}"
+	namingTemplateDomain     = "{domain}"
+	namingTemplateLaunchPlan = "{launch_plan}"
+	namingTemplateDate       = "{date}"
+	namingTemplateTime       = "{time}"
+	namingTemplateSeq        = "{seq}"
+)
+
+// Execution names are used to name the pods of their nodes, so they're kept as short as requests are allowed to set.
+const maxExecutionNameLength = 20
+
+// Launch plans are shortened so that sequence numbers of up to this many digits fit without shortening them further,
+// which keeps the names executions are counted by stable.
+const reservedSeqLength = 4
+
+// The number of names tried before giving up on naming an execution.
+const maxExecutionNameAttempts = 10
+
+var executionNameTokens = regexp.MustCompile(`\{launch_plan\}|\{seq\}`)
+var invalidExecutionNameChars = regexp.MustCompile(`[^a-z0-9-]`)
+
+// In kubernetes, resource names must comply with this regex.
+var executionNameRegex = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+type GetExecutionNameInput struct {
+	Project string
+	Domain  string
+	// The launch plan, or the task of single task executions, the execution is launched from.
+	ReferenceName string
+	RequestedAt   time.Time
+	// The number of names previously chosen for the execution which turned out to be taken.
+	Attempt int
+}
+
+// Names executions which requests don't name, following the naming policy of their project and domain.
+type ExecutionNamer interface {
+	// Returns a name for a new execution which no other execution in its project and domain has yet. Names may be
+	// taken by executions created concurrently, in which case the execution is named again with the next attempt.
+	GetExecutionName(ctx context.Context, input GetExecutionNameInput) (string, error)
+	// Checks a name a request sets against the names the policy of its project and domain allows.
+	ValidateExecutionName(project, domain, name string) error
+}
+
+type executionNamer struct {
+	config runtimeInterfaces.Configuration
+	db     repositories.RepositoryInterface
+}
+
+func sanitizeExecutionName(value string) string {
+	return invalidExecutionNameChars.ReplaceAllString(strings.ToLower(value), "-")
+}
+
+// Renders a naming template with a sequence number, and returns the part of the name before the first sequence number
+// along with it.
+func renderExecutionName(template string, input GetExecutionNameInput, seq int) (name, seqPrefix string) {
+	requestedAt := input.RequestedAt.UTC()
+	template = strings.NewReplacer(
+		namingTemplateProject, input.Project,
+		namingTemplateDomain, input.Domain,
+		namingTemplateDate, requestedAt.Format("20060102"),
+		namingTemplateTime, requestedAt.Format("150405"),
+	).Replace(template)
+	seqValue := strconv.Itoa(seq)
+
+	// Literal parts of the template alternate with launch plans and sequence numbers.
+	var literals []string
+	var tokens []string
+	fixedLength := 0
+	launchPlans := 0
+	start := 0
+	for _, match := range executionNameTokens.FindAllStringIndex(template, -1) {
+		literal := sanitizeExecutionName(template[start:match[0]])
+		literals = append(literals, literal)
+		fixedLength += len(literal)
+		token := template[match[0]:match[1]]
+		tokens = append(tokens, token)
+		if token == namingTemplateSeq {
+			if len(seqValue) > reservedSeqLength {
+				fixedLength += len(seqValue)
+			} else {
+				fixedLength += reservedSeqLength
+			}
+		} else {
+			launchPlans++
+		}
+		start = match[1]
+	}
+	lastLiteral := sanitizeExecutionName(template[start:])
+	fixedLength += len(lastLiteral)
+
+	launchPlan := sanitizeExecutionName(input.ReferenceName)
+	if launchPlans > 0 {
+		available := (maxExecutionNameLength - fixedLength) / launchPlans
+		if available < 0 {
+			available = 0
+		}
+		if len(launchPlan) > available {
+			launchPlan = launchPlan[:available]
+		}
+	}
+
+	var builder strings.Builder
+	seqPrefixLength := -1
+	for i, token := range tokens {
+		builder.WriteString(literals[i])
+		if token == namingTemplateSeq {
+			if seqPrefixLength < 0 {
+				seqPrefixLength = builder.Len()
+			}
+			builder.WriteString(seqValue)
+		} else {
+			builder.WriteString(launchPlan)
+		}
+	}
+	builder.WriteString(lastLiteral)
+	name = builder.String()
+	if seqPrefixLength >= 0 {
+		seqPrefix = name[:seqPrefixLength]
+	}
+	return name, seqPrefix
+}
+
+// Returns the first sequence number to try, which is one more than the number of executions named alike so that
+// names are usually unused on the first attempt.
+func (n *executionNamer) getFirstSeq(ctx context.Context, input GetExecutionNameInput, seqPrefix string) (int, error) {
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: input.Project,
+		Domain:  input.Domain,
+	}, common.Execution)
+	if err != nil {
+		return 0, err
+	}
+	if len(seqPrefix) > 0 {
+		nameFilter, err := common.NewSingleValueFilter(common.Execution, common.StartsWith, "execution_name", seqPrefix)
+		if err != nil {
+			return 0, err
+		}
+		filters = append(filters, nameFilter)
+	}
+	count, err := n.db.ExecutionRepo().Count(ctx, repositoryInterfaces.CountResourceInput{InlineFilters: filters})
+	if err != nil {
+		return 0, err
+	}
+	return int(count) + 1, nil
+}
+
+func getNamingAttemptsExhaustedErr(input GetExecutionNameInput) error {
+	return errors.NewFlyteAdminErrorf(codes.Aborted,
+		"failed to find an unused name for an execution of [%s] in [%s/%s] after %d attempts, retry or name the execution",
+		input.ReferenceName, input.Project, input.Domain, maxExecutionNameAttempts)
+}
+
+func (n *executionNamer) GetExecutionName(ctx context.Context, input GetExecutionNameInput) (string, error) {
+	if input.Attempt >= maxExecutionNameAttempts {
+		return "", getNamingAttemptsExhaustedErr(input)
+	}
+	policy, ok := n.config.ApplicationConfiguration().GetTopLevelConfig().GetExecutionNamingConfig().GetPolicy(
+		input.Project, input.Domain)
+	if !ok || len(policy.Template) == 0 {
+		return common.GetExecutionName(time.Now().UnixNano()), nil
+	}
+	template := policy.Template
+	seq := 0
+	if strings.Contains(template, namingTemplateSeq) {
+		_, seqPrefix := renderExecutionName(template, input, 1)
+		var err error
+		if seq, err = n.getFirstSeq(ctx, input, seqPrefix); err != nil {
+			return "", err
+		}
+	}
+	// Names known to be taken are skipped so that executions aren't launched under them, though the unique key on
+	// execution names is what keeps executions created concurrently from sharing one.
+	for attempt := input.Attempt; attempt < maxExecutionNameAttempts; attempt++ {
+		attemptTemplate := template
+		if seq == 0 && attempt > 0 {
+			// Templates without sequence numbers are numbered once their names are taken, starting from the second.
+			attemptTemplate = template + "-" + namingTemplateSeq
+		}
+		attemptSeq := seq + attempt
+		if seq == 0 {
+			attemptSeq = attempt + 1
+		}
+		name, _ := renderExecutionName(attemptTemplate, input, attemptSeq)
+		if len(name) > maxExecutionNameLength || !executionNameRegex.MatchString(name) {
+			return "", errors.NewFlyteAdminErrorf(codes.Internal,
+				"execution naming template [%s] for [%s/%s] produced the invalid name [%s]", template, input.Project,
+				input.Domain, name)
+		}
+		exists, err := n.db.ExecutionRepo().Exists(ctx, repositoryInterfaces.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    name,
+		})
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return name, nil
+		}
+		logger.Debugf(ctx, "Execution name [%s] is taken in [%s/%s], trying the next", name, input.Project,
+			input.Domain)
+	}
+	return "", getNamingAttemptsExhaustedErr(input)
+}
+
+func (n *executionNamer) ValidateExecutionName(project, domain, name string) error {
+	policy, ok := n.config.ApplicationConfiguration().GetTopLevelConfig().GetExecutionNamingConfig().GetPolicy(
+		project, domain)
+	if !ok || len(policy.AllowedNames) == 0 {
+		return nil
+	}
+	allowedNames, err := regexp.Compile(policy.AllowedNames)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal,
+			"invalid allowed execution names [%s] for [%s/%s]: %v", policy.AllowedNames, project, domain, err)
+	}
+	if !allowedNames.MatchString(name) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"execution name [%s] isn't allowed in [%s/%s], names must match [%s]", name, project, domain,
+			policy.AllowedNames)
+	}
+	return nil
+}
+
+// Checks the naming policies so that templates which can't produce valid names, such as those starting with a date,
+// fail when admin starts rather than when executions are named.
+func validateNamingPolicies(namingConfig runtimeInterfaces.ExecutionNamingConfig) error {
+	for _, policy := range namingConfig.Policies {
+		if len(policy.AllowedNames) > 0 {
+			if _, err := regexp.Compile(policy.AllowedNames); err != nil {
+				return fmt.Errorf("invalid allowed execution names [%s] for [%s/%s]: %v", policy.AllowedNames,
+					policy.Project, policy.Domain, err)
+			}
+		}
+		if len(policy.Template) == 0 {
+			continue
+		}
+		input := GetExecutionNameInput{
+			Project:       policy.Project,
+			Domain:        policy.Domain,
+			ReferenceName: "lp",
+			RequestedAt:   time.Now(),
+		}
+		if len(input.Project) == 0 {
+			input.Project = "project"
+		}
+		if len(input.Domain) == 0 {
+			input.Domain = "domain"
+		}
+		name, _ := renderExecutionName(policy.Template, input, 1)
+		if len(name) > maxExecutionNameLength || !executionNameRegex.MatchString(name) {
+			return fmt.Errorf("execution naming template [%s] for [%s/%s] produces invalid names such as [%s], "+
+				"names must start with a letter, end with a letter or digit and be at most %d characters",
+				policy.Template, policy.Project, policy.Domain, name, maxExecutionNameLength)
+		}
+	}
+	return nil
+}
+
+func NewExecutionNamer(config runtimeInterfaces.Configuration, db repositories.RepositoryInterface) ExecutionNamer {
+	if err := validateNamingPolicies(
+		config.ApplicationConfiguration().GetTopLevelConfig().GetExecutionNamingConfig()); err != nil {
+		panic(err)
+	}
+	return &executionNamer{
+		config: config,
+		db:     db,
+	}
+}
@@ -0,0 +1,150 @@
+package executions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var namingInput = GetExecutionNameInput{
+	Project:       "flytesnacks",
+	Domain:        "development",
+	ReferenceName: "core.nightly_etl",
+	RequestedAt:   time.Date(2021, time.November, 26, 9, 30, 0, 0, time.UTC),
+}
+
+func getExecutionNamerForTest(policies ...runtimeInterfaces.ProjectDomainNamingPolicy) (
+	ExecutionNamer, repositories.RepositoryInterface) {
+	repository := repositoryMocks.NewMockRepository()
+	mockConfig := runtimeMocks.NewMockConfigurationProvider(nil, nil, nil, nil, nil, nil)
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			ExecutionNaming: runtimeInterfaces.ExecutionNamingConfig{Policies: policies},
+		})
+	return NewExecutionNamer(mockConfig, repository), repository
+}
+
+// Treats the given names as taken.
+func setTakenExecutionNames(repository repositories.RepositoryInterface, names ...string) {
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).ExistsFunction = func(
+		ctx context.Context, input repositoryInterfaces.Identifier) (bool, error) {
+		for _, name := range names {
+			if input.Name == name {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+func TestRenderExecutionName(t *testing.T) {
+	name, seqPrefix := renderExecutionName("{launch_plan}-{date}-{seq}", namingInput, 3)
+	// The launch plan is shortened to leave room for the date and a four digit sequence number.
+	assert.Equal(t, "core-n-20211126-3", name)
+	assert.Equal(t, "core-n-20211126-", seqPrefix)
+
+	name, seqPrefix = renderExecutionName("{domain}_{time}", namingInput, 1)
+	assert.Equal(t, "development-093000", name)
+	assert.Empty(t, seqPrefix)
+}
+
+func TestGetExecutionName_Random(t *testing.T) {
+	namer, _ := getExecutionNamerForTest(runtimeInterfaces.ProjectDomainNamingPolicy{
+		Project:  "other",
+		Template: "{launch_plan}-{seq}",
+	})
+	name, err := namer.GetExecutionName(context.Background(), namingInput)
+	assert.NoError(t, err)
+	assert.Len(t, name, common.ExecutionIDLength)
+}
+
+func TestGetExecutionName_Seq(t *testing.T) {
+	namer, repository := getExecutionNamerForTest(runtimeInterfaces.ProjectDomainNamingPolicy{
+		Project:  "flytesnacks",
+		Template: "{launch_plan}-{date}-{seq}",
+	})
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).CountFunction = func(
+		ctx context.Context, input repositoryInterfaces.CountResourceInput) (int64, error) {
+		assert.Len(t, input.InlineFilters, 3)
+		expr, err := input.InlineFilters[2].GetGormQueryExpr()
+		assert.NoError(t, err)
+		assert.Equal(t, "core-n-20211126-%", expr.Args)
+		return 2, nil
+	}
+	// Names are taken out of order, e.g. by executions named explicitly.
+	setTakenExecutionNames(repository, "core-n-20211126-3")
+
+	name, err := namer.GetExecutionName(context.Background(), namingInput)
+	assert.NoError(t, err)
+	assert.Equal(t, "core-n-20211126-4", name)
+}
+
+func TestGetExecutionName_Collision(t *testing.T) {
+	namer, repository := getExecutionNamerForTest(runtimeInterfaces.ProjectDomainNamingPolicy{
+		Template: "nightly-{date}",
+	})
+	setTakenExecutionNames(repository, "nightly-20211126", "nightly-20211126-2")
+
+	name, err := namer.GetExecutionName(context.Background(), namingInput)
+	assert.NoError(t, err)
+	assert.Equal(t, "nightly-20211126-3", name)
+}
+
+func TestGetExecutionName_Attempt(t *testing.T) {
+	namer, repository := getExecutionNamerForTest(runtimeInterfaces.ProjectDomainNamingPolicy{
+		Template: "nightly-{date}",
+	})
+	// The name of the first attempt was taken concurrently, after it was checked.
+	setTakenExecutionNames(repository)
+	input := namingInput
+	input.Attempt = 1
+
+	name, err := namer.GetExecutionName(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, "nightly-20211126-2", name)
+
+	input.Attempt = maxExecutionNameAttempts
+	_, err = namer.GetExecutionName(context.Background(), input)
+	assert.Equal(t, codes.Aborted, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestNewExecutionNamer_InvalidTemplate(t *testing.T) {
+	assert.Panics(t, func() {
+		getExecutionNamerForTest(runtimeInterfaces.ProjectDomainNamingPolicy{
+			Template: "{date}-{launch_plan}",
+		})
+	})
+	assert.Panics(t, func() {
+		getExecutionNamerForTest(runtimeInterfaces.ProjectDomainNamingPolicy{
+			AllowedNames: "(",
+		})
+	})
+	assert.NotPanics(t, func() {
+		getExecutionNamerForTest(runtimeInterfaces.ProjectDomainNamingPolicy{
+			Template: "{launch_plan}-{date}-{seq}",
+		})
+	})
+}
+
+func TestValidateExecutionName(t *testing.T) {
+	namer, _ := getExecutionNamerForTest(runtimeInterfaces.ProjectDomainNamingPolicy{
+		Project:      "flytesnacks",
+		Domain:       "development",
+		AllowedNames: "^etl-[a-z0-9-]+$",
+	})
+	assert.NoError(t, namer.ValidateExecutionName("flytesnacks", "development", "etl-backfill"))
+	err := namer.ValidateExecutionName("flytesnacks", "development", "adhoc")
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	// Other projects and domains have no policy.
+	assert.NoError(t, namer.ValidateExecutionName("flytesnacks", "production", "adhoc"))
+}
@@ -0,0 +1,92 @@
+package executions
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	dataInterfaces "github.com/flyteorg/flyteadmin/pkg/data/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The number of offloaded inputs listed at a time.
+const offloadedInputsBatchSize = 100
+
+// Collects the inputs offloaded for executions which were never created, such as those which failed to launch, and
+// stops tracking the inputs of executions which were.
+type OffloadedInputsCollector interface {
+	Collect(ctx context.Context) error
+}
+
+type offloadedInputsMetrics struct {
+	Scope          promutils.Scope
+	OrphansDeleted prometheus.Counter
+	DeleteFailures prometheus.Counter
+}
+
+type offloadedInputsCollector struct {
+	db      repositories.RepositoryInterface
+	config  runtimeInterfaces.Configuration
+	deleter dataInterfaces.RemoteDataDeleterInterface
+	metrics offloadedInputsMetrics
+	_clock  clock.Clock
+}
+
+func (c *offloadedInputsCollector) Collect(ctx context.Context) error {
+	offloadingConfig := c.config.ApplicationConfiguration().GetTopLevelConfig().GetLiteralOffloadingConfig()
+	// Inputs are offloaded before their execution is created, so recent inputs may belong to executions which are still
+	// being created.
+	createdBefore := c._clock.Now().Add(-offloadingConfig.OrphanGracePeriod.Duration)
+	for {
+		literals, err := c.db.OffloadedLiteralRepo().ListCollectable(
+			ctx, repositoryInterfaces.ListCollectableOffloadedLiteralsInput{
+				CreatedBefore: createdBefore,
+				Limit:         offloadedInputsBatchSize,
+			})
+		if err != nil {
+			return err
+		}
+		ids := make([]uint, 0, len(literals))
+		for _, literal := range literals {
+			if literal.Orphaned {
+				if err := c.deleter.Delete(ctx, storage.DataReference(literal.URI)); err != nil {
+					// Inputs which failed to delete are tracked until a later pass deletes them.
+					logger.Warningf(ctx, "Failed to delete the orphaned inputs [%s] of execution [%s/%s/%s] with err: %v",
+						literal.URI, literal.ExecutionProject, literal.ExecutionDomain, literal.ExecutionName, err)
+					c.metrics.DeleteFailures.Inc()
+					continue
+				}
+				c.metrics.OrphansDeleted.Inc()
+			}
+			ids = append(ids, literal.ID)
+		}
+		if err := c.db.OffloadedLiteralRepo().Delete(ctx, ids); err != nil {
+			return err
+		}
+		if len(literals) < offloadedInputsBatchSize || len(ids) == 0 {
+			return nil
+		}
+	}
+}
+
+func NewOffloadedInputsCollector(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	deleter dataInterfaces.RemoteDataDeleterInterface, scope promutils.Scope) OffloadedInputsCollector {
+	return &offloadedInputsCollector{
+		db:      db,
+		config:  config,
+		deleter: deleter,
+		metrics: offloadedInputsMetrics{
+			Scope: scope,
+			OrphansDeleted: scope.MustNewCounter("orphans_deleted",
+				"count of offloaded inputs deleted because their execution was never created"),
+			DeleteFailures: scope.MustNewCounter("delete_failures",
+				"count of orphaned offloaded inputs which failed to delete"),
+		},
+		_clock: clock.New(),
+	}
+}
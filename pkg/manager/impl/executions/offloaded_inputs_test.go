@@ -0,0 +1,75 @@
+package executions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	dataMocks "github.com/flyteorg/flyteadmin/pkg/data/mocks"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var offloadedInputsNow = time.Date(2021, time.November, 12, 12, 0, 0, 0, time.UTC)
+
+func getOffloadedInputsCollectorForTest(deleter *dataMocks.MockRemoteDataDeleter) (
+	*offloadedInputsCollector, *repositoryMocks.OffloadedLiteralRepoInterface) {
+	repository := repositoryMocks.NewMockRepository()
+	mockConfig := runtimeMocks.NewMockConfigurationProvider(nil, nil, nil, nil, nil, nil)
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			LiteralOffloading: runtimeInterfaces.LiteralOffloadingConfig{
+				Enabled:           true,
+				OrphanGracePeriod: config.Duration{Duration: time.Hour},
+			},
+		})
+	mockClock := clock.NewMock()
+	mockClock.Set(offloadedInputsNow)
+	collector := NewOffloadedInputsCollector(
+		repository, mockConfig, deleter, promutils.NewTestScope()).(*offloadedInputsCollector)
+	collector._clock = mockClock
+	return collector, repository.OffloadedLiteralRepo().(*repositoryMocks.OffloadedLiteralRepoInterface)
+}
+
+func TestOffloadedInputsCollector_Collect(t *testing.T) {
+	var deleted []storage.DataReference
+	collector, literalRepo := getOffloadedInputsCollectorForTest(&dataMocks.MockRemoteDataDeleter{
+		DeleteCallback: func(ctx context.Context, reference storage.DataReference) error {
+			deleted = append(deleted, reference)
+			if reference == "s3://bucket/failed" {
+				return errors.New("access denied")
+			}
+			return nil
+		},
+	})
+	literalRepo.OnListCollectable(mock.Anything, repositoryInterfaces.ListCollectableOffloadedLiteralsInput{
+		CreatedBefore: offloadedInputsNow.Add(-time.Hour),
+		Limit:         offloadedInputsBatchSize,
+	}).Return([]models.CollectableOffloadedLiteral{
+		{OffloadedLiteral: models.OffloadedLiteral{ID: 1, URI: "s3://bucket/created"}},
+		{OffloadedLiteral: models.OffloadedLiteral{ID: 2, URI: "s3://bucket/orphan"}, Orphaned: true},
+		{OffloadedLiteral: models.OffloadedLiteral{ID: 3, URI: "s3://bucket/failed"}, Orphaned: true},
+	}, nil)
+	literalRepo.OnDelete(mock.Anything, []uint{1, 2}).Return(nil)
+
+	assert.NoError(t, collector.Collect(context.Background()))
+	assert.Equal(t, []storage.DataReference{"s3://bucket/orphan", "s3://bucket/failed"}, deleted)
+	literalRepo.AssertExpectations(t)
+}
+
+func TestOffloadedInputsCollector_ListError(t *testing.T) {
+	collector, literalRepo := getOffloadedInputsCollectorForTest(&dataMocks.MockRemoteDataDeleter{})
+	literalRepo.OnListCollectableMatch(mock.Anything, mock.Anything).Return(nil, errors.New("db unavailable"))
+
+	assert.EqualError(t, collector.Collect(context.Background()), "db unavailable")
+}
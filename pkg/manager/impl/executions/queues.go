@@ -2,6 +2,7 @@ package executions
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/resources"
@@ -27,8 +28,23 @@ type queues = []singleQueueConfiguration
 
 type queueConfig = map[tag]queues
 
+// Explains which queue an execution is assigned and why.
+type QueueResolution struct {
+	// The dynamic queue of the execution, which is picked at random from the candidates.
+	Queue      string
+	Candidates []string
+	// The tag the candidates were matched by.
+	Tag string
+	// The matchable attributes which set the tag, if the tag wasn't set by the workflow configs.
+	Attributes *interfaces.ResourceResponse
+	// Why the candidates were picked, in the order the decisions were made.
+	Reasons []string
+}
+
 type QueueAllocator interface {
 	GetQueue(ctx context.Context, identifier core.Identifier) singleQueueConfiguration
+	// Explains which queue GetQueue picks for a workflow and why.
+	ResolveQueue(ctx context.Context, identifier core.Identifier) QueueResolution
 }
 
 type queueAllocatorImpl struct {
@@ -55,11 +71,36 @@ func (q *queueAllocatorImpl) refreshExecutionQueues(executionQueues []runtimeInt
 	q.queueConfigMap = queueConfigMap
 }
 
+// Picks a queue at random among those with a tag, or returns false when no queues have the tag.
+func (q *queueAllocatorImpl) pickQueue(tag string, resolution *QueueResolution) bool {
+	matches, ok := q.queueConfigMap[tag]
+	if !ok {
+		return false
+	}
+	resolution.Tag = tag
+	for _, match := range matches {
+		resolution.Candidates = append(resolution.Candidates, match.DynamicQueue)
+	}
+	/* #nosec */
+	resolution.Queue = matches[rand.Intn(len(matches))].DynamicQueue
+	return true
+}
+
 func (q *queueAllocatorImpl) GetQueue(ctx context.Context, identifier core.Identifier) singleQueueConfiguration {
+	return singleQueueConfiguration{
+		DynamicQueue: q.ResolveQueue(ctx, identifier).Queue,
+	}
+}
+
+func (q *queueAllocatorImpl) ResolveQueue(ctx context.Context, identifier core.Identifier) QueueResolution {
 	// NOTE: If refreshing the execution queues & workflow configs on every call to GetQueue becomes too slow we should
 	// investigate caching the computed queue assignments.
 	executionQueues := q.config.QueueConfiguration().GetExecutionQueues()
 	q.refreshExecutionQueues(executionQueues)
+	resolution := QueueResolution{
+		Candidates: make([]string, 0),
+		Reasons:    make([]string, 0),
+	}
 
 	resource, err := q.resourceManager.GetResource(ctx, interfaces.ResourceRequest{
 		Project:      identifier.Project,
@@ -75,13 +116,16 @@ func (q *queueAllocatorImpl) GetQueue(ctx context.Context, identifier core.Ident
 
 	if resource != nil && resource.Attributes != nil && resource.Attributes.GetExecutionQueueAttributes() != nil {
 		for _, tag := range resource.Attributes.GetExecutionQueueAttributes().Tags {
-			matches, ok := q.queueConfigMap[tag]
-			if !ok {
-				continue
+			if q.pickQueue(tag, &resolution) {
+				resolution.Attributes = resource
+				resolution.Reasons = append(resolution.Reasons, fmt.Sprintf(
+					"the execution queue tag [%s] is set by the matchable attributes of project [%s], domain [%s], workflow [%s]",
+					tag, resource.Project, resource.Domain, resource.Workflow))
+				return resolution
 			}
-			/* #nosec */
-			return matches[rand.Intn(len(matches))]
 		}
+		resolution.Reasons = append(resolution.Reasons,
+			"no execution queues have the tags set by the execution queue matchable attributes")
 	}
 	var tags []string
 	var defaultTags []string
@@ -93,20 +137,22 @@ func (q *queueAllocatorImpl) GetQueue(ctx context.Context, identifier core.Ident
 			defaultTags = workflowConfig.Tags
 		}
 	}
+	source := fmt.Sprintf("the workflow config of domain [%s]", identifier.Domain)
 	if len(tags) == 0 {
 		// Use the uber-default queue
 		tags = defaultTags
+		source = "the default workflow config"
 	}
 	for _, tag := range tags {
-		matches, ok := q.queueConfigMap[tag]
-		if !ok {
-			continue
+		if q.pickQueue(tag, &resolution) {
+			resolution.Reasons = append(resolution.Reasons, fmt.Sprintf(
+				"the execution queue tag [%s] is set by %s", tag, source))
+			return resolution
 		}
-		/* #nosec */
-		return matches[rand.Intn(len(matches))]
 	}
 	logger.Infof(ctx, "found no matching queue for [%+v]", identifier)
-	return singleQueueConfiguration{}
+	resolution.Reasons = append(resolution.Reasons, "no execution queues match the tags of the workflow configs")
+	return resolution
 }
 
 func NewQueueAllocator(config runtimeInterfaces.Configuration, db repositories.RepositoryInterface) QueueAllocator {
@@ -205,4 +205,23 @@ func TestGetQueueDefaults(t *testing.T) {
 			Domain:  "domain",
 			Name:    "workflow",
 		}))
+
+	resolution := queueAllocator.ResolveQueue(context.Background(), core.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "workflow",
+	})
+	assert.Equal(t, "queue3 dynamic", resolution.Queue)
+	assert.Equal(t, []string{"queue3 dynamic"}, resolution.Candidates)
+	assert.Equal(t, "attr3", resolution.Tag)
+	assert.Equal(t, "workflow", resolution.Attributes.Workflow)
+	resolution = queueAllocator.ResolveQueue(context.Background(), core.Identifier{
+		Project: "unmatched",
+		Domain:  "domain",
+		Name:    "workflow",
+	})
+	assert.Equal(t, "default", resolution.Tag)
+	assert.Nil(t, resolution.Attributes)
+	assert.Equal(t, []string{"the execution queue tag [default] is set by the default workflow config"},
+		resolution.Reasons)
 }
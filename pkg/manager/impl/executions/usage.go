@@ -0,0 +1,84 @@
+package executions
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flytestdlib/logger"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const bytesPerGB = 1 << 30
+
+type GetTaskExecutionUsageInput struct {
+	TaskExecutionID core.TaskExecutionIdentifier
+	// The event which moved the task execution to a terminal phase.
+	Event *event.TaskExecutionEvent
+	// How long the task execution ran for.
+	Duration time.Duration
+}
+
+// Determines the resources a task execution used once it terminates. Implementations may estimate usage, or look it
+// up from what plugins report in their events or from external systems.
+type UsageProvider interface {
+	GetTaskExecutionUsage(ctx context.Context, input GetTaskExecutionUsageInput) (interfaces.ResourceUsage, error)
+}
+
+// Estimates usage as the resources a task's container requests, held for as long as it ran. Containers which don't
+// request a resource are assumed to get the platform default, and tasks which don't run a container use nothing.
+type requestedResourcesUsageProvider struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func (p *requestedResourcesUsageProvider) GetTaskExecutionUsage(
+	ctx context.Context, input GetTaskExecutionUsageInput) (interfaces.ResourceUsage, error) {
+	if input.Duration <= 0 {
+		return interfaces.ResourceUsage{}, nil
+	}
+	task, err := util.GetTask(ctx, p.db, *input.TaskExecutionID.TaskId)
+	if err != nil {
+		return interfaces.ResourceUsage{}, err
+	}
+	container := task.GetClosure().GetCompiledTask().GetTemplate().GetContainer()
+	if container == nil {
+		return interfaces.ResourceUsage{}, nil
+	}
+	requests := p.config.TaskResourceConfiguration().GetDefaults()
+	for _, entry := range container.GetResources().GetRequests() {
+		quantity, err := resource.ParseQuantity(entry.Value)
+		if err != nil {
+			logger.Infof(ctx, "Failed to parse task [%+v] resource [%v] request [%s] with err: %v",
+				input.TaskExecutionID.TaskId, entry.Name, entry.Value, err)
+			continue
+		}
+		switch entry.Name {
+		case core.Resources_CPU:
+			requests.CPU = quantity
+		case core.Resources_GPU:
+			requests.GPU = quantity
+		case core.Resources_MEMORY:
+			requests.Memory = quantity
+		}
+	}
+	hours := input.Duration.Hours()
+	return interfaces.ResourceUsage{
+		CPUHours:      float64(requests.CPU.MilliValue()) / 1000 * hours,
+		GPUHours:      float64(requests.GPU.MilliValue()) / 1000 * hours,
+		MemoryGBHours: float64(requests.Memory.Value()) / bytesPerGB * hours,
+	}, nil
+}
+
+func NewRequestedResourcesUsageProvider(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) UsageProvider {
+	return &requestedResourcesUsageProvider{
+		db:     db,
+		config: config,
+	}
+}
@@ -0,0 +1,137 @@
+package executions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var usageTaskExecutionID = core.TaskExecutionIdentifier{
+	TaskId: &core.Identifier{
+		ResourceType: core.ResourceType_TASK,
+		Project:      "project",
+		Domain:       "development",
+		Name:         "task",
+		Version:      "version",
+	},
+	NodeExecutionId: &core.NodeExecutionIdentifier{
+		NodeId: "node",
+		ExecutionId: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "development",
+			Name:    "name",
+		},
+	},
+}
+
+func getUsageProviderWithTask(t *testing.T, template *core.TaskTemplate) UsageProvider {
+	repository := repositoryMocks.NewMockRepository()
+	closure, err := proto.Marshal(&admin.TaskClosure{
+		CompiledTask: &core.CompiledTask{
+			Template: template,
+		},
+	})
+	assert.NoError(t, err)
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input repoInterfaces.Identifier) (models.Task, error) {
+			assert.Equal(t, usageTaskExecutionID.TaskId.Name, input.Name)
+			assert.Equal(t, usageTaskExecutionID.TaskId.Version, input.Version)
+			return models.Task{
+				TaskKey: models.TaskKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Closure: closure,
+			}, nil
+		})
+	config := runtimeMocks.NewMockConfigurationProvider(nil, nil, nil,
+		runtimeMocks.NewMockTaskResourceConfiguration(runtimeInterfaces.TaskResourceSet{
+			CPU:    resource.MustParse("500m"),
+			Memory: resource.MustParse("1Gi"),
+		}, runtimeInterfaces.TaskResourceSet{}), nil, nil)
+	return NewRequestedResourcesUsageProvider(repository, config)
+}
+
+func TestGetTaskExecutionUsage(t *testing.T) {
+	provider := getUsageProviderWithTask(t, &core.TaskTemplate{
+		Target: &core.TaskTemplate_Container{
+			Container: &core.Container{
+				Resources: &core.Resources{
+					Requests: []*core.Resources_ResourceEntry{
+						{Name: core.Resources_CPU, Value: "2"},
+						{Name: core.Resources_GPU, Value: "1"},
+						{Name: core.Resources_STORAGE, Value: "10Gi"},
+					},
+				},
+			},
+		},
+	})
+	usage, err := provider.GetTaskExecutionUsage(context.Background(), GetTaskExecutionUsageInput{
+		TaskExecutionID: usageTaskExecutionID,
+		Duration:        30 * time.Minute,
+	})
+	assert.NoError(t, err)
+	// Memory isn't requested, so falls back to the platform default.
+	assert.Equal(t, interfaces.ResourceUsage{
+		CPUHours:      1,
+		GPUHours:      0.5,
+		MemoryGBHours: 0.5,
+	}, usage)
+}
+
+func TestGetTaskExecutionUsage_InvalidRequest(t *testing.T) {
+	provider := getUsageProviderWithTask(t, &core.TaskTemplate{
+		Target: &core.TaskTemplate_Container{
+			Container: &core.Container{
+				Resources: &core.Resources{
+					Requests: []*core.Resources_ResourceEntry{
+						{Name: core.Resources_CPU, Value: "lots"},
+					},
+				},
+			},
+		},
+	})
+	usage, err := provider.GetTaskExecutionUsage(context.Background(), GetTaskExecutionUsageInput{
+		TaskExecutionID: usageTaskExecutionID,
+		Duration:        time.Hour,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.ResourceUsage{
+		CPUHours:      0.5,
+		MemoryGBHours: 1,
+	}, usage)
+}
+
+func TestGetTaskExecutionUsage_NoContainer(t *testing.T) {
+	provider := getUsageProviderWithTask(t, &core.TaskTemplate{})
+	usage, err := provider.GetTaskExecutionUsage(context.Background(), GetTaskExecutionUsageInput{
+		TaskExecutionID: usageTaskExecutionID,
+		Duration:        time.Hour,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.ResourceUsage{}, usage)
+}
+
+func TestGetTaskExecutionUsage_NoDuration(t *testing.T) {
+	provider := NewRequestedResourcesUsageProvider(repositoryMocks.NewMockRepository(),
+		runtimeMocks.NewMockConfigurationProvider(nil, nil, nil, nil, nil, nil))
+	usage, err := provider.GetTaskExecutionUsage(context.Background(), GetTaskExecutionUsageInput{
+		TaskExecutionID: usageTaskExecutionID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.ResourceUsage{}, usage)
+}
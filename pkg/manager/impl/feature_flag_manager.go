@@ -0,0 +1,278 @@
+package impl
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type FeatureFlagManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.ApplicationConfiguration
+}
+
+// Returns the flags which can be overridden: those managers consult, and those named in config.
+func getKnownFeatureFlags(flagsConfig runtimeInterfaces.FeatureFlagsConfig) sets.String {
+	knownFlags := sets.NewString(interfaces.FeatureFlagAdmissionControl, interfaces.FeatureFlagRejectDeprecatedExecutions)
+	for name := range flagsConfig.Defaults {
+		knownFlags.Insert(name)
+	}
+	return knownFlags
+}
+
+// Returns the value a flag takes for a project given its overrides, or for every project when no project is given.
+func evaluateFeatureFlag(flagsConfig runtimeInterfaces.FeatureFlagsConfig, name, project string,
+	overrides []models.FeatureFlag) interfaces.FeatureFlagValue {
+	flagConfig := flagsConfig.Defaults[name]
+	value := interfaces.FeatureFlagValue{
+		Name:    name,
+		Project: project,
+		Enabled: flagConfig.Enabled || (len(project) > 0 && sets.NewString(flagConfig.Projects...).Has(project)),
+		Source:  interfaces.FeatureFlagSourceConfig,
+	}
+	for _, override := range overrides {
+		if len(project) > 0 && override.Project == project {
+			value.Enabled = override.Enabled
+			value.Source = interfaces.FeatureFlagSourceProjectOverride
+			break
+		}
+		if len(override.Project) == 0 {
+			value.Enabled = override.Enabled
+			value.Source = interfaces.FeatureFlagSourceOverride
+		}
+	}
+	return value
+}
+
+// Returns whether a flag is enabled for a project, for managers which gate behaviors on flags.
+func isFeatureEnabled(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration, name, project string) (bool, error) {
+	overrides, err := db.FeatureFlagRepo().Get(ctx, name)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to get the overrides of feature flag [%s] with err: %v", name, err)
+		return false, err
+	}
+	return evaluateFeatureFlag(config.GetTopLevelConfig().GetFeatureFlagsConfig(), name, project, overrides).Enabled, nil
+}
+
+func toFeatureFlagOverride(flagModel models.FeatureFlag) *interfaces.FeatureFlagOverride {
+	return &interfaces.FeatureFlagOverride{
+		Name:      flagModel.Name,
+		Project:   flagModel.Project,
+		Enabled:   flagModel.Enabled,
+		Principal: flagModel.Principal,
+		UpdatedAt: flagModel.UpdatedAt,
+	}
+}
+
+// Overrides of every project affect projects the caller may not be able to see.
+func checkFeatureFlagProjectVisible(ctx context.Context, project string) error {
+	if len(project) > 0 {
+		return checkProjectVisible(ctx, project)
+	}
+	if _, restricted := auth.VisibleProjectsFromContext(ctx); restricted {
+		return errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+			"feature flags can only be overridden for every project by callers who can see every project")
+	}
+	return nil
+}
+
+func (m *FeatureFlagManager) getFlagsConfig() runtimeInterfaces.FeatureFlagsConfig {
+	return m.config.GetTopLevelConfig().GetFeatureFlagsConfig()
+}
+
+func (m *FeatureFlagManager) SetFeatureFlag(
+	ctx context.Context, request interfaces.SetFeatureFlagRequest) (*interfaces.FeatureFlagOverride, error) {
+	if err := validation.ValidateSetFeatureFlagRequest(request, getKnownFeatureFlags(m.getFlagsConfig())); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	if err := checkFeatureFlagProjectVisible(ctx, request.Project); err != nil {
+		return nil, err
+	}
+	if len(request.Project) > 0 {
+		if _, err := m.db.ProjectRepo().Get(ctx, request.Project); err != nil {
+			return nil, err
+		}
+	}
+	principal := getUser(ctx)
+	enabled := request.Enabled
+	if err := m.db.FeatureFlagRepo().Set(ctx, models.FeatureFlag{
+		Name:      request.Name,
+		Project:   request.Project,
+		Enabled:   request.Enabled,
+		Principal: principal,
+	}, models.FeatureFlagChange{
+		Name:      request.Name,
+		Project:   request.Project,
+		Enabled:   &enabled,
+		Principal: principal,
+		Reason:    request.Reason,
+	}); err != nil {
+		logger.Debugf(ctx, "Failed to set feature flag [%s] for project [%s] with err: %v", request.Name,
+			request.Project, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "[%s] set feature flag [%s] to [%t] for project [%s]: %s", principal, request.Name,
+		request.Enabled, request.Project, request.Reason)
+
+	overrides, err := m.db.FeatureFlagRepo().Get(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, override := range overrides {
+		if override.Project == request.Project {
+			return toFeatureFlagOverride(override), nil
+		}
+	}
+	// The override was cleared right after it was set.
+	return nil, errors.NewFlyteAdminErrorf(codes.Aborted, "feature flag [%s] was changed concurrently", request.Name)
+}
+
+func (m *FeatureFlagManager) ClearFeatureFlag(ctx context.Context, request interfaces.ClearFeatureFlagRequest) error {
+	if err := validation.ValidateClearFeatureFlagRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return err
+	}
+	if err := checkFeatureFlagProjectVisible(ctx, request.Project); err != nil {
+		return err
+	}
+	principal := getUser(ctx)
+	if err := m.db.FeatureFlagRepo().Clear(ctx, request.Name, request.Project, models.FeatureFlagChange{
+		Name:      request.Name,
+		Project:   request.Project,
+		Principal: principal,
+		Reason:    request.Reason,
+	}); err != nil {
+		logger.Debugf(ctx, "Failed to clear feature flag [%s] for project [%s] with err: %v", request.Name,
+			request.Project, err)
+		return err
+	}
+	logger.Infof(ctx, "[%s] cleared the override of feature flag [%s] for project [%s]: %s", principal,
+		request.Name, request.Project, request.Reason)
+	return nil
+}
+
+func (m *FeatureFlagManager) GetFeatureFlagValue(
+	ctx context.Context, name, project string) (*interfaces.FeatureFlagValue, error) {
+	if err := validation.ValidateEmptyStringField(name, "name"); err != nil {
+		return nil, err
+	}
+	if len(project) > 0 {
+		if err := checkProjectVisible(ctx, project); err != nil {
+			return nil, err
+		}
+	}
+	overrides, err := m.db.FeatureFlagRepo().Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	flagsConfig := m.getFlagsConfig()
+	if len(overrides) == 0 && !getKnownFeatureFlags(flagsConfig).Has(name) {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound, "feature flag [%s] doesn't exist", name)
+	}
+	value := evaluateFeatureFlag(flagsConfig, name, project, overrides)
+	return &value, nil
+}
+
+func (m *FeatureFlagManager) ListFeatureFlags(ctx context.Context) ([]*interfaces.FeatureFlag, error) {
+	flagModels, err := m.db.FeatureFlagRepo().ListAll(ctx)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list feature flags with err: %v", err)
+		return nil, err
+	}
+	flagsConfig := m.getFlagsConfig()
+	flags := make(map[string]*interfaces.FeatureFlag)
+	for _, name := range getKnownFeatureFlags(flagsConfig).List() {
+		flags[name] = &interfaces.FeatureFlag{
+			Name:   name,
+			Config: flagsConfig.Defaults[name],
+		}
+	}
+	visibleProjects, restricted := auth.VisibleProjectsFromContext(ctx)
+	for _, flagModel := range flagModels {
+		flag, ok := flags[flagModel.Name]
+		if !ok {
+			// Overrides of flags which are no longer known are listed until they're cleared.
+			flag = &interfaces.FeatureFlag{Name: flagModel.Name}
+			flags[flagModel.Name] = flag
+		}
+		if len(flagModel.Project) > 0 && restricted && !visibleProjects.Has(flagModel.Project) {
+			continue
+		}
+		flag.Overrides = append(flag.Overrides, toFeatureFlagOverride(flagModel))
+	}
+	list := make([]*interfaces.FeatureFlag, 0, len(flags))
+	for _, flag := range flags {
+		list = append(list, flag)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list, nil
+}
+
+func (m *FeatureFlagManager) ListFeatureFlagChanges(
+	ctx context.Context, request interfaces.FeatureFlagChangeListRequest) (*interfaces.FeatureFlagChangeList, error) {
+	if err := validation.ValidateFeatureFlagChangeListRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	if _, restricted := auth.VisibleProjectsFromContext(ctx); restricted {
+		return nil, errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+			"the changes to feature flags can only be listed by callers who can see every project")
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListFeatureFlagChanges", request.Token)
+	}
+	changeModels, err := m.db.FeatureFlagRepo().ListChanges(ctx, repoInterfaces.ListFeatureFlagChangesInput{
+		Name:   request.Name,
+		Limit:  int(request.Limit),
+		Offset: offset,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list the changes to feature flags with err: %v", err)
+		return nil, err
+	}
+	changes := make([]*interfaces.FeatureFlagChange, len(changeModels))
+	for i, changeModel := range changeModels {
+		changes[i] = &interfaces.FeatureFlagChange{
+			Name:      changeModel.Name,
+			Project:   changeModel.Project,
+			Enabled:   changeModel.Enabled,
+			Principal: changeModel.Principal,
+			Reason:    changeModel.Reason,
+			ChangedAt: changeModel.CreatedAt,
+		}
+	}
+	var token string
+	if len(changes) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(changes))
+	}
+	return &interfaces.FeatureFlagChangeList{
+		Changes: changes,
+		Token:   token,
+	}, nil
+}
+
+func NewFeatureFlagManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration) interfaces.FeatureFlagInterface {
+	return &FeatureFlagManager{
+		db:     db,
+		config: config,
+	}
+}
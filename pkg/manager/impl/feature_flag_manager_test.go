@@ -0,0 +1,219 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const strictValidationFlag = "strict_validation"
+
+func getFeatureFlagTestConfig() runtimeInterfaces.ApplicationConfiguration {
+	applicationConfig := runtimeMocks.MockApplicationProvider{}
+	applicationConfig.SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		FeatureFlags: runtimeInterfaces.FeatureFlagsConfig{
+			Defaults: map[string]runtimeInterfaces.FeatureFlagDefault{
+				strictValidationFlag: {
+					Projects: []string{"pilot"},
+				},
+			},
+		},
+	})
+	return &applicationConfig
+}
+
+func getFeatureFlagTestContext() context.Context {
+	return auth.NewIdentityContext("", "jane", "", time.Now(), sets.NewString(), nil).WithContext(
+		context.Background())
+}
+
+func TestEvaluateFeatureFlag(t *testing.T) {
+	flagsConfig := getFeatureFlagTestConfig().GetTopLevelConfig().GetFeatureFlagsConfig()
+	value := evaluateFeatureFlag(flagsConfig, strictValidationFlag, "pilot", nil)
+	assert.True(t, value.Enabled)
+	assert.Equal(t, interfaces.FeatureFlagSourceConfig, value.Source)
+	assert.False(t, evaluateFeatureFlag(flagsConfig, strictValidationFlag, "project", nil).Enabled)
+	assert.False(t, evaluateFeatureFlag(flagsConfig, strictValidationFlag, "", nil).Enabled)
+
+	// Overrides of every project take precedence over config, and those of single projects over both.
+	overrides := []models.FeatureFlag{
+		{Name: strictValidationFlag, Enabled: false},
+		{Name: strictValidationFlag, Project: "project", Enabled: true},
+	}
+	value = evaluateFeatureFlag(flagsConfig, strictValidationFlag, "pilot", overrides)
+	assert.False(t, value.Enabled)
+	assert.Equal(t, interfaces.FeatureFlagSourceOverride, value.Source)
+	value = evaluateFeatureFlag(flagsConfig, strictValidationFlag, "project", overrides)
+	assert.True(t, value.Enabled)
+	assert.Equal(t, interfaces.FeatureFlagSourceProjectOverride, value.Source)
+}
+
+func TestSetFeatureFlag(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	featureFlagRepo := repository.FeatureFlagRepo().(*repositoryMocks.MockFeatureFlagRepo)
+	var overrides []models.FeatureFlag
+	featureFlagRepo.SetFunction = func(
+		ctx context.Context, input models.FeatureFlag, change models.FeatureFlagChange) error {
+		assert.Equal(t, "jane", input.Principal)
+		assert.True(t, *change.Enabled)
+		assert.Equal(t, "pilot of the new queueing path", change.Reason)
+		overrides = append(overrides, input)
+		return nil
+	}
+	featureFlagRepo.GetFunction = func(ctx context.Context, name string) ([]models.FeatureFlag, error) {
+		return overrides, nil
+	}
+	featureFlagManager := NewFeatureFlagManager(repository, getFeatureFlagTestConfig())
+
+	override, err := featureFlagManager.SetFeatureFlag(getFeatureFlagTestContext(), interfaces.SetFeatureFlagRequest{
+		Name:    interfaces.FeatureFlagAdmissionControl,
+		Project: "project",
+		Enabled: true,
+		Reason:  "pilot of the new queueing path",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "project", override.Project)
+	assert.True(t, override.Enabled)
+
+	value, err := featureFlagManager.GetFeatureFlagValue(
+		context.Background(), interfaces.FeatureFlagAdmissionControl, "project")
+	assert.NoError(t, err)
+	assert.True(t, value.Enabled)
+	assert.Equal(t, interfaces.FeatureFlagSourceProjectOverride, value.Source)
+}
+
+func TestSetFeatureFlag_UnknownFlag(t *testing.T) {
+	featureFlagManager := NewFeatureFlagManager(repositoryMocks.NewMockRepository(), getFeatureFlagTestConfig())
+	_, err := featureFlagManager.SetFeatureFlag(context.Background(), interfaces.SetFeatureFlagRequest{
+		Name:    "unknown",
+		Enabled: true,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestSetFeatureFlag_Visibility(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	featureFlagRepo := repository.FeatureFlagRepo().(*repositoryMocks.MockFeatureFlagRepo)
+	featureFlagRepo.SetFunction = func(
+		ctx context.Context, input models.FeatureFlag, change models.FeatureFlagChange) error {
+		t.Fatal("feature flags shouldn't be set by callers who can't see their projects")
+		return nil
+	}
+	featureFlagManager := NewFeatureFlagManager(repository, getFeatureFlagTestConfig())
+	ctx := auth.WithVisibleProjects(context.Background(), sets.NewString("project"))
+
+	_, err := featureFlagManager.SetFeatureFlag(ctx, interfaces.SetFeatureFlagRequest{
+		Name:    strictValidationFlag,
+		Project: "other",
+		Enabled: true,
+	})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+	_, err = featureFlagManager.SetFeatureFlag(ctx, interfaces.SetFeatureFlagRequest{
+		Name:    strictValidationFlag,
+		Enabled: true,
+	})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestClearFeatureFlag(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	var cleared bool
+	repository.FeatureFlagRepo().(*repositoryMocks.MockFeatureFlagRepo).ClearFunction = func(
+		ctx context.Context, name, project string, change models.FeatureFlagChange) error {
+		assert.Equal(t, "retired", name)
+		assert.Equal(t, "project", project)
+		assert.Nil(t, change.Enabled)
+		assert.Equal(t, "jane", change.Principal)
+		cleared = true
+		return nil
+	}
+	featureFlagManager := NewFeatureFlagManager(repository, getFeatureFlagTestConfig())
+
+	// Overrides of flags which are no longer known can be cleared.
+	assert.NoError(t, featureFlagManager.ClearFeatureFlag(getFeatureFlagTestContext(),
+		interfaces.ClearFeatureFlagRequest{
+			Name:    "retired",
+			Project: "project",
+		}))
+	assert.True(t, cleared)
+}
+
+func TestGetFeatureFlagValue_NotFound(t *testing.T) {
+	featureFlagManager := NewFeatureFlagManager(repositoryMocks.NewMockRepository(), getFeatureFlagTestConfig())
+	_, err := featureFlagManager.GetFeatureFlagValue(context.Background(), "unknown", "project")
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListFeatureFlags(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.FeatureFlagRepo().(*repositoryMocks.MockFeatureFlagRepo).ListAllFunction = func(
+		ctx context.Context) ([]models.FeatureFlag, error) {
+		return []models.FeatureFlag{
+			{Name: interfaces.FeatureFlagAdmissionControl, Enabled: true},
+			{Name: interfaces.FeatureFlagAdmissionControl, Project: "other", Enabled: false},
+			{Name: "retired", Project: "project", Enabled: true},
+		}, nil
+	}
+	featureFlagManager := NewFeatureFlagManager(repository, getFeatureFlagTestConfig())
+
+	flags, err := featureFlagManager.ListFeatureFlags(
+		auth.WithVisibleProjects(context.Background(), sets.NewString("project")))
+	assert.NoError(t, err)
+	assert.Len(t, flags, 4)
+	assert.Equal(t, interfaces.FeatureFlagAdmissionControl, flags[0].Name)
+	// Overrides of projects the caller can't see are left out.
+	assert.Len(t, flags[0].Overrides, 1)
+	assert.Equal(t, "", flags[0].Overrides[0].Project)
+	assert.Equal(t, interfaces.FeatureFlagRejectDeprecatedExecutions, flags[1].Name)
+	assert.Empty(t, flags[1].Overrides)
+	assert.Equal(t, "retired", flags[2].Name)
+	assert.Len(t, flags[2].Overrides, 1)
+	assert.Equal(t, strictValidationFlag, flags[3].Name)
+	assert.Equal(t, []string{"pilot"}, flags[3].Config.Projects)
+}
+
+func TestListFeatureFlagChanges(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	enabled := true
+	repository.FeatureFlagRepo().(*repositoryMocks.MockFeatureFlagRepo).ListChangesFunction = func(
+		ctx context.Context, input repoInterfaces.ListFeatureFlagChangesInput) ([]models.FeatureFlagChange, error) {
+		assert.Equal(t, repoInterfaces.ListFeatureFlagChangesInput{
+			Name:   strictValidationFlag,
+			Limit:  2,
+			Offset: 2,
+		}, input)
+		return []models.FeatureFlagChange{
+			{Name: strictValidationFlag, Project: "project", Principal: "jane"},
+			{Name: strictValidationFlag, Project: "project", Enabled: &enabled, Principal: "jane"},
+		}, nil
+	}
+	featureFlagManager := NewFeatureFlagManager(repository, getFeatureFlagTestConfig())
+	request := interfaces.FeatureFlagChangeListRequest{
+		Name:  strictValidationFlag,
+		Limit: 2,
+		Token: "2",
+	}
+
+	changes, err := featureFlagManager.ListFeatureFlagChanges(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, changes.Changes, 2)
+	assert.Nil(t, changes.Changes[0].Enabled)
+	assert.True(t, *changes.Changes[1].Enabled)
+	assert.Equal(t, "4", changes.Token)
+
+	_, err = featureFlagManager.ListFeatureFlagChanges(
+		auth.WithVisibleProjects(context.Background(), sets.NewString("project")), request)
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
@@ -30,11 +30,15 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
+// Enabling a launch plan is retried this many times when it races with another state transition for the same name.
+const maxEnableLaunchPlanAttempts = 3
+
 type launchPlanMetrics struct {
-	Scope                 promutils.Scope
-	FailedScheduleUpdates prometheus.Counter
-	SpecSizeBytes         prometheus.Summary
-	ClosureSizeBytes      prometheus.Summary
+	Scope                  promutils.Scope
+	FailedScheduleUpdates  prometheus.Counter
+	ConcurrentStateUpdates prometheus.Counter
+	SpecSizeBytes          prometheus.Summary
+	ClosureSizeBytes       prometheus.Summary
 }
 
 type LaunchPlanManager struct {
@@ -339,7 +343,15 @@ func (m *LaunchPlanManager) UpdateLaunchPlan(ctx context.Context, request admin.
 	case admin.LaunchPlanState_INACTIVE:
 		return m.disableLaunchPlan(ctx, request)
 	case admin.LaunchPlanState_ACTIVE:
-		return m.enableLaunchPlan(ctx, request)
+		for attempt := 1; ; attempt++ {
+			response, err := m.enableLaunchPlan(ctx, request)
+			if flyteAdminErr, ok := err.(errors.FlyteAdminError); !ok || attempt == maxEnableLaunchPlanAttempts ||
+				flyteAdminErr.Code() != codes.FailedPrecondition {
+				return response, err
+			}
+			m.metrics.ConcurrentStateUpdates.Inc()
+			logger.Infof(ctx, "Retrying enabling launch plan [%+v] after it was updated concurrently", request.Id)
+		}
 	default:
 		return nil, errors.NewFlyteAdminErrorf(
 			codes.InvalidArgument, "Unrecognized launch plan state %v for update for launch plan [%+v]",
@@ -408,6 +420,10 @@ func (m *LaunchPlanManager) ListLaunchPlans(ctx context.Context, request admin.R
 	if err != nil {
 		return nil, err
 	}
+	filters, err = util.AddProjectVisibilityFilter(ctx, common.LaunchPlan, filters)
+	if err != nil {
+		return nil, err
+	}
 
 	var sortParameter common.SortParameter
 	if request.SortBy != nil {
@@ -549,6 +565,52 @@ func (m *LaunchPlanManager) ListLaunchPlanIds(ctx context.Context, request admin
 	}, nil
 }
 
+func (m *LaunchPlanManager) DeleteLaunchPlan(ctx context.Context, id *core.Identifier) error {
+	if err := validation.ValidateIdentifier(id, common.LaunchPlan); err != nil {
+		logger.Debugf(ctx, "can't delete launch plan with invalid identifier [%+v]: %v", id, err)
+		return err
+	}
+	ctx = getLaunchPlanContext(ctx, id)
+	launchPlanModel, err := util.GetLaunchPlanModel(ctx, m.db, *id)
+	if err != nil {
+		logger.Debugf(ctx, "couldn't find launch plan [%+v] to delete with err: %v", id, err)
+		return err
+	}
+	// Deleting the active version would leave its schedule running without a launch plan to launch.
+	if launchPlanModel.State != nil && *launchPlanModel.State == int32(admin.LaunchPlanState_ACTIVE) {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"launch plan [%+v] is active and must be deactivated before it's deleted", id)
+	}
+	if err := m.db.LaunchPlanRepo().Delete(ctx, repoInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+		Version: id.Version,
+	}); err != nil {
+		logger.Debugf(ctx, "failed to delete launch plan [%+v] with err: %v", id, err)
+		return err
+	}
+	return nil
+}
+
+func (m *LaunchPlanManager) RestoreLaunchPlan(ctx context.Context, id *core.Identifier) error {
+	if err := validation.ValidateIdentifier(id, common.LaunchPlan); err != nil {
+		logger.Debugf(ctx, "can't restore launch plan with invalid identifier [%+v]: %v", id, err)
+		return err
+	}
+	ctx = getLaunchPlanContext(ctx, id)
+	if err := m.db.LaunchPlanRepo().Restore(ctx, repoInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+		Version: id.Version,
+	}); err != nil {
+		logger.Debugf(ctx, "failed to restore launch plan [%+v] with err: %v", id, err)
+		return err
+	}
+	return nil
+}
+
 func NewLaunchPlanManager(
 	db repositories.RepositoryInterface,
 	config runtimeInterfaces.Configuration,
@@ -559,6 +621,8 @@ func NewLaunchPlanManager(
 		Scope: scope,
 		FailedScheduleUpdates: scope.MustNewCounter("failed_schedule_updates",
 			"count of unsuccessful attempts to update the schedules when updating launch plan version"),
+		ConcurrentStateUpdates: scope.MustNewCounter("concurrent_state_updates",
+			"count of attempts to enable a launch plan version which raced with another state update and were retried"),
 		SpecSizeBytes:    scope.MustNewSummary("spec_size_bytes", "size in bytes of serialized launch plan spec"),
 		ClosureSizeBytes: scope.MustNewSummary("closure_size_bytes", "size in bytes of serialized launch plan closure"),
 	}
@@ -895,6 +895,78 @@ func TestDisableLaunchPlan_DatabaseError(t *testing.T) {
 		"Errors on setting the desired launch plan to inactive should propagate")
 }
 
+func TestDeleteLaunchPlan(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	lpGetFunc := func(input interfaces.Identifier) (models.LaunchPlan, error) {
+		return models.LaunchPlan{
+			LaunchPlanKey: models.LaunchPlanKey{
+				Project: input.Project,
+				Domain:  input.Domain,
+				Name:    input.Name,
+				Version: input.Version,
+			},
+			State: &inactive,
+		}, nil
+	}
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(lpGetFunc)
+	var deleted bool
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).DeleteFunction = func(
+		input interfaces.Identifier) error {
+		assert.Equal(t, project, input.Project)
+		assert.Equal(t, domain, input.Domain)
+		assert.Equal(t, name, input.Name)
+		assert.Equal(t, version, input.Version)
+		deleted = true
+		return nil
+	}
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	err := lpManager.DeleteLaunchPlan(context.Background(), &launchPlanIdentifier)
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+}
+
+func TestDeleteLaunchPlan_Active(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	lpGetFunc := func(input interfaces.Identifier) (models.LaunchPlan, error) {
+		return models.LaunchPlan{
+			LaunchPlanKey: models.LaunchPlanKey{
+				Project: input.Project,
+				Domain:  input.Domain,
+				Name:    input.Name,
+				Version: input.Version,
+			},
+			State: &active,
+		}, nil
+	}
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(lpGetFunc)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).DeleteFunction = func(
+		input interfaces.Identifier) error {
+		t.Fatal("active launch plans should not be deleted")
+		return nil
+	}
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	err := lpManager.DeleteLaunchPlan(context.Background(), &launchPlanIdentifier)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestRestoreLaunchPlan(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	var restored bool
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).RestoreFunction = func(
+		input interfaces.Identifier) error {
+		assert.Equal(t, project, input.Project)
+		assert.Equal(t, domain, input.Domain)
+		assert.Equal(t, name, input.Name)
+		assert.Equal(t, version, input.Version)
+		restored = true
+		return nil
+	}
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	err := lpManager.RestoreLaunchPlan(context.Background(), &launchPlanIdentifier)
+	assert.NoError(t, err)
+	assert.True(t, restored)
+}
+
 func TestEnableLaunchPlan(t *testing.T) {
 	repository := getMockRepositoryForLpTest()
 
@@ -980,6 +1052,61 @@ func TestEnableLaunchPlan_NoCurrentlyActiveVersion(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestEnableLaunchPlan_ConcurrentUpdate(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+
+	lpGetFunc := makeLaunchPlanRepoGetCallback(t)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(lpGetFunc)
+	listFunc := func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+		return interfaces.LaunchPlanCollectionOutput{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "foo")
+	}
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(listFunc)
+
+	attempts := 0
+	enableFunc := func(toEnable models.LaunchPlan, toDisable *models.LaunchPlan) error {
+		attempts++
+		if attempts == 1 {
+			return flyteAdminErrors.NewFlyteAdminErrorf(codes.FailedPrecondition, "updated concurrently")
+		}
+		return nil
+	}
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetSetActiveCallback(enableFunc)
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.UpdateLaunchPlan(context.Background(), admin.LaunchPlanUpdateRequest{
+		Id:    &launchPlanIdentifier,
+		State: admin.LaunchPlanState_ACTIVE,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestEnableLaunchPlan_ConcurrentUpdateRetriesExhausted(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+
+	lpGetFunc := makeLaunchPlanRepoGetCallback(t)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(lpGetFunc)
+	listFunc := func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+		return interfaces.LaunchPlanCollectionOutput{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "foo")
+	}
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(listFunc)
+
+	attempts := 0
+	enableFunc := func(toEnable models.LaunchPlan, toDisable *models.LaunchPlan) error {
+		attempts++
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.FailedPrecondition, "updated concurrently")
+	}
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetSetActiveCallback(enableFunc)
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.UpdateLaunchPlan(context.Background(), admin.LaunchPlanUpdateRequest{
+		Id:    &launchPlanIdentifier,
+		State: admin.LaunchPlanState_ACTIVE,
+	})
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Equal(t, maxEnableLaunchPlanAttempts, attempts)
+}
+
 func TestEnableLaunchPlan_DatabaseError(t *testing.T) {
 	repository := getMockRepositoryForLpTest()
 	expectedError := errors.New("expected error")
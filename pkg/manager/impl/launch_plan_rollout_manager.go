@@ -0,0 +1,387 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// The number of most recently registered versions of a launch plan a rollout picks from.
+	rolloutCandidateVersions = 100
+	// The number of rollout policies advanced at a time.
+	advanceRolloutsBatchSize = 100
+)
+
+type launchPlanRolloutMetrics struct {
+	Scope             promutils.Scope
+	VersionsRolledOut prometheus.Counter
+	Rollbacks         prometheus.Counter
+	AdvanceFailures   prometheus.Counter
+}
+
+type LaunchPlanRolloutManager struct {
+	db                repositories.RepositoryInterface
+	config            runtimeInterfaces.Configuration
+	launchPlanManager interfaces.LaunchPlanInterface
+	metrics           launchPlanRolloutMetrics
+}
+
+func splitRejectedVersions(rejectedVersions string) []string {
+	if len(rejectedVersions) == 0 {
+		return []string{}
+	}
+	return strings.Split(rejectedVersions, ",")
+}
+
+func toRolloutPolicy(rolloutModel models.LaunchPlanRollout) *interfaces.RolloutPolicy {
+	return &interfaces.RolloutPolicy{
+		Id: &admin.NamedEntityIdentifier{
+			Project: rolloutModel.Project,
+			Domain:  rolloutModel.Domain,
+			Name:    rolloutModel.Name,
+		},
+		Strategy:          rolloutModel.Strategy,
+		Label:             rolloutModel.Label,
+		VersionConstraint: rolloutModel.VersionConstraint,
+		SoakPeriod:        time.Duration(rolloutModel.SoakPeriodSeconds) * time.Second,
+		RolledOutVersion:  rolloutModel.RolledOutVersion,
+		PreviousVersion:   rolloutModel.PreviousVersion,
+		RolledOutAt:       rolloutModel.RolledOutAt,
+		RejectedVersions:  splitRejectedVersions(rolloutModel.RejectedVersions),
+		Principal:         rolloutModel.Principal,
+		CreatedAt:         rolloutModel.CreatedAt,
+		UpdatedAt:         rolloutModel.UpdatedAt,
+	}
+}
+
+func getRolloutIdentifier(id admin.NamedEntityIdentifier) repoInterfaces.Identifier {
+	return repoInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	}
+}
+
+// Returns whether launch plan labels have a rollout's label, which is either a key or a key=value pair.
+func hasRolloutLabel(labels map[string]string, label string) bool {
+	parts := strings.SplitN(label, "=", 2)
+	value, ok := labels[parts[0]]
+	return ok && (len(parts) == 1 || value == parts[1])
+}
+
+// Picks the version a rollout activates from the versions of its launch plan, most recently registered first. Only
+// versions which have soaked and weren't rolled back are picked. Returns an empty version when there are none.
+func pickRolloutVersion(rolloutModel models.LaunchPlanRollout, launchPlans []models.LaunchPlan, now time.Time) (
+	string, error) {
+	rejected := sets.NewString(splitRejectedVersions(rolloutModel.RejectedVersions)...)
+	soakPeriod := time.Duration(rolloutModel.SoakPeriodSeconds) * time.Second
+	var constraint common.VersionConstraint
+	if rolloutModel.Strategy == models.RolloutStrategySemver {
+		var err error
+		if constraint, err = common.ParseVersionConstraint(rolloutModel.VersionConstraint); err != nil {
+			return "", err
+		}
+	}
+	var picked string
+	var pickedSemanticVersion common.SemanticVersion
+	for _, launchPlan := range launchPlans {
+		if rejected.Has(launchPlan.Version) || now.Sub(launchPlan.CreatedAt) < soakPeriod {
+			continue
+		}
+		switch rolloutModel.Strategy {
+		case models.RolloutStrategyLabel:
+			var spec admin.LaunchPlanSpec
+			if err := proto.Unmarshal(launchPlan.Spec, &spec); err != nil {
+				return "", errors.NewFlyteAdminErrorf(codes.Internal,
+					"failed to unmarshal the spec of launch plan version [%s]: %v", launchPlan.Version, err)
+			}
+			if hasRolloutLabel(spec.GetLabels().GetValues(), rolloutModel.Label) {
+				return launchPlan.Version, nil
+			}
+		case models.RolloutStrategySemver:
+			// Versions which aren't semantic versions, such as commit hashes, are never picked.
+			semanticVersion, err := common.ParseSemanticVersion(launchPlan.Version)
+			if err != nil || !constraint.Matches(semanticVersion) {
+				continue
+			}
+			if len(picked) == 0 || semanticVersion.Compare(pickedSemanticVersion) > 0 {
+				picked = launchPlan.Version
+				pickedSemanticVersion = semanticVersion
+			}
+		}
+	}
+	return picked, nil
+}
+
+// Returns the active version of a launch plan, or an empty version when none is active.
+func (m *LaunchPlanRolloutManager) getActiveVersion(ctx context.Context, id admin.NamedEntityIdentifier) (
+	string, error) {
+	launchPlan, err := m.launchPlanManager.GetActiveLaunchPlan(ctx, admin.ActiveLaunchPlanRequest{
+		Id: &id,
+	})
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	return launchPlan.GetId().GetVersion(), nil
+}
+
+func (m *LaunchPlanRolloutManager) activateVersion(
+	ctx context.Context, id admin.NamedEntityIdentifier, version string) (*core.Identifier, error) {
+	launchPlanID := &core.Identifier{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		Project:      id.Project,
+		Domain:       id.Domain,
+		Name:         id.Name,
+		Version:      version,
+	}
+	_, err := m.launchPlanManager.UpdateLaunchPlan(ctx, admin.LaunchPlanUpdateRequest{
+		Id:    launchPlanID,
+		State: admin.LaunchPlanState_ACTIVE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return launchPlanID, nil
+}
+
+func (m *LaunchPlanRolloutManager) SetRolloutPolicy(
+	ctx context.Context, request interfaces.SetRolloutPolicyRequest) (*interfaces.RolloutPolicy, error) {
+	if err := validation.ValidateSetRolloutPolicyRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid set rollout policy request [%+v]: %v", request.Id, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Id.Project, request.Id.Domain)
+	if err := validation.ValidateProjectAndDomain(ctx, m.db, m.config.ApplicationConfiguration(),
+		request.Id.Project, request.Id.Domain); err != nil {
+		return nil, err
+	}
+	rolloutModel, err := m.db.LaunchPlanRolloutRepo().Get(ctx, getRolloutIdentifier(request.Id))
+	exists := err == nil
+	if flyteAdminErr, ok := err.(errors.FlyteAdminError); err != nil && (!ok || flyteAdminErr.Code() != codes.NotFound) {
+		return nil, err
+	}
+	rolloutModel.LaunchPlanRolloutKey = models.LaunchPlanRolloutKey{
+		Project: request.Id.Project,
+		Domain:  request.Id.Domain,
+		Name:    request.Id.Name,
+	}
+	rolloutModel.Strategy = request.Strategy
+	rolloutModel.Label = ""
+	rolloutModel.VersionConstraint = ""
+	if request.Strategy == models.RolloutStrategyLabel {
+		rolloutModel.Label = request.Label
+	} else {
+		rolloutModel.VersionConstraint = request.VersionConstraint
+	}
+	rolloutModel.SoakPeriodSeconds = uint32(request.SoakPeriod / time.Second)
+	rolloutModel.Principal = getUser(ctx)
+	if exists {
+		err = m.db.LaunchPlanRolloutRepo().Update(ctx, rolloutModel)
+	} else {
+		err = m.db.LaunchPlanRolloutRepo().Create(ctx, rolloutModel)
+	}
+	if err != nil {
+		logger.Debugf(ctx, "failed to set the rollout policy of launch plan [%+v] with err: %v", request.Id, err)
+		return nil, err
+	}
+	rolloutModel, err = m.db.LaunchPlanRolloutRepo().Get(ctx, getRolloutIdentifier(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	return toRolloutPolicy(rolloutModel), nil
+}
+
+func (m *LaunchPlanRolloutManager) GetRolloutPolicy(
+	ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.RolloutPolicy, error) {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	rolloutModel, err := m.db.LaunchPlanRolloutRepo().Get(ctx, getRolloutIdentifier(id))
+	if err != nil {
+		return nil, err
+	}
+	return toRolloutPolicy(rolloutModel), nil
+}
+
+func (m *LaunchPlanRolloutManager) DeleteRolloutPolicy(ctx context.Context, id admin.NamedEntityIdentifier) error {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	return m.db.LaunchPlanRolloutRepo().Delete(ctx, getRolloutIdentifier(id))
+}
+
+func (m *LaunchPlanRolloutManager) RollbackLaunchPlan(
+	ctx context.Context, id admin.NamedEntityIdentifier) (*core.Identifier, error) {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	rolloutModel, err := m.db.LaunchPlanRolloutRepo().Get(ctx, getRolloutIdentifier(id))
+	if err != nil {
+		return nil, err
+	}
+	// Versions rolled out when no other version was active, or when they were already active, have nothing to roll
+	// back to. They can be deactivated by hand instead.
+	if len(rolloutModel.RolledOutVersion) == 0 || len(rolloutModel.PreviousVersion) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"launch plan [%s] has no rolled out version to roll back", id.Name)
+	}
+	launchPlanID, err := m.activateVersion(ctx, id, rolloutModel.PreviousVersion)
+	if err != nil {
+		logger.Debugf(ctx, "failed to roll back launch plan [%+v] to version [%s] with err: %v", id,
+			rolloutModel.PreviousVersion, err)
+		return nil, err
+	}
+	rejectedVersions := append(splitRejectedVersions(rolloutModel.RejectedVersions), rolloutModel.RolledOutVersion)
+	rolledBackVersion := rolloutModel.RolledOutVersion
+	now := time.Now()
+	rolloutModel.RejectedVersions = strings.Join(rejectedVersions, ",")
+	rolloutModel.RolledOutVersion = rolloutModel.PreviousVersion
+	rolloutModel.PreviousVersion = ""
+	rolloutModel.RolledOutAt = &now
+	if err := m.db.LaunchPlanRolloutRepo().Update(ctx, rolloutModel); err != nil {
+		return nil, err
+	}
+	m.metrics.Rollbacks.Inc()
+	logger.Infof(ctx, "Rolled back launch plan [%+v] from version [%s] to [%s]", id, rolledBackVersion,
+		rolloutModel.RolledOutVersion)
+	return launchPlanID, nil
+}
+
+// Activates the version a rollout picks, unless it's the version the rollout last activated. Versions activated by
+// hand are left active until the rollout picks a new version.
+func (m *LaunchPlanRolloutManager) advanceRollout(ctx context.Context, rolloutModel models.LaunchPlanRollout) error {
+	ctx = contextutils.WithProjectDomain(ctx, rolloutModel.Project, rolloutModel.Domain)
+	id := admin.NamedEntityIdentifier{
+		Project: rolloutModel.Project,
+		Domain:  rolloutModel.Domain,
+		Name:    rolloutModel.Name,
+	}
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	}, common.LaunchPlan)
+	if err != nil {
+		return err
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       "created_at",
+		Direction: admin.Sort_DESCENDING,
+	})
+	if err != nil {
+		return err
+	}
+	output, err := m.db.LaunchPlanRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         rolloutCandidateVersions,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	version, err := pickRolloutVersion(rolloutModel, output.LaunchPlans, now)
+	if err != nil || len(version) == 0 || version == rolloutModel.RolledOutVersion {
+		return err
+	}
+	activeVersion, err := m.getActiveVersion(ctx, id)
+	if err != nil {
+		return err
+	}
+	rolloutModel.PreviousVersion = ""
+	if version != activeVersion {
+		// Versions are activated on behalf of the user who set the rollout policy.
+		identity := auth.NewIdentityContext("", rolloutModel.Principal, "", now, sets.NewString(), nil)
+		if _, err := m.activateVersion(identity.WithContext(ctx), id, version); err != nil {
+			return err
+		}
+		rolloutModel.PreviousVersion = activeVersion
+	}
+	rolloutModel.RolledOutVersion = version
+	rolloutModel.RolledOutAt = &now
+	if err := m.db.LaunchPlanRolloutRepo().Update(ctx, rolloutModel); err != nil {
+		return err
+	}
+	m.metrics.VersionsRolledOut.Inc()
+	logger.Infof(ctx, "Rolled out version [%s] of launch plan [%+v], replacing [%s]", version, id, activeVersion)
+	return nil
+}
+
+func (m *LaunchPlanRolloutManager) AdvanceRollouts(ctx context.Context) error {
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       "created_at",
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return err
+	}
+	for offset := 0; ; offset += advanceRolloutsBatchSize {
+		rolloutModels, err := m.db.LaunchPlanRolloutRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         advanceRolloutsBatchSize,
+			Offset:        offset,
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return err
+		}
+		for _, rolloutModel := range rolloutModels {
+			if err := m.advanceRollout(ctx, rolloutModel); err != nil {
+				m.metrics.AdvanceFailures.Inc()
+				logger.Warningf(ctx, "failed to advance the rollout of launch plan [%s/%s/%s] with err: %v",
+					rolloutModel.Project, rolloutModel.Domain, rolloutModel.Name, err)
+			}
+		}
+		if len(rolloutModels) < advanceRolloutsBatchSize {
+			return nil
+		}
+	}
+}
+
+func NewLaunchPlanRolloutManager(
+	db repositories.RepositoryInterface,
+	config runtimeInterfaces.Configuration,
+	launchPlanManager interfaces.LaunchPlanInterface,
+	scope promutils.Scope) interfaces.LaunchPlanRolloutInterface {
+	metrics := launchPlanRolloutMetrics{
+		Scope: scope,
+		VersionsRolledOut: scope.MustNewCounter("versions_rolled_out",
+			"overall count of launch plan versions activated by rollout policies"),
+		Rollbacks: scope.MustNewCounter("rollbacks",
+			"overall count of launch plan rollouts rolled back"),
+		AdvanceFailures: scope.MustNewCounter("advance_failures",
+			"overall count of failures checking rollout policies for versions to activate, which are retried"),
+	}
+	return &LaunchPlanRolloutManager{
+		db:                db,
+		config:            config,
+		launchPlanManager: launchPlanManager,
+		metrics:           metrics,
+	}
+}
@@ -0,0 +1,222 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	managerMocks "github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+var rolloutID = admin.NamedEntityIdentifier{
+	Project: "project",
+	Domain:  "development",
+	Name:    "nightly",
+}
+
+func getRolloutLaunchPlan(t *testing.T, version string, age time.Duration, labels map[string]string) models.LaunchPlan {
+	spec, err := proto.Marshal(&admin.LaunchPlanSpec{
+		Labels: &admin.Labels{
+			Values: labels,
+		},
+	})
+	assert.NoError(t, err)
+	launchPlan := models.LaunchPlan{
+		LaunchPlanKey: models.LaunchPlanKey{
+			Project: rolloutID.Project,
+			Domain:  rolloutID.Domain,
+			Name:    rolloutID.Name,
+			Version: version,
+		},
+		Spec: spec,
+	}
+	launchPlan.CreatedAt = time.Now().Add(-age)
+	return launchPlan
+}
+
+func getRolloutModel(strategy string) models.LaunchPlanRollout {
+	return models.LaunchPlanRollout{
+		LaunchPlanRolloutKey: models.LaunchPlanRolloutKey{
+			Project: rolloutID.Project,
+			Domain:  rolloutID.Domain,
+			Name:    rolloutID.Name,
+		},
+		Strategy:          strategy,
+		Label:             "release=stable",
+		VersionConstraint: "^1.2",
+		SoakPeriodSeconds: 3600,
+		Principal:         "user",
+	}
+}
+
+func TestPickRolloutVersion_Label(t *testing.T) {
+	// Most recently registered first.
+	launchPlans := []models.LaunchPlan{
+		getRolloutLaunchPlan(t, "c", time.Minute, map[string]string{"release": "stable"}),
+		getRolloutLaunchPlan(t, "b", 2*time.Hour, map[string]string{"release": "canary"}),
+		getRolloutLaunchPlan(t, "a", 3*time.Hour, map[string]string{"release": "stable"}),
+	}
+	rolloutModel := getRolloutModel(models.RolloutStrategyLabel)
+	version, err := pickRolloutVersion(rolloutModel, launchPlans, time.Now())
+	assert.NoError(t, err)
+	// c hasn't soaked yet.
+	assert.Equal(t, "a", version)
+
+	rolloutModel.Label = "release"
+	version, err = pickRolloutVersion(rolloutModel, launchPlans, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, "b", version)
+
+	rolloutModel.RejectedVersions = "b,a"
+	version, err = pickRolloutVersion(rolloutModel, launchPlans, time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, version)
+}
+
+func TestPickRolloutVersion_Semver(t *testing.T) {
+	launchPlans := []models.LaunchPlan{
+		getRolloutLaunchPlan(t, "9f3e2c1", 2*time.Hour, nil),
+		getRolloutLaunchPlan(t, "v1.2.0", 2*time.Hour, nil),
+		getRolloutLaunchPlan(t, "v2.0.0", 2*time.Hour, nil),
+		getRolloutLaunchPlan(t, "v1.4.0-rc.1", 2*time.Hour, nil),
+		getRolloutLaunchPlan(t, "v1.3.1", 2*time.Hour, nil),
+		getRolloutLaunchPlan(t, "v1.3.0", 2*time.Hour, nil),
+	}
+	rolloutModel := getRolloutModel(models.RolloutStrategySemver)
+	version, err := pickRolloutVersion(rolloutModel, launchPlans, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.3.1", version)
+
+	rolloutModel.RejectedVersions = "v1.3.1"
+	version, err = pickRolloutVersion(rolloutModel, launchPlans, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.3.0", version)
+}
+
+func TestAdvanceRollouts(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	rolloutRepo := repository.LaunchPlanRolloutRepo().(*repositoryMocks.LaunchPlanRolloutRepoInterface)
+	rolloutRepo.OnListMatch(mock.Anything, mock.Anything).Return(
+		[]models.LaunchPlanRollout{getRolloutModel(models.RolloutStrategySemver)}, nil)
+	var updated models.LaunchPlanRollout
+	rolloutRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.LaunchPlanRollout)
+	})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			assert.Equal(t, rolloutCandidateVersions, input.Limit)
+			return interfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: []models.LaunchPlan{
+					getRolloutLaunchPlan(t, "v1.3.0", 2*time.Hour, nil),
+					getRolloutLaunchPlan(t, "v1.2.0", 3*time.Hour, nil),
+				},
+			}, nil
+		})
+	launchPlanManager := managerMocks.MockLaunchPlanManager{}
+	launchPlanManager.SetGetActiveLaunchPlanCallback(func(
+		ctx context.Context, request admin.ActiveLaunchPlanRequest) (*admin.LaunchPlan, error) {
+		return &admin.LaunchPlan{
+			Id: &core.Identifier{Version: "v1.2.0"},
+		}, nil
+	})
+	var activated *core.Identifier
+	launchPlanManager.SetUpdateLaunchPlan(func(ctx context.Context, request admin.LaunchPlanUpdateRequest) (
+		*admin.LaunchPlanUpdateResponse, error) {
+		assert.Equal(t, admin.LaunchPlanState_ACTIVE, request.State)
+		activated = request.Id
+		return &admin.LaunchPlanUpdateResponse{}, nil
+	})
+	rolloutManager := NewLaunchPlanRolloutManager(repository, getMockExecutionsConfigProvider(), &launchPlanManager,
+		mockScope.NewTestScope())
+
+	assert.NoError(t, rolloutManager.AdvanceRollouts(context.Background()))
+	assert.Equal(t, "nightly", activated.Name)
+	assert.Equal(t, "v1.3.0", activated.Version)
+	assert.Equal(t, "v1.3.0", updated.RolledOutVersion)
+	assert.Equal(t, "v1.2.0", updated.PreviousVersion)
+	assert.NotNil(t, updated.RolledOutAt)
+}
+
+func TestAdvanceRollouts_AlreadyRolledOut(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	rolloutModel := getRolloutModel(models.RolloutStrategySemver)
+	rolloutModel.RolledOutVersion = "v1.3.0"
+	rolloutRepo := repository.LaunchPlanRolloutRepo().(*repositoryMocks.LaunchPlanRolloutRepoInterface)
+	rolloutRepo.OnListMatch(mock.Anything, mock.Anything).Return([]models.LaunchPlanRollout{rolloutModel}, nil)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input interfaces.ListResourceInput) (interfaces.LaunchPlanCollectionOutput, error) {
+			return interfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: []models.LaunchPlan{
+					getRolloutLaunchPlan(t, "v1.3.0", 2*time.Hour, nil),
+				},
+			}, nil
+		})
+	launchPlanManager := managerMocks.MockLaunchPlanManager{}
+	launchPlanManager.SetUpdateLaunchPlan(func(ctx context.Context, request admin.LaunchPlanUpdateRequest) (
+		*admin.LaunchPlanUpdateResponse, error) {
+		assert.Fail(t, "a version which was already rolled out was activated again")
+		return nil, nil
+	})
+	rolloutManager := NewLaunchPlanRolloutManager(repository, getMockExecutionsConfigProvider(), &launchPlanManager,
+		mockScope.NewTestScope())
+
+	assert.NoError(t, rolloutManager.AdvanceRollouts(context.Background()))
+	rolloutRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestRollbackLaunchPlan(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	rolloutModel := getRolloutModel(models.RolloutStrategySemver)
+	rolloutModel.RolledOutVersion = "v1.3.0"
+	rolloutModel.PreviousVersion = "v1.2.0"
+	rolloutModel.RejectedVersions = "v1.2.5"
+	rolloutRepo := repository.LaunchPlanRolloutRepo().(*repositoryMocks.LaunchPlanRolloutRepoInterface)
+	rolloutRepo.OnGetMatch(mock.Anything, mock.Anything).Return(rolloutModel, nil)
+	var updated models.LaunchPlanRollout
+	rolloutRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.LaunchPlanRollout)
+	})
+	launchPlanManager := managerMocks.MockLaunchPlanManager{}
+	var activated string
+	launchPlanManager.SetUpdateLaunchPlan(func(ctx context.Context, request admin.LaunchPlanUpdateRequest) (
+		*admin.LaunchPlanUpdateResponse, error) {
+		activated = request.Id.Version
+		return &admin.LaunchPlanUpdateResponse{}, nil
+	})
+	rolloutManager := NewLaunchPlanRolloutManager(repository, getMockExecutionsConfigProvider(), &launchPlanManager,
+		mockScope.NewTestScope())
+
+	launchPlanID, err := rolloutManager.RollbackLaunchPlan(context.Background(), rolloutID)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.2.0", launchPlanID.Version)
+	assert.Equal(t, "v1.2.0", activated)
+	assert.Equal(t, "v1.2.0", updated.RolledOutVersion)
+	assert.Empty(t, updated.PreviousVersion)
+	assert.Equal(t, "v1.2.5,v1.3.0", updated.RejectedVersions)
+
+}
+
+func TestRollbackLaunchPlan_NothingToRollBack(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	rolloutModel := getRolloutModel(models.RolloutStrategySemver)
+	// The version rolled out was already active.
+	rolloutModel.RolledOutVersion = "v1.3.0"
+	repository.LaunchPlanRolloutRepo().(*repositoryMocks.LaunchPlanRolloutRepoInterface).OnGetMatch(
+		mock.Anything, mock.Anything).Return(rolloutModel, nil)
+	rolloutManager := NewLaunchPlanRolloutManager(repository, getMockExecutionsConfigProvider(),
+		&managerMocks.MockLaunchPlanManager{}, mockScope.NewTestScope())
+
+	_, err := rolloutManager.RollbackLaunchPlan(context.Background(), rolloutID)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
@@ -0,0 +1,269 @@
+package impl
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+// The phases of the executions counted in launch plan stats.
+var launchPlanStatsPhases = []string{
+	core.WorkflowExecution_SUCCEEDED.String(),
+	core.WorkflowExecution_FAILED.String(),
+	core.WorkflowExecution_TIMED_OUT.String(),
+}
+
+// The duration percentiles exported, labelled by their quantile.
+var launchPlanStatsQuantiles = []struct {
+	label    string
+	duration func(stats interfaces.LaunchPlanWindowStats) time.Duration
+}{
+	{"0.5", func(stats interfaces.LaunchPlanWindowStats) time.Duration { return stats.DurationP50 }},
+	{"0.9", func(stats interfaces.LaunchPlanWindowStats) time.Duration { return stats.DurationP90 }},
+	{"0.99", func(stats interfaces.LaunchPlanWindowStats) time.Duration { return stats.DurationP99 }},
+}
+
+type launchPlanKey struct {
+	project string
+	domain  string
+	name    string
+}
+
+type launchPlanStatsMetrics struct {
+	Scope       promutils.Scope
+	Executions  *prometheus.GaugeVec
+	SuccessRate *prometheus.GaugeVec
+	BurnRate    *prometheus.GaugeVec
+	Duration    *prometheus.GaugeVec
+}
+
+type LaunchPlanStatsManager struct {
+	db      repositories.RepositoryInterface
+	config  runtimeInterfaces.Configuration
+	metrics launchPlanStatsMetrics
+	_clock  clock.Clock
+
+	lock       sync.RWMutex
+	stats      map[launchPlanKey]*interfaces.LaunchPlanStats
+	computedAt time.Time
+}
+
+// Formats a window as the shortest duration string, e.g. 24h rather than 24h0m0s, for labelling gauges.
+func formatLaunchPlanStatsWindow(window time.Duration) string {
+	formatted := window.String()
+	if strings.HasSuffix(formatted, "m0s") {
+		formatted = strings.TrimSuffix(formatted, "0s")
+	}
+	if strings.HasSuffix(formatted, "h0m") {
+		formatted = strings.TrimSuffix(formatted, "0m")
+	}
+	return formatted
+}
+
+// Returns the nearest-rank percentile of sorted durations.
+func getDurationPercentile(sorted []time.Duration, quantile float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(quantile * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// Computes the stats of the outcomes which terminated within window of now.
+func computeLaunchPlanWindowStats(outcomes []models.LaunchPlanExecutionOutcome, window time.Duration, now time.Time,
+	successRateObjective float64) interfaces.LaunchPlanWindowStats {
+	stats := interfaces.LaunchPlanWindowStats{
+		Window: window,
+	}
+	var durations []time.Duration
+	since := now.Add(-window)
+	for _, outcome := range outcomes {
+		if outcome.ExecutionUpdatedAt.Before(since) {
+			continue
+		}
+		stats.Executions++
+		if outcome.Phase == core.WorkflowExecution_SUCCEEDED.String() {
+			stats.Succeeded++
+			durations = append(durations, outcome.Duration)
+		}
+	}
+	if stats.Executions == 0 {
+		return stats
+	}
+	stats.SuccessRate = float64(stats.Succeeded) / float64(stats.Executions)
+	if successRateObjective > 0 && successRateObjective < 1 {
+		stats.BurnRate = (1 - stats.SuccessRate) / (1 - successRateObjective)
+	}
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] < durations[j]
+	})
+	stats.DurationP50 = getDurationPercentile(durations, 0.5)
+	stats.DurationP90 = getDurationPercentile(durations, 0.9)
+	stats.DurationP99 = getDurationPercentile(durations, 0.99)
+	return stats
+}
+
+func (m *LaunchPlanStatsManager) computeLaunchPlanStats(key launchPlanKey, outcomes []models.LaunchPlanExecutionOutcome,
+	cfg runtimeInterfaces.LaunchPlanStatsConfig, now time.Time) *interfaces.LaunchPlanStats {
+	stats := &interfaces.LaunchPlanStats{
+		Id: &admin.NamedEntityIdentifier{
+			Project: key.project,
+			Domain:  key.domain,
+			Name:    key.name,
+		},
+		ComputedAt: now,
+		Windows:    make([]interfaces.LaunchPlanWindowStats, len(cfg.Windows)),
+	}
+	if objective, ok := cfg.GetObjective(key.project, key.domain, key.name); ok {
+		stats.SuccessRateObjective = objective.SuccessRate
+	}
+	for idx, window := range cfg.Windows {
+		stats.Windows[idx] = computeLaunchPlanWindowStats(outcomes, window.Duration, now, stats.SuccessRateObjective)
+	}
+	return stats
+}
+
+// Replaces the gauges of every launch plan with those of the launch plans which executed within the windows.
+func (m *LaunchPlanStatsManager) exportStats(stats map[launchPlanKey]*interfaces.LaunchPlanStats) {
+	m.metrics.Executions.Reset()
+	m.metrics.SuccessRate.Reset()
+	m.metrics.BurnRate.Reset()
+	m.metrics.Duration.Reset()
+	for key, launchPlanStats := range stats {
+		for _, windowStats := range launchPlanStats.Windows {
+			if windowStats.Executions == 0 {
+				continue
+			}
+			labels := prometheus.Labels{
+				"project":     key.project,
+				"domain":      key.domain,
+				"launch_plan": key.name,
+				"window":      formatLaunchPlanStatsWindow(windowStats.Window),
+			}
+			m.metrics.Executions.With(labels).Set(float64(windowStats.Executions))
+			m.metrics.SuccessRate.With(labels).Set(windowStats.SuccessRate)
+			if launchPlanStats.SuccessRateObjective > 0 {
+				m.metrics.BurnRate.With(labels).Set(windowStats.BurnRate)
+			}
+			if windowStats.Succeeded == 0 {
+				continue
+			}
+			for _, quantile := range launchPlanStatsQuantiles {
+				labels["quantile"] = quantile.label
+				m.metrics.Duration.With(labels).Set(quantile.duration(windowStats).Seconds())
+			}
+		}
+	}
+}
+
+func (m *LaunchPlanStatsManager) ComputeStats(ctx context.Context) error {
+	cfg := m.config.ApplicationConfiguration().GetTopLevelConfig().GetLaunchPlanStatsConfig()
+	var longestWindow time.Duration
+	for _, window := range cfg.Windows {
+		if window.Duration > longestWindow {
+			longestWindow = window.Duration
+		}
+	}
+	if longestWindow <= 0 {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "no launch plan stats windows are configured")
+	}
+	now := m._clock.Now()
+	outcomes, err := m.db.ExecutionRepo().ListLaunchPlanOutcomes(ctx, repoInterfaces.ListLaunchPlanOutcomesInput{
+		Phases:       launchPlanStatsPhases,
+		UpdatedSince: now.Add(-longestWindow),
+	})
+	if err != nil {
+		return err
+	}
+	outcomesByLaunchPlan := make(map[launchPlanKey][]models.LaunchPlanExecutionOutcome)
+	for _, outcome := range outcomes {
+		key := launchPlanKey{
+			project: outcome.LaunchPlanProject,
+			domain:  outcome.LaunchPlanDomain,
+			name:    outcome.LaunchPlanName,
+		}
+		outcomesByLaunchPlan[key] = append(outcomesByLaunchPlan[key], outcome)
+	}
+	stats := make(map[launchPlanKey]*interfaces.LaunchPlanStats, len(outcomesByLaunchPlan))
+	for key, launchPlanOutcomes := range outcomesByLaunchPlan {
+		stats[key] = m.computeLaunchPlanStats(key, launchPlanOutcomes, cfg, now)
+	}
+	m.exportStats(stats)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.stats = stats
+	m.computedAt = now
+	return nil
+}
+
+func (m *LaunchPlanStatsManager) GetLaunchPlanStats(
+	ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.LaunchPlanStats, error) {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	cfg := m.config.ApplicationConfiguration().GetTopLevelConfig().GetLaunchPlanStatsConfig()
+	if !cfg.Enabled {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "launch plan stats aren't enabled")
+	}
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if m.computedAt.IsZero() {
+		return nil, errors.NewFlyteAdminErrorf(codes.Unavailable, "launch plan stats haven't been computed yet")
+	}
+	key := launchPlanKey{
+		project: id.Project,
+		domain:  id.Domain,
+		name:    id.Name,
+	}
+	if stats, ok := m.stats[key]; ok {
+		return stats, nil
+	}
+	// Launch plans which didn't execute within any window have no stats computed.
+	return m.computeLaunchPlanStats(key, nil, cfg, m.computedAt), nil
+}
+
+func NewLaunchPlanStatsManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	scope promutils.Scope) interfaces.LaunchPlanStatsInterface {
+	labels := []string{"project", "domain", "launch_plan", "window"}
+	return &LaunchPlanStatsManager{
+		db:     db,
+		config: config,
+		metrics: launchPlanStatsMetrics{
+			Scope: scope,
+			Executions: scope.MustNewGaugeVec("executions",
+				"succeeded, failed and timed out executions of a launch plan within a window", labels...),
+			SuccessRate: scope.MustNewGaugeVec("success_rate",
+				"fraction of the executions of a launch plan within a window which succeeded", labels...),
+			BurnRate: scope.MustNewGaugeVec("error_budget_burn_rate",
+				"rate the error budget of a launch plan's success rate objective is spent within a window", labels...),
+			Duration: scope.MustNewGaugeVec("duration_seconds",
+				"percentiles of how long succeeded executions of a launch plan within a window ran for",
+				append(labels, "quantile")...),
+		},
+		_clock: clock.New(),
+		stats:  make(map[launchPlanKey]*interfaces.LaunchPlanStats),
+	}
+}
@@ -0,0 +1,177 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/config"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var launchPlanStatsNow = time.Date(2021, time.November, 10, 12, 0, 0, 0, time.UTC)
+
+var launchPlanStatsID = admin.NamedEntityIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func newLaunchPlanStatsManagerForTest(
+	repository repositories.RepositoryInterface, enabled bool) *LaunchPlanStatsManager {
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			LaunchPlanStats: runtimeInterfaces.LaunchPlanStatsConfig{
+				Enabled: enabled,
+				Windows: []config.Duration{
+					{Duration: time.Hour},
+					{Duration: 24 * time.Hour},
+				},
+				Objectives: []runtimeInterfaces.LaunchPlanObjective{
+					{Project: "project", SuccessRate: 0.9},
+					{Project: "project", Name: "name", SuccessRate: 0.5},
+				},
+			},
+		})
+	statsManager := NewLaunchPlanStatsManager(repository, mockConfig, mockScope.NewTestScope()).(*LaunchPlanStatsManager)
+	mockClock := clock.NewMock()
+	mockClock.Set(launchPlanStatsNow)
+	statsManager._clock = mockClock
+	return statsManager
+}
+
+func getLaunchPlanOutcomeForTest(name, phase string, duration, age time.Duration) models.LaunchPlanExecutionOutcome {
+	return models.LaunchPlanExecutionOutcome{
+		LaunchPlanProject:  "project",
+		LaunchPlanDomain:   "domain",
+		LaunchPlanName:     name,
+		Phase:              phase,
+		Duration:           duration,
+		ExecutionUpdatedAt: launchPlanStatsNow.Add(-age),
+	}
+}
+
+func TestLaunchPlanStatsManager_ComputeStats(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).ListLaunchPlanOutcomesFunction = func(
+		ctx context.Context, input repositoryInterfaces.ListLaunchPlanOutcomesInput) (
+		[]models.LaunchPlanExecutionOutcome, error) {
+		assert.Equal(t, []string{"SUCCEEDED", "FAILED", "TIMED_OUT"}, input.Phases)
+		assert.Equal(t, launchPlanStatsNow.Add(-24*time.Hour), input.UpdatedSince)
+		return []models.LaunchPlanExecutionOutcome{
+			getLaunchPlanOutcomeForTest("name", "SUCCEEDED", time.Minute, 10*time.Minute),
+			getLaunchPlanOutcomeForTest("name", "SUCCEEDED", 3*time.Minute, 20*time.Minute),
+			getLaunchPlanOutcomeForTest("name", "FAILED", time.Second, 30*time.Minute),
+			getLaunchPlanOutcomeForTest("name", "SUCCEEDED", 2*time.Minute, 2*time.Hour),
+			getLaunchPlanOutcomeForTest("name", "TIMED_OUT", time.Hour, 3*time.Hour),
+			getLaunchPlanOutcomeForTest("other", "FAILED", time.Second, 5*time.Hour),
+		}, nil
+	}
+	statsManager := newLaunchPlanStatsManagerForTest(repository, true)
+
+	assert.NoError(t, statsManager.ComputeStats(context.Background()))
+	stats, err := statsManager.GetLaunchPlanStats(context.Background(), launchPlanStatsID)
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.LaunchPlanStats{
+		Id:                   &launchPlanStatsID,
+		ComputedAt:           launchPlanStatsNow,
+		SuccessRateObjective: 0.5,
+		Windows: []interfaces.LaunchPlanWindowStats{
+			{
+				Window:      time.Hour,
+				Executions:  3,
+				Succeeded:   2,
+				SuccessRate: 2.0 / 3,
+				BurnRate:    (1 - 2.0/3) / 0.5,
+				DurationP50: time.Minute,
+				DurationP90: 3 * time.Minute,
+				DurationP99: 3 * time.Minute,
+			},
+			{
+				Window:      24 * time.Hour,
+				Executions:  5,
+				Succeeded:   3,
+				SuccessRate: 0.6,
+				BurnRate:    0.8,
+				DurationP50: 2 * time.Minute,
+				DurationP90: 3 * time.Minute,
+				DurationP99: 3 * time.Minute,
+			},
+		},
+	}, stats)
+
+	// Launch plans fall back to objectives for their project.
+	stats, err = statsManager.GetLaunchPlanStats(context.Background(), admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "other",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0.9, stats.SuccessRateObjective)
+	assert.Equal(t, 0, stats.Windows[0].Executions)
+	assert.Equal(t, 1, stats.Windows[1].Executions)
+	assert.InDelta(t, 10, stats.Windows[1].BurnRate, 1e-9)
+
+	assert.Equal(t, float64(0.6), testutil.ToFloat64(statsManager.metrics.SuccessRate.WithLabelValues(
+		"project", "domain", "name", "24h")))
+	assert.Equal(t, float64(180), testutil.ToFloat64(statsManager.metrics.Duration.WithLabelValues(
+		"project", "domain", "name", "1h", "0.99")))
+	assert.InDelta(t, 10, testutil.ToFloat64(statsManager.metrics.BurnRate.WithLabelValues(
+		"project", "domain", "other", "24h")), 1e-9)
+	// Windows without executions aren't exported.
+	assert.Equal(t, 3, testutil.CollectAndCount(statsManager.metrics.Executions))
+}
+
+func TestLaunchPlanStatsManager_NoExecutions(t *testing.T) {
+	statsManager := newLaunchPlanStatsManagerForTest(repositoryMocks.NewMockRepository(), true)
+	assert.NoError(t, statsManager.ComputeStats(context.Background()))
+
+	stats, err := statsManager.GetLaunchPlanStats(context.Background(), launchPlanStatsID)
+	assert.NoError(t, err)
+	assert.Equal(t, []interfaces.LaunchPlanWindowStats{
+		{Window: time.Hour},
+		{Window: 24 * time.Hour},
+	}, stats.Windows)
+}
+
+func TestLaunchPlanStatsManager_GetLaunchPlanStats_Unavailable(t *testing.T) {
+	statsManager := newLaunchPlanStatsManagerForTest(repositoryMocks.NewMockRepository(), true)
+	_, err := statsManager.GetLaunchPlanStats(context.Background(), launchPlanStatsID)
+	assert.Equal(t, codes.Unavailable, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	statsManager = newLaunchPlanStatsManagerForTest(repositoryMocks.NewMockRepository(), false)
+	_, err = statsManager.GetLaunchPlanStats(context.Background(), launchPlanStatsID)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	_, err = statsManager.GetLaunchPlanStats(context.Background(), admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestFormatLaunchPlanStatsWindow(t *testing.T) {
+	for window, expected := range map[time.Duration]string{
+		time.Hour:        "1h",
+		24 * time.Hour:   "24h",
+		90 * time.Minute: "1h30m",
+		5 * time.Minute:  "5m",
+		30 * time.Second: "30s",
+	} {
+		assert.Equal(t, expected, formatLaunchPlanStatsWindow(window))
+	}
+}
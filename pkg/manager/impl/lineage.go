@@ -0,0 +1,69 @@
+package impl
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Returns the artifacts a terminated task execution read and wrote.
+func getTaskExecutionArtifactModels(
+	request *admin.TaskExecutionEventRequest, inputURI string) []models.TaskExecutionArtifact {
+	nodeExecutionID := request.Event.ParentNodeExecutionId
+	artifact := models.TaskExecutionArtifact{
+		ExecutionProject: nodeExecutionID.ExecutionId.Project,
+		ExecutionDomain:  nodeExecutionID.ExecutionId.Domain,
+		ExecutionName:    nodeExecutionID.ExecutionId.Name,
+		NodeID:           nodeExecutionID.NodeId,
+		RetryAttempt:     &request.Event.RetryAttempt,
+		TaskProject:      request.Event.TaskId.Project,
+		TaskDomain:       request.Event.TaskId.Domain,
+		TaskName:         request.Event.TaskId.Name,
+		TaskVersion:      request.Event.TaskId.Version,
+	}
+	var artifacts []models.TaskExecutionArtifact
+	for _, uri := range []struct{ direction, uri string }{
+		{interfaces.ArtifactInput, inputURI},
+		{interfaces.ArtifactOutput, request.Event.GetOutputUri()},
+	} {
+		if len(uri.uri) == 0 {
+			continue
+		}
+		artifact.Direction = uri.direction
+		artifact.URI = uri.uri
+		artifacts = append(artifacts, artifact)
+	}
+	return artifacts
+}
+
+// Returns the relationships between a newly created execution and the executions upstream of it.
+func getExecutionRelationshipModels(
+	executionID *core.WorkflowExecutionIdentifier, spec *admin.ExecutionSpec) []models.ExecutionRelationship {
+	var relationships []models.ExecutionRelationship
+	addRelationship := func(relatedID *core.WorkflowExecutionIdentifier, kind, nodeID string) {
+		relationships = append(relationships, models.ExecutionRelationship{
+			ExecutionProject: executionID.Project,
+			ExecutionDomain:  executionID.Domain,
+			ExecutionName:    executionID.Name,
+			RelatedProject:   relatedID.Project,
+			RelatedDomain:    relatedID.Domain,
+			RelatedName:      relatedID.Name,
+			Kind:             kind,
+			NodeID:           nodeID,
+		})
+	}
+	metadata := spec.GetMetadata()
+	if parentNodeExecution := metadata.GetParentNodeExecution(); parentNodeExecution.GetExecutionId() != nil {
+		addRelationship(parentNodeExecution.ExecutionId, interfaces.RelationshipParent, parentNodeExecution.NodeId)
+	}
+	if referenceExecution := metadata.GetReferenceExecution(); referenceExecution != nil {
+		switch metadata.GetMode() {
+		case admin.ExecutionMetadata_RECOVERED:
+			addRelationship(referenceExecution, interfaces.RelationshipRecoveredFrom, "")
+		case admin.ExecutionMetadata_RELAUNCH:
+			addRelationship(referenceExecution, interfaces.RelationshipRelaunchedFrom, "")
+		}
+	}
+	return relationships
+}
@@ -0,0 +1,301 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+// The number of relationships or artifacts read at a time when traversing lineage.
+const lineageListBatchSize = 500
+
+type LineageManager struct {
+	db repositories.RepositoryInterface
+}
+
+// An execution reached while traversing lineage, and the direction to keep traversing from it in.
+type lineageVisit struct {
+	id         *core.WorkflowExecutionIdentifier
+	depth      uint32
+	upstream   bool
+	downstream bool
+}
+
+func getExecutionKey(id *core.WorkflowExecutionIdentifier) string {
+	return fmt.Sprintf("%s/%s/%s", id.Project, id.Domain, id.Name)
+}
+
+// Returns filters matching an execution identifier stored in columns with the given prefix, e.g. execution_project.
+func getLineageExecutionFilters(
+	entity common.Entity, columnPrefix string, id *core.WorkflowExecutionIdentifier) ([]common.InlineFilter, error) {
+	var filters []common.InlineFilter
+	for _, field := range []struct{ name, value string }{
+		{columnPrefix + "_project", id.Project},
+		{columnPrefix + "_domain", id.Domain},
+		{columnPrefix + "_name", id.Name},
+	} {
+		filter, err := common.NewSingleValueFilter(entity, common.Equal, field.name, field.value)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func getLineageSortParameter() (common.SortParameter, error) {
+	return common.NewSortParameter(admin.Sort{
+		Key:       "id",
+		Direction: admin.Sort_ASCENDING,
+	})
+}
+
+func (m *LineageManager) listAllRelationships(
+	ctx context.Context, filters []common.InlineFilter) ([]models.ExecutionRelationship, error) {
+	sortParameter, err := getLineageSortParameter()
+	if err != nil {
+		return nil, err
+	}
+	var relationships []models.ExecutionRelationship
+	for {
+		output, err := m.db.LineageRepo().ListRelationships(ctx, repoInterfaces.ListResourceInput{
+			Limit:         lineageListBatchSize,
+			Offset:        len(relationships),
+			InlineFilters: filters,
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		relationships = append(relationships, output...)
+		if len(output) < lineageListBatchSize {
+			return relationships, nil
+		}
+	}
+}
+
+func (m *LineageManager) listAllArtifacts(
+	ctx context.Context, filters []common.InlineFilter) ([]models.TaskExecutionArtifact, error) {
+	sortParameter, err := getLineageSortParameter()
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []models.TaskExecutionArtifact
+	for {
+		output, err := m.db.LineageRepo().ListArtifacts(ctx, repoInterfaces.ListResourceInput{
+			Limit:         lineageListBatchSize,
+			Offset:        len(artifacts),
+			InlineFilters: filters,
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, output...)
+		if len(output) < lineageListBatchSize {
+			return artifacts, nil
+		}
+	}
+}
+
+func getArtifact(artifactModel models.TaskExecutionArtifact) interfaces.Artifact {
+	var retryAttempt uint32
+	if artifactModel.RetryAttempt != nil {
+		retryAttempt = *artifactModel.RetryAttempt
+	}
+	return interfaces.Artifact{
+		TaskExecutionId: &core.TaskExecutionIdentifier{
+			TaskId: &core.Identifier{
+				ResourceType: core.ResourceType_TASK,
+				Project:      artifactModel.TaskProject,
+				Domain:       artifactModel.TaskDomain,
+				Name:         artifactModel.TaskName,
+				Version:      artifactModel.TaskVersion,
+			},
+			NodeExecutionId: &core.NodeExecutionIdentifier{
+				NodeId: artifactModel.NodeID,
+				ExecutionId: &core.WorkflowExecutionIdentifier{
+					Project: artifactModel.ExecutionProject,
+					Domain:  artifactModel.ExecutionDomain,
+					Name:    artifactModel.ExecutionName,
+				},
+			},
+			RetryAttempt: retryAttempt,
+		},
+		Direction: artifactModel.Direction,
+		Uri:       artifactModel.URI,
+	}
+}
+
+func (m *LineageManager) getLineageNode(
+	ctx context.Context, id *core.WorkflowExecutionIdentifier, depth uint32) (interfaces.LineageNode, error) {
+	node := interfaces.LineageNode{
+		Id:    id,
+		Depth: depth,
+	}
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *id)
+	if err != nil {
+		flyteAdminErr, ok := err.(errors.FlyteAdminError)
+		if !ok || flyteAdminErr.Code() != codes.NotFound || depth == 0 {
+			return interfaces.LineageNode{}, err
+		}
+		// Related executions may since have been deleted, which doesn't invalidate the rest of the lineage.
+		logger.Debugf(ctx, "Related execution [%+v] no longer exists", id)
+	} else {
+		execution, err := transformers.FromExecutionModel(*executionModel)
+		if err != nil {
+			return interfaces.LineageNode{}, err
+		}
+		node.Phase = execution.GetClosure().GetPhase()
+		node.Mode = execution.GetSpec().GetMetadata().GetMode()
+		node.Principal = execution.GetSpec().GetMetadata().GetPrincipal()
+		node.LaunchPlan = execution.GetSpec().GetLaunchPlan()
+	}
+	filters, err := getLineageExecutionFilters(common.TaskExecutionArtifact, "execution", id)
+	if err != nil {
+		return interfaces.LineageNode{}, err
+	}
+	artifactModels, err := m.listAllArtifacts(ctx, filters)
+	if err != nil {
+		return interfaces.LineageNode{}, err
+	}
+	for _, artifactModel := range artifactModels {
+		node.Artifacts = append(node.Artifacts, getArtifact(artifactModel))
+	}
+	return node, nil
+}
+
+func validateLineageRequest(request *interfaces.LineageRequest) error {
+	if err := validation.ValidateWorkflowExecutionIdentifier(request.Id); err != nil {
+		return err
+	}
+	switch request.Direction {
+	case "":
+		request.Direction = interfaces.LineageBoth
+	case interfaces.LineageUpstream, interfaces.LineageDownstream, interfaces.LineageBoth:
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unknown lineage direction [%s]", request.Direction)
+	}
+	if request.Depth == 0 {
+		request.Depth = interfaces.DefaultLineageDepth
+	} else if request.Depth > interfaces.MaxLineageDepth {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"lineage depth [%d] exceeds the maximum of %d", request.Depth, interfaces.MaxLineageDepth)
+	}
+	return nil
+}
+
+func (m *LineageManager) GetLineage(
+	ctx context.Context, request interfaces.LineageRequest) (*interfaces.Lineage, error) {
+	if err := validateLineageRequest(&request); err != nil {
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.Id)
+	root, err := m.getLineageNode(ctx, request.Id, 0)
+	if err != nil {
+		return nil, err
+	}
+	lineage := &interfaces.Lineage{
+		Nodes: []interfaces.LineageNode{root},
+	}
+	visited := map[string]bool{
+		getExecutionKey(request.Id): true,
+	}
+	traversed := make(map[uint]bool)
+	// Upstream executions are only traversed further upstream, and downstream ones further downstream, so that the
+	// lineage doesn't include siblings which merely share an ancestor.
+	queue := []lineageVisit{
+		{
+			id:         request.Id,
+			upstream:   request.Direction != interfaces.LineageDownstream,
+			downstream: request.Direction != interfaces.LineageUpstream,
+		},
+	}
+	for len(queue) > 0 {
+		visit := queue[0]
+		queue = queue[1:]
+		if visit.depth >= request.Depth {
+			continue
+		}
+		for _, direction := range []struct {
+			traverse     bool
+			columnPrefix string
+			upstream     bool
+		}{
+			{visit.upstream, "execution", true},
+			{visit.downstream, "related", false},
+		} {
+			if !direction.traverse {
+				continue
+			}
+			filters, err := getLineageExecutionFilters(common.ExecutionRelationship, direction.columnPrefix, visit.id)
+			if err != nil {
+				return nil, err
+			}
+			relationships, err := m.listAllRelationships(ctx, filters)
+			if err != nil {
+				return nil, err
+			}
+			for _, relationship := range relationships {
+				upstreamID := &core.WorkflowExecutionIdentifier{
+					Project: relationship.RelatedProject,
+					Domain:  relationship.RelatedDomain,
+					Name:    relationship.RelatedName,
+				}
+				downstreamID := &core.WorkflowExecutionIdentifier{
+					Project: relationship.ExecutionProject,
+					Domain:  relationship.ExecutionDomain,
+					Name:    relationship.ExecutionName,
+				}
+				if !traversed[relationship.ID] {
+					traversed[relationship.ID] = true
+					lineage.Edges = append(lineage.Edges, interfaces.LineageEdge{
+						Upstream:   upstreamID,
+						Downstream: downstreamID,
+						Kind:       relationship.Kind,
+						NodeId:     relationship.NodeID,
+					})
+				}
+				relatedID := downstreamID
+				if direction.upstream {
+					relatedID = upstreamID
+				}
+				if visited[getExecutionKey(relatedID)] {
+					continue
+				}
+				visited[getExecutionKey(relatedID)] = true
+				node, err := m.getLineageNode(ctx, relatedID, visit.depth+1)
+				if err != nil {
+					return nil, err
+				}
+				lineage.Nodes = append(lineage.Nodes, node)
+				queue = append(queue, lineageVisit{
+					id:         relatedID,
+					depth:      visit.depth + 1,
+					upstream:   direction.upstream,
+					downstream: !direction.upstream,
+				})
+			}
+		}
+	}
+	return lineage, nil
+}
+
+func NewLineageManager(db repositories.RepositoryInterface) interfaces.LineageInterface {
+	return &LineageManager{
+		db: db,
+	}
+}
@@ -0,0 +1,238 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+// a <- b (recovered from a) <- c (launched by b's node n1), and d (relaunched from a) as b's sibling.
+var sampleRelationships = []models.ExecutionRelationship{
+	{ID: 1, ExecutionName: "b", RelatedName: "a", Kind: interfaces.RelationshipRecoveredFrom},
+	{ID: 2, ExecutionName: "c", RelatedName: "b", Kind: interfaces.RelationshipParent, NodeID: "n1"},
+	{ID: 3, ExecutionName: "d", RelatedName: "a", Kind: interfaces.RelationshipRelaunchedFrom},
+}
+
+func getLineageExecutionID(name string) *core.WorkflowExecutionIdentifier {
+	return &core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    name,
+	}
+}
+
+// Returns the value a list input filters the given column on.
+func getLineageFilterValue(input repoInterfaces.ListResourceInput, field string) string {
+	for _, filter := range input.InlineFilters {
+		if filter.GetField() != field {
+			continue
+		}
+		expr, _ := filter.GetGormQueryExpr()
+		return expr.Args.(string)
+	}
+	return ""
+}
+
+func getMockRepositoryForLineageTest(deleted ...string) repositories.RepositoryInterface {
+	repository := repositoryMocks.NewMockRepository()
+	lineageRepo := repository.LineageRepo().(*repositoryMocks.LineageRepoInterface)
+	for i := range sampleRelationships {
+		sampleRelationships[i].ExecutionProject = "project"
+		sampleRelationships[i].ExecutionDomain = "domain"
+		sampleRelationships[i].RelatedProject = "project"
+		sampleRelationships[i].RelatedDomain = "domain"
+	}
+	for _, name := range []string{"a", "b", "c", "d"} {
+		var upstream, downstream []models.ExecutionRelationship
+		for _, relationship := range sampleRelationships {
+			if relationship.ExecutionName == name {
+				upstream = append(upstream, relationship)
+			}
+			if relationship.RelatedName == name {
+				downstream = append(downstream, relationship)
+			}
+		}
+		executionName := name
+		lineageRepo.OnListRelationshipsMatch(mock.Anything, mock.MatchedBy(
+			func(input repoInterfaces.ListResourceInput) bool {
+				return getLineageFilterValue(input, "execution_name") == executionName
+			})).Return(upstream, nil)
+		lineageRepo.OnListRelationshipsMatch(mock.Anything, mock.MatchedBy(
+			func(input repoInterfaces.ListResourceInput) bool {
+				return getLineageFilterValue(input, "related_name") == executionName
+			})).Return(downstream, nil)
+	}
+	retryAttempt := uint32(1)
+	lineageRepo.OnListArtifactsMatch(mock.Anything, mock.MatchedBy(func(input repoInterfaces.ListResourceInput) bool {
+		return getLineageFilterValue(input, "execution_name") == "b"
+	})).Return([]models.TaskExecutionArtifact{
+		{
+			ExecutionProject: "project",
+			ExecutionDomain:  "domain",
+			ExecutionName:    "b",
+			NodeID:           "n1",
+			RetryAttempt:     &retryAttempt,
+			TaskProject:      "project",
+			TaskDomain:       "domain",
+			TaskName:         "task",
+			TaskVersion:      "v1",
+			Direction:        interfaces.ArtifactOutput,
+			URI:              "s3://bucket/b/n1/outputs.pb",
+		},
+	}, nil)
+	lineageRepo.OnListArtifactsMatch(mock.Anything, mock.Anything).Return(nil, nil)
+
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repoInterfaces.Identifier) (models.Execution, error) {
+			for _, name := range deleted {
+				if input.Name == name {
+					return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+				}
+			}
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+				},
+			}, nil
+		})
+	return repository
+}
+
+func getLineageNodeNames(lineage *interfaces.Lineage) []string {
+	var names []string
+	for _, node := range lineage.Nodes {
+		names = append(names, node.Id.Name)
+	}
+	return names
+}
+
+func getLineageEdgeKinds(lineage *interfaces.Lineage) []string {
+	var kinds []string
+	for _, edge := range lineage.Edges {
+		kinds = append(kinds, edge.Upstream.Name+"->"+edge.Downstream.Name+":"+edge.Kind)
+	}
+	return kinds
+}
+
+func TestGetLineage(t *testing.T) {
+	lineageManager := NewLineageManager(getMockRepositoryForLineageTest())
+	lineage, err := lineageManager.GetLineage(context.Background(), interfaces.LineageRequest{
+		Id: getLineageExecutionID("b"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b", "a", "c"}, getLineageNodeNames(lineage))
+	assert.Equal(t, []string{"a->b:RECOVERED_FROM", "b->c:PARENT"}, getLineageEdgeKinds(lineage))
+	assert.Equal(t, "n1", lineage.Edges[1].NodeId)
+	assert.Equal(t, uint32(0), lineage.Nodes[0].Depth)
+	assert.Equal(t, uint32(1), lineage.Nodes[1].Depth)
+
+	assert.Len(t, lineage.Nodes[0].Artifacts, 1)
+	artifact := lineage.Nodes[0].Artifacts[0]
+	assert.Equal(t, interfaces.ArtifactOutput, artifact.Direction)
+	assert.Equal(t, "s3://bucket/b/n1/outputs.pb", artifact.Uri)
+	assert.Equal(t, "task", artifact.TaskExecutionId.TaskId.Name)
+	assert.Equal(t, "n1", artifact.TaskExecutionId.NodeExecutionId.NodeId)
+	assert.Equal(t, uint32(1), artifact.TaskExecutionId.RetryAttempt)
+}
+
+func TestGetLineage_Upstream(t *testing.T) {
+	lineageManager := NewLineageManager(getMockRepositoryForLineageTest())
+	lineage, err := lineageManager.GetLineage(context.Background(), interfaces.LineageRequest{
+		Id:        getLineageExecutionID("c"),
+		Direction: interfaces.LineageUpstream,
+		Depth:     interfaces.MaxLineageDepth,
+	})
+	assert.NoError(t, err)
+	// d shares an ancestor with c but isn't upstream of it.
+	assert.Equal(t, []string{"c", "b", "a"}, getLineageNodeNames(lineage))
+	assert.Equal(t, []string{"b->c:PARENT", "a->b:RECOVERED_FROM"}, getLineageEdgeKinds(lineage))
+}
+
+func TestGetLineage_Downstream(t *testing.T) {
+	lineageManager := NewLineageManager(getMockRepositoryForLineageTest())
+	lineage, err := lineageManager.GetLineage(context.Background(), interfaces.LineageRequest{
+		Id:        getLineageExecutionID("a"),
+		Direction: interfaces.LineageDownstream,
+		Depth:     2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "d", "c"}, getLineageNodeNames(lineage))
+	assert.Equal(t, uint32(2), lineage.Nodes[3].Depth)
+	assert.Equal(t, []string{"a->b:RECOVERED_FROM", "a->d:RELAUNCHED_FROM", "b->c:PARENT"},
+		getLineageEdgeKinds(lineage))
+}
+
+func TestGetLineage_DeletedRelatedExecution(t *testing.T) {
+	lineageManager := NewLineageManager(getMockRepositoryForLineageTest("a"))
+	lineage, err := lineageManager.GetLineage(context.Background(), interfaces.LineageRequest{
+		Id:        getLineageExecutionID("b"),
+		Direction: interfaces.LineageUpstream,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b", "a"}, getLineageNodeNames(lineage))
+	assert.Equal(t, core.WorkflowExecution_UNDEFINED, lineage.Nodes[1].Phase)
+}
+
+func TestGetLineage_MissingExecution(t *testing.T) {
+	lineageManager := NewLineageManager(getMockRepositoryForLineageTest("b"))
+	_, err := lineageManager.GetLineage(context.Background(), interfaces.LineageRequest{
+		Id: getLineageExecutionID("b"),
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetLineage_InvalidRequest(t *testing.T) {
+	lineageManager := NewLineageManager(getMockRepositoryForLineageTest())
+	for _, request := range []interfaces.LineageRequest{
+		{
+			Id: &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain"},
+		},
+		{
+			Id:        getLineageExecutionID("b"),
+			Direction: "SIDEWAYS",
+		},
+		{
+			Id:    getLineageExecutionID("b"),
+			Depth: interfaces.MaxLineageDepth + 1,
+		},
+	} {
+		_, err := lineageManager.GetLineage(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
+func TestGetLineage_ListError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.LineageRepo().(*repositoryMocks.LineageRepoInterface).OnListArtifactsMatch(
+		mock.Anything, mock.Anything).Return(nil, nil)
+	repository.LineageRepo().(*repositoryMocks.LineageRepoInterface).OnListRelationshipsMatch(
+		mock.Anything, mock.Anything).Return(nil, flyteAdminErrors.NewFlyteAdminError(codes.Internal, "foo"))
+	addGetWorkflowExecutionCallback(repository)
+	lineageManager := NewLineageManager(repository)
+	_, err := lineageManager.GetLineage(context.Background(), interfaces.LineageRequest{
+		Id: getLineageExecutionID("b"),
+	})
+	assert.EqualError(t, err, "foo")
+}
+
+func TestGetLineageExecutionFilters(t *testing.T) {
+	filters, err := getLineageExecutionFilters(common.ExecutionRelationship, "related", getLineageExecutionID("b"))
+	assert.NoError(t, err)
+	assert.Len(t, filters, 3)
+	assert.Equal(t, "related_project", filters[0].GetField())
+	assert.Equal(t, "related_domain", filters[1].GetField())
+	assert.Equal(t, "related_name", filters[2].GetField())
+}
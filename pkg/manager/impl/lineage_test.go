@@ -0,0 +1,88 @@
+package impl
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTaskExecutionArtifactModels(t *testing.T) {
+	request := &admin.TaskExecutionEventRequest{
+		Event: &event.TaskExecutionEvent{
+			TaskId:                sampleTaskID,
+			ParentNodeExecutionId: sampleNodeExecID,
+			RetryAttempt:          2,
+			Phase:                 core.TaskExecution_SUCCEEDED,
+			OutputResult: &event.TaskExecutionEvent_OutputUri{
+				OutputUri: "s3://bucket/outputs.pb",
+			},
+		},
+	}
+	artifacts := getTaskExecutionArtifactModels(request, "s3://bucket/inputs.pb")
+	assert.Len(t, artifacts, 2)
+	assert.Equal(t, interfaces.ArtifactInput, artifacts[0].Direction)
+	assert.Equal(t, "s3://bucket/inputs.pb", artifacts[0].URI)
+	assert.Equal(t, interfaces.ArtifactOutput, artifacts[1].Direction)
+	assert.Equal(t, "s3://bucket/outputs.pb", artifacts[1].URI)
+	assert.Equal(t, sampleNodeExecID.ExecutionId.Name, artifacts[1].ExecutionName)
+	assert.Equal(t, sampleNodeExecID.NodeId, artifacts[1].NodeID)
+	assert.Equal(t, sampleTaskID.Version, artifacts[1].TaskVersion)
+	assert.Equal(t, uint32(2), *artifacts[1].RetryAttempt)
+
+	request.Event.OutputResult = nil
+	artifacts = getTaskExecutionArtifactModels(request, "s3://bucket/inputs.pb")
+	assert.Len(t, artifacts, 1)
+	assert.Equal(t, interfaces.ArtifactInput, artifacts[0].Direction)
+}
+
+func TestGetExecutionRelationshipModels(t *testing.T) {
+	executionID := &core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "child",
+	}
+	referenceExecution := &core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "original",
+	}
+	relationships := getExecutionRelationshipModels(executionID, &admin.ExecutionSpec{
+		Metadata: &admin.ExecutionMetadata{
+			Mode: admin.ExecutionMetadata_CHILD_WORKFLOW,
+			ParentNodeExecution: &core.NodeExecutionIdentifier{
+				NodeId:      "n0",
+				ExecutionId: referenceExecution,
+			},
+		},
+	})
+	assert.Len(t, relationships, 1)
+	assert.Equal(t, interfaces.RelationshipParent, relationships[0].Kind)
+	assert.Equal(t, "n0", relationships[0].NodeID)
+	assert.Equal(t, "child", relationships[0].ExecutionName)
+	assert.Equal(t, "original", relationships[0].RelatedName)
+
+	for mode, kind := range map[admin.ExecutionMetadata_ExecutionMode]string{
+		admin.ExecutionMetadata_RECOVERED: interfaces.RelationshipRecoveredFrom,
+		admin.ExecutionMetadata_RELAUNCH:  interfaces.RelationshipRelaunchedFrom,
+	} {
+		relationships = getExecutionRelationshipModels(executionID, &admin.ExecutionSpec{
+			Metadata: &admin.ExecutionMetadata{
+				Mode:               mode,
+				ReferenceExecution: referenceExecution,
+			},
+		})
+		assert.Len(t, relationships, 1)
+		assert.Equal(t, kind, relationships[0].Kind)
+		assert.Empty(t, relationships[0].NodeID)
+	}
+
+	assert.Empty(t, getExecutionRelationshipModels(executionID, &admin.ExecutionSpec{
+		Metadata: &admin.ExecutionMetadata{
+			Mode: admin.ExecutionMetadata_MANUAL,
+		},
+	}))
+}
@@ -0,0 +1,240 @@
+package impl
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+// The number of node or task executions read at a time when building an execution's timeline.
+const metricsListBatchSize = 500
+
+// Node and task executions are listed joined with other tables, so are ordered by their qualified ids.
+const nodeExecutionsSortKey = "node_executions.id"
+const taskExecutionsSortKey = "task_executions.id"
+
+type MetricsManager struct {
+	db repositories.RepositoryInterface
+}
+
+func getTimeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+// Returns how long an entity waited between being created and starting to run, if it has started.
+func getQueuedDuration(createdAt, startedAt *time.Time) time.Duration {
+	if createdAt == nil || startedAt == nil || startedAt.Before(*createdAt) {
+		return 0
+	}
+	return startedAt.Sub(*createdAt)
+}
+
+// Lists every node execution of an execution, including nested ones, in the order they were created.
+func listAllNodeExecutions(ctx context.Context, db repositories.RepositoryInterface, filters []common.InlineFilter) (
+	[]models.NodeExecution, error) {
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       nodeExecutionsSortKey,
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var nodeExecutions []models.NodeExecution
+	for {
+		output, err := db.NodeExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         metricsListBatchSize,
+			Offset:        len(nodeExecutions),
+			InlineFilters: filters,
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		nodeExecutions = append(nodeExecutions, output.NodeExecutions...)
+		if len(output.NodeExecutions) < metricsListBatchSize {
+			return nodeExecutions, nil
+		}
+	}
+}
+
+// Lists every task execution of an execution.
+func (m *MetricsManager) listAllTaskExecutions(
+	ctx context.Context, filters []common.InlineFilter) ([]models.TaskExecution, error) {
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       taskExecutionsSortKey,
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var taskExecutions []models.TaskExecution
+	for {
+		output, err := m.db.TaskExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         metricsListBatchSize,
+			Offset:        len(taskExecutions),
+			InlineFilters: filters,
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		taskExecutions = append(taskExecutions, output.TaskExecutions...)
+		if len(output.TaskExecutions) < metricsListBatchSize {
+			return taskExecutions, nil
+		}
+	}
+}
+
+func getTaskExecutionTimeline(taskExecutionModel models.TaskExecution) interfaces.TaskExecutionTimeline {
+	var retryAttempt uint32
+	if taskExecutionModel.RetryAttempt != nil {
+		retryAttempt = *taskExecutionModel.RetryAttempt
+	}
+	return interfaces.TaskExecutionTimeline{
+		Id: &core.TaskExecutionIdentifier{
+			TaskId: &core.Identifier{
+				ResourceType: core.ResourceType_TASK,
+				Project:      taskExecutionModel.TaskKey.Project,
+				Domain:       taskExecutionModel.TaskKey.Domain,
+				Name:         taskExecutionModel.TaskKey.Name,
+				Version:      taskExecutionModel.TaskKey.Version,
+			},
+			NodeExecutionId: &core.NodeExecutionIdentifier{
+				NodeId: taskExecutionModel.NodeExecutionKey.NodeID,
+				ExecutionId: &core.WorkflowExecutionIdentifier{
+					Project: taskExecutionModel.NodeExecutionKey.ExecutionKey.Project,
+					Domain:  taskExecutionModel.NodeExecutionKey.ExecutionKey.Domain,
+					Name:    taskExecutionModel.NodeExecutionKey.ExecutionKey.Name,
+				},
+			},
+			RetryAttempt: retryAttempt,
+		},
+		Phase:          core.TaskExecution_Phase(core.TaskExecution_Phase_value[taskExecutionModel.Phase]),
+		CreatedAt:      getTimeOrZero(taskExecutionModel.TaskExecutionCreatedAt),
+		StartedAt:      getTimeOrZero(taskExecutionModel.StartedAt),
+		QueuedDuration: getQueuedDuration(taskExecutionModel.TaskExecutionCreatedAt, taskExecutionModel.StartedAt),
+		Duration:       taskExecutionModel.Duration,
+	}
+}
+
+func getNodeExecutionTimeline(
+	ctx context.Context, nodeExecutionModel models.NodeExecution) interfaces.NodeExecutionTimeline {
+	timeline := interfaces.NodeExecutionTimeline{
+		Id: &core.NodeExecutionIdentifier{
+			NodeId: nodeExecutionModel.NodeID,
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Project: nodeExecutionModel.NodeExecutionKey.ExecutionKey.Project,
+				Domain:  nodeExecutionModel.NodeExecutionKey.ExecutionKey.Domain,
+				Name:    nodeExecutionModel.NodeExecutionKey.ExecutionKey.Name,
+			},
+		},
+		IsParentNode:   len(nodeExecutionModel.ChildNodeExecutions) > 0,
+		Phase:          core.NodeExecution_Phase(core.NodeExecution_Phase_value[nodeExecutionModel.Phase]),
+		CreatedAt:      getTimeOrZero(nodeExecutionModel.NodeExecutionCreatedAt),
+		StartedAt:      getTimeOrZero(nodeExecutionModel.StartedAt),
+		QueuedDuration: getQueuedDuration(nodeExecutionModel.NodeExecutionCreatedAt, nodeExecutionModel.StartedAt),
+		Duration:       nodeExecutionModel.Duration,
+	}
+	if nodeExecutionModel.CacheStatus != nil {
+		timeline.CacheStatus = core.CatalogCacheStatus(core.CatalogCacheStatus_value[*nodeExecutionModel.CacheStatus])
+	}
+	var metadata admin.NodeExecutionMetaData
+	if err := proto.Unmarshal(nodeExecutionModel.NodeExecutionMetadata, &metadata); err != nil {
+		// The timeline is still useful without the metadata.
+		logger.Warningf(ctx, "Failed to unmarshal metadata of node execution [%+v] with err: %v", timeline.Id, err)
+	} else {
+		timeline.SpecNodeId = metadata.SpecNodeId
+		timeline.IsParentNode = timeline.IsParentNode || metadata.IsParentNode
+	}
+	return timeline
+}
+
+func (m *MetricsManager) GetExecutionMetrics(
+	ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.ExecutionMetrics, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(&id); err != nil {
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, &id)
+	executionModel, err := util.GetExecutionModel(ctx, m.db, id)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := util.GetWorkflowExecutionIdentifierFilters(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	nodeExecutionModels, err := listAllNodeExecutions(ctx, m.db, filters)
+	if err != nil {
+		return nil, err
+	}
+	taskExecutionModels, err := m.listAllTaskExecutions(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &interfaces.ExecutionMetrics{
+		Id:        &id,
+		Phase:     core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[executionModel.Phase]),
+		CreatedAt: getTimeOrZero(executionModel.ExecutionCreatedAt),
+		StartedAt: getTimeOrZero(executionModel.StartedAt),
+		Duration:  executionModel.Duration,
+		Nodes:     make([]interfaces.NodeExecutionTimeline, len(nodeExecutionModels)),
+	}
+	nodeIDs := make(map[uint]string, len(nodeExecutionModels))
+	nodeIndexes := make(map[string]int, len(nodeExecutionModels))
+	for idx, nodeExecutionModel := range nodeExecutionModels {
+		metrics.Nodes[idx] = getNodeExecutionTimeline(ctx, nodeExecutionModel)
+		nodeIDs[nodeExecutionModel.ID] = nodeExecutionModel.NodeID
+		nodeIndexes[nodeExecutionModel.NodeID] = idx
+	}
+	for idx, nodeExecutionModel := range nodeExecutionModels {
+		if nodeExecutionModel.ParentID != nil {
+			metrics.Nodes[idx].ParentNodeId = nodeIDs[*nodeExecutionModel.ParentID]
+		}
+		if metrics.Nodes[idx].CacheStatus == core.CatalogCacheStatus_CACHE_HIT {
+			metrics.CacheHits++
+		}
+	}
+	for _, taskExecutionModel := range taskExecutionModels {
+		idx, ok := nodeIndexes[taskExecutionModel.NodeID]
+		if !ok {
+			logger.Debugf(ctx, "Ignoring task execution of unknown node [%s]", taskExecutionModel.NodeID)
+			continue
+		}
+		metrics.Nodes[idx].TaskExecutions = append(
+			metrics.Nodes[idx].TaskExecutions, getTaskExecutionTimeline(taskExecutionModel))
+	}
+	for idx := range metrics.Nodes {
+		taskExecutions := metrics.Nodes[idx].TaskExecutions
+		if len(taskExecutions) == 0 {
+			continue
+		}
+		sort.SliceStable(taskExecutions, func(i, j int) bool {
+			return taskExecutions[i].Id.RetryAttempt < taskExecutions[j].Id.RetryAttempt
+		})
+		metrics.Nodes[idx].Retries = int(taskExecutions[len(taskExecutions)-1].Id.RetryAttempt)
+		metrics.Retries += metrics.Nodes[idx].Retries
+	}
+	return metrics, nil
+}
+
+func NewMetricsManager(db repositories.RepositoryInterface) interfaces.MetricsInterface {
+	return &MetricsManager{
+		db: db,
+	}
+}
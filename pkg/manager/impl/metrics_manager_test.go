@@ -0,0 +1,288 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var metricsStartTime = time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+var metricsExecutionID = core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func getMetricsNodeExecutionModel(
+	id uint, nodeID string, parentID *uint, createdAt, startedAt time.Time, duration time.Duration) models.NodeExecution {
+	metadata, _ := proto.Marshal(&admin.NodeExecutionMetaData{
+		SpecNodeId: nodeID + "-spec",
+	})
+	return models.NodeExecution{
+		BaseModel: models.BaseModel{
+			ID: id,
+		},
+		NodeExecutionKey: models.NodeExecutionKey{
+			ExecutionKey: models.ExecutionKey{
+				Project: metricsExecutionID.Project,
+				Domain:  metricsExecutionID.Domain,
+				Name:    metricsExecutionID.Name,
+			},
+			NodeID: nodeID,
+		},
+		Phase:                  core.NodeExecution_SUCCEEDED.String(),
+		NodeExecutionCreatedAt: &createdAt,
+		StartedAt:              &startedAt,
+		Duration:               duration,
+		NodeExecutionMetadata:  metadata,
+		ParentID:               parentID,
+	}
+}
+
+func getMetricsTaskExecutionModel(
+	nodeID string, retryAttempt uint32, phase core.TaskExecution_Phase, createdAt, startedAt time.Time,
+	duration time.Duration) models.TaskExecution {
+	return models.TaskExecution{
+		TaskExecutionKey: models.TaskExecutionKey{
+			TaskKey: models.TaskKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "task",
+				Version: "version",
+			},
+			NodeExecutionKey: models.NodeExecutionKey{
+				ExecutionKey: models.ExecutionKey{
+					Project: metricsExecutionID.Project,
+					Domain:  metricsExecutionID.Domain,
+					Name:    metricsExecutionID.Name,
+				},
+				NodeID: nodeID,
+			},
+			RetryAttempt: &retryAttempt,
+		},
+		Phase:                  phase.String(),
+		TaskExecutionCreatedAt: &createdAt,
+		StartedAt:              &startedAt,
+		Duration:               duration,
+	}
+}
+
+func getMetricsTaskExecutionID(nodeID string, retryAttempt uint32) *core.TaskExecutionIdentifier {
+	return &core.TaskExecutionIdentifier{
+		TaskId: &core.Identifier{
+			ResourceType: core.ResourceType_TASK,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "task",
+			Version:      "version",
+		},
+		NodeExecutionId: &core.NodeExecutionIdentifier{
+			NodeId:      nodeID,
+			ExecutionId: &metricsExecutionID,
+		},
+		RetryAttempt: retryAttempt,
+	}
+}
+
+func TestGetExecutionMetrics(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.Identifier) (models.Execution, error) {
+			createdAt := metricsStartTime
+			startedAt := metricsStartTime.Add(time.Second)
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+				},
+				Phase:              core.WorkflowExecution_SUCCEEDED.String(),
+				ExecutionCreatedAt: &createdAt,
+				StartedAt:          &startedAt,
+				Duration:           time.Hour,
+			}, nil
+		})
+
+	dynamicID := uint(1)
+	cachedNode := getMetricsNodeExecutionModel(
+		2, "dynamic-n0", &dynamicID, metricsStartTime.Add(time.Minute), metricsStartTime.Add(time.Minute), 0)
+	cacheHit := core.CatalogCacheStatus_CACHE_HIT.String()
+	cachedNode.CacheStatus = &cacheHit
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repositoryInterfaces.ListResourceInput) (
+			repositoryInterfaces.NodeExecutionCollectionOutput, error) {
+			assert.Equal(t, metricsListBatchSize, input.Limit)
+			assert.Equal(t, 0, input.Offset)
+			assert.Len(t, input.InlineFilters, 3)
+			assert.Equal(t, "node_executions.id asc", input.SortParameter.GetGormOrderExpr())
+			return repositoryInterfaces.NodeExecutionCollectionOutput{
+				NodeExecutions: []models.NodeExecution{
+					getMetricsNodeExecutionModel(1, "dynamic", nil, metricsStartTime.Add(time.Second),
+						metricsStartTime.Add(2*time.Second), time.Minute),
+					cachedNode,
+					getMetricsNodeExecutionModel(3, "task", nil, metricsStartTime.Add(2*time.Minute),
+						metricsStartTime.Add(3*time.Minute), 10*time.Minute),
+				},
+			}, nil
+		})
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repositoryInterfaces.ListResourceInput) (
+			repositoryInterfaces.TaskExecutionCollectionOutput, error) {
+			assert.Equal(t, "task_executions.id asc", input.SortParameter.GetGormOrderExpr())
+			return repositoryInterfaces.TaskExecutionCollectionOutput{
+				TaskExecutions: []models.TaskExecution{
+					getMetricsTaskExecutionModel("dynamic", 0, core.TaskExecution_SUCCEEDED,
+						metricsStartTime.Add(2*time.Second), metricsStartTime.Add(3*time.Second), 30*time.Second),
+					getMetricsTaskExecutionModel("task", 1, core.TaskExecution_SUCCEEDED,
+						metricsStartTime.Add(8*time.Minute), metricsStartTime.Add(9*time.Minute), 4*time.Minute),
+					getMetricsTaskExecutionModel("task", 0, core.TaskExecution_FAILED,
+						metricsStartTime.Add(3*time.Minute), metricsStartTime.Add(4*time.Minute), 3*time.Minute),
+				},
+			}, nil
+		})
+
+	metricsManager := NewMetricsManager(repository)
+	metrics, err := metricsManager.GetExecutionMetrics(context.Background(), metricsExecutionID)
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ExecutionMetrics{
+		Id:        &metricsExecutionID,
+		Phase:     core.WorkflowExecution_SUCCEEDED,
+		CreatedAt: metricsStartTime,
+		StartedAt: metricsStartTime.Add(time.Second),
+		Duration:  time.Hour,
+		Nodes: []interfaces.NodeExecutionTimeline{
+			{
+				Id: &core.NodeExecutionIdentifier{
+					NodeId:      "dynamic",
+					ExecutionId: &metricsExecutionID,
+				},
+				SpecNodeId:     "dynamic-spec",
+				Phase:          core.NodeExecution_SUCCEEDED,
+				CreatedAt:      metricsStartTime.Add(time.Second),
+				StartedAt:      metricsStartTime.Add(2 * time.Second),
+				QueuedDuration: time.Second,
+				Duration:       time.Minute,
+				TaskExecutions: []interfaces.TaskExecutionTimeline{
+					{
+						Id:             getMetricsTaskExecutionID("dynamic", 0),
+						Phase:          core.TaskExecution_SUCCEEDED,
+						CreatedAt:      metricsStartTime.Add(2 * time.Second),
+						StartedAt:      metricsStartTime.Add(3 * time.Second),
+						QueuedDuration: time.Second,
+						Duration:       30 * time.Second,
+					},
+				},
+			},
+			{
+				Id: &core.NodeExecutionIdentifier{
+					NodeId:      "dynamic-n0",
+					ExecutionId: &metricsExecutionID,
+				},
+				ParentNodeId: "dynamic",
+				SpecNodeId:   "dynamic-n0-spec",
+				Phase:        core.NodeExecution_SUCCEEDED,
+				CreatedAt:    metricsStartTime.Add(time.Minute),
+				StartedAt:    metricsStartTime.Add(time.Minute),
+				CacheStatus:  core.CatalogCacheStatus_CACHE_HIT,
+			},
+			{
+				Id: &core.NodeExecutionIdentifier{
+					NodeId:      "task",
+					ExecutionId: &metricsExecutionID,
+				},
+				SpecNodeId:     "task-spec",
+				Phase:          core.NodeExecution_SUCCEEDED,
+				CreatedAt:      metricsStartTime.Add(2 * time.Minute),
+				StartedAt:      metricsStartTime.Add(3 * time.Minute),
+				QueuedDuration: time.Minute,
+				Duration:       10 * time.Minute,
+				Retries:        1,
+				TaskExecutions: []interfaces.TaskExecutionTimeline{
+					{
+						Id:             getMetricsTaskExecutionID("task", 0),
+						Phase:          core.TaskExecution_FAILED,
+						CreatedAt:      metricsStartTime.Add(3 * time.Minute),
+						StartedAt:      metricsStartTime.Add(4 * time.Minute),
+						QueuedDuration: time.Minute,
+						Duration:       3 * time.Minute,
+					},
+					{
+						Id:             getMetricsTaskExecutionID("task", 1),
+						Phase:          core.TaskExecution_SUCCEEDED,
+						CreatedAt:      metricsStartTime.Add(8 * time.Minute),
+						StartedAt:      metricsStartTime.Add(9 * time.Minute),
+						QueuedDuration: time.Minute,
+						Duration:       4 * time.Minute,
+					},
+				},
+			},
+		},
+		CacheHits: 1,
+		Retries:   1,
+	}, metrics)
+}
+
+func TestGetExecutionMetrics_Paginated(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	var offsets []int
+	repository.NodeExecutionRepo().(*repositoryMocks.MockNodeExecutionRepo).SetListCallback(
+		func(ctx context.Context, input repositoryInterfaces.ListResourceInput) (
+			repositoryInterfaces.NodeExecutionCollectionOutput, error) {
+			offsets = append(offsets, input.Offset)
+			count := input.Limit
+			if input.Offset > 0 {
+				count = 1
+			}
+			nodeExecutions := make([]models.NodeExecution, count)
+			for idx := range nodeExecutions {
+				id := input.Offset + idx
+				nodeExecutions[idx] = getMetricsNodeExecutionModel(
+					uint(id), fmt.Sprintf("n%d", id), nil, metricsStartTime, metricsStartTime, 0)
+			}
+			return repositoryInterfaces.NodeExecutionCollectionOutput{
+				NodeExecutions: nodeExecutions,
+			}, nil
+		})
+
+	metricsManager := NewMetricsManager(repository)
+	metrics, err := metricsManager.GetExecutionMetrics(context.Background(), metricsExecutionID)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, metricsListBatchSize}, offsets)
+	assert.Len(t, metrics.Nodes, metricsListBatchSize+1)
+	assert.Equal(t, fmt.Sprintf("n%d", metricsListBatchSize), metrics.Nodes[metricsListBatchSize].Id.NodeId)
+}
+
+func TestGetExecutionMetrics_MissingExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.Identifier) (models.Execution, error) {
+			return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		})
+
+	metricsManager := NewMetricsManager(repository)
+	_, err := metricsManager.GetExecutionMetrics(context.Background(), metricsExecutionID)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetExecutionMetrics_InvalidID(t *testing.T) {
+	metricsManager := NewMetricsManager(repositoryMocks.NewMockRepository())
+	_, err := metricsManager.GetExecutionMetrics(context.Background(), core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
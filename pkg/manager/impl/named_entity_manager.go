@@ -2,6 +2,7 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/flyteorg/flytestdlib/contextutils"
 
+	"github.com/flyteorg/flyteadmin/auth"
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -18,6 +20,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -163,6 +166,226 @@ func (m *NamedEntityManager) ListNamedEntities(ctx context.Context, request admi
 
 }
 
+func (m *NamedEntityManager) SearchNamedEntities(ctx context.Context, request interfaces.NamedEntitySearchRequest) (
+	*admin.NamedEntityList, error) {
+	if err := validation.ValidateNamedEntitySearchRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+
+	results, err := m.db.NamedEntityRepo().Search(ctx, repoInterfaces.SearchNamedEntityInput{
+		Project:       request.Project,
+		Domain:        request.Domain,
+		Query:         request.Query,
+		ResourceTypes: request.ResourceTypes,
+		Limit:         int(request.Limit),
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to search named entities with project: %s, domain: %s for [%s]. "+
+			"Returned error was: %v", request.Project, request.Domain, request.Query, err)
+		return nil, err
+	}
+	entities := make([]*admin.NamedEntity, len(results))
+	for i, result := range results {
+		entity := transformers.FromNamedEntityModel(result.NamedEntity)
+		entities[i] = &entity
+	}
+	return &admin.NamedEntityList{
+		Entities: entities,
+	}, nil
+}
+
+func (m *NamedEntityManager) GetNamedEntityDescription(
+	ctx context.Context, request interfaces.NamedEntityDescriptionGetRequest) (*interfaces.NamedEntityDescription, error) {
+	if err := validation.ValidateNamedEntityDescriptionGetRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.ID.Project, request.ID.Domain)
+
+	// Ensure entity exists, since entities without a description document have an empty one.
+	if _, err := util.GetNamedEntity(ctx, m.db, request.ResourceType, *request.ID); err != nil {
+		return nil, err
+	}
+	model, err := m.db.NamedEntityRepo().GetDescription(ctx, repoInterfaces.GetNamedEntityInput{
+		ResourceType: request.ResourceType,
+		Project:      request.ID.Project,
+		Domain:       request.ID.Domain,
+		Name:         request.ID.Name,
+	})
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+			return &interfaces.NamedEntityDescription{
+				Owners: []interfaces.NamedEntityOwner{},
+			}, nil
+		}
+		return nil, err
+	}
+	description := interfaces.NamedEntityDescription{
+		LongDescription: model.LongDescription,
+		SourceLink:      model.SourceLink,
+		Owners:          []interfaces.NamedEntityOwner{},
+	}
+	if len(model.Owners) > 0 {
+		if err := json.Unmarshal(model.Owners, &description.Owners); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to read the owners of [%+v]: %v",
+				request.ID, err)
+		}
+	}
+	return &description, nil
+}
+
+func (m *NamedEntityManager) UpdateNamedEntityDescription(
+	ctx context.Context, request interfaces.NamedEntityDescriptionUpdateRequest) error {
+	if err := validation.ValidateNamedEntityDescriptionUpdateRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.ID.Project, request.ID.Domain)
+
+	// Ensure entity exists before trying to document it
+	if _, err := util.GetNamedEntity(ctx, m.db, request.ResourceType, *request.ID); err != nil {
+		return err
+	}
+	owners := request.Description.Owners
+	if owners == nil {
+		owners = []interfaces.NamedEntityOwner{}
+	}
+	serializedOwners, err := json.Marshal(owners)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid owners: %v", err)
+	}
+	err = m.db.NamedEntityRepo().UpdateDescription(ctx, models.NamedEntityDescription{
+		ResourceType:    request.ResourceType,
+		Project:         request.ID.Project,
+		Domain:          request.ID.Domain,
+		Name:            request.ID.Name,
+		LongDescription: request.Description.LongDescription,
+		SourceLink:      request.Description.SourceLink,
+		Owners:          serializedOwners,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to update the description of [%+v] with err %v", request.ID, err)
+		return err
+	}
+	return nil
+}
+
+func getNamedEntityInput(resourceType core.ResourceType, id *admin.NamedEntityIdentifier) repoInterfaces.GetNamedEntityInput {
+	return repoInterfaces.GetNamedEntityInput{
+		ResourceType: resourceType,
+		Project:      id.Project,
+		Domain:       id.Domain,
+		Name:         id.Name,
+	}
+}
+
+func (m *NamedEntityManager) TagNamedEntity(ctx context.Context, request interfaces.NamedEntityTagRequest) error {
+	if err := validation.ValidateNamedEntityTagRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.ID.Project, request.ID.Domain)
+
+	// Ensure entity exists before trying to tag it
+	if _, err := util.GetNamedEntity(ctx, m.db, request.ResourceType, *request.ID); err != nil {
+		return err
+	}
+	input := getNamedEntityInput(request.ResourceType, request.ID)
+	existingTags, err := m.db.NamedEntityRepo().ListTags(ctx, input)
+	if err != nil {
+		return err
+	}
+	tags := make(map[string]bool, len(existingTags)+len(request.Tags))
+	for _, tag := range existingTags {
+		tags[tag] = true
+	}
+	for _, tag := range request.Tags {
+		tags[tag] = true
+	}
+	if len(tags) > validation.MaxNamedEntityTags {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"a named entity cannot have more than %d tags, %s already has %d", validation.MaxNamedEntityTags,
+			request.ID.Name, len(existingTags))
+	}
+	if err := m.db.NamedEntityRepo().AddTags(ctx, input, request.Tags); err != nil {
+		logger.Debugf(ctx, "Failed to tag [%+v] with err %v", request.ID, err)
+		return err
+	}
+	return nil
+}
+
+func (m *NamedEntityManager) UntagNamedEntity(ctx context.Context, request interfaces.NamedEntityTagRequest) error {
+	if err := validation.ValidateNamedEntityTagRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.ID.Project, request.ID.Domain)
+
+	if err := m.db.NamedEntityRepo().RemoveTags(
+		ctx, getNamedEntityInput(request.ResourceType, request.ID), request.Tags); err != nil {
+		logger.Debugf(ctx, "Failed to untag [%+v] with err %v", request.ID, err)
+		return err
+	}
+	return nil
+}
+
+func (m *NamedEntityManager) GetNamedEntityTags(ctx context.Context, request admin.NamedEntityGetRequest) (
+	[]string, error) {
+	if err := validation.ValidateNamedEntityTagsGetRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Id.Project, request.Id.Domain)
+
+	if _, err := util.GetNamedEntity(ctx, m.db, request.ResourceType, *request.Id); err != nil {
+		return nil, err
+	}
+	return m.db.NamedEntityRepo().ListTags(ctx, getNamedEntityInput(request.ResourceType, request.Id))
+}
+
+func (m *NamedEntityManager) ListNamedEntitiesByTag(
+	ctx context.Context, request interfaces.NamedEntityTagListRequest) (*admin.NamedEntityList, error) {
+	if err := validation.ValidateNamedEntityTagListRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListNamedEntitiesByTag", request.Token)
+	}
+	resourceTypes := request.ResourceTypes
+	if len(resourceTypes) == 0 {
+		resourceTypes = []core.ResourceType{core.ResourceType_WORKFLOW, core.ResourceType_LAUNCH_PLAN}
+	}
+	listInput := repoInterfaces.ListNamedEntityByTagInput{
+		Tag:           request.Tag,
+		ResourceTypes: resourceTypes,
+		Limit:         int(request.Limit),
+		Offset:        offset,
+	}
+	// Callers restricted to some projects only browse the entities of those projects.
+	if visibleProjects, restricted := auth.VisibleProjectsFromContext(ctx); restricted {
+		listInput.Projects = visibleProjects.List()
+	}
+
+	output, err := m.db.NamedEntityRepo().ListByTag(ctx, listInput)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list named entities tagged [%s] with err %v", request.Tag, err)
+		return nil, err
+	}
+	var token string
+	if len(output.Entities) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(output.Entities))
+	}
+	return &admin.NamedEntityList{
+		Entities: transformers.FromNamedEntityModels(output.Entities),
+		Token:    token,
+	}, nil
+}
+
 func NewNamedEntityManager(
 	db repositories.RepositoryInterface,
 	config runtimeInterfaces.Configuration,
@@ -2,9 +2,12 @@ package impl
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/flyteorg/flyteadmin/auth"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
@@ -15,6 +18,7 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 var namedEntityIdentifier = admin.NamedEntityIdentifier{
@@ -159,3 +163,243 @@ func TestNamedEntityManager_Update_BadRequest(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, response)
 }
+
+func TestNamedEntityManager_Search(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+
+	repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo).SetSearchCallback(
+		func(input interfaces.SearchNamedEntityInput) ([]interfaces.NamedEntitySearchResult, error) {
+			assert.Equal(t, interfaces.SearchNamedEntityInput{
+				Project:       project,
+				Domain:        domain,
+				Query:         "flow",
+				ResourceTypes: []core.ResourceType{core.ResourceType_WORKFLOW, core.ResourceType_TASK},
+				Limit:         10,
+			}, input)
+			results := make([]interfaces.NamedEntitySearchResult, 0)
+			for _, resourceType := range input.ResourceTypes {
+				results = append(results, interfaces.NamedEntitySearchResult{
+					NamedEntity: models.NamedEntity{
+						NamedEntityKey: models.NamedEntityKey{
+							ResourceType: resourceType,
+							Project:      input.Project,
+							Domain:       input.Domain,
+							Name:         "flow",
+						},
+					},
+				})
+			}
+			return results, nil
+		})
+	response, err := manager.SearchNamedEntities(context.Background(), managerInterfaces.NamedEntitySearchRequest{
+		Project:       project,
+		Domain:        domain,
+		Query:         "flow",
+		ResourceTypes: []core.ResourceType{core.ResourceType_WORKFLOW, core.ResourceType_TASK},
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, response.Entities, 2)
+	// Results are returned in the order ranked by the repo.
+	assert.Equal(t, core.ResourceType_WORKFLOW, response.Entities[0].ResourceType)
+	assert.Equal(t, "flow", response.Entities[0].Id.Name)
+	assert.Equal(t, core.ResourceType_TASK, response.Entities[1].ResourceType)
+}
+
+func TestNamedEntityManager_Search_BadRequest(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+
+	response, err := manager.SearchNamedEntities(context.Background(), managerInterfaces.NamedEntitySearchRequest{
+		Project: project,
+		Domain:  domain,
+		Limit:   10,
+	})
+	assert.Error(t, err)
+	assert.Nil(t, response)
+}
+
+func TestNamedEntityManager_Description(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+	namedEntityRepo := repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo)
+
+	// Entities are described by an empty document until one is saved.
+	description, err := manager.GetNamedEntityDescription(context.Background(),
+		managerInterfaces.NamedEntityDescriptionGetRequest{
+			ResourceType: resourceType,
+			ID:           &namedEntityIdentifier,
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, &managerInterfaces.NamedEntityDescription{
+		Owners: []managerInterfaces.NamedEntityOwner{},
+	}, description)
+
+	var saved models.NamedEntityDescription
+	namedEntityRepo.SetUpdateDescriptionCallback(func(input models.NamedEntityDescription) error {
+		saved = input
+		return nil
+	})
+	namedEntityRepo.SetGetDescriptionCallback(
+		func(input interfaces.GetNamedEntityInput) (models.NamedEntityDescription, error) {
+			assert.Equal(t, interfaces.GetNamedEntityInput{
+				ResourceType: resourceType,
+				Project:      project,
+				Domain:       domain,
+				Name:         name,
+			}, input)
+			return saved, nil
+		})
+	updated := managerInterfaces.NamedEntityDescription{
+		LongDescription: "# Training\nTrains the model nightly.",
+		SourceLink:      "https://github.com/flyteorg/flytesnacks/blob/master/workflows/training.py",
+		Owners: []managerInterfaces.NamedEntityOwner{
+			{Name: "ml-platform", Email: "ml-platform@example.com"},
+		},
+	}
+	err = manager.UpdateNamedEntityDescription(context.Background(),
+		managerInterfaces.NamedEntityDescriptionUpdateRequest{
+			ResourceType: resourceType,
+			ID:           &namedEntityIdentifier,
+			Description:  updated,
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, resourceType, saved.ResourceType)
+	assert.Equal(t, name, saved.Name)
+
+	description, err = manager.GetNamedEntityDescription(context.Background(),
+		managerInterfaces.NamedEntityDescriptionGetRequest{
+			ResourceType: resourceType,
+			ID:           &namedEntityIdentifier,
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, &updated, description)
+}
+
+func TestNamedEntityManager_UpdateDescription_BadRequest(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+	repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo).SetUpdateDescriptionCallback(
+		func(input models.NamedEntityDescription) error {
+			assert.Fail(t, "invalid descriptions shouldn't be saved")
+			return nil
+		})
+
+	err := manager.UpdateNamedEntityDescription(context.Background(),
+		managerInterfaces.NamedEntityDescriptionUpdateRequest{
+			ResourceType: resourceType,
+			ID:           &namedEntityIdentifier,
+			Description: managerInterfaces.NamedEntityDescription{
+				SourceLink: "file:///home/user/workflows/training.py",
+			},
+		})
+	assert.EqualError(t, err, "source_link must be an http or https URL")
+}
+
+func TestNamedEntityManager_Tags(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+	namedEntityRepo := repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo)
+
+	tags := sets.NewString("team:growth")
+	namedEntityRepo.SetListTagsCallback(func(input interfaces.GetNamedEntityInput) ([]string, error) {
+		assert.Equal(t, interfaces.GetNamedEntityInput{
+			ResourceType: resourceType,
+			Project:      project,
+			Domain:       domain,
+			Name:         name,
+		}, input)
+		return tags.List(), nil
+	})
+	namedEntityRepo.SetAddTagsCallback(func(input interfaces.GetNamedEntityInput, added []string) error {
+		tags.Insert(added...)
+		return nil
+	})
+	namedEntityRepo.SetRemoveTagsCallback(func(input interfaces.GetNamedEntityInput, removed []string) error {
+		tags.Delete(removed...)
+		return nil
+	})
+
+	err := manager.TagNamedEntity(context.Background(), managerInterfaces.NamedEntityTagRequest{
+		ResourceType: resourceType,
+		ID:           &namedEntityIdentifier,
+		Tags:         []string{"tier:critical", "team:growth"},
+	})
+	assert.NoError(t, err)
+	err = manager.UntagNamedEntity(context.Background(), managerInterfaces.NamedEntityTagRequest{
+		ResourceType: resourceType,
+		ID:           &namedEntityIdentifier,
+		Tags:         []string{"team:growth"},
+	})
+	assert.NoError(t, err)
+
+	entityTags, err := manager.GetNamedEntityTags(context.Background(), admin.NamedEntityGetRequest{
+		ResourceType: resourceType,
+		Id:           &namedEntityIdentifier,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tier:critical"}, entityTags)
+}
+
+func TestNamedEntityManager_Tag_TooManyTags(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+	namedEntityRepo := repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo)
+	namedEntityRepo.SetListTagsCallback(func(input interfaces.GetNamedEntityInput) ([]string, error) {
+		existingTags := make([]string, 32)
+		for i := range existingTags {
+			existingTags[i] = fmt.Sprintf("tag-%d", i)
+		}
+		return existingTags, nil
+	})
+	namedEntityRepo.SetAddTagsCallback(func(input interfaces.GetNamedEntityInput, tags []string) error {
+		assert.Fail(t, "entities shouldn't be tagged beyond the limit")
+		return nil
+	})
+
+	err := manager.TagNamedEntity(context.Background(), managerInterfaces.NamedEntityTagRequest{
+		ResourceType: resourceType,
+		ID:           &namedEntityIdentifier,
+		Tags:         []string{"tag-0", "team:growth"},
+	})
+	assert.EqualError(t, err, "a named entity cannot have more than 32 tags, name already has 32")
+}
+
+func TestNamedEntityManager_ListByTag(t *testing.T) {
+	repository := getMockRepositoryForNETest()
+	manager := NewNamedEntityManager(repository, getMockConfigForNETest(), mockScope.NewTestScope())
+	repository.NamedEntityRepo().(*repositoryMocks.MockNamedEntityRepo).SetListByTagCallback(
+		func(input interfaces.ListNamedEntityByTagInput) (interfaces.NamedEntityCollectionOutput, error) {
+			assert.Equal(t, interfaces.ListNamedEntityByTagInput{
+				Tag:           "team:growth",
+				ResourceTypes: []core.ResourceType{core.ResourceType_WORKFLOW, core.ResourceType_LAUNCH_PLAN},
+				Projects:      []string{"flytesnacks"},
+				Limit:         1,
+				Offset:        2,
+			}, input)
+			return interfaces.NamedEntityCollectionOutput{
+				Entities: []models.NamedEntity{
+					{
+						NamedEntityKey: models.NamedEntityKey{
+							ResourceType: core.ResourceType_LAUNCH_PLAN,
+							Project:      "flytesnacks",
+							Domain:       domain,
+							Name:         name,
+						},
+					},
+				},
+			}, nil
+		})
+
+	ctx := auth.WithVisibleProjects(context.Background(), sets.NewString("flytesnacks"))
+	entities, err := manager.ListNamedEntitiesByTag(ctx, managerInterfaces.NamedEntityTagListRequest{
+		Tag:   "team:growth",
+		Limit: 1,
+		Token: "2",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, entities.Entities, 1)
+	assert.Equal(t, "flytesnacks", entities.Entities[0].Id.Project)
+	assert.Equal(t, "3", entities.Token)
+}
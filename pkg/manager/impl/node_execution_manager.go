@@ -60,6 +60,8 @@ type NodeExecutionManager struct {
 	urlData        dataInterfaces.RemoteURLInterface
 	eventPublisher notificationInterfaces.Publisher
 	dbEventWriter  eventWriter.NodeExecutionEventWriter
+	// Nil unless every event received is recorded.
+	recordedEventWriter eventWriter.RecordedEventWriter
 }
 
 type updateNodeExecutionStatus int
@@ -224,6 +226,8 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 	*admin.NodeExecutionEventResponse, error) {
 	if err := validation.ValidateNodeExecutionEventRequest(&request, m.config.ApplicationConfiguration().GetRemoteDataConfig().MaxSizeInBytes); err != nil {
 		logger.Debugf(ctx, "CreateNodeEvent called with invalid identifier [%+v]: %v", request.Event.Id, err)
+	} else if m.recordedEventWriter != nil {
+		m.recordedEventWriter.Write(getRecordedNodeEventModel(request))
 	}
 	ctx = getNodeExecutionContext(ctx, request.Event.Id)
 	logger.Debugf(ctx, "Received node execution event for Node Exec Id [%+v] transitioning to phase [%v], w/ Metadata [%v]",
@@ -256,6 +260,22 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 		m.metrics.NodeExecutionsCreated.Inc()
 	} else {
 		phase := core.NodeExecution_Phase(core.NodeExecution_Phase_value[nodeExecutionModel.Phase])
+		if m.config.ApplicationConfiguration().GetTopLevelConfig().GetEventReconciliationConfig().Enabled {
+			reason := getEventSkipReason(eventSequence{
+				duplicate:         phase == request.Event.Phase,
+				currentIsTerminal: common.IsNodeExecutionTerminal(phase),
+				eventIsTerminal:   common.IsNodeExecutionTerminal(request.Event.Phase),
+				lastAppliedAt:     nodeExecutionModel.NodeExecutionUpdatedAt,
+				occurredAt:        request.Event.OccurredAt,
+			})
+			if reason != "" {
+				skippedEvent := getSkippedEventModel(interfaces.NodeEventType, request.Event.Id.ExecutionId,
+					request.RequestId, request.Event.Phase.String(), request.Event.OccurredAt, phase.String(), reason)
+				skippedEvent.NodeID = request.Event.Id.NodeId
+				recordSkippedEvent(ctx, m.db, skippedEvent)
+				return &admin.NodeExecutionEventResponse{}, nil
+			}
+		}
 		updateStatus, err := m.updateNodeExecutionWithEvent(ctx, &request, &nodeExecutionModel, dynamicWorkflowRemoteClosureReference)
 		if err != nil {
 			return nil, err
@@ -280,6 +300,7 @@ func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request admi
 	}
 	m.metrics.NodeExecutionEventsCreated.Inc()
 
+	// Node events aren't written to the outbox, even when it's enabled, so they're published at most once.
 	if err := m.eventPublisher.Publish(ctx, proto.MessageName(&request), &request); err != nil {
 		m.metrics.PublishEventError.Inc()
 		logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
@@ -481,9 +502,66 @@ func (m *NodeExecutionManager) GetNodeExecutionData(
 	return response, nil
 }
 
+func (m *NodeExecutionManager) GetNodeExecutionDataWithOptions(ctx context.Context,
+	request admin.NodeExecutionGetDataRequest, options interfaces.GetDataOptions) (*interfaces.GetDataResponse, error) {
+	if err := validation.ValidateNodeExecutionIdentifier(request.Id); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateGetDataOptions(options); err != nil {
+		return nil, err
+	}
+	ctx = getNodeExecutionContext(ctx, request.Id)
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.Id)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to get node execution with id [%+v] with err %v", request.Id, err)
+		return nil, err
+	}
+	nodeExecution, err := transformers.FromNodeExecutionModel(*nodeExecutionModel)
+	if err != nil {
+		logger.Debugf(ctx, "failed to transform node execution model [%+v] when fetching data: %v", request.Id, err)
+		return nil, err
+	}
+	remoteDataConfig := m.config.ApplicationConfiguration().GetRemoteDataConfig()
+	selector := util.NewLiteralSelector(options)
+	inputs, err := util.GetPartialInputs(ctx, m.urlData, remoteDataConfig, m.storageClient, nodeExecution.InputUri,
+		selector)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := util.GetPartialOutputs(ctx, m.urlData, remoteDataConfig, m.storageClient, nodeExecution.Closure,
+		selector)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.GetDataResponse{
+		Inputs:  inputs,
+		Outputs: outputs,
+	}, nil
+}
+
+func (m *NodeExecutionManager) GetDynamicWorkflow(
+	ctx context.Context, request admin.NodeExecutionGetRequest) (*interfaces.DynamicWorkflow, error) {
+	if err := validation.ValidateNodeExecutionIdentifier(request.Id); err != nil {
+		return nil, err
+	}
+	ctx = getNodeExecutionContext(ctx, request.Id)
+	nodeExecutionModel, err := util.GetNodeExecutionModel(ctx, m.db, request.Id)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to get node execution with id [%+v] with err %v", request.Id, err)
+		return nil, err
+	}
+	if len(nodeExecutionModel.DynamicWorkflowRemoteClosureReference) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"node execution [%+v] didn't yield a dynamic workflow", request.Id)
+	}
+	return util.GetDynamicWorkflow(ctx, m.urlData, m.config.ApplicationConfiguration().GetRemoteDataConfig(),
+		m.storageClient, nodeExecutionModel.DynamicWorkflowRemoteClosureReference)
+}
+
 func NewNodeExecutionManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
 	storagePrefix []string, storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface,
-	eventPublisher notificationInterfaces.Publisher, eventWriter eventWriter.NodeExecutionEventWriter) interfaces.NodeExecutionInterface {
+	eventPublisher notificationInterfaces.Publisher, eventWriter eventWriter.NodeExecutionEventWriter,
+	recordedEventWriter eventWriter.RecordedEventWriter) interfaces.NodeExecutionInterface {
 	metrics := nodeExecutionMetrics{
 		Scope: scope,
 		ActiveNodeExecutions: scope.MustNewGauge("active_node_executions",
@@ -509,11 +587,12 @@ func NewNodeExecutionManager(db repositories.RepositoryInterface, config runtime
 		db:     db,
 		config: config,
 
-		storagePrefix:  storagePrefix,
-		storageClient:  storageClient,
-		metrics:        metrics,
-		urlData:        urlData,
-		eventPublisher: eventPublisher,
-		dbEventWriter:  eventWriter,
+		storagePrefix:       storagePrefix,
+		storageClient:       storageClient,
+		metrics:             metrics,
+		urlData:             urlData,
+		eventPublisher:      eventPublisher,
+		dbEventWriter:       eventWriter,
+		recordedEventWriter: recordedEventWriter,
 	}
 }
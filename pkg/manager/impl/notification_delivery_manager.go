@@ -0,0 +1,186 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+type NotificationDeliveryManager struct {
+	db                 repositories.RepositoryInterface
+	notificationClient notificationInterfaces.Publisher
+}
+
+func toNotificationDelivery(deliveryModel models.NotificationDelivery) *interfaces.NotificationDelivery {
+	return &interfaces.NotificationDelivery{
+		Id: deliveryModel.ID,
+		Execution: &core.WorkflowExecutionIdentifier{
+			Project: deliveryModel.Project,
+			Domain:  deliveryModel.Domain,
+			Name:    deliveryModel.ExecutionName,
+		},
+		Type:      deliveryModel.Type,
+		Status:    deliveryModel.Status,
+		Attempts:  deliveryModel.Attempts,
+		Error:     deliveryModel.LastError,
+		CreatedAt: deliveryModel.CreatedAt,
+		UpdatedAt: deliveryModel.UpdatedAt,
+	}
+}
+
+func validateDeliveryStatus(status string) error {
+	switch status {
+	case "", common.DeliveryPending, common.DeliverySucceeded, common.DeliveryFailed:
+		return nil
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid delivery status [%s]", status)
+	}
+}
+
+func (m *NotificationDeliveryManager) ListNotificationDeliveries(
+	ctx context.Context, request interfaces.ListNotificationDeliveriesRequest) (*interfaces.NotificationDeliveryList,
+	error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(&request.Id); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	if err := validateDeliveryStatus(request.Status); err != nil {
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, &request.Id)
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: request.Id.Project,
+		Domain:  request.Id.Domain,
+	}, common.NotificationDelivery)
+	if err != nil {
+		return nil, err
+	}
+	executionFilter, err := common.NewSingleValueFilter(
+		common.NotificationDelivery, common.Equal, executionNameColumn, request.Id.Name)
+	if err != nil {
+		return nil, err
+	}
+	filters = append(filters, executionFilter)
+	if len(request.Status) > 0 {
+		statusFilter, err := common.NewSingleValueFilter(
+			common.NotificationDelivery, common.Equal, statusColumn, request.Status)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, statusFilter)
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListNotificationDeliveries", request.Token)
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       "created_at",
+		Direction: admin.Sort_DESCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	deliveryModels, err := m.db.NotificationDeliveryRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to list notification deliveries for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	deliveries := make([]*interfaces.NotificationDelivery, 0, len(deliveryModels))
+	for _, deliveryModel := range deliveryModels {
+		deliveries = append(deliveries, toNotificationDelivery(deliveryModel))
+	}
+	var token string
+	if len(deliveryModels) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(deliveryModels))
+	}
+	return &interfaces.NotificationDeliveryList{
+		Deliveries: deliveries,
+		Token:      token,
+	}, nil
+}
+
+func (m *NotificationDeliveryManager) RetryNotificationDelivery(
+	ctx context.Context, request interfaces.RetryNotificationDeliveryRequest) (*interfaces.NotificationDelivery, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(&request.Id); err != nil {
+		return nil, err
+	}
+	if request.DeliveryId == 0 {
+		return nil, shared.GetMissingArgumentError(shared.ID)
+	}
+	ctx = getExecutionContext(ctx, &request.Id)
+	deliveryModel, err := m.db.NotificationDeliveryRepo().Get(ctx, request.DeliveryId)
+	if err != nil {
+		return nil, err
+	}
+	// Deliveries are looked up by the execution they were published for, so that they can't be resent from another
+	// project or domain.
+	if deliveryModel.Project != request.Id.Project || deliveryModel.Domain != request.Id.Domain ||
+		deliveryModel.ExecutionName != request.Id.Name {
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"notification delivery [%d] not found for execution [%+v]", request.DeliveryId, request.Id)
+	}
+	failed, err := m.db.NotificationDeliveryRepo().MarkPending(ctx, request.DeliveryId)
+	if err != nil {
+		return nil, err
+	}
+	if !failed {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"notification delivery [%d] is %s and can only be retried once it has failed",
+			request.DeliveryId, deliveryModel.Status)
+	}
+	trackedNotification, err := implementations.NewTrackedNotification(notificationInterfaces.TrackedNotification{
+		DeliveryID:    deliveryModel.ID,
+		Project:       deliveryModel.Project,
+		Domain:        deliveryModel.Domain,
+		ExecutionName: deliveryModel.ExecutionName,
+		Notification:  deliveryModel.Payload,
+	})
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to serialize notification delivery [%d] with err: %v", request.DeliveryId, err)
+	}
+	if err := m.notificationClient.Publish(ctx, trackedNotificationType, trackedNotification); err != nil {
+		logger.Infof(ctx, "failed to publish notification delivery [%d] with err: %v", request.DeliveryId, err)
+		// The delivery is failed again, so that it can still be retried.
+		if recordErr := m.db.NotificationDeliveryRepo().RecordAttempt(
+			ctx, request.DeliveryId, common.DeliveryFailed, err.Error()); recordErr != nil {
+			logger.Errorf(ctx, "failed to record failed publish of notification delivery [%d] with err: %v",
+				request.DeliveryId, recordErr)
+		}
+		return nil, errors.NewFlyteAdminErrorf(codes.Unavailable,
+			"failed to publish notification delivery [%d]: %v", request.DeliveryId, err)
+	}
+	deliveryModel.Status = common.DeliveryPending
+	return toNotificationDelivery(deliveryModel), nil
+}
+
+func NewNotificationDeliveryManager(repo repositories.RepositoryInterface,
+	notificationClient notificationInterfaces.Publisher) interfaces.NotificationDeliveryInterface {
+	return &NotificationDeliveryManager{
+		db:                 repo,
+		notificationClient: notificationClient,
+	}
+}
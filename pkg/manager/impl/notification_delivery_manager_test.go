@@ -0,0 +1,158 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func getFailedNotificationDelivery() models.NotificationDelivery {
+	return models.NotificationDelivery{
+		ID:            3,
+		Project:       "project",
+		Domain:        "domain",
+		ExecutionName: "name",
+		Type:          "email",
+		Payload:       []byte("email"),
+		Status:        common.DeliveryFailed,
+		Attempts:      1,
+		LastError:     "refused",
+	}
+}
+
+func TestListNotificationDeliveries(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationDeliveryRepo().(*repositoryMocks.NotificationDeliveryRepoInterface).OnListMatch(
+		mock.Anything, mock.MatchedBy(func(input repositoryInterfaces.ListResourceInput) bool {
+			// The project, domain, execution name and status filters.
+			return input.Limit == 1 && input.Offset == 2 && len(input.InlineFilters) == 4
+		})).Return([]models.NotificationDelivery{getFailedNotificationDelivery()}, nil)
+	deliveryManager := NewNotificationDeliveryManager(repository, &notificationMocks.MockPublisher{})
+
+	deliveries, err := deliveryManager.ListNotificationDeliveries(context.Background(),
+		interfaces.ListNotificationDeliveriesRequest{
+			Id:     executionIdentifier,
+			Status: common.DeliveryFailed,
+			Limit:  1,
+			Token:  "2",
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", deliveries.Token)
+	assert.Len(t, deliveries.Deliveries, 1)
+	assert.Equal(t, uint(3), deliveries.Deliveries[0].Id)
+	assert.True(t, proto.Equal(&executionIdentifier, deliveries.Deliveries[0].Execution))
+	assert.Equal(t, "email", deliveries.Deliveries[0].Type)
+	assert.Equal(t, common.DeliveryFailed, deliveries.Deliveries[0].Status)
+	assert.Equal(t, "refused", deliveries.Deliveries[0].Error)
+
+	_, err = deliveryManager.ListNotificationDeliveries(context.Background(),
+		interfaces.ListNotificationDeliveriesRequest{
+			Id:     executionIdentifier,
+			Status: "DROPPED",
+			Limit:  1,
+		})
+	assert.EqualError(t, err, "invalid delivery status [DROPPED]")
+}
+
+func TestRetryNotificationDelivery(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	deliveryRepo := repository.NotificationDeliveryRepo().(*repositoryMocks.NotificationDeliveryRepoInterface)
+	deliveryRepo.OnGetMatch(mock.Anything, uint(3)).Return(getFailedNotificationDelivery(), nil)
+	deliveryRepo.OnMarkPendingMatch(mock.Anything, uint(3)).Return(true, nil)
+	var published proto.Message
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		assert.Equal(t, "flyteadmin.notifications.TrackedNotification", notificationType)
+		published = msg
+		return nil
+	})
+	deliveryManager := NewNotificationDeliveryManager(repository, &publisher)
+
+	delivery, err := deliveryManager.RetryNotificationDelivery(context.Background(),
+		interfaces.RetryNotificationDeliveryRequest{
+			Id:         executionIdentifier,
+			DeliveryId: 3,
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, common.DeliveryPending, delivery.Status)
+	var tracked notificationInterfaces.TrackedNotification
+	assert.NoError(t, json.Unmarshal(published.(*any.Any).Value, &tracked))
+	assert.Equal(t, notificationInterfaces.TrackedNotification{
+		DeliveryID:    3,
+		Project:       "project",
+		Domain:        "domain",
+		ExecutionName: "name",
+		Notification:  []byte("email"),
+	}, tracked)
+}
+
+func TestRetryNotificationDelivery_NotFailed(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	deliveryRepo := repository.NotificationDeliveryRepo().(*repositoryMocks.NotificationDeliveryRepoInterface)
+	delivery := getFailedNotificationDelivery()
+	delivery.Status = common.DeliverySucceeded
+	deliveryRepo.OnGetMatch(mock.Anything, uint(3)).Return(delivery, nil)
+	deliveryRepo.OnMarkPendingMatch(mock.Anything, uint(3)).Return(false, nil)
+	deliveryManager := NewNotificationDeliveryManager(repository, &notificationMocks.MockPublisher{})
+
+	_, err := deliveryManager.RetryNotificationDelivery(context.Background(),
+		interfaces.RetryNotificationDeliveryRequest{
+			Id:         executionIdentifier,
+			DeliveryId: 3,
+		})
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestRetryNotificationDelivery_OtherExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	deliveryRepo := repository.NotificationDeliveryRepo().(*repositoryMocks.NotificationDeliveryRepoInterface)
+	delivery := getFailedNotificationDelivery()
+	delivery.ExecutionName = "other"
+	deliveryRepo.OnGetMatch(mock.Anything, uint(3)).Return(delivery, nil)
+	deliveryManager := NewNotificationDeliveryManager(repository, &notificationMocks.MockPublisher{})
+
+	_, err := deliveryManager.RetryNotificationDelivery(context.Background(),
+		interfaces.RetryNotificationDeliveryRequest{
+			Id:         executionIdentifier,
+			DeliveryId: 3,
+		})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	deliveryRepo.AssertNotCalled(t, "MarkPending", mock.Anything, mock.Anything)
+}
+
+func TestRetryNotificationDelivery_PublishFailure(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	deliveryRepo := repository.NotificationDeliveryRepo().(*repositoryMocks.NotificationDeliveryRepoInterface)
+	deliveryRepo.OnGetMatch(mock.Anything, uint(3)).Return(getFailedNotificationDelivery(), nil)
+	deliveryRepo.OnMarkPendingMatch(mock.Anything, uint(3)).Return(true, nil)
+	deliveryRepo.OnRecordAttemptMatch(mock.Anything, uint(3), common.DeliveryFailed, "unavailable").Return(nil)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		return errors.New("unavailable")
+	})
+	deliveryManager := NewNotificationDeliveryManager(repository, &publisher)
+
+	_, err := deliveryManager.RetryNotificationDelivery(context.Background(),
+		interfaces.RetryNotificationDeliveryRequest{
+			Id:         executionIdentifier,
+			DeliveryId: 3,
+		})
+	assert.Equal(t, codes.Unavailable, err.(flyteAdminErrors.FlyteAdminError).Code())
+	// The delivery is failed again so that it can still be retried.
+	deliveryRepo.AssertCalled(t, "RecordAttempt", mock.Anything, uint(3), common.DeliveryFailed, "unavailable")
+}
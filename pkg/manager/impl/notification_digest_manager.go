@@ -0,0 +1,133 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The number of due digest entries listed at a time when sending digests.
+const digestEntriesBatchSize = 1000
+
+// How long the entries of a digest are leased while it's sent.
+const digestLeaseDuration = 5 * time.Minute
+
+type notificationDigestMetrics struct {
+	Scope        promutils.Scope
+	DigestsSent  prometheus.Counter
+	SendFailures prometheus.Counter
+}
+
+type NotificationDigestManager struct {
+	db                 repositories.RepositoryInterface
+	config             runtimeInterfaces.Configuration
+	notificationClient notificationInterfaces.Publisher
+	metrics            notificationDigestMetrics
+}
+
+// Splits due digest entries, which are listed ordered by group, into the entries of each digest.
+func groupDigestEntries(entries []models.NotificationDigestEntry) [][]models.NotificationDigestEntry {
+	var groups [][]models.NotificationDigestEntry
+	for i, entry := range entries {
+		if i == 0 || entry.GroupKey != entries[i-1].GroupKey {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], entry)
+	}
+	return groups
+}
+
+// Claims the entries of a digest by leasing them, so that a digest is sent once when several admins send digests,
+// then publishes its email and deletes the entries. The entries are released if the email can't be published, and
+// those of senders which stop before deleting them are claimed again once their lease expires, so that the digest is
+// retried.
+func (m *NotificationDigestManager) sendDigest(ctx context.Context, entries []models.NotificationDigestEntry) error {
+	ids := make([]uint, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+	now := time.Now()
+	claimed, err := m.db.NotificationDigestRepo().Claim(ctx, repositoryInterfaces.ClaimNotificationDigestEntriesInput{
+		IDs:         ids,
+		Now:         now,
+		LeasedUntil: now.Add(digestLeaseDuration),
+	})
+	if err != nil {
+		return err
+	}
+	if len(claimed) == 0 {
+		logger.Debugf(ctx, "digest [%s] is already being sent", entries[0].GroupKey)
+		return nil
+	}
+	leaseID := claimed[0].LeaseID
+	if len(claimed) < len(entries) {
+		// Another admin claimed part of the digest, which it sends once it claims the rest.
+		logger.Debugf(ctx, "digest [%s] is already being sent", entries[0].GroupKey)
+		return m.db.NotificationDigestRepo().Release(ctx, leaseID)
+	}
+	emailMessage := notifications.ToDigestEmailMessage(
+		*m.config.ApplicationConfiguration().GetNotificationsConfig(), claimed)
+	if err := m.notificationClient.Publish(ctx, emailNotificationType, emailMessage); err != nil {
+		if releaseErr := m.db.NotificationDigestRepo().Release(ctx, leaseID); releaseErr != nil {
+			logger.Errorf(ctx, "failed to release the entries of digest [%s] with err: %v",
+				entries[0].GroupKey, releaseErr)
+		}
+		return err
+	}
+	m.metrics.DigestsSent.Inc()
+	if err := m.db.NotificationDigestRepo().Delete(ctx, leaseID); err != nil {
+		// The digest is sent again once the lease expires.
+		logger.Errorf(ctx, "failed to delete the entries of sent digest [%s] with err: %v", entries[0].GroupKey, err)
+	}
+	return nil
+}
+
+func (m *NotificationDigestManager) SendDueDigests(ctx context.Context) error {
+	entries, err := m.db.NotificationDigestRepo().ListDue(ctx, time.Now(), digestEntriesBatchSize)
+	if err != nil {
+		return err
+	}
+	groups := groupDigestEntries(entries)
+	// When the batch is full, the last digest may have more entries which weren't listed. It's sent the next time,
+	// unless it fills the whole batch.
+	if len(entries) == digestEntriesBatchSize && len(groups) > 1 {
+		groups = groups[:len(groups)-1]
+	}
+	for _, group := range groups {
+		if err := m.sendDigest(ctx, group); err != nil {
+			m.metrics.SendFailures.Inc()
+			logger.Warningf(ctx, "failed to send digest [%s] with err: %v", group[0].GroupKey, err)
+		}
+	}
+	return nil
+}
+
+func NewNotificationDigestManager(
+	db repositories.RepositoryInterface,
+	config runtimeInterfaces.Configuration,
+	notificationClient notificationInterfaces.Publisher,
+	scope promutils.Scope) interfaces.NotificationDigestInterface {
+	metrics := notificationDigestMetrics{
+		Scope: scope,
+		DigestsSent: scope.MustNewCounter("digests_sent",
+			"overall count of notification digests sent"),
+		SendFailures: scope.MustNewCounter("send_failures",
+			"overall count of failures sending a notification digest, which are retried"),
+	}
+	return &NotificationDigestManager{
+		db:                 db,
+		config:             config,
+		notificationClient: notificationClient,
+		metrics:            metrics,
+	}
+}
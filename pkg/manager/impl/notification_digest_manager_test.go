@@ -0,0 +1,155 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	notificationMocks "github.com/flyteorg/flyteadmin/pkg/async/notifications/mocks"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getDueDigestEntries() []models.NotificationDigestEntry {
+	return []models.NotificationDigestEntry{
+		{ID: 1, Project: "project", Domain: "domain", LaunchPlan: "lp", ExecutionName: "a", Phase: "succeeded",
+			Recipients: "a@example.com", GroupKey: "project/domain/lp|a@example.com"},
+		{ID: 2, Project: "project", Domain: "domain", LaunchPlan: "lp", ExecutionName: "b", Phase: "failed",
+			Recipients: "a@example.com", GroupKey: "project/domain/lp|a@example.com"},
+		{ID: 3, Project: "project", Domain: "domain", LaunchPlan: "lp", ExecutionName: "c", Phase: "succeeded",
+			Recipients: "b@example.com", GroupKey: "project/domain/lp|b@example.com"},
+	}
+}
+
+func getMockDigestsConfigProvider() runtimeInterfaces.Configuration {
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetNotificationsConfig(
+		runtimeInterfaces.NotificationsConfig{
+			NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+				Sender: "flyte@example.com",
+			},
+		})
+	return mockConfig
+}
+
+// Leases the entries with the given ids which are listed in entries.
+func getClaimedDigestEntries(
+	entries []models.NotificationDigestEntry, leaseID string, ids ...uint) []models.NotificationDigestEntry {
+	var claimed []models.NotificationDigestEntry
+	for _, entry := range entries {
+		for _, id := range ids {
+			if entry.ID == id {
+				entry.LeaseID = leaseID
+				claimed = append(claimed, entry)
+			}
+		}
+	}
+	return claimed
+}
+
+// Matches claims of the entries with the given ids.
+func claimsDigestEntries(ids ...uint) interface{} {
+	return mock.MatchedBy(func(input repositoryInterfaces.ClaimNotificationDigestEntriesInput) bool {
+		return assert.ObjectsAreEqual(ids, input.IDs) && input.LeasedUntil.After(input.Now)
+	})
+}
+
+func TestSendDueDigests(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	digestRepo := repository.NotificationDigestRepo().(*repositoryMocks.NotificationDigestRepoInterface)
+	entries := getDueDigestEntries()
+	digestRepo.OnListDueMatch(mock.Anything, mock.Anything, digestEntriesBatchSize).Return(entries, nil)
+	digestRepo.OnClaimMatch(mock.Anything, claimsDigestEntries(1, 2)).Return(
+		getClaimedDigestEntries(entries, "lease", 1, 2), nil)
+	digestRepo.OnDelete(mock.Anything, "lease").Return(nil)
+	// The second digest is already being sent by another admin.
+	digestRepo.OnClaimMatch(mock.Anything, claimsDigestEntries(3)).Return(nil, nil)
+	var published []proto.Message
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		assert.Equal(t, emailNotificationType, notificationType)
+		published = append(published, msg)
+		return nil
+	})
+	digestManager := NewNotificationDigestManager(repository, getMockDigestsConfigProvider(), &publisher,
+		mockScope.NewTestScope())
+
+	assert.NoError(t, digestManager.SendDueDigests(context.Background()))
+	assert.Len(t, published, 1)
+	emailMessage := published[0].(*admin.EmailMessage)
+	assert.Equal(t, "Flyte digest: 2 executions of launch plan lp in project project, domain domain",
+		emailMessage.SubjectLine)
+	assert.Equal(t, "flyte@example.com", emailMessage.SenderEmail)
+	assert.Equal(t, []string{"a@example.com"}, emailMessage.RecipientsEmail)
+	digestRepo.AssertNumberOfCalls(t, "Delete", 1)
+	digestRepo.AssertNotCalled(t, "Release", mock.Anything, mock.Anything)
+}
+
+func TestSendDueDigests_PartlyClaimed(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	digestRepo := repository.NotificationDigestRepo().(*repositoryMocks.NotificationDigestRepoInterface)
+	entries := getDueDigestEntries()[:2]
+	digestRepo.OnListDueMatch(mock.Anything, mock.Anything, digestEntriesBatchSize).Return(entries, nil)
+	// Another admin claimed the first entry, so the second is released rather than sent alone.
+	digestRepo.OnClaimMatch(mock.Anything, claimsDigestEntries(1, 2)).Return(
+		getClaimedDigestEntries(entries, "lease", 2), nil)
+	digestRepo.OnRelease(mock.Anything, "lease").Return(nil)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		t.Fatal("published a partly claimed digest")
+		return nil
+	})
+	digestManager := NewNotificationDigestManager(repository, getMockDigestsConfigProvider(), &publisher,
+		mockScope.NewTestScope())
+
+	assert.NoError(t, digestManager.SendDueDigests(context.Background()))
+	digestRepo.AssertNumberOfCalls(t, "Release", 1)
+	digestRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestSendDueDigests_PublishError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	digestRepo := repository.NotificationDigestRepo().(*repositoryMocks.NotificationDigestRepoInterface)
+	entries := getDueDigestEntries()[2:]
+	digestRepo.OnListDueMatch(mock.Anything, mock.Anything, digestEntriesBatchSize).Return(entries, nil)
+	digestRepo.OnClaimMatch(mock.Anything, claimsDigestEntries(3)).Return(
+		getClaimedDigestEntries(entries, "lease", 3), nil)
+	// Entries are released, so that their digest is retried.
+	digestRepo.OnRelease(mock.Anything, "lease").Return(nil)
+	var publisher notificationMocks.MockPublisher
+	publisher.SetPublishCallback(func(ctx context.Context, notificationType string, msg proto.Message) error {
+		return errors.New("unavailable")
+	})
+	digestManager := NewNotificationDigestManager(repository, getMockDigestsConfigProvider(), &publisher,
+		mockScope.NewTestScope())
+
+	assert.NoError(t, digestManager.SendDueDigests(context.Background()))
+	digestRepo.AssertNumberOfCalls(t, "Release", 1)
+	digestRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestSendDueDigests_ListError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationDigestRepo().(*repositoryMocks.NotificationDigestRepoInterface).OnListDueMatch(
+		mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("foo"))
+	digestManager := NewNotificationDigestManager(repository, getMockDigestsConfigProvider(),
+		&notificationMocks.MockPublisher{}, mockScope.NewTestScope())
+
+	assert.EqualError(t, digestManager.SendDueDigests(context.Background()), "foo")
+}
+
+func TestGroupDigestEntries(t *testing.T) {
+	groups := groupDigestEntries(getDueDigestEntries())
+	assert.Len(t, groups, 2)
+	assert.Len(t, groups[0], 2)
+	assert.Equal(t, "c", groups[1][0].ExecutionName)
+	assert.Empty(t, groupDigestEntries(nil))
+}
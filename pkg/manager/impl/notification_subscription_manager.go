@@ -0,0 +1,181 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	launchPlanColumn = "launch_plan"
+	addressColumn    = "address"
+)
+
+type NotificationSubscriptionManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func toNotificationSubscription(subscriptionModel models.NotificationSubscription) *interfaces.NotificationSubscription {
+	return &interfaces.NotificationSubscription{
+		Id:         subscriptionModel.ID,
+		Project:    subscriptionModel.Project,
+		Domain:     subscriptionModel.Domain,
+		LaunchPlan: subscriptionModel.LaunchPlan,
+		Phases:     splitWebhookPhases(subscriptionModel.Phases),
+		Type:       subscriptionModel.Type,
+		Address:    subscriptionModel.Address,
+		Principal:  subscriptionModel.Principal,
+		CreatedAt:  subscriptionModel.CreatedAt,
+		UpdatedAt:  subscriptionModel.UpdatedAt,
+	}
+}
+
+func (m *NotificationSubscriptionManager) Subscribe(
+	ctx context.Context, request interfaces.SubscribeRequest) (*interfaces.NotificationSubscription, error) {
+	if err := validation.ValidateSubscribeRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid subscribe request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	if err := validation.ValidateProjectAndDomain(ctx, m.db, m.config.ApplicationConfiguration(),
+		request.Project, request.Domain); err != nil {
+		return nil, err
+	}
+	key := models.NotificationSubscriptionKey{
+		Project:    request.Project,
+		Domain:     request.Domain,
+		LaunchPlan: request.LaunchPlan,
+		Type:       request.Type,
+		Address:    request.Address,
+	}
+	subscriptionModel, err := m.db.NotificationSubscriptionRepo().Get(ctx, key)
+	if err == nil {
+		subscriptionModel.Phases = joinWebhookPhases(request.Phases)
+		subscriptionModel.Principal = getUser(ctx)
+		err = m.db.NotificationSubscriptionRepo().Update(ctx, subscriptionModel)
+	} else if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+		err = m.db.NotificationSubscriptionRepo().Create(ctx, models.NotificationSubscription{
+			NotificationSubscriptionKey: key,
+			Phases:                      joinWebhookPhases(request.Phases),
+			Principal:                   getUser(ctx),
+		})
+	}
+	if err != nil {
+		logger.Debugf(ctx, "failed to subscribe [%s] to [%s/%s/%s] with err: %v", request.Address,
+			request.Project, request.Domain, request.LaunchPlan, err)
+		return nil, err
+	}
+	subscriptionModel, err = m.db.NotificationSubscriptionRepo().Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return toNotificationSubscription(subscriptionModel), nil
+}
+
+func (m *NotificationSubscriptionManager) Unsubscribe(ctx context.Context, request interfaces.UnsubscribeRequest) error {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := validation.ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	if request.Id == 0 {
+		return shared.GetMissingArgumentError(shared.ID)
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	return m.db.NotificationSubscriptionRepo().Delete(ctx, request.Project, request.Domain, request.Id)
+}
+
+func (m *NotificationSubscriptionManager) ListNotificationSubscriptions(
+	ctx context.Context, request interfaces.ListNotificationSubscriptionsRequest) (
+	*interfaces.NotificationSubscriptionList, error) {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: request.Project,
+		Domain:  request.Domain,
+	}, common.NotificationSubscription)
+	if err != nil {
+		return nil, err
+	}
+	equalFilters := map[string]string{
+		launchPlanColumn: request.LaunchPlan,
+		addressColumn:    request.Address,
+	}
+	for _, column := range []string{launchPlanColumn, addressColumn} {
+		if len(equalFilters[column]) == 0 {
+			continue
+		}
+		filter, err := common.NewSingleValueFilter(
+			common.NotificationSubscription, common.Equal, column, equalFilters[column])
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListNotificationSubscriptions", request.Token)
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       shared.ID,
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	subscriptionModels, err := m.db.NotificationSubscriptionRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to list notification subscriptions for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	subscriptions := make([]*interfaces.NotificationSubscription, 0, len(subscriptionModels))
+	for _, subscriptionModel := range subscriptionModels {
+		subscriptions = append(subscriptions, toNotificationSubscription(subscriptionModel))
+	}
+	var token string
+	if len(subscriptionModels) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(subscriptionModels))
+	}
+	return &interfaces.NotificationSubscriptionList{
+		Subscriptions: subscriptions,
+		Token:         token,
+	}, nil
+}
+
+func NewNotificationSubscriptionManager(db repositories.RepositoryInterface,
+	config runtimeInterfaces.Configuration) interfaces.NotificationSubscriptionInterface {
+	return &NotificationSubscriptionManager{
+		db:     db,
+		config: config,
+	}
+}
@@ -0,0 +1,142 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func getNotificationSubscriptionModel() models.NotificationSubscription {
+	return models.NotificationSubscription{
+		ID: 1,
+		NotificationSubscriptionKey: models.NotificationSubscriptionKey{
+			Project:    "project",
+			Domain:     "development",
+			LaunchPlan: "daily",
+			Type:       "email",
+			Address:    "alice@example.com",
+		},
+		Phases:    "FAILED,TIMED_OUT",
+		Principal: "user",
+	}
+}
+
+func getSubscribeRequest() interfaces.SubscribeRequest {
+	return interfaces.SubscribeRequest{
+		Project:    "project",
+		Domain:     "development",
+		LaunchPlan: "daily",
+		Phases:     []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED, core.WorkflowExecution_TIMED_OUT},
+		Type:       "email",
+		Address:    "alice@example.com",
+	}
+}
+
+func TestSubscribe_Create(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	subscriptionRepo := repository.NotificationSubscriptionRepo().(*repositoryMocks.NotificationSubscriptionRepoInterface)
+	subscriptionRepo.OnGetMatch(mock.Anything, mock.Anything).Return(
+		models.NotificationSubscription{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")).Once()
+	subscriptionRepo.OnGetMatch(mock.Anything, mock.Anything).Return(getNotificationSubscriptionModel(), nil)
+	var created models.NotificationSubscription
+	subscriptionRepo.OnCreateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		created = args.Get(1).(models.NotificationSubscription)
+	})
+	subscriptionManager := NewNotificationSubscriptionManager(repository, getMockExecutionsConfigProvider())
+
+	ctx := auth.NewIdentityContext("", "user", "", time.Now(), sets.NewString(), nil).WithContext(context.Background())
+	subscription, err := subscriptionManager.Subscribe(ctx, getSubscribeRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, "daily", created.LaunchPlan)
+	assert.Equal(t, "alice@example.com", created.Address)
+	assert.Equal(t, "FAILED,TIMED_OUT", created.Phases)
+	assert.Equal(t, "user", created.Principal)
+	subscriptionRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+	assert.Equal(t, uint(1), subscription.Id)
+	assert.Equal(t, []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED, core.WorkflowExecution_TIMED_OUT},
+		subscription.Phases)
+	assert.Equal(t, "user", subscription.Principal)
+}
+
+func TestSubscribe_Update(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	subscriptionRepo := repository.NotificationSubscriptionRepo().(*repositoryMocks.NotificationSubscriptionRepoInterface)
+	subscriptionRepo.OnGetMatch(mock.Anything, mock.Anything).Return(getNotificationSubscriptionModel(), nil)
+	var updated models.NotificationSubscription
+	subscriptionRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.NotificationSubscription)
+	})
+	subscriptionManager := NewNotificationSubscriptionManager(repository, getMockExecutionsConfigProvider())
+
+	request := getSubscribeRequest()
+	request.Phases = []core.WorkflowExecution_Phase{core.WorkflowExecution_SUCCEEDED}
+	_, err := subscriptionManager.Subscribe(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), updated.ID)
+	assert.Equal(t, "SUCCEEDED", updated.Phases)
+	subscriptionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestSubscribe_Invalid(t *testing.T) {
+	subscriptionManager := NewNotificationSubscriptionManager(
+		repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider())
+	request := getSubscribeRequest()
+	request.Type = "sms"
+	_, err := subscriptionManager.Subscribe(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestUnsubscribe(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationSubscriptionRepo().(*repositoryMocks.NotificationSubscriptionRepoInterface).OnDelete(
+		mock.Anything, "project", "development", uint(1)).Return(nil)
+	subscriptionManager := NewNotificationSubscriptionManager(repository, getMockExecutionsConfigProvider())
+	assert.NoError(t, subscriptionManager.Unsubscribe(context.Background(), interfaces.UnsubscribeRequest{
+		Project: "project",
+		Domain:  "development",
+		Id:      1,
+	}))
+
+	err := subscriptionManager.Unsubscribe(context.Background(), interfaces.UnsubscribeRequest{
+		Project: "project",
+		Domain:  "development",
+	})
+	assert.EqualError(t, err, "missing id")
+}
+
+func TestListNotificationSubscriptions(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.NotificationSubscriptionRepo().(*repositoryMocks.NotificationSubscriptionRepoInterface).OnListMatch(
+		mock.Anything, mock.MatchedBy(func(input repositoryInterfaces.ListResourceInput) bool {
+			// The project, domain and address filters.
+			return input.Limit == 1 && input.Offset == 2 && len(input.InlineFilters) == 3
+		})).Return([]models.NotificationSubscription{getNotificationSubscriptionModel()}, nil)
+	subscriptionManager := NewNotificationSubscriptionManager(repository, getMockExecutionsConfigProvider())
+
+	subscriptions, err := subscriptionManager.ListNotificationSubscriptions(context.Background(),
+		interfaces.ListNotificationSubscriptionsRequest{
+			Project: "project",
+			Domain:  "development",
+			Address: "alice@example.com",
+			Limit:   1,
+			Token:   "2",
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", subscriptions.Token)
+	assert.Len(t, subscriptions.Subscriptions, 1)
+	assert.Equal(t, "daily", subscriptions.Subscriptions[0].LaunchPlan)
+	assert.Equal(t, "alice@example.com", subscriptions.Subscriptions[0].Address)
+}
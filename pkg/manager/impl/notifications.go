@@ -0,0 +1,179 @@
+package impl
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func getMandatoryNotification(config runtimeInterfaces.ProjectDomainNotifications) (*admin.Notification, error) {
+	notification := &admin.Notification{}
+	for _, phase := range config.Phases {
+		value, ok := core.WorkflowExecution_Phase_value[phase]
+		if !ok {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"mandatory notification configured with unknown phase [%s]", phase)
+		}
+		notification.Phases = append(notification.Phases, core.WorkflowExecution_Phase(value))
+	}
+	switch config.Type {
+	case common.NotificationTypeEmail:
+		notification.Type = &admin.Notification_Email{
+			Email: &admin.EmailNotification{RecipientsEmail: config.Recipients},
+		}
+	case common.NotificationTypeSlack:
+		notification.Type = &admin.Notification_Slack{
+			Slack: &admin.SlackNotification{RecipientsEmail: config.Recipients},
+		}
+	case common.NotificationTypePagerDuty:
+		notification.Type = &admin.Notification_PagerDuty{
+			PagerDuty: &admin.PagerDutyNotification{RecipientsEmail: config.Recipients},
+		}
+	default:
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"mandatory notification configured with unknown type [%s]", config.Type)
+	}
+	return notification, nil
+}
+
+// Appends notifications which aren't already present, so that merged settings don't notify recipients twice.
+func appendNotifications(notifications []*admin.Notification, additions ...*admin.Notification) []*admin.Notification {
+	for _, addition := range additions {
+		duplicate := false
+		for _, notification := range notifications {
+			if proto.Equal(notification, addition) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			notifications = append(notifications, addition)
+		}
+	}
+	return notifications
+}
+
+// Resolves the notifications of an execution launched from a launch plan. Request notification settings take
+// precedence over the launch plan's defaults, or are merged with them when configured to. Mandatory notifications
+// configured for the execution's project and domain are always added, even when the request disables the rest.
+func getExecutionNotifications(config *runtimeInterfaces.NotificationsConfig, id *core.WorkflowExecutionIdentifier,
+	launchPlan *admin.LaunchPlan, requestSpec *admin.ExecutionSpec) ([]*admin.Notification, error) {
+	var notifications []*admin.Notification
+	launchPlanNotifications := launchPlan.GetSpec().GetEntityMetadata().GetNotifications()
+	requestNotifications := requestSpec.GetNotifications().GetNotifications()
+	if requestSpec.GetDisableAll() {
+		notifications = make([]*admin.Notification, 0)
+	} else if len(requestNotifications) == 0 {
+		notifications = appendNotifications(notifications, launchPlanNotifications...)
+	} else if config.MergeLaunchPlanNotifications {
+		notifications = appendNotifications(notifications, launchPlanNotifications...)
+		notifications = appendNotifications(notifications, requestNotifications...)
+	} else {
+		notifications = appendNotifications(notifications, requestNotifications...)
+	}
+	for _, mandatoryNotification := range config.GetMandatoryNotifications(id.Project, id.Domain) {
+		notification, err := getMandatoryNotification(mandatoryNotification)
+		if err != nil {
+			return nil, err
+		}
+		notifications = appendNotifications(notifications, notification)
+	}
+	return notifications, nil
+}
+
+func getNotificationType(notification *admin.Notification) string {
+	switch {
+	case notification.GetEmail() != nil:
+		return common.NotificationTypeEmail
+	case notification.GetSlack() != nil:
+		return common.NotificationTypeSlack
+	case notification.GetPagerDuty() != nil:
+		return common.NotificationTypePagerDuty
+	default:
+		return ""
+	}
+}
+
+func getNotificationRecipients(notification *admin.Notification) []string {
+	switch {
+	case notification.GetEmail() != nil:
+		return notification.GetEmail().GetRecipientsEmail()
+	case notification.GetSlack() != nil:
+		return notification.GetSlack().GetRecipientsEmail()
+	default:
+		return notification.GetPagerDuty().GetRecipientsEmail()
+	}
+}
+
+// Returns notifications sending an execution which reached a phase to the subscribers of its launch plan or project,
+// at most one per notification type. Subscribers which the execution's own notifications of the same type already
+// send it to aren't notified twice.
+func getSubscriptionNotifications(notifications []*admin.Notification,
+	subscriptions []models.NotificationSubscription, phase core.WorkflowExecution_Phase) []*admin.Notification {
+	notified := make(map[string]sets.String)
+	for _, notification := range notifications {
+		notificationType := getNotificationType(notification)
+		for _, notificationPhase := range notification.Phases {
+			if notificationPhase != phase {
+				continue
+			}
+			if _, ok := notified[notificationType]; !ok {
+				notified[notificationType] = sets.NewString()
+			}
+			notified[notificationType].Insert(getNotificationRecipients(notification)...)
+		}
+	}
+	recipients := make(map[string][]string)
+	for _, subscription := range subscriptions {
+		if !isSubscriptionPhase(subscription, phase) {
+			continue
+		}
+		if notified[subscription.Type].Has(subscription.Address) {
+			continue
+		}
+		if _, ok := notified[subscription.Type]; !ok {
+			notified[subscription.Type] = sets.NewString()
+		}
+		notified[subscription.Type].Insert(subscription.Address)
+		recipients[subscription.Type] = append(recipients[subscription.Type], subscription.Address)
+	}
+	subscriptionNotifications := make([]*admin.Notification, 0, len(recipients))
+	for _, notificationType := range []string{
+		common.NotificationTypeEmail, common.NotificationTypeSlack, common.NotificationTypePagerDuty} {
+		if len(recipients[notificationType]) == 0 {
+			continue
+		}
+		notification := &admin.Notification{Phases: []core.WorkflowExecution_Phase{phase}}
+		switch notificationType {
+		case common.NotificationTypeEmail:
+			notification.Type = &admin.Notification_Email{
+				Email: &admin.EmailNotification{RecipientsEmail: recipients[notificationType]},
+			}
+		case common.NotificationTypeSlack:
+			notification.Type = &admin.Notification_Slack{
+				Slack: &admin.SlackNotification{RecipientsEmail: recipients[notificationType]},
+			}
+		case common.NotificationTypePagerDuty:
+			notification.Type = &admin.Notification_PagerDuty{
+				PagerDuty: &admin.PagerDutyNotification{RecipientsEmail: recipients[notificationType]},
+			}
+		}
+		subscriptionNotifications = append(subscriptionNotifications, notification)
+	}
+	return subscriptionNotifications
+}
+
+func isSubscriptionPhase(subscription models.NotificationSubscription, phase core.WorkflowExecution_Phase) bool {
+	for _, subscriptionPhase := range splitWebhookPhases(subscription.Phases) {
+		if subscriptionPhase == phase {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package impl
+
+import (
+	"testing"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var notificationsExecutionID = &core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "production",
+	Name:    "name",
+}
+
+var launchPlanNotification = &admin.Notification{
+	Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_SUCCEEDED},
+	Type: &admin.Notification_Email{
+		Email: &admin.EmailNotification{RecipientsEmail: []string{"owner@example.com"}},
+	},
+}
+
+var requestNotification = &admin.Notification{
+	Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+	Type: &admin.Notification_Slack{
+		Slack: &admin.SlackNotification{RecipientsEmail: []string{"channel@example.slack.com"}},
+	},
+}
+
+var notificationsLaunchPlan = &admin.LaunchPlan{
+	Spec: &admin.LaunchPlanSpec{
+		EntityMetadata: &admin.LaunchPlanMetadata{
+			Notifications: []*admin.Notification{launchPlanNotification},
+		},
+	},
+}
+
+func getNotificationsRequestSpec(notifications ...*admin.Notification) *admin.ExecutionSpec {
+	return &admin.ExecutionSpec{
+		NotificationOverrides: &admin.ExecutionSpec_Notifications{
+			Notifications: &admin.NotificationList{Notifications: notifications},
+		},
+	}
+}
+
+func assertNotifications(t *testing.T, expected, actual []*admin.Notification) {
+	assert.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.True(t, proto.Equal(expected[i], actual[i]), "expected %v but got %v", expected[i], actual[i])
+	}
+}
+
+func TestGetExecutionNotifications(t *testing.T) {
+	config := &runtimeInterfaces.NotificationsConfig{}
+	notifications, err := getExecutionNotifications(
+		config, notificationsExecutionID, notificationsLaunchPlan, getNotificationsRequestSpec())
+	assert.NoError(t, err)
+	assertNotifications(t, []*admin.Notification{launchPlanNotification}, notifications)
+
+	// Request notifications replace the launch plan's by default.
+	notifications, err = getExecutionNotifications(
+		config, notificationsExecutionID, notificationsLaunchPlan, getNotificationsRequestSpec(requestNotification))
+	assert.NoError(t, err)
+	assertNotifications(t, []*admin.Notification{requestNotification}, notifications)
+
+	notifications, err = getExecutionNotifications(config, notificationsExecutionID, notificationsLaunchPlan,
+		&admin.ExecutionSpec{
+			NotificationOverrides: &admin.ExecutionSpec_DisableAll{DisableAll: true},
+		})
+	assert.NoError(t, err)
+	assert.NotNil(t, notifications)
+	assert.Empty(t, notifications)
+}
+
+func TestGetExecutionNotifications_Merged(t *testing.T) {
+	config := &runtimeInterfaces.NotificationsConfig{
+		MergeLaunchPlanNotifications: true,
+	}
+	notifications, err := getExecutionNotifications(config, notificationsExecutionID, notificationsLaunchPlan,
+		getNotificationsRequestSpec(requestNotification, launchPlanNotification))
+	assert.NoError(t, err)
+	assertNotifications(t, []*admin.Notification{launchPlanNotification, requestNotification}, notifications)
+}
+
+func TestGetExecutionNotifications_Mandatory(t *testing.T) {
+	config := &runtimeInterfaces.NotificationsConfig{
+		MandatoryNotifications: []runtimeInterfaces.ProjectDomainNotifications{
+			{
+				Domain:     "production",
+				Phases:     []string{"FAILED", "TIMED_OUT"},
+				Type:       "pagerDuty",
+				Recipients: []string{"oncall@example.com"},
+			},
+			{
+				Project:    "project",
+				Phases:     []string{"SUCCEEDED"},
+				Type:       "email",
+				Recipients: []string{"owner@example.com"},
+			},
+			{
+				Domain:     "development",
+				Phases:     []string{"FAILED"},
+				Type:       "slack",
+				Recipients: []string{"channel@example.slack.com"},
+			},
+		},
+	}
+	pagerDutyNotification := &admin.Notification{
+		Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED, core.WorkflowExecution_TIMED_OUT},
+		Type: &admin.Notification_PagerDuty{
+			PagerDuty: &admin.PagerDutyNotification{RecipientsEmail: []string{"oncall@example.com"}},
+		},
+	}
+
+	// Mandatory notifications which duplicate the launch plan's are only sent once.
+	notifications, err := getExecutionNotifications(
+		config, notificationsExecutionID, notificationsLaunchPlan, getNotificationsRequestSpec())
+	assert.NoError(t, err)
+	assertNotifications(t, []*admin.Notification{launchPlanNotification, pagerDutyNotification}, notifications)
+
+	// Requests can't disable mandatory notifications.
+	notifications, err = getExecutionNotifications(config, notificationsExecutionID, notificationsLaunchPlan,
+		&admin.ExecutionSpec{
+			NotificationOverrides: &admin.ExecutionSpec_DisableAll{DisableAll: true},
+		})
+	assert.NoError(t, err)
+	assertNotifications(t, []*admin.Notification{pagerDutyNotification, launchPlanNotification}, notifications)
+}
+
+func TestGetExecutionNotifications_InvalidMandatoryNotification(t *testing.T) {
+	for _, mandatoryNotification := range []runtimeInterfaces.ProjectDomainNotifications{
+		{Phases: []string{"EXPLODED"}, Type: "email"},
+		{Phases: []string{"FAILED"}, Type: "carrier pigeon"},
+	} {
+		config := &runtimeInterfaces.NotificationsConfig{
+			MandatoryNotifications: []runtimeInterfaces.ProjectDomainNotifications{mandatoryNotification},
+		}
+		_, err := getExecutionNotifications(
+			config, notificationsExecutionID, notificationsLaunchPlan, getNotificationsRequestSpec())
+		assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
+
+func TestGetSubscriptionNotifications(t *testing.T) {
+	subscription := func(notificationType, address, phases string) models.NotificationSubscription {
+		return models.NotificationSubscription{
+			NotificationSubscriptionKey: models.NotificationSubscriptionKey{
+				Project: "project",
+				Domain:  "production",
+				Type:    notificationType,
+				Address: address,
+			},
+			Phases: phases,
+		}
+	}
+	subscriptions := []models.NotificationSubscription{
+		subscription("email", "alice@example.com", "FAILED"),
+		subscription("email", "bob@example.com", "FAILED,TIMED_OUT"),
+		subscription("email", "carol@example.com", "SUCCEEDED"),
+		subscription("slack", "channel@example.slack.com", "FAILED"),
+		subscription("pagerDuty", "service@example.pagerduty.com", "FAILED"),
+		subscription("pagerDuty", "service@example.pagerduty.com", "FAILED"),
+	}
+	// The Slack channel is already notified of failures by the request's notification.
+	notifications := getSubscriptionNotifications(
+		[]*admin.Notification{launchPlanNotification, requestNotification}, subscriptions,
+		core.WorkflowExecution_FAILED)
+	assertNotifications(t, []*admin.Notification{
+		{
+			Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+			Type: &admin.Notification_Email{
+				Email: &admin.EmailNotification{RecipientsEmail: []string{"alice@example.com", "bob@example.com"}},
+			},
+		},
+		{
+			Phases: []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+			Type: &admin.Notification_PagerDuty{
+				PagerDuty: &admin.PagerDutyNotification{RecipientsEmail: []string{"service@example.pagerduty.com"}},
+			},
+		},
+	}, notifications)
+
+	// The launch plan's notification of successes doesn't send to subscribers of failures.
+	notifications = getSubscriptionNotifications(
+		[]*admin.Notification{launchPlanNotification}, subscriptions[:1], core.WorkflowExecution_SUCCEEDED)
+	assert.Empty(t, notifications)
+}
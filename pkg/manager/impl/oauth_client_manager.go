@@ -0,0 +1,295 @@
+package impl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/ory/fosite"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	oauthClientSecretBytes = 32
+	// The work factor the authorization server hashes secrets with by default.
+	oauthClientSecretWorkFactor = 12
+	redirectURISeparator        = "\n"
+	oauthValueSeparator         = " "
+)
+
+// Scopes which grant access to every project, or to write the events of any execution, so that only holders of the
+// admin role may register clients with them.
+var privilegedOAuthScopes = sets.NewString(auth.ScopeAll, auth.ScopeEventsWrite)
+
+type OAuthClientManager struct {
+	db repositories.RepositoryInterface
+	// The ids of the static clients of the authorization server, which registered clients can't use.
+	staticClientIDs sets.String
+	// The role allowed to register clients with privileged scopes. Nobody may if it's empty.
+	adminRole string
+	hasher    fosite.Hasher
+}
+
+func splitOAuthValues(values, separator string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return strings.Split(values, separator)
+}
+
+func toOAuthClient(clientModel models.OAuthClient) *interfaces.OAuthClient {
+	return &interfaces.OAuthClient{
+		ClientID:      clientModel.ClientID,
+		Owner:         clientModel.Owner,
+		CreatedAt:     clientModel.CreatedAt,
+		Public:        clientModel.Public,
+		RedirectURIs:  splitOAuthValues(clientModel.RedirectURIs, redirectURISeparator),
+		GrantTypes:    splitOAuthValues(clientModel.GrantTypes, oauthValueSeparator),
+		ResponseTypes: splitOAuthValues(clientModel.ResponseTypes, oauthValueSeparator),
+		Scopes:        splitOAuthValues(clientModel.Scopes, oauthValueSeparator),
+	}
+}
+
+// Returns a new random secret along with its hash.
+func (m *OAuthClientManager) newSecret(ctx context.Context) (string, []byte, error) {
+	secretBytes := make([]byte, oauthClientSecretBytes)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to generate a client secret: %v", err)
+	}
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+	secretHash, err := m.hasher.Hash(ctx, []byte(secret))
+	if err != nil {
+		return "", nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to hash the client secret: %v", err)
+	}
+	return secret, secretHash, nil
+}
+
+// Returns the caller, who owns the clients they register.
+func getOAuthClientOwner(ctx context.Context) (string, error) {
+	owner := getUser(ctx)
+	if len(owner) == 0 {
+		return "", errors.NewFlyteAdminErrorf(codes.Unauthenticated, "only authenticated users can manage oauth clients")
+	}
+	return owner, nil
+}
+
+// Returns the model of a client the caller owns.
+func (m *OAuthClientManager) getOwnedClient(ctx context.Context, clientID string) (models.OAuthClient, error) {
+	owner, err := getOAuthClientOwner(ctx)
+	if err != nil {
+		return models.OAuthClient{}, err
+	}
+	clientModel, err := m.db.OAuthClientRepo().Get(ctx, clientID)
+	if err != nil {
+		return models.OAuthClient{}, err
+	}
+	if clientModel.Owner != owner {
+		return models.OAuthClient{}, errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+			"oauth client [%s] is owned by another user", clientID)
+	}
+	return clientModel, nil
+}
+
+// Callers can only register clients with scopes their own token holds, so that registering a client can't escalate
+// their privileges, and only admins can register clients with privileged scopes.
+func (m *OAuthClientManager) checkOAuthClientScopes(ctx context.Context, scopes []string) error {
+	callerScopes := auth.IdentityContextFromContext(ctx).Scopes()
+	isAdmin := len(m.adminRole) > 0 && auth.RolesFromContext(ctx).Has(m.adminRole)
+	for _, scope := range scopes {
+		if privilegedOAuthScopes.Has(scope) && !isAdmin {
+			return errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+				"only admins can register oauth clients with the [%s] scope", scope)
+		}
+		if !callerScopes.Has(scope) && !callerScopes.Has(auth.ScopeAll) {
+			return errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+				"oauth clients can't be registered with the [%s] scope, which the caller doesn't hold", scope)
+		}
+	}
+	return nil
+}
+
+func (m *OAuthClientManager) RegisterOAuthClient(
+	ctx context.Context, request interfaces.OAuthClientRegisterRequest) (*interfaces.OAuthClientCredentials, error) {
+	if err := validation.ValidateOAuthClientRegisterRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	owner, err := getOAuthClientOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkOAuthClientScopes(ctx, request.Scopes); err != nil {
+		return nil, err
+	}
+	if m.staticClientIDs.Has(request.ClientID) {
+		return nil, errors.NewFlyteAdminErrorf(codes.AlreadyExists, "oauth client [%s] already exists",
+			request.ClientID)
+	}
+	clientModel := models.OAuthClient{
+		ClientID:      request.ClientID,
+		Owner:         owner,
+		Public:        request.Public,
+		RedirectURIs:  strings.Join(request.RedirectURIs, redirectURISeparator),
+		GrantTypes:    strings.Join(request.GrantTypes, oauthValueSeparator),
+		ResponseTypes: strings.Join(request.ResponseTypes, oauthValueSeparator),
+		Scopes:        strings.Join(request.Scopes, oauthValueSeparator),
+	}
+	var secret string
+	if !request.Public {
+		secret, clientModel.SecretHash, err = m.newSecret(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := m.db.OAuthClientRepo().Create(ctx, clientModel); err != nil {
+		logger.Debugf(ctx, "Failed to register oauth client [%s] with err %v", request.ClientID, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "Registered oauth client [%s] for [%s]", request.ClientID, owner)
+	return &interfaces.OAuthClientCredentials{
+		ClientID: request.ClientID,
+		Secret:   secret,
+	}, nil
+}
+
+func (m *OAuthClientManager) ListOAuthClients(
+	ctx context.Context, request interfaces.OAuthClientListRequest) (*interfaces.OAuthClientList, error) {
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	owner, err := getOAuthClientOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListOAuthClients", request.Token)
+	}
+	clientModels, err := m.db.OAuthClientRepo().List(ctx, repoInterfaces.ListOAuthClientsInput{
+		Owner:  owner,
+		Limit:  int(request.Limit),
+		Offset: offset,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list the oauth clients of [%s] with err %v", owner, err)
+		return nil, err
+	}
+	clients := make([]*interfaces.OAuthClient, len(clientModels))
+	for i, clientModel := range clientModels {
+		clients[i] = toOAuthClient(clientModel)
+	}
+	var token string
+	if len(clients) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(clients))
+	}
+	return &interfaces.OAuthClientList{
+		Clients: clients,
+		Token:   token,
+	}, nil
+}
+
+func (m *OAuthClientManager) RotateOAuthClientSecret(
+	ctx context.Context, clientID string) (*interfaces.OAuthClientCredentials, error) {
+	clientModel, err := m.getOwnedClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if clientModel.Public {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"oauth client [%s] is public and has no secret", clientID)
+	}
+	secret, secretHash, err := m.newSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.db.OAuthClientRepo().UpdateSecret(ctx, clientID, secretHash); err != nil {
+		logger.Debugf(ctx, "Failed to rotate the secret of oauth client [%s] with err %v", clientID, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "Rotated the secret of oauth client [%s]", clientID)
+	return &interfaces.OAuthClientCredentials{
+		ClientID: clientID,
+		Secret:   secret,
+	}, nil
+}
+
+func (m *OAuthClientManager) DeleteOAuthClient(ctx context.Context, clientID string) error {
+	if _, err := m.getOwnedClient(ctx, clientID); err != nil {
+		return err
+	}
+	if err := m.db.OAuthClientRepo().Delete(ctx, clientID); err != nil {
+		logger.Debugf(ctx, "Failed to delete oauth client [%s] with err %v", clientID, err)
+		return err
+	}
+	logger.Infof(ctx, "Deleted oauth client [%s]", clientID)
+	return nil
+}
+
+func (m *OAuthClientManager) GetOAuthClient(ctx context.Context, clientID string) (*interfaces.OAuthClient, error) {
+	clientModel, err := m.db.OAuthClientRepo().Get(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	client := toOAuthClient(clientModel)
+	client.SecretHash = clientModel.SecretHash
+	return client, nil
+}
+
+// Returns an OAuthClientInterface which rejects registrations under the ids of the static clients of the authorization
+// server, and registrations with privileged scopes by callers who don't hold the admin role.
+func NewOAuthClientManager(db repositories.RepositoryInterface, staticClients map[string]*fosite.DefaultClient,
+	adminRole string) interfaces.OAuthClientInterface {
+	staticClientIDs := sets.NewString()
+	for clientID := range staticClients {
+		staticClientIDs.Insert(clientID)
+	}
+	return &OAuthClientManager{
+		db:              db,
+		staticClientIDs: staticClientIDs,
+		adminRole:       adminRole,
+		hasher:          &fosite.BCrypt{WorkFactor: oauthClientSecretWorkFactor},
+	}
+}
+
+// OAuthClientLookup finds the clients registered through an OAuthClientInterface for the internal authorization
+// server.
+type OAuthClientLookup struct {
+	manager interfaces.OAuthClientInterface
+}
+
+func (l OAuthClientLookup) GetClient(ctx context.Context, id string) (fosite.Client, error) {
+	client, err := l.manager.GetOAuthClient(ctx, id)
+	if err != nil {
+		if ec, ok := err.(errors.FlyteAdminError); ok && ec.Code() == codes.NotFound {
+			return nil, fosite.ErrNotFound
+		}
+		return nil, err
+	}
+	return &fosite.DefaultClient{
+		ID:            client.ClientID,
+		Secret:        client.SecretHash,
+		RedirectURIs:  client.RedirectURIs,
+		GrantTypes:    client.GrantTypes,
+		ResponseTypes: client.ResponseTypes,
+		Scopes:        client.Scopes,
+		Public:        client.Public,
+	}, nil
+}
+
+func NewOAuthClientLookup(manager interfaces.OAuthClientInterface) OAuthClientLookup {
+	return OAuthClientLookup{
+		manager: manager,
+	}
+}
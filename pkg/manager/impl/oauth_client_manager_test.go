@@ -0,0 +1,222 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/ory/fosite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var staticOAuthClients = map[string]*fosite.DefaultClient{
+	"flytectl": {ID: "flytectl"},
+}
+
+const oauthClientAdminRole = "oauth-admin"
+
+// Returns the context of an admin whose token holds every scope.
+func getOAuthClientContext(user string) context.Context {
+	ctx := auth.NewIdentityContext("", user, "", time.Now(), sets.NewString(auth.ScopeAll), nil).WithContext(
+		context.Background())
+	return auth.WithRoles(ctx, sets.NewString(oauthClientAdminRole))
+}
+
+func TestRegisterOAuthClient(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	clientRepo := repository.OAuthClientRepo().(*repositoryMocks.OAuthClientRepoInterface)
+	var secretHash []byte
+	clientRepo.OnCreateMatch(mock.Anything, mock.MatchedBy(func(input models.OAuthClient) bool {
+		secretHash = input.SecretHash
+		return input.ClientID == "etl-bot" && input.Owner == "jane" &&
+			input.GrantTypes == "client_credentials refresh_token" && input.Scopes == "all offline"
+	})).Return(nil)
+
+	credentials, err := NewOAuthClientManager(repository, staticOAuthClients, oauthClientAdminRole).RegisterOAuthClient(
+		getOAuthClientContext("jane"), interfaces.OAuthClientRegisterRequest{
+			ClientID:      "etl-bot",
+			GrantTypes:    []string{"client_credentials", "refresh_token"},
+			ResponseTypes: []string{"token"},
+			Scopes:        []string{"all", "offline"},
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, "etl-bot", credentials.ClientID)
+	assert.NotEmpty(t, credentials.Secret)
+	// Only the hash of the secret is stored.
+	assert.NoError(t, (&fosite.BCrypt{}).Compare(context.Background(), secretHash, []byte(credentials.Secret)))
+}
+
+func TestRegisterOAuthClient_StaticClient(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	_, err := NewOAuthClientManager(repository, staticOAuthClients, oauthClientAdminRole).RegisterOAuthClient(
+		getOAuthClientContext("jane"), interfaces.OAuthClientRegisterRequest{
+			ClientID:      "flytectl",
+			Public:        true,
+			RedirectURIs:  []string{"http://localhost:53593/callback"},
+			GrantTypes:    []string{"authorization_code"},
+			ResponseTypes: []string{"code"},
+			Scopes:        []string{"all"},
+		})
+	assert.EqualError(t, err, "oauth client [flytectl] already exists")
+}
+
+func TestRegisterOAuthClient_Unauthenticated(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	_, err := NewOAuthClientManager(repository, staticOAuthClients, oauthClientAdminRole).RegisterOAuthClient(
+		context.Background(), interfaces.OAuthClientRegisterRequest{
+			ClientID:      "etl-bot",
+			GrantTypes:    []string{"client_credentials"},
+			ResponseTypes: []string{"token"},
+			Scopes:        []string{"all"},
+		})
+	assert.Equal(t, codes.Unauthenticated, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestRegisterOAuthClient_Scopes(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	clientRepo := repository.OAuthClientRepo().(*repositoryMocks.OAuthClientRepoInterface)
+	clientRepo.OnCreateMatch(mock.Anything, mock.Anything).Return(nil)
+	clientManager := NewOAuthClientManager(repository, staticOAuthClients, oauthClientAdminRole)
+	userCtx := auth.NewIdentityContext("", "jane", "", time.Now(), sets.NewString("offline", "access_token"),
+		nil).WithContext(context.Background())
+
+	for _, test := range []struct {
+		name   string
+		ctx    context.Context
+		scopes []string
+		code   codes.Code
+	}{
+		{name: "held scopes", ctx: userCtx, scopes: []string{"offline", "access_token"}, code: codes.OK},
+		{name: "all by a user", ctx: userCtx, scopes: []string{"all"}, code: codes.PermissionDenied},
+		{name: "events:write by a user", ctx: userCtx, scopes: []string{"events:write"},
+			code: codes.PermissionDenied},
+		{name: "all by an admin", ctx: getOAuthClientContext("jane"), scopes: []string{"all"}, code: codes.OK},
+		{name: "scope not held by an admin", scopes: []string{"events:write"}, code: codes.PermissionDenied,
+			ctx: auth.WithRoles(userCtx, sets.NewString(oauthClientAdminRole))},
+	} {
+		_, err := clientManager.RegisterOAuthClient(test.ctx, interfaces.OAuthClientRegisterRequest{
+			ClientID:      "etl-bot",
+			GrantTypes:    []string{"client_credentials"},
+			ResponseTypes: []string{"token"},
+			Scopes:        test.scopes,
+		})
+		if test.code == codes.OK {
+			assert.NoError(t, err, test.name)
+		} else {
+			assert.Equal(t, test.code, err.(flyteAdminErrors.FlyteAdminError).Code(), test.name)
+		}
+	}
+
+	// Without an admin role configured, nobody may register clients with privileged scopes.
+	_, err := NewOAuthClientManager(repository, staticOAuthClients, "").RegisterOAuthClient(
+		getOAuthClientContext("jane"), interfaces.OAuthClientRegisterRequest{
+			ClientID:      "etl-bot",
+			GrantTypes:    []string{"client_credentials"},
+			ResponseTypes: []string{"token"},
+			Scopes:        []string{"all"},
+		})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListOAuthClients(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	clientRepo := repository.OAuthClientRepo().(*repositoryMocks.OAuthClientRepoInterface)
+	clientRepo.OnList(mock.Anything, repoInterfaces.ListOAuthClientsInput{
+		Owner:  "jane",
+		Limit:  1,
+		Offset: 1,
+	}).Return([]models.OAuthClient{
+		{
+			ClientID:   "etl-bot",
+			Owner:      "jane",
+			SecretHash: []byte("hash"),
+			GrantTypes: "client_credentials",
+			Scopes:     "all offline",
+		},
+	}, nil)
+
+	clients, err := NewOAuthClientManager(repository, staticOAuthClients, oauthClientAdminRole).ListOAuthClients(
+		getOAuthClientContext("jane"), interfaces.OAuthClientListRequest{
+			Limit: 1,
+			Token: "1",
+		})
+	assert.NoError(t, err)
+	assert.Len(t, clients.Clients, 1)
+	assert.Equal(t, []string{"all", "offline"}, clients.Clients[0].Scopes)
+	assert.Empty(t, clients.Clients[0].RedirectURIs)
+	assert.Empty(t, clients.Clients[0].SecretHash)
+	assert.Equal(t, "2", clients.Token)
+}
+
+func TestRotateOAuthClientSecret(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	clientRepo := repository.OAuthClientRepo().(*repositoryMocks.OAuthClientRepoInterface)
+	clientRepo.OnGet(mock.Anything, "etl-bot").Return(models.OAuthClient{
+		ClientID:   "etl-bot",
+		Owner:      "jane",
+		SecretHash: []byte("hash"),
+	}, nil)
+	clientRepo.OnUpdateSecretMatch(mock.Anything, "etl-bot", mock.Anything).Return(nil)
+	clientManager := NewOAuthClientManager(repository, staticOAuthClients, oauthClientAdminRole)
+
+	credentials, err := clientManager.RotateOAuthClientSecret(getOAuthClientContext("jane"), "etl-bot")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, credentials.Secret)
+	clientRepo.AssertCalled(t, "UpdateSecret", mock.Anything, "etl-bot", mock.Anything)
+
+	_, err = clientManager.RotateOAuthClientSecret(getOAuthClientContext("joe"), "etl-bot")
+	assert.EqualError(t, err, "oauth client [etl-bot] is owned by another user")
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteOAuthClient(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	clientRepo := repository.OAuthClientRepo().(*repositoryMocks.OAuthClientRepoInterface)
+	clientRepo.OnGet(mock.Anything, "etl-bot").Return(models.OAuthClient{ClientID: "etl-bot", Owner: "jane"}, nil)
+	clientRepo.OnDelete(mock.Anything, "etl-bot").Return(nil)
+	clientManager := NewOAuthClientManager(repository, staticOAuthClients, oauthClientAdminRole)
+
+	err := clientManager.DeleteOAuthClient(getOAuthClientContext("joe"), "etl-bot")
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+	clientRepo.AssertNotCalled(t, "Delete", mock.Anything, "etl-bot")
+
+	assert.NoError(t, clientManager.DeleteOAuthClient(getOAuthClientContext("jane"), "etl-bot"))
+	clientRepo.AssertCalled(t, "Delete", mock.Anything, "etl-bot")
+}
+
+func TestOAuthClientLookup(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	clientRepo := repository.OAuthClientRepo().(*repositoryMocks.OAuthClientRepoInterface)
+	clientRepo.OnGet(mock.Anything, "etl-bot").Return(models.OAuthClient{
+		ClientID:      "etl-bot",
+		SecretHash:    []byte("hash"),
+		GrantTypes:    "client_credentials",
+		ResponseTypes: "token",
+		Scopes:        "all",
+	}, nil)
+	clientRepo.OnGet(mock.Anything, "unknown").Return(models.OAuthClient{},
+		flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "oauth client [unknown] doesn't exist"))
+	lookup := NewOAuthClientLookup(NewOAuthClientManager(repository, staticOAuthClients, oauthClientAdminRole))
+
+	client, err := lookup.GetClient(context.Background(), "etl-bot")
+	assert.NoError(t, err)
+	assert.Equal(t, &fosite.DefaultClient{
+		ID:            "etl-bot",
+		Secret:        []byte("hash"),
+		GrantTypes:    []string{"client_credentials"},
+		ResponseTypes: []string{"token"},
+		Scopes:        []string{"all"},
+	}, client)
+
+	_, err = lookup.GetClient(context.Background(), "unknown")
+	assert.Equal(t, fosite.ErrNotFound, err)
+}
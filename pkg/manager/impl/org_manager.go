@@ -0,0 +1,414 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// The number of domain quotas of a project read at once when summing up the quota of an org.
+const orgQuotaBatchSize = 100
+
+const orgIdentifierKey = "identifier"
+
+type OrgManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func toOrg(orgModel models.Org) *interfaces.Org {
+	return &interfaces.Org{
+		Id:          orgModel.Identifier,
+		Name:        orgModel.Name,
+		Description: orgModel.Description,
+		Principal:   orgModel.Principal,
+		CreatedAt:   orgModel.CreatedAt,
+		UpdatedAt:   orgModel.UpdatedAt,
+	}
+}
+
+// Orgs are managed by callers who may view every project, since an org grants visibility to the projects moved into
+// it.
+func checkOrgsManageable(ctx context.Context) error {
+	if _, restricted := auth.VisibleProjectsFromContext(ctx); restricted {
+		return errors.NewFlyteAdminError(codes.PermissionDenied,
+			"orgs can only be managed by callers who may view every project")
+	}
+	return nil
+}
+
+func checkOrgVisible(ctx context.Context, org string) error {
+	if _, restricted := auth.VisibleProjectsFromContext(ctx); restricted && !auth.OrgsFromContext(ctx).Has(org) {
+		return errors.NewFlyteAdminErrorf(codes.PermissionDenied, "org [%s] isn't visible to the caller", org)
+	}
+	return nil
+}
+
+func (m *OrgManager) CreateOrg(ctx context.Context, request interfaces.OrgSpec) (*interfaces.Org, error) {
+	if err := validation.ValidateOrgSpec(request); err != nil {
+		logger.Debugf(ctx, "invalid create org request [%+v]: %v", request, err)
+		return nil, err
+	}
+	if err := checkOrgsManageable(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.db.OrgRepo().Create(ctx, models.Org{
+		Identifier:  request.Id,
+		Name:        request.Name,
+		Description: request.Description,
+		Principal:   getUser(ctx),
+	}); err != nil {
+		logger.Debugf(ctx, "failed to create org [%s] with err: %v", request.Id, err)
+		return nil, err
+	}
+	return m.GetOrg(ctx, request.Id)
+}
+
+func (m *OrgManager) GetOrg(ctx context.Context, id string) (*interfaces.Org, error) {
+	if err := validation.ValidateEmptyStringField(id, shared.ID); err != nil {
+		return nil, err
+	}
+	if err := checkOrgVisible(ctx, id); err != nil {
+		return nil, err
+	}
+	orgModel, err := m.db.OrgRepo().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toOrg(orgModel), nil
+}
+
+func (m *OrgManager) UpdateOrg(ctx context.Context, request interfaces.OrgSpec) (*interfaces.Org, error) {
+	if err := validation.ValidateOrgSpec(request); err != nil {
+		logger.Debugf(ctx, "invalid update org request [%+v]: %v", request, err)
+		return nil, err
+	}
+	if err := checkOrgsManageable(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.db.OrgRepo().Update(ctx, models.Org{
+		Identifier:  request.Id,
+		Name:        request.Name,
+		Description: request.Description,
+		Principal:   getUser(ctx),
+	}); err != nil {
+		logger.Debugf(ctx, "failed to update org [%s] with err: %v", request.Id, err)
+		return nil, err
+	}
+	return m.GetOrg(ctx, request.Id)
+}
+
+func (m *OrgManager) DeleteOrg(ctx context.Context, id string) error {
+	if err := validation.ValidateEmptyStringField(id, shared.ID); err != nil {
+		return err
+	}
+	if err := checkOrgsManageable(ctx); err != nil {
+		return err
+	}
+	if err := m.db.OrgRepo().Delete(ctx, id); err != nil {
+		return err
+	}
+	logger.Infof(ctx, "Deleted org [%s]", id)
+	return nil
+}
+
+func (m *OrgManager) ListOrgs(ctx context.Context, request interfaces.ListOrgsRequest) (*interfaces.OrgList, error) {
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListOrgs", request.Token)
+	}
+	var filters []common.InlineFilter
+	if _, restricted := auth.VisibleProjectsFromContext(ctx); restricted {
+		orgFilter, err := common.NewRepeatedValueFilter(common.Org, common.ValueIn, orgIdentifierKey,
+			auth.OrgsFromContext(ctx).List())
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, orgFilter)
+	}
+	orgModels, err := m.db.OrgRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: alphabeticalSortParam,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to list orgs for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	orgs := make([]*interfaces.Org, len(orgModels))
+	for i, orgModel := range orgModels {
+		orgs[i] = toOrg(orgModel)
+	}
+	var token string
+	if len(orgModels) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(orgModels))
+	}
+	return &interfaces.OrgList{
+		Orgs:  orgs,
+		Token: token,
+	}, nil
+}
+
+func (m *OrgManager) ListOrgProjects(
+	ctx context.Context, request interfaces.ListOrgProjectsRequest) (*admin.Projects, error) {
+	if err := validation.ValidateEmptyStringField(request.Org, shared.Org); err != nil {
+		return nil, err
+	}
+	if err := checkOrgVisible(ctx, request.Org); err != nil {
+		return nil, err
+	}
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		RequestFilters: request.Filters,
+	}, common.Project)
+	if err != nil {
+		return nil, err
+	}
+	if len(filters) == 0 {
+		// The repository only excludes archived projects when no filters are given, so preserve that default before
+		// narrowing the query to the org.
+		archivedFilter, err := common.NewSingleValueFilter(
+			common.Project, common.NotEqual, shared.State, int32(admin.Project_ARCHIVED))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, archivedFilter)
+	}
+	orgFilter, err := common.NewSingleValueFilter(common.Project, common.Equal, shared.Org, request.Org)
+	if err != nil {
+		return nil, err
+	}
+	filters, err = util.AddProjectVisibilityFilter(ctx, common.Project, append(filters, orgFilter))
+	if err != nil {
+		return nil, err
+	}
+	sortParameter := alphabeticalSortParam
+	if request.SortBy != nil {
+		sortParameter, err = common.NewSortParameter(*request.SortBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListOrgProjects", request.Token)
+	}
+	projectModels, err := m.db.ProjectRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	domains, err := shared.GetDomains(ctx, m.db, m.config.ApplicationConfiguration())
+	if err != nil {
+		return nil, err
+	}
+	projectDomains := make([]*admin.Domain, len(domains))
+	for i, domain := range domains {
+		projectDomains[i] = &admin.Domain{
+			Id:   domain.ID,
+			Name: domain.Name,
+		}
+	}
+	projects := transformers.FromProjectModels(projectModels, projectDomains)
+	var token string
+	if len(projects) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(projects))
+	}
+	return &admin.Projects{
+		Projects: projects,
+		Token:    token,
+	}, nil
+}
+
+func (m *OrgManager) SetProjectOrg(ctx context.Context, request interfaces.SetProjectOrgRequest) error {
+	if err := validation.ValidateSetProjectOrgRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid set project org request [%+v]: %v", request, err)
+		return err
+	}
+	if err := checkOrgsManageable(ctx); err != nil {
+		return err
+	}
+	if len(request.Org) > 0 {
+		if _, err := m.db.OrgRepo().Get(ctx, request.Org); err != nil {
+			return err
+		}
+	}
+	if err := m.db.ProjectRepo().UpdateOrg(ctx, request.Project, request.Org); err != nil {
+		logger.Debugf(ctx, "failed to move project [%s] into org [%s] with err: %v", request.Project, request.Org, err)
+		return err
+	}
+	logger.Infof(ctx, "Moved project [%s] into org [%s]", request.Project, request.Org)
+	return nil
+}
+
+// Returns the projects which belong to any of the orgs, including archived ones.
+func (m *OrgManager) listOrgProjects(ctx context.Context, orgs []string) ([]models.Project, error) {
+	orgFilter, err := common.NewRepeatedValueFilter(common.Project, common.ValueIn, shared.Org, orgs)
+	if err != nil {
+		return nil, err
+	}
+	return m.db.ProjectRepo().List(ctx, repoInterfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{orgFilter},
+		SortParameter: alphabeticalSortParam,
+	})
+}
+
+func (m *OrgManager) listDomainQuotas(ctx context.Context, project string) ([]models.DomainQuota, error) {
+	projectFilter, err := common.NewSingleValueFilter(common.DomainQuota, common.Equal, shared.Project, project)
+	if err != nil {
+		return nil, err
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       shared.Domain,
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var quotas []models.DomainQuota
+	for {
+		page, err := m.db.DomainQuotaRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         orgQuotaBatchSize,
+			Offset:        len(quotas),
+			InlineFilters: []common.InlineFilter{projectFilter},
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, page...)
+		if len(page) < orgQuotaBatchSize {
+			return quotas, nil
+		}
+	}
+}
+
+// The sums of the caps of domain quotas. A sum is nil, which is unlimited, once one of its caps is unset.
+type quotaCaps struct {
+	cpu    *resource.Quantity
+	memory *resource.Quantity
+	gpu    *resource.Quantity
+}
+
+func (c *quotaCaps) add(quota models.DomainQuota) error {
+	var err error
+	if c.cpu, err = addQuotaCap(c.cpu, quota.CPU); err != nil {
+		return err
+	}
+	if c.memory, err = addQuotaCap(c.memory, quota.Memory); err != nil {
+		return err
+	}
+	c.gpu, err = addQuotaCap(c.gpu, quota.GPU)
+	return err
+}
+
+func addQuotaCap(sum *resource.Quantity, quotaCap string) (*resource.Quantity, error) {
+	if sum == nil || len(quotaCap) == 0 {
+		return nil, nil
+	}
+	quantity, err := resource.ParseQuantity(quotaCap)
+	if err != nil {
+		return nil, err
+	}
+	sum.Add(quantity)
+	return sum, nil
+}
+
+func quotaCapString(quotaCap *resource.Quantity) string {
+	if quotaCap == nil {
+		return ""
+	}
+	return quotaCap.String()
+}
+
+func (m *OrgManager) GetOrgQuota(ctx context.Context, id string) (*interfaces.OrgQuota, error) {
+	if err := validation.ValidateEmptyStringField(id, shared.ID); err != nil {
+		return nil, err
+	}
+	if err := checkOrgVisible(ctx, id); err != nil {
+		return nil, err
+	}
+	if _, err := m.db.OrgRepo().Get(ctx, id); err != nil {
+		return nil, err
+	}
+	projects, err := m.listOrgProjects(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	caps := quotaCaps{
+		cpu:    &resource.Quantity{},
+		memory: &resource.Quantity{},
+		gpu:    &resource.Quantity{},
+	}
+	orgQuota := &interfaces.OrgQuota{
+		Org:      id,
+		Projects: len(projects),
+	}
+	for _, project := range projects {
+		quotas, err := m.listDomainQuotas(ctx, project.Identifier)
+		if err != nil {
+			return nil, err
+		}
+		for _, quota := range quotas {
+			if err := caps.add(quota); err != nil {
+				return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+					"failed to parse the quota of project [%s] and domain [%s]: %v", quota.Project, quota.Domain, err)
+			}
+			orgQuota.DomainQuotas++
+		}
+	}
+	if orgQuota.DomainQuotas > 0 {
+		orgQuota.CPU = quotaCapString(caps.cpu)
+		orgQuota.Memory = quotaCapString(caps.memory)
+		orgQuota.GPU = quotaCapString(caps.gpu)
+	}
+	return orgQuota, nil
+}
+
+func (m *OrgManager) GetOrgProjectIDs(ctx context.Context, orgs []string) ([]string, error) {
+	if len(orgs) == 0 {
+		return nil, nil
+	}
+	projects, err := m.listOrgProjects(ctx, orgs)
+	if err != nil {
+		return nil, err
+	}
+	projectIDs := make([]string, len(projects))
+	for i, project := range projects {
+		projectIDs[i] = project.Identifier
+	}
+	return projectIDs, nil
+}
+
+func NewOrgManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.OrgInterface {
+	return &OrgManager{
+		db:     db,
+		config: config,
+	}
+}
@@ -0,0 +1,212 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func getOrgManagerForTest(repository *repositoryMocks.MockRepository) interfaces.OrgInterface {
+	config := runtimeMocks.NewMockConfigurationProvider(testutils.GetApplicationConfigWithDefaultDomains(), nil, nil,
+		nil, nil, nil)
+	return NewOrgManager(repository, config)
+}
+
+// Returns a context whose caller may only view the flytesnacks project and belongs to the research org.
+func getRestrictedOrgContext() context.Context {
+	ctx := auth.WithVisibleProjects(context.Background(), sets.NewString("flytesnacks"))
+	return auth.WithOrgs(ctx, sets.NewString("research"))
+}
+
+func TestCreateOrg(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	orgRepo := repository.OrgRepo().(*repositoryMocks.OrgRepoInterface)
+	orgRepo.OnCreateMatch(mock.Anything, mock.MatchedBy(func(input models.Org) bool {
+		return input.Identifier == "research" && input.Name == "Research"
+	})).Return(nil)
+	orgRepo.OnGetMatch(mock.Anything, "research").Return(models.Org{
+		Identifier: "research",
+		Name:       "Research",
+	}, nil)
+
+	org, err := getOrgManagerForTest(repository).CreateOrg(context.Background(), interfaces.OrgSpec{
+		Id:   "research",
+		Name: "Research",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "research", org.Id)
+	assert.Equal(t, "Research", org.Name)
+}
+
+func TestCreateOrg_Restricted(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+
+	_, err := getOrgManagerForTest(repository).CreateOrg(getRestrictedOrgContext(), interfaces.OrgSpec{
+		Id:   "finance",
+		Name: "Finance",
+	})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetOrg_Restricted(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.OrgRepo().(*repositoryMocks.OrgRepoInterface).OnGetMatch(mock.Anything, "research").Return(
+		models.Org{Identifier: "research"}, nil)
+	manager := getOrgManagerForTest(repository)
+
+	org, err := manager.GetOrg(getRestrictedOrgContext(), "research")
+	assert.NoError(t, err)
+	assert.Equal(t, "research", org.Id)
+
+	_, err = manager.GetOrg(getRestrictedOrgContext(), "finance")
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListOrgs_Restricted(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.OrgRepo().(*repositoryMocks.OrgRepoInterface).OnListMatch(mock.Anything, mock.MatchedBy(
+		func(input repoInterfaces.ListResourceInput) bool {
+			return len(input.InlineFilters) == 1 && input.InlineFilters[0].GetField() == "identifier" &&
+				input.Limit == 2 && input.Offset == 0
+		})).Return([]models.Org{{Identifier: "research"}}, nil)
+
+	orgs, err := getOrgManagerForTest(repository).ListOrgs(getRestrictedOrgContext(), interfaces.ListOrgsRequest{
+		Limit: 2,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, orgs.Orgs, 1)
+	assert.Empty(t, orgs.Token)
+}
+
+func TestListOrgProjects(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListProjectsFunction = func(
+		ctx context.Context, input repoInterfaces.ListResourceInput) ([]models.Project, error) {
+		fields := make([]string, len(input.InlineFilters))
+		for i, filter := range input.InlineFilters {
+			fields[i] = filter.GetField()
+		}
+		// Archived projects are excluded, and the projects are narrowed down to those of the org the caller may
+		// view.
+		assert.Equal(t, []string{"state", "org", "identifier"}, fields)
+		return []models.Project{{Identifier: "flytesnacks", Org: "research"}}, nil
+	}
+
+	projects, err := getOrgManagerForTest(repository).ListOrgProjects(getRestrictedOrgContext(),
+		interfaces.ListOrgProjectsRequest{
+			Org:   "research",
+			Limit: 1,
+		})
+	assert.NoError(t, err)
+	assert.Len(t, projects.Projects, 1)
+	assert.Equal(t, "flytesnacks", projects.Projects[0].Id)
+	assert.Len(t, projects.Projects[0].Domains, 4)
+	assert.Equal(t, "1", projects.Token)
+}
+
+func TestSetProjectOrg(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.OrgRepo().(*repositoryMocks.OrgRepoInterface).OnGetMatch(mock.Anything, "research").Return(
+		models.Org{Identifier: "research"}, nil)
+	moved := make(map[string]string)
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateOrgFunction = func(
+		ctx context.Context, projectID, org string) error {
+		moved[projectID] = org
+		return nil
+	}
+	manager := getOrgManagerForTest(repository)
+
+	assert.NoError(t, manager.SetProjectOrg(context.Background(), interfaces.SetProjectOrgRequest{
+		Project: "flytesnacks",
+		Org:     "research",
+	}))
+	assert.NoError(t, manager.SetProjectOrg(context.Background(), interfaces.SetProjectOrgRequest{
+		Project: "sandbox",
+	}))
+	assert.Equal(t, map[string]string{"flytesnacks": "research", "sandbox": ""}, moved)
+}
+
+func TestSetProjectOrg_MissingOrg(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.OrgRepo().(*repositoryMocks.OrgRepoInterface).OnGetMatch(mock.Anything, "finance").Return(
+		models.Org{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "org [finance] not found"))
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateOrgFunction = func(
+		ctx context.Context, projectID, org string) error {
+		assert.Fail(t, "projects shouldn't be moved into missing orgs")
+		return nil
+	}
+
+	err := getOrgManagerForTest(repository).SetProjectOrg(context.Background(), interfaces.SetProjectOrgRequest{
+		Project: "flytesnacks",
+		Org:     "finance",
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetOrgQuota(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.OrgRepo().(*repositoryMocks.OrgRepoInterface).OnGetMatch(mock.Anything, "research").Return(
+		models.Org{Identifier: "research"}, nil)
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListProjectsFunction = func(
+		ctx context.Context, input repoInterfaces.ListResourceInput) ([]models.Project, error) {
+		return []models.Project{{Identifier: "flytesnacks"}, {Identifier: "sandbox"}}, nil
+	}
+	quotas := map[string][]models.DomainQuota{
+		"flytesnacks": {
+			{DomainQuotaKey: models.DomainQuotaKey{Project: "flytesnacks", Domain: "development"}, CPU: "8",
+				Memory: "32Gi", GPU: "1"},
+			{DomainQuotaKey: models.DomainQuotaKey{Project: "flytesnacks", Domain: "production"}, CPU: "500m",
+				Memory: "64Gi"},
+		},
+		"sandbox": {
+			{DomainQuotaKey: models.DomainQuotaKey{Project: "sandbox", Domain: "development"}, CPU: "2",
+				Memory: "512Mi", GPU: "2"},
+		},
+	}
+	repository.DomainQuotaRepo().(*repositoryMocks.MockDomainQuotaRepo).ListFunction = func(
+		ctx context.Context, input repoInterfaces.ListResourceInput) ([]models.DomainQuota, error) {
+		expr, err := input.InlineFilters[0].GetGormQueryExpr()
+		assert.NoError(t, err)
+		return quotas[expr.Args.(string)], nil
+	}
+
+	quota, err := getOrgManagerForTest(repository).GetOrgQuota(context.Background(), "research")
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.OrgQuota{
+		Org:          "research",
+		CPU:          "10500m",
+		Memory:       "98816Mi",
+		Projects:     2,
+		DomainQuotas: 3,
+	}, quota)
+}
+
+func TestGetOrgProjectIDs(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListProjectsFunction = func(
+		ctx context.Context, input repoInterfaces.ListResourceInput) ([]models.Project, error) {
+		assert.Equal(t, 0, input.Limit)
+		return []models.Project{{Identifier: "flytesnacks"}, {Identifier: "sandbox"}}, nil
+	}
+	manager := getOrgManagerForTest(repository)
+
+	projectIDs, err := manager.GetOrgProjectIDs(context.Background(), []string{"research"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"flytesnacks", "sandbox"}, projectIDs)
+
+	projectIDs, err = manager.GetOrgProjectIDs(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, projectIDs)
+}
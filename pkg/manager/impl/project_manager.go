@@ -2,21 +2,29 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 
+	"github.com/flyteorg/flyteadmin/auth"
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
 	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
 	"google.golang.org/grpc/codes"
 )
 
+// The maximum number of running purges advanced at once.
+const advanceProjectPurgesBatchSize = 100
+
 type ProjectManager struct {
 	db     repositories.RepositoryInterface
 	config runtimeInterfaces.Configuration
@@ -41,16 +49,19 @@ func (m *ProjectManager) CreateProject(ctx context.Context, request admin.Projec
 	return &admin.ProjectRegisterResponse{}, nil
 }
 
-func (m *ProjectManager) getDomains() []*admin.Domain {
-	configDomains := m.config.ApplicationConfiguration().GetDomainsConfig()
-	var domains = make([]*admin.Domain, len(*configDomains))
-	for index, configDomain := range *configDomains {
+func (m *ProjectManager) getDomains(ctx context.Context) ([]*admin.Domain, error) {
+	systemDomains, err := shared.GetDomains(ctx, m.db, m.config.ApplicationConfiguration())
+	if err != nil {
+		return nil, err
+	}
+	var domains = make([]*admin.Domain, len(systemDomains))
+	for index, systemDomain := range systemDomains {
 		domains[index] = &admin.Domain{
-			Id:   configDomain.ID,
-			Name: configDomain.Name,
+			Id:   systemDomain.ID,
+			Name: systemDomain.Name,
 		}
 	}
-	return domains
+	return domains, nil
 }
 
 func (m *ProjectManager) ListProjects(ctx context.Context, request admin.ProjectListRequest) (*admin.Projects, error) {
@@ -61,6 +72,20 @@ func (m *ProjectManager) ListProjects(ctx context.Context, request admin.Project
 	if err != nil {
 		return nil, err
 	}
+	if _, restricted := auth.VisibleProjectsFromContext(ctx); restricted && len(filters) == 0 {
+		// The repository only excludes archived projects when no filters are given, so preserve that default before
+		// narrowing the query to the visible projects.
+		archivedFilter, err := common.NewSingleValueFilter(
+			common.Project, common.NotEqual, shared.State, int32(admin.Project_ARCHIVED))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, archivedFilter)
+	}
+	filters, err = util.AddProjectVisibilityFilter(ctx, common.Project, filters)
+	if err != nil {
+		return nil, err
+	}
 
 	var sortParameter common.SortParameter
 	if request.SortBy != nil {
@@ -89,7 +114,11 @@ func (m *ProjectManager) ListProjects(ctx context.Context, request admin.Project
 	if err != nil {
 		return nil, err
 	}
-	projects := transformers.FromProjectModels(projectModels, m.getDomains())
+	domains, err := m.getDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects := transformers.FromProjectModels(projectModels, domains)
 
 	var token string
 	if len(projects) == int(request.Limit) {
@@ -107,10 +136,16 @@ func (m *ProjectManager) UpdateProject(ctx context.Context, projectUpdate admin.
 	projectRepo := m.db.ProjectRepo()
 
 	// Fetch the existing project if exists. If not, return err and do not update.
-	_, err := projectRepo.Get(ctx, projectUpdate.Id)
+	existingProject, err := projectRepo.Get(ctx, projectUpdate.Id)
 	if err != nil {
 		return nil, err
 	}
+	if existingProject.State != nil && *existingProject.State == int32(admin.Project_ARCHIVED) &&
+		projectUpdate.State != admin.Project_ARCHIVED {
+		if err := m.checkNotPurging(ctx, projectUpdate.Id); err != nil {
+			return nil, err
+		}
+	}
 
 	// Run validation on the request and return err if validation does not succeed.
 	if err := validation.ValidateProject(projectUpdate); err != nil {
@@ -128,6 +163,309 @@ func (m *ProjectManager) UpdateProject(ctx context.Context, projectUpdate admin.
 	return &response, nil
 }
 
+// Returns a project which is visible to the caller.
+func (m *ProjectManager) getProject(ctx context.Context, projectID string) (models.Project, error) {
+	if err := validation.ValidateEmptyStringField(projectID, shared.Project); err != nil {
+		return models.Project{}, err
+	}
+	if err := checkProjectVisible(ctx, projectID); err != nil {
+		return models.Project{}, err
+	}
+	return m.db.ProjectRepo().Get(ctx, projectID)
+}
+
+func (m *ProjectManager) updateProjectState(ctx context.Context, projectID string, state admin.Project_ProjectState) error {
+	stateInt := int32(state)
+	return m.db.ProjectRepo().UpdateProject(ctx, models.Project{
+		Identifier: projectID,
+		State:      &stateInt,
+	})
+}
+
+// Returns an error if a purge of the project is running, since the records it deletes would otherwise be in use again.
+func (m *ProjectManager) checkNotPurging(ctx context.Context, projectID string) error {
+	purge, err := m.db.ProjectPurgeRepo().GetLatest(ctx, projectID)
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+			return nil
+		}
+		return err
+	}
+	if purge.Phase == models.ProjectPurgeRunning {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "project [%s] is being purged", projectID)
+	}
+	return nil
+}
+
+func (m *ProjectManager) ArchiveProject(ctx context.Context, projectID string) error {
+	project, err := m.getProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if *project.State == int32(admin.Project_ARCHIVED) {
+		return nil
+	}
+	logger.Infof(ctx, "Archiving project [%s]", projectID)
+	// Schedules are deactivated first, so that they're retried if archiving fails, rather than left firing.
+	if err := m.db.SchedulableEntityRepo().DeactivateProject(ctx, projectID); err != nil {
+		return err
+	}
+	return m.updateProjectState(ctx, projectID, admin.Project_ARCHIVED)
+}
+
+func (m *ProjectManager) RestoreProject(ctx context.Context, projectID string) error {
+	project, err := m.getProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if *project.State != int32(admin.Project_ARCHIVED) {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "project [%s] isn't archived", projectID)
+	}
+	if err := m.checkNotPurging(ctx, projectID); err != nil {
+		return err
+	}
+	logger.Infof(ctx, "Restoring project [%s]", projectID)
+	if err := m.updateProjectState(ctx, projectID, admin.Project_ACTIVE); err != nil {
+		return err
+	}
+	// The schedules of launch plans which are still active resume firing.
+	return m.db.SchedulableEntityRepo().ReactivateProject(ctx, projectID)
+}
+
+// Returns the position of a table in the order tables are purged in, or -1 for tables which aren't purged.
+func getPurgeTableIndex(table string) int {
+	for idx, purgeTable := range repoInterfaces.ProjectPurgeTables {
+		if purgeTable == table {
+			return idx
+		}
+	}
+	return -1
+}
+
+func toProjectPurge(purgeModel models.ProjectPurge) *interfaces.ProjectPurge {
+	purgedTables := len(repoInterfaces.ProjectPurgeTables)
+	if purgeModel.Phase != models.ProjectPurgeSucceeded {
+		purgedTables = getPurgeTableIndex(purgeModel.CurrentTable)
+		if purgedTables < 0 {
+			purgedTables = 0
+		}
+	}
+	return &interfaces.ProjectPurge{
+		Project:        purgeModel.Project,
+		Phase:          purgeModel.Phase,
+		Table:          purgeModel.CurrentTable,
+		PurgedTables:   purgedTables,
+		TotalTables:    len(repoInterfaces.ProjectPurgeTables),
+		DeletedRecords: purgeModel.DeletedRecords,
+		LastError:      purgeModel.LastError,
+		Principal:      purgeModel.Principal,
+		CreatedAt:      purgeModel.CreatedAt,
+		UpdatedAt:      purgeModel.UpdatedAt,
+	}
+}
+
+func (m *ProjectManager) PurgeProject(ctx context.Context, projectID string) (*interfaces.ProjectPurge, error) {
+	project, err := m.getProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if *project.State != int32(admin.Project_ARCHIVED) {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"project [%s] must be archived before it's purged", projectID)
+	}
+	if err := m.checkNotPurging(ctx, projectID); err != nil {
+		return nil, err
+	}
+	err = m.db.ProjectPurgeRepo().Create(ctx, models.ProjectPurge{
+		Project:      projectID,
+		Phase:        models.ProjectPurgeRunning,
+		CurrentTable: repoInterfaces.ProjectPurgeTables[0],
+		Principal:    getUser(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Started purging project [%s]", projectID)
+	purgeModel, err := m.db.ProjectPurgeRepo().GetLatest(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return toProjectPurge(purgeModel), nil
+}
+
+func (m *ProjectManager) GetProjectPurge(ctx context.Context, projectID string) (*interfaces.ProjectPurge, error) {
+	if err := validation.ValidateEmptyStringField(projectID, shared.Project); err != nil {
+		return nil, err
+	}
+	// Purged projects no longer exist, so only their visibility is checked.
+	if err := checkProjectVisible(ctx, projectID); err != nil {
+		return nil, err
+	}
+	purgeModel, err := m.db.ProjectPurgeRepo().GetLatest(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return toProjectPurge(purgeModel), nil
+}
+
+// Deletes up to the configured number of batches of a purge's records, moving on to the next table once a batch
+// comes up short, and deletes the project once every table has been purged.
+func (m *ProjectManager) advanceProjectPurge(ctx context.Context, purgeModel models.ProjectPurge) error {
+	purgeConfig := m.config.ApplicationConfiguration().GetTopLevelConfig().GetProjectPurgeConfig()
+	tableIndex := getPurgeTableIndex(purgeModel.CurrentTable)
+	if tableIndex < 0 {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "purge of project [%s] is at unknown table [%s]",
+			purgeModel.Project, purgeModel.CurrentTable)
+	}
+	tables := repoInterfaces.ProjectPurgeTables
+	var err error
+	for batches := 0; batches < purgeConfig.MaxBatches && tableIndex < len(tables); batches++ {
+		var deleted int64
+		deleted, err = m.db.ProjectPurgeRepo().DeleteRecords(ctx, repoInterfaces.DeleteProjectRecordsInput{
+			Table:   tables[tableIndex],
+			Project: purgeModel.Project,
+			Limit:   purgeConfig.BatchSize,
+		})
+		if err != nil {
+			break
+		}
+		purgeModel.DeletedRecords += deleted
+		if deleted < int64(purgeConfig.BatchSize) {
+			tableIndex++
+		}
+	}
+	if err == nil && tableIndex == len(tables) {
+		err = m.db.ProjectPurgeRepo().DeleteProject(ctx, purgeModel.Project)
+		if err == nil {
+			purgeModel.Phase = models.ProjectPurgeSucceeded
+			logger.Infof(ctx, "Purged project [%s] with %d records deleted", purgeModel.Project,
+				purgeModel.DeletedRecords)
+		}
+	}
+	// The batches which were deleted are recorded even if the next one failed.
+	purgeModel.CurrentTable = ""
+	if tableIndex < len(tables) {
+		purgeModel.CurrentTable = tables[tableIndex]
+	}
+	purgeModel.LastError = ""
+	if err != nil {
+		purgeModel.LastError = err.Error()
+	}
+	if updateErr := m.db.ProjectPurgeRepo().Update(ctx, purgeModel); updateErr != nil {
+		return updateErr
+	}
+	return err
+}
+
+func (m *ProjectManager) AdvanceProjectPurges(ctx context.Context) error {
+	purgeModels, err := m.db.ProjectPurgeRepo().ListByPhase(
+		ctx, models.ProjectPurgeRunning, advanceProjectPurgesBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, purgeModel := range purgeModels {
+		if err := m.advanceProjectPurge(ctx, purgeModel); err != nil {
+			logger.Warningf(ctx, "failed to advance purge of project [%s] with err: %v", purgeModel.Project, err)
+		}
+	}
+	return nil
+}
+
+func (m *ProjectManager) GetProjectMetadata(ctx context.Context, projectID string) (*interfaces.ProjectMetadata, error) {
+	project, err := m.getProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	metadata := interfaces.ProjectMetadata{
+		Owner: project.Owner,
+		Team:  project.Team,
+	}
+	if len(project.Metadata) > 0 {
+		if err := json.Unmarshal(project.Metadata, &metadata.Metadata); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to read the metadata of project [%s]: %v",
+				projectID, err)
+		}
+	}
+	if len(project.Links) > 0 {
+		if err := json.Unmarshal(project.Links, &metadata.Links); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to read the links of project [%s]: %v",
+				projectID, err)
+		}
+	}
+	return &metadata, nil
+}
+
+func (m *ProjectManager) UpdateProjectMetadata(
+	ctx context.Context, projectID string, metadata interfaces.ProjectMetadata) error {
+	if err := validation.ValidateProjectMetadata(metadata); err != nil {
+		return err
+	}
+	if _, err := m.getProject(ctx, projectID); err != nil {
+		return err
+	}
+	if metadata.Metadata == nil {
+		metadata.Metadata = map[string]string{}
+	}
+	if metadata.Links == nil {
+		metadata.Links = []interfaces.ProjectLink{}
+	}
+	serializedMetadata, err := json.Marshal(metadata.Metadata)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid metadata: %v", err)
+	}
+	serializedLinks, err := json.Marshal(metadata.Links)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid links: %v", err)
+	}
+	return m.db.ProjectRepo().UpdateMetadata(ctx, models.Project{
+		Identifier: projectID,
+		Owner:      metadata.Owner,
+		Team:       metadata.Team,
+		Metadata:   serializedMetadata,
+		Links:      serializedLinks,
+	})
+}
+
+// Returns the execution defaults stored with a project.
+func getProjectExecutionDefaults(project models.Project) (*interfaces.ProjectExecutionDefaults, error) {
+	var defaults interfaces.ProjectExecutionDefaults
+	if len(project.ExecutionDefaults) > 0 {
+		if err := json.Unmarshal(project.ExecutionDefaults, &defaults); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to read the execution defaults of project [%s]: %v", project.Identifier, err)
+		}
+	}
+	return &defaults, nil
+}
+
+func (m *ProjectManager) GetProjectExecutionDefaults(
+	ctx context.Context, projectID string) (*interfaces.ProjectExecutionDefaults, error) {
+	project, err := m.getProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return getProjectExecutionDefaults(project)
+}
+
+func (m *ProjectManager) UpdateProjectExecutionDefaults(
+	ctx context.Context, projectID string, defaults interfaces.ProjectExecutionDefaults) error {
+	if err := validation.ValidateProjectExecutionDefaults(defaults); err != nil {
+		return err
+	}
+	if _, err := m.getProject(ctx, projectID); err != nil {
+		return err
+	}
+	serializedDefaults, err := json.Marshal(defaults)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid execution defaults: %v", err)
+	}
+	logger.Infof(ctx, "Setting the execution defaults of project [%s] to %s", projectID, serializedDefaults)
+	return m.db.ProjectRepo().UpdateExecutionDefaults(ctx, models.Project{
+		Identifier:        projectID,
+		ExecutionDefaults: serializedDefaults,
+	})
+}
+
 func NewProjectManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.ProjectInterface {
 	return &ProjectManager{
 		db:     db,
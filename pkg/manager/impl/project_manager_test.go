@@ -4,18 +4,27 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/flyteorg/flyteadmin/auth"
 	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	schedulerMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -105,6 +114,30 @@ func TestListProjects_NoToken_NoLimit(t *testing.T) {
 	testListProjects(admin.ProjectListRequest{}, "", "identifier asc", nil, t)
 }
 
+func TestListProjects_VisibilityRestricted(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListProjectsFunction = func(
+		ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error) {
+		assert.Len(t, input.InlineFilters, 2)
+		stateExpr, _ := input.InlineFilters[0].GetGormQueryExpr()
+		assert.Equal(t, common.GormQueryExpr{
+			Query: "state <> ?",
+			Args:  int32(admin.Project_ARCHIVED),
+		}, stateExpr)
+		visibilityExpr, _ := input.InlineFilters[1].GetGormQueryExpr()
+		assert.Equal(t, common.GormQueryExpr{
+			Query: "identifier in (?)",
+			Args:  []string{"project"},
+		}, visibilityExpr)
+		return []models.Project{}, nil
+	}
+
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+	ctx := auth.WithVisibleProjects(context.Background(), sets.NewString("project"))
+	_, err := projectManager.ListProjects(ctx, admin.ProjectListRequest{})
+	assert.NoError(t, err)
+}
+
 func TestProjectManager_CreateProject(t *testing.T) {
 	mockRepository := repositoryMocks.NewMockRepository()
 	var createFuncCalled bool
@@ -279,3 +312,324 @@ func TestProjectManager_UpdateProject_ErrorDueToInvalidProjectName(t *testing.T)
 	})
 	assert.EqualError(t, err, "project_name cannot exceed 64 characters")
 }
+
+func setProjectState(repository *repositoryMocks.MockRepository, state admin.Project_ProjectState) {
+	stateInt := int32(state)
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+		ctx context.Context, projectID string) (models.Project, error) {
+		return models.Project{Identifier: projectID, State: &stateInt}, nil
+	}
+}
+
+func setLatestProjectPurge(repository *repositoryMocks.MockRepository, purge *models.ProjectPurge) {
+	call := repository.ProjectPurgeRepo().(*repositoryMocks.ProjectPurgeRepoInterface).OnGetLatestMatch(
+		mock.Anything, "project")
+	if purge == nil {
+		call.Return(models.ProjectPurge{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "not found"))
+	} else {
+		call.Return(*purge, nil)
+	}
+}
+
+func TestProjectManager_ArchiveProject(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setProjectState(repository, admin.Project_ACTIVE)
+	var updated models.Project
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateProjectFunction = func(
+		ctx context.Context, projectUpdate models.Project) error {
+		updated = projectUpdate
+		return nil
+	}
+	schedulableEntityRepo := repository.SchedulableEntityRepo().(*schedulerMocks.SchedulableEntityRepoInterface)
+	schedulableEntityRepo.OnDeactivateProject(mock.Anything, "project").Return(nil).Once()
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	assert.NoError(t, projectManager.ArchiveProject(context.Background(), "project"))
+	assert.Equal(t, "project", updated.Identifier)
+	assert.Equal(t, int32(admin.Project_ARCHIVED), *updated.State)
+	assert.Empty(t, updated.Name)
+	schedulableEntityRepo.AssertExpectations(t)
+}
+
+func TestProjectManager_ArchiveProject_DeactivateSchedulesError(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setProjectState(repository, admin.Project_ACTIVE)
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateProjectFunction = func(
+		ctx context.Context, projectUpdate models.Project) error {
+		assert.Fail(t, "archived a project whose schedules are still active")
+		return nil
+	}
+	repository.SchedulableEntityRepo().(*schedulerMocks.SchedulableEntityRepoInterface).OnDeactivateProject(
+		mock.Anything, "project").Return(errors.New("expected error"))
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	assert.EqualError(t, projectManager.ArchiveProject(context.Background(), "project"), "expected error")
+}
+
+func TestProjectManager_ArchiveProject_NotVisible(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	ctx := auth.WithVisibleProjects(context.Background(), sets.NewString("other"))
+	err := projectManager.ArchiveProject(ctx, "project")
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestProjectManager_RestoreProject(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setProjectState(repository, admin.Project_ARCHIVED)
+	setLatestProjectPurge(repository, &models.ProjectPurge{Project: "project", Phase: models.ProjectPurgeSucceeded})
+	var updated models.Project
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateProjectFunction = func(
+		ctx context.Context, projectUpdate models.Project) error {
+		updated = projectUpdate
+		return nil
+	}
+	schedulableEntityRepo := repository.SchedulableEntityRepo().(*schedulerMocks.SchedulableEntityRepoInterface)
+	schedulableEntityRepo.OnReactivateProject(mock.Anything, "project").Return(nil).Once()
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	assert.NoError(t, projectManager.RestoreProject(context.Background(), "project"))
+	assert.Equal(t, int32(admin.Project_ACTIVE), *updated.State)
+	schedulableEntityRepo.AssertExpectations(t)
+}
+
+func TestProjectManager_RestoreProject_Purging(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setProjectState(repository, admin.Project_ARCHIVED)
+	setLatestProjectPurge(repository, &models.ProjectPurge{Project: "project", Phase: models.ProjectPurgeRunning})
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateProjectFunction = func(
+		ctx context.Context, projectUpdate models.Project) error {
+		assert.Fail(t, "No calls to UpdateProject were expected")
+		return nil
+	}
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	err := projectManager.RestoreProject(context.Background(), "project")
+	assert.EqualError(t, err, "project [project] is being purged")
+
+	// Purging projects can't be made active by updating them either.
+	_, err = projectManager.UpdateProject(context.Background(), admin.Project{
+		Id:    "project",
+		Name:  "project",
+		State: admin.Project_ACTIVE,
+	})
+	assert.EqualError(t, err, "project [project] is being purged")
+}
+
+func TestProjectManager_RestoreProject_NotArchived(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	err := projectManager.RestoreProject(context.Background(), "project")
+	assert.EqualError(t, err, "project [project] isn't archived")
+}
+
+func TestProjectManager_PurgeProject(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	setProjectState(repository, admin.Project_ARCHIVED)
+	purgeRepo := repository.ProjectPurgeRepo().(*repositoryMocks.ProjectPurgeRepoInterface)
+	purgeRepo.OnGetLatestMatch(mock.Anything, "project").Return(
+		models.ProjectPurge{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "not found")).Once()
+	var created models.ProjectPurge
+	purgeRepo.OnCreateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		created = args.Get(1).(models.ProjectPurge)
+		purgeRepo.OnGetLatestMatch(mock.Anything, "project").Return(created, nil)
+	})
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	ctx := auth.NewIdentityContext("", "user", "", time.Now(), sets.NewString(), nil).WithContext(context.Background())
+	purge, err := projectManager.PurgeProject(ctx, "project")
+	assert.NoError(t, err)
+	assert.Equal(t, models.ProjectPurgeRunning, created.Phase)
+	assert.Equal(t, interfaces.ExecutionEventsTable, created.CurrentTable)
+	assert.Equal(t, "user", created.Principal)
+	assert.Equal(t, "project", purge.Project)
+	assert.Equal(t, 0, purge.PurgedTables)
+	assert.Equal(t, len(interfaces.ProjectPurgeTables), purge.TotalTables)
+}
+
+func TestProjectManager_PurgeProject_NotArchived(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	_, err := projectManager.PurgeProject(context.Background(), "project")
+	assert.EqualError(t, err, "project [project] must be archived before it's purged")
+}
+
+func getMockProjectPurgeConfigProvider() runtimeInterfaces.Configuration {
+	applicationConfig := getMockApplicationConfigForProjectManagerTest()
+	applicationConfig.(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(runtimeInterfaces.ApplicationConfig{
+		ProjectPurge: runtimeInterfaces.ProjectPurgeConfig{
+			BatchSize:  2,
+			MaxBatches: 3,
+		},
+	})
+	return runtimeMocks.NewMockConfigurationProvider(applicationConfig, nil, nil, nil, nil, nil)
+}
+
+func TestProjectManager_AdvanceProjectPurges(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	purgeRepo := repository.ProjectPurgeRepo().(*repositoryMocks.ProjectPurgeRepoInterface)
+	purgeRepo.OnListByPhaseMatch(mock.Anything, models.ProjectPurgeRunning, mock.Anything).Return([]models.ProjectPurge{
+		{
+			Project:        "project",
+			Phase:          models.ProjectPurgeRunning,
+			CurrentTable:   interfaces.ResourcesTable,
+			DeletedRecords: 10,
+			LastError:      "transient",
+		},
+	}, nil)
+	deleteInput := func(table string) interfaces.DeleteProjectRecordsInput {
+		return interfaces.DeleteProjectRecordsInput{Table: table, Project: "project", Limit: 2}
+	}
+	purgeRepo.OnDeleteRecordsMatch(mock.Anything, deleteInput(interfaces.ResourcesTable)).Return(int64(2), nil).Once()
+	purgeRepo.OnDeleteRecordsMatch(mock.Anything, deleteInput(interfaces.ResourcesTable)).Return(int64(1), nil).Once()
+	purgeRepo.OnDeleteRecordsMatch(mock.Anything, deleteInput(interfaces.ProjectLabelsTable)).Return(int64(0), nil).Once()
+	purgeRepo.OnDeleteProjectMatch(mock.Anything, "project").Return(nil)
+	var updated models.ProjectPurge
+	purgeRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.ProjectPurge)
+	})
+	projectManager := NewProjectManager(repository, getMockProjectPurgeConfigProvider())
+
+	assert.NoError(t, projectManager.AdvanceProjectPurges(context.Background()))
+	purgeRepo.AssertExpectations(t)
+	assert.Equal(t, models.ProjectPurgeSucceeded, updated.Phase)
+	assert.Empty(t, updated.CurrentTable)
+	assert.Equal(t, int64(13), updated.DeletedRecords)
+	assert.Empty(t, updated.LastError)
+}
+
+func TestProjectManager_AdvanceProjectPurges_BatchFailure(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	purgeRepo := repository.ProjectPurgeRepo().(*repositoryMocks.ProjectPurgeRepoInterface)
+	purgeRepo.OnListByPhaseMatch(mock.Anything, models.ProjectPurgeRunning, mock.Anything).Return([]models.ProjectPurge{
+		{
+			Project:      "project",
+			Phase:        models.ProjectPurgeRunning,
+			CurrentTable: interfaces.ExecutionsTable,
+		},
+	}, nil)
+	purgeRepo.OnDeleteRecordsMatch(mock.Anything, mock.Anything).Return(int64(2), nil).Once()
+	purgeRepo.OnDeleteRecordsMatch(mock.Anything, mock.Anything).Return(int64(0), errors.New("timeout")).Once()
+	var updated models.ProjectPurge
+	purgeRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.ProjectPurge)
+	})
+	projectManager := NewProjectManager(repository, getMockProjectPurgeConfigProvider())
+
+	assert.NoError(t, projectManager.AdvanceProjectPurges(context.Background()))
+	assert.Equal(t, models.ProjectPurgeRunning, updated.Phase)
+	assert.Equal(t, interfaces.ExecutionsTable, updated.CurrentTable)
+	assert.Equal(t, int64(2), updated.DeletedRecords)
+	assert.Equal(t, "timeout", updated.LastError)
+}
+
+func TestProjectManager_ProjectMetadata(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	activeState := int32(admin.Project_ACTIVE)
+	stored := models.Project{Identifier: "project", State: &activeState}
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateMetadataFunction = func(
+		ctx context.Context, project models.Project) error {
+		assert.Equal(t, "project", project.Identifier)
+		stored.Owner = project.Owner
+		stored.Team = project.Team
+		stored.Metadata = project.Metadata
+		stored.Links = project.Links
+		return nil
+	}
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+		ctx context.Context, projectID string) (models.Project, error) {
+		return stored, nil
+	}
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	metadata := managerInterfaces.ProjectMetadata{
+		Owner:    "alice@example.com",
+		Team:     "ml",
+		Metadata: map[string]string{"cost-center": "1234"},
+		Links: []managerInterfaces.ProjectLink{
+			{Name: "Grafana", URL: "https://grafana.example.com/d/project"},
+		},
+	}
+	assert.NoError(t, projectManager.UpdateProjectMetadata(context.Background(), "project", metadata))
+	saved, err := projectManager.GetProjectMetadata(context.Background(), "project")
+	assert.NoError(t, err)
+	assert.Equal(t, metadata, *saved)
+
+	// Metadata is replaced rather than merged.
+	assert.NoError(t, projectManager.UpdateProjectMetadata(context.Background(), "project",
+		managerInterfaces.ProjectMetadata{Team: "infra"}))
+	saved, err = projectManager.GetProjectMetadata(context.Background(), "project")
+	assert.NoError(t, err)
+	assert.Empty(t, saved.Owner)
+	assert.Equal(t, "infra", saved.Team)
+	assert.Empty(t, saved.Metadata)
+	assert.Empty(t, saved.Links)
+}
+
+func TestProjectManager_UpdateProjectMetadata_InvalidLink(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateMetadataFunction = func(
+		ctx context.Context, project models.Project) error {
+		assert.Fail(t, "No calls to UpdateMetadata were expected")
+		return nil
+	}
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	err := projectManager.UpdateProjectMetadata(context.Background(), "project", managerInterfaces.ProjectMetadata{
+		Links: []managerInterfaces.ProjectLink{
+			{Name: "Grafana", URL: "javascript:alert(1)"},
+		},
+	})
+	assert.EqualError(t, err, "link [Grafana] must be an http or https URL")
+}
+
+func TestProjectManager_ProjectExecutionDefaults(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	activeState := int32(admin.Project_ACTIVE)
+	stored := models.Project{Identifier: "project", State: &activeState}
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateExecutionDefaultsFunction = func(
+		ctx context.Context, project models.Project) error {
+		assert.Equal(t, "project", project.Identifier)
+		stored.ExecutionDefaults = project.ExecutionDefaults
+		return nil
+	}
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).GetFunction = func(
+		ctx context.Context, projectID string) (models.Project, error) {
+		return stored, nil
+	}
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	saved, err := projectManager.GetProjectExecutionDefaults(context.Background(), "project")
+	assert.NoError(t, err)
+	assert.Equal(t, managerInterfaces.ProjectExecutionDefaults{}, *saved)
+
+	defaults := managerInterfaces.ProjectExecutionDefaults{
+		ServiceAccount:      "flyte-sa",
+		RawOutputDataPrefix: "s3://my-bucket/raw-data",
+		MaxParallelism:      25,
+		SecurityContext: &core.SecurityContext{
+			RunAs: &core.Identity{IamRole: "arn:aws:iam::123456789012:role/flyte"},
+		},
+	}
+	assert.NoError(t, projectManager.UpdateProjectExecutionDefaults(context.Background(), "project", defaults))
+	saved, err = projectManager.GetProjectExecutionDefaults(context.Background(), "project")
+	assert.NoError(t, err)
+	assert.Equal(t, defaults, *saved)
+}
+
+func TestProjectManager_UpdateProjectExecutionDefaults_Invalid(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).UpdateExecutionDefaultsFunction = func(
+		ctx context.Context, project models.Project) error {
+		assert.Fail(t, "No calls to UpdateExecutionDefaults were expected")
+		return nil
+	}
+	projectManager := NewProjectManager(repository, mockProjectConfigProvider)
+
+	err := projectManager.UpdateProjectExecutionDefaults(context.Background(), "project",
+		managerInterfaces.ProjectExecutionDefaults{RawOutputDataPrefix: "my-bucket/raw-data"})
+	assert.EqualError(t, err, "raw_output_data_prefix [my-bucket/raw-data] must be a URL such as s3://my-bucket/raw-data")
+}
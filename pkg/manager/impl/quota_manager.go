@@ -0,0 +1,147 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+type QuotaManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func toDomainQuota(quotaModel models.DomainQuota) *interfaces.DomainQuota {
+	return &interfaces.DomainQuota{
+		Project:   quotaModel.Project,
+		Domain:    quotaModel.Domain,
+		CPU:       quotaModel.CPU,
+		Memory:    quotaModel.Memory,
+		GPU:       quotaModel.GPU,
+		Principal: quotaModel.Principal,
+		UpdatedAt: quotaModel.UpdatedAt,
+	}
+}
+
+func validateProjectDomainKey(project, domain string) error {
+	if err := validation.ValidateEmptyStringField(project, shared.Project); err != nil {
+		return err
+	}
+	return validation.ValidateEmptyStringField(domain, shared.Domain)
+}
+
+func (m *QuotaManager) SetDomainQuota(
+	ctx context.Context, request interfaces.DomainQuota) (*interfaces.DomainQuota, error) {
+	if err := validation.ValidateSetDomainQuotaRequest(
+		ctx, request, m.db, m.config.ApplicationConfiguration()); err != nil {
+		logger.Debugf(ctx, "invalid set domain quota request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	if err := m.db.DomainQuotaRepo().CreateOrUpdate(ctx, models.DomainQuota{
+		DomainQuotaKey: models.DomainQuotaKey{
+			Project: request.Project,
+			Domain:  request.Domain,
+		},
+		CPU:       request.CPU,
+		Memory:    request.Memory,
+		GPU:       request.GPU,
+		Principal: getUser(ctx),
+	}); err != nil {
+		logger.Debugf(ctx, "failed to set the quota of project [%s] and domain [%s] with err: %v",
+			request.Project, request.Domain, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "Set the quota of project [%s] and domain [%s] to cpu [%s], memory [%s] and gpu [%s]",
+		request.Project, request.Domain, request.CPU, request.Memory, request.GPU)
+	return m.GetDomainQuota(ctx, request.Project, request.Domain)
+}
+
+func (m *QuotaManager) GetDomainQuota(ctx context.Context, project, domain string) (*interfaces.DomainQuota, error) {
+	if err := validateProjectDomainKey(project, domain); err != nil {
+		return nil, err
+	}
+	quotaModel, err := m.db.DomainQuotaRepo().Get(ctx, project, domain)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainQuota(quotaModel), nil
+}
+
+func (m *QuotaManager) DeleteDomainQuota(ctx context.Context, project, domain string) error {
+	if err := validateProjectDomainKey(project, domain); err != nil {
+		return err
+	}
+	return m.db.DomainQuotaRepo().Delete(ctx, project, domain)
+}
+
+func (m *QuotaManager) ListDomainQuotas(
+	ctx context.Context, request interfaces.ListDomainQuotasRequest) (*interfaces.DomainQuotaList, error) {
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListDomainQuotas", request.Token)
+	}
+	var filters []common.InlineFilter
+	if len(request.Project) > 0 {
+		projectFilter, err := common.NewSingleValueFilter(common.DomainQuota, common.Equal, shared.Project,
+			request.Project)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, projectFilter)
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       shared.Project,
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	quotaModels, err := m.db.DomainQuotaRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to list domain quotas for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	quotas := make([]*interfaces.DomainQuota, len(quotaModels))
+	for i, quotaModel := range quotaModels {
+		quotas[i] = toDomainQuota(quotaModel)
+	}
+	var token string
+	if len(quotaModels) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(quotaModels))
+	}
+	return &interfaces.DomainQuotaList{
+		DomainQuotas: quotas,
+		Token:        token,
+	}, nil
+}
+
+func NewQuotaManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.QuotaInterface {
+	return &QuotaManager{
+		db:     db,
+		config: config,
+	}
+}
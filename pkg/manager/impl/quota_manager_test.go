@@ -0,0 +1,99 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func getQuotaManagerForTest(repository *repositoryMocks.MockRepository) interfaces.QuotaInterface {
+	config := runtimeMocks.NewMockConfigurationProvider(testutils.GetApplicationConfigWithDefaultDomains(), nil, nil,
+		nil, nil, nil)
+	return NewQuotaManager(repository, config)
+}
+
+func TestSetDomainQuota(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	quotaRepo := repository.DomainQuotaRepo().(*repositoryMocks.MockDomainQuotaRepo)
+	var saved models.DomainQuota
+	quotaRepo.CreateOrUpdateFunction = func(ctx context.Context, input models.DomainQuota) error {
+		saved = input
+		return nil
+	}
+	quotaRepo.GetFunction = func(ctx context.Context, project, domain string) (models.DomainQuota, error) {
+		return saved, nil
+	}
+
+	quota, err := getQuotaManagerForTest(repository).SetDomainQuota(context.Background(), interfaces.DomainQuota{
+		Project: "project",
+		Domain:  "development",
+		CPU:     "16",
+		Memory:  "64Gi",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.DomainQuotaKey{Project: "project", Domain: "development"}, saved.DomainQuotaKey)
+	assert.Equal(t, "16", quota.CPU)
+	assert.Equal(t, "64Gi", quota.Memory)
+	assert.Empty(t, quota.GPU)
+}
+
+func TestSetDomainQuota_Invalid(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.DomainQuotaRepo().(*repositoryMocks.MockDomainQuotaRepo).CreateOrUpdateFunction = func(
+		ctx context.Context, input models.DomainQuota) error {
+		assert.Fail(t, "invalid quotas shouldn't be saved")
+		return nil
+	}
+
+	_, err := getQuotaManagerForTest(repository).SetDomainQuota(context.Background(), interfaces.DomainQuota{
+		Project: "project",
+		Domain:  "development",
+		GPU:     "-2",
+	})
+	assert.EqualError(t, err, "gpu quota [-2] can't be negative")
+}
+
+func TestGetDomainQuota_NotFound(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.DomainQuotaRepo().(*repositoryMocks.MockDomainQuotaRepo).GetFunction = func(
+		ctx context.Context, project, domain string) (models.DomainQuota, error) {
+		return models.DomainQuota{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+	}
+
+	_, err := getQuotaManagerForTest(repository).GetDomainQuota(context.Background(), "project", "development")
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListDomainQuotas(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.DomainQuotaRepo().(*repositoryMocks.MockDomainQuotaRepo).ListFunction = func(
+		ctx context.Context, input repoInterfaces.ListResourceInput) ([]models.DomainQuota, error) {
+		assert.Equal(t, 2, input.Limit)
+		assert.Equal(t, 2, input.Offset)
+		assert.Len(t, input.InlineFilters, 1)
+		return []models.DomainQuota{
+			{DomainQuotaKey: models.DomainQuotaKey{Project: "project", Domain: "development"}, CPU: "4"},
+			{DomainQuotaKey: models.DomainQuotaKey{Project: "project", Domain: "production"}, CPU: "16"},
+		}, nil
+	}
+
+	quotas, err := getQuotaManagerForTest(repository).ListDomainQuotas(context.Background(),
+		interfaces.ListDomainQuotasRequest{
+			Project: "project",
+			Limit:   2,
+			Token:   "2",
+		})
+	assert.NoError(t, err)
+	assert.Len(t, quotas.DomainQuotas, 2)
+	assert.Equal(t, "production", quotas.DomainQuotas[1].Domain)
+	assert.Equal(t, "4", quotas.Token)
+}
@@ -0,0 +1,268 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+// The entities whose list requests saved views are evaluated as.
+var savedViewEntities = map[interfaces.SavedViewResourceType]common.Entity{
+	interfaces.SavedViewExecutions: common.Execution,
+	interfaces.SavedViewWorkflows:  common.Workflow,
+}
+
+type SavedViewManager struct {
+	db               repositories.RepositoryInterface
+	executionManager interfaces.ExecutionInterface
+	workflowManager  interfaces.WorkflowInterface
+}
+
+// Returns the key of a saved view, whose owner is the caller for personal views.
+func (m *SavedViewManager) getSavedViewKey(ctx context.Context, id interfaces.SavedViewIdentifier) (
+	models.SavedViewKey, error) {
+	key := models.SavedViewKey{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	}
+	if id.Personal {
+		key.Owner = getUser(ctx)
+		if len(key.Owner) == 0 {
+			return models.SavedViewKey{}, errors.NewFlyteAdminErrorf(codes.Unauthenticated,
+				"personal saved views require an authenticated caller")
+		}
+	}
+	return key, nil
+}
+
+func toSavedView(savedViewModel models.SavedView) *interfaces.SavedView {
+	savedView := &interfaces.SavedView{
+		ID: interfaces.SavedViewIdentifier{
+			Project:  savedViewModel.Project,
+			Domain:   savedViewModel.Domain,
+			Name:     savedViewModel.Name,
+			Personal: len(savedViewModel.Owner) > 0,
+		},
+		Owner:        savedViewModel.Owner,
+		ResourceType: savedViewModel.ResourceType,
+		Filters:      savedViewModel.Filters,
+		Description:  savedViewModel.Description,
+	}
+	if len(savedViewModel.SortKey) > 0 {
+		savedView.SortBy = &admin.Sort{
+			Key:       savedViewModel.SortKey,
+			Direction: admin.Sort_Direction(savedViewModel.SortDirection),
+		}
+	}
+	return savedView
+}
+
+// Validates a saved view, including that its filters parse for its resource type, and returns its model.
+func (m *SavedViewManager) toSavedViewModel(ctx context.Context, request interfaces.SavedView) (
+	models.SavedView, error) {
+	if err := validation.ValidateSavedView(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return models.SavedView{}, err
+	}
+	if len(request.Filters) > 0 {
+		if _, err := util.ParseFilters(request.Filters, savedViewEntities[request.ResourceType]); err != nil {
+			return models.SavedView{}, err
+		}
+	}
+	key, err := m.getSavedViewKey(ctx, request.ID)
+	if err != nil {
+		return models.SavedView{}, err
+	}
+	savedViewModel := models.SavedView{
+		SavedViewKey: key,
+		ResourceType: request.ResourceType,
+		Filters:      request.Filters,
+		Description:  request.Description,
+	}
+	if request.SortBy != nil {
+		savedViewModel.SortKey = request.SortBy.Key
+		savedViewModel.SortDirection = int32(request.SortBy.Direction)
+	}
+	return savedViewModel, nil
+}
+
+func (m *SavedViewManager) CreateSavedView(ctx context.Context, request interfaces.SavedView) (
+	*interfaces.SavedView, error) {
+	ctx = contextutils.WithProjectDomain(ctx, request.ID.Project, request.ID.Domain)
+	savedViewModel, err := m.toSavedViewModel(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkProjectVisible(ctx, request.ID.Project); err != nil {
+		return nil, err
+	}
+	if err := m.db.SavedViewRepo().Create(ctx, savedViewModel); err != nil {
+		logger.Debugf(ctx, "Failed to create saved view [%+v] with err %v", request.ID, err)
+		return nil, err
+	}
+	return toSavedView(savedViewModel), nil
+}
+
+func (m *SavedViewManager) getSavedView(ctx context.Context, id interfaces.SavedViewIdentifier) (
+	models.SavedView, error) {
+	if err := validation.ValidateSavedViewIdentifier(id); err != nil {
+		logger.Debugf(ctx, "invalid identifier [%+v]: %v", id, err)
+		return models.SavedView{}, err
+	}
+	if err := checkProjectVisible(ctx, id.Project); err != nil {
+		return models.SavedView{}, err
+	}
+	key, err := m.getSavedViewKey(ctx, id)
+	if err != nil {
+		return models.SavedView{}, err
+	}
+	return m.db.SavedViewRepo().Get(ctx, key)
+}
+
+func (m *SavedViewManager) GetSavedView(ctx context.Context, id interfaces.SavedViewIdentifier) (
+	*interfaces.SavedView, error) {
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	savedViewModel, err := m.getSavedView(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toSavedView(savedViewModel), nil
+}
+
+func (m *SavedViewManager) UpdateSavedView(ctx context.Context, request interfaces.SavedView) (
+	*interfaces.SavedView, error) {
+	ctx = contextutils.WithProjectDomain(ctx, request.ID.Project, request.ID.Domain)
+	savedViewModel, err := m.toSavedViewModel(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkProjectVisible(ctx, request.ID.Project); err != nil {
+		return nil, err
+	}
+	if err := m.db.SavedViewRepo().Update(ctx, savedViewModel); err != nil {
+		logger.Debugf(ctx, "Failed to update saved view [%+v] with err %v", request.ID, err)
+		return nil, err
+	}
+	return toSavedView(savedViewModel), nil
+}
+
+func (m *SavedViewManager) DeleteSavedView(ctx context.Context, id interfaces.SavedViewIdentifier) error {
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	if err := validation.ValidateSavedViewIdentifier(id); err != nil {
+		logger.Debugf(ctx, "invalid identifier [%+v]: %v", id, err)
+		return err
+	}
+	if err := checkProjectVisible(ctx, id.Project); err != nil {
+		return err
+	}
+	key, err := m.getSavedViewKey(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := m.db.SavedViewRepo().Delete(ctx, key); err != nil {
+		logger.Debugf(ctx, "Failed to delete saved view [%+v] with err %v", id, err)
+		return err
+	}
+	return nil
+}
+
+func (m *SavedViewManager) ListSavedViews(ctx context.Context, request interfaces.SavedViewListRequest) (
+	*interfaces.SavedViewList, error) {
+	if err := validation.ValidateSavedViewListRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	if err := checkProjectVisible(ctx, request.Project); err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListSavedViews", request.Token)
+	}
+	savedViewModels, err := m.db.SavedViewRepo().List(ctx, repoInterfaces.ListSavedViewsInput{
+		Project: request.Project,
+		Domain:  request.Domain,
+		Owner:   getUser(ctx),
+		Limit:   int(request.Limit),
+		Offset:  offset,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list the saved views of [%s/%s] with err %v", request.Project,
+			request.Domain, err)
+		return nil, err
+	}
+	savedViews := make([]*interfaces.SavedView, len(savedViewModels))
+	for i, savedViewModel := range savedViewModels {
+		savedViews[i] = toSavedView(savedViewModel)
+	}
+	var token string
+	if len(savedViews) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(savedViews))
+	}
+	return &interfaces.SavedViewList{
+		SavedViews: savedViews,
+		Token:      token,
+	}, nil
+}
+
+func (m *SavedViewManager) EvaluateSavedView(ctx context.Context, request interfaces.SavedViewEvaluateRequest) (
+	*interfaces.SavedViewResults, error) {
+	if err := validation.ValidateSavedViewEvaluateRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.ID.Project, request.ID.Domain)
+	savedViewModel, err := m.getSavedView(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	savedView := toSavedView(savedViewModel)
+	// Views are evaluated as list requests, so that they're filtered on the projects visible to the caller like any
+	// other list.
+	listRequest := admin.ResourceListRequest{
+		Id: &admin.NamedEntityIdentifier{
+			Project: savedView.ID.Project,
+			Domain:  savedView.ID.Domain,
+		},
+		Limit:   request.Limit,
+		Token:   request.Token,
+		Filters: savedView.Filters,
+		SortBy:  savedView.SortBy,
+	}
+	if savedView.ResourceType == interfaces.SavedViewWorkflows {
+		workflows, err := m.workflowManager.ListWorkflows(ctx, listRequest)
+		if err != nil {
+			return nil, err
+		}
+		return &interfaces.SavedViewResults{Workflows: workflows}, nil
+	}
+	executions, err := m.executionManager.ListExecutions(ctx, listRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.SavedViewResults{Executions: executions}, nil
+}
+
+func NewSavedViewManager(db repositories.RepositoryInterface, executionManager interfaces.ExecutionInterface,
+	workflowManager interfaces.WorkflowInterface) interfaces.SavedViewInterface {
+	return &SavedViewManager{
+		db:               db,
+		executionManager: executionManager,
+		workflowManager:  workflowManager,
+	}
+}
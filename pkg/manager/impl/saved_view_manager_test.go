@@ -0,0 +1,191 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	managerMocks "github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var failedRunsViewID = interfaces.SavedViewIdentifier{
+	Project:  "project",
+	Domain:   "production",
+	Name:     "My failed prod runs this week",
+	Personal: true,
+}
+
+var failedRunsViewKey = models.SavedViewKey{
+	Project: "project",
+	Domain:  "production",
+	Owner:   "jane",
+	Name:    "My failed prod runs this week",
+}
+
+func getSavedViewContext() context.Context {
+	return auth.NewIdentityContext("", "jane", "", time.Now(), sets.NewString(), nil).WithContext(
+		context.Background())
+}
+
+func getFailedRunsViewModel() models.SavedView {
+	return models.SavedView{
+		SavedViewKey:  failedRunsViewKey,
+		ResourceType:  interfaces.SavedViewExecutions,
+		Filters:       "eq(phase,FAILED)+gte(created_at,now-168h)",
+		SortKey:       "created_at",
+		SortDirection: int32(admin.Sort_DESCENDING),
+	}
+}
+
+func TestCreateSavedView(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	savedViewRepo := repository.SavedViewRepo().(*repositoryMocks.SavedViewRepoInterface)
+	savedViewRepo.OnCreate(mock.Anything, getFailedRunsViewModel()).Return(nil)
+	savedViewManager := NewSavedViewManager(repository, &managerMocks.MockExecutionManager{},
+		&managerMocks.MockWorkflowManager{})
+	request := interfaces.SavedView{
+		ID:           failedRunsViewID,
+		ResourceType: interfaces.SavedViewExecutions,
+		Filters:      "eq(phase,FAILED)+gte(created_at,now-168h)",
+		SortBy: &admin.Sort{
+			Key:       "created_at",
+			Direction: admin.Sort_DESCENDING,
+		},
+	}
+
+	savedView, err := savedViewManager.CreateSavedView(getSavedViewContext(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, "jane", savedView.Owner)
+	assert.True(t, savedView.ID.Personal)
+
+	// Personal views belong to the caller.
+	_, err = savedViewManager.CreateSavedView(context.Background(), request)
+	assert.Equal(t, codes.Unauthenticated, err.(flyteAdminErrors.FlyteAdminError).Code())
+	savedViewRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestCreateSavedView_InvalidFilters(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	savedViewManager := NewSavedViewManager(repository, &managerMocks.MockExecutionManager{},
+		&managerMocks.MockWorkflowManager{})
+
+	_, err := savedViewManager.CreateSavedView(getSavedViewContext(), interfaces.SavedView{
+		ID:           failedRunsViewID,
+		ResourceType: interfaces.SavedViewExecutions,
+		Filters:      "phase is FAILED",
+	})
+	assert.EqualError(t, err, "invalid value for filters")
+}
+
+func TestListSavedViews(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.SavedViewRepo().(*repositoryMocks.SavedViewRepoInterface).OnList(mock.Anything,
+		repoInterfaces.ListSavedViewsInput{
+			Project: "project",
+			Domain:  "production",
+			Owner:   "jane",
+			Limit:   2,
+		}).Return([]models.SavedView{
+		getFailedRunsViewModel(),
+		{
+			SavedViewKey: models.SavedViewKey{
+				Project: "project",
+				Domain:  "production",
+				Name:    "Workflows",
+			},
+			ResourceType: interfaces.SavedViewWorkflows,
+		},
+	}, nil)
+	savedViewManager := NewSavedViewManager(repository, &managerMocks.MockExecutionManager{},
+		&managerMocks.MockWorkflowManager{})
+
+	savedViews, err := savedViewManager.ListSavedViews(getSavedViewContext(), interfaces.SavedViewListRequest{
+		Project: "project",
+		Domain:  "production",
+		Limit:   2,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, savedViews.SavedViews, 2)
+	assert.True(t, savedViews.SavedViews[0].ID.Personal)
+	assert.Equal(t, admin.Sort_DESCENDING, savedViews.SavedViews[0].SortBy.Direction)
+	assert.False(t, savedViews.SavedViews[1].ID.Personal)
+	assert.Nil(t, savedViews.SavedViews[1].SortBy)
+	assert.Equal(t, "2", savedViews.Token)
+
+	_, err = savedViewManager.ListSavedViews(
+		auth.WithVisibleProjects(getSavedViewContext(), sets.NewString("other")), interfaces.SavedViewListRequest{
+			Project: "project",
+			Domain:  "production",
+			Limit:   2,
+		})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestEvaluateSavedView(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.SavedViewRepo().(*repositoryMocks.SavedViewRepoInterface).OnGet(mock.Anything, failedRunsViewKey).
+		Return(getFailedRunsViewModel(), nil)
+	executionManager := &managerMocks.MockExecutionManager{}
+	executionManager.SetListCallback(func(ctx context.Context, request admin.ResourceListRequest) (
+		*admin.ExecutionList, error) {
+		assert.Equal(t, "project", request.Id.Project)
+		assert.Equal(t, "production", request.Id.Domain)
+		assert.Equal(t, "eq(phase,FAILED)+gte(created_at,now-168h)", request.Filters)
+		assert.Equal(t, "created_at", request.SortBy.Key)
+		assert.Equal(t, uint32(10), request.Limit)
+		return &admin.ExecutionList{Token: "10"}, nil
+	})
+	savedViewManager := NewSavedViewManager(repository, executionManager, &managerMocks.MockWorkflowManager{})
+
+	results, err := savedViewManager.EvaluateSavedView(getSavedViewContext(), interfaces.SavedViewEvaluateRequest{
+		ID:    failedRunsViewID,
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "10", results.Executions.Token)
+	assert.Nil(t, results.Workflows)
+}
+
+func TestEvaluateSavedView_Workflows(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	projectViewKey := models.SavedViewKey{
+		Project: "project",
+		Domain:  "production",
+		Name:    "Workflows",
+	}
+	repository.SavedViewRepo().(*repositoryMocks.SavedViewRepoInterface).OnGet(mock.Anything, projectViewKey).
+		Return(models.SavedView{
+			SavedViewKey: projectViewKey,
+			ResourceType: interfaces.SavedViewWorkflows,
+		}, nil)
+	workflowManager := &managerMocks.MockWorkflowManager{}
+	workflowManager.SetListCallback(func(ctx context.Context, request admin.ResourceListRequest) (
+		*admin.WorkflowList, error) {
+		assert.Nil(t, request.SortBy)
+		return &admin.WorkflowList{}, nil
+	})
+	savedViewManager := NewSavedViewManager(repository, &managerMocks.MockExecutionManager{}, workflowManager)
+
+	results, err := savedViewManager.EvaluateSavedView(context.Background(), interfaces.SavedViewEvaluateRequest{
+		ID: interfaces.SavedViewIdentifier{
+			Project: "project",
+			Domain:  "production",
+			Name:    "Workflows",
+		},
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, results.Workflows)
+	assert.Nil(t, results.Executions)
+}
@@ -0,0 +1,127 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+type ScheduleManager struct {
+	db repositories.RepositoryInterface
+}
+
+func toSchedulableEntityKey(id admin.NamedEntityIdentifier) schedulerModels.SchedulableEntityKey {
+	return schedulerModels.SchedulableEntityKey{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	}
+}
+
+func (m *ScheduleManager) UpdateScheduleOptions(
+	ctx context.Context, request interfaces.UpdateScheduleOptionsRequest) error {
+	if err := validation.ValidateUpdateScheduleOptionsRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid update schedule options request [%+v]: %v", request, err)
+		return err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Id.Project, request.Id.Domain)
+	err := m.db.SchedulableEntityRepo().UpdateOptions(ctx, toSchedulableEntityKey(request.Id),
+		schedulerModels.ScheduleOptions{
+			JitterSeconds:     uint32(request.Jitter.Seconds()),
+			CatchUpPolicy:     request.CatchUpPolicy,
+			ConcurrencyPolicy: request.ConcurrencyPolicy,
+		})
+	if err != nil {
+		logger.Debugf(ctx, "failed to update the schedule options of [%+v] with err: %v", request.Id, err)
+		return err
+	}
+	return nil
+}
+
+func (m *ScheduleManager) setPaused(ctx context.Context, id admin.NamedEntityIdentifier, paused bool) error {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	if err := m.db.SchedulableEntityRepo().SetPaused(ctx, toSchedulableEntityKey(id), paused); err != nil {
+		logger.Debugf(ctx, "failed to set the schedule of [%+v] to paused [%v] with err: %v", id, paused, err)
+		return err
+	}
+	return nil
+}
+
+func (m *ScheduleManager) PauseSchedule(ctx context.Context, id admin.NamedEntityIdentifier) error {
+	return m.setPaused(ctx, id, true)
+}
+
+func (m *ScheduleManager) ResumeSchedule(ctx context.Context, id admin.NamedEntityIdentifier) error {
+	return m.setPaused(ctx, id, false)
+}
+
+func toScheduleRun(runModel schedulerModels.ScheduleRun) *interfaces.ScheduleRun {
+	run := &interfaces.ScheduleRun{
+		Version:     runModel.Version,
+		ScheduledAt: runModel.ScheduledAt,
+		FiredAt:     runModel.FiredAt,
+		Delay:       runModel.FiredAt.Sub(runModel.ScheduledAt),
+		Status:      runModel.Status,
+		Error:       runModel.Error,
+	}
+	if len(runModel.ExecutionName) > 0 {
+		run.ExecutionId = &core.WorkflowExecutionIdentifier{
+			Project: runModel.Project,
+			Domain:  runModel.Domain,
+			Name:    runModel.ExecutionName,
+		}
+	}
+	return run
+}
+
+func (m *ScheduleManager) ListScheduleRuns(
+	ctx context.Context, request interfaces.ListScheduleRunsRequest) (*interfaces.ScheduleRunList, error) {
+	if err := validation.ValidateNamedEntityIdentifier(&request.Id); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Id.Project, request.Id.Domain)
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListScheduleRuns", request.Token)
+	}
+	runModels, err := m.db.ScheduleRunRepo().List(ctx, toSchedulableEntityKey(request.Id), int(request.Limit), offset)
+	if err != nil {
+		logger.Debugf(ctx, "failed to list the schedule runs of [%+v] with err: %v", request.Id, err)
+		return nil, err
+	}
+	runs := make([]*interfaces.ScheduleRun, 0, len(runModels))
+	for _, runModel := range runModels {
+		runs = append(runs, toScheduleRun(runModel))
+	}
+	var token string
+	if len(runModels) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(runModels))
+	}
+	return &interfaces.ScheduleRunList{
+		Runs:  runs,
+		Token: token,
+	}, nil
+}
+
+func NewScheduleManager(db repositories.RepositoryInterface) interfaces.ScheduleInterface {
+	return &ScheduleManager{
+		db: db,
+	}
+}
@@ -0,0 +1,123 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	schedulerMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var scheduleIdentifier = admin.NamedEntityIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+var schedulableEntityKey = schedulerModels.SchedulableEntityKey{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func TestUpdateScheduleOptions(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.SchedulableEntityRepo().(*schedulerMocks.SchedulableEntityRepoInterface).OnUpdateOptions(
+		mock.Anything, schedulableEntityKey, schedulerModels.ScheduleOptions{
+			JitterSeconds:     90,
+			CatchUpPolicy:     schedulerModels.CatchUpLast,
+			ConcurrencyPolicy: schedulerModels.ConcurrencySkip,
+		}).Return(nil)
+	scheduleManager := NewScheduleManager(repository)
+
+	assert.NoError(t, scheduleManager.UpdateScheduleOptions(context.Background(), interfaces.UpdateScheduleOptionsRequest{
+		Id:                scheduleIdentifier,
+		Jitter:            90*time.Second + 500*time.Millisecond,
+		CatchUpPolicy:     schedulerModels.CatchUpLast,
+		ConcurrencyPolicy: schedulerModels.ConcurrencySkip,
+	}))
+
+	err := scheduleManager.UpdateScheduleOptions(context.Background(), interfaces.UpdateScheduleOptionsRequest{
+		Id:            scheduleIdentifier,
+		CatchUpPolicy: "FIRST",
+	})
+	assert.EqualError(t, err, "invalid value for catch_up_policy")
+}
+
+func TestPauseAndResumeSchedule(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	schedulableEntityRepo := repository.SchedulableEntityRepo().(*schedulerMocks.SchedulableEntityRepoInterface)
+	schedulableEntityRepo.OnSetPaused(mock.Anything, schedulableEntityKey, true).Return(nil).Once()
+	schedulableEntityRepo.OnSetPaused(mock.Anything, schedulableEntityKey, false).Return(nil).Once()
+	scheduleManager := NewScheduleManager(repository)
+
+	assert.NoError(t, scheduleManager.PauseSchedule(context.Background(), scheduleIdentifier))
+	assert.NoError(t, scheduleManager.ResumeSchedule(context.Background(), scheduleIdentifier))
+	schedulableEntityRepo.AssertExpectations(t)
+
+	err := scheduleManager.PauseSchedule(context.Background(), admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+	})
+	assert.EqualError(t, err, "missing name")
+}
+
+func TestListScheduleRuns(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	scheduledAt := time.Date(2021, time.October, 30, 3, 0, 0, 0, time.UTC)
+	repository.ScheduleRunRepo().(*schedulerMocks.ScheduleRunRepoInterface).OnList(
+		mock.Anything, schedulableEntityKey, 2, 2).Return([]schedulerModels.ScheduleRun{
+		{
+			Project:       "project",
+			Domain:        "domain",
+			Name:          "name",
+			Version:       "v2",
+			ScheduledAt:   scheduledAt,
+			FiredAt:       scheduledAt.Add(90 * time.Second),
+			Status:        schedulerModels.ScheduleRunSucceeded,
+			ExecutionName: "execution",
+		},
+		{
+			Project:     "project",
+			Domain:      "domain",
+			Name:        "name",
+			Version:     "v1",
+			ScheduledAt: scheduledAt.Add(-24 * time.Hour),
+			FiredAt:     scheduledAt.Add(-24 * time.Hour),
+			Status:      schedulerModels.ScheduleRunFailed,
+			Error:       "launch plan is archived",
+		},
+	}, nil)
+	scheduleManager := NewScheduleManager(repository)
+
+	runs, err := scheduleManager.ListScheduleRuns(context.Background(), interfaces.ListScheduleRunsRequest{
+		Id:    scheduleIdentifier,
+		Limit: 2,
+		Token: "2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "4", runs.Token)
+	assert.Len(t, runs.Runs, 2)
+	assert.Equal(t, 90*time.Second, runs.Runs[0].Delay)
+	assert.True(t, proto.Equal(&core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "execution",
+	}, runs.Runs[0].ExecutionId))
+	assert.Equal(t, schedulerModels.ScheduleRunFailed, runs.Runs[1].Status)
+	assert.Nil(t, runs.Runs[1].ExecutionId)
+	assert.Equal(t, "launch plan is archived", runs.Runs[1].Error)
+
+	_, err = scheduleManager.ListScheduleRuns(context.Background(), interfaces.ListScheduleRunsRequest{
+		Id: scheduleIdentifier,
+	})
+	assert.EqualError(t, err, "invalid value for limit")
+}
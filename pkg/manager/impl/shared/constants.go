@@ -16,7 +16,10 @@ const (
 	TypedInterface        = "typed interface"
 	Image                 = "image"
 	Limit                 = "limit"
+	BatchSize             = "batch_size"
+	Phases                = "phases"
 	Filters               = "filters"
+	Query                 = "query"
 	ExpectedInputs        = "expected_inputs"
 	FixedInputs           = "fixed_inputs"
 	DefaultInputs         = "default_inputs"
@@ -32,6 +35,31 @@ const (
 	UserInputs            = "user_inputs"
 	Attributes            = "attributes"
 	MatchingAttributes    = "matching_attributes"
+	LaunchPlan            = "launch_plan"
+	StartTime             = "start_time"
+	EndTime               = "end_time"
+	Parallelism           = "parallelism"
+	Order                 = "order"
+	URL                   = "url"
+	Secret                = "secret"
+	Template              = "template"
+	Address               = "address"
+	Jitter                = "jitter"
+	CatchUpPolicy         = "catch_up_policy"
+	ConcurrencyPolicy     = "concurrency_policy"
+	InputMappings         = "input_mappings"
+	Strategy              = "strategy"
+	Label                 = "label"
+	VersionConstraint     = "version_constraint"
+	SoakPeriod            = "soak_period"
+	Members               = "members"
+	Cluster               = "cluster"
+	Weight                = "weight"
+	Labels                = "labels"
+	CPU                   = "cpu"
+	Memory                = "memory"
+	GPU                   = "gpu"
+	Org                   = "org"
 	// Parent of a node execution in the node executions table
 	ParentID = "parent_id"
 )
@@ -0,0 +1,28 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Returns the domains stored in the database, or the configured domains until any are stored.
+func GetDomains(ctx context.Context, db repositories.RepositoryInterface,
+	config runtimeInterfaces.ApplicationConfiguration) ([]runtimeInterfaces.Domain, error) {
+	domainModels, err := db.DomainRepo().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(domainModels) == 0 {
+		return *config.GetDomainsConfig(), nil
+	}
+	domains := make([]runtimeInterfaces.Domain, len(domainModels))
+	for index, domainModel := range domainModels {
+		domains[index] = runtimeInterfaces.Domain{
+			ID:   domainModel.Identifier,
+			Name: domainModel.Name,
+		}
+	}
+	return domains, nil
+}
@@ -0,0 +1,204 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+)
+
+type SignalManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+	_clock clock.Clock
+}
+
+func toSignalKey(id interfaces.SignalIdentifier) models.SignalKey {
+	return models.SignalKey{
+		ExecutionKey: models.ExecutionKey{
+			Project: id.ExecutionID.Project,
+			Domain:  id.ExecutionID.Domain,
+			Name:    id.ExecutionID.Name,
+		},
+		SignalID: id.SignalID,
+	}
+}
+
+func toSignal(signalModel models.Signal) (*interfaces.Signal, error) {
+	signal := &interfaces.Signal{
+		ID: interfaces.SignalIdentifier{
+			SignalID: signalModel.SignalID,
+			ExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: signalModel.Project,
+				Domain:  signalModel.Domain,
+				Name:    signalModel.Name,
+			},
+		},
+		Type:  &core.LiteralType{},
+		SetBy: signalModel.SetBy,
+		SetAt: signalModel.SetAt,
+	}
+	if err := proto.Unmarshal(signalModel.Type, signal.Type); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to read the type of signal [%s]: %v",
+			signalModel.SignalID, err)
+	}
+	if len(signalModel.Value) > 0 {
+		signal.Value = &core.Literal{}
+		if err := proto.Unmarshal(signalModel.Value, signal.Value); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to read the value of signal [%s]: %v",
+				signalModel.SignalID, err)
+		}
+	}
+	return signal, nil
+}
+
+func (m *SignalManager) GetOrCreateSignal(ctx context.Context, request interfaces.SignalGetOrCreateRequest) (
+	*interfaces.Signal, error) {
+	if err := validation.ValidateSignalGetOrCreateRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.ID.ExecutionID)
+	if _, err := util.GetExecutionModel(ctx, m.db, *request.ID.ExecutionID); err != nil {
+		return nil, err
+	}
+	serializedType, err := proto.Marshal(request.Type)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid type: %v", err)
+	}
+	signalModel := &models.Signal{
+		SignalKey: toSignalKey(request.ID),
+		Type:      serializedType,
+	}
+	if err := m.db.SignalRepo().GetOrCreate(ctx, signalModel); err != nil {
+		logger.Debugf(ctx, "Failed to get or create signal [%s] with err %v", request.ID.SignalID, err)
+		return nil, err
+	}
+	return toSignal(*signalModel)
+}
+
+func (m *SignalManager) ListSignals(ctx context.Context, request interfaces.SignalListRequest) (
+	*interfaces.SignalList, error) {
+	if err := validation.ValidateSignalListRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.ExecutionID)
+	if err := checkProjectVisible(ctx, request.ExecutionID.Project); err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListSignals", request.Token)
+	}
+	if _, err := util.GetExecutionModel(ctx, m.db, *request.ExecutionID); err != nil {
+		return nil, err
+	}
+	signalModels, err := m.db.SignalRepo().List(ctx, repoInterfaces.ListSignalsInput{
+		Execution: models.ExecutionKey{
+			Project: request.ExecutionID.Project,
+			Domain:  request.ExecutionID.Domain,
+			Name:    request.ExecutionID.Name,
+		},
+		Limit:  int(request.Limit),
+		Offset: offset,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list the signals of [%+v] with err %v", request.ExecutionID, err)
+		return nil, err
+	}
+	signals := make([]*interfaces.Signal, len(signalModels))
+	for i, signalModel := range signalModels {
+		if signals[i], err = toSignal(signalModel); err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	if len(signals) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(signals))
+	}
+	return &interfaces.SignalList{
+		Signals: signals,
+		Token:   token,
+	}, nil
+}
+
+// Checks the caller holds one of the roles allowed to set signals, when any are configured.
+func (m *SignalManager) checkApprover(ctx context.Context) error {
+	approverRoles := m.config.ApplicationConfiguration().GetTopLevelConfig().GetSignalsConfig().ApproverRoles
+	if len(approverRoles) == 0 || auth.RolesFromContext(ctx).HasAny(approverRoles...) {
+		return nil
+	}
+	return errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+		"only callers holding one of the roles %v can set signals", approverRoles)
+}
+
+func (m *SignalManager) SetSignal(ctx context.Context, request interfaces.SignalSetRequest) (
+	*interfaces.Signal, error) {
+	if err := validation.ValidateSignalSetRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.ID.ExecutionID)
+	if err := checkProjectVisible(ctx, request.ID.ExecutionID.Project); err != nil {
+		return nil, err
+	}
+	if err := m.checkApprover(ctx); err != nil {
+		return nil, err
+	}
+	signalModel, err := m.db.SignalRepo().Get(ctx, toSignalKey(request.ID))
+	if err != nil {
+		return nil, err
+	}
+	signal, err := toSignal(signalModel)
+	if err != nil {
+		return nil, err
+	}
+	if signal.Value != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "signal [%s] was already set by [%s]",
+			request.ID.SignalID, signal.SetBy)
+	}
+	if err := validation.ValidateSignalValue(request, *signal); err != nil {
+		return nil, err
+	}
+	serializedValue, err := proto.Marshal(request.Value)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid value: %v", err)
+	}
+	setAt := m._clock.Now()
+	signalModel.Value = serializedValue
+	signalModel.SetBy = getUser(ctx)
+	signalModel.SetAt = &setAt
+	if err := m.db.SignalRepo().Update(ctx, signalModel); err != nil {
+		logger.Debugf(ctx, "Failed to set signal [%s] with err %v", request.ID.SignalID, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "Signal [%s] was set by [%s]", request.ID.SignalID, signalModel.SetBy)
+	signal.Value = request.Value
+	signal.SetBy = signalModel.SetBy
+	signal.SetAt = signalModel.SetAt
+	return signal, nil
+}
+
+func NewSignalManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.SignalInterface {
+	return &SignalManager{
+		db:     db,
+		config: config,
+		_clock: clock.New(),
+	}
+}
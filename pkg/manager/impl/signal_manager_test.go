@@ -0,0 +1,174 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var signalExecutionID = core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+var approvalSignalID = interfaces.SignalIdentifier{
+	SignalID:    "approve-deploy",
+	ExecutionID: &signalExecutionID,
+}
+
+var booleanType = &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_BOOLEAN}}
+
+func getSignalManagerForTest(repository *repositoryMocks.MockRepository, approverRoles ...string) interfaces.SignalInterface {
+	config := runtimeMocks.NewMockConfigurationProvider(testutils.GetApplicationConfigWithDefaultDomains(), nil, nil,
+		nil, nil, nil)
+	config.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			Signals: runtimeInterfaces.SignalsConfig{
+				ApproverRoles: approverRoles,
+			},
+		})
+	return NewSignalManager(repository, config)
+}
+
+func getSignalModelForTest(t *testing.T, value *core.Literal) models.Signal {
+	serializedType, err := proto.Marshal(booleanType)
+	assert.NoError(t, err)
+	signalModel := models.Signal{
+		SignalKey: toSignalKey(approvalSignalID),
+		Type:      serializedType,
+	}
+	if value != nil {
+		signalModel.Value, err = proto.Marshal(value)
+		assert.NoError(t, err)
+		signalModel.SetBy = "jane"
+	}
+	return signalModel
+}
+
+func TestGetOrCreateSignal(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.SignalRepo().(*repositoryMocks.SignalRepoInterface).OnGetOrCreateMatch(mock.Anything,
+		mock.MatchedBy(func(input *models.Signal) bool {
+			return input.SignalKey == toSignalKey(approvalSignalID)
+		})).Return(nil)
+
+	signal, err := getSignalManagerForTest(repository).GetOrCreateSignal(context.Background(),
+		interfaces.SignalGetOrCreateRequest{
+			ID:   approvalSignalID,
+			Type: booleanType,
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, "approve-deploy", signal.ID.SignalID)
+	assert.True(t, proto.Equal(&signalExecutionID, signal.ID.ExecutionID))
+	assert.True(t, proto.Equal(booleanType, signal.Type))
+	assert.Nil(t, signal.Value)
+}
+
+func TestListSignals(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.SignalRepo().(*repositoryMocks.SignalRepoInterface).OnList(mock.Anything, repoInterfaces.ListSignalsInput{
+		Execution: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Limit:  1,
+		Offset: 1,
+	}).Return([]models.Signal{getSignalModelForTest(t, coreutils.MustMakeLiteral(true))}, nil)
+
+	signals, err := getSignalManagerForTest(repository).ListSignals(context.Background(), interfaces.SignalListRequest{
+		ExecutionID: &signalExecutionID,
+		Limit:       1,
+		Token:       "1",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, signals.Signals, 1)
+	assert.True(t, proto.Equal(coreutils.MustMakeLiteral(true), signals.Signals[0].Value))
+	assert.Equal(t, "jane", signals.Signals[0].SetBy)
+	assert.Equal(t, "2", signals.Token)
+
+	_, err = getSignalManagerForTest(repository).ListSignals(
+		auth.WithVisibleProjects(context.Background(), sets.NewString("other")), interfaces.SignalListRequest{
+			ExecutionID: &signalExecutionID,
+			Limit:       1,
+		})
+	assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestSetSignal(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	signalRepo := repository.SignalRepo().(*repositoryMocks.SignalRepoInterface)
+	signalRepo.OnGet(mock.Anything, toSignalKey(approvalSignalID)).Return(getSignalModelForTest(t, nil), nil)
+	signalRepo.OnUpdateMatch(mock.Anything, mock.MatchedBy(func(input models.Signal) bool {
+		return len(input.Value) > 0 && input.SetAt != nil
+	})).Return(nil)
+
+	signal, err := getSignalManagerForTest(repository).SetSignal(context.Background(), interfaces.SignalSetRequest{
+		ID:    approvalSignalID,
+		Value: coreutils.MustMakeLiteral(true),
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(coreutils.MustMakeLiteral(true), signal.Value))
+	assert.NotNil(t, signal.SetAt)
+	signalRepo.AssertNumberOfCalls(t, "Update", 1)
+}
+
+func TestSetSignal_AlreadySet(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.SignalRepo().(*repositoryMocks.SignalRepoInterface).OnGet(mock.Anything, toSignalKey(approvalSignalID)).
+		Return(getSignalModelForTest(t, coreutils.MustMakeLiteral(true)), nil)
+
+	_, err := getSignalManagerForTest(repository).SetSignal(context.Background(), interfaces.SignalSetRequest{
+		ID:    approvalSignalID,
+		Value: coreutils.MustMakeLiteral(false),
+	})
+	assert.EqualError(t, err, "signal [approve-deploy] was already set by [jane]")
+}
+
+func TestSetSignal_MismatchedType(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.SignalRepo().(*repositoryMocks.SignalRepoInterface).OnGet(mock.Anything, toSignalKey(approvalSignalID)).
+		Return(getSignalModelForTest(t, nil), nil)
+
+	_, err := getSignalManagerForTest(repository).SetSignal(context.Background(), interfaces.SignalSetRequest{
+		ID:    approvalSignalID,
+		Value: coreutils.MustMakeLiteral("yes"),
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestSetSignal_ApproverRoles(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	signalRepo := repository.SignalRepo().(*repositoryMocks.SignalRepoInterface)
+	signalRepo.OnGet(mock.Anything, toSignalKey(approvalSignalID)).Return(getSignalModelForTest(t, nil), nil)
+	signalRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(nil)
+	signalManager := getSignalManagerForTest(repository, "release-managers")
+	request := interfaces.SignalSetRequest{
+		ID:    approvalSignalID,
+		Value: coreutils.MustMakeLiteral(true),
+	}
+
+	_, err := signalManager.SetSignal(auth.WithRoles(context.Background(), sets.NewString("developers")), request)
+	assert.EqualError(t, err, "only callers holding one of the roles [release-managers] can set signals")
+	signalRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+	_, err = signalManager.SetSignal(auth.WithRoles(context.Background(), sets.NewString("release-managers")), request)
+	assert.NoError(t, err)
+}
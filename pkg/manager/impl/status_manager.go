@@ -0,0 +1,144 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/outbox"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/config"
+)
+
+// The parts of the status which are collected separately, so that one failing doesn't hide the others.
+const (
+	statusQueueDepths    = "queueDepths"
+	statusClusters       = "clusters"
+	statusConfigChecksum = "configChecksum"
+)
+
+type StatusManager struct {
+	db            repositories.RepositoryInterface
+	configSection config.Section
+	_clock        clock.Clock
+}
+
+func getCacheStatus(stats repoInterfaces.CacheStats) interfaces.CacheStatus {
+	status := interfaces.CacheStatus{
+		Hits:   stats.Hits,
+		Misses: stats.Misses,
+	}
+	if gets := stats.Hits + stats.Misses; gets > 0 {
+		status.HitRate = float64(stats.Hits) / float64(gets)
+	}
+	return status
+}
+
+func (m *StatusManager) countPendingExecutions(ctx context.Context, mode *admin.ExecutionMetadata_ExecutionMode) (
+	int64, error) {
+	pendingFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, "pending", true)
+	if err != nil {
+		return 0, err
+	}
+	// Pending executions which were terminated before they were launched are aborted or failed.
+	phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueIn, "phase", []string{
+		core.WorkflowExecution_UNDEFINED.String(), core.WorkflowExecution_QUEUED.String()})
+	if err != nil {
+		return 0, err
+	}
+	filters := []common.InlineFilter{pendingFilter, phaseFilter}
+	if mode != nil {
+		modeFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, "mode", int32(*mode))
+		if err != nil {
+			return 0, err
+		}
+		filters = append(filters, modeFilter)
+	}
+	return m.db.ExecutionRepo().Count(ctx, repoInterfaces.CountResourceInput{InlineFilters: filters})
+}
+
+func (m *StatusManager) getQueueDepths(ctx context.Context) (map[string]int64, error) {
+	outboxCounts, err := m.db.OutboxRepo().CountByPublisher(ctx)
+	if err != nil {
+		return nil, err
+	}
+	launches, err := m.countPendingExecutions(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	scheduledMode := admin.ExecutionMetadata_SCHEDULED
+	scheduled, err := m.countPendingExecutions(ctx, &scheduledMode)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]int64{
+		interfaces.NotificationsQueue: outboxCounts[outbox.NotificationsPublisher],
+		interfaces.EventsQueue:        outboxCounts[outbox.EventsPublisher],
+		interfaces.LaunchesQueue:      launches,
+		interfaces.SchedulerQueue:     scheduled,
+	}, nil
+}
+
+func (m *StatusManager) getClusters(ctx context.Context) ([]interfaces.ClusterStatus, error) {
+	healths, err := m.db.ClusterHealthRepo().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clusters := make([]interfaces.ClusterStatus, len(healths))
+	for i, health := range healths {
+		clusters[i] = interfaces.ClusterStatus{
+			Cluster:                  health.Cluster,
+			State:                    health.State,
+			Reason:                   health.Reason,
+			ProbedAt:                 health.ProbedAt,
+			ProbeError:               health.ProbeError,
+			ConsecutiveProbeFailures: health.ConsecutiveProbeFailures,
+			PropellerHeartbeatAt:     health.PropellerHeartbeatAt,
+		}
+	}
+	return clusters, nil
+}
+
+func (m *StatusManager) GetStatus(ctx context.Context) (*interfaces.Status, error) {
+	stats := m.db.Stats()
+	status := &interfaces.Status{
+		GeneratedAt: m._clock.Now(),
+		Database: interfaces.DatabaseStatus{
+			MaxOpenConnections: stats.DB.MaxOpenConnections,
+			OpenConnections:    stats.DB.OpenConnections,
+			InUse:              stats.DB.InUse,
+			Idle:               stats.DB.Idle,
+			WaitCount:          stats.DB.WaitCount,
+			WaitDuration:       stats.DB.WaitDuration,
+		},
+		Caches: make(map[string]interfaces.CacheStatus, len(stats.Caches)),
+		Errors: make(map[string]string),
+	}
+	for resource, cacheStats := range stats.Caches {
+		status.Caches[resource] = getCacheStatus(cacheStats)
+	}
+	var err error
+	if status.QueueDepths, err = m.getQueueDepths(ctx); err != nil {
+		status.Errors[statusQueueDepths] = err.Error()
+	}
+	if status.Clusters, err = m.getClusters(ctx); err != nil {
+		status.Errors[statusClusters] = err.Error()
+	}
+	if status.ConfigChecksum, err = runtime.GetConfigChecksum(m.configSection); err != nil {
+		status.Errors[statusConfigChecksum] = err.Error()
+	}
+	return status, nil
+}
+
+func NewStatusManager(db repositories.RepositoryInterface) interfaces.StatusInterface {
+	return &StatusManager{
+		db:            db,
+		configSection: config.GetRootSection(),
+		_clock:        clock.New(),
+	}
+}
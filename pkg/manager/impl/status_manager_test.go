@@ -0,0 +1,131 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+)
+
+var statusNow = time.Date(2021, time.November, 11, 12, 0, 0, 0, time.UTC)
+
+type statusTestConfig struct {
+	Port int `json:"port"`
+}
+
+func newStatusManagerForTest(repository *repositoryMocks.MockRepository, port int) *StatusManager {
+	root := config.NewSection(nil, nil)
+	root.MustRegisterSection("server", &statusTestConfig{Port: port})
+	mockClock := clock.NewMock()
+	mockClock.Set(statusNow)
+	return &StatusManager{
+		db:            repository,
+		configSection: root,
+		_clock:        mockClock,
+	}
+}
+
+func getMockRepositoryForStatus() *repositoryMocks.MockRepository {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.RepositoryStats = repositoryInterfaces.RepositoryStats{
+		DB: sql.DBStats{
+			MaxOpenConnections: 100,
+			OpenConnections:    10,
+			InUse:              4,
+			Idle:               6,
+			WaitCount:          2,
+			WaitDuration:       time.Second,
+		},
+		Caches: map[string]repositoryInterfaces.CacheStats{
+			"workflows": {Hits: 3, Misses: 1},
+			"tasks":     {},
+		},
+	}
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).CountFunction = func(
+		ctx context.Context, input repositoryInterfaces.CountResourceInput) (int64, error) {
+		// Scheduled executions are counted with a filter on their mode.
+		if len(input.InlineFilters) == 3 {
+			return 2, nil
+		}
+		return 5, nil
+	}
+	repository.ClusterHealthRepo().(*repositoryMocks.ClusterHealthRepoInterface).OnListAll(context.Background()).Return(
+		[]models.ClusterHealth{
+			{
+				Cluster:                  "cluster-1",
+				State:                    models.ClusterUnhealthy,
+				Reason:                   "failed 3 consecutive health probes: timeout",
+				ProbeError:               "timeout",
+				ConsecutiveProbeFailures: 3,
+			},
+		}, nil)
+	return repository
+}
+
+func TestGetStatus(t *testing.T) {
+	repository := getMockRepositoryForStatus()
+	repository.OutboxRepo().(*repositoryMocks.OutboxRepoInterface).OnCountByPublisher(context.Background()).Return(
+		map[string]int64{"events": 7}, nil)
+	statusManager := newStatusManagerForTest(repository, 8088)
+
+	status, err := statusManager.GetStatus(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, statusNow, status.GeneratedAt)
+	assert.Equal(t, interfaces.DatabaseStatus{
+		MaxOpenConnections: 100,
+		OpenConnections:    10,
+		InUse:              4,
+		Idle:               6,
+		WaitCount:          2,
+		WaitDuration:       time.Second,
+	}, status.Database)
+	assert.Equal(t, map[string]int64{
+		interfaces.NotificationsQueue: 0,
+		interfaces.EventsQueue:        7,
+		interfaces.LaunchesQueue:      5,
+		interfaces.SchedulerQueue:     2,
+	}, status.QueueDepths)
+	assert.Equal(t, map[string]interfaces.CacheStatus{
+		"workflows": {Hits: 3, Misses: 1, HitRate: 0.75},
+		"tasks":     {},
+	}, status.Caches)
+	assert.Len(t, status.Clusters, 1)
+	assert.Equal(t, "cluster-1", status.Clusters[0].Cluster)
+	assert.Equal(t, models.ClusterUnhealthy, status.Clusters[0].State)
+	assert.Equal(t, int32(3), status.Clusters[0].ConsecutiveProbeFailures)
+	assert.Len(t, status.ConfigChecksum, 64)
+	assert.Empty(t, status.Errors)
+
+	// The checksum only changes along with the config.
+	unchanged, err := newStatusManagerForTest(repository, 8088).GetStatus(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, status.ConfigChecksum, unchanged.ConfigChecksum)
+	changed, err := newStatusManagerForTest(repository, 8089).GetStatus(context.Background())
+	assert.NoError(t, err)
+	assert.NotEqual(t, status.ConfigChecksum, changed.ConfigChecksum)
+}
+
+func TestGetStatus_PartialFailure(t *testing.T) {
+	repository := getMockRepositoryForStatus()
+	repository.OutboxRepo().(*repositoryMocks.OutboxRepoInterface).OnCountByPublisher(context.Background()).Return(
+		nil, errors.New("connection refused"))
+	statusManager := newStatusManagerForTest(repository, 8088)
+
+	status, err := statusManager.GetStatus(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		statusQueueDepths: "connection refused",
+	}, status.Errors)
+	assert.Nil(t, status.QueueDepths)
+	assert.Len(t, status.Clusters, 1)
+	assert.NotEmpty(t, status.ConfigChecksum)
+}
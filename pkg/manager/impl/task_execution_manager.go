@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
+	eventWriter "github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
 	notificationInterfaces "github.com/flyteorg/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 
 	"github.com/flyteorg/flytestdlib/storage"
 
@@ -18,6 +22,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	dataInterfaces "github.com/flyteorg/flyteadmin/pkg/data/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/executions"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
 	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
@@ -28,8 +33,10 @@ import (
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyteplugins/go/tasks/pluginmachinery/tasklog"
 	"github.com/flyteorg/flytestdlib/logger"
 	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 type taskExecutionMetrics struct {
@@ -44,6 +51,8 @@ type taskExecutionMetrics struct {
 	TaskExecutionInputBytes    prometheus.Summary
 	TaskExecutionOutputBytes   prometheus.Summary
 	PublishEventError          prometheus.Counter
+	UsageRecordFailures        prometheus.Counter
+	LineageRecordFailures      prometheus.Counter
 }
 
 type TaskExecutionManager struct {
@@ -53,6 +62,9 @@ type TaskExecutionManager struct {
 	metrics            taskExecutionMetrics
 	urlData            dataInterfaces.RemoteURLInterface
 	notificationClient notificationInterfaces.Publisher
+	usageProvider      executions.UsageProvider
+	// Nil unless every event received is recorded.
+	recordedEventWriter eventWriter.RecordedEventWriter
 }
 
 func getTaskExecutionContext(ctx context.Context, identifier *core.TaskExecutionIdentifier) context.Context {
@@ -120,11 +132,89 @@ func (m *TaskExecutionManager) updateTaskExecutionModelState(
 	return *existingTaskExecution, nil
 }
 
+// Records the resources a task execution used once it terminates. Failures are logged rather than returned, since the
+// event can't be retried once the task execution is terminal.
+func (m *TaskExecutionManager) recordUsage(
+	ctx context.Context, request *admin.TaskExecutionEventRequest, taskExecutionModel models.TaskExecution) {
+	if m.usageProvider == nil {
+		return
+	}
+	taskExecutionID := core.TaskExecutionIdentifier{
+		TaskId:          request.Event.TaskId,
+		NodeExecutionId: request.Event.ParentNodeExecutionId,
+		RetryAttempt:    request.Event.RetryAttempt,
+	}
+	usage, err := m.usageProvider.GetTaskExecutionUsage(ctx, executions.GetTaskExecutionUsageInput{
+		TaskExecutionID: taskExecutionID,
+		Event:           request.Event,
+		Duration:        taskExecutionModel.Duration,
+	})
+	if err != nil {
+		m.metrics.UsageRecordFailures.Inc()
+		logger.Warningf(ctx, "Failed to get the usage of task execution [%+v] with err: %v", taskExecutionID, err)
+		return
+	}
+	if usage == (interfaces.ResourceUsage{}) {
+		return
+	}
+	endedAt, err := ptypes.Timestamp(request.Event.OccurredAt)
+	if err != nil {
+		m.metrics.UsageRecordFailures.Inc()
+		logger.Warningf(ctx, "Invalid occurred at time for task execution [%+v]: %v", taskExecutionID, err)
+		return
+	}
+	err = m.db.TaskExecutionUsageRepo().Create(ctx, models.TaskExecutionUsage{
+		ExecutionKey: models.ExecutionKey{
+			Project: taskExecutionID.NodeExecutionId.ExecutionId.Project,
+			Domain:  taskExecutionID.NodeExecutionId.ExecutionId.Domain,
+			Name:    taskExecutionID.NodeExecutionId.ExecutionId.Name,
+		},
+		NodeID:        taskExecutionID.NodeExecutionId.NodeId,
+		RetryAttempt:  &taskExecutionID.RetryAttempt,
+		TaskProject:   taskExecutionID.TaskId.Project,
+		TaskDomain:    taskExecutionID.TaskId.Domain,
+		TaskName:      taskExecutionID.TaskId.Name,
+		TaskVersion:   taskExecutionID.TaskId.Version,
+		EndedAt:       endedAt,
+		CPUHours:      usage.CPUHours,
+		GPUHours:      usage.GPUHours,
+		MemoryGBHours: usage.MemoryGBHours,
+	})
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.AlreadyExists {
+			return
+		}
+		m.metrics.UsageRecordFailures.Inc()
+		logger.Warningf(ctx, "Failed to record the usage of task execution [%+v] with err: %v", taskExecutionID, err)
+	}
+}
+
+// Records the artifacts a task execution read and wrote once it terminates, when lineage is enabled. Like usage,
+// failures are logged rather than returned.
+func (m *TaskExecutionManager) recordArtifacts(
+	ctx context.Context, request *admin.TaskExecutionEventRequest, taskExecutionModel models.TaskExecution) {
+	if !m.config.ApplicationConfiguration().GetTopLevelConfig().GetLineageConfig().Enabled {
+		return
+	}
+	artifacts := getTaskExecutionArtifactModels(request, taskExecutionModel.InputURI)
+	if len(artifacts) == 0 {
+		return
+	}
+	if err := m.db.LineageRepo().CreateArtifacts(ctx, artifacts); err != nil {
+		m.metrics.LineageRecordFailures.Inc()
+		logger.Warningf(ctx, "Failed to record the artifacts of task execution [%+v] with err: %v",
+			request.Event.ParentNodeExecutionId, err)
+	}
+}
+
 func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, request admin.TaskExecutionEventRequest) (
 	*admin.TaskExecutionEventResponse, error) {
 	if err := validation.ValidateTaskExecutionRequest(request, m.config.ApplicationConfiguration().GetRemoteDataConfig().MaxSizeInBytes); err != nil {
 		return nil, err
 	}
+	if m.recordedEventWriter != nil {
+		m.recordedEventWriter.Write(getRecordedTaskEventModel(request))
+	}
 
 	// Get the parent node execution, if none found a MissingEntityError will be returned
 	nodeExecutionID := request.Event.ParentNodeExecutionId
@@ -156,6 +246,26 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 
 		return &admin.TaskExecutionEventResponse{}, nil
 	}
+	currentPhase := core.TaskExecution_Phase(core.TaskExecution_Phase_value[taskExecutionModel.Phase])
+	if m.config.ApplicationConfiguration().GetTopLevelConfig().GetEventReconciliationConfig().Enabled {
+		reason := getEventSkipReason(eventSequence{
+			duplicate: currentPhase == request.Event.Phase &&
+				taskExecutionModel.PhaseVersion >= request.Event.PhaseVersion,
+			currentIsTerminal: common.IsTaskExecutionTerminal(currentPhase),
+			eventIsTerminal:   common.IsTaskExecutionTerminal(request.Event.Phase),
+			lastAppliedAt:     taskExecutionModel.TaskExecutionUpdatedAt,
+			occurredAt:        request.Event.OccurredAt,
+		})
+		if reason != "" {
+			skippedEvent := getSkippedEventModel(interfaces.TaskEventType, taskExecutionID.NodeExecutionId.ExecutionId,
+				request.RequestId, request.Event.Phase.String(), request.Event.OccurredAt, currentPhase.String(), reason)
+			skippedEvent.NodeID = taskExecutionID.NodeExecutionId.NodeId
+			skippedEvent.RetryAttempt = &taskExecutionID.RetryAttempt
+			skippedEvent.PhaseVersion = request.Event.PhaseVersion
+			recordSkippedEvent(ctx, m.db, skippedEvent)
+			return &admin.TaskExecutionEventResponse{}, nil
+		}
+	}
 	if taskExecutionModel.Phase == request.Event.Phase.String() &&
 		taskExecutionModel.PhaseVersion >= request.Event.PhaseVersion {
 		logger.Debugf(ctx, "have already recorded task execution phase %s (version: %d) for %v",
@@ -165,7 +275,6 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 			request.Event.Phase.String(), request.Event.PhaseVersion, taskExecutionID)
 	}
 
-	currentPhase := core.TaskExecution_Phase(core.TaskExecution_Phase_value[taskExecutionModel.Phase])
 	if common.IsTaskExecutionTerminal(currentPhase) {
 		// Cannot update a terminal execution.
 		curPhase := request.Event.Phase.String()
@@ -181,6 +290,11 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 		return nil, err
 	}
 
+	if common.IsTaskExecutionTerminal(request.Event.Phase) {
+		m.recordUsage(ctx, &request, taskExecutionModel)
+		m.recordArtifacts(ctx, &request, taskExecutionModel)
+	}
+
 	if request.Event.Phase == core.TaskExecution_RUNNING && request.Event.PhaseVersion == 0 {
 		m.metrics.ActiveTaskExecutions.Inc()
 	} else if common.IsTaskExecutionTerminal(request.Event.Phase) && request.Event.PhaseVersion == 0 {
@@ -191,6 +305,7 @@ func (m *TaskExecutionManager) CreateTaskExecutionEvent(ctx context.Context, req
 		}
 	}
 
+	// Task events aren't written to the outbox, even when it's enabled, so they're published at most once.
 	if err = m.notificationClient.Publish(ctx, proto.MessageName(&request), &request); err != nil {
 		m.metrics.PublishEventError.Inc()
 		logger.Infof(ctx, "error publishing event [%+v] with err: [%v]", request.RequestId, err)
@@ -322,7 +437,100 @@ func (m *TaskExecutionManager) GetTaskExecutionData(
 	return response, nil
 }
 
-func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration, storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface, publisher notificationInterfaces.Publisher) interfaces.TaskExecutionInterface {
+// Returns the values log templates are filled in from. Task executions run in a pod named after their generated name,
+// whose container is named the same.
+func (m *TaskExecutionManager) getTaskLogInput(taskExecution *admin.TaskExecution) tasklog.Input {
+	executionID := taskExecution.Id.NodeExecutionId.ExecutionId
+	closure := taskExecution.Closure
+	input := tasklog.Input{
+		PodName: closure.GetMetadata().GetGeneratedName(),
+		Namespace: common.GetNamespaceName(m.config.NamespaceMappingConfiguration().GetNamespaceTemplate(),
+			executionID.Project, executionID.Domain),
+		ContainerName: closure.GetMetadata().GetGeneratedName(),
+	}
+	startedAtProto := closure.StartedAt
+	if startedAtProto == nil {
+		startedAtProto = closure.CreatedAt
+	}
+	startedAt, err := ptypes.Timestamp(startedAtProto)
+	if err != nil {
+		startedAt = time.Now()
+	}
+	input.PodUnixStartTime = startedAt.Unix()
+	finishedAt := time.Now()
+	if duration, err := ptypes.Duration(closure.Duration); err == nil && common.IsTaskExecutionTerminal(closure.Phase) {
+		finishedAt = startedAt.Add(duration)
+	}
+	input.PodUnixFinishTime = finishedAt.Unix()
+	return input
+}
+
+// Returns the logs reported for a task execution followed by those generated from the log templates which apply to it.
+// Generated logs which were also reported aren't repeated.
+func (m *TaskExecutionManager) getTaskLogs(taskExecution *admin.TaskExecution, cluster string) ([]*core.TaskLog, error) {
+	logs := append([]*core.TaskLog{}, taskExecution.Closure.GetLogs()...)
+	if len(taskExecution.Closure.GetMetadata().GetGeneratedName()) == 0 {
+		// Without the name of the pod the task execution ran in there's nothing to fill templates in with.
+		return logs, nil
+	}
+	logURIs := sets.NewString()
+	for _, taskLog := range logs {
+		logURIs.Insert(taskLog.Uri)
+	}
+	input := m.getTaskLogInput(taskExecution)
+	for _, template := range m.config.ApplicationConfiguration().GetTopLevelConfig().GetTaskLogsConfig().Templates {
+		if !template.Matches(taskExecution.Closure.TaskType, cluster) {
+			continue
+		}
+		messageFormat := core.TaskLog_MessageFormat(core.TaskLog_MessageFormat_value[strings.ToUpper(template.MessageFormat)])
+		input.LogName = template.DisplayName
+		output, err := tasklog.NewTemplateLogPlugin(template.TemplateURIs, messageFormat).GetTaskLogs(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, taskLog := range output.TaskLogs {
+			if !logURIs.Has(taskLog.Uri) {
+				logURIs.Insert(taskLog.Uri)
+				logs = append(logs, taskLog)
+			}
+		}
+	}
+	return logs, nil
+}
+
+func (m *TaskExecutionManager) GetTaskExecutionLinks(
+	ctx context.Context, request admin.TaskExecutionGetRequest) (*interfaces.TaskExecutionLinks, error) {
+	taskExecution, err := m.GetTaskExecution(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	ctx = getTaskExecutionContext(ctx, request.Id)
+	executionModel, err := util.GetExecutionModel(ctx, m.db, *request.Id.NodeExecutionId.ExecutionId)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := m.getTaskLogs(taskExecution, executionModel.Cluster)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to generate the logs of task execution [%+v] with err: %v", request.Id, err)
+		return nil, err
+	}
+	links := &interfaces.TaskExecutionLinks{
+		Logs: logs,
+	}
+	if outputURI := taskExecution.Closure.GetOutputUri(); len(outputURI) > 0 {
+		deckURI := getDeckURI(outputURI)
+		metadata, err := m.storageClient.Head(ctx, storage.DataReference(deckURI))
+		if err != nil {
+			// The logs are still worth returning when the store can't be reached.
+			logger.Warningf(ctx, "Failed to check whether deck [%s] exists with err: %v", deckURI, err)
+		} else if metadata.Exists() {
+			links.DeckURI = deckURI
+		}
+	}
+	return links, nil
+}
+
+func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration, storageClient *storage.DataStore, scope promutils.Scope, urlData dataInterfaces.RemoteURLInterface, publisher notificationInterfaces.Publisher, usageProvider executions.UsageProvider, recordedEventWriter eventWriter.RecordedEventWriter) interfaces.TaskExecutionInterface {
 	metrics := taskExecutionMetrics{
 		Scope: scope,
 		ActiveTaskExecutions: scope.MustNewGauge("active_executions",
@@ -345,13 +553,19 @@ func NewTaskExecutionManager(db repositories.RepositoryInterface, config runtime
 			"size in bytes of serialized node execution outputs"),
 		PublishEventError: scope.MustNewCounter("publish_event_error",
 			"overall count of publish event errors when invoking publish()"),
+		UsageRecordFailures: scope.MustNewCounter("usage_record_failures",
+			"overall count of terminated task executions whose resource usage failed to be recorded"),
+		LineageRecordFailures: scope.MustNewCounter("lineage_record_failures",
+			"overall count of terminated task executions whose artifacts failed to be recorded"),
 	}
 	return &TaskExecutionManager{
-		db:                 db,
-		config:             config,
-		storageClient:      storageClient,
-		metrics:            metrics,
-		urlData:            urlData,
-		notificationClient: publisher,
+		db:                  db,
+		config:              config,
+		storageClient:       storageClient,
+		metrics:             metrics,
+		urlData:             urlData,
+		notificationClient:  publisher,
+		usageProvider:       usageProvider,
+		recordedEventWriter: recordedEventWriter,
 	}
 }
@@ -7,7 +7,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/executions"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/runtime"
 	"github.com/flyteorg/flytestdlib/storage"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
@@ -19,6 +22,8 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
@@ -26,6 +31,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
 )
 
@@ -175,7 +181,7 @@ func TestCreateTaskEvent(t *testing.T) {
 			}, input)
 			return nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, createTaskCalled)
@@ -288,7 +294,7 @@ func TestCreateTaskEvent_Update(t *testing.T) {
 		OutputUri: expectedOutputResult.OutputUri,
 	}
 
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, updateTaskCalled)
@@ -296,6 +302,186 @@ func TestCreateTaskEvent_Update(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+// Reports a fixed usage for every task execution.
+type mockUsageProvider struct {
+	usage managerInterfaces.ResourceUsage
+	err   error
+	input *executions.GetTaskExecutionUsageInput
+}
+
+func (p *mockUsageProvider) GetTaskExecutionUsage(
+	ctx context.Context, input executions.GetTaskExecutionUsageInput) (managerInterfaces.ResourceUsage, error) {
+	p.input = &input
+	return p.usage, p.err
+}
+
+func getTaskEventRequestForUsage(phase core.TaskExecution_Phase, occurredAt time.Time) admin.TaskExecutionEventRequest {
+	occurredAtProto, _ := ptypes.TimestampProto(occurredAt)
+	return admin.TaskExecutionEventRequest{
+		RequestId: "request id",
+		Event: &event.TaskExecutionEvent{
+			ProducerId:            "propeller",
+			TaskId:                sampleTaskID,
+			ParentNodeExecutionId: sampleNodeExecID,
+			OccurredAt:            occurredAtProto,
+			Phase:                 phase,
+			RetryAttempt:          retryAttemptValue,
+			InputUri:              "input uri",
+		},
+	}
+}
+
+func getRepositoryWithRunningTaskExecution() repositories.RepositoryInterface {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	addGetNodeExecutionCallback(repository)
+	addGetTaskCallback(repository)
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			runningTaskClosureBytes, _ := proto.Marshal(&admin.TaskExecutionClosure{
+				StartedAt: sampleTaskEventOccurredAt,
+				CreatedAt: sampleTaskEventOccurredAt,
+				UpdatedAt: sampleTaskEventOccurredAt,
+				Phase:     core.TaskExecution_RUNNING,
+			})
+			return models.TaskExecution{
+				TaskExecutionKey: models.TaskExecutionKey{
+					TaskKey: models.TaskKey{
+						Project: sampleTaskID.Project,
+						Domain:  sampleTaskID.Domain,
+						Name:    sampleTaskID.Name,
+						Version: sampleTaskID.Version,
+					},
+					NodeExecutionKey: models.NodeExecutionKey{
+						NodeID: sampleNodeExecID.NodeId,
+						ExecutionKey: models.ExecutionKey{
+							Project: sampleNodeExecID.ExecutionId.Project,
+							Domain:  sampleNodeExecID.ExecutionId.Domain,
+							Name:    sampleNodeExecID.ExecutionId.Name,
+						},
+					},
+					RetryAttempt: &retryAttemptValue,
+				},
+				Closure:                runningTaskClosureBytes,
+				StartedAt:              &taskStartedAt,
+				TaskExecutionCreatedAt: &taskStartedAt,
+				TaskExecutionUpdatedAt: &taskStartedAt,
+				Phase:                  core.TaskExecution_RUNNING.String(),
+			}, nil
+		})
+	return repository
+}
+
+func TestCreateTaskEvent_RecordsUsage(t *testing.T) {
+	taskCompletedAt := taskStartedAt.Add(time.Hour)
+	repository := getRepositoryWithRunningTaskExecution()
+	usageRepo := repository.TaskExecutionUsageRepo().(*repositoryMocks.TaskExecutionUsageRepoInterface)
+	usageRepo.OnCreateMatch(mock.Anything, mock.MatchedBy(func(input models.TaskExecutionUsage) bool {
+		assert.Equal(t, models.TaskExecutionUsage{
+			ExecutionKey: models.ExecutionKey{
+				Project: sampleNodeExecID.ExecutionId.Project,
+				Domain:  sampleNodeExecID.ExecutionId.Domain,
+				Name:    sampleNodeExecID.ExecutionId.Name,
+			},
+			NodeID:        sampleNodeExecID.NodeId,
+			RetryAttempt:  &retryAttemptValue,
+			TaskProject:   sampleTaskID.Project,
+			TaskDomain:    sampleTaskID.Domain,
+			TaskName:      sampleTaskID.Name,
+			TaskVersion:   sampleTaskID.Version,
+			EndedAt:       taskCompletedAt,
+			CPUHours:      2,
+			MemoryGBHours: 4,
+		}, input)
+		return true
+	})).Return(nil)
+	usageProvider := &mockUsageProvider{
+		usage: managerInterfaces.ResourceUsage{
+			CPUHours:      2,
+			MemoryGBHours: 4,
+		},
+	}
+
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, usageProvider, nil)
+	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(),
+		getTaskEventRequestForUsage(core.TaskExecution_SUCCEEDED, taskCompletedAt))
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.NotNil(t, usageProvider.input)
+	assert.Equal(t, time.Hour, usageProvider.input.Duration)
+	usageRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestCreateTaskEvent_RecordsUsageOnlyOnceTerminal(t *testing.T) {
+	repository := getRepositoryWithRunningTaskExecution()
+	usageProvider := &mockUsageProvider{
+		usage: managerInterfaces.ResourceUsage{
+			CPUHours: 1,
+		},
+	}
+
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, usageProvider, nil)
+	request := getTaskEventRequestForUsage(core.TaskExecution_RUNNING, taskStartedAt.Add(time.Minute))
+	request.Event.PhaseVersion = 1
+	_, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Nil(t, usageProvider.input)
+}
+
+func TestCreateTaskEvent_RecordUsageFailure(t *testing.T) {
+	repository := getRepositoryWithRunningTaskExecution()
+	usageProvider := &mockUsageProvider{
+		err: errors.New("foo"),
+	}
+
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, usageProvider, nil)
+	// Failing to record usage doesn't fail the event.
+	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(),
+		getTaskEventRequestForUsage(core.TaskExecution_FAILED, taskStartedAt.Add(time.Hour)))
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	repository.TaskExecutionUsageRepo().(*repositoryMocks.TaskExecutionUsageRepoInterface).AssertNotCalled(
+		t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateTaskEvent_RecordsArtifacts(t *testing.T) {
+	repository := getRepositoryWithRunningTaskExecution()
+	lineageRepo := repository.LineageRepo().(*repositoryMocks.LineageRepoInterface)
+	lineageRepo.OnCreateArtifactsMatch(mock.Anything, mock.MatchedBy(func(input []models.TaskExecutionArtifact) bool {
+		outputArtifact := input[len(input)-1]
+		assert.Equal(t, managerInterfaces.ArtifactOutput, outputArtifact.Direction)
+		assert.Equal(t, "output uri", outputArtifact.URI)
+		assert.Equal(t, sampleNodeExecID.NodeId, outputArtifact.NodeID)
+		assert.Equal(t, sampleTaskID.Name, outputArtifact.TaskName)
+		return true
+	})).Return(nil)
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			Lineage: runtimeInterfaces.LineageConfig{Enabled: true},
+		})
+
+	taskExecManager := NewTaskExecutionManager(repository, mockConfig, getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
+	request := getTaskEventRequestForUsage(core.TaskExecution_SUCCEEDED, taskStartedAt.Add(time.Hour))
+	request.Event.OutputResult = &event.TaskExecutionEvent_OutputUri{
+		OutputUri: "output uri",
+	}
+	_, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), request)
+	assert.NoError(t, err)
+	lineageRepo.AssertNumberOfCalls(t, "CreateArtifacts", 1)
+}
+
+func TestCreateTaskEvent_LineageDisabled(t *testing.T) {
+	repository := getRepositoryWithRunningTaskExecution()
+
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
+	_, err := taskExecManager.CreateTaskExecutionEvent(context.Background(),
+		getTaskEventRequestForUsage(core.TaskExecution_SUCCEEDED, taskStartedAt.Add(time.Hour)))
+	assert.NoError(t, err)
+	repository.LineageRepo().(*repositoryMocks.LineageRepoInterface).AssertNotCalled(
+		t, "CreateArtifacts", mock.Anything, mock.Anything)
+}
+
 func TestCreateTaskEvent_MissingExecution(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	expectedErr := flyteAdminErrors.NewFlyteAdminErrorf(codes.Internal, "expected error")
@@ -307,7 +493,7 @@ func TestCreateTaskEvent_MissingExecution(t *testing.T) {
 		ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
 		return false, expectedErr
 	}
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, "Failed to get existing node execution id: [node_id:\"node-id\""+
 		" execution_id:<project:\"project\" domain:\"domain\" name:\"name\" > ] "+
@@ -318,7 +504,7 @@ func TestCreateTaskEvent_MissingExecution(t *testing.T) {
 		ctx context.Context, input interfaces.NodeExecutionResource) (bool, error) {
 		return false, nil
 	}
-	taskExecManager = NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager = NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err = taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, "failed to get existing node execution id: [node_id:\"node-id\""+
 		" execution_id:<project:\"project\" domain:\"domain\" name:\"name\" > ]")
@@ -337,7 +523,7 @@ func TestCreateTaskEvent_CreateDatabaseError(t *testing.T) {
 		func(ctx context.Context, input models.TaskExecution) error {
 			return expectedErr
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -377,7 +563,7 @@ func TestCreateTaskEvent_UpdateDatabaseError(t *testing.T) {
 		func(ctx context.Context, execution models.TaskExecution) error {
 			return expectedErr
 		})
-	nodeExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	nodeExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err := nodeExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.EqualError(t, err, expectedErr.Error())
 	assert.Nil(t, resp)
@@ -410,7 +596,7 @@ func TestCreateTaskEvent_UpdateTerminalEventError(t *testing.T) {
 			}, nil
 		})
 	taskEventRequest.Event.Phase = core.TaskExecution_RUNNING
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 
 	assert.Nil(t, resp)
@@ -477,7 +663,7 @@ func TestCreateTaskEvent_PhaseVersionChange(t *testing.T) {
 	taskEventRequest.Event.PhaseVersion = uint32(1)
 	taskEventRequest.Event.OccurredAt = taskEventUpdatedAtProto
 
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, &mockPublisher, nil, nil)
 	resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), taskEventRequest)
 	assert.True(t, getTaskCalled)
 	assert.True(t, updateTaskCalled)
@@ -543,7 +729,7 @@ func TestGetTaskExecution(t *testing.T) {
 				},
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	taskExecution, err := taskExecManager.GetTaskExecution(context.Background(), admin.TaskExecutionGetRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -593,7 +779,7 @@ func TestGetTaskExecution_TransformerError(t *testing.T) {
 				Closure:   []byte("i'm an invalid task closure"),
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	taskExecution, err := taskExecManager.GetTaskExecution(context.Background(), admin.TaskExecutionGetRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -703,7 +889,7 @@ func TestListTaskExecutions(t *testing.T) {
 				},
 			}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	taskExecutions, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		NodeExecutionId: &core.NodeExecutionIdentifier{
 			NodeId: "nodey b",
@@ -774,7 +960,7 @@ func TestListTaskExecutions_NoFilters(t *testing.T) {
 			listTaskCalled = true
 			return interfaces.TaskExecutionCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		Token: "1",
 		Limit: 99,
@@ -792,7 +978,7 @@ func TestListTaskExecutions_NoLimit(t *testing.T) {
 			getTaskCalled = true
 			return interfaces.TaskExecutionCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		Limit: 0,
 	})
@@ -823,7 +1009,7 @@ func TestListTaskExecutions_NothingToReturn(t *testing.T) {
 			listTasksCalled = true
 			return interfaces.TaskCollectionOutput{}, nil
 		})
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	_, err := taskExecManager.ListTaskExecutions(context.Background(), admin.TaskExecutionListRequest{
 		NodeExecutionId: &core.NodeExecutionIdentifier{
 			ExecutionId: &core.WorkflowExecutionIdentifier{
@@ -925,7 +1111,7 @@ func TestGetTaskExecutionData(t *testing.T) {
 		}
 		return fmt.Errorf("unexpected call to find value in storage [%v]", reference.String())
 	}
-	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil)
+	taskExecManager := NewTaskExecutionManager(repository, getMockExecutionsConfigProvider(), mockStorage, mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
 	dataResponse, err := taskExecManager.GetTaskExecutionData(context.Background(), admin.TaskExecutionGetDataRequest{
 		Id: &core.TaskExecutionIdentifier{
 			TaskId:          sampleTaskID,
@@ -948,3 +1134,165 @@ func TestGetTaskExecutionData(t *testing.T) {
 		FullOutputs: fullOutputs,
 	}, dataResponse))
 }
+
+type mockDeckMetadata struct {
+	exists bool
+}
+
+func (m mockDeckMetadata) Exists() bool {
+	return m.exists
+}
+
+func (m mockDeckMetadata) Size() int64 {
+	return 0
+}
+
+func TestGetTaskExecutionLinks(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{
+				ExecutionKey: models.ExecutionKey{
+					Project: sampleNodeExecID.ExecutionId.Project,
+					Domain:  sampleNodeExecID.ExecutionId.Domain,
+					Name:    sampleNodeExecID.ExecutionId.Name,
+				},
+				Cluster: "cluster-a",
+			}, nil
+		})
+	reportedLog := &core.TaskLog{
+		Name: "Kubernetes Logs",
+		Uri:  "https://k8s.example.com/project-domain/pod-name",
+	}
+	closure := &admin.TaskExecutionClosure{
+		Phase:     core.TaskExecution_SUCCEEDED,
+		TaskType:  "python-task",
+		StartedAt: sampleTaskEventOccurredAt,
+		Duration:  ptypes.DurationProto(time.Minute),
+		OutputResult: &admin.TaskExecutionClosure_OutputUri{
+			OutputUri: "s3://bucket/metadata/outputs.pb",
+		},
+		Logs: []*core.TaskLog{reportedLog},
+		Metadata: &event.TaskExecutionMetadata{
+			GeneratedName: "pod-name",
+		},
+	}
+	closureBytes, _ := proto.Marshal(closure)
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
+			return models.TaskExecution{
+				TaskExecutionKey: models.TaskExecutionKey{
+					TaskKey: models.TaskKey{
+						Project: sampleTaskID.Project,
+						Domain:  sampleTaskID.Domain,
+						Name:    sampleTaskID.Name,
+						Version: sampleTaskID.Version,
+					},
+					NodeExecutionKey: models.NodeExecutionKey{
+						NodeID: sampleNodeExecID.NodeId,
+						ExecutionKey: models.ExecutionKey{
+							Project: sampleNodeExecID.ExecutionId.Project,
+							Domain:  sampleNodeExecID.ExecutionId.Domain,
+							Name:    sampleNodeExecID.ExecutionId.Name,
+						},
+					},
+					RetryAttempt: &retryAttemptValue,
+				},
+				Phase:     core.TaskExecution_SUCCEEDED.String(),
+				StartedAt: &taskStartedAt,
+				Closure:   closureBytes,
+			}, nil
+		})
+	mockConfig := getMockExecutionsConfigProvider()
+	mockConfig.(*runtimeMocks.MockConfigurationProvider).AddNamespaceMappingConfiguration(
+		runtime.NewNamespaceMappingConfigurationProvider())
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			TaskLogs: runtimeInterfaces.TaskLogsConfig{
+				Templates: []runtimeInterfaces.TaskLogTemplate{
+					{
+						DisplayName:  "Kubernetes Logs",
+						TemplateURIs: []string{"https://k8s.example.com/{{ .namespace }}/{{ .podName }}"},
+					},
+					{
+						DisplayName:   "CloudWatch Logs",
+						TemplateURIs:  []string{"https://cloudwatch.example.com/{{ .podName }}?start={{ .podUnixStartTime }}&end={{ .podUnixFinishTime }}"},
+						MessageFormat: "json",
+						Clusters:      []string{"cluster-a"},
+					},
+					{
+						DisplayName:  "Spark UI",
+						TemplateURIs: []string{"https://spark.example.com/{{ .podName }}"},
+						TaskTypes:    []string{"spark"},
+					},
+				},
+			},
+		})
+	mockStorage := commonMocks.GetMockStorageClient()
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).HeadCb = func(
+		ctx context.Context, reference storage.DataReference) (storage.Metadata, error) {
+		assert.Equal(t, "s3://bucket/metadata/deck.html", reference.String())
+		return mockDeckMetadata{exists: true}, nil
+	}
+
+	taskExecManager := NewTaskExecutionManager(repository, mockConfig, mockStorage, mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
+	links, err := taskExecManager.GetTaskExecutionLinks(context.Background(), admin.TaskExecutionGetRequest{
+		Id: &core.TaskExecutionIdentifier{
+			TaskId:          sampleTaskID,
+			NodeExecutionId: sampleNodeExecID,
+			RetryAttempt:    1,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "s3://bucket/metadata/deck.html", links.DeckURI)
+	// The generated Kubernetes log duplicates the reported one and the Spark UI doesn't apply to python tasks.
+	assert.Len(t, links.Logs, 2)
+	assert.True(t, proto.Equal(reportedLog, links.Logs[0]))
+	assert.True(t, proto.Equal(&core.TaskLog{
+		Name: "CloudWatch Logs",
+		Uri: fmt.Sprintf("https://cloudwatch.example.com/pod-name?start=%d&end=%d",
+			taskStartedAt.Unix(), taskStartedAt.Add(time.Minute).Unix()),
+		MessageFormat: core.TaskLog_JSON,
+	}, links.Logs[1]))
+}
+
+func TestCreateTaskEvent_EventReconciliation(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		phase        core.TaskExecution_Phase
+		phaseVersion uint32
+		occurredAt   time.Time
+		reason       string
+	}{
+		{"duplicate", core.TaskExecution_RUNNING, 0, taskStartedAt, managerInterfaces.SkipReasonDuplicate},
+		{"stale", core.TaskExecution_QUEUED, 0, taskStartedAt.Add(-time.Minute), managerInterfaces.SkipReasonStale},
+		{"stale phase version", core.TaskExecution_RUNNING, 1, taskStartedAt.Add(-time.Minute), managerInterfaces.SkipReasonStale},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			repository := getRepositoryWithRunningTaskExecution()
+			repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetUpdateCallback(
+				func(ctx context.Context, execution models.TaskExecution) error {
+					t.Error("unexpected task execution update")
+					return nil
+				})
+			skippedEventRepo := repository.SkippedEventRepo().(*repositoryMocks.SkippedEventRepoInterface)
+			var skippedEvents []models.SkippedEvent
+			skippedEventRepo.OnCreateMatch(mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				skippedEvents = append(skippedEvents, args.Get(1).(models.SkippedEvent))
+			}).Return(nil)
+			taskExecManager := NewTaskExecutionManager(repository, getEventReconciliationConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockTaskExecutionRemoteURL, nil, nil, nil)
+			request := getTaskEventRequestForUsage(test.phase, test.occurredAt)
+			request.Event.PhaseVersion = test.phaseVersion
+			resp, err := taskExecManager.CreateTaskExecutionEvent(context.Background(), request)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Len(t, skippedEvents, 1)
+			assert.Equal(t, managerInterfaces.TaskEventType, skippedEvents[0].EventType)
+			assert.Equal(t, sampleNodeExecID.NodeId, skippedEvents[0].NodeID)
+			assert.Equal(t, retryAttemptValue, *skippedEvents[0].RetryAttempt)
+			assert.Equal(t, test.phaseVersion, skippedEvents[0].PhaseVersion)
+			assert.Equal(t, core.TaskExecution_RUNNING.String(), skippedEvents[0].CurrentPhase)
+			assert.Equal(t, test.reason, skippedEvents[0].Reason)
+		})
+	}
+}
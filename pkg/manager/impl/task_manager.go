@@ -137,6 +137,21 @@ func (t *TaskManager) GetTask(ctx context.Context, request admin.ObjectGetReques
 	return task, nil
 }
 
+func (t *TaskManager) GetTasks(ctx context.Context, ids []*core.Identifier) (*admin.TaskList, error) {
+	if err := validation.ValidateIdentifiers(ids, common.Task); err != nil {
+		logger.Debugf(ctx, "invalid identifiers [%+v]: %v", ids, err)
+		return nil, err
+	}
+	tasks, err := util.GetTasks(ctx, t.db, ids)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to get tasks with ids [%+v] with err %v", ids, err)
+		return nil, err
+	}
+	return &admin.TaskList{
+		Tasks: tasks,
+	}, nil
+}
+
 func (t *TaskManager) ListTasks(ctx context.Context, request admin.ResourceListRequest) (*admin.TaskList, error) {
 	// Check required fields
 	if err := validation.ValidateResourceListRequest(request); err != nil {
@@ -156,6 +171,10 @@ func (t *TaskManager) ListTasks(ctx context.Context, request admin.ResourceListR
 	if err != nil {
 		return nil, err
 	}
+	filters, err = util.AddProjectVisibilityFilter(ctx, common.Task, filters)
+	if err != nil {
+		return nil, err
+	}
 	var sortParameter common.SortParameter
 	if request.SortBy != nil {
 		sortParameter, err = common.NewSortParameter(*request.SortBy)
@@ -250,6 +269,32 @@ func (t *TaskManager) ListUniqueTaskIdentifiers(ctx context.Context, request adm
 	}, nil
 }
 
+func (t *TaskManager) GetTaskDiff(ctx context.Context, request interfaces.VersionDiffRequest) (
+	*interfaces.TaskDiff, error) {
+	if err := validation.ValidateVersionDiffRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.ID.Project, request.ID.Domain)
+	ctx = contextutils.WithTaskID(ctx, request.ID.Name)
+	templates := make([]*core.TaskTemplate, 2)
+	for i, version := range []string{request.BaseVersion, request.TargetVersion} {
+		task, err := util.GetTask(ctx, t.db, core.Identifier{
+			ResourceType: core.ResourceType_TASK,
+			Project:      request.ID.Project,
+			Domain:       request.ID.Domain,
+			Name:         request.ID.Name,
+			Version:      version,
+		})
+		if err != nil {
+			logger.Debugf(ctx, "Failed to get version [%s] of task [%+v] with err %v", version, request.ID, err)
+			return nil, err
+		}
+		templates[i] = task.Closure.GetCompiledTask().GetTemplate()
+	}
+	return diffTaskTemplates(templates[0], templates[1]), nil
+}
+
 func NewTaskManager(
 	db repositories.RepositoryInterface,
 	config runtimeInterfaces.Configuration, compiler workflowengine.Compiler,
@@ -11,6 +11,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -173,6 +174,70 @@ func TestGetTask(t *testing.T) {
 	assert.True(t, proto.Equal(testutils.GetTaskClosure(), task.Closure))
 }
 
+func TestGetTasks(t *testing.T) {
+	repository := getMockTaskRepository()
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.Task, error) {
+			return models.Task{
+				TaskKey: models.TaskKey(input),
+				Closure: testutils.GetTaskClosureBytes(),
+			}, nil
+		})
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(), mockScope.NewTestScope())
+
+	tasks, err := taskManager.GetTasks(context.Background(), []*core.Identifier{&taskIdentifier})
+	assert.NoError(t, err)
+	assert.Len(t, tasks.Tasks, 1)
+	assert.True(t, proto.Equal(&taskIdentifier, tasks.Tasks[0].Id))
+
+	_, err = taskManager.GetTasks(context.Background(), []*core.Identifier{})
+	assert.EqualError(t, err, "missing id")
+}
+
+func TestGetTaskDiff(t *testing.T) {
+	repository := getMockTaskRepository()
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.Task, error) {
+			closure := testutils.GetTaskClosure()
+			closure.CompiledTask.Template.Id.Version = input.Version
+			if input.Version == "version 2" {
+				closure.CompiledTask.Template.GetContainer().Image = "image:2"
+			}
+			closureBytes, err := proto.Marshal(closure)
+			assert.NoError(t, err)
+			return models.Task{
+				TaskKey: models.TaskKey(input),
+				Closure: closureBytes,
+			}, nil
+		})
+	taskManager := NewTaskManager(repository, getMockConfigForTaskTest(), getMockTaskCompiler(), mockScope.NewTestScope())
+
+	diff, err := taskManager.GetTaskDiff(context.Background(), managerInterfaces.VersionDiffRequest{
+		ID: &admin.NamedEntityIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		BaseVersion:   "version",
+		TargetVersion: "version 2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "version", diff.BaseID.Version)
+	assert.Equal(t, "version 2", diff.TargetID.Version)
+	assert.Equal(t, "image:2", diff.Image.Target)
+	assert.Nil(t, diff.Resources)
+
+	_, err = taskManager.GetTaskDiff(context.Background(), managerInterfaces.VersionDiffRequest{
+		ID: &admin.NamedEntityIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		BaseVersion: "version",
+	})
+	assert.EqualError(t, err, "missing target_version")
+}
+
 func TestGetTask_DatabaseError(t *testing.T) {
 	repository := getMockTaskRepository()
 	expectedErr := errors.New("expected error")
@@ -0,0 +1,365 @@
+package impl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Each execution a trigger launches is labelled with the trigger's name, so that they can be listed.
+const triggerLabel = "flyte-trigger"
+
+// Payloads signed longer ago than this, or this far in the future, are rejected so that captured requests can't be
+// replayed later on. Redeliveries within the window launch a single execution, since they share the signed event id.
+const triggerTimestampTolerance = 5 * time.Minute
+
+type TriggerManager struct {
+	db                repositories.RepositoryInterface
+	config            runtimeInterfaces.Configuration
+	executionManager  interfaces.ExecutionInterface
+	launchPlanManager interfaces.LaunchPlanInterface
+}
+
+// The secret of a trigger is never returned.
+func toTrigger(triggerModel models.Trigger) (*interfaces.Trigger, error) {
+	inputMappings := make(map[string]string)
+	if len(triggerModel.InputMappings) > 0 {
+		if err := json.Unmarshal(triggerModel.InputMappings, &inputMappings); err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to unmarshal the input mappings of trigger [%s]: %v", triggerModel.Name, err)
+		}
+	}
+	return &interfaces.Trigger{
+		Id: &admin.NamedEntityIdentifier{
+			Project: triggerModel.Project,
+			Domain:  triggerModel.Domain,
+			Name:    triggerModel.Name,
+		},
+		LaunchPlan:    triggerModel.LaunchPlan,
+		InputMappings: inputMappings,
+		Principal:     triggerModel.Principal,
+		CreatedAt:     triggerModel.CreatedAt,
+		UpdatedAt:     triggerModel.UpdatedAt,
+	}, nil
+}
+
+func getTriggerIdentifier(id admin.NamedEntityIdentifier) repoInterfaces.Identifier {
+	return repoInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	}
+}
+
+// Returns whether a request was signed with a trigger's secret. The signature covers the timestamp and event id along
+// with the payload, so that neither can be changed to replay a payload.
+func isValidTriggerSignature(secret string, request interfaces.FireTriggerRequest) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(request.Timestamp + "." + request.EventId + "."))
+	mac.Write(request.Payload)
+	return hmac.Equal([]byte("sha256="+hex.EncodeToString(mac.Sum(nil))), []byte(request.Signature))
+}
+
+// Returns an error unless a request's timestamp, in seconds since the epoch, is within the tolerated skew of now.
+func validateTriggerTimestamp(timestamp string, now time.Time) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Unauthenticated, "invalid timestamp [%s]", timestamp)
+	}
+	skew := now.Sub(time.Unix(seconds, 0))
+	if skew > triggerTimestampTolerance || skew < -triggerTimestampTolerance {
+		return errors.NewFlyteAdminErrorf(codes.Unauthenticated,
+			"timestamp [%s] is outside of the tolerated %v of skew", timestamp, triggerTimestampTolerance)
+	}
+	return nil
+}
+
+// Returns the field of a JSON payload at a dot-separated path, and whether the payload has it.
+func getPayloadField(payload interface{}, path string) (interface{}, bool) {
+	field := payload
+	for _, key := range strings.Split(path, ".") {
+		object, ok := field.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if field, ok = object[key]; !ok {
+			return nil, false
+		}
+	}
+	return field, true
+}
+
+// Maps the fields of a payload to the launch plan inputs of a trigger's mappings, converting them to the types the
+// launch plan expects. Inputs without a field in the payload are left to their default.
+func getTriggerInputs(inputMappings map[string]string, payload interface{}, launchPlan *admin.LaunchPlan) (
+	*core.LiteralMap, error) {
+	literals := make(map[string]*core.Literal)
+	expectedInputs := launchPlan.GetClosure().GetExpectedInputs().GetParameters()
+	for input, path := range inputMappings {
+		value, ok := getPayloadField(payload, path)
+		if !ok {
+			continue
+		}
+		parameter, ok := expectedInputs[input]
+		if !ok {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"launch plan [%s] has no input [%s]", launchPlan.GetId().GetName(), input)
+		}
+		literal, err := coreutils.MakeLiteralForType(parameter.GetVar().GetType(), value)
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid value for input [%s] at payload path [%s]: %v", input, path, err)
+		}
+		literals[input] = literal
+	}
+	return &core.LiteralMap{
+		Literals: literals,
+	}, nil
+}
+
+func (m *TriggerManager) RegisterTrigger(
+	ctx context.Context, request interfaces.RegisterTriggerRequest) (*interfaces.Trigger, error) {
+	if err := validation.ValidateRegisterTriggerRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid register trigger request [%+v]: %v", request.Id, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Id.Project, request.Id.Domain)
+	if err := validation.ValidateProjectAndDomain(ctx, m.db, m.config.ApplicationConfiguration(),
+		request.Id.Project, request.Id.Domain); err != nil {
+		return nil, err
+	}
+	inputMappings, err := json.Marshal(request.InputMappings)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal input mappings: %v", err)
+	}
+	triggerModel := models.Trigger{
+		TriggerKey: models.TriggerKey{
+			Project: request.Id.Project,
+			Domain:  request.Id.Domain,
+			Name:    request.Id.Name,
+		},
+		LaunchPlan:    request.LaunchPlan,
+		Secret:        request.Secret,
+		InputMappings: inputMappings,
+		Principal:     getUser(ctx),
+	}
+	_, err = m.db.TriggerRepo().Get(ctx, getTriggerIdentifier(request.Id))
+	if err == nil {
+		err = m.db.TriggerRepo().Update(ctx, triggerModel)
+	} else if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+		err = m.db.TriggerRepo().Create(ctx, triggerModel)
+	}
+	if err != nil {
+		logger.Debugf(ctx, "failed to register trigger [%+v] with err: %v", request.Id, err)
+		return nil, err
+	}
+	triggerModel, err = m.db.TriggerRepo().Get(ctx, getTriggerIdentifier(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	return toTrigger(triggerModel)
+}
+
+func (m *TriggerManager) GetTrigger(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.Trigger, error) {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	triggerModel, err := m.db.TriggerRepo().Get(ctx, getTriggerIdentifier(id))
+	if err != nil {
+		return nil, err
+	}
+	return toTrigger(triggerModel)
+}
+
+func (m *TriggerManager) ListTriggers(
+	ctx context.Context, request interfaces.ListTriggersRequest) (*interfaces.TriggerList, error) {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: request.Project,
+		Domain:  request.Domain,
+	}, common.Trigger)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListTriggers", request.Token)
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       shared.Name,
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	triggerModels, err := m.db.TriggerRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to list triggers for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	triggers := make([]*interfaces.Trigger, 0, len(triggerModels))
+	for _, triggerModel := range triggerModels {
+		trigger, err := toTrigger(triggerModel)
+		if err != nil {
+			return nil, err
+		}
+		triggers = append(triggers, trigger)
+	}
+	var token string
+	if len(triggerModels) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(triggerModels))
+	}
+	return &interfaces.TriggerList{
+		Triggers: triggers,
+		Token:    token,
+	}, nil
+}
+
+func (m *TriggerManager) DeleteTrigger(ctx context.Context, id admin.NamedEntityIdentifier) error {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	return m.db.TriggerRepo().Delete(ctx, getTriggerIdentifier(id))
+}
+
+func (m *TriggerManager) FireTrigger(
+	ctx context.Context, request interfaces.FireTriggerRequest) (*core.WorkflowExecutionIdentifier, error) {
+	if err := validation.ValidateNamedEntityIdentifier(&request.Id); err != nil {
+		return nil, err
+	}
+	if len(request.Signature) == 0 {
+		return nil, errors.NewFlyteAdminError(codes.Unauthenticated, "missing payload signature")
+	}
+	if len(request.EventId) == 0 {
+		return nil, errors.NewFlyteAdminError(codes.InvalidArgument, "missing event id")
+	}
+	if err := validateTriggerTimestamp(request.Timestamp, time.Now()); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Id.Project, request.Id.Domain)
+	triggerModel, err := m.db.TriggerRepo().Get(ctx, getTriggerIdentifier(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if !isValidTriggerSignature(triggerModel.Secret, request) {
+		logger.Infof(ctx, "rejected payload posted to trigger [%+v] with an invalid signature", request.Id)
+		return nil, errors.NewFlyteAdminError(codes.Unauthenticated, "invalid payload signature")
+	}
+	var payload interface{}
+	if err := json.Unmarshal(request.Payload, &payload); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "payload isn't valid JSON: %v", err)
+	}
+	trigger, err := toTrigger(triggerModel)
+	if err != nil {
+		return nil, err
+	}
+	launchPlan, err := m.launchPlanManager.GetActiveLaunchPlan(ctx, admin.ActiveLaunchPlanRequest{
+		Id: &admin.NamedEntityIdentifier{
+			Project: triggerModel.Project,
+			Domain:  triggerModel.Domain,
+			Name:    triggerModel.LaunchPlan,
+		},
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to get the active launch plan of trigger [%+v] with err: %v", request.Id, err)
+		return nil, err
+	}
+	inputs, err := getTriggerInputs(trigger.InputMappings, payload, launchPlan)
+	if err != nil {
+		return nil, err
+	}
+	// Labels in the execution spec replace those of the launch plan, so the launch plan's are kept alongside the
+	// trigger's.
+	labels := map[string]string{
+		triggerLabel: triggerModel.Name,
+	}
+	for key, value := range launchPlan.GetSpec().GetLabels().GetValues() {
+		if key != triggerLabel {
+			labels[key] = value
+		}
+	}
+	executionRequest := admin.ExecutionCreateRequest{
+		Project: triggerModel.Project,
+		Domain:  triggerModel.Domain,
+		Spec: &admin.ExecutionSpec{
+			LaunchPlan: launchPlan.Id,
+			Labels: &admin.Labels{
+				Values: labels,
+			},
+		},
+		// Each signed event launches a single execution, however many times it's delivered.
+		Name: common.GetIdempotentExecutionName(triggerModel.Project, triggerModel.Domain,
+			fmt.Sprintf("trigger/%s/%s", triggerModel.Name, request.EventId)),
+		Inputs: inputs,
+	}
+	// Executions are launched on behalf of the user who registered the trigger.
+	identity := auth.NewIdentityContext("", triggerModel.Principal, "", time.Now(), sets.NewString(), nil)
+	response, err := m.executionManager.CreateExecution(identity.WithContext(ctx), executionRequest, time.Now())
+	if err != nil {
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.AlreadyExists {
+			logger.Debugf(ctx, "execution [%s] of trigger [%s] for event [%s] was already launched",
+				executionRequest.Name, triggerModel.Name, request.EventId)
+			return &core.WorkflowExecutionIdentifier{
+				Project: executionRequest.Project,
+				Domain:  executionRequest.Domain,
+				Name:    executionRequest.Name,
+			}, nil
+		}
+		logger.Debugf(ctx, "failed to launch an execution of trigger [%+v] with err: %v", request.Id, err)
+		return nil, err
+	}
+	logger.Infof(ctx, "Trigger [%+v] launched execution [%+v]", request.Id, response.Id)
+	return response.Id, nil
+}
+
+func NewTriggerManager(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	executionManager interfaces.ExecutionInterface, launchPlanManager interfaces.LaunchPlanInterface) interfaces.TriggerInterface {
+	return &TriggerManager{
+		db:                db,
+		config:            config,
+		executionManager:  executionManager,
+		launchPlanManager: launchPlanManager,
+	}
+}
@@ -0,0 +1,288 @@
+package impl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	managerMocks "github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var triggerID = admin.NamedEntityIdentifier{
+	Project: "project",
+	Domain:  "development",
+	Name:    "partition-landed",
+}
+
+const triggerPayload = `{"table": "events", "partition": {"date": "2021-11-01T00:00:00Z", "rows": 8888888}}`
+
+func getTriggerModel() models.Trigger {
+	return models.Trigger{
+		TriggerKey: models.TriggerKey{
+			Project: triggerID.Project,
+			Domain:  triggerID.Domain,
+			Name:    triggerID.Name,
+		},
+		LaunchPlan:    "daily",
+		Secret:        "secret",
+		InputMappings: []byte(`{"date": "partition.date", "rows": "partition.rows", "region": "region"}`),
+		Principal:     "user",
+	}
+}
+
+// Returns a request firing the test trigger, signed with the given secret at the given time.
+func getFireTriggerRequest(secret, payload, eventID string, signedAt time.Time) interfaces.FireTriggerRequest {
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + eventID + "." + payload))
+	return interfaces.FireTriggerRequest{
+		Id:        triggerID,
+		Payload:   []byte(payload),
+		Signature: "sha256=" + hex.EncodeToString(mac.Sum(nil)),
+		EventId:   eventID,
+		Timestamp: timestamp,
+	}
+}
+
+func getTriggerLaunchPlan() *admin.LaunchPlan {
+	return &admin.LaunchPlan{
+		Id: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      triggerID.Project,
+			Domain:       triggerID.Domain,
+			Name:         "daily",
+			Version:      "v2",
+		},
+		Spec: &admin.LaunchPlanSpec{
+			Labels: &admin.Labels{
+				Values: map[string]string{"team": "data"},
+			},
+		},
+		Closure: &admin.LaunchPlanClosure{
+			ExpectedInputs: &core.ParameterMap{
+				Parameters: map[string]*core.Parameter{
+					"date": {Var: &core.Variable{Type: &core.LiteralType{
+						Type: &core.LiteralType_Simple{Simple: core.SimpleType_DATETIME}}}},
+					"rows": {Var: &core.Variable{Type: &core.LiteralType{
+						Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}}},
+					"region": {Var: &core.Variable{Type: &core.LiteralType{
+						Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}}}},
+				},
+			},
+		},
+	}
+}
+
+func getTriggerManagerForTest(repository *repositoryMocks.MockRepository,
+	executionManager *managerMocks.MockExecutionManager) interfaces.TriggerInterface {
+	repository.TriggerRepo().(*repositoryMocks.TriggerRepoInterface).OnGetMatch(mock.Anything, mock.Anything).Return(
+		getTriggerModel(), nil)
+	launchPlanManager := managerMocks.MockLaunchPlanManager{}
+	launchPlanManager.SetGetActiveLaunchPlanCallback(func(
+		ctx context.Context, request admin.ActiveLaunchPlanRequest) (*admin.LaunchPlan, error) {
+		return getTriggerLaunchPlan(), nil
+	})
+	return NewTriggerManager(repository, getMockExecutionsConfigProvider(), executionManager, &launchPlanManager)
+}
+
+func TestGetPayloadField(t *testing.T) {
+	payload := map[string]interface{}{
+		"table": "events",
+		"partition": map[string]interface{}{
+			"date": "2021-11-01",
+		},
+	}
+	field, ok := getPayloadField(payload, "table")
+	assert.True(t, ok)
+	assert.Equal(t, "events", field)
+	field, ok = getPayloadField(payload, "partition.date")
+	assert.True(t, ok)
+	assert.Equal(t, "2021-11-01", field)
+	_, ok = getPayloadField(payload, "partition.hour")
+	assert.False(t, ok)
+	_, ok = getPayloadField(payload, "table.name")
+	assert.False(t, ok)
+}
+
+func TestRegisterTrigger_Create(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	triggerRepo := repository.TriggerRepo().(*repositoryMocks.TriggerRepoInterface)
+	triggerRepo.OnGetMatch(mock.Anything, mock.Anything).Return(
+		models.Trigger{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")).Once()
+	triggerRepo.OnGetMatch(mock.Anything, mock.Anything).Return(getTriggerModel(), nil)
+	var created models.Trigger
+	triggerRepo.OnCreateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		created = args.Get(1).(models.Trigger)
+	})
+	triggerManager := NewTriggerManager(repository, getMockExecutionsConfigProvider(),
+		&managerMocks.MockExecutionManager{}, &managerMocks.MockLaunchPlanManager{})
+
+	ctx := auth.NewIdentityContext("", "user", "", time.Now(), sets.NewString(), nil).WithContext(context.Background())
+	trigger, err := triggerManager.RegisterTrigger(ctx, interfaces.RegisterTriggerRequest{
+		Id:            triggerID,
+		LaunchPlan:    "daily",
+		Secret:        "secret",
+		InputMappings: map[string]string{"date": "partition.date"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "partition-landed", created.Name)
+	assert.Equal(t, "daily", created.LaunchPlan)
+	assert.Equal(t, "secret", created.Secret)
+	assert.JSONEq(t, `{"date": "partition.date"}`, string(created.InputMappings))
+	assert.Equal(t, "user", created.Principal)
+	triggerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+	assert.Equal(t, "daily", trigger.LaunchPlan)
+	assert.Equal(t, "partition.date", trigger.InputMappings["date"])
+	assert.Equal(t, "user", trigger.Principal)
+}
+
+func TestFireTrigger(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	var launched admin.ExecutionCreateRequest
+	var principal string
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		launched = request
+		principal = auth.IdentityContextFromContext(ctx).UserID()
+		return &admin.ExecutionCreateResponse{
+			Id: &core.WorkflowExecutionIdentifier{
+				Project: request.Project,
+				Domain:  request.Domain,
+				Name:    "launched",
+			},
+		}, nil
+	})
+	triggerManager := getTriggerManagerForTest(repository, &executionManager)
+
+	executionID, err := triggerManager.FireTrigger(context.Background(),
+		getFireTriggerRequest("secret", triggerPayload, "event-1", time.Now()))
+	assert.NoError(t, err)
+	assert.Equal(t, "launched", executionID.Name)
+	assert.Equal(t, "user", principal)
+	assert.Equal(t, "v2", launched.Spec.LaunchPlan.Version)
+	assert.NotEmpty(t, launched.Name)
+	assert.Equal(t, map[string]string{triggerLabel: "partition-landed", "team": "data"},
+		launched.Spec.Labels.Values)
+	// The region isn't in the payload, so it keeps its default.
+	assert.Len(t, launched.Inputs.Literals, 2)
+	assert.Equal(t, int64(8888888), launched.Inputs.Literals["rows"].GetScalar().GetPrimitive().GetInteger())
+	assert.Equal(t, int64(1635724800),
+		launched.Inputs.Literals["date"].GetScalar().GetPrimitive().GetDatetime().GetSeconds())
+}
+
+func TestFireTrigger_Redelivered(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	var names []string
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		names = append(names, request.Name)
+		if len(names) > 1 {
+			return nil, flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "already exists")
+		}
+		return &admin.ExecutionCreateResponse{
+			Id: &core.WorkflowExecutionIdentifier{
+				Project: request.Project,
+				Domain:  request.Domain,
+				Name:    request.Name,
+			},
+		}, nil
+	})
+	triggerManager := getTriggerManagerForTest(repository, &executionManager)
+
+	first, err := triggerManager.FireTrigger(context.Background(),
+		getFireTriggerRequest("secret", triggerPayload, "event-1", time.Now()))
+	assert.NoError(t, err)
+	// Redeliveries are signed anew, but with the same event id.
+	second, err := triggerManager.FireTrigger(context.Background(),
+		getFireTriggerRequest("secret", triggerPayload, "event-1", time.Now().Add(-time.Minute)))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, first.Name)
+	assert.Equal(t, first.Name, second.Name)
+	assert.Equal(t, names[0], names[1])
+}
+
+func TestFireTrigger_InvalidSignature(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	executionManager := managerMocks.MockExecutionManager{}
+	executionManager.SetCreateCallback(func(ctx context.Context, request admin.ExecutionCreateRequest,
+		requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
+		assert.Fail(t, "an execution was launched for a payload with an invalid signature")
+		return nil, nil
+	})
+	triggerManager := getTriggerManagerForTest(repository, &executionManager)
+
+	signed := func() interfaces.FireTriggerRequest {
+		return getFireTriggerRequest("secret", triggerPayload, "event-1", time.Now())
+	}
+	for _, test := range []struct {
+		name    string
+		request interfaces.FireTriggerRequest
+		modify  func(request *interfaces.FireTriggerRequest)
+	}{
+		{name: "missing signature", request: signed(), modify: func(request *interfaces.FireTriggerRequest) {
+			request.Signature = ""
+		}},
+		{name: "other secret", request: getFireTriggerRequest("other", triggerPayload, "event-1", time.Now())},
+		{name: "stale timestamp",
+			request: getFireTriggerRequest("secret", triggerPayload, "event-1", time.Now().Add(-time.Hour))},
+		{name: "future timestamp",
+			request: getFireTriggerRequest("secret", triggerPayload, "event-1", time.Now().Add(time.Hour))},
+		{name: "invalid timestamp", request: signed(), modify: func(request *interfaces.FireTriggerRequest) {
+			request.Timestamp = "yesterday"
+		}},
+		{name: "replaced timestamp", request: signed(), modify: func(request *interfaces.FireTriggerRequest) {
+			request.Timestamp = strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10)
+		}},
+		{name: "replaced event id", request: signed(), modify: func(request *interfaces.FireTriggerRequest) {
+			request.EventId = "event-2"
+		}},
+	} {
+		request := test.request
+		if test.modify != nil {
+			test.modify(&request)
+		}
+		_, err := triggerManager.FireTrigger(context.Background(), request)
+		assert.Equal(t, codes.Unauthenticated, err.(flyteAdminErrors.FlyteAdminError).Code(), test.name)
+	}
+}
+
+func TestFireTrigger_MissingEventID(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	triggerManager := getTriggerManagerForTest(repository, &managerMocks.MockExecutionManager{})
+
+	_, err := triggerManager.FireTrigger(context.Background(),
+		getFireTriggerRequest("secret", triggerPayload, "", time.Now()))
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestFireTrigger_InvalidPayload(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	triggerManager := getTriggerManagerForTest(repository, &managerMocks.MockExecutionManager{})
+
+	for _, payload := range []string{
+		`not json`,
+		`{"partition": {"rows": "many"}}`,
+	} {
+		_, err := triggerManager.FireTrigger(context.Background(),
+			getFireTriggerRequest("secret", payload, "event-1", time.Now()))
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code(), payload)
+	}
+}
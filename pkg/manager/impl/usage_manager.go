@@ -0,0 +1,137 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"google.golang.org/grpc/codes"
+)
+
+type UsageManager struct {
+	db repositories.RepositoryInterface
+}
+
+func toResourceUsage(sum models.TaskExecutionUsageSum) interfaces.ResourceUsage {
+	return interfaces.ResourceUsage{
+		CPUHours:      sum.CPUHours,
+		GPUHours:      sum.GPUHours,
+		MemoryGBHours: sum.MemoryGBHours,
+	}
+}
+
+func (m *UsageManager) GetExecutionUsage(
+	ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.ExecutionUsage, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(&id); err != nil {
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, &id)
+	if _, err := util.GetExecutionModel(ctx, m.db, id); err != nil {
+		return nil, err
+	}
+	var filters []common.InlineFilter
+	for _, field := range []struct{ name, value string }{
+		{"execution_project", id.Project},
+		{"execution_domain", id.Domain},
+		{"execution_name", id.Name},
+	} {
+		filter, err := common.NewSingleValueFilter(common.TaskExecutionUsage, common.Equal, field.name, field.value)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	sums, err := m.db.TaskExecutionUsageRepo().Sum(ctx, repoInterfaces.SumTaskExecutionUsageInput{
+		InlineFilters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	executionUsage := &interfaces.ExecutionUsage{
+		Id: &id,
+	}
+	// Executions with no terminated task executions yet have no usage recorded.
+	if len(sums) > 0 {
+		executionUsage.Usage = toResourceUsage(sums[0])
+		executionUsage.TaskExecutions = int(sums[0].TaskExecutions)
+	}
+	return executionUsage, nil
+}
+
+func (m *UsageManager) GetUsageReport(
+	ctx context.Context, request interfaces.UsageReportRequest) (*interfaces.UsageReport, error) {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return nil, err
+	}
+	if request.StartTime.IsZero() {
+		return nil, shared.GetMissingArgumentError("start_time")
+	}
+	if request.EndTime.IsZero() {
+		return nil, shared.GetMissingArgumentError("end_time")
+	}
+	if !request.EndTime.After(request.StartTime) {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"end time [%v] must be after start time [%v]", request.EndTime, request.StartTime)
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	projectFilter, err := common.NewSingleValueFilter(
+		common.TaskExecutionUsage, common.Equal, "execution_project", request.Project)
+	if err != nil {
+		return nil, err
+	}
+	startFilter, err := common.NewSingleValueFilter(
+		common.TaskExecutionUsage, common.GreaterThanOrEqual, "ended_at", request.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endFilter, err := common.NewSingleValueFilter(
+		common.TaskExecutionUsage, common.LessThan, "ended_at", request.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	filters := []common.InlineFilter{projectFilter, startFilter, endFilter}
+	if len(request.Domain) > 0 {
+		domainFilter, err := common.NewSingleValueFilter(
+			common.TaskExecutionUsage, common.Equal, "execution_domain", request.Domain)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, domainFilter)
+	}
+	sums, err := m.db.TaskExecutionUsageRepo().Sum(ctx, repoInterfaces.SumTaskExecutionUsageInput{
+		InlineFilters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	report := &interfaces.UsageReport{
+		StartTime: request.StartTime,
+		EndTime:   request.EndTime,
+		Domains:   make([]interfaces.DomainUsage, len(sums)),
+	}
+	for idx, sum := range sums {
+		report.Domains[idx] = interfaces.DomainUsage{
+			Project:        sum.ExecutionProject,
+			Domain:         sum.ExecutionDomain,
+			Usage:          toResourceUsage(sum),
+			Executions:     int(sum.Executions),
+			TaskExecutions: int(sum.TaskExecutions),
+		}
+	}
+	return report, nil
+}
+
+func NewUsageManager(db repositories.RepositoryInterface) interfaces.UsageInterface {
+	return &UsageManager{
+		db: db,
+	}
+}
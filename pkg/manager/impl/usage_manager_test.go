@@ -0,0 +1,215 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+)
+
+var usageReportStartTime = time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+var usageExecutionID = core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+// Returns the queries and args of the filters the usage repo was summed with.
+func getUsageFilterQueries(t *testing.T, filters []common.InlineFilter) map[string]interface{} {
+	queries := make(map[string]interface{}, len(filters))
+	for _, filter := range filters {
+		assert.Equal(t, common.TaskExecutionUsage, filter.GetEntity())
+		expr, err := filter.GetGormQueryExpr()
+		assert.NoError(t, err)
+		queries[expr.Query] = expr.Args
+	}
+	return queries
+}
+
+func TestGetExecutionUsage(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	usageRepo := repository.TaskExecutionUsageRepo().(*repositoryMocks.TaskExecutionUsageRepoInterface)
+	usageRepo.OnSumMatch(mock.Anything, mock.MatchedBy(func(input repositoryInterfaces.SumTaskExecutionUsageInput) bool {
+		assert.Equal(t, map[string]interface{}{
+			"execution_project = ?": "project",
+			"execution_domain = ?":  "domain",
+			"execution_name = ?":    "name",
+		}, getUsageFilterQueries(t, input.InlineFilters))
+		return true
+	})).Return([]models.TaskExecutionUsageSum{
+		{
+			ExecutionProject: "project",
+			ExecutionDomain:  "domain",
+			CPUHours:         1.5,
+			GPUHours:         0.5,
+			MemoryGBHours:    3,
+			TaskExecutions:   2,
+			Executions:       1,
+		},
+	}, nil)
+
+	usageManager := NewUsageManager(repository)
+	usage, err := usageManager.GetExecutionUsage(context.Background(), usageExecutionID)
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ExecutionUsage{
+		Id: &usageExecutionID,
+		Usage: interfaces.ResourceUsage{
+			CPUHours:      1.5,
+			GPUHours:      0.5,
+			MemoryGBHours: 3,
+		},
+		TaskExecutions: 2,
+	}, usage)
+}
+
+func TestGetExecutionUsage_NoneRecorded(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	addGetWorkflowExecutionCallback(repository)
+	repository.TaskExecutionUsageRepo().(*repositoryMocks.TaskExecutionUsageRepoInterface).OnSumMatch(
+		mock.Anything, mock.Anything).Return(nil, nil)
+
+	usageManager := NewUsageManager(repository)
+	usage, err := usageManager.GetExecutionUsage(context.Background(), usageExecutionID)
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.ExecutionUsage{
+		Id: &usageExecutionID,
+	}, usage)
+}
+
+func TestGetExecutionUsage_MissingExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input repositoryInterfaces.Identifier) (models.Execution, error) {
+			return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		})
+
+	usageManager := NewUsageManager(repository)
+	_, err := usageManager.GetExecutionUsage(context.Background(), usageExecutionID)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestGetUsageReport(t *testing.T) {
+	endTime := usageReportStartTime.Add(24 * time.Hour)
+	repository := repositoryMocks.NewMockRepository()
+	usageRepo := repository.TaskExecutionUsageRepo().(*repositoryMocks.TaskExecutionUsageRepoInterface)
+	usageRepo.OnSumMatch(mock.Anything, mock.MatchedBy(func(input repositoryInterfaces.SumTaskExecutionUsageInput) bool {
+		assert.Equal(t, map[string]interface{}{
+			"execution_project = ?": "project",
+			"ended_at >= ?":         usageReportStartTime,
+			"ended_at < ?":          endTime,
+		}, getUsageFilterQueries(t, input.InlineFilters))
+		return true
+	})).Return([]models.TaskExecutionUsageSum{
+		{
+			ExecutionProject: "project",
+			ExecutionDomain:  "development",
+			CPUHours:         10,
+			MemoryGBHours:    20,
+			TaskExecutions:   5,
+			Executions:       2,
+		},
+		{
+			ExecutionProject: "project",
+			ExecutionDomain:  "production",
+			GPUHours:         4,
+			TaskExecutions:   1,
+			Executions:       1,
+		},
+	}, nil)
+
+	usageManager := NewUsageManager(repository)
+	report, err := usageManager.GetUsageReport(context.Background(), interfaces.UsageReportRequest{
+		Project:   "project",
+		StartTime: usageReportStartTime,
+		EndTime:   endTime,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &interfaces.UsageReport{
+		StartTime: usageReportStartTime,
+		EndTime:   endTime,
+		Domains: []interfaces.DomainUsage{
+			{
+				Project: "project",
+				Domain:  "development",
+				Usage: interfaces.ResourceUsage{
+					CPUHours:      10,
+					MemoryGBHours: 20,
+				},
+				Executions:     2,
+				TaskExecutions: 5,
+			},
+			{
+				Project: "project",
+				Domain:  "production",
+				Usage: interfaces.ResourceUsage{
+					GPUHours: 4,
+				},
+				Executions:     1,
+				TaskExecutions: 1,
+			},
+		},
+	}, report)
+}
+
+func TestGetUsageReport_Domain(t *testing.T) {
+	endTime := usageReportStartTime.Add(time.Hour)
+	repository := repositoryMocks.NewMockRepository()
+	usageRepo := repository.TaskExecutionUsageRepo().(*repositoryMocks.TaskExecutionUsageRepoInterface)
+	usageRepo.OnSumMatch(mock.Anything, mock.MatchedBy(func(input repositoryInterfaces.SumTaskExecutionUsageInput) bool {
+		assert.Equal(t, map[string]interface{}{
+			"execution_project = ?": "project",
+			"execution_domain = ?":  "development",
+			"ended_at >= ?":         usageReportStartTime,
+			"ended_at < ?":          endTime,
+		}, getUsageFilterQueries(t, input.InlineFilters))
+		return true
+	})).Return(nil, nil)
+
+	usageManager := NewUsageManager(repository)
+	report, err := usageManager.GetUsageReport(context.Background(), interfaces.UsageReportRequest{
+		Project:   "project",
+		Domain:    "development",
+		StartTime: usageReportStartTime,
+		EndTime:   endTime,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, report.Domains)
+}
+
+func TestGetUsageReport_InvalidRequest(t *testing.T) {
+	usageManager := NewUsageManager(repositoryMocks.NewMockRepository())
+	for _, request := range []interfaces.UsageReportRequest{
+		{
+			StartTime: usageReportStartTime,
+			EndTime:   usageReportStartTime.Add(time.Hour),
+		},
+		{
+			Project: "project",
+			EndTime: usageReportStartTime,
+		},
+		{
+			Project:   "project",
+			StartTime: usageReportStartTime,
+		},
+		{
+			Project:   "project",
+			StartTime: usageReportStartTime,
+			EndTime:   usageReportStartTime,
+		},
+	} {
+		_, err := usageManager.GetUsageReport(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+	}
+}
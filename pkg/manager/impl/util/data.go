@@ -5,12 +5,16 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	dataInterfaces "github.com/flyteorg/flyteadmin/pkg/data/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/storage"
 	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 func shouldFetchData(config *runtimeInterfaces.RemoteDataConfig, urlBlob admin.UrlBlob) bool {
@@ -115,3 +119,143 @@ func GetOutputs(ctx context.Context, urlData dataInterfaces.RemoteURLInterface,
 	}
 	return fullOutputs, &outputsURLBlob, nil
 }
+
+// Returns a URL blob for the compiled workflow closure a dynamic node offloaded to blob storage and if config settings
+// permit, the inline closure. The closure is always read to identify the workflow.
+func GetDynamicWorkflow(ctx context.Context, urlData dataInterfaces.RemoteURLInterface,
+	remoteDataConfig *runtimeInterfaces.RemoteDataConfig, storageClient *storage.DataStore, closureURI string) (
+	*interfaces.DynamicWorkflow, error) {
+	closureURLBlob, err := urlData.Get(ctx, closureURI)
+	if err != nil {
+		return nil, err
+	}
+	closure := &core.CompiledWorkflowClosure{}
+	if err := storageClient.ReadProtobuf(ctx, storage.DataReference(closureURI), closure); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"Unable to read WorkflowClosure from location %s : %v", closureURI, err)
+	}
+	dynamicWorkflow := &interfaces.DynamicWorkflow{
+		Id:                  closure.GetPrimary().GetTemplate().GetId(),
+		CompiledWorkflowUrl: &closureURLBlob,
+	}
+	if shouldFetchData(remoteDataConfig, closureURLBlob) {
+		dynamicWorkflow.CompiledWorkflow = closure
+	}
+	return dynamicWorkflow, nil
+}
+
+// Selects the literals returned by a partial fetch of execution data, keeping count of the bytes returned across the
+// literal maps it selects from.
+type LiteralSelector struct {
+	options       interfaces.GetDataOptions
+	keys          sets.String
+	returnedBytes int64
+	// Set once a literal doesn't fit within MaxTotalBytes, after which every literal is omitted so that clients can
+	// fetch the rest by requesting the omitted literals.
+	exhausted bool
+}
+
+func (s *LiteralSelector) selectLiterals(
+	literalMap *core.LiteralMap, fullValueURL string, data *interfaces.LiteralMapData) {
+	names := s.keys.List()
+	if s.keys.Len() == 0 {
+		names = sets.StringKeySet(literalMap.GetLiterals()).List()
+	}
+	for _, name := range names {
+		literal, ok := literalMap.GetLiterals()[name]
+		if !ok {
+			continue
+		}
+		size := int64(proto.Size(literal))
+		if s.options.MaxLiteralBytes > 0 && size > s.options.MaxLiteralBytes {
+			data.Truncated[name] = interfaces.TruncatedLiteral{
+				Bytes:        size,
+				FullValueURL: fullValueURL,
+			}
+			continue
+		}
+		if s.exhausted || (s.options.MaxTotalBytes > 0 && s.returnedBytes+size > s.options.MaxTotalBytes) {
+			s.exhausted = true
+			data.Omitted = append(data.Omitted, name)
+			continue
+		}
+		data.Literals[name] = literal
+		s.returnedBytes += size
+	}
+}
+
+func NewLiteralSelector(options interfaces.GetDataOptions) *LiteralSelector {
+	return &LiteralSelector{
+		options: options,
+		keys:    sets.NewString(options.Keys...),
+	}
+}
+
+// Literal maps are read for a partial fetch up to a larger size than they're returned inline, since only some of their
+// literals are returned.
+func shouldFetchPartialData(config *runtimeInterfaces.RemoteDataConfig, urlBlob admin.UrlBlob) bool {
+	return shouldFetchData(config, urlBlob) || urlBlob.Bytes <= config.MaxPartialFetchSizeInBytes
+}
+
+// Returns the literals selected from the literal map stored at uri, or held inline when it isn't offloaded. Literal
+// maps which are too large to read are only returned as a URL blob.
+func getPartialLiteralMap(ctx context.Context, urlData dataInterfaces.RemoteURLInterface,
+	remoteDataConfig *runtimeInterfaces.RemoteDataConfig, storageClient *storage.DataStore, uri string,
+	inline *core.LiteralMap, selector *LiteralSelector) (*interfaces.LiteralMapData, error) {
+	if len(uri) == 0 && inline == nil {
+		return nil, nil
+	}
+	data := &interfaces.LiteralMapData{
+		Literals:  map[string]*core.Literal{},
+		Truncated: map[string]interfaces.TruncatedLiteral{},
+	}
+	var fullValueURL string
+	if len(uri) > 0 {
+		urlBlob, err := urlData.Get(ctx, uri)
+		if err != nil {
+			return nil, err
+		}
+		data.URLBlob = &urlBlob
+		fullValueURL = urlBlob.Url
+	}
+
+	literalMap := inline
+	if literalMap == nil {
+		if shouldFetchPartialData(remoteDataConfig, *data.URLBlob) {
+			literalMap = &core.LiteralMap{}
+			if err := storageClient.ReadProtobuf(ctx, storage.DataReference(uri), literalMap); err != nil {
+				// As with full fetches, clients fall back to the signed URL blob rather than failing altogether.
+				logger.Warningf(ctx, "Failed to read literal map from URI [%s] with err: %v", uri, err)
+				literalMap = nil
+			}
+		} else {
+			logger.Debugf(ctx, "literal map [%s] of %d bytes is too large to read for a partial fetch",
+				uri, data.URLBlob.Bytes)
+		}
+	}
+	if literalMap != nil {
+		selector.selectLiterals(literalMap, fullValueURL, data)
+	} else {
+		data.Unread = true
+	}
+	data.Incomplete = data.Unread || len(data.Truncated) > 0 || len(data.Omitted) > 0
+	return data, nil
+}
+
+// Returns the inputs of an execution selected for a partial fetch.
+func GetPartialInputs(ctx context.Context, urlData dataInterfaces.RemoteURLInterface,
+	remoteDataConfig *runtimeInterfaces.RemoteDataConfig, storageClient *storage.DataStore, inputURI string,
+	selector *LiteralSelector) (*interfaces.LiteralMapData, error) {
+	return getPartialLiteralMap(ctx, urlData, remoteDataConfig, storageClient, inputURI, nil, selector)
+}
+
+// Returns the outputs of an execution selected for a partial fetch.
+func GetPartialOutputs(ctx context.Context, urlData dataInterfaces.RemoteURLInterface,
+	remoteDataConfig *runtimeInterfaces.RemoteDataConfig, storageClient *storage.DataStore, closure ExecutionClosure,
+	selector *LiteralSelector) (*interfaces.LiteralMapData, error) {
+	if closure == nil {
+		return nil, nil
+	}
+	return getPartialLiteralMap(ctx, urlData, remoteDataConfig, storageClient, closure.GetOutputUri(),
+		closure.GetOutputData(), selector)
+}
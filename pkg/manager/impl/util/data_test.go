@@ -12,6 +12,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/stretchr/testify/assert"
@@ -242,3 +243,172 @@ func TestWorkflowExecutionClosure(t *testing.T) {
 		assert.True(t, proto.Equal(testLiteralMap, closureImpl.GetOutputData()))
 	})
 }
+
+func TestGetDynamicWorkflow(t *testing.T) {
+	closureURI := "s3://foo/bar/dynamic.pb"
+	workflowID := &core.Identifier{
+		ResourceType: core.ResourceType_WORKFLOW,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "dynamic",
+		Version:      "version",
+	}
+	closure := &core.CompiledWorkflowClosure{
+		Primary: &core.CompiledWorkflow{
+			Template: &core.WorkflowTemplate{
+				Id: workflowID,
+			},
+		},
+	}
+	expectedURLBlob := admin.UrlBlob{
+		Url:   "s3://foo/signed/dynamic.pb",
+		Bytes: 1000,
+	}
+
+	mockRemoteURL := urlMocks.NewMockRemoteURL()
+	mockRemoteURL.(*urlMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		assert.Equal(t, closureURI, uri)
+		return expectedURLBlob, nil
+	}
+	mockStorage := commonMocks.GetMockStorageClient()
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+		assert.Equal(t, closureURI, reference.String())
+		marshalled, _ := proto.Marshal(closure)
+		_ = proto.Unmarshal(marshalled, msg)
+		return nil
+	}
+
+	t.Run("inline", func(t *testing.T) {
+		dynamicWorkflow, err := GetDynamicWorkflow(context.TODO(), mockRemoteURL, &interfaces.RemoteDataConfig{
+			MaxSizeInBytes: 2000,
+		}, mockStorage, closureURI)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(workflowID, dynamicWorkflow.Id))
+		assert.True(t, proto.Equal(&expectedURLBlob, dynamicWorkflow.CompiledWorkflowUrl))
+		assert.True(t, proto.Equal(closure, dynamicWorkflow.CompiledWorkflow))
+	})
+	t.Run("too large to return inline", func(t *testing.T) {
+		dynamicWorkflow, err := GetDynamicWorkflow(context.TODO(), mockRemoteURL, &interfaces.RemoteDataConfig{
+			Scheme:         common.AWS,
+			MaxSizeInBytes: 500,
+		}, mockStorage, closureURI)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(workflowID, dynamicWorkflow.Id))
+		assert.True(t, proto.Equal(&expectedURLBlob, dynamicWorkflow.CompiledWorkflowUrl))
+		assert.Nil(t, dynamicWorkflow.CompiledWorkflow)
+	})
+}
+
+func TestGetPartialInputs(t *testing.T) {
+	inputsURI := "s3://foo/bar/inputs.pb"
+	literalMap := &core.LiteralMap{
+		Literals: map[string]*core.Literal{
+			"a": coreutils.MustMakeLiteral("short"),
+			"b": coreutils.MustMakeLiteral("a considerably longer value"),
+			"c": coreutils.MustMakeLiteral("short"),
+		},
+	}
+	shortSize := int64(proto.Size(literalMap.Literals["a"]))
+	longSize := int64(proto.Size(literalMap.Literals["b"]))
+	expectedURLBlob := admin.UrlBlob{
+		Url:   "s3://foo/signed/inputs.pb",
+		Bytes: 1000,
+	}
+
+	mockRemoteURL := urlMocks.NewMockRemoteURL()
+	mockRemoteURL.(*urlMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		assert.Equal(t, inputsURI, uri)
+		return expectedURLBlob, nil
+	}
+	mockStorage := commonMocks.GetMockStorageClient()
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+		assert.Equal(t, inputsURI, reference.String())
+		marshalled, _ := proto.Marshal(literalMap)
+		_ = proto.Unmarshal(marshalled, msg)
+		return nil
+	}
+	remoteDataConfig := interfaces.RemoteDataConfig{}
+	remoteDataConfig.MaxSizeInBytes = 100
+	remoteDataConfig.MaxPartialFetchSizeInBytes = 2000
+
+	t.Run("selected keys", func(t *testing.T) {
+		selector := NewLiteralSelector(managerInterfaces.GetDataOptions{
+			Keys: []string{"c", "missing"},
+		})
+		data, err := GetPartialInputs(context.TODO(), mockRemoteURL, &remoteDataConfig, mockStorage, inputsURI, selector)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(data.URLBlob, &expectedURLBlob))
+		assert.Len(t, data.Literals, 1)
+		assert.True(t, proto.Equal(literalMap.Literals["c"], data.Literals["c"]))
+		assert.False(t, data.Incomplete)
+	})
+	t.Run("truncated literals", func(t *testing.T) {
+		selector := NewLiteralSelector(managerInterfaces.GetDataOptions{
+			MaxLiteralBytes: shortSize,
+		})
+		data, err := GetPartialInputs(context.TODO(), mockRemoteURL, &remoteDataConfig, mockStorage, inputsURI, selector)
+		assert.NoError(t, err)
+		assert.Len(t, data.Literals, 2)
+		assert.Equal(t, map[string]managerInterfaces.TruncatedLiteral{
+			"b": {
+				Bytes:        longSize,
+				FullValueURL: expectedURLBlob.Url,
+			},
+		}, data.Truncated)
+		assert.True(t, data.Incomplete)
+	})
+	t.Run("omitted literals", func(t *testing.T) {
+		selector := NewLiteralSelector(managerInterfaces.GetDataOptions{
+			MaxTotalBytes: shortSize + longSize,
+		})
+		data, err := GetPartialInputs(context.TODO(), mockRemoteURL, &remoteDataConfig, mockStorage, inputsURI, selector)
+		assert.NoError(t, err)
+		assert.Len(t, data.Literals, 2)
+		assert.Contains(t, data.Literals, "a")
+		assert.Contains(t, data.Literals, "b")
+		assert.Equal(t, []string{"c"}, data.Omitted)
+		assert.True(t, data.Incomplete)
+
+		// Once the limit is reached, literals from subsequent literal maps are omitted as well.
+		data, err = GetPartialInputs(context.TODO(), mockRemoteURL, &remoteDataConfig, mockStorage, inputsURI, selector)
+		assert.NoError(t, err)
+		assert.Empty(t, data.Literals)
+		assert.Equal(t, []string{"a", "b", "c"}, data.Omitted)
+	})
+	t.Run("too large to read", func(t *testing.T) {
+		largeConfig := remoteDataConfig
+		largeConfig.MaxPartialFetchSizeInBytes = 500
+		selector := NewLiteralSelector(managerInterfaces.GetDataOptions{})
+		data, err := GetPartialInputs(context.TODO(), mockRemoteURL, &largeConfig, mockStorage, inputsURI, selector)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(data.URLBlob, &expectedURLBlob))
+		assert.Empty(t, data.Literals)
+		assert.True(t, data.Unread)
+		assert.True(t, data.Incomplete)
+	})
+}
+
+func TestGetPartialOutputs_Inline(t *testing.T) {
+	mockRemoteURL := urlMocks.NewMockRemoteURL()
+	mockRemoteURL.(*urlMocks.MockRemoteURL).GetCallback = func(ctx context.Context, uri string) (admin.UrlBlob, error) {
+		t.Fatal("Should not fetch a remote URL for outputs stored inline")
+		return admin.UrlBlob{}, nil
+	}
+	closure := &admin.NodeExecutionClosure{
+		OutputResult: &admin.NodeExecutionClosure_OutputData{
+			OutputData: testLiteralMap,
+		},
+	}
+	selector := NewLiteralSelector(managerInterfaces.GetDataOptions{
+		MaxLiteralBytes: 1,
+	})
+	data, err := GetPartialOutputs(context.TODO(), mockRemoteURL, &interfaces.RemoteDataConfig{},
+		commonMocks.GetMockStorageClient(), closure, selector)
+	assert.NoError(t, err)
+	assert.Nil(t, data.URLBlob)
+	assert.Empty(t, data.Literals)
+	assert.Empty(t, data.Truncated["foo-1"].FullValueURL)
+	assert.True(t, data.Incomplete)
+}
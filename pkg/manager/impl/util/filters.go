@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytestdlib/logger"
 
@@ -16,18 +17,29 @@ import (
 
 	"fmt"
 
+	"github.com/flyteorg/flyteadmin/auth"
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
 )
 
 const (
+	labelFieldPrefix          = "label."
 	filterExpressionSeperator = "+"
+	orGroupSeparator          = "|"
 	listValueSeparator        = ";"
+	projectIdentifierField    = "identifier"
 )
 
+// Upper bound on the values of a repeated value filter. Each value is bound as a separate query parameter, and
+// databases cap the parameters of a single statement (e.g. 65535 for postgres).
+const maxRepeatedValues = 10000
+
 // Matches filters of the form `func(field,value)` or `func(field, value)`
 var filterRegex = regexp.MustCompile(`(.+)\((.+),\s?(.+)\)`)
 
+// Matches null check filters of the form `func(field)`
+var nullCheckFilterRegex = regexp.MustCompile(`^(\w+)\(([^,]+)\)$`)
+
 // InlineFilter parsing consts. For example, matching on the filter string "contains(Name, foo)"
 // will return a slice consisting of: ["contains(Name, foo)", "contains", "Name", "foo"]
 const (
@@ -44,6 +56,18 @@ var timestampFields = map[string]bool{
 	"StartedAt": true,
 }
 
+// The columns of timestamp fields. Their values are compared as strings, except relative timestamps.
+var timestampColumns = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+	"deleted_at": true,
+	"started_at": true,
+}
+
+// Timestamps can be relative to when a filter is evaluated, e.g. "now-168h" for the past week, so that filters which
+// are saved and evaluated later stay current.
+const relativeTimestampPrefix = "now"
+
 var durationFields = map[string]bool{
 	"duration": true,
 }
@@ -76,8 +100,36 @@ func parseField(field string, primaryEntity common.Entity) (common.Entity, strin
 	return primaryEntity, field
 }
 
+// Splits repeated values, dropping duplicates so that large value sets bind as few query parameters as possible.
 func parseRepeatedValues(parsedValues string) []string {
-	return strings.Split(parsedValues, listValueSeparator)
+	values := strings.Split(parsedValues, listValueSeparator)
+	seen := make(map[string]bool, len(values))
+	uniqueValues := make([]string, 0, len(values))
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		uniqueValues = append(uniqueValues, value)
+	}
+	return uniqueValues
+}
+
+func isRelativeTimestamp(value string) bool {
+	return strings.HasPrefix(value, relativeTimestampPrefix)
+}
+
+func parseRelativeTimestamp(value string) (time.Time, error) {
+	offset := strings.TrimPrefix(value, relativeTimestampPrefix)
+	if len(offset) == 0 {
+		return time.Now(), nil
+	}
+	duration, err := time.ParseDuration(offset)
+	if err != nil {
+		return time.Time{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"Relative timestamp %s must be of the form now-<duration>, e.g. now-24h", value)
+	}
+	return time.Now().Add(duration), nil
 }
 
 // Handles parsing repeated values and non-string values such as time fields.
@@ -85,6 +137,14 @@ func prepareValues(field string, values []string) (interface{}, error) {
 	preparedValues := make([]interface{}, len(values))
 	if isTimestampField := timestampFields[field]; isTimestampField {
 		for idx, value := range values {
+			if isRelativeTimestamp(value) {
+				timestamp, err := parseRelativeTimestamp(value)
+				if err != nil {
+					return nil, err
+				}
+				preparedValues[idx] = timestamp
+				continue
+			}
 			timestamp, err := time.Parse(time.RFC3339Nano, value)
 			if err != nil {
 				return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
@@ -92,6 +152,17 @@ func prepareValues(field string, values []string) (interface{}, error) {
 			}
 			preparedValues[idx] = timestamp
 		}
+	} else if timestampColumns[field] {
+		for idx, value := range values {
+			preparedValues[idx] = value
+			if isRelativeTimestamp(value) {
+				timestamp, err := parseRelativeTimestamp(value)
+				if err != nil {
+					return nil, err
+				}
+				preparedValues[idx] = timestamp
+			}
+		}
 	} else if isDurationField := durationFields[strings.ToLower(field)]; isDurationField {
 		for idx, value := range values {
 			floatValue, err := strconv.ParseFloat(value, 64)
@@ -117,27 +188,97 @@ func prepareValues(field string, values []string) (interface{}, error) {
 	return preparedValues, nil
 }
 
+func parseFilter(filterExpression string, primaryEntity common.Entity) (common.InlineFilter, error) {
+	// Null checks don't take a value, e.g. "is_null(error_kind)"
+	if matches := nullCheckFilterRegex.FindStringSubmatch(filterExpression); len(matches) == 3 {
+		referencedEntity, field := parseField(matches[fieldMatchIndex], primaryEntity)
+		if isLabelField(referencedEntity, field) {
+			return newInlineLabelFilter(referencedEntity, matches[funcMatchIndex], field, nil)
+		}
+		return common.NewInlineNullCheckFilter(referencedEntity, matches[funcMatchIndex], field)
+	}
+
+	// Parse string expression
+	matches := filterRegex.FindStringSubmatch(filterExpression)
+	if len(matches) != expectedMatchGroupLength {
+		// Poorly formatted filter string doesn't match expected regex.
+		return nil, shared.GetInvalidArgumentError(shared.Filters)
+	}
+	referencedEntity, field := parseField(matches[fieldMatchIndex], primaryEntity)
+
+	// Parse and transform values
+	parsedValues := parseRepeatedValues(matches[valueMatchIndex])
+	if len(parsedValues) > maxRepeatedValues {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"filter on %s has %d values, exceeding the maximum of %d", field, len(parsedValues), maxRepeatedValues)
+	}
+	preparedValues, err := prepareValues(field, parsedValues)
+	if err != nil {
+		return nil, err
+	}
+	// Create InlineFilter object.
+	if isLabelField(referencedEntity, field) {
+		return newInlineLabelFilter(referencedEntity, matches[funcMatchIndex], field, preparedValues)
+	}
+	return common.NewInlineFilter(referencedEntity, matches[funcMatchIndex], field, preparedValues)
+}
+
+// Execution and project labels are filtered on as "label.<key>", e.g. "eq(label.team, ml)"
+func isLabelField(entity common.Entity, field string) bool {
+	return (entity == common.Execution || entity == common.Project) && strings.HasPrefix(field, labelFieldPrefix)
+}
+
+func newInlineLabelFilter(entity common.Entity, function, field string, value interface{}) (common.InlineFilter, error) {
+	key := strings.TrimPrefix(field, labelFieldPrefix)
+	if entity == common.Project {
+		return common.NewInlineProjectLabelFilter(function, key, value)
+	}
+	return common.NewInlineExecutionLabelFilter(function, key, value)
+}
+
+// Returns the parameter to sort executions by, which may be the value of a label as "label.<key>".
+func GetExecutionSortParameter(sort admin.Sort) (common.SortParameter, error) {
+	if strings.HasPrefix(sort.Key, labelFieldPrefix) {
+		return common.NewExecutionLabelSortParameter(sort, strings.TrimPrefix(sort.Key, labelFieldPrefix))
+	}
+	return common.NewSortParameter(sort)
+}
+
+// Splits an OR group into its filters. Only separators between filters split the group, so values such as that of
+// "eq(name, a|b)" may contain orGroupSeparator.
+func splitOrGroup(filterExpression string) []string {
+	var groupExpressions []string
+	depth, start := 0, 0
+	for idx, char := range filterExpression {
+		switch {
+		case char == '(':
+			depth++
+		case char == ')' && depth > 0:
+			depth--
+		case depth == 0 && strings.HasPrefix(filterExpression[idx:], orGroupSeparator):
+			groupExpressions = append(groupExpressions, filterExpression[start:idx])
+			start = idx + len(orGroupSeparator)
+		}
+	}
+	return append(groupExpressions, filterExpression[start:])
+}
+
 func ParseFilters(filterParams string, primaryEntity common.Entity) ([]common.InlineFilter, error) {
 	// Multiple filters can be appended as URI-escaped strings joined by filterExpressionSeperator
 	filterExpressions := strings.Split(filterParams, filterExpressionSeperator)
 	parsedFilters := make([]common.InlineFilter, 0)
 	for _, filterExpression := range filterExpressions {
-		// Parse string expression
-		matches := filterRegex.FindStringSubmatch(filterExpression)
-		if len(matches) != expectedMatchGroupLength {
-			// Poorly formatted filter string doesn't match expected regex.
-			return nil, shared.GetInvalidArgumentError(shared.Filters)
-		}
-		referencedEntity, field := parseField(matches[fieldMatchIndex], primaryEntity)
-
-		// Parse and transform values
-		parsedValues := parseRepeatedValues(matches[valueMatchIndex])
-		preparedValues, err := prepareValues(field, parsedValues)
-		if err != nil {
-			return nil, err
+		// Filters joined by orGroupSeparator match when any of them do, e.g. "eq(phase, FAILED)|eq(phase, ABORTED)"
+		groupExpressions := splitOrGroup(filterExpression)
+		groupFilters := make([]common.InlineFilter, len(groupExpressions))
+		for idx, groupExpression := range groupExpressions {
+			filter, err := parseFilter(strings.TrimSpace(groupExpression), primaryEntity)
+			if err != nil {
+				return nil, err
+			}
+			groupFilters[idx] = filter
 		}
-		// Create InlineFilter object.
-		filter, err := common.NewInlineFilter(referencedEntity, matches[funcMatchIndex], field, preparedValues)
+		filter, err := common.NewOrGroupFilter(groupFilters)
 		if err != nil {
 			return nil, err
 		}
@@ -202,6 +343,28 @@ func AddRequestFilters(requestFilters string, primaryEntity common.Entity, exist
 	return updatedFilters, nil
 }
 
+// AddProjectVisibilityFilter restricts the query to the projects the caller is allowed to view when project visibility
+// is enforced. The predicate is pushed down to the database rather than applied to the listed results.
+func AddProjectVisibilityFilter(ctx context.Context, primaryEntity common.Entity, existingFilters []common.InlineFilter) (
+	[]common.InlineFilter, error) {
+
+	visibleProjects, restricted := auth.VisibleProjectsFromContext(ctx)
+	if !restricted {
+		return existingFilters, nil
+	}
+
+	field := shared.Project
+	if primaryEntity == common.Project {
+		field = projectIdentifierField
+	}
+
+	visibilityFilter, err := common.NewRepeatedValueFilter(primaryEntity, common.ValueIn, field, visibleProjects.List())
+	if err != nil {
+		return nil, err
+	}
+	return append(existingFilters, visibilityFilter), nil
+}
+
 // Consolidates request params and filters to a single list of filters. This consolidation is necessary since the db is
 // agnostic to required request parameters and additional filter arguments.
 func GetDbFilters(spec FilterSpec, primaryEntity common.Entity) ([]common.InlineFilter, error) {
@@ -2,11 +2,17 @@ package util
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/flyteorg/flyteadmin/auth"
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
@@ -15,6 +21,7 @@ import (
 
 func TestParseRepeatedValues(t *testing.T) {
 	assert.EqualValues(t, []string{"foo", "bar"}, parseRepeatedValues("foo;bar"))
+	assert.EqualValues(t, []string{"foo", "bar"}, parseRepeatedValues("foo;bar;foo"))
 }
 
 func TestPrepareValues_WithTimestamp(t *testing.T) {
@@ -34,6 +41,24 @@ func TestPrepareValues_WithTimestamp(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestPrepareValues_WithRelativeTimestamp(t *testing.T) {
+	values, err := prepareValues("CreatedAt", []string{"now-168h"})
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-168*time.Hour), values.(time.Time), time.Minute)
+
+	values, err = prepareValues("created_at", []string{"now"})
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), values.(time.Time), time.Minute)
+
+	// Absolute timestamps of columns are still compared as strings.
+	values, err = prepareValues("created_at", []string{"2018-07-27T00:30:31Z"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2018-07-27T00:30:31Z", values)
+
+	_, err = prepareValues("started_at", []string{"now-a week"})
+	assert.EqualError(t, err, "Relative timestamp now-a week must be of the form now-<duration>, e.g. now-24h")
+}
+
 func TestPrepareValues_WithDuration(t *testing.T) {
 	duration := "3600.5s"
 	values, err := prepareValues("duration", []string{duration})
@@ -88,6 +113,114 @@ func TestParseFilters(t *testing.T) {
 	assert.EqualError(t, err, "unrecognized filter function: invalid_function")
 }
 
+func TestParseFilters_OrGroups(t *testing.T) {
+	filterExpression := "eq(phase, FAILED)| ieq(phase, aborted)|is_null(execution.error_kind)+gt(duration, 1h)"
+	executionFilters, err := ParseFilters(filterExpression, common.Execution)
+	assert.NoError(t, err)
+
+	assert.Len(t, executionFilters, 2)
+	actualFilterExpression, _ := executionFilters[0].GetGormQueryExpr()
+	assert.Equal(t, "(phase = ? OR LOWER(phase) = LOWER(?) OR error_kind IS NULL)", actualFilterExpression.Query)
+	assert.Equal(t, []interface{}{"FAILED", "aborted"}, actualFilterExpression.GetArgs())
+
+	actualFilterExpression, _ = executionFilters[1].GetGormQueryExpr()
+	assert.Equal(t, "duration > ?", actualFilterExpression.Query)
+	assert.Equal(t, time.Hour, actualFilterExpression.Args)
+
+	_, err = ParseFilters("eq(phase, FAILED)|eq(workflow.name, foo)", common.Execution)
+	assert.EqualError(t, err, "filters on phase and name in an OR group must reference the same entity")
+}
+
+func TestParseFilters_OrGroupSeparatorInValues(t *testing.T) {
+	executionFilters, err := ParseFilters("eq(name, a|b)|value_in(name, c|d;e)+contains(name, |)", common.Execution)
+	assert.NoError(t, err)
+
+	assert.Len(t, executionFilters, 2)
+	actualFilterExpression, _ := executionFilters[0].GetGormQueryExpr()
+	assert.Equal(t, "(name = ? OR name in (?))", actualFilterExpression.Query)
+	assert.Equal(t, []interface{}{"a|b", []interface{}{"c|d", "e"}}, actualFilterExpression.GetArgs())
+
+	actualFilterExpression, _ = executionFilters[1].GetGormQueryExpr()
+	assert.Equal(t, "name LIKE ?", actualFilterExpression.Query)
+	assert.Equal(t, "%|%", actualFilterExpression.Args)
+}
+
+func TestParseFilters_NullChecks(t *testing.T) {
+	executionFilters, err := ParseFilters("is_not_null(error_kind)+is_null(workflow.state)", common.Execution)
+	assert.NoError(t, err)
+
+	assert.Len(t, executionFilters, 2)
+	actualFilterExpression, _ := executionFilters[0].GetGormQueryExpr()
+	assert.Equal(t, "error_kind IS NOT NULL", actualFilterExpression.Query)
+	assert.Equal(t, common.Workflow, executionFilters[1].GetEntity())
+
+	_, err = ParseFilters("eq(error_kind)", common.Execution)
+	assert.EqualError(t, err, "invalid null check filter expression: equal")
+
+	_, err = ParseFilters("is_null(error_kind, foo)", common.Execution)
+	assert.EqualError(t, err, "invalid repeated value filter expression: is null")
+}
+
+func TestParseFilters_ExecutionLabels(t *testing.T) {
+	executionFilters, err := ParseFilters(
+		"eq(label.team, ml)+is_null(label.env)+value_in(execution.label.tier, gold;silver)", common.Execution)
+	assert.NoError(t, err)
+
+	assert.Len(t, executionFilters, 3)
+	assert.Equal(t, "label.team", executionFilters[0].GetField())
+	actualFilterExpression, _ := executionFilters[0].GetGormJoinTableQueryExpr("executions")
+	assert.Equal(t, []interface{}{"team", "ml"}, actualFilterExpression.GetArgs())
+
+	actualFilterExpression, _ = executionFilters[1].GetGormJoinTableQueryExpr("executions")
+	assert.True(t, strings.HasPrefix(actualFilterExpression.Query, "NOT EXISTS"))
+	assert.Equal(t, []interface{}{"env"}, actualFilterExpression.GetArgs())
+
+	actualFilterExpression, _ = executionFilters[2].GetGormJoinTableQueryExpr("executions")
+	assert.Equal(t, []interface{}{"tier", []interface{}{"gold", "silver"}}, actualFilterExpression.GetArgs())
+
+	// Labels are only recognized on executions and projects.
+	workflowFilters, err := ParseFilters("eq(label.team, ml)", common.Workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, "label.team", workflowFilters[0].GetField())
+	assert.Equal(t, common.Workflow, workflowFilters[0].GetEntity())
+
+	_, err = ParseFilters("contains(label.team, ml)", common.Execution)
+	assert.EqualError(t, err, "unsupported execution label filter expression: contains")
+}
+
+func TestParseFilters_ProjectLabels(t *testing.T) {
+	projectFilters, err := ParseFilters("eq(label.team, ml)+eq(owner, alice)", common.Project)
+	assert.NoError(t, err)
+
+	assert.Len(t, projectFilters, 2)
+	assert.Equal(t, common.Project, projectFilters[0].GetEntity())
+	actualFilterExpression, err := projectFilters[0].GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(actualFilterExpression.Query, "project_labels"))
+	assert.Equal(t, []interface{}{"team", "ml"}, actualFilterExpression.GetArgs())
+
+	actualFilterExpression, err = projectFilters[1].GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "owner = ?", actualFilterExpression.Query)
+}
+
+func TestParseFilters_LargeValueSets(t *testing.T) {
+	values := make([]string, maxRepeatedValues)
+	for idx := range values {
+		values[idx] = strconv.Itoa(idx)
+	}
+	executionFilters, err := ParseFilters(
+		fmt.Sprintf("value_not_in(name, %s;0)", strings.Join(values, ";")), common.Execution)
+	assert.NoError(t, err)
+	actualFilterExpression, _ := executionFilters[0].GetGormQueryExpr()
+	assert.Equal(t, "execution_name not in (?)", actualFilterExpression.Query)
+	assert.Len(t, actualFilterExpression.Args, maxRepeatedValues)
+
+	values = append(values, "one too many")
+	_, err = ParseFilters(fmt.Sprintf("value_in(name, %s)", strings.Join(values, ";")), common.Execution)
+	assert.EqualError(t, err, "filter on name has 10001 values, exceeding the maximum of 10000")
+}
+
 func TestGetEqualityFilter(t *testing.T) {
 	filter, err := GetSingleValueEqualityFilter(common.Task, "field", "value")
 	assert.NoError(t, err)
@@ -113,6 +246,41 @@ func TestAddRequestFilters(t *testing.T) {
 	assert.Equal(t, "workflow", expression.Args)
 }
 
+func TestAddProjectVisibilityFilter(t *testing.T) {
+	filters, err := AddProjectVisibilityFilter(context.Background(), common.Workflow, make([]common.InlineFilter, 0))
+	assert.NoError(t, err)
+	assert.Empty(t, filters)
+
+	ctx := auth.WithVisibleProjects(context.Background(), sets.NewString("foo", "bar"))
+	filters, err = AddProjectVisibilityFilter(ctx, common.Execution, make([]common.InlineFilter, 0))
+	assert.NoError(t, err)
+	assert.Len(t, filters, 1)
+	expression, err := filters[0].GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "execution_project in (?)", expression.Query)
+	assert.Equal(t, []string{"bar", "foo"}, expression.Args)
+
+	filters, err = AddProjectVisibilityFilter(ctx, common.Project, make([]common.InlineFilter, 0))
+	assert.NoError(t, err)
+	assert.Len(t, filters, 1)
+	expression, err = filters[0].GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "identifier in (?)", expression.Query)
+}
+
+func TestGetExecutionSortParameter(t *testing.T) {
+	sortParameter, err := GetExecutionSortParameter(admin.Sort{Key: "created_at", Direction: admin.Sort_ASCENDING})
+	assert.NoError(t, err)
+	assert.Equal(t, "created_at asc", sortParameter.GetGormOrderExpr())
+
+	sortParameter, err = GetExecutionSortParameter(admin.Sort{Key: "label.team", Direction: admin.Sort_ASCENDING})
+	assert.NoError(t, err)
+	assert.True(t, strings.HasSuffix(sortParameter.GetGormOrderExpr(), "execution_labels.key = 'team') asc"))
+
+	_, err = GetExecutionSortParameter(admin.Sort{Key: "label.", Direction: admin.Sort_ASCENDING})
+	assert.Error(t, err)
+}
+
 func TestGetDbFilters(t *testing.T) {
 	actualFilters, err := GetDbFilters(FilterSpec{
 		Project:        "project",
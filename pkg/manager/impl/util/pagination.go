@@ -0,0 +1,65 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"google.golang.org/grpc/codes"
+)
+
+// Lists sorted by this key are paginated with cursor tokens rather than offsets.
+const cursorSortKey = "created_at"
+
+// The contents of a cursor token, which identifies the last resource on a page.
+type cursorToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uint      `json:"id"`
+}
+
+// SupportsCursorPagination returns true when a list sorted by sortBy can be paginated using cursor tokens.
+func SupportsCursorPagination(sortBy *admin.Sort) bool {
+	return sortBy != nil && sortBy.Key == cursorSortKey
+}
+
+// NewCursorToken encodes the position of the last resource on a page as an opaque pagination token.
+func NewCursorToken(createdAt time.Time, id uint) (string, error) {
+	marshaled, err := json.Marshal(cursorToken{
+		CreatedAt: createdAt,
+		ID:        id,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(marshaled), nil
+}
+
+// ParseCursorToken decodes a token issued by NewCursorToken into a list cursor. Clients may still hold offset tokens
+// issued before they switched to a cursor sortable list, in which case no cursor is returned and the token should be
+// parsed as an offset instead.
+func ParseCursorToken(token string, sortBy *admin.Sort) (*repoInterfaces.ListCursor, error) {
+	cursor := &repoInterfaces.ListCursor{
+		Descending: sortBy.Direction == admin.Sort_DESCENDING,
+	}
+	if token == "" {
+		return cursor, nil
+	}
+	if _, err := strconv.Atoi(token); err == nil {
+		return nil, nil
+	}
+	marshaled, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid pagination token %s", token)
+	}
+	var decoded cursorToken
+	if err := json.Unmarshal(marshaled, &decoded); err != nil || decoded.ID == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid pagination token %s", token)
+	}
+	cursor.CreatedAt = decoded.CreatedAt
+	cursor.ID = decoded.ID
+	return cursor, nil
+}
@@ -0,0 +1,64 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var createdAtAscending = &admin.Sort{
+	Key:       "created_at",
+	Direction: admin.Sort_ASCENDING,
+}
+
+func TestSupportsCursorPagination(t *testing.T) {
+	assert.True(t, SupportsCursorPagination(createdAtAscending))
+	assert.False(t, SupportsCursorPagination(&admin.Sort{
+		Key: "name",
+	}))
+	assert.False(t, SupportsCursorPagination(nil))
+}
+
+func TestCursorToken(t *testing.T) {
+	createdAt := time.Date(2021, 8, 20, 10, 0, 0, 123456000, time.UTC)
+	token, err := NewCursorToken(createdAt, 42)
+	assert.NoError(t, err)
+
+	cursor, err := ParseCursorToken(token, createdAtAscending)
+	assert.NoError(t, err)
+	assert.Equal(t, &repoInterfaces.ListCursor{
+		CreatedAt: createdAt,
+		ID:        42,
+	}, cursor)
+}
+
+func TestParseCursorToken_FirstPage(t *testing.T) {
+	cursor, err := ParseCursorToken("", &admin.Sort{
+		Key:       "created_at",
+		Direction: admin.Sort_DESCENDING,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &repoInterfaces.ListCursor{
+		Descending: true,
+	}, cursor)
+	assert.True(t, cursor.IsFirstPage())
+}
+
+func TestParseCursorToken_OffsetToken(t *testing.T) {
+	cursor, err := ParseCursorToken("20", createdAtAscending)
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestParseCursorToken_Invalid(t *testing.T) {
+	for _, token := range []string{"!!!", "bm90IGpzb24", "e30"} {
+		_, err := ParseCursorToken(token, createdAtAscending)
+		assert.Error(t, err, token)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	}
+}
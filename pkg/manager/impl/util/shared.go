@@ -3,13 +3,13 @@ package util
 
 import (
 	"context"
-	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoErrors "github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
@@ -17,16 +17,10 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 )
 
-func GetExecutionName(request admin.ExecutionCreateRequest) string {
-	if request.Name != "" {
-		return request.Name
-	}
-	return common.GetExecutionName(time.Now().UnixNano())
-}
-
 func GetTask(ctx context.Context, repo repositories.RepositoryInterface, identifier core.Identifier) (
 	*admin.Task, error) {
 	taskModel, err := GetTaskModel(ctx, repo, &identifier)
@@ -42,6 +36,51 @@ func GetTask(ctx context.Context, repo repositories.RepositoryInterface, identif
 	return &task, nil
 }
 
+func getRepoIdentifier(id *core.Identifier) repoInterfaces.Identifier {
+	return repoInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+		Version: id.Version,
+	}
+}
+
+func getRepoIdentifiers(ids []*core.Identifier) []repoInterfaces.Identifier {
+	repoIDs := make([]repoInterfaces.Identifier, len(ids))
+	for i, id := range ids {
+		repoIDs[i] = getRepoIdentifier(id)
+	}
+	return repoIDs
+}
+
+// Returns the tasks with the given identifiers, in the same order, which are fetched in a single query. Fails if any
+// of the tasks doesn't exist.
+func GetTasks(ctx context.Context, repo repositories.RepositoryInterface, ids []*core.Identifier) (
+	[]*admin.Task, error) {
+	taskModels, err := repo.TaskRepo().GetBatch(ctx, getRepoIdentifiers(ids))
+	if err != nil {
+		return nil, err
+	}
+	taskModelsByID := make(map[repoInterfaces.Identifier]models.Task, len(taskModels))
+	for _, taskModel := range taskModels {
+		taskModelsByID[repoInterfaces.Identifier(taskModel.TaskKey)] = taskModel
+	}
+	tasks := make([]*admin.Task, len(ids))
+	for i, id := range ids {
+		taskModel, ok := taskModelsByID[getRepoIdentifier(id)]
+		if !ok {
+			return nil, repoErrors.GetMissingEntityError(core.ResourceType_TASK.String(), id)
+		}
+		task, err := transformers.FromTaskModel(taskModel)
+		if err != nil {
+			logger.Errorf(ctx, "Failed to transform task model for identifier [%+v] with err: %v", id, err)
+			return nil, err
+		}
+		tasks[i] = &task
+	}
+	return tasks, nil
+}
+
 func GetWorkflowModel(
 	ctx context.Context, repo repositories.RepositoryInterface, identifier core.Identifier) (models.Workflow, error) {
 	workflowModel, err := (repo).WorkflowRepo().Get(ctx, repoInterfaces.Identifier{
@@ -69,6 +108,21 @@ func FetchAndGetWorkflowClosure(ctx context.Context,
 	return closure, nil
 }
 
+// Returns the compiled closure of the workflow, whether it's stored with the workflow or offloaded to the blob store.
+func GetWorkflowClosure(ctx context.Context, store *storage.DataStore, workflowModel models.Workflow) (
+	*admin.WorkflowClosure, error) {
+	if len(workflowModel.Closure) == 0 {
+		return FetchAndGetWorkflowClosure(ctx, store, workflowModel.RemoteClosureIdentifier)
+	}
+	closure := &admin.WorkflowClosure{}
+	if err := proto.Unmarshal(workflowModel.Closure, closure); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"Unable to unmarshal WorkflowClosure of workflow [%s/%s/%s/%s]: %v", workflowModel.Project,
+			workflowModel.Domain, workflowModel.Name, workflowModel.Version, err)
+	}
+	return closure, nil
+}
+
 func GetWorkflow(
 	ctx context.Context,
 	repo repositories.RepositoryInterface,
@@ -82,7 +136,7 @@ func GetWorkflow(
 	if err != nil {
 		return nil, err
 	}
-	closure, err := FetchAndGetWorkflowClosure(ctx, store, workflowModel.RemoteClosureIdentifier)
+	closure, err := GetWorkflowClosure(ctx, store, workflowModel)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +145,42 @@ func GetWorkflow(
 	return &workflow, nil
 }
 
+// Returns the workflows with the given identifiers, in the same order, which are fetched in a single query. Fails if any
+// of the workflows doesn't exist.
+func GetWorkflows(
+	ctx context.Context,
+	repo repositories.RepositoryInterface,
+	store *storage.DataStore,
+	ids []*core.Identifier) ([]*admin.Workflow, error) {
+	workflowModels, err := repo.WorkflowRepo().GetBatch(ctx, getRepoIdentifiers(ids))
+	if err != nil {
+		return nil, err
+	}
+	workflowModelsByID := make(map[repoInterfaces.Identifier]models.Workflow, len(workflowModels))
+	for _, workflowModel := range workflowModels {
+		workflowModelsByID[repoInterfaces.Identifier(workflowModel.WorkflowKey)] = workflowModel
+	}
+	workflows := make([]*admin.Workflow, len(ids))
+	for i, id := range ids {
+		workflowModel, ok := workflowModelsByID[getRepoIdentifier(id)]
+		if !ok {
+			return nil, repoErrors.GetMissingEntityError(core.ResourceType_WORKFLOW.String(), id)
+		}
+		workflow, err := transformers.FromWorkflowModel(workflowModel)
+		if err != nil {
+			return nil, err
+		}
+		closure, err := GetWorkflowClosure(ctx, store, workflowModel)
+		if err != nil {
+			return nil, err
+		}
+		closure.CreatedAt = workflow.Closure.CreatedAt
+		workflow.Closure = closure
+		workflows[i] = &workflow
+	}
+	return workflows, nil
+}
+
 func GetLaunchPlanModel(
 	ctx context.Context, repo repositories.RepositoryInterface, identifier core.Identifier) (models.LaunchPlan, error) {
 	launchPlanModel, err := (repo).LaunchPlanRepo().Get(ctx, repoInterfaces.Identifier{
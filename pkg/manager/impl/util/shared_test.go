@@ -8,7 +8,6 @@ import (
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 
-	"github.com/flyteorg/flyteadmin/pkg/common"
 	commonMocks "github.com/flyteorg/flyteadmin/pkg/common/mocks"
 	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
@@ -32,24 +31,6 @@ const remoteClosureIdentifier = "remote closure id"
 
 var errExpected = errors.New("expected error")
 
-func TestPopulateExecutionID(t *testing.T) {
-	name := GetExecutionName(admin.ExecutionCreateRequest{
-		Project: "project",
-		Domain:  "domain",
-	})
-	assert.NotEmpty(t, name)
-	assert.Len(t, name, common.ExecutionIDLength)
-}
-
-func TestPopulateExecutionID_ExistingName(t *testing.T) {
-	name := GetExecutionName(admin.ExecutionCreateRequest{
-		Project: "project",
-		Domain:  "domain",
-		Name:    "name",
-	})
-	assert.Equal(t, "name", name)
-}
-
 func TestGetTask(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	taskGetFunc := func(input interfaces.Identifier) (models.Task, error) {
@@ -83,6 +64,67 @@ func TestGetTask(t *testing.T) {
 	assert.Equal(t, version, task.Id.Version)
 }
 
+func TestGetTasks(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetBatchCallback(
+		func(input []interfaces.Identifier) ([]models.Task, error) {
+			assert.Len(t, input, 2)
+			// Returned in a different order from the one requested.
+			tasks := make([]models.Task, 0, len(input))
+			for i := len(input) - 1; i >= 0; i-- {
+				tasks = append(tasks, models.Task{
+					TaskKey: models.TaskKey{
+						Project: input[i].Project,
+						Domain:  input[i].Domain,
+						Name:    input[i].Name,
+						Version: input[i].Version,
+					},
+					Closure: testutils.GetTaskClosureBytes(),
+				})
+			}
+			return tasks, nil
+		})
+	tasks, err := GetTasks(context.Background(), repository, []*core.Identifier{
+		{
+			ResourceType: core.ResourceType_TASK,
+			Project:      project,
+			Domain:       domain,
+			Name:         name,
+			Version:      "v1",
+		},
+		{
+			ResourceType: core.ResourceType_TASK,
+			Project:      project,
+			Domain:       domain,
+			Name:         name,
+			Version:      "v2",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, "v1", tasks[0].Id.Version)
+	assert.Equal(t, "v2", tasks[1].Id.Version)
+}
+
+func TestGetTasks_Missing(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetBatchCallback(
+		func(input []interfaces.Identifier) ([]models.Task, error) {
+			return []models.Task{}, nil
+		})
+	tasks, err := GetTasks(context.Background(), repository, []*core.Identifier{
+		{
+			ResourceType: core.ResourceType_TASK,
+			Project:      project,
+			Domain:       domain,
+			Name:         name,
+			Version:      version,
+		},
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Nil(t, tasks)
+}
+
 func TestGetTask_DatabaseError(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	taskGetFunc := func(input interfaces.Identifier) (models.Task, error) {
@@ -206,6 +248,42 @@ func TestFetchAndGetWorkflowClosure_RemoteReadError(t *testing.T) {
 	assert.Nil(t, closure)
 }
 
+func TestGetWorkflowClosure(t *testing.T) {
+	mockStorageClient := commonMocks.GetMockStorageClient()
+	var read bool
+	mockStorageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			assert.Equal(t, remoteClosureIdentifier, reference.String())
+			read = true
+			_ = proto.Unmarshal(testutils.GetWorkflowClosureBytes(), msg)
+			return nil
+		}
+	t.Run("inline", func(t *testing.T) {
+		read = false
+		closure, err := GetWorkflowClosure(context.Background(), mockStorageClient, models.Workflow{
+			Closure: testutils.GetWorkflowClosureBytes(),
+		})
+		assert.NoError(t, err)
+		assert.False(t, read)
+		assert.True(t, proto.Equal(testutils.GetWorkflowClosure(), closure))
+	})
+	t.Run("offloaded", func(t *testing.T) {
+		read = false
+		closure, err := GetWorkflowClosure(context.Background(), mockStorageClient, models.Workflow{
+			RemoteClosureIdentifier: remoteClosureIdentifier,
+		})
+		assert.NoError(t, err)
+		assert.True(t, read)
+		assert.True(t, proto.Equal(testutils.GetWorkflowClosure(), closure))
+	})
+	t.Run("corrupt", func(t *testing.T) {
+		_, err := GetWorkflowClosure(context.Background(), mockStorageClient, models.Workflow{
+			Closure: []byte("not a closure"),
+		})
+		assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+	})
+}
+
 func TestGetWorkflow(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	workflowGetFunc := func(input interfaces.Identifier) (models.Workflow, error) {
@@ -247,6 +325,50 @@ func TestGetWorkflow(t *testing.T) {
 	assert.NotNil(t, workflow)
 }
 
+func TestGetWorkflows(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetGetBatchCallback(
+		func(input []interfaces.Identifier) ([]models.Workflow, error) {
+			workflows := make([]models.Workflow, len(input))
+			for i, id := range input {
+				workflows[i] = models.Workflow{
+					WorkflowKey: models.WorkflowKey{
+						Project: id.Project,
+						Domain:  id.Domain,
+						Name:    id.Name,
+						Version: id.Version,
+					},
+					TypedInterface:          testutils.GetWorkflowRequestInterfaceBytes(),
+					RemoteClosureIdentifier: remoteClosureIdentifier,
+				}
+			}
+			return workflows, nil
+		})
+
+	mockStorageClient := commonMocks.GetMockStorageClient()
+	mockStorageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			assert.Equal(t, remoteClosureIdentifier, reference.String())
+			compiledWorkflowClosure := testutils.GetWorkflowClosure()
+			workflowBytes, _ := proto.Marshal(compiledWorkflowClosure)
+			_ = proto.Unmarshal(workflowBytes, msg)
+			return nil
+		}
+	workflows, err := GetWorkflows(context.Background(), repository, mockStorageClient, []*core.Identifier{
+		{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "name",
+			Version:      "version",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, workflows, 1)
+	assert.Equal(t, "version", workflows[0].Id.Version)
+	assert.NotNil(t, workflows[0].Closure.CompiledWorkflow)
+}
+
 func TestGetLaunchPlanModel(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	getLaunchPlanFunc := func(input interfaces.Identifier) (models.LaunchPlan, error) {
@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+)
+
+// Validates a request to create a backfill. The number of times its launch plan was scheduled in the range is only
+// known once the launch plan is fetched, and is validated by the backfill manager.
+func ValidateCreateBackfillRequest(request interfaces.CreateBackfillRequest, maxParallelism int) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	// Backfill names label their executions, so they're restricted to the same format as execution names.
+	if len(request.Name) > 0 {
+		if err := CheckValidExecutionID(request.Name, shared.Name); err != nil {
+			return err
+		}
+	}
+	if request.LaunchPlan == nil {
+		return shared.GetMissingArgumentError(shared.LaunchPlan)
+	}
+	if err := ValidateIdentifier(request.LaunchPlan, common.LaunchPlan); err != nil {
+		return err
+	}
+	if request.LaunchPlan.Project != request.Project || request.LaunchPlan.Domain != request.Domain {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"launch plan [%+v] must be in the backfill's project and domain", request.LaunchPlan)
+	}
+	if request.StartTime.IsZero() {
+		return shared.GetMissingArgumentError(shared.StartTime)
+	}
+	if request.EndTime.IsZero() {
+		return shared.GetMissingArgumentError(shared.EndTime)
+	}
+	if !request.EndTime.After(request.StartTime) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"%s must be after %s", shared.EndTime, shared.StartTime)
+	}
+	if request.Parallelism == 0 {
+		return shared.GetMissingArgumentError(shared.Parallelism)
+	}
+	if int(request.Parallelism) > maxParallelism {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"%s cannot exceed %d", shared.Parallelism, maxParallelism)
+	}
+	if request.Order != interfaces.BackfillOrderOldestFirst && request.Order != interfaces.BackfillOrderNewestFirst {
+		return shared.GetInvalidArgumentError(shared.Order)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func getCreateBackfillRequestForTest() interfaces.CreateBackfillRequest {
+	startTime := time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC)
+	return interfaces.CreateBackfillRequest{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "backfill",
+		LaunchPlan: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      "project",
+			Domain:       "domain",
+			Name:         "name",
+			Version:      "version",
+		},
+		StartTime:   startTime,
+		EndTime:     startTime.Add(24 * time.Hour),
+		Parallelism: 2,
+		Order:       interfaces.BackfillOrderNewestFirst,
+	}
+}
+
+func TestValidateCreateBackfillRequest(t *testing.T) {
+	assert.NoError(t, ValidateCreateBackfillRequest(getCreateBackfillRequestForTest(), 10))
+
+	request := getCreateBackfillRequestForTest()
+	request.Name = ""
+	assert.NoError(t, ValidateCreateBackfillRequest(request, 10))
+}
+
+func TestValidateCreateBackfillRequest_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		update func(request *interfaces.CreateBackfillRequest)
+		err    string
+	}{
+		{
+			name:   "missing project",
+			update: func(request *interfaces.CreateBackfillRequest) { request.Project = "" },
+			err:    "missing project",
+		},
+		{
+			name:   "missing domain",
+			update: func(request *interfaces.CreateBackfillRequest) { request.Domain = "" },
+			err:    "missing domain",
+		},
+		{
+			name:   "invalid name",
+			update: func(request *interfaces.CreateBackfillRequest) { request.Name = "Backfill" },
+			err:    "invalid name format: Backfill",
+		},
+		{
+			name:   "missing launch plan",
+			update: func(request *interfaces.CreateBackfillRequest) { request.LaunchPlan = nil },
+			err:    "missing launch_plan",
+		},
+		{
+			name:   "launch plan in another domain",
+			update: func(request *interfaces.CreateBackfillRequest) { request.LaunchPlan.Domain = "other" },
+			err: "launch plan [resource_type:LAUNCH_PLAN project:\"project\" domain:\"other\" name:\"name\" " +
+				"version:\"version\" ] must be in the backfill's project and domain",
+		},
+		{
+			name:   "missing start time",
+			update: func(request *interfaces.CreateBackfillRequest) { request.StartTime = time.Time{} },
+			err:    "missing start_time",
+		},
+		{
+			name:   "end time before start time",
+			update: func(request *interfaces.CreateBackfillRequest) { request.EndTime = request.StartTime },
+			err:    "end_time must be after start_time",
+		},
+		{
+			name:   "missing parallelism",
+			update: func(request *interfaces.CreateBackfillRequest) { request.Parallelism = 0 },
+			err:    "missing parallelism",
+		},
+		{
+			name:   "parallelism over the limit",
+			update: func(request *interfaces.CreateBackfillRequest) { request.Parallelism = 11 },
+			err:    "parallelism cannot exceed 10",
+		},
+		{
+			name:   "invalid order",
+			update: func(request *interfaces.CreateBackfillRequest) { request.Order = 2 },
+			err:    "invalid value for order",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			request := getCreateBackfillRequestForTest()
+			test.update(&request)
+			assert.EqualError(t, ValidateCreateBackfillRequest(request, 10), test.err)
+		})
+	}
+}
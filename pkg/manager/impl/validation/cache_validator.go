@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+)
+
+const inputsHash = "inputs_hash"
+const cacheEvictionReason = "reason"
+
+const maxCacheEvictionReasonLength = 1024
+
+// Each query is a datacatalog lookup, so requests are kept small.
+const maxCachedOutputsQueries = 100
+
+func ValidateCheckCachedOutputsRequest(request interfaces.CheckCachedOutputsRequest) error {
+	if len(request.Queries) == 0 {
+		return shared.GetMissingArgumentError("queries")
+	}
+	if len(request.Queries) > maxCachedOutputsQueries {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "cannot check more than %d cached outputs at once",
+			maxCachedOutputsQueries)
+	}
+	for _, query := range request.Queries {
+		if err := ValidateIdentifier(query.TaskId, common.Task); err != nil {
+			return err
+		}
+		if err := ValidateEmptyStringField(query.InputsHash, inputsHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ValidateCacheEvictRequest(request interfaces.CacheEvictRequest) error {
+	scopes := 0
+	if request.TaskExecutionId != nil {
+		scopes++
+		if err := ValidateTaskExecutionIdentifier(request.TaskExecutionId); err != nil {
+			return err
+		}
+	}
+	if request.ExecutionId != nil {
+		scopes++
+		if err := ValidateWorkflowExecutionIdentifier(request.ExecutionId); err != nil {
+			return err
+		}
+	}
+	if request.TaskId != nil {
+		scopes++
+		if err := ValidateIdentifier(request.TaskId, common.Task); err != nil {
+			return err
+		}
+	}
+	if scopes != 1 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"exactly one of task_execution_id, execution_id or task_id must be set")
+	}
+	return ValidateMaxLengthStringField(request.Reason, cacheEvictionReason, maxCacheEvictionReasonLength)
+}
+
+func ValidateCacheEvictionListRequest(request interfaces.CacheEvictionListRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	return ValidateLimit(request.Limit)
+}
@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+var cachedTaskID = &core.Identifier{
+	ResourceType: core.ResourceType_TASK,
+	Project:      "project",
+	Domain:       "domain",
+	Name:         "name",
+	Version:      "version",
+}
+
+func TestValidateCheckCachedOutputsRequest(t *testing.T) {
+	assert.NoError(t, ValidateCheckCachedOutputsRequest(interfaces.CheckCachedOutputsRequest{
+		Queries: []interfaces.CachedOutputsQuery{
+			{
+				TaskId:     cachedTaskID,
+				InputsHash: "GQid5LjHbakcW68DS3P2jp80QLbiF0olFHF2hTh5bg8",
+			},
+		},
+	}))
+	assert.EqualError(t, ValidateCheckCachedOutputsRequest(interfaces.CheckCachedOutputsRequest{}),
+		"missing queries")
+	assert.EqualError(t, ValidateCheckCachedOutputsRequest(interfaces.CheckCachedOutputsRequest{
+		Queries: make([]interfaces.CachedOutputsQuery, maxCachedOutputsQueries+1),
+	}), "cannot check more than 100 cached outputs at once")
+	assert.EqualError(t, ValidateCheckCachedOutputsRequest(interfaces.CheckCachedOutputsRequest{
+		Queries: []interfaces.CachedOutputsQuery{
+			{
+				TaskId: cachedTaskID,
+			},
+		},
+	}), "missing inputs_hash")
+}
+
+func TestValidateCacheEvictRequest(t *testing.T) {
+	assert.NoError(t, ValidateCacheEvictRequest(interfaces.CacheEvictRequest{
+		TaskId: cachedTaskID,
+		Reason: "the upstream data was corrupt",
+	}))
+	assert.NoError(t, ValidateCacheEvictRequest(interfaces.CacheEvictRequest{
+		ExecutionId: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}))
+	assert.EqualError(t, ValidateCacheEvictRequest(interfaces.CacheEvictRequest{}),
+		"exactly one of task_execution_id, execution_id or task_id must be set")
+	assert.EqualError(t, ValidateCacheEvictRequest(interfaces.CacheEvictRequest{
+		TaskId: cachedTaskID,
+		ExecutionId: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}), "exactly one of task_execution_id, execution_id or task_id must be set")
+	assert.EqualError(t, ValidateCacheEvictRequest(interfaces.CacheEvictRequest{
+		TaskId: cachedTaskID,
+		Reason: strings.Repeat("a", maxCacheEvictionReasonLength+1),
+	}), "reason cannot exceed 1024 characters")
+}
+
+func TestValidateCacheEvictionListRequest(t *testing.T) {
+	assert.NoError(t, ValidateCacheEvictionListRequest(interfaces.CacheEvictionListRequest{
+		Project: "project",
+		Domain:  "domain",
+		Limit:   10,
+	}))
+	assert.EqualError(t, ValidateCacheEvictionListRequest(interfaces.CacheEvictionListRequest{
+		Project: "project",
+		Limit:   10,
+	}), "missing domain")
+}
@@ -0,0 +1,61 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Validates a request to set a cluster pool, whose members must be distinct clusters among those configured.
+func ValidateSetClusterPoolRequest(request interfaces.SetClusterPoolRequest, clusters sets.String) error {
+	if err := ValidateEmptyStringField(request.Name, shared.Name); err != nil {
+		return err
+	}
+	for key := range request.Labels {
+		if len(key) == 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must have a key", shared.Labels)
+		}
+	}
+	if len(request.Members) == 0 {
+		return shared.GetMissingArgumentError(shared.Members)
+	}
+	members := sets.NewString()
+	for _, member := range request.Members {
+		if err := ValidateEmptyStringField(member.Cluster, shared.Cluster); err != nil {
+			return err
+		}
+		if !clusters.Has(member.Cluster) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "cluster [%s] isn't configured", member.Cluster)
+		}
+		if members.Has(member.Cluster) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "cluster [%s] is in %s more than once",
+				member.Cluster, shared.Members)
+		}
+		members.Insert(member.Cluster)
+		if member.Weight <= 0 {
+			return shared.GetInvalidArgumentError(shared.Weight)
+		}
+	}
+	return nil
+}
+
+func ValidateSetClusterHealthRequest(request interfaces.SetClusterHealthRequest, clusters sets.String) error {
+	if err := ValidateEmptyStringField(request.Cluster, shared.Cluster); err != nil {
+		return err
+	}
+	if !clusters.Has(request.Cluster) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "cluster [%s] isn't configured", request.Cluster)
+	}
+	switch request.State {
+	case models.ClusterHealthy, models.ClusterUnhealthy, models.ClusterDraining:
+		return nil
+	case "":
+		return shared.GetMissingArgumentError(shared.State)
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must be one of %s, %s or %s", shared.State,
+			models.ClusterHealthy, models.ClusterUnhealthy, models.ClusterDraining)
+	}
+}
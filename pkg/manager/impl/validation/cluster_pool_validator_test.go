@@ -0,0 +1,87 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var configuredClusters = sets.NewString("us-east-1a", "us-east-1b")
+
+func getSetClusterPoolRequestForTest() interfaces.SetClusterPoolRequest {
+	return interfaces.SetClusterPoolRequest{
+		Name:   "us-east",
+		Labels: map[string]string{"region": "us-east-1"},
+		Members: []interfaces.ClusterPoolMember{
+			{Cluster: "us-east-1a", Weight: 2},
+			{Cluster: "us-east-1b", Weight: 1},
+		},
+	}
+}
+
+func TestValidateSetClusterPoolRequest(t *testing.T) {
+	assert.NoError(t, ValidateSetClusterPoolRequest(getSetClusterPoolRequestForTest(), configuredClusters))
+}
+
+func TestValidateSetClusterPoolRequest_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		update func(request *interfaces.SetClusterPoolRequest)
+		err    string
+	}{
+		{
+			name:   "missing name",
+			update: func(request *interfaces.SetClusterPoolRequest) { request.Name = "" },
+			err:    "missing name",
+		},
+		{
+			name:   "label without a key",
+			update: func(request *interfaces.SetClusterPoolRequest) { request.Labels[""] = "us-east-1" },
+			err:    "labels must have a key",
+		},
+		{
+			name:   "missing members",
+			update: func(request *interfaces.SetClusterPoolRequest) { request.Members = nil },
+			err:    "missing members",
+		},
+		{
+			name:   "unconfigured cluster",
+			update: func(request *interfaces.SetClusterPoolRequest) { request.Members[1].Cluster = "eu-west-1a" },
+			err:    "cluster [eu-west-1a] isn't configured",
+		},
+		{
+			name:   "duplicate cluster",
+			update: func(request *interfaces.SetClusterPoolRequest) { request.Members[1].Cluster = "us-east-1a" },
+			err:    "cluster [us-east-1a] is in members more than once",
+		},
+		{
+			name:   "zero weight",
+			update: func(request *interfaces.SetClusterPoolRequest) { request.Members[0].Weight = 0 },
+			err:    "invalid value for weight",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			request := getSetClusterPoolRequestForTest()
+			test.update(&request)
+			assert.EqualError(t, ValidateSetClusterPoolRequest(request, configuredClusters), test.err)
+		})
+	}
+}
+
+func TestValidateSetClusterHealthRequest(t *testing.T) {
+	assert.NoError(t, ValidateSetClusterHealthRequest(interfaces.SetClusterHealthRequest{
+		Cluster: "us-east-1a",
+		State:   "DRAINING",
+		Reason:  "node pool upgrade",
+	}, configuredClusters))
+	assert.EqualError(t, ValidateSetClusterHealthRequest(interfaces.SetClusterHealthRequest{
+		Cluster: "eu-west-1a",
+		State:   "DRAINING",
+	}, configuredClusters), "cluster [eu-west-1a] isn't configured")
+	assert.EqualError(t, ValidateSetClusterHealthRequest(interfaces.SetClusterHealthRequest{
+		Cluster: "us-east-1a",
+		State:   "DEGRADED",
+	}, configuredClusters), "state must be one of HEALTHY, UNHEALTHY or DRAINING")
+}
@@ -0,0 +1,30 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const domainID = "domain_id"
+const domainName = "domain_name"
+const domainDescription = "domain_description"
+
+// Validates a domain to create or update. Domain ids are interpolated into the namespaces of projects, so like project
+// ids they must be DNS-1123 labels.
+func ValidateDomain(domain interfaces.Domain) error {
+	if err := ValidateEmptyStringField(domain.ID, domainID); err != nil {
+		return err
+	}
+	if errs := validation.IsDNS1123Label(domain.ID); len(errs) > 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid domain id [%s]: %v", domain.ID, errs)
+	}
+	if err := ValidateEmptyStringField(domain.Name, domainName); err != nil {
+		return err
+	}
+	if err := ValidateMaxLengthStringField(domain.Name, domainName, maxNameLength); err != nil {
+		return err
+	}
+	return ValidateMaxLengthStringField(domain.Description, domainDescription, maxDescriptionLength)
+}
@@ -0,0 +1,52 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDomain(t *testing.T) {
+	assert.NoError(t, ValidateDomain(interfaces.Domain{
+		ID:          "staging",
+		Name:        "Staging",
+		Description: "pre-production",
+	}))
+}
+
+func TestValidateDomain_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		domain interfaces.Domain
+		err    string
+	}{
+		{
+			name:   "missing id",
+			domain: interfaces.Domain{Name: "Staging"},
+			err:    "missing domain_id",
+		},
+		{
+			name:   "invalid id",
+			domain: interfaces.Domain{ID: "Staging_1", Name: "Staging"},
+			err: "invalid domain id [Staging_1]: [a lowercase RFC 1123 label must consist of lower case alphanumeric characters " +
+				"or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex " +
+				"used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')]",
+		},
+		{
+			name:   "missing name",
+			domain: interfaces.Domain{ID: "staging"},
+			err:    "missing domain_name",
+		},
+		{
+			name:   "long description",
+			domain: interfaces.Domain{ID: "staging", Name: "Staging", Description: strings.Repeat("a", 301)},
+			err:    "domain_description cannot exceed 300 characters",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.EqualError(t, ValidateDomain(test.domain), test.err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package validation
+
+import (
+	"net/url"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+)
+
+const commentText = "text"
+const commentLinks = "links"
+
+const maxCommentTextLength = 4096
+const maxCommentLinks = 10
+
+func ValidateExecutionCommentAddRequest(request interfaces.ExecutionCommentAddRequest) error {
+	if err := ValidateWorkflowExecutionIdentifier(request.ExecutionID); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Text, commentText); err != nil {
+		return err
+	}
+	if err := ValidateMaxLengthStringField(request.Text, commentText, maxCommentTextLength); err != nil {
+		return err
+	}
+	if len(request.Links) > maxCommentLinks {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "a comment can have at most %d %s",
+			maxCommentLinks, commentLinks)
+	}
+	for _, link := range request.Links {
+		linkURL, err := url.Parse(link)
+		if err != nil || (linkURL.Scheme != "http" && linkURL.Scheme != "https") || len(linkURL.Host) == 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must be http or https URLs, not [%s]",
+				commentLinks, link)
+		}
+	}
+	return nil
+}
+
+func ValidateExecutionCommentListRequest(request interfaces.ExecutionCommentListRequest) error {
+	if err := ValidateWorkflowExecutionIdentifier(request.ExecutionID); err != nil {
+		return err
+	}
+	return ValidateLimit(request.Limit)
+}
@@ -0,0 +1,51 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+var commentExecutionID = &core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func TestValidateExecutionCommentAddRequest(t *testing.T) {
+	assert.NoError(t, ValidateExecutionCommentAddRequest(interfaces.ExecutionCommentAddRequest{
+		ExecutionID: commentExecutionID,
+		Text:        "re-ran manually, upstream data was late",
+		Links:       []string{"https://status.example.com/incidents/1"},
+	}))
+	assert.EqualError(t, ValidateExecutionCommentAddRequest(interfaces.ExecutionCommentAddRequest{
+		ExecutionID: commentExecutionID,
+	}), "missing text")
+	assert.EqualError(t, ValidateExecutionCommentAddRequest(interfaces.ExecutionCommentAddRequest{
+		ExecutionID: commentExecutionID,
+		Text:        strings.Repeat("a", maxCommentTextLength+1),
+	}), "text cannot exceed 4096 characters")
+	assert.EqualError(t, ValidateExecutionCommentAddRequest(interfaces.ExecutionCommentAddRequest{
+		ExecutionID: commentExecutionID,
+		Text:        "re-ran manually",
+		Links:       []string{"ftp://example.com/logs"},
+	}), "links must be http or https URLs, not [ftp://example.com/logs]")
+	assert.EqualError(t, ValidateExecutionCommentAddRequest(interfaces.ExecutionCommentAddRequest{
+		ExecutionID: commentExecutionID,
+		Text:        "re-ran manually",
+		Links:       make([]string, maxCommentLinks+1),
+	}), "a comment can have at most 10 links")
+}
+
+func TestValidateExecutionCommentListRequest(t *testing.T) {
+	assert.NoError(t, ValidateExecutionCommentListRequest(interfaces.ExecutionCommentListRequest{
+		ExecutionID: commentExecutionID,
+		Limit:       10,
+	}))
+	assert.Error(t, ValidateExecutionCommentListRequest(interfaces.ExecutionCommentListRequest{
+		ExecutionID: commentExecutionID,
+	}))
+}
@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	defaultServiceAccount  = "default_service_account"
+	defaultIamRole         = "default_iam_role"
+	allowedServiceAccounts = "allowed_service_accounts"
+	allowedIamRoles        = "allowed_iam_roles"
+)
+
+func validateServiceAccount(field, serviceAccount string) error {
+	if errs := validation.IsDNS1123Subdomain(serviceAccount); len(errs) > 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s [%s]: %v", field, serviceAccount, errs)
+	}
+	return nil
+}
+
+func ValidateExecutionIdentityAttributes(attributes interfaces.ExecutionIdentityAttributes) error {
+	if len(attributes.DefaultServiceAccount) > 0 {
+		if err := validateServiceAccount(defaultServiceAccount, attributes.DefaultServiceAccount); err != nil {
+			return err
+		}
+	}
+	for _, serviceAccount := range attributes.AllowedServiceAccounts {
+		if err := validateServiceAccount(allowedServiceAccounts, serviceAccount); err != nil {
+			return err
+		}
+	}
+	for _, iamRole := range attributes.AllowedIamRoles {
+		if err := ValidateEmptyStringField(iamRole, allowedIamRoles); err != nil {
+			return err
+		}
+	}
+	// A default which isn't allowed would reject every execution which relies on it.
+	if len(attributes.DefaultServiceAccount) > 0 && len(attributes.AllowedServiceAccounts) > 0 &&
+		!sets.NewString(attributes.AllowedServiceAccounts...).Has(attributes.DefaultServiceAccount) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s [%s] must be one of the %s",
+			defaultServiceAccount, attributes.DefaultServiceAccount, allowedServiceAccounts)
+	}
+	if len(attributes.DefaultIamRole) > 0 && len(attributes.AllowedIamRoles) > 0 &&
+		!sets.NewString(attributes.AllowedIamRoles...).Has(attributes.DefaultIamRole) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s [%s] must be one of the %s",
+			defaultIamRole, attributes.DefaultIamRole, allowedIamRoles)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateExecutionIdentityAttributes(t *testing.T) {
+	assert.NoError(t, ValidateExecutionIdentityAttributes(interfaces.ExecutionIdentityAttributes{
+		DefaultServiceAccount:  "etl-runner",
+		DefaultIamRole:         "arn:aws:iam::123456789012:role/etl",
+		AllowedServiceAccounts: []string{"etl-runner", "etl-backfill"},
+		AllowedIamRoles:        []string{"arn:aws:iam::123456789012:role/etl"},
+	}))
+	assert.NoError(t, ValidateExecutionIdentityAttributes(interfaces.ExecutionIdentityAttributes{
+		AllowedIamRoles: []string{"etl@my-project.iam.gserviceaccount.com"},
+	}))
+	assert.EqualError(t, ValidateExecutionIdentityAttributes(interfaces.ExecutionIdentityAttributes{
+		AllowedServiceAccounts: []string{"etl-runner", "ETL Runner"},
+	}), "invalid allowed_service_accounts [ETL Runner]: [a lowercase RFC 1123 subdomain must consist of lower case "+
+		"alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. "+
+		"'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')]")
+	assert.EqualError(t, ValidateExecutionIdentityAttributes(interfaces.ExecutionIdentityAttributes{
+		AllowedIamRoles: []string{""},
+	}), "missing allowed_iam_roles")
+	assert.EqualError(t, ValidateExecutionIdentityAttributes(interfaces.ExecutionIdentityAttributes{
+		DefaultServiceAccount:  "admin",
+		AllowedServiceAccounts: []string{"etl-runner"},
+	}), "default_service_account [admin] must be one of the allowed_service_accounts")
+	assert.EqualError(t, ValidateExecutionIdentityAttributes(interfaces.ExecutionIdentityAttributes{
+		DefaultIamRole:  "admin",
+		AllowedIamRoles: []string{"etl"},
+	}), "default_iam_role [admin] must be one of the allowed_iam_roles")
+}
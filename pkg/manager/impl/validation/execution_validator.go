@@ -7,8 +7,10 @@ import (
 
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 
+	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -18,6 +20,15 @@ import (
 
 const allowedExecutionNameLength = 20
 
+const maxTerminateExecutionsBatchSize = 1000
+
+var executionCountGroupByDimensions = map[string]bool{
+	interfaces.ExecutionCountGroupByProject: true,
+	interfaces.ExecutionCountGroupByDomain:  true,
+	interfaces.ExecutionCountGroupByPhase:   true,
+	interfaces.ExecutionCountGroupByDay:     true,
+}
+
 var executionIDRegex = regexp.MustCompile(`^[a-z][a-z\-0-9]*$`)
 
 var acceptedReferenceLaunchTypes = map[core.ResourceType]interface{}{
@@ -160,3 +171,57 @@ func ValidateWorkflowExecutionIdentifier(identifier *core.WorkflowExecutionIdent
 	}
 	return nil
 }
+
+func ValidateExecutionCountRequest(request interfaces.ExecutionCountRequest) error {
+	if len(request.Domain) > 0 && len(request.Project) == 0 {
+		return shared.GetMissingArgumentError(shared.Project)
+	}
+	seen := make(map[string]bool, len(request.GroupBy))
+	for _, dimension := range request.GroupBy {
+		if !executionCountGroupByDimensions[dimension] {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "executions can't be counted by [%s]", dimension)
+		}
+		if seen[dimension] {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "executions are already counted by [%s]",
+				dimension)
+		}
+		seen[dimension] = true
+	}
+	if request.Limit < 0 {
+		return shared.GetInvalidArgumentError(shared.Limit)
+	}
+	return nil
+}
+
+func ValidateExecutionLabelListRequest(request interfaces.ExecutionLabelListRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	if request.Limit < 0 {
+		return shared.GetInvalidArgumentError(shared.Limit)
+	}
+	return nil
+}
+
+func ValidateTerminateExecutionsRequest(request interfaces.TerminateExecutionsRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	for _, phase := range request.Phases {
+		if common.IsExecutionTerminal(phase) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"executions which are %s can't be terminated", phase.String())
+		}
+	}
+	if request.BatchSize > maxTerminateExecutionsBatchSize {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"%s cannot exceed %d", shared.BatchSize, maxTerminateExecutionsBatchSize)
+	}
+	return nil
+}
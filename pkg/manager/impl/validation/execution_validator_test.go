@@ -10,6 +10,7 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/event"
 
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/stretchr/testify/assert"
@@ -223,3 +224,72 @@ func TestValidateWorkflowExecutionIdentifier_Error(t *testing.T) {
 		Name:   "name",
 	}))
 }
+
+func TestValidateExecutionCountRequest(t *testing.T) {
+	assert.Nil(t, ValidateExecutionCountRequest(interfaces.ExecutionCountRequest{}))
+	assert.Nil(t, ValidateExecutionCountRequest(interfaces.ExecutionCountRequest{
+		Project: "project",
+		Domain:  "domain",
+		GroupBy: []string{interfaces.ExecutionCountGroupByPhase, interfaces.ExecutionCountGroupByDay},
+		Limit:   10,
+	}))
+
+	assert.EqualError(t, ValidateExecutionCountRequest(interfaces.ExecutionCountRequest{
+		Domain: "domain",
+	}), "missing project")
+	assert.EqualError(t, ValidateExecutionCountRequest(interfaces.ExecutionCountRequest{
+		GroupBy: []string{"name"},
+	}), "executions can't be counted by [name]")
+	assert.EqualError(t, ValidateExecutionCountRequest(interfaces.ExecutionCountRequest{
+		GroupBy: []string{interfaces.ExecutionCountGroupByDay, interfaces.ExecutionCountGroupByDay},
+	}), "executions are already counted by [day]")
+	assert.EqualError(t, ValidateExecutionCountRequest(interfaces.ExecutionCountRequest{
+		Limit: -1,
+	}), "invalid value for limit")
+}
+
+func TestValidateExecutionLabelListRequest(t *testing.T) {
+	assert.Nil(t, ValidateExecutionLabelListRequest(interfaces.ExecutionLabelListRequest{
+		Project: "project",
+		Domain:  "domain",
+		Key:     "team",
+	}))
+
+	assert.EqualError(t, ValidateExecutionLabelListRequest(interfaces.ExecutionLabelListRequest{
+		Domain: "domain",
+	}), "missing project")
+	assert.EqualError(t, ValidateExecutionLabelListRequest(interfaces.ExecutionLabelListRequest{
+		Project: "project",
+	}), "missing domain")
+	assert.EqualError(t, ValidateExecutionLabelListRequest(interfaces.ExecutionLabelListRequest{
+		Project: "project",
+		Domain:  "domain",
+		Limit:   -1,
+	}), "invalid value for limit")
+}
+
+func TestValidateTerminateExecutionsRequest(t *testing.T) {
+	assert.Nil(t, ValidateTerminateExecutionsRequest(interfaces.TerminateExecutionsRequest{
+		Project:   "project",
+		Domain:    "domain",
+		Phases:    []core.WorkflowExecution_Phase{core.WorkflowExecution_QUEUED, core.WorkflowExecution_RUNNING},
+		BatchSize: maxTerminateExecutionsBatchSize,
+	}))
+
+	assert.EqualError(t, ValidateTerminateExecutionsRequest(interfaces.TerminateExecutionsRequest{
+		Domain: "domain",
+	}), "missing project")
+	assert.EqualError(t, ValidateTerminateExecutionsRequest(interfaces.TerminateExecutionsRequest{
+		Project: "project",
+	}), "missing domain")
+	assert.EqualError(t, ValidateTerminateExecutionsRequest(interfaces.TerminateExecutionsRequest{
+		Project: "project",
+		Domain:  "domain",
+		Phases:  []core.WorkflowExecution_Phase{core.WorkflowExecution_RUNNING, core.WorkflowExecution_SUCCEEDED},
+	}), "executions which are SUCCEEDED can't be terminated")
+	assert.EqualError(t, ValidateTerminateExecutionsRequest(interfaces.TerminateExecutionsRequest{
+		Project:   "project",
+		Domain:    "domain",
+		BatchSize: maxTerminateExecutionsBatchSize + 1,
+	}), "batch_size cannot exceed 1000")
+}
@@ -0,0 +1,44 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const featureFlagName = "name"
+const featureFlagReason = "reason"
+
+const maxFeatureFlagReasonLength = 1024
+
+// Only known flags can be overridden, so that a misspelled flag isn't silently set.
+func validateFeatureFlagName(name string, knownFlags sets.String) error {
+	if err := ValidateEmptyStringField(name, featureFlagName); err != nil {
+		return err
+	}
+	if !knownFlags.Has(name) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "unknown feature flag [%s], must be one of %v",
+			name, knownFlags.List())
+	}
+	return nil
+}
+
+func ValidateSetFeatureFlagRequest(request interfaces.SetFeatureFlagRequest, knownFlags sets.String) error {
+	if err := validateFeatureFlagName(request.Name, knownFlags); err != nil {
+		return err
+	}
+	return ValidateMaxLengthStringField(request.Reason, featureFlagReason, maxFeatureFlagReasonLength)
+}
+
+// Overrides of flags which are no longer known can still be cleared.
+func ValidateClearFeatureFlagRequest(request interfaces.ClearFeatureFlagRequest) error {
+	if err := ValidateEmptyStringField(request.Name, featureFlagName); err != nil {
+		return err
+	}
+	return ValidateMaxLengthStringField(request.Reason, featureFlagReason, maxFeatureFlagReasonLength)
+}
+
+func ValidateFeatureFlagChangeListRequest(request interfaces.FeatureFlagChangeListRequest) error {
+	return ValidateLimit(request.Limit)
+}
@@ -0,0 +1,41 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var knownFeatureFlags = sets.NewString(interfaces.FeatureFlagAdmissionControl, "strict_validation")
+
+func TestValidateSetFeatureFlagRequest(t *testing.T) {
+	assert.NoError(t, ValidateSetFeatureFlagRequest(interfaces.SetFeatureFlagRequest{
+		Name:    interfaces.FeatureFlagAdmissionControl,
+		Project: "pilot",
+		Enabled: true,
+		Reason:  "piloting admission control",
+	}, knownFeatureFlags))
+}
+
+func TestValidateSetFeatureFlagRequest_Invalid(t *testing.T) {
+	err := ValidateSetFeatureFlagRequest(interfaces.SetFeatureFlagRequest{}, knownFeatureFlags)
+	assert.EqualError(t, err, "missing name")
+
+	err = ValidateSetFeatureFlagRequest(interfaces.SetFeatureFlagRequest{Name: "admision_control"}, knownFeatureFlags)
+	assert.EqualError(t, err,
+		"unknown feature flag [admision_control], must be one of [admission_control strict_validation]")
+
+	err = ValidateSetFeatureFlagRequest(interfaces.SetFeatureFlagRequest{
+		Name:   "strict_validation",
+		Reason: strings.Repeat("a", maxFeatureFlagReasonLength+1),
+	}, knownFeatureFlags)
+	assert.EqualError(t, err, "reason cannot exceed 1024 characters")
+}
+
+func TestValidateClearFeatureFlagRequest(t *testing.T) {
+	assert.NoError(t, ValidateClearFeatureFlagRequest(interfaces.ClearFeatureFlagRequest{Name: "retired_flag"}))
+	assert.EqualError(t, ValidateClearFeatureFlagRequest(interfaces.ClearFeatureFlagRequest{}), "missing name")
+}
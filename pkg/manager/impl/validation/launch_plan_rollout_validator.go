@@ -0,0 +1,44 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"google.golang.org/grpc/codes"
+)
+
+func ValidateSetRolloutPolicyRequest(request interfaces.SetRolloutPolicyRequest) error {
+	if err := ValidateNamedEntityIdentifier(&request.Id); err != nil {
+		return err
+	}
+	switch request.Strategy {
+	case models.RolloutStrategyLabel:
+		if err := ValidateEmptyStringField(request.Label, shared.Label); err != nil {
+			return err
+		}
+		if strings.HasPrefix(request.Label, "=") {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"%s must be a key or a key=value pair", shared.Label)
+		}
+	case models.RolloutStrategySemver:
+		if err := ValidateEmptyStringField(request.VersionConstraint, shared.VersionConstraint); err != nil {
+			return err
+		}
+		if _, err := common.ParseVersionConstraint(request.VersionConstraint); err != nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%v", err)
+		}
+	case "":
+		return shared.GetMissingArgumentError(shared.Strategy)
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must be one of %s or %s",
+			shared.Strategy, models.RolloutStrategyLabel, models.RolloutStrategySemver)
+	}
+	if request.SoakPeriod < 0 {
+		return shared.GetInvalidArgumentError(shared.SoakPeriod)
+	}
+	return nil
+}
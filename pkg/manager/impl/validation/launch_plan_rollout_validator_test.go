@@ -0,0 +1,91 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+func getSetRolloutPolicyRequestForTest() interfaces.SetRolloutPolicyRequest {
+	return interfaces.SetRolloutPolicyRequest{
+		Id: admin.NamedEntityIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "daily",
+		},
+		Strategy:          "SEMVER",
+		VersionConstraint: "^1.4",
+		SoakPeriod:        time.Hour,
+	}
+}
+
+func TestValidateSetRolloutPolicyRequest(t *testing.T) {
+	assert.NoError(t, ValidateSetRolloutPolicyRequest(getSetRolloutPolicyRequestForTest()))
+
+	request := getSetRolloutPolicyRequestForTest()
+	request.Strategy = "LABEL"
+	request.Label = "release=stable"
+	request.VersionConstraint = ""
+	assert.NoError(t, ValidateSetRolloutPolicyRequest(request))
+}
+
+func TestValidateSetRolloutPolicyRequest_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		update func(request *interfaces.SetRolloutPolicyRequest)
+		err    string
+	}{
+		{
+			name:   "missing name",
+			update: func(request *interfaces.SetRolloutPolicyRequest) { request.Id.Name = "" },
+			err:    "missing name",
+		},
+		{
+			name:   "missing strategy",
+			update: func(request *interfaces.SetRolloutPolicyRequest) { request.Strategy = "" },
+			err:    "missing strategy",
+		},
+		{
+			name:   "unknown strategy",
+			update: func(request *interfaces.SetRolloutPolicyRequest) { request.Strategy = "LATEST" },
+			err:    "strategy must be one of LABEL or SEMVER",
+		},
+		{
+			name:   "missing label",
+			update: func(request *interfaces.SetRolloutPolicyRequest) { request.Strategy = "LABEL" },
+			err:    "missing label",
+		},
+		{
+			name: "label without a key",
+			update: func(request *interfaces.SetRolloutPolicyRequest) {
+				request.Strategy = "LABEL"
+				request.Label = "=stable"
+			},
+			err: "label must be a key or a key=value pair",
+		},
+		{
+			name:   "missing version constraint",
+			update: func(request *interfaces.SetRolloutPolicyRequest) { request.VersionConstraint = "" },
+			err:    "missing version_constraint",
+		},
+		{
+			name:   "invalid version constraint",
+			update: func(request *interfaces.SetRolloutPolicyRequest) { request.VersionConstraint = ">= latest" },
+			err:    "invalid version constraint [>= latest]: invalid semantic version [latest]",
+		},
+		{
+			name:   "negative soak period",
+			update: func(request *interfaces.SetRolloutPolicyRequest) { request.SoakPeriod = -time.Minute },
+			err:    "invalid value for soak_period",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			request := getSetRolloutPolicyRequestForTest()
+			test.update(&request)
+			assert.EqualError(t, ValidateSetRolloutPolicyRequest(request), test.err)
+		})
+	}
+}
@@ -2,12 +2,14 @@ package validation
 
 import (
 	"context"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	scheduleCore "github.com/flyteorg/flyteadmin/scheduler/core"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytepropeller/pkg/compiler/validators"
@@ -59,6 +61,16 @@ func ValidateLaunchPlan(ctx context.Context,
 
 func validateSchedule(request admin.LaunchPlanCreateRequest, expectedInputs *core.ParameterMap) error {
 	schedule := request.GetSpec().GetEntityMetadata().GetSchedule()
+	if schedule.GetCronSchedule() != nil {
+		if _, err := scheduleCore.ParseCronSchedule(schedule.GetCronSchedule().GetSchedule()); err != nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "Invalid cron schedule: %v", err)
+		}
+	}
+	// Only the native scheduler evaluates schedules in a timezone, and it doesn't use the deprecated cron expression.
+	if timezone, _ := scheduleCore.GetCronTimezone(schedule.GetCronExpression()); timezone != time.UTC.String() {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"Cron expression [%v] cannot have a timezone, use a cron schedule instead", schedule.GetCronExpression())
+	}
 	if schedule.GetCronExpression() != "" || schedule.GetRate() != nil {
 		for key, value := range expectedInputs.Parameters {
 			if value.GetRequired() && key != schedule.GetKickoffTimeInputArg() {
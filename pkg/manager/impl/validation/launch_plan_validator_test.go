@@ -382,3 +382,30 @@ func TestValidateSchedule_KickoffTimeBound(t *testing.T) {
 	err := validateSchedule(request, inputMap)
 	assert.Nil(t, err)
 }
+
+func TestValidateSchedule_CronScheduleTimezone(t *testing.T) {
+	request := testutils.GetLaunchPlanRequest()
+	request.Spec.EntityMetadata = &admin.LaunchPlanMetadata{
+		Schedule: &admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{
+					Schedule: "CRON_TZ=America/New_York 0 3 * * *",
+				},
+			},
+		},
+	}
+	inputMap := &core.ParameterMap{
+		Parameters: map[string]*core.Parameter{},
+	}
+	assert.Nil(t, validateSchedule(request, inputMap))
+
+	request.Spec.EntityMetadata.Schedule.GetCronSchedule().Schedule = "CRON_TZ=America/Gotham 0 3 * * *"
+	err := validateSchedule(request, inputMap)
+	assert.EqualError(t, err, "Invalid cron schedule: invalid timezone [America/Gotham] in cron schedule "+
+		"[CRON_TZ=America/Gotham 0 3 * * *]")
+
+	request = testutils.GetLaunchPlanRequestWithCronSchedule("CRON_TZ=America/New_York 0 3 * * *")
+	err = validateSchedule(request, inputMap)
+	assert.EqualError(t, err, "Cron expression [CRON_TZ=America/New_York 0 3 * * *] cannot have a timezone, "+
+		"use a cron schedule instead")
+}
@@ -1,8 +1,13 @@
 package validation
 
 import (
+	"net/mail"
+	"net/url"
+	"regexp"
+
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"google.golang.org/grpc/codes"
@@ -29,11 +34,21 @@ func ValidateNamedEntityUpdateRequest(request admin.NamedEntityUpdateRequest) er
 		return shared.GetMissingArgumentError(shared.Metadata)
 	}
 
-	// Anything but the default state is only permitted for workflow resources.
-	if request.Metadata.State != admin.NamedEntityState_NAMED_ENTITY_ACTIVE &&
-		request.ResourceType != core.ResourceType_WORKFLOW {
-		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
-			"Only workflow name entities can have their state updated")
+	switch request.Metadata.State {
+	case admin.NamedEntityState_NAMED_ENTITY_ACTIVE:
+	case interfaces.NamedEntityStateDeprecated:
+		if request.ResourceType != core.ResourceType_WORKFLOW && request.ResourceType != core.ResourceType_TASK {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"Only workflow and task name entities can be deprecated")
+		}
+	case admin.NamedEntityState_NAMED_ENTITY_ARCHIVED, admin.NamedEntityState_SYSTEM_GENERATED:
+		// Anything but the default and deprecated states is only permitted for workflow resources.
+		if request.ResourceType != core.ResourceType_WORKFLOW {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"Only workflow name entities can have their state updated")
+		}
+	default:
+		return shared.GetInvalidArgumentError(shared.State)
 	}
 	return nil
 }
@@ -53,3 +68,163 @@ func ValidateNamedEntityListRequest(request admin.NamedEntityListRequest) error
 	}
 	return nil
 }
+
+// The maximum length of a named entity search query, which matches the length of the names searched.
+const maxSearchQueryLength = 255
+
+func ValidateNamedEntitySearchRequest(request interfaces.NamedEntitySearchRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Query, shared.Query); err != nil {
+		return err
+	}
+	if err := ValidateMaxLengthStringField(request.Query, shared.Query, maxSearchQueryLength); err != nil {
+		return err
+	}
+	for _, resourceType := range request.ResourceTypes {
+		if resourceType != core.ResourceType_WORKFLOW && resourceType != core.ResourceType_TASK &&
+			resourceType != core.ResourceType_LAUNCH_PLAN {
+			return shared.GetInvalidArgumentError(shared.ResourceType)
+		}
+	}
+	if err := ValidateLimit(request.Limit); err != nil {
+		return err
+	}
+	return nil
+}
+
+const longDescription = "long_description"
+const sourceLink = "source_link"
+const owners = "owners"
+
+// Description documents are limited in size since they're stored in the database and returned whole.
+const maxLongDescriptionLength = 64 * 1024
+const maxSourceLinkLength = 255
+const maxOwnersLength = 16
+
+// Validates that descriptions are only documented for the workflow, task and launch plan names of a project and
+// domain.
+func validateNamedEntityDescriptionTarget(resourceType core.ResourceType, id *admin.NamedEntityIdentifier) error {
+	if resourceType != core.ResourceType_WORKFLOW && resourceType != core.ResourceType_TASK &&
+		resourceType != core.ResourceType_LAUNCH_PLAN {
+		return shared.GetInvalidArgumentError(shared.ResourceType)
+	}
+	return ValidateNamedEntityIdentifier(id)
+}
+
+func ValidateNamedEntityDescriptionGetRequest(request interfaces.NamedEntityDescriptionGetRequest) error {
+	return validateNamedEntityDescriptionTarget(request.ResourceType, request.ID)
+}
+
+func ValidateNamedEntityDescriptionUpdateRequest(request interfaces.NamedEntityDescriptionUpdateRequest) error {
+	if err := validateNamedEntityDescriptionTarget(request.ResourceType, request.ID); err != nil {
+		return err
+	}
+	description := request.Description
+	if err := ValidateMaxLengthStringField(
+		description.LongDescription, longDescription, maxLongDescriptionLength); err != nil {
+		return err
+	}
+	if err := ValidateMaxLengthStringField(description.SourceLink, sourceLink, maxSourceLinkLength); err != nil {
+		return err
+	}
+	if len(description.SourceLink) > 0 {
+		linkURL, err := url.Parse(description.SourceLink)
+		if err != nil || (linkURL.Scheme != "http" && linkURL.Scheme != "https") || len(linkURL.Host) == 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must be an http or https URL", sourceLink)
+		}
+	}
+	if len(description.Owners) > maxOwnersLength {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s cannot exceed %d entries", owners,
+			maxOwnersLength)
+	}
+	for _, owner := range description.Owners {
+		if err := ValidateEmptyStringField(owner.Name, "owner name"); err != nil {
+			return err
+		}
+		if err := ValidateMaxLengthStringField(owner.Name, "owner name", maxOwnerLength); err != nil {
+			return err
+		}
+		if len(owner.Email) == 0 {
+			continue
+		}
+		if address, err := mail.ParseAddress(owner.Email); err != nil || address.Address != owner.Email {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "owner [%s] has an invalid email address [%s]",
+				owner.Name, owner.Email)
+		}
+	}
+	return nil
+}
+
+const tags = "tags"
+
+// Tags are a lowercase key optionally followed by a colon and a value, such as "critical" or "team:growth".
+var tagRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9_.]*[a-z0-9])?(:[-a-zA-Z0-9_.]+)?$`)
+
+const maxTagLength = 128
+
+// The maximum number of tags a single named entity can have.
+const MaxNamedEntityTags = 32
+
+func validateTag(tag string) error {
+	if err := ValidateMaxLengthStringField(tag, "tag", maxTagLength); err != nil {
+		return err
+	}
+	if !tagRegex.MatchString(tag) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid tag [%s], tags must be a lowercase key optionally followed by a value such as team:growth", tag)
+	}
+	return nil
+}
+
+func validateTaggedResourceType(resourceType core.ResourceType) error {
+	if resourceType != core.ResourceType_WORKFLOW && resourceType != core.ResourceType_LAUNCH_PLAN {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "only workflows and launch plans can be tagged")
+	}
+	return nil
+}
+
+func ValidateNamedEntityTagRequest(request interfaces.NamedEntityTagRequest) error {
+	if err := validateTaggedResourceType(request.ResourceType); err != nil {
+		return err
+	}
+	if err := ValidateNamedEntityIdentifier(request.ID); err != nil {
+		return err
+	}
+	if len(request.Tags) == 0 {
+		return shared.GetMissingArgumentError(tags)
+	}
+	if len(request.Tags) > MaxNamedEntityTags {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s cannot exceed %d entries", tags,
+			MaxNamedEntityTags)
+	}
+	for _, tag := range request.Tags {
+		if err := validateTag(tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ValidateNamedEntityTagsGetRequest(request admin.NamedEntityGetRequest) error {
+	if err := validateTaggedResourceType(request.ResourceType); err != nil {
+		return err
+	}
+	return ValidateNamedEntityIdentifier(request.Id)
+}
+
+func ValidateNamedEntityTagListRequest(request interfaces.NamedEntityTagListRequest) error {
+	if err := validateTag(request.Tag); err != nil {
+		return err
+	}
+	for _, resourceType := range request.ResourceTypes {
+		if err := validateTaggedResourceType(resourceType); err != nil {
+			return err
+		}
+	}
+	return ValidateLimit(request.Limit)
+}
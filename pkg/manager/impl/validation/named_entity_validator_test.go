@@ -1,9 +1,11 @@
 package validation
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"google.golang.org/grpc/codes"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -132,6 +134,37 @@ func TestValidateNamedEntityUpdateRequest(t *testing.T) {
 	}))
 }
 
+func TestValidateNamedEntityUpdateRequest_Deprecated(t *testing.T) {
+	id := &admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	for _, resourceType := range []core.ResourceType{core.ResourceType_WORKFLOW, core.ResourceType_TASK} {
+		assert.Nil(t, ValidateNamedEntityUpdateRequest(admin.NamedEntityUpdateRequest{
+			ResourceType: resourceType,
+			Id:           id,
+			Metadata: &admin.NamedEntityMetadata{
+				State: interfaces.NamedEntityStateDeprecated,
+			},
+		}))
+	}
+	assert.EqualError(t, ValidateNamedEntityUpdateRequest(admin.NamedEntityUpdateRequest{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		Id:           id,
+		Metadata: &admin.NamedEntityMetadata{
+			State: interfaces.NamedEntityStateDeprecated,
+		},
+	}), "Only workflow and task name entities can be deprecated")
+	assert.EqualError(t, ValidateNamedEntityUpdateRequest(admin.NamedEntityUpdateRequest{
+		ResourceType: core.ResourceType_WORKFLOW,
+		Id:           id,
+		Metadata: &admin.NamedEntityMetadata{
+			State: admin.NamedEntityState(4),
+		},
+	}), "invalid value for state")
+}
+
 func TestValidateNamedEntityListRequest(t *testing.T) {
 	assert.Nil(t, ValidateNamedEntityListRequest(admin.NamedEntityListRequest{
 		ResourceType: core.ResourceType_WORKFLOW,
@@ -164,3 +197,180 @@ func TestValidateNamedEntityListRequest(t *testing.T) {
 		Domain:       "domain",
 	}))
 }
+
+func TestValidateNamedEntitySearchRequest(t *testing.T) {
+	assert.Nil(t, ValidateNamedEntitySearchRequest(interfaces.NamedEntitySearchRequest{
+		Project: "project",
+		Domain:  "domain",
+		Query:   "flow",
+		Limit:   2,
+	}))
+
+	assert.Nil(t, ValidateNamedEntitySearchRequest(interfaces.NamedEntitySearchRequest{
+		Project:       "project",
+		Domain:        "domain",
+		Query:         "flow",
+		ResourceTypes: []core.ResourceType{core.ResourceType_TASK, core.ResourceType_LAUNCH_PLAN},
+		Limit:         2,
+	}))
+
+	assert.NotNil(t, ValidateNamedEntitySearchRequest(interfaces.NamedEntitySearchRequest{
+		Domain: "domain",
+		Query:  "flow",
+		Limit:  2,
+	}))
+
+	assert.NotNil(t, ValidateNamedEntitySearchRequest(interfaces.NamedEntitySearchRequest{
+		Project: "project",
+		Query:   "flow",
+		Limit:   2,
+	}))
+
+	assert.NotNil(t, ValidateNamedEntitySearchRequest(interfaces.NamedEntitySearchRequest{
+		Project: "project",
+		Domain:  "domain",
+		Limit:   2,
+	}))
+
+	assert.NotNil(t, ValidateNamedEntitySearchRequest(interfaces.NamedEntitySearchRequest{
+		Project: "project",
+		Domain:  "domain",
+		Query:   strings.Repeat("f", 256),
+		Limit:   2,
+	}))
+
+	assert.NotNil(t, ValidateNamedEntitySearchRequest(interfaces.NamedEntitySearchRequest{
+		Project:       "project",
+		Domain:        "domain",
+		Query:         "flow",
+		ResourceTypes: []core.ResourceType{core.ResourceType_DATASET},
+		Limit:         2,
+	}))
+
+	assert.NotNil(t, ValidateNamedEntitySearchRequest(interfaces.NamedEntitySearchRequest{
+		Project: "project",
+		Domain:  "domain",
+		Query:   "flow",
+	}))
+}
+
+func TestValidateNamedEntityDescriptionUpdateRequest(t *testing.T) {
+	id := &admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	assert.Nil(t, ValidateNamedEntityDescriptionUpdateRequest(interfaces.NamedEntityDescriptionUpdateRequest{
+		ResourceType: core.ResourceType_TASK,
+		ID:           id,
+		Description: interfaces.NamedEntityDescription{
+			LongDescription: "# Task\nDoes things.",
+			SourceLink:      "https://github.com/flyteorg/flytesnacks/blob/master/task.py",
+			Owners: []interfaces.NamedEntityOwner{
+				{Name: "ml-platform", Email: "ml-platform@example.com"},
+				{Name: "Jane Doe"},
+			},
+		},
+	}))
+	// Empty documents clear the description.
+	assert.Nil(t, ValidateNamedEntityDescriptionUpdateRequest(interfaces.NamedEntityDescriptionUpdateRequest{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		ID:           id,
+	}))
+
+	assert.NotNil(t, ValidateNamedEntityDescriptionUpdateRequest(interfaces.NamedEntityDescriptionUpdateRequest{
+		ResourceType: core.ResourceType_DATASET,
+		ID:           id,
+	}))
+
+	err := ValidateNamedEntityDescriptionUpdateRequest(interfaces.NamedEntityDescriptionUpdateRequest{
+		ResourceType: core.ResourceType_WORKFLOW,
+		ID:           id,
+		Description: interfaces.NamedEntityDescription{
+			LongDescription: strings.Repeat("a", 64*1024+1),
+		},
+	})
+	assert.EqualError(t, err, "long_description cannot exceed 65536 characters")
+
+	err = ValidateNamedEntityDescriptionUpdateRequest(interfaces.NamedEntityDescriptionUpdateRequest{
+		ResourceType: core.ResourceType_WORKFLOW,
+		ID:           id,
+		Description: interfaces.NamedEntityDescription{
+			SourceLink: "github.com/flyteorg/flytesnacks",
+		},
+	})
+	assert.EqualError(t, err, "source_link must be an http or https URL")
+
+	err = ValidateNamedEntityDescriptionUpdateRequest(interfaces.NamedEntityDescriptionUpdateRequest{
+		ResourceType: core.ResourceType_WORKFLOW,
+		ID:           id,
+		Description: interfaces.NamedEntityDescription{
+			Owners: []interfaces.NamedEntityOwner{{Name: "Jane Doe", Email: "jane"}},
+		},
+	})
+	assert.EqualError(t, err, "owner [Jane Doe] has an invalid email address [jane]")
+
+	err = ValidateNamedEntityDescriptionUpdateRequest(interfaces.NamedEntityDescriptionUpdateRequest{
+		ResourceType: core.ResourceType_WORKFLOW,
+		ID:           id,
+		Description: interfaces.NamedEntityDescription{
+			Owners: []interfaces.NamedEntityOwner{{Email: "jane@example.com"}},
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+}
+
+func TestValidateNamedEntityTagRequest(t *testing.T) {
+	id := &admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	assert.Nil(t, ValidateNamedEntityTagRequest(interfaces.NamedEntityTagRequest{
+		ResourceType: core.ResourceType_WORKFLOW,
+		ID:           id,
+		Tags:         []string{"team:growth", "tier:critical", "nightly", "owner:Jane.Doe"},
+	}))
+
+	assert.EqualError(t, ValidateNamedEntityTagRequest(interfaces.NamedEntityTagRequest{
+		ResourceType: core.ResourceType_TASK,
+		ID:           id,
+		Tags:         []string{"team:growth"},
+	}), "only workflows and launch plans can be tagged")
+	assert.EqualError(t, ValidateNamedEntityTagRequest(interfaces.NamedEntityTagRequest{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		ID:           id,
+	}), "missing tags")
+	for _, tag := range []string{"Team:growth", "team:", ":growth", "team growth", "-team"} {
+		assert.NotNil(t, ValidateNamedEntityTagRequest(interfaces.NamedEntityTagRequest{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			ID:           id,
+			Tags:         []string{tag},
+		}), tag)
+	}
+	assert.NotNil(t, ValidateNamedEntityTagRequest(interfaces.NamedEntityTagRequest{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		ID:           id,
+		Tags:         []string{strings.Repeat("a", 129)},
+	}))
+}
+
+func TestValidateNamedEntityTagListRequest(t *testing.T) {
+	assert.Nil(t, ValidateNamedEntityTagListRequest(interfaces.NamedEntityTagListRequest{
+		Tag:           "team:growth",
+		ResourceTypes: []core.ResourceType{core.ResourceType_LAUNCH_PLAN},
+		Limit:         10,
+	}))
+	assert.NotNil(t, ValidateNamedEntityTagListRequest(interfaces.NamedEntityTagListRequest{
+		Tag:   "team:growth",
+		Limit: 0,
+	}))
+	assert.NotNil(t, ValidateNamedEntityTagListRequest(interfaces.NamedEntityTagListRequest{
+		Tag:           "team:growth",
+		ResourceTypes: []core.ResourceType{core.ResourceType_TASK},
+		Limit:         10,
+	}))
+	assert.NotNil(t, ValidateNamedEntityTagListRequest(interfaces.NamedEntityTagListRequest{
+		Limit: 10,
+	}))
+}
@@ -0,0 +1,48 @@
+package validation
+
+import (
+	"net/mail"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+)
+
+// Validates a request to subscribe to the notifications of executions.
+func ValidateSubscribeRequest(request interfaces.SubscribeRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	switch request.Type {
+	case common.NotificationTypeEmail, common.NotificationTypeSlack, common.NotificationTypePagerDuty:
+	case "":
+		return shared.GetMissingArgumentError(shared.Type)
+	default:
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"%s must be one of %s, %s or %s, not %s", shared.Type, common.NotificationTypeEmail,
+			common.NotificationTypeSlack, common.NotificationTypePagerDuty, request.Type)
+	}
+	if err := ValidateEmptyStringField(request.Address, shared.Address); err != nil {
+		return err
+	}
+	// Slack and PagerDuty notifications are emailed to the address of a channel or service, like email notifications.
+	if address, err := mail.ParseAddress(request.Address); err != nil || address.Address != request.Address {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must be an email address", shared.Address)
+	}
+	if len(request.Phases) == 0 {
+		return shared.GetMissingArgumentError(shared.Phases)
+	}
+	// Only terminal phases are notified, like the notifications of launch plans.
+	for _, phase := range request.Phases {
+		if !common.IsExecutionTerminal(phase) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"%s must be terminal execution phases, not %s", shared.Phases, phase)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func getSubscribeRequestForTest() interfaces.SubscribeRequest {
+	return interfaces.SubscribeRequest{
+		Project:    "project",
+		Domain:     "domain",
+		LaunchPlan: "daily",
+		Phases:     []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED},
+		Type:       "email",
+		Address:    "alice@example.com",
+	}
+}
+
+func TestValidateSubscribeRequest(t *testing.T) {
+	assert.NoError(t, ValidateSubscribeRequest(getSubscribeRequestForTest()))
+	request := getSubscribeRequestForTest()
+	request.LaunchPlan = ""
+	assert.NoError(t, ValidateSubscribeRequest(request))
+}
+
+func TestValidateSubscribeRequest_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		update func(request *interfaces.SubscribeRequest)
+		err    string
+	}{
+		{
+			name:   "missing domain",
+			update: func(request *interfaces.SubscribeRequest) { request.Domain = "" },
+			err:    "missing domain",
+		},
+		{
+			name:   "missing type",
+			update: func(request *interfaces.SubscribeRequest) { request.Type = "" },
+			err:    "missing type",
+		},
+		{
+			name:   "unknown type",
+			update: func(request *interfaces.SubscribeRequest) { request.Type = "sms" },
+			err:    "type must be one of email, slack or pagerDuty, not sms",
+		},
+		{
+			name:   "missing address",
+			update: func(request *interfaces.SubscribeRequest) { request.Address = "" },
+			err:    "missing address",
+		},
+		{
+			name:   "address with a display name",
+			update: func(request *interfaces.SubscribeRequest) { request.Address = "Alice <alice@example.com>" },
+			err:    "address must be an email address",
+		},
+		{
+			name:   "missing phases",
+			update: func(request *interfaces.SubscribeRequest) { request.Phases = nil },
+			err:    "missing phases",
+		},
+		{
+			name: "non-terminal phase",
+			update: func(request *interfaces.SubscribeRequest) {
+				request.Phases = []core.WorkflowExecution_Phase{core.WorkflowExecution_RUNNING}
+			},
+			err: "phases must be terminal execution phases, not RUNNING",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			request := getSubscribeRequestForTest()
+			test.update(&request)
+			assert.EqualError(t, ValidateSubscribeRequest(request), test.err)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package validation
+
+import (
+	"net/url"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	oauthClientID          = "client_id"
+	oauthRedirectURIs      = "redirect_uris"
+	oauthGrantTypes        = "grant_types"
+	oauthResponseTypes     = "response_types"
+	oauthScopes            = "scopes"
+	maxOAuthRedirectURIs   = 10
+	grantAuthorizationCode = "authorization_code"
+	grantClientCredentials = "client_credentials"
+)
+
+var (
+	registrableGrantTypes    = sets.NewString(grantAuthorizationCode, grantClientCredentials, "refresh_token")
+	registrableResponseTypes = sets.NewString("code", "token")
+	// The scopes of the static clients of the default config.
+	registrableScopes = sets.NewString(auth.ScopeAll, auth.ScopeEventsWrite, "offline", "access_token")
+)
+
+func validateOAuthValues(values []string, field string, allowed sets.String) error {
+	if len(values) == 0 {
+		return shared.GetMissingArgumentError(field)
+	}
+	if unknown := sets.NewString(values...).Difference(allowed); unknown.Len() > 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must be among %v, found %v", field,
+			allowed.List(), unknown.List())
+	}
+	return nil
+}
+
+func ValidateOAuthClientRegisterRequest(request interfaces.OAuthClientRegisterRequest) error {
+	if errs := validation.IsDNS1123Label(request.ClientID); len(errs) > 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s [%s]: %v", oauthClientID, request.ClientID,
+			errs)
+	}
+	if err := validateOAuthValues(request.GrantTypes, oauthGrantTypes, registrableGrantTypes); err != nil {
+		return err
+	}
+	if err := validateOAuthValues(request.ResponseTypes, oauthResponseTypes, registrableResponseTypes); err != nil {
+		return err
+	}
+	if err := validateOAuthValues(request.Scopes, oauthScopes, registrableScopes); err != nil {
+		return err
+	}
+	grantTypes := sets.NewString(request.GrantTypes...)
+	// Clients without a secret can't authenticate as themselves.
+	if request.Public && grantTypes.Has(grantClientCredentials) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "public clients can't use the %s grant",
+			grantClientCredentials)
+	}
+	if grantTypes.Has(grantAuthorizationCode) && len(request.RedirectURIs) == 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "clients using the %s grant must set %s",
+			grantAuthorizationCode, oauthRedirectURIs)
+	}
+	if len(request.RedirectURIs) > maxOAuthRedirectURIs {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s cannot exceed %d uris", oauthRedirectURIs,
+			maxOAuthRedirectURIs)
+	}
+	for _, redirectURI := range request.RedirectURIs {
+		parsedURI, err := url.Parse(redirectURI)
+		if err != nil || !parsedURI.IsAbs() || len(parsedURI.Fragment) > 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"redirect uri [%s] must be an absolute uri without a fragment", redirectURI)
+		}
+	}
+	return nil
+}
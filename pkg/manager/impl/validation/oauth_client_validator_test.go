@@ -0,0 +1,59 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func getOAuthClientRegisterRequest() interfaces.OAuthClientRegisterRequest {
+	return interfaces.OAuthClientRegisterRequest{
+		ClientID:      "etl-bot",
+		RedirectURIs:  []string{"http://localhost:3846/callback"},
+		GrantTypes:    []string{"client_credentials", "refresh_token"},
+		ResponseTypes: []string{"token"},
+		Scopes:        []string{"all", "offline"},
+	}
+}
+
+func TestValidateOAuthClientRegisterRequest(t *testing.T) {
+	assert.NoError(t, ValidateOAuthClientRegisterRequest(getOAuthClientRegisterRequest()))
+
+	request := getOAuthClientRegisterRequest()
+	request.ClientID = "ETL Bot"
+	assert.EqualError(t, ValidateOAuthClientRegisterRequest(request), "invalid client_id [ETL Bot]: [a lowercase "+
+		"RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an "+
+		"alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is "+
+		"'[a-z0-9]([-a-z0-9]*[a-z0-9])?')]")
+
+	request = getOAuthClientRegisterRequest()
+	request.GrantTypes = nil
+	assert.EqualError(t, ValidateOAuthClientRegisterRequest(request), "missing grant_types")
+
+	request = getOAuthClientRegisterRequest()
+	request.GrantTypes = []string{"password"}
+	assert.EqualError(t, ValidateOAuthClientRegisterRequest(request),
+		"grant_types must be among [authorization_code client_credentials refresh_token], found [password]")
+
+	request = getOAuthClientRegisterRequest()
+	request.Scopes = []string{"all", "admin"}
+	assert.EqualError(t, ValidateOAuthClientRegisterRequest(request),
+		"scopes must be among [access_token all events:write offline], found [admin]")
+
+	request = getOAuthClientRegisterRequest()
+	request.Public = true
+	assert.EqualError(t, ValidateOAuthClientRegisterRequest(request),
+		"public clients can't use the client_credentials grant")
+
+	request = getOAuthClientRegisterRequest()
+	request.GrantTypes = []string{"authorization_code"}
+	request.RedirectURIs = nil
+	assert.EqualError(t, ValidateOAuthClientRegisterRequest(request),
+		"clients using the authorization_code grant must set redirect_uris")
+
+	request = getOAuthClientRegisterRequest()
+	request.RedirectURIs = []string{"/callback"}
+	assert.EqualError(t, ValidateOAuthClientRegisterRequest(request),
+		"redirect uri [/callback] must be an absolute uri without a fragment")
+}
@@ -0,0 +1,32 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const orgDescription = "description"
+
+// Validates the spec an org is created or updated with. Org identifiers follow the same rules as project identifiers.
+func ValidateOrgSpec(request interfaces.OrgSpec) error {
+	if err := ValidateEmptyStringField(request.Id, shared.ID); err != nil {
+		return err
+	}
+	if errs := validation.IsDNS1123Label(request.Id); len(errs) > 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid org id [%s]: %v", request.Id, errs)
+	}
+	if err := ValidateEmptyStringField(request.Name, shared.Name); err != nil {
+		return err
+	}
+	if err := ValidateMaxLengthStringField(request.Name, shared.Name, maxNameLength); err != nil {
+		return err
+	}
+	return ValidateMaxLengthStringField(request.Description, orgDescription, maxDescriptionLength)
+}
+
+func ValidateSetProjectOrgRequest(request interfaces.SetProjectOrgRequest) error {
+	return ValidateEmptyStringField(request.Project, shared.Project)
+}
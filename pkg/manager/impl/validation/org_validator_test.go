@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOrgSpec(t *testing.T) {
+	assert.NoError(t, ValidateOrgSpec(interfaces.OrgSpec{
+		Id:          "research",
+		Name:        "Research",
+		Description: "The projects of the research unit",
+	}))
+}
+
+func TestValidateOrgSpec_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		request interfaces.OrgSpec
+		err     string
+	}{
+		{
+			name:    "missing id",
+			request: interfaces.OrgSpec{Name: "Research"},
+			err:     "missing id",
+		},
+		{
+			name:    "invalid id",
+			request: interfaces.OrgSpec{Id: "Research Unit", Name: "Research"},
+			err:     "invalid org id [Research Unit]",
+		},
+		{
+			name:    "missing name",
+			request: interfaces.OrgSpec{Id: "research"},
+			err:     "missing name",
+		},
+		{
+			name: "description too long",
+			request: interfaces.OrgSpec{
+				Id:          "research",
+				Name:        "Research",
+				Description: strings.Repeat("a", maxDescriptionLength+1),
+			},
+			err: "description cannot exceed 300 characters",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateOrgSpec(test.request)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestValidateSetProjectOrgRequest(t *testing.T) {
+	// Projects are moved out of their org with an empty org.
+	assert.NoError(t, ValidateSetProjectOrgRequest(interfaces.SetProjectOrgRequest{Project: "flytesnacks"}))
+	assert.EqualError(t, ValidateSetProjectOrgRequest(interfaces.SetProjectOrgRequest{Org: "research"}),
+		"missing project")
+}
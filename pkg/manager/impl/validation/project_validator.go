@@ -2,8 +2,10 @@ package validation
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
@@ -19,6 +21,16 @@ const projectDescription = "project_description"
 const maxNameLength = 64
 const maxDescriptionLength = 300
 const maxLabelArrayLength = 16
+const projectOwner = "owner"
+const projectTeam = "team"
+const projectMetadata = "metadata"
+const projectLinks = "links"
+const maxOwnerLength = 255
+const maxMetadataLength = 64
+const maxLinksLength = 16
+const projectServiceAccount = "service_account"
+const projectRawOutputDataPrefix = "raw_output_data_prefix"
+const projectMaxParallelism = "max_parallelism"
 
 func ValidateProjectRegisterRequest(request admin.ProjectRegisterRequest) error {
 	if request.Project == nil {
@@ -54,6 +66,59 @@ func ValidateProject(project admin.Project) error {
 	return nil
 }
 
+func ValidateProjectMetadata(metadata interfaces.ProjectMetadata) error {
+	if err := ValidateMaxLengthStringField(metadata.Owner, projectOwner, maxOwnerLength); err != nil {
+		return err
+	}
+	if err := ValidateMaxLengthStringField(metadata.Team, projectTeam, maxOwnerLength); err != nil {
+		return err
+	}
+	if err := ValidateMaxMapLengthField(metadata.Metadata, projectMetadata, maxMetadataLength); err != nil {
+		return err
+	}
+	for key := range metadata.Metadata {
+		if len(key) == 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s keys can't be empty", projectMetadata)
+		}
+	}
+	if len(metadata.Links) > maxLinksLength {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s cannot exceed %d entries", projectLinks,
+			maxLinksLength)
+	}
+	for _, link := range metadata.Links {
+		if len(link.Name) == 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must be named", projectLinks)
+		}
+		linkURL, err := url.Parse(link.URL)
+		if err != nil || (linkURL.Scheme != "http" && linkURL.Scheme != "https") || len(linkURL.Host) == 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "link [%s] must be an http or https URL",
+				link.Name)
+		}
+	}
+	return nil
+}
+
+func ValidateProjectExecutionDefaults(defaults interfaces.ProjectExecutionDefaults) error {
+	if len(defaults.ServiceAccount) > 0 {
+		if errs := validation.IsDNS1123Subdomain(defaults.ServiceAccount); len(errs) > 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s [%s]: %v", projectServiceAccount,
+				defaults.ServiceAccount, errs)
+		}
+	}
+	if len(defaults.RawOutputDataPrefix) > 0 {
+		prefixURL, err := url.Parse(defaults.RawOutputDataPrefix)
+		if err != nil || len(prefixURL.Scheme) == 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"%s [%s] must be a URL such as s3://my-bucket/raw-data", projectRawOutputDataPrefix,
+				defaults.RawOutputDataPrefix)
+		}
+	}
+	if defaults.MaxParallelism < 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s can't be negative", projectMaxParallelism)
+	}
+	return nil
+}
+
 // Validates that a specified project and domain combination has been registered and exists in the db.
 func ValidateProjectAndDomain(
 	ctx context.Context, db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration, projectID, domainID string) error {
@@ -67,9 +132,12 @@ func ValidateProjectAndDomain(
 		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"project [%s] is not active", projectID)
 	}
+	domains, err := shared.GetDomains(ctx, db, config)
+	if err != nil {
+		return err
+	}
 	var validDomain bool
-	domains := config.GetDomainsConfig()
-	for _, domain := range *domains {
+	for _, domain := range domains {
 		if domain.ID == domainID {
 			validDomain = true
 			break
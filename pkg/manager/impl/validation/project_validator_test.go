@@ -303,3 +303,18 @@ func TestValidateProjectAndDomainNotFound(t *testing.T) {
 		"flyte-project", "domain")
 	assert.EqualError(t, err, "failed to validate that project [flyte-project] and domain [domain] are registered, err: [project [flyte-project] not found]")
 }
+
+func TestValidateProjectAndDomain_StoredDomains(t *testing.T) {
+	mockRepo := repositoryMocks.NewMockRepository()
+	mockRepo.DomainRepo().(*repositoryMocks.MockDomainRepo).ListFunction = func(
+		ctx context.Context) ([]models.Domain, error) {
+		return []models.Domain{{Identifier: "staging", Name: "staging"}}, nil
+	}
+
+	assert.NoError(t, ValidateProjectAndDomain(context.Background(), mockRepo,
+		testutils.GetApplicationConfigWithDefaultDomains(), "flyte-project-id", "staging"))
+	// The configured domains are only used until domains are stored.
+	err := ValidateProjectAndDomain(context.Background(), mockRepo, testutils.GetApplicationConfigWithDefaultDomains(),
+		"flyte-project-id", "domain")
+	assert.EqualError(t, err, "domain [domain] is unrecognized by system")
+}
@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func validateQuotaCap(value, fieldName string) error {
+	if len(value) == 0 {
+		return nil
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid %s quota [%s], which must be a kubernetes quantity: %v", fieldName, value, err)
+	}
+	if quantity.Sign() < 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s quota [%s] can't be negative", fieldName, value)
+	}
+	return nil
+}
+
+// Validates a request to set the quota of a project and domain, which must be registered and have at least one cap.
+func ValidateSetDomainQuotaRequest(ctx context.Context, request interfaces.DomainQuota,
+	db repositories.RepositoryInterface, config runtimeInterfaces.ApplicationConfiguration) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	if len(request.CPU) == 0 && len(request.Memory) == 0 && len(request.GPU) == 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "a quota must set at least one of %s, %s or %s",
+			shared.CPU, shared.Memory, shared.GPU)
+	}
+	if err := validateQuotaCap(request.CPU, shared.CPU); err != nil {
+		return err
+	}
+	if err := validateQuotaCap(request.Memory, shared.Memory); err != nil {
+		return err
+	}
+	if err := validateQuotaCap(request.GPU, shared.GPU); err != nil {
+		return err
+	}
+	return ValidateProjectAndDomain(ctx, db, config, request.Project, request.Domain)
+}
@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func getSetDomainQuotaRequestForTest() interfaces.DomainQuota {
+	return interfaces.DomainQuota{
+		Project: "project",
+		Domain:  "development",
+		CPU:     "16",
+		Memory:  "64Gi",
+		GPU:     "2",
+	}
+}
+
+func TestValidateSetDomainQuotaRequest(t *testing.T) {
+	assert.NoError(t, ValidateSetDomainQuotaRequest(context.Background(), getSetDomainQuotaRequestForTest(),
+		repositoryMocks.NewMockRepository(), testutils.GetApplicationConfigWithDefaultDomains()))
+}
+
+func TestValidateSetDomainQuotaRequest_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		update func(request *interfaces.DomainQuota)
+		err    string
+	}{
+		{
+			name:   "missing project",
+			update: func(request *interfaces.DomainQuota) { request.Project = "" },
+			err:    "missing project",
+		},
+		{
+			name: "no caps",
+			update: func(request *interfaces.DomainQuota) {
+				request.CPU = ""
+				request.Memory = ""
+				request.GPU = ""
+			},
+			err: "a quota must set at least one of cpu, memory or gpu",
+		},
+		{
+			name:   "invalid quantity",
+			update: func(request *interfaces.DomainQuota) { request.Memory = "lots" },
+			err:    "invalid memory quota [lots], which must be a kubernetes quantity: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			name:   "negative quantity",
+			update: func(request *interfaces.DomainQuota) { request.CPU = "-1" },
+			err:    "cpu quota [-1] can't be negative",
+		},
+		{
+			name:   "unknown domain",
+			update: func(request *interfaces.DomainQuota) { request.Domain = "sandbox" },
+			err:    "domain [sandbox] is unrecognized by system",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			request := getSetDomainQuotaRequestForTest()
+			test.update(&request)
+			assert.EqualError(t, ValidateSetDomainQuotaRequest(context.Background(), request,
+				repositoryMocks.NewMockRepository(), testutils.GetApplicationConfigWithDefaultDomains()), test.err)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package validation
+
+import (
+	"regexp"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"google.golang.org/grpc/codes"
+)
+
+const savedViewSortBy = "sort_by"
+
+const maxSavedViewNameLength = 255
+const maxSavedViewFiltersLength = 4096
+
+// Sort keys are saved and replayed into order clauses, so they're restricted to (optionally qualified) column names.
+var sortKeyRegex = regexp.MustCompile(`^[a-z_]+(\.[a-z_]+)?$`)
+
+func ValidateSavedViewIdentifier(id interfaces.SavedViewIdentifier) error {
+	if err := ValidateEmptyStringField(id.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(id.Domain, shared.Domain); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(id.Name, shared.Name); err != nil {
+		return err
+	}
+	return ValidateMaxLengthStringField(id.Name, shared.Name, maxSavedViewNameLength)
+}
+
+// Validates the fields of a saved view. Its filters are validated when they're parsed for its resource type.
+func ValidateSavedView(request interfaces.SavedView) error {
+	if err := ValidateSavedViewIdentifier(request.ID); err != nil {
+		return err
+	}
+	switch request.ResourceType {
+	case interfaces.SavedViewExecutions, interfaces.SavedViewWorkflows:
+	case "":
+		return shared.GetMissingArgumentError(shared.ResourceType)
+	default:
+		return shared.GetInvalidArgumentError(shared.ResourceType)
+	}
+	if err := ValidateMaxLengthStringField(request.Filters, shared.Filters, maxSavedViewFiltersLength); err != nil {
+		return err
+	}
+	if request.SortBy != nil {
+		if !sortKeyRegex.MatchString(request.SortBy.Key) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s key [%s]", savedViewSortBy,
+				request.SortBy.Key)
+		}
+		if _, ok := admin.Sort_Direction_name[int32(request.SortBy.Direction)]; !ok {
+			return shared.GetInvalidArgumentError(savedViewSortBy)
+		}
+	}
+	return nil
+}
+
+func ValidateSavedViewListRequest(request interfaces.SavedViewListRequest) error {
+	if err := ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return err
+	}
+	return ValidateLimit(request.Limit)
+}
+
+func ValidateSavedViewEvaluateRequest(request interfaces.SavedViewEvaluateRequest) error {
+	if err := ValidateSavedViewIdentifier(request.ID); err != nil {
+		return err
+	}
+	return ValidateLimit(request.Limit)
+}
@@ -0,0 +1,66 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+var savedViewID = interfaces.SavedViewIdentifier{
+	Project: "project",
+	Domain:  "production",
+	Name:    "Failed runs",
+}
+
+func TestValidateSavedView(t *testing.T) {
+	assert.NoError(t, ValidateSavedView(interfaces.SavedView{
+		ID:           savedViewID,
+		ResourceType: interfaces.SavedViewExecutions,
+		Filters:      "eq(phase,FAILED)",
+		SortBy: &admin.Sort{
+			Key:       "created_at",
+			Direction: admin.Sort_DESCENDING,
+		},
+	}))
+	assert.EqualError(t, ValidateSavedView(interfaces.SavedView{
+		ID: interfaces.SavedViewIdentifier{
+			Project: "project",
+			Domain:  "production",
+		},
+		ResourceType: interfaces.SavedViewExecutions,
+	}), "missing name")
+	assert.EqualError(t, ValidateSavedView(interfaces.SavedView{
+		ID: savedViewID,
+	}), "missing resource_type")
+	assert.EqualError(t, ValidateSavedView(interfaces.SavedView{
+		ID:           savedViewID,
+		ResourceType: "tasks",
+	}), "invalid value for resource_type")
+	assert.EqualError(t, ValidateSavedView(interfaces.SavedView{
+		ID:           savedViewID,
+		ResourceType: interfaces.SavedViewWorkflows,
+		SortBy: &admin.Sort{
+			Key: "created_at; DROP TABLE workflows",
+		},
+	}), "invalid sort_by key [created_at; DROP TABLE workflows]")
+	assert.EqualError(t, ValidateSavedView(interfaces.SavedView{
+		ID:           savedViewID,
+		ResourceType: interfaces.SavedViewWorkflows,
+		SortBy: &admin.Sort{
+			Key:       "created_at",
+			Direction: admin.Sort_Direction(7),
+		},
+	}), "invalid value for sort_by")
+}
+
+func TestValidateSavedViewEvaluateRequest(t *testing.T) {
+	assert.NoError(t, ValidateSavedViewEvaluateRequest(interfaces.SavedViewEvaluateRequest{
+		ID:    savedViewID,
+		Limit: 10,
+	}))
+	assert.Error(t, ValidateSavedViewEvaluateRequest(interfaces.SavedViewEvaluateRequest{
+		ID: savedViewID,
+	}))
+}
@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"google.golang.org/grpc/codes"
+)
+
+// Jitter delays a scheduled execution, so it's capped well below the interval of typical schedules.
+const maxScheduleJitter = time.Hour
+
+func ValidateUpdateScheduleOptionsRequest(request interfaces.UpdateScheduleOptionsRequest) error {
+	if err := ValidateNamedEntityIdentifier(&request.Id); err != nil {
+		return err
+	}
+	if request.Jitter < 0 {
+		return shared.GetInvalidArgumentError(shared.Jitter)
+	}
+	if request.Jitter > maxScheduleJitter {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"%s cannot exceed %v", shared.Jitter, maxScheduleJitter)
+	}
+	switch request.CatchUpPolicy {
+	case "", schedulerModels.CatchUpAll, schedulerModels.CatchUpLast, schedulerModels.CatchUpSkip:
+	default:
+		return shared.GetInvalidArgumentError(shared.CatchUpPolicy)
+	}
+	switch request.ConcurrencyPolicy {
+	case "", schedulerModels.ConcurrencyAllow, schedulerModels.ConcurrencySkip, schedulerModels.ConcurrencyReplace,
+		schedulerModels.ConcurrencyQueue:
+	default:
+		return shared.GetInvalidArgumentError(shared.ConcurrencyPolicy)
+	}
+	return nil
+}
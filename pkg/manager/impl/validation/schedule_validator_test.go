@@ -0,0 +1,50 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUpdateScheduleOptionsRequest(t *testing.T) {
+	id := admin.NamedEntityIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	assert.NoError(t, ValidateUpdateScheduleOptionsRequest(interfaces.UpdateScheduleOptionsRequest{
+		Id: id,
+	}))
+	assert.NoError(t, ValidateUpdateScheduleOptionsRequest(interfaces.UpdateScheduleOptionsRequest{
+		Id:                id,
+		Jitter:            time.Minute,
+		CatchUpPolicy:     "LAST",
+		ConcurrencyPolicy: "QUEUE",
+	}))
+
+	assert.EqualError(t, ValidateUpdateScheduleOptionsRequest(interfaces.UpdateScheduleOptionsRequest{
+		Id: admin.NamedEntityIdentifier{
+			Project: "project",
+			Domain:  "domain",
+		},
+	}), "missing name")
+	assert.EqualError(t, ValidateUpdateScheduleOptionsRequest(interfaces.UpdateScheduleOptionsRequest{
+		Id:     id,
+		Jitter: -time.Second,
+	}), "invalid value for jitter")
+	assert.EqualError(t, ValidateUpdateScheduleOptionsRequest(interfaces.UpdateScheduleOptionsRequest{
+		Id:     id,
+		Jitter: 2 * time.Hour,
+	}), "jitter cannot exceed 1h0m0s")
+	assert.EqualError(t, ValidateUpdateScheduleOptionsRequest(interfaces.UpdateScheduleOptionsRequest{
+		Id:            id,
+		CatchUpPolicy: "FIRST",
+	}), "invalid value for catch_up_policy")
+	assert.EqualError(t, ValidateUpdateScheduleOptionsRequest(interfaces.UpdateScheduleOptionsRequest{
+		Id:                id,
+		ConcurrencyPolicy: "FORBID",
+	}), "invalid value for concurrency_policy")
+}
@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flytepropeller/pkg/compiler/validators"
+	"google.golang.org/grpc/codes"
+)
+
+const signalID = "signal_id"
+const signalType = "type"
+const signalValue = "value"
+
+const maxSignalIDLength = 255
+
+func ValidateSignalIdentifier(id interfaces.SignalIdentifier) error {
+	if err := ValidateEmptyStringField(id.SignalID, signalID); err != nil {
+		return err
+	}
+	if err := ValidateMaxLengthStringField(id.SignalID, signalID, maxSignalIDLength); err != nil {
+		return err
+	}
+	return ValidateWorkflowExecutionIdentifier(id.ExecutionID)
+}
+
+func ValidateSignalGetOrCreateRequest(request interfaces.SignalGetOrCreateRequest) error {
+	if err := ValidateSignalIdentifier(request.ID); err != nil {
+		return err
+	}
+	if request.Type == nil {
+		return shared.GetMissingArgumentError(signalType)
+	}
+	return nil
+}
+
+func ValidateSignalListRequest(request interfaces.SignalListRequest) error {
+	if err := ValidateWorkflowExecutionIdentifier(request.ExecutionID); err != nil {
+		return err
+	}
+	return ValidateLimit(request.Limit)
+}
+
+func ValidateSignalSetRequest(request interfaces.SignalSetRequest) error {
+	if err := ValidateSignalIdentifier(request.ID); err != nil {
+		return err
+	}
+	if request.Value == nil {
+		return shared.GetMissingArgumentError(signalValue)
+	}
+	return nil
+}
+
+// Validates that the value a signal is set to is of the type the signal expects.
+func ValidateSignalValue(request interfaces.SignalSetRequest, signal interfaces.Signal) error {
+	valueType := validators.LiteralTypeForLiteral(request.Value)
+	if !validators.AreTypesCastable(valueType, signal.Type) {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"signal [%s] expects a value of type [%v] but was set to a value of type [%v]", request.ID.SignalID,
+			signal.Type, valueType)
+	}
+	return nil
+}
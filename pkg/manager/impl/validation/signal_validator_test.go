@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+var signalExecutionID = &core.WorkflowExecutionIdentifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+}
+
+func TestValidateSignalGetOrCreateRequest(t *testing.T) {
+	assert.NoError(t, ValidateSignalGetOrCreateRequest(interfaces.SignalGetOrCreateRequest{
+		ID: interfaces.SignalIdentifier{
+			SignalID:    "approve-deploy",
+			ExecutionID: signalExecutionID,
+		},
+		Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_BOOLEAN}},
+	}))
+	assert.EqualError(t, ValidateSignalGetOrCreateRequest(interfaces.SignalGetOrCreateRequest{
+		ID: interfaces.SignalIdentifier{
+			ExecutionID: signalExecutionID,
+		},
+		Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_BOOLEAN}},
+	}), "missing signal_id")
+	assert.EqualError(t, ValidateSignalGetOrCreateRequest(interfaces.SignalGetOrCreateRequest{
+		ID: interfaces.SignalIdentifier{
+			SignalID:    "approve-deploy",
+			ExecutionID: signalExecutionID,
+		},
+	}), "missing type")
+}
+
+func TestValidateSignalSetRequest(t *testing.T) {
+	id := interfaces.SignalIdentifier{
+		SignalID:    "approve-deploy",
+		ExecutionID: signalExecutionID,
+	}
+	assert.NoError(t, ValidateSignalSetRequest(interfaces.SignalSetRequest{
+		ID:    id,
+		Value: coreutils.MustMakeLiteral(true),
+	}))
+	assert.EqualError(t, ValidateSignalSetRequest(interfaces.SignalSetRequest{
+		ID: id,
+	}), "missing value")
+	assert.Error(t, ValidateSignalSetRequest(interfaces.SignalSetRequest{
+		ID: interfaces.SignalIdentifier{
+			SignalID: "approve-deploy",
+		},
+		Value: coreutils.MustMakeLiteral(true),
+	}))
+}
+
+func TestValidateSignalValue(t *testing.T) {
+	signal := interfaces.Signal{
+		Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_BOOLEAN}},
+	}
+	assert.NoError(t, ValidateSignalValue(interfaces.SignalSetRequest{
+		Value: coreutils.MustMakeLiteral(false),
+	}, signal))
+	assert.Error(t, ValidateSignalValue(interfaces.SignalSetRequest{
+		Value: coreutils.MustMakeLiteral(1),
+	}, signal))
+}
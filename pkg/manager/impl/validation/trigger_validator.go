@@ -0,0 +1,36 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"google.golang.org/grpc/codes"
+)
+
+// Validates a request to register a trigger, rejecting input mappings without an input name or with an empty field
+// in their payload path.
+func ValidateRegisterTriggerRequest(request interfaces.RegisterTriggerRequest) error {
+	if err := ValidateNamedEntityIdentifier(&request.Id); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.LaunchPlan, shared.LaunchPlan); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Secret, shared.Secret); err != nil {
+		return err
+	}
+	for input, path := range request.InputMappings {
+		if len(input) == 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must name an input", shared.InputMappings)
+		}
+		for _, field := range strings.Split(path, ".") {
+			if len(field) == 0 {
+				return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"invalid payload path [%s] in %s for input [%s]", path, shared.InputMappings, input)
+			}
+		}
+	}
+	return nil
+}
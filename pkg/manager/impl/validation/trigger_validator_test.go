@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+func getRegisterTriggerRequestForTest() interfaces.RegisterTriggerRequest {
+	return interfaces.RegisterTriggerRequest{
+		Id: admin.NamedEntityIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "partition-landed",
+		},
+		LaunchPlan: "daily",
+		Secret:     "secret",
+		InputMappings: map[string]string{
+			"date":  "partition.date",
+			"table": "table",
+		},
+	}
+}
+
+func TestValidateRegisterTriggerRequest(t *testing.T) {
+	assert.NoError(t, ValidateRegisterTriggerRequest(getRegisterTriggerRequestForTest()))
+
+	request := getRegisterTriggerRequestForTest()
+	request.InputMappings = nil
+	assert.NoError(t, ValidateRegisterTriggerRequest(request))
+}
+
+func TestValidateRegisterTriggerRequest_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		update func(request *interfaces.RegisterTriggerRequest)
+		err    string
+	}{
+		{
+			name:   "missing name",
+			update: func(request *interfaces.RegisterTriggerRequest) { request.Id.Name = "" },
+			err:    "missing name",
+		},
+		{
+			name:   "missing launch plan",
+			update: func(request *interfaces.RegisterTriggerRequest) { request.LaunchPlan = "" },
+			err:    "missing launch_plan",
+		},
+		{
+			name:   "missing secret",
+			update: func(request *interfaces.RegisterTriggerRequest) { request.Secret = "" },
+			err:    "missing secret",
+		},
+		{
+			name: "mapping without an input",
+			update: func(request *interfaces.RegisterTriggerRequest) {
+				request.InputMappings = map[string]string{"": "partition.date"}
+			},
+			err: "input_mappings must name an input",
+		},
+		{
+			name: "empty payload path",
+			update: func(request *interfaces.RegisterTriggerRequest) {
+				request.InputMappings = map[string]string{"date": ""}
+			},
+			err: "invalid payload path [] in input_mappings for input [date]",
+		},
+		{
+			name: "empty field in payload path",
+			update: func(request *interfaces.RegisterTriggerRequest) {
+				request.InputMappings = map[string]string{"date": "partition..date"}
+			},
+			err: "invalid payload path [partition..date] in input_mappings for input [date]",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			request := getRegisterTriggerRequestForTest()
+			test.update(&request)
+			assert.EqualError(t, ValidateRegisterTriggerRequest(request), test.err)
+		})
+	}
+}
@@ -10,12 +10,16 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytepropeller/pkg/compiler/validators"
 	"google.golang.org/grpc/codes"
 )
 
+// The maximum number of entities which can be fetched by identifier at once.
+const maxBatchGetSize = 500
+
 var entityToResourceType = map[common.Entity]core.ResourceType{
 	common.Task:       core.ResourceType_TASK,
 	common.Workflow:   core.ResourceType_WORKFLOW,
@@ -104,6 +108,23 @@ func ValidateIdentifier(id *core.Identifier, expectedType common.Entity) error {
 	return ValidateIdentifierFieldsSet(id)
 }
 
+// Validates the identifiers of entities fetched in a single batch, which are limited to maxBatchGetSize.
+func ValidateIdentifiers(ids []*core.Identifier, expectedType common.Entity) error {
+	if len(ids) == 0 {
+		return shared.GetMissingArgumentError(shared.ID)
+	}
+	if len(ids) > maxBatchGetSize {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "cannot get more than %d entities at once",
+			maxBatchGetSize)
+	}
+	for _, id := range ids {
+		if err := ValidateIdentifier(id, expectedType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Validates that all required fields for an identifier are present.
 func ValidateNamedEntityIdentifier(id *admin.NamedEntityIdentifier) error {
 	if id == nil {
@@ -268,3 +289,29 @@ func ValidateOutputData(outputData *core.LiteralMap, maxSizeInBytes int64) error
 	}
 	return errors.NewFlyteAdminErrorf(codes.ResourceExhausted, "Output data size exceeds platform configured threshold (%+v > %v)", outputSizeInBytes, maxSizeInBytes)
 }
+
+func ValidateGetDataOptions(options interfaces.GetDataOptions) error {
+	for _, key := range options.Keys {
+		if len(key) == 0 {
+			return shared.GetInvalidArgumentError("keys")
+		}
+	}
+	if options.MaxLiteralBytes < 0 {
+		return shared.GetInvalidArgumentError("max_literal_bytes")
+	}
+	if options.MaxTotalBytes < 0 {
+		return shared.GetInvalidArgumentError("max_total_bytes")
+	}
+	return nil
+}
+
+// Validates a request to compare two versions of a workflow or task.
+func ValidateVersionDiffRequest(request interfaces.VersionDiffRequest) error {
+	if err := ValidateNamedEntityIdentifier(request.ID); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.BaseVersion, "base_version"); err != nil {
+		return err
+	}
+	return ValidateEmptyStringField(request.TargetVersion, "target_version")
+}
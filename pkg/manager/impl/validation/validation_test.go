@@ -8,6 +8,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/stretchr/testify/assert"
@@ -68,6 +69,29 @@ func TestValidateIdentifier(t *testing.T) {
 		"[resource_type:WORKFLOW project:\"project\" domain:\"domain\" ], expected task instead")
 }
 
+func TestValidateIdentifiers(t *testing.T) {
+	id := &core.Identifier{
+		ResourceType: core.ResourceType_TASK,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "name",
+		Version:      "version",
+	}
+	assert.NoError(t, ValidateIdentifiers([]*core.Identifier{id, id}, common.Task))
+	assert.EqualError(t, ValidateIdentifiers(nil, common.Task), "missing id")
+	assert.EqualError(t, ValidateIdentifiers([]*core.Identifier{id, {
+		ResourceType: core.ResourceType_TASK,
+		Project:      "project",
+		Domain:       "domain",
+	}}, common.Task), "missing name")
+
+	ids := make([]*core.Identifier, maxBatchGetSize+1)
+	for i := range ids {
+		ids[i] = id
+	}
+	assert.EqualError(t, ValidateIdentifiers(ids, common.Task), "cannot get more than 500 entities at once")
+}
+
 func TestValidateNamedEntityIdentifierListRequest(t *testing.T) {
 	assert.Nil(t, ValidateNamedEntityIdentifierListRequest(admin.NamedEntityIdentifierListRequest{
 		Project: "project",
@@ -334,3 +358,21 @@ func TestValidateOutputData(t *testing.T) {
 		assert.Equal(t, codes.ResourceExhausted, err.(errors.FlyteAdminError).Code())
 	})
 }
+
+func TestValidateGetDataOptions(t *testing.T) {
+	assert.NoError(t, ValidateGetDataOptions(interfaces.GetDataOptions{}))
+	assert.NoError(t, ValidateGetDataOptions(interfaces.GetDataOptions{
+		Keys:            []string{"foo"},
+		MaxLiteralBytes: 100,
+		MaxTotalBytes:   1000,
+	}))
+	assert.EqualError(t, ValidateGetDataOptions(interfaces.GetDataOptions{
+		Keys: []string{"foo", ""},
+	}), "invalid value for keys")
+	assert.EqualError(t, ValidateGetDataOptions(interfaces.GetDataOptions{
+		MaxLiteralBytes: -1,
+	}), "invalid value for max_literal_bytes")
+	assert.EqualError(t, ValidateGetDataOptions(interfaces.GetDataOptions{
+		MaxTotalBytes: -1,
+	}), "invalid value for max_total_bytes")
+}
@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"net/url"
+
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	"github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"google.golang.org/grpc/codes"
+)
+
+// Validates a request to register a webhook, rejecting templates which don't render JSON and URLs of hosts webhooks
+// can't be delivered to.
+func ValidateRegisterWebhookRequest(
+	request interfaces.RegisterWebhookRequest, config runtimeInterfaces.RegisteredWebhooksConfig) error {
+	if err := ValidateNamedEntityIdentifier(&request.Id); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.URL, shared.URL); err != nil {
+		return err
+	}
+	webhookURL, err := url.Parse(request.URL)
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || len(webhookURL.Host) == 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s must be an http or https URL", shared.URL)
+	}
+	if err := implementations.ValidateWebhookHost(config, webhookURL.Hostname()); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s: %v", shared.URL, err)
+	}
+	if err := ValidateEmptyStringField(request.Secret, shared.Secret); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.Template, shared.Template); err != nil {
+		return err
+	}
+	if err := notifications.ValidateWebhookPayloadTemplate(request.Template); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s: %v", shared.Template, err)
+	}
+	if len(request.Phases) == 0 {
+		return shared.GetMissingArgumentError(shared.Phases)
+	}
+	// Only terminal phases are delivered, so that each execution is delivered to a webhook at most once.
+	for _, phase := range request.Phases {
+		if !common.IsExecutionTerminal(phase) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"%s must be terminal execution phases, not %s", shared.Phases, phase)
+		}
+	}
+	return nil
+}
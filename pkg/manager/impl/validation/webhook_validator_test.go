@@ -0,0 +1,99 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func getRegisterWebhookRequestForTest() interfaces.RegisterWebhookRequest {
+	return interfaces.RegisterWebhookRequest{
+		Id: admin.NamedEntityIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "webhook",
+		},
+		URL:      "https://example.com/hooks/flyte",
+		Secret:   "secret",
+		Template: `{"execution": {{ json .Name }}, "phase": {{ json .Phase }}}`,
+		Phases:   []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED, core.WorkflowExecution_TIMED_OUT},
+	}
+}
+
+func TestValidateRegisterWebhookRequest(t *testing.T) {
+	assert.NoError(t, ValidateRegisterWebhookRequest(getRegisterWebhookRequestForTest(),
+		runtimeInterfaces.RegisteredWebhooksConfig{}))
+}
+
+func TestValidateRegisterWebhookRequest_Invalid(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		update func(request *interfaces.RegisterWebhookRequest)
+		err    string
+	}{
+		{
+			name:   "missing name",
+			update: func(request *interfaces.RegisterWebhookRequest) { request.Id.Name = "" },
+			err:    "missing name",
+		},
+		{
+			name:   "missing url",
+			update: func(request *interfaces.RegisterWebhookRequest) { request.URL = "" },
+			err:    "missing url",
+		},
+		{
+			name:   "url without a scheme",
+			update: func(request *interfaces.RegisterWebhookRequest) { request.URL = "example.com/hooks/flyte" },
+			err:    "url must be an http or https URL",
+		},
+		{
+			name:   "internal address",
+			update: func(request *interfaces.RegisterWebhookRequest) { request.URL = "http://169.254.169.254/latest" },
+			err:    "invalid url: webhooks can't be delivered to internal address 169.254.169.254",
+		},
+		{
+			name:   "denied host",
+			update: func(request *interfaces.RegisterWebhookRequest) { request.URL = "https://denied.example.com/hooks" },
+			err:    "invalid url: webhooks can't be delivered to denied host denied.example.com",
+		},
+		{
+			name:   "missing secret",
+			update: func(request *interfaces.RegisterWebhookRequest) { request.Secret = "" },
+			err:    "missing secret",
+		},
+		{
+			name:   "unparseable template",
+			update: func(request *interfaces.RegisterWebhookRequest) { request.Template = `{"name": {{ json .Name }` },
+			err:    `invalid template: template: payload:1: unexpected "}" in operand`,
+		},
+		{
+			name:   "template rendering invalid JSON",
+			update: func(request *interfaces.RegisterWebhookRequest) { request.Template = `{"name": {{ .Name }}}` },
+			err:    `invalid template: payload rendered for a sample execution isn't valid JSON: {"name": name}`,
+		},
+		{
+			name:   "missing phases",
+			update: func(request *interfaces.RegisterWebhookRequest) { request.Phases = nil },
+			err:    "missing phases",
+		},
+		{
+			name: "non-terminal phase",
+			update: func(request *interfaces.RegisterWebhookRequest) {
+				request.Phases = []core.WorkflowExecution_Phase{core.WorkflowExecution_RUNNING}
+			},
+			err: "phases must be terminal execution phases, not RUNNING",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			request := getRegisterWebhookRequestForTest()
+			test.update(&request)
+			assert.EqualError(t, ValidateRegisterWebhookRequest(request, runtimeInterfaces.RegisteredWebhooksConfig{
+				DeniedHosts: []string{"denied.example.com"},
+			}), test.err)
+		})
+	}
+}
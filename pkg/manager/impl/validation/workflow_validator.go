@@ -2,6 +2,8 @@ package validation
 
 import (
 	"context"
+	"crypto/md5"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -11,6 +13,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	runtime "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -20,6 +23,9 @@ import (
 
 const numSystemNodes = 2 // A workflow graph always has a start and end node injected by the platform.
 
+// The maximum number of parts a workflow spec can be registered from.
+const maxWorkflowSpecParts = 1000
+
 func ValidateWorkflow(
 	ctx context.Context, request admin.WorkflowCreateRequest, db repositories.RepositoryInterface,
 	config runtime.ApplicationConfiguration) error {
@@ -35,6 +41,58 @@ func ValidateWorkflow(
 	return nil
 }
 
+func ValidateWorkflowCompileRequest(
+	ctx context.Context, request interfaces.WorkflowCompileRequest, db repositories.RepositoryInterface,
+	config runtime.ApplicationConfiguration) error {
+	if err := ValidateIdentifier(request.Id, common.Workflow); err != nil {
+		return err
+	}
+	if err := ValidateProjectAndDomain(ctx, db, config, request.Id.Project, request.Id.Domain); err != nil {
+		return err
+	}
+	if request.Spec == nil || request.Spec.Template == nil {
+		return shared.GetMissingArgumentError(shared.Spec)
+	}
+	for _, task := range request.Tasks {
+		if task == nil || task.Template == nil {
+			return shared.GetMissingArgumentError("task template")
+		}
+		if err := ValidateIdentifier(task.Template.Id, common.Task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validates a request to register a workflow from parts uploaded through the data proxy. Parts must have been uploaded
+// to the project and domain of the workflow.
+func ValidateWorkflowFromPartsCreateRequest(
+	request interfaces.WorkflowFromPartsCreateRequest, uploadPrefix string) error {
+	if err := ValidateIdentifier(request.Id, common.Workflow); err != nil {
+		return err
+	}
+	if len(request.PartURLs) == 0 {
+		return shared.GetMissingArgumentError("part_urls")
+	}
+	if len(request.PartURLs) > maxWorkflowSpecParts {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"a workflow spec can't be registered from more than %d parts", maxWorkflowSpecParts)
+	}
+	if len(request.ContentMD5) != md5.Size {
+		return shared.GetInvalidArgumentError("content_md5")
+	}
+	partPrefix := strings.Join([]string{
+		strings.TrimSuffix(uploadPrefix, "/"), request.Id.Project, request.Id.Domain, ""}, "/")
+	for _, partURL := range request.PartURLs {
+		if !strings.HasPrefix(partURL, partPrefix) || strings.Contains(partURL, "/../") {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"part [%s] wasn't uploaded through the data proxy for [%s/%s]", partURL, request.Id.Project,
+				request.Id.Domain)
+		}
+	}
+	return nil
+}
+
 func ValidateCompiledWorkflow(identifier core.Identifier, workflow admin.WorkflowClosure, config runtime.RegistrationValidationConfiguration) error {
 	if len(config.GetWorkflowSizeLimit()) > 0 {
 		workflowSizeLimit := resource.MustParse(config.GetWorkflowSizeLimit())
@@ -10,6 +10,7 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
 	"github.com/stretchr/testify/assert"
 )
@@ -79,3 +80,36 @@ func TestValidateCompiledWorkflow(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.EqualError(t, err, "Workflow closure size exceeds max limit [1]")
 }
+
+func TestValidateWorkflowFromPartsCreateRequest(t *testing.T) {
+	identifier := testutils.GetWorkflowRequest().Id
+	request := interfaces.WorkflowFromPartsCreateRequest{
+		Id:         identifier,
+		PartURLs:   []string{"s3://bucket/uploads/project/domain/digest/part-0"},
+		ContentMD5: make([]byte, 16),
+	}
+	assert.NoError(t, ValidateWorkflowFromPartsCreateRequest(request, "s3://bucket/uploads/"))
+	assert.NoError(t, ValidateWorkflowFromPartsCreateRequest(request, "s3://bucket/uploads"))
+
+	for name, partURL := range map[string]string{
+		"other domain":   "s3://bucket/uploads/project/production/digest/part-0",
+		"outside prefix": "s3://bucket/project/domain/digest/part-0",
+		"traversal":      "s3://bucket/uploads/project/domain/../../other/domain/part-0",
+	} {
+		t.Run(name, func(t *testing.T) {
+			partsRequest := request
+			partsRequest.PartURLs = []string{partURL}
+			assert.Error(t, ValidateWorkflowFromPartsCreateRequest(partsRequest, "s3://bucket/uploads"))
+		})
+	}
+
+	noParts := request
+	noParts.PartURLs = nil
+	assert.EqualError(t, ValidateWorkflowFromPartsCreateRequest(noParts, "s3://bucket/uploads"),
+		"missing part_urls")
+
+	shortDigest := request
+	shortDigest.ContentMD5 = []byte("digest")
+	assert.EqualError(t, ValidateWorkflowFromPartsCreateRequest(shortDigest, "s3://bucket/uploads"),
+		"invalid value for content_md5")
+}
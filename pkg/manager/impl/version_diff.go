@@ -0,0 +1,133 @@
+package impl
+
+import (
+	"sort"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+)
+
+// Returns the sorted names of the variables added, removed or changed to a different type between two variable maps.
+func diffVariables(base, target *core.VariableMap) (added, removed, changed []string) {
+	baseVariables := base.GetVariables()
+	targetVariables := target.GetVariables()
+	for name, targetVariable := range targetVariables {
+		baseVariable, ok := baseVariables[name]
+		if !ok {
+			added = append(added, name)
+		} else if !proto.Equal(baseVariable.GetType(), targetVariable.GetType()) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range baseVariables {
+		if _, ok := targetVariables[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+	return added, removed, changed
+}
+
+func diffInterfaces(base, target *core.TypedInterface) interfaces.InterfaceDiff {
+	var diff interfaces.InterfaceDiff
+	diff.AddedInputs, diff.RemovedInputs, diff.ChangedInputs = diffVariables(base.GetInputs(), target.GetInputs())
+	diff.AddedOutputs, diff.RemovedOutputs, diff.ChangedOutputs = diffVariables(base.GetOutputs(), target.GetOutputs())
+	return diff
+}
+
+func isInterfaceDiffEmpty(diff interfaces.InterfaceDiff) bool {
+	return len(diff.AddedInputs) == 0 && len(diff.RemovedInputs) == 0 && len(diff.ChangedInputs) == 0 &&
+		len(diff.AddedOutputs) == 0 && len(diff.RemovedOutputs) == 0 && len(diff.ChangedOutputs) == 0
+}
+
+func diffStrings(base, target string) *interfaces.StringChange {
+	if base == target {
+		return nil
+	}
+	return &interfaces.StringChange{
+		Base:   base,
+		Target: target,
+	}
+}
+
+func diffTaskTemplates(base, target *core.TaskTemplate) *interfaces.TaskDiff {
+	diff := &interfaces.TaskDiff{
+		BaseID:    base.GetId(),
+		TargetID:  target.GetId(),
+		Interface: diffInterfaces(base.GetInterface(), target.GetInterface()),
+		Type:      diffStrings(base.GetType(), target.GetType()),
+		Image:     diffStrings(base.GetContainer().GetImage(), target.GetContainer().GetImage()),
+	}
+	baseResources := base.GetContainer().GetResources()
+	targetResources := target.GetContainer().GetResources()
+	if !proto.Equal(baseResources, targetResources) {
+		diff.Resources = &interfaces.ResourcesChange{
+			Base:   baseResources,
+			Target: targetResources,
+		}
+	}
+	return diff
+}
+
+func isTaskDiffEmpty(diff *interfaces.TaskDiff) bool {
+	return isInterfaceDiffEmpty(diff.Interface) && diff.Type == nil && diff.Image == nil && diff.Resources == nil
+}
+
+// Returns the differences between the primary workflows of two closures, and between the tasks both run.
+func diffWorkflowClosures(base, target *admin.WorkflowClosure) *interfaces.WorkflowDiff {
+	baseTemplate := base.GetCompiledWorkflow().GetPrimary().GetTemplate()
+	targetTemplate := target.GetCompiledWorkflow().GetPrimary().GetTemplate()
+	diff := &interfaces.WorkflowDiff{
+		BaseID:    baseTemplate.GetId(),
+		TargetID:  targetTemplate.GetId(),
+		Interface: diffInterfaces(baseTemplate.GetInterface(), targetTemplate.GetInterface()),
+	}
+
+	baseNodes := make(map[string]*core.Node, len(baseTemplate.GetNodes()))
+	for _, node := range baseTemplate.GetNodes() {
+		baseNodes[node.Id] = node
+	}
+	targetNodeIDs := make(map[string]bool, len(targetTemplate.GetNodes()))
+	for _, node := range targetTemplate.GetNodes() {
+		targetNodeIDs[node.Id] = true
+		baseNode, ok := baseNodes[node.Id]
+		if !ok {
+			diff.AddedNodes = append(diff.AddedNodes, node.Id)
+		} else if !proto.Equal(baseNode, node) {
+			diff.ChangedNodes = append(diff.ChangedNodes, node.Id)
+		}
+	}
+	for _, node := range baseTemplate.GetNodes() {
+		if !targetNodeIDs[node.Id] {
+			diff.RemovedNodes = append(diff.RemovedNodes, node.Id)
+		}
+	}
+	sort.Strings(diff.AddedNodes)
+	sort.Strings(diff.RemovedNodes)
+	sort.Strings(diff.ChangedNodes)
+
+	// Tasks are versioned along with the workflows which run them, so they're matched by everything but version.
+	baseTasks := make(map[string]*core.TaskTemplate, len(base.GetCompiledWorkflow().GetTasks()))
+	for _, task := range base.GetCompiledWorkflow().GetTasks() {
+		id := task.GetTemplate().GetId()
+		baseTasks[id.GetProject()+"/"+id.GetDomain()+"/"+id.GetName()] = task.GetTemplate()
+	}
+	for _, task := range target.GetCompiledWorkflow().GetTasks() {
+		id := task.GetTemplate().GetId()
+		baseTask, ok := baseTasks[id.GetProject()+"/"+id.GetDomain()+"/"+id.GetName()]
+		if !ok {
+			continue
+		}
+		if taskDiff := diffTaskTemplates(baseTask, task.GetTemplate()); !isTaskDiffEmpty(taskDiff) {
+			diff.Tasks = append(diff.Tasks, taskDiff)
+		}
+	}
+	sort.Slice(diff.Tasks, func(i, j int) bool {
+		return diff.Tasks[i].TargetID.GetName() < diff.Tasks[j].TargetID.GetName()
+	})
+	return diff
+}
@@ -0,0 +1,82 @@
+package impl
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+var integerType = &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}
+
+func TestDiffTaskTemplates(t *testing.T) {
+	base := testutils.GetValidTaskRequest().Spec.Template
+	target := testutils.GetValidTaskRequest().Spec.Template
+	assert.True(t, isTaskDiffEmpty(diffTaskTemplates(base, target)))
+
+	target.Id.Version = "version 2"
+	target.GetContainer().Image = "image:2"
+	target.GetContainer().Resources = &core.Resources{
+		Requests: []*core.Resources_ResourceEntry{
+			{
+				Name:  core.Resources_MEMORY,
+				Value: "1Gi",
+			},
+		},
+	}
+	target.Interface = &core.TypedInterface{
+		Inputs: &core.VariableMap{
+			Variables: map[string]*core.Variable{
+				"retries": {Type: integerType},
+			},
+		},
+	}
+	diff := diffTaskTemplates(base, target)
+	assert.Equal(t, "version 2", diff.TargetID.Version)
+	assert.Equal(t, "image", diff.Image.Base)
+	assert.Equal(t, "image:2", diff.Image.Target)
+	assert.Nil(t, diff.Resources.Base)
+	assert.Equal(t, "1Gi", diff.Resources.Target.Requests[0].Value)
+	assert.Equal(t, []string{"retries"}, diff.Interface.AddedInputs)
+	assert.Nil(t, diff.Type)
+}
+
+func TestDiffWorkflowClosures(t *testing.T) {
+	base := testutils.GetWorkflowClosure()
+	target := testutils.GetWorkflowClosure()
+	targetTemplate := target.CompiledWorkflow.Primary.Template
+	targetTemplate.Nodes = []*core.Node{
+		{
+			Id: "node 1",
+			Metadata: &core.NodeMetadata{
+				Name: "renamed",
+			},
+		},
+		{
+			Id: "node 3",
+		},
+	}
+	targetTemplate.Interface.Inputs.Variables["foo"] = &core.Variable{Type: integerType}
+	delete(targetTemplate.Interface.Outputs.Variables, "bar")
+	target.CompiledWorkflow.Tasks[0].Template.GetContainer().Image = "image:2"
+	target.CompiledWorkflow.Tasks = append(target.CompiledWorkflow.Tasks, &core.CompiledTask{
+		Template: &core.TaskTemplate{
+			Id: &core.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "new task",
+			},
+		},
+	})
+
+	diff := diffWorkflowClosures(base, target)
+	assert.Equal(t, []string{"node 3"}, diff.AddedNodes)
+	assert.Equal(t, []string{"node 2"}, diff.RemovedNodes)
+	assert.Equal(t, []string{"node 1"}, diff.ChangedNodes)
+	assert.Equal(t, []string{"foo"}, diff.Interface.ChangedInputs)
+	assert.Equal(t, []string{"bar"}, diff.Interface.RemovedOutputs)
+	// Only tasks both versions run are compared.
+	assert.Len(t, diff.Tasks, 1)
+	assert.Equal(t, "image:2", diff.Tasks[0].Image.Target)
+}
@@ -0,0 +1,299 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repoInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	webhookNameColumn   = "webhook_name"
+	executionNameColumn = "execution_name"
+	statusColumn        = "status"
+)
+
+type WebhookManager struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+}
+
+func joinWebhookPhases(phases []core.WorkflowExecution_Phase) string {
+	phaseNames := make([]string, 0, len(phases))
+	for _, phase := range phases {
+		phaseNames = append(phaseNames, phase.String())
+	}
+	return strings.Join(phaseNames, ",")
+}
+
+func splitWebhookPhases(phases string) []core.WorkflowExecution_Phase {
+	webhookPhases := make([]core.WorkflowExecution_Phase, 0)
+	for _, phaseName := range strings.Split(phases, ",") {
+		if phase, ok := core.WorkflowExecution_Phase_value[phaseName]; ok {
+			webhookPhases = append(webhookPhases, core.WorkflowExecution_Phase(phase))
+		}
+	}
+	return webhookPhases
+}
+
+// Returns whether executions reaching a phase are delivered to a webhook.
+func isWebhookPhase(webhook models.Webhook, phase core.WorkflowExecution_Phase) bool {
+	for _, webhookPhase := range splitWebhookPhases(webhook.Phases) {
+		if webhookPhase == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// The secret of a webhook is never returned.
+func toWebhook(webhookModel models.Webhook) *interfaces.Webhook {
+	return &interfaces.Webhook{
+		Id: &admin.NamedEntityIdentifier{
+			Project: webhookModel.Project,
+			Domain:  webhookModel.Domain,
+			Name:    webhookModel.Name,
+		},
+		URL:       webhookModel.URL,
+		Template:  webhookModel.Template,
+		Phases:    splitWebhookPhases(webhookModel.Phases),
+		Principal: webhookModel.Principal,
+		CreatedAt: webhookModel.CreatedAt,
+		UpdatedAt: webhookModel.UpdatedAt,
+	}
+}
+
+func toWebhookDelivery(deliveryModel models.WebhookDelivery) *interfaces.WebhookDelivery {
+	return &interfaces.WebhookDelivery{
+		Id: deliveryModel.ID,
+		Execution: &core.WorkflowExecutionIdentifier{
+			Project: deliveryModel.Project,
+			Domain:  deliveryModel.Domain,
+			Name:    deliveryModel.ExecutionName,
+		},
+		Phase:        core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[deliveryModel.Phase]),
+		Status:       deliveryModel.Status,
+		Attempts:     deliveryModel.Attempts,
+		ResponseCode: deliveryModel.ResponseCode,
+		Error:        deliveryModel.LastError,
+		CreatedAt:    deliveryModel.CreatedAt,
+		UpdatedAt:    deliveryModel.UpdatedAt,
+	}
+}
+
+func getWebhookIdentifier(id admin.NamedEntityIdentifier) repoInterfaces.Identifier {
+	return repoInterfaces.Identifier{
+		Project: id.Project,
+		Domain:  id.Domain,
+		Name:    id.Name,
+	}
+}
+
+func (m *WebhookManager) RegisterWebhook(
+	ctx context.Context, request interfaces.RegisterWebhookRequest) (*interfaces.Webhook, error) {
+	if err := validation.ValidateRegisterWebhookRequest(request,
+		m.config.ApplicationConfiguration().GetNotificationsConfig().RegisteredWebhooksConfig); err != nil {
+		logger.Debugf(ctx, "invalid register webhook request [%+v]: %v", request.Id, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Id.Project, request.Id.Domain)
+	if err := validation.ValidateProjectAndDomain(ctx, m.db, m.config.ApplicationConfiguration(),
+		request.Id.Project, request.Id.Domain); err != nil {
+		return nil, err
+	}
+	webhookModel := models.Webhook{
+		WebhookKey: models.WebhookKey{
+			Project: request.Id.Project,
+			Domain:  request.Id.Domain,
+			Name:    request.Id.Name,
+		},
+		URL:       request.URL,
+		Secret:    request.Secret,
+		Template:  request.Template,
+		Phases:    joinWebhookPhases(request.Phases),
+		Principal: getUser(ctx),
+	}
+	_, err := m.db.WebhookRepo().Get(ctx, getWebhookIdentifier(request.Id))
+	if err == nil {
+		err = m.db.WebhookRepo().Update(ctx, webhookModel)
+	} else if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.NotFound {
+		err = m.db.WebhookRepo().Create(ctx, webhookModel)
+	}
+	if err != nil {
+		logger.Debugf(ctx, "failed to register webhook [%+v] with err: %v", request.Id, err)
+		return nil, err
+	}
+	webhookModel, err = m.db.WebhookRepo().Get(ctx, getWebhookIdentifier(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	return toWebhook(webhookModel), nil
+}
+
+func (m *WebhookManager) GetWebhook(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.Webhook, error) {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	webhookModel, err := m.db.WebhookRepo().Get(ctx, getWebhookIdentifier(id))
+	if err != nil {
+		return nil, err
+	}
+	return toWebhook(webhookModel), nil
+}
+
+func (m *WebhookManager) ListWebhooks(
+	ctx context.Context, request interfaces.ListWebhooksRequest) (*interfaces.WebhookList, error) {
+	if err := validation.ValidateEmptyStringField(request.Project, shared.Project); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateEmptyStringField(request.Domain, shared.Domain); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Project, request.Domain)
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: request.Project,
+		Domain:  request.Domain,
+	}, common.Webhook)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListWebhooks", request.Token)
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       shared.Name,
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	output, err := m.db.WebhookRepo().List(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to list webhooks for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	webhooks := make([]*interfaces.Webhook, 0, len(output.Webhooks))
+	for _, webhookModel := range output.Webhooks {
+		webhooks = append(webhooks, toWebhook(webhookModel))
+	}
+	var token string
+	if len(output.Webhooks) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(output.Webhooks))
+	}
+	return &interfaces.WebhookList{
+		Webhooks: webhooks,
+		Token:    token,
+	}, nil
+}
+
+func (m *WebhookManager) DeleteWebhook(ctx context.Context, id admin.NamedEntityIdentifier) error {
+	if err := validation.ValidateNamedEntityIdentifier(&id); err != nil {
+		return err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, id.Project, id.Domain)
+	return m.db.WebhookRepo().Delete(ctx, getWebhookIdentifier(id))
+}
+
+func (m *WebhookManager) ListWebhookDeliveries(
+	ctx context.Context, request interfaces.ListWebhookDeliveriesRequest) (*interfaces.WebhookDeliveryList, error) {
+	if err := validation.ValidateNamedEntityIdentifier(&request.Id); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateLimit(request.Limit); err != nil {
+		return nil, err
+	}
+	if err := validateDeliveryStatus(request.Status); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.Id.Project, request.Id.Domain)
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project: request.Id.Project,
+		Domain:  request.Id.Domain,
+	}, common.WebhookDelivery)
+	if err != nil {
+		return nil, err
+	}
+	equalFilters := map[string]string{
+		webhookNameColumn:   request.Id.Name,
+		executionNameColumn: request.ExecutionName,
+		statusColumn:        request.Status,
+	}
+	for _, column := range []string{webhookNameColumn, executionNameColumn, statusColumn} {
+		if len(equalFilters[column]) == 0 {
+			continue
+		}
+		filter, err := common.NewSingleValueFilter(common.WebhookDelivery, common.Equal, column, equalFilters[column])
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	offset, err := validation.ValidateToken(request.Token)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListWebhookDeliveries", request.Token)
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       "created_at",
+		Direction: admin.Sort_DESCENDING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	deliveryModels, err := m.db.WebhookRepo().ListDeliveries(ctx, repoInterfaces.ListResourceInput{
+		Limit:         int(request.Limit),
+		Offset:        offset,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "failed to list webhook deliveries for request [%+v] with err: %v", request, err)
+		return nil, err
+	}
+	deliveries := make([]*interfaces.WebhookDelivery, 0, len(deliveryModels))
+	for _, deliveryModel := range deliveryModels {
+		deliveries = append(deliveries, toWebhookDelivery(deliveryModel))
+	}
+	var token string
+	if len(deliveryModels) == int(request.Limit) {
+		token = strconv.Itoa(offset + len(deliveryModels))
+	}
+	return &interfaces.WebhookDeliveryList{
+		Deliveries: deliveries,
+		Token:      token,
+	}, nil
+}
+
+func NewWebhookManager(
+	db repositories.RepositoryInterface, config runtimeInterfaces.Configuration) interfaces.WebhookInterface {
+	return &WebhookManager{
+		db:     db,
+		config: config,
+	}
+}
@@ -0,0 +1,191 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var webhookID = admin.NamedEntityIdentifier{
+	Project: "project",
+	Domain:  "development",
+	Name:    "alerts",
+}
+
+func getWebhookModel() models.Webhook {
+	return models.Webhook{
+		WebhookKey: models.WebhookKey{
+			Project: webhookID.Project,
+			Domain:  webhookID.Domain,
+			Name:    webhookID.Name,
+		},
+		URL:       "https://example.com/hooks/alerts",
+		Secret:    "secret",
+		Template:  `{"execution": {{ json .Name }}}`,
+		Phases:    "FAILED,TIMED_OUT",
+		Principal: "user",
+	}
+}
+
+func getRegisterWebhookRequest() interfaces.RegisterWebhookRequest {
+	return interfaces.RegisterWebhookRequest{
+		Id:       webhookID,
+		URL:      "https://example.com/hooks/alerts",
+		Secret:   "secret",
+		Template: `{"execution": {{ json .Name }}}`,
+		Phases:   []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED, core.WorkflowExecution_TIMED_OUT},
+	}
+}
+
+func TestIsWebhookPhase(t *testing.T) {
+	webhook := getWebhookModel()
+	assert.True(t, isWebhookPhase(webhook, core.WorkflowExecution_FAILED))
+	assert.True(t, isWebhookPhase(webhook, core.WorkflowExecution_TIMED_OUT))
+	assert.False(t, isWebhookPhase(webhook, core.WorkflowExecution_SUCCEEDED))
+}
+
+func TestRegisterWebhook_Create(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	webhookRepo := repository.WebhookRepo().(*repositoryMocks.WebhookRepoInterface)
+	webhookRepo.OnGetMatch(mock.Anything, mock.Anything).Return(
+		models.Webhook{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")).Once()
+	webhookRepo.OnGetMatch(mock.Anything, mock.Anything).Return(getWebhookModel(), nil)
+	var created models.Webhook
+	webhookRepo.OnCreateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		created = args.Get(1).(models.Webhook)
+	})
+	webhookManager := NewWebhookManager(repository, getMockExecutionsConfigProvider())
+
+	ctx := auth.NewIdentityContext("", "user", "", time.Now(), sets.NewString(), nil).WithContext(context.Background())
+	webhook, err := webhookManager.RegisterWebhook(ctx, getRegisterWebhookRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, "alerts", created.Name)
+	assert.Equal(t, "secret", created.Secret)
+	assert.Equal(t, "FAILED,TIMED_OUT", created.Phases)
+	assert.Equal(t, "user", created.Principal)
+	webhookRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+	assert.Equal(t, "alerts", webhook.Id.Name)
+	assert.Equal(t, "https://example.com/hooks/alerts", webhook.URL)
+	assert.Equal(t, []core.WorkflowExecution_Phase{core.WorkflowExecution_FAILED, core.WorkflowExecution_TIMED_OUT},
+		webhook.Phases)
+	assert.Equal(t, "user", webhook.Principal)
+}
+
+func TestRegisterWebhook_Update(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	webhookRepo := repository.WebhookRepo().(*repositoryMocks.WebhookRepoInterface)
+	webhookRepo.OnGetMatch(mock.Anything, mock.Anything).Return(getWebhookModel(), nil)
+	var updated models.Webhook
+	webhookRepo.OnUpdateMatch(mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updated = args.Get(1).(models.Webhook)
+	})
+	webhookManager := NewWebhookManager(repository, getMockExecutionsConfigProvider())
+
+	request := getRegisterWebhookRequest()
+	request.URL = "https://example.com/hooks/other"
+	_, err := webhookManager.RegisterWebhook(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/hooks/other", updated.URL)
+	webhookRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestRegisterWebhook_Invalid(t *testing.T) {
+	webhookManager := NewWebhookManager(repositoryMocks.NewMockRepository(), getMockExecutionsConfigProvider())
+	request := getRegisterWebhookRequest()
+	request.Phases = []core.WorkflowExecution_Phase{core.WorkflowExecution_RUNNING}
+	_, err := webhookManager.RegisterWebhook(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestListWebhooks(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.WebhookRepo().(*repositoryMocks.WebhookRepoInterface).OnListMatch(mock.Anything, mock.MatchedBy(
+		func(input repositoryInterfaces.ListResourceInput) bool {
+			return input.Limit == 1 && input.Offset == 2
+		})).Return(repositoryInterfaces.WebhookCollectionOutput{
+		Webhooks: []models.Webhook{getWebhookModel()},
+	}, nil)
+	webhookManager := NewWebhookManager(repository, getMockExecutionsConfigProvider())
+
+	webhooks, err := webhookManager.ListWebhooks(context.Background(), interfaces.ListWebhooksRequest{
+		Project: "project",
+		Domain:  "development",
+		Limit:   1,
+		Token:   "2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", webhooks.Token)
+	assert.Len(t, webhooks.Webhooks, 1)
+	assert.Equal(t, "alerts", webhooks.Webhooks[0].Id.Name)
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.WebhookRepo().(*repositoryMocks.WebhookRepoInterface).OnListDeliveriesMatch(mock.Anything,
+		mock.MatchedBy(func(input repositoryInterfaces.ListResourceInput) bool {
+			// The project, domain, webhook name and status filters.
+			return input.Limit == 10 && len(input.InlineFilters) == 4
+		})).Return([]models.WebhookDelivery{
+		{
+			ID:            1,
+			Project:       webhookID.Project,
+			Domain:        webhookID.Domain,
+			WebhookName:   webhookID.Name,
+			ExecutionName: "execution",
+			Phase:         "FAILED",
+			Status:        common.DeliveryFailed,
+			Attempts:      3,
+			ResponseCode:  503,
+			LastError:     "webhook responded with status 503",
+		},
+	}, nil)
+	webhookManager := NewWebhookManager(repository, getMockExecutionsConfigProvider())
+
+	deliveries, err := webhookManager.ListWebhookDeliveries(context.Background(),
+		interfaces.ListWebhookDeliveriesRequest{
+			Id:     webhookID,
+			Status: common.DeliveryFailed,
+			Limit:  10,
+		})
+	assert.NoError(t, err)
+	assert.Empty(t, deliveries.Token)
+	assert.Len(t, deliveries.Deliveries, 1)
+	assert.Equal(t, "execution", deliveries.Deliveries[0].Execution.Name)
+	assert.Equal(t, core.WorkflowExecution_FAILED, deliveries.Deliveries[0].Phase)
+	assert.Equal(t, 3, deliveries.Deliveries[0].Attempts)
+	assert.Equal(t, 503, deliveries.Deliveries[0].ResponseCode)
+
+	_, err = webhookManager.ListWebhookDeliveries(context.Background(), interfaces.ListWebhookDeliveriesRequest{
+		Id:     webhookID,
+		Status: "RUNNING",
+		Limit:  10,
+	})
+	assert.EqualError(t, err, "invalid delivery status [RUNNING]")
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.WebhookRepo().(*repositoryMocks.WebhookRepoInterface).OnDeleteMatch(mock.Anything,
+		repositoryInterfaces.Identifier{
+			Project: "project",
+			Domain:  "development",
+			Name:    "alerts",
+		}).Return(nil)
+	webhookManager := NewWebhookManager(repository, getMockExecutionsConfigProvider())
+	assert.NoError(t, webhookManager.DeleteWebhook(context.Background(), webhookID))
+}
@@ -0,0 +1,180 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	workflowengine "github.com/flyteorg/flyteadmin/pkg/workflowengine/impl"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	compiler "github.com/flyteorg/flytepropeller/pkg/compiler/common"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+)
+
+const compilerModulePath = "github.com/flyteorg/flytepropeller"
+
+// The version of the compiler module admin was built with, or unknown for builds without module information.
+var compilerVersion = getCompilerVersion()
+
+func getCompilerVersion() string {
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	for _, module := range buildInfo.Deps {
+		if module.Path != compilerModulePath {
+			continue
+		}
+		if module.Replace != nil {
+			return module.Replace.Version
+		}
+		return module.Version
+	}
+	return "unknown"
+}
+
+func getTaskKey(id *core.Identifier) string {
+	return fmt.Sprintf("%s/%s/%s/%s", id.Project, id.Domain, id.Name, id.Version)
+}
+
+func isNotFound(err error) bool {
+	adminErr, ok := err.(errors.FlyteAdminError)
+	return ok && adminErr.Code() == codes.NotFound
+}
+
+// Resolves the tasks the workflow requires from those of the request, or else from those registered. Tasks which
+// can't be resolved are reported as diagnostics.
+func (w *WorkflowManager) resolveCompileTasks(ctx context.Context, taskIDs []core.Identifier,
+	requestTasks map[string]*core.CompiledTask) ([]*core.CompiledTask, []interfaces.CompileDiagnostic, error) {
+	var diagnostics []interfaces.CompileDiagnostic
+	tasks := make([]*core.CompiledTask, 0, len(taskIDs))
+	for i := range taskIDs {
+		taskID := taskIDs[i]
+		if task, ok := requestTasks[getTaskKey(&taskID)]; ok {
+			tasks = append(tasks, task)
+			continue
+		}
+		task, err := util.GetTask(ctx, w.db, taskID)
+		if isNotFound(err) {
+			diagnostics = append(diagnostics, interfaces.CompileDiagnostic{
+				Id:      &taskID,
+				Message: "the task isn't registered or given along with the workflow",
+			})
+			continue
+		} else if err != nil {
+			logger.Debugf(ctx, "Failed to get task with id [%+v] when compiling workflow with err %v", taskID, err)
+			return nil, nil, err
+		}
+		tasks = append(tasks, task.Closure.CompiledTask)
+	}
+	return tasks, diagnostics, nil
+}
+
+func (w *WorkflowManager) resolveCompileLaunchPlans(ctx context.Context, launchPlanIDs []core.Identifier) (
+	[]compiler.InterfaceProvider, []interfaces.CompileDiagnostic, error) {
+	var diagnostics []interfaces.CompileDiagnostic
+	launchPlans := make([]compiler.InterfaceProvider, 0, len(launchPlanIDs))
+	for i := range launchPlanIDs {
+		launchPlanID := launchPlanIDs[i]
+		launchPlanModel, err := util.GetLaunchPlanModel(ctx, w.db, launchPlanID)
+		if isNotFound(err) {
+			diagnostics = append(diagnostics, interfaces.CompileDiagnostic{
+				Id:      &launchPlanID,
+				Message: "the launch plan isn't registered",
+			})
+			continue
+		} else if err != nil {
+			logger.Debugf(ctx, "Failed to get launch plan with id [%+v] when compiling workflow with err %v",
+				launchPlanID, err)
+			return nil, nil, err
+		}
+		launchPlan, err := workflowengine.NewLaunchPlanInterfaceProvider(launchPlanModel, launchPlanID)
+		if err != nil {
+			return nil, nil, err
+		}
+		launchPlans = append(launchPlans, launchPlan)
+	}
+	return launchPlans, diagnostics, nil
+}
+
+func (w *WorkflowManager) CompileWorkflow(ctx context.Context, request interfaces.WorkflowCompileRequest) (
+	*interfaces.WorkflowCompileResponse, error) {
+	if err := validation.ValidateWorkflowCompileRequest(
+		ctx, request, w.db, w.config.ApplicationConfiguration()); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = getWorkflowContext(ctx, request.Id)
+	if err := checkProjectVisible(ctx, request.Id.Project); err != nil {
+		return nil, err
+	}
+	response := &interfaces.WorkflowCompileResponse{
+		CompilerVersion: compilerVersion,
+	}
+
+	requestTasks := make(map[string]*core.CompiledTask, len(request.Tasks))
+	for _, task := range request.Tasks {
+		compiledTask, err := w.compiler.CompileTask(task.Template)
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, interfaces.CompileDiagnostic{
+				Id:      task.Template.Id,
+				Message: err.Error(),
+			})
+			continue
+		}
+		requestTasks[getTaskKey(task.Template.Id)] = compiledTask
+		response.CompiledTasks = append(response.CompiledTasks, compiledTask)
+	}
+
+	// Workflows are compiled with the identifier they'd be registered with.
+	template := proto.Clone(request.Spec.Template).(*core.WorkflowTemplate)
+	template.Id = request.Id
+	reqs, err := w.compiler.GetRequirements(template, request.Spec.SubWorkflows)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, interfaces.CompileDiagnostic{
+			Id:      request.Id,
+			Message: err.Error(),
+		})
+		return response, nil
+	}
+	tasks, diagnostics, err := w.resolveCompileTasks(ctx, reqs.GetRequiredTaskIds(), requestTasks)
+	if err != nil {
+		return nil, err
+	}
+	response.Diagnostics = append(response.Diagnostics, diagnostics...)
+	launchPlans, diagnostics, err := w.resolveCompileLaunchPlans(ctx, reqs.GetRequiredLaunchPlanIds())
+	if err != nil {
+		return nil, err
+	}
+	response.Diagnostics = append(response.Diagnostics, diagnostics...)
+	if len(response.Diagnostics) > 0 {
+		return response, nil
+	}
+
+	closure, err := w.compiler.CompileWorkflow(template, request.Spec.SubWorkflows, tasks, launchPlans)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, interfaces.CompileDiagnostic{
+			Id:      request.Id,
+			Message: err.Error(),
+		})
+		return response, nil
+	}
+	// Workflows which compile may still exceed the limits registration enforces.
+	if err := validation.ValidateCompiledWorkflow(*request.Id, admin.WorkflowClosure{CompiledWorkflow: closure},
+		w.config.RegistrationValidationConfiguration()); err != nil {
+		response.Diagnostics = append(response.Diagnostics, interfaces.CompileDiagnostic{
+			Id:      request.Id,
+			Message: err.Error(),
+		})
+		return response, nil
+	}
+	response.CompiledWorkflow = closure
+	return response, nil
+}
@@ -0,0 +1,117 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	workflowengineMocks "github.com/flyteorg/flyteadmin/pkg/workflowengine/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	engine "github.com/flyteorg/flytepropeller/pkg/compiler/common"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func getWorkflowCompileRequest() managerInterfaces.WorkflowCompileRequest {
+	workflowRequest := testutils.GetWorkflowRequest()
+	taskRequest := testutils.GetValidTaskRequest()
+	return managerInterfaces.WorkflowCompileRequest{
+		Id:    workflowRequest.Id,
+		Spec:  workflowRequest.Spec,
+		Tasks: []*admin.TaskSpec{taskRequest.Spec},
+	}
+}
+
+func getWorkflowManagerForCompileTest(t *testing.T) (
+	managerInterfaces.WorkflowInterface, *workflowengineMocks.MockCompiler) {
+	repository := getMockRepository(returnWorkflowOnGet)
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetCreateCallback(func(input models.Workflow) error {
+		t.Fatal("compiling a workflow registered it")
+		return nil
+	})
+	mockCompiler := getMockWorkflowCompiler()
+	mockCompiler.(*workflowengineMocks.MockCompiler).AddCompileTaskCallback(
+		func(task *core.TaskTemplate) (*core.CompiledTask, error) {
+			return &core.CompiledTask{Template: task}, nil
+		})
+	workflowManager := NewWorkflowManager(repository, getMockWorkflowConfigProvider(), mockCompiler, getMockStorage(),
+		storagePrefix, mockScope.NewTestScope())
+	return workflowManager, mockCompiler.(*workflowengineMocks.MockCompiler)
+}
+
+func TestCompileWorkflow(t *testing.T) {
+	workflowManager, _ := getWorkflowManagerForCompileTest(t)
+	request := getWorkflowCompileRequest()
+	request.Spec.Template.Id = nil
+
+	response, err := workflowManager.CompileWorkflow(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Empty(t, response.Diagnostics)
+	assert.True(t, proto.Equal(request.Id, response.CompiledWorkflow.Primary.Template.Id))
+	assert.Len(t, response.CompiledTasks, 1)
+	assert.NotEmpty(t, response.CompilerVersion)
+	// The workflow spec of the request is left as is.
+	assert.Nil(t, request.Spec.Template.Id)
+}
+
+func TestCompileWorkflow_TaskDiagnostics(t *testing.T) {
+	workflowManager, mockCompiler := getWorkflowManagerForCompileTest(t)
+	mockCompiler.AddCompileTaskCallback(func(task *core.TaskTemplate) (*core.CompiledTask, error) {
+		return nil, errors.New("task has no interface")
+	})
+	compiledWorkflow := false
+	mockCompiler.AddCompileWorkflowCallback(func(
+		primaryWf *core.WorkflowTemplate, subworkflows []*core.WorkflowTemplate, tasks []*core.CompiledTask,
+		launchPlans []engine.InterfaceProvider) (*core.CompiledWorkflowClosure, error) {
+		compiledWorkflow = true
+		return &core.CompiledWorkflowClosure{}, nil
+	})
+	request := getWorkflowCompileRequest()
+
+	response, err := workflowManager.CompileWorkflow(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Nil(t, response.CompiledWorkflow)
+	assert.Empty(t, response.CompiledTasks)
+	assert.Len(t, response.Diagnostics, 1)
+	assert.True(t, proto.Equal(request.Tasks[0].Template.Id, response.Diagnostics[0].Id))
+	assert.Equal(t, "task has no interface", response.Diagnostics[0].Message)
+	assert.False(t, compiledWorkflow)
+}
+
+func TestCompileWorkflow_WorkflowDiagnostics(t *testing.T) {
+	workflowManager, mockCompiler := getWorkflowManagerForCompileTest(t)
+	mockCompiler.AddCompileWorkflowCallback(func(
+		primaryWf *core.WorkflowTemplate, subworkflows []*core.WorkflowTemplate, tasks []*core.CompiledTask,
+		launchPlans []engine.InterfaceProvider) (*core.CompiledWorkflowClosure, error) {
+		return nil, errors.New("node n0 has no upstream nodes")
+	})
+	request := getWorkflowCompileRequest()
+
+	response, err := workflowManager.CompileWorkflow(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Nil(t, response.CompiledWorkflow)
+	assert.Len(t, response.CompiledTasks, 1)
+	assert.Equal(t, []managerInterfaces.CompileDiagnostic{
+		{
+			Id:      request.Id,
+			Message: "node n0 has no upstream nodes",
+		},
+	}, response.Diagnostics)
+}
+
+func TestCompileWorkflow_InvalidRequest(t *testing.T) {
+	workflowManager, _ := getWorkflowManagerForCompileTest(t)
+	request := getWorkflowCompileRequest()
+	request.Spec = nil
+
+	_, err := workflowManager.CompileWorkflow(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+}
@@ -3,6 +3,9 @@ package impl
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
 	"strconv"
 	"time"
 
@@ -26,17 +29,24 @@ import (
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 )
 
 var defaultStorageOptions = storage.Options{}
 
+// The gRPC metadata workflow registration warnings are returned in.
+const registrationWarningHeader = "flyte-registration-warning"
+
 type workflowMetrics struct {
 	Scope                   promutils.Scope
 	CompilationFailures     prometheus.Counter
 	TypedInterfaceSizeBytes prometheus.Summary
+	OversizedWorkflows      prometheus.Counter
 }
 
 type WorkflowManager struct {
@@ -131,6 +141,70 @@ func (w *WorkflowManager) createDataReference(
 	return w.storageClient.ConstructReference(ctx, w.storageClient.GetBaseContainerFQN(ctx), nestedKeys...)
 }
 
+// Offloads the compiled workflow closure to the blob store and returns its location, unless offloading is limited to
+// closures of at least a threshold size and it's smaller, in which case it's returned serialized to store in the
+// database instead.
+func (w *WorkflowManager) storeWorkflowClosure(ctx context.Context, identifier *core.Identifier,
+	workflowClosure *admin.WorkflowClosure) (string, []byte, error) {
+	offloadingConfig := w.config.ApplicationConfiguration().GetTopLevelConfig().GetWorkflowClosureOffloadingConfig()
+	if offloadingConfig.Enabled {
+		serializedClosure, err := proto.Marshal(workflowClosure)
+		if err != nil {
+			logger.Errorf(ctx, "Failed to marshal compiled workflow closure [%+v] with err: %v", identifier, err)
+			return "", nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to marshal compiled workflow closure [%+v] with err %v", identifier, err)
+		}
+		if int64(len(serializedClosure)) < offloadingConfig.ThresholdBytes {
+			return "", serializedClosure, nil
+		}
+	}
+	remoteClosureDataRef, err := w.createDataReference(ctx, identifier)
+	if err != nil {
+		logger.Infof(ctx, "failed to construct data reference for workflow closure with id [%+v] with err %v",
+			identifier, err)
+		return "", nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to construct data reference for workflow closure with id [%+v] and err %v", identifier, err)
+	}
+	err = w.storageClient.WriteProtobuf(ctx, remoteClosureDataRef, defaultStorageOptions, workflowClosure)
+	if err != nil {
+		logger.Infof(ctx,
+			"failed to write marshaled workflow with id [%+v] to storage %s with err %v and base container: %s",
+			identifier, remoteClosureDataRef.String(), err, w.storageClient.GetBaseContainerFQN(ctx))
+		return "", nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to write marshaled workflow [%+v] to storage %s with err %v and base container: %s",
+			identifier, remoteClosureDataRef.String(), err, w.storageClient.GetBaseContainerFQN(ctx))
+	}
+	return remoteClosureDataRef.String(), nil, nil
+}
+
+// Warns when executions of the workflow would launch FlyteWorkflows which may exceed the object size limit of etcd.
+func (w *WorkflowManager) warnIfOversized(ctx context.Context, identifier *core.Identifier,
+	compiledWorkflow *core.CompiledWorkflowClosure) {
+	offloadingConfig := w.config.ApplicationConfiguration().GetTopLevelConfig().GetExecutorConfig().Offloading
+	// Large workflows are offloaded in every cluster.
+	if offloadingConfig.WarnThresholdBytes <= 0 || (offloadingConfig.Enabled && len(offloadingConfig.Clusters) == 0) {
+		return
+	}
+	size, err := workflowengine.EstimateFlyteWorkflowSize(compiledWorkflow)
+	if err != nil {
+		logger.Debugf(ctx, "Failed to estimate the size of workflow [%+v] with err %v", identifier, err)
+		return
+	}
+	if size < offloadingConfig.WarnThresholdBytes {
+		return
+	}
+	w.metrics.OversizedWorkflows.Inc()
+	warning := fmt.Sprintf("workflow [%s/%s/%s/%s] is launched as a FlyteWorkflow of about %d bytes, which may "+
+		"exceed the object size limit of execution clusters", identifier.Project, identifier.Domain,
+		identifier.Name, identifier.Version, size)
+	if offloadingConfig.Enabled {
+		warning += fmt.Sprintf(" other than %v, which offload it", offloadingConfig.Clusters)
+	}
+	logger.Warning(ctx, warning)
+	// Fails outside of a gRPC request, in which case there's no client to warn.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(registrationWarningHeader, warning))
+}
+
 func (w *WorkflowManager) CreateWorkflow(
 	ctx context.Context,
 	request admin.WorkflowCreateRequest) (*admin.WorkflowCreateResponse, error) {
@@ -155,6 +229,7 @@ func (w *WorkflowManager) CreateWorkflow(
 	if err != nil {
 		return nil, err
 	}
+	w.warnIfOversized(ctx, request.Id, workflowClosure.CompiledWorkflow)
 	workflowDigest, err := util.GetWorkflowDigest(ctx, workflowClosure.CompiledWorkflow)
 	if err != nil {
 		logger.Errorf(ctx, "failed to compute workflow digest with err %v", err)
@@ -178,32 +253,20 @@ func (w *WorkflowManager) CreateWorkflow(
 		return nil, err
 	}
 
-	remoteClosureDataRef, err := w.createDataReference(ctx, request.Spec.Template.Id)
-	if err != nil {
-		logger.Infof(ctx, "failed to construct data reference for workflow closure with id [%+v] with err %v",
-			request.Id, err)
-		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
-			"failed to construct data reference for workflow closure with id [%+v] and err %v", request.Id, err)
-	}
-	err = w.storageClient.WriteProtobuf(ctx, remoteClosureDataRef, defaultStorageOptions, &workflowClosure)
-
+	remoteClosureIdentifier, serializedClosure, err := w.storeWorkflowClosure(ctx, request.Id, &workflowClosure)
 	if err != nil {
-		logger.Infof(ctx,
-			"failed to write marshaled workflow with id [%+v] to storage %s with err %v and base container: %s",
-			request.Id, remoteClosureDataRef.String(), err, w.storageClient.GetBaseContainerFQN(ctx))
-		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
-			"failed to write marshaled workflow [%+v] to storage %s with err %v and base container: %s",
-			request.Id, remoteClosureDataRef.String(), err, w.storageClient.GetBaseContainerFQN(ctx))
+		return nil, err
 	}
-	// Save the workflow & its reference to the offloaded, compiled workflow in the database.
+	// Save the workflow & either its compiled closure or the reference to the offloaded one in the database.
 	workflowModel, err := transformers.CreateWorkflowModel(
-		finalizedRequest, remoteClosureDataRef.String(), workflowDigest)
+		finalizedRequest, remoteClosureIdentifier, workflowDigest)
 	if err != nil {
 		logger.Errorf(ctx,
 			"Failed to transform workflow model for request [%+v] and remoteClosureIdentifier [%s] with err: %v",
-			finalizedRequest, remoteClosureDataRef.String(), err)
+			finalizedRequest, remoteClosureIdentifier, err)
 		return nil, err
 	}
+	workflowModel.Closure = serializedClosure
 	if err = w.db.WorkflowRepo().Create(ctx, workflowModel); err != nil {
 		logger.Infof(ctx, "Failed to create workflow model [%+v] with err %v", request.Id, err)
 		return nil, err
@@ -212,6 +275,71 @@ func (w *WorkflowManager) CreateWorkflow(
 	return &admin.WorkflowCreateResponse{}, nil
 }
 
+// Concatenates the uploaded parts of a workflow spec, up to maxBytes when positive.
+func (w *WorkflowManager) readWorkflowSpecParts(ctx context.Context, partURLs []string, maxBytes int64) (
+	[]byte, error) {
+	var spec bytes.Buffer
+	for _, partURL := range partURLs {
+		reader, err := w.storageClient.ReadRaw(ctx, storage.DataReference(partURL))
+		if err != nil {
+			if storage.IsNotFound(err) {
+				return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "part [%s] hasn't been uploaded", partURL)
+			}
+			logger.Errorf(ctx, "Failed to read workflow spec part [%s] with err %v", partURL, err)
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to read part [%s]", partURL)
+		}
+		var part io.Reader = reader
+		if maxBytes > 0 {
+			// Reads a byte past the limit, to tell specs which exceed it apart from those which meet it.
+			part = io.LimitReader(reader, maxBytes-int64(spec.Len())+1)
+		}
+		_, err = spec.ReadFrom(part)
+		_ = reader.Close()
+		if err != nil {
+			logger.Errorf(ctx, "Failed to read workflow spec part [%s] with err %v", partURL, err)
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to read part [%s]", partURL)
+		}
+		if maxBytes > 0 && int64(spec.Len()) > maxBytes {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"the workflow spec exceeds the limit of %d bytes", maxBytes)
+		}
+	}
+	return spec.Bytes(), nil
+}
+
+func (w *WorkflowManager) CreateWorkflowFromParts(ctx context.Context,
+	request interfaces.WorkflowFromPartsCreateRequest) (*admin.WorkflowCreateResponse, error) {
+	dataProxyConfig := w.config.ApplicationConfiguration().GetTopLevelConfig().GetDataProxyConfig()
+	if !dataProxyConfig.Enabled || len(dataProxyConfig.UploadPrefix) == 0 {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "uploads through the data proxy aren't enabled")
+	}
+	if err := validation.ValidateWorkflowFromPartsCreateRequest(request, dataProxyConfig.UploadPrefix); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = getWorkflowContext(ctx, request.Id)
+	if err := checkProjectVisible(ctx, request.Id.Project); err != nil {
+		return nil, err
+	}
+	serializedSpec, err := w.readWorkflowSpecParts(ctx, request.PartURLs, dataProxyConfig.MaxWorkflowSpecBytes)
+	if err != nil {
+		return nil, err
+	}
+	if digest := md5.Sum(serializedSpec); !bytes.Equal(digest[:], request.ContentMD5) {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"the md5 digest of the assembled workflow spec doesn't match content_md5")
+	}
+	var spec admin.WorkflowSpec
+	if err := proto.Unmarshal(serializedSpec, &spec); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"the assembled parts aren't a workflow spec: %v", err)
+	}
+	return w.CreateWorkflow(ctx, admin.WorkflowCreateRequest{
+		Id:   request.Id,
+		Spec: &spec,
+	})
+}
+
 func (w *WorkflowManager) GetWorkflow(ctx context.Context, request admin.ObjectGetRequest) (*admin.Workflow, error) {
 	if err := validation.ValidateIdentifier(request.Id, common.Workflow); err != nil {
 		logger.Debugf(ctx, "invalid identifier [%+v]: %v", request.Id, err)
@@ -226,6 +354,21 @@ func (w *WorkflowManager) GetWorkflow(ctx context.Context, request admin.ObjectG
 	return workflow, nil
 }
 
+func (w *WorkflowManager) GetWorkflows(ctx context.Context, ids []*core.Identifier) (*admin.WorkflowList, error) {
+	if err := validation.ValidateIdentifiers(ids, common.Workflow); err != nil {
+		logger.Debugf(ctx, "invalid identifiers [%+v]: %v", ids, err)
+		return nil, err
+	}
+	workflows, err := util.GetWorkflows(ctx, w.db, w.storageClient, ids)
+	if err != nil {
+		logger.Infof(ctx, "Failed to get workflows with ids [%+v] with err %v", ids, err)
+		return nil, err
+	}
+	return &admin.WorkflowList{
+		Workflows: workflows,
+	}, nil
+}
+
 // Returns workflows *without* a populated workflow closure.
 func (w *WorkflowManager) ListWorkflows(
 	ctx context.Context, request admin.ResourceListRequest) (*admin.WorkflowList, error) {
@@ -244,6 +387,10 @@ func (w *WorkflowManager) ListWorkflows(
 	if err != nil {
 		return nil, err
 	}
+	filters, err = util.AddProjectVisibilityFilter(ctx, common.Workflow, filters)
+	if err != nil {
+		return nil, err
+	}
 	var sortParameter common.SortParameter
 	if request.SortBy != nil {
 		sortParameter, err = common.NewSortParameter(*request.SortBy)
@@ -336,6 +483,32 @@ func (w *WorkflowManager) ListWorkflowIdentifiers(ctx context.Context, request a
 
 }
 
+func (w *WorkflowManager) GetWorkflowDiff(ctx context.Context, request interfaces.VersionDiffRequest) (
+	*interfaces.WorkflowDiff, error) {
+	if err := validation.ValidateVersionDiffRequest(request); err != nil {
+		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.ID.Project, request.ID.Domain)
+	ctx = contextutils.WithWorkflowID(ctx, request.ID.Name)
+	closures := make([]*admin.WorkflowClosure, 2)
+	for i, version := range []string{request.BaseVersion, request.TargetVersion} {
+		workflow, err := util.GetWorkflow(ctx, w.db, w.storageClient, core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      request.ID.Project,
+			Domain:       request.ID.Domain,
+			Name:         request.ID.Name,
+			Version:      version,
+		})
+		if err != nil {
+			logger.Debugf(ctx, "Failed to get version [%s] of workflow [%+v] with err %v", version, request.ID, err)
+			return nil, err
+		}
+		closures[i] = workflow.Closure
+	}
+	return diffWorkflowClosures(closures[0], closures[1]), nil
+}
+
 func NewWorkflowManager(
 	db repositories.RepositoryInterface,
 	config runtimeInterfaces.Configuration,
@@ -349,6 +522,8 @@ func NewWorkflowManager(
 			"compilation_failures", "any observed failures when compiling a workflow"),
 		TypedInterfaceSizeBytes: scope.MustNewSummary("typed_interface_size_bytes",
 			"size in bytes of serialized workflow TypedInterface"),
+		OversizedWorkflows: scope.MustNewCounter("oversized_workflows",
+			"count of registered workflows whose FlyteWorkflows exceed the size warning threshold"),
 	}
 	return &WorkflowManager{
 		db:            db,
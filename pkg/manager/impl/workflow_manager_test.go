@@ -2,6 +2,7 @@ package impl
 
 import (
 	"context"
+	"crypto/md5"
 	"errors"
 	"fmt"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	commonMocks "github.com/flyteorg/flyteadmin/pkg/common/mocks"
 	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
+	managerInterfaces "github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
@@ -26,6 +28,7 @@ import (
 	engine "github.com/flyteorg/flytepropeller/pkg/compiler/common"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 )
@@ -259,6 +262,158 @@ func TestCreateWorkflow_DatabaseError(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func TestCreateWorkflow_OversizedWorkflow(t *testing.T) {
+	mockCompiler := getMockWorkflowCompiler()
+	mockCompiler.(*workflowengineMocks.MockCompiler).AddCompileWorkflowCallback(func(
+		primaryWf *core.WorkflowTemplate, subworkflows []*core.WorkflowTemplate, tasks []*core.CompiledTask,
+		launchPlans []engine.InterfaceProvider) (*core.CompiledWorkflowClosure, error) {
+		template := proto.Clone(primaryWf).(*core.WorkflowTemplate)
+		template.Nodes = []*core.Node{
+			{
+				Id: engine.StartNodeID,
+			},
+			{
+				Id: engine.EndNodeID,
+			},
+		}
+		return &core.CompiledWorkflowClosure{
+			Primary: &core.CompiledWorkflow{
+				Template: template,
+				Connections: &core.ConnectionSet{
+					Downstream: map[string]*core.ConnectionSet_IdList{
+						engine.StartNodeID: {
+							Ids: []string{engine.EndNodeID},
+						},
+					},
+					Upstream: map[string]*core.ConnectionSet_IdList{
+						engine.EndNodeID: {
+							Ids: []string{engine.StartNodeID},
+						},
+					},
+				},
+			},
+		}, nil
+	})
+	for _, test := range []struct {
+		name       string
+		offloading runtimeInterfaces.WorkflowOffloadingConfig
+		warnings   float64
+	}{
+		{
+			name: "not offloaded",
+			offloading: runtimeInterfaces.WorkflowOffloadingConfig{
+				WarnThresholdBytes: 1,
+			},
+			warnings: 1,
+		},
+		{
+			name: "offloaded in some clusters",
+			offloading: runtimeInterfaces.WorkflowOffloadingConfig{
+				Enabled:            true,
+				Clusters:           []string{"C1"},
+				WarnThresholdBytes: 1,
+			},
+			warnings: 1,
+		},
+		{
+			name: "offloaded in all clusters",
+			offloading: runtimeInterfaces.WorkflowOffloadingConfig{
+				Enabled:            true,
+				WarnThresholdBytes: 1,
+			},
+		},
+		{
+			name: "below the threshold",
+			offloading: runtimeInterfaces.WorkflowOffloadingConfig{
+				WarnThresholdBytes: 1024 * 1024,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mockConfig := getMockWorkflowConfigProvider()
+			mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+				runtimeInterfaces.ApplicationConfig{
+					Executor: runtimeInterfaces.ExecutorConfig{
+						Offloading: test.offloading,
+					},
+				})
+			workflowManager := NewWorkflowManager(
+				getMockRepository(!returnWorkflowOnGet), mockConfig, mockCompiler, getMockStorage(), storagePrefix,
+				mockScope.NewTestScope())
+
+			_, err := workflowManager.CreateWorkflow(context.Background(), testutils.GetWorkflowRequest())
+			assert.NoError(t, err)
+			assert.Equal(t, test.warnings,
+				testutil.ToFloat64(workflowManager.(*WorkflowManager).metrics.OversizedWorkflows))
+		})
+	}
+}
+
+func TestCreateWorkflow_ClosureOffloading(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		offloading runtimeInterfaces.WorkflowClosureOffloadingConfig
+		offloaded  bool
+	}{
+		{
+			name:      "disabled",
+			offloaded: true,
+		},
+		{
+			name: "below the threshold",
+			offloading: runtimeInterfaces.WorkflowClosureOffloadingConfig{
+				Enabled:        true,
+				ThresholdBytes: 1024 * 1024,
+			},
+		},
+		{
+			name: "above the threshold",
+			offloading: runtimeInterfaces.WorkflowClosureOffloadingConfig{
+				Enabled:        true,
+				ThresholdBytes: 1,
+			},
+			offloaded: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mockConfig := getMockWorkflowConfigProvider()
+			mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+				runtimeInterfaces.ApplicationConfig{
+					WorkflowClosureOffloading: test.offloading,
+				})
+			mockStorage := getMockStorage()
+			var written bool
+			mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).WriteProtobufCb =
+				func(ctx context.Context, reference storage.DataReference, opts storage.Options, msg proto.Message) error {
+					written = true
+					return nil
+				}
+			repository := getMockRepository(!returnWorkflowOnGet)
+			var createdWorkflow models.Workflow
+			repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetCreateCallback(
+				func(input models.Workflow) error {
+					createdWorkflow = input
+					return nil
+				})
+			workflowManager := NewWorkflowManager(
+				repository, mockConfig, getMockWorkflowCompiler(), mockStorage, storagePrefix, mockScope.NewTestScope())
+
+			_, err := workflowManager.CreateWorkflow(context.Background(), testutils.GetWorkflowRequest())
+			assert.NoError(t, err)
+			assert.Equal(t, test.offloaded, written)
+			if test.offloaded {
+				assert.NotEmpty(t, createdWorkflow.RemoteClosureIdentifier)
+				assert.Empty(t, createdWorkflow.Closure)
+				return
+			}
+			assert.Empty(t, createdWorkflow.RemoteClosureIdentifier)
+			var closure admin.WorkflowClosure
+			assert.NoError(t, proto.Unmarshal(createdWorkflow.Closure, &closure))
+			assert.Equal(t, "name", closure.CompiledWorkflow.Primary.Template.Id.Name)
+		})
+	}
+}
+
 func TestGetWorkflow(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	workflowGetFunc := func(input interfaces.Identifier) (models.Workflow, error) {
@@ -305,6 +460,41 @@ func TestGetWorkflow(t *testing.T) {
 		"%+v !=\n %+v", testutils.GetWorkflowClosure(), workflow.Closure)
 }
 
+func TestGetWorkflow_InlineClosure(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.Workflow, error) {
+			return models.Workflow{
+				BaseModel: models.BaseModel{
+					CreatedAt: testutils.MockCreatedAtValue,
+				},
+				WorkflowKey: models.WorkflowKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				TypedInterface: testutils.GetWorkflowRequestInterfaceBytes(),
+				Closure:        testutils.GetWorkflowClosureBytes(),
+			}, nil
+		})
+	mockStorageClient := commonMocks.GetMockStorageClient()
+	mockStorageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb =
+		func(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+			t.Fatal("inline workflow closures shouldn't be read from storage")
+			return nil
+		}
+	workflowManager := NewWorkflowManager(
+		repository, getMockWorkflowConfigProvider(), getMockWorkflowCompiler(), mockStorageClient, storagePrefix,
+		mockScope.NewTestScope())
+	workflow, err := workflowManager.GetWorkflow(context.Background(), admin.ObjectGetRequest{
+		Id: &workflowIdentifier,
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(testutils.GetWorkflowClosure(), workflow.Closure),
+		"%+v !=\n %+v", testutils.GetWorkflowClosure(), workflow.Closure)
+}
+
 func TestGetWorkflow_DatabaseError(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	expectedErr := errors.New("expected error")
@@ -573,3 +763,87 @@ func TestWorkflowManager_ListWorkflowIdentifiers(t *testing.T) {
 		assert.Equal(t, nameValue, entity.Name)
 	}
 }
+
+func getWorkflowManagerForPartsTest(
+	repository repositories.RepositoryInterface, uploadPrefix string, maxSpecBytes int64) (
+	managerInterfaces.WorkflowInterface, *commonMocks.TestDataStore) {
+	mockConfig := getMockWorkflowConfigProvider()
+	mockConfig.ApplicationConfiguration().(*runtimeMocks.MockApplicationProvider).SetTopLevelConfig(
+		runtimeInterfaces.ApplicationConfig{
+			DataProxy: runtimeInterfaces.DataProxyConfig{
+				Enabled:              true,
+				UploadPrefix:         uploadPrefix,
+				MaxWorkflowSpecBytes: maxSpecBytes,
+			},
+		})
+	mockStorage := getMockStorage()
+	workflowManager := NewWorkflowManager(
+		repository, mockConfig, getMockWorkflowCompiler(), mockStorage, storagePrefix, mockScope.NewTestScope())
+	return workflowManager, mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore)
+}
+
+// Uploads the workflow spec of the test workflow request in two parts.
+func uploadWorkflowSpecParts(
+	t *testing.T, store *commonMocks.TestDataStore) managerInterfaces.WorkflowFromPartsCreateRequest {
+	serializedSpec, err := proto.Marshal(testutils.GetWorkflowRequest().Spec)
+	assert.NoError(t, err)
+	digest := md5.Sum(serializedSpec)
+	partURLs := []string{
+		"s3://bucket/uploads/project/domain/part-0",
+		"s3://bucket/uploads/project/domain/part-1",
+	}
+	store.Store[storage.DataReference(partURLs[0])] = serializedSpec[:len(serializedSpec)/2]
+	store.Store[storage.DataReference(partURLs[1])] = serializedSpec[len(serializedSpec)/2:]
+	return managerInterfaces.WorkflowFromPartsCreateRequest{
+		Id:         &workflowIdentifier,
+		PartURLs:   partURLs,
+		ContentMD5: digest[:],
+	}
+}
+
+func TestCreateWorkflowFromParts(t *testing.T) {
+	repository := getMockRepository(!returnWorkflowOnGet)
+	var createCalled bool
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetCreateCallback(func(input models.Workflow) error {
+		assert.Equal(t, workflowIdentifier.Name, input.Name)
+		assert.Equal(t, workflowIdentifier.Version, input.Version)
+		createCalled = true
+		return nil
+	})
+	workflowManager, store := getWorkflowManagerForPartsTest(repository, "s3://bucket/uploads/", 0)
+	request := uploadWorkflowSpecParts(t, store)
+
+	response, err := workflowManager.CreateWorkflowFromParts(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, &admin.WorkflowCreateResponse{}, response)
+	assert.True(t, createCalled)
+}
+
+func TestCreateWorkflowFromParts_DigestMismatch(t *testing.T) {
+	workflowManager, store := getWorkflowManagerForPartsTest(
+		getMockRepository(!returnWorkflowOnGet), "s3://bucket/uploads", 0)
+	request := uploadWorkflowSpecParts(t, store)
+	// The parts are assembled out of order.
+	request.PartURLs[0], request.PartURLs[1] = request.PartURLs[1], request.PartURLs[0]
+
+	_, err := workflowManager.CreateWorkflowFromParts(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestCreateWorkflowFromParts_ExceedsLimit(t *testing.T) {
+	workflowManager, store := getWorkflowManagerForPartsTest(
+		getMockRepository(!returnWorkflowOnGet), "s3://bucket/uploads", 10)
+	request := uploadWorkflowSpecParts(t, store)
+
+	_, err := workflowManager.CreateWorkflowFromParts(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "exceeds the limit of 10 bytes")
+}
+
+func TestCreateWorkflowFromParts_UploadsDisabled(t *testing.T) {
+	workflowManager, store := getWorkflowManagerForPartsTest(getMockRepository(!returnWorkflowOnGet), "", 0)
+	request := uploadWorkflowSpecParts(t, store)
+
+	_, err := workflowManager.CreateWorkflowFromParts(context.Background(), request)
+	assert.Equal(t, codes.FailedPrecondition, err.(adminErrors.FlyteAdminError).Code())
+}
@@ -0,0 +1,67 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// The dimensions API usage can be reported by.
+const (
+	APIUsageGroupByMethod    = "method"
+	APIUsageGroupByPrincipal = "principal"
+	APIUsageGroupByProject   = "project"
+)
+
+// Interface for recording and reporting how callers use the admin API, to find abusive clients and plan capacity.
+type APIUsageInterface interface {
+	// Adds a call to the usage aggregated in memory.
+	RecordCall(ctx context.Context, call APIUsageCall)
+	// Adds the usage aggregated in memory to the daily totals in the database.
+	Flush(ctx context.Context) error
+	// Returns the calls, errors and latency of each caller, project or RPC over a time window.
+	GetUsageReport(ctx context.Context, request APIUsageReportRequest) (*APIUsageReport, error)
+}
+
+type APIUsageCall struct {
+	// The full name of the RPC called.
+	Method string
+	// The user or application which made the call. Empty when the call wasn't authenticated.
+	Principal string
+	// The project the call targeted. Empty when the call didn't target a project.
+	Project string
+	Failed  bool
+	Latency time.Duration
+}
+
+type APIUsageReportRequest struct {
+	// Usage is totalled per UTC day, so every day from the one StartTime falls on which begins before EndTime is
+	// reported.
+	StartTime time.Time
+	EndTime   time.Time
+	// Optionally restrict the report to a project, caller or RPC.
+	Project   string
+	Principal string
+	Method    string
+	// Any of the APIUsageGroupBy dimensions. Reports usage by principal and project when empty.
+	GroupBy []string
+	// Reports every group when zero.
+	Limit int
+}
+
+type APIUsageReport struct {
+	StartTime time.Time
+	EndTime   time.Time
+	// Ordered by the most calls first.
+	Rows []APIUsageRow
+}
+
+// The usage of a group. Fields which the report isn't grouped by are empty.
+type APIUsageRow struct {
+	Method         string
+	Principal      string
+	Project        string
+	Calls          int64
+	Errors         int64
+	AverageLatency time.Duration
+	MaxLatency     time.Duration
+}
@@ -0,0 +1,84 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// The order a backfill launches its executions in.
+type BackfillOrder int
+
+const (
+	// Launches executions for earlier scheduled times first.
+	BackfillOrderOldestFirst BackfillOrder = iota
+	// Launches executions for later scheduled times first.
+	BackfillOrderNewestFirst
+)
+
+// Interface for managing backfills, which launch an execution of a scheduled launch plan for each time it was
+// scheduled in a range, a few at a time.
+type BackfillInterface interface {
+	// Creates a backfill, whose executions are launched as AdvanceBackfills runs.
+	CreateBackfill(ctx context.Context, request CreateBackfillRequest) (*Backfill, error)
+	GetBackfill(ctx context.Context, id admin.NamedEntityIdentifier) (*Backfill, error)
+	ListBackfills(ctx context.Context, request ListBackfillsRequest) (*BackfillList, error)
+	// Stops a backfill from launching more executions and terminates those which are still running.
+	TerminateBackfill(ctx context.Context, id admin.NamedEntityIdentifier, cause string) error
+	// Launches the next executions of each running backfill, and completes those whose executions have all
+	// terminated.
+	AdvanceBackfills(ctx context.Context) error
+}
+
+type CreateBackfillRequest struct {
+	Project string
+	Domain  string
+	// Generated when empty. Names are formatted like execution names.
+	Name string
+	// A scheduled launch plan in the same project and domain as the backfill.
+	LaunchPlan *core.Identifier
+	// Executions are launched for the times the launch plan was scheduled after StartTime, up to and including
+	// EndTime.
+	StartTime time.Time
+	EndTime   time.Time
+	// The maximum number of the backfill's executions which run at once.
+	Parallelism uint32
+	Order       BackfillOrder
+}
+
+type ListBackfillsRequest struct {
+	Project string
+	Domain  string
+	Limit   uint32
+	Token   string
+}
+
+type BackfillList struct {
+	Backfills []*Backfill
+	Token     string
+}
+
+type Backfill struct {
+	Id          *admin.NamedEntityIdentifier
+	LaunchPlan  *core.Identifier
+	StartTime   time.Time
+	EndTime     time.Time
+	Parallelism uint32
+	Order       BackfillOrder
+	// One of RUNNING, SUCCEEDED, FAILED or ABORTED.
+	Phase      core.WorkflowExecution_Phase
+	AbortCause string
+	Principal  string
+	CreatedAt  time.Time
+	// The number of times the launch plan was scheduled in the range, and how many of them executions have been
+	// launched for.
+	Total    int
+	Launched int
+	// The number of launched executions which are still running, which succeeded, and which terminated in any other
+	// phase.
+	Running   int
+	Succeeded int
+	Failed    int
+}
@@ -0,0 +1,93 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for looking up the outputs tasks cached in datacatalog, so that callers can tell which tasks of an
+// execution they're about to launch would run.
+type CacheInterface interface {
+	// Reports whether datacatalog holds the outputs of each task for the given inputs.
+	CheckCachedOutputs(ctx context.Context, request CheckCachedOutputsRequest) (*CheckCachedOutputsResponse, error)
+	// Counts how many of the node executions of a terminated execution hit, missed or populated the cache.
+	GetExecutionCacheSummary(ctx context.Context, id core.WorkflowExecutionIdentifier) (*ExecutionCacheSummary, error)
+	// Evicts the outputs a task execution, the task executions of an execution or every execution of a task version
+	// cached, and records who evicted them and why.
+	EvictCache(ctx context.Context, request CacheEvictRequest) (*CacheEvictResponse, error)
+	// Returns the evictions of outputs cached for the tasks of a project and domain, most recent first.
+	ListCacheEvictions(ctx context.Context, request CacheEvictionListRequest) (*CacheEvictionList, error)
+}
+
+type CachedOutputsQuery struct {
+	TaskId *core.Identifier
+	// The discovery version the outputs were cached with. Defaults to that of the registered task.
+	CacheVersion string
+	// The hash of the inputs the task ran with, which the outputs are tagged with in datacatalog.
+	InputsHash string
+}
+
+type CheckCachedOutputsRequest struct {
+	Queries []CachedOutputsQuery
+}
+
+type CachedOutputsStatus struct {
+	TaskId *core.Identifier
+	// CACHE_DISABLED for tasks which aren't discoverable, otherwise CACHE_HIT or CACHE_MISS.
+	Status core.CatalogCacheStatus
+	// The datacatalog artifact holding the outputs, and when it was created, for hits.
+	ArtifactId string
+	CachedAt   time.Time
+}
+
+type CheckCachedOutputsResponse struct {
+	// In the order of the queries of the request.
+	Statuses []CachedOutputsStatus
+}
+
+type ExecutionCacheSummary struct {
+	Id *core.WorkflowExecutionIdentifier
+	// The number of node executions which reported each cache status. Nodes which don't report one, such as branch
+	// and workflow nodes, aren't counted.
+	NodeCounts map[core.CatalogCacheStatus]int
+}
+
+// Exactly one of the task execution, execution or task scopes an eviction.
+type CacheEvictRequest struct {
+	TaskExecutionId *core.TaskExecutionIdentifier
+	ExecutionId     *core.WorkflowExecutionIdentifier
+	// Evicts the outputs cached with the task version's current discovery version, for any inputs.
+	TaskId *core.Identifier
+	// Why the outputs are evicted, which is kept along with the eviction.
+	Reason string
+}
+
+type CacheEviction struct {
+	// The datacatalog dataset the outputs are cached in.
+	Dataset *core.Identifier
+	// The evicted artifact, or empty when every artifact of the dataset was evicted.
+	ArtifactId string
+	// The node execution which ran or reused the evicted outputs, if any.
+	NodeExecutionId *core.NodeExecutionIdentifier
+	Principal       string
+	Reason          string
+	EvictedAt       time.Time
+}
+
+type CacheEvictResponse struct {
+	Evictions []*CacheEviction
+}
+
+type CacheEvictionListRequest struct {
+	Project string
+	Domain  string
+	Limit   uint32
+	Token   string
+}
+
+type CacheEvictionList struct {
+	Evictions []*CacheEviction
+	Token     string
+}
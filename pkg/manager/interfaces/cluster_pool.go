@@ -0,0 +1,89 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Interface for managing named pools of execution clusters and the health states of clusters. Executions are routed to
+// a pool when an ExecutionClusterLabel matchable attribute references its name, and run on one of its healthy clusters
+// picked by weight.
+type ClusterPoolInterface interface {
+	// Creates a cluster pool, replacing the pool with the same name if there is one.
+	SetClusterPool(ctx context.Context, request SetClusterPoolRequest) (*ClusterPool, error)
+	GetClusterPool(ctx context.Context, name string) (*ClusterPool, error)
+	ListClusterPools(ctx context.Context, request ListClusterPoolsRequest) (*ClusterPoolList, error)
+	DeleteClusterPool(ctx context.Context, name string) error
+	// Sets the health state of a cluster, which is HEALTHY, UNHEALTHY or DRAINING. New executions are only routed to
+	// healthy clusters.
+	SetClusterHealth(ctx context.Context, request SetClusterHealthRequest) (*ClusterHealth, error)
+	// Lists the configured execution clusters along with their health states and the results of their last health
+	// probes.
+	ListClusters(ctx context.Context) (*ClusterList, error)
+}
+
+type ClusterPoolMember struct {
+	// The name of a configured execution cluster.
+	Cluster string
+	// The relative weight with which the cluster is picked from the healthy clusters in the pool.
+	Weight float32
+	// The health state of the cluster, which is only set in responses.
+	State string
+}
+
+type SetClusterPoolRequest struct {
+	Name        string
+	Description string
+	Labels      map[string]string
+	Members     []ClusterPoolMember
+}
+
+type ListClusterPoolsRequest struct {
+	Limit uint32
+	Token string
+}
+
+type ClusterPool struct {
+	Name        string
+	Description string
+	Labels      map[string]string
+	Members     []ClusterPoolMember
+	Principal   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type ClusterPoolList struct {
+	ClusterPools []*ClusterPool
+	Token        string
+}
+
+type SetClusterHealthRequest struct {
+	Cluster string
+	State   string
+	// Why the cluster is in its state, e.g. the maintenance it's drained for.
+	Reason string
+}
+
+type ClusterHealth struct {
+	Cluster   string
+	State     string
+	Reason    string
+	Principal string
+	UpdatedAt time.Time
+	// The results of the last health probe of the cluster, if it was probed.
+	ProbedAt                 *time.Time
+	ProbeError               string
+	ConsecutiveProbeFailures int32
+	PropellerHeartbeatAt     *time.Time
+}
+
+type Cluster struct {
+	Name    string
+	Enabled bool
+	Health  ClusterHealth
+}
+
+type ClusterList struct {
+	Clusters []*Cluster
+}
@@ -0,0 +1,59 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Interface for reporting how the cluster resource controller synced its templates to the namespaces of projects and
+// domains in the execution clusters.
+type ClusterResourceSyncInterface interface {
+	// Returns, per execution cluster, the templates last applied to the namespace of a project and domain along with
+	// the errors applying them and the drift detected in their resources. For archived projects and removed domains it
+	// also reports what was cleaned up.
+	GetClusterResourceSyncStatus(ctx context.Context, request ClusterResourceSyncStatusRequest) (
+		*ClusterResourceSyncStatus, error)
+}
+
+type ClusterResourceSyncStatusRequest struct {
+	Project string
+	Domain  string
+	// Optionally restricts the status to a single execution cluster.
+	Cluster string
+}
+
+type TemplateSyncStatus struct {
+	// The name of the template file.
+	Template string
+	// The resource the template was last applied as.
+	ResourceKind string
+	ResourceName string
+	// The hash of the template last applied, with its values substituted.
+	AppliedVersion string
+	AppliedAt      *time.Time
+	// The error of the last attempt at applying the template, which is cleared once it's applied.
+	ApplyError   string
+	ApplyErrorAt *time.Time
+	// How the resource in the cluster differs from the applied template, if it does, as of the last drift check.
+	Drift          string
+	DriftCheckedAt *time.Time
+	// When the project was found archived, or the domain removed, and when the resource was cleaned up once the grace
+	// period passed, either DELETED or CORDONED.
+	RetiredAt   *time.Time
+	CleanedUpAt *time.Time
+	Cleanup     string
+}
+
+type ClusterSyncStatus struct {
+	Cluster   string
+	Namespace string
+	Templates []TemplateSyncStatus
+	// Whether every template was applied without error and none of their resources drifted.
+	InSync bool
+}
+
+type ClusterResourceSyncStatus struct {
+	Project  string
+	Domain   string
+	Clusters []*ClusterSyncStatus
+}
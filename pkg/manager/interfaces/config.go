@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// Interface for inspecting the config admin runs with, for checking which values a change to the config files took
+// effect with.
+type ConfigInterface interface {
+	GetEffectiveConfig(ctx context.Context) (*EffectiveConfig, error)
+}
+
+type EffectiveConfig struct {
+	runtimeInterfaces.ConfigVersion
+	// The values of every config section in use, keyed by section, with secrets such as passwords redacted.
+	// Subsections are keyed by their keys within their sections.
+	Sections map[string]interface{} `json:"sections"`
+}
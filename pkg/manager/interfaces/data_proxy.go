@@ -0,0 +1,56 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// The artifacts of an execution which download links are created for.
+type ArtifactType = string
+
+const (
+	ArtifactInputs  ArtifactType = "inputs"
+	ArtifactOutputs ArtifactType = "outputs"
+	// The deck a task rendered alongside its outputs. Only node executions have decks.
+	ArtifactDeck ArtifactType = "deck"
+)
+
+// Interface for creating signed URLs for the artifacts of executions, so that clients such as the console can
+// download them without credentials for the bucket they're stored in.
+type DataProxyInterface interface {
+	CreateDownloadLink(ctx context.Context, request CreateDownloadLinkRequest) (*CreateDownloadLinkResponse, error)
+	// Returns a signed URL which a file, such as a fast registered code package, is uploaded to with a PUT request.
+	CreateUploadLocation(
+		ctx context.Context, request CreateUploadLocationRequest) (*CreateUploadLocationResponse, error)
+}
+
+// Identifies the artifact of either a workflow execution or a node execution.
+type CreateDownloadLinkRequest struct {
+	ArtifactType    ArtifactType
+	ExecutionID     *core.WorkflowExecutionIdentifier
+	NodeExecutionID *core.NodeExecutionIdentifier
+}
+
+type CreateDownloadLinkResponse struct {
+	SignedURL string
+	ExpiresAt time.Time
+	// The size of the artifact.
+	Bytes int64
+}
+
+type CreateUploadLocationRequest struct {
+	Project  string
+	Domain   string
+	Filename string
+	// The MD5 digest of the file. The upload request must carry it as its Content-MD5 header.
+	ContentMD5 []byte
+}
+
+type CreateUploadLocationResponse struct {
+	SignedURL string
+	// Where the file is stored once uploaded, e.g. s3://my-bucket/uploads/project/domain/<content md5>/filename.
+	NativeURL string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// Interface for managing the domains which every project has. Domains are stored in the database, and the domains in
+// the application config are only used until they're seeded into it.
+type DomainInterface interface {
+	CreateDomain(ctx context.Context, request Domain) (*Domain, error)
+	GetDomain(ctx context.Context, id string) (*Domain, error)
+	ListDomains(ctx context.Context) (*DomainList, error)
+	// Replaces the name and description of a domain.
+	UpdateDomain(ctx context.Context, request Domain) (*Domain, error)
+	// Deletes a domain. The namespaces of its projects are cleaned up by the cluster resource controller.
+	DeleteDomain(ctx context.Context, id string) error
+	// Stores the configured domains when no domains are stored yet.
+	SeedDomains(ctx context.Context) error
+}
+
+type Domain struct {
+	// The unique identifier of the domain, e.g. "development", which must be a DNS-1123 label.
+	ID          string
+	Name        string
+	Description string
+}
+
+type DomainList struct {
+	Domains []*Domain
+}
@@ -0,0 +1,120 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// The most events accepted in a single batch.
+const MaxEventBatchSize = 1000
+
+// Interface for ingesting node and task execution events in batches, rather than one request per event.
+type EventInterface interface {
+	// Validates and records each event of the batch in order. An event failing doesn't stop those after it from being
+	// recorded, so callers should retry only the events the response reports as failed.
+	CreateEvents(ctx context.Context, request EventBatchRequest) (*EventBatchResponse, error)
+	// Returns the events of an execution, and of its node and task executions, which were skipped rather than applied.
+	GetEventConsistencyReport(ctx context.Context, id core.WorkflowExecutionIdentifier) (*EventConsistencyReport, error)
+	// Returns the events received for an execution and its node and task executions, in the order they were received.
+	// Events are only recorded when event history is enabled.
+	ListExecutionEvents(ctx context.Context, request ExecutionEventListRequest) (*ExecutionEventList, error)
+}
+
+// Why an event was skipped rather than applied.
+const (
+	// The event's phase, and for task executions its phase version, was already recorded.
+	SkipReasonDuplicate = "DUPLICATE"
+	// The event occurred before the last event applied, so applying it would roll back newer state.
+	SkipReasonStale = "STALE"
+	// The event occurred before the entity reached the terminal phase it's already in.
+	SkipReasonAfterTerminal = "AFTER_TERMINAL"
+)
+
+// The types of event which may be skipped.
+const (
+	WorkflowEventType = "workflow"
+	NodeEventType     = "node"
+	TaskEventType     = "task"
+)
+
+// Exactly one of NodeEvent or TaskEvent is set.
+type Event struct {
+	NodeEvent *admin.NodeExecutionEventRequest
+	TaskEvent *admin.TaskExecutionEventRequest
+}
+
+type EventBatchRequest struct {
+	Events []Event
+}
+
+type EventError struct {
+	// The position of the failed event in the batch.
+	Index int
+	Err   error
+}
+
+type EventBatchResponse struct {
+	// The number of events which were recorded.
+	Accepted int
+	Errors   []EventError
+}
+
+type SkippedEvent struct {
+	EventType string
+	// Empty for workflow execution events.
+	NodeId string
+	// Only set for task execution events.
+	RetryAttempt *uint32
+	RequestId    string
+	Phase        string
+	PhaseVersion uint32
+	OccurredAt   time.Time
+	// The phase the entity was in when the event was received.
+	CurrentPhase string
+	Reason       string
+	SkippedAt    time.Time
+}
+
+type EventConsistencyReport struct {
+	Id *core.WorkflowExecutionIdentifier
+	// Skipped events in the order they were received.
+	SkippedEvents []SkippedEvent
+	// The number of skipped events for each reason.
+	SkippedByReason map[string]int
+}
+
+type ExecutionEventListRequest struct {
+	Id    *core.WorkflowExecutionIdentifier
+	Limit uint32
+	Token string
+	// Optional filters, which match every event when empty.
+	EventType string
+	NodeId    string
+	Phase     string
+	// When set, only events which occurred at or after OccurredAfter and before OccurredBefore are listed.
+	OccurredAfter  time.Time
+	OccurredBefore time.Time
+}
+
+type RecordedEvent struct {
+	EventType string
+	// Empty for workflow execution events.
+	NodeId string
+	// Only set for task execution events.
+	RetryAttempt *uint32
+	RequestId    string
+	ProducerId   string
+	Phase        string
+	PhaseVersion uint32
+	OccurredAt   time.Time
+	ReceivedAt   time.Time
+}
+
+type ExecutionEventList struct {
+	Events []RecordedEvent
+	// Empty when there are no more events to list.
+	Token string
+}
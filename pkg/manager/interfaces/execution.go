@@ -5,12 +5,18 @@ import (
 	"time"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 )
 
 // Interface for managing Flyte Workflow Executions
 type ExecutionInterface interface {
 	CreateExecution(ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
 		*admin.ExecutionCreateResponse, error)
+	// Validates and resolves an execution as CreateExecution does, without recording or launching it, so that new
+	// workflow versions can be checked to launch.
+	DryRunExecution(ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
+		*DryRunExecutionResponse, error)
 	RelaunchExecution(ctx context.Context, request admin.ExecutionRelaunchRequest, requestedAt time.Time) (
 		*admin.ExecutionCreateResponse, error)
 	// Recreates a previously-run workflow execution that will point to the original execution so that propeller will
@@ -18,12 +24,144 @@ type ExecutionInterface interface {
 	// which previously succeeded based on the recovery (original) workflow execution id.
 	RecoverExecution(ctx context.Context, request admin.ExecutionRecoverRequest, requestedAt time.Time) (
 		*admin.ExecutionCreateResponse, error)
+	// Recovers a workflow execution as RecoverExecution does, limiting the nodes which may be recovered, or relaunches
+	// it with some of its inputs replaced.
+	RecoverExecutionWithOverrides(ctx context.Context, request admin.ExecutionRecoverRequest,
+		overrides RecoverExecutionOverrides, requestedAt time.Time) (*admin.ExecutionCreateResponse, error)
 	CreateWorkflowEvent(ctx context.Context, request admin.WorkflowExecutionEventRequest) (
 		*admin.WorkflowExecutionEventResponse, error)
 	GetExecution(ctx context.Context, request admin.WorkflowExecutionGetRequest) (*admin.Execution, error)
 	GetExecutionData(ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (
 		*admin.WorkflowExecutionGetDataResponse, error)
+	// Returns the inputs and outputs of an execution as GetExecutionData does, limited to a subset of the literals and
+	// of their size.
+	GetExecutionDataWithOptions(ctx context.Context, request admin.WorkflowExecutionGetDataRequest,
+		options GetDataOptions) (*GetDataResponse, error)
 	ListExecutions(ctx context.Context, request admin.ResourceListRequest) (*admin.ExecutionList, error)
 	TerminateExecution(
 		ctx context.Context, request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error)
+	// Counts the executions matching the request, in total and in groups such as per phase per day, without listing
+	// them.
+	CountExecutions(ctx context.Context, request ExecutionCountRequest) (*ExecutionCountResponse, error)
+	// Lists the label keys applied to the executions of a project and domain, or the values of one key, with the
+	// number of executions each is applied to, most applied first.
+	ListExecutionLabels(ctx context.Context, request ExecutionLabelListRequest) (*ExecutionLabelListResponse, error)
+	// Terminates every execution matching the request in batches, or only counts them for a dry run.
+	TerminateExecutions(ctx context.Context, request TerminateExecutionsRequest) (*TerminateExecutionsResponse, error)
+	// Soft-deletes a terminated execution, hiding it from gets and lists until it's restored.
+	DeleteExecution(ctx context.Context, id *core.WorkflowExecutionIdentifier) error
+	RestoreExecution(ctx context.Context, id *core.WorkflowExecutionIdentifier) error
+	// Launches the executions held as pending which are due to run, oldest first, for the projects and domains now
+	// running fewer executions than their admission limits.
+	DispatchPendingExecutions(ctx context.Context) error
+}
+
+// An execution as it would be created and launched.
+type DryRunExecutionResponse struct {
+	Id *core.WorkflowExecutionIdentifier
+	// The spec the execution would be recorded with, including the labels, annotations and permissions it resolved.
+	Spec *admin.ExecutionSpec
+	// The cluster the execution would be launched on.
+	Cluster string
+	// Whether the execution would be held until its project and domain run fewer executions, or until it's due to run.
+	Pending bool
+	// The FlyteWorkflow resource the execution would be launched as.
+	FlyteWorkflow *v1alpha1.FlyteWorkflow
+}
+
+// Changes made to an execution when it's recovered.
+type RecoverExecutionOverrides struct {
+	// Inputs which replace those of the same name the original execution was launched with. Since the original
+	// outputs may depend on them, no nodes are recovered: the execution is relaunched with the merged inputs, and
+	// NodeIDs can't be set.
+	Inputs *core.LiteralMap
+	// When set, the only nodes of the original execution which may be recovered, such as those on its failed
+	// branches. Each must have run in the original execution. They're recorded in the recovered execution's
+	// flyte.org/recover-nodes annotation.
+	NodeIDs []string
+}
+
+// The dimensions executions can be counted by.
+const (
+	ExecutionCountGroupByProject = "project"
+	ExecutionCountGroupByDomain  = "domain"
+	ExecutionCountGroupByPhase   = "phase"
+	// The UTC day executions were created on.
+	ExecutionCountGroupByDay = "day"
+)
+
+type ExecutionCountRequest struct {
+	// Optional, executions of every project visible to the caller are counted when empty.
+	Project string
+	// Optional, executions of every domain are counted when empty.
+	Domain string
+	// Optional filters in the syntax ListExecutions accepts, e.g. eq(launch_plan.name,nightly).
+	Filters string
+	// Any of the ExecutionCountGroupBy dimensions. Only the total is counted when empty.
+	GroupBy []string
+	// Counts every group when zero.
+	Limit int
+}
+
+type ExecutionCountResponse struct {
+	// The number of executions matching the request.
+	Total int64
+	// Ordered by the dimensions grouped by.
+	Groups []ExecutionGroupCount
+}
+
+// The executions in a group of those counted. Fields which executions aren't grouped by are empty.
+type ExecutionGroupCount struct {
+	Project string
+	Domain  string
+	Phase   core.WorkflowExecution_Phase
+	Day     time.Time
+	Count   int64
+}
+
+type ExecutionLabelListRequest struct {
+	Project string
+	Domain  string
+	// Optional, when set the values of the label with this key are listed rather than the keys.
+	Key string
+	// Lists every label when zero.
+	Limit int
+}
+
+type ExecutionLabelListResponse struct {
+	Labels []ExecutionLabelCount
+}
+
+// A label key, or a key and value, and the number of executions it's applied to.
+type ExecutionLabelCount struct {
+	Key string
+	// Empty when keys are listed.
+	Value string
+	Count int64
+}
+
+// Selects the executions to terminate in bulk, such as those flooding a cluster from a bad launch plan.
+type TerminateExecutionsRequest struct {
+	Project string
+	Domain  string
+	// Optional, when set only executions of launch plans with this name are terminated.
+	LaunchPlanName string
+	// Optional, when set only executions in these phases are terminated. They must not be terminal, and default to
+	// every phase which isn't.
+	Phases []core.WorkflowExecution_Phase
+	// Optional, when set only executions created before this time are terminated.
+	CreatedBefore *time.Time
+	Cause         string
+	// The number of executions terminated in each batch, defaulting to 100.
+	BatchSize uint32
+	// When set, matching executions are only counted.
+	DryRun bool
+}
+
+type TerminateExecutionsResponse struct {
+	// The number of executions which matched the request, all of which were terminated unless it was a dry run or
+	// they're listed in Failed.
+	Matched int
+	// Executions which failed to terminate, and may be terminated by retrying the request.
+	Failed []*core.WorkflowExecutionIdentifier
 }
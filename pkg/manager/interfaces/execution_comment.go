@@ -0,0 +1,44 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for managing the comments left on executions after the fact, such as "re-ran manually, upstream data was
+// late", so that the next person looking at the execution sees them.
+type ExecutionCommentInterface interface {
+	AddExecutionComment(ctx context.Context, request ExecutionCommentAddRequest) (*ExecutionComment, error)
+	// Returns the comments of an execution in the order they were left.
+	ListExecutionComments(ctx context.Context, request ExecutionCommentListRequest) (*ExecutionCommentList, error)
+}
+
+type ExecutionComment struct {
+	ID          uint
+	ExecutionID *core.WorkflowExecutionIdentifier
+	// The user who left the comment, and when.
+	Author    string
+	CreatedAt time.Time
+	Text      string
+	Links     []string
+}
+
+type ExecutionCommentAddRequest struct {
+	ExecutionID *core.WorkflowExecutionIdentifier
+	Text        string
+	// Optional http or https links, e.g. to an incident or a dashboard.
+	Links []string
+}
+
+type ExecutionCommentListRequest struct {
+	ExecutionID *core.WorkflowExecutionIdentifier
+	Limit       uint32
+	Token       string
+}
+
+type ExecutionCommentList struct {
+	Comments []*ExecutionComment
+	Token    string
+}
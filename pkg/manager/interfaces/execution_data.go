@@ -0,0 +1,47 @@
+package interfaces
+
+import (
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Limits the inputs and outputs returned for an execution or node execution, so that clients such as the console can
+// fetch large literal maps a piece at a time.
+type GetDataOptions struct {
+	// When set, only the literals with these names are returned. Names which a literal map doesn't hold are ignored.
+	Keys []string
+	// Literals which serialize to more than this many bytes are truncated. Zero disables it.
+	MaxLiteralBytes int64
+	// Literals are returned, inputs first and by name, until their serialized size would exceed this many bytes. The
+	// rest are omitted. Zero disables it.
+	MaxTotalBytes int64
+}
+
+type GetDataResponse struct {
+	Inputs  *LiteralMapData
+	Outputs *LiteralMapData
+}
+
+// The literals returned from a literal map, and those which weren't.
+type LiteralMapData struct {
+	// Signed URL to download the full literal map from.
+	URLBlob *admin.UrlBlob
+	// The literals returned in full.
+	Literals map[string]*core.Literal
+	// Literals which exceeded MaxLiteralBytes, keyed by name.
+	Truncated map[string]TruncatedLiteral
+	// The names of literals which didn't fit within MaxTotalBytes.
+	Omitted []string
+	// Set when the literal map is too large to read, in which case it's only returned as a URL.
+	Unread bool
+	// Set when any literal selected by the request isn't returned in full.
+	Incomplete bool
+}
+
+type TruncatedLiteral struct {
+	// The serialized size of the literal.
+	Bytes int64
+	// Signed URL to download the full value from. Literals are stored in the literal map they belong to, so the URL
+	// downloads the whole map. Empty when the literal map is stored inline.
+	FullValueURL string
+}
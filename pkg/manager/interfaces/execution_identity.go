@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// The resource type execution identity attributes are stored as alongside the matchable attributes. flyteidl has no
+// matchable resource for them, so they're managed through ExecutionIdentityInterface rather than the attributes APIs.
+const ExecutionIdentityResourceType = "EXECUTION_IDENTITY"
+
+// Interface for managing the identities which executions in a project and domain run as by default and are allowed to
+// assume.
+type ExecutionIdentityInterface interface {
+	// Replaces the execution identity attributes of a project and domain. Executions launched afterwards are checked
+	// against them.
+	UpdateExecutionIdentityAttributes(
+		ctx context.Context, project, domain string, attributes ExecutionIdentityAttributes) error
+	GetExecutionIdentityAttributes(ctx context.Context, project, domain string) (*ExecutionIdentityAttributes, error)
+	DeleteExecutionIdentityAttributes(ctx context.Context, project, domain string) error
+}
+
+// The identities executions in a project and domain run as. Identities are either kubernetes service accounts or the
+// cloud identity annotated onto executions, i.e. an IAM role on AWS or a GCP service account with workload identity.
+type ExecutionIdentityAttributes struct {
+	// The service account executions run as when neither they, their launch plan nor their project set one.
+	DefaultServiceAccount string `json:"default_service_account,omitempty"`
+	// The IAM role or GCP service account executions assume when neither they, their launch plan nor their project
+	// set one.
+	DefaultIamRole string `json:"default_iam_role,omitempty"`
+	// When set, executions are rejected unless they run as one of these service accounts. Executions without a
+	// service account run as the default service account of their namespace and are always allowed.
+	AllowedServiceAccounts []string `json:"allowed_service_accounts,omitempty"`
+	// When set, executions are rejected unless they assume one of these IAM roles or GCP service accounts.
+	// Executions which assume none are always allowed.
+	AllowedIamRoles []string `json:"allowed_iam_roles,omitempty"`
+}
@@ -0,0 +1,60 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// Interface for explaining how executions are routed to clusters and queues.
+type ExecutionRoutingInterface interface {
+	// Resolves the cluster and queue an execution of a launch plan would be assigned and why, without launching
+	// anything.
+	ResolveExecutionCluster(ctx context.Context, request ResolveExecutionClusterRequest) (
+		*ExecutionClusterResolution, error)
+}
+
+type ResolveExecutionClusterRequest struct {
+	Project    string
+	Domain     string
+	LaunchPlan string
+	// The version of the launch plan, which defaults to the active version.
+	LaunchPlanVersion string
+	// Resolves the cluster as if the ExecutionClusterLabel matchable attributes had this value.
+	ExecutionClusterLabel string
+	// Resolves the cluster an execution with this name would be assigned. Without a name only the candidate clusters
+	// are resolved, since executions are otherwise assigned one of them at random.
+	ExecutionName string
+}
+
+// The project, domain, workflow and launch plan of the matchable attributes an assignment was made by.
+type MatchedAttributes struct {
+	Project    string
+	Domain     string
+	Workflow   string
+	LaunchPlan string
+}
+
+type ClusterCandidate struct {
+	Cluster     string
+	Weight      float32
+	Probability float64
+}
+
+type ExecutionClusterResolution struct {
+	// The version of the launch plan the execution was resolved for.
+	LaunchPlanVersion string
+	// The assigned cluster, which is only set when it doesn't depend on the name of the execution.
+	Cluster           string
+	ClusterCandidates []ClusterCandidate
+	// The ExecutionClusterLabel the candidates were picked by, which names a cluster pool or a label in the cluster
+	// configuration.
+	ClusterLabel           string
+	ClusterPool            bool
+	ClusterLabelAttributes *MatchedAttributes
+	// The assigned dynamic queue, which is picked at random from the candidates.
+	Queue              string
+	QueueCandidates    []string
+	QueueTag           string
+	QueueTagAttributes *MatchedAttributes
+	// Why the cluster and queue were assigned, in the order the decisions were made.
+	Reasons []string
+}
@@ -0,0 +1,99 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+// The feature flags consulted by managers. Flags named in the feature flags config can be set as well.
+const (
+	// Holds new executions until their project and domain run fewer than their maximum running executions, for
+	// projects which admission control isn't enabled for in config.
+	FeatureFlagAdmissionControl = "admission_control"
+	// Fails new executions of deprecated workflows and tasks rather than warning about them.
+	FeatureFlagRejectDeprecatedExecutions = "reject_deprecated_executions"
+)
+
+// Where the value a feature flag takes for a project comes from.
+type FeatureFlagSource = string
+
+const (
+	FeatureFlagSourceConfig          FeatureFlagSource = "config"
+	FeatureFlagSourceOverride        FeatureFlagSource = "override"
+	FeatureFlagSourceProjectOverride FeatureFlagSource = "project_override"
+)
+
+// Interface for flipping feature flags at runtime, for every project or for single ones, with an audit of who flipped
+// them.
+type FeatureFlagInterface interface {
+	// Overrides the value of a flag for a project, or for every project when no project is set.
+	SetFeatureFlag(ctx context.Context, request SetFeatureFlagRequest) (*FeatureFlagOverride, error)
+	// Removes an override, so that the flag takes its next most specific value.
+	ClearFeatureFlag(ctx context.Context, request ClearFeatureFlagRequest) error
+	// Returns the value a flag takes for a project, or for every project when no project is set.
+	GetFeatureFlagValue(ctx context.Context, name, project string) (*FeatureFlagValue, error)
+	// Returns every flag which is known or overridden, along with its config and overrides.
+	ListFeatureFlags(ctx context.Context) ([]*FeatureFlag, error)
+	// Returns the changes made to flags, most recent first.
+	ListFeatureFlagChanges(ctx context.Context, request FeatureFlagChangeListRequest) (*FeatureFlagChangeList, error)
+}
+
+type SetFeatureFlagRequest struct {
+	Name    string
+	Project string
+	Enabled bool
+	// Why the flag is flipped, which is kept along with the change.
+	Reason string
+}
+
+type ClearFeatureFlagRequest struct {
+	Name    string
+	Project string
+	Reason  string
+}
+
+type FeatureFlagOverride struct {
+	Name string
+	// Empty for an override of every project.
+	Project   string
+	Enabled   bool
+	Principal string
+	UpdatedAt time.Time
+}
+
+type FeatureFlagValue struct {
+	Name    string
+	Project string
+	Enabled bool
+	Source  FeatureFlagSource
+}
+
+type FeatureFlag struct {
+	Name      string
+	Config    runtimeInterfaces.FeatureFlagDefault
+	Overrides []*FeatureFlagOverride
+}
+
+type FeatureFlagChange struct {
+	Name    string
+	Project string
+	// Nil when the override was removed.
+	Enabled   *bool
+	Principal string
+	Reason    string
+	ChangedAt time.Time
+}
+
+type FeatureFlagChangeListRequest struct {
+	// Lists the changes to every flag when empty.
+	Name  string
+	Limit uint32
+	Token string
+}
+
+type FeatureFlagChangeList struct {
+	Changes []*FeatureFlagChange
+	Token   string
+}
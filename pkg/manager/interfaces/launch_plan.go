@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 // Interface for managing Flyte Launch Plans
@@ -23,4 +24,7 @@ type LaunchPlanInterface interface {
 		*admin.LaunchPlanList, error)
 	ListLaunchPlanIds(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (
 		*admin.NamedEntityIdentifierList, error)
+	// Soft-deletes an inactive launch plan, hiding it from gets and lists until it's restored.
+	DeleteLaunchPlan(ctx context.Context, id *core.Identifier) error
+	RestoreLaunchPlan(ctx context.Context, id *core.Identifier) error
 }
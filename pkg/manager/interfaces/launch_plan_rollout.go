@@ -0,0 +1,56 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for managing the rollout policies of launch plans, which activate newly registered versions picked by a
+// label or semantic version constraint once they've soaked, so that releases don't need to be activated by hand.
+type LaunchPlanRolloutInterface interface {
+	// Sets the rollout policy of a launch plan, replacing its existing policy if there is one. The record of the
+	// versions it rolled out and back is kept.
+	SetRolloutPolicy(ctx context.Context, request SetRolloutPolicyRequest) (*RolloutPolicy, error)
+	GetRolloutPolicy(ctx context.Context, id admin.NamedEntityIdentifier) (*RolloutPolicy, error)
+	// Deletes the rollout policy of a launch plan, leaving its active version as it is.
+	DeleteRolloutPolicy(ctx context.Context, id admin.NamedEntityIdentifier) error
+	// Reactivates the version which was active before the policy's last rollout, and keeps the policy from rolling out
+	// the version rolled back again. Returns the identifier of the version now active, if any.
+	RollbackLaunchPlan(ctx context.Context, id admin.NamedEntityIdentifier) (*core.Identifier, error)
+	// Activates the version picked by each rollout policy once it's soaked.
+	AdvanceRollouts(ctx context.Context) error
+}
+
+type SetRolloutPolicyRequest struct {
+	// The launch plan the policy rolls out the versions of.
+	Id admin.NamedEntityIdentifier
+	// Either LABEL, to activate the most recently registered version with a label, or SEMVER, to activate the highest
+	// version matching a semantic version constraint.
+	Strategy string
+	// With the LABEL strategy, the label versions must have, either a key or a key=value pair.
+	Label string
+	// With the SEMVER strategy, the constraint versions must match, e.g. ">=1.2.0, <2.0.0" or "^1.4".
+	VersionConstraint string
+	// How long after being registered versions are activated.
+	SoakPeriod time.Duration
+}
+
+type RolloutPolicy struct {
+	Id                *admin.NamedEntityIdentifier
+	Strategy          string
+	Label             string
+	VersionConstraint string
+	SoakPeriod        time.Duration
+	// The version the policy last activated, and the version which was active before it.
+	RolledOutVersion string
+	PreviousVersion  string
+	RolledOutAt      *time.Time
+	// The versions which were rolled back, which the policy doesn't activate again.
+	RejectedVersions []string
+	Principal        string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,41 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// Interface for reporting how reliably and quickly launch plans run, so that teams can alert on pipeline SLOs.
+type LaunchPlanStatsInterface interface {
+	// Recomputes the stats of every launch plan over the configured windows.
+	ComputeStats(ctx context.Context) error
+	// Returns the stats of a launch plan as of when they were last computed.
+	GetLaunchPlanStats(ctx context.Context, id admin.NamedEntityIdentifier) (*LaunchPlanStats, error)
+}
+
+type LaunchPlanStats struct {
+	Id         *admin.NamedEntityIdentifier
+	ComputedAt time.Time
+	// The fraction of executions expected to succeed. Zero when the launch plan has no objective.
+	SuccessRateObjective float64
+	// In the order the windows are configured.
+	Windows []LaunchPlanWindowStats
+}
+
+// The stats of the executions which terminated within a window ending when the stats were computed. Only succeeded,
+// failed and timed out executions are counted, since aborted executions are usually aborted on purpose.
+type LaunchPlanWindowStats struct {
+	Window      time.Duration
+	Executions  int
+	Succeeded   int
+	SuccessRate float64
+	// How many times faster than sustainable the objective's error budget is being spent, i.e. the fraction of
+	// executions which didn't succeed over the fraction the objective allows. Zero without an objective.
+	BurnRate float64
+	// Percentiles of how long succeeded executions ran for.
+	DurationP50 time.Duration
+	DurationP90 time.Duration
+	DurationP99 time.Duration
+}
@@ -0,0 +1,84 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// The directions lineage can be traversed in from an execution.
+const (
+	LineageUpstream   = "UPSTREAM"
+	LineageDownstream = "DOWNSTREAM"
+	LineageBoth       = "BOTH"
+)
+
+// The kinds of relationship between an execution and one upstream of it.
+const (
+	// The upstream execution launched the downstream one from one of its nodes, such as a launch plan node.
+	RelationshipParent = "PARENT"
+	// The downstream execution recovered the upstream one, reusing the outputs of its successful nodes.
+	RelationshipRecoveredFrom = "RECOVERED_FROM"
+	// The downstream execution relaunched the upstream one with the same inputs.
+	RelationshipRelaunchedFrom = "RELAUNCHED_FROM"
+)
+
+// Whether a task execution read or wrote an artifact.
+const (
+	ArtifactInput  = "INPUT"
+	ArtifactOutput = "OUTPUT"
+)
+
+// The number of relationships traversed from an execution when a depth isn't requested, and the most which can be.
+const DefaultLineageDepth = 1
+const MaxLineageDepth = 10
+
+// Interface for traversing the lineage of executions.
+type LineageInterface interface {
+	// Returns the executions related to an execution, up to the requested depth, along with the artifacts their task
+	// executions read and wrote. Lineage is only recorded when it is enabled.
+	GetLineage(ctx context.Context, request LineageRequest) (*Lineage, error)
+}
+
+type LineageRequest struct {
+	Id *core.WorkflowExecutionIdentifier
+	// One of UPSTREAM, DOWNSTREAM or BOTH. Defaults to BOTH.
+	Direction string
+	// The most relationships traversed from the execution. Defaults to DefaultLineageDepth.
+	Depth uint32
+}
+
+type Lineage struct {
+	// The requested execution is always first, followed by related executions in the order they were reached.
+	Nodes []LineageNode
+	Edges []LineageEdge
+}
+
+type LineageNode struct {
+	Id *core.WorkflowExecutionIdentifier
+	// The number of relationships between this execution and the requested one.
+	Depth uint32
+	Phase core.WorkflowExecution_Phase
+	// How and by whom the execution was launched. Unset if the execution no longer exists.
+	Mode       admin.ExecutionMetadata_ExecutionMode
+	Principal  string
+	LaunchPlan *core.Identifier
+	Artifacts  []Artifact
+}
+
+type Artifact struct {
+	TaskExecutionId *core.TaskExecutionIdentifier
+	// One of INPUT or OUTPUT.
+	Direction string
+	Uri       string
+}
+
+type LineageEdge struct {
+	Upstream   *core.WorkflowExecutionIdentifier
+	Downstream *core.WorkflowExecutionIdentifier
+	// One of PARENT, RECOVERED_FROM or RELAUNCHED_FROM.
+	Kind string
+	// For PARENT relationships, the node of the upstream execution which launched the downstream one.
+	NodeId string
+}
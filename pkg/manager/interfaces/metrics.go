@@ -0,0 +1,58 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for summarizing how executions ran, for rendering them on a timeline.
+type MetricsInterface interface {
+	// Returns the timeline of every node and task execution of an execution, including those of nested nodes.
+	GetExecutionMetrics(ctx context.Context, id core.WorkflowExecutionIdentifier) (*ExecutionMetrics, error)
+}
+
+type ExecutionMetrics struct {
+	Id        *core.WorkflowExecutionIdentifier
+	Phase     core.WorkflowExecution_Phase
+	CreatedAt time.Time
+	// Zero until the execution starts running.
+	StartedAt time.Time
+	Duration  time.Duration
+	// Node executions in the order they were created.
+	Nodes []NodeExecutionTimeline
+	// Totals across all of the node executions.
+	CacheHits int
+	Retries   int
+}
+
+type NodeExecutionTimeline struct {
+	Id *core.NodeExecutionIdentifier
+	// The node which launched this one, such as a dynamic task or subworkflow. Empty for nodes at the top level of the
+	// execution's workflow.
+	ParentNodeId string
+	SpecNodeId   string
+	IsParentNode bool
+	Phase        core.NodeExecution_Phase
+	CreatedAt    time.Time
+	// Zero until the node starts running.
+	StartedAt time.Time
+	// How long the node waited between being created and starting to run.
+	QueuedDuration time.Duration
+	Duration       time.Duration
+	CacheStatus    core.CatalogCacheStatus
+	// The number of attempts after the first.
+	Retries int
+	// Task executions in the order they were attempted.
+	TaskExecutions []TaskExecutionTimeline
+}
+
+type TaskExecutionTimeline struct {
+	Id             *core.TaskExecutionIdentifier
+	Phase          core.TaskExecution_Phase
+	CreatedAt      time.Time
+	StartedAt      time.Time
+	QueuedDuration time.Duration
+	Duration       time.Duration
+}
@@ -4,11 +4,81 @@ import (
 	"context"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
+// Searches the named entities in a project and domain by their names and descriptions.
+type NamedEntitySearchRequest struct {
+	Project string
+	Domain  string
+	Query   string
+	// All workflow, task and launch plan names are searched when empty.
+	ResourceTypes []core.ResourceType
+	Limit         uint32
+}
+
 // Interface for managing metadata associated with NamedEntityIdentifiers
 type NamedEntityInterface interface {
 	GetNamedEntity(ctx context.Context, request admin.NamedEntityGetRequest) (*admin.NamedEntity, error)
 	UpdateNamedEntity(ctx context.Context, request admin.NamedEntityUpdateRequest) (*admin.NamedEntityUpdateResponse, error)
 	ListNamedEntities(ctx context.Context, request admin.NamedEntityListRequest) (*admin.NamedEntityList, error)
+	// Returns the named entities matching a search across resource types, ordered from the closest match.
+	SearchNamedEntities(ctx context.Context, request NamedEntitySearchRequest) (*admin.NamedEntityList, error)
+	// Returns the description document of a named entity, which is empty until one is saved.
+	GetNamedEntityDescription(ctx context.Context, request NamedEntityDescriptionGetRequest) (
+		*NamedEntityDescription, error)
+	// Replaces the description document of a named entity.
+	UpdateNamedEntityDescription(ctx context.Context, request NamedEntityDescriptionUpdateRequest) error
+	// Applies tags such as "team:growth" or "tier:critical" to a workflow or launch plan name.
+	TagNamedEntity(ctx context.Context, request NamedEntityTagRequest) error
+	UntagNamedEntity(ctx context.Context, request NamedEntityTagRequest) error
+	// Returns the tags of a workflow or launch plan name in alphabetical order.
+	GetNamedEntityTags(ctx context.Context, request admin.NamedEntityGetRequest) ([]string, error)
+	// Returns the named entities with a tag across all projects visible to the caller.
+	ListNamedEntitiesByTag(ctx context.Context, request NamedEntityTagListRequest) (*admin.NamedEntityList, error)
+}
+
+// Deprecated workflows and tasks keep running their existing executions and schedules, while new executions of them
+// are warned about or rejected. flyteidl has no deprecated state, so it's the next unused NamedEntityState.
+const NamedEntityStateDeprecated = admin.NamedEntityState(3)
+
+// Documents a workflow, task or launch plan name beyond its short description, so that the console can show
+// documentation for it.
+type NamedEntityDescription struct {
+	// Markdown describing the entity.
+	LongDescription string
+	// A link to the source of the entity, such as the file in its repository which defines it.
+	SourceLink string
+	// The people to contact about the entity.
+	Owners []NamedEntityOwner
+}
+
+type NamedEntityOwner struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+type NamedEntityDescriptionGetRequest struct {
+	ResourceType core.ResourceType
+	ID           *admin.NamedEntityIdentifier
+}
+
+type NamedEntityDescriptionUpdateRequest struct {
+	ResourceType core.ResourceType
+	ID           *admin.NamedEntityIdentifier
+	Description  NamedEntityDescription
+}
+
+type NamedEntityTagRequest struct {
+	ResourceType core.ResourceType
+	ID           *admin.NamedEntityIdentifier
+	Tags         []string
+}
+
+type NamedEntityTagListRequest struct {
+	Tag string
+	// Both workflows and launch plans are listed when empty.
+	ResourceTypes []core.ResourceType
+	Limit         uint32
+	Token         string
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 // Interface for managing Flyte Workflow NodeExecutions
@@ -15,4 +16,18 @@ type NodeExecutionInterface interface {
 	ListNodeExecutionsForTask(ctx context.Context, request admin.NodeExecutionForTaskListRequest) (*admin.NodeExecutionList, error)
 	GetNodeExecutionData(
 		ctx context.Context, request admin.NodeExecutionGetDataRequest) (*admin.NodeExecutionGetDataResponse, error)
+	// Returns the inputs and outputs of a node execution as GetNodeExecutionData does, limited to a subset of the
+	// literals and of their size.
+	GetNodeExecutionDataWithOptions(ctx context.Context, request admin.NodeExecutionGetDataRequest,
+		options GetDataOptions) (*GetDataResponse, error)
+	// Returns the workflow a dynamic node compiled at run time.
+	GetDynamicWorkflow(ctx context.Context, request admin.NodeExecutionGetRequest) (*DynamicWorkflow, error)
+}
+
+type DynamicWorkflow struct {
+	Id *core.Identifier
+	// Signed URL to download the compiled workflow closure from.
+	CompiledWorkflowUrl *admin.UrlBlob
+	// The compiled workflow closure, if config settings permit returning it inline.
+	CompiledWorkflow *core.CompiledWorkflowClosure
 }
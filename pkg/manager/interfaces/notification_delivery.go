@@ -0,0 +1,52 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for finding and resending the notifications published for executions, whose deliveries are recorded when
+// tracking them is enabled.
+type NotificationDeliveryInterface interface {
+	// Lists the deliveries of the notifications published for an execution, most recent first.
+	ListNotificationDeliveries(ctx context.Context, request ListNotificationDeliveriesRequest) (
+		*NotificationDeliveryList, error)
+	// Publishes a failed notification again, recording the attempt in the same delivery.
+	RetryNotificationDelivery(ctx context.Context, request RetryNotificationDeliveryRequest) (
+		*NotificationDelivery, error)
+}
+
+type ListNotificationDeliveriesRequest struct {
+	Id core.WorkflowExecutionIdentifier
+	// Optionally restricts the deliveries to those with a status, one of PENDING, SUCCEEDED or FAILED.
+	Status string
+	Limit  uint32
+	Token  string
+}
+
+type NotificationDeliveryList struct {
+	Deliveries []*NotificationDelivery
+	Token      string
+}
+
+type RetryNotificationDeliveryRequest struct {
+	// The execution the notification was published for.
+	Id         core.WorkflowExecutionIdentifier
+	DeliveryId uint
+}
+
+type NotificationDelivery struct {
+	Id        uint
+	Execution *core.WorkflowExecutionIdentifier
+	// How the notification is sent: email, registeredWebhook, or the chat platform or paging service it's sent to.
+	Type string
+	// PENDING while being sent, then SUCCEEDED, or FAILED until the notification is resent.
+	Status   string
+	Attempts int
+	// The error the last attempt failed with, if it failed.
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
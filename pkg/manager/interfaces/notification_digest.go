@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// Interface for sending the digests executions' emails are batched into.
+type NotificationDigestInterface interface {
+	// Sends each digest which is due, summarizing the executions batched into it in a single email.
+	SendDueDigests(ctx context.Context) error
+}
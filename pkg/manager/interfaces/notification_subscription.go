@@ -0,0 +1,67 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for managing users' subscriptions to the notifications of the executions of launch plans or projects,
+// which add recipients to executions' notifications without re-registering their launch plans.
+type NotificationSubscriptionInterface interface {
+	// Subscribes an address to executions, replacing the phases of its existing subscription to them if there is one.
+	Subscribe(ctx context.Context, request SubscribeRequest) (*NotificationSubscription, error)
+	// Deletes a subscription.
+	Unsubscribe(ctx context.Context, request UnsubscribeRequest) error
+	ListNotificationSubscriptions(ctx context.Context, request ListNotificationSubscriptionsRequest) (
+		*NotificationSubscriptionList, error)
+}
+
+type SubscribeRequest struct {
+	Project string
+	Domain  string
+	// Restricts the subscription to the executions of a launch plan, if set.
+	LaunchPlan string
+	// The terminal execution phases notified.
+	Phases []core.WorkflowExecution_Phase
+	// The channel the subscriber is notified through, one of email, slack or pagerDuty.
+	Type string
+	// The email address notified, which for Slack and PagerDuty is the address of a channel or service.
+	Address string
+}
+
+type UnsubscribeRequest struct {
+	Project string
+	Domain  string
+	Id      uint
+}
+
+type ListNotificationSubscriptionsRequest struct {
+	Project string
+	Domain  string
+	// Optionally restricts the subscriptions to those to a launch plan's executions.
+	LaunchPlan string
+	// Optionally restricts the subscriptions to those of an address.
+	Address string
+	Limit   uint32
+	Token   string
+}
+
+type NotificationSubscriptionList struct {
+	Subscriptions []*NotificationSubscription
+	Token         string
+}
+
+type NotificationSubscription struct {
+	Id         uint
+	Project    string
+	Domain     string
+	LaunchPlan string
+	Phases     []core.WorkflowExecution_Phase
+	Type       string
+	Address    string
+	Principal  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
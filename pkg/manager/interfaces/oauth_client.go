@@ -0,0 +1,63 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Interface for managing the OAuth2 clients users register with the internal authorization server, in addition to
+// the static clients of its config. flyteidl has no RPCs for client registration, and this API isn't served over gRPC or
+// HTTP either: it's only available to code embedding admin, e.g. a deployment's own registration endpoint.
+type OAuthClientInterface interface {
+	// Registers a client owned by the caller, and returns the secret of confidential clients. Secrets can't be
+	// retrieved again. Clients may only be registered with scopes the caller's token holds, and only admins may register
+	// clients with the all or events:write scopes.
+	RegisterOAuthClient(ctx context.Context, request OAuthClientRegisterRequest) (*OAuthClientCredentials, error)
+	// Returns the clients the caller owns, ordered by client id.
+	ListOAuthClients(ctx context.Context, request OAuthClientListRequest) (*OAuthClientList, error)
+	// Replaces the secret of a confidential client the caller owns.
+	RotateOAuthClientSecret(ctx context.Context, clientID string) (*OAuthClientCredentials, error)
+	DeleteOAuthClient(ctx context.Context, clientID string) error
+	// Returns a registered client along with its secret hash, for the authorization server to authenticate it.
+	GetOAuthClient(ctx context.Context, clientID string) (*OAuthClient, error)
+}
+
+type OAuthClient struct {
+	ClientID  string
+	Owner     string
+	CreatedAt time.Time
+	// Public clients, such as command line tools, can't keep a secret.
+	Public        bool
+	RedirectURIs  []string
+	GrantTypes    []string
+	ResponseTypes []string
+	// The scopes tokens issued to the client may be granted.
+	Scopes []string
+	// The bcrypt hash of the secret of confidential clients. Only GetOAuthClient returns it.
+	SecretHash []byte
+}
+
+type OAuthClientRegisterRequest struct {
+	ClientID      string
+	Public        bool
+	RedirectURIs  []string
+	GrantTypes    []string
+	ResponseTypes []string
+	Scopes        []string
+}
+
+type OAuthClientCredentials struct {
+	ClientID string
+	// Empty for public clients.
+	Secret string
+}
+
+type OAuthClientListRequest struct {
+	Limit uint32
+	Token string
+}
+
+type OAuthClientList struct {
+	Clients []*OAuthClient
+	Token   string
+}
@@ -0,0 +1,81 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// Interface for managing orgs, which group the projects of a business unit. Callers whose project visibility is
+// restricted only see the orgs they belong to and the projects of those orgs, and can't manage orgs.
+type OrgInterface interface {
+	CreateOrg(ctx context.Context, request OrgSpec) (*Org, error)
+	GetOrg(ctx context.Context, id string) (*Org, error)
+	// Replaces the name and description of an org.
+	UpdateOrg(ctx context.Context, request OrgSpec) (*Org, error)
+	// Deletes an org, which fails as long as projects belong to it.
+	DeleteOrg(ctx context.Context, id string) error
+	ListOrgs(ctx context.Context, request ListOrgsRequest) (*OrgList, error)
+	// Lists the projects of an org, with the same filters, sorting and pagination as ListProjects.
+	ListOrgProjects(ctx context.Context, request ListOrgProjectsRequest) (*admin.Projects, error)
+	// Moves a project into an org, or out of its org when the org is empty.
+	SetProjectOrg(ctx context.Context, request SetProjectOrgRequest) error
+	// Sums up the domain quotas of the projects of an org.
+	GetOrgQuota(ctx context.Context, id string) (*OrgQuota, error)
+	// Returns the identifiers of the projects which belong to any of the orgs.
+	GetOrgProjectIDs(ctx context.Context, orgs []string) ([]string, error)
+}
+
+type OrgSpec struct {
+	Id          string
+	Name        string
+	Description string
+}
+
+type Org struct {
+	Id          string
+	Name        string
+	Description string
+	// The user who last created or updated the org.
+	Principal string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ListOrgsRequest struct {
+	Limit uint32
+	Token string
+}
+
+type OrgList struct {
+	Orgs  []*Org
+	Token string
+}
+
+type ListOrgProjectsRequest struct {
+	Org     string
+	Limit   uint32
+	Token   string
+	Filters string
+	SortBy  *admin.Sort
+}
+
+type SetProjectOrgRequest struct {
+	Project string
+	// The org the project is moved into, or empty to move the project out of its org.
+	Org string
+}
+
+// The sum of the domain quotas of the projects of an org. Caps are kubernetes quantities, and a cap is unset
+// (unlimited) when any of the quotas leaves it unset. Domains without a quota aren't counted.
+type OrgQuota struct {
+	Org string
+	CPU string
+	// Memory is summed up in the binary units of its quotas, e.g. "96Gi".
+	Memory string
+	GPU    string
+	// The number of projects in the org, and the number of domain quotas set for them.
+	Projects     int
+	DomainQuotas int
+}
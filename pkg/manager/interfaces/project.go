@@ -2,8 +2,10 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 // Interface for managing projects (and domains).
@@ -11,4 +13,72 @@ type ProjectInterface interface {
 	CreateProject(ctx context.Context, request admin.ProjectRegisterRequest) (*admin.ProjectRegisterResponse, error)
 	ListProjects(ctx context.Context, request admin.ProjectListRequest) (*admin.Projects, error)
 	UpdateProject(ctx context.Context, request admin.Project) (*admin.ProjectUpdateResponse, error)
+	// Archives an active project, which hides it from project lists and rejects registrations and executions in it.
+	// Executions which are already running aren't affected.
+	ArchiveProject(ctx context.Context, projectID string) error
+	// Makes an archived project active again, unless it's being purged.
+	RestoreProject(ctx context.Context, projectID string) error
+	// Starts purging an archived project, which hard deletes the workflows, tasks, launch plans, executions and
+	// matchable attributes of the project and then the project itself as AdvanceProjectPurges runs.
+	PurgeProject(ctx context.Context, projectID string) (*ProjectPurge, error)
+	// Returns the progress of the latest purge of a project.
+	GetProjectPurge(ctx context.Context, projectID string) (*ProjectPurge, error)
+	// Deletes the next batches of records of each running purge, and completes those which have deleted everything.
+	AdvanceProjectPurges(ctx context.Context) error
+	GetProjectMetadata(ctx context.Context, projectID string) (*ProjectMetadata, error)
+	// Replaces the metadata of a project. Projects are listed by owner and team with filters such as
+	// "eq(team, ml)", and by the labels they're registered with as "eq(label.<key>, <value>)".
+	UpdateProjectMetadata(ctx context.Context, projectID string, metadata ProjectMetadata) error
+	GetProjectExecutionDefaults(ctx context.Context, projectID string) (*ProjectExecutionDefaults, error)
+	// Replaces the execution defaults of a project. Executions launched afterwards use them for the settings which
+	// neither their request nor their launch plan set.
+	UpdateProjectExecutionDefaults(ctx context.Context, projectID string, defaults ProjectExecutionDefaults) error
+}
+
+// Describes a project beyond what it's registered with, so that installs with many projects can organize them.
+type ProjectMetadata struct {
+	// The user or group who owns the project.
+	Owner string
+	Team  string
+	// Arbitrary key-value metadata, e.g. a cost center.
+	Metadata map[string]string
+	// Custom links shown with the project, such as to its dashboards.
+	Links []ProjectLink
+}
+
+type ProjectLink struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// The settings which executions in a project default to. Unset settings fall back to the matchable attributes and the
+// application config as before.
+type ProjectExecutionDefaults struct {
+	// The kubernetes service account executions run as.
+	ServiceAccount string `json:"service_account,omitempty"`
+	// Where executions write their offloaded data, e.g. s3://my-bucket/raw-data. Only applies to launch plan
+	// executions.
+	RawOutputDataPrefix string `json:"raw_output_data_prefix,omitempty"`
+	MaxParallelism      int32  `json:"max_parallelism,omitempty"`
+	// The security context executions run with. Its run-as identity also defaults the permissions of executions.
+	SecurityContext *core.SecurityContext `json:"security_context,omitempty"`
+}
+
+// The progress of a purge of an archived project.
+type ProjectPurge struct {
+	Project string
+	// RUNNING until every record and the project itself have been deleted, after which it's SUCCEEDED.
+	Phase string
+	// The table being purged. Empty once the purge succeeded.
+	Table string
+	// The number of tables which have been purged, out of TotalTables.
+	PurgedTables   int
+	TotalTables    int
+	DeletedRecords int64
+	// The error the purge last failed with, if its last batch failed. Failed batches are retried.
+	LastError string
+	// The user who purged the project.
+	Principal string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
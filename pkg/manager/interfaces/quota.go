@@ -0,0 +1,42 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Interface for managing the resource quotas of projects and domains. Quotas are projected into the ResourceQuota
+// templates synced by the cluster resource controller, and executions whose tasks request more than a quota allows are
+// rejected at admission.
+type QuotaInterface interface {
+	// Sets the quota of a project and domain, replacing the quota it had.
+	SetDomainQuota(ctx context.Context, request DomainQuota) (*DomainQuota, error)
+	GetDomainQuota(ctx context.Context, project, domain string) (*DomainQuota, error)
+	DeleteDomainQuota(ctx context.Context, project, domain string) error
+	ListDomainQuotas(ctx context.Context, request ListDomainQuotasRequest) (*DomainQuotaList, error)
+}
+
+// The resource quota of a project and domain. Caps are kubernetes quantities, e.g. "16" or "64Gi", and unset caps are
+// unlimited.
+type DomainQuota struct {
+	Project string
+	Domain  string
+	CPU     string
+	Memory  string
+	GPU     string
+	// The user who last set the quota, which is only set in responses.
+	Principal string
+	UpdatedAt time.Time
+}
+
+type ListDomainQuotasRequest struct {
+	// Lists the quotas of a single project when set.
+	Project string
+	Limit   uint32
+	Token   string
+}
+
+type DomainQuotaList struct {
+	DomainQuotas []*DomainQuota
+	Token        string
+}
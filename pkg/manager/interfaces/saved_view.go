@@ -0,0 +1,72 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// The resources saved views list.
+type SavedViewResourceType = string
+
+const (
+	SavedViewExecutions SavedViewResourceType = "executions"
+	SavedViewWorkflows  SavedViewResourceType = "workflows"
+)
+
+// Interface for managing the named filters and sort orders users save for listing executions and workflows, such as
+// "My failed prod runs this week", so that the console and CLI can offer them as persistent views.
+type SavedViewInterface interface {
+	CreateSavedView(ctx context.Context, request SavedView) (*SavedView, error)
+	GetSavedView(ctx context.Context, id SavedViewIdentifier) (*SavedView, error)
+	UpdateSavedView(ctx context.Context, request SavedView) (*SavedView, error)
+	DeleteSavedView(ctx context.Context, id SavedViewIdentifier) error
+	// Returns the views of a project and domain along with the caller's personal views, ordered by name.
+	ListSavedViews(ctx context.Context, request SavedViewListRequest) (*SavedViewList, error)
+	// Lists the executions or workflows which currently match a view.
+	EvaluateSavedView(ctx context.Context, request SavedViewEvaluateRequest) (*SavedViewResults, error)
+}
+
+type SavedViewIdentifier struct {
+	Project string
+	Domain  string
+	Name    string
+	// Personal views are only visible to the user who saved them. Other views are shared with the project.
+	Personal bool
+}
+
+type SavedView struct {
+	ID SavedViewIdentifier
+	// The user who owns a personal view.
+	Owner        string
+	ResourceType SavedViewResourceType
+	// Filters in the syntax of list endpoints. Timestamps can be relative to when the view is evaluated, e.g.
+	// eq(phase,FAILED)+gte(created_at,now-168h).
+	Filters     string
+	SortBy      *admin.Sort
+	Description string
+}
+
+type SavedViewListRequest struct {
+	Project string
+	Domain  string
+	Limit   uint32
+	Token   string
+}
+
+type SavedViewList struct {
+	SavedViews []*SavedView
+	Token      string
+}
+
+type SavedViewEvaluateRequest struct {
+	ID    SavedViewIdentifier
+	Limit uint32
+	Token string
+}
+
+// Exactly one of the lists is set, depending on the resource type of the view.
+type SavedViewResults struct {
+	Executions *admin.ExecutionList
+	Workflows  *admin.WorkflowList
+}
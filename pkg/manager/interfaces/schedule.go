@@ -0,0 +1,65 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for managing how the native scheduler fires the schedules of launch plans. Changes apply to every version
+// of a launch plan's schedule, including versions activated later.
+type ScheduleInterface interface {
+	// Sets the jitter, catch-up and concurrency policies of a launch plan's schedule.
+	UpdateScheduleOptions(ctx context.Context, request UpdateScheduleOptionsRequest) error
+	// Stops a launch plan's schedule from firing, without deactivating the launch plan.
+	PauseSchedule(ctx context.Context, id admin.NamedEntityIdentifier) error
+	// Resumes a paused schedule. The times it was scheduled at while paused aren't fired.
+	ResumeSchedule(ctx context.Context, id admin.NamedEntityIdentifier) error
+	// Lists the times the native scheduler fired a launch plan's schedule, most recently scheduled first.
+	ListScheduleRuns(ctx context.Context, request ListScheduleRunsRequest) (*ScheduleRunList, error)
+}
+
+type UpdateScheduleOptionsRequest struct {
+	// The launch plan whose schedule is updated.
+	Id admin.NamedEntityIdentifier
+	// Delays firing by a random duration of up to the jitter, rounded down to seconds, so that schedules due at the
+	// same time don't all launch at once.
+	Jitter time.Duration
+	// How the schedule catches up on the times it was scheduled at while the scheduler was down, one of ALL, LAST or
+	// SKIP. Defaults to ALL.
+	CatchUpPolicy string
+	// How the schedule fires while executions of the launch plan are still running, one of ALLOW, SKIP, REPLACE or
+	// QUEUE. Defaults to ALLOW.
+	ConcurrencyPolicy string
+}
+
+type ListScheduleRunsRequest struct {
+	// The launch plan whose schedule runs are listed.
+	Id    admin.NamedEntityIdentifier
+	Limit uint32
+	Token string
+}
+
+// An attempt of the native scheduler to launch an execution for a scheduled time.
+type ScheduleRun struct {
+	// The version of the launch plan the schedule was fired for.
+	Version     string
+	ScheduledAt time.Time
+	// The time the attempt finished. Its delay after the scheduled time includes the jitter of the schedule and the
+	// time spent catching up while the scheduler was down.
+	FiredAt time.Time
+	Delay   time.Duration
+	// One of SUCCEEDED, FAILED or SKIPPED.
+	Status string
+	// The execution launched by succeeded runs.
+	ExecutionId *core.WorkflowExecutionIdentifier
+	// Why failed and skipped runs didn't launch an execution.
+	Error string
+}
+
+type ScheduleRunList struct {
+	Runs  []*ScheduleRun
+	Token string
+}
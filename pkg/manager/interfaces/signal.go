@@ -0,0 +1,55 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for managing the signals gate nodes of executions wait on, such as the approval of an approve-before-deploy
+// node, so that they can be satisfied from the console.
+type SignalInterface interface {
+	// Returns the signal a gate node waits on, creating it when the node first runs.
+	GetOrCreateSignal(ctx context.Context, request SignalGetOrCreateRequest) (*Signal, error)
+	ListSignals(ctx context.Context, request SignalListRequest) (*SignalList, error)
+	// Provides the value of a signal. Signals can only be set once.
+	SetSignal(ctx context.Context, request SignalSetRequest) (*Signal, error)
+}
+
+type SignalIdentifier struct {
+	SignalID    string
+	ExecutionID *core.WorkflowExecutionIdentifier
+}
+
+type Signal struct {
+	ID SignalIdentifier
+	// The type of the value the signal expects. Approvals are booleans.
+	Type *core.LiteralType
+	// Nil until the signal is set.
+	Value *core.Literal
+	// The principal who set the signal, and when.
+	SetBy string
+	SetAt *time.Time
+}
+
+type SignalGetOrCreateRequest struct {
+	ID   SignalIdentifier
+	Type *core.LiteralType
+}
+
+type SignalListRequest struct {
+	ExecutionID *core.WorkflowExecutionIdentifier
+	Limit       uint32
+	Token       string
+}
+
+type SignalList struct {
+	Signals []*Signal
+	Token   string
+}
+
+type SignalSetRequest struct {
+	ID    SignalIdentifier
+	Value *core.Literal
+}
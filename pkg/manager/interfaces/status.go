@@ -0,0 +1,66 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// The asynchronous queues whose depths are reported.
+const (
+	// Messages waiting in the outbox to be published as notifications.
+	NotificationsQueue = "notifications"
+	// Messages waiting in the outbox to be published as events.
+	EventsQueue = "events"
+	// Pending executions waiting to be launched.
+	LaunchesQueue = "launches"
+	// Pending executions launched by the scheduler which are waiting to be launched.
+	SchedulerQueue = "scheduler"
+)
+
+// Interface for summarizing the internal state of admin, for triaging it in production without access to Prometheus.
+type StatusInterface interface {
+	GetStatus(ctx context.Context) (*Status, error)
+}
+
+type Status struct {
+	GeneratedAt time.Time      `json:"generatedAt"`
+	Database    DatabaseStatus `json:"database"`
+	// The number of items waiting in each of the asynchronous queues, keyed by queue.
+	QueueDepths map[string]int64 `json:"queueDepths"`
+	// Keyed by the resource cached: workflows, tasks or launch_plans.
+	Caches map[string]CacheStatus `json:"caches"`
+	// The health states of the clusters which have one. Clusters without one are healthy.
+	Clusters []ClusterStatus `json:"clusters"`
+	// A checksum of the config admin runs with, which differs between replicas only if their configs do.
+	ConfigChecksum string `json:"configChecksum"`
+	// Why the parts of the status which couldn't be collected are missing, keyed by part.
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// The state of the database connection pool.
+type DatabaseStatus struct {
+	MaxOpenConnections int `json:"maxOpenConnections"`
+	OpenConnections    int `json:"openConnections"`
+	InUse              int `json:"inUse"`
+	Idle               int `json:"idle"`
+	// The number of times a connection was waited for, and for how long in total.
+	WaitCount    int64         `json:"waitCount"`
+	WaitDuration time.Duration `json:"waitDuration"`
+}
+
+type CacheStatus struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+	// The fraction of gets served from the cache. Zero before the first get.
+	HitRate float64 `json:"hitRate"`
+}
+
+type ClusterStatus struct {
+	Cluster                  string     `json:"cluster"`
+	State                    string     `json:"state"`
+	Reason                   string     `json:"reason,omitempty"`
+	ProbedAt                 *time.Time `json:"probedAt,omitempty"`
+	ProbeError               string     `json:"probeError,omitempty"`
+	ConsecutiveProbeFailures int32      `json:"consecutiveProbeFailures"`
+	PropellerHeartbeatAt     *time.Time `json:"propellerHeartbeatAt,omitempty"`
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 // Interface for managing Flyte Tasks
 type TaskInterface interface {
 	CreateTask(ctx context.Context, request admin.TaskCreateRequest) (*admin.TaskCreateResponse, error)
 	GetTask(ctx context.Context, request admin.ObjectGetRequest) (*admin.Task, error)
+	// Returns the tasks with the given identifiers, in the same order, fetched in a single round trip.
+	GetTasks(ctx context.Context, ids []*core.Identifier) (*admin.TaskList, error)
 	ListTasks(ctx context.Context, request admin.ResourceListRequest) (*admin.TaskList, error)
 	ListUniqueTaskIdentifiers(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (
 		*admin.NamedEntityIdentifierList, error)
+	// Compares two registered versions of a task.
+	GetTaskDiff(ctx context.Context, request VersionDiffRequest) (*TaskDiff, error)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 // Interface for managing Flyte Workflow TaskExecutions
@@ -14,4 +15,14 @@ type TaskExecutionInterface interface {
 	ListTaskExecutions(ctx context.Context, request admin.TaskExecutionListRequest) (*admin.TaskExecutionList, error)
 	GetTaskExecutionData(
 		ctx context.Context, request admin.TaskExecutionGetDataRequest) (*admin.TaskExecutionGetDataResponse, error)
+	// Returns every log link and deck available for a task execution.
+	GetTaskExecutionLinks(ctx context.Context, request admin.TaskExecutionGetRequest) (*TaskExecutionLinks, error)
+}
+
+type TaskExecutionLinks struct {
+	// The logs reported by the plugin which ran the task execution, followed by those generated from the configured
+	// log templates.
+	Logs []*core.TaskLog
+	// Where the deck rendered by the task execution is stored. Empty when it didn't render one.
+	DeckURI string
 }
@@ -0,0 +1,66 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for managing the triggers users register for a project and domain, which launch the active version of a
+// launch plan whenever an external event source posts a signed payload to them.
+type TriggerInterface interface {
+	// Registers a trigger, replacing the trigger with the same name in the project and domain if there is one.
+	RegisterTrigger(ctx context.Context, request RegisterTriggerRequest) (*Trigger, error)
+	GetTrigger(ctx context.Context, id admin.NamedEntityIdentifier) (*Trigger, error)
+	ListTriggers(ctx context.Context, request ListTriggersRequest) (*TriggerList, error)
+	DeleteTrigger(ctx context.Context, id admin.NamedEntityIdentifier) error
+	// Verifies the signature of a payload posted to a trigger and launches the active version of its launch plan with
+	// the inputs mapped from the payload.
+	FireTrigger(ctx context.Context, request FireTriggerRequest) (*core.WorkflowExecutionIdentifier, error)
+}
+
+type RegisterTriggerRequest struct {
+	Id admin.NamedEntityIdentifier
+	// The name of the launch plan launched, which is in the trigger's project and domain.
+	LaunchPlan string
+	// Payloads must be signed with the secret, which is never returned, in the X-Flyte-Signature header as the hex
+	// encoded HMAC-SHA256 of the timestamp, event id and payload joined with periods, prefixed with sha256=.
+	Secret string
+	// Maps launch plan input names to the dot-separated paths of the JSON payload fields they're set from, e.g.
+	// {"date": "partition.date"}. Inputs without a field in the payload keep their default.
+	InputMappings map[string]string
+}
+
+type FireTriggerRequest struct {
+	Id admin.NamedEntityIdentifier
+	// The JSON payload posted by the event source.
+	Payload   []byte
+	Signature string
+	// Identifies the event, so that redelivering it doesn't launch another execution.
+	EventId string
+	// When the payload was signed, in seconds since the epoch. Payloads signed more than a few minutes ago are rejected.
+	Timestamp string
+}
+
+type ListTriggersRequest struct {
+	Project string
+	Domain  string
+	Limit   uint32
+	Token   string
+}
+
+type TriggerList struct {
+	Triggers []*Trigger
+	Token    string
+}
+
+type Trigger struct {
+	Id            *admin.NamedEntityIdentifier
+	LaunchPlan    string
+	InputMappings map[string]string
+	Principal     string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
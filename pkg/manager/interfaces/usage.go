@@ -0,0 +1,52 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Resources used over time: CPU core-hours, GPU-hours and memory GiB-hours.
+type ResourceUsage struct {
+	CPUHours      float64
+	GPUHours      float64
+	MemoryGBHours float64
+}
+
+// Interface for reporting the resources executions used, for chargeback.
+type UsageInterface interface {
+	// Returns the usage of the task executions of an execution which have terminated so far.
+	GetExecutionUsage(ctx context.Context, id core.WorkflowExecutionIdentifier) (*ExecutionUsage, error)
+	// Returns the usage of each domain of a project, or of a single domain, over a time window.
+	GetUsageReport(ctx context.Context, request UsageReportRequest) (*UsageReport, error)
+}
+
+type ExecutionUsage struct {
+	Id             *core.WorkflowExecutionIdentifier
+	Usage          ResourceUsage
+	TaskExecutions int
+}
+
+type UsageReportRequest struct {
+	Project string
+	// Reports every domain of the project when empty.
+	Domain string
+	// Task executions are counted in the window if they terminated at or after StartTime and before EndTime.
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type UsageReport struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Domains   []DomainUsage
+}
+
+type DomainUsage struct {
+	Project        string
+	Domain         string
+	Usage          ResourceUsage
+	Executions     int
+	TaskExecutions int
+}
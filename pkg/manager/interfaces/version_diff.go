@@ -0,0 +1,58 @@
+package interfaces
+
+import (
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Identifies two registered versions of a workflow or task to compare, for example by release review tooling.
+type VersionDiffRequest struct {
+	ID            *admin.NamedEntityIdentifier
+	BaseVersion   string
+	TargetVersion string
+}
+
+// The inputs and outputs added, removed or changed to a different type between two versions of an interface.
+type InterfaceDiff struct {
+	AddedInputs    []string
+	RemovedInputs  []string
+	ChangedInputs  []string
+	AddedOutputs   []string
+	RemovedOutputs []string
+	ChangedOutputs []string
+}
+
+// A value which differs between two versions.
+type StringChange struct {
+	Base   string
+	Target string
+}
+
+type ResourcesChange struct {
+	Base   *core.Resources
+	Target *core.Resources
+}
+
+// The structural differences between two versions of a task, computed from their compiled templates. Changes which
+// are nil didn't happen.
+type TaskDiff struct {
+	BaseID    *core.Identifier
+	TargetID  *core.Identifier
+	Interface InterfaceDiff
+	Type      *StringChange
+	Image     *StringChange
+	Resources *ResourcesChange
+}
+
+// The structural differences between two versions of a workflow, computed from their compiled closures.
+type WorkflowDiff struct {
+	BaseID    *core.Identifier
+	TargetID  *core.Identifier
+	Interface InterfaceDiff
+	// The ids of the nodes of the workflow which were added, removed or changed.
+	AddedNodes   []string
+	RemovedNodes []string
+	ChangedNodes []string
+	// The tasks which both versions run, matched by name, that changed between them.
+	Tasks []*TaskDiff
+}
@@ -0,0 +1,88 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Interface for managing the webhooks users register for a project and domain, which are posted a payload rendered
+// from their template whenever an execution in the project and domain reaches one of their phases.
+type WebhookInterface interface {
+	// Registers a webhook, replacing the webhook with the same name in the project and domain if there is one.
+	RegisterWebhook(ctx context.Context, request RegisterWebhookRequest) (*Webhook, error)
+	GetWebhook(ctx context.Context, id admin.NamedEntityIdentifier) (*Webhook, error)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequest) (*WebhookList, error)
+	// Deletes a webhook. The record of deliveries to it is kept.
+	DeleteWebhook(ctx context.Context, id admin.NamedEntityIdentifier) error
+	// Lists the deliveries to a webhook, most recent first.
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequest) (*WebhookDeliveryList, error)
+}
+
+type RegisterWebhookRequest struct {
+	Id admin.NamedEntityIdentifier
+	// The URL payloads are posted to.
+	URL string
+	// Payloads are signed with the secret, which is never returned, in the X-Flyte-Signature header as the hex encoded
+	// HMAC-SHA256 of the payload prefixed with sha256=.
+	Secret string
+	// A Go text/template which renders JSON from the fields of notifications.WebhookPayloadData, e.g.
+	// {"execution": {{ json .Name }}, "phase": {{ json .Phase }}}.
+	Template string
+	// The terminal execution phases delivered to the webhook.
+	Phases []core.WorkflowExecution_Phase
+}
+
+type ListWebhooksRequest struct {
+	Project string
+	Domain  string
+	Limit   uint32
+	Token   string
+}
+
+type WebhookList struct {
+	Webhooks []*Webhook
+	Token    string
+}
+
+type Webhook struct {
+	Id        *admin.NamedEntityIdentifier
+	URL       string
+	Template  string
+	Phases    []core.WorkflowExecution_Phase
+	Principal string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ListWebhookDeliveriesRequest struct {
+	// The webhook to list the deliveries to.
+	Id admin.NamedEntityIdentifier
+	// Optionally restricts the deliveries to those of an execution.
+	ExecutionName string
+	// Optionally restricts the deliveries to those with a status, one of PENDING, SUCCEEDED or FAILED.
+	Status string
+	Limit  uint32
+	Token  string
+}
+
+type WebhookDeliveryList struct {
+	Deliveries []*WebhookDelivery
+	Token      string
+}
+
+type WebhookDelivery struct {
+	Id        uint
+	Execution *core.WorkflowExecutionIdentifier
+	Phase     core.WorkflowExecution_Phase
+	// PENDING while being delivered, then SUCCEEDED, or FAILED once every attempt to deliver it failed.
+	Status   string
+	Attempts int
+	// The status code of the webhook's last response, if it responded.
+	ResponseCode int
+	Error        string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -4,13 +4,36 @@ import (
 	"context"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 // Interface for managing Flyte Workflows
 type WorkflowInterface interface {
 	CreateWorkflow(ctx context.Context, request admin.WorkflowCreateRequest) (*admin.WorkflowCreateResponse, error)
 	GetWorkflow(ctx context.Context, request admin.ObjectGetRequest) (*admin.Workflow, error)
+	// Returns the workflows with the given identifiers, in the same order, fetched in a single round trip.
+	GetWorkflows(ctx context.Context, ids []*core.Identifier) (*admin.WorkflowList, error)
 	ListWorkflows(ctx context.Context, request admin.ResourceListRequest) (*admin.WorkflowList, error)
 	ListWorkflowIdentifiers(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (
 		*admin.NamedEntityIdentifierList, error)
+	// Compares two registered versions of a workflow.
+	GetWorkflowDiff(ctx context.Context, request VersionDiffRequest) (*WorkflowDiff, error)
+	// Registers a workflow whose spec was uploaded through the data proxy in parts, for specs which exceed the gRPC
+	// message size limit.
+	CreateWorkflowFromParts(ctx context.Context, request WorkflowFromPartsCreateRequest) (
+		*admin.WorkflowCreateResponse, error)
+	// Compiles a workflow without registering anything. Issues which keep the workflow from being registered are
+	// reported as diagnostics rather than errors.
+	CompileWorkflow(ctx context.Context, request WorkflowCompileRequest) (*WorkflowCompileResponse, error)
+}
+
+// Registers the workflow spec which the parts concatenate to, once the whole spec has been assembled and checked
+// against its digest.
+type WorkflowFromPartsCreateRequest struct {
+	Id *core.Identifier
+	// The native URLs the data proxy returned for the uploaded parts, in the order the spec is assembled in. The parts
+	// concatenate to a serialized admin.WorkflowSpec.
+	PartURLs []string
+	// The md5 digest of the whole serialized spec.
+	ContentMD5 []byte
 }
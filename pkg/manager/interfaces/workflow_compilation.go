@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Compiles a workflow the way registering it would, without registering the workflow or its tasks, so that SDKs and CI
+// can validate workflows against the compiler admin runs.
+type WorkflowCompileRequest struct {
+	Id   *core.Identifier
+	Spec *admin.WorkflowSpec
+	// Tasks to compile along with the workflow. The workflow's other tasks and launch plans must be registered in the
+	// project and domain of the workflow.
+	Tasks []*admin.TaskSpec
+}
+
+// An issue which keeps a workflow from being registered.
+type CompileDiagnostic struct {
+	// The task, launch plan or workflow with the issue.
+	Id      *core.Identifier
+	Message string
+}
+
+type WorkflowCompileResponse struct {
+	// Unset when there are any diagnostics.
+	CompiledWorkflow *core.CompiledWorkflowClosure
+	// The tasks of the request which compiled, in the order they were given.
+	CompiledTasks []*core.CompiledTask
+	Diagnostics   []CompileDiagnostic
+	// The version of the flytepropeller compiler admin was built with.
+	CompilerVersion string
+}
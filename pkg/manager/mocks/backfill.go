@@ -0,0 +1,57 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+type CreateBackfillFunc func(ctx context.Context, request interfaces.CreateBackfillRequest) (*interfaces.Backfill, error)
+type GetBackfillFunc func(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.Backfill, error)
+type ListBackfillsFunc func(ctx context.Context, request interfaces.ListBackfillsRequest) (*interfaces.BackfillList, error)
+type TerminateBackfillFunc func(ctx context.Context, id admin.NamedEntityIdentifier, cause string) error
+type AdvanceBackfillsFunc func(ctx context.Context) error
+
+type BackfillManager struct {
+	CreateBackfillFunc    CreateBackfillFunc
+	GetBackfillFunc       GetBackfillFunc
+	ListBackfillsFunc     ListBackfillsFunc
+	TerminateBackfillFunc TerminateBackfillFunc
+	AdvanceBackfillsFunc  AdvanceBackfillsFunc
+}
+
+func (m *BackfillManager) CreateBackfill(ctx context.Context, request interfaces.CreateBackfillRequest) (*interfaces.Backfill, error) {
+	if m.CreateBackfillFunc != nil {
+		return m.CreateBackfillFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *BackfillManager) GetBackfill(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.Backfill, error) {
+	if m.GetBackfillFunc != nil {
+		return m.GetBackfillFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *BackfillManager) ListBackfills(ctx context.Context, request interfaces.ListBackfillsRequest) (*interfaces.BackfillList, error) {
+	if m.ListBackfillsFunc != nil {
+		return m.ListBackfillsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *BackfillManager) TerminateBackfill(ctx context.Context, id admin.NamedEntityIdentifier, cause string) error {
+	if m.TerminateBackfillFunc != nil {
+		return m.TerminateBackfillFunc(ctx, id, cause)
+	}
+	return nil
+}
+
+func (m *BackfillManager) AdvanceBackfills(ctx context.Context) error {
+	if m.AdvanceBackfillsFunc != nil {
+		return m.AdvanceBackfillsFunc(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+type CheckCachedOutputsFunc func(ctx context.Context, request interfaces.CheckCachedOutputsRequest) (*interfaces.CheckCachedOutputsResponse, error)
+type GetExecutionCacheSummaryFunc func(ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.ExecutionCacheSummary, error)
+type EvictCacheFunc func(ctx context.Context, request interfaces.CacheEvictRequest) (*interfaces.CacheEvictResponse, error)
+type ListCacheEvictionsFunc func(ctx context.Context, request interfaces.CacheEvictionListRequest) (*interfaces.CacheEvictionList, error)
+
+type CacheManager struct {
+	CheckCachedOutputsFunc       CheckCachedOutputsFunc
+	GetExecutionCacheSummaryFunc GetExecutionCacheSummaryFunc
+	EvictCacheFunc               EvictCacheFunc
+	ListCacheEvictionsFunc       ListCacheEvictionsFunc
+}
+
+func (m *CacheManager) CheckCachedOutputs(ctx context.Context, request interfaces.CheckCachedOutputsRequest) (*interfaces.CheckCachedOutputsResponse, error) {
+	if m.CheckCachedOutputsFunc != nil {
+		return m.CheckCachedOutputsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *CacheManager) GetExecutionCacheSummary(ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.ExecutionCacheSummary, error) {
+	if m.GetExecutionCacheSummaryFunc != nil {
+		return m.GetExecutionCacheSummaryFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *CacheManager) EvictCache(ctx context.Context, request interfaces.CacheEvictRequest) (*interfaces.CacheEvictResponse, error) {
+	if m.EvictCacheFunc != nil {
+		return m.EvictCacheFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *CacheManager) ListCacheEvictions(ctx context.Context, request interfaces.CacheEvictionListRequest) (*interfaces.CacheEvictionList, error) {
+	if m.ListCacheEvictionsFunc != nil {
+		return m.ListCacheEvictionsFunc(ctx, request)
+	}
+	return nil, nil
+}
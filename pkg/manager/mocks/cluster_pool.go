@@ -0,0 +1,65 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type SetClusterPoolFunc func(ctx context.Context, request interfaces.SetClusterPoolRequest) (*interfaces.ClusterPool, error)
+type GetClusterPoolFunc func(ctx context.Context, name string) (*interfaces.ClusterPool, error)
+type ListClusterPoolsFunc func(ctx context.Context, request interfaces.ListClusterPoolsRequest) (*interfaces.ClusterPoolList, error)
+type DeleteClusterPoolFunc func(ctx context.Context, name string) error
+type SetClusterHealthFunc func(ctx context.Context, request interfaces.SetClusterHealthRequest) (*interfaces.ClusterHealth, error)
+type ListClustersFunc func(ctx context.Context) (*interfaces.ClusterList, error)
+
+type ClusterPoolManager struct {
+	SetClusterPoolFunc    SetClusterPoolFunc
+	GetClusterPoolFunc    GetClusterPoolFunc
+	ListClusterPoolsFunc  ListClusterPoolsFunc
+	DeleteClusterPoolFunc DeleteClusterPoolFunc
+	SetClusterHealthFunc  SetClusterHealthFunc
+	ListClustersFunc      ListClustersFunc
+}
+
+func (m *ClusterPoolManager) SetClusterPool(ctx context.Context, request interfaces.SetClusterPoolRequest) (*interfaces.ClusterPool, error) {
+	if m.SetClusterPoolFunc != nil {
+		return m.SetClusterPoolFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *ClusterPoolManager) GetClusterPool(ctx context.Context, name string) (*interfaces.ClusterPool, error) {
+	if m.GetClusterPoolFunc != nil {
+		return m.GetClusterPoolFunc(ctx, name)
+	}
+	return nil, nil
+}
+
+func (m *ClusterPoolManager) ListClusterPools(ctx context.Context, request interfaces.ListClusterPoolsRequest) (*interfaces.ClusterPoolList, error) {
+	if m.ListClusterPoolsFunc != nil {
+		return m.ListClusterPoolsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *ClusterPoolManager) DeleteClusterPool(ctx context.Context, name string) error {
+	if m.DeleteClusterPoolFunc != nil {
+		return m.DeleteClusterPoolFunc(ctx, name)
+	}
+	return nil
+}
+
+func (m *ClusterPoolManager) SetClusterHealth(ctx context.Context, request interfaces.SetClusterHealthRequest) (*interfaces.ClusterHealth, error) {
+	if m.SetClusterHealthFunc != nil {
+		return m.SetClusterHealthFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *ClusterPoolManager) ListClusters(ctx context.Context) (*interfaces.ClusterList, error) {
+	if m.ListClustersFunc != nil {
+		return m.ListClustersFunc(ctx)
+	}
+	return nil, nil
+}
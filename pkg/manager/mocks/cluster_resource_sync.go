@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type GetClusterResourceSyncStatusFunc func(ctx context.Context, request interfaces.ClusterResourceSyncStatusRequest) (*interfaces.ClusterResourceSyncStatus, error)
+
+type ClusterResourceSyncManager struct {
+	GetClusterResourceSyncStatusFunc GetClusterResourceSyncStatusFunc
+}
+
+func (m *ClusterResourceSyncManager) GetClusterResourceSyncStatus(ctx context.Context, request interfaces.ClusterResourceSyncStatusRequest) (*interfaces.ClusterResourceSyncStatus, error) {
+	if m.GetClusterResourceSyncStatusFunc != nil {
+		return m.GetClusterResourceSyncStatusFunc(ctx, request)
+	}
+	return nil, nil
+}
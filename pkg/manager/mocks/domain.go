@@ -0,0 +1,65 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type CreateDomainFunc func(ctx context.Context, request interfaces.Domain) (*interfaces.Domain, error)
+type GetDomainFunc func(ctx context.Context, id string) (*interfaces.Domain, error)
+type ListDomainsFunc func(ctx context.Context) (*interfaces.DomainList, error)
+type UpdateDomainFunc func(ctx context.Context, request interfaces.Domain) (*interfaces.Domain, error)
+type DeleteDomainFunc func(ctx context.Context, id string) error
+type SeedDomainsFunc func(ctx context.Context) error
+
+type DomainManager struct {
+	CreateDomainFunc CreateDomainFunc
+	GetDomainFunc    GetDomainFunc
+	ListDomainsFunc  ListDomainsFunc
+	UpdateDomainFunc UpdateDomainFunc
+	DeleteDomainFunc DeleteDomainFunc
+	SeedDomainsFunc  SeedDomainsFunc
+}
+
+func (m *DomainManager) CreateDomain(ctx context.Context, request interfaces.Domain) (*interfaces.Domain, error) {
+	if m.CreateDomainFunc != nil {
+		return m.CreateDomainFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *DomainManager) GetDomain(ctx context.Context, id string) (*interfaces.Domain, error) {
+	if m.GetDomainFunc != nil {
+		return m.GetDomainFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *DomainManager) ListDomains(ctx context.Context) (*interfaces.DomainList, error) {
+	if m.ListDomainsFunc != nil {
+		return m.ListDomainsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *DomainManager) UpdateDomain(ctx context.Context, request interfaces.Domain) (*interfaces.Domain, error) {
+	if m.UpdateDomainFunc != nil {
+		return m.UpdateDomainFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *DomainManager) DeleteDomain(ctx context.Context, id string) error {
+	if m.DeleteDomainFunc != nil {
+		return m.DeleteDomainFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *DomainManager) SeedDomains(ctx context.Context) error {
+	if m.SeedDomainsFunc != nil {
+		return m.SeedDomainsFunc(ctx)
+	}
+	return nil
+}
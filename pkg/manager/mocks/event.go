@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+type CreateEventsFunc func(ctx context.Context, request interfaces.EventBatchRequest) (*interfaces.EventBatchResponse, error)
+
+type GetEventConsistencyReportFunc func(ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.EventConsistencyReport, error)
+
+type ListExecutionEventsFunc func(ctx context.Context, request interfaces.ExecutionEventListRequest) (*interfaces.ExecutionEventList, error)
+
+type EventManager struct {
+	CreateEventsFunc              CreateEventsFunc
+	GetEventConsistencyReportFunc GetEventConsistencyReportFunc
+	ListExecutionEventsFunc       ListExecutionEventsFunc
+}
+
+func (m *EventManager) CreateEvents(ctx context.Context, request interfaces.EventBatchRequest) (*interfaces.EventBatchResponse, error) {
+	if m.CreateEventsFunc != nil {
+		return m.CreateEventsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *EventManager) GetEventConsistencyReport(ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.EventConsistencyReport, error) {
+	if m.GetEventConsistencyReportFunc != nil {
+		return m.GetEventConsistencyReportFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *EventManager) ListExecutionEvents(ctx context.Context, request interfaces.ExecutionEventListRequest) (*interfaces.ExecutionEventList, error) {
+	if m.ListExecutionEventsFunc != nil {
+		return m.ListExecutionEventsFunc(ctx, request)
+	}
+	return nil, nil
+}
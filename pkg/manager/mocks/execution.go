@@ -4,35 +4,66 @@ import (
 	"context"
 	"time"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 type CreateExecutionFunc func(
 	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
 	*admin.ExecutionCreateResponse, error)
+type DryRunExecutionFunc func(
+	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
+	*interfaces.DryRunExecutionResponse, error)
 type RelaunchExecutionFunc func(
 	ctx context.Context, request admin.ExecutionRelaunchRequest, requestedAt time.Time) (
 	*admin.ExecutionCreateResponse, error)
 type RecoverExecutionFunc func(ctx context.Context, request admin.ExecutionRecoverRequest, requestedAt time.Time) (
 	*admin.ExecutionCreateResponse, error)
+type RecoverExecutionWithOverridesFunc func(ctx context.Context, request admin.ExecutionRecoverRequest,
+	overrides interfaces.RecoverExecutionOverrides, requestedAt time.Time) (*admin.ExecutionCreateResponse, error)
 type CreateExecutionEventFunc func(ctx context.Context, request admin.WorkflowExecutionEventRequest) (
 	*admin.WorkflowExecutionEventResponse, error)
 type GetExecutionFunc func(ctx context.Context, request admin.WorkflowExecutionGetRequest) (*admin.Execution, error)
 type GetExecutionDataFunc func(ctx context.Context, request admin.WorkflowExecutionGetDataRequest) (
 	*admin.WorkflowExecutionGetDataResponse, error)
+type GetExecutionDataWithOptionsFunc func(ctx context.Context, request admin.WorkflowExecutionGetDataRequest,
+	options interfaces.GetDataOptions) (*interfaces.GetDataResponse, error)
 type ListExecutionFunc func(ctx context.Context, request admin.ResourceListRequest) (*admin.ExecutionList, error)
 type TerminateExecutionFunc func(
 	ctx context.Context, request admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error)
 
+type CountExecutionsFunc func(ctx context.Context, request interfaces.ExecutionCountRequest) (
+	*interfaces.ExecutionCountResponse, error)
+
+type ListExecutionLabelsFunc func(ctx context.Context, request interfaces.ExecutionLabelListRequest) (
+	*interfaces.ExecutionLabelListResponse, error)
+
+type TerminateExecutionsFunc func(ctx context.Context, request interfaces.TerminateExecutionsRequest) (
+	*interfaces.TerminateExecutionsResponse, error)
+
+type DeleteExecutionFunc func(ctx context.Context, id *core.WorkflowExecutionIdentifier) error
+
+type DispatchPendingExecutionsFunc func(ctx context.Context) error
+
 type MockExecutionManager struct {
-	createExecutionFunc      CreateExecutionFunc
-	relaunchExecutionFunc    RelaunchExecutionFunc
-	RecoverExecutionFunc     RecoverExecutionFunc
-	createExecutionEventFunc CreateExecutionEventFunc
-	getExecutionFunc         GetExecutionFunc
-	getExecutionDataFunc     GetExecutionDataFunc
-	listExecutionFunc        ListExecutionFunc
-	terminateExecutionFunc   TerminateExecutionFunc
+	createExecutionFunc               CreateExecutionFunc
+	DryRunExecutionFunc               DryRunExecutionFunc
+	relaunchExecutionFunc             RelaunchExecutionFunc
+	RecoverExecutionFunc              RecoverExecutionFunc
+	RecoverExecutionWithOverridesFunc RecoverExecutionWithOverridesFunc
+	createExecutionEventFunc          CreateExecutionEventFunc
+	getExecutionFunc                  GetExecutionFunc
+	getExecutionDataFunc              GetExecutionDataFunc
+	GetExecutionDataWithOptionsFunc   GetExecutionDataWithOptionsFunc
+	listExecutionFunc                 ListExecutionFunc
+	terminateExecutionFunc            TerminateExecutionFunc
+	CountExecutionsFunc               CountExecutionsFunc
+	ListExecutionLabelsFunc           ListExecutionLabelsFunc
+	TerminateExecutionsFunc           TerminateExecutionsFunc
+	DeleteExecutionFunc               DeleteExecutionFunc
+	RestoreExecutionFunc              DeleteExecutionFunc
+	DispatchPendingExecutionsFunc     DispatchPendingExecutionsFunc
 }
 
 func (m *MockExecutionManager) SetCreateCallback(createFunction CreateExecutionFunc) {
@@ -48,6 +79,15 @@ func (m *MockExecutionManager) CreateExecution(
 	return nil, nil
 }
 
+func (m *MockExecutionManager) DryRunExecution(
+	ctx context.Context, request admin.ExecutionCreateRequest, requestedAt time.Time) (
+	*interfaces.DryRunExecutionResponse, error) {
+	if m.DryRunExecutionFunc != nil {
+		return m.DryRunExecutionFunc(ctx, request, requestedAt)
+	}
+	return nil, nil
+}
+
 func (m *MockExecutionManager) SetRelaunchCallback(relaunchFunction RelaunchExecutionFunc) {
 	m.relaunchExecutionFunc = relaunchFunction
 }
@@ -73,6 +113,15 @@ func (m *MockExecutionManager) RecoverExecution(ctx context.Context, request adm
 	return &admin.ExecutionCreateResponse{}, nil
 }
 
+func (m *MockExecutionManager) RecoverExecutionWithOverrides(ctx context.Context,
+	request admin.ExecutionRecoverRequest, overrides interfaces.RecoverExecutionOverrides, requestedAt time.Time) (
+	*admin.ExecutionCreateResponse, error) {
+	if m.RecoverExecutionWithOverridesFunc != nil {
+		return m.RecoverExecutionWithOverridesFunc(ctx, request, overrides, requestedAt)
+	}
+	return &admin.ExecutionCreateResponse{}, nil
+}
+
 func (m *MockExecutionManager) CreateWorkflowEvent(
 	ctx context.Context,
 	request admin.WorkflowExecutionEventRequest) (*admin.WorkflowExecutionEventResponse, error) {
@@ -106,6 +155,15 @@ func (m *MockExecutionManager) GetExecutionData(ctx context.Context, request adm
 	return nil, nil
 }
 
+func (m *MockExecutionManager) GetExecutionDataWithOptions(ctx context.Context,
+	request admin.WorkflowExecutionGetDataRequest, options interfaces.GetDataOptions) (
+	*interfaces.GetDataResponse, error) {
+	if m.GetExecutionDataWithOptionsFunc != nil {
+		return m.GetExecutionDataWithOptionsFunc(ctx, request, options)
+	}
+	return &interfaces.GetDataResponse{}, nil
+}
+
 func (m *MockExecutionManager) SetListCallback(listExecutionFunc ListExecutionFunc) {
 	m.listExecutionFunc = listExecutionFunc
 }
@@ -129,3 +187,48 @@ func (m *MockExecutionManager) TerminateExecution(
 	}
 	return nil, nil
 }
+
+func (m *MockExecutionManager) CountExecutions(ctx context.Context,
+	request interfaces.ExecutionCountRequest) (*interfaces.ExecutionCountResponse, error) {
+	if m.CountExecutionsFunc != nil {
+		return m.CountExecutionsFunc(ctx, request)
+	}
+	return &interfaces.ExecutionCountResponse{}, nil
+}
+
+func (m *MockExecutionManager) ListExecutionLabels(ctx context.Context,
+	request interfaces.ExecutionLabelListRequest) (*interfaces.ExecutionLabelListResponse, error) {
+	if m.ListExecutionLabelsFunc != nil {
+		return m.ListExecutionLabelsFunc(ctx, request)
+	}
+	return &interfaces.ExecutionLabelListResponse{}, nil
+}
+
+func (m *MockExecutionManager) TerminateExecutions(ctx context.Context,
+	request interfaces.TerminateExecutionsRequest) (*interfaces.TerminateExecutionsResponse, error) {
+	if m.TerminateExecutionsFunc != nil {
+		return m.TerminateExecutionsFunc(ctx, request)
+	}
+	return &interfaces.TerminateExecutionsResponse{}, nil
+}
+
+func (m *MockExecutionManager) DeleteExecution(ctx context.Context, id *core.WorkflowExecutionIdentifier) error {
+	if m.DeleteExecutionFunc != nil {
+		return m.DeleteExecutionFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockExecutionManager) RestoreExecution(ctx context.Context, id *core.WorkflowExecutionIdentifier) error {
+	if m.RestoreExecutionFunc != nil {
+		return m.RestoreExecutionFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockExecutionManager) DispatchPendingExecutions(ctx context.Context) error {
+	if m.DispatchPendingExecutionsFunc != nil {
+		return m.DispatchPendingExecutionsFunc(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type AddExecutionCommentFunc func(ctx context.Context, request interfaces.ExecutionCommentAddRequest) (
+	*interfaces.ExecutionComment, error)
+type ListExecutionCommentsFunc func(ctx context.Context, request interfaces.ExecutionCommentListRequest) (
+	*interfaces.ExecutionCommentList, error)
+
+type ExecutionCommentManager struct {
+	AddExecutionCommentFunc   AddExecutionCommentFunc
+	ListExecutionCommentsFunc ListExecutionCommentsFunc
+}
+
+func (m *ExecutionCommentManager) AddExecutionComment(
+	ctx context.Context, request interfaces.ExecutionCommentAddRequest) (*interfaces.ExecutionComment, error) {
+	if m.AddExecutionCommentFunc != nil {
+		return m.AddExecutionCommentFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *ExecutionCommentManager) ListExecutionComments(
+	ctx context.Context, request interfaces.ExecutionCommentListRequest) (*interfaces.ExecutionCommentList, error) {
+	if m.ListExecutionCommentsFunc != nil {
+		return m.ListExecutionCommentsFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type UpdateExecutionIdentityAttributesFunc func(
+	ctx context.Context, project, domain string, attributes interfaces.ExecutionIdentityAttributes) error
+type GetExecutionIdentityAttributesFunc func(ctx context.Context, project, domain string) (
+	*interfaces.ExecutionIdentityAttributes, error)
+type DeleteExecutionIdentityAttributesFunc func(ctx context.Context, project, domain string) error
+
+type ExecutionIdentityManager struct {
+	UpdateExecutionIdentityAttributesFunc UpdateExecutionIdentityAttributesFunc
+	GetExecutionIdentityAttributesFunc    GetExecutionIdentityAttributesFunc
+	DeleteExecutionIdentityAttributesFunc DeleteExecutionIdentityAttributesFunc
+}
+
+func (m *ExecutionIdentityManager) UpdateExecutionIdentityAttributes(
+	ctx context.Context, project, domain string, attributes interfaces.ExecutionIdentityAttributes) error {
+	if m.UpdateExecutionIdentityAttributesFunc != nil {
+		return m.UpdateExecutionIdentityAttributesFunc(ctx, project, domain, attributes)
+	}
+	return nil
+}
+
+func (m *ExecutionIdentityManager) GetExecutionIdentityAttributes(
+	ctx context.Context, project, domain string) (*interfaces.ExecutionIdentityAttributes, error) {
+	if m.GetExecutionIdentityAttributesFunc != nil {
+		return m.GetExecutionIdentityAttributesFunc(ctx, project, domain)
+	}
+	return nil, nil
+}
+
+func (m *ExecutionIdentityManager) DeleteExecutionIdentityAttributes(
+	ctx context.Context, project, domain string) error {
+	if m.DeleteExecutionIdentityAttributesFunc != nil {
+		return m.DeleteExecutionIdentityAttributesFunc(ctx, project, domain)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type ResolveExecutionClusterFunc func(ctx context.Context, request interfaces.ResolveExecutionClusterRequest) (*interfaces.ExecutionClusterResolution, error)
+
+type ExecutionRoutingManager struct {
+	ResolveExecutionClusterFunc ResolveExecutionClusterFunc
+}
+
+func (m *ExecutionRoutingManager) ResolveExecutionCluster(ctx context.Context, request interfaces.ResolveExecutionClusterRequest) (*interfaces.ExecutionClusterResolution, error) {
+	if m.ResolveExecutionClusterFunc != nil {
+		return m.ResolveExecutionClusterFunc(ctx, request)
+	}
+	return nil, nil
+}
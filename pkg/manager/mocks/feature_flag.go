@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type SetFeatureFlagFunc func(ctx context.Context, request interfaces.SetFeatureFlagRequest) (
+	*interfaces.FeatureFlagOverride, error)
+type ClearFeatureFlagFunc func(ctx context.Context, request interfaces.ClearFeatureFlagRequest) error
+type GetFeatureFlagValueFunc func(ctx context.Context, name, project string) (*interfaces.FeatureFlagValue, error)
+type ListFeatureFlagsFunc func(ctx context.Context) ([]*interfaces.FeatureFlag, error)
+type ListFeatureFlagChangesFunc func(ctx context.Context, request interfaces.FeatureFlagChangeListRequest) (
+	*interfaces.FeatureFlagChangeList, error)
+
+type FeatureFlagManager struct {
+	SetFeatureFlagFunc         SetFeatureFlagFunc
+	ClearFeatureFlagFunc       ClearFeatureFlagFunc
+	GetFeatureFlagValueFunc    GetFeatureFlagValueFunc
+	ListFeatureFlagsFunc       ListFeatureFlagsFunc
+	ListFeatureFlagChangesFunc ListFeatureFlagChangesFunc
+}
+
+func (m *FeatureFlagManager) SetFeatureFlag(ctx context.Context, request interfaces.SetFeatureFlagRequest) (
+	*interfaces.FeatureFlagOverride, error) {
+	if m.SetFeatureFlagFunc != nil {
+		return m.SetFeatureFlagFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *FeatureFlagManager) ClearFeatureFlag(ctx context.Context, request interfaces.ClearFeatureFlagRequest) error {
+	if m.ClearFeatureFlagFunc != nil {
+		return m.ClearFeatureFlagFunc(ctx, request)
+	}
+	return nil
+}
+
+func (m *FeatureFlagManager) GetFeatureFlagValue(ctx context.Context, name, project string) (
+	*interfaces.FeatureFlagValue, error) {
+	if m.GetFeatureFlagValueFunc != nil {
+		return m.GetFeatureFlagValueFunc(ctx, name, project)
+	}
+	return nil, nil
+}
+
+func (m *FeatureFlagManager) ListFeatureFlags(ctx context.Context) ([]*interfaces.FeatureFlag, error) {
+	if m.ListFeatureFlagsFunc != nil {
+		return m.ListFeatureFlagsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *FeatureFlagManager) ListFeatureFlagChanges(ctx context.Context,
+	request interfaces.FeatureFlagChangeListRequest) (*interfaces.FeatureFlagChangeList, error) {
+	if m.ListFeatureFlagChangesFunc != nil {
+		return m.ListFeatureFlagChangesFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 type CreateLaunchPlanFunc func(ctx context.Context, request admin.LaunchPlanCreateRequest) (
@@ -23,6 +24,8 @@ type ListLaunchPlanIdsFunc func(ctx context.Context, request admin.NamedEntityId
 type ListActiveLaunchPlansFunc func(ctx context.Context, request admin.ActiveLaunchPlanListRequest) (
 	*admin.LaunchPlanList, error)
 
+type DeleteLaunchPlanFunc func(ctx context.Context, id *core.Identifier) error
+
 type MockLaunchPlanManager struct {
 	createLaunchPlanFunc      CreateLaunchPlanFunc
 	updateLaunchPlanFunc      UpdateLaunchPlanFunc
@@ -31,6 +34,8 @@ type MockLaunchPlanManager struct {
 	listLaunchPlansFunc       ListLaunchPlansFunc
 	listLaunchPlanIdsFunc     ListLaunchPlanIdsFunc
 	listActiveLaunchPlansFunc ListActiveLaunchPlansFunc
+	DeleteLaunchPlanFunc      DeleteLaunchPlanFunc
+	RestoreLaunchPlanFunc     DeleteLaunchPlanFunc
 }
 
 func (r *MockLaunchPlanManager) SetCreateCallback(createFunction CreateLaunchPlanFunc) {
@@ -110,6 +115,20 @@ func (r *MockLaunchPlanManager) ListLaunchPlanIds(ctx context.Context, request a
 	return nil, nil
 }
 
+func (r *MockLaunchPlanManager) DeleteLaunchPlan(ctx context.Context, id *core.Identifier) error {
+	if r.DeleteLaunchPlanFunc != nil {
+		return r.DeleteLaunchPlanFunc(ctx, id)
+	}
+	return nil
+}
+
+func (r *MockLaunchPlanManager) RestoreLaunchPlan(ctx context.Context, id *core.Identifier) error {
+	if r.RestoreLaunchPlanFunc != nil {
+		return r.RestoreLaunchPlanFunc(ctx, id)
+	}
+	return nil
+}
+
 func NewMockLaunchPlanManager() interfaces.LaunchPlanInterface {
 	return &MockLaunchPlanManager{}
 }
@@ -0,0 +1,58 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+type SetRolloutPolicyFunc func(ctx context.Context, request interfaces.SetRolloutPolicyRequest) (*interfaces.RolloutPolicy, error)
+type GetRolloutPolicyFunc func(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.RolloutPolicy, error)
+type DeleteRolloutPolicyFunc func(ctx context.Context, id admin.NamedEntityIdentifier) error
+type RollbackLaunchPlanFunc func(ctx context.Context, id admin.NamedEntityIdentifier) (*core.Identifier, error)
+type AdvanceRolloutsFunc func(ctx context.Context) error
+
+type LaunchPlanRolloutManager struct {
+	SetRolloutPolicyFunc    SetRolloutPolicyFunc
+	GetRolloutPolicyFunc    GetRolloutPolicyFunc
+	DeleteRolloutPolicyFunc DeleteRolloutPolicyFunc
+	RollbackLaunchPlanFunc  RollbackLaunchPlanFunc
+	AdvanceRolloutsFunc     AdvanceRolloutsFunc
+}
+
+func (m *LaunchPlanRolloutManager) SetRolloutPolicy(ctx context.Context, request interfaces.SetRolloutPolicyRequest) (*interfaces.RolloutPolicy, error) {
+	if m.SetRolloutPolicyFunc != nil {
+		return m.SetRolloutPolicyFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *LaunchPlanRolloutManager) GetRolloutPolicy(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.RolloutPolicy, error) {
+	if m.GetRolloutPolicyFunc != nil {
+		return m.GetRolloutPolicyFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *LaunchPlanRolloutManager) DeleteRolloutPolicy(ctx context.Context, id admin.NamedEntityIdentifier) error {
+	if m.DeleteRolloutPolicyFunc != nil {
+		return m.DeleteRolloutPolicyFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *LaunchPlanRolloutManager) RollbackLaunchPlan(ctx context.Context, id admin.NamedEntityIdentifier) (*core.Identifier, error) {
+	if m.RollbackLaunchPlanFunc != nil {
+		return m.RollbackLaunchPlanFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *LaunchPlanRolloutManager) AdvanceRollouts(ctx context.Context) error {
+	if m.AdvanceRolloutsFunc != nil {
+		return m.AdvanceRolloutsFunc(ctx)
+	}
+	return nil
+}
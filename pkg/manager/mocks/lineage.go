@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type GetLineageFunc func(ctx context.Context, request interfaces.LineageRequest) (*interfaces.Lineage, error)
+
+type LineageManager struct {
+	GetLineageFunc GetLineageFunc
+}
+
+func (m *LineageManager) GetLineage(ctx context.Context, request interfaces.LineageRequest) (*interfaces.Lineage, error) {
+	if m.GetLineageFunc != nil {
+		return m.GetLineageFunc(ctx, request)
+	}
+	return nil, nil
+}
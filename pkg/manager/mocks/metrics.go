@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+type GetExecutionMetricsFunc func(ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.ExecutionMetrics, error)
+
+type MetricsManager struct {
+	GetExecutionMetricsFunc GetExecutionMetricsFunc
+}
+
+func (m *MetricsManager) GetExecutionMetrics(ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.ExecutionMetrics, error) {
+	if m.GetExecutionMetricsFunc != nil {
+		return m.GetExecutionMetricsFunc(ctx, id)
+	}
+	return nil, nil
+}
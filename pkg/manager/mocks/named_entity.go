@@ -3,17 +3,31 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
 type GetNamedEntityFunc func(ctx context.Context, request admin.NamedEntityGetRequest) (*admin.NamedEntity, error)
 type UpdateNamedEntityFunc func(ctx context.Context, request admin.NamedEntityUpdateRequest) (*admin.NamedEntityUpdateResponse, error)
 type ListNamedEntitiesFunc func(ctx context.Context, request admin.NamedEntityListRequest) (*admin.NamedEntityList, error)
+type SearchNamedEntitiesFunc func(ctx context.Context, request interfaces.NamedEntitySearchRequest) (*admin.NamedEntityList, error)
+type GetNamedEntityDescriptionFunc func(ctx context.Context, request interfaces.NamedEntityDescriptionGetRequest) (*interfaces.NamedEntityDescription, error)
+type UpdateNamedEntityDescriptionFunc func(ctx context.Context, request interfaces.NamedEntityDescriptionUpdateRequest) error
+type NamedEntityTagFunc func(ctx context.Context, request interfaces.NamedEntityTagRequest) error
+type GetNamedEntityTagsFunc func(ctx context.Context, request admin.NamedEntityGetRequest) ([]string, error)
+type ListNamedEntitiesByTagFunc func(ctx context.Context, request interfaces.NamedEntityTagListRequest) (*admin.NamedEntityList, error)
 
 type NamedEntityManager struct {
-	GetNamedEntityFunc    GetNamedEntityFunc
-	UpdateNamedEntityFunc UpdateNamedEntityFunc
-	ListNamedEntitiesFunc ListNamedEntitiesFunc
+	GetNamedEntityFunc               GetNamedEntityFunc
+	UpdateNamedEntityFunc            UpdateNamedEntityFunc
+	ListNamedEntitiesFunc            ListNamedEntitiesFunc
+	SearchNamedEntitiesFunc          SearchNamedEntitiesFunc
+	GetNamedEntityDescriptionFunc    GetNamedEntityDescriptionFunc
+	UpdateNamedEntityDescriptionFunc UpdateNamedEntityDescriptionFunc
+	TagNamedEntityFunc               NamedEntityTagFunc
+	UntagNamedEntityFunc             NamedEntityTagFunc
+	GetNamedEntityTagsFunc           GetNamedEntityTagsFunc
+	ListNamedEntitiesByTagFunc       ListNamedEntitiesByTagFunc
 }
 
 func (m *NamedEntityManager) GetNamedEntity(ctx context.Context, request admin.NamedEntityGetRequest) (*admin.NamedEntity, error) {
@@ -36,3 +50,52 @@ func (m *NamedEntityManager) ListNamedEntities(ctx context.Context, request admi
 	}
 	return nil, nil
 }
+
+func (m *NamedEntityManager) SearchNamedEntities(ctx context.Context, request interfaces.NamedEntitySearchRequest) (*admin.NamedEntityList, error) {
+	if m.SearchNamedEntitiesFunc != nil {
+		return m.SearchNamedEntitiesFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *NamedEntityManager) GetNamedEntityDescription(ctx context.Context, request interfaces.NamedEntityDescriptionGetRequest) (*interfaces.NamedEntityDescription, error) {
+	if m.GetNamedEntityDescriptionFunc != nil {
+		return m.GetNamedEntityDescriptionFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *NamedEntityManager) UpdateNamedEntityDescription(ctx context.Context, request interfaces.NamedEntityDescriptionUpdateRequest) error {
+	if m.UpdateNamedEntityDescriptionFunc != nil {
+		return m.UpdateNamedEntityDescriptionFunc(ctx, request)
+	}
+	return nil
+}
+
+func (m *NamedEntityManager) TagNamedEntity(ctx context.Context, request interfaces.NamedEntityTagRequest) error {
+	if m.TagNamedEntityFunc != nil {
+		return m.TagNamedEntityFunc(ctx, request)
+	}
+	return nil
+}
+
+func (m *NamedEntityManager) UntagNamedEntity(ctx context.Context, request interfaces.NamedEntityTagRequest) error {
+	if m.UntagNamedEntityFunc != nil {
+		return m.UntagNamedEntityFunc(ctx, request)
+	}
+	return nil
+}
+
+func (m *NamedEntityManager) GetNamedEntityTags(ctx context.Context, request admin.NamedEntityGetRequest) ([]string, error) {
+	if m.GetNamedEntityTagsFunc != nil {
+		return m.GetNamedEntityTagsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *NamedEntityManager) ListNamedEntitiesByTag(ctx context.Context, request interfaces.NamedEntityTagListRequest) (*admin.NamedEntityList, error) {
+	if m.ListNamedEntitiesByTagFunc != nil {
+		return m.ListNamedEntitiesByTagFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
@@ -15,6 +16,10 @@ type ListNodeExecutionsForTaskFunc func(ctx context.Context, request admin.NodeE
 	*admin.NodeExecutionList, error)
 type GetNodeExecutionDataFunc func(
 	ctx context.Context, request admin.NodeExecutionGetDataRequest) (*admin.NodeExecutionGetDataResponse, error)
+type GetNodeExecutionDataWithOptionsFunc func(ctx context.Context, request admin.NodeExecutionGetDataRequest,
+	options interfaces.GetDataOptions) (*interfaces.GetDataResponse, error)
+type GetDynamicWorkflowFunc func(
+	ctx context.Context, request admin.NodeExecutionGetRequest) (*interfaces.DynamicWorkflow, error)
 
 type MockNodeExecutionManager struct {
 	createNodeEventFunc           CreateNodeEventFunc
@@ -22,6 +27,9 @@ type MockNodeExecutionManager struct {
 	listNodeExecutionsFunc        ListNodeExecutionsFunc
 	listNodeExecutionsForTaskFunc ListNodeExecutionsForTaskFunc
 	getNodeExecutionDataFunc      GetNodeExecutionDataFunc
+	getDynamicWorkflowFunc        GetDynamicWorkflowFunc
+
+	GetNodeExecutionDataWithOptionsFunc GetNodeExecutionDataWithOptionsFunc
 }
 
 func (m *MockNodeExecutionManager) SetCreateNodeEventCallback(createNodeEventFunc CreateNodeEventFunc) {
@@ -84,3 +92,23 @@ func (m *MockNodeExecutionManager) GetNodeExecutionData(
 	}
 	return nil, nil
 }
+
+func (m *MockNodeExecutionManager) GetNodeExecutionDataWithOptions(ctx context.Context,
+	request admin.NodeExecutionGetDataRequest, options interfaces.GetDataOptions) (*interfaces.GetDataResponse, error) {
+	if m.GetNodeExecutionDataWithOptionsFunc != nil {
+		return m.GetNodeExecutionDataWithOptionsFunc(ctx, request, options)
+	}
+	return &interfaces.GetDataResponse{}, nil
+}
+
+func (m *MockNodeExecutionManager) SetGetDynamicWorkflowFunc(getDynamicWorkflowFunc GetDynamicWorkflowFunc) {
+	m.getDynamicWorkflowFunc = getDynamicWorkflowFunc
+}
+
+func (m *MockNodeExecutionManager) GetDynamicWorkflow(
+	ctx context.Context, request admin.NodeExecutionGetRequest) (*interfaces.DynamicWorkflow, error) {
+	if m.getDynamicWorkflowFunc != nil {
+		return m.getDynamicWorkflowFunc(ctx, request)
+	}
+	return nil, nil
+}
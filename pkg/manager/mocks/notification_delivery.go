@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type ListNotificationDeliveriesFunc func(ctx context.Context, request interfaces.ListNotificationDeliveriesRequest) (*interfaces.NotificationDeliveryList, error)
+type RetryNotificationDeliveryFunc func(ctx context.Context, request interfaces.RetryNotificationDeliveryRequest) (*interfaces.NotificationDelivery, error)
+
+type NotificationDeliveryManager struct {
+	ListNotificationDeliveriesFunc ListNotificationDeliveriesFunc
+	RetryNotificationDeliveryFunc  RetryNotificationDeliveryFunc
+}
+
+func (m *NotificationDeliveryManager) ListNotificationDeliveries(ctx context.Context, request interfaces.ListNotificationDeliveriesRequest) (*interfaces.NotificationDeliveryList, error) {
+	if m.ListNotificationDeliveriesFunc != nil {
+		return m.ListNotificationDeliveriesFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *NotificationDeliveryManager) RetryNotificationDelivery(ctx context.Context, request interfaces.RetryNotificationDeliveryRequest) (*interfaces.NotificationDelivery, error) {
+	if m.RetryNotificationDeliveryFunc != nil {
+		return m.RetryNotificationDeliveryFunc(ctx, request)
+	}
+	return nil, nil
+}
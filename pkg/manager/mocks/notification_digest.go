@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"context"
+)
+
+type SendDueDigestsFunc func(ctx context.Context) error
+
+type NotificationDigestManager struct {
+	SendDueDigestsFunc SendDueDigestsFunc
+}
+
+func (m *NotificationDigestManager) SendDueDigests(ctx context.Context) error {
+	if m.SendDueDigestsFunc != nil {
+		return m.SendDueDigestsFunc(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type SubscribeFunc func(ctx context.Context, request interfaces.SubscribeRequest) (*interfaces.NotificationSubscription, error)
+type UnsubscribeFunc func(ctx context.Context, request interfaces.UnsubscribeRequest) error
+type ListNotificationSubscriptionsFunc func(ctx context.Context, request interfaces.ListNotificationSubscriptionsRequest) (*interfaces.NotificationSubscriptionList, error)
+
+type NotificationSubscriptionManager struct {
+	SubscribeFunc                     SubscribeFunc
+	UnsubscribeFunc                   UnsubscribeFunc
+	ListNotificationSubscriptionsFunc ListNotificationSubscriptionsFunc
+}
+
+func (m *NotificationSubscriptionManager) Subscribe(ctx context.Context, request interfaces.SubscribeRequest) (*interfaces.NotificationSubscription, error) {
+	if m.SubscribeFunc != nil {
+		return m.SubscribeFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *NotificationSubscriptionManager) Unsubscribe(ctx context.Context, request interfaces.UnsubscribeRequest) error {
+	if m.UnsubscribeFunc != nil {
+		return m.UnsubscribeFunc(ctx, request)
+	}
+	return nil
+}
+
+func (m *NotificationSubscriptionManager) ListNotificationSubscriptions(ctx context.Context, request interfaces.ListNotificationSubscriptionsRequest) (*interfaces.NotificationSubscriptionList, error) {
+	if m.ListNotificationSubscriptionsFunc != nil {
+		return m.ListNotificationSubscriptionsFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type RegisterOAuthClientFunc func(ctx context.Context, request interfaces.OAuthClientRegisterRequest) (
+	*interfaces.OAuthClientCredentials, error)
+type ListOAuthClientsFunc func(ctx context.Context, request interfaces.OAuthClientListRequest) (
+	*interfaces.OAuthClientList, error)
+type RotateOAuthClientSecretFunc func(ctx context.Context, clientID string) (*interfaces.OAuthClientCredentials, error)
+type DeleteOAuthClientFunc func(ctx context.Context, clientID string) error
+type GetOAuthClientFunc func(ctx context.Context, clientID string) (*interfaces.OAuthClient, error)
+
+type OAuthClientManager struct {
+	RegisterOAuthClientFunc     RegisterOAuthClientFunc
+	ListOAuthClientsFunc        ListOAuthClientsFunc
+	RotateOAuthClientSecretFunc RotateOAuthClientSecretFunc
+	DeleteOAuthClientFunc       DeleteOAuthClientFunc
+	GetOAuthClientFunc          GetOAuthClientFunc
+}
+
+func (m *OAuthClientManager) RegisterOAuthClient(
+	ctx context.Context, request interfaces.OAuthClientRegisterRequest) (*interfaces.OAuthClientCredentials, error) {
+	if m.RegisterOAuthClientFunc != nil {
+		return m.RegisterOAuthClientFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *OAuthClientManager) ListOAuthClients(
+	ctx context.Context, request interfaces.OAuthClientListRequest) (*interfaces.OAuthClientList, error) {
+	if m.ListOAuthClientsFunc != nil {
+		return m.ListOAuthClientsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *OAuthClientManager) RotateOAuthClientSecret(
+	ctx context.Context, clientID string) (*interfaces.OAuthClientCredentials, error) {
+	if m.RotateOAuthClientSecretFunc != nil {
+		return m.RotateOAuthClientSecretFunc(ctx, clientID)
+	}
+	return nil, nil
+}
+
+func (m *OAuthClientManager) DeleteOAuthClient(ctx context.Context, clientID string) error {
+	if m.DeleteOAuthClientFunc != nil {
+		return m.DeleteOAuthClientFunc(ctx, clientID)
+	}
+	return nil
+}
+
+func (m *OAuthClientManager) GetOAuthClient(ctx context.Context, clientID string) (*interfaces.OAuthClient, error) {
+	if m.GetOAuthClientFunc != nil {
+		return m.GetOAuthClientFunc(ctx, clientID)
+	}
+	return nil, nil
+}
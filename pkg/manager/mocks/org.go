@@ -0,0 +1,93 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+type CreateOrgFunc func(ctx context.Context, request interfaces.OrgSpec) (*interfaces.Org, error)
+type GetOrgFunc func(ctx context.Context, id string) (*interfaces.Org, error)
+type UpdateOrgFunc func(ctx context.Context, request interfaces.OrgSpec) (*interfaces.Org, error)
+type DeleteOrgFunc func(ctx context.Context, id string) error
+type ListOrgsFunc func(ctx context.Context, request interfaces.ListOrgsRequest) (*interfaces.OrgList, error)
+type ListOrgProjectsFunc func(ctx context.Context, request interfaces.ListOrgProjectsRequest) (*admin.Projects, error)
+type SetProjectOrgFunc func(ctx context.Context, request interfaces.SetProjectOrgRequest) error
+type GetOrgQuotaFunc func(ctx context.Context, id string) (*interfaces.OrgQuota, error)
+type GetOrgProjectIDsFunc func(ctx context.Context, orgs []string) ([]string, error)
+
+type OrgManager struct {
+	CreateOrgFunc        CreateOrgFunc
+	GetOrgFunc           GetOrgFunc
+	UpdateOrgFunc        UpdateOrgFunc
+	DeleteOrgFunc        DeleteOrgFunc
+	ListOrgsFunc         ListOrgsFunc
+	ListOrgProjectsFunc  ListOrgProjectsFunc
+	SetProjectOrgFunc    SetProjectOrgFunc
+	GetOrgQuotaFunc      GetOrgQuotaFunc
+	GetOrgProjectIDsFunc GetOrgProjectIDsFunc
+}
+
+func (m *OrgManager) CreateOrg(ctx context.Context, request interfaces.OrgSpec) (*interfaces.Org, error) {
+	if m.CreateOrgFunc != nil {
+		return m.CreateOrgFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *OrgManager) GetOrg(ctx context.Context, id string) (*interfaces.Org, error) {
+	if m.GetOrgFunc != nil {
+		return m.GetOrgFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *OrgManager) UpdateOrg(ctx context.Context, request interfaces.OrgSpec) (*interfaces.Org, error) {
+	if m.UpdateOrgFunc != nil {
+		return m.UpdateOrgFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *OrgManager) DeleteOrg(ctx context.Context, id string) error {
+	if m.DeleteOrgFunc != nil {
+		return m.DeleteOrgFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *OrgManager) ListOrgs(ctx context.Context, request interfaces.ListOrgsRequest) (*interfaces.OrgList, error) {
+	if m.ListOrgsFunc != nil {
+		return m.ListOrgsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *OrgManager) ListOrgProjects(ctx context.Context, request interfaces.ListOrgProjectsRequest) (*admin.Projects, error) {
+	if m.ListOrgProjectsFunc != nil {
+		return m.ListOrgProjectsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *OrgManager) SetProjectOrg(ctx context.Context, request interfaces.SetProjectOrgRequest) error {
+	if m.SetProjectOrgFunc != nil {
+		return m.SetProjectOrgFunc(ctx, request)
+	}
+	return nil
+}
+
+func (m *OrgManager) GetOrgQuota(ctx context.Context, id string) (*interfaces.OrgQuota, error) {
+	if m.GetOrgQuotaFunc != nil {
+		return m.GetOrgQuotaFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *OrgManager) GetOrgProjectIDs(ctx context.Context, orgs []string) ([]string, error) {
+	if m.GetOrgProjectIDsFunc != nil {
+		return m.GetOrgProjectIDsFunc(ctx, orgs)
+	}
+	return nil, nil
+}
@@ -3,17 +3,34 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
 type CreateProjectFunc func(ctx context.Context, request admin.ProjectRegisterRequest) (*admin.ProjectRegisterResponse, error)
 type ListProjectFunc func(ctx context.Context, request admin.ProjectListRequest) (*admin.Projects, error)
 type UpdateProjectFunc func(ctx context.Context, request admin.Project) (*admin.ProjectUpdateResponse, error)
+type ProjectStateFunc func(ctx context.Context, projectID string) error
+type ProjectPurgeFunc func(ctx context.Context, projectID string) (*interfaces.ProjectPurge, error)
+type GetProjectMetadataFunc func(ctx context.Context, projectID string) (*interfaces.ProjectMetadata, error)
+type UpdateProjectMetadataFunc func(ctx context.Context, projectID string, metadata interfaces.ProjectMetadata) error
+type GetProjectExecutionDefaultsFunc func(ctx context.Context, projectID string) (
+	*interfaces.ProjectExecutionDefaults, error)
+type UpdateProjectExecutionDefaultsFunc func(
+	ctx context.Context, projectID string, defaults interfaces.ProjectExecutionDefaults) error
 
 type MockProjectManager struct {
-	listProjectFunc   ListProjectFunc
-	createProjectFunc CreateProjectFunc
-	updateProjectFunc UpdateProjectFunc
+	listProjectFunc                    ListProjectFunc
+	createProjectFunc                  CreateProjectFunc
+	updateProjectFunc                  UpdateProjectFunc
+	archiveProjectFunc                 ProjectStateFunc
+	restoreProjectFunc                 ProjectStateFunc
+	purgeProjectFunc                   ProjectPurgeFunc
+	getProjectPurgeFunc                ProjectPurgeFunc
+	getProjectMetadataFunc             GetProjectMetadataFunc
+	updateProjectMetadataFunc          UpdateProjectMetadataFunc
+	getProjectExecutionDefaultsFunc    GetProjectExecutionDefaultsFunc
+	updateProjectExecutionDefaultsFunc UpdateProjectExecutionDefaultsFunc
 }
 
 func (m *MockProjectManager) SetCreateProject(createProjectFunc CreateProjectFunc) {
@@ -45,3 +62,101 @@ func (m *MockProjectManager) ListProjects(
 	}
 	return nil, nil
 }
+
+func (m *MockProjectManager) SetArchiveProjectCallback(archiveProjectFunc ProjectStateFunc) {
+	m.archiveProjectFunc = archiveProjectFunc
+}
+
+func (m *MockProjectManager) ArchiveProject(ctx context.Context, projectID string) error {
+	if m.archiveProjectFunc != nil {
+		return m.archiveProjectFunc(ctx, projectID)
+	}
+	return nil
+}
+
+func (m *MockProjectManager) SetRestoreProjectCallback(restoreProjectFunc ProjectStateFunc) {
+	m.restoreProjectFunc = restoreProjectFunc
+}
+
+func (m *MockProjectManager) RestoreProject(ctx context.Context, projectID string) error {
+	if m.restoreProjectFunc != nil {
+		return m.restoreProjectFunc(ctx, projectID)
+	}
+	return nil
+}
+
+func (m *MockProjectManager) SetPurgeProjectCallback(purgeProjectFunc ProjectPurgeFunc) {
+	m.purgeProjectFunc = purgeProjectFunc
+}
+
+func (m *MockProjectManager) PurgeProject(ctx context.Context, projectID string) (*interfaces.ProjectPurge, error) {
+	if m.purgeProjectFunc != nil {
+		return m.purgeProjectFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectManager) SetGetProjectPurgeCallback(getProjectPurgeFunc ProjectPurgeFunc) {
+	m.getProjectPurgeFunc = getProjectPurgeFunc
+}
+
+func (m *MockProjectManager) GetProjectPurge(ctx context.Context, projectID string) (*interfaces.ProjectPurge, error) {
+	if m.getProjectPurgeFunc != nil {
+		return m.getProjectPurgeFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectManager) AdvanceProjectPurges(ctx context.Context) error {
+	return nil
+}
+
+func (m *MockProjectManager) SetGetProjectMetadataCallback(getProjectMetadataFunc GetProjectMetadataFunc) {
+	m.getProjectMetadataFunc = getProjectMetadataFunc
+}
+
+func (m *MockProjectManager) GetProjectMetadata(
+	ctx context.Context, projectID string) (*interfaces.ProjectMetadata, error) {
+	if m.getProjectMetadataFunc != nil {
+		return m.getProjectMetadataFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectManager) SetUpdateProjectMetadataCallback(updateProjectMetadataFunc UpdateProjectMetadataFunc) {
+	m.updateProjectMetadataFunc = updateProjectMetadataFunc
+}
+
+func (m *MockProjectManager) UpdateProjectMetadata(
+	ctx context.Context, projectID string, metadata interfaces.ProjectMetadata) error {
+	if m.updateProjectMetadataFunc != nil {
+		return m.updateProjectMetadataFunc(ctx, projectID, metadata)
+	}
+	return nil
+}
+
+func (m *MockProjectManager) SetGetProjectExecutionDefaultsCallback(
+	getProjectExecutionDefaultsFunc GetProjectExecutionDefaultsFunc) {
+	m.getProjectExecutionDefaultsFunc = getProjectExecutionDefaultsFunc
+}
+
+func (m *MockProjectManager) GetProjectExecutionDefaults(
+	ctx context.Context, projectID string) (*interfaces.ProjectExecutionDefaults, error) {
+	if m.getProjectExecutionDefaultsFunc != nil {
+		return m.getProjectExecutionDefaultsFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectManager) SetUpdateProjectExecutionDefaultsCallback(
+	updateProjectExecutionDefaultsFunc UpdateProjectExecutionDefaultsFunc) {
+	m.updateProjectExecutionDefaultsFunc = updateProjectExecutionDefaultsFunc
+}
+
+func (m *MockProjectManager) UpdateProjectExecutionDefaults(
+	ctx context.Context, projectID string, defaults interfaces.ProjectExecutionDefaults) error {
+	if m.updateProjectExecutionDefaultsFunc != nil {
+		return m.updateProjectExecutionDefaultsFunc(ctx, projectID, defaults)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type SetDomainQuotaFunc func(ctx context.Context, request interfaces.DomainQuota) (*interfaces.DomainQuota, error)
+type GetDomainQuotaFunc func(ctx context.Context, project, domain string) (*interfaces.DomainQuota, error)
+type DeleteDomainQuotaFunc func(ctx context.Context, project, domain string) error
+type ListDomainQuotasFunc func(ctx context.Context, request interfaces.ListDomainQuotasRequest) (*interfaces.DomainQuotaList, error)
+
+type QuotaManager struct {
+	SetDomainQuotaFunc    SetDomainQuotaFunc
+	GetDomainQuotaFunc    GetDomainQuotaFunc
+	DeleteDomainQuotaFunc DeleteDomainQuotaFunc
+	ListDomainQuotasFunc  ListDomainQuotasFunc
+}
+
+func (m *QuotaManager) SetDomainQuota(ctx context.Context, request interfaces.DomainQuota) (*interfaces.DomainQuota, error) {
+	if m.SetDomainQuotaFunc != nil {
+		return m.SetDomainQuotaFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *QuotaManager) GetDomainQuota(ctx context.Context, project, domain string) (*interfaces.DomainQuota, error) {
+	if m.GetDomainQuotaFunc != nil {
+		return m.GetDomainQuotaFunc(ctx, project, domain)
+	}
+	return nil, nil
+}
+
+func (m *QuotaManager) DeleteDomainQuota(ctx context.Context, project, domain string) error {
+	if m.DeleteDomainQuotaFunc != nil {
+		return m.DeleteDomainQuotaFunc(ctx, project, domain)
+	}
+	return nil
+}
+
+func (m *QuotaManager) ListDomainQuotas(ctx context.Context, request interfaces.ListDomainQuotasRequest) (*interfaces.DomainQuotaList, error) {
+	if m.ListDomainQuotasFunc != nil {
+		return m.ListDomainQuotasFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -0,0 +1,72 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type CreateSavedViewFunc func(ctx context.Context, request interfaces.SavedView) (*interfaces.SavedView, error)
+type GetSavedViewFunc func(ctx context.Context, id interfaces.SavedViewIdentifier) (*interfaces.SavedView, error)
+type UpdateSavedViewFunc func(ctx context.Context, request interfaces.SavedView) (*interfaces.SavedView, error)
+type DeleteSavedViewFunc func(ctx context.Context, id interfaces.SavedViewIdentifier) error
+type ListSavedViewsFunc func(ctx context.Context, request interfaces.SavedViewListRequest) (
+	*interfaces.SavedViewList, error)
+type EvaluateSavedViewFunc func(ctx context.Context, request interfaces.SavedViewEvaluateRequest) (
+	*interfaces.SavedViewResults, error)
+
+type SavedViewManager struct {
+	CreateSavedViewFunc   CreateSavedViewFunc
+	GetSavedViewFunc      GetSavedViewFunc
+	UpdateSavedViewFunc   UpdateSavedViewFunc
+	DeleteSavedViewFunc   DeleteSavedViewFunc
+	ListSavedViewsFunc    ListSavedViewsFunc
+	EvaluateSavedViewFunc EvaluateSavedViewFunc
+}
+
+func (m *SavedViewManager) CreateSavedView(ctx context.Context, request interfaces.SavedView) (
+	*interfaces.SavedView, error) {
+	if m.CreateSavedViewFunc != nil {
+		return m.CreateSavedViewFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *SavedViewManager) GetSavedView(ctx context.Context, id interfaces.SavedViewIdentifier) (
+	*interfaces.SavedView, error) {
+	if m.GetSavedViewFunc != nil {
+		return m.GetSavedViewFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *SavedViewManager) UpdateSavedView(ctx context.Context, request interfaces.SavedView) (
+	*interfaces.SavedView, error) {
+	if m.UpdateSavedViewFunc != nil {
+		return m.UpdateSavedViewFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *SavedViewManager) DeleteSavedView(ctx context.Context, id interfaces.SavedViewIdentifier) error {
+	if m.DeleteSavedViewFunc != nil {
+		return m.DeleteSavedViewFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *SavedViewManager) ListSavedViews(ctx context.Context, request interfaces.SavedViewListRequest) (
+	*interfaces.SavedViewList, error) {
+	if m.ListSavedViewsFunc != nil {
+		return m.ListSavedViewsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *SavedViewManager) EvaluateSavedView(ctx context.Context, request interfaces.SavedViewEvaluateRequest) (
+	*interfaces.SavedViewResults, error) {
+	if m.EvaluateSavedViewFunc != nil {
+		return m.EvaluateSavedViewFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+type UpdateScheduleOptionsFunc func(ctx context.Context, request interfaces.UpdateScheduleOptionsRequest) error
+type PauseScheduleFunc func(ctx context.Context, id admin.NamedEntityIdentifier) error
+type ResumeScheduleFunc func(ctx context.Context, id admin.NamedEntityIdentifier) error
+type ListScheduleRunsFunc func(ctx context.Context, request interfaces.ListScheduleRunsRequest) (
+	*interfaces.ScheduleRunList, error)
+
+type ScheduleManager struct {
+	UpdateScheduleOptionsFunc UpdateScheduleOptionsFunc
+	PauseScheduleFunc         PauseScheduleFunc
+	ResumeScheduleFunc        ResumeScheduleFunc
+	ListScheduleRunsFunc      ListScheduleRunsFunc
+}
+
+func (m *ScheduleManager) UpdateScheduleOptions(ctx context.Context, request interfaces.UpdateScheduleOptionsRequest) error {
+	if m.UpdateScheduleOptionsFunc != nil {
+		return m.UpdateScheduleOptionsFunc(ctx, request)
+	}
+	return nil
+}
+
+func (m *ScheduleManager) PauseSchedule(ctx context.Context, id admin.NamedEntityIdentifier) error {
+	if m.PauseScheduleFunc != nil {
+		return m.PauseScheduleFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *ScheduleManager) ResumeSchedule(ctx context.Context, id admin.NamedEntityIdentifier) error {
+	if m.ResumeScheduleFunc != nil {
+		return m.ResumeScheduleFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *ScheduleManager) ListScheduleRuns(ctx context.Context, request interfaces.ListScheduleRunsRequest) (
+	*interfaces.ScheduleRunList, error) {
+	if m.ListScheduleRunsFunc != nil {
+		return m.ListScheduleRunsFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type GetOrCreateSignalFunc func(ctx context.Context, request interfaces.SignalGetOrCreateRequest) (
+	*interfaces.Signal, error)
+type ListSignalsFunc func(ctx context.Context, request interfaces.SignalListRequest) (*interfaces.SignalList, error)
+type SetSignalFunc func(ctx context.Context, request interfaces.SignalSetRequest) (*interfaces.Signal, error)
+
+type SignalManager struct {
+	GetOrCreateSignalFunc GetOrCreateSignalFunc
+	ListSignalsFunc       ListSignalsFunc
+	SetSignalFunc         SetSignalFunc
+}
+
+func (m *SignalManager) GetOrCreateSignal(ctx context.Context, request interfaces.SignalGetOrCreateRequest) (
+	*interfaces.Signal, error) {
+	if m.GetOrCreateSignalFunc != nil {
+		return m.GetOrCreateSignalFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *SignalManager) ListSignals(ctx context.Context, request interfaces.SignalListRequest) (
+	*interfaces.SignalList, error) {
+	if m.ListSignalsFunc != nil {
+		return m.ListSignalsFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *SignalManager) SetSignal(ctx context.Context, request interfaces.SignalSetRequest) (
+	*interfaces.Signal, error) {
+	if m.SetSignalFunc != nil {
+		return m.SetSignalFunc(ctx, request)
+	}
+	return nil, nil
+}
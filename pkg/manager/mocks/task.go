@@ -3,7 +3,9 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 type CreateTaskFunc func(ctx context.Context, request admin.TaskCreateRequest) (*admin.TaskCreateResponse, error)
@@ -31,6 +33,10 @@ func (r *MockTaskManager) GetTask(ctx context.Context, request admin.ObjectGetRe
 	return nil, nil
 }
 
+func (r *MockTaskManager) GetTasks(ctx context.Context, ids []*core.Identifier) (*admin.TaskList, error) {
+	return nil, nil
+}
+
 func (r *MockTaskManager) ListTasks(ctx context.Context, request admin.ResourceListRequest) (*admin.TaskList, error) {
 	return nil, nil
 }
@@ -48,3 +54,8 @@ func (r *MockTaskManager) ListUniqueTaskIdentifiers(ctx context.Context, request
 
 	return nil, nil
 }
+
+func (r *MockTaskManager) GetTaskDiff(
+	ctx context.Context, request interfaces.VersionDiffRequest) (*interfaces.TaskDiff, error) {
+	return nil, nil
+}
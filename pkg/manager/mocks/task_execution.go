@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 )
 
@@ -14,12 +15,15 @@ type ListTaskExecutionsFunc func(ctx context.Context, request admin.TaskExecutio
 	*admin.TaskExecutionList, error)
 type GetTaskExecutionDataFunc func(ctx context.Context, request admin.TaskExecutionGetDataRequest) (
 	*admin.TaskExecutionGetDataResponse, error)
+type GetTaskExecutionLinksFunc func(ctx context.Context, request admin.TaskExecutionGetRequest) (
+	*interfaces.TaskExecutionLinks, error)
 
 type MockTaskExecutionManager struct {
 	createTaskExecutionEventFunc CreateTaskExecutionEventFunc
 	getTaskExecutionFunc         GetTaskExecutionFunc
 	listTaskExecutionsFunc       ListTaskExecutionsFunc
 	getTaskExecutionDataFunc     GetTaskExecutionDataFunc
+	getTaskExecutionLinksFunc    GetTaskExecutionLinksFunc
 }
 
 func (m *MockTaskExecutionManager) CreateTaskExecutionEvent(
@@ -73,3 +77,16 @@ func (m *MockTaskExecutionManager) SetGetTaskExecutionDataCallback(
 	getTaskExecutionDataFunc GetTaskExecutionDataFunc) {
 	m.getTaskExecutionDataFunc = getTaskExecutionDataFunc
 }
+
+func (m *MockTaskExecutionManager) GetTaskExecutionLinks(
+	ctx context.Context, request admin.TaskExecutionGetRequest) (*interfaces.TaskExecutionLinks, error) {
+	if m.getTaskExecutionLinksFunc != nil {
+		return m.getTaskExecutionLinksFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *MockTaskExecutionManager) SetGetTaskExecutionLinksCallback(
+	getTaskExecutionLinksFunc GetTaskExecutionLinksFunc) {
+	m.getTaskExecutionLinksFunc = getTaskExecutionLinksFunc
+}
@@ -0,0 +1,58 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+type RegisterTriggerFunc func(ctx context.Context, request interfaces.RegisterTriggerRequest) (*interfaces.Trigger, error)
+type GetTriggerFunc func(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.Trigger, error)
+type ListTriggersFunc func(ctx context.Context, request interfaces.ListTriggersRequest) (*interfaces.TriggerList, error)
+type DeleteTriggerFunc func(ctx context.Context, id admin.NamedEntityIdentifier) error
+type FireTriggerFunc func(ctx context.Context, request interfaces.FireTriggerRequest) (*core.WorkflowExecutionIdentifier, error)
+
+type TriggerManager struct {
+	RegisterTriggerFunc RegisterTriggerFunc
+	GetTriggerFunc      GetTriggerFunc
+	ListTriggersFunc    ListTriggersFunc
+	DeleteTriggerFunc   DeleteTriggerFunc
+	FireTriggerFunc     FireTriggerFunc
+}
+
+func (m *TriggerManager) RegisterTrigger(ctx context.Context, request interfaces.RegisterTriggerRequest) (*interfaces.Trigger, error) {
+	if m.RegisterTriggerFunc != nil {
+		return m.RegisterTriggerFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *TriggerManager) GetTrigger(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.Trigger, error) {
+	if m.GetTriggerFunc != nil {
+		return m.GetTriggerFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *TriggerManager) ListTriggers(ctx context.Context, request interfaces.ListTriggersRequest) (*interfaces.TriggerList, error) {
+	if m.ListTriggersFunc != nil {
+		return m.ListTriggersFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *TriggerManager) DeleteTrigger(ctx context.Context, id admin.NamedEntityIdentifier) error {
+	if m.DeleteTriggerFunc != nil {
+		return m.DeleteTriggerFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *TriggerManager) FireTrigger(ctx context.Context, request interfaces.FireTriggerRequest) (*core.WorkflowExecutionIdentifier, error) {
+	if m.FireTriggerFunc != nil {
+		return m.FireTriggerFunc(ctx, request)
+	}
+	return nil, nil
+}
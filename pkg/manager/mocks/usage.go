@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+type GetExecutionUsageFunc func(ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.ExecutionUsage, error)
+type GetUsageReportFunc func(ctx context.Context, request interfaces.UsageReportRequest) (*interfaces.UsageReport, error)
+
+type UsageManager struct {
+	GetExecutionUsageFunc GetExecutionUsageFunc
+	GetUsageReportFunc    GetUsageReportFunc
+}
+
+func (m *UsageManager) GetExecutionUsage(ctx context.Context, id core.WorkflowExecutionIdentifier) (*interfaces.ExecutionUsage, error) {
+	if m.GetExecutionUsageFunc != nil {
+		return m.GetExecutionUsageFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *UsageManager) GetUsageReport(ctx context.Context, request interfaces.UsageReportRequest) (*interfaces.UsageReport, error) {
+	if m.GetUsageReportFunc != nil {
+		return m.GetUsageReportFunc(ctx, request)
+	}
+	return nil, nil
+}
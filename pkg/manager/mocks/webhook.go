@@ -0,0 +1,57 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+type RegisterWebhookFunc func(ctx context.Context, request interfaces.RegisterWebhookRequest) (*interfaces.Webhook, error)
+type GetWebhookFunc func(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.Webhook, error)
+type ListWebhooksFunc func(ctx context.Context, request interfaces.ListWebhooksRequest) (*interfaces.WebhookList, error)
+type DeleteWebhookFunc func(ctx context.Context, id admin.NamedEntityIdentifier) error
+type ListWebhookDeliveriesFunc func(ctx context.Context, request interfaces.ListWebhookDeliveriesRequest) (*interfaces.WebhookDeliveryList, error)
+
+type WebhookManager struct {
+	RegisterWebhookFunc       RegisterWebhookFunc
+	GetWebhookFunc            GetWebhookFunc
+	ListWebhooksFunc          ListWebhooksFunc
+	DeleteWebhookFunc         DeleteWebhookFunc
+	ListWebhookDeliveriesFunc ListWebhookDeliveriesFunc
+}
+
+func (m *WebhookManager) RegisterWebhook(ctx context.Context, request interfaces.RegisterWebhookRequest) (*interfaces.Webhook, error) {
+	if m.RegisterWebhookFunc != nil {
+		return m.RegisterWebhookFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *WebhookManager) GetWebhook(ctx context.Context, id admin.NamedEntityIdentifier) (*interfaces.Webhook, error) {
+	if m.GetWebhookFunc != nil {
+		return m.GetWebhookFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *WebhookManager) ListWebhooks(ctx context.Context, request interfaces.ListWebhooksRequest) (*interfaces.WebhookList, error) {
+	if m.ListWebhooksFunc != nil {
+		return m.ListWebhooksFunc(ctx, request)
+	}
+	return nil, nil
+}
+
+func (m *WebhookManager) DeleteWebhook(ctx context.Context, id admin.NamedEntityIdentifier) error {
+	if m.DeleteWebhookFunc != nil {
+		return m.DeleteWebhookFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *WebhookManager) ListWebhookDeliveries(ctx context.Context, request interfaces.ListWebhookDeliveriesRequest) (*interfaces.WebhookDeliveryList, error) {
+	if m.ListWebhookDeliveriesFunc != nil {
+		return m.ListWebhookDeliveriesFunc(ctx, request)
+	}
+	return nil, nil
+}
@@ -3,13 +3,17 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 type CreateWorkflowFunc func(ctx context.Context, request admin.WorkflowCreateRequest) (*admin.WorkflowCreateResponse, error)
+type ListWorkflowsFunc func(ctx context.Context, request admin.ResourceListRequest) (*admin.WorkflowList, error)
 
 type MockWorkflowManager struct {
 	createWorkflowFunc CreateWorkflowFunc
+	listWorkflowsFunc  ListWorkflowsFunc
 }
 
 func (r *MockWorkflowManager) SetCreateCallback(createFunction CreateWorkflowFunc) {
@@ -25,8 +29,15 @@ func (r *MockWorkflowManager) CreateWorkflow(
 	return nil, nil
 }
 
+func (r *MockWorkflowManager) SetListCallback(listFunction ListWorkflowsFunc) {
+	r.listWorkflowsFunc = listFunction
+}
+
 func (r *MockWorkflowManager) ListWorkflows(ctx context.Context,
 	request admin.ResourceListRequest) (*admin.WorkflowList, error) {
+	if r.listWorkflowsFunc != nil {
+		return r.listWorkflowsFunc(ctx, request)
+	}
 	return nil, nil
 }
 
@@ -35,7 +46,27 @@ func (r *MockWorkflowManager) GetWorkflow(
 	return nil, nil
 }
 
+func (r *MockWorkflowManager) GetWorkflows(
+	ctx context.Context, ids []*core.Identifier) (*admin.WorkflowList, error) {
+	return nil, nil
+}
+
 func (r *MockWorkflowManager) ListWorkflowIdentifiers(ctx context.Context, request admin.NamedEntityIdentifierListRequest) (
 	*admin.NamedEntityIdentifierList, error) {
 	return nil, nil
 }
+
+func (r *MockWorkflowManager) GetWorkflowDiff(
+	ctx context.Context, request interfaces.VersionDiffRequest) (*interfaces.WorkflowDiff, error) {
+	return nil, nil
+}
+
+func (r *MockWorkflowManager) CreateWorkflowFromParts(
+	ctx context.Context, request interfaces.WorkflowFromPartsCreateRequest) (*admin.WorkflowCreateResponse, error) {
+	return nil, nil
+}
+
+func (r *MockWorkflowManager) CompileWorkflow(
+	ctx context.Context, request interfaces.WorkflowCompileRequest) (*interfaces.WorkflowCompileResponse, error) {
+	return nil, nil
+}
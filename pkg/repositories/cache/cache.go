@@ -0,0 +1,134 @@
+// Package cache serves hot repository reads, such as the workflow, task and launch plan gets propeller repeats for the
+// same versions, from a cache in front of the database.
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Cache stores repository models by key. Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (interface{}, bool)
+	Add(key string, value interface{})
+	Remove(key string)
+	// Removes every entry whose key starts with prefix, such as the entries of a project.
+	RemovePrefix(prefix string)
+}
+
+type entry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// In-memory Cache which evicts the least recently used entries once full, and expires entries after a fixed TTL.
+type lruCache struct {
+	cache *lru.Cache
+	ttl   time.Duration
+	now   func() time.Time
+}
+
+func (c *lruCache) Get(key string) (interface{}, bool) {
+	cached, found := c.cache.Get(key)
+	if !found {
+		return nil, false
+	}
+	e := cached.(entry)
+	if !c.now().Before(e.expiresAt) {
+		c.cache.Remove(key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (c *lruCache) Add(key string, value interface{}) {
+	c.cache.Add(key, entry{
+		value:     value,
+		expiresAt: c.now().Add(c.ttl),
+	})
+}
+
+func (c *lruCache) Remove(key string) {
+	c.cache.Remove(key)
+}
+
+func (c *lruCache) RemovePrefix(prefix string) {
+	for _, key := range c.cache.Keys() {
+		if strings.HasPrefix(key.(string), prefix) {
+			c.cache.Remove(key)
+		}
+	}
+}
+
+// Returns an in-memory Cache holding at most size entries, each for at most ttl.
+func NewLRUCache(size int, ttl time.Duration) (Cache, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &lruCache{
+		cache: cache,
+		ttl:   ttl,
+		now:   time.Now,
+	}, nil
+}
+
+// A counter which also keeps its count, so that hit rates can be reported without querying Prometheus.
+type counter struct {
+	prometheus.Counter
+	count uint64
+}
+
+func (c *counter) Inc() {
+	atomic.AddUint64(&c.count, 1)
+	c.Counter.Inc()
+}
+
+func (c *counter) get() uint64 {
+	return atomic.LoadUint64(&c.count)
+}
+
+type cacheMetrics struct {
+	Hits   *counter
+	Misses *counter
+}
+
+func (m cacheMetrics) getStats() interfaces.CacheStats {
+	return interfaces.CacheStats{
+		Hits:   m.Hits.get(),
+		Misses: m.Misses.get(),
+	}
+}
+
+func newCacheMetrics(scope promutils.Scope) cacheMetrics {
+	return cacheMetrics{
+		Hits: &counter{
+			Counter: scope.MustNewCounter("cache_hits", "count of gets served from the cache"),
+		},
+		Misses: &counter{
+			Counter: scope.MustNewCounter("cache_misses",
+				"count of gets which weren't cached and were read from the database"),
+		},
+	}
+}
+
+// Implemented by the cached repos, to report how often gets are served from their cache.
+type StatsReporter interface {
+	GetCacheStats() interfaces.CacheStats
+}
+
+// Returns the prefix of the keys of a project's entries.
+func getProjectPrefix(project string) string {
+	return project + "/"
+}
+
+func getKey(id interfaces.Identifier) string {
+	return fmt.Sprintf("%s/%s/%s/%s", id.Project, id.Domain, id.Name, id.Version)
+}
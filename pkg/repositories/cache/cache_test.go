@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCache(t *testing.T) {
+	c, err := NewLRUCache(2, time.Minute)
+	assert.NoError(t, err)
+	now := time.Now()
+	c.(*lruCache).now = func() time.Time {
+		return now
+	}
+
+	c.Add("a", 1)
+	c.Add("b", 2)
+	value, found := c.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+
+	// Evicts the least recently used entry once full.
+	c.Add("c", 3)
+	_, found = c.Get("b")
+	assert.False(t, found)
+
+	c.Remove("c")
+	_, found = c.Get("c")
+	assert.False(t, found)
+
+	now = now.Add(time.Minute)
+	_, found = c.Get("a")
+	assert.False(t, found, "entries should expire after the ttl")
+}
+
+func TestLRUCache_RemovePrefix(t *testing.T) {
+	c, err := NewLRUCache(3, time.Minute)
+	assert.NoError(t, err)
+	c.Add("project/domain/a/v1", 1)
+	c.Add("project/domain/b/v1", 2)
+	c.Add("project-other/domain/a/v1", 3)
+
+	c.RemovePrefix(getProjectPrefix("project"))
+	_, found := c.Get("project/domain/a/v1")
+	assert.False(t, found)
+	_, found = c.Get("project/domain/b/v1")
+	assert.False(t, found)
+	value, found := c.Get("project-other/domain/a/v1")
+	assert.True(t, found)
+	assert.Equal(t, 3, value)
+}
+
+func TestNewLRUCache_InvalidSize(t *testing.T) {
+	_, err := NewLRUCache(0, time.Minute)
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+)
+
+// Caches launch plan gets. Launch plans are updated in place when they're activated, deactivated or deleted, which
+// invalidates their cached entries. Writes made through other admin replicas aren't observed until entries expire.
+type LaunchPlanRepo struct {
+	interfaces.LaunchPlanRepoInterface
+	cache   Cache
+	metrics cacheMetrics
+}
+
+func getLaunchPlanKey(launchPlan models.LaunchPlan) string {
+	return getKey(interfaces.Identifier{
+		Project: launchPlan.Project,
+		Domain:  launchPlan.Domain,
+		Name:    launchPlan.Name,
+		Version: launchPlan.Version,
+	})
+}
+
+func (r *LaunchPlanRepo) Create(ctx context.Context, input models.LaunchPlan) error {
+	defer r.cache.Remove(getLaunchPlanKey(input))
+	return r.LaunchPlanRepoInterface.Create(ctx, input)
+}
+
+func (r *LaunchPlanRepo) Update(ctx context.Context, input models.LaunchPlan) error {
+	defer r.cache.Remove(getLaunchPlanKey(input))
+	return r.LaunchPlanRepoInterface.Update(ctx, input)
+}
+
+func (r *LaunchPlanRepo) SetActive(
+	ctx context.Context, toEnable models.LaunchPlan, toDisable *models.LaunchPlan) error {
+	defer func() {
+		r.cache.Remove(getLaunchPlanKey(toEnable))
+		if toDisable != nil {
+			r.cache.Remove(getLaunchPlanKey(*toDisable))
+		}
+	}()
+	return r.LaunchPlanRepoInterface.SetActive(ctx, toEnable, toDisable)
+}
+
+// Callers update the state of the launch plans they get, which mustn't change the cached entry.
+func copyState(launchPlan models.LaunchPlan) models.LaunchPlan {
+	if launchPlan.State != nil {
+		state := *launchPlan.State
+		launchPlan.State = &state
+	}
+	return launchPlan
+}
+
+func (r *LaunchPlanRepo) Get(ctx context.Context, input interfaces.Identifier) (models.LaunchPlan, error) {
+	key := getKey(input)
+	if cached, found := r.cache.Get(key); found {
+		r.metrics.Hits.Inc()
+		return copyState(cached.(models.LaunchPlan)), nil
+	}
+	r.metrics.Misses.Inc()
+	launchPlan, err := r.LaunchPlanRepoInterface.Get(ctx, input)
+	if err != nil {
+		return models.LaunchPlan{}, err
+	}
+	r.cache.Add(key, launchPlan)
+	return copyState(launchPlan), nil
+}
+
+func (r *LaunchPlanRepo) Delete(ctx context.Context, input interfaces.Identifier) error {
+	defer r.cache.Remove(getKey(input))
+	return r.LaunchPlanRepoInterface.Delete(ctx, input)
+}
+
+func (r *LaunchPlanRepo) Restore(ctx context.Context, input interfaces.Identifier) error {
+	defer r.cache.Remove(getKey(input))
+	return r.LaunchPlanRepoInterface.Restore(ctx, input)
+}
+
+func (r *LaunchPlanRepo) GetCacheStats() interfaces.CacheStats {
+	return r.metrics.getStats()
+}
+
+// Returns a LaunchPlanRepoInterface which serves gets from the cache before falling back to repo.
+func NewLaunchPlanRepo(
+	repo interfaces.LaunchPlanRepoInterface, cache Cache, scope promutils.Scope) interfaces.LaunchPlanRepoInterface {
+	return &LaunchPlanRepo{
+		LaunchPlanRepoInterface: repo,
+		cache:                   cache,
+		metrics:                 newCacheMetrics(scope),
+	}
+}
@@ -0,0 +1,97 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/cache"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var launchPlanIdentifier = interfaces.Identifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+	Version: "version",
+}
+
+func newLaunchPlan(state admin.LaunchPlanState) models.LaunchPlan {
+	stateInt := int32(state)
+	return models.LaunchPlan{
+		LaunchPlanKey: models.LaunchPlanKey{
+			Project: launchPlanIdentifier.Project,
+			Domain:  launchPlanIdentifier.Domain,
+			Name:    launchPlanIdentifier.Name,
+			Version: launchPlanIdentifier.Version,
+		},
+		State: &stateInt,
+	}
+}
+
+func TestLaunchPlanRepo_Get(t *testing.T) {
+	mockRepo := repositoryMocks.NewMockLaunchPlanRepo()
+	state := admin.LaunchPlanState_INACTIVE
+	var gets int
+	mockRepo.(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.LaunchPlan, error) {
+			gets++
+			return newLaunchPlan(state), nil
+		})
+	repo := cache.NewLaunchPlanRepo(mockRepo, newTestCache(t), mockScope.NewTestScope())
+
+	launchPlan, err := repo.Get(context.Background(), launchPlanIdentifier)
+	assert.NoError(t, err)
+	// Changes to returned launch plans shouldn't be cached.
+	*launchPlan.State = int32(admin.LaunchPlanState_ACTIVE)
+	launchPlan, err = repo.Get(context.Background(), launchPlanIdentifier)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(admin.LaunchPlanState_INACTIVE), *launchPlan.State)
+	assert.Equal(t, 1, gets)
+
+	state = admin.LaunchPlanState_ACTIVE
+	assert.NoError(t, repo.SetActive(context.Background(), newLaunchPlan(state), nil))
+	launchPlan, err = repo.Get(context.Background(), launchPlanIdentifier)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(admin.LaunchPlanState_ACTIVE), *launchPlan.State)
+	assert.Equal(t, 2, gets)
+}
+
+func TestLaunchPlanRepo_Invalidation(t *testing.T) {
+	mockRepo := repositoryMocks.NewMockLaunchPlanRepo()
+	var gets int
+	mockRepo.(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.LaunchPlan, error) {
+			gets++
+			return newLaunchPlan(admin.LaunchPlanState_INACTIVE), nil
+		})
+	repo := cache.NewLaunchPlanRepo(mockRepo, newTestCache(t), mockScope.NewTestScope())
+
+	writes := []func() error{
+		func() error {
+			return repo.Update(context.Background(), newLaunchPlan(admin.LaunchPlanState_ACTIVE))
+		},
+		func() error {
+			toDisable := newLaunchPlan(admin.LaunchPlanState_INACTIVE)
+			return repo.SetActive(context.Background(), models.LaunchPlan{}, &toDisable)
+		},
+		func() error {
+			return repo.Delete(context.Background(), launchPlanIdentifier)
+		},
+		func() error {
+			return repo.Restore(context.Background(), launchPlanIdentifier)
+		},
+	}
+	for i, write := range writes {
+		_, err := repo.Get(context.Background(), launchPlanIdentifier)
+		assert.NoError(t, err)
+		assert.NoError(t, write())
+		_, err = repo.Get(context.Background(), launchPlanIdentifier)
+		assert.NoError(t, err)
+		assert.Equal(t, i+2, gets, "writes should invalidate the cached launch plan")
+	}
+}
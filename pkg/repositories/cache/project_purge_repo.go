@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+)
+
+// Invalidates the cached workflows, tasks and launch plans of a project as they're purged, since the purge deletes
+// them with raw SQL rather than through the cached repos.
+type ProjectPurgeRepo struct {
+	interfaces.ProjectPurgeRepoInterface
+	// The caches of the purged tables, by table.
+	caches map[string]Cache
+}
+
+func (r *ProjectPurgeRepo) DeleteRecords(ctx context.Context, input interfaces.DeleteProjectRecordsInput) (int64, error) {
+	if cache, ok := r.caches[input.Table]; ok {
+		// Entries cached while the records are deleted are removed once they are.
+		defer cache.RemovePrefix(getProjectPrefix(input.Project))
+	}
+	return r.ProjectPurgeRepoInterface.DeleteRecords(ctx, input)
+}
+
+// Returns a ProjectPurgeRepoInterface which removes a project's entries from the caches of the tables it purges.
+func NewProjectPurgeRepo(repo interfaces.ProjectPurgeRepoInterface, workflowCache, taskCache,
+	launchPlanCache Cache) interfaces.ProjectPurgeRepoInterface {
+	return &ProjectPurgeRepo{
+		ProjectPurgeRepoInterface: repo,
+		caches: map[string]Cache{
+			interfaces.WorkflowsTable:   workflowCache,
+			interfaces.TasksTable:       taskCache,
+			interfaces.LaunchPlansTable: launchPlanCache,
+		},
+	}
+}
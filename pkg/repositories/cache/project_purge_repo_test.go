@@ -0,0 +1,47 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/cache"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectPurgeRepo_DeleteRecords(t *testing.T) {
+	workflowCache, taskCache, launchPlanCache := newTestCache(t), newTestCache(t), newTestCache(t)
+	for _, c := range []cache.Cache{workflowCache, taskCache, launchPlanCache} {
+		c.Add("project/domain/name/version", 1)
+		c.Add("other/domain/name/version", 2)
+	}
+	mockRepo := &repositoryMocks.ProjectPurgeRepoInterface{}
+	mockRepo.OnDeleteRecords(context.Background(), interfaces.DeleteProjectRecordsInput{
+		Table: interfaces.TasksTable, Project: "project", Limit: 10,
+	}).Return(int64(1), nil)
+	mockRepo.OnDeleteRecords(context.Background(), interfaces.DeleteProjectRecordsInput{
+		Table: interfaces.ExecutionsTable, Project: "project", Limit: 10,
+	}).Return(int64(1), nil)
+	repo := cache.NewProjectPurgeRepo(mockRepo, workflowCache, taskCache, launchPlanCache)
+
+	deleted, err := repo.DeleteRecords(context.Background(), interfaces.DeleteProjectRecordsInput{
+		Table: interfaces.TasksTable, Project: "project", Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	_, found := taskCache.Get("project/domain/name/version")
+	assert.False(t, found)
+	_, found = taskCache.Get("other/domain/name/version")
+	assert.True(t, found)
+	_, found = workflowCache.Get("project/domain/name/version")
+	assert.True(t, found, "only the cache of the purged table should be invalidated")
+
+	// Tables which aren't cached leave the caches alone.
+	_, err = repo.DeleteRecords(context.Background(), interfaces.DeleteProjectRecordsInput{
+		Table: interfaces.ExecutionsTable, Project: "project", Limit: 10,
+	})
+	assert.NoError(t, err)
+	_, found = launchPlanCache.Get("project/domain/name/version")
+	assert.True(t, found)
+}
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+)
+
+// Caches task gets. Task versions are immutable, so cached entries never go stale.
+type TaskRepo struct {
+	interfaces.TaskRepoInterface
+	cache   Cache
+	metrics cacheMetrics
+}
+
+func (r *TaskRepo) Create(ctx context.Context, input models.Task) error {
+	defer r.cache.Remove(getKey(interfaces.Identifier{
+		Project: input.Project,
+		Domain:  input.Domain,
+		Name:    input.Name,
+		Version: input.Version,
+	}))
+	return r.TaskRepoInterface.Create(ctx, input)
+}
+
+func (r *TaskRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Task, error) {
+	key := getKey(input)
+	if cached, found := r.cache.Get(key); found {
+		r.metrics.Hits.Inc()
+		return cached.(models.Task), nil
+	}
+	r.metrics.Misses.Inc()
+	task, err := r.TaskRepoInterface.Get(ctx, input)
+	if err != nil {
+		return models.Task{}, err
+	}
+	r.cache.Add(key, task)
+	return task, nil
+}
+
+// Serves the cached tasks, and gets the rest in a single batch.
+func (r *TaskRepo) GetBatch(ctx context.Context, input []interfaces.Identifier) ([]models.Task, error) {
+	tasks := make([]models.Task, 0, len(input))
+	misses := make([]interfaces.Identifier, 0)
+	for _, id := range input {
+		if cached, found := r.cache.Get(getKey(id)); found {
+			r.metrics.Hits.Inc()
+			tasks = append(tasks, cached.(models.Task))
+			continue
+		}
+		r.metrics.Misses.Inc()
+		misses = append(misses, id)
+	}
+	if len(misses) == 0 {
+		return tasks, nil
+	}
+	fetched, err := r.TaskRepoInterface.GetBatch(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range fetched {
+		r.cache.Add(getKey(interfaces.Identifier{
+			Project: task.Project,
+			Domain:  task.Domain,
+			Name:    task.Name,
+			Version: task.Version,
+		}), task)
+	}
+	return append(tasks, fetched...), nil
+}
+
+func (r *TaskRepo) GetCacheStats() interfaces.CacheStats {
+	return r.metrics.getStats()
+}
+
+// Returns a TaskRepoInterface which serves gets from the cache before falling back to repo.
+func NewTaskRepo(repo interfaces.TaskRepoInterface, cache Cache, scope promutils.Scope) interfaces.TaskRepoInterface {
+	return &TaskRepo{
+		TaskRepoInterface: repo,
+		cache:             cache,
+		metrics:           newCacheMetrics(scope),
+	}
+}
@@ -0,0 +1,44 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/cache"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskRepo_Get(t *testing.T) {
+	mockRepo := repositoryMocks.NewMockTaskRepo()
+	var gets int
+	mockRepo.(*repositoryMocks.MockTaskRepo).SetGetCallback(func(input interfaces.Identifier) (models.Task, error) {
+		gets++
+		return models.Task{
+			TaskKey: models.TaskKey{
+				Project: input.Project,
+				Domain:  input.Domain,
+				Name:    input.Name,
+				Version: input.Version,
+			},
+			Type: "python",
+		}, nil
+	})
+	repo := cache.NewTaskRepo(mockRepo, newTestCache(t), mockScope.NewTestScope())
+
+	for i := 0; i < 3; i++ {
+		task, err := repo.Get(context.Background(), workflowIdentifier)
+		assert.NoError(t, err)
+		assert.Equal(t, "python", task.Type)
+	}
+	assert.Equal(t, 1, gets)
+
+	otherVersion := workflowIdentifier
+	otherVersion.Version = "other"
+	_, err := repo.Get(context.Background(), otherVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, gets)
+}
@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+)
+
+// Caches workflow gets. Workflow versions are immutable, so cached entries never go stale.
+type WorkflowRepo struct {
+	interfaces.WorkflowRepoInterface
+	cache   Cache
+	metrics cacheMetrics
+}
+
+func (r *WorkflowRepo) Create(ctx context.Context, input models.Workflow) error {
+	defer r.cache.Remove(getKey(interfaces.Identifier{
+		Project: input.Project,
+		Domain:  input.Domain,
+		Name:    input.Name,
+		Version: input.Version,
+	}))
+	return r.WorkflowRepoInterface.Create(ctx, input)
+}
+
+func (r *WorkflowRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Workflow, error) {
+	key := getKey(input)
+	if cached, found := r.cache.Get(key); found {
+		r.metrics.Hits.Inc()
+		return cached.(models.Workflow), nil
+	}
+	r.metrics.Misses.Inc()
+	workflow, err := r.WorkflowRepoInterface.Get(ctx, input)
+	if err != nil {
+		return models.Workflow{}, err
+	}
+	r.cache.Add(key, workflow)
+	return workflow, nil
+}
+
+// Serves the cached workflows, and gets the rest in a single batch.
+func (r *WorkflowRepo) GetBatch(ctx context.Context, input []interfaces.Identifier) ([]models.Workflow, error) {
+	workflows := make([]models.Workflow, 0, len(input))
+	misses := make([]interfaces.Identifier, 0)
+	for _, id := range input {
+		if cached, found := r.cache.Get(getKey(id)); found {
+			r.metrics.Hits.Inc()
+			workflows = append(workflows, cached.(models.Workflow))
+			continue
+		}
+		r.metrics.Misses.Inc()
+		misses = append(misses, id)
+	}
+	if len(misses) == 0 {
+		return workflows, nil
+	}
+	fetched, err := r.WorkflowRepoInterface.GetBatch(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+	for _, workflow := range fetched {
+		r.cache.Add(getKey(interfaces.Identifier{
+			Project: workflow.Project,
+			Domain:  workflow.Domain,
+			Name:    workflow.Name,
+			Version: workflow.Version,
+		}), workflow)
+	}
+	return append(workflows, fetched...), nil
+}
+
+func (r *WorkflowRepo) GetCacheStats() interfaces.CacheStats {
+	return r.metrics.getStats()
+}
+
+// Returns a WorkflowRepoInterface which serves gets from the cache before falling back to repo.
+func NewWorkflowRepo(
+	repo interfaces.WorkflowRepoInterface, cache Cache, scope promutils.Scope) interfaces.WorkflowRepoInterface {
+	return &WorkflowRepo{
+		WorkflowRepoInterface: repo,
+		cache:                 cache,
+		metrics:               newCacheMetrics(scope),
+	}
+}
@@ -0,0 +1,105 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/cache"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var workflowIdentifier = interfaces.Identifier{
+	Project: "project",
+	Domain:  "domain",
+	Name:    "name",
+	Version: "version",
+}
+
+func newTestCache(t *testing.T) cache.Cache {
+	c, err := cache.NewLRUCache(10, time.Minute)
+	assert.NoError(t, err)
+	return c
+}
+
+func TestWorkflowRepo_Get(t *testing.T) {
+	mockRepo := repositoryMocks.NewMockWorkflowRepo()
+	var gets int
+	mockRepo.(*repositoryMocks.MockWorkflowRepo).SetGetCallback(func(input interfaces.Identifier) (models.Workflow, error) {
+		gets++
+		return models.Workflow{
+			WorkflowKey: models.WorkflowKey{
+				Project: input.Project,
+				Domain:  input.Domain,
+				Name:    input.Name,
+				Version: input.Version,
+			},
+			TypedInterface: []byte{1, 2},
+		}, nil
+	})
+	repo := cache.NewWorkflowRepo(mockRepo, newTestCache(t), mockScope.NewTestScope())
+
+	for i := 0; i < 3; i++ {
+		workflow, err := repo.Get(context.Background(), workflowIdentifier)
+		assert.NoError(t, err)
+		assert.Equal(t, "version", workflow.Version)
+		assert.Equal(t, []byte{1, 2}, workflow.TypedInterface)
+	}
+	assert.Equal(t, 1, gets)
+	assert.Equal(t, interfaces.CacheStats{Hits: 2, Misses: 1}, repo.(cache.StatsReporter).GetCacheStats())
+}
+
+func TestWorkflowRepo_GetError(t *testing.T) {
+	mockRepo := repositoryMocks.NewMockWorkflowRepo()
+	expectedErr := errors.New("expected error")
+	var gets int
+	mockRepo.(*repositoryMocks.MockWorkflowRepo).SetGetCallback(func(input interfaces.Identifier) (models.Workflow, error) {
+		gets++
+		return models.Workflow{}, expectedErr
+	})
+	repo := cache.NewWorkflowRepo(mockRepo, newTestCache(t), mockScope.NewTestScope())
+
+	for i := 0; i < 2; i++ {
+		_, err := repo.Get(context.Background(), workflowIdentifier)
+		assert.Equal(t, expectedErr, err)
+	}
+	assert.Equal(t, 2, gets, "errors should not be cached")
+}
+
+func TestWorkflowRepo_GetBatch(t *testing.T) {
+	mockRepo := repositoryMocks.NewMockWorkflowRepo()
+	var batches [][]interfaces.Identifier
+	mockRepo.(*repositoryMocks.MockWorkflowRepo).SetGetBatchCallback(
+		func(input []interfaces.Identifier) ([]models.Workflow, error) {
+			batches = append(batches, input)
+			workflows := make([]models.Workflow, len(input))
+			for i, id := range input {
+				workflows[i] = models.Workflow{
+					WorkflowKey: models.WorkflowKey(id),
+				}
+			}
+			return workflows, nil
+		})
+	repo := cache.NewWorkflowRepo(mockRepo, newTestCache(t), mockScope.NewTestScope())
+
+	otherVersion := workflowIdentifier
+	otherVersion.Version = "other"
+	workflows, err := repo.GetBatch(context.Background(), []interfaces.Identifier{workflowIdentifier})
+	assert.NoError(t, err)
+	assert.Len(t, workflows, 1)
+	workflows, err = repo.GetBatch(context.Background(), []interfaces.Identifier{workflowIdentifier, otherVersion})
+	assert.NoError(t, err)
+	assert.Len(t, workflows, 2)
+	// Only the version which wasn't cached is fetched.
+	assert.Equal(t, [][]interfaces.Identifier{{workflowIdentifier}, {otherVersion}}, batches)
+
+	workflows, err = repo.GetBatch(context.Background(), []interfaces.Identifier{otherVersion, workflowIdentifier})
+	assert.NoError(t, err)
+	assert.Len(t, workflows, 2)
+	assert.Len(t, batches, 2)
+}
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"time"
+	"unsafe"
+
+	"github.com/jinzhu/gorm"
+	"github.com/qor/validations"
+)
+
+// Settings applied to every handle on a database connection.
+type connectionSettings struct {
+	dialect            string
+	isDebug            bool
+	slowQueryThreshold time.Duration
+	metrics            *dbMetrics
+}
+
+func configureDb(db *gorm.DB, settings connectionSettings) {
+	db.LogMode(settings.isDebug)
+	validations.RegisterCallbacks(db)
+	if settings.dialect == SQLite {
+		registerSQLiteCallbacks(db)
+	}
+	registerContextCallbacks(db)
+	registerMetricsCallbacks(db, settings)
+	registerTracingCallbacks(db, settings)
+}
+
+// Fails a statement issued through a handle returned by WithContext without issuing it if its context is done.
+func skipIfContextDone(scope *gorm.Scope) {
+	ctx, ok := scope.Get(contextKey)
+	if !ok {
+		return
+	}
+	if err := ctx.(context.Context).Err(); err != nil {
+		scope.Err(err)
+		scope.SkipLeft()
+	}
+}
+
+func registerContextCallbacks(db *gorm.DB) {
+	callback := db.Callback()
+	// Before transactions are begun, so that there's nothing to roll back.
+	callback.Create().Before("gorm:begin_transaction").Register("flyteadmin:skip_create_if_done", skipIfContextDone)
+	callback.Query().Before("gorm:query").Register("flyteadmin:skip_query_if_done", skipIfContextDone)
+	callback.Update().Before("gorm:begin_transaction").Register("flyteadmin:skip_update_if_done", skipIfContextDone)
+	callback.Delete().Before("gorm:begin_transaction").Register("flyteadmin:skip_delete_if_done", skipIfContextDone)
+}
+
+// The statements of *sql.DB and *sql.Tx which are issued with a context.
+type contextCommonDB interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Issues gorm's statements with a context, so that the database driver cancels those which are running once the
+// context is done. Transactions begun through it are bound to the context too.
+type contextSQLCommon struct {
+	ctx context.Context
+	db  contextCommonDB
+}
+
+func (c contextSQLCommon) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return c.db.ExecContext(c.ctx, query, args...)
+}
+
+func (c contextSQLCommon) Prepare(query string) (*sql.Stmt, error) {
+	return c.db.PrepareContext(c.ctx, query)
+}
+
+func (c contextSQLCommon) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return c.db.QueryContext(c.ctx, query, args...)
+}
+
+func (c contextSQLCommon) QueryRow(query string, args ...interface{}) *sql.Row {
+	return c.db.QueryRowContext(c.ctx, query, args...)
+}
+
+func (c contextSQLCommon) Begin() (*sql.Tx, error) {
+	return c.BeginTx(c.ctx, nil)
+}
+
+// gorm begins transactions with the background context, so they're bound to the handle's context instead. Transactions
+// are rolled back once it's done.
+func (c contextSQLCommon) BeginTx(_ context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	db, ok := c.db.(*sql.DB)
+	if !ok {
+		return nil, gorm.ErrCantStartTransaction
+	}
+	return db.BeginTx(c.ctx, opts)
+}
+
+func (c contextSQLCommon) Commit() error {
+	tx, ok := c.db.(*sql.Tx)
+	if !ok {
+		return gorm.ErrInvalidTransaction
+	}
+	return tx.Commit()
+}
+
+func (c contextSQLCommon) Rollback() error {
+	tx, ok := c.db.(*sql.Tx)
+	if !ok {
+		return gorm.ErrInvalidTransaction
+	}
+	return tx.Rollback()
+}
+
+// Replaces the SQLCommon a handle issues its statements through. gorm v1 only sets it when opening connections and
+// beginning transactions, so it's set on the handle directly.
+func setCommonDB(db *gorm.DB, commonDB gorm.SQLCommon) {
+	field := reflect.ValueOf(db).Elem().FieldByName("db")
+	reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().Set(reflect.ValueOf(commonDB))
+}
+
+// WithContext returns a handle on db which carries ctx. Statements issued through the handle are spanned if ctx belongs
+// to a trace, and are issued with ctx, so that they're cancelled once ctx is done, e.g. when the deadline of the RPC
+// being served expires or the client goes away. Creates, queries, updates and deletes fail with the context's error
+// rather than being issued once ctx is done. The handle shares db's connection pool, callbacks and logger.
+func WithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	handle := db.Set(contextKey, ctx)
+	commonDB := db.CommonDB()
+	if bound, ok := commonDB.(contextSQLCommon); ok {
+		commonDB = bound.db
+	}
+	if contextDB, ok := commonDB.(contextCommonDB); ok {
+		setCommonDB(handle, contextSQLCommon{ctx: ctx, db: contextDB})
+	}
+	return handle
+}
@@ -0,0 +1,97 @@
+// +build cgo
+
+package config
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/trace"
+)
+
+type spanRecorder struct {
+	spans []*trace.SpanData
+}
+
+func (r *spanRecorder) ExportSpan(span *trace.SpanData) {
+	r.spans = append(r.spans, span)
+}
+
+func TestWithContext(t *testing.T) {
+	db := OpenDbConnection(NewSQLiteConfigProvider(DbConfig{
+		SQLiteFile: filepath.Join(t.TempDir(), "flyteadmin.db"),
+	}, mockScope.NewTestScope()))
+	defer db.Close()
+
+	t.Run("active context", func(t *testing.T) {
+		var result int
+		assert.NoError(t, WithContext(context.Background(), db).Raw("SELECT 1").Row().Scan(&result))
+		assert.Equal(t, 1, result)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var result struct{ Value int }
+		assert.ErrorIs(t, WithContext(ctx, db).Raw("SELECT 1 AS value").Scan(&result).Error, context.Canceled)
+		// The handle it was derived from isn't bound to the context.
+		assert.NoError(t, db.Raw("SELECT 1 AS value").Scan(&result).Error)
+		assert.Equal(t, 1, result.Value)
+	})
+
+	t.Run("traced context", func(t *testing.T) {
+		exporter := &spanRecorder{}
+		trace.RegisterExporter(exporter)
+		defer trace.UnregisterExporter(exporter)
+		ctx, parent := trace.StartSpan(context.Background(), "parent", trace.WithSampler(trace.AlwaysSample()))
+
+		var result int
+		assert.NoError(t, WithContext(ctx, db).Raw("SELECT 1").Row().Scan(&result))
+		parent.End()
+		assert.Len(t, exporter.spans, 2)
+		assert.Equal(t, "db.row_query", exporter.spans[0].Name)
+		assert.Equal(t, parent.SpanContext().SpanID, exporter.spans[0].ParentSpanID)
+		assert.Equal(t, "SELECT 1", exporter.spans[0].Attributes["db.statement"])
+	})
+
+	t.Run("transaction", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		var result int
+		assert.NoError(t, WithContext(context.Background(), tx).Raw("SELECT 1").Row().Scan(&result))
+		// Statements are still issued in the transaction.
+		assert.Equal(t, tx.CommonDB(), WithContext(context.Background(), tx).CommonDB().(contextSQLCommon).db)
+	})
+
+	t.Run("running query cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		// Cancelled like the context of an RPC whose client went away.
+		time.AfterFunc(100*time.Millisecond, cancel)
+
+		start := time.Now()
+		var count int64
+		err := WithContext(ctx, db).Raw("WITH RECURSIVE numbers(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM numbers " +
+			"WHERE n < 10000000000) SELECT count(*) FROM numbers").Row().Scan(&count)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Less(t, time.Since(start), 10*time.Second)
+	})
+
+	t.Run("transaction cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		tx := WithContext(ctx, db).Begin()
+		assert.NoError(t, tx.Error)
+		var result int
+		assert.NoError(t, tx.Raw("SELECT 1").Row().Scan(&result))
+
+		// Transactions begun through the handle are rolled back once its context is done.
+		cancel()
+		assert.Error(t, tx.Commit().Error)
+	})
+}
@@ -1,6 +1,11 @@
 package config
 
-import "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+import (
+	"database/sql"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
 
 // Database config. Contains values necessary to open a database connection.
 type DbConfig struct {
@@ -11,10 +16,25 @@ type DbConfig struct {
 	User         string `json:"user"`
 	Password     string `json:"password"`
 	ExtraOptions string `json:"options"`
+	// When set, the SQLite database at this path is used instead of postgres.
+	SQLiteFile string
+
+	MaxOpenConnections int           `json:"maxOpenConnections"`
+	MaxIdleConnections int           `json:"maxIdleConnections"`
+	ConnMaxLifeTime    time.Duration `json:"connMaxLifeTime"`
+	QueryTimeout       time.Duration `json:"queryTimeout"`
+	SlowQueryThreshold time.Duration `json:"slowQueryThreshold"`
+
+	// The number of workflow, task and launch plan gets cached by each repository. Zero disables caching.
+	CacheSize int           `json:"cacheSize"`
+	CacheTTL  time.Duration `json:"cacheTTL"`
+
+	// Sensitive fields are stored in the clear unless encryption is enabled.
+	Encryption interfaces.EncryptionConfig `json:"encryption"`
 }
 
 func NewDbConfig(dbConfigValues interfaces.DbConfig) DbConfig {
-	return DbConfig{
+	dbConfig := DbConfig{
 		BaseConfig: BaseConfig{
 			IsDebug: dbConfigValues.Debug,
 		},
@@ -24,5 +44,39 @@ func NewDbConfig(dbConfigValues interfaces.DbConfig) DbConfig {
 		User:         dbConfigValues.User,
 		Password:     dbConfigValues.Password,
 		ExtraOptions: dbConfigValues.ExtraOptions,
+		SQLiteFile:   dbConfigValues.SQLite.File,
+
+		MaxOpenConnections: dbConfigValues.MaxOpenConnections,
+		MaxIdleConnections: dbConfigValues.MaxIdleConnections,
+		ConnMaxLifeTime:    dbConfigValues.ConnMaxLifeTime.Duration,
+		QueryTimeout:       dbConfigValues.QueryTimeout.Duration,
+		SlowQueryThreshold: dbConfigValues.SlowQueryThreshold.Duration,
+		Encryption:         dbConfigValues.Encryption,
+	}
+	if dbConfigValues.Cache.Enabled {
+		dbConfig.CacheSize = dbConfigValues.Cache.Size
+		dbConfig.CacheTTL = dbConfigValues.Cache.TTL.Duration
+	}
+	return dbConfig
+}
+
+// IsSQLite returns whether the config points to a local SQLite database rather than postgres.
+func (c DbConfig) IsSQLite() bool {
+	return len(c.SQLiteFile) > 0
+}
+
+// ConfigureConnectionPool applies the connection pool limits set in the config. Unset limits keep the database/sql
+// defaults.
+func ConfigureConnectionPool(db *sql.DB, config DbConfig) {
+	if config.MaxOpenConnections > 0 {
+		db.SetMaxOpenConns(config.MaxOpenConnections)
+	}
+
+	if config.MaxIdleConnections > 0 {
+		db.SetMaxIdleConns(config.MaxIdleConnections)
+	}
+
+	if config.ConnMaxLifeTime > 0 {
+		db.SetConnMaxLifetime(config.ConnMaxLifeTime)
 	}
 }
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	startTimeKey = "flyteadmin:start_time"
+
+	tableLabel     = "table"
+	operationLabel = "operation"
+	errorTypeLabel = "error_type"
+
+	// Statements which aren't issued for a model, such as raw queries read through Rows, are labelled with this table.
+	rawTable = "raw"
+)
+
+// Metrics emitted for every statement issued through gorm, labelled by the table (and so the repo) and operation.
+type dbMetrics struct {
+	QueryDuration *prometheus.HistogramVec
+	Rows          *prometheus.SummaryVec
+	Errors        *prometheus.CounterVec
+	SlowQueries   *prometheus.CounterVec
+}
+
+var (
+	dbMetricsByScope     = make(map[string]*dbMetrics)
+	dbMetricsByScopeLock sync.Mutex
+)
+
+// Returns the metrics emitted under scope, which are shared by all connections opened with the same scope since metrics
+// can only be registered once.
+func getDbMetrics(scope promutils.Scope) *dbMetrics {
+	dbMetricsByScopeLock.Lock()
+	defer dbMetricsByScopeLock.Unlock()
+	if metrics, ok := dbMetricsByScope[scope.CurrentScope()]; ok {
+		return metrics
+	}
+	metrics := &dbMetrics{
+		QueryDuration: scope.MustNewHistogramVec("query_duration_seconds",
+			"time taken by database statements", tableLabel, operationLabel),
+		Rows: scope.MustNewSummaryVec("rows",
+			"number of rows read or affected by database statements", tableLabel, operationLabel),
+		Errors: scope.MustNewCounterVec("errors",
+			"overall count of database statements which failed", tableLabel, operationLabel, errorTypeLabel),
+		SlowQueries: scope.MustNewCounterVec("slow_queries",
+			"overall count of database statements which exceeded the slow query threshold", tableLabel, operationLabel),
+	}
+	dbMetricsByScope[scope.CurrentScope()] = metrics
+	return metrics
+}
+
+// Returns a coarse, low cardinality description of err to label metrics with.
+func getErrorType(err error) string {
+	var pqErr *pq.Error
+	switch {
+	case gorm.IsRecordNotFoundError(err):
+		return "not_found"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "deadline_exceeded"
+	case errors.As(err, &pqErr):
+		return pqErr.Code.Name()
+	default:
+		return "unknown"
+	}
+}
+
+func startTimer(scope *gorm.Scope) {
+	scope.InstanceSet(startTimeKey, time.Now())
+}
+
+func getTableName(scope *gorm.Scope) (tableName string) {
+	defer func() {
+		// gorm panics resolving the table of statements which aren't issued for a model.
+		if recover() != nil {
+			tableName = rawTable
+		}
+	}()
+	if tableName = scope.TableName(); len(tableName) == 0 {
+		return rawTable
+	}
+	return tableName
+}
+
+// Returns a callback which records the metrics for a statement once it has been issued, and logs it if it was slow.
+// Rows read through row queries are scanned after the callback, so they aren't counted.
+func newStopTimer(operation string, settings connectionSettings) func(scope *gorm.Scope) {
+	return func(scope *gorm.Scope) {
+		startTime, ok := scope.InstanceGet(startTimeKey)
+		if !ok {
+			return
+		}
+		duration := time.Since(startTime.(time.Time))
+		table := getTableName(scope)
+		settings.metrics.QueryDuration.WithLabelValues(table, operation).Observe(duration.Seconds())
+		if scope.HasError() {
+			settings.metrics.Errors.WithLabelValues(table, operation, getErrorType(scope.DB().Error)).Inc()
+		} else if operation != "row_query" {
+			settings.metrics.Rows.WithLabelValues(table, operation).Observe(float64(scope.DB().RowsAffected))
+		}
+		if settings.slowQueryThreshold > 0 && duration >= settings.slowQueryThreshold {
+			settings.metrics.SlowQueries.WithLabelValues(table, operation).Inc()
+			// gorm binds values as parameters rather than inlining them, so the statement logged doesn't contain any
+			// user data.
+			logger.Warningf(context.Background(), "Slow %s on [%s] took %v: %s", operation, table, duration, scope.SQL)
+		}
+	}
+}
+
+func registerMetricsCallbacks(db *gorm.DB, settings connectionSettings) {
+	if settings.metrics == nil {
+		return
+	}
+	callback := db.Callback()
+	callback.Create().Before("gorm:create").Register("flyteadmin:start_create", startTimer)
+	callback.Create().After("gorm:create").Register("flyteadmin:stop_create", newStopTimer("create", settings))
+	callback.Query().Before("gorm:query").Register("flyteadmin:start_query", startTimer)
+	callback.Query().After("gorm:query").Register("flyteadmin:stop_query", newStopTimer("query", settings))
+	callback.RowQuery().Before("gorm:row_query").Register("flyteadmin:start_row_query", startTimer)
+	callback.RowQuery().After("gorm:row_query").Register("flyteadmin:stop_row_query", newStopTimer("row_query", settings))
+	callback.Update().Before("gorm:update").Register("flyteadmin:start_update", startTimer)
+	callback.Update().After("gorm:update").Register("flyteadmin:stop_update", newStopTimer("update", settings))
+	callback.Delete().Before("gorm:delete").Register("flyteadmin:start_delete", startTimer)
+	callback.Delete().After("gorm:delete").Register("flyteadmin:stop_delete", newStopTimer("delete", settings))
+}
@@ -0,0 +1,68 @@
+// +build cgo
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+type metricsTestModel struct {
+	ID   uint `gorm:"primary_key"`
+	Name string
+}
+
+func TestMetricsCallbacks(t *testing.T) {
+	scope := mockScope.NewTestScope()
+	db := OpenDbConnection(NewSQLiteConfigProvider(DbConfig{
+		SQLiteFile: filepath.Join(t.TempDir(), "flyteadmin.db"),
+		// Every statement is logged as slow.
+		SlowQueryThreshold: time.Nanosecond,
+	}, scope))
+	defer db.Close()
+	assert.NoError(t, db.AutoMigrate(&metricsTestModel{}).Error)
+	metrics := getDbMetrics(scope.NewSubScope("database"))
+
+	ctxDb := WithContext(context.Background(), db)
+	assert.NoError(t, ctxDb.Create(&metricsTestModel{Name: "a"}).Error)
+	assert.NoError(t, ctxDb.Create(&metricsTestModel{Name: "b"}).Error)
+	var models []metricsTestModel
+	assert.NoError(t, ctxDb.Find(&models).Error)
+	assert.Len(t, models, 2)
+	assert.True(t, gorm.IsRecordNotFoundError(ctxDb.Where("name = ?", "c").First(&metricsTestModel{}).Error))
+
+	table := "metrics_test_models"
+	rows := &dto.Metric{}
+	assert.NoError(t, metrics.Rows.WithLabelValues(table, "query").(prometheus.Metric).Write(rows))
+	// Rows are only counted for the query which succeeded.
+	assert.Equal(t, uint64(1), rows.GetSummary().GetSampleCount())
+	assert.Equal(t, float64(2), rows.GetSummary().GetSampleSum())
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.Errors.WithLabelValues(table, "query", "not_found")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.SlowQueries.WithLabelValues(table, "create")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.SlowQueries.WithLabelValues(table, "query")))
+}
+
+func TestGetDbMetrics_SharedByScope(t *testing.T) {
+	scope := mockScope.NewTestScope()
+	assert.Same(t, getDbMetrics(scope), getDbMetrics(scope))
+}
+
+func TestGetErrorType(t *testing.T) {
+	assert.Equal(t, "not_found", getErrorType(gorm.ErrRecordNotFound))
+	assert.Equal(t, "canceled", getErrorType(fmt.Errorf("query failed: %w", context.Canceled)))
+	assert.Equal(t, "deadline_exceeded", getErrorType(context.DeadlineExceeded))
+	assert.Equal(t, "unique_violation", getErrorType(&pq.Error{Code: "23505"}))
+	assert.Equal(t, "unknown", getErrorType(errors.New("foo")))
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/jinzhu/gorm"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+// Identifies the postgres advisory lock held while migrating. Any constant works, so long as every admin replica
+// uses the same one.
+const migrationLockID int64 = 4387102
+
+// Migrate runs all pending migrations. The IDs of applied migrations are recorded in the gormigrate migrations table,
+// and the database is left untouched if it contains migrations this binary doesn't know about, since its schema is
+// then newer than the binary supports.
+// On postgres, migrations run while holding an advisory lock so that replicas which start together wait for each
+// other instead of racing to apply the same migrations.
+func Migrate(ctx context.Context, db *gorm.DB) error {
+	if db.Dialect().GetName() == Postgres {
+		unlock, err := acquireMigrationLock(ctx, db)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
+	if err := CheckSchemaVersion(db); err != nil {
+		return err
+	}
+	return gormigrate.New(db, gormigrate.DefaultOptions, Migrations).Migrate()
+}
+
+// Advisory locks belong to the session which acquired them, so the lock is taken on a connection reserved for it until
+// the returned func releases it.
+func acquireMigrationLock(ctx context.Context, db *gorm.DB) (func(), error) {
+	conn, err := db.DB().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Waiting for the migration lock")
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to acquire the migration lock: %w", err)
+	}
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID); err != nil {
+			logger.Warningf(ctx, "Failed to release the migration lock: %v", err)
+		}
+		_ = conn.Close()
+	}, nil
+}
+
+// CheckSchemaVersion returns an error if the database has migrations applied which aren't in Migrations, which means
+// it was migrated by a newer version of admin.
+func CheckSchemaVersion(db *gorm.DB) error {
+	options := gormigrate.DefaultOptions
+	if !db.HasTable(options.TableName) {
+		return nil
+	}
+	var applied []string
+	if err := db.Table(options.TableName).Pluck(options.IDColumnName, &applied).Error; err != nil {
+		return err
+	}
+	known := make(map[string]bool, len(Migrations))
+	for _, migration := range Migrations {
+		known[migration.ID] = true
+	}
+	unknown := make([]string, 0)
+	for _, id := range applied {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("database schema is newer than this version of admin supports, unknown migrations: %v",
+			unknown)
+	}
+	return nil
+}
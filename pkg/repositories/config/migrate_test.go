@@ -0,0 +1,116 @@
+// +build cgo
+
+package config
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/golang/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	gormigrate "gopkg.in/gormigrate.v1"
+)
+
+func newSQLiteDb(t *testing.T) *gorm.DB {
+	db := OpenDbConnection(NewSQLiteConfigProvider(DbConfig{
+		SQLiteFile: filepath.Join(t.TempDir(), "flyteadmin.db"),
+	}, mockScope.NewTestScope()))
+	t.Cleanup(func() {
+		assert.NoError(t, db.Close())
+	})
+	return db
+}
+
+func TestMigrate(t *testing.T) {
+	db := newSQLiteDb(t)
+	assert.NoError(t, CheckSchemaVersion(db))
+	assert.NoError(t, Migrate(context.Background(), db))
+
+	var applied int
+	assert.NoError(t, db.Table(gormigrate.DefaultOptions.TableName).Count(&applied).Error)
+	assert.Equal(t, len(Migrations), applied)
+
+	// Already applied migrations are skipped.
+	assert.NoError(t, Migrate(context.Background(), db))
+}
+
+func TestMigrations_WorkflowStateRollback(t *testing.T) {
+	db := newSQLiteDb(t)
+	assert.NoError(t, Migrate(context.Background(), db))
+	for _, migration := range Migrations {
+		if migration.ID != "2020-04-03-workflow-state" {
+			continue
+		}
+		assert.NoError(t, migration.Rollback(db))
+		assert.True(t, db.Dialect().HasColumn("workflows", "state"))
+		// Rolling back again leaves the column as is.
+		assert.NoError(t, migration.Rollback(db))
+		return
+	}
+	t.Fatal("migration not found")
+}
+
+func TestBackfillExecutionLabels(t *testing.T) {
+	db := newSQLiteDb(t)
+	assert.NoError(t, Migrate(context.Background(), db))
+
+	launchPlanSpec, err := proto.Marshal(&admin.LaunchPlanSpec{
+		Labels: &admin.Labels{Values: map[string]string{"team": "infra"}},
+	})
+	assert.NoError(t, err)
+	launchPlan := models.LaunchPlan{
+		LaunchPlanKey: models.LaunchPlanKey{Project: "flytesnacks", Domain: "development", Name: "lp", Version: "v1"},
+		Spec:          launchPlanSpec,
+	}
+	assert.NoError(t, db.Create(&launchPlan).Error)
+	assert.NoError(t, db.Create(&models.ProjectLabel{Project: "flytesnacks", Key: "env", Value: "prod"}).Error)
+	executionSpec, err := proto.Marshal(&admin.ExecutionSpec{
+		Labels: &admin.Labels{Values: map[string]string{"team": "ml", "env": "staging"}},
+	})
+	assert.NoError(t, err)
+	for _, execution := range []models.Execution{
+		{ExecutionKey: models.ExecutionKey{Name: "spec"}, LaunchPlanID: launchPlan.ID, Spec: executionSpec},
+		{ExecutionKey: models.ExecutionKey{Name: "launch-plan"}, LaunchPlanID: launchPlan.ID, Spec: []byte{}},
+		{ExecutionKey: models.ExecutionKey{Name: "labeled"}, LaunchPlanID: launchPlan.ID, Spec: executionSpec},
+	} {
+		execution.Project = "flytesnacks"
+		execution.Domain = "development"
+		assert.NoError(t, db.Create(&execution).Error)
+	}
+	assert.NoError(t, db.Create(&models.ExecutionLabel{
+		ExecutionKey: models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: "labeled"},
+		Key:          "team",
+		Value:        "data",
+	}).Error)
+
+	assert.NoError(t, backfillExecutionLabels(db))
+	getLabels := func(name string) map[string]string {
+		var labels []models.ExecutionLabel
+		assert.NoError(t, db.Where("execution_name = ?", name).Find(&labels).Error)
+		values := make(map[string]string, len(labels))
+		for _, label := range labels {
+			values[label.Key] = label.Value
+		}
+		return values
+	}
+	assert.Equal(t, map[string]string{"team": "ml", "env": "staging"}, getLabels("spec"))
+	assert.Equal(t, map[string]string{"team": "infra", "env": "prod"}, getLabels("launch-plan"))
+	// Executions which already have labels are left unchanged.
+	assert.Equal(t, map[string]string{"team": "data"}, getLabels("labeled"))
+}
+
+func TestMigrate_NewerSchema(t *testing.T) {
+	db := newSQLiteDb(t)
+	assert.NoError(t, Migrate(context.Background(), db))
+	assert.NoError(t, db.Exec("INSERT INTO migrations (id) VALUES (?)", "2099-01-01-unreleased").Error)
+
+	err := Migrate(context.Background(), db)
+	assert.EqualError(t, err,
+		"database schema is newer than this version of admin supports, unknown migrations: [2099-01-01-unreleased]")
+	assert.EqualError(t, CheckSchemaVersion(db), err.Error())
+}
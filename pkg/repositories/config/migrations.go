@@ -1,12 +1,32 @@
 package config
 
 import (
+	"fmt"
+
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/golang/protobuf/proto"
 	"github.com/jinzhu/gorm"
 	gormigrate "gopkg.in/gormigrate.v1"
 )
 
+// dropColumnsIfExist drops the given columns if present. Unlike "ALTER TABLE ... DROP COLUMN IF EXISTS", this works for
+// every supported database dialect.
+func dropColumnsIfExist(tx *gorm.DB, tableName string, columnNames ...string) error {
+	for _, columnName := range columnNames {
+		if !tx.Dialect().HasColumn(tableName, columnName) {
+			continue
+		}
+
+		if err := tx.Table(tableName).DropColumn(columnName).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 var Migrations = []*gormigrate.Migration{
 	// Create projects table.
 	{
@@ -117,7 +137,7 @@ var Migrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&models.Execution{}).Error
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS cluster").Error
+			return dropColumnsIfExist(tx, "executions", "cluster")
 		},
 	},
 	// Update projects table to add description column
@@ -127,7 +147,7 @@ var Migrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&models.Project{}).Error
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE projects DROP COLUMN IF EXISTS description").Error
+			return dropColumnsIfExist(tx, "projects", "description")
 		},
 	},
 	// Add offloaded URIs to table
@@ -137,7 +157,7 @@ var Migrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&models.Execution{}).Error
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS InputsURI, DROP COLUMN IF EXISTS UserInputsURI").Error
+			return dropColumnsIfExist(tx, "executions", "inputsuri", "userinputsuri")
 		},
 	},
 	// Create named_entity_metadata table.
@@ -167,7 +187,7 @@ var Migrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&models.Task{}).Error
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE tasks DROP COLUMN IF EXISTS type").Error
+			return dropColumnsIfExist(tx, "tasks", "type")
 		},
 	},
 	// Add state to name entity model
@@ -194,10 +214,14 @@ var Migrations = []*gormigrate.Migration{
 	{
 		ID: "2020-04-03-workflow-state",
 		Migrate: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE workflows DROP COLUMN IF EXISTS state").Error
+			return dropColumnsIfExist(tx, "workflows", "state")
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE workflows ADD COLUMN IF NOT EXISTS state integer;").Error
+			// SQLite doesn't support ADD COLUMN IF NOT EXISTS, so the column is looked up through the dialect instead.
+			if tx.Dialect().HasColumn("workflows", "state") {
+				return nil
+			}
+			return tx.Exec("ALTER TABLE workflows ADD COLUMN state integer;").Error
 		},
 	},
 	// Modify the executions & node_execution table, if necessary
@@ -220,7 +244,7 @@ var Migrations = []*gormigrate.Migration{
 			return tx.AutoMigrate(&models.Execution{}).Error
 		},
 		Rollback: func(tx *gorm.DB) error {
-			return tx.Exec("ALTER TABLE executions DROP COLUMN IF EXISTS task_id").Error
+			return dropColumnsIfExist(tx, "executions", "task_id")
 		},
 	},
 
@@ -326,4 +350,738 @@ var Migrations = []*gormigrate.Migration{
 			return tx.DropTable("schedulable_entities_snapshot").Error
 		},
 	},
+
+	{
+		ID: "2021-08-20-executions-created-at-idx",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Model(&models.Execution{}).AddIndex(
+				"idx_executions_created_at_id", "created_at", "id").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Model(&models.Execution{}).RemoveIndex("idx_executions_created_at_id").Error
+		},
+	},
+	{
+		ID: "2021-08-24-execution-labels",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ExecutionLabel{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("execution_labels").Error
+		},
+	},
+	// Indexes records by creation time within their project and domain for the retention pruner, which also looks up
+	// task executions by the node execution they belong to.
+	{
+		ID: "2021-09-01-retention-idx",
+		Migrate: func(tx *gorm.DB) error {
+			for _, index := range retentionIndexes {
+				if err := tx.Model(index.model).AddIndex(
+					index.name, "execution_project", "execution_domain", "created_at").Error; err != nil {
+					return err
+				}
+			}
+			return tx.Model(&models.TaskExecution{}).AddIndex("idx_task_executions_node_execution",
+				"execution_project", "execution_domain", "execution_name", "node_id").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, index := range retentionIndexes {
+				if err := tx.Model(index.model).RemoveIndex(index.name).Error; err != nil {
+					return err
+				}
+			}
+			return tx.Model(&models.TaskExecution{}).RemoveIndex("idx_task_executions_node_execution").Error
+		},
+	},
+	{
+		ID: "2021-09-20-outbox-messages",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.OutboxMessage{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTable("outbox_messages").Error
+		},
+	},
+	// Indexes entity names and descriptions with trigrams on postgres, which named entity searches match against.
+	{
+		ID: "2021-09-27-named-entity-search-idx",
+		Migrate: func(tx *gorm.DB) error {
+			if tx.Dialect().GetName() != Postgres {
+				return nil
+			}
+			if err := tx.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+				return err
+			}
+			for _, index := range searchIndexes {
+				if err := tx.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING gin (%s gin_trgm_ops)",
+					index.name, index.table, index.column)).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if tx.Dialect().GetName() != Postgres {
+				return nil
+			}
+			for _, index := range searchIndexes {
+				if err := tx.Exec(fmt.Sprintf("DROP INDEX IF EXISTS %s", index.name)).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	// Add the revision launch plan state transitions are checked against.
+	{
+		ID: "2021-10-04-launch-plan-state-revision",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.LaunchPlan{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "launch_plans", "state_revision")
+		},
+	},
+	// Add the idempotency key executions are created with, which is unique within a project and domain.
+	{
+		ID: "2021-10-06-execution-idempotency-key",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&models.Execution{}).Error; err != nil {
+				return err
+			}
+			return tx.Model(&models.Execution{}).AddUniqueIndex("idx_executions_idempotency_key",
+				"execution_project", "execution_domain", "idempotency_key").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Model(&models.Execution{}).RemoveIndex("idx_executions_idempotency_key").Error; err != nil {
+				return err
+			}
+			return dropColumnsIfExist(tx, "executions", "idempotency_key")
+		},
+	},
+	// Add the flag marking executions which are waiting to be admitted, and index them by project and domain so that
+	// they can be counted and dispatched quickly.
+	{
+		ID: "2021-10-08-execution-pending",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&models.Execution{}).Error; err != nil {
+				return err
+			}
+			return tx.Model(&models.Execution{}).AddIndex("idx_executions_pending",
+				"pending", "execution_project", "execution_domain").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Model(&models.Execution{}).RemoveIndex("idx_executions_pending").Error; err != nil {
+				return err
+			}
+			return dropColumnsIfExist(tx, "executions", "pending")
+		},
+	},
+	{
+		ID: "2021-10-12-execution-run-at",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "executions", "run_at")
+		},
+	},
+	{
+		ID: "2021-10-14-backfills",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Backfill{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("backfills").Error
+		},
+	},
+	{
+		ID: "2021-10-15-execution-priority",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "executions", "priority")
+		},
+	},
+	{
+		ID: "2021-10-16-task-execution-usages",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.TaskExecutionUsage{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("task_execution_usages").Error
+		},
+	},
+	{
+		ID: "2021-10-18-skipped-events",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.SkippedEvent{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("skipped_events").Error
+		},
+	},
+	{
+		ID: "2021-10-19-recorded-events",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.RecordedEvent{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("recorded_events").Error
+		},
+	},
+	{
+		ID: "2021-10-20-lineage",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.TaskExecutionArtifact{}, &models.ExecutionRelationship{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("task_execution_artifacts", "execution_relationships").Error
+		},
+	},
+	{
+		ID: "2021-10-21-execution-failure-cause",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "executions", "failure_cause")
+		},
+	},
+	{
+		ID: "2021-10-22-webhooks",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Webhook{}, &models.WebhookDelivery{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("webhooks", "webhook_deliveries").Error
+		},
+	},
+	{
+		ID: "2021-10-25-notification-deliveries",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NotificationDelivery{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("notification_deliveries").Error
+		},
+	},
+	{
+		ID: "2021-10-27-notification-digests",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NotificationDigestEntry{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("notification_digest_entries").Error
+		},
+	},
+	{
+		ID: "2021-10-28-notification-subscriptions",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NotificationSubscription{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("notification_subscriptions").Error
+		},
+	},
+	// Add the jitter, catch-up policy and paused state of native schedules.
+	{
+		ID: "2021-10-29-schedule-options",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.SchedulableEntity{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "schedulable_entities", "jitter_seconds", "catch_up_policy", "paused")
+		},
+	},
+	{
+		ID: "2021-10-30-schedule-runs",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.ScheduleRun{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("schedule_runs").Error
+		},
+	},
+	{
+		ID: "2021-10-31-schedule-concurrency-policy",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&schedulerModels.SchedulableEntity{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "schedulable_entities", "concurrency_policy")
+		},
+	},
+	{
+		ID: "2021-11-01-triggers",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Trigger{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("triggers").Error
+		},
+	},
+	{
+		ID: "2021-11-02-launch-plan-rollouts",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.LaunchPlanRollout{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("launch_plan_rollouts").Error
+		},
+	},
+	{
+		ID: "2021-11-03-cluster-pools",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ClusterPool{}, &models.ClusterHealth{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("cluster_pools", "cluster_healths").Error
+		},
+	},
+	{
+		ID: "2021-11-04-cluster-health-probes",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ClusterHealth{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "cluster_healths",
+				"probed_at", "probe_error", "consecutive_probe_failures", "propeller_heartbeat_at")
+		},
+	},
+	{
+		ID: "2021-11-05-cluster-resource-syncs",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ClusterResourceSync{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("cluster_resource_syncs").Error
+		},
+	},
+	{
+		ID: "2021-11-06-cluster-resource-sync-cleanup",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ClusterResourceSync{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "cluster_resource_syncs", "resource_api_version", "resource_kind",
+				"resource_name", "retired_at", "cleaned_up_at", "cleanup")
+		},
+	},
+	{
+		ID: "2021-11-07-domain-quotas",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.DomainQuota{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("domain_quotas").Error
+		},
+	},
+	// Add the number of times pending executions failed to launch and the error they last failed with.
+	{
+		ID: "2021-11-08-execution-launch-failures",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "executions", "launch_attempts", "launch_error")
+		},
+	},
+	// Add the daily totals of the calls each caller makes to each RPC.
+	{
+		ID: "2021-11-09-api-usages",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.APIUsage{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("api_usages").Error
+		},
+	},
+	// Index executions by when they were last updated, so that those which recently terminated can be listed quickly to
+	// compute launch plan stats.
+	{
+		ID: "2021-11-10-executions-updated-at-idx",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Model(&models.Execution{}).AddIndex(
+				"idx_executions_execution_updated_at", "execution_updated_at").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Model(&models.Execution{}).RemoveIndex("idx_executions_execution_updated_at").Error
+		},
+	},
+	// Track the inputs offloaded for executions until the executions are created.
+	{
+		ID: "2021-11-12-offloaded-literals",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.OffloadedLiteral{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("offloaded_literals").Error
+		},
+	},
+	// Track the purges of archived projects.
+	{
+		ID: "2021-11-15-project-purges",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ProjectPurge{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("project_purges").Error
+		},
+	},
+	// Adds owners, teams, metadata and links to projects, and stores project labels in their own table so that
+	// projects can be filtered on them.
+	{
+		ID: "2021-11-16-project-metadata",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&models.Project{}, &models.ProjectLabel{}).Error; err != nil {
+				return err
+			}
+			return backfillProjectLabels(tx)
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.DropTableIfExists("project_labels").Error; err != nil {
+				return err
+			}
+			return dropColumnsIfExist(tx, "projects", "owner", "team", "metadata", "links")
+		},
+	},
+	// Store domains in the database so that they can be managed without redeploying. The configured domains are
+	// seeded into the table at startup while it's empty.
+	{
+		ID: "2021-11-17-domains",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Domain{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("domains").Error
+		},
+	},
+	// Adds the settings executions in a project default to.
+	{
+		ID: "2021-11-18-project-execution-defaults",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Project{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "projects", "execution_defaults")
+		},
+	},
+	// Adds the long-form documentation of workflows, tasks and launch plans.
+	{
+		ID: "2021-11-19-named-entity-descriptions",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NamedEntityDescription{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("named_entity_descriptions").Error
+		},
+	},
+	// Adds the tags workflows and launch plans are browsed by.
+	{
+		ID: "2021-11-20-named-entity-tags",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NamedEntityTag{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("named_entity_tags").Error
+		},
+	},
+	// Adds the signals, such as approvals, which gate nodes of executions wait on.
+	{
+		ID: "2021-11-21-signals",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Signal{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("signals").Error
+		},
+	},
+	// Adds the comments left on executions after the fact.
+	{
+		ID: "2021-11-22-execution-comments",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.ExecutionComment{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("execution_comments").Error
+		},
+	},
+	// Adds the views users save for listing executions and workflows.
+	{
+		ID: "2021-11-23-saved-views",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.SavedView{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("saved_views").Error
+		},
+	},
+	// Adds the OAuth2 clients registered with the internal authorization server at runtime.
+	{
+		ID: "2021-11-24-oauth-clients",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.OAuthClient{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("oauth_clients").Error
+		},
+	},
+	// Adds the overrides of feature flags, and the changes made to them.
+	{
+		ID: "2021-11-25-feature-flags",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.FeatureFlag{}, &models.FeatureFlagChange{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("feature_flag_changes", "feature_flags").Error
+		},
+	},
+	// Adds the evictions of cached task outputs.
+	{
+		ID: "2021-11-26-cache-evictions",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.CacheEviction{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("cache_evictions").Error
+		},
+	},
+	// Adds the orgs projects are grouped into.
+	{
+		ID: "2021-11-27-orgs",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Org{}, &models.Project{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := dropColumnsIfExist(tx, "projects", "org"); err != nil {
+				return err
+			}
+			return tx.DropTableIfExists("orgs").Error
+		},
+	},
+	// Rows locked while admitting the executions of a project and domain.
+	{
+		ID: "2021-11-28-admission-locks",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.AdmissionLock{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("admission_locks").Error
+		},
+	},
+	// Digest entries are leased while their digest is sent rather than deleted before it's sent.
+	{
+		ID: "2021-11-29-notification-digest-leases",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.NotificationDigestEntry{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "notification_digest_entries", "lease_id", "leased_until")
+		},
+	},
+	// Outbox messages which failed to publish too many times are kept as dead letters.
+	{
+		ID: "2021-11-30-outbox-dead-letters",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.OutboxMessage{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "outbox_messages", "dead_lettered_at")
+		},
+	},
+	// The user who launched an execution while impersonating its principal.
+	{
+		ID: "2021-12-01-execution-impersonator",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Execution{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "executions", "impersonator")
+		},
+	},
+	// Idempotency keys are reserved before the execution created with them is launched.
+	{
+		ID: "2021-12-02-idempotency-key-reservations",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.IdempotencyKeyReservation{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists("idempotency_key_reservations").Error
+		},
+	},
+	// Executions created before their labels were stored in the execution_labels table are given the labels they were
+	// most likely launched with.
+	{
+		ID:      "2021-12-03-execution-labels-backfill",
+		Migrate: backfillExecutionLabels,
+		Rollback: func(tx *gorm.DB) error {
+			// Backfilled labels can't be told apart from those saved with new executions, and are left in place.
+			return nil
+		},
+	},
+	// Compiled workflow closures below the offloading threshold are stored with their workflow.
+	{
+		ID: "2021-12-04-workflow-closures",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.Workflow{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "workflows", "closure")
+		},
+	},
+	// Outbox messages are tracked by project so that they're purged with it.
+	{
+		ID: "2021-12-05-outbox-message-project",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&models.OutboxMessage{}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropColumnsIfExist(tx, "outbox_messages", "project")
+		},
+	},
+}
+
+// Copies the labels serialized with existing projects into the project_labels table.
+func backfillProjectLabels(tx *gorm.DB) error {
+	var projects []models.Project
+	if err := tx.Where("labels IS NOT NULL").Find(&projects).Error; err != nil {
+		return err
+	}
+	for _, project := range projects {
+		var serialized admin.Project
+		if err := proto.Unmarshal(project.Labels, &serialized); err != nil {
+			// Such projects are also returned without labels.
+			continue
+		}
+		for key, value := range serialized.GetLabels().GetValues() {
+			err := tx.Create(&models.ProjectLabel{Project: project.Identifier, Key: key, Value: value}).Error
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// The number of executions whose labels are backfilled at a time.
+const executionLabelsBackfillBatchSize = 1000
+
+// Matches executions without any rows in the execution_labels table.
+const withoutExecutionLabels = "NOT EXISTS (SELECT 1 FROM execution_labels WHERE " +
+	"execution_labels.execution_project = executions.execution_project AND " +
+	"execution_labels.execution_domain = executions.execution_domain AND " +
+	"execution_labels.execution_name = executions.execution_name)"
+
+// Copies the labels of executions without any in the execution_labels table into it. These are the labels of the
+// execution spec, or of its launch plan when the spec has none, along with those of its project which aren't already
+// set. Labels the project had when the execution was launched but has since dropped can't be recovered.
+func backfillExecutionLabels(tx *gorm.DB) error {
+	launchPlanLabels := make(map[uint]map[string]string)
+	projectLabels := make(map[string]map[string]string)
+	var lastID uint
+	for {
+		var executions []models.Execution
+		if err := tx.Unscoped().Select(
+			"id, execution_project, execution_domain, execution_name, launch_plan_id, spec").Where(
+			"id > ?", lastID).Where(withoutExecutionLabels).Order("id").Limit(
+			executionLabelsBackfillBatchSize).Find(&executions).Error; err != nil {
+			return err
+		}
+		if len(executions) == 0 {
+			return nil
+		}
+		for _, execution := range executions {
+			lastID = execution.ID
+			labels := make(map[string]string)
+			var spec admin.ExecutionSpec
+			if err := proto.Unmarshal(execution.Spec, &spec); err == nil && spec.GetLabels().GetValues() != nil {
+				labels = spec.GetLabels().GetValues()
+			} else if execution.LaunchPlanID != 0 {
+				values, err := getLaunchPlanLabels(tx, execution.LaunchPlanID, launchPlanLabels)
+				if err != nil {
+					return err
+				}
+				for key, value := range values {
+					labels[key] = value
+				}
+			}
+			values, err := getProjectLabels(tx, execution.Project, projectLabels)
+			if err != nil {
+				return err
+			}
+			for key, value := range values {
+				if _, ok := labels[key]; !ok {
+					labels[key] = value
+				}
+			}
+			for key, value := range labels {
+				if err := tx.Create(&models.ExecutionLabel{
+					ExecutionKey: execution.ExecutionKey,
+					Key:          key,
+					Value:        value,
+				}).Error; err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// Returns the labels of a launch plan spec, reading them from the launch_plans table the first time.
+func getLaunchPlanLabels(tx *gorm.DB, id uint, cache map[uint]map[string]string) (map[string]string, error) {
+	if labels, ok := cache[id]; ok {
+		return labels, nil
+	}
+	var launchPlan models.LaunchPlan
+	result := tx.Unscoped().Select("id, spec").Where("id = ?", id).Take(&launchPlan)
+	if result.Error != nil && !result.RecordNotFound() {
+		return nil, result.Error
+	}
+	var spec admin.LaunchPlanSpec
+	if err := proto.Unmarshal(launchPlan.Spec, &spec); err == nil {
+		cache[id] = spec.GetLabels().GetValues()
+	} else {
+		cache[id] = nil
+	}
+	return cache[id], nil
+}
+
+// Returns the labels of a project, reading them from the project_labels table the first time.
+func getProjectLabels(tx *gorm.DB, project string, cache map[string]map[string]string) (map[string]string, error) {
+	if labels, ok := cache[project]; ok {
+		return labels, nil
+	}
+	var projectLabels []models.ProjectLabel
+	if err := tx.Where(&models.ProjectLabel{Project: project}).Find(&projectLabels).Error; err != nil {
+		return nil, err
+	}
+	labels := make(map[string]string, len(projectLabels))
+	for _, label := range projectLabels {
+		labels[label.Key] = label.Value
+	}
+	cache[project] = labels
+	return labels, nil
+}
+
+var retentionIndexes = []struct {
+	name  string
+	model interface{}
+}{
+	{name: "idx_execution_events_retention", model: &models.ExecutionEvent{}},
+	{name: "idx_node_execution_events_retention", model: &models.NodeExecutionEvent{}},
+	{name: "idx_node_executions_retention", model: &models.NodeExecution{}},
+	{name: "idx_task_executions_retention", model: &models.TaskExecution{}},
+}
+
+var searchIndexes = []struct {
+	name   string
+	table  string
+	column string
+}{
+	{name: "idx_workflows_name_trgm", table: "workflows", column: "name"},
+	{name: "idx_tasks_name_trgm", table: "tasks", column: "name"},
+	{name: "idx_launch_plans_name_trgm", table: "launch_plans", column: "name"},
+	{name: "idx_named_entity_metadata_description_trgm", table: "named_entity_metadata", column: "description"},
 }
@@ -2,11 +2,11 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres" // Required to import database driver.
-	"github.com/qor/validations"
 )
 
 const Postgres = "postgres"
@@ -23,6 +23,10 @@ type DbConnectionConfigProvider interface {
 	WithDebugModeDisabled()
 	// Returns whether verbose logging is enabled or not.
 	IsDebug() bool
+	// Returns the duration after which statements are logged as slow. Zero disables slow query logging.
+	GetSlowQueryThreshold() time.Duration
+	// Returns the scope database metrics are emitted under.
+	GetScope() promutils.Scope
 }
 
 type BaseConfig struct {
@@ -48,13 +52,21 @@ func (p *PostgresConfigProvider) GetType() string {
 }
 
 func (p *PostgresConfigProvider) GetArgs() string {
+	var args string
 	if p.config.Password == "" {
 		// Switch for development
-		return fmt.Sprintf("host=%s port=%d dbname=%s user=%s sslmode=disable",
+		args = fmt.Sprintf("host=%s port=%d dbname=%s user=%s sslmode=disable",
 			p.config.Host, p.config.Port, p.config.DbName, p.config.User)
+	} else {
+		args = fmt.Sprintf("host=%s port=%d dbname=%s user=%s password=%s %s",
+			p.config.Host, p.config.Port, p.config.DbName, p.config.User, p.config.Password, p.config.ExtraOptions)
 	}
-	return fmt.Sprintf("host=%s port=%d dbname=%s user=%s password=%s %s",
-		p.config.Host, p.config.Port, p.config.DbName, p.config.User, p.config.Password, p.config.ExtraOptions)
+
+	if p.config.QueryTimeout > 0 {
+		// Passed on to the server as a run-time parameter which aborts any statement running longer.
+		args = fmt.Sprintf("%s statement_timeout=%d", args, p.config.QueryTimeout.Milliseconds())
+	}
+	return args
 }
 
 func (p *PostgresConfigProvider) WithDebugModeEnabled() {
@@ -69,6 +81,14 @@ func (p *PostgresConfigProvider) IsDebug() bool {
 	return p.config.IsDebug
 }
 
+func (p *PostgresConfigProvider) GetSlowQueryThreshold() time.Duration {
+	return p.config.SlowQueryThreshold
+}
+
+func (p *PostgresConfigProvider) GetScope() promutils.Scope {
+	return p.scope
+}
+
 // Opens a connection to the database specified in the config.
 // You must call CloseDbConnection at the end of your session!
 func OpenDbConnection(config DbConnectionConfigProvider) *gorm.DB {
@@ -76,7 +96,12 @@ func OpenDbConnection(config DbConnectionConfigProvider) *gorm.DB {
 	if err != nil {
 		panic(err)
 	}
-	db.LogMode(config.IsDebug())
-	validations.RegisterCallbacks(db)
+	settings := connectionSettings{
+		dialect:            config.GetType(),
+		isDebug:            config.IsDebug(),
+		slowQueryThreshold: config.GetSlowQueryThreshold(),
+		metrics:            getDbMetrics(config.GetScope().NewSubScope("database")),
+	}
+	configureDb(db, settings)
 	return db
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 
@@ -48,3 +49,16 @@ func TestConstructGormArgsWithPasswordNoExtra(t *testing.T) {
 
 	assert.Equal(t, "host=localhost port=5432 dbname=postgres user=postgres password=pass ", postgresConfigProvider.GetArgs())
 }
+
+func TestConstructGormArgsWithQueryTimeout(t *testing.T) {
+	postgresConfigProvider := NewPostgresConfigProvider(DbConfig{
+		Host:         "localhost",
+		Port:         5432,
+		DbName:       "postgres",
+		User:         "postgres",
+		QueryTimeout: 30 * time.Second,
+	}, mockScope.NewTestScope())
+
+	assert.Equal(t, "host=localhost port=5432 dbname=postgres user=postgres sslmode=disable statement_timeout=30000",
+		postgresConfigProvider.GetArgs())
+}
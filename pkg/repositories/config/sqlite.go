@@ -0,0 +1,134 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite" // Required to import database driver.
+)
+
+const SQLite = "sqlite3"
+
+// Writers wait this long for the database lock before failing with SQLITE_BUSY.
+const sqliteBusyTimeoutMillis = 5000
+
+// The dialect registered by gorm for sqlite, which sqliteDialect delegates to.
+var baseSQLiteDialect gorm.Dialect
+
+func init() {
+	dialect, ok := gorm.GetDialect(SQLite)
+	if !ok {
+		panic("sqlite dialect is not registered")
+	}
+
+	baseSQLiteDialect = dialect
+	gorm.RegisterDialect(SQLite, &sqliteDialect{})
+}
+
+// sqliteDialect adapts gorm's sqlite dialect to the flyteadmin models. Models embedding BaseModel declare an
+// auto-incremented ID next to a composite primary key, which sqlite can't express since it only auto-increments
+// primary keys. Such IDs are created as plain integer columns and assigned by assignSQLiteIDs instead.
+type sqliteDialect struct {
+	gorm.Dialect
+}
+
+func (d *sqliteDialect) SetDB(db gorm.SQLCommon) {
+	d.Dialect = reflect.New(reflect.TypeOf(baseSQLiteDialect).Elem()).Interface().(gorm.Dialect)
+	d.Dialect.SetDB(db)
+}
+
+func (d *sqliteDialect) DataTypeOf(field *gorm.StructField) string {
+	if isNonPrimaryAutoIncrement(field) {
+		return "integer"
+	}
+
+	return d.Dialect.DataTypeOf(field)
+}
+
+func isNonPrimaryAutoIncrement(field *gorm.StructField) bool {
+	_, autoIncrement := field.TagSettingsGet("AUTO_INCREMENT")
+	return autoIncrement && !field.IsPrimaryKey
+}
+
+// assignSQLiteIDs sets auto-incremented, non primary key IDs on create. It runs within the create transaction, which
+// holds the database write lock, so concurrently created records can't be assigned the same ID.
+func assignSQLiteIDs(scope *gorm.Scope) {
+	if scope.HasError() {
+		return
+	}
+
+	field, ok := scope.FieldByName("ID")
+	if !ok || !field.IsBlank || !isNonPrimaryAutoIncrement(field.StructField) {
+		return
+	}
+
+	var maxID uint64
+	if err := scope.SQLDB().QueryRow(fmt.Sprintf("SELECT COALESCE(MAX(%s), 0) FROM %s",
+		scope.Quote(field.DBName), scope.QuotedTableName())).Scan(&maxID); err != nil {
+		scope.Err(err)
+		return
+	}
+
+	scope.Err(field.Set(maxID + 1))
+}
+
+func registerSQLiteCallbacks(db *gorm.DB) {
+	db.Callback().Create().Before("gorm:create").Register("flyteadmin:assign_sqlite_ids", assignSQLiteIDs)
+}
+
+// SQLite implementation for DbConnectionConfigProvider. Meant for single-binary local sandbox deployments.
+type SQLiteConfigProvider struct {
+	config DbConfig
+	scope  promutils.Scope
+}
+
+func NewSQLiteConfigProvider(config DbConfig, scope promutils.Scope) DbConnectionConfigProvider {
+	return &SQLiteConfigProvider{
+		config: config,
+		scope:  scope,
+	}
+}
+
+func (p *SQLiteConfigProvider) GetType() string {
+	return SQLite
+}
+
+// GetArgs returns a DSN which enables write-ahead logging so readers don't block the writer, and starts transactions
+// with an immediate write lock so that concurrent read-modify-write transactions (e.g. upserts) are serialized instead
+// of failing to upgrade their lock mid-transaction.
+func (p *SQLiteConfigProvider) GetArgs() string {
+	return fmt.Sprintf("file:%s?_busy_timeout=%d&_journal_mode=WAL&_foreign_keys=on&_txlock=immediate",
+		p.config.SQLiteFile, sqliteBusyTimeoutMillis)
+}
+
+func (p *SQLiteConfigProvider) WithDebugModeEnabled() {
+	p.config.IsDebug = true
+}
+
+func (p *SQLiteConfigProvider) WithDebugModeDisabled() {
+	p.config.IsDebug = false
+}
+
+func (p *SQLiteConfigProvider) IsDebug() bool {
+	return p.config.IsDebug
+}
+
+func (p *SQLiteConfigProvider) GetSlowQueryThreshold() time.Duration {
+	return p.config.SlowQueryThreshold
+}
+
+func (p *SQLiteConfigProvider) GetScope() promutils.Scope {
+	return p.scope
+}
+
+// NewDbConnectionConfigProvider returns the connection config provider for the database type the config points to.
+func NewDbConnectionConfigProvider(config DbConfig, scope promutils.Scope) DbConnectionConfigProvider {
+	if config.IsSQLite() {
+		return NewSQLiteConfigProvider(config, scope)
+	}
+
+	return NewPostgresConfigProvider(config, scope)
+}
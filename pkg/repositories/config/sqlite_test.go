@@ -0,0 +1,26 @@
+package config
+
+import (
+	"testing"
+
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLiteConfigProvider(t *testing.T) {
+	sqliteConfigProvider := NewSQLiteConfigProvider(DbConfig{
+		SQLiteFile: "/tmp/flyteadmin.db",
+	}, mockScope.NewTestScope())
+
+	assert.Equal(t, SQLite, sqliteConfigProvider.GetType())
+	assert.Equal(t, "file:/tmp/flyteadmin.db?_busy_timeout=5000&_journal_mode=WAL&_foreign_keys=on&_txlock=immediate",
+		sqliteConfigProvider.GetArgs())
+	assert.False(t, sqliteConfigProvider.IsDebug())
+}
+
+func TestNewDbConnectionConfigProvider(t *testing.T) {
+	assert.IsType(t, &SQLiteConfigProvider{},
+		NewDbConnectionConfigProvider(DbConfig{SQLiteFile: "flyteadmin.db"}, mockScope.NewTestScope()))
+	assert.IsType(t, &PostgresConfigProvider{},
+		NewDbConnectionConfigProvider(DbConfig{Host: "localhost"}, mockScope.NewTestScope()))
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/tracing"
+	"github.com/jinzhu/gorm"
+	"go.opencensus.io/trace"
+)
+
+const (
+	contextKey = "flyteadmin:context"
+	spanKey    = "flyteadmin:span"
+)
+
+// Returns a callback which spans a statement issued through a handle returned by WithContext, if its context belongs to
+// a trace.
+func newSpanStarter(operation string, settings connectionSettings) func(scope *gorm.Scope) {
+	return func(scope *gorm.Scope) {
+		ctx, ok := scope.Get(contextKey)
+		if !ok {
+			return
+		}
+		_, span := tracing.StartChildSpan(ctx.(context.Context), "db."+operation,
+			trace.StringAttribute("db.system", settings.dialect),
+			trace.StringAttribute("db.operation", operation))
+		if span != nil {
+			scope.InstanceSet(spanKey, span)
+		}
+	}
+}
+
+func endSpan(scope *gorm.Scope) {
+	span, ok := scope.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	// gorm binds values as parameters rather than inlining them, so the statement doesn't contain any user data.
+	span.(*trace.Span).AddAttributes(
+		trace.StringAttribute("db.sql.table", getTableName(scope)),
+		trace.StringAttribute("db.statement", scope.SQL))
+	var err error
+	if scope.HasError() && !gorm.IsRecordNotFoundError(scope.DB().Error) {
+		err = scope.DB().Error
+	}
+	tracing.EndSpan(span.(*trace.Span), err)
+}
+
+func registerTracingCallbacks(db *gorm.DB, settings connectionSettings) {
+	callback := db.Callback()
+	callback.Create().Before("gorm:create").Register("flyteadmin:start_create_span", newSpanStarter("create", settings))
+	callback.Create().After("gorm:create").Register("flyteadmin:end_create_span", endSpan)
+	callback.Query().Before("gorm:query").Register("flyteadmin:start_query_span", newSpanStarter("query", settings))
+	callback.Query().After("gorm:query").Register("flyteadmin:end_query_span", endSpan)
+	callback.RowQuery().Before("gorm:row_query").Register("flyteadmin:start_row_query_span",
+		newSpanStarter("row_query", settings))
+	callback.RowQuery().After("gorm:row_query").Register("flyteadmin:end_row_query_span", endSpan)
+	callback.Update().Before("gorm:update").Register("flyteadmin:start_update_span", newSpanStarter("update", settings))
+	callback.Update().After("gorm:update").Register("flyteadmin:end_update_span", endSpan)
+	callback.Delete().Before("gorm:delete").Register("flyteadmin:start_delete_span", newSpanStarter("delete", settings))
+	callback.Delete().After("gorm:delete").Register("flyteadmin:end_delete_span", endSpan)
+}
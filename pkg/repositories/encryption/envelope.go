@@ -0,0 +1,166 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+)
+
+// Prefixes encrypted fields. Serialized protos never start with a zero byte, so fields stored before encryption was
+// enabled are told apart from encrypted ones and read as they are.
+var envelopeMagic = []byte{0, 'f', 'e', 'n', 'c', 1}
+
+// An encrypted field: the data encrypted with a data key of its own, along with the data key wrapped with a key of the
+// key provider.
+type envelope struct {
+	keyID      string
+	wrappedKey []byte
+	nonce      []byte
+	ciphertext []byte
+}
+
+func appendBytes(buf []byte, value []byte) []byte {
+	size := make([]byte, binary.MaxVarintLen64)
+	buf = append(buf, size[:binary.PutUvarint(size, uint64(len(value)))]...)
+	return append(buf, value...)
+}
+
+func (e envelope) marshal() []byte {
+	buf := append([]byte{}, envelopeMagic...)
+	buf = appendBytes(buf, []byte(e.keyID))
+	buf = appendBytes(buf, e.wrappedKey)
+	buf = appendBytes(buf, e.nonce)
+	return append(buf, e.ciphertext...)
+}
+
+func readBytes(buf []byte) ([]byte, []byte, error) {
+	size, n := binary.Uvarint(buf)
+	if n <= 0 || uint64(len(buf)-n) < size {
+		return nil, nil, fmt.Errorf("encrypted field is truncated")
+	}
+	return buf[n : n+int(size)], buf[n+int(size):], nil
+}
+
+func unmarshalEnvelope(blob []byte) (envelope, error) {
+	buf := blob[len(envelopeMagic):]
+	keyID, buf, err := readBytes(buf)
+	if err != nil {
+		return envelope{}, err
+	}
+	wrappedKey, buf, err := readBytes(buf)
+	if err != nil {
+		return envelope{}, err
+	}
+	nonce, ciphertext, err := readBytes(buf)
+	if err != nil {
+		return envelope{}, err
+	}
+	return envelope{
+		keyID:      string(keyID),
+		wrappedKey: wrappedKey,
+		nonce:      nonce,
+		ciphertext: ciphertext,
+	}, nil
+}
+
+// IsEncrypted returns whether a stored field was encrypted by an Encryptor.
+func IsEncrypted(blob []byte) bool {
+	return bytes.HasPrefix(blob, envelopeMagic)
+}
+
+// Encrypts fields with data keys of their own, which are wrapped with the key of the tenant the field belongs to.
+// Associated data, such as the identifier of the record a field belongs to, is authenticated along with the field so
+// that encrypted fields can't be moved between records.
+type Encryptor struct {
+	keys KeyProvider
+}
+
+func (e *Encryptor) Encrypt(ctx context.Context, tenant Tenant, plaintext, associatedData []byte) ([]byte, error) {
+	keyID, err := e.keys.CurrentKeyID(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	wrappedKey, err := e.keys.WrapKey(ctx, keyID, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return envelope{
+		keyID:      keyID,
+		wrappedKey: wrappedKey,
+		nonce:      nonce,
+		ciphertext: aead.Seal(nil, nonce, plaintext, associatedData),
+	}.marshal(), nil
+}
+
+// Decrypts a field encrypted with Encrypt. Fields which aren't encrypted are returned as they are.
+func (e *Encryptor) Decrypt(ctx context.Context, blob, associatedData []byte) ([]byte, error) {
+	if !IsEncrypted(blob) {
+		return blob, nil
+	}
+	encrypted, err := unmarshalEnvelope(blob)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := e.keys.UnwrapKey(ctx, encrypted.keyID, encrypted.wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with key [%s]: %v", encrypted.keyID, err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(encrypted.nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted field has a nonce of %d bytes", len(encrypted.nonce))
+	}
+	return aead.Open(nil, encrypted.nonce, encrypted.ciphertext, associatedData)
+}
+
+// Rewrap wraps the data key of an encrypted field with the current key of its tenant, leaving the field encrypted with
+// the same data key. Fields which aren't encrypted yet are encrypted. Returns whether the field changed.
+func (e *Encryptor) Rewrap(ctx context.Context, tenant Tenant, blob, associatedData []byte) ([]byte, bool, error) {
+	if !IsEncrypted(blob) {
+		encrypted, err := e.Encrypt(ctx, tenant, blob, associatedData)
+		return encrypted, err == nil, err
+	}
+	keyID, err := e.keys.CurrentKeyID(ctx, tenant)
+	if err != nil {
+		return nil, false, err
+	}
+	encrypted, err := unmarshalEnvelope(blob)
+	if err != nil {
+		return nil, false, err
+	}
+	if encrypted.keyID == keyID {
+		return blob, false, nil
+	}
+	dataKey, err := e.keys.UnwrapKey(ctx, encrypted.keyID, encrypted.wrappedKey)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to unwrap data key with key [%s]: %v", encrypted.keyID, err)
+	}
+	encrypted.wrappedKey, err = e.keys.WrapKey(ctx, keyID, dataKey)
+	if err != nil {
+		return nil, false, err
+	}
+	encrypted.keyID = keyID
+	return encrypted.marshal(), true, nil
+}
+
+func NewEncryptor(keys KeyProvider) *Encryptor {
+	return &Encryptor{
+		keys: keys,
+	}
+}
@@ -0,0 +1,70 @@
+package encryption
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testAssociatedData = []byte("execution/flytesnacks/development/name")
+
+func TestEncryptor(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider(t))
+	plaintext := []byte("spec")
+
+	blob, err := encryptor.Encrypt(context.Background(), Tenant{Project: "flytesnacks"}, plaintext, testAssociatedData)
+	assert.NoError(t, err)
+	assert.True(t, IsEncrypted(blob))
+	assert.NotContains(t, string(blob), string(plaintext))
+
+	decrypted, err := encryptor.Decrypt(context.Background(), blob, testAssociatedData)
+	assert.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+
+	// Encrypted fields can't be read as those of another record.
+	_, err = encryptor.Decrypt(context.Background(), blob, []byte("execution/flytesnacks/development/other"))
+	assert.Error(t, err)
+	_, err = encryptor.Decrypt(context.Background(), blob[:len(envelopeMagic)+2], testAssociatedData)
+	assert.Error(t, err)
+}
+
+func TestEncryptor_DecryptPlaintext(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider(t))
+	plaintext := []byte{0x0a, 0x02, 'i', 'd'}
+
+	assert.False(t, IsEncrypted(plaintext))
+	decrypted, err := encryptor.Decrypt(context.Background(), plaintext, testAssociatedData)
+	assert.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+}
+
+func TestEncryptor_Rewrap(t *testing.T) {
+	keys := newTestKeyProvider(t)
+	encryptor := NewEncryptor(keys)
+	plaintext := []byte("spec")
+	blob, err := encryptor.Encrypt(context.Background(), Tenant{Project: "flytesnacks"}, plaintext, testAssociatedData)
+	assert.NoError(t, err)
+
+	_, changed, err := encryptor.Rewrap(context.Background(), Tenant{Project: "flytesnacks"}, blob, testAssociatedData)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// The project moved into an org with a key of its own.
+	rewrapped, changed, err := encryptor.Rewrap(
+		context.Background(), Tenant{Org: "research", Project: "flytesnacks"}, blob, testAssociatedData)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	encrypted, err := unmarshalEnvelope(rewrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, "research", encrypted.keyID)
+	decrypted, err := encryptor.Decrypt(context.Background(), rewrapped, testAssociatedData)
+	assert.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+
+	encryptedPlaintext, changed, err := encryptor.Rewrap(
+		context.Background(), Tenant{Project: "sandbox"}, plaintext, testAssociatedData)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, IsEncrypted(encryptedPlaintext))
+}
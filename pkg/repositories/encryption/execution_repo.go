@@ -0,0 +1,195 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+// Implemented by repositories which encrypt the fields they store.
+type KeyRotator interface {
+	// Wraps the data keys of stored fields with the current keys of their tenants, and encrypts fields stored before
+	// encryption was enabled, in batches of the given size. Returns the number of records which changed.
+	RotateKeys(ctx context.Context, batchSize int) (int, error)
+}
+
+// Encrypts execution specs, which hold security contexts, auth roles and the literals executions are launched with,
+// with the key of the project the execution belongs to or that project's org.
+type ExecutionRepo struct {
+	interfaces.ExecutionRepoInterface
+	projects  interfaces.ProjectRepoInterface
+	encryptor *Encryptor
+}
+
+func getExecutionAssociatedData(key models.ExecutionKey) []byte {
+	return []byte(fmt.Sprintf("execution/%s/%s/%s", key.Project, key.Domain, key.Name))
+}
+
+func getTenant(ctx context.Context, projects interfaces.ProjectRepoInterface, project string) (Tenant, error) {
+	projectModel, err := projects.Get(ctx, project)
+	if err != nil {
+		return Tenant{}, err
+	}
+	return Tenant{
+		Org:     projectModel.Org,
+		Project: project,
+	}, nil
+}
+
+func (r *ExecutionRepo) encryptSpec(ctx context.Context, execution *models.Execution) error {
+	if len(execution.Spec) == 0 || IsEncrypted(execution.Spec) {
+		return nil
+	}
+	tenant, err := getTenant(ctx, r.projects, execution.Project)
+	if err != nil {
+		return err
+	}
+	spec, err := r.encryptor.Encrypt(ctx, tenant, execution.Spec, getExecutionAssociatedData(execution.ExecutionKey))
+	if err != nil {
+		logger.Errorf(ctx, "Failed to encrypt spec of execution [%+v] with err: %v", execution.ExecutionKey, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to encrypt execution spec")
+	}
+	execution.Spec = spec
+	return nil
+}
+
+func (r *ExecutionRepo) decryptSpec(ctx context.Context, execution *models.Execution) error {
+	spec, err := r.encryptor.Decrypt(ctx, execution.Spec, getExecutionAssociatedData(execution.ExecutionKey))
+	if err != nil {
+		logger.Errorf(ctx, "Failed to decrypt spec of execution [%+v] with err: %v", execution.ExecutionKey, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to decrypt execution spec")
+	}
+	execution.Spec = spec
+	return nil
+}
+
+func (r *ExecutionRepo) Create(ctx context.Context, input models.Execution) error {
+	if err := r.encryptSpec(ctx, &input); err != nil {
+		return err
+	}
+	return r.ExecutionRepoInterface.Create(ctx, input)
+}
+
+func (r *ExecutionRepo) Update(ctx context.Context, execution models.Execution) error {
+	if err := r.encryptSpec(ctx, &execution); err != nil {
+		return err
+	}
+	return r.ExecutionRepoInterface.Update(ctx, execution)
+}
+
+func (r *ExecutionRepo) UpdateWithMessages(
+	ctx context.Context, execution models.Execution, messages []models.OutboxMessage) error {
+	if err := r.encryptSpec(ctx, &execution); err != nil {
+		return err
+	}
+	return r.ExecutionRepoInterface.UpdateWithMessages(ctx, execution, messages)
+}
+
+func (r *ExecutionRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+	execution, err := r.ExecutionRepoInterface.Get(ctx, input)
+	if err != nil {
+		return models.Execution{}, err
+	}
+	if err := r.decryptSpec(ctx, &execution); err != nil {
+		return models.Execution{}, err
+	}
+	return execution, nil
+}
+
+func (r *ExecutionRepo) GetByIdempotencyKey(
+	ctx context.Context, input interfaces.IdempotencyKey) (models.Execution, error) {
+	execution, err := r.ExecutionRepoInterface.GetByIdempotencyKey(ctx, input)
+	if err != nil {
+		return models.Execution{}, err
+	}
+	if err := r.decryptSpec(ctx, &execution); err != nil {
+		return models.Execution{}, err
+	}
+	return execution, nil
+}
+
+func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.ExecutionCollectionOutput, error) {
+	output, err := r.ExecutionRepoInterface.List(ctx, input)
+	if err != nil {
+		return interfaces.ExecutionCollectionOutput{}, err
+	}
+	for i := range output.Executions {
+		if err := r.decryptSpec(ctx, &output.Executions[i]); err != nil {
+			return interfaces.ExecutionCollectionOutput{}, err
+		}
+	}
+	return output, nil
+}
+
+// Soft-deleted executions aren't listed, so their data keys are rotated once they're restored.
+func (r *ExecutionRepo) RotateKeys(ctx context.Context, batchSize int) (int, error) {
+	filter, err := common.NewSingleValueFilter(common.Execution, common.GreaterThan, "id", 0)
+	if err != nil {
+		return 0, err
+	}
+	tenants := make(map[string]Tenant)
+	cursor := interfaces.ListCursor{}
+	var rotated int
+	for {
+		output, err := r.ExecutionRepoInterface.List(ctx, interfaces.ListResourceInput{
+			Limit:         batchSize,
+			InlineFilters: []common.InlineFilter{filter},
+			Cursor:        &cursor,
+		})
+		if err != nil {
+			return rotated, err
+		}
+		for _, execution := range output.Executions {
+			tenant, ok := tenants[execution.Project]
+			if !ok {
+				if tenant, err = getTenant(ctx, r.projects, execution.Project); err != nil {
+					return rotated, err
+				}
+				tenants[execution.Project] = tenant
+			}
+			spec, changed, err := r.encryptor.Rewrap(
+				ctx, tenant, execution.Spec, getExecutionAssociatedData(execution.ExecutionKey))
+			if err != nil {
+				logger.Errorf(ctx, "Failed to rotate the key of execution [%+v] with err: %v",
+					execution.ExecutionKey, err)
+				return rotated, errors.NewFlyteAdminErrorf(codes.Internal, "failed to rotate execution spec key")
+			}
+			if !changed {
+				continue
+			}
+			if err := r.ExecutionRepoInterface.Update(ctx, models.Execution{
+				ExecutionKey: execution.ExecutionKey,
+				Spec:         spec,
+			}); err != nil {
+				return rotated, err
+			}
+			rotated++
+		}
+		if len(output.Executions) < batchSize {
+			return rotated, nil
+		}
+		last := output.Executions[len(output.Executions)-1]
+		cursor = interfaces.ListCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+}
+
+// Returns an ExecutionRepoInterface which encrypts the specs executions are stored with and decrypts those it reads.
+// Specs stored before encryption was enabled are read as they are.
+func NewExecutionRepo(repo interfaces.ExecutionRepoInterface, projects interfaces.ProjectRepoInterface,
+	encryptor *Encryptor) interfaces.ExecutionRepoInterface {
+	return &ExecutionRepo{
+		ExecutionRepoInterface: repo,
+		projects:               projects,
+		encryptor:              encryptor,
+	}
+}
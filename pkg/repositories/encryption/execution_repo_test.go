@@ -0,0 +1,122 @@
+package encryption
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/stretchr/testify/assert"
+)
+
+var testExecutionKey = models.ExecutionKey{
+	Project: "flytesnacks",
+	Domain:  "development",
+	Name:    "name",
+}
+
+// Returns an execution repo which stores the executions it's given in memory, wrapped with an encrypting one.
+func newTestExecutionRepo(t *testing.T, stored map[string]models.Execution) interfaces.ExecutionRepoInterface {
+	mockRepo := repositoryMocks.NewMockExecutionRepo().(*repositoryMocks.MockExecutionRepo)
+	mockRepo.SetCreateCallback(func(ctx context.Context, input models.Execution) error {
+		stored[input.Name] = input
+		return nil
+	})
+	mockRepo.SetUpdateCallback(func(ctx context.Context, execution models.Execution) error {
+		existing := stored[execution.Name]
+		existing.Spec = execution.Spec
+		stored[execution.Name] = existing
+		return nil
+	})
+	mockRepo.SetGetCallback(func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+		return stored[input.Name], nil
+	})
+	mockRepo.SetListCallback(func(ctx context.Context, input interfaces.ListResourceInput) (
+		interfaces.ExecutionCollectionOutput, error) {
+		var executions []models.Execution
+		for _, execution := range stored {
+			if execution.ID > input.Cursor.ID {
+				executions = append(executions, execution)
+			}
+		}
+		sort.Slice(executions, func(i, j int) bool {
+			return executions[i].ID < executions[j].ID
+		})
+		if len(executions) > input.Limit {
+			executions = executions[:input.Limit]
+		}
+		return interfaces.ExecutionCollectionOutput{Executions: executions}, nil
+	})
+	projectRepo := repositoryMocks.NewMockProjectRepo().(*repositoryMocks.MockProjectRepo)
+	projectRepo.GetFunction = func(ctx context.Context, projectID string) (models.Project, error) {
+		return models.Project{Identifier: projectID, Org: "research"}, nil
+	}
+	return NewExecutionRepo(mockRepo, projectRepo, NewEncryptor(newTestKeyProvider(t)))
+}
+
+func TestExecutionRepo(t *testing.T) {
+	stored := make(map[string]models.Execution)
+	repo := newTestExecutionRepo(t, stored)
+
+	assert.NoError(t, repo.Create(context.Background(), models.Execution{
+		ExecutionKey: testExecutionKey,
+		Spec:         []byte("spec"),
+	}))
+	assert.True(t, IsEncrypted(stored["name"].Spec))
+	execution, err := repo.Get(context.Background(), interfaces.Identifier{
+		Project: "flytesnacks",
+		Domain:  "development",
+		Name:    "name",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("spec"), execution.Spec)
+
+	assert.NoError(t, repo.Update(context.Background(), models.Execution{
+		ExecutionKey: testExecutionKey,
+		Spec:         []byte("updated spec"),
+	}))
+	assert.True(t, IsEncrypted(stored["name"].Spec))
+	executions, err := repo.List(context.Background(), interfaces.ListResourceInput{
+		Limit:  10,
+		Cursor: &interfaces.ListCursor{},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, executions.Executions, 1)
+	assert.Equal(t, []byte("updated spec"), executions.Executions[0].Spec)
+}
+
+func TestExecutionRepo_RotateKeys(t *testing.T) {
+	stored := map[string]models.Execution{
+		"plaintext": {
+			BaseModel:    models.BaseModel{ID: 1},
+			ExecutionKey: models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: "plaintext"},
+			Spec:         []byte("spec"),
+		},
+	}
+	repo := newTestExecutionRepo(t, stored)
+	encryptor := repo.(*ExecutionRepo).encryptor
+	// Encrypted under the default key, before the project moved into the research org.
+	spec, err := encryptor.Encrypt(context.Background(), Tenant{Project: "flytesnacks"}, []byte("spec"),
+		getExecutionAssociatedData(testExecutionKey))
+	assert.NoError(t, err)
+	stored["name"] = models.Execution{
+		BaseModel:    models.BaseModel{ID: 2},
+		ExecutionKey: testExecutionKey,
+		Spec:         spec,
+	}
+
+	rotated, err := repo.(KeyRotator).RotateKeys(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rotated)
+	for name, execution := range stored {
+		encrypted, err := unmarshalEnvelope(execution.Spec)
+		assert.NoError(t, err, name)
+		assert.Equal(t, "research", encrypted.keyID, name)
+	}
+
+	rotated, err = repo.(KeyRotator).RotateKeys(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Zero(t, rotated)
+}
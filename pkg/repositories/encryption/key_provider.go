@@ -0,0 +1,139 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+const keySize = 32
+
+// Identifies whose data is encrypted, which determines the key its data keys are wrapped with.
+type Tenant struct {
+	Org     string
+	Project string
+}
+
+// Wraps and unwraps data keys with the keys of a key management service. Keys never leave the provider.
+type KeyProvider interface {
+	// Returns the id of the key new data keys of a tenant are wrapped with.
+	CurrentKeyID(ctx context.Context, tenant Tenant) (string, error)
+	// Encrypts a data key with the key with the given id.
+	WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error)
+	// Decrypts a data key wrapped with the key with the given id.
+	UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// Wraps data keys with keys read from files, such as mounted secrets, when admin starts.
+type staticKeyProvider struct {
+	keys          map[string]cipher.AEAD
+	defaultKeyID  string
+	orgKeyIDs     map[string]string
+	projectKeyIDs map[string]string
+}
+
+func (p *staticKeyProvider) CurrentKeyID(ctx context.Context, tenant Tenant) (string, error) {
+	if keyID, ok := p.projectKeyIDs[tenant.Project]; ok {
+		return keyID, nil
+	}
+	if keyID, ok := p.orgKeyIDs[tenant.Org]; ok && len(tenant.Org) > 0 {
+		return keyID, nil
+	}
+	if len(p.defaultKeyID) == 0 {
+		return "", fmt.Errorf("no key is configured for project [%s] of org [%s]", tenant.Project, tenant.Org)
+	}
+	return p.defaultKeyID, nil
+}
+
+func (p *staticKeyProvider) getKey(keyID string) (cipher.AEAD, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key [%s] isn't configured", keyID)
+	}
+	return key, nil
+}
+
+func (p *staticKeyProvider) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	key, err := p.getKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, key.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return key.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+func (p *staticKeyProvider) UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	key, err := p.getKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < key.NonceSize() {
+		return nil, fmt.Errorf("data key wrapped with key [%s] is truncated", keyID)
+	}
+	nonce, ciphertext := wrappedKey[:key.NonceSize()], wrappedKey[key.NonceSize():]
+	return key.Open(nil, nonce, ciphertext, []byte(keyID))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func readKeyFile(keyID, path string) (cipher.AEAD, error) {
+	encoded, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key [%s] from path [%s] with err: %v", keyID, path, err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return nil, fmt.Errorf("key [%s] at path [%s] isn't base64 encoded: %v", keyID, path, err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("key [%s] at path [%s] has %d bytes rather than %d", keyID, path, len(key), keySize)
+	}
+	return newAEAD(key)
+}
+
+// Returns a KeyProvider which wraps data keys with the keys configured, or an error if a key can't be read or a
+// tenant's current key isn't one of them.
+func NewStaticKeyProvider(config runtimeInterfaces.EncryptionConfig) (KeyProvider, error) {
+	keys := make(map[string]cipher.AEAD, len(config.KeyFiles))
+	for keyID, path := range config.KeyFiles {
+		key, err := readKeyFile(keyID, path)
+		if err != nil {
+			return nil, err
+		}
+		keys[keyID] = key
+	}
+	currentKeyIDs := []string{config.DefaultKeyID}
+	for _, keyID := range config.OrgKeyIDs {
+		currentKeyIDs = append(currentKeyIDs, keyID)
+	}
+	for _, keyID := range config.ProjectKeyIDs {
+		currentKeyIDs = append(currentKeyIDs, keyID)
+	}
+	for _, keyID := range currentKeyIDs {
+		if _, ok := keys[keyID]; !ok && len(keyID) > 0 {
+			return nil, fmt.Errorf("key [%s] isn't configured", keyID)
+		}
+	}
+	return &staticKeyProvider{
+		keys:          keys,
+		defaultKeyID:  config.DefaultKeyID,
+		orgKeyIDs:     config.OrgKeyIDs,
+		projectKeyIDs: config.ProjectKeyIDs,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeKeyFile(t *testing.T, key string) string {
+	path := filepath.Join(t.TempDir(), "key")
+	encoded := base64.StdEncoding.EncodeToString([]byte(key))
+	if err := ioutil.WriteFile(path, []byte(encoded+"\n"), 0600); err != nil {
+		t.Fatalf("Failed to write key file with err %v", err)
+	}
+	return path
+}
+
+func newTestKeyProvider(t *testing.T) KeyProvider {
+	keys, err := NewStaticKeyProvider(runtimeInterfaces.EncryptionConfig{
+		Enabled: true,
+		KeyFiles: map[string]string{
+			"default":  writeKeyFile(t, strings.Repeat("d", keySize)),
+			"research": writeKeyFile(t, strings.Repeat("r", keySize)),
+			"sandbox":  writeKeyFile(t, strings.Repeat("s", keySize)),
+		},
+		DefaultKeyID:  "default",
+		OrgKeyIDs:     map[string]string{"research": "research"},
+		ProjectKeyIDs: map[string]string{"sandbox": "sandbox"},
+	})
+	assert.NoError(t, err)
+	return keys
+}
+
+func TestStaticKeyProvider_CurrentKeyID(t *testing.T) {
+	keys := newTestKeyProvider(t)
+	for _, test := range []struct {
+		tenant Tenant
+		keyID  string
+	}{
+		{Tenant{Project: "flytesnacks"}, "default"},
+		{Tenant{Org: "research", Project: "flytesnacks"}, "research"},
+		// Keys of projects take precedence over those of their orgs.
+		{Tenant{Org: "research", Project: "sandbox"}, "sandbox"},
+	} {
+		keyID, err := keys.CurrentKeyID(context.Background(), test.tenant)
+		assert.NoError(t, err)
+		assert.Equal(t, test.keyID, keyID)
+	}
+}
+
+func TestStaticKeyProvider_WrapKey(t *testing.T) {
+	keys := newTestKeyProvider(t)
+	dataKey := []byte(strings.Repeat("k", keySize))
+
+	wrappedKey, err := keys.WrapKey(context.Background(), "research", dataKey)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(wrappedKey), string(dataKey))
+	unwrappedKey, err := keys.UnwrapKey(context.Background(), "research", wrappedKey)
+	assert.NoError(t, err)
+	assert.Equal(t, dataKey, unwrappedKey)
+
+	_, err = keys.UnwrapKey(context.Background(), "default", wrappedKey)
+	assert.Error(t, err)
+	_, err = keys.WrapKey(context.Background(), "missing", dataKey)
+	assert.EqualError(t, err, "key [missing] isn't configured")
+}
+
+func TestNewStaticKeyProvider_InvalidConfig(t *testing.T) {
+	_, err := NewStaticKeyProvider(runtimeInterfaces.EncryptionConfig{
+		KeyFiles:     map[string]string{"default": writeKeyFile(t, "short")},
+		DefaultKeyID: "default",
+	})
+	assert.Error(t, err)
+
+	_, err = NewStaticKeyProvider(runtimeInterfaces.EncryptionConfig{
+		KeyFiles:     map[string]string{"default": writeKeyFile(t, strings.Repeat("d", keySize))},
+		DefaultKeyID: "default",
+		OrgKeyIDs:    map[string]string{"research": "research"},
+	})
+	assert.EqualError(t, err, "key [research] isn't configured")
+
+	keys, err := NewStaticKeyProvider(runtimeInterfaces.EncryptionConfig{})
+	assert.NoError(t, err)
+	_, err = keys.CurrentKeyID(context.Background(), Tenant{Project: "flytesnacks"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,177 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+)
+
+// Encrypts the secrets triggers verify payload signatures with, using the key of the trigger's project or that
+// project's org. Secrets are stored as text, so encrypted secrets are stored base64 encoded.
+type TriggerRepo struct {
+	interfaces.TriggerRepoInterface
+	projects  interfaces.ProjectRepoInterface
+	encryptor *Encryptor
+}
+
+func getTriggerAssociatedData(key models.TriggerKey) []byte {
+	return []byte(fmt.Sprintf("trigger/%s/%s/%s", key.Project, key.Domain, key.Name))
+}
+
+// Returns the envelope a secret was stored as, or nil if it was stored before encryption was enabled.
+func getEncryptedSecret(secret string) []byte {
+	blob, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil || !IsEncrypted(blob) {
+		return nil
+	}
+	return blob
+}
+
+func (r *TriggerRepo) encryptSecret(ctx context.Context, trigger *models.Trigger) error {
+	if len(trigger.Secret) == 0 || getEncryptedSecret(trigger.Secret) != nil {
+		return nil
+	}
+	tenant, err := getTenant(ctx, r.projects, trigger.Project)
+	if err != nil {
+		return err
+	}
+	secret, err := r.encryptor.Encrypt(
+		ctx, tenant, []byte(trigger.Secret), getTriggerAssociatedData(trigger.TriggerKey))
+	if err != nil {
+		logger.Errorf(ctx, "Failed to encrypt secret of trigger [%+v] with err: %v", trigger.TriggerKey, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to encrypt trigger secret")
+	}
+	trigger.Secret = base64.StdEncoding.EncodeToString(secret)
+	return nil
+}
+
+func (r *TriggerRepo) decryptSecret(ctx context.Context, trigger *models.Trigger) error {
+	blob := getEncryptedSecret(trigger.Secret)
+	if blob == nil {
+		return nil
+	}
+	secret, err := r.encryptor.Decrypt(ctx, blob, getTriggerAssociatedData(trigger.TriggerKey))
+	if err != nil {
+		logger.Errorf(ctx, "Failed to decrypt secret of trigger [%+v] with err: %v", trigger.TriggerKey, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to decrypt trigger secret")
+	}
+	trigger.Secret = string(secret)
+	return nil
+}
+
+func (r *TriggerRepo) Create(ctx context.Context, input models.Trigger) error {
+	if err := r.encryptSecret(ctx, &input); err != nil {
+		return err
+	}
+	return r.TriggerRepoInterface.Create(ctx, input)
+}
+
+func (r *TriggerRepo) Update(ctx context.Context, input models.Trigger) error {
+	if err := r.encryptSecret(ctx, &input); err != nil {
+		return err
+	}
+	return r.TriggerRepoInterface.Update(ctx, input)
+}
+
+func (r *TriggerRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Trigger, error) {
+	trigger, err := r.TriggerRepoInterface.Get(ctx, input)
+	if err != nil {
+		return models.Trigger{}, err
+	}
+	if err := r.decryptSecret(ctx, &trigger); err != nil {
+		return models.Trigger{}, err
+	}
+	return trigger, nil
+}
+
+func (r *TriggerRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Trigger, error) {
+	triggers, err := r.TriggerRepoInterface.List(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	for i := range triggers {
+		if err := r.decryptSecret(ctx, &triggers[i]); err != nil {
+			return nil, err
+		}
+	}
+	return triggers, nil
+}
+
+func (r *TriggerRepo) RotateKeys(ctx context.Context, batchSize int) (int, error) {
+	filter, err := common.NewSingleValueFilter(common.Trigger, common.GreaterThan, "id", 0)
+	if err != nil {
+		return 0, err
+	}
+	sortParameter, err := common.NewSortParameter(admin.Sort{
+		Key:       "id",
+		Direction: admin.Sort_ASCENDING,
+	})
+	if err != nil {
+		return 0, err
+	}
+	tenants := make(map[string]Tenant)
+	var offset, rotated int
+	for {
+		triggers, err := r.TriggerRepoInterface.List(ctx, interfaces.ListResourceInput{
+			Limit:         batchSize,
+			Offset:        offset,
+			InlineFilters: []common.InlineFilter{filter},
+			SortParameter: sortParameter,
+		})
+		if err != nil {
+			return rotated, err
+		}
+		for _, trigger := range triggers {
+			tenant, ok := tenants[trigger.Project]
+			if !ok {
+				if tenant, err = getTenant(ctx, r.projects, trigger.Project); err != nil {
+					return rotated, err
+				}
+				tenants[trigger.Project] = tenant
+			}
+			blob := getEncryptedSecret(trigger.Secret)
+			if blob == nil {
+				blob = []byte(trigger.Secret)
+			}
+			secret, changed, err := r.encryptor.Rewrap(
+				ctx, tenant, blob, getTriggerAssociatedData(trigger.TriggerKey))
+			if err != nil {
+				logger.Errorf(ctx, "Failed to rotate the key of trigger [%+v] with err: %v", trigger.TriggerKey, err)
+				return rotated, errors.NewFlyteAdminErrorf(codes.Internal, "failed to rotate trigger secret key")
+			}
+			if !changed {
+				continue
+			}
+			if err := r.TriggerRepoInterface.Update(ctx, models.Trigger{
+				TriggerKey: trigger.TriggerKey,
+				Secret:     base64.StdEncoding.EncodeToString(secret),
+			}); err != nil {
+				return rotated, err
+			}
+			rotated++
+		}
+		if len(triggers) < batchSize {
+			return rotated, nil
+		}
+		offset += len(triggers)
+	}
+}
+
+// Returns a TriggerRepoInterface which encrypts the secrets triggers are stored with and decrypts those it reads.
+// Secrets stored before encryption was enabled are read as they are.
+func NewTriggerRepo(repo interfaces.TriggerRepoInterface, projects interfaces.ProjectRepoInterface,
+	encryptor *Encryptor) interfaces.TriggerRepoInterface {
+	return &TriggerRepo{
+		TriggerRepoInterface: repo,
+		projects:             projects,
+		encryptor:            encryptor,
+	}
+}
@@ -0,0 +1,129 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/stretchr/testify/assert"
+)
+
+var testTriggerKey = models.TriggerKey{
+	Project: "flytesnacks",
+	Domain:  "development",
+	Name:    "partition-landed",
+}
+
+// Stores the triggers it's given in memory.
+type memoryTriggerRepo struct {
+	interfaces.TriggerRepoInterface
+	stored map[string]models.Trigger
+}
+
+func (r *memoryTriggerRepo) Create(ctx context.Context, input models.Trigger) error {
+	r.stored[input.Name] = input
+	return nil
+}
+
+func (r *memoryTriggerRepo) Update(ctx context.Context, input models.Trigger) error {
+	existing := r.stored[input.Name]
+	existing.Secret = input.Secret
+	r.stored[input.Name] = existing
+	return nil
+}
+
+func (r *memoryTriggerRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Trigger, error) {
+	return r.stored[input.Name], nil
+}
+
+func (r *memoryTriggerRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Trigger, error) {
+	var triggers []models.Trigger
+	for _, trigger := range r.stored {
+		triggers = append(triggers, trigger)
+	}
+	sort.Slice(triggers, func(i, j int) bool {
+		return triggers[i].ID < triggers[j].ID
+	})
+	if input.Offset >= len(triggers) {
+		return nil, nil
+	}
+	triggers = triggers[input.Offset:]
+	if len(triggers) > input.Limit {
+		triggers = triggers[:input.Limit]
+	}
+	return triggers, nil
+}
+
+func newTestTriggerRepo(t *testing.T, stored map[string]models.Trigger) interfaces.TriggerRepoInterface {
+	projectRepo := repositoryMocks.NewMockProjectRepo().(*repositoryMocks.MockProjectRepo)
+	projectRepo.GetFunction = func(ctx context.Context, projectID string) (models.Project, error) {
+		return models.Project{Identifier: projectID, Org: "research"}, nil
+	}
+	return NewTriggerRepo(&memoryTriggerRepo{stored: stored}, projectRepo, NewEncryptor(newTestKeyProvider(t)))
+}
+
+func TestTriggerRepo(t *testing.T) {
+	stored := make(map[string]models.Trigger)
+	repo := newTestTriggerRepo(t, stored)
+
+	assert.NoError(t, repo.Create(context.Background(), models.Trigger{
+		TriggerKey: testTriggerKey,
+		Secret:     "secret",
+	}))
+	assert.NotNil(t, getEncryptedSecret(stored["partition-landed"].Secret))
+	trigger, err := repo.Get(context.Background(), interfaces.Identifier{
+		Project: "flytesnacks",
+		Domain:  "development",
+		Name:    "partition-landed",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", trigger.Secret)
+
+	assert.NoError(t, repo.Update(context.Background(), models.Trigger{
+		TriggerKey: testTriggerKey,
+		Secret:     "rotated secret",
+	}))
+	assert.NotNil(t, getEncryptedSecret(stored["partition-landed"].Secret))
+	triggers, err := repo.List(context.Background(), interfaces.ListResourceInput{Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, triggers, 1)
+	assert.Equal(t, "rotated secret", triggers[0].Secret)
+}
+
+func TestTriggerRepo_RotateKeys(t *testing.T) {
+	stored := map[string]models.Trigger{
+		"plaintext": {
+			BaseModel:  models.BaseModel{ID: 1},
+			TriggerKey: models.TriggerKey{Project: "flytesnacks", Domain: "development", Name: "plaintext"},
+			Secret:     "secret",
+		},
+	}
+	repo := newTestTriggerRepo(t, stored)
+	encryptor := repo.(*TriggerRepo).encryptor
+	// Encrypted under the default key, before the project moved into the research org.
+	secret, err := encryptor.Encrypt(context.Background(), Tenant{Project: "flytesnacks"}, []byte("secret"),
+		getTriggerAssociatedData(testTriggerKey))
+	assert.NoError(t, err)
+	stored["partition-landed"] = models.Trigger{
+		BaseModel:  models.BaseModel{ID: 2},
+		TriggerKey: testTriggerKey,
+		Secret:     base64.StdEncoding.EncodeToString(secret),
+	}
+
+	rotated, err := repo.(KeyRotator).RotateKeys(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rotated)
+	for name, trigger := range stored {
+		encrypted, err := unmarshalEnvelope(getEncryptedSecret(trigger.Secret))
+		assert.NoError(t, err, name)
+		assert.Equal(t, "research", encrypted.keyID, name)
+	}
+
+	rotated, err = repo.(KeyRotator).RotateKeys(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Zero(t, rotated)
+}
@@ -12,6 +12,7 @@ const (
 	notFound          = "missing entity of type %s with identifier %v"
 	idNotFound        = "missing entity of type %s"
 	invalidInput      = "missing and/or invalid parameters: %s"
+	concurrentUpdate  = "entity of type %s with identifier %v was updated concurrently"
 )
 
 func GetMissingEntityError(entityType string, identifier proto.Message) errors.FlyteAdminError {
@@ -29,3 +30,9 @@ func GetMissingEntityByIDError(entityType string) errors.FlyteAdminError {
 func GetInvalidInputError(input string) errors.FlyteAdminError {
 	return errors.NewFlyteAdminErrorf(codes.InvalidArgument, invalidInput, input)
 }
+
+// Returned when an update is rejected because the entity changed since it was read. Callers may retry with the current
+// version of the entity.
+func GetConcurrentUpdateError(entityType string, identifier proto.Message) errors.FlyteAdminError {
+	return errors.NewFlyteAdminErrorf(codes.FailedPrecondition, concurrentUpdate, entityType, identifier)
+}
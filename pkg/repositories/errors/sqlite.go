@@ -0,0 +1,93 @@
+// SQLite-specific implementation of an ErrorTransformer.
+// This errors utility translates sqlite result codes into internal error types.
+// The result codes are documented here: https://www.sqlite.org/rescode.html
+package errors
+
+import (
+	"fmt"
+
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Error message format strings
+const (
+	sqliteUniqueConstraintViolation = "value with matching key already exists (%s)"
+	sqliteBusy                      = "database is busy, please retry: %s"
+	defaultSQLiteError              = "failed database operation with %s"
+)
+
+type sqliteErrorKind int
+
+const (
+	sqliteOtherError sqliteErrorKind = iota
+	sqliteUniqueConstraintError
+	sqliteBusyError
+)
+
+type sqliteErrorTransformerMetrics struct {
+	Scope              promutils.Scope
+	NotFound           prometheus.Counter
+	GormError          prometheus.Counter
+	AlreadyExistsError prometheus.Counter
+	BusyError          prometheus.Counter
+	SQLiteError        prometheus.Counter
+}
+
+type sqliteErrorTransformer struct {
+	metrics sqliteErrorTransformerMetrics
+}
+
+func (s *sqliteErrorTransformer) fromGormError(err error) errors.FlyteAdminError {
+	switch err.Error() {
+	case gorm.ErrRecordNotFound.Error():
+		s.metrics.NotFound.Inc()
+		return errors.NewFlyteAdminErrorf(codes.NotFound, "entry not found")
+	default:
+		s.metrics.GormError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Internal, unexpectedType, err)
+	}
+}
+
+func (s *sqliteErrorTransformer) ToFlyteAdminError(err error) errors.FlyteAdminError {
+	kind, message, ok := classifySQLiteError(err)
+	if !ok {
+		return s.fromGormError(err)
+	}
+
+	switch kind {
+	case sqliteUniqueConstraintError:
+		s.metrics.AlreadyExistsError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.AlreadyExists, sqliteUniqueConstraintViolation, message)
+	case sqliteBusyError:
+		// Writes are serialized in SQLite, callers may retry once the competing transaction completes.
+		s.metrics.BusyError.Inc()
+		return errors.NewFlyteAdminErrorf(codes.Unavailable, sqliteBusy, message)
+	default:
+		s.metrics.SQLiteError.Inc()
+		return errors.NewFlyteAdminError(codes.Unknown, fmt.Sprintf(defaultSQLiteError, message))
+	}
+}
+
+func NewSQLiteErrorTransformer(scope promutils.Scope) ErrorTransformer {
+	metrics := sqliteErrorTransformerMetrics{
+		Scope: scope,
+		NotFound: scope.MustNewCounter("not_found",
+			"count of all queries for entities not found in the database"),
+		GormError: scope.MustNewCounter("gorm_error",
+			"unspecified gorm error returned by database operation"),
+		AlreadyExistsError: scope.MustNewCounter("already_exists",
+			"counts for when a unique constraint was violated in a database operation"),
+		BusyError: scope.MustNewCounter("busy",
+			"database operations that timed out waiting for a lock"),
+		SQLiteError: scope.MustNewCounter("sqlite_error",
+			"unspecified sqlite error returned in a database operation"),
+	}
+	return &sqliteErrorTransformer{
+		metrics: metrics,
+	}
+}
@@ -0,0 +1,23 @@
+// +build cgo
+
+package errors
+
+import "github.com/mattn/go-sqlite3"
+
+// classifySQLiteError returns the kind of the given error if it was returned by the sqlite driver.
+func classifySQLiteError(err error) (kind sqliteErrorKind, message string, ok bool) {
+	sqliteError, ok := err.(sqlite3.Error)
+	if !ok {
+		return sqliteOtherError, "", false
+	}
+
+	switch {
+	case sqliteError.ExtendedCode == sqlite3.ErrConstraintUnique ||
+		sqliteError.ExtendedCode == sqlite3.ErrConstraintPrimaryKey:
+		return sqliteUniqueConstraintError, sqliteError.Error(), true
+	case sqliteError.Code == sqlite3.ErrBusy || sqliteError.Code == sqlite3.ErrLocked:
+		return sqliteBusyError, sqliteError.Error(), true
+	default:
+		return sqliteOtherError, sqliteError.Error(), true
+	}
+}
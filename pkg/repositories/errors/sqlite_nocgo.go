@@ -0,0 +1,8 @@
+// +build !cgo
+
+package errors
+
+// The sqlite driver requires cgo, without it no sqlite errors can be returned.
+func classifySQLiteError(err error) (kind sqliteErrorKind, message string, ok bool) {
+	return sqliteOtherError, "", false
+}
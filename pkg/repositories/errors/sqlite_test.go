@@ -0,0 +1,47 @@
+// +build cgo
+
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+
+	flyteAdminError "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/magiconair/properties/assert"
+	"github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSQLiteToFlyteAdminError_InvalidSQLiteError(t *testing.T) {
+	err := errors.New("foo")
+	transformedErr := NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.Internal, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+	assert.Equal(t, "unexpected error type for: foo", transformedErr.(flyteAdminError.FlyteAdminError).Error())
+}
+
+func TestSQLiteToFlyteAdminError_UniqueConstraintViolation(t *testing.T) {
+	err := sqlite3.Error{
+		Code:         sqlite3.ErrConstraint,
+		ExtendedCode: sqlite3.ErrConstraintPrimaryKey,
+	}
+	transformedErr := NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.AlreadyExists, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+}
+
+func TestSQLiteToFlyteAdminError_Busy(t *testing.T) {
+	err := sqlite3.Error{
+		Code: sqlite3.ErrBusy,
+	}
+	transformedErr := NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.Unavailable, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+}
+
+func TestSQLiteToFlyteAdminError_UnrecognizedSQLiteError(t *testing.T) {
+	err := sqlite3.Error{
+		Code: sqlite3.ErrCorrupt,
+	}
+	transformedErr := NewSQLiteErrorTransformer(mockScope.NewTestScope()).ToFlyteAdminError(err)
+	assert.Equal(t, codes.Unknown, transformedErr.(flyteAdminError.FlyteAdminError).Code())
+}
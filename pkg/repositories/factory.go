@@ -3,7 +3,9 @@ package repositories
 import (
 	"fmt"
 
+	"github.com/flyteorg/flyteadmin/pkg/repositories/cache"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/encryption"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	schedulerInterfaces "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
@@ -14,10 +16,21 @@ type RepoConfig int32
 
 const (
 	POSTGRES RepoConfig = 0
+	SQLITE   RepoConfig = 1
 )
 
 var RepositoryConfigurationName = map[int32]string{
 	0: "POSTGRES",
+	1: "SQLITE",
+}
+
+// GetRepoConfig returns the repository type matching the database the config points to.
+func GetRepoConfig(dbConfig config.DbConfig) RepoConfig {
+	if dbConfig.IsSQLite() {
+		return SQLITE
+	}
+
+	return POSTGRES
 }
 
 // The RepositoryInterface indicates the methods that each Repository must support.
@@ -35,8 +48,40 @@ type RepositoryInterface interface {
 	NodeExecutionEventRepo() interfaces.NodeExecutionEventRepoInterface
 	TaskExecutionRepo() interfaces.TaskExecutionRepoInterface
 	NamedEntityRepo() interfaces.NamedEntityRepoInterface
+	RetentionRepo() interfaces.RetentionRepoInterface
+	OutboxRepo() interfaces.OutboxRepoInterface
+	BackfillRepo() interfaces.BackfillRepoInterface
+	TaskExecutionUsageRepo() interfaces.TaskExecutionUsageRepoInterface
+	SkippedEventRepo() interfaces.SkippedEventRepoInterface
+	RecordedEventRepo() interfaces.RecordedEventRepoInterface
+	LineageRepo() interfaces.LineageRepoInterface
+	WebhookRepo() interfaces.WebhookRepoInterface
+	NotificationDeliveryRepo() interfaces.NotificationDeliveryRepoInterface
+	NotificationDigestRepo() interfaces.NotificationDigestRepoInterface
+	NotificationSubscriptionRepo() interfaces.NotificationSubscriptionRepoInterface
+	TriggerRepo() interfaces.TriggerRepoInterface
+	LaunchPlanRolloutRepo() interfaces.LaunchPlanRolloutRepoInterface
+	ClusterPoolRepo() interfaces.ClusterPoolRepoInterface
+	ClusterHealthRepo() interfaces.ClusterHealthRepoInterface
+	ClusterResourceSyncRepo() interfaces.ClusterResourceSyncRepoInterface
+	DomainQuotaRepo() interfaces.DomainQuotaRepoInterface
+	DomainRepo() interfaces.DomainRepoInterface
+	APIUsageRepo() interfaces.APIUsageRepoInterface
+	OffloadedLiteralRepo() interfaces.OffloadedLiteralRepoInterface
+	ProjectPurgeRepo() interfaces.ProjectPurgeRepoInterface
+	SignalRepo() interfaces.SignalRepoInterface
+	ExecutionCommentRepo() interfaces.ExecutionCommentRepoInterface
+	SavedViewRepo() interfaces.SavedViewRepoInterface
+	OAuthClientRepo() interfaces.OAuthClientRepoInterface
+	FeatureFlagRepo() interfaces.FeatureFlagRepoInterface
+	CacheEvictionRepo() interfaces.CacheEvictionRepoInterface
+	OrgRepo() interfaces.OrgRepoInterface
 	SchedulableEntityRepo() schedulerInterfaces.SchedulableEntityRepoInterface
 	ScheduleEntitiesSnapshotRepo() schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
+	ScheduleRunRepo() schedulerInterfaces.ScheduleRunRepoInterface
+
+	// Returns the state of the database connection pool and of the caches in front of the database.
+	Stats() interfaces.RepositoryStats
 }
 
 func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutils.Scope) RepositoryInterface {
@@ -44,11 +89,63 @@ func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutil
 	case POSTGRES:
 		postgresScope := scope.NewSubScope("postgres")
 		db := config.OpenDbConnection(config.NewPostgresConfigProvider(dbConfig, postgresScope))
-		return NewPostgresRepo(
+		config.ConfigureConnectionPool(db.DB(), dbConfig)
+		return withEncryption(withCache(NewPostgresRepo(
 			db,
 			errors.NewPostgresErrorTransformer(postgresScope.NewSubScope("errors")),
-			postgresScope.NewSubScope("repositories"))
+			postgresScope.NewSubScope("repositories")), dbConfig, postgresScope.NewSubScope("cache")), dbConfig)
+	case SQLITE:
+		sqliteScope := scope.NewSubScope("sqlite")
+		db := config.OpenDbConnection(config.NewSQLiteConfigProvider(dbConfig, sqliteScope))
+		config.ConfigureConnectionPool(db.DB(), dbConfig)
+		return withEncryption(withCache(NewPostgresRepo(
+			db,
+			errors.NewSQLiteErrorTransformer(sqliteScope.NewSubScope("errors")),
+			sqliteScope.NewSubScope("repositories")), dbConfig, sqliteScope.NewSubScope("cache")), dbConfig)
 	default:
 		panic(fmt.Sprintf("Invalid repoType %v", repoType))
 	}
 }
+
+// withCache serves workflow, task and launch plan gets from in-memory caches, if caching is configured. Purged
+// projects are removed from the caches.
+func withCache(repo RepositoryInterface, dbConfig config.DbConfig, scope promutils.Scope) RepositoryInterface {
+	if dbConfig.CacheSize <= 0 {
+		return repo
+	}
+	newCache := func() cache.Cache {
+		c, err := cache.NewLRUCache(dbConfig.CacheSize, dbConfig.CacheTTL)
+		if err != nil {
+			panic(err)
+		}
+		return c
+	}
+	workflowCache, taskCache, launchPlanCache := newCache(), newCache(), newCache()
+	postgresRepo := repo.(*PostgresRepo)
+	postgresRepo.workflowRepo = cache.NewWorkflowRepo(
+		postgresRepo.workflowRepo, workflowCache, scope.NewSubScope("workflows"))
+	postgresRepo.taskRepo = cache.NewTaskRepo(postgresRepo.taskRepo, taskCache, scope.NewSubScope("tasks"))
+	postgresRepo.launchPlanRepo = cache.NewLaunchPlanRepo(
+		postgresRepo.launchPlanRepo, launchPlanCache, scope.NewSubScope("launch_plans"))
+	postgresRepo.projectPurgeRepo = cache.NewProjectPurgeRepo(
+		postgresRepo.projectPurgeRepo, workflowCache, taskCache, launchPlanCache)
+	return postgresRepo
+}
+
+// withEncryption encrypts sensitive fields before they're stored, if encryption is configured.
+func withEncryption(repo RepositoryInterface, dbConfig config.DbConfig) RepositoryInterface {
+	if !dbConfig.Encryption.Enabled {
+		return repo
+	}
+	keys, err := encryption.NewStaticKeyProvider(dbConfig.Encryption)
+	if err != nil {
+		panic(err)
+	}
+	encryptor := encryption.NewEncryptor(keys)
+	postgresRepo := repo.(*PostgresRepo)
+	postgresRepo.executionRepo = encryption.NewExecutionRepo(
+		postgresRepo.executionRepo, postgresRepo.projectRepo, encryptor)
+	postgresRepo.triggerRepo = encryption.NewTriggerRepo(
+		postgresRepo.triggerRepo, postgresRepo.projectRepo, encryptor)
+	return postgresRepo
+}
@@ -0,0 +1,109 @@
+package gormimpl
+
+import (
+	"context"
+	"strings"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+const apiUsageSumSelect = "SUM(calls) AS calls, SUM(errors) AS errors, SUM(total_latency_ms) AS total_latency_ms, " +
+	"MAX(max_latency_ms) AS max_latency_ms"
+
+// The columns usage may be grouped by.
+var apiUsageGroupColumns = map[string]bool{
+	"method":    true,
+	"principal": true,
+	"project":   true,
+}
+
+// Implementation of APIUsageRepoInterface.
+type APIUsageRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *APIUsageRepo) Increment(ctx context.Context, input models.APIUsage) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	// Structs can't be used as conditions, since gorm ignores the empty principals and projects of unauthenticated
+	// calls and calls which don't target a project.
+	key := map[string]interface{}{
+		"day":       input.Day,
+		"method":    input.Method,
+		"principal": input.Principal,
+		"project":   input.Project,
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	var record models.APIUsage
+	if err := tx.Where(key).FirstOrCreate(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	// The totals are incremented in place, rather than saved, so that replicas adding to the same totals at once don't
+	// overwrite each other's calls.
+	if err := tx.Model(&models.APIUsage{}).Where(key).UpdateColumns(map[string]interface{}{
+		"calls":            gorm.Expr("calls + ?", input.Calls),
+		"errors":           gorm.Expr("errors + ?", input.Errors),
+		"total_latency_ms": gorm.Expr("total_latency_ms + ?", input.TotalLatencyMs),
+		"max_latency_ms": gorm.Expr("CASE WHEN max_latency_ms < ? THEN ? ELSE max_latency_ms END",
+			input.MaxLatencyMs, input.MaxLatencyMs),
+	}).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *APIUsageRepo) Sum(ctx context.Context, input interfaces.SumAPIUsageInput) ([]models.APIUsageSum, error) {
+	if len(input.InlineFilters) == 0 {
+		return nil, errors.GetInvalidInputError(filters)
+	}
+	if len(input.GroupBy) == 0 {
+		return nil, errors.GetInvalidInputError("group_by")
+	}
+	for _, column := range input.GroupBy {
+		if !apiUsageGroupColumns[column] {
+			return nil, errors.GetInvalidInputError(column)
+		}
+	}
+	groupBy := strings.Join(input.GroupBy, ", ")
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.APIUsage{}).Select(
+		groupBy + ", " + apiUsageSumSelect)
+	tx, err := applyFilters(tx, input.InlineFilters, nil)
+	if err != nil {
+		return nil, err
+	}
+	tx = tx.Group(groupBy).Order("SUM(calls) DESC, " + groupBy)
+	if input.Limit > 0 {
+		tx = tx.Limit(input.Limit)
+	}
+	var sums []models.APIUsageSum
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Scan(&sums)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return sums, nil
+}
+
+// Returns an instance of APIUsageRepoInterface
+func NewAPIUsageRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.APIUsageRepoInterface {
+	metrics := newMetrics(scope)
+	return &APIUsageRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
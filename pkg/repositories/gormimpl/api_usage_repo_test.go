@@ -0,0 +1,105 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumAPIUsage(t *testing.T) {
+	usageRepo := NewAPIUsageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT principal, project, SUM(calls) AS calls, SUM(errors) AS errors, ` +
+		`SUM(total_latency_ms) AS total_latency_ms, MAX(max_latency_ms) AS max_latency_ms FROM "api_usages"  WHERE ` +
+		`"api_usages"."deleted_at" IS NULL AND ((project = project)) ` +
+		`GROUP BY principal, project ORDER BY SUM(calls) DESC, principal, project LIMIT 10`).WithReply(
+		[]map[string]interface{}{
+			{
+				"principal":        "flytepropeller",
+				"project":          project,
+				"calls":            100,
+				"errors":           1,
+				"total_latency_ms": 2000,
+				"max_latency_ms":   300,
+			},
+			{
+				"principal":        "user",
+				"project":          project,
+				"calls":            10,
+				"errors":           0,
+				"total_latency_ms": 50,
+				"max_latency_ms":   20,
+			},
+		})
+
+	sums, err := usageRepo.Sum(context.Background(), interfaces.SumAPIUsageInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.APIUsage, "project", project),
+		},
+		GroupBy: []string{"principal", "project"},
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.APIUsageSum{
+		{
+			Principal:      "flytepropeller",
+			Project:        project,
+			Calls:          100,
+			Errors:         1,
+			TotalLatencyMs: 2000,
+			MaxLatencyMs:   300,
+		},
+		{
+			Principal:      "user",
+			Project:        project,
+			Calls:          10,
+			TotalLatencyMs: 50,
+			MaxLatencyMs:   20,
+		},
+	}, sums)
+}
+
+func TestSumAPIUsage_InvalidInput(t *testing.T) {
+	usageRepo := NewAPIUsageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	projectFilter := getEqualityFilter(common.APIUsage, "project", project)
+	for _, test := range []struct {
+		name  string
+		input interfaces.SumAPIUsageInput
+		err   string
+	}{
+		{
+			name: "missing filters",
+			input: interfaces.SumAPIUsageInput{
+				GroupBy: []string{"project"},
+			},
+			err: "missing and/or invalid parameters: filters",
+		},
+		{
+			name: "missing group by",
+			input: interfaces.SumAPIUsageInput{
+				InlineFilters: []common.InlineFilter{projectFilter},
+			},
+			err: "missing and/or invalid parameters: group_by",
+		},
+		{
+			name: "unknown group by column",
+			input: interfaces.SumAPIUsageInput{
+				InlineFilters: []common.InlineFilter{projectFilter},
+				GroupBy:       []string{"project; DROP TABLE api_usages"},
+			},
+			err: "missing and/or invalid parameters: project; DROP TABLE api_usages",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := usageRepo.Sum(context.Background(), test.input)
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of BackfillRepoInterface.
+type BackfillRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *BackfillRepo) Create(ctx context.Context, input models.Backfill) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *BackfillRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Backfill, error) {
+	var backfill models.Backfill
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Backfill{
+		BackfillKey: models.BackfillKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Take(&backfill)
+	timer.Stop()
+	if tx.Error != nil {
+		return models.Backfill{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RecordNotFound() {
+		return models.Backfill{}, errors.GetMissingEntityError("backfill", &admin.NamedEntityIdentifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		})
+	}
+	return backfill, nil
+}
+
+func (r *BackfillRepo) Update(ctx context.Context, input models.Backfill) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&input).Updates(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *BackfillRepo) UpdateProgress(ctx context.Context, input models.Backfill) (bool, error) {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.Backfill{}).Where(
+		"id = ? AND phase = ?", input.ID, core.WorkflowExecution_RUNNING.String()).Updates(map[string]interface{}{
+		"launched": input.Launched,
+		"phase":    input.Phase,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+func (r *BackfillRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.BackfillCollectionOutput, error) {
+	if err := ValidateListInput(input); err != nil {
+		return interfaces.BackfillCollectionOutput{}, err
+	}
+	var backfills []models.Backfill
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return interfaces.BackfillCollectionOutput{}, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&backfills)
+	timer.Stop()
+	if tx.Error != nil {
+		return interfaces.BackfillCollectionOutput{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return interfaces.BackfillCollectionOutput{
+		Backfills: backfills,
+	}, nil
+}
+
+// Returns an instance of BackfillRepoInterface
+func NewBackfillRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.BackfillRepoInterface {
+	metrics := newMetrics(scope)
+	return &BackfillRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,152 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var backfillStartTime = time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+func TestCreateBackfill(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "backfills" ("created_at","updated_at","deleted_at","project","domain","name",` +
+		`"launch_plan_name","launch_plan_version","start_time","end_time","parallelism","reverse","total","launched",` +
+		`"phase","abort_cause","principal") VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+
+	err := backfillRepo.Create(context.Background(), models.Backfill{
+		BackfillKey: models.BackfillKey{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+		},
+		LaunchPlanName:    "lp",
+		LaunchPlanVersion: version,
+		StartTime:         backfillStartTime,
+		EndTime:           backfillStartTime.Add(24 * time.Hour),
+		Parallelism:       2,
+		Total:             24,
+		Phase:             "RUNNING",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestGetBackfill(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "backfills"  WHERE "backfills"."deleted_at" IS NULL AND ` +
+		`(("backfills"."project" = project) AND ("backfills"."domain" = domain) AND ("backfills"."name" = name)) ` +
+		`LIMIT 1`).WithReply([]map[string]interface{}{{
+		"project":     project,
+		"domain":      domain,
+		"name":        name,
+		"parallelism": 2,
+		"total":       24,
+		"launched":    4,
+		"phase":       "RUNNING",
+	}})
+
+	output, err := backfillRepo.Get(context.Background(), interfaces.Identifier{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, name, output.Name)
+	assert.Equal(t, 2, output.Parallelism)
+	assert.Equal(t, 24, output.Total)
+	assert.Equal(t, 4, output.Launched)
+	assert.Equal(t, "RUNNING", output.Phase)
+}
+
+func TestUpdateBackfill(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "backfills" SET "domain" = ?, "id" = ?, "launched" = ?, "name" = ?, "phase" = ?, "project" = ?, ` +
+		`"updated_at" = ?  ` +
+		`WHERE "backfills"."deleted_at" IS NULL AND "backfills"."project" = ? AND "backfills"."domain" = ? AND ` +
+		`"backfills"."name" = ?`)
+
+	err := backfillRepo.Update(context.Background(), models.Backfill{
+		BaseModel: models.BaseModel{ID: 1},
+		BackfillKey: models.BackfillKey{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+		},
+		Launched: 6,
+		Phase:    "RUNNING",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestUpdateBackfillProgress(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		rowsAffected int64
+	}{
+		{name: "running", rowsAffected: 1},
+		{name: "terminated", rowsAffected: 0},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+			GlobalMock := mocket.Catcher.Reset()
+			query := GlobalMock.NewMock()
+			query.WithQuery(`UPDATE "backfills" SET "launched" = ?, "phase" = ?, "updated_at" = ?  ` +
+				`WHERE "backfills"."deleted_at" IS NULL AND ((id = ? AND phase = ?))`).WithRowsNum(test.rowsAffected)
+
+			updated, err := backfillRepo.UpdateProgress(context.Background(), models.Backfill{
+				BaseModel: models.BaseModel{ID: 1},
+				Launched:  6,
+				Phase:     "SUCCEEDED",
+			})
+			assert.NoError(t, err)
+			assert.True(t, query.Triggered)
+			assert.Equal(t, test.rowsAffected > 0, updated)
+		})
+	}
+}
+
+func TestListBackfills(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "backfills"  WHERE "backfills"."deleted_at" IS NULL AND ` +
+		`((backfills.project = project) AND (backfills.phase = RUNNING)) LIMIT 20 OFFSET 0`).WithReply(
+		[]map[string]interface{}{
+			{"project": project, "domain": domain, "name": "first"},
+			{"project": project, "domain": domain, "name": "second"},
+		})
+
+	output, err := backfillRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Backfill, "project", project),
+			getEqualityFilter(common.Backfill, "phase", "RUNNING"),
+		},
+		Limit: 20,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output.Backfills, 2)
+	assert.Equal(t, "first", output.Backfills[0].Name)
+	assert.Equal(t, "second", output.Backfills[1].Name)
+}
+
+func TestListBackfills_MissingParameters(t *testing.T) {
+	backfillRepo := NewBackfillRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := backfillRepo.List(context.Background(), interfaces.ListResourceInput{
+		Limit: 20,
+	})
+	assert.EqualError(t, err, "missing and/or invalid parameters: filters")
+}
@@ -0,0 +1,64 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of CacheEvictionRepoInterface.
+type CacheEvictionRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *CacheEvictionRepo) Create(ctx context.Context, evictions []models.CacheEviction) error {
+	timer := r.metrics.CreateDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	for i := range evictions {
+		if err := tx.Create(&evictions[i]).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *CacheEvictionRepo) List(
+	ctx context.Context, input interfaces.ListCacheEvictionsInput) ([]models.CacheEviction, error) {
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	var evictions []models.CacheEviction
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.CacheEviction{
+		DatasetProject: input.Project,
+		DatasetDomain:  input.Domain,
+	}).Order("created_at desc, id desc").Limit(input.Limit).Offset(input.Offset).Find(&evictions)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return evictions, nil
+}
+
+// Returns an instance of CacheEvictionRepoInterface
+func NewCacheEvictionRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.CacheEvictionRepoInterface {
+	metrics := newMetrics(scope)
+	return &CacheEvictionRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
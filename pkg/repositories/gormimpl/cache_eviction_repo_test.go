@@ -0,0 +1,58 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCacheEvictions(t *testing.T) {
+	cacheEvictionRepo := NewCacheEvictionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	insert := GlobalMock.NewMock().WithQuery(`INSERT INTO "cache_evictions"`)
+
+	err := cacheEvictionRepo.Create(context.Background(), []models.CacheEviction{
+		{
+			DatasetProject: "project",
+			DatasetDomain:  "domain",
+			DatasetName:    "flyte_task-name",
+			DatasetVersion: "1.0-abcdefgh-ijklmnop",
+			ArtifactID:     "artifact",
+			Principal:      "jane",
+			Reason:         "the upstream data was corrupt",
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, insert.Triggered)
+}
+
+func TestListCacheEvictions(t *testing.T) {
+	cacheEvictionRepo := NewCacheEvictionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`ORDER BY created_at desc, id desc LIMIT 10 OFFSET 0`).WithReply(
+		[]map[string]interface{}{
+			{"dataset_project": "project", "dataset_domain": "domain", "artifact_id": "artifact", "principal": "jane"},
+		})
+
+	evictions, err := cacheEvictionRepo.List(context.Background(), interfaces.ListCacheEvictionsInput{
+		Project: "project",
+		Domain:  "domain",
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, evictions, 1)
+	assert.Equal(t, "artifact", evictions[0].ArtifactID)
+}
+
+func TestListCacheEvictions_MissingLimit(t *testing.T) {
+	cacheEvictionRepo := NewCacheEvictionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	_, err := cacheEvictionRepo.List(context.Background(), interfaces.ListCacheEvictionsInput{})
+	assert.EqualError(t, err, "missing and/or invalid parameters: limit")
+}
@@ -0,0 +1,118 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of ClusterHealthRepoInterface.
+type ClusterHealthRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *ClusterHealthRepo) CreateOrUpdate(ctx context.Context, input models.ClusterHealth) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	// Use a transaction so that concurrent updates of the same cluster are serialized rather than interleaving their
+	// lookup and write.
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	var record models.ClusterHealth
+	if err := tx.Where(&models.ClusterHealth{
+		Cluster: input.Cluster,
+	}).Attrs(models.ClusterHealth{
+		State: input.State,
+	}).FirstOrCreate(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	record.State = input.State
+	record.Reason = input.Reason
+	record.Principal = input.Principal
+	if err := tx.Save(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *ClusterHealthRepo) RecordProbe(ctx context.Context, input models.ClusterHealth) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	var record models.ClusterHealth
+	if err := tx.Where(&models.ClusterHealth{
+		Cluster: input.Cluster,
+	}).Attrs(models.ClusterHealth{
+		State: input.State,
+	}).FirstOrCreate(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	record.State = input.State
+	record.Reason = input.Reason
+	record.Principal = input.Principal
+	record.ProbedAt = input.ProbedAt
+	record.ProbeError = input.ProbeError
+	record.ConsecutiveProbeFailures = input.ConsecutiveProbeFailures
+	record.PropellerHeartbeatAt = input.PropellerHeartbeatAt
+	if err := tx.Save(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *ClusterHealthRepo) Get(ctx context.Context, cluster string) (models.ClusterHealth, error) {
+	var health models.ClusterHealth
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.ClusterHealth{
+		Cluster: cluster,
+	}).Take(&health)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.ClusterHealth{}, errors.GetMissingEntityError("cluster health", &admin.NamedEntityIdentifier{
+			Name: cluster,
+		})
+	}
+	if tx.Error != nil {
+		return models.ClusterHealth{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return health, nil
+}
+
+func (r *ClusterHealthRepo) ListAll(ctx context.Context) ([]models.ClusterHealth, error) {
+	var healths []models.ClusterHealth
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Find(&healths)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return healths, nil
+}
+
+// Returns an instance of ClusterHealthRepoInterface
+func NewClusterHealthRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ClusterHealthRepoInterface {
+	metrics := newMetrics(scope)
+	return &ClusterHealthRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,122 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of ClusterPoolRepoInterface.
+type ClusterPoolRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getMissingClusterPoolError(name string) error {
+	return errors.GetMissingEntityError("cluster pool", &admin.NamedEntityIdentifier{
+		Name: name,
+	})
+}
+
+func (r *ClusterPoolRepo) Create(ctx context.Context, input models.ClusterPool) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ClusterPoolRepo) Get(ctx context.Context, name string) (models.ClusterPool, error) {
+	var pool models.ClusterPool
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.ClusterPool{
+		Name: name,
+	}).Take(&pool)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.ClusterPool{}, getMissingClusterPoolError(name)
+	}
+	if tx.Error != nil {
+		return models.ClusterPool{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return pool, nil
+}
+
+func (r *ClusterPoolRepo) Update(ctx context.Context, input models.ClusterPool) error {
+	timer := r.metrics.UpdateDuration.Start()
+	// The description and labels may be cleared, so every column is updated rather than the non-empty fields.
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.ClusterPool{}).Where(&models.ClusterPool{
+		Name: input.Name,
+	}).Updates(map[string]interface{}{
+		"description": input.Description,
+		"labels":      input.Labels,
+		"members":     input.Members,
+		"principal":   input.Principal,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingClusterPoolError(input.Name)
+	}
+	return nil
+}
+
+func (r *ClusterPoolRepo) Delete(ctx context.Context, name string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	// Cluster pools are deleted outright rather than soft-deleted, so that their name can be used again.
+	tx := repositoryConfig.WithContext(ctx, r.db).Unscoped().Where(&models.ClusterPool{
+		Name: name,
+	}).Delete(&models.ClusterPool{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingClusterPoolError(name)
+	}
+	return nil
+}
+
+func (r *ClusterPoolRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.ClusterPool, error) {
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	var pools []models.ClusterPool
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&pools)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return pools, nil
+}
+
+// Returns an instance of ClusterPoolRepoInterface
+func NewClusterPoolRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ClusterPoolRepoInterface {
+	metrics := newMetrics(scope)
+	return &ClusterPoolRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,121 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of ClusterResourceSyncRepoInterface.
+type ClusterResourceSyncRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+// Creates the sync status of a template if there is none yet and saves it once updated by the given func.
+func (r *ClusterResourceSyncRepo) upsert(
+	ctx context.Context, input models.ClusterResourceSync, update func(record *models.ClusterResourceSync)) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	var record models.ClusterResourceSync
+	if err := tx.Where(&models.ClusterResourceSync{
+		ClusterResourceSyncKey: input.ClusterResourceSyncKey,
+	}).FirstOrCreate(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	record.Namespace = input.Namespace
+	update(&record)
+	if err := tx.Save(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *ClusterResourceSyncRepo) RecordApplied(ctx context.Context, input models.ClusterResourceSync) error {
+	return r.upsert(ctx, input, func(record *models.ClusterResourceSync) {
+		record.ResourceAPIVersion = input.ResourceAPIVersion
+		record.ResourceKind = input.ResourceKind
+		record.ResourceName = input.ResourceName
+		record.AppliedVersion = input.AppliedVersion
+		record.AppliedAt = input.AppliedAt
+		record.ApplyError = ""
+		record.ApplyErrorAt = nil
+		record.Drift = ""
+		record.RetiredAt = nil
+		record.CleanedUpAt = nil
+		record.Cleanup = ""
+	})
+}
+
+func (r *ClusterResourceSyncRepo) RecordApplyError(ctx context.Context, input models.ClusterResourceSync) error {
+	return r.upsert(ctx, input, func(record *models.ClusterResourceSync) {
+		record.ApplyError = input.ApplyError
+		record.ApplyErrorAt = input.ApplyErrorAt
+	})
+}
+
+func (r *ClusterResourceSyncRepo) RecordDrift(ctx context.Context, input models.ClusterResourceSync) error {
+	return r.upsert(ctx, input, func(record *models.ClusterResourceSync) {
+		record.Drift = input.Drift
+		record.DriftCheckedAt = input.DriftCheckedAt
+	})
+}
+
+func (r *ClusterResourceSyncRepo) RecordCleanup(ctx context.Context, input models.ClusterResourceSync) error {
+	return r.upsert(ctx, input, func(record *models.ClusterResourceSync) {
+		record.RetiredAt = input.RetiredAt
+		record.CleanedUpAt = input.CleanedUpAt
+		record.Cleanup = input.Cleanup
+	})
+}
+
+func (r *ClusterResourceSyncRepo) List(ctx context.Context, project, domain string) (
+	[]models.ClusterResourceSync, error) {
+	var syncs []models.ClusterResourceSync
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.ClusterResourceSync{
+		ClusterResourceSyncKey: models.ClusterResourceSyncKey{
+			Project: project,
+			Domain:  domain,
+		},
+	}).Order("cluster, template").Find(&syncs)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return syncs, nil
+}
+
+func (r *ClusterResourceSyncRepo) ListAll(ctx context.Context) ([]models.ClusterResourceSync, error) {
+	var syncs []models.ClusterResourceSync
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Order("cluster, project, domain, template").Find(&syncs)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return syncs, nil
+}
+
+// Returns an instance of ClusterResourceSyncRepoInterface
+func NewClusterResourceSyncRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ClusterResourceSyncRepoInterface {
+	metrics := newMetrics(scope)
+	return &ClusterResourceSyncRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -2,6 +2,7 @@ package gormimpl
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
@@ -14,11 +15,13 @@ import (
 const Project = "project"
 const Domain = "domain"
 const Name = "name"
+const Version = "version"
 const Description = "description"
 const ResourceType = "resource_type"
 const State = "state"
 const ID = "id"
 
+const admissionLockTableName = "admission_locks"
 const executionTableName = "executions"
 const namedEntityMetadataTableName = "named_entity_metadata"
 const nodeExecutionTableName = "node_executions"
@@ -26,21 +29,54 @@ const nodeExecutionEventTableName = "node_event_executions"
 const taskExecutionTableName = "task_executions"
 const taskTableName = "tasks"
 
+const deletedAt = "deleted_at"
+
+// Matches soft-deleted records, which are otherwise excluded from queries on models.
+var isDeleted = fmt.Sprintf("%s IS NOT NULL", deletedAt)
+
 const limit = "limit"
 const filters = "filters"
 
 var identifierGroupBy = fmt.Sprintf("%s, %s, %s", Project, Domain, Name)
 
+var identifierCondition = fmt.Sprintf("(%s = ? AND %s = ? AND %s = ? AND %s = ?)", Project, Domain, Name, Version)
+
+// Returns a condition matching any of the versioned identifiers. Postgres plans the disjunction as a union of primary
+// key lookups.
+func getIdentifiersCondition(input []interfaces.Identifier) (string, []interface{}) {
+	conditions := make([]string, len(input))
+	args := make([]interface{}, 0, len(input)*4)
+	for i, id := range input {
+		conditions[i] = identifierCondition
+		args = append(args, id.Project, id.Domain, id.Name, id.Version)
+	}
+	return strings.Join(conditions, " OR "), args
+}
+
 var entityToTableName = map[common.Entity]string{
-	common.Execution:           "executions",
-	common.LaunchPlan:          "launch_plans",
-	common.NodeExecution:       "node_executions",
-	common.NodeExecutionEvent:  "node_execution_events",
-	common.Task:                "tasks",
-	common.TaskExecution:       "task_executions",
-	common.Workflow:            "workflows",
-	common.NamedEntity:         "entities",
-	common.NamedEntityMetadata: "named_entity_metadata",
+	common.APIUsage:                 "api_usages",
+	common.Backfill:                 "backfills",
+	common.DomainQuota:              "domain_quotas",
+	common.Execution:                "executions",
+	common.LaunchPlan:               "launch_plans",
+	common.LaunchPlanRollout:        "launch_plan_rollouts",
+	common.NodeExecution:            "node_executions",
+	common.NodeExecutionEvent:       "node_execution_events",
+	common.Task:                     "tasks",
+	common.TaskExecution:            "task_executions",
+	common.TaskExecutionUsage:       "task_execution_usages",
+	common.SkippedEvent:             "skipped_events",
+	common.RecordedEvent:            "recorded_events",
+	common.TaskExecutionArtifact:    "task_execution_artifacts",
+	common.ExecutionRelationship:    "execution_relationships",
+	common.Webhook:                  "webhooks",
+	common.WebhookDelivery:          "webhook_deliveries",
+	common.NotificationDelivery:     "notification_deliveries",
+	common.NotificationSubscription: "notification_subscriptions",
+	common.Trigger:                  "triggers",
+	common.Workflow:                 "workflows",
+	common.NamedEntity:              "entities",
+	common.NamedEntityMetadata:      "named_entity_metadata",
 }
 
 var innerJoinNodeExecToNodeEvents = fmt.Sprintf(
@@ -85,7 +121,7 @@ func applyFilters(tx *gorm.DB, inlineFilters []common.InlineFilter, mapFilters [
 		if err != nil {
 			return nil, errors.GetInvalidInputError(err.Error())
 		}
-		tx = tx.Where(gormQueryExpr.Query, gormQueryExpr.Args)
+		tx = tx.Where(gormQueryExpr.Query, gormQueryExpr.GetArgs()...)
 	}
 	for _, mapFilter := range mapFilters {
 		tx = tx.Where(mapFilter.GetFilter())
@@ -104,7 +140,7 @@ func applyScopedFilters(tx *gorm.DB, inlineFilters []common.InlineFilter, mapFil
 		if err != nil {
 			return nil, err
 		}
-		tx = tx.Where(gormQueryExpr.Query, gormQueryExpr.Args)
+		tx = tx.Where(gormQueryExpr.Query, gormQueryExpr.GetArgs()...)
 	}
 	for _, mapFilter := range mapFilters {
 		tx = tx.Where(mapFilter.GetFilter())
@@ -0,0 +1,124 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of DomainQuotaRepoInterface.
+type DomainQuotaRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getMissingDomainQuotaError(project, domain string) error {
+	return errors.GetMissingEntityError("domain quota", &admin.ProjectDomainAttributesGetRequest{
+		Project: project,
+		Domain:  domain,
+	})
+}
+
+func (r *DomainQuotaRepo) CreateOrUpdate(ctx context.Context, input models.DomainQuota) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	var record models.DomainQuota
+	if err := tx.Where(&models.DomainQuota{
+		DomainQuotaKey: input.DomainQuotaKey,
+	}).FirstOrCreate(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	// Caps are cleared when they're unset, so every field is saved even when empty.
+	record.CPU = input.CPU
+	record.Memory = input.Memory
+	record.GPU = input.GPU
+	record.Principal = input.Principal
+	if err := tx.Save(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *DomainQuotaRepo) Get(ctx context.Context, project, domain string) (models.DomainQuota, error) {
+	var quota models.DomainQuota
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.DomainQuota{
+		DomainQuotaKey: models.DomainQuotaKey{
+			Project: project,
+			Domain:  domain,
+		},
+	}).Take(&quota)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.DomainQuota{}, getMissingDomainQuotaError(project, domain)
+	}
+	if tx.Error != nil {
+		return models.DomainQuota{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return quota, nil
+}
+
+func (r *DomainQuotaRepo) Delete(ctx context.Context, project, domain string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	// Quotas are deleted outright rather than soft-deleted, so that they can be set again.
+	tx := repositoryConfig.WithContext(ctx, r.db).Unscoped().Where(&models.DomainQuota{
+		DomainQuotaKey: models.DomainQuotaKey{
+			Project: project,
+			Domain:  domain,
+		},
+	}).Delete(&models.DomainQuota{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingDomainQuotaError(project, domain)
+	}
+	return nil
+}
+
+func (r *DomainQuotaRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.DomainQuota, error) {
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	var quotas []models.DomainQuota
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&quotas)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return quotas, nil
+}
+
+// Returns an instance of DomainQuotaRepoInterface
+func NewDomainQuotaRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.DomainQuotaRepoInterface {
+	metrics := newMetrics(scope)
+	return &DomainQuotaRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,107 @@
+package gormimpl
+
+import (
+	"context"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Implementation of DomainRepoInterface.
+type DomainRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getMissingDomainError(domainID string) error {
+	return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "domain [%s] not found", domainID)
+}
+
+func (r *DomainRepo) Create(ctx context.Context, domain models.Domain) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&domain)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *DomainRepo) Get(ctx context.Context, domainID string) (models.Domain, error) {
+	var domain models.Domain
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Domain{
+		Identifier: domainID,
+	}).Take(&domain)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.Domain{}, getMissingDomainError(domainID)
+	}
+	if tx.Error != nil {
+		return models.Domain{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return domain, nil
+}
+
+func (r *DomainRepo) List(ctx context.Context) ([]models.Domain, error) {
+	var domains []models.Domain
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Order("identifier asc").Find(&domains)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return domains, nil
+}
+
+func (r *DomainRepo) Update(ctx context.Context, domain models.Domain) error {
+	timer := r.metrics.UpdateDuration.Start()
+	// The description is cleared when it's unset, so both fields are saved even when empty.
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.Domain{Identifier: domain.Identifier}).Updates(
+		map[string]interface{}{
+			"name":        domain.Name,
+			"description": domain.Description,
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingDomainError(domain.Identifier)
+	}
+	return nil
+}
+
+func (r *DomainRepo) Delete(ctx context.Context, domainID string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	// Domains are deleted outright rather than soft-deleted, so that they can be created again.
+	tx := repositoryConfig.WithContext(ctx, r.db).Unscoped().Where(&models.Domain{
+		Identifier: domainID,
+	}).Delete(&models.Domain{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingDomainError(domainID)
+	}
+	return nil
+}
+
+// Returns an instance of DomainRepoInterface
+func NewDomainRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.DomainRepoInterface {
+	metrics := newMetrics(scope)
+	return &DomainRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
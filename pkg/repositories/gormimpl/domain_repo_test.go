@@ -0,0 +1,86 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDomain(t *testing.T) {
+	domainRepo := NewDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	insertQuery := GlobalMock.NewMock()
+	insertQuery.WithQuery(`INSERT  INTO "domains"`)
+
+	err := domainRepo.Create(context.Background(), models.Domain{
+		Identifier: "staging",
+		Name:       "Staging",
+	})
+	assert.NoError(t, err)
+	assert.True(t, insertQuery.Triggered)
+}
+
+func TestGetDomain(t *testing.T) {
+	domainRepo := NewDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "domains"  WHERE "domains"."deleted_at" IS NULL AND (("domains"."identifier" = staging)) LIMIT 1`).
+		WithReply([]map[string]interface{}{
+			{"identifier": "staging", "name": "Staging", "description": "pre-production"},
+		})
+
+	domain, err := domainRepo.Get(context.Background(), "staging")
+	assert.NoError(t, err)
+	assert.Equal(t, "Staging", domain.Name)
+	assert.Equal(t, "pre-production", domain.Description)
+}
+
+func TestGetDomain_NotFound(t *testing.T) {
+	domainRepo := NewDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := domainRepo.Get(context.Background(), "staging")
+	assert.EqualError(t, err, "domain [staging] not found")
+}
+
+func TestListDomains(t *testing.T) {
+	domainRepo := NewDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "domains"  WHERE "domains"."deleted_at" IS NULL ORDER BY identifier asc`).WithReply(
+		[]map[string]interface{}{
+			{"identifier": "development", "name": "Development"},
+			{"identifier": "production", "name": "Production"},
+		})
+
+	domains, err := domainRepo.List(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, domains, 2)
+	assert.Equal(t, "development", domains[0].Identifier)
+	assert.Equal(t, "production", domains[1].Identifier)
+}
+
+func TestUpdateDomain_NotFound(t *testing.T) {
+	domainRepo := NewDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "domains"`).WithRowsNum(0)
+
+	err := domainRepo.Update(context.Background(), models.Domain{Identifier: "staging"})
+	assert.EqualError(t, err, "domain [staging] not found")
+}
+
+func TestDeleteDomain(t *testing.T) {
+	domainRepo := NewDomainRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	deleteQuery := GlobalMock.NewMock()
+	deleteQuery.WithQuery(`DELETE FROM "domains"  WHERE (("domains"."identifier" = staging))`).WithRowsNum(1)
+
+	err := domainRepo.Delete(context.Background(), "staging")
+	assert.NoError(t, err)
+	assert.True(t, deleteQuery.Triggered)
+}
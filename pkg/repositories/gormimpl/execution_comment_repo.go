@@ -0,0 +1,59 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of ExecutionCommentRepoInterface.
+type ExecutionCommentRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *ExecutionCommentRepo) Create(ctx context.Context, input *models.ExecutionComment) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ExecutionCommentRepo) List(
+	ctx context.Context, input interfaces.ListExecutionCommentsInput) ([]models.ExecutionComment, error) {
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	var comments []models.ExecutionComment
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.ExecutionComment{
+		ExecutionProject: input.Execution.Project,
+		ExecutionDomain:  input.Execution.Domain,
+		ExecutionName:    input.Execution.Name,
+	}).Order("id asc").Limit(input.Limit).Offset(input.Offset).Find(&comments)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return comments, nil
+}
+
+// Returns an instance of ExecutionCommentRepoInterface
+func NewExecutionCommentRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.ExecutionCommentRepoInterface {
+	metrics := newMetrics(scope)
+	return &ExecutionCommentRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,57 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateExecutionComment(t *testing.T) {
+	commentRepo := NewExecutionCommentRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	insertQuery := GlobalMock.NewMock()
+	insertQuery.WithQuery(`INSERT INTO "execution_comments"`)
+
+	err := commentRepo.Create(context.Background(), &models.ExecutionComment{
+		ExecutionProject: project,
+		ExecutionDomain:  domain,
+		ExecutionName:    name,
+		Author:           "jane",
+		Text:             "re-ran manually, upstream data was late",
+	})
+	assert.NoError(t, err)
+	assert.True(t, insertQuery.Triggered)
+}
+
+func TestListExecutionComments(t *testing.T) {
+	commentRepo := NewExecutionCommentRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`ORDER BY id asc LIMIT 10 OFFSET 0`).WithReply([]map[string]interface{}{
+		{"id": 1, "author": "jane", "text": "re-ran manually"},
+		{"id": 2, "author": "joe", "text": "see the incident"},
+	})
+
+	execution := models.ExecutionKey{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+	}
+	comments, err := commentRepo.List(context.Background(), interfaces.ListExecutionCommentsInput{
+		Execution: execution,
+		Limit:     10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, comments, 2)
+	assert.Equal(t, "joe", comments[1].Author)
+
+	_, err = commentRepo.List(context.Background(), interfaces.ListExecutionCommentsInput{
+		Execution: execution,
+	})
+	assert.Error(t, err)
+}
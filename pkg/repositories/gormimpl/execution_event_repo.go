@@ -3,6 +3,7 @@ package gormimpl
 import (
 	"context"
 
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -18,7 +19,7 @@ type ExecutionEventRepo struct {
 
 func (r *ExecutionEventRepo) Create(ctx context.Context, input models.ExecutionEvent) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&input)
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
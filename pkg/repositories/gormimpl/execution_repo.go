@@ -3,11 +3,16 @@ package gormimpl
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"google.golang.org/grpc/codes"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -20,14 +25,29 @@ type ExecutionRepo struct {
 	db               *gorm.DB
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
+	// The admission locks of each project and domain, for databases without row locks.
+	admissionLocksMutex sync.Mutex
+	admissionLocks      map[string]*sync.Mutex
 }
 
 func (r *ExecutionRepo) Create(ctx context.Context, input models.Execution) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&input)
-	timer.Stop()
-	if tx.Error != nil {
-		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	if err := tx.Create(&input).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	// Labels are saved in the same transaction so that executions are never listed without them.
+	for _, label := range input.Labels {
+		label.ExecutionKey = input.ExecutionKey
+		if err := tx.Create(&label).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
 	}
 	return nil
 }
@@ -35,7 +55,7 @@ func (r *ExecutionRepo) Create(ctx context.Context, input models.Execution) erro
 func (r *ExecutionRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
 	var execution models.Execution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Execution{
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Execution{
 		ExecutionKey: models.ExecutionKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -56,9 +76,83 @@ func (r *ExecutionRepo) Get(ctx context.Context, input interfaces.Identifier) (m
 	return execution, nil
 }
 
+func (r *ExecutionRepo) GetByIdempotencyKey(
+	ctx context.Context, input interfaces.IdempotencyKey) (models.Execution, error) {
+	var execution models.Execution
+	timer := r.metrics.GetDuration.Start()
+	// Soft-deleted executions keep their keys, as they do in the unique index of keys.
+	tx := repositoryConfig.WithContext(ctx, r.db).Unscoped().Where(&models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+		},
+		IdempotencyKey: &input.Key,
+	}).Take(&execution)
+	timer.Stop()
+	if tx.Error != nil {
+		return models.Execution{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RecordNotFound() {
+		return models.Execution{}, errors.GetMissingEntityByIDError("execution")
+	}
+	return execution, nil
+}
+
+func (r *ExecutionRepo) ReserveIdempotencyKey(ctx context.Context, input interfaces.ReserveIdempotencyKeyInput) error {
+	timer := r.metrics.CreateDuration.Start()
+	defer timer.Stop()
+	err := repositoryConfig.WithContext(ctx, r.db).Create(&models.IdempotencyKeyReservation{
+		Project:        input.Project,
+		Domain:         input.Domain,
+		IdempotencyKey: input.Key,
+		ReservationID:  input.ReservationID,
+		ReservedUntil:  input.ReservedUntil,
+	}).Error
+	if err == nil {
+		return nil
+	}
+	if adminErr := r.errorTransformer.ToFlyteAdminError(err); adminErr.Code() != codes.AlreadyExists {
+		return adminErr
+	}
+	// Take over an expired reservation, such as one held by an admin which stopped while launching the execution.
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.IdempotencyKeyReservation{}).Where(
+		&models.IdempotencyKeyReservation{
+			Project:        input.Project,
+			Domain:         input.Domain,
+			IdempotencyKey: input.Key,
+		}).Where("reserved_until < ?", input.Now).UpdateColumns(map[string]interface{}{
+		"reservation_id": input.ReservationID,
+		"reserved_until": input.ReservedUntil,
+	})
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return adminErrors.NewFlyteAdminErrorf(codes.AlreadyExists,
+			"idempotency key [%s] is reserved by another request", input.Key)
+	}
+	return nil
+}
+
+func (r *ExecutionRepo) ReleaseIdempotencyKey(
+	ctx context.Context, input interfaces.IdempotencyKey, reservationID string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.IdempotencyKeyReservation{
+		Project:        input.Project,
+		Domain:         input.Domain,
+		IdempotencyKey: input.Key,
+		ReservationID:  reservationID,
+	}).Delete(&models.IdempotencyKeyReservation{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
 func (r *ExecutionRepo) Update(ctx context.Context, execution models.Execution) error {
 	timer := r.metrics.UpdateDuration.Start()
-	tx := r.db.Model(&execution).Updates(execution)
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&execution).Updates(execution)
 	timer.Stop()
 	if err := tx.Error; err != nil {
 		return r.errorTransformer.ToFlyteAdminError(err)
@@ -66,27 +160,58 @@ func (r *ExecutionRepo) Update(ctx context.Context, execution models.Execution)
 	return nil
 }
 
-func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
-	interfaces.ExecutionCollectionOutput, error) {
-	// First validate input.
-	if err := ValidateListInput(input); err != nil {
-		return interfaces.ExecutionCollectionOutput{}, err
+func (r *ExecutionRepo) UpdateWithMessages(
+	ctx context.Context, execution models.Execution, messages []models.OutboxMessage) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	if err := tx.Model(&execution).Updates(execution).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
 	}
-	var executions []models.Execution
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
-	// And add join condition as required by user-specified filters (which can potentially include join table attrs).
-	if ok := input.JoinTableEntities[common.LaunchPlan]; ok {
+	for _, message := range messages {
+		message.Project = execution.Project
+		if err := tx.Create(&message).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+// Joins executions with the entities filters refer to.
+func joinExecutionTables(tx *gorm.DB, joinTableEntities map[common.Entity]bool) *gorm.DB {
+	if ok := joinTableEntities[common.LaunchPlan]; ok {
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.launch_plan_id = %s.id",
 			launchPlanTableName, executionTableName, launchPlanTableName))
 	}
-	if ok := input.JoinTableEntities[common.Workflow]; ok {
+	if ok := joinTableEntities[common.Workflow]; ok {
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.workflow_id = %s.id",
 			workflowTableName, executionTableName, workflowTableName))
 	}
-	if ok := input.JoinTableEntities[common.Task]; ok {
+	if ok := joinTableEntities[common.Task]; ok {
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.task_id = %s.id",
 			taskTableName, executionTableName, taskTableName))
 	}
+	return tx
+}
+
+func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.ExecutionCollectionOutput, error) {
+	// First validate input.
+	if err := ValidateListInput(input); err != nil {
+		return interfaces.ExecutionCollectionOutput{}, err
+	}
+	var executions []models.Execution
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit)
+	if input.Cursor == nil {
+		tx = tx.Offset(input.Offset)
+	}
+	// And add join condition as required by user-specified filters (which can potentially include join table attrs).
+	tx = joinExecutionTables(tx, input.JoinTableEntities)
 
 	// Apply filters
 	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
@@ -95,7 +220,9 @@ func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceI
 	}
 
 	// Apply sort ordering.
-	if input.SortParameter != nil {
+	if input.Cursor != nil {
+		tx = applyExecutionCursor(tx, *input.Cursor)
+	} else if input.SortParameter != nil {
 		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
 	}
 
@@ -110,11 +237,206 @@ func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceI
 	}, nil
 }
 
+func (r *ExecutionRepo) Count(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+	var count int64
+	tx, err := applyScopedFilters(
+		repositoryConfig.WithContext(ctx, r.db).Model(&models.Execution{}), input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return 0, err
+	}
+	timer := r.metrics.CountDuration.Start()
+	tx = tx.Count(&count)
+	timer.Stop()
+	if tx.Error != nil {
+		return 0, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return count, nil
+}
+
+// Returns the expression of a column executions may be counted by.
+func getExecutionGroupColumn(dialect, column string) (string, bool) {
+	switch column {
+	case interfaces.ExecutionGroupByProject, interfaces.ExecutionGroupByDomain, interfaces.ExecutionGroupByPhase:
+		return fmt.Sprintf("%s.%s", executionTableName, column), true
+	case interfaces.ExecutionGroupByDay:
+		if dialect == repositoryConfig.Postgres {
+			return fmt.Sprintf("to_char(%s.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')", executionTableName), true
+		}
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d', %s.created_at)", executionTableName), true
+	default:
+		return "", false
+	}
+}
+
+func (r *ExecutionRepo) CountGroups(ctx context.Context, input interfaces.CountExecutionGroupsInput) (
+	[]models.ExecutionGroupCount, error) {
+	db := repositoryConfig.WithContext(ctx, r.db)
+	selects := make([]string, 0, len(input.GroupBy)+1)
+	groupBy := make([]string, 0, len(input.GroupBy))
+	for _, column := range input.GroupBy {
+		expr, ok := getExecutionGroupColumn(db.Dialect().GetName(), column)
+		if !ok {
+			return nil, errors.GetInvalidInputError(column)
+		}
+		selects = append(selects, fmt.Sprintf("%s AS %s", expr, column))
+		groupBy = append(groupBy, expr)
+	}
+	selects = append(selects, "COUNT(*) AS count")
+	tx := joinExecutionTables(db.Model(&models.Execution{}), input.JoinTableEntities).Select(
+		strings.Join(selects, ", "))
+	tx, err := applyScopedFilters(tx, input.InlineFilters, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(groupBy) > 0 {
+		tx = tx.Group(strings.Join(groupBy, ", ")).Order(strings.Join(groupBy, ", "))
+	}
+	if input.Limit > 0 {
+		tx = tx.Limit(input.Limit)
+	}
+	var counts []models.ExecutionGroupCount
+	timer := r.metrics.CountDuration.Start()
+	tx = tx.Scan(&counts)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return counts, nil
+}
+
+func (r *ExecutionRepo) ListLabels(ctx context.Context, input interfaces.ListExecutionLabelsInput) (
+	[]models.ExecutionLabelCount, error) {
+	// Labels of soft-deleted executions aren't counted.
+	tx := repositoryConfig.WithContext(ctx, r.db).Table(executionLabelTableName).Joins(fmt.Sprintf(
+		"INNER JOIN %[1]s ON %[1]s.execution_project = %[2]s.execution_project AND "+
+			"%[1]s.execution_domain = %[2]s.execution_domain AND %[1]s.execution_name = %[2]s.execution_name AND "+
+			"%[1]s.deleted_at IS NULL", executionTableName, executionLabelTableName)).Where(fmt.Sprintf(
+		"%[1]s.execution_project = ? AND %[1]s.execution_domain = ?", executionLabelTableName),
+		input.Project, input.Domain)
+	if len(input.Key) == 0 {
+		tx = tx.Select(fmt.Sprintf("%s.key AS key, COUNT(*) AS count", executionLabelTableName)).Group(
+			fmt.Sprintf("%s.key", executionLabelTableName)).Order(fmt.Sprintf("COUNT(*) desc, %s.key asc",
+			executionLabelTableName))
+	} else {
+		tx = tx.Select(fmt.Sprintf("%[1]s.key AS key, %[1]s.value AS value, COUNT(*) AS count",
+			executionLabelTableName)).Where(fmt.Sprintf("%s.key = ?", executionLabelTableName), input.Key).Group(
+			fmt.Sprintf("%[1]s.key, %[1]s.value", executionLabelTableName)).Order(fmt.Sprintf(
+			"COUNT(*) desc, %s.value asc", executionLabelTableName))
+	}
+	if input.Limit > 0 {
+		tx = tx.Limit(input.Limit)
+	}
+	var counts []models.ExecutionLabelCount
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Scan(&counts)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return counts, nil
+}
+
+func (r *ExecutionRepo) MarkDispatched(ctx context.Context, input interfaces.Identifier, cluster string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.Execution{}).Where(&models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Where("pending = ?", true).UpdateColumns(map[string]interface{}{
+		"pending":      false,
+		"cluster":      cluster,
+		"launch_error": "",
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Postgres locks the admission row of the project and domain in a transaction which lasts until unlock is called.
+// Other databases, such as sqlite, aren't shared by replicas and don't lock rows, so a lock within the process is used
+// instead.
+func (r *ExecutionRepo) LockAdmission(ctx context.Context, project, domain string) (func(), error) {
+	if r.db.Dialect().GetName() != repositoryConfig.Postgres {
+		key := fmt.Sprintf("%s/%s", project, domain)
+		r.admissionLocksMutex.Lock()
+		lock, ok := r.admissionLocks[key]
+		if !ok {
+			lock = &sync.Mutex{}
+			r.admissionLocks[key] = lock
+		}
+		r.admissionLocksMutex.Unlock()
+		lock.Lock()
+		return lock.Unlock, nil
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	// The row of each project and domain is created the first time it's locked.
+	if err := tx.Exec(fmt.Sprintf("INSERT INTO %s (project, domain) VALUES (?, ?) ON CONFLICT DO NOTHING",
+		admissionLockTableName), project, domain).Error; err != nil {
+		tx.Rollback()
+		return nil, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	var lock models.AdmissionLock
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where(&models.AdmissionLock{
+		Project: project,
+		Domain:  domain,
+	}).Take(&lock).Error; err != nil {
+		tx.Rollback()
+		return nil, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	// Nothing was written, so the transaction is rolled back to release the lock.
+	return func() {
+		tx.Rollback()
+	}, nil
+}
+
+func (r *ExecutionRepo) RecordLaunchFailure(ctx context.Context, input interfaces.Identifier, launchError string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.Execution{}).Where(&models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Where("pending = ?", true).UpdateColumns(map[string]interface{}{
+		"launch_attempts": gorm.Expr("launch_attempts + ?", 1),
+		"launch_error":    launchError,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ExecutionRepo) ListLaunchPlanOutcomes(ctx context.Context, input interfaces.ListLaunchPlanOutcomesInput) (
+	[]models.LaunchPlanExecutionOutcome, error) {
+	if len(input.Phases) == 0 {
+		return nil, errors.GetInvalidInputError("phases")
+	}
+	var outcomes []models.LaunchPlanExecutionOutcome
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.Execution{}).Select(fmt.Sprintf(
+		"%[1]s.project AS launch_plan_project, %[1]s.domain AS launch_plan_domain, %[1]s.name AS launch_plan_name, "+
+			"%[2]s.phase, %[2]s.duration, %[2]s.execution_updated_at", launchPlanTableName, executionTableName)).Joins(
+		fmt.Sprintf("INNER JOIN %s ON %s.launch_plan_id = %s.id", launchPlanTableName, executionTableName,
+			launchPlanTableName)).Where(fmt.Sprintf("%s.phase IN (?) AND %s.execution_updated_at >= ?",
+		executionTableName, executionTableName), input.Phases, input.UpdatedSince).Scan(&outcomes)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return outcomes, nil
+}
+
 func (r *ExecutionRepo) Exists(ctx context.Context, input interfaces.Identifier) (bool, error) {
 	var execution models.Execution
 	timer := r.metrics.ExistsDuration.Start()
 	// Only select the id field (uint) to check for existence.
-	tx := r.db.Select(ID).Where(&models.Execution{
+	tx := repositoryConfig.WithContext(ctx, r.db).Select(ID).Where(&models.Execution{
 		ExecutionKey: models.ExecutionKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -128,6 +450,66 @@ func (r *ExecutionRepo) Exists(ctx context.Context, input interfaces.Identifier)
 	return !tx.RecordNotFound(), nil
 }
 
+func (r *ExecutionRepo) Delete(ctx context.Context, input interfaces.Identifier) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Delete(&models.Execution{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return errors.GetMissingEntityError("execution", &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		})
+	}
+	return nil
+}
+
+func (r *ExecutionRepo) Restore(ctx context.Context, input interfaces.Identifier) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Unscoped().Model(&models.Execution{}).Where(&models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Where(isDeleted).Update(deletedAt, nil)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return errors.GetMissingEntityError("deleted execution", &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		})
+	}
+	return nil
+}
+
+// Orders executions by creation time, using the id to break ties, and skips those on or before the cursor.
+func applyExecutionCursor(tx *gorm.DB, cursor interfaces.ListCursor) *gorm.DB {
+	direction, comparison := "asc", ">"
+	if cursor.Descending {
+		direction, comparison = "desc", "<"
+	}
+	if !cursor.IsFirstPage() {
+		tx = tx.Where(fmt.Sprintf("(%s.created_at, %s.id) %s (?, ?)", executionTableName, executionTableName,
+			comparison), cursor.CreatedAt, cursor.ID)
+	}
+	return tx.Order(fmt.Sprintf("%s.created_at %s", executionTableName, direction)).Order(
+		fmt.Sprintf("%s.id %s", executionTableName, direction))
+}
+
 // Returns an instance of ExecutionRepoInterface
 func NewExecutionRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ExecutionRepoInterface {
@@ -136,5 +518,6 @@ func NewExecutionRepo(
 		db:               db,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
+		admissionLocks:   make(map[string]*sync.Mutex),
 	}
 }
@@ -13,10 +13,12 @@ import (
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/flyteorg/flyteadmin/pkg/common"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 var createdAt = time.Date(2018, time.February, 17, 00, 00, 00, 00, time.UTC).UTC()
@@ -41,6 +43,28 @@ func TestCreateExecution(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateExecution_Labels(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	labelQuery := GlobalMock.NewMock()
+	labelQuery.WithQuery(`INSERT INTO "execution_labels" ("execution_project","execution_domain","execution_name",` +
+		`"key","value") VALUES (?,?,?,?,?)`)
+
+	err := executionRepo.Create(context.Background(), models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "1",
+		},
+		Spec: []byte{3, 4},
+		Labels: []models.ExecutionLabel{
+			{Key: "team", Value: "ml"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, labelQuery.Triggered)
+}
+
 func TestUpdateExecution(t *testing.T) {
 	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 	GlobalMock := mocket.Catcher.Reset()
@@ -70,6 +94,53 @@ func TestUpdateExecution(t *testing.T) {
 	assert.True(t, executionQuery.Triggered)
 }
 
+func TestUpdateExecutionWithMessages(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	executionQuery := GlobalMock.NewMock()
+	executionQuery.WithQuery(`UPDATE "executions" SET "execution_domain" = ?, "execution_name" = ?, ` +
+		`"execution_project" = ?, "phase" = ?, "updated_at" = ?  WHERE "executions"."deleted_at" IS NULL`)
+	messageQuery := GlobalMock.NewMock()
+	messageQuery.WithQuery(`INSERT INTO "outbox_messages" ("created_at","project","publisher","notification_type",` +
+		`"message_type","payload","lease_id","leased_until","attempts","last_error","dead_lettered_at") ` +
+		`VALUES (?,?,?,?,?,?,?,?,?,?,?)`)
+
+	err := executionRepo.UpdateWithMessages(context.Background(), models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "1",
+		},
+		Phase: core.WorkflowExecution_SUCCEEDED.String(),
+	}, []models.OutboxMessage{
+		{
+			Publisher:        "events",
+			NotificationType: "flyteidl.admin.WorkflowExecutionEventRequest",
+			MessageType:      "flyteidl.admin.WorkflowExecutionEventRequest",
+			Payload:          []byte{1, 2},
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, executionQuery.Triggered)
+	assert.True(t, messageQuery.Triggered)
+}
+
+func TestUpdateExecutionWithMessages_RolledBack(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`INSERT INTO "outbox_messages"`).WithExecException()
+
+	err := executionRepo.UpdateWithMessages(context.Background(), models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "1",
+		},
+		Phase: core.WorkflowExecution_SUCCEEDED.String(),
+	}, []models.OutboxMessage{{Publisher: "events", Payload: []byte{1, 2}}})
+	assert.Error(t, err)
+}
+
 func getMockExecutionResponseFromDb(expected models.Execution) map[string]interface{} {
 	execution := make(map[string]interface{})
 	execution["id"] = expected.ID
@@ -86,6 +157,9 @@ func getMockExecutionResponseFromDb(expected models.Execution) map[string]interf
 	execution["execution_updated_at"] = expected.ExecutionUpdatedAt
 	execution["duration"] = expected.Duration
 	execution["mode"] = expected.Mode
+	if expected.IdempotencyKey != nil {
+		execution["idempotency_key"] = *expected.IdempotencyKey
+	}
 	return execution
 }
 
@@ -128,6 +202,44 @@ func TestGetExecution(t *testing.T) {
 	assert.EqualValues(t, expectedExecution, output)
 }
 
+func TestGetExecutionByIdempotencyKey(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	idempotencyKey := "key"
+	expectedExecution := models.Execution{
+		BaseModel: models.BaseModel{
+			ID: uint(20),
+		},
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "1",
+		},
+		LaunchPlanID:   uint(2),
+		Phase:          core.WorkflowExecution_SUCCEEDED.String(),
+		Closure:        []byte{1, 2},
+		WorkflowID:     uint(3),
+		Spec:           []byte{3, 4},
+		IdempotencyKey: &idempotencyKey,
+	}
+
+	executions := make([]map[string]interface{}, 0)
+	execution := getMockExecutionResponseFromDb(expectedExecution)
+	executions = append(executions, execution)
+
+	GlobalMock := mocket.Catcher.Reset()
+	// Soft-deleted executions are included.
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "executions"  WHERE ` +
+		`("executions"."execution_project" = project) AND ("executions"."execution_domain" = domain) AND ` +
+		`("executions"."idempotency_key" = key) LIMIT 1`).WithReply(executions)
+	output, err := executionRepo.GetByIdempotencyKey(context.Background(), interfaces.IdempotencyKey{
+		Project: "project",
+		Domain:  "domain",
+		Key:     idempotencyKey,
+	})
+	assert.NoError(t, err)
+	assert.EqualValues(t, expectedExecution, output)
+}
+
 func TestListExecutions(t *testing.T) {
 	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 
@@ -180,6 +292,49 @@ func TestListExecutions(t *testing.T) {
 	}
 }
 
+func TestListExecutions_Cursor(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	executions := []map[string]interface{}{
+		getMockExecutionResponseFromDb(models.Execution{
+			ExecutionKey: models.ExecutionKey{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "1",
+			},
+			Phase:     core.WorkflowExecution_SUCCEEDED.String(),
+			Closure:   []byte{1, 2},
+			Spec:      []byte{3, 4},
+			StartedAt: &executionStartedAt,
+		}),
+	}
+
+	GlobalMock := mocket.Catcher.Reset()
+	// Only match on queries which skip executions up to the cursor and break ties on creation time by id.
+	GlobalMock.NewMock().WithQuery(
+		`(executions.created_at, executions.id) < (2021-08-20 10:00:00 +0000 UTC, 7))) ` +
+			`ORDER BY executions.created_at desc,executions.id desc LIMIT 20`,
+	).WithReply(executions)
+
+	collection, err := executionRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Execution, "project", project),
+			getEqualityFilter(common.Execution, "domain", domain),
+		},
+		Limit: 20,
+		// Offsets are ignored in favour of the cursor.
+		Offset: 40,
+		Cursor: &interfaces.ListCursor{
+			Descending: true,
+			CreatedAt:  time.Date(2021, 8, 20, 10, 0, 0, 0, time.UTC),
+			ID:         7,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, collection.Executions, 1)
+	assert.Equal(t, "1", collection.Executions[0].Name)
+}
+
 func TestListExecutions_Filters(t *testing.T) {
 	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 
@@ -370,3 +525,159 @@ func TestExecutionExists(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, exists)
 }
+
+func TestDeleteExecution(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "executions" SET "deleted_at"=?  WHERE "executions"."deleted_at" IS NULL AND ` +
+		`(("executions"."execution_project" = ?) AND ("executions"."execution_domain" = ?) AND ` +
+		`("executions"."execution_name" = ?))`).WithRowsNum(1)
+
+	err := executionRepo.Delete(context.Background(), interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "1",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestRestoreExecution(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "executions" SET "deleted_at" = ?, "updated_at" = ?  WHERE ` +
+		`("executions"."execution_project" = ?) AND ("executions"."execution_domain" = ?) AND ` +
+		`("executions"."execution_name" = ?) AND (deleted_at IS NOT NULL)`).WithRowsNum(1)
+
+	err := executionRepo.Restore(context.Background(), interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "1",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestRestoreExecution_NotDeleted(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	err := executionRepo.Restore(context.Background(), interfaces.Identifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "1",
+	})
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestListLaunchPlanOutcomes(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT launch_plans.project AS launch_plan_project, launch_plans.domain AS ` +
+		`launch_plan_domain, launch_plans.name AS launch_plan_name, executions.phase, executions.duration, ` +
+		`executions.execution_updated_at FROM "executions" INNER JOIN launch_plans ON ` +
+		`executions.launch_plan_id = launch_plans.id WHERE "executions"."deleted_at" IS NULL AND ` +
+		`((executions.phase IN (`).WithReply([]map[string]interface{}{
+		{
+			"launch_plan_project":  "project",
+			"launch_plan_domain":   "domain",
+			"launch_plan_name":     "name",
+			"phase":                core.WorkflowExecution_SUCCEEDED.String(),
+			"duration":             int64(time.Minute),
+			"execution_updated_at": executionUpdatedAt,
+		},
+	})
+
+	outcomes, err := executionRepo.ListLaunchPlanOutcomes(context.Background(), interfaces.ListLaunchPlanOutcomesInput{
+		Phases:       []string{core.WorkflowExecution_SUCCEEDED.String(), core.WorkflowExecution_FAILED.String()},
+		UpdatedSince: createdAt,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.LaunchPlanExecutionOutcome{
+		{
+			LaunchPlanProject:  "project",
+			LaunchPlanDomain:   "domain",
+			LaunchPlanName:     "name",
+			Phase:              core.WorkflowExecution_SUCCEEDED.String(),
+			Duration:           time.Minute,
+			ExecutionUpdatedAt: executionUpdatedAt,
+		},
+	}, outcomes)
+
+	_, err = executionRepo.ListLaunchPlanOutcomes(context.Background(), interfaces.ListLaunchPlanOutcomesInput{})
+	assert.EqualError(t, err, "missing and/or invalid parameters: phases")
+}
+
+func TestCountExecutionGroups(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`((launch_plans.name = name)) GROUP BY executions.phase, ` +
+		`strftime('%Y-%m-%d', executions.created_at) ORDER BY executions.phase, ` +
+		`strftime('%Y-%m-%d', executions.created_at) LIMIT 10`).WithReply(
+		[]map[string]interface{}{
+			{"phase": core.WorkflowExecution_FAILED.String(), "day": "2021-11-26", "count": 2},
+			{"phase": core.WorkflowExecution_SUCCEEDED.String(), "day": "2021-11-26", "count": 5},
+		})
+
+	counts, err := executionRepo.CountGroups(context.Background(), interfaces.CountExecutionGroupsInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Execution, "project", "project"),
+			getEqualityFilter(common.LaunchPlan, "name", "name"),
+		},
+		JoinTableEntities: map[common.Entity]bool{common.LaunchPlan: true},
+		GroupBy:           []string{interfaces.ExecutionGroupByPhase, interfaces.ExecutionGroupByDay},
+		Limit:             10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ExecutionGroupCount{
+		{Phase: core.WorkflowExecution_FAILED.String(), Day: "2021-11-26", Count: 2},
+		{Phase: core.WorkflowExecution_SUCCEEDED.String(), Day: "2021-11-26", Count: 5},
+	}, counts)
+}
+
+func TestCountExecutionGroups_Total(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT COUNT(*) AS count FROM "executions"`).WithReply(
+		[]map[string]interface{}{{"count": 7}})
+
+	counts, err := executionRepo.CountGroups(context.Background(), interfaces.CountExecutionGroupsInput{
+		InlineFilters: []common.InlineFilter{getEqualityFilter(common.Execution, "project", "project")},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ExecutionGroupCount{{Count: 7}}, counts)
+}
+
+func TestListExecutionLabels(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT execution_labels.key AS key, execution_labels.value AS value, ` +
+		`COUNT(*) AS count FROM "execution_labels" INNER JOIN executions ON ` +
+		`executions.execution_project = execution_labels.execution_project AND ` +
+		`executions.execution_domain = execution_labels.execution_domain AND ` +
+		`executions.execution_name = execution_labels.execution_name AND executions.deleted_at IS NULL ` +
+		`WHERE (execution_labels.execution_project = $1 AND execution_labels.execution_domain = $2) AND ` +
+		`(execution_labels.key = $3) GROUP BY execution_labels.key, execution_labels.value ` +
+		`ORDER BY COUNT(*) desc, execution_labels.value asc LIMIT 10`).WithReply(
+		[]map[string]interface{}{{"key": "team", "value": "ml", "count": 3}})
+
+	counts, err := executionRepo.ListLabels(context.Background(), interfaces.ListExecutionLabelsInput{
+		Project: "project",
+		Domain:  "domain",
+		Key:     "team",
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ExecutionLabelCount{{Key: "team", Value: "ml", Count: 3}}, counts)
+}
+
+func TestCountExecutionGroups_InvalidGroupBy(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	_, err := executionRepo.CountGroups(context.Background(), interfaces.CountExecutionGroupsInput{
+		GroupBy: []string{"name"},
+	})
+	assert.EqualError(t, err, "missing and/or invalid parameters: name")
+}
@@ -0,0 +1,141 @@
+package gormimpl
+
+import (
+	"context"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Overrides for every project have an empty project, which gorm skips when querying by struct, so overrides are
+// queried by their columns.
+const featureFlagKeyQuery = "name = ? AND project = ?"
+
+// Implementation of FeatureFlagRepoInterface.
+type FeatureFlagRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getMissingFeatureFlagError(name, project string) error {
+	if len(project) == 0 {
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"feature flag [%s] isn't overridden for every project", name)
+	}
+	return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+		"feature flag [%s] isn't overridden for project [%s]", name, project)
+}
+
+func (r *FeatureFlagRepo) Set(ctx context.Context, input models.FeatureFlag, change models.FeatureFlagChange) error {
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	var record models.FeatureFlag
+	err := tx.Where(featureFlagKeyQuery, input.Name, input.Project).Take(&record).Error
+	if gorm.IsRecordNotFoundError(err) {
+		record = models.FeatureFlag{
+			Name:    input.Name,
+			Project: input.Project,
+		}
+	} else if err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	record.Enabled = input.Enabled
+	record.Principal = input.Principal
+	// Saves every field, including disabled overrides, and creates the override when it doesn't exist yet.
+	if err := tx.Save(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Create(&change).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *FeatureFlagRepo) Clear(ctx context.Context, name, project string, change models.FeatureFlagChange) error {
+	timer := r.metrics.DeleteDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	deleted := tx.Where(featureFlagKeyQuery, name, project).Delete(&models.FeatureFlag{})
+	if deleted.Error != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(deleted.Error)
+	}
+	if deleted.RowsAffected == 0 {
+		tx.Rollback()
+		return getMissingFeatureFlagError(name, project)
+	}
+	if err := tx.Create(&change).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *FeatureFlagRepo) Get(ctx context.Context, name string) ([]models.FeatureFlag, error) {
+	var flags []models.FeatureFlag
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where("name = ?", name).Order("project asc").Find(&flags)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return flags, nil
+}
+
+func (r *FeatureFlagRepo) ListAll(ctx context.Context) ([]models.FeatureFlag, error) {
+	var flags []models.FeatureFlag
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Order("name asc, project asc").Find(&flags)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return flags, nil
+}
+
+func (r *FeatureFlagRepo) ListChanges(ctx context.Context, input interfaces.ListFeatureFlagChangesInput) (
+	[]models.FeatureFlagChange, error) {
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db)
+	if len(input.Name) > 0 {
+		tx = tx.Where("name = ?", input.Name)
+	}
+	var changes []models.FeatureFlagChange
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Order("created_at desc, id desc").Limit(input.Limit).Offset(input.Offset).Find(&changes)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return changes, nil
+}
+
+// Returns an instance of FeatureFlagRepoInterface
+func NewFeatureFlagRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.FeatureFlagRepoInterface {
+	metrics := newMetrics(scope)
+	return &FeatureFlagRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,63 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFeatureFlag(t *testing.T) {
+	featureFlagRepo := NewFeatureFlagRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "feature_flags"  WHERE (name = admission_control)`).WithReply(
+		[]map[string]interface{}{
+			{"name": "admission_control", "project": "", "enabled": false},
+			{"name": "admission_control", "project": "pilot", "enabled": true},
+		})
+
+	flags, err := featureFlagRepo.Get(context.Background(), "admission_control")
+	assert.NoError(t, err)
+	assert.Len(t, flags, 2)
+	assert.Equal(t, "pilot", flags[1].Project)
+	assert.True(t, flags[1].Enabled)
+}
+
+func TestClearFeatureFlag_NotFound(t *testing.T) {
+	featureFlagRepo := NewFeatureFlagRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`DELETE FROM "feature_flags"`).WithRowsNum(0)
+
+	err := featureFlagRepo.Clear(context.Background(), "admission_control", "pilot", models.FeatureFlagChange{})
+	assert.EqualError(t, err, "feature flag [admission_control] isn't overridden for project [pilot]")
+	err = featureFlagRepo.Clear(context.Background(), "admission_control", "", models.FeatureFlagChange{})
+	assert.EqualError(t, err, "feature flag [admission_control] isn't overridden for every project")
+}
+
+func TestListFeatureFlagChanges(t *testing.T) {
+	featureFlagRepo := NewFeatureFlagRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`ORDER BY created_at desc, id desc LIMIT 10 OFFSET 0`).WithReply(
+		[]map[string]interface{}{
+			{"name": "admission_control", "project": "pilot", "principal": "jane", "reason": "pilot"},
+		})
+
+	changes, err := featureFlagRepo.ListChanges(context.Background(), interfaces.ListFeatureFlagChangesInput{
+		Name:  "admission_control",
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "jane", changes[0].Principal)
+}
+
+func TestListFeatureFlagChanges_MissingLimit(t *testing.T) {
+	featureFlagRepo := NewFeatureFlagRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := featureFlagRepo.ListChanges(context.Background(), interfaces.ListFeatureFlagChangesInput{})
+	assert.EqualError(t, err, "missing and/or invalid parameters: limit")
+}
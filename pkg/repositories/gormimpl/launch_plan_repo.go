@@ -7,6 +7,7 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytestdlib/promutils"
 
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -30,7 +31,7 @@ type LaunchPlanRepo struct {
 
 func (r *LaunchPlanRepo) Create(ctx context.Context, input models.LaunchPlan) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&input)
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -40,7 +41,8 @@ func (r *LaunchPlanRepo) Create(ctx context.Context, input models.LaunchPlan) er
 
 func (r *LaunchPlanRepo) Update(ctx context.Context, input models.LaunchPlan) error {
 	timer := r.metrics.UpdateDuration.Start()
-	tx := r.db.Model(&input).Updates(input)
+	// The revision is only advanced by state transitions, and mustn't be reverted to the one read.
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&input).Omit("state_revision").Updates(input)
 	timer.Stop()
 	if err := tx.Error; err != nil {
 		return r.errorTransformer.ToFlyteAdminError(err)
@@ -51,7 +53,7 @@ func (r *LaunchPlanRepo) Update(ctx context.Context, input models.LaunchPlan) er
 func (r *LaunchPlanRepo) Get(ctx context.Context, input interfaces.Identifier) (models.LaunchPlan, error) {
 	var launchPlan models.LaunchPlan
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.LaunchPlan{
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.LaunchPlan{
 		LaunchPlanKey: models.LaunchPlanKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -75,30 +77,49 @@ func (r *LaunchPlanRepo) Get(ctx context.Context, input interfaces.Identifier) (
 	return launchPlan, nil
 }
 
+// Updates the state of a launch plan, provided no other state transition has been applied since it was read.
+func (r *LaunchPlanRepo) updateState(tx *gorm.DB, launchPlan models.LaunchPlan) error {
+	revision := launchPlan.StateRevision
+	launchPlan.StateRevision++
+	updated := tx.Model(&launchPlan).Where("state_revision = ?", revision).UpdateColumns(launchPlan)
+	if updated.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(updated.Error)
+	}
+	if updated.RowsAffected == 0 {
+		return errors.GetConcurrentUpdateError(core.ResourceType_LAUNCH_PLAN.String(), &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      launchPlan.Project,
+			Domain:       launchPlan.Domain,
+			Name:         launchPlan.Name,
+			Version:      launchPlan.Version,
+		})
+	}
+	return nil
+}
+
 // This operation is performed as a two-step transaction because only one launch plan version can be active at a time.
 // Transactional semantics are used to guarantee that setting the desired launch plan to active also disables
-// the existing launch plan version (if any).
+// the existing launch plan version (if any). Either launch plan having changed state since it was read fails the
+// operation with FailedPrecondition, so that concurrent callers can't both leave a version active.
 func (r *LaunchPlanRepo) SetActive(
 	ctx context.Context, toEnable models.LaunchPlan, toDisable *models.LaunchPlan) error {
 	timer := r.launchPlanMetrics.SetActiveDuration.Start()
 	defer timer.Stop()
 	// Use a transaction to guarantee no partial updates.
-	tx := r.db.Begin()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
 
-	// There is a launch plan to disable as part of this transaction
-	if toDisable != nil {
-		tx.Model(&toDisable).UpdateColumns(toDisable)
-		if err := tx.Error; err != nil {
+	// There is a launch plan to disable as part of this transaction, unless the active version is being re-enabled.
+	if toDisable != nil && toDisable.LaunchPlanKey != toEnable.LaunchPlanKey {
+		if err := r.updateState(tx, *toDisable); err != nil {
 			tx.Rollback()
-			return r.errorTransformer.ToFlyteAdminError(err)
+			return err
 		}
 	}
 
 	// And update the desired version.
-	tx.Model(&toEnable).UpdateColumns(toEnable)
-	if err := tx.Error; err != nil {
+	if err := r.updateState(tx, toEnable); err != nil {
 		tx.Rollback()
-		return r.errorTransformer.ToFlyteAdminError(err)
+		return err
 	}
 	if err := tx.Commit().Error; err != nil {
 		return r.errorTransformer.ToFlyteAdminError(err)
@@ -113,7 +134,7 @@ func (r *LaunchPlanRepo) List(ctx context.Context, input interfaces.ListResource
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 	var launchPlans []models.LaunchPlan
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
 
 	// Add join conditions
 	tx = tx.Joins("inner join workflows on launch_plans.workflow_id = workflows.id")
@@ -150,7 +171,7 @@ func (r *LaunchPlanRepo) ListLaunchPlanIdentifiers(ctx context.Context, input in
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -177,6 +198,56 @@ func (r *LaunchPlanRepo) ListLaunchPlanIdentifiers(ctx context.Context, input in
 
 }
 
+func (r *LaunchPlanRepo) Delete(ctx context.Context, input interfaces.Identifier) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.LaunchPlan{
+		LaunchPlanKey: models.LaunchPlanKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		},
+	}).Delete(&models.LaunchPlan{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return errors.GetMissingEntityError(core.ResourceType_LAUNCH_PLAN.String(), &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		})
+	}
+	return nil
+}
+
+func (r *LaunchPlanRepo) Restore(ctx context.Context, input interfaces.Identifier) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Unscoped().Model(&models.LaunchPlan{}).Where(&models.LaunchPlan{
+		LaunchPlanKey: models.LaunchPlanKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		},
+	}).Where(isDeleted).Update(deletedAt, nil)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return errors.GetMissingEntityError("deleted "+core.ResourceType_LAUNCH_PLAN.String(), &core.Identifier{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+			Version: input.Version,
+		})
+	}
+	return nil
+}
+
 // Returns an instance of LaunchPlanRepoInterface
 func NewLaunchPlanRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.LaunchPlanRepoInterface {
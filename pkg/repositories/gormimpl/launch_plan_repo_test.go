@@ -8,11 +8,13 @@ import (
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/flyteorg/flyteadmin/pkg/common"
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 const workflowID = uint(1)
@@ -129,9 +131,11 @@ func TestSetActiveLaunchPlan(t *testing.T) {
 	mockQuery := GlobalMock.NewMock()
 	mockQuery.WithQuery(
 		`UPDATE "launch_plans" ` +
-			`SET "closure" = ?, "domain" = ?, "id" = ?, "name" = ?, "project" = ?, "state" = ?, "version" = ?  ` +
+			`SET "closure" = ?, "domain" = ?, "id" = ?, "name" = ?, "project" = ?, "state" = ?, ` +
+			`"state_revision" = ?, "version" = ?  ` +
 			`WHERE "launch_plans"."deleted_at" IS NULL AND "launch_plans"."project" = ? AND ` +
-			`"launch_plans"."domain" = ? AND "launch_plans"."name" = ? AND "launch_plans"."version" = ?`)
+			`"launch_plans"."domain" = ? AND "launch_plans"."name" = ? AND "launch_plans"."version" = ? AND ` +
+			`((state_revision = ?))`).WithRowsNum(1)
 
 	err := launchPlanRepo.SetActive(context.Background(), models.LaunchPlan{
 		BaseModel: models.BaseModel{
@@ -169,8 +173,9 @@ func TestSetActiveLaunchPlan_NoCurrentlyActiveLaunchPlan(t *testing.T) {
 	mockQuery := GlobalMock.NewMock()
 	mockQuery.WithQuery(
 		`UPDATE "launch_plans" SET "closure" = ?, "domain" = ?, "id" = ?, "name" = ?, "project" = ?, "state" = ?, ` +
-			`"version" = ?  WHERE "launch_plans"."deleted_at" IS NULL AND "launch_plans"."project" = ? AND ` +
-			`"launch_plans"."domain" = ? AND "launch_plans"."name" = ? AND "launch_plans"."version" = ?`)
+			`"state_revision" = ?, "version" = ?  WHERE "launch_plans"."deleted_at" IS NULL AND ` +
+			`"launch_plans"."project" = ? AND "launch_plans"."domain" = ? AND "launch_plans"."name" = ? AND ` +
+			`"launch_plans"."version" = ? AND ((state_revision = ?))`).WithRowsNum(1)
 	err := launchPlanRepo.SetActive(context.Background(), models.LaunchPlan{
 		BaseModel: models.BaseModel{
 			ID: 1,
@@ -188,6 +193,37 @@ func TestSetActiveLaunchPlan_NoCurrentlyActiveLaunchPlan(t *testing.T) {
 	assert.True(t, mockQuery.Triggered)
 }
 
+func TestSetActiveLaunchPlan_ConcurrentUpdate(t *testing.T) {
+	launchPlanRepo := NewLaunchPlanRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	// The formerly active version was already disabled by another caller, so its revision no longer matches.
+	mockQuery := GlobalMock.NewMock()
+	mockQuery.WithQuery(`UPDATE "launch_plans"`).WithRowsNum(0)
+	err := launchPlanRepo.SetActive(context.Background(), models.LaunchPlan{
+		LaunchPlanKey: models.LaunchPlanKey{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+			Version: "new version",
+		},
+		Closure: []byte{5, 6},
+		State:   &active,
+	}, &models.LaunchPlan{
+		LaunchPlanKey: models.LaunchPlanKey{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+			Version: "old version",
+		},
+		Closure:       []byte{5, 6},
+		State:         &inactive,
+		StateRevision: 3,
+	})
+	assert.Equal(t, codes.FailedPrecondition, err.(adminErrors.FlyteAdminError).Code())
+	assert.True(t, mockQuery.Triggered)
+}
+
 func TestListLaunchPlans(t *testing.T) {
 	launchPlanRepo := NewLaunchPlanRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 
@@ -491,3 +527,52 @@ func TestListLaunchPlanIds(t *testing.T) {
 		assert.True(t, launchPlan.WorkflowID == workflowID || launchPlan.WorkflowID == uint(2))
 	}
 }
+
+func TestDeleteLaunchPlan(t *testing.T) {
+	launchPlanRepo := NewLaunchPlanRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "launch_plans" SET "deleted_at"=?  WHERE "launch_plans"."deleted_at" IS NULL AND ` +
+		`(("launch_plans"."project" = ?) AND ("launch_plans"."domain" = ?) AND ("launch_plans"."name" = ?) AND ` +
+		`("launch_plans"."version" = ?))`).WithRowsNum(1)
+
+	err := launchPlanRepo.Delete(context.Background(), interfaces.Identifier{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestDeleteLaunchPlan_NotFound(t *testing.T) {
+	launchPlanRepo := NewLaunchPlanRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	err := launchPlanRepo.Delete(context.Background(), interfaces.Identifier{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+	})
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestRestoreLaunchPlan(t *testing.T) {
+	launchPlanRepo := NewLaunchPlanRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`UPDATE "launch_plans" SET "deleted_at" = ?, "updated_at" = ?  WHERE ` +
+		`("launch_plans"."project" = ?) AND ("launch_plans"."domain" = ?) AND ("launch_plans"."name" = ?) AND ` +
+		`("launch_plans"."version" = ?) AND (deleted_at IS NOT NULL)`).WithRowsNum(1)
+
+	err := launchPlanRepo.Restore(context.Background(), interfaces.Identifier{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+		Version: version,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
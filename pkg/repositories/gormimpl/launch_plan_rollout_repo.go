@@ -0,0 +1,133 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of LaunchPlanRolloutRepoInterface.
+type LaunchPlanRolloutRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getMissingLaunchPlanRolloutError(input interfaces.Identifier) error {
+	return errors.GetMissingEntityError("launch plan rollout", &admin.NamedEntityIdentifier{
+		Project: input.Project,
+		Domain:  input.Domain,
+		Name:    input.Name,
+	})
+}
+
+func (r *LaunchPlanRolloutRepo) Create(ctx context.Context, input models.LaunchPlanRollout) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *LaunchPlanRolloutRepo) Get(ctx context.Context, input interfaces.Identifier) (models.LaunchPlanRollout, error) {
+	var rollout models.LaunchPlanRollout
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.LaunchPlanRollout{
+		LaunchPlanRolloutKey: models.LaunchPlanRolloutKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Take(&rollout)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.LaunchPlanRollout{}, getMissingLaunchPlanRolloutError(input)
+	}
+	if tx.Error != nil {
+		return models.LaunchPlanRollout{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return rollout, nil
+}
+
+func (r *LaunchPlanRolloutRepo) Update(ctx context.Context, input models.LaunchPlanRollout) error {
+	timer := r.metrics.UpdateDuration.Start()
+	// Rolling back clears the previous version, and policies may clear their label or constraint when they change
+	// strategy, so every field is updated even when empty.
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&input).Updates(map[string]interface{}{
+		"strategy":            input.Strategy,
+		"label":               input.Label,
+		"version_constraint":  input.VersionConstraint,
+		"soak_period_seconds": input.SoakPeriodSeconds,
+		"rolled_out_version":  input.RolledOutVersion,
+		"previous_version":    input.PreviousVersion,
+		"rolled_out_at":       input.RolledOutAt,
+		"rejected_versions":   input.RejectedVersions,
+		"principal":           input.Principal,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *LaunchPlanRolloutRepo) Delete(ctx context.Context, input interfaces.Identifier) error {
+	timer := r.metrics.DeleteDuration.Start()
+	// Rollouts are deleted outright rather than soft-deleted, so that their primary key can be registered again.
+	tx := repositoryConfig.WithContext(ctx, r.db).Unscoped().Where(&models.LaunchPlanRollout{
+		LaunchPlanRolloutKey: models.LaunchPlanRolloutKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Delete(&models.LaunchPlanRollout{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingLaunchPlanRolloutError(input)
+	}
+	return nil
+}
+
+func (r *LaunchPlanRolloutRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.LaunchPlanRollout, error) {
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	var rollouts []models.LaunchPlanRollout
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&rollouts)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return rollouts, nil
+}
+
+// Returns an instance of LaunchPlanRolloutRepoInterface
+func NewLaunchPlanRolloutRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.LaunchPlanRolloutRepoInterface {
+	metrics := newMetrics(scope)
+	return &LaunchPlanRolloutRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,102 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of LineageRepoInterface.
+type LineageRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *LineageRepo) CreateArtifacts(ctx context.Context, input []models.TaskExecutionArtifact) error {
+	timer := r.metrics.CreateDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	for _, artifact := range input {
+		if err := tx.Create(&artifact).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *LineageRepo) ListArtifacts(
+	ctx context.Context, input interfaces.ListResourceInput) ([]models.TaskExecutionArtifact, error) {
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	var artifacts []models.TaskExecutionArtifact
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&artifacts)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return artifacts, nil
+}
+
+func (r *LineageRepo) CreateRelationship(ctx context.Context, input models.ExecutionRelationship) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *LineageRepo) ListRelationships(
+	ctx context.Context, input interfaces.ListResourceInput) ([]models.ExecutionRelationship, error) {
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	var relationships []models.ExecutionRelationship
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&relationships)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return relationships, nil
+}
+
+// Returns an instance of LineageRepoInterface
+func NewLineageRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.LineageRepoInterface {
+	metrics := newMetrics(scope)
+	return &LineageRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
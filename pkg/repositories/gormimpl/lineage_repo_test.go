@@ -0,0 +1,134 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateArtifacts(t *testing.T) {
+	lineageRepo := NewLineageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "task_execution_artifacts" ("created_at","execution_project","execution_domain",` +
+		`"execution_name","node_id","retry_attempt","task_project","task_domain","task_name","task_version",` +
+		`"direction","uri") VALUES (?,?,?,?,?,?,?,?,?,?,?,?)`)
+
+	retryAttempt := uint32(0)
+	var artifacts []models.TaskExecutionArtifact
+	for _, direction := range []string{"INPUT", "OUTPUT"} {
+		artifacts = append(artifacts, models.TaskExecutionArtifact{
+			ExecutionProject: project,
+			ExecutionDomain:  domain,
+			ExecutionName:    name,
+			NodeID:           "node",
+			RetryAttempt:     &retryAttempt,
+			TaskProject:      project,
+			TaskDomain:       domain,
+			TaskName:         name,
+			TaskVersion:      version,
+			Direction:        direction,
+			URI:              "s3://bucket/" + direction,
+		})
+	}
+	err := lineageRepo.CreateArtifacts(context.Background(), artifacts)
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListArtifacts(t *testing.T) {
+	lineageRepo := NewLineageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "task_execution_artifacts"  WHERE (execution_project = project) ` +
+		`LIMIT 10 OFFSET 0`).WithReply([]map[string]interface{}{
+		{
+			"id":                1,
+			"execution_project": project,
+			"direction":         "INPUT",
+			"uri":               "s3://bucket/inputs.pb",
+		},
+		{
+			"id":                2,
+			"execution_project": project,
+			"direction":         "OUTPUT",
+			"uri":               "s3://bucket/outputs.pb",
+		},
+	})
+
+	artifacts, err := lineageRepo.ListArtifacts(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.TaskExecutionArtifact, "execution_project", project),
+		},
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, artifacts, 2)
+	assert.Equal(t, "INPUT", artifacts[0].Direction)
+	assert.Equal(t, "s3://bucket/outputs.pb", artifacts[1].URI)
+}
+
+func TestListArtifacts_MissingFilters(t *testing.T) {
+	lineageRepo := NewLineageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := lineageRepo.ListArtifacts(context.Background(), interfaces.ListResourceInput{Limit: 10})
+	assert.EqualError(t, err, "missing and/or invalid parameters: filters")
+}
+
+func TestCreateRelationship(t *testing.T) {
+	lineageRepo := NewLineageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "execution_relationships" ("created_at","execution_project","execution_domain",` +
+		`"execution_name","related_project","related_domain","related_name","kind","node_id") ` +
+		`VALUES (?,?,?,?,?,?,?,?,?)`)
+
+	err := lineageRepo.CreateRelationship(context.Background(), models.ExecutionRelationship{
+		ExecutionProject: project,
+		ExecutionDomain:  domain,
+		ExecutionName:    "child",
+		RelatedProject:   project,
+		RelatedDomain:    domain,
+		RelatedName:      name,
+		Kind:             "PARENT",
+		NodeID:           "node",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListRelationships(t *testing.T) {
+	lineageRepo := NewLineageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "execution_relationships"  WHERE (related_name = name) ` +
+		`LIMIT 10 OFFSET 0`).WithReply([]map[string]interface{}{
+		{
+			"id":             1,
+			"execution_name": "child",
+			"related_name":   name,
+			"kind":           "PARENT",
+		},
+	})
+
+	relationships, err := lineageRepo.ListRelationships(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.ExecutionRelationship, "related_name", name),
+		},
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, relationships, 1)
+	assert.Equal(t, "child", relationships[0].ExecutionName)
+	assert.Equal(t, "PARENT", relationships[0].Kind)
+}
+
+func TestListRelationships_MissingFilters(t *testing.T) {
+	lineageRepo := NewLineageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := lineageRepo.ListRelationships(context.Background(), interfaces.ListResourceInput{Limit: 10})
+	assert.EqualError(t, err, "missing and/or invalid parameters: filters")
+}
@@ -3,12 +3,15 @@ package gormimpl
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"google.golang.org/grpc/codes"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -114,7 +117,7 @@ type NamedEntityRepo struct {
 func (r *NamedEntityRepo) Update(ctx context.Context, input models.NamedEntity) error {
 	timer := r.metrics.UpdateDuration.Start()
 	var metadata models.NamedEntityMetadata
-	tx := r.db.Where(&models.NamedEntityMetadata{
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.NamedEntityMetadata{
 		NamedEntityMetadataKey: models.NamedEntityMetadataKey{
 			ResourceType: input.ResourceType,
 			Project:      input.Project,
@@ -143,7 +146,7 @@ func (r *NamedEntityRepo) Get(ctx context.Context, input interfaces.GetNamedEnti
 		return models.NamedEntity{}, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "Cannot get NamedEntityMetadata for resource type: %v", input.ResourceType)
 	}
 
-	tx := r.db.Table(tableName).Joins(joinString)
+	tx := repositoryConfig.WithContext(ctx, r.db).Table(tableName).Joins(joinString)
 
 	// Apply filters
 	tx, err = applyScopedFilters(tx, filters, nil)
@@ -182,7 +185,7 @@ func (r *NamedEntityRepo) List(ctx context.Context, input interfaces.ListNamedEn
 			"Cannot list entity names for resource type: %v", input.ResourceType)
 	}
 
-	tx := getSubQueryJoin(r.db, tableName, input)
+	tx := getSubQueryJoin(repositoryConfig.WithContext(ctx, r.db), tableName, input)
 
 	// Apply filters
 	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
@@ -211,6 +214,244 @@ func (r *NamedEntityRepo) List(ctx context.Context, input interfaces.ListNamedEn
 	}, nil
 }
 
+// Escapes the wildcards in a search query so that it's matched literally by LIKE.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// Ranks entities named exactly as searched for first, followed by those whose names start with the query, those whose
+// names contain it and finally those whose descriptions contain it.
+const searchRank = "CASE WHEN LOWER(entities.name) = ? THEN 1.0 " +
+	"WHEN LOWER(entities.name) LIKE ? ESCAPE '\\' THEN 0.75 " +
+	"WHEN LOWER(entities.name) LIKE ? ESCAPE '\\' THEN 0.5 ELSE 0.25 END"
+
+const searchMatch = "LOWER(entities.name) LIKE ? ESCAPE '\\' OR " +
+	"LOWER(named_entity_metadata.description) LIKE ? ESCAPE '\\'"
+
+// On postgres, names and descriptions similar to the query are matched too, using the trigram indexes created for
+// them. Descriptions are weighted lower than names, as they are for substring matches.
+const trigramSearchRank = "GREATEST(%s, similarity(entities.name, ?), " +
+	"similarity(COALESCE(named_entity_metadata.description, ''), ?) * 0.5)"
+
+const trigramSearchMatch = "%s OR entities.name %% ? OR named_entity_metadata.description %% ?"
+
+// Searches the distinct names of an entity table, joined with their metadata. Only active entities are returned,
+// consistent with the default when listing named entities. Columns are aliased so that the union of the queries for
+// each resource type can be ordered by them.
+const searchQuery = "SELECT entities.project AS project, entities.domain AS domain, entities.name AS name, " +
+	"%[1]d AS resource_type, named_entity_metadata.description AS description, named_entity_metadata.state AS state, " +
+	"%[3]s AS rank " +
+	"FROM (SELECT DISTINCT project, domain, name FROM %[2]s WHERE project = ? AND domain = ? AND deleted_at IS NULL) " +
+	"AS entities LEFT JOIN named_entity_metadata ON named_entity_metadata.resource_type = %[1]d AND " +
+	"named_entity_metadata.project = entities.project AND named_entity_metadata.domain = entities.domain AND " +
+	"named_entity_metadata.name = entities.name " +
+	"WHERE COALESCE(named_entity_metadata.state, 0) = ? AND (%[4]s)"
+
+var searchResourceTypes = []core.ResourceType{
+	core.ResourceType_WORKFLOW,
+	core.ResourceType_TASK,
+	core.ResourceType_LAUNCH_PLAN,
+}
+
+// Returns the query and args searching the given resource types, ordered from the closest match.
+func getSearchQuery(dialect string, input interfaces.SearchNamedEntityInput) (string, []interface{}, error) {
+	resourceTypes := input.ResourceTypes
+	if len(resourceTypes) == 0 {
+		resourceTypes = searchResourceTypes
+	}
+	query := strings.ToLower(input.Query)
+	escaped := likeEscaper.Replace(query)
+	rank, match := searchRank, searchMatch
+	rankArgs := []interface{}{query, escaped + "%", "%" + escaped + "%"}
+	matchArgs := []interface{}{"%" + escaped + "%", "%" + escaped + "%"}
+	if dialect == repositoryConfig.Postgres {
+		rank, match = fmt.Sprintf(trigramSearchRank, rank), fmt.Sprintf(trigramSearchMatch, match)
+		rankArgs = append(rankArgs, input.Query, input.Query)
+		matchArgs = append(matchArgs, input.Query, input.Query)
+	}
+
+	queries := make([]string, len(resourceTypes))
+	args := make([]interface{}, 0)
+	for i, resourceType := range resourceTypes {
+		tableName, ok := resourceTypeToTableName[resourceType]
+		if !ok {
+			return "", nil, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"Cannot search entity names for resource type: %v", resourceType)
+		}
+		queries[i] = fmt.Sprintf(searchQuery, resourceType, tableName, rank, match)
+		args = append(args, rankArgs...)
+		args = append(args, input.Project, input.Domain, int32(admin.NamedEntityState_NAMED_ENTITY_ACTIVE))
+		args = append(args, matchArgs...)
+	}
+	return strings.Join(queries, " UNION ALL ") + " ORDER BY rank DESC, name asc, resource_type asc LIMIT ?",
+		append(args, input.Limit), nil
+}
+
+func (r *NamedEntityRepo) Search(ctx context.Context, input interfaces.SearchNamedEntityInput) (
+	[]interfaces.NamedEntitySearchResult, error) {
+	if len(input.Project) == 0 {
+		return nil, errors.GetInvalidInputError(Project)
+	}
+	if len(input.Domain) == 0 {
+		return nil, errors.GetInvalidInputError(Domain)
+	}
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	db := repositoryConfig.WithContext(ctx, r.db)
+	query, args, err := getSearchQuery(db.Dialect().GetName(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]interfaces.NamedEntitySearchResult, 0)
+	timer := r.metrics.ListDuration.Start()
+	tx := db.Raw(query, args...).Scan(&results)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return results, nil
+}
+
+func (r *NamedEntityRepo) GetDescription(
+	ctx context.Context, input interfaces.GetNamedEntityInput) (models.NamedEntityDescription, error) {
+	var description models.NamedEntityDescription
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.NamedEntityDescription{
+		ResourceType: input.ResourceType,
+		Project:      input.Project,
+		Domain:       input.Domain,
+		Name:         input.Name,
+	}).Take(&description)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.NamedEntityDescription{}, adminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"no description of %s [%s/%s/%s] exists", input.ResourceType, input.Project, input.Domain, input.Name)
+	}
+	if tx.Error != nil {
+		return models.NamedEntityDescription{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return description, nil
+}
+
+func (r *NamedEntityRepo) UpdateDescription(ctx context.Context, input models.NamedEntityDescription) error {
+	var description models.NamedEntityDescription
+	timer := r.metrics.UpdateDuration.Start()
+	// Fields are assigned as a map so that they can be cleared.
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.NamedEntityDescription{
+		ResourceType: input.ResourceType,
+		Project:      input.Project,
+		Domain:       input.Domain,
+		Name:         input.Name,
+	}).Assign(map[string]interface{}{
+		"long_description": input.LongDescription,
+		"source_link":      input.SourceLink,
+		"owners":           input.Owners,
+	}).FirstOrCreate(&description)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+const namedEntityTagTableName = "named_entity_tags"
+
+// Matches the tags of a single named entity.
+const namedEntityTagQuery = "resource_type = ? AND project = ? AND domain = ? AND name = ?"
+
+func getNamedEntityTagArgs(input interfaces.GetNamedEntityInput) []interface{} {
+	return []interface{}{input.ResourceType, input.Project, input.Domain, input.Name}
+}
+
+func (r *NamedEntityRepo) AddTags(ctx context.Context, input interfaces.GetNamedEntityInput, tags []string) error {
+	timer := r.metrics.CreateDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	for _, tag := range tags {
+		namedEntityTag := models.NamedEntityTag{
+			ResourceType: input.ResourceType,
+			Project:      input.Project,
+			Domain:       input.Domain,
+			Name:         input.Name,
+			Tag:          tag,
+		}
+		if err := tx.Where(namedEntityTag).FirstOrCreate(&models.NamedEntityTag{}).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *NamedEntityRepo) RemoveTags(ctx context.Context, input interfaces.GetNamedEntityInput, tags []string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(namedEntityTagQuery, getNamedEntityTagArgs(input)...).Where(
+		"tag IN (?)", tags).Delete(&models.NamedEntityTag{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NamedEntityRepo) ListTags(ctx context.Context, input interfaces.GetNamedEntityInput) ([]string, error) {
+	tags := make([]string, 0)
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.NamedEntityTag{}).Where(
+		namedEntityTagQuery, getNamedEntityTagArgs(input)...).Order("tag asc").Pluck("tag", &tags)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tags, nil
+}
+
+// Joins tagged entities with their metadata, which not every entity has.
+var leftJoinTagToMetadata = fmt.Sprintf(
+	"LEFT JOIN %[1]s ON %[1]s.resource_type = %[2]s.resource_type AND %[1]s.project = %[2]s.project AND "+
+		"%[1]s.domain = %[2]s.domain AND %[1]s.name = %[2]s.name", namedEntityMetadataTableName,
+	namedEntityTagTableName)
+
+func (r *NamedEntityRepo) ListByTag(ctx context.Context, input interfaces.ListNamedEntityByTagInput) (
+	interfaces.NamedEntityCollectionOutput, error) {
+	if len(input.Tag) == 0 {
+		return interfaces.NamedEntityCollectionOutput{}, errors.GetInvalidInputError("tag")
+	}
+	if input.Limit == 0 {
+		return interfaces.NamedEntityCollectionOutput{}, errors.GetInvalidInputError(limit)
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Table(namedEntityTagTableName).Select([]string{
+		fmt.Sprintf("%s.%s", namedEntityTagTableName, ResourceType),
+		fmt.Sprintf("%s.%s", namedEntityTagTableName, Project),
+		fmt.Sprintf("%s.%s", namedEntityTagTableName, Domain),
+		fmt.Sprintf("%s.%s", namedEntityTagTableName, Name),
+		fmt.Sprintf("%s.%s", namedEntityMetadataTableName, Description),
+		fmt.Sprintf("%s.%s", namedEntityMetadataTableName, State),
+	}).Joins(leftJoinTagToMetadata).Where(fmt.Sprintf("%s.tag = ?", namedEntityTagTableName), input.Tag)
+	if len(input.ResourceTypes) > 0 {
+		tx = tx.Where(fmt.Sprintf("%s.resource_type IN (?)", namedEntityTagTableName), input.ResourceTypes)
+	}
+	if input.Projects != nil {
+		tx = tx.Where(fmt.Sprintf("%s.project IN (?)", namedEntityTagTableName), input.Projects)
+	}
+	tx = tx.Order(fmt.Sprintf("%[1]s.project asc, %[1]s.domain asc, %[1]s.name asc, %[1]s.resource_type asc",
+		namedEntityTagTableName)).Limit(input.Limit).Offset(input.Offset)
+
+	entities := make([]models.NamedEntity, 0)
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Scan(&entities)
+	timer.Stop()
+	if tx.Error != nil {
+		return interfaces.NamedEntityCollectionOutput{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return interfaces.NamedEntityCollectionOutput{
+		Entities: entities,
+	}, nil
+}
+
 // Returns an instance of NamedEntityRepoInterface
 func NewNamedEntityRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.NamedEntityRepoInterface {
@@ -2,18 +2,22 @@ package gormimpl
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 
 	mocket "github.com/Selvatico/go-mocket"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	mockScope "github.com/flyteorg/flytestdlib/promutils"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 func getMockNamedEntityResponseFromDb(expected models.NamedEntity) map[string]interface{} {
@@ -176,3 +180,222 @@ func TestListNamedEntity(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, output.Entities, 1)
 }
+
+func TestGetSearchQuery(t *testing.T) {
+	query, args, err := getSearchQuery("sqlite3", interfaces.SearchNamedEntityInput{
+		Project:       project,
+		Domain:        domain,
+		Query:         "My_Flow",
+		ResourceTypes: []core.ResourceType{core.ResourceType_TASK},
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT entities.project AS project, entities.domain AS domain, entities.name AS name, 1 AS resource_type, named_entity_metadata.description AS description, named_entity_metadata.state AS state, CASE WHEN LOWER(entities.name) = ? THEN 1.0 WHEN LOWER(entities.name) LIKE ? ESCAPE '\' THEN 0.75 WHEN LOWER(entities.name) LIKE ? ESCAPE '\' THEN 0.5 ELSE 0.25 END AS rank FROM (SELECT DISTINCT project, domain, name FROM tasks WHERE project = ? AND domain = ? AND deleted_at IS NULL) AS entities LEFT JOIN named_entity_metadata ON named_entity_metadata.resource_type = 1 AND named_entity_metadata.project = entities.project AND named_entity_metadata.domain = entities.domain AND named_entity_metadata.name = entities.name WHERE COALESCE(named_entity_metadata.state, 0) = ? AND (LOWER(entities.name) LIKE ? ESCAPE '\' OR LOWER(named_entity_metadata.description) LIKE ? ESCAPE '\') ORDER BY rank DESC, name asc, resource_type asc LIMIT ?`, query)
+	assert.Equal(t, []interface{}{"my_flow", `my\_flow%`, `%my\_flow%`, project, domain, int32(0),
+		`%my\_flow%`, `%my\_flow%`, 10}, args)
+}
+
+func TestGetSearchQuery_Postgres(t *testing.T) {
+	query, args, err := getSearchQuery("postgres", interfaces.SearchNamedEntityInput{
+		Project: project,
+		Domain:  domain,
+		Query:   "Flow",
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	// All of the searchable resource types are searched by default.
+	assert.Equal(t, 2, strings.Count(query, " UNION ALL "))
+	assert.Contains(t, query, "GREATEST(CASE")
+	assert.Contains(t, query, "similarity(entities.name, ?)")
+	assert.Contains(t, query, "OR entities.name % ? OR named_entity_metadata.description % ?)")
+	assert.Len(t, args, 3*(5+3+4)+1)
+	assert.Equal(t, []interface{}{"flow", "flow%", "%flow%", "Flow", "Flow", project, domain, int32(0),
+		"%flow%", "%flow%", "Flow", "Flow"}, args[:12])
+}
+
+func TestGetSearchQuery_InvalidResourceType(t *testing.T) {
+	_, _, err := getSearchQuery("postgres", interfaces.SearchNamedEntityInput{
+		Project:       project,
+		Domain:        domain,
+		Query:         "flow",
+		ResourceTypes: []core.ResourceType{core.ResourceType_DATASET},
+		Limit:         10,
+	})
+	assert.EqualError(t, err, "Cannot search entity names for resource type: DATASET")
+}
+
+func TestSearchNamedEntity(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	metadata := getMockNamedEntityResponseFromDb(models.NamedEntity{
+		NamedEntityKey: models.NamedEntityKey{
+			ResourceType: resourceType,
+			Project:      project,
+			Domain:       domain,
+			Name:         name,
+		},
+		NamedEntityMetadataFields: models.NamedEntityMetadataFields{
+			Description: description,
+		},
+	})
+	metadata["rank"] = 0.75
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`FROM (SELECT DISTINCT project, domain, name FROM workflows WHERE project =`).WithReply([]map[string]interface{}{metadata})
+
+	output, err := metadataRepo.Search(context.Background(), interfaces.SearchNamedEntityInput{
+		Project: project,
+		Domain:  domain,
+		Query:   name,
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output, 1)
+	assert.Equal(t, name, output[0].Name)
+	assert.Equal(t, description, output[0].Description)
+	assert.Equal(t, 0.75, output[0].Rank)
+}
+
+func TestSearchNamedEntity_InvalidInput(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	_, err := metadataRepo.Search(context.Background(), interfaces.SearchNamedEntityInput{
+		Domain: domain,
+		Query:  name,
+		Limit:  10,
+	})
+	assert.EqualError(t, err, "missing and/or invalid parameters: project")
+
+	_, err = metadataRepo.Search(context.Background(), interfaces.SearchNamedEntityInput{
+		Project: project,
+		Domain:  domain,
+		Query:   name,
+	})
+	assert.EqualError(t, err, "missing and/or invalid parameters: limit")
+}
+
+func TestGetNamedEntityDescription(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "named_entity_descriptions"  WHERE "named_entity_descriptions"."deleted_at" IS NULL AND ` +
+			`(("named_entity_descriptions"."resource_type" = 2) AND ("named_entity_descriptions"."project" = project)`).
+		WithReply([]map[string]interface{}{
+			{
+				"resource_type":    resourceType,
+				"project":          project,
+				"domain":           domain,
+				"name":             name,
+				"long_description": "# Workflow",
+				"source_link":      "https://github.com/flyteorg/flytesnacks",
+				"owners":           []byte(`[{"name":"owner"}]`),
+			},
+		})
+
+	output, err := metadataRepo.GetDescription(context.Background(), interfaces.GetNamedEntityInput{
+		ResourceType: resourceType,
+		Project:      project,
+		Domain:       domain,
+		Name:         name,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "# Workflow", output.LongDescription)
+	assert.Equal(t, "https://github.com/flyteorg/flytesnacks", output.SourceLink)
+	assert.Equal(t, []byte(`[{"name":"owner"}]`), output.Owners)
+}
+
+func TestGetNamedEntityDescription_NotFound(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := metadataRepo.GetDescription(context.Background(), interfaces.GetNamedEntityInput{
+		ResourceType: resourceType,
+		Project:      project,
+		Domain:       domain,
+		Name:         name,
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestUpdateNamedEntityDescription_CreateNew(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	mockQuery := GlobalMock.NewMock()
+	mockQuery.WithQuery(`INSERT INTO "named_entity_descriptions"`)
+
+	err := metadataRepo.UpdateDescription(context.Background(), models.NamedEntityDescription{
+		ResourceType:    resourceType,
+		Project:         project,
+		Domain:          domain,
+		Name:            name,
+		LongDescription: "# Workflow",
+		Owners:          []byte("[]"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, mockQuery.Triggered)
+}
+
+func TestListNamedEntityTags(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	mockQuery := GlobalMock.NewMock()
+	mockQuery.WithQuery(`SELECT tag FROM "named_entity_tags"`).WithReply([]map[string]interface{}{
+		{"tag": "team:growth"},
+		{"tag": "tier:critical"},
+	})
+
+	tags, err := metadataRepo.ListTags(context.Background(), interfaces.GetNamedEntityInput{
+		ResourceType: resourceType,
+		Project:      project,
+		Domain:       domain,
+		Name:         name,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"team:growth", "tier:critical"}, tags)
+}
+
+func TestListNamedEntityByTag(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	mockQuery := GlobalMock.NewMock()
+	mockQuery.WithQuery(`FROM "named_entity_tags" LEFT JOIN named_entity_metadata ON ` +
+		`named_entity_metadata.resource_type = named_entity_tags.resource_type`).WithReply(
+		[]map[string]interface{}{
+			getMockNamedEntityResponseFromDb(models.NamedEntity{
+				NamedEntityKey: models.NamedEntityKey{
+					ResourceType: resourceType,
+					Project:      project,
+					Domain:       domain,
+					Name:         name,
+				},
+				NamedEntityMetadataFields: models.NamedEntityMetadataFields{
+					Description: description,
+				},
+			}),
+		})
+
+	output, err := metadataRepo.ListByTag(context.Background(), interfaces.ListNamedEntityByTagInput{
+		Tag:           "team:growth",
+		ResourceTypes: []core.ResourceType{resourceType},
+		Projects:      []string{project},
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, mockQuery.Triggered)
+	assert.Len(t, output.Entities, 1)
+	assert.Equal(t, description, output.Entities[0].Description)
+}
+
+func TestListNamedEntityByTag_InvalidInput(t *testing.T) {
+	metadataRepo := NewNamedEntityRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := metadataRepo.ListByTag(context.Background(), interfaces.ListNamedEntityByTagInput{
+		Limit: 10,
+	})
+	assert.NotNil(t, err)
+	_, err = metadataRepo.ListByTag(context.Background(), interfaces.ListNamedEntityByTagInput{
+		Tag: "team:growth",
+	})
+	assert.NotNil(t, err)
+}
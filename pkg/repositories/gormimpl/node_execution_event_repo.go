@@ -3,6 +3,7 @@ package gormimpl
 import (
 	"context"
 
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -18,7 +19,7 @@ type NodeExecutionEventRepo struct {
 
 func (r *NodeExecutionEventRepo) Create(ctx context.Context, input models.NodeExecutionEvent) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&input)
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -26,6 +27,22 @@ func (r *NodeExecutionEventRepo) Create(ctx context.Context, input models.NodeEx
 	return nil
 }
 
+func (r *NodeExecutionEventRepo) BatchCreate(ctx context.Context, input []models.NodeExecutionEvent) error {
+	timer := r.metrics.CreateDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	for _, event := range input {
+		if err := tx.Create(&event).Error; err != nil {
+			tx.Rollback()
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
 // Returns an instance of NodeExecutionRepoInterface
 func NewNodeExecutionEventRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.NodeExecutionEventRepoInterface {
@@ -34,3 +34,31 @@ func TestCreateNodeExecutionEvent(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, nodeExecutionEventQuery.Triggered)
 }
+
+func TestBatchCreateNodeExecutionEvents(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	nodeExecutionEventQuery := GlobalMock.NewMock()
+	nodeExecutionEventQuery.WithQuery(`INSERT INTO "node_execution_events" ("created_at","updated_at",` +
+		`"deleted_at","execution_project","execution_domain","execution_name","node_id","request_id","occurred_at",` +
+		`"phase") VALUES (?,?,?,?,?,?,?,?,?,?)`)
+	nodeExecEventRepo := NewNodeExecutionEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	var events []models.NodeExecutionEvent
+	for _, nodeID := range []string{"1", "2"} {
+		events = append(events, models.NodeExecutionEvent{
+			NodeExecutionKey: models.NodeExecutionKey{
+				NodeID: nodeID,
+				ExecutionKey: models.ExecutionKey{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "1",
+				},
+			},
+			RequestID:  "xxyzz",
+			Phase:      nodePhase,
+			OccurredAt: nodeStartedAt,
+		})
+	}
+	err := nodeExecEventRepo.BatchCreate(context.Background(), events)
+	assert.NoError(t, err)
+	assert.True(t, nodeExecutionEventQuery.Triggered)
+}
@@ -0,0 +1,104 @@
+package gormimpl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of NotificationDeliveryRepoInterface.
+type NotificationDeliveryRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *NotificationDeliveryRepo) Create(ctx context.Context, input models.NotificationDelivery) (uint, error) {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return 0, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return input.ID, nil
+}
+
+func (r *NotificationDeliveryRepo) Get(ctx context.Context, id uint) (models.NotificationDelivery, error) {
+	var delivery models.NotificationDelivery
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where("id = ?", id).Take(&delivery)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.NotificationDelivery{}, errors.GetMissingEntityByIDError("notification delivery")
+	}
+	if tx.Error != nil {
+		return models.NotificationDelivery{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return delivery, nil
+}
+
+func (r *NotificationDeliveryRepo) RecordAttempt(ctx context.Context, id uint, status string, lastError string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.NotificationDelivery{}).Where("id = ?", id).Updates(
+		map[string]interface{}{
+			"status":     status,
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": lastError,
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationDeliveryRepo) MarkPending(ctx context.Context, id uint) (bool, error) {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.NotificationDelivery{}).Where(
+		"id = ? AND status = ?", id, common.DeliveryFailed).Update("status", common.DeliveryPending)
+	timer.Stop()
+	if tx.Error != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+func (r *NotificationDeliveryRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) ([]models.NotificationDelivery, error) {
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	var deliveries []models.NotificationDelivery
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&deliveries)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return deliveries, nil
+}
+
+// Returns an instance of NotificationDeliveryRepoInterface
+func NewNotificationDeliveryRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.NotificationDeliveryRepoInterface {
+	metrics := newMetrics(scope)
+	return &NotificationDeliveryRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
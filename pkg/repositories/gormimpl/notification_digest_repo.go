@@ -0,0 +1,107 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of NotificationDigestRepoInterface.
+type NotificationDigestRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *NotificationDigestRepo) Create(ctx context.Context, input models.NotificationDigestEntry) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationDigestRepo) ListDue(
+	ctx context.Context, dueBefore time.Time, limit int) ([]models.NotificationDigestEntry, error) {
+	var entries []models.NotificationDigestEntry
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where("due_at <= ?", dueBefore).Where(
+		leaseExpiredQuery, dueBefore).Order("group_key, occurred_at, id").Limit(limit).Find(&entries)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return entries, nil
+}
+
+func (r *NotificationDigestRepo) Claim(
+	ctx context.Context, input interfaces.ClaimNotificationDigestEntriesInput) ([]models.NotificationDigestEntry, error) {
+	if len(input.IDs) == 0 {
+		return []models.NotificationDigestEntry{}, nil
+	}
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	// As with outbox messages, the lease condition is checked again as each entry is updated, so only one sender
+	// wins each entry, and the winner finds the entries it leased by its lease id.
+	leaseID := uuid.New().String()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.NotificationDigestEntry{}).Where(
+		"id IN (?)", input.IDs).Where(leaseExpiredQuery, input.Now).Updates(map[string]interface{}{
+		"lease_id":     leaseID,
+		"leased_until": input.LeasedUntil,
+	})
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	var entries []models.NotificationDigestEntry
+	tx = repositoryConfig.WithContext(ctx, r.db).Where(&models.NotificationDigestEntry{
+		LeaseID: leaseID,
+	}).Order("group_key, occurred_at, id").Find(&entries)
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return entries, nil
+}
+
+func (r *NotificationDigestRepo) Release(ctx context.Context, leaseID string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.NotificationDigestEntry{}).Where(
+		"lease_id = ?", leaseID).Updates(map[string]interface{}{
+		"leased_until": nil,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationDigestRepo) Delete(ctx context.Context, leaseID string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where("lease_id = ?", leaseID).Delete(
+		&models.NotificationDigestEntry{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Returns an instance of NotificationDigestRepoInterface
+func NewNotificationDigestRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.NotificationDigestRepoInterface {
+	metrics := newMetrics(scope)
+	return &NotificationDigestRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,133 @@
+package gormimpl
+
+import (
+	"context"
+	"fmt"
+
+	adminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Implementation of NotificationSubscriptionRepoInterface.
+type NotificationSubscriptionRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *NotificationSubscriptionRepo) Create(ctx context.Context, input models.NotificationSubscription) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationSubscriptionRepo) Get(ctx context.Context, input models.NotificationSubscriptionKey) (
+	models.NotificationSubscription, error) {
+	var subscription models.NotificationSubscription
+	timer := r.metrics.GetDuration.Start()
+	// Empty launch plans are matched explicitly, since gorm ignores zero values in struct conditions.
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(map[string]interface{}{
+		Project:       input.Project,
+		Domain:        input.Domain,
+		"launch_plan": input.LaunchPlan,
+		"type":        input.Type,
+		"address":     input.Address,
+	}).Take(&subscription)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.NotificationSubscription{}, adminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"%s subscription of [%s] to [%s/%s/%s] does not exist", input.Type, input.Address, input.Project,
+			input.Domain, input.LaunchPlan)
+	}
+	if tx.Error != nil {
+		return models.NotificationSubscription{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return subscription, nil
+}
+
+func (r *NotificationSubscriptionRepo) Update(ctx context.Context, input models.NotificationSubscription) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.NotificationSubscription{ID: input.ID}).Updates(
+		map[string]interface{}{
+			"phases":    input.Phases,
+			"principal": input.Principal,
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *NotificationSubscriptionRepo) Delete(ctx context.Context, project, domain string, id uint) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(fmt.Sprintf("id = ? AND %s = ? AND %s = ?", Project, Domain),
+		id, project, domain).Delete(&models.NotificationSubscription{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return adminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"subscription [%d] does not exist in [%s/%s]", id, project, domain)
+	}
+	return nil
+}
+
+func (r *NotificationSubscriptionRepo) List(
+	ctx context.Context, input interfaces.ListResourceInput) ([]models.NotificationSubscription, error) {
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	var subscriptions []models.NotificationSubscription
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&subscriptions)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return subscriptions, nil
+}
+
+func (r *NotificationSubscriptionRepo) ListForLaunchPlan(ctx context.Context, project, domain, launchPlan string) (
+	[]models.NotificationSubscription, error) {
+	var subscriptions []models.NotificationSubscription
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(
+		fmt.Sprintf("%s = ? AND %s = ? AND launch_plan IN (?)", Project, Domain),
+		project, domain, []string{"", launchPlan}).Order("id").Find(&subscriptions)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return subscriptions, nil
+}
+
+// Returns an instance of NotificationSubscriptionRepoInterface
+func NewNotificationSubscriptionRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.NotificationSubscriptionRepoInterface {
+	metrics := newMetrics(scope)
+	return &NotificationSubscriptionRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
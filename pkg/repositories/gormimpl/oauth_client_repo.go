@@ -0,0 +1,103 @@
+package gormimpl
+
+import (
+	"context"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Implementation of OAuthClientRepoInterface.
+type OAuthClientRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getMissingOAuthClientError(clientID string) error {
+	return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "oauth client [%s] doesn't exist", clientID)
+}
+
+func (r *OAuthClientRepo) Create(ctx context.Context, input models.OAuthClient) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OAuthClientRepo) Get(ctx context.Context, clientID string) (models.OAuthClient, error) {
+	var client models.OAuthClient
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.OAuthClient{ClientID: clientID}).Take(&client)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.OAuthClient{}, getMissingOAuthClientError(clientID)
+	}
+	if tx.Error != nil {
+		return models.OAuthClient{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return client, nil
+}
+
+func (r *OAuthClientRepo) UpdateSecret(ctx context.Context, clientID string, secretHash []byte) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.OAuthClient{}).Where(
+		&models.OAuthClient{ClientID: clientID}).Update("secret_hash", secretHash)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingOAuthClientError(clientID)
+	}
+	return nil
+}
+
+func (r *OAuthClientRepo) Delete(ctx context.Context, clientID string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.OAuthClient{ClientID: clientID}).Delete(
+		&models.OAuthClient{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingOAuthClientError(clientID)
+	}
+	return nil
+}
+
+func (r *OAuthClientRepo) List(ctx context.Context, input interfaces.ListOAuthClientsInput) ([]models.OAuthClient, error) {
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	var clients []models.OAuthClient
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.OAuthClient{Owner: input.Owner}).Order(
+		"client_id asc").Limit(input.Limit).Offset(input.Offset).Find(&clients)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return clients, nil
+}
+
+// Returns an instance of OAuthClientRepoInterface
+func NewOAuthClientRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.OAuthClientRepoInterface {
+	metrics := newMetrics(scope)
+	return &OAuthClientRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
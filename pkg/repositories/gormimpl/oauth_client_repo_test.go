@@ -0,0 +1,71 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOAuthClient(t *testing.T) {
+	oauthClientRepo := NewOAuthClientRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "oauth_clients"`).WithReply([]map[string]interface{}{
+		{
+			"client_id":   "etl-bot",
+			"owner":       "jane",
+			"grant_types": "client_credentials",
+			"scopes":      "all",
+		},
+	})
+
+	client, err := oauthClientRepo.Get(context.Background(), "etl-bot")
+	assert.NoError(t, err)
+	assert.Equal(t, "etl-bot", client.ClientID)
+	assert.Equal(t, "jane", client.Owner)
+	assert.Equal(t, "client_credentials", client.GrantTypes)
+}
+
+func TestGetOAuthClient_NotFound(t *testing.T) {
+	oauthClientRepo := NewOAuthClientRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := oauthClientRepo.Get(context.Background(), "etl-bot")
+	assert.EqualError(t, err, "oauth client [etl-bot] doesn't exist")
+}
+
+func TestUpdateOAuthClientSecret_NotFound(t *testing.T) {
+	oauthClientRepo := NewOAuthClientRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "oauth_clients"`).WithRowsNum(0)
+
+	err := oauthClientRepo.UpdateSecret(context.Background(), "etl-bot", []byte("hash"))
+	assert.EqualError(t, err, "oauth client [etl-bot] doesn't exist")
+}
+
+func TestListOAuthClients(t *testing.T) {
+	oauthClientRepo := NewOAuthClientRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`ORDER BY client_id asc LIMIT 10 OFFSET 0`).WithReply([]map[string]interface{}{
+		{"client_id": "alerts-bot", "owner": "jane"},
+		{"client_id": "etl-bot", "owner": "jane"},
+	})
+
+	clients, err := oauthClientRepo.List(context.Background(), interfaces.ListOAuthClientsInput{
+		Owner: "jane",
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, clients, 2)
+	assert.Equal(t, "alerts-bot", clients[0].ClientID)
+}
+
+func TestListOAuthClients_MissingLimit(t *testing.T) {
+	oauthClientRepo := NewOAuthClientRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := oauthClientRepo.List(context.Background(), interfaces.ListOAuthClientsInput{Owner: "jane"})
+	assert.EqualError(t, err, "missing and/or invalid parameters: limit")
+}
@@ -0,0 +1,81 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+const offloadedLiteralTableName = "offloaded_literals"
+
+// Soft-deleted executions still reference their inputs, since they can be restored, so deleted executions are joined
+// as well.
+const offloadedLiteralExecutionJoin = "LEFT JOIN executions ON " +
+	"executions.execution_project = offloaded_literals.execution_project AND " +
+	"executions.execution_domain = offloaded_literals.execution_domain AND " +
+	"executions.execution_name = offloaded_literals.execution_name"
+
+// Implementation of OffloadedLiteralRepoInterface.
+type OffloadedLiteralRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *OffloadedLiteralRepo) Create(ctx context.Context, input models.OffloadedLiteral) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OffloadedLiteralRepo) ListCollectable(
+	ctx context.Context, input interfaces.ListCollectableOffloadedLiteralsInput) (
+	[]models.CollectableOffloadedLiteral, error) {
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	var literals []models.CollectableOffloadedLiteral
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Table(offloadedLiteralTableName).Select(
+		"offloaded_literals.*, executions.execution_name IS NULL AS orphaned").Joins(
+		offloadedLiteralExecutionJoin).Where("offloaded_literals.created_at < ?", input.CreatedBefore).Order(
+		"offloaded_literals.id asc").Limit(input.Limit).Scan(&literals)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return literals, nil
+}
+
+func (r *OffloadedLiteralRepo) Delete(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where("id IN (?)", ids).Delete(&models.OffloadedLiteral{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Returns an instance of OffloadedLiteralRepoInterface
+func NewOffloadedLiteralRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.OffloadedLiteralRepoInterface {
+	metrics := newMetrics(scope)
+	return &OffloadedLiteralRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,73 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateOffloadedLiteral(t *testing.T) {
+	literalRepo := NewOffloadedLiteralRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	insertQuery := GlobalMock.NewMock()
+	insertQuery.WithQuery(`INSERT  INTO "offloaded_literals"`)
+
+	err := literalRepo.Create(context.Background(), models.OffloadedLiteral{
+		ExecutionProject: project,
+		ExecutionDomain:  domain,
+		ExecutionName:    name,
+		URI:              "s3://bucket/metadata/project/domain/name/inputs",
+	})
+	assert.NoError(t, err)
+	assert.True(t, insertQuery.Triggered)
+}
+
+func TestListCollectableOffloadedLiterals(t *testing.T) {
+	literalRepo := NewOffloadedLiteralRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT offloaded_literals.*, executions.execution_name IS NULL AS orphaned ` +
+		`FROM "offloaded_literals" LEFT JOIN executions ON ` +
+		`executions.execution_project = offloaded_literals.execution_project AND ` +
+		`executions.execution_domain = offloaded_literals.execution_domain AND ` +
+		`executions.execution_name = offloaded_literals.execution_name ` +
+		`WHERE (offloaded_literals.created_at < 2021-11-11 00:00:00 +0000 UTC) ` +
+		`ORDER BY offloaded_literals.id asc LIMIT 10`).WithReply([]map[string]interface{}{
+		{"id": 1, "execution_name": "orphan", "uri": "s3://bucket/orphan", "orphaned": true},
+		{"id": 2, "execution_name": name, "uri": "s3://bucket/name", "orphaned": false},
+	})
+
+	literals, err := literalRepo.ListCollectable(context.Background(), interfaces.ListCollectableOffloadedLiteralsInput{
+		CreatedBefore: time.Date(2021, time.November, 11, 0, 0, 0, 0, time.UTC),
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, literals, 2)
+	assert.Equal(t, uint(1), literals[0].ID)
+	assert.Equal(t, "s3://bucket/orphan", literals[0].URI)
+	assert.True(t, literals[0].Orphaned)
+	assert.Equal(t, name, literals[1].ExecutionName)
+	assert.False(t, literals[1].Orphaned)
+}
+
+func TestListCollectableOffloadedLiterals_MissingLimit(t *testing.T) {
+	literalRepo := NewOffloadedLiteralRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := literalRepo.ListCollectable(context.Background(), interfaces.ListCollectableOffloadedLiteralsInput{})
+	assert.EqualError(t, err, "missing and/or invalid parameters: limit")
+}
+
+func TestDeleteOffloadedLiterals(t *testing.T) {
+	literalRepo := NewOffloadedLiteralRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	deleteQuery := GlobalMock.NewMock()
+	deleteQuery.WithQuery(`DELETE FROM "offloaded_literals"  WHERE (id IN (?,?))`)
+
+	assert.NoError(t, literalRepo.Delete(context.Background(), []uint{1, 2}))
+	assert.True(t, deleteQuery.Triggered)
+}
@@ -0,0 +1,136 @@
+package gormimpl
+
+import (
+	"context"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Implementation of OrgRepoInterface.
+type OrgRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getMissingOrgError(id string) error {
+	return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "org [%s] not found", id)
+}
+
+func (r *OrgRepo) Create(ctx context.Context, input models.Org) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OrgRepo) Get(ctx context.Context, id string) (models.Org, error) {
+	var org models.Org
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Org{
+		Identifier: id,
+	}).Take(&org)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.Org{}, getMissingOrgError(id)
+	}
+	if tx.Error != nil {
+		return models.Org{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return org, nil
+}
+
+func (r *OrgRepo) Update(ctx context.Context, input models.Org) error {
+	timer := r.metrics.UpdateDuration.Start()
+	// The description may be cleared, so every column is updated rather than the non-empty fields.
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.Org{}).Where(&models.Org{
+		Identifier: input.Identifier,
+	}).Updates(map[string]interface{}{
+		"name":        input.Name,
+		"description": input.Description,
+		"principal":   input.Principal,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingOrgError(input.Identifier)
+	}
+	return nil
+}
+
+func (r *OrgRepo) Delete(ctx context.Context, id string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	defer timer.Stop()
+	// The projects of the org are counted in the same transaction, so that projects moved into the org meanwhile
+	// aren't left in an org which no longer exists.
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	var projects int
+	if err := tx.Model(&models.Project{}).Where("org = ?", id).Count(&projects).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if projects > 0 {
+		tx.Rollback()
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"org [%s] still has %d projects", id, projects)
+	}
+	// Orgs are deleted outright rather than soft-deleted, so that their identifier can be used again.
+	deleteTx := tx.Unscoped().Where(&models.Org{Identifier: id}).Delete(&models.Org{})
+	if deleteTx.Error != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(deleteTx.Error)
+	}
+	if deleteTx.RowsAffected == 0 {
+		tx.Rollback()
+		return getMissingOrgError(id)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *OrgRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Org, error) {
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	var orgs []models.Org
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&orgs)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return orgs, nil
+}
+
+// Returns an instance of OrgRepoInterface
+func NewOrgRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.OrgRepoInterface {
+	metrics := newMetrics(scope)
+	return &OrgRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
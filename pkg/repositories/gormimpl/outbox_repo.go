@@ -0,0 +1,174 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+// Matches messages which aren't leased by any relay.
+const leaseExpiredQuery = "leased_until IS NULL OR leased_until < ?"
+
+// Matches messages which relays still try to publish.
+const notDeadLetteredQuery = "dead_lettered_at IS NULL"
+
+// Implementation of OutboxRepoInterface.
+type OutboxRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *OutboxRepo) Claim(
+	ctx context.Context, input interfaces.ClaimOutboxMessagesInput) ([]models.OutboxMessage, error) {
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	timer := r.metrics.ListDuration.Start()
+	defer timer.Stop()
+	var ids []uint
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.OutboxMessage{}).Where(
+		leaseExpiredQuery, input.Now).Where(notDeadLetteredQuery).Order("id asc").Limit(input.Limit).Pluck(ID, &ids)
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if len(ids) == 0 {
+		return []models.OutboxMessage{}, nil
+	}
+	// Relays listing the same messages race to lease them. The lease condition is checked again as each message is
+	// updated, so only one relay wins each message, and the winner finds the messages it leased by its lease id.
+	leaseID := uuid.New().String()
+	tx = repositoryConfig.WithContext(ctx, r.db).Model(&models.OutboxMessage{}).Where("id IN (?)", ids).Where(
+		leaseExpiredQuery, input.Now).Updates(map[string]interface{}{
+		"lease_id":     leaseID,
+		"leased_until": input.LeasedUntil,
+	})
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	var messages []models.OutboxMessage
+	tx = repositoryConfig.WithContext(ctx, r.db).Where(&models.OutboxMessage{
+		LeaseID: leaseID,
+	}).Order("id asc").Find(&messages)
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return messages, nil
+}
+
+func (r *OutboxRepo) Delete(ctx context.Context, id uint) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where("id = ?", id).Delete(&models.OutboxMessage{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OutboxRepo) MarkFailed(ctx context.Context, id uint, reason string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.OutboxMessage{}).Where("id = ?", id).Updates(
+		map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": reason,
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OutboxRepo) DeadLetter(ctx context.Context, id uint, reason string, deadLetteredAt time.Time) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.OutboxMessage{}).Where("id = ?", id).Updates(
+		map[string]interface{}{
+			"attempts":         gorm.Expr("attempts + 1"),
+			"last_error":       reason,
+			"dead_lettered_at": deadLetteredAt,
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *OutboxRepo) CountByPublisher(ctx context.Context) (map[string]int64, error) {
+	var counts []struct {
+		Publisher string
+		Count     int64
+	}
+	timer := r.metrics.CountDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.OutboxMessage{}).Select(
+		"publisher, COUNT(*) AS count").Where(notDeadLetteredQuery).Group("publisher").Scan(&counts)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	byPublisher := make(map[string]int64, len(counts))
+	for _, count := range counts {
+		byPublisher[count.Publisher] = count.Count
+	}
+	return byPublisher, nil
+}
+
+func (r *OutboxRepo) GetBacklog(ctx context.Context) (map[string]interfaces.OutboxBacklog, error) {
+	var backlogs []struct {
+		Publisher string
+		Count     int64
+		OldestID  uint
+	}
+	timer := r.metrics.CountDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.OutboxMessage{}).Select(
+		"publisher, COUNT(*) AS count, MIN(id) AS oldest_id").Where(notDeadLetteredQuery).Group("publisher").Scan(
+		&backlogs)
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if len(backlogs) == 0 {
+		return map[string]interfaces.OutboxBacklog{}, nil
+	}
+	// Messages are numbered in the order they're written, so the oldest message of a publisher has its smallest id.
+	oldestIDs := make([]uint, len(backlogs))
+	for i, backlog := range backlogs {
+		oldestIDs[i] = backlog.OldestID
+	}
+	var oldest []models.OutboxMessage
+	tx = repositoryConfig.WithContext(ctx, r.db).Select("id, publisher, created_at").Where(
+		"id IN (?)", oldestIDs).Find(&oldest)
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	byPublisher := make(map[string]interfaces.OutboxBacklog, len(backlogs))
+	for _, backlog := range backlogs {
+		byPublisher[backlog.Publisher] = interfaces.OutboxBacklog{Count: backlog.Count}
+	}
+	// Messages deleted in between the two queries leave the age of their publisher's backlog unknown.
+	for _, message := range oldest {
+		backlog := byPublisher[message.Publisher]
+		backlog.OldestCreatedAt = message.CreatedAt
+		byPublisher[message.Publisher] = backlog
+	}
+	return byPublisher, nil
+}
+
+// Returns an instance of OutboxRepoInterface
+func NewOutboxRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.OutboxRepoInterface {
+	metrics := newMetrics(scope)
+	return &OutboxRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,133 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var outboxNow = time.Date(2021, time.September, 20, 0, 0, 0, 0, time.UTC)
+
+func TestClaimOutboxMessages(t *testing.T) {
+	outboxRepo := NewOutboxRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT id FROM "outbox_messages"  WHERE ` +
+		`(leased_until IS NULL OR leased_until < 2021-09-20 00:00:00 +0000 UTC) AND (dead_lettered_at IS NULL) ` +
+		`ORDER BY id asc LIMIT 10`).WithReply(
+		[]map[string]interface{}{{"id": 1}, {"id": 2}})
+	leaseQuery := GlobalMock.NewMock()
+	leaseQuery.WithQuery(`UPDATE "outbox_messages" SET "lease_id" = ?, "leased_until" = ?  ` +
+		`WHERE (id IN (?,?)) AND (leased_until IS NULL OR leased_until < ?)`)
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "outbox_messages"  WHERE ("outbox_messages"."lease_id" = `).WithReply(
+		[]map[string]interface{}{{"id": 2, "publisher": "events", "attempts": 1}})
+
+	messages, err := outboxRepo.Claim(context.Background(), interfaces.ClaimOutboxMessagesInput{
+		Now:         outboxNow,
+		LeasedUntil: outboxNow.Add(time.Minute),
+		Limit:       10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, leaseQuery.Triggered)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, uint(2), messages[0].ID)
+	assert.Equal(t, "events", messages[0].Publisher)
+	assert.Equal(t, 1, messages[0].Attempts)
+}
+
+func TestClaimOutboxMessages_Empty(t *testing.T) {
+	outboxRepo := NewOutboxRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	leaseQuery := GlobalMock.NewMock()
+	leaseQuery.WithQuery(`UPDATE "outbox_messages"`)
+
+	messages, err := outboxRepo.Claim(context.Background(), interfaces.ClaimOutboxMessagesInput{
+		Now:         outboxNow,
+		LeasedUntil: outboxNow.Add(time.Minute),
+		Limit:       10,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+	assert.False(t, leaseQuery.Triggered)
+}
+
+func TestClaimOutboxMessages_MissingLimit(t *testing.T) {
+	outboxRepo := NewOutboxRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := outboxRepo.Claim(context.Background(), interfaces.ClaimOutboxMessagesInput{Now: outboxNow})
+	assert.EqualError(t, err, "missing and/or invalid parameters: limit")
+}
+
+func TestDeleteOutboxMessage(t *testing.T) {
+	outboxRepo := NewOutboxRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	deleteQuery := GlobalMock.NewMock()
+	deleteQuery.WithQuery(`DELETE FROM "outbox_messages"  WHERE (id = ?)`)
+
+	assert.NoError(t, outboxRepo.Delete(context.Background(), 3))
+	assert.True(t, deleteQuery.Triggered)
+}
+
+func TestMarkOutboxMessageFailed(t *testing.T) {
+	outboxRepo := NewOutboxRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	updateQuery := GlobalMock.NewMock()
+	updateQuery.WithQuery(`UPDATE "outbox_messages" SET "attempts" = attempts + 1, "last_error" = ?  WHERE (id = ?)`)
+
+	assert.NoError(t, outboxRepo.MarkFailed(context.Background(), 3, "topic not found"))
+	assert.True(t, updateQuery.Triggered)
+}
+
+func TestDeadLetterOutboxMessage(t *testing.T) {
+	outboxRepo := NewOutboxRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	updateQuery := GlobalMock.NewMock()
+	updateQuery.WithQuery(`UPDATE "outbox_messages" SET "attempts" = attempts + 1, "dead_lettered_at" = ?, ` +
+		`"last_error" = ?  WHERE (id = ?)`)
+
+	assert.NoError(t, outboxRepo.DeadLetter(context.Background(), 3, "topic not found", outboxNow))
+	assert.True(t, updateQuery.Triggered)
+}
+
+func TestCountOutboxMessagesByPublisher(t *testing.T) {
+	outboxRepo := NewOutboxRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT publisher, COUNT(*) AS count FROM "outbox_messages"`).WithReply(
+		[]map[string]interface{}{
+			{"publisher": "events", "count": 3},
+			{"publisher": "notifications", "count": 1},
+		})
+
+	counts, err := outboxRepo.CountByPublisher(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{
+		"events":        3,
+		"notifications": 1,
+	}, counts)
+}
+
+func TestGetOutboxBacklog(t *testing.T) {
+	outboxRepo := NewOutboxRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT publisher, COUNT(*) AS count, MIN(id) AS oldest_id FROM "outbox_messages"`).WithReply(
+		[]map[string]interface{}{
+			{"publisher": "events", "count": 3, "oldest_id": 4},
+			{"publisher": "notifications", "count": 1, "oldest_id": 7},
+		})
+	GlobalMock.NewMock().WithQuery(`SELECT id, publisher, created_at FROM "outbox_messages"  WHERE (id IN (?,?))`).WithReply(
+		[]map[string]interface{}{
+			{"id": 4, "publisher": "events", "created_at": outboxNow},
+		})
+
+	backlog, err := outboxRepo.GetBacklog(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interfaces.OutboxBacklog{
+		"events":        {Count: 3, OldestCreatedAt: outboxNow},
+		"notifications": {Count: 1},
+	}, backlog)
+}
@@ -0,0 +1,200 @@
+package gormimpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Deletes a batch of a project's records. Subqueries are used since DELETE statements can't be limited in postgres.
+const deleteProjectRecordsQuery = "DELETE FROM %[1]s WHERE id IN (SELECT id FROM %[1]s WHERE %[2]s LIMIT ?)"
+
+const deleteAllProjectRecordsQuery = "DELETE FROM %s WHERE %s"
+
+// Describes how a project's records are found in a table.
+type purgeTable struct {
+	// Records belong to the project when any of these columns is the project.
+	projectColumns []string
+	// Tables without an id column are purged in a single batch.
+	batched bool
+}
+
+// Returns the condition matching the project's records, and its arguments.
+func (t purgeTable) getProjectCondition(project string) (string, []interface{}) {
+	conditions := make([]string, len(t.projectColumns))
+	args := make([]interface{}, len(t.projectColumns))
+	for idx, column := range t.projectColumns {
+		conditions[idx] = fmt.Sprintf("%s = ?", column)
+		args[idx] = project
+	}
+	return strings.Join(conditions, " OR "), args
+}
+
+var executionPurgeTable = purgeTable{projectColumns: []string{"execution_project"}, batched: true}
+
+var entityPurgeTable = purgeTable{projectColumns: []string{"project"}, batched: true}
+
+var purgeTables = map[string]purgeTable{
+	interfaces.ExecutionEventsTable:           executionPurgeTable,
+	interfaces.NodeExecutionEventsTable:       executionPurgeTable,
+	interfaces.TaskExecutionArtifactsTable:    executionPurgeTable,
+	interfaces.ExecutionRelationshipsTable:    executionPurgeTable,
+	interfaces.TaskExecutionUsagesTable:       executionPurgeTable,
+	interfaces.RecordedEventsTable:            executionPurgeTable,
+	interfaces.SkippedEventsTable:             executionPurgeTable,
+	interfaces.SignalsTable:                   executionPurgeTable,
+	interfaces.ExecutionCommentsTable:         executionPurgeTable,
+	interfaces.NotificationDeliveriesTable:    entityPurgeTable,
+	interfaces.NotificationDigestEntriesTable: entityPurgeTable,
+	interfaces.WebhookDeliveriesTable:         entityPurgeTable,
+	interfaces.OutboxMessagesTable:            entityPurgeTable,
+	// Evictions of the project's cached outputs, and of outputs its executions reused.
+	interfaces.CacheEvictionsTable: {
+		projectColumns: []string{"dataset_project", "execution_project"},
+		batched:        true,
+	},
+	interfaces.TaskExecutionsTable:            executionPurgeTable,
+	interfaces.NodeExecutionsTable:            executionPurgeTable,
+	interfaces.ExecutionLabelsTable:           {projectColumns: []string{"execution_project"}},
+	interfaces.OffloadedLiteralsTable:         executionPurgeTable,
+	interfaces.IdempotencyKeysTable:           {projectColumns: []string{"project"}},
+	interfaces.ExecutionsTable:                executionPurgeTable,
+	interfaces.BackfillsTable:                 entityPurgeTable,
+	interfaces.LaunchPlanRolloutsTable:        entityPurgeTable,
+	interfaces.TriggersTable:                  entityPurgeTable,
+	interfaces.ScheduleRunsTable:              entityPurgeTable,
+	interfaces.SchedulableEntitiesTable:       entityPurgeTable,
+	interfaces.LaunchPlansTable:               entityPurgeTable,
+	interfaces.WorkflowsTable:                 entityPurgeTable,
+	interfaces.TasksTable:                     entityPurgeTable,
+	interfaces.NamedEntityMetadataTable:       entityPurgeTable,
+	interfaces.NamedEntityDescriptionsTable:   entityPurgeTable,
+	interfaces.NamedEntityTagsTable:           {projectColumns: []string{"project"}},
+	interfaces.NotificationSubscriptionsTable: entityPurgeTable,
+	interfaces.WebhooksTable:                  entityPurgeTable,
+	interfaces.SavedViewsTable:                entityPurgeTable,
+	interfaces.DomainQuotasTable:              entityPurgeTable,
+	interfaces.FeatureFlagsTable:              entityPurgeTable,
+	interfaces.ResourcesTable:                 entityPurgeTable,
+	interfaces.APIUsagesTable:                 entityPurgeTable,
+	interfaces.ClusterResourceSyncsTable:      entityPurgeTable,
+	interfaces.AdmissionLocksTable:            {projectColumns: []string{"project"}},
+	interfaces.ProjectLabelsTable:             {projectColumns: []string{"project"}},
+}
+
+// Implementation of ProjectPurgeRepoInterface.
+type ProjectPurgeRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *ProjectPurgeRepo) Create(ctx context.Context, input models.ProjectPurge) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ProjectPurgeRepo) GetLatest(ctx context.Context, project string) (models.ProjectPurge, error) {
+	var purge models.ProjectPurge
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.ProjectPurge{
+		Project: project,
+	}).Order("id desc").Take(&purge)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.ProjectPurge{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"project [%s] hasn't been purged", project)
+	}
+	if tx.Error != nil {
+		return models.ProjectPurge{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return purge, nil
+}
+
+func (r *ProjectPurgeRepo) Update(ctx context.Context, input models.ProjectPurge) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Save(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ProjectPurgeRepo) ListByPhase(ctx context.Context, phase string, maxPurges int) ([]models.ProjectPurge, error) {
+	if maxPurges == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	var purges []models.ProjectPurge
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.ProjectPurge{
+		Phase: phase,
+	}).Order("id asc").Limit(maxPurges).Find(&purges)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return purges, nil
+}
+
+func (r *ProjectPurgeRepo) DeleteRecords(ctx context.Context, input interfaces.DeleteProjectRecordsInput) (int64, error) {
+	table, ok := purgeTables[input.Table]
+	if !ok {
+		return 0, errors.GetInvalidInputError(fmt.Sprintf("purge table %s", input.Table))
+	}
+	if input.Limit == 0 {
+		return 0, errors.GetInvalidInputError(limit)
+	}
+	condition, args := table.getProjectCondition(input.Project)
+	timer := r.metrics.DeleteDuration.Start()
+	var tx *gorm.DB
+	if table.batched {
+		tx = repositoryConfig.WithContext(ctx, r.db).Exec(
+			fmt.Sprintf(deleteProjectRecordsQuery, input.Table, condition), append(args, input.Limit)...)
+	} else {
+		tx = repositoryConfig.WithContext(ctx, r.db).Exec(
+			fmt.Sprintf(deleteAllProjectRecordsQuery, input.Table, condition), args...)
+	}
+	timer.Stop()
+	if tx.Error != nil {
+		return 0, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tx.RowsAffected, nil
+}
+
+func (r *ProjectPurgeRepo) DeleteProject(ctx context.Context, project string) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Unscoped().Where(&models.Project{
+		Identifier: project,
+	}).Delete(&models.Project{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Returns an instance of ProjectPurgeRepoInterface
+func NewProjectPurgeRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.ProjectPurgeRepoInterface {
+	metrics := newMetrics(scope)
+	return &ProjectPurgeRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,104 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateProjectPurge(t *testing.T) {
+	purgeRepo := NewProjectPurgeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	insertQuery := GlobalMock.NewMock()
+	insertQuery.WithQuery(`INSERT  INTO "project_purges"`)
+
+	err := purgeRepo.Create(context.Background(), models.ProjectPurge{
+		Project:      project,
+		Phase:        models.ProjectPurgeRunning,
+		CurrentTable: interfaces.ExecutionEventsTable,
+	})
+	assert.NoError(t, err)
+	assert.True(t, insertQuery.Triggered)
+}
+
+func TestGetLatestProjectPurge(t *testing.T) {
+	purgeRepo := NewProjectPurgeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "project_purges"  WHERE "project_purges"."deleted_at" IS NULL AND ` +
+			`(("project_purges"."project" = project)) ORDER BY id desc LIMIT 1`).WithReply(
+		[]map[string]interface{}{
+			{"id": 2, "project": project, "phase": models.ProjectPurgeRunning, "current_table": "executions",
+				"deleted_records": 10},
+		})
+
+	purge, err := purgeRepo.GetLatest(context.Background(), project)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(2), purge.ID)
+	assert.Equal(t, "executions", purge.CurrentTable)
+	assert.Equal(t, int64(10), purge.DeletedRecords)
+}
+
+func TestGetLatestProjectPurge_NotFound(t *testing.T) {
+	purgeRepo := NewProjectPurgeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := purgeRepo.GetLatest(context.Background(), project)
+	assert.EqualError(t, err, "project [project] hasn't been purged")
+}
+
+func TestListProjectPurgesByPhase_MissingLimit(t *testing.T) {
+	purgeRepo := NewProjectPurgeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := purgeRepo.ListByPhase(context.Background(), models.ProjectPurgeRunning, 0)
+	assert.EqualError(t, err, "missing and/or invalid parameters: limit")
+}
+
+func TestDeleteProjectRecords(t *testing.T) {
+	purgeRepo := NewProjectPurgeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	deleteQuery := GlobalMock.NewMock()
+	deleteQuery.WithQuery(`DELETE FROM executions WHERE id IN ` +
+		`(SELECT id FROM executions WHERE execution_project = ? LIMIT ?)`).WithRowsNum(3)
+
+	deleted, err := purgeRepo.DeleteRecords(context.Background(), interfaces.DeleteProjectRecordsInput{
+		Table:   interfaces.ExecutionsTable,
+		Project: project,
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, deleteQuery.Triggered)
+	assert.Equal(t, int64(3), deleted)
+}
+
+func TestDeleteProjectRecords_MultipleProjectColumns(t *testing.T) {
+	purgeRepo := NewProjectPurgeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	deleteQuery := GlobalMock.NewMock()
+	deleteQuery.WithQuery(`DELETE FROM cache_evictions WHERE id IN ` +
+		`(SELECT id FROM cache_evictions WHERE dataset_project = ? OR execution_project = ? LIMIT ?)`).WithRowsNum(2)
+
+	deleted, err := purgeRepo.DeleteRecords(context.Background(), interfaces.DeleteProjectRecordsInput{
+		Table:   interfaces.CacheEvictionsTable,
+		Project: project,
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, deleteQuery.Triggered)
+	assert.Equal(t, int64(2), deleted)
+}
+
+func TestDeleteProjectRecords_UnknownTable(t *testing.T) {
+	purgeRepo := NewProjectPurgeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := purgeRepo.DeleteRecords(context.Background(), interfaces.DeleteProjectRecordsInput{
+		Table:   "projects",
+		Project: project,
+		Limit:   10,
+	})
+	assert.EqualError(t, err, "missing and/or invalid parameters: purge table projects")
+}
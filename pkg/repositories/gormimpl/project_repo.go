@@ -11,6 +11,7 @@ import (
 
 	"github.com/jinzhu/gorm"
 
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -24,10 +25,29 @@ type ProjectRepo struct {
 
 func (r *ProjectRepo) Create(ctx context.Context, project models.Project) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&project)
-	timer.Stop()
-	if tx.Error != nil {
-		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	if err := tx.Create(&project).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	// Labels are saved in the same transaction so that projects are never listed without them.
+	if err := createProjectLabels(tx, project); err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func createProjectLabels(tx *gorm.DB, project models.Project) error {
+	for _, label := range project.ProjectLabels {
+		label.Project = project.Identifier
+		if err := tx.Create(&label).Error; err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -35,7 +55,7 @@ func (r *ProjectRepo) Create(ctx context.Context, project models.Project) error
 func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project, error) {
 	var project models.Project
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Project{
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Project{
 		Identifier: projectID,
 	}).Take(&project)
 	timer.Stop()
@@ -53,7 +73,7 @@ func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project
 func (r *ProjectRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error) {
 	var projects []models.Project
 
-	tx := r.db.Offset(input.Offset)
+	tx := repositoryConfig.WithContext(ctx, r.db).Offset(input.Offset)
 	if input.Limit != 0 {
 		tx = tx.Limit(input.Limit)
 	}
@@ -85,6 +105,52 @@ func (r *ProjectRepo) List(ctx context.Context, input interfaces.ListResourceInp
 	return projects, nil
 }
 
+func (r *ProjectRepo) UpdateMetadata(ctx context.Context, project models.Project) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.Project{Identifier: project.Identifier}).Updates(
+		map[string]interface{}{
+			"owner":    project.Owner,
+			"team":     project.Team,
+			"metadata": project.Metadata,
+			"links":    project.Links,
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "project [%s] not found", project.Identifier)
+	}
+	return nil
+}
+
+func (r *ProjectRepo) UpdateExecutionDefaults(ctx context.Context, project models.Project) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.Project{Identifier: project.Identifier}).Update(
+		"execution_defaults", project.ExecutionDefaults)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "project [%s] not found", project.Identifier)
+	}
+	return nil
+}
+
+func (r *ProjectRepo) UpdateOrg(ctx context.Context, projectID, org string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.Project{Identifier: projectID}).Update("org", org)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "project [%s] not found", projectID)
+	}
+	return nil
+}
+
 func NewProjectRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
 	scope promutils.Scope) interfaces.ProjectRepoInterface {
 	metrics := newMetrics(scope)
@@ -96,13 +162,36 @@ func NewProjectRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer,
 }
 
 func (r *ProjectRepo) UpdateProject(ctx context.Context, projectUpdate models.Project) error {
-	// Use gorm client to update the two fields that are changed.
-	writeTx := r.db.Model(&projectUpdate).Updates(projectUpdate)
-
-	// Return error if applies.
-	if writeTx.Error != nil {
-		return r.errorTransformer.ToFlyteAdminError(writeTx.Error)
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	if projectUpdate.ProjectLabels == nil {
+		// Use gorm client to update the two fields that are changed.
+		writeTx := repositoryConfig.WithContext(ctx, r.db).Model(&projectUpdate).Updates(projectUpdate)
+
+		// Return error if applies.
+		if writeTx.Error != nil {
+			return r.errorTransformer.ToFlyteAdminError(writeTx.Error)
+		}
+		return nil
 	}
 
+	// The project's labels are replaced in the same transaction as the project is updated.
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	if err := tx.Model(&projectUpdate).Updates(projectUpdate).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	err := tx.Where(&models.ProjectLabel{Project: projectUpdate.Identifier}).Delete(&models.ProjectLabel{}).Error
+	if err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := createProjectLabels(tx, projectUpdate); err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
 	return nil
 }
@@ -25,7 +25,8 @@ func TestCreateProject(t *testing.T) {
 
 	query := GlobalMock.NewMock()
 	query.WithQuery(
-		`INSERT INTO "projects" ("created_at","updated_at","deleted_at","identifier","name","description","labels","state") VALUES (?,?,?,?,?,?,?,?)`)
+		`INSERT INTO "projects" ("created_at","updated_at","deleted_at","identifier","name","description","labels","state",` +
+			`"owner","team","metadata","links","execution_defaults") VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)`)
 
 	activeState := int32(admin.Project_ACTIVE)
 	err := projectRepo.Create(context.Background(), models.Project{
@@ -134,3 +135,37 @@ func TestUpdateProject(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, query.Triggered)
 }
+
+func TestCreateProject_Labels(t *testing.T) {
+	projectRepo := NewProjectRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	labelQuery := GlobalMock.NewMock()
+	labelQuery.WithQuery(`INSERT INTO "project_labels" ("project","key","value") VALUES (?,?,?)`)
+
+	err := projectRepo.Create(context.Background(), models.Project{
+		Identifier: "proj",
+		Name:       "proj",
+		ProjectLabels: []models.ProjectLabel{
+			{Key: "team", Value: "ml"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, labelQuery.Triggered)
+}
+
+func TestUpdateProject_Labels(t *testing.T) {
+	projectRepo := NewProjectRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+
+	deleteQuery := GlobalMock.NewMock()
+	deleteQuery.WithQuery(`DELETE FROM "project_labels"  WHERE ("project_labels"."project" = ?)`)
+
+	err := projectRepo.UpdateProject(context.Background(), models.Project{
+		Identifier:    "project_id",
+		Name:          "project_name",
+		ProjectLabels: []models.ProjectLabel{},
+	})
+	assert.NoError(t, err)
+	assert.True(t, deleteQuery.Triggered)
+}
@@ -0,0 +1,78 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchCreateRecordedEvents(t *testing.T) {
+	recordedEventRepo := NewRecordedEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "recorded_events" ("created_at","execution_project","execution_domain",` +
+		`"execution_name","event_type","node_id","retry_attempt","request_id","producer_id","phase","phase_version",` +
+		`"occurred_at") VALUES (?,?,?,?,?,?,?,?,?,?,?,?)`)
+
+	var recordedEvents []models.RecordedEvent
+	for _, requestID := range []string{"a", "b"} {
+		recordedEvents = append(recordedEvents, models.RecordedEvent{
+			ExecutionProject: project,
+			ExecutionDomain:  domain,
+			ExecutionName:    name,
+			EventType:        "workflow",
+			RequestID:        requestID,
+			ProducerID:       "propeller",
+			Phase:            "RUNNING",
+			OccurredAt:       time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC),
+		})
+	}
+	err := recordedEventRepo.BatchCreate(context.Background(), recordedEvents)
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListRecordedEvents(t *testing.T) {
+	recordedEventRepo := NewRecordedEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "recorded_events"  WHERE (execution_project = project) ` +
+		`LIMIT 10 OFFSET 0`).WithReply([]map[string]interface{}{
+		{
+			"id":                1,
+			"execution_project": project,
+			"event_type":        "workflow",
+			"phase":             "RUNNING",
+		},
+		{
+			"id":                2,
+			"execution_project": project,
+			"event_type":        "node",
+			"phase":             "QUEUED",
+		},
+	})
+
+	recordedEvents, err := recordedEventRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.RecordedEvent, "execution_project", project),
+		},
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, recordedEvents, 2)
+	assert.Equal(t, "workflow", recordedEvents[0].EventType)
+	assert.Equal(t, "QUEUED", recordedEvents[1].Phase)
+}
+
+func TestListRecordedEvents_MissingFilters(t *testing.T) {
+	recordedEventRepo := NewRecordedEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := recordedEventRepo.List(context.Background(), interfaces.ListResourceInput{Limit: 10})
+	assert.EqualError(t, err, "missing and/or invalid parameters: filters")
+}
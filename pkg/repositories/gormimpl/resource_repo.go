@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -56,27 +57,31 @@ func (r *ResourceRepo) CreateOrUpdate(ctx context.Context, input models.Resource
 	if input.Priority == 0 {
 		return errors.GetInvalidInputError(fmt.Sprintf("invalid priority %v", input))
 	}
-	timer := r.metrics.GetDuration.Start()
+	timer := r.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	// Use a transaction so that concurrent upserts of the same resource are serialized rather than interleaving their
+	// lookup and write.
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
 	var record models.Resource
-	tx := r.db.FirstOrCreate(&record, models.Resource{
+	if err := tx.FirstOrCreate(&record, models.Resource{
 		Project:      input.Project,
 		Domain:       input.Domain,
 		Workflow:     input.Workflow,
 		LaunchPlan:   input.LaunchPlan,
 		ResourceType: input.ResourceType,
 		Priority:     input.Priority,
-	})
-	timer.Stop()
-	if tx.Error != nil {
-		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
 	}
 
-	timer = r.metrics.UpdateDuration.Start()
 	record.Attributes = input.Attributes
-	tx = r.db.Save(&record)
-	timer.Stop()
-	if tx.Error != nil {
-		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	if err := tx.Save(&record).Error; err != nil {
+		tx.Rollback()
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
 	}
 	return nil
 }
@@ -104,7 +109,7 @@ func (r *ResourceRepo) Get(ctx context.Context, ID interfaces.ResourceID) (model
 		launchPlan = append(launchPlan, ID.LaunchPlan)
 	}
 
-	tx := r.db.Where(txWhereClause, ID.ResourceType, ID.Domain, project, workflow, launchPlan)
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(txWhereClause, ID.ResourceType, ID.Domain, project, workflow, launchPlan)
 	tx.Order(priorityDescending).First(&resources)
 	timer.Stop()
 
@@ -124,7 +129,7 @@ func (r *ResourceRepo) GetRaw(ctx context.Context, ID interfaces.ResourceID) (mo
 	}
 	var model models.Resource
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Resource{
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Resource{
 		Project:      ID.Project,
 		Domain:       ID.Domain,
 		Workflow:     ID.Workflow,
@@ -146,7 +151,7 @@ func (r *ResourceRepo) ListAll(ctx context.Context, resourceType string) ([]mode
 	var resources []models.Resource
 	timer := r.metrics.ListDuration.Start()
 
-	tx := r.db.Where(&models.Resource{ResourceType: resourceType}).Order(priorityDescending).Find(&resources)
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Resource{ResourceType: resourceType}).Order(priorityDescending).Find(&resources)
 	timer.Stop()
 
 	if tx.Error != nil {
@@ -158,7 +163,7 @@ func (r *ResourceRepo) ListAll(ctx context.Context, resourceType string) ([]mode
 func (r *ResourceRepo) Delete(ctx context.Context, ID interfaces.ResourceID) error {
 	var tx *gorm.DB
 	r.metrics.DeleteDuration.Time(func() {
-		tx = r.db.Where(&models.Resource{
+		tx = repositoryConfig.WithContext(ctx, r.db).Where(&models.Resource{
 			Project:      ID.Project,
 			Domain:       ID.Domain,
 			Workflow:     ID.Workflow,
@@ -0,0 +1,386 @@
+package gormimpl
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+const executionLabelTableName = "execution_labels"
+
+// Excludes records of the outer table referenced by records of another table within the same execution.
+const notReferencedByQuery = "NOT EXISTS (SELECT 1 FROM %[1]s WHERE " +
+	"%[1]s.execution_project = %[2]s.execution_project AND %[1]s.execution_domain = %[2]s.execution_domain AND " +
+	"%[1]s.execution_name = %[2]s.execution_name%[3]s)"
+
+const sameNodeCondition = " AND %[1]s.node_id = %[2]s.node_id"
+
+// Matches the executions referenced by the records of another table.
+const referencedExecutionCondition = "executions.execution_project = %[1]s.execution_project AND " +
+	"executions.execution_domain = %[1]s.execution_domain AND executions.execution_name = %[1]s.execution_name"
+
+// Matches records belonging to executions which still exist.
+const existingExecutionQuery = "EXISTS (SELECT 1 FROM executions WHERE %s)"
+
+// Excludes records belonging to executions which haven't terminated, since those executions may still read them.
+const terminatedExecutionQuery = "NOT EXISTS (SELECT 1 FROM executions WHERE %s AND executions.phase NOT IN (?))"
+
+// Matches records belonging to executions soft-deleted before a given time.
+const executionDeletedBeforeQuery = "EXISTS (SELECT 1 FROM executions WHERE %s AND executions.deleted_at < ?)"
+
+// Describes how records in a table are pruned. Tables are pruned in the order given by interfaces.RetentionTables, so
+// referenced records are normally pruned in the same run as their references.
+type retentionTable struct {
+	projectColumn string
+	domainColumn  string
+	// Additional conditions records must meet before they can be pruned.
+	query string
+	args  []interface{}
+	// Matches records soft-deleted before a given time, or which belong to executions that were.
+	deletedBeforeQuery string
+}
+
+func newExecutionRetentionTable(table string, query string, args ...interface{}) retentionTable {
+	terminatedQuery := fmt.Sprintf(terminatedExecutionQuery, fmt.Sprintf(referencedExecutionCondition, table))
+	if len(query) > 0 {
+		terminatedQuery = fmt.Sprintf("%s AND %s", terminatedQuery, query)
+	}
+	return retentionTable{
+		projectColumn:      "execution_project",
+		domainColumn:       "execution_domain",
+		query:              terminatedQuery,
+		args:               append([]interface{}{terminalExecutionPhases()}, args...),
+		deletedBeforeQuery: fmt.Sprintf(executionDeletedBeforeQuery, fmt.Sprintf(referencedExecutionCondition, table)),
+	}
+}
+
+var retentionTables = map[string]retentionTable{
+	interfaces.ExecutionEventsTable:     newExecutionRetentionTable(interfaces.ExecutionEventsTable, ""),
+	interfaces.NodeExecutionEventsTable: newExecutionRetentionTable(interfaces.NodeExecutionEventsTable, ""),
+	interfaces.TaskExecutionsTable:      newExecutionRetentionTable(interfaces.TaskExecutionsTable, ""),
+	interfaces.NodeExecutionsTable: newExecutionRetentionTable(interfaces.NodeExecutionsTable,
+		notReferencedBy(interfaces.NodeExecutionEventsTable, interfaces.NodeExecutionsTable, true)+" AND "+
+			notReferencedBy(interfaces.TaskExecutionsTable, interfaces.NodeExecutionsTable, true)+" AND "+
+			"NOT EXISTS (SELECT 1 FROM node_executions AS children WHERE children.parent_id = node_executions.id)"),
+	interfaces.ExecutionCommentsTable:      newExecutionRetentionTable(interfaces.ExecutionCommentsTable, ""),
+	interfaces.SignalsTable:                newExecutionRetentionTable(interfaces.SignalsTable, ""),
+	interfaces.ExecutionRelationshipsTable: newExecutionRetentionTable(interfaces.ExecutionRelationshipsTable, ""),
+	interfaces.TaskExecutionUsagesTable:    newExecutionRetentionTable(interfaces.TaskExecutionUsagesTable, ""),
+	interfaces.RecordedEventsTable:         newExecutionRetentionTable(interfaces.RecordedEventsTable, ""),
+	// Literals of executions which were never created are left for the collector, which deletes them from storage
+	// as well.
+	interfaces.OffloadedLiteralsTable: newExecutionRetentionTable(interfaces.OffloadedLiteralsTable,
+		fmt.Sprintf(existingExecutionQuery, fmt.Sprintf(referencedExecutionCondition, interfaces.OffloadedLiteralsTable))),
+	interfaces.ExecutionsTable: {
+		projectColumn: "execution_project",
+		domainColumn:  "execution_domain",
+		query: "executions.phase IN (?) AND " +
+			notReferencedBy(interfaces.ExecutionEventsTable, interfaces.ExecutionsTable, false) + " AND " +
+			notReferencedBy(interfaces.NodeExecutionsTable, interfaces.ExecutionsTable, false) + " AND " +
+			notReferencedBy(interfaces.ExecutionCommentsTable, interfaces.ExecutionsTable, false) + " AND " +
+			notReferencedBy(interfaces.SignalsTable, interfaces.ExecutionsTable, false) + " AND " +
+			notReferencedBy(interfaces.ExecutionRelationshipsTable, interfaces.ExecutionsTable, false) + " AND " +
+			notReferencedBy(interfaces.TaskExecutionUsagesTable, interfaces.ExecutionsTable, false) + " AND " +
+			notReferencedBy(interfaces.RecordedEventsTable, interfaces.ExecutionsTable, false) + " AND " +
+			notReferencedBy(interfaces.OffloadedLiteralsTable, interfaces.ExecutionsTable, false),
+		args:               []interface{}{terminalExecutionPhases()},
+		deletedBeforeQuery: "executions.deleted_at < ?",
+	},
+	// Launch plans are only pruned once they've been deleted, and aren't referenced by any remaining executions.
+	interfaces.LaunchPlansTable: {
+		projectColumn: "project",
+		domainColumn:  "domain",
+		query: "launch_plans.deleted_at IS NOT NULL AND " +
+			"NOT EXISTS (SELECT 1 FROM executions WHERE executions.launch_plan_id = launch_plans.id)",
+		deletedBeforeQuery: "launch_plans.deleted_at < ?",
+	},
+}
+
+// Matches versions with at least a given number of newer versions of the same name. Ids increase with each
+// registration, so newer versions have greater ids.
+const excessVersionQuery = "(SELECT COUNT(*) FROM %[1]s AS newer WHERE newer.project = %[1]s.project AND " +
+	"newer.domain = %[1]s.domain AND newer.name = %[1]s.name AND newer.id > %[1]s.id) >= ?"
+
+// Excludes versions referenced by executions created after a given time.
+const notRecentlyExecutedQuery = "NOT EXISTS (SELECT 1 FROM executions WHERE executions.%s = %s.id AND " +
+	"executions.created_at > ?)"
+
+// Describes which versions of registered entities can be pruned once they exceed the number retained.
+type versionTable struct {
+	query string
+	// Returns the arguments of the query for versions referenced by executions created after the given time.
+	args func(executedAfter time.Time) []interface{}
+}
+
+var versionTables = map[string]versionTable{
+	interfaces.LaunchPlansTable: {
+		query: "(launch_plans.state IS NULL OR launch_plans.state <> ?) AND " +
+			fmt.Sprintf(notRecentlyExecutedQuery, "launch_plan_id", interfaces.LaunchPlansTable),
+		args: func(executedAfter time.Time) []interface{} {
+			return []interface{}{int32(admin.LaunchPlanState_ACTIVE), executedAfter}
+		},
+	},
+	// Workflows are kept while any launch plan references them, so launch plans are pruned first.
+	interfaces.WorkflowsTable: {
+		query: "NOT EXISTS (SELECT 1 FROM launch_plans WHERE launch_plans.workflow_id = workflows.id) AND " +
+			fmt.Sprintf(notRecentlyExecutedQuery, "workflow_id", interfaces.WorkflowsTable),
+		args: func(executedAfter time.Time) []interface{} {
+			return []interface{}{executedAfter}
+		},
+	},
+	// Tasks are also referenced by the task executions of workflow executions.
+	interfaces.TasksTable: {
+		query: fmt.Sprintf(notRecentlyExecutedQuery, "task_id", interfaces.TasksTable) + " AND " +
+			"NOT EXISTS (SELECT 1 FROM task_executions WHERE task_executions.project = tasks.project AND " +
+			"task_executions.domain = tasks.domain AND task_executions.name = tasks.name AND " +
+			"task_executions.version = tasks.version AND task_executions.created_at > ?)",
+		args: func(executedAfter time.Time) []interface{} {
+			return []interface{}{executedAfter, executedAfter}
+		},
+	},
+}
+
+func notReferencedBy(referencingTable, table string, sameNode bool) string {
+	var nodeCondition string
+	if sameNode {
+		nodeCondition = fmt.Sprintf(sameNodeCondition, referencingTable, table)
+	}
+	return fmt.Sprintf(notReferencedByQuery, referencingTable, table, nodeCondition)
+}
+
+func terminalExecutionPhases() []string {
+	phases := make([]string, 0)
+	for phase, name := range core.WorkflowExecution_Phase_name {
+		if common.IsExecutionTerminal(core.WorkflowExecution_Phase(phase)) {
+			phases = append(phases, name)
+		}
+	}
+	sort.Strings(phases)
+	return phases
+}
+
+// Implementation of RetentionRepoInterface.
+type RetentionRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getRetentionTable(table string) (retentionTable, error) {
+	retention, ok := retentionTables[table]
+	if !ok {
+		return retentionTable{}, errors.GetInvalidInputError(fmt.Sprintf("retention table %s", table))
+	}
+	return retention, nil
+}
+
+func (r *RetentionRepo) ListExpired(
+	ctx context.Context, input interfaces.ListExpiredInput) ([]interfaces.ExpiredRecord, error) {
+	retention, err := getRetentionTable(input.Table)
+	if err != nil {
+		return nil, err
+	}
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Table(input.Table).Where(
+		fmt.Sprintf("%[1]s.%[2]s = ? AND %[1]s.%[3]s = ?", input.Table, retention.projectColumn, retention.domainColumn),
+		input.Project, input.Domain)
+	if input.DeletedBefore.IsZero() {
+		tx = tx.Where(fmt.Sprintf("%s.created_at < ?", input.Table), input.CreatedBefore)
+	} else {
+		tx = tx.Where(retention.deletedBeforeQuery, input.DeletedBefore)
+	}
+	if len(retention.query) > 0 {
+		tx = tx.Where(retention.query, retention.args...)
+	}
+	tx = tx.Order(fmt.Sprintf("%[1]s.created_at asc, %[1]s.id asc", input.Table)).Limit(input.Limit)
+
+	timer := r.metrics.ListDuration.Start()
+	defer timer.Stop()
+	rows, err := tx.Rows()
+	if err != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	defer rows.Close()
+	records, err := scanExpiredRecords(rows)
+	if err != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return records, nil
+}
+
+func scanExpiredRecords(rows *sql.Rows) ([]interfaces.ExpiredRecord, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	records := make([]interfaces.ExpiredRecord, 0)
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		record := interfaces.ExpiredRecord{
+			Columns: make(map[string]interface{}, len(columns)),
+		}
+		for i, column := range columns {
+			value := values[i]
+			// Drivers may reuse the buffers backing byte slices between rows.
+			if bytes, ok := value.([]byte); ok {
+				value = append([]byte{}, bytes...)
+			}
+			record.Columns[column] = value
+			if column == ID {
+				if record.ID, err = toID(value); err != nil {
+					return nil, err
+				}
+			}
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+func toID(value interface{}) (uint, error) {
+	switch id := value.(type) {
+	case int64:
+		return uint(id), nil
+	case int32:
+		return uint(id), nil
+	case uint64:
+		return uint(id), nil
+	case []byte:
+		parsed, err := strconv.ParseUint(string(id), 10, 64)
+		return uint(parsed), err
+	}
+	return 0, fmt.Errorf("unexpected id type %T", value)
+}
+
+func (r *RetentionRepo) Delete(ctx context.Context, table string, ids []uint) (int64, error) {
+	retention, err := getRetentionTable(table)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	query := fmt.Sprintf("%s.id IN (?)", table)
+	args := []interface{}{ids}
+	if len(retention.query) > 0 {
+		query = fmt.Sprintf("%s AND %s", query, retention.query)
+		args = append(args, retention.args...)
+	}
+
+	timer := r.metrics.DeleteDuration.Start()
+	defer timer.Stop()
+	tx := repositoryConfig.WithContext(ctx, r.db).Begin()
+	if table == interfaces.ExecutionsTable {
+		// Labels are deleted along with their executions, since they're only ever read through them.
+		labelQuery := fmt.Sprintf("DELETE FROM %s WHERE EXISTS (SELECT 1 FROM executions WHERE %s AND %s)",
+			executionLabelTableName, query, fmt.Sprintf(referencedExecutionCondition, executionLabelTableName))
+		if err := tx.Exec(labelQuery, args...).Error; err != nil {
+			tx.Rollback()
+			return 0, r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	deleted := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s", table, query), args...)
+	if err := deleted.Error; err != nil {
+		tx.Rollback()
+		return 0, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return deleted.RowsAffected, nil
+}
+
+// Returns the conditions versions must meet to be pruned, along with their arguments.
+func getExcessVersionsQuery(input interfaces.ListExcessVersionsInput) (string, []interface{}, error) {
+	version, ok := versionTables[input.Table]
+	if !ok {
+		return "", nil, errors.GetInvalidInputError(fmt.Sprintf("version table %s", input.Table))
+	}
+	if input.MaxVersions <= 0 {
+		return "", nil, errors.GetInvalidInputError("max versions")
+	}
+	query := fmt.Sprintf("%[1]s.project = ? AND %[1]s.domain = ? AND ", input.Table) +
+		fmt.Sprintf(excessVersionQuery, input.Table) + " AND " + version.query
+	args := append([]interface{}{input.Project, input.Domain, input.MaxVersions}, version.args(input.ExecutedAfter)...)
+	return query, args, nil
+}
+
+func (r *RetentionRepo) ListExcessVersions(
+	ctx context.Context, input interfaces.ListExcessVersionsInput) ([]interfaces.ExpiredRecord, error) {
+	query, args, err := getExcessVersionsQuery(input)
+	if err != nil {
+		return nil, err
+	}
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Table(input.Table).Where(
+		fmt.Sprintf("%s.id > ?", input.Table), input.AfterID).Where(query, args...).Order(
+		fmt.Sprintf("%s.id asc", input.Table)).Limit(input.Limit)
+
+	timer := r.metrics.ListDuration.Start()
+	defer timer.Stop()
+	rows, err := tx.Rows()
+	if err != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	defer rows.Close()
+	records, err := scanExpiredRecords(rows)
+	if err != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return records, nil
+}
+
+func (r *RetentionRepo) DeleteExcessVersions(
+	ctx context.Context, input interfaces.ListExcessVersionsInput, ids []uint) (int64, error) {
+	query, args, err := getExcessVersionsQuery(input)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	timer := r.metrics.DeleteDuration.Start()
+	defer timer.Stop()
+	deleted := repositoryConfig.WithContext(ctx, r.db).Exec(
+		fmt.Sprintf("DELETE FROM %[1]s WHERE %[1]s.id IN (?) AND %[2]s", input.Table, query),
+		append([]interface{}{ids}, args...)...)
+	if err := deleted.Error; err != nil {
+		return 0, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return deleted.RowsAffected, nil
+}
+
+// Returns an instance of RetentionRepoInterface
+func NewRetentionRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.RetentionRepoInterface {
+	metrics := newMetrics(scope)
+	return &RetentionRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
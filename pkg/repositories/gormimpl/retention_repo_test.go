@@ -0,0 +1,183 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListExpired(t *testing.T) {
+	retentionRepo := NewRetentionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "node_executions"  WHERE (node_executions.execution_project = project AND ` +
+		`node_executions.execution_domain = domain) AND (node_executions.created_at < `).WithReply(
+		[]map[string]interface{}{
+			{"id": int64(1), "node_id": "n0"},
+			{"id": int64(2), "node_id": "n1"},
+		})
+
+	records, err := retentionRepo.ListExpired(context.Background(), interfaces.ListExpiredInput{
+		Table:         interfaces.NodeExecutionsTable,
+		Project:       "project",
+		Domain:        "domain",
+		CreatedBefore: time.Now(),
+		Limit:         2,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Len(t, records, 2)
+	assert.Equal(t, uint(1), records[0].ID)
+	assert.Equal(t, "n0", records[0].Columns["node_id"])
+	assert.Equal(t, uint(2), records[1].ID)
+}
+
+func TestListExpired_Deleted(t *testing.T) {
+	retentionRepo := NewRetentionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "launch_plans"  WHERE (launch_plans.project = project AND ` +
+		`launch_plans.domain = domain) AND (launch_plans.deleted_at < `).WithReply(
+		[]map[string]interface{}{
+			{"id": int64(1), "name": "lp"},
+		})
+
+	records, err := retentionRepo.ListExpired(context.Background(), interfaces.ListExpiredInput{
+		Table:         interfaces.LaunchPlansTable,
+		Project:       "project",
+		Domain:        "domain",
+		DeletedBefore: time.Now(),
+		Limit:         2,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Len(t, records, 1)
+	assert.Equal(t, uint(1), records[0].ID)
+}
+
+func TestListExpired_UnsupportedTable(t *testing.T) {
+	retentionRepo := NewRetentionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := retentionRepo.ListExpired(context.Background(), interfaces.ListExpiredInput{
+		Table: "projects",
+		Limit: 2,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteExpired_Executions(t *testing.T) {
+	retentionRepo := NewRetentionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	labelQuery := GlobalMock.NewMock()
+	labelQuery.WithQuery(`DELETE FROM execution_labels WHERE EXISTS (SELECT 1 FROM executions WHERE ` +
+		`executions.id IN (?,?) AND executions.phase IN (?,?,?,?) AND NOT EXISTS`)
+	executionQuery := GlobalMock.NewMock()
+	executionQuery.WithQuery(`DELETE FROM executions WHERE executions.id IN (?,?) AND ` +
+		`executions.phase IN (?,?,?,?) AND NOT EXISTS`).WithRowsNum(2)
+
+	deleted, err := retentionRepo.Delete(context.Background(), interfaces.ExecutionsTable, []uint{1, 2})
+	assert.NoError(t, err)
+	assert.True(t, labelQuery.Triggered)
+	assert.True(t, executionQuery.Triggered)
+	assert.Equal(t, int64(2), deleted)
+}
+
+func TestDeleteExpired_NodeExecutions(t *testing.T) {
+	retentionRepo := NewRetentionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	// Node executions of executions which haven't terminated are kept.
+	query.WithQuery(`DELETE FROM node_executions WHERE node_executions.id IN (?,?) AND NOT EXISTS (SELECT 1 FROM ` +
+		`executions WHERE executions.execution_project = node_executions.execution_project AND ` +
+		`executions.execution_domain = node_executions.execution_domain AND ` +
+		`executions.execution_name = node_executions.execution_name AND executions.phase NOT IN (?,?,?,?)) AND ` +
+		`NOT EXISTS`).WithRowsNum(2)
+
+	deleted, err := retentionRepo.Delete(context.Background(), interfaces.NodeExecutionsTable, []uint{1, 2})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Equal(t, int64(2), deleted)
+}
+
+func TestDeleteExpired_OffloadedLiterals(t *testing.T) {
+	retentionRepo := NewRetentionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	// Literals of executions which were never created are left for the collector.
+	query.WithQuery(`DELETE FROM offloaded_literals WHERE offloaded_literals.id IN (?) AND NOT EXISTS (SELECT 1 ` +
+		`FROM executions WHERE executions.execution_project = offloaded_literals.execution_project AND ` +
+		`executions.execution_domain = offloaded_literals.execution_domain AND ` +
+		`executions.execution_name = offloaded_literals.execution_name AND executions.phase NOT IN (?,?,?,?)) AND ` +
+		`EXISTS (SELECT 1 FROM executions WHERE`).WithRowsNum(1)
+
+	deleted, err := retentionRepo.Delete(context.Background(), interfaces.OffloadedLiteralsTable, []uint{1})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Equal(t, int64(1), deleted)
+}
+
+func TestListExcessVersions(t *testing.T) {
+	retentionRepo := NewRetentionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "workflows"  WHERE (workflows.id > 1) AND (workflows.project = project AND ` +
+		`workflows.domain = domain AND (SELECT COUNT(*) FROM workflows AS newer WHERE`).WithReply(
+		[]map[string]interface{}{
+			{"id": int64(2), "name": "workflow", "version": "v1"},
+		})
+
+	records, err := retentionRepo.ListExcessVersions(context.Background(), interfaces.ListExcessVersionsInput{
+		Table:       interfaces.WorkflowsTable,
+		Project:     "project",
+		Domain:      "domain",
+		MaxVersions: 10,
+		AfterID:     1,
+		Limit:       2,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Len(t, records, 1)
+	assert.Equal(t, uint(2), records[0].ID)
+	assert.Equal(t, "v1", records[0].Columns["version"])
+}
+
+func TestListExcessVersions_InvalidInput(t *testing.T) {
+	retentionRepo := NewRetentionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := retentionRepo.ListExcessVersions(context.Background(), interfaces.ListExcessVersionsInput{
+		Table:       interfaces.ExecutionsTable,
+		MaxVersions: 10,
+		Limit:       2,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	_, err = retentionRepo.ListExcessVersions(context.Background(), interfaces.ListExcessVersionsInput{
+		Table: interfaces.TasksTable,
+		Limit: 2,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteExcessVersions_LaunchPlans(t *testing.T) {
+	retentionRepo := NewRetentionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM launch_plans WHERE launch_plans.id IN (?,?) AND launch_plans.project = ? AND ` +
+		`launch_plans.domain = ? AND (SELECT COUNT(*) FROM launch_plans AS newer WHERE`).WithRowsNum(2)
+
+	deleted, err := retentionRepo.DeleteExcessVersions(context.Background(), interfaces.ListExcessVersionsInput{
+		Table:       interfaces.LaunchPlansTable,
+		Project:     "project",
+		Domain:      "domain",
+		MaxVersions: 10,
+	}, []uint{1, 2})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+	assert.Equal(t, int64(2), deleted)
+}
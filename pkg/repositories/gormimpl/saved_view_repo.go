@@ -0,0 +1,125 @@
+package gormimpl
+
+import (
+	"context"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Implementation of SavedViewRepoInterface.
+type SavedViewRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+// Project views have an empty owner, which struct conditions would ignore, so keys are matched with a map.
+func getSavedViewKeyCondition(input models.SavedViewKey) map[string]interface{} {
+	return map[string]interface{}{
+		"project": input.Project,
+		"domain":  input.Domain,
+		"owner":   input.Owner,
+		"name":    input.Name,
+	}
+}
+
+func getMissingSavedViewError(input models.SavedViewKey) error {
+	return flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "saved view [%s] of [%s/%s] doesn't exist",
+		input.Name, input.Project, input.Domain)
+}
+
+func (r *SavedViewRepo) Create(ctx context.Context, input models.SavedView) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *SavedViewRepo) Get(ctx context.Context, input models.SavedViewKey) (models.SavedView, error) {
+	var savedView models.SavedView
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(getSavedViewKeyCondition(input)).Take(&savedView)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.SavedView{}, getMissingSavedViewError(input)
+	}
+	if tx.Error != nil {
+		return models.SavedView{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return savedView, nil
+}
+
+func (r *SavedViewRepo) Update(ctx context.Context, input models.SavedView) error {
+	timer := r.metrics.UpdateDuration.Start()
+	// Filters, sort orders and descriptions can be cleared, so they're updated even when empty.
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.SavedView{}).Where(
+		getSavedViewKeyCondition(input.SavedViewKey)).Updates(map[string]interface{}{
+		"resource_type":  input.ResourceType,
+		"filters":        input.Filters,
+		"sort_key":       input.SortKey,
+		"sort_direction": input.SortDirection,
+		"description":    input.Description,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingSavedViewError(input.SavedViewKey)
+	}
+	return nil
+}
+
+func (r *SavedViewRepo) Delete(ctx context.Context, input models.SavedViewKey) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(getSavedViewKeyCondition(input)).Delete(&models.SavedView{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingSavedViewError(input)
+	}
+	return nil
+}
+
+func (r *SavedViewRepo) List(ctx context.Context, input interfaces.ListSavedViewsInput) ([]models.SavedView, error) {
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	var savedViews []models.SavedView
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.SavedView{
+		SavedViewKey: models.SavedViewKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+		},
+	}).Where("owner IN (?)", []string{"", input.Owner}).Order("name asc, owner asc").Limit(input.Limit).Offset(
+		input.Offset).Find(&savedViews)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return savedViews, nil
+}
+
+// Returns an instance of SavedViewRepoInterface
+func NewSavedViewRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.SavedViewRepoInterface {
+	metrics := newMetrics(scope)
+	return &SavedViewRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
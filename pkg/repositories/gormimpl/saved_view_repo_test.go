@@ -0,0 +1,83 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var savedViewKey = models.SavedViewKey{
+	Project: project,
+	Domain:  domain,
+	Name:    "failed runs",
+}
+
+func TestGetSavedView(t *testing.T) {
+	savedViewRepo := NewSavedViewRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "saved_views"`).WithReply([]map[string]interface{}{
+		{
+			"project":       project,
+			"domain":        domain,
+			"name":          "failed runs",
+			"resource_type": "executions",
+			"filters":       "eq(phase,FAILED)",
+		},
+	})
+
+	savedView, err := savedViewRepo.Get(context.Background(), savedViewKey)
+	assert.NoError(t, err)
+	assert.Equal(t, savedViewKey, savedView.SavedViewKey)
+	assert.Equal(t, "eq(phase,FAILED)", savedView.Filters)
+}
+
+func TestGetSavedView_NotFound(t *testing.T) {
+	savedViewRepo := NewSavedViewRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := savedViewRepo.Get(context.Background(), savedViewKey)
+	assert.EqualError(t, err, "saved view [failed runs] of [project/domain] doesn't exist")
+}
+
+func TestUpdateSavedView_NotFound(t *testing.T) {
+	savedViewRepo := NewSavedViewRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "saved_views"`).WithRowsNum(0)
+
+	err := savedViewRepo.Update(context.Background(), models.SavedView{
+		SavedViewKey: savedViewKey,
+		ResourceType: "executions",
+	})
+	assert.EqualError(t, err, "saved view [failed runs] of [project/domain] doesn't exist")
+}
+
+func TestListSavedViews(t *testing.T) {
+	savedViewRepo := NewSavedViewRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`ORDER BY name asc, owner asc LIMIT 10 OFFSET 0`).WithReply([]map[string]interface{}{
+		{"name": "failed runs"},
+		{"name": "failed runs", "owner": "jane"},
+	})
+
+	savedViews, err := savedViewRepo.List(context.Background(), interfaces.ListSavedViewsInput{
+		Project: project,
+		Domain:  domain,
+		Owner:   "jane",
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, savedViews, 2)
+	assert.Equal(t, "jane", savedViews[1].Owner)
+
+	_, err = savedViewRepo.List(context.Background(), interfaces.ListSavedViewsInput{
+		Project: project,
+		Domain:  domain,
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,102 @@
+package gormimpl
+
+import (
+	"context"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// Implementation of SignalRepoInterface.
+type SignalRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *SignalRepo) GetOrCreate(ctx context.Context, input *models.Signal) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Signal{
+		SignalKey: input.SignalKey,
+	}).FirstOrCreate(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *SignalRepo) Get(ctx context.Context, input models.SignalKey) (models.Signal, error) {
+	var signal models.Signal
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Signal{
+		SignalKey: input,
+	}).Take(&signal)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.Signal{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"signal [%s] of execution [%s/%s/%s] doesn't exist", input.SignalID, input.Project, input.Domain,
+			input.Name)
+	}
+	if tx.Error != nil {
+		return models.Signal{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return signal, nil
+}
+
+func (r *SignalRepo) List(ctx context.Context, input interfaces.ListSignalsInput) ([]models.Signal, error) {
+	if input.Limit == 0 {
+		return nil, errors.GetInvalidInputError(limit)
+	}
+	var signals []models.Signal
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Signal{
+		SignalKey: models.SignalKey{
+			ExecutionKey: input.Execution,
+		},
+	}).Order("id asc").Limit(input.Limit).Offset(input.Offset).Find(&signals)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return signals, nil
+}
+
+func (r *SignalRepo) Update(ctx context.Context, input models.Signal) error {
+	timer := r.metrics.UpdateDuration.Start()
+	// Signals are only set once, so that a gate can't be approved and then rejected while its node reads it.
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.Signal{}).Where(&models.Signal{
+		SignalKey: input.SignalKey,
+	}).Where("value IS NULL").Updates(map[string]interface{}{
+		"value":  input.Value,
+		"set_by": input.SetBy,
+		"set_at": input.SetAt,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"signal [%s] of execution [%s/%s/%s] is already set", input.SignalID, input.Project, input.Domain,
+			input.Name)
+	}
+	return nil
+}
+
+// Returns an instance of SignalRepoInterface
+func NewSignalRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.SignalRepoInterface {
+	metrics := newMetrics(scope)
+	return &SignalRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
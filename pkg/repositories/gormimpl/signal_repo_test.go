@@ -0,0 +1,98 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var signalKey = models.SignalKey{
+	ExecutionKey: models.ExecutionKey{
+		Project: project,
+		Domain:  domain,
+		Name:    name,
+	},
+	SignalID: "approve-deploy",
+}
+
+func TestGetSignal(t *testing.T) {
+	signalRepo := NewSignalRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "signals"`).WithReply([]map[string]interface{}{
+		{
+			"execution_project": project,
+			"execution_domain":  domain,
+			"execution_name":    name,
+			"signal_id":         "approve-deploy",
+			"set_by":            "jane",
+		},
+	})
+
+	signal, err := signalRepo.Get(context.Background(), signalKey)
+	assert.NoError(t, err)
+	assert.Equal(t, signalKey, signal.SignalKey)
+	assert.Equal(t, "jane", signal.SetBy)
+}
+
+func TestGetSignal_NotFound(t *testing.T) {
+	signalRepo := NewSignalRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	mocket.Catcher.Reset()
+
+	_, err := signalRepo.Get(context.Background(), signalKey)
+	assert.EqualError(t, err, "signal [approve-deploy] of execution [project/domain/name] doesn't exist")
+}
+
+func TestListSignals(t *testing.T) {
+	signalRepo := NewSignalRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`ORDER BY id asc LIMIT 10 OFFSET 0`).WithReply([]map[string]interface{}{
+		{"signal_id": "approve-deploy"},
+		{"signal_id": "approve-rollback"},
+	})
+
+	signals, err := signalRepo.List(context.Background(), interfaces.ListSignalsInput{
+		Execution: signalKey.ExecutionKey,
+		Limit:     10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, signals, 2)
+	assert.Equal(t, "approve-rollback", signals[1].SignalID)
+
+	_, err = signalRepo.List(context.Background(), interfaces.ListSignalsInput{
+		Execution: signalKey.ExecutionKey,
+	})
+	assert.Error(t, err)
+}
+
+func TestUpdateSignal(t *testing.T) {
+	signalRepo := NewSignalRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	updateQuery := GlobalMock.NewMock()
+	updateQuery.WithQuery(`UPDATE "signals"`).WithRowsNum(1)
+
+	err := signalRepo.Update(context.Background(), models.Signal{
+		SignalKey: signalKey,
+		Value:     []byte("value"),
+		SetBy:     "jane",
+	})
+	assert.NoError(t, err)
+	assert.True(t, updateQuery.Triggered)
+}
+
+func TestUpdateSignal_AlreadySet(t *testing.T) {
+	signalRepo := NewSignalRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`UPDATE "signals"`).WithRowsNum(0)
+
+	err := signalRepo.Update(context.Background(), models.Signal{
+		SignalKey: signalKey,
+		Value:     []byte("value"),
+	})
+	assert.EqualError(t, err, "signal [approve-deploy] of execution [project/domain/name] is already set")
+}
@@ -0,0 +1,76 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSkippedEvent(t *testing.T) {
+	skippedEventRepo := NewSkippedEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "skipped_events" ("created_at","execution_project","execution_domain",` +
+		`"execution_name","event_type","node_id","retry_attempt","request_id","phase","phase_version","occurred_at",` +
+		`"current_phase","reason") VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+
+	err := skippedEventRepo.Create(context.Background(), models.SkippedEvent{
+		ExecutionProject: project,
+		ExecutionDomain:  domain,
+		ExecutionName:    name,
+		EventType:        "node",
+		NodeID:           "node",
+		RequestID:        "request",
+		Phase:            "RUNNING",
+		OccurredAt:       time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC),
+		CurrentPhase:     "RUNNING",
+		Reason:           "DUPLICATE",
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListSkippedEvents(t *testing.T) {
+	skippedEventRepo := NewSkippedEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "skipped_events"  WHERE (execution_project = project) ` +
+		`LIMIT 10 OFFSET 0`).WithReply([]map[string]interface{}{
+		{
+			"id":                1,
+			"execution_project": project,
+			"event_type":        "node",
+			"reason":            "DUPLICATE",
+		},
+		{
+			"id":                2,
+			"execution_project": project,
+			"event_type":        "task",
+			"reason":            "STALE",
+		},
+	})
+
+	skippedEvents, err := skippedEventRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.SkippedEvent, "execution_project", project),
+		},
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, skippedEvents, 2)
+	assert.Equal(t, "DUPLICATE", skippedEvents[0].Reason)
+	assert.Equal(t, "task", skippedEvents[1].EventType)
+}
+
+func TestListSkippedEvents_MissingFilters(t *testing.T) {
+	skippedEventRepo := NewSkippedEventRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := skippedEventRepo.List(context.Background(), interfaces.ListResourceInput{Limit: 10})
+	assert.EqualError(t, err, "missing and/or invalid parameters: filters")
+}
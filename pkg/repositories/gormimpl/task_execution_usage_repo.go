@@ -0,0 +1,66 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+const taskExecutionUsageSumSelect = "execution_project, execution_domain, " +
+	"SUM(cpu_hours) AS cpu_hours, SUM(gpu_hours) AS gpu_hours, SUM(memory_gb_hours) AS memory_gb_hours, " +
+	"COUNT(*) AS task_executions, COUNT(DISTINCT execution_name) AS executions"
+
+// Implementation of TaskExecutionUsageRepoInterface.
+type TaskExecutionUsageRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *TaskExecutionUsageRepo) Create(ctx context.Context, input models.TaskExecutionUsage) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *TaskExecutionUsageRepo) Sum(ctx context.Context, input interfaces.SumTaskExecutionUsageInput) (
+	[]models.TaskExecutionUsageSum, error) {
+	if len(input.InlineFilters) == 0 {
+		return nil, errors.GetInvalidInputError(filters)
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.TaskExecutionUsage{}).Select(
+		taskExecutionUsageSumSelect)
+	tx, err := applyFilters(tx, input.InlineFilters, nil)
+	if err != nil {
+		return nil, err
+	}
+	var sums []models.TaskExecutionUsageSum
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Group("execution_project, execution_domain").Order(
+		"execution_project, execution_domain").Scan(&sums)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return sums, nil
+}
+
+// Returns an instance of TaskExecutionUsageRepoInterface
+func NewTaskExecutionUsageRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.TaskExecutionUsageRepoInterface {
+	metrics := newMetrics(scope)
+	return &TaskExecutionUsageRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,105 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTaskExecutionUsage(t *testing.T) {
+	usageRepo := NewTaskExecutionUsageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	query := GlobalMock.NewMock()
+	query.WithQuery(`INSERT INTO "task_execution_usages" ("created_at","updated_at","deleted_at",` +
+		`"execution_project","execution_domain","execution_name","node_id","retry_attempt","task_project",` +
+		`"task_domain","task_name","task_version","ended_at","cpu_hours","gpu_hours","memory_gb_hours") ` +
+		`VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
+
+	retryAttempt := uint32(0)
+	err := usageRepo.Create(context.Background(), models.TaskExecutionUsage{
+		ExecutionKey: models.ExecutionKey{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+		},
+		NodeID:        "node",
+		RetryAttempt:  &retryAttempt,
+		TaskProject:   project,
+		TaskDomain:    domain,
+		TaskName:      name,
+		TaskVersion:   version,
+		EndedAt:       time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC),
+		CPUHours:      1,
+		MemoryGBHours: 2,
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestSumTaskExecutionUsage(t *testing.T) {
+	usageRepo := NewTaskExecutionUsageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(`SELECT execution_project, execution_domain, SUM(cpu_hours) AS cpu_hours, ` +
+		`SUM(gpu_hours) AS gpu_hours, SUM(memory_gb_hours) AS memory_gb_hours, COUNT(*) AS task_executions, ` +
+		`COUNT(DISTINCT execution_name) AS executions FROM "task_execution_usages"  WHERE ` +
+		`"task_execution_usages"."deleted_at" IS NULL AND ((execution_project = project)) ` +
+		`GROUP BY execution_project, execution_domain ORDER BY execution_project, execution_domain`).WithReply(
+		[]map[string]interface{}{
+			{
+				"execution_project": project,
+				"execution_domain":  "development",
+				"cpu_hours":         1.5,
+				"gpu_hours":         0.0,
+				"memory_gb_hours":   3.0,
+				"task_executions":   2,
+				"executions":        1,
+			},
+			{
+				"execution_project": project,
+				"execution_domain":  "production",
+				"cpu_hours":         0.0,
+				"gpu_hours":         4.0,
+				"memory_gb_hours":   0.0,
+				"task_executions":   1,
+				"executions":        1,
+			},
+		})
+
+	sums, err := usageRepo.Sum(context.Background(), interfaces.SumTaskExecutionUsageInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.TaskExecutionUsage, "execution_project", project),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.TaskExecutionUsageSum{
+		{
+			ExecutionProject: project,
+			ExecutionDomain:  "development",
+			CPUHours:         1.5,
+			MemoryGBHours:    3,
+			TaskExecutions:   2,
+			Executions:       1,
+		},
+		{
+			ExecutionProject: project,
+			ExecutionDomain:  "production",
+			GPUHours:         4,
+			TaskExecutions:   1,
+			Executions:       1,
+		},
+	}, sums)
+}
+
+func TestSumTaskExecutionUsage_MissingFilters(t *testing.T) {
+	usageRepo := NewTaskExecutionUsageRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	_, err := usageRepo.Sum(context.Background(), interfaces.SumTaskExecutionUsageInput{})
+	assert.EqualError(t, err, "missing and/or invalid parameters: filters")
+}
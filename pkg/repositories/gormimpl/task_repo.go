@@ -7,6 +7,7 @@ import (
 
 	"github.com/flyteorg/flytestdlib/promutils"
 
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
@@ -22,7 +23,7 @@ type TaskRepo struct {
 
 func (r *TaskRepo) Create(ctx context.Context, input models.Task) error {
 	timer := r.metrics.CreateDuration.Start()
-	tx := r.db.Create(&input)
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -33,7 +34,7 @@ func (r *TaskRepo) Create(ctx context.Context, input models.Task) error {
 func (r *TaskRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Task, error) {
 	var task models.Task
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.Task{
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Task{
 		TaskKey: models.TaskKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -56,6 +57,21 @@ func (r *TaskRepo) Get(ctx context.Context, input interfaces.Identifier) (models
 	return task, nil
 }
 
+func (r *TaskRepo) GetBatch(ctx context.Context, input []interfaces.Identifier) ([]models.Task, error) {
+	tasks := make([]models.Task, 0, len(input))
+	if len(input) == 0 {
+		return tasks, nil
+	}
+	query, args := getIdentifiersCondition(input)
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(query, args...).Find(&tasks)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return tasks, nil
+}
+
 func (r *TaskRepo) List(
 	ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error) {
 	// First validate input.
@@ -63,7 +79,7 @@ func (r *TaskRepo) List(
 		return interfaces.TaskCollectionOutput{}, err
 	}
 	var tasks []models.Task
-	tx := r.db.Limit(input.Limit).Offset(input.Offset)
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -94,7 +110,7 @@ func (r *TaskRepo) ListTaskIdentifiers(ctx context.Context, input interfaces.Lis
 		return interfaces.TaskCollectionOutput{}, err
 	}
 
-	tx := r.db.Model(models.Task{}).Limit(input.Limit).Offset(input.Offset)
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(models.Task{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
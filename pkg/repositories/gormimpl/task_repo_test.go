@@ -82,6 +82,46 @@ func TestGetTask(t *testing.T) {
 	assert.Equal(t, pythonTestTaskType, output.Type)
 }
 
+func TestGetBatchTasks(t *testing.T) {
+	taskRepo := NewTaskRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	output, err := taskRepo.GetBatch(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, output)
+
+	tasks := []map[string]interface{}{
+		getMockTaskResponseFromDb(version, []byte{1, 2}),
+		getMockTaskResponseFromDb("ABC", []byte{3, 4}),
+	}
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "tasks"  WHERE "tasks"."deleted_at" IS NULL AND ((` +
+			`(project = project AND domain = domain AND name = name AND version = XYZ) OR ` +
+			`(project = project AND domain = domain AND name = name AND version = ABC)))`).
+		WithReply(tasks)
+	output, err = taskRepo.GetBatch(context.Background(), []interfaces.Identifier{
+		{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+			Version: version,
+		},
+		{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+			Version: "ABC",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output, 2)
+	assert.Equal(t, version, output[0].Version)
+	assert.Equal(t, []byte{1, 2}, output[0].Closure)
+	assert.Equal(t, "ABC", output[1].Version)
+	assert.Equal(t, []byte{3, 4}, output[1].Closure)
+}
+
 func TestListTasks(t *testing.T) {
 	taskRepo := NewTaskRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 
@@ -0,0 +1,121 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of TriggerRepoInterface.
+type TriggerRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getMissingTriggerError(input interfaces.Identifier) error {
+	return errors.GetMissingEntityError("trigger", &admin.NamedEntityIdentifier{
+		Project: input.Project,
+		Domain:  input.Domain,
+		Name:    input.Name,
+	})
+}
+
+func (r *TriggerRepo) Create(ctx context.Context, input models.Trigger) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *TriggerRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Trigger, error) {
+	var trigger models.Trigger
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Trigger{
+		TriggerKey: models.TriggerKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Take(&trigger)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.Trigger{}, getMissingTriggerError(input)
+	}
+	if tx.Error != nil {
+		return models.Trigger{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return trigger, nil
+}
+
+func (r *TriggerRepo) Update(ctx context.Context, input models.Trigger) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&input).Updates(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *TriggerRepo) Delete(ctx context.Context, input interfaces.Identifier) error {
+	timer := r.metrics.DeleteDuration.Start()
+	// Triggers are deleted outright rather than soft-deleted, so that their primary key can be registered again.
+	tx := repositoryConfig.WithContext(ctx, r.db).Unscoped().Where(&models.Trigger{
+		TriggerKey: models.TriggerKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Delete(&models.Trigger{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingTriggerError(input)
+	}
+	return nil
+}
+
+func (r *TriggerRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Trigger, error) {
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	var triggers []models.Trigger
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&triggers)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return triggers, nil
+}
+
+// Returns an instance of TriggerRepoInterface
+func NewTriggerRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.TriggerRepoInterface {
+	metrics := newMetrics(scope)
+	return &TriggerRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,174 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/jinzhu/gorm"
+)
+
+// Implementation of WebhookRepoInterface.
+type WebhookRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getMissingWebhookError(input interfaces.Identifier) error {
+	return errors.GetMissingEntityError("webhook", &admin.NamedEntityIdentifier{
+		Project: input.Project,
+		Domain:  input.Domain,
+		Name:    input.Name,
+	})
+}
+
+func (r *WebhookRepo) Create(ctx context.Context, input models.Webhook) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *WebhookRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Webhook, error) {
+	var webhook models.Webhook
+	timer := r.metrics.GetDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.Webhook{
+		WebhookKey: models.WebhookKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Take(&webhook)
+	timer.Stop()
+	if tx.RecordNotFound() {
+		return models.Webhook{}, getMissingWebhookError(input)
+	}
+	if tx.Error != nil {
+		return models.Webhook{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return webhook, nil
+}
+
+func (r *WebhookRepo) Update(ctx context.Context, input models.Webhook) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&input).Updates(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *WebhookRepo) Delete(ctx context.Context, input interfaces.Identifier) error {
+	timer := r.metrics.DeleteDuration.Start()
+	// Webhooks are deleted outright rather than soft-deleted, so that their primary key can be registered again.
+	tx := repositoryConfig.WithContext(ctx, r.db).Unscoped().Where(&models.Webhook{
+		WebhookKey: models.WebhookKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+			Name:    input.Name,
+		},
+	}).Delete(&models.Webhook{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return getMissingWebhookError(input)
+	}
+	return nil
+}
+
+func (r *WebhookRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
+	interfaces.WebhookCollectionOutput, error) {
+	if err := ValidateListInput(input); err != nil {
+		return interfaces.WebhookCollectionOutput{}, err
+	}
+	var webhooks []models.Webhook
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return interfaces.WebhookCollectionOutput{}, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&webhooks)
+	timer.Stop()
+	if tx.Error != nil {
+		return interfaces.WebhookCollectionOutput{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return interfaces.WebhookCollectionOutput{
+		Webhooks: webhooks,
+	}, nil
+}
+
+func (r *WebhookRepo) CreateDelivery(ctx context.Context, input models.WebhookDelivery) (uint, error) {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return 0, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return input.ID, nil
+}
+
+func (r *WebhookRepo) UpdateDelivery(ctx context.Context, input models.WebhookDelivery) error {
+	timer := r.metrics.UpdateDuration.Start()
+	// The response code and last error are cleared by a successful attempt, so they're updated even when empty.
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.WebhookDelivery{ID: input.ID}).Updates(
+		map[string]interface{}{
+			"status":        input.Status,
+			"attempts":      input.Attempts,
+			"response_code": input.ResponseCode,
+			"last_error":    input.LastError,
+		})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *WebhookRepo) ListDeliveries(
+	ctx context.Context, input interfaces.ListResourceInput) ([]models.WebhookDelivery, error) {
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	tx := repositoryConfig.WithContext(ctx, r.db).Limit(input.Limit).Offset(input.Offset)
+	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	if input.SortParameter != nil {
+		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	}
+	var deliveries []models.WebhookDelivery
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Find(&deliveries)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return deliveries, nil
+}
+
+// Returns an instance of WebhookRepoInterface
+func NewWebhookRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.WebhookRepoInterface {
+	metrics := newMetrics(scope)
+	return &WebhookRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
@@ -71,6 +71,30 @@ func TestGetWorkflow(t *testing.T) {
 	assert.Equal(t, remoteSpecIdentifier, output.RemoteClosureIdentifier)
 }
 
+func TestGetBatchWorkflows(t *testing.T) {
+	workflowRepo := NewWorkflowRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	workflows := []map[string]interface{}{
+		getMockWorkflowResponseFromDb(version, typedInterface),
+	}
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "workflows"  WHERE "workflows"."deleted_at" IS NULL AND ((` +
+			`(project = project AND domain = domain AND name = name AND version = XYZ)))`).WithReply(workflows)
+	output, err := workflowRepo.GetBatch(context.Background(), []interfaces.Identifier{
+		{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+			Version: version,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output, 1)
+	assert.Equal(t, version, output[0].Version)
+	assert.Equal(t, typedInterface, output[0].TypedInterface)
+}
+
 func TestListWorkflows(t *testing.T) {
 	workflowRepo := NewWorkflowRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
 
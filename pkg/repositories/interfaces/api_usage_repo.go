@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=APIUsageRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the daily totals of API calls.
+type APIUsageRepoInterface interface {
+	// Adds the calls, errors and latency of input to the totals of its day, method, principal and project.
+	Increment(ctx context.Context, input models.APIUsage) error
+	// Sums the usage matching the filters for each group of the columns grouped by, ordered by the most calls first.
+	// At least one filter must be provided.
+	Sum(ctx context.Context, input SumAPIUsageInput) ([]models.APIUsageSum, error)
+}
+
+type SumAPIUsageInput struct {
+	InlineFilters []common.InlineFilter
+	// Any of method, principal and project.
+	GroupBy []string
+	// Returns every group when zero.
+	Limit int
+}
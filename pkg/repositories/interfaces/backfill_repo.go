@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=BackfillRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with backfill models.
+type BackfillRepoInterface interface {
+	// Inserts a backfill model into the database store.
+	Create(ctx context.Context, input models.Backfill) error
+	// Returns a matching backfill if it exists. The version of the identifier is ignored.
+	Get(ctx context.Context, input Identifier) (models.Backfill, error)
+	// Updates an existing backfill in the database store with all non-empty fields in the input.
+	Update(ctx context.Context, input models.Backfill) error
+	// Updates the launched count and phase of a running backfill. Returns false without updating the backfill if it's no
+	// longer running, such as when it was terminated concurrently.
+	UpdateProgress(ctx context.Context, input models.Backfill) (bool, error)
+	// Returns backfills matching query parameters. A limit must be provided for the results page size.
+	List(ctx context.Context, input ListResourceInput) (BackfillCollectionOutput, error)
+}
+
+// Response format for a query on backfills.
+type BackfillCollectionOutput struct {
+	Backfills []models.Backfill
+}
@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=CacheEvictionRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the evictions of cached task outputs.
+type CacheEvictionRepoInterface interface {
+	// Inserts the evictions of a single request in one transaction.
+	Create(ctx context.Context, evictions []models.CacheEviction) error
+	// Returns the evictions of outputs cached for tasks of a project and domain, most recent first.
+	List(ctx context.Context, input ListCacheEvictionsInput) ([]models.CacheEviction, error)
+}
+
+type ListCacheEvictionsInput struct {
+	Project string
+	Domain  string
+	Limit   int
+	Offset  int
+}
@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=ClusterHealthRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the health states of execution clusters.
+type ClusterHealthRepoInterface interface {
+	// Inserts or updates the health state of a cluster in the database store, leaving its probe results as they are.
+	CreateOrUpdate(ctx context.Context, input models.ClusterHealth) error
+	// Inserts or updates the health state of a cluster along with the results of a health probe.
+	RecordProbe(ctx context.Context, input models.ClusterHealth) error
+	// Returns the health state of a cluster if one was set.
+	Get(ctx context.Context, cluster string) (models.ClusterHealth, error)
+	// Returns the health states of all clusters which have one.
+	ListAll(ctx context.Context) ([]models.ClusterHealth, error)
+}
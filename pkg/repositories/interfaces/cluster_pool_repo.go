@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=ClusterPoolRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with pools of execution clusters.
+type ClusterPoolRepoInterface interface {
+	// Inserts a cluster pool model into the database store.
+	Create(ctx context.Context, input models.ClusterPool) error
+	// Returns the cluster pool with a name if it exists.
+	Get(ctx context.Context, name string) (models.ClusterPool, error)
+	// Updates the description, labels and members of an existing cluster pool in the database store.
+	Update(ctx context.Context, input models.ClusterPool) error
+	// Deletes a cluster pool, so that executions are no longer routed to it.
+	Delete(ctx context.Context, name string) error
+	// Returns cluster pools matching query parameters. A limit must be provided for the results page size.
+	List(ctx context.Context, input ListResourceInput) ([]models.ClusterPool, error)
+}
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=ClusterResourceSyncRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the sync statuses of cluster resource templates.
+type ClusterResourceSyncRepoInterface interface {
+	// Records that a template was applied, clearing its apply error, drift and cleanup.
+	RecordApplied(ctx context.Context, input models.ClusterResourceSync) error
+	// Records the error of a failed attempt at applying a template, leaving the version last applied as it is.
+	RecordApplyError(ctx context.Context, input models.ClusterResourceSync) error
+	// Records the outcome of checking an applied template for drift.
+	RecordDrift(ctx context.Context, input models.ClusterResourceSync) error
+	// Records the cleanup state of a template once its project is archived or its domain removed.
+	RecordCleanup(ctx context.Context, input models.ClusterResourceSync) error
+	// Returns the sync statuses of the templates of a project and domain across clusters.
+	List(ctx context.Context, project, domain string) ([]models.ClusterResourceSync, error)
+	// Returns the sync statuses of all templates.
+	ListAll(ctx context.Context) ([]models.ClusterResourceSync, error)
+}
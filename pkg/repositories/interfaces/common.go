@@ -1,6 +1,8 @@
 package interfaces
 
 import (
+	"time"
+
 	"github.com/flyteorg/flyteadmin/pkg/common"
 )
 
@@ -25,6 +27,30 @@ type ListResourceInput struct {
 	// A set of the entities (besides the primary table being queries) that should be joined with when performing
 	// the list query. This enables filtering on non-primary entity attributes.
 	JoinTableEntities map[common.Entity]bool
+	// When set, resources are ordered by creation time and listed after the cursor rather than skipping Offset
+	// resources. Only supported by repositories that document it.
+	Cursor *ListCursor
+}
+
+// Parameters for counting multiple resources.
+type CountResourceInput struct {
+	InlineFilters []common.InlineFilter
+	MapFilters    []common.MapFilter
+}
+
+// Keyset pagination cursor. Unlike offsets, which require scanning every skipped row, cursors are resolved with an
+// index lookup regardless of how deep into the result set the requested page is.
+type ListCursor struct {
+	// Whether resources are listed from newest to oldest.
+	Descending bool
+	// The creation time and id of the last resource on the previous page. Unset when requesting the first page.
+	CreatedAt time.Time
+	ID        uint
+}
+
+// IsFirstPage returns true when the cursor doesn't point past any resource yet.
+func (c ListCursor) IsFirstPage() bool {
+	return c.ID == 0
 }
 
 // Describes a set of resources for which to apply attribute updates.
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Defines the interface for interacting with the resource quotas of projects and domains.
+type DomainQuotaRepoInterface interface {
+	// Inserts or updates the quota of a project and domain in the database store.
+	CreateOrUpdate(ctx context.Context, input models.DomainQuota) error
+	// Returns the quota of a project and domain if one was set.
+	Get(ctx context.Context, project, domain string) (models.DomainQuota, error)
+	Delete(ctx context.Context, project, domain string) error
+	// Returns a page of quotas matching the input filters.
+	List(ctx context.Context, input ListResourceInput) ([]models.DomainQuota, error)
+}
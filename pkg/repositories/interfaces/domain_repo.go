@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Defines the interface for interacting with the domains shared by all projects.
+type DomainRepoInterface interface {
+	// Inserts a domain model into the database store.
+	Create(ctx context.Context, domain models.Domain) error
+	// Returns a matching domain when it exists.
+	Get(ctx context.Context, domainID string) (models.Domain, error)
+	// Returns every domain, ordered by identifier.
+	List(ctx context.Context) ([]models.Domain, error)
+	// Replaces the name and description of an existing domain.
+	Update(ctx context.Context, domain models.Domain) error
+	Delete(ctx context.Context, domainID string) error
+}
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=ExecutionCommentRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the comments left on executions.
+type ExecutionCommentRepoInterface interface {
+	// Inserts an execution comment model into the database store and populates its id and creation time.
+	Create(ctx context.Context, input *models.ExecutionComment) error
+	// Returns the comments of an execution in the order they were left.
+	List(ctx context.Context, input ListExecutionCommentsInput) ([]models.ExecutionComment, error)
+}
+
+type ListExecutionCommentsInput struct {
+	Execution models.ExecutionKey
+	Limit     int
+	Offset    int
+}
@@ -2,7 +2,9 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
+	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 )
 
@@ -12,12 +14,98 @@ type ExecutionRepoInterface interface {
 	Create(ctx context.Context, input models.Execution) error
 	// This updates only an existing execution model with all non-empty fields in the input.
 	Update(ctx context.Context, execution models.Execution) error
+	// Updates an execution model as Update does, and writes messages to the outbox in the same transaction, so that
+	// they're published if and only if the update is committed.
+	UpdateWithMessages(ctx context.Context, execution models.Execution, messages []models.OutboxMessage) error
 	// Returns a matching execution if it exists.
 	Get(ctx context.Context, input Identifier) (models.Execution, error)
+	// Returns the execution created with an idempotency key if it exists. Soft-deleted executions are returned too,
+	// since they keep their keys.
+	GetByIdempotencyKey(ctx context.Context, input IdempotencyKey) (models.Execution, error)
+	// Reserves an idempotency key for the request launching the execution created with it. Returns an AlreadyExists
+	// error if another request holds an unexpired reservation of the key.
+	ReserveIdempotencyKey(ctx context.Context, input ReserveIdempotencyKeyInput) error
+	// Releases a reservation of an idempotency key, unless another request has since taken it over.
+	ReleaseIdempotencyKey(ctx context.Context, input IdempotencyKey, reservationID string) error
 	// Returns executions matching query parameters. A limit must be provided for the results page size.
 	List(ctx context.Context, input ListResourceInput) (ExecutionCollectionOutput, error)
+	// Returns the number of executions matching query parameters.
+	Count(ctx context.Context, input CountResourceInput) (int64, error)
+	// Counts the executions matching the filters in each group of the columns grouped by, ordered by those columns.
+	// Every matching execution is counted as a single group when no columns are grouped by.
+	CountGroups(ctx context.Context, input CountExecutionGroupsInput) ([]models.ExecutionGroupCount, error)
+	// Counts the executions of a project and domain each label key is applied to, or each value of one key, most
+	// applied first.
+	ListLabels(ctx context.Context, input ListExecutionLabelsInput) ([]models.ExecutionLabelCount, error)
 	// Returns a matching execution if it exists.
 	Exists(ctx context.Context, input Identifier) (bool, error)
+	// Records that a matching pending execution was launched on a cluster. Executions which aren't pending, such as
+	// those another admin already dispatched, are left unchanged.
+	MarkDispatched(ctx context.Context, input Identifier, cluster string) error
+	// Blocks until this caller is the only one deciding whether executions of a project and domain are admitted, across
+	// every replica, and returns a function which lets the next caller decide. Callers count the running executions,
+	// launch and create the admitted execution in between, so that concurrent launches can't all be admitted against
+	// the same count.
+	LockAdmission(ctx context.Context, project, domain string) (unlock func(), err error)
+	// Records that a matching pending execution failed to launch with the given error.
+	RecordLaunchFailure(ctx context.Context, input Identifier, launchError string) error
+	// Returns the outcome of each execution launched from a launch plan which was last updated in one of the given
+	// phases at or after a time.
+	ListLaunchPlanOutcomes(ctx context.Context, input ListLaunchPlanOutcomesInput) (
+		[]models.LaunchPlanExecutionOutcome, error)
+	// Soft-deletes a matching execution, which is then excluded from gets and lists until it's restored.
+	Delete(ctx context.Context, input Identifier) error
+	// Restores a matching soft-deleted execution.
+	Restore(ctx context.Context, input Identifier) error
+}
+
+// Identifies an execution by the idempotency key it was created with.
+type IdempotencyKey struct {
+	Project string
+	Domain  string
+	Key     string
+}
+
+type ReserveIdempotencyKeyInput struct {
+	IdempotencyKey
+	// Identifies the request reserving the key.
+	ReservationID string
+	// Reservations which expired before this time are taken over.
+	Now           time.Time
+	ReservedUntil time.Time
+}
+
+// The columns executions may be counted by.
+const (
+	ExecutionGroupByProject = "project"
+	ExecutionGroupByDomain  = "domain"
+	ExecutionGroupByPhase   = "phase"
+	// The UTC day executions were created on.
+	ExecutionGroupByDay = "day"
+)
+
+type CountExecutionGroupsInput struct {
+	InlineFilters []common.InlineFilter
+	// The entities other than executions the filters refer to, which are joined with.
+	JoinTableEntities map[common.Entity]bool
+	// Any of the ExecutionGroupBy columns.
+	GroupBy []string
+	// Returns every group when zero.
+	Limit int
+}
+
+type ListExecutionLabelsInput struct {
+	Project string
+	Domain  string
+	// Optional, when set the values of the label with this key are counted rather than the keys.
+	Key string
+	// Returns every label when zero.
+	Limit int
+}
+
+type ListLaunchPlanOutcomesInput struct {
+	Phases       []string
+	UpdatedSince time.Time
 }
 
 // Response format for a query on workflows.
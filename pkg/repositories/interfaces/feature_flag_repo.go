@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Defines the interface for interacting with the overrides of feature flags, along with the changes made to them.
+type FeatureFlagRepoInterface interface {
+	// Inserts or updates the override of a flag for its project, and records the change in the same transaction.
+	Set(ctx context.Context, input models.FeatureFlag, change models.FeatureFlagChange) error
+	// Deletes the override of a flag for a project, and records the change in the same transaction.
+	Clear(ctx context.Context, name, project string, change models.FeatureFlagChange) error
+	// Returns every override of a flag, for every project and for single ones.
+	Get(ctx context.Context, name string) ([]models.FeatureFlag, error)
+	// Returns every override of every flag, ordered by flag and project.
+	ListAll(ctx context.Context) ([]models.FeatureFlag, error)
+	// Returns the changes made to flags, most recent first.
+	ListChanges(ctx context.Context, input ListFeatureFlagChangesInput) ([]models.FeatureFlagChange, error)
+}
+
+type ListFeatureFlagChangesInput struct {
+	// Lists the changes to every flag when empty.
+	Name   string
+	Limit  int
+	Offset int
+}
@@ -23,6 +23,10 @@ type LaunchPlanRepoInterface interface {
 	List(ctx context.Context, input ListResourceInput) (LaunchPlanCollectionOutput, error)
 	// Returns a list of identifiers for launch plans.  A limit must be provided for the results page size.
 	ListLaunchPlanIdentifiers(ctx context.Context, input ListResourceInput) (LaunchPlanCollectionOutput, error)
+	// Soft-deletes a matching launch plan, which is then excluded from gets and lists until it's restored.
+	Delete(ctx context.Context, input Identifier) error
+	// Restores a matching soft-deleted launch plan.
+	Restore(ctx context.Context, input Identifier) error
 }
 
 type SetStateInput struct {
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=LaunchPlanRolloutRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the rollout policies of launch plans.
+type LaunchPlanRolloutRepoInterface interface {
+	// Inserts a launch plan rollout model into the database store.
+	Create(ctx context.Context, input models.LaunchPlanRollout) error
+	// Returns a matching launch plan rollout if it exists. The version of the identifier is ignored.
+	Get(ctx context.Context, input Identifier) (models.LaunchPlanRollout, error)
+	// Updates the policy and state of an existing launch plan rollout in the database store, including empty fields.
+	Update(ctx context.Context, input models.LaunchPlanRollout) error
+	// Deletes a launch plan rollout, so that its launch plan's versions are no longer activated automatically.
+	Delete(ctx context.Context, input Identifier) error
+	// Returns launch plan rollouts matching query parameters. A limit must be provided for the results page size.
+	List(ctx context.Context, input ListResourceInput) ([]models.LaunchPlanRollout, error)
+}
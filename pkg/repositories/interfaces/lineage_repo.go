@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=LineageRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the artifacts task executions read and wrote, and the relationships
+// between executions.
+type LineageRepoInterface interface {
+	// Inserts the artifacts of a task execution into the database store in a single transaction.
+	CreateArtifacts(ctx context.Context, input []models.TaskExecutionArtifact) error
+	// Returns the artifacts matching the filters. At least one filter must be provided.
+	ListArtifacts(ctx context.Context, input ListResourceInput) ([]models.TaskExecutionArtifact, error)
+	// Inserts an execution relationship model into the database store.
+	CreateRelationship(ctx context.Context, input models.ExecutionRelationship) error
+	// Returns the relationships matching the filters. At least one filter must be provided.
+	ListRelationships(ctx context.Context, input ListResourceInput) ([]models.ExecutionRelationship, error)
+}
@@ -27,6 +27,35 @@ type NamedEntityCollectionOutput struct {
 	Entities []models.NamedEntity
 }
 
+// Parameters for searching named entities by their names and descriptions.
+type SearchNamedEntityInput struct {
+	Project string
+	Domain  string
+	// Matched case insensitively against names and descriptions. Postgres also matches names and descriptions
+	// similar to the query, so that typos are tolerated.
+	Query string
+	// All resource types are searched when empty.
+	ResourceTypes []core.ResourceType
+	Limit         int
+}
+
+// Parameters for listing the named entities with a tag across projects.
+type ListNamedEntityByTagInput struct {
+	Tag string
+	// All resource types are listed when empty.
+	ResourceTypes []core.ResourceType
+	// Restricts the entities listed to those in the given projects, unless nil.
+	Projects []string
+	Limit    int
+	Offset   int
+}
+
+// A named entity matching a search, ranked from 0 to 1 by how closely it matches.
+type NamedEntitySearchResult struct {
+	models.NamedEntity
+	Rank float64
+}
+
 // Defines the interface for interacting with NamedEntity models
 type NamedEntityRepoInterface interface {
 	// Returns NamedEntity objects matching the provided query. A limit is
@@ -36,4 +65,19 @@ type NamedEntityRepoInterface interface {
 	Update(ctx context.Context, input models.NamedEntity) error
 	// Gets metadata (if available) associated with a NamedEntity
 	Get(ctx context.Context, input GetNamedEntityInput) (models.NamedEntity, error)
+	// Returns the active named entities matching a search across resource types, ordered from the closest match.
+	Search(ctx context.Context, input SearchNamedEntityInput) ([]NamedEntitySearchResult, error)
+	// Returns the description document of a named entity, or a NotFound error when none was saved.
+	GetDescription(ctx context.Context, input GetNamedEntityInput) (models.NamedEntityDescription, error)
+	// Replaces the description document of a named entity, creating it if it does not exist.
+	UpdateDescription(ctx context.Context, input models.NamedEntityDescription) error
+	// Applies tags to a named entity. Tags it already has are left unchanged.
+	AddTags(ctx context.Context, input GetNamedEntityInput, tags []string) error
+	// Removes tags from a named entity.
+	RemoveTags(ctx context.Context, input GetNamedEntityInput, tags []string) error
+	// Returns the tags of a named entity in alphabetical order.
+	ListTags(ctx context.Context, input GetNamedEntityInput) ([]string, error)
+	// Returns the named entities with a tag along with their metadata, ordered by project, domain and name. A limit
+	// is required.
+	ListByTag(ctx context.Context, input ListNamedEntityByTagInput) (NamedEntityCollectionOutput, error)
 }
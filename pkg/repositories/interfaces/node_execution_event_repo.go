@@ -11,4 +11,6 @@ import (
 type NodeExecutionEventRepoInterface interface {
 	// Inserts a node execution event into the database store.
 	Create(ctx context.Context, input models.NodeExecutionEvent) error
+	// Inserts node execution events into the database store in a single transaction.
+	BatchCreate(ctx context.Context, input []models.NodeExecutionEvent) error
 }
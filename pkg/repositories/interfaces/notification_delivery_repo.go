@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=NotificationDeliveryRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the record of notification deliveries.
+type NotificationDeliveryRepoInterface interface {
+	// Inserts a notification delivery model into the database store and returns its id.
+	Create(ctx context.Context, input models.NotificationDelivery) (uint, error)
+	// Returns the notification delivery with an id if it exists.
+	Get(ctx context.Context, id uint) (models.NotificationDelivery, error)
+	// Records an attempt to send a notification, which ended in the given status.
+	RecordAttempt(ctx context.Context, id uint, status string, lastError string) error
+	// Marks a failed notification delivery as pending again before it's resent. Returns whether the delivery was
+	// failed, so that concurrent requests only resend it once.
+	MarkPending(ctx context.Context, id uint) (bool, error)
+	// Returns notification deliveries matching query parameters. A limit must be provided for the results page size.
+	List(ctx context.Context, input ListResourceInput) ([]models.NotificationDelivery, error)
+}
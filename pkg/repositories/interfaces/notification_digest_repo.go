@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=NotificationDigestRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the executions batched into notification digests.
+type NotificationDigestRepoInterface interface {
+	// Inserts a digest entry model into the database store.
+	Create(ctx context.Context, input models.NotificationDigestEntry) error
+	// Returns up to limit entries whose digests are due before the given time and which aren't leased by a sender at
+	// that time, ordered by group.
+	ListDue(ctx context.Context, dueBefore time.Time, limit int) ([]models.NotificationDigestEntry, error)
+	// Leases those of the given entries which aren't leased by another sender until the given time, so that
+	// concurrent senders only send each digest once, and returns the entries it leased.
+	Claim(ctx context.Context, input ClaimNotificationDigestEntriesInput) ([]models.NotificationDigestEntry, error)
+	// Releases the entries of a lease, so that they're claimed again, e.g. when their digest couldn't be sent.
+	Release(ctx context.Context, leaseID string) error
+	// Deletes the entries of a lease once their digest is sent.
+	Delete(ctx context.Context, leaseID string) error
+}
+
+type ClaimNotificationDigestEntriesInput struct {
+	IDs         []uint
+	Now         time.Time
+	LeasedUntil time.Time
+}
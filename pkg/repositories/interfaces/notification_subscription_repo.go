@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=NotificationSubscriptionRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with users' subscriptions to the notifications of executions.
+type NotificationSubscriptionRepoInterface interface {
+	// Inserts a subscription model into the database store.
+	Create(ctx context.Context, input models.NotificationSubscription) error
+	// Returns the subscription with a key if it exists.
+	Get(ctx context.Context, input models.NotificationSubscriptionKey) (models.NotificationSubscription, error)
+	// Updates the phases and principal of an existing subscription.
+	Update(ctx context.Context, input models.NotificationSubscription) error
+	// Deletes a subscription in a project and domain.
+	Delete(ctx context.Context, project, domain string, id uint) error
+	// Returns subscriptions matching query parameters. A limit must be provided for the results page size.
+	List(ctx context.Context, input ListResourceInput) ([]models.NotificationSubscription, error)
+	// Returns every subscription to the executions of a launch plan, including those to its whole project and domain.
+	ListForLaunchPlan(ctx context.Context, project, domain, launchPlan string) (
+		[]models.NotificationSubscription, error)
+}
@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=OAuthClientRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the OAuth2 clients registered with the internal authorization server.
+type OAuthClientRepoInterface interface {
+	// Inserts an OAuth2 client model into the database store.
+	Create(ctx context.Context, input models.OAuthClient) error
+	// Returns a matching client if it exists.
+	Get(ctx context.Context, clientID string) (models.OAuthClient, error)
+	// Replaces the secret hash of an existing client.
+	UpdateSecret(ctx context.Context, clientID string, secretHash []byte) error
+	Delete(ctx context.Context, clientID string) error
+	// Returns the clients of an owner, ordered by client id.
+	List(ctx context.Context, input ListOAuthClientsInput) ([]models.OAuthClient, error)
+}
+
+type ListOAuthClientsInput struct {
+	Owner  string
+	Limit  int
+	Offset int
+}
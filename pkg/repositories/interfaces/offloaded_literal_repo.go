@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=OffloadedLiteralRepoInterface -output=../mocks -case=underscore
+
+// Tracks the literal maps admin offloads to blob storage until the executions they belong to are created.
+type OffloadedLiteralRepoInterface interface {
+	// Records a literal map which is about to be offloaded.
+	Create(ctx context.Context, input models.OffloadedLiteral) error
+	// Returns the oldest literal maps offloaded before the given time, along with whether their execution exists.
+	ListCollectable(ctx context.Context, input ListCollectableOffloadedLiteralsInput) (
+		[]models.CollectableOffloadedLiteral, error)
+	// Stops tracking the given literal maps.
+	Delete(ctx context.Context, ids []uint) error
+}
+
+type ListCollectableOffloadedLiteralsInput struct {
+	CreatedBefore time.Time
+	Limit         int
+}
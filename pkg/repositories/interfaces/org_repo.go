@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=OrgRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the orgs projects are grouped into.
+type OrgRepoInterface interface {
+	// Inserts an org model into the database store.
+	Create(ctx context.Context, input models.Org) error
+	// Returns the org with an identifier if it exists.
+	Get(ctx context.Context, id string) (models.Org, error)
+	// Updates the name and description of an existing org in the database store.
+	Update(ctx context.Context, input models.Org) error
+	// Deletes an org, which fails as long as projects belong to it.
+	Delete(ctx context.Context, id string) error
+	// Returns orgs matching query parameters. A limit must be provided for the results page size.
+	List(ctx context.Context, input ListResourceInput) ([]models.Org, error)
+}
@@ -0,0 +1,41 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=OutboxRepoInterface -output=../mocks -case=underscore
+
+// Claims and deletes the messages written to the outbox, which are relayed to the configured publishers.
+type OutboxRepoInterface interface {
+	// Claims the oldest messages which aren't leased by another relay or dead lettered, and leases them until the given
+	// time.
+	Claim(ctx context.Context, input ClaimOutboxMessagesInput) ([]models.OutboxMessage, error)
+	// Deletes a message once it has been published.
+	Delete(ctx context.Context, id uint) error
+	// Records a failed attempt to publish a message, which is claimed again once its lease expires.
+	MarkFailed(ctx context.Context, id uint, reason string) error
+	// Records the last failed attempt to publish a message, after which it's kept as a dead letter and not claimed
+	// again.
+	DeadLetter(ctx context.Context, id uint, reason string, deadLetteredAt time.Time) error
+	// Returns the number of messages waiting in the outbox, other than dead letters, keyed by the publisher they're
+	// relayed through.
+	CountByPublisher(ctx context.Context) (map[string]int64, error)
+	// Returns the messages waiting in the outbox, other than dead letters, and when the oldest of them was written,
+	// keyed by the publisher they're relayed through.
+	GetBacklog(ctx context.Context) (map[string]OutboxBacklog, error)
+}
+
+type ClaimOutboxMessagesInput struct {
+	Now         time.Time
+	LeasedUntil time.Time
+	Limit       int
+}
+
+type OutboxBacklog struct {
+	Count           int64
+	OldestCreatedAt time.Time
+}
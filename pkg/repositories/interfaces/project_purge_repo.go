@@ -0,0 +1,119 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+// Tables with project records which aren't subject to retention policies.
+const (
+	ExecutionLabelsTable           = "execution_labels"
+	TaskExecutionArtifactsTable    = "task_execution_artifacts"
+	ExecutionRelationshipsTable    = "execution_relationships"
+	TaskExecutionUsagesTable       = "task_execution_usages"
+	RecordedEventsTable            = "recorded_events"
+	SkippedEventsTable             = "skipped_events"
+	SignalsTable                   = "signals"
+	ExecutionCommentsTable         = "execution_comments"
+	NotificationDeliveriesTable    = "notification_deliveries"
+	WebhookDeliveriesTable         = "webhook_deliveries"
+	BackfillsTable                 = "backfills"
+	LaunchPlanRolloutsTable        = "launch_plan_rollouts"
+	TriggersTable                  = "triggers"
+	NotificationSubscriptionsTable = "notification_subscriptions"
+	WebhooksTable                  = "webhooks"
+	SavedViewsTable                = "saved_views"
+	DomainQuotasTable              = "domain_quotas"
+	FeatureFlagsTable              = "feature_flags"
+	WorkflowsTable                 = "workflows"
+	TasksTable                     = "tasks"
+	NamedEntityMetadataTable       = "named_entity_metadata"
+	NamedEntityDescriptionsTable   = "named_entity_descriptions"
+	NamedEntityTagsTable           = "named_entity_tags"
+	ResourcesTable                 = "resources"
+	ProjectLabelsTable             = "project_labels"
+	IdempotencyKeysTable           = "idempotency_key_reservations"
+	OutboxMessagesTable            = "outbox_messages"
+	NotificationDigestEntriesTable = "notification_digest_entries"
+	OffloadedLiteralsTable         = "offloaded_literals"
+	CacheEvictionsTable            = "cache_evictions"
+	ScheduleRunsTable              = "schedule_runs"
+	SchedulableEntitiesTable       = "schedulable_entities"
+	APIUsagesTable                 = "api_usages"
+	ClusterResourceSyncsTable      = "cluster_resource_syncs"
+	AdmissionLocksTable            = "admission_locks"
+)
+
+// Tables purged of an archived project's records, in the order they are purged so that records are deleted before
+// those they reference. Matchable attributes are stored as resources. Only the tracking of offloaded inputs is purged:
+// the inputs themselves are left in blob storage, like the rest of the project's data. Changes to feature flags aren't
+// purged, so that they remain auditable.
+var ProjectPurgeTables = []string{
+	ExecutionEventsTable,
+	NodeExecutionEventsTable,
+	TaskExecutionArtifactsTable,
+	ExecutionRelationshipsTable,
+	TaskExecutionUsagesTable,
+	RecordedEventsTable,
+	SkippedEventsTable,
+	SignalsTable,
+	ExecutionCommentsTable,
+	NotificationDeliveriesTable,
+	NotificationDigestEntriesTable,
+	WebhookDeliveriesTable,
+	OutboxMessagesTable,
+	CacheEvictionsTable,
+	TaskExecutionsTable,
+	NodeExecutionsTable,
+	ExecutionLabelsTable,
+	OffloadedLiteralsTable,
+	IdempotencyKeysTable,
+	ExecutionsTable,
+	BackfillsTable,
+	LaunchPlanRolloutsTable,
+	TriggersTable,
+	ScheduleRunsTable,
+	SchedulableEntitiesTable,
+	LaunchPlansTable,
+	WorkflowsTable,
+	TasksTable,
+	NamedEntityMetadataTable,
+	NamedEntityDescriptionsTable,
+	NamedEntityTagsTable,
+	NotificationSubscriptionsTable,
+	WebhooksTable,
+	SavedViewsTable,
+	DomainQuotasTable,
+	FeatureFlagsTable,
+	ResourcesTable,
+	APIUsagesTable,
+	ClusterResourceSyncsTable,
+	AdmissionLocksTable,
+	ProjectLabelsTable,
+}
+
+//go:generate mockery -name=ProjectPurgeRepoInterface -output=../mocks -case=underscore
+
+// Tracks purges of archived projects and hard deletes the records they purge.
+type ProjectPurgeRepoInterface interface {
+	// Inserts a purge model into the database store.
+	Create(ctx context.Context, input models.ProjectPurge) error
+	// Returns the most recent purge of a project.
+	GetLatest(ctx context.Context, project string) (models.ProjectPurge, error)
+	// Updates an existing purge in the database store with all fields in the input, so that errors can be cleared.
+	Update(ctx context.Context, input models.ProjectPurge) error
+	// Returns the oldest purges in a phase.
+	ListByPhase(ctx context.Context, phase string, limit int) ([]models.ProjectPurge, error)
+	// Hard deletes up to limit of a project's records from one of ProjectPurgeTables, soft-deleted records included.
+	// Returns the number of records deleted.
+	DeleteRecords(ctx context.Context, input DeleteProjectRecordsInput) (int64, error)
+	// Hard deletes the project itself.
+	DeleteProject(ctx context.Context, project string) error
+}
+
+type DeleteProjectRecordsInput struct {
+	Table   string
+	Project string
+	Limit   int
+}
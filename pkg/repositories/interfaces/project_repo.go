@@ -17,4 +17,10 @@ type ProjectRepoInterface interface {
 	// as a second project (projectUpdate), updates the original project which already
 	// exists in the DB.
 	UpdateProject(ctx context.Context, projectUpdate models.Project) error
+	// Replaces the owner, team, metadata and links of an existing project, including with empty values.
+	UpdateMetadata(ctx context.Context, project models.Project) error
+	// Replaces the execution defaults of an existing project.
+	UpdateExecutionDefaults(ctx context.Context, project models.Project) error
+	// Moves an existing project into an org, or out of its org when the org is empty.
+	UpdateOrg(ctx context.Context, projectID, org string) error
 }
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=RecordedEventRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the history of every event received for executions.
+type RecordedEventRepoInterface interface {
+	// Inserts recorded event models into the database store in a single transaction.
+	BatchCreate(ctx context.Context, input []models.RecordedEvent) error
+	// Returns the recorded events matching the filters. At least one filter must be provided.
+	List(ctx context.Context, input ListResourceInput) ([]models.RecordedEvent, error)
+}
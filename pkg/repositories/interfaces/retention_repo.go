@@ -0,0 +1,105 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Tables with records subject to retention policies, ordered so that records are pruned before those they reference.
+const (
+	ExecutionEventsTable     = "execution_events"
+	NodeExecutionEventsTable = "node_execution_events"
+	TaskExecutionsTable      = "task_executions"
+	NodeExecutionsTable      = "node_executions"
+	ExecutionsTable          = "executions"
+	LaunchPlansTable         = "launch_plans"
+)
+
+var RetentionTables = []string{
+	ExecutionEventsTable,
+	NodeExecutionEventsTable,
+	TaskExecutionsTable,
+	NodeExecutionsTable,
+	ExecutionCommentsTable,
+	SignalsTable,
+	ExecutionRelationshipsTable,
+	TaskExecutionUsagesTable,
+	RecordedEventsTable,
+	OffloadedLiteralsTable,
+	ExecutionsTable,
+}
+
+// Tables with records purged once they've been soft-deleted for long enough, in the order they are purged. Records are
+// purged along with the soft-deleted executions they belong to.
+var PurgeTables = []string{
+	ExecutionEventsTable,
+	NodeExecutionEventsTable,
+	TaskExecutionsTable,
+	NodeExecutionsTable,
+	ExecutionCommentsTable,
+	SignalsTable,
+	ExecutionRelationshipsTable,
+	TaskExecutionUsagesTable,
+	RecordedEventsTable,
+	OffloadedLiteralsTable,
+	ExecutionsTable,
+	LaunchPlansTable,
+}
+
+// Tables with registered entities whose versions are capped, in the order they are pruned. Launch plans are pruned
+// first since they reference workflows.
+var VersionTables = []string{
+	LaunchPlansTable,
+	WorkflowsTable,
+	TasksTable,
+}
+
+//go:generate mockery -name=RetentionRepoInterface -output=../mocks -case=underscore
+
+// Lists and hard deletes records which have outlived their retention period.
+type RetentionRepoInterface interface {
+	// Returns the oldest records in a project and domain created before the cutoff. Records still referenced by
+	// others, such as executions with node executions, are excluded, as are executions which haven't terminated along
+	// with their records and launch plans which haven't been deleted.
+	ListExpired(ctx context.Context, input ListExpiredInput) ([]ExpiredRecord, error)
+	// Deletes the records with the given ids from a table, unless they have since been referenced. Returns the number
+	// of records deleted.
+	Delete(ctx context.Context, table string, ids []uint) (int64, error)
+	// Returns the oldest versions of the entities in a project and domain beyond the number of latest versions
+	// retained for each name. Active launch plans, workflows referenced by any launch plan and versions referenced by
+	// executions created after the cutoff are excluded.
+	ListExcessVersions(ctx context.Context, input ListExcessVersionsInput) ([]ExpiredRecord, error)
+	// Deletes the versions with the given ids, unless they no longer exceed the cap or have since been referenced.
+	// Returns the number of versions deleted.
+	DeleteExcessVersions(ctx context.Context, input ListExcessVersionsInput, ids []uint) (int64, error)
+}
+
+type ListExpiredInput struct {
+	Table         string
+	Project       string
+	Domain        string
+	CreatedBefore time.Time
+	// When set, records soft-deleted before this time, or belonging to executions soft-deleted before it, are listed
+	// regardless of when they were created.
+	DeletedBefore time.Time
+	Limit         int
+}
+
+type ListExcessVersionsInput struct {
+	Table   string
+	Project string
+	Domain  string
+	// The number of the latest versions of each name which are retained.
+	MaxVersions int
+	// Versions referenced by executions created after this time are retained.
+	ExecutedAfter time.Time
+	// Only versions with greater ids are listed, so that versions the caller chose to keep can be paged past.
+	AfterID uint
+	Limit   int
+}
+
+type ExpiredRecord struct {
+	ID uint
+	// All of the record's columns, keyed by name.
+	Columns map[string]interface{}
+}
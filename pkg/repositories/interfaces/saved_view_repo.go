@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=SavedViewRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the views users save for listing executions and workflows.
+type SavedViewRepoInterface interface {
+	// Inserts a saved view model into the database store.
+	Create(ctx context.Context, input models.SavedView) error
+	// Returns a matching saved view if it exists.
+	Get(ctx context.Context, input models.SavedViewKey) (models.SavedView, error)
+	// Updates the resource type, filters, sort order and description of an existing saved view.
+	Update(ctx context.Context, input models.SavedView) error
+	Delete(ctx context.Context, input models.SavedViewKey) error
+	// Returns the views of a project and domain, and the personal views of the owner, ordered by name.
+	List(ctx context.Context, input ListSavedViewsInput) ([]models.SavedView, error)
+}
+
+type ListSavedViewsInput struct {
+	Project string
+	Domain  string
+	// The user whose personal views are returned along with the views of the project.
+	Owner  string
+	Limit  int
+	Offset int
+}
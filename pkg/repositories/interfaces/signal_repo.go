@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=SignalRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the signals gate nodes of executions wait on.
+type SignalRepoInterface interface {
+	// Inserts a signal unless one with the same key exists, and populates input with the stored signal.
+	GetOrCreate(ctx context.Context, input *models.Signal) error
+	// Returns a matching signal when it exists.
+	Get(ctx context.Context, input models.SignalKey) (models.Signal, error)
+	// Returns the signals of an execution in the order they were created.
+	List(ctx context.Context, input ListSignalsInput) ([]models.Signal, error)
+	// Sets the value of a signal. Returns a FailedPrecondition error when the signal is already set.
+	Update(ctx context.Context, input models.Signal) error
+}
+
+type ListSignalsInput struct {
+	Execution models.ExecutionKey
+	Limit     int
+	Offset    int
+}
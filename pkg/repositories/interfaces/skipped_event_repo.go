@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=SkippedEventRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the events which were skipped rather than applied.
+type SkippedEventRepoInterface interface {
+	// Inserts a skipped event model into the database store.
+	Create(ctx context.Context, input models.SkippedEvent) error
+	// Returns the skipped events matching the filters. At least one filter must be provided.
+	List(ctx context.Context, input ListResourceInput) ([]models.SkippedEvent, error)
+}
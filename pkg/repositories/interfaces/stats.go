@@ -0,0 +1,16 @@
+package interfaces
+
+import "database/sql"
+
+// The state of the database connection pool and of the caches in front of the database.
+type RepositoryStats struct {
+	DB sql.DBStats
+	// Keyed by the resource cached: workflows, tasks or launch_plans. Empty unless caching is configured.
+	Caches map[string]CacheStats
+}
+
+// The number of gets served from a cache and of those read from the database, since admin started.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
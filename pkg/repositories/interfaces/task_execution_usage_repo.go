@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=TaskExecutionUsageRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with the recorded resource usage of task executions.
+type TaskExecutionUsageRepoInterface interface {
+	// Inserts a task execution usage model into the database store.
+	Create(ctx context.Context, input models.TaskExecutionUsage) error
+	// Sums the usage of the task executions matching the filters for each project and domain. At least one filter must
+	// be provided.
+	Sum(ctx context.Context, input SumTaskExecutionUsageInput) ([]models.TaskExecutionUsageSum, error)
+}
+
+type SumTaskExecutionUsageInput struct {
+	InlineFilters []common.InlineFilter
+}
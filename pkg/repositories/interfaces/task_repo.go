@@ -12,6 +12,9 @@ type TaskRepoInterface interface {
 	Create(ctx context.Context, input models.Task) error
 	// Returns a matching task if it exists.
 	Get(ctx context.Context, input Identifier) (models.Task, error)
+	// Returns the tasks matching any of the identifiers in a single query. Identifiers without a matching task are
+	// skipped.
+	GetBatch(ctx context.Context, input []Identifier) ([]models.Task, error)
 	// Returns task revisions matching query parameters. A limit must be provided for the results page size.
 	List(ctx context.Context, input ListResourceInput) (TaskCollectionOutput, error)
 	// Returns tasks with only the project, name, and domain filled in.
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=TriggerRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with registered triggers.
+type TriggerRepoInterface interface {
+	// Inserts a trigger model into the database store.
+	Create(ctx context.Context, input models.Trigger) error
+	// Returns a matching trigger if it exists. The version of the identifier is ignored.
+	Get(ctx context.Context, input Identifier) (models.Trigger, error)
+	// Updates an existing trigger in the database store with all non-empty fields in the input.
+	Update(ctx context.Context, input models.Trigger) error
+	// Deletes a trigger, so that its name can be registered again.
+	Delete(ctx context.Context, input Identifier) error
+	// Returns triggers matching query parameters. A limit must be provided for the results page size.
+	List(ctx context.Context, input ListResourceInput) ([]models.Trigger, error)
+}
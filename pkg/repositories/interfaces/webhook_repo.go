@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery -name=WebhookRepoInterface -output=../mocks -case=underscore
+
+// Defines the interface for interacting with registered webhooks and the record of deliveries to them.
+type WebhookRepoInterface interface {
+	// Inserts a webhook model into the database store.
+	Create(ctx context.Context, input models.Webhook) error
+	// Returns a matching webhook if it exists. The version of the identifier is ignored.
+	Get(ctx context.Context, input Identifier) (models.Webhook, error)
+	// Updates an existing webhook in the database store with all non-empty fields in the input.
+	Update(ctx context.Context, input models.Webhook) error
+	// Deletes a webhook, so that its name can be registered again. The record of deliveries to it is kept.
+	Delete(ctx context.Context, input Identifier) error
+	// Returns webhooks matching query parameters. A limit must be provided for the results page size.
+	List(ctx context.Context, input ListResourceInput) (WebhookCollectionOutput, error)
+	// Inserts a webhook delivery model into the database store and returns its id.
+	CreateDelivery(ctx context.Context, input models.WebhookDelivery) (uint, error)
+	// Updates the status, attempts, response code and last error of an existing webhook delivery.
+	UpdateDelivery(ctx context.Context, input models.WebhookDelivery) error
+	// Returns webhook deliveries matching query parameters. A limit must be provided for the results page size.
+	ListDeliveries(ctx context.Context, input ListResourceInput) ([]models.WebhookDelivery, error)
+}
+
+// Response format for a query on webhooks.
+type WebhookCollectionOutput struct {
+	Webhooks []models.Webhook
+}
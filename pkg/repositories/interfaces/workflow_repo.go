@@ -12,6 +12,9 @@ type WorkflowRepoInterface interface {
 	Create(ctx context.Context, input models.Workflow) error
 	// Returns a matching workflow if it exists.
 	Get(ctx context.Context, input Identifier) (models.Workflow, error)
+	// Returns the workflows matching any of the identifiers in a single query. Identifiers without a matching
+	// workflow are skipped.
+	GetBatch(ctx context.Context, input []Identifier) ([]models.Workflow, error)
 	// Returns workflow revisions matching query parameters. A limit must be provided for the results page size.
 	List(ctx context.Context, input ListResourceInput) (WorkflowCollectionOutput, error)
 	ListIdentifiers(ctx context.Context, input ListResourceInput) (WorkflowCollectionOutput, error)
@@ -0,0 +1,54 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type CreateOrUpdateDomainQuotaFunction func(ctx context.Context, input models.DomainQuota) error
+type GetDomainQuotaFunction func(ctx context.Context, project, domain string) (models.DomainQuota, error)
+type DeleteDomainQuotaFunction func(ctx context.Context, project, domain string) error
+type ListDomainQuotasFunction func(ctx context.Context, input interfaces.ListResourceInput) ([]models.DomainQuota, error)
+
+// Executions are checked against the quotas of their domains, so unlike mockery mocks this returns an unlimited quota
+// unless a Get function is set.
+type MockDomainQuotaRepo struct {
+	CreateOrUpdateFunction CreateOrUpdateDomainQuotaFunction
+	GetFunction            GetDomainQuotaFunction
+	DeleteFunction         DeleteDomainQuotaFunction
+	ListFunction           ListDomainQuotasFunction
+}
+
+func (r *MockDomainQuotaRepo) CreateOrUpdate(ctx context.Context, input models.DomainQuota) error {
+	if r.CreateOrUpdateFunction != nil {
+		return r.CreateOrUpdateFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockDomainQuotaRepo) Get(ctx context.Context, project, domain string) (models.DomainQuota, error) {
+	if r.GetFunction != nil {
+		return r.GetFunction(ctx, project, domain)
+	}
+	return models.DomainQuota{}, nil
+}
+
+func (r *MockDomainQuotaRepo) Delete(ctx context.Context, project, domain string) error {
+	if r.DeleteFunction != nil {
+		return r.DeleteFunction(ctx, project, domain)
+	}
+	return nil
+}
+
+func (r *MockDomainQuotaRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.DomainQuota, error) {
+	if r.ListFunction != nil {
+		return r.ListFunction(ctx, input)
+	}
+	return []models.DomainQuota{}, nil
+}
+
+func NewMockDomainQuotaRepo() interfaces.DomainQuotaRepoInterface {
+	return &MockDomainQuotaRepo{}
+}
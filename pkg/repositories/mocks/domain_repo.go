@@ -0,0 +1,63 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type CreateDomainFunction func(ctx context.Context, domain models.Domain) error
+type GetDomainFunction func(ctx context.Context, domainID string) (models.Domain, error)
+type ListDomainsFunction func(ctx context.Context) ([]models.Domain, error)
+type UpdateDomainFunction func(ctx context.Context, domain models.Domain) error
+type DeleteDomainFunction func(ctx context.Context, domainID string) error
+
+// Projects and domains are validated against the stored domains, so unlike mockery mocks this lists no domains,
+// which falls back to the configured ones, unless a List function is set.
+type MockDomainRepo struct {
+	CreateFunction CreateDomainFunction
+	GetFunction    GetDomainFunction
+	ListFunction   ListDomainsFunction
+	UpdateFunction UpdateDomainFunction
+	DeleteFunction DeleteDomainFunction
+}
+
+func (r *MockDomainRepo) Create(ctx context.Context, domain models.Domain) error {
+	if r.CreateFunction != nil {
+		return r.CreateFunction(ctx, domain)
+	}
+	return nil
+}
+
+func (r *MockDomainRepo) Get(ctx context.Context, domainID string) (models.Domain, error) {
+	if r.GetFunction != nil {
+		return r.GetFunction(ctx, domainID)
+	}
+	return models.Domain{Identifier: domainID}, nil
+}
+
+func (r *MockDomainRepo) List(ctx context.Context) ([]models.Domain, error) {
+	if r.ListFunction != nil {
+		return r.ListFunction(ctx)
+	}
+	return []models.Domain{}, nil
+}
+
+func (r *MockDomainRepo) Update(ctx context.Context, domain models.Domain) error {
+	if r.UpdateFunction != nil {
+		return r.UpdateFunction(ctx, domain)
+	}
+	return nil
+}
+
+func (r *MockDomainRepo) Delete(ctx context.Context, domainID string) error {
+	if r.DeleteFunction != nil {
+		return r.DeleteFunction(ctx, domainID)
+	}
+	return nil
+}
+
+func NewMockDomainRepo() interfaces.DomainRepoInterface {
+	return &MockDomainRepo{}
+}
@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 )
@@ -14,11 +15,36 @@ type ListExecutionFunc func(ctx context.Context, input interfaces.ListResourceIn
 	interfaces.ExecutionCollectionOutput, error)
 
 type MockExecutionRepo struct {
-	createFunction CreateExecutionFunc
-	updateFunction UpdateExecutionFunc
-	getFunction    GetExecutionFunc
-	listFunction   ListExecutionFunc
-	ExistsFunction func(ctx context.Context, input interfaces.Identifier) (bool, error)
+	createFunction  CreateExecutionFunc
+	updateFunction  UpdateExecutionFunc
+	getFunction     GetExecutionFunc
+	listFunction    ListExecutionFunc
+	ExistsFunction  func(ctx context.Context, input interfaces.Identifier) (bool, error)
+	DeleteFunction  func(ctx context.Context, input interfaces.Identifier) error
+	RestoreFunction func(ctx context.Context, input interfaces.Identifier) error
+	// Returns a not found error when unset.
+	GetByIdempotencyKeyFunction func(ctx context.Context, input interfaces.IdempotencyKey) (models.Execution, error)
+	// Reserves every key when unset.
+	ReserveIdempotencyKeyFunction func(ctx context.Context, input interfaces.ReserveIdempotencyKeyInput) error
+	ReleaseIdempotencyKeyFunction func(ctx context.Context, input interfaces.IdempotencyKey, reservationID string) error
+	// Falls back to the update callback when unset.
+	UpdateWithMessagesFunction func(
+		ctx context.Context, execution models.Execution, messages []models.OutboxMessage) error
+	CountFunction          func(ctx context.Context, input interfaces.CountResourceInput) (int64, error)
+	MarkDispatchedFunction func(ctx context.Context, input interfaces.Identifier, cluster string) error
+	// Locks nothing when unset.
+	LockAdmissionFunction       func(ctx context.Context, project, domain string) (func(), error)
+	RecordLaunchFailureFunction func(ctx context.Context, input interfaces.Identifier, launchError string) error
+
+	// Returns no outcomes when unset.
+	ListLaunchPlanOutcomesFunction func(
+		ctx context.Context, input interfaces.ListLaunchPlanOutcomesInput) ([]models.LaunchPlanExecutionOutcome, error)
+	// Returns no groups when unset.
+	CountGroupsFunction func(
+		ctx context.Context, input interfaces.CountExecutionGroupsInput) ([]models.ExecutionGroupCount, error)
+	// Returns no labels when unset.
+	ListLabelsFunction func(
+		ctx context.Context, input interfaces.ListExecutionLabelsInput) ([]models.ExecutionLabelCount, error)
 }
 
 func (r *MockExecutionRepo) Create(ctx context.Context, input models.Execution) error {
@@ -43,6 +69,14 @@ func (r *MockExecutionRepo) Update(ctx context.Context, execution models.Executi
 	return nil
 }
 
+func (r *MockExecutionRepo) UpdateWithMessages(
+	ctx context.Context, execution models.Execution, messages []models.OutboxMessage) error {
+	if r.UpdateWithMessagesFunction != nil {
+		return r.UpdateWithMessagesFunction(ctx, execution, messages)
+	}
+	return r.Update(ctx, execution)
+}
+
 func (r *MockExecutionRepo) SetUpdateExecutionCallback(updateExecutionFunc UpdateExecutionFunc) {
 	r.updateFunction = updateExecutionFunc
 }
@@ -54,6 +88,14 @@ func (r *MockExecutionRepo) Get(ctx context.Context, input interfaces.Identifier
 	return models.Execution{}, nil
 }
 
+func (r *MockExecutionRepo) GetByIdempotencyKey(
+	ctx context.Context, input interfaces.IdempotencyKey) (models.Execution, error) {
+	if r.GetByIdempotencyKeyFunction != nil {
+		return r.GetByIdempotencyKeyFunction(ctx, input)
+	}
+	return models.Execution{}, errors.GetMissingEntityByIDError("execution")
+}
+
 func (r *MockExecutionRepo) SetGetCallback(getFunction GetExecutionFunc) {
 	r.getFunction = getFunction
 }
@@ -77,6 +119,89 @@ func (r *MockExecutionRepo) Exists(ctx context.Context, input interfaces.Identif
 	return true, nil
 }
 
+func (r *MockExecutionRepo) Count(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+	if r.CountFunction != nil {
+		return r.CountFunction(ctx, input)
+	}
+	return 0, nil
+}
+
+func (r *MockExecutionRepo) CountGroups(
+	ctx context.Context, input interfaces.CountExecutionGroupsInput) ([]models.ExecutionGroupCount, error) {
+	if r.CountGroupsFunction != nil {
+		return r.CountGroupsFunction(ctx, input)
+	}
+	return nil, nil
+}
+
+func (r *MockExecutionRepo) ListLabels(
+	ctx context.Context, input interfaces.ListExecutionLabelsInput) ([]models.ExecutionLabelCount, error) {
+	if r.ListLabelsFunction != nil {
+		return r.ListLabelsFunction(ctx, input)
+	}
+	return nil, nil
+}
+
+func (r *MockExecutionRepo) MarkDispatched(ctx context.Context, input interfaces.Identifier, cluster string) error {
+	if r.MarkDispatchedFunction != nil {
+		return r.MarkDispatchedFunction(ctx, input, cluster)
+	}
+	return nil
+}
+
+func (r *MockExecutionRepo) ReserveIdempotencyKey(
+	ctx context.Context, input interfaces.ReserveIdempotencyKeyInput) error {
+	if r.ReserveIdempotencyKeyFunction != nil {
+		return r.ReserveIdempotencyKeyFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockExecutionRepo) ReleaseIdempotencyKey(
+	ctx context.Context, input interfaces.IdempotencyKey, reservationID string) error {
+	if r.ReleaseIdempotencyKeyFunction != nil {
+		return r.ReleaseIdempotencyKeyFunction(ctx, input, reservationID)
+	}
+	return nil
+}
+
+func (r *MockExecutionRepo) LockAdmission(ctx context.Context, project, domain string) (func(), error) {
+	if r.LockAdmissionFunction != nil {
+		return r.LockAdmissionFunction(ctx, project, domain)
+	}
+	return func() {}, nil
+}
+
+func (r *MockExecutionRepo) RecordLaunchFailure(
+	ctx context.Context, input interfaces.Identifier, launchError string) error {
+	if r.RecordLaunchFailureFunction != nil {
+		return r.RecordLaunchFailureFunction(ctx, input, launchError)
+	}
+	return nil
+}
+
+func (r *MockExecutionRepo) ListLaunchPlanOutcomes(
+	ctx context.Context, input interfaces.ListLaunchPlanOutcomesInput) ([]models.LaunchPlanExecutionOutcome, error) {
+	if r.ListLaunchPlanOutcomesFunction != nil {
+		return r.ListLaunchPlanOutcomesFunction(ctx, input)
+	}
+	return nil, nil
+}
+
+func (r *MockExecutionRepo) Delete(ctx context.Context, input interfaces.Identifier) error {
+	if r.DeleteFunction != nil {
+		return r.DeleteFunction(ctx, input)
+	}
+	return nil
+}
+
+func (r *MockExecutionRepo) Restore(ctx context.Context, input interfaces.Identifier) error {
+	if r.RestoreFunction != nil {
+		return r.RestoreFunction(ctx, input)
+	}
+	return nil
+}
+
 func NewMockExecutionRepo() interfaces.ExecutionRepoInterface {
 	return &MockExecutionRepo{}
 }
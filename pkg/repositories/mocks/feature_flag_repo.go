@@ -0,0 +1,67 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+)
+
+type SetFeatureFlagFunction func(ctx context.Context, input models.FeatureFlag, change models.FeatureFlagChange) error
+type ClearFeatureFlagFunction func(ctx context.Context, name, project string, change models.FeatureFlagChange) error
+type GetFeatureFlagFunction func(ctx context.Context, name string) ([]models.FeatureFlag, error)
+type ListAllFeatureFlagsFunction func(ctx context.Context) ([]models.FeatureFlag, error)
+type ListFeatureFlagChangesFunction func(ctx context.Context, input interfaces.ListFeatureFlagChangesInput) (
+	[]models.FeatureFlagChange, error)
+
+// Managers check feature flags as they handle requests, so unlike mockery mocks this returns no overrides unless a Get
+// function is set.
+type MockFeatureFlagRepo struct {
+	SetFunction         SetFeatureFlagFunction
+	ClearFunction       ClearFeatureFlagFunction
+	GetFunction         GetFeatureFlagFunction
+	ListAllFunction     ListAllFeatureFlagsFunction
+	ListChangesFunction ListFeatureFlagChangesFunction
+}
+
+func (r *MockFeatureFlagRepo) Set(
+	ctx context.Context, input models.FeatureFlag, change models.FeatureFlagChange) error {
+	if r.SetFunction != nil {
+		return r.SetFunction(ctx, input, change)
+	}
+	return nil
+}
+
+func (r *MockFeatureFlagRepo) Clear(
+	ctx context.Context, name, project string, change models.FeatureFlagChange) error {
+	if r.ClearFunction != nil {
+		return r.ClearFunction(ctx, name, project, change)
+	}
+	return nil
+}
+
+func (r *MockFeatureFlagRepo) Get(ctx context.Context, name string) ([]models.FeatureFlag, error) {
+	if r.GetFunction != nil {
+		return r.GetFunction(ctx, name)
+	}
+	return []models.FeatureFlag{}, nil
+}
+
+func (r *MockFeatureFlagRepo) ListAll(ctx context.Context) ([]models.FeatureFlag, error) {
+	if r.ListAllFunction != nil {
+		return r.ListAllFunction(ctx)
+	}
+	return []models.FeatureFlag{}, nil
+}
+
+func (r *MockFeatureFlagRepo) ListChanges(ctx context.Context, input interfaces.ListFeatureFlagChangesInput) (
+	[]models.FeatureFlagChange, error) {
+	if r.ListChangesFunction != nil {
+		return r.ListChangesFunction(ctx, input)
+	}
+	return []models.FeatureFlagChange{}, nil
+}
+
+func NewMockFeatureFlagRepo() interfaces.FeatureFlagRepoInterface {
+	return &MockFeatureFlagRepo{}
+}
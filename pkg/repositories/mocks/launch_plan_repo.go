@@ -23,6 +23,8 @@ type MockLaunchPlanRepo struct {
 	getFunction       GetLaunchPlanFunc
 	listFunction      ListLaunchPlanFunc
 	listIdsFunction   ListLaunchPlanIdentifiersFunc
+	DeleteFunction    func(input interfaces.Identifier) error
+	RestoreFunction   func(input interfaces.Identifier) error
 }
 
 func (r *MockLaunchPlanRepo) Create(ctx context.Context, input models.LaunchPlan) error {
@@ -102,6 +104,20 @@ func (r *MockLaunchPlanRepo) SetListLaunchPlanIdentifiersCallback(fn ListLaunchP
 	r.listIdsFunction = fn
 }
 
+func (r *MockLaunchPlanRepo) Delete(ctx context.Context, input interfaces.Identifier) error {
+	if r.DeleteFunction != nil {
+		return r.DeleteFunction(input)
+	}
+	return nil
+}
+
+func (r *MockLaunchPlanRepo) Restore(ctx context.Context, input interfaces.Identifier) error {
+	if r.RestoreFunction != nil {
+		return r.RestoreFunction(input)
+	}
+	return nil
+}
+
 func NewMockLaunchPlanRepo() interfaces.LaunchPlanRepoInterface {
 	return &MockLaunchPlanRepo{}
 }
@@ -4,6 +4,9 @@ package mocks
 import (
 	"context"
 
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"google.golang.org/grpc/codes"
+
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 )
@@ -11,11 +14,24 @@ import (
 type GetNamedEntityFunc func(input interfaces.GetNamedEntityInput) (models.NamedEntity, error)
 type ListNamedEntityFunc func(input interfaces.ListNamedEntityInput) (interfaces.NamedEntityCollectionOutput, error)
 type UpdateNamedEntityFunc func(input models.NamedEntity) error
+type SearchNamedEntityFunc func(input interfaces.SearchNamedEntityInput) ([]interfaces.NamedEntitySearchResult, error)
+type GetNamedEntityDescriptionFunc func(input interfaces.GetNamedEntityInput) (models.NamedEntityDescription, error)
+type UpdateNamedEntityDescriptionFunc func(input models.NamedEntityDescription) error
+type NamedEntityTagsFunc func(input interfaces.GetNamedEntityInput, tags []string) error
+type ListNamedEntityTagsFunc func(input interfaces.GetNamedEntityInput) ([]string, error)
+type ListNamedEntityByTagFunc func(input interfaces.ListNamedEntityByTagInput) (interfaces.NamedEntityCollectionOutput, error)
 
 type MockNamedEntityRepo struct {
-	getFunction    GetNamedEntityFunc
-	listFunction   ListNamedEntityFunc
-	updateFunction UpdateNamedEntityFunc
+	getFunction               GetNamedEntityFunc
+	listFunction              ListNamedEntityFunc
+	updateFunction            UpdateNamedEntityFunc
+	searchFunction            SearchNamedEntityFunc
+	getDescriptionFunction    GetNamedEntityDescriptionFunc
+	updateDescriptionFunction UpdateNamedEntityDescriptionFunc
+	addTagsFunction           NamedEntityTagsFunc
+	removeTagsFunction        NamedEntityTagsFunc
+	listTagsFunction          ListNamedEntityTagsFunc
+	listByTagFunction         ListNamedEntityByTagFunc
 }
 
 func (r *MockNamedEntityRepo) Update(ctx context.Context, NamedEntity models.NamedEntity) error {
@@ -50,6 +66,60 @@ func (r *MockNamedEntityRepo) List(ctx context.Context, input interfaces.ListNam
 	return interfaces.NamedEntityCollectionOutput{}, nil
 }
 
+func (r *MockNamedEntityRepo) Search(
+	ctx context.Context, input interfaces.SearchNamedEntityInput) ([]interfaces.NamedEntitySearchResult, error) {
+	if r.searchFunction != nil {
+		return r.searchFunction(input)
+	}
+	return []interfaces.NamedEntitySearchResult{}, nil
+}
+
+func (r *MockNamedEntityRepo) GetDescription(
+	ctx context.Context, input interfaces.GetNamedEntityInput) (models.NamedEntityDescription, error) {
+	if r.getDescriptionFunction != nil {
+		return r.getDescriptionFunction(input)
+	}
+	return models.NamedEntityDescription{}, errors.NewFlyteAdminErrorf(codes.NotFound, "description not found")
+}
+
+func (r *MockNamedEntityRepo) UpdateDescription(ctx context.Context, input models.NamedEntityDescription) error {
+	if r.updateDescriptionFunction != nil {
+		return r.updateDescriptionFunction(input)
+	}
+	return nil
+}
+
+func (r *MockNamedEntityRepo) AddTags(
+	ctx context.Context, input interfaces.GetNamedEntityInput, tags []string) error {
+	if r.addTagsFunction != nil {
+		return r.addTagsFunction(input, tags)
+	}
+	return nil
+}
+
+func (r *MockNamedEntityRepo) RemoveTags(
+	ctx context.Context, input interfaces.GetNamedEntityInput, tags []string) error {
+	if r.removeTagsFunction != nil {
+		return r.removeTagsFunction(input, tags)
+	}
+	return nil
+}
+
+func (r *MockNamedEntityRepo) ListTags(ctx context.Context, input interfaces.GetNamedEntityInput) ([]string, error) {
+	if r.listTagsFunction != nil {
+		return r.listTagsFunction(input)
+	}
+	return []string{}, nil
+}
+
+func (r *MockNamedEntityRepo) ListByTag(
+	ctx context.Context, input interfaces.ListNamedEntityByTagInput) (interfaces.NamedEntityCollectionOutput, error) {
+	if r.listByTagFunction != nil {
+		return r.listByTagFunction(input)
+	}
+	return interfaces.NamedEntityCollectionOutput{}, nil
+}
+
 func (r *MockNamedEntityRepo) SetGetCallback(getFunction GetNamedEntityFunc) {
 	r.getFunction = getFunction
 }
@@ -62,6 +132,35 @@ func (r *MockNamedEntityRepo) SetUpdateCallback(updateFunction UpdateNamedEntity
 	r.updateFunction = updateFunction
 }
 
+func (r *MockNamedEntityRepo) SetSearchCallback(searchFunction SearchNamedEntityFunc) {
+	r.searchFunction = searchFunction
+}
+
+func (r *MockNamedEntityRepo) SetGetDescriptionCallback(getDescriptionFunction GetNamedEntityDescriptionFunc) {
+	r.getDescriptionFunction = getDescriptionFunction
+}
+
+func (r *MockNamedEntityRepo) SetUpdateDescriptionCallback(
+	updateDescriptionFunction UpdateNamedEntityDescriptionFunc) {
+	r.updateDescriptionFunction = updateDescriptionFunction
+}
+
+func (r *MockNamedEntityRepo) SetAddTagsCallback(addTagsFunction NamedEntityTagsFunc) {
+	r.addTagsFunction = addTagsFunction
+}
+
+func (r *MockNamedEntityRepo) SetRemoveTagsCallback(removeTagsFunction NamedEntityTagsFunc) {
+	r.removeTagsFunction = removeTagsFunction
+}
+
+func (r *MockNamedEntityRepo) SetListTagsCallback(listTagsFunction ListNamedEntityTagsFunc) {
+	r.listTagsFunction = listTagsFunction
+}
+
+func (r *MockNamedEntityRepo) SetListByTagCallback(listByTagFunction ListNamedEntityByTagFunc) {
+	r.listByTagFunction = listByTagFunction
+}
+
 func NewMockNamedEntityRepo() interfaces.NamedEntityRepoInterface {
 	return &MockNamedEntityRepo{}
 }
@@ -15,6 +15,38 @@ type NodeExecutionEventRepoInterface struct {
 	mock.Mock
 }
 
+type NodeExecutionEventRepoInterface_BatchCreate struct {
+	*mock.Call
+}
+
+func (_m NodeExecutionEventRepoInterface_BatchCreate) Return(_a0 error) *NodeExecutionEventRepoInterface_BatchCreate {
+	return &NodeExecutionEventRepoInterface_BatchCreate{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *NodeExecutionEventRepoInterface) OnBatchCreate(ctx context.Context, input []models.NodeExecutionEvent) *NodeExecutionEventRepoInterface_BatchCreate {
+	c := _m.On("BatchCreate", ctx, input)
+	return &NodeExecutionEventRepoInterface_BatchCreate{Call: c}
+}
+
+func (_m *NodeExecutionEventRepoInterface) OnBatchCreateMatch(matchers ...interface{}) *NodeExecutionEventRepoInterface_BatchCreate {
+	c := _m.On("BatchCreate", matchers...)
+	return &NodeExecutionEventRepoInterface_BatchCreate{Call: c}
+}
+
+// BatchCreate provides a mock function with given fields: ctx, input
+func (_m *NodeExecutionEventRepoInterface) BatchCreate(ctx context.Context, input []models.NodeExecutionEvent) error {
+	ret := _m.Called(ctx, input)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.NodeExecutionEvent) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type NodeExecutionEventRepoInterface_Create struct {
 	*mock.Call
 }
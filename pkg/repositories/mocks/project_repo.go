@@ -12,12 +12,18 @@ type CreateProjectFunction func(ctx context.Context, project models.Project) err
 type GetProjectFunction func(ctx context.Context, projectID string) (models.Project, error)
 type ListProjectsFunction func(ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error)
 type UpdateProjectFunction func(ctx context.Context, projectUpdate models.Project) error
+type UpdateProjectMetadataFunction func(ctx context.Context, project models.Project) error
+type UpdateProjectExecutionDefaultsFunction func(ctx context.Context, project models.Project) error
+type UpdateProjectOrgFunction func(ctx context.Context, projectID, org string) error
 
 type MockProjectRepo struct {
-	CreateFunction        CreateProjectFunction
-	GetFunction           GetProjectFunction
-	ListProjectsFunction  ListProjectsFunction
-	UpdateProjectFunction UpdateProjectFunction
+	CreateFunction                  CreateProjectFunction
+	GetFunction                     GetProjectFunction
+	ListProjectsFunction            ListProjectsFunction
+	UpdateProjectFunction           UpdateProjectFunction
+	UpdateMetadataFunction          UpdateProjectMetadataFunction
+	UpdateExecutionDefaultsFunction UpdateProjectExecutionDefaultsFunction
+	UpdateOrgFunction               UpdateProjectOrgFunction
 }
 
 func (r *MockProjectRepo) Create(ctx context.Context, project models.Project) error {
@@ -52,6 +58,27 @@ func (r *MockProjectRepo) UpdateProject(ctx context.Context, projectUpdate model
 	return nil
 }
 
+func (r *MockProjectRepo) UpdateMetadata(ctx context.Context, project models.Project) error {
+	if r.UpdateMetadataFunction != nil {
+		return r.UpdateMetadataFunction(ctx, project)
+	}
+	return nil
+}
+
+func (r *MockProjectRepo) UpdateExecutionDefaults(ctx context.Context, project models.Project) error {
+	if r.UpdateExecutionDefaultsFunction != nil {
+		return r.UpdateExecutionDefaultsFunction(ctx, project)
+	}
+	return nil
+}
+
+func (r *MockProjectRepo) UpdateOrg(ctx context.Context, projectID, org string) error {
+	if r.UpdateOrgFunction != nil {
+		return r.UpdateOrgFunction(ctx, projectID, org)
+	}
+	return nil
+}
+
 func NewMockProjectRepo() interfaces.ProjectRepoInterface {
 	return &MockProjectRepo{}
 }
@@ -8,19 +8,55 @@ import (
 )
 
 type MockRepository struct {
-	taskRepo                      interfaces.TaskRepoInterface
-	workflowRepo                  interfaces.WorkflowRepoInterface
-	launchPlanRepo                interfaces.LaunchPlanRepoInterface
-	executionRepo                 interfaces.ExecutionRepoInterface
-	ExecutionEventRepoIface       interfaces.ExecutionEventRepoInterface
-	nodeExecutionRepo             interfaces.NodeExecutionRepoInterface
-	NodeExecutionEventRepoIface   interfaces.NodeExecutionEventRepoInterface
-	projectRepo                   interfaces.ProjectRepoInterface
-	resourceRepo                  interfaces.ResourceRepoInterface
-	taskExecutionRepo             interfaces.TaskExecutionRepoInterface
-	namedEntityRepo               interfaces.NamedEntityRepoInterface
-	schedulableEntityRepo         sIface.SchedulableEntityRepoInterface
-	schedulableEntitySnapshotRepo sIface.ScheduleEntitiesSnapShotRepoInterface
+	taskRepo                          interfaces.TaskRepoInterface
+	workflowRepo                      interfaces.WorkflowRepoInterface
+	launchPlanRepo                    interfaces.LaunchPlanRepoInterface
+	executionRepo                     interfaces.ExecutionRepoInterface
+	ExecutionEventRepoIface           interfaces.ExecutionEventRepoInterface
+	nodeExecutionRepo                 interfaces.NodeExecutionRepoInterface
+	NodeExecutionEventRepoIface       interfaces.NodeExecutionEventRepoInterface
+	projectRepo                       interfaces.ProjectRepoInterface
+	resourceRepo                      interfaces.ResourceRepoInterface
+	taskExecutionRepo                 interfaces.TaskExecutionRepoInterface
+	namedEntityRepo                   interfaces.NamedEntityRepoInterface
+	RetentionRepoIface                interfaces.RetentionRepoInterface
+	OutboxRepoIface                   interfaces.OutboxRepoInterface
+	BackfillRepoIface                 interfaces.BackfillRepoInterface
+	TaskExecutionUsageRepoIface       interfaces.TaskExecutionUsageRepoInterface
+	SkippedEventRepoIface             interfaces.SkippedEventRepoInterface
+	RecordedEventRepoIface            interfaces.RecordedEventRepoInterface
+	LineageRepoIface                  interfaces.LineageRepoInterface
+	WebhookRepoIface                  interfaces.WebhookRepoInterface
+	NotificationDeliveryRepoIface     interfaces.NotificationDeliveryRepoInterface
+	NotificationDigestRepoIface       interfaces.NotificationDigestRepoInterface
+	NotificationSubscriptionRepoIface interfaces.NotificationSubscriptionRepoInterface
+	TriggerRepoIface                  interfaces.TriggerRepoInterface
+	LaunchPlanRolloutRepoIface        interfaces.LaunchPlanRolloutRepoInterface
+	ClusterPoolRepoIface              interfaces.ClusterPoolRepoInterface
+	ClusterHealthRepoIface            interfaces.ClusterHealthRepoInterface
+	ClusterResourceSyncRepoIface      interfaces.ClusterResourceSyncRepoInterface
+	domainQuotaRepo                   interfaces.DomainQuotaRepoInterface
+	domainRepo                        interfaces.DomainRepoInterface
+	APIUsageRepoIface                 interfaces.APIUsageRepoInterface
+	OffloadedLiteralRepoIface         interfaces.OffloadedLiteralRepoInterface
+	ProjectPurgeRepoIface             interfaces.ProjectPurgeRepoInterface
+	SignalRepoIface                   interfaces.SignalRepoInterface
+	ExecutionCommentRepoIface         interfaces.ExecutionCommentRepoInterface
+	SavedViewRepoIface                interfaces.SavedViewRepoInterface
+	OAuthClientRepoIface              interfaces.OAuthClientRepoInterface
+	featureFlagRepo                   interfaces.FeatureFlagRepoInterface
+	CacheEvictionRepoIface            interfaces.CacheEvictionRepoInterface
+	OrgRepoIface                      interfaces.OrgRepoInterface
+	schedulableEntityRepo             sIface.SchedulableEntityRepoInterface
+	schedulableEntitySnapshotRepo     sIface.ScheduleEntitiesSnapShotRepoInterface
+	scheduleRunRepo                   sIface.ScheduleRunRepoInterface
+
+	// Returned by Stats.
+	RepositoryStats interfaces.RepositoryStats
+}
+
+func (r *MockRepository) Stats() interfaces.RepositoryStats {
+	return r.RepositoryStats
 }
 
 func (r *MockRepository) SchedulableEntityRepo() sIface.SchedulableEntityRepoInterface {
@@ -31,6 +67,10 @@ func (r *MockRepository) ScheduleEntitiesSnapshotRepo() sIface.ScheduleEntitiesS
 	return r.schedulableEntitySnapshotRepo
 }
 
+func (r *MockRepository) ScheduleRunRepo() sIface.ScheduleRunRepoInterface {
+	return r.scheduleRunRepo
+}
+
 func (r *MockRepository) TaskRepo() interfaces.TaskRepoInterface {
 	return r.taskRepo
 }
@@ -75,20 +115,161 @@ func (r *MockRepository) NamedEntityRepo() interfaces.NamedEntityRepoInterface {
 	return r.namedEntityRepo
 }
 
+func (r *MockRepository) RetentionRepo() interfaces.RetentionRepoInterface {
+	return r.RetentionRepoIface
+}
+
+func (r *MockRepository) OutboxRepo() interfaces.OutboxRepoInterface {
+	return r.OutboxRepoIface
+}
+
+func (r *MockRepository) BackfillRepo() interfaces.BackfillRepoInterface {
+	return r.BackfillRepoIface
+}
+
+func (r *MockRepository) TaskExecutionUsageRepo() interfaces.TaskExecutionUsageRepoInterface {
+	return r.TaskExecutionUsageRepoIface
+}
+
+func (r *MockRepository) SkippedEventRepo() interfaces.SkippedEventRepoInterface {
+	return r.SkippedEventRepoIface
+}
+
+func (r *MockRepository) RecordedEventRepo() interfaces.RecordedEventRepoInterface {
+	return r.RecordedEventRepoIface
+}
+
+func (r *MockRepository) LineageRepo() interfaces.LineageRepoInterface {
+	return r.LineageRepoIface
+}
+
+func (r *MockRepository) WebhookRepo() interfaces.WebhookRepoInterface {
+	return r.WebhookRepoIface
+}
+
+func (r *MockRepository) NotificationDeliveryRepo() interfaces.NotificationDeliveryRepoInterface {
+	return r.NotificationDeliveryRepoIface
+}
+
+func (r *MockRepository) NotificationDigestRepo() interfaces.NotificationDigestRepoInterface {
+	return r.NotificationDigestRepoIface
+}
+
+func (r *MockRepository) NotificationSubscriptionRepo() interfaces.NotificationSubscriptionRepoInterface {
+	return r.NotificationSubscriptionRepoIface
+}
+
+func (r *MockRepository) TriggerRepo() interfaces.TriggerRepoInterface {
+	return r.TriggerRepoIface
+}
+
+func (r *MockRepository) LaunchPlanRolloutRepo() interfaces.LaunchPlanRolloutRepoInterface {
+	return r.LaunchPlanRolloutRepoIface
+}
+
+func (r *MockRepository) ClusterPoolRepo() interfaces.ClusterPoolRepoInterface {
+	return r.ClusterPoolRepoIface
+}
+
+func (r *MockRepository) ClusterHealthRepo() interfaces.ClusterHealthRepoInterface {
+	return r.ClusterHealthRepoIface
+}
+
+func (r *MockRepository) ClusterResourceSyncRepo() interfaces.ClusterResourceSyncRepoInterface {
+	return r.ClusterResourceSyncRepoIface
+}
+
+func (r *MockRepository) DomainQuotaRepo() interfaces.DomainQuotaRepoInterface {
+	return r.domainQuotaRepo
+}
+
+func (r *MockRepository) DomainRepo() interfaces.DomainRepoInterface {
+	return r.domainRepo
+}
+
+func (r *MockRepository) APIUsageRepo() interfaces.APIUsageRepoInterface {
+	return r.APIUsageRepoIface
+}
+
+func (r *MockRepository) OffloadedLiteralRepo() interfaces.OffloadedLiteralRepoInterface {
+	return r.OffloadedLiteralRepoIface
+}
+
+func (r *MockRepository) ProjectPurgeRepo() interfaces.ProjectPurgeRepoInterface {
+	return r.ProjectPurgeRepoIface
+}
+
+func (r *MockRepository) SignalRepo() interfaces.SignalRepoInterface {
+	return r.SignalRepoIface
+}
+
+func (r *MockRepository) ExecutionCommentRepo() interfaces.ExecutionCommentRepoInterface {
+	return r.ExecutionCommentRepoIface
+}
+
+func (r *MockRepository) SavedViewRepo() interfaces.SavedViewRepoInterface {
+	return r.SavedViewRepoIface
+}
+
+func (r *MockRepository) OAuthClientRepo() interfaces.OAuthClientRepoInterface {
+	return r.OAuthClientRepoIface
+}
+
+func (r *MockRepository) FeatureFlagRepo() interfaces.FeatureFlagRepoInterface {
+	return r.featureFlagRepo
+}
+
+func (r *MockRepository) CacheEvictionRepo() interfaces.CacheEvictionRepoInterface {
+	return r.CacheEvictionRepoIface
+}
+
+func (r *MockRepository) OrgRepo() interfaces.OrgRepoInterface {
+	return r.OrgRepoIface
+}
+
 func NewMockRepository() repositories.RepositoryInterface {
 	return &MockRepository{
-		taskRepo:                      NewMockTaskRepo(),
-		workflowRepo:                  NewMockWorkflowRepo(),
-		launchPlanRepo:                NewMockLaunchPlanRepo(),
-		executionRepo:                 NewMockExecutionRepo(),
-		nodeExecutionRepo:             NewMockNodeExecutionRepo(),
-		projectRepo:                   NewMockProjectRepo(),
-		resourceRepo:                  NewMockResourceRepo(),
-		taskExecutionRepo:             NewMockTaskExecutionRepo(),
-		namedEntityRepo:               NewMockNamedEntityRepo(),
-		ExecutionEventRepoIface:       &ExecutionEventRepoInterface{},
-		NodeExecutionEventRepoIface:   &NodeExecutionEventRepoInterface{},
-		schedulableEntityRepo:         &sMocks.SchedulableEntityRepoInterface{},
-		schedulableEntitySnapshotRepo: &sMocks.ScheduleEntitiesSnapShotRepoInterface{},
+		taskRepo:                          NewMockTaskRepo(),
+		workflowRepo:                      NewMockWorkflowRepo(),
+		launchPlanRepo:                    NewMockLaunchPlanRepo(),
+		executionRepo:                     NewMockExecutionRepo(),
+		nodeExecutionRepo:                 NewMockNodeExecutionRepo(),
+		projectRepo:                       NewMockProjectRepo(),
+		resourceRepo:                      NewMockResourceRepo(),
+		taskExecutionRepo:                 NewMockTaskExecutionRepo(),
+		namedEntityRepo:                   NewMockNamedEntityRepo(),
+		ExecutionEventRepoIface:           &ExecutionEventRepoInterface{},
+		NodeExecutionEventRepoIface:       &NodeExecutionEventRepoInterface{},
+		RetentionRepoIface:                &RetentionRepoInterface{},
+		OutboxRepoIface:                   &OutboxRepoInterface{},
+		BackfillRepoIface:                 &BackfillRepoInterface{},
+		TaskExecutionUsageRepoIface:       &TaskExecutionUsageRepoInterface{},
+		SkippedEventRepoIface:             &SkippedEventRepoInterface{},
+		RecordedEventRepoIface:            &RecordedEventRepoInterface{},
+		LineageRepoIface:                  &LineageRepoInterface{},
+		WebhookRepoIface:                  &WebhookRepoInterface{},
+		NotificationDeliveryRepoIface:     &NotificationDeliveryRepoInterface{},
+		NotificationDigestRepoIface:       &NotificationDigestRepoInterface{},
+		NotificationSubscriptionRepoIface: &NotificationSubscriptionRepoInterface{},
+		TriggerRepoIface:                  &TriggerRepoInterface{},
+		LaunchPlanRolloutRepoIface:        &LaunchPlanRolloutRepoInterface{},
+		ClusterPoolRepoIface:              &ClusterPoolRepoInterface{},
+		ClusterHealthRepoIface:            &ClusterHealthRepoInterface{},
+		ClusterResourceSyncRepoIface:      &ClusterResourceSyncRepoInterface{},
+		domainQuotaRepo:                   NewMockDomainQuotaRepo(),
+		domainRepo:                        NewMockDomainRepo(),
+		APIUsageRepoIface:                 &APIUsageRepoInterface{},
+		OffloadedLiteralRepoIface:         &OffloadedLiteralRepoInterface{},
+		ProjectPurgeRepoIface:             &ProjectPurgeRepoInterface{},
+		SignalRepoIface:                   &SignalRepoInterface{},
+		ExecutionCommentRepoIface:         &ExecutionCommentRepoInterface{},
+		SavedViewRepoIface:                &SavedViewRepoInterface{},
+		OAuthClientRepoIface:              &OAuthClientRepoInterface{},
+		featureFlagRepo:                   NewMockFeatureFlagRepo(),
+		CacheEvictionRepoIface:            &CacheEvictionRepoInterface{},
+		OrgRepoIface:                      &OrgRepoInterface{},
+		schedulableEntityRepo:             &sMocks.SchedulableEntityRepoInterface{},
+		schedulableEntitySnapshotRepo:     &sMocks.ScheduleEntitiesSnapShotRepoInterface{},
+		scheduleRunRepo:                   &sMocks.ScheduleRunRepoInterface{},
 	}
 }
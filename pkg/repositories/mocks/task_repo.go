@@ -10,6 +10,7 @@ import (
 
 type CreateTaskFunc func(input models.Task) error
 type GetTaskFunc func(input interfaces.Identifier) (models.Task, error)
+type GetBatchTaskFunc func(input []interfaces.Identifier) ([]models.Task, error)
 type ListTaskFunc func(input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error)
 type ListTaskIdentifiersFunc func(input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error)
 
@@ -18,6 +19,7 @@ type MockTaskRepo struct {
 	getFunction               GetTaskFunc
 	listFunction              ListTaskFunc
 	listUniqueTaskIdsFunction ListTaskIdentifiersFunc
+	getBatchFunction          GetBatchTaskFunc
 }
 
 func (r *MockTaskRepo) Create(ctx context.Context, input models.Task) error {
@@ -49,6 +51,25 @@ func (r *MockTaskRepo) SetGetCallback(getFunction GetTaskFunc) {
 	r.getFunction = getFunction
 }
 
+func (r *MockTaskRepo) GetBatch(ctx context.Context, input []interfaces.Identifier) ([]models.Task, error) {
+	if r.getBatchFunction != nil {
+		return r.getBatchFunction(input)
+	}
+	tasks := make([]models.Task, 0, len(input))
+	for _, id := range input {
+		task, err := r.Get(ctx, id)
+		if err != nil {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func (r *MockTaskRepo) SetGetBatchCallback(getBatchFunction GetBatchTaskFunc) {
+	r.getBatchFunction = getBatchFunction
+}
+
 func (r *MockTaskRepo) List(
 	ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskCollectionOutput, error) {
 	if r.listFunction != nil {
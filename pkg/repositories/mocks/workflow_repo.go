@@ -10,6 +10,7 @@ import (
 
 type CreateWorkflowFunc func(input models.Workflow) error
 type GetWorkflowFunc func(input interfaces.Identifier) (models.Workflow, error)
+type GetBatchWorkflowFunc func(input []interfaces.Identifier) ([]models.Workflow, error)
 type ListWorkflowFunc func(input interfaces.ListResourceInput) (interfaces.WorkflowCollectionOutput, error)
 type ListIdentifiersFunc func(input interfaces.ListResourceInput) (interfaces.WorkflowCollectionOutput, error)
 
@@ -18,6 +19,7 @@ type MockWorkflowRepo struct {
 	getFunction         GetWorkflowFunc
 	listFunction        ListWorkflowFunc
 	listIdentifiersFunc ListIdentifiersFunc
+	getBatchFunction    GetBatchWorkflowFunc
 }
 
 func (r *MockWorkflowRepo) Create(ctx context.Context, input models.Workflow) error {
@@ -49,6 +51,25 @@ func (r *MockWorkflowRepo) SetGetCallback(getFunction GetWorkflowFunc) {
 	r.getFunction = getFunction
 }
 
+func (r *MockWorkflowRepo) GetBatch(ctx context.Context, input []interfaces.Identifier) ([]models.Workflow, error) {
+	if r.getBatchFunction != nil {
+		return r.getBatchFunction(input)
+	}
+	workflows := make([]models.Workflow, 0, len(input))
+	for _, id := range input {
+		workflow, err := r.Get(ctx, id)
+		if err != nil {
+			continue
+		}
+		workflows = append(workflows, workflow)
+	}
+	return workflows, nil
+}
+
+func (r *MockWorkflowRepo) SetGetBatchCallback(getBatchFunction GetBatchWorkflowFunc) {
+	r.getBatchFunction = getBatchFunction
+}
+
 func (r *MockWorkflowRepo) List(
 	ctx context.Context, input interfaces.ListResourceInput) (interfaces.WorkflowCollectionOutput, error) {
 	if r.listFunction != nil {
@@ -0,0 +1,8 @@
+package models
+
+// Database model of the row locked while deciding whether executions of a project and domain are admitted, so that
+// replicas admit them one at a time.
+type AdmissionLock struct {
+	Project string `gorm:"primary_key" valid:"length(0|255)"`
+	Domain  string `gorm:"primary_key" valid:"length(0|255)"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// The calls a caller made to an RPC on behalf of a project in a single day. Admin replicas add the calls they served
+// to the totals of each day, so the totals cover every replica.
+type APIUsage struct {
+	BaseModel
+	// The UTC day the calls were made on.
+	Day time.Time `gorm:"primary_key"`
+	// The full name of the RPC, e.g. /flyteidl.service.AdminService/CreateExecution.
+	Method string `gorm:"primary_key" valid:"length(0|255)"`
+	// The user or application which made the calls, or empty for unauthenticated calls.
+	Principal string `gorm:"primary_key" valid:"length(0|255)"`
+	// The project the calls targeted, or empty for calls which don't target a project.
+	Project string `gorm:"primary_key" valid:"length(0|255)"`
+	Calls   int64
+	Errors  int64
+	// The summed and slowest latency of the calls, in milliseconds.
+	TotalLatencyMs int64
+	MaxLatencyMs   int64
+}
+
+// The usage of a group of callers, projects or RPCs over a number of days. Fields which aren't grouped by are empty.
+type APIUsageSum struct {
+	Method         string
+	Principal      string
+	Project        string
+	Calls          int64
+	Errors         int64
+	TotalLatencyMs int64
+	MaxLatencyMs   int64
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// Backfill primary key
+type BackfillKey struct {
+	Project string `gorm:"primary_key;index:idx_backfills_project_domain" valid:"length(0|255)"`
+	Domain  string `gorm:"primary_key;index:idx_backfills_project_domain" valid:"length(0|255)"`
+	Name    string `gorm:"primary_key" valid:"length(0|255)"`
+}
+
+// Database model to encapsulate a backfill, which launches an execution of a scheduled launch plan for each time it
+// was scheduled in a range, a few at a time.
+type Backfill struct {
+	BaseModel
+	BackfillKey
+	// The launch plan is in the same project and domain as the backfill.
+	LaunchPlanName    string `gorm:"not null" valid:"length(0|255)"`
+	LaunchPlanVersion string `gorm:"not null" valid:"length(0|255)"`
+	// Executions are launched for the times the launch plan was scheduled after StartTime, up to and including EndTime.
+	StartTime time.Time
+	EndTime   time.Time
+	// The maximum number of the backfill's executions which run at once.
+	Parallelism int
+	// Whether executions for later scheduled times are launched first.
+	Reverse bool
+	// The number of scheduled times in the range, and how many of them executions have been launched for.
+	Total    int
+	Launched int
+	// Backfills are RUNNING until each of their executions terminates, and are then SUCCEEDED unless any of them
+	// didn't succeed, in which case they're FAILED. Terminated backfills are ABORTED.
+	Phase      string `gorm:"index" valid:"length(0|255)"`
+	AbortCause string `valid:"length(0|255)"`
+	// The user who created the backfill.
+	Principal string
+}
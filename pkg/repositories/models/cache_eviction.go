@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// Database model to encapsulate the eviction of the cached outputs of a task. Evictions are kept to audit who evicted
+// outputs, when and why, and mark the node executions whose cached outputs shouldn't be trusted.
+type CacheEviction struct {
+	ID        uint      `gorm:"primary_key"`
+	CreatedAt time.Time `gorm:"index"`
+	// The datacatalog dataset the outputs are cached in, which is named after the task.
+	DatasetProject string `gorm:"index:idx_cache_evictions_dataset" valid:"length(0|255)"`
+	DatasetDomain  string `gorm:"index:idx_cache_evictions_dataset" valid:"length(0|255)"`
+	DatasetName    string `gorm:"index:idx_cache_evictions_dataset" valid:"length(0|255)"`
+	DatasetVersion string `valid:"length(0|255)"`
+	// The evicted artifact, or empty when every artifact of the dataset was evicted.
+	ArtifactID string `valid:"length(0|255)"`
+	// The node execution which ran or reused the evicted outputs, if any.
+	ExecutionProject string `gorm:"index:idx_cache_evictions_execution" valid:"length(0|255)"`
+	ExecutionDomain  string `gorm:"index:idx_cache_evictions_execution" valid:"length(0|255)"`
+	ExecutionName    string `gorm:"index:idx_cache_evictions_execution" valid:"length(0|255)"`
+	NodeID           string `valid:"length(0|255)"`
+	// The user who evicted the outputs.
+	Principal string
+	Reason    string
+}
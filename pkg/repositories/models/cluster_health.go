@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// The health states of execution clusters.
+const (
+	// New executions are routed to the cluster.
+	ClusterHealthy = "HEALTHY"
+	// New executions aren't routed to the cluster, since it's failing.
+	ClusterUnhealthy = "UNHEALTHY"
+	// New executions aren't routed to the cluster, so that it can be taken down once its running executions complete.
+	ClusterDraining = "DRAINING"
+)
+
+// Database model to encapsulate the health state of an execution cluster. Clusters without a health state are healthy.
+type ClusterHealth struct {
+	BaseModel
+	Cluster string `gorm:"primary_key" valid:"length(0|255)"`
+	State   string `gorm:"not null"`
+	// Why the cluster is in its state, e.g. the maintenance it's drained for.
+	Reason string
+	// The user who last set the state, or the health prober when it marked the cluster unhealthy.
+	Principal string
+	// The results of the last health probe of the cluster.
+	ProbedAt                 *time.Time
+	ProbeError               string
+	ConsecutiveProbeFailures int32
+	// When flytepropeller in the cluster last renewed its lease, as of the last health probe.
+	PropellerHeartbeatAt *time.Time
+}
@@ -0,0 +1,21 @@
+package models
+
+// Database model to encapsulate a named pool of execution clusters, which executions are routed to when an
+// ExecutionClusterLabel matchable attribute references the pool's name.
+type ClusterPool struct {
+	BaseModel
+	Name        string `gorm:"primary_key" valid:"length(0|255)"`
+	Description string
+	// The JSON serialized labels describing the pool, e.g. {"region": "us-east-1"}.
+	Labels []byte
+	// The JSON serialized list of the pool's clusters and the weights with which they're picked.
+	Members []byte
+	// The user who last updated the pool.
+	Principal string
+}
+
+// A cluster in a pool, as serialized in its members.
+type ClusterPoolMember struct {
+	Cluster string  `json:"cluster"`
+	Weight  float32 `json:"weight"`
+}
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// Cluster resource sync primary key
+type ClusterResourceSyncKey struct {
+	Project string `gorm:"primary_key" valid:"length(0|255)"`
+	Domain  string `gorm:"primary_key" valid:"length(0|255)"`
+	Cluster string `gorm:"primary_key" valid:"length(0|255)"`
+	// The name of the cluster resource template file.
+	Template string `gorm:"primary_key" valid:"length(0|255)"`
+}
+
+// The ways the namespaces of archived projects and removed domains are cleaned up.
+const (
+	// The resources created from templates were deleted.
+	ClusterResourceCleanupDeleted = "DELETED"
+	// A resource quota preventing new pods was created in the namespace, leaving its resources as they are.
+	ClusterResourceCleanupCordoned = "CORDONED"
+)
+
+// Database model to encapsulate the outcome of syncing a cluster resource template to the namespace of a project and
+// domain in an execution cluster.
+type ClusterResourceSync struct {
+	BaseModel
+	ClusterResourceSyncKey
+	Namespace string
+	// The resource the template was last applied as.
+	ResourceAPIVersion string
+	ResourceKind       string
+	ResourceName       string
+	// The version of the template last applied, which is the hash of the template with its values substituted.
+	AppliedVersion string
+	AppliedAt      *time.Time
+	// The error of the last attempt at applying the template, which is cleared once it's applied.
+	ApplyError   string
+	ApplyErrorAt *time.Time
+	// The fields of the resource in the cluster which differ from the applied template, as of the last drift check.
+	Drift          string
+	DriftCheckedAt *time.Time
+	// When the project was found archived, or the domain removed, which starts the cleanup grace period.
+	RetiredAt *time.Time
+	// When the resource was cleaned up once the grace period passed, and how.
+	CleanedUpAt *time.Time
+	Cleanup     string
+}
@@ -0,0 +1,9 @@
+package models
+
+// Database model to encapsulate a domain which every project has, such as development, staging and production.
+type Domain struct {
+	BaseModel
+	Identifier  string `gorm:"primary_key" valid:"length(0|255)"`
+	Name        string `valid:"length(0|255)"` // Human-readable name, not a unique identifier.
+	Description string `gorm:"type:varchar(300)"`
+}
@@ -0,0 +1,19 @@
+package models
+
+// Domain quota primary key
+type DomainQuotaKey struct {
+	Project string `gorm:"primary_key" valid:"length(0|255)"`
+	Domain  string `gorm:"primary_key" valid:"length(0|255)"`
+}
+
+// Database model to encapsulate the resource quota of a project and domain. The caps are kubernetes quantities, and
+// unset caps are unlimited.
+type DomainQuota struct {
+	BaseModel
+	DomainQuotaKey
+	CPU    string
+	Memory string
+	GPU    string
+	// The user who last set the quota.
+	Principal string
+}
@@ -56,7 +56,49 @@ type Execution struct {
 	ErrorKind *string `gorm:"index"`
 	// Execution Error Code nullable
 	ErrorCode *string `valid:"length(0|255)"`
+	// Why the execution didn't succeed, one of the common.FailureCause values. Null unless the execution failed, timed
+	// out or was aborted.
+	FailureCause *string `gorm:"index" valid:"length(0|255)"`
 	// The user responsible for launching this execution.
 	// This is also stored in the spec but promoted as a column for filtering.
 	User string `gorm:"index" valid:"length(0|255)"`
+	// The user who launched this execution on behalf of User, if it was launched while impersonating User.
+	Impersonator string `gorm:"index" valid:"length(0|255)"`
+	// Labels applied to the execution, which are saved alongside it on create.
+	Labels []ExecutionLabel `gorm:"-"`
+	// The key supplied by the client creating the execution, unique within its project and domain. Requests retried
+	// with the same key return this execution instead of creating another.
+	IdempotencyKey *string `valid:"length(0|255)"`
+	// Whether the execution is waiting for its project and domain to run fewer executions before it's launched, or to
+	// be launched asynchronously. Pending executions remain UNDEFINED, or QUEUED when they're launched asynchronously,
+	// until they're launched.
+	Pending bool `gorm:"not null;default:false"`
+	// When set, the time before which a pending execution isn't launched.
+	RunAt *time.Time
+	// The priority class the execution was launched with, if any. Pending executions are dispatched in class order.
+	Priority string `gorm:"index" valid:"length(0|255)"`
+	// The number of times launching a pending execution failed.
+	LaunchAttempts int `gorm:"not null;default:0"`
+	// The error a pending execution last failed to launch with. Cleared once it's launched.
+	LaunchError string
+}
+
+// The number of executions in a group of those counted. Fields which executions aren't grouped by are empty.
+type ExecutionGroupCount struct {
+	Project string
+	Domain  string
+	Phase   string
+	// The UTC day the executions were created on, formatted as YYYY-MM-DD.
+	Day   string
+	Count int64
+}
+
+// The outcome of an execution launched from a launch plan, for computing how reliably and quickly the launch plan runs.
+type LaunchPlanExecutionOutcome struct {
+	LaunchPlanProject  string
+	LaunchPlanDomain   string
+	LaunchPlanName     string
+	Phase              string
+	Duration           time.Duration
+	ExecutionUpdatedAt time.Time
 }
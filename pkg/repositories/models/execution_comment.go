@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// Database model to encapsulate a comment left on an execution after the fact, such as why it was re-run manually.
+type ExecutionComment struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// The execution commented on.
+	ExecutionProject string `gorm:"index:idx_execution_comments_execution" valid:"length(0|255)"`
+	ExecutionDomain  string `gorm:"index:idx_execution_comments_execution" valid:"length(0|255)"`
+	ExecutionName    string `gorm:"index:idx_execution_comments_execution" valid:"length(0|255)"`
+	// The user who left the comment.
+	Author string
+	Text   string `gorm:"not null"`
+	// The newline-separated links attached to the comment, e.g. to an incident or a dashboard.
+	Links string
+}
@@ -0,0 +1,17 @@
+package models
+
+// A label applied to an execution. Labels are also serialized in the execution spec, but are stored in their own table
+// so that executions can be filtered and sorted on them.
+type ExecutionLabel struct {
+	ExecutionKey
+	Key   string `gorm:"primary_key;index:idx_execution_labels_key_value" valid:"length(0|255)"`
+	Value string `gorm:"index:idx_execution_labels_key_value" valid:"length(0|255)"`
+}
+
+// The number of executions a label key, or a key and value, is applied to.
+type ExecutionLabelCount struct {
+	Key string
+	// Empty when only keys are counted.
+	Value string
+	Count int64
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// Database model to encapsulate an override of a feature flag, either for every project or for a single one. Flags
+// without overrides take the values admin is configured with.
+type FeatureFlag struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// The flag overridden, e.g. admission_control.
+	Name string `gorm:"unique_index:idx_feature_flags_key;not null" valid:"length(0|255)"`
+	// The project the override applies to, or empty for an override which applies to every project.
+	Project string `gorm:"unique_index:idx_feature_flags_key" valid:"length(0|255)"`
+	Enabled bool
+	// The user who last set the override.
+	Principal string
+}
+
+// Database model to encapsulate a change to an override of a feature flag, which is kept to audit who flipped flags,
+// when and why.
+type FeatureFlagChange struct {
+	ID        uint      `gorm:"primary_key"`
+	CreatedAt time.Time `gorm:"index"`
+	Name      string    `gorm:"index:idx_feature_flag_changes_name" valid:"length(0|255)"`
+	Project   string    `valid:"length(0|255)"`
+	// The value the flag was overridden with, or nil when the override was removed.
+	Enabled *bool
+	// The user who changed the flag.
+	Principal string
+	Reason    string
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Database model of an idempotency key reserved by the request launching the execution created with it, so that
+// concurrent retries of the request don't launch it again.
+type IdempotencyKeyReservation struct {
+	Project        string `gorm:"primary_key" valid:"length(0|255)"`
+	Domain         string `gorm:"primary_key" valid:"length(0|255)"`
+	IdempotencyKey string `gorm:"primary_key" valid:"length(0|255)"`
+	// Identifies the request holding the reservation.
+	ReservationID string
+	// The reservation may be taken over by another request after this time, such as when the admin holding it
+	// stopped while launching the execution.
+	ReservedUntil time.Time
+}
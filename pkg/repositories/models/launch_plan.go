@@ -27,8 +27,10 @@ type LaunchPlan struct {
 	WorkflowID uint   `gorm:"index"`
 	Closure    []byte `gorm:"not null"`
 	// GORM doesn't save the zero value for ints, so we use a pointer for the State field
-	State      *int32 `gorm:"default:0"`
-	Executions []Execution
+	State *int32 `gorm:"default:0"`
+	// Incremented by every state transition, so that concurrent transitions can be detected.
+	StateRevision int32 `gorm:"not null;default:0"`
+	Executions    []Execution
 	// Hash of the launch plan
 	Digest       []byte
 	ScheduleType LaunchPlanScheduleType
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// Launch plan rollout primary key
+type LaunchPlanRolloutKey struct {
+	Project string `gorm:"primary_key" valid:"length(0|255)"`
+	Domain  string `gorm:"primary_key" valid:"length(0|255)"`
+	Name    string `gorm:"primary_key" valid:"length(0|255)"`
+}
+
+// The strategies with which a rollout picks the launch plan version to activate.
+const (
+	// Activates the most recently registered version with a label.
+	RolloutStrategyLabel = "LABEL"
+	// Activates the highest version matching a semantic version constraint.
+	RolloutStrategySemver = "SEMVER"
+)
+
+// Database model to encapsulate the rollout policy of a launch plan, which activates newly registered versions picked
+// by its strategy once they've soaked, along with the state needed to roll back the last version it activated.
+type LaunchPlanRollout struct {
+	BaseModel
+	LaunchPlanRolloutKey
+	Strategy string `gorm:"not null"`
+	// The label versions must have with the LABEL strategy, either a key or a key=value pair.
+	Label string
+	// The constraint versions must match with the SEMVER strategy, e.g. ^1.4.
+	VersionConstraint string
+	// How long after being registered versions are activated.
+	SoakPeriodSeconds uint32
+	// The version last activated by the rollout, and the version which was active before it.
+	RolledOutVersion string
+	PreviousVersion  string
+	RolledOutAt      *time.Time
+	// The comma-separated versions which were rolled back, and aren't activated by the rollout again.
+	RejectedVersions string
+	// The user who set the rollout policy, on whose behalf versions are activated.
+	Principal string
+}
@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// An input or output of a task execution, recorded once the task execution terminates so the data an execution read
+// and wrote can be traced.
+type TaskExecutionArtifact struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	// The execution the task execution belongs to.
+	ExecutionProject string `gorm:"index:idx_task_execution_artifacts_execution" valid:"length(0|255)"`
+	ExecutionDomain  string `gorm:"index:idx_task_execution_artifacts_execution" valid:"length(0|255)"`
+	ExecutionName    string `gorm:"index:idx_task_execution_artifacts_execution" valid:"length(0|255)"`
+	NodeID           string `valid:"length(0|255)"`
+	RetryAttempt     *uint32
+	TaskProject      string `valid:"length(0|255)"`
+	TaskDomain       string `valid:"length(0|255)"`
+	TaskName         string `valid:"length(0|255)"`
+	TaskVersion      string `valid:"length(0|255)"`
+	// One of INPUT or OUTPUT.
+	Direction string
+	URI       string `gorm:"index"`
+}
+
+// A link from an execution to one it was derived from, such as the execution which launched it or which it recovered.
+type ExecutionRelationship struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	// The downstream execution.
+	ExecutionProject string `gorm:"index:idx_execution_relationships_execution" valid:"length(0|255)"`
+	ExecutionDomain  string `gorm:"index:idx_execution_relationships_execution" valid:"length(0|255)"`
+	ExecutionName    string `gorm:"index:idx_execution_relationships_execution" valid:"length(0|255)"`
+	// The upstream execution.
+	RelatedProject string `gorm:"index:idx_execution_relationships_related" valid:"length(0|255)"`
+	RelatedDomain  string `gorm:"index:idx_execution_relationships_related" valid:"length(0|255)"`
+	RelatedName    string `gorm:"index:idx_execution_relationships_related" valid:"length(0|255)"`
+	// One of PARENT, RECOVERED_FROM or RELAUNCHED_FROM.
+	Kind string
+	// For PARENT relationships, the node of the upstream execution which launched the downstream one.
+	NodeID string `valid:"length(0|255)"`
+}
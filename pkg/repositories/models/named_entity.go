@@ -40,3 +40,28 @@ type NamedEntity struct {
 	NamedEntityKey
 	NamedEntityMetadataFields
 }
+
+// Long-form documentation of a named entity. It's stored apart from the entity's metadata, which is joined with every
+// listed entity, since it's only read when a single entity is shown.
+type NamedEntityDescription struct {
+	BaseModel
+	ResourceType core.ResourceType `gorm:"primary_key" valid:"length(0|255)"`
+	Project      string            `gorm:"primary_key" valid:"length(0|255)"`
+	Domain       string            `gorm:"primary_key" valid:"length(0|255)"`
+	Name         string            `gorm:"primary_key" valid:"length(0|255)"`
+	// Markdown describing the entity.
+	LongDescription string `gorm:"type:text"`
+	// A link to the source of the entity, such as the file in its repository which defines it.
+	SourceLink string `valid:"length(0|255)"`
+	// The JSON serialized list of the people to contact about the entity.
+	Owners []byte
+}
+
+// A tag applied to a named entity, such as "team:growth", by which entities are browsed across projects.
+type NamedEntityTag struct {
+	ResourceType core.ResourceType `gorm:"primary_key"`
+	Project      string            `gorm:"primary_key" valid:"length(0|255)"`
+	Domain       string            `gorm:"primary_key" valid:"length(0|255)"`
+	Name         string            `gorm:"primary_key" valid:"length(0|255)"`
+	Tag          string            `gorm:"primary_key;index" valid:"length(0|255)"`
+}
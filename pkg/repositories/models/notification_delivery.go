@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// Records delivering a notification published for an execution, so that failed notifications can be found and resent.
+type NotificationDelivery struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// The execution the notification was published for.
+	Project       string `gorm:"index:idx_notification_deliveries_execution" valid:"length(0|255)"`
+	Domain        string `gorm:"index:idx_notification_deliveries_execution" valid:"length(0|255)"`
+	ExecutionName string `gorm:"index:idx_notification_deliveries_execution" valid:"length(0|255)"`
+	// How the notification is sent, e.g. email or slack.
+	Type string `valid:"length(0|255)"`
+	// The serialized notification, which is published again to resend it.
+	Payload []byte `gorm:"not null"`
+	// PENDING while being sent, then SUCCEEDED, or FAILED until the notification is resent.
+	Status    string `gorm:"index" valid:"length(0|255)"`
+	Attempts  int
+	LastError string
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// An execution whose email is batched into a digest, which is kept until the digest is sent.
+type NotificationDigestEntry struct {
+	ID            uint `gorm:"primary_key"`
+	CreatedAt     time.Time
+	Project       string `valid:"length(0|255)"`
+	Domain        string `valid:"length(0|255)"`
+	LaunchPlan    string `valid:"length(0|255)"`
+	ExecutionName string `valid:"length(0|255)"`
+	Phase         string `valid:"length(0|255)"`
+	Error         string
+	Link          string
+	OccurredAt    time.Time
+	// Comma separated email addresses the digest is sent to.
+	Recipients string
+	// Entries in the same group are summarized in the same digest.
+	GroupKey string `gorm:"index"`
+	// When the digest the entry is summarized in is sent.
+	DueAt time.Time `gorm:"index"`
+	// Identifies the sender which last claimed the entry.
+	LeaseID string
+	// The entry isn't claimed by other senders until its lease expires.
+	LeasedUntil *time.Time `gorm:"index"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// Identifies whom a subscription notifies, and of which executions.
+type NotificationSubscriptionKey struct {
+	Project string `gorm:"unique_index:idx_notification_subscriptions_key" valid:"length(0|255)"`
+	Domain  string `gorm:"unique_index:idx_notification_subscriptions_key" valid:"length(0|255)"`
+	// The launch plan whose executions are notified, or empty for every execution in the project and domain.
+	LaunchPlan string `gorm:"unique_index:idx_notification_subscriptions_key" valid:"length(0|255)"`
+	// One of email, slack or pagerDuty.
+	Type    string `gorm:"unique_index:idx_notification_subscriptions_key" valid:"length(0|255)"`
+	Address string `gorm:"unique_index:idx_notification_subscriptions_key" valid:"length(0|255)"`
+}
+
+// Database model to encapsulate a user's subscription to the executions of a launch plan or project, whose address is
+// added to the recipients of the execution's notifications when it reaches one of the subscription's phases.
+type NotificationSubscription struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	NotificationSubscriptionKey
+	// The comma-separated execution phases notified, e.g. FAILED,TIMED_OUT.
+	Phases string `gorm:"not null"`
+	// The user who subscribed.
+	Principal string
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Database model to encapsulate an OAuth2 client registered with the internal authorization server at runtime, as
+// opposed to the static clients of its config.
+type OAuthClient struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ClientID  string `gorm:"unique_index;not null" valid:"length(0|255)"`
+	// The user who registered the client and may rotate its secret or delete it.
+	Owner string `gorm:"index" valid:"length(0|255)"`
+	// The bcrypt hash of the client secret. Public clients have no secret.
+	SecretHash []byte
+	Public     bool
+	// Newline-separated, since URIs can't contain newlines.
+	RedirectURIs string
+	// Space-separated, as in OAuth2 requests.
+	GrantTypes    string
+	ResponseTypes string
+	Scopes        string
+}
+
+// gorm would otherwise name the table o_auth_clients.
+func (OAuthClient) TableName() string {
+	return "oauth_clients"
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// A literal map admin offloaded to blob storage on behalf of an execution. Literals are tracked from before they're
+// written until their execution is created, so that those of executions which failed to be created are collected.
+type OffloadedLiteral struct {
+	ID               uint      `gorm:"primary_key"`
+	CreatedAt        time.Time `gorm:"index"`
+	ExecutionProject string    `gorm:"not null"`
+	ExecutionDomain  string    `gorm:"not null"`
+	ExecutionName    string    `gorm:"not null"`
+	// The location the literal map is offloaded to.
+	URI string `gorm:"not null"`
+}
+
+// An offloaded literal which no longer needs to be tracked.
+type CollectableOffloadedLiteral struct {
+	OffloadedLiteral
+	// Whether the execution the literal map was offloaded for was never created, in which case nothing references it.
+	Orphaned bool
+}
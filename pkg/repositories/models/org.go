@@ -0,0 +1,12 @@
+package models
+
+// Database model to encapsulate an organization, which groups the projects of a business unit so that a single admin
+// can host several of them.
+type Org struct {
+	BaseModel
+	Identifier  string `gorm:"primary_key" valid:"length(0|255)"`
+	Name        string `valid:"length(0|255)"` // Human-readable name, not a unique identifier.
+	Description string `gorm:"type:varchar(300)"`
+	// The user who last updated the org.
+	Principal string
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// A message written in the same transaction as the execution update it belongs to, which the outbox relay publishes
+// once the transaction commits. Messages are deleted once they've been published.
+type OutboxMessage struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	// The project of the execution the message belongs to, whose messages are deleted when it's purged.
+	Project string
+	// The publisher the message is relayed through, e.g. notifications or events.
+	Publisher string `gorm:"not null"`
+	// The type the message is published with, which publishers use to route the message.
+	NotificationType string `gorm:"not null"`
+	// The fully qualified name of the proto message serialized in the payload.
+	MessageType string `gorm:"not null"`
+	Payload     []byte `gorm:"not null"`
+	// Identifies the relay which last claimed the message.
+	LeaseID string
+	// The message isn't claimed by other relays until its lease expires.
+	LeasedUntil *time.Time `gorm:"index"`
+	Attempts    int
+	LastError   string
+	// Set once the message failed to publish as many times as configured. Relays no longer claim dead lettered
+	// messages, which are kept so that they can be inspected and replayed.
+	DeadLetteredAt *time.Time `gorm:"index"`
+}
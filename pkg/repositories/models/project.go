@@ -8,4 +8,25 @@ type Project struct {
 	Labels      []byte
 	// GORM doesn't save the zero value for ints, so we use a pointer for the State field
 	State *int32 `gorm:"default:0;index"`
+	// The user or group who owns the project, and the team it belongs to.
+	Owner string `gorm:"index" valid:"length(0|255)"`
+	Team  string `gorm:"index" valid:"length(0|255)"`
+	// The org the project belongs to, if any.
+	Org string `gorm:"index" valid:"length(0|255)"`
+	// The JSON serialized map of arbitrary key-value metadata.
+	Metadata []byte
+	// The JSON serialized list of custom links, such as to the dashboards of the project.
+	Links []byte
+	// The JSON serialized settings which executions in the project default to when they don't set them.
+	ExecutionDefaults []byte
+	// The labels serialized in Labels, which are also stored in their own table so that projects can be filtered on
+	// them. Labels are only saved when non-nil, so that partial updates leave them unchanged.
+	ProjectLabels []ProjectLabel `gorm:"-"`
+}
+
+// A label applied to a project.
+type ProjectLabel struct {
+	Project string `gorm:"primary_key" valid:"length(0|255)"`
+	Key     string `gorm:"primary_key;index:idx_project_labels_key_value" valid:"length(0|255)"`
+	Value   string `gorm:"index:idx_project_labels_key_value" valid:"length(0|255)"`
 }
@@ -0,0 +1,25 @@
+package models
+
+// The phases of a project purge.
+const (
+	ProjectPurgeRunning   = "RUNNING"
+	ProjectPurgeSucceeded = "SUCCEEDED"
+)
+
+// Database model to encapsulate a purge, which hard deletes an archived project along with everything registered and
+// executed in it, a batch of records at a time.
+type ProjectPurge struct {
+	BaseModel
+	Project string `gorm:"index" valid:"length(0|255)"`
+	// Purges are RUNNING until each table has been purged and the project itself deleted, after which they're
+	// SUCCEEDED.
+	Phase string `gorm:"index" valid:"length(0|255)"`
+	// The table being purged. Tables are purged in the order given by interfaces.ProjectPurgeTables.
+	CurrentTable string `valid:"length(0|255)"`
+	// The number of records deleted so far, across tables.
+	DeletedRecords int64
+	// The error the purge last failed with. Failed batches are retried, and the error is cleared once one succeeds.
+	LastError string
+	// The user who purged the project.
+	Principal string
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// A workflow, node or task execution event as it was received, kept so the exact sequence of an execution's state
+// transitions can be reviewed. Unlike ExecutionEvent and NodeExecutionEvent, which keep one event per phase, every
+// event is kept, including those repeating a phase or which were skipped or rejected.
+type RecordedEvent struct {
+	ID uint `gorm:"primary_key"`
+	// When the event was received.
+	CreatedAt        time.Time
+	ExecutionProject string `gorm:"index:idx_recorded_events_execution" valid:"length(0|255)"`
+	ExecutionDomain  string `gorm:"index:idx_recorded_events_execution" valid:"length(0|255)"`
+	ExecutionName    string `gorm:"index:idx_recorded_events_execution" valid:"length(0|255)"`
+	// One of workflow, node or task.
+	EventType string
+	// Empty for workflow execution events.
+	NodeID string `valid:"length(0|255)"`
+	// Only set for task execution events.
+	RetryAttempt *uint32
+	RequestID    string
+	ProducerID   string
+	Phase        string
+	PhaseVersion uint32
+	OccurredAt   time.Time
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Identifies a saved view. Views without an owner are shared with everyone who can see their project.
+type SavedViewKey struct {
+	Project string `gorm:"unique_index:idx_saved_views_key" valid:"length(0|255)"`
+	Domain  string `gorm:"unique_index:idx_saved_views_key" valid:"length(0|255)"`
+	// The user whose personal view it is, or empty for a view of the project.
+	Owner string `gorm:"unique_index:idx_saved_views_key" valid:"length(0|255)"`
+	Name  string `gorm:"unique_index:idx_saved_views_key" valid:"length(0|255)"`
+}
+
+// Database model to encapsulate a named filter and sort order for listing executions or workflows, such as "My failed
+// prod runs this week", which is evaluated whenever the view is opened.
+type SavedView struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	SavedViewKey
+	// Either executions or workflows.
+	ResourceType string `gorm:"not null"`
+	// The filters of the list request, in the syntax of list endpoints, e.g. eq(phase,FAILED)+gte(created_at,now-168h).
+	Filters string
+	SortKey string
+	// Serialized admin.Sort_Direction, which is only set along with the sort key.
+	SortDirection int32
+	Description   string
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// Signal primary key
+type SignalKey struct {
+	ExecutionKey
+	SignalID string `gorm:"primary_key;index" valid:"length(0|255)"`
+}
+
+// Database model to encapsulate a signal, such as an approval, which a gate node of an execution waits on.
+type Signal struct {
+	BaseModel
+	SignalKey
+	// Serialized core.LiteralType of the value the signal expects.
+	Type []byte `gorm:"not null"`
+	// Serialized core.Literal, which is empty until the signal is set.
+	Value []byte
+	// The principal who set the signal.
+	SetBy string
+	SetAt *time.Time
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// An event which was received but not applied to the execution, node execution or task execution it belongs to, kept
+// with the reason it was skipped so that gaps in an execution's event history can be explained.
+type SkippedEvent struct {
+	ID               uint `gorm:"primary_key"`
+	CreatedAt        time.Time
+	ExecutionProject string `gorm:"index:idx_skipped_events_execution" valid:"length(0|255)"`
+	ExecutionDomain  string `gorm:"index:idx_skipped_events_execution" valid:"length(0|255)"`
+	ExecutionName    string `gorm:"index:idx_skipped_events_execution" valid:"length(0|255)"`
+	// One of workflow, node or task.
+	EventType string
+	// Empty for workflow execution events.
+	NodeID string `valid:"length(0|255)"`
+	// Only set for task execution events.
+	RetryAttempt *uint32
+	RequestID    string
+	Phase        string
+	PhaseVersion uint32
+	OccurredAt   time.Time
+	// The phase the entity was in when the event was received.
+	CurrentPhase string
+	Reason       string
+}
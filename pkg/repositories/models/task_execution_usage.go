@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// The resources a single task execution attempt used, recorded once it terminates. Usage is attributed to the
+// workflow execution the task ran in.
+type TaskExecutionUsage struct {
+	BaseModel
+	ExecutionKey
+	NodeID string `gorm:"primary_key" valid:"length(0|255)"`
+	// *IMPORTANT* This is a pointer to an int in order to allow setting an empty ("0") value according to gorm convention.
+	RetryAttempt *uint32 `gorm:"primary_key;AUTO_INCREMENT:FALSE"`
+	TaskProject  string  `valid:"length(0|255)"`
+	TaskDomain   string  `valid:"length(0|255)"`
+	TaskName     string  `valid:"length(0|255)"`
+	TaskVersion  string  `valid:"length(0|255)"`
+	// When the attempt terminated. Reports count usage in the window the attempt ended in.
+	EndedAt       time.Time `gorm:"index"`
+	CPUHours      float64
+	GPUHours      float64
+	MemoryGBHours float64
+}
+
+// The usage of a set of task executions in a project and domain.
+type TaskExecutionUsageSum struct {
+	ExecutionProject string
+	ExecutionDomain  string
+	CPUHours         float64
+	GPUHours         float64
+	MemoryGBHours    float64
+	TaskExecutions   int64
+	Executions       int64
+}
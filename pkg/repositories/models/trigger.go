@@ -0,0 +1,25 @@
+package models
+
+// Trigger primary key
+type TriggerKey struct {
+	Project string `gorm:"primary_key" valid:"length(0|255)"`
+	Domain  string `gorm:"primary_key" valid:"length(0|255)"`
+	Name    string `gorm:"primary_key" valid:"length(0|255)"`
+}
+
+// Database model to encapsulate a trigger registered for a project and domain, which launches the active version of
+// its launch plan whenever an external event source posts a signed payload to it.
+type Trigger struct {
+	BaseModel
+	TriggerKey
+	// The name of the launch plan launched, which is in the trigger's project and domain.
+	LaunchPlan string `gorm:"not null" valid:"length(0|255)"`
+	// Payloads must be signed with the secret, so that only the event source can fire the trigger. When encryption is
+	// enabled in the database config, the secret is stored encrypted with the key of the trigger's project or org.
+	Secret string `gorm:"not null"`
+	// The JSON serialized map of launch plan input names to the dot-separated paths of the payload fields they're set
+	// from, e.g. {"date": "partition.date"}.
+	InputMappings []byte
+	// The user who registered the trigger, on whose behalf executions are launched.
+	Principal string
+}
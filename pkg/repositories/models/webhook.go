@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// Webhook primary key
+type WebhookKey struct {
+	Project string `gorm:"primary_key" valid:"length(0|255)"`
+	Domain  string `gorm:"primary_key" valid:"length(0|255)"`
+	Name    string `gorm:"primary_key" valid:"length(0|255)"`
+}
+
+// Database model to encapsulate a webhook registered for a project and domain, which is posted a payload rendered from
+// its template whenever an execution in the project and domain reaches one of its phases.
+type Webhook struct {
+	BaseModel
+	WebhookKey
+	URL string `gorm:"not null"`
+	// Payloads are signed with the secret, so that receivers can verify they were sent by flyteadmin.
+	Secret string `gorm:"not null"`
+	// The Go template the payload is rendered from.
+	Template string `gorm:"not null"`
+	// The comma-separated execution phases delivered to the webhook, e.g. FAILED,TIMED_OUT.
+	Phases string `gorm:"not null"`
+	// The user who registered the webhook.
+	Principal string
+}
+
+// Records delivering an execution which reached one of a webhook's phases to the webhook.
+type WebhookDelivery struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// The webhook delivered to.
+	Project     string `gorm:"index:idx_webhook_deliveries_webhook" valid:"length(0|255)"`
+	Domain      string `gorm:"index:idx_webhook_deliveries_webhook" valid:"length(0|255)"`
+	WebhookName string `gorm:"index:idx_webhook_deliveries_webhook" valid:"length(0|255)"`
+	// The execution delivered, which is in the webhook's project and domain.
+	ExecutionName string `valid:"length(0|255)"`
+	Phase         string `valid:"length(0|255)"`
+	// PENDING while being delivered, then SUCCEEDED, or FAILED once every attempt to deliver it failed.
+	Status   string `gorm:"index" valid:"length(0|255)"`
+	Attempts int
+	// The status code of the webhook's last response, if it responded.
+	ResponseCode int
+	LastError    string
+}
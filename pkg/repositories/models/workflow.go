@@ -12,10 +12,14 @@ type WorkflowKey struct {
 type Workflow struct {
 	BaseModel
 	WorkflowKey
-	TypedInterface          []byte
+	TypedInterface []byte
+	// Location of the compiled workflow closure in the blob store, or empty when the closure is stored in Closure
+	// because it's smaller than the offloading threshold.
 	RemoteClosureIdentifier string `gorm:"not null" valid:"length(0|255)"`
 	LaunchPlans             []LaunchPlan
 	Executions              []Execution
 	// Hash of the compiled workflow closure
 	Digest []byte
+	// Serialized compiled workflow closure, unless it's offloaded to RemoteClosureIdentifier.
+	Closure []byte
 }
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"github.com/flyteorg/flyteadmin/pkg/repositories/cache"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/gormimpl"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
@@ -11,6 +12,7 @@ import (
 )
 
 type PostgresRepo struct {
+	db                           *gorm.DB
 	executionRepo                interfaces.ExecutionRepoInterface
 	executionEventRepo           interfaces.ExecutionEventRepoInterface
 	namedEntityRepo              interfaces.NamedEntityRepoInterface
@@ -22,8 +24,37 @@ type PostgresRepo struct {
 	taskExecutionRepo            interfaces.TaskExecutionRepoInterface
 	workflowRepo                 interfaces.WorkflowRepoInterface
 	resourceRepo                 interfaces.ResourceRepoInterface
+	retentionRepo                interfaces.RetentionRepoInterface
+	outboxRepo                   interfaces.OutboxRepoInterface
+	backfillRepo                 interfaces.BackfillRepoInterface
+	taskExecutionUsageRepo       interfaces.TaskExecutionUsageRepoInterface
+	skippedEventRepo             interfaces.SkippedEventRepoInterface
+	recordedEventRepo            interfaces.RecordedEventRepoInterface
+	lineageRepo                  interfaces.LineageRepoInterface
+	webhookRepo                  interfaces.WebhookRepoInterface
+	notificationDeliveryRepo     interfaces.NotificationDeliveryRepoInterface
+	notificationDigestRepo       interfaces.NotificationDigestRepoInterface
+	notificationSubscriptionRepo interfaces.NotificationSubscriptionRepoInterface
+	triggerRepo                  interfaces.TriggerRepoInterface
+	launchPlanRolloutRepo        interfaces.LaunchPlanRolloutRepoInterface
+	clusterPoolRepo              interfaces.ClusterPoolRepoInterface
+	clusterHealthRepo            interfaces.ClusterHealthRepoInterface
+	clusterResourceSyncRepo      interfaces.ClusterResourceSyncRepoInterface
+	domainQuotaRepo              interfaces.DomainQuotaRepoInterface
+	domainRepo                   interfaces.DomainRepoInterface
+	apiUsageRepo                 interfaces.APIUsageRepoInterface
+	offloadedLiteralRepo         interfaces.OffloadedLiteralRepoInterface
+	projectPurgeRepo             interfaces.ProjectPurgeRepoInterface
+	signalRepo                   interfaces.SignalRepoInterface
+	executionCommentRepo         interfaces.ExecutionCommentRepoInterface
+	savedViewRepo                interfaces.SavedViewRepoInterface
+	oauthClientRepo              interfaces.OAuthClientRepoInterface
+	featureFlagRepo              interfaces.FeatureFlagRepoInterface
+	cacheEvictionRepo            interfaces.CacheEvictionRepoInterface
+	orgRepo                      interfaces.OrgRepoInterface
 	schedulableEntityRepo        schedulerInterfaces.SchedulableEntityRepoInterface
 	scheduleEntitiesSnapshotRepo schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
+	scheduleRunRepo              schedulerInterfaces.ScheduleRunRepoInterface
 }
 
 func (p *PostgresRepo) ExecutionRepo() interfaces.ExecutionRepoInterface {
@@ -70,6 +101,118 @@ func (p *PostgresRepo) ResourceRepo() interfaces.ResourceRepoInterface {
 	return p.resourceRepo
 }
 
+func (p *PostgresRepo) RetentionRepo() interfaces.RetentionRepoInterface {
+	return p.retentionRepo
+}
+
+func (p *PostgresRepo) OutboxRepo() interfaces.OutboxRepoInterface {
+	return p.outboxRepo
+}
+
+func (p *PostgresRepo) BackfillRepo() interfaces.BackfillRepoInterface {
+	return p.backfillRepo
+}
+
+func (p *PostgresRepo) TaskExecutionUsageRepo() interfaces.TaskExecutionUsageRepoInterface {
+	return p.taskExecutionUsageRepo
+}
+
+func (p *PostgresRepo) SkippedEventRepo() interfaces.SkippedEventRepoInterface {
+	return p.skippedEventRepo
+}
+
+func (p *PostgresRepo) RecordedEventRepo() interfaces.RecordedEventRepoInterface {
+	return p.recordedEventRepo
+}
+
+func (p *PostgresRepo) LineageRepo() interfaces.LineageRepoInterface {
+	return p.lineageRepo
+}
+
+func (p *PostgresRepo) WebhookRepo() interfaces.WebhookRepoInterface {
+	return p.webhookRepo
+}
+
+func (p *PostgresRepo) NotificationDeliveryRepo() interfaces.NotificationDeliveryRepoInterface {
+	return p.notificationDeliveryRepo
+}
+
+func (p *PostgresRepo) NotificationDigestRepo() interfaces.NotificationDigestRepoInterface {
+	return p.notificationDigestRepo
+}
+
+func (p *PostgresRepo) NotificationSubscriptionRepo() interfaces.NotificationSubscriptionRepoInterface {
+	return p.notificationSubscriptionRepo
+}
+
+func (p *PostgresRepo) TriggerRepo() interfaces.TriggerRepoInterface {
+	return p.triggerRepo
+}
+
+func (p *PostgresRepo) LaunchPlanRolloutRepo() interfaces.LaunchPlanRolloutRepoInterface {
+	return p.launchPlanRolloutRepo
+}
+
+func (p *PostgresRepo) ClusterPoolRepo() interfaces.ClusterPoolRepoInterface {
+	return p.clusterPoolRepo
+}
+
+func (p *PostgresRepo) ClusterHealthRepo() interfaces.ClusterHealthRepoInterface {
+	return p.clusterHealthRepo
+}
+
+func (p *PostgresRepo) ClusterResourceSyncRepo() interfaces.ClusterResourceSyncRepoInterface {
+	return p.clusterResourceSyncRepo
+}
+
+func (p *PostgresRepo) DomainQuotaRepo() interfaces.DomainQuotaRepoInterface {
+	return p.domainQuotaRepo
+}
+
+func (p *PostgresRepo) DomainRepo() interfaces.DomainRepoInterface {
+	return p.domainRepo
+}
+
+func (p *PostgresRepo) APIUsageRepo() interfaces.APIUsageRepoInterface {
+	return p.apiUsageRepo
+}
+
+func (p *PostgresRepo) OffloadedLiteralRepo() interfaces.OffloadedLiteralRepoInterface {
+	return p.offloadedLiteralRepo
+}
+
+func (p *PostgresRepo) ProjectPurgeRepo() interfaces.ProjectPurgeRepoInterface {
+	return p.projectPurgeRepo
+}
+
+func (p *PostgresRepo) SignalRepo() interfaces.SignalRepoInterface {
+	return p.signalRepo
+}
+
+func (p *PostgresRepo) ExecutionCommentRepo() interfaces.ExecutionCommentRepoInterface {
+	return p.executionCommentRepo
+}
+
+func (p *PostgresRepo) SavedViewRepo() interfaces.SavedViewRepoInterface {
+	return p.savedViewRepo
+}
+
+func (p *PostgresRepo) OAuthClientRepo() interfaces.OAuthClientRepoInterface {
+	return p.oauthClientRepo
+}
+
+func (p *PostgresRepo) FeatureFlagRepo() interfaces.FeatureFlagRepoInterface {
+	return p.featureFlagRepo
+}
+
+func (p *PostgresRepo) CacheEvictionRepo() interfaces.CacheEvictionRepoInterface {
+	return p.cacheEvictionRepo
+}
+
+func (p *PostgresRepo) OrgRepo() interfaces.OrgRepoInterface {
+	return p.orgRepo
+}
+
 func (p *PostgresRepo) SchedulableEntityRepo() schedulerInterfaces.SchedulableEntityRepoInterface {
 	return p.schedulableEntityRepo
 }
@@ -78,8 +221,30 @@ func (p *PostgresRepo) ScheduleEntitiesSnapshotRepo() schedulerInterfaces.Schedu
 	return p.scheduleEntitiesSnapshotRepo
 }
 
+func (p *PostgresRepo) ScheduleRunRepo() schedulerInterfaces.ScheduleRunRepoInterface {
+	return p.scheduleRunRepo
+}
+
+func (p *PostgresRepo) Stats() interfaces.RepositoryStats {
+	stats := interfaces.RepositoryStats{
+		DB:     p.db.DB().Stats(),
+		Caches: make(map[string]interfaces.CacheStats),
+	}
+	for resource, repo := range map[string]interface{}{
+		"workflows":    p.workflowRepo,
+		"tasks":        p.taskRepo,
+		"launch_plans": p.launchPlanRepo,
+	} {
+		if reporter, ok := repo.(cache.StatsReporter); ok {
+			stats.Caches[resource] = reporter.GetCacheStats()
+		}
+	}
+	return stats
+}
+
 func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) RepositoryInterface {
 	return &PostgresRepo{
+		db:                           db,
 		executionRepo:                gormimpl.NewExecutionRepo(db, errorTransformer, scope.NewSubScope("executions")),
 		executionEventRepo:           gormimpl.NewExecutionEventRepo(db, errorTransformer, scope.NewSubScope("execution_events")),
 		launchPlanRepo:               gormimpl.NewLaunchPlanRepo(db, errorTransformer, scope.NewSubScope("launch_plans")),
@@ -91,7 +256,36 @@ func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scop
 		taskExecutionRepo:            gormimpl.NewTaskExecutionRepo(db, errorTransformer, scope.NewSubScope("task_executions")),
 		workflowRepo:                 gormimpl.NewWorkflowRepo(db, errorTransformer, scope.NewSubScope("workflows")),
 		resourceRepo:                 gormimpl.NewResourceRepo(db, errorTransformer, scope.NewSubScope("resources")),
+		retentionRepo:                gormimpl.NewRetentionRepo(db, errorTransformer, scope.NewSubScope("retention")),
+		outboxRepo:                   gormimpl.NewOutboxRepo(db, errorTransformer, scope.NewSubScope("outbox")),
+		backfillRepo:                 gormimpl.NewBackfillRepo(db, errorTransformer, scope.NewSubScope("backfills")),
+		taskExecutionUsageRepo:       gormimpl.NewTaskExecutionUsageRepo(db, errorTransformer, scope.NewSubScope("task_execution_usages")),
+		skippedEventRepo:             gormimpl.NewSkippedEventRepo(db, errorTransformer, scope.NewSubScope("skipped_events")),
+		recordedEventRepo:            gormimpl.NewRecordedEventRepo(db, errorTransformer, scope.NewSubScope("recorded_events")),
+		lineageRepo:                  gormimpl.NewLineageRepo(db, errorTransformer, scope.NewSubScope("lineage")),
+		webhookRepo:                  gormimpl.NewWebhookRepo(db, errorTransformer, scope.NewSubScope("webhooks")),
+		notificationDeliveryRepo:     gormimpl.NewNotificationDeliveryRepo(db, errorTransformer, scope.NewSubScope("notification_deliveries")),
+		notificationDigestRepo:       gormimpl.NewNotificationDigestRepo(db, errorTransformer, scope.NewSubScope("notification_digests")),
+		notificationSubscriptionRepo: gormimpl.NewNotificationSubscriptionRepo(db, errorTransformer, scope.NewSubScope("notification_subscriptions")),
+		triggerRepo:                  gormimpl.NewTriggerRepo(db, errorTransformer, scope.NewSubScope("triggers")),
+		launchPlanRolloutRepo:        gormimpl.NewLaunchPlanRolloutRepo(db, errorTransformer, scope.NewSubScope("launch_plan_rollouts")),
+		clusterPoolRepo:              gormimpl.NewClusterPoolRepo(db, errorTransformer, scope.NewSubScope("cluster_pools")),
+		clusterHealthRepo:            gormimpl.NewClusterHealthRepo(db, errorTransformer, scope.NewSubScope("cluster_healths")),
+		clusterResourceSyncRepo:      gormimpl.NewClusterResourceSyncRepo(db, errorTransformer, scope.NewSubScope("cluster_resource_syncs")),
+		domainQuotaRepo:              gormimpl.NewDomainQuotaRepo(db, errorTransformer, scope.NewSubScope("domain_quotas")),
+		domainRepo:                   gormimpl.NewDomainRepo(db, errorTransformer, scope.NewSubScope("domains")),
+		apiUsageRepo:                 gormimpl.NewAPIUsageRepo(db, errorTransformer, scope.NewSubScope("api_usages")),
+		offloadedLiteralRepo:         gormimpl.NewOffloadedLiteralRepo(db, errorTransformer, scope.NewSubScope("offloaded_literals")),
+		projectPurgeRepo:             gormimpl.NewProjectPurgeRepo(db, errorTransformer, scope.NewSubScope("project_purges")),
+		signalRepo:                   gormimpl.NewSignalRepo(db, errorTransformer, scope.NewSubScope("signals")),
+		executionCommentRepo:         gormimpl.NewExecutionCommentRepo(db, errorTransformer, scope.NewSubScope("execution_comments")),
+		savedViewRepo:                gormimpl.NewSavedViewRepo(db, errorTransformer, scope.NewSubScope("saved_views")),
+		oauthClientRepo:              gormimpl.NewOAuthClientRepo(db, errorTransformer, scope.NewSubScope("oauth_clients")),
+		featureFlagRepo:              gormimpl.NewFeatureFlagRepo(db, errorTransformer, scope.NewSubScope("feature_flags")),
+		cacheEvictionRepo:            gormimpl.NewCacheEvictionRepo(db, errorTransformer, scope.NewSubScope("cache_evictions")),
+		orgRepo:                      gormimpl.NewOrgRepo(db, errorTransformer, scope.NewSubScope("orgs")),
 		schedulableEntityRepo:        schedulerGormImpl.NewSchedulableEntityRepo(db, errorTransformer, scope.NewSubScope("schedulable_entity")),
 		scheduleEntitiesSnapshotRepo: schedulerGormImpl.NewScheduleEntitiesSnapshotRepo(db, errorTransformer, scope.NewSubScope("schedule_entities_snapshot")),
+		scheduleRunRepo:              schedulerGormImpl.NewScheduleRunRepo(db, errorTransformer, scope.NewSubScope("schedule_runs")),
 	}
 }
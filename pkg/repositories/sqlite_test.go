@@ -0,0 +1,2046 @@
+// +build cgo
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	gormigrate "gopkg.in/gormigrate.v1"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/cache"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/gormimpl"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	schedulerModels "github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+)
+
+func newSQLiteRepo(t *testing.T) RepositoryInterface {
+	dbConfig := config.DbConfig{SQLiteFile: filepath.Join(t.TempDir(), "flyteadmin.db")}
+	assert.Equal(t, SQLITE, GetRepoConfig(dbConfig))
+
+	db := config.OpenDbConnection(config.NewSQLiteConfigProvider(dbConfig, promutils.NewTestScope()))
+	t.Cleanup(func() {
+		assert.NoError(t, db.Close())
+	})
+
+	assert.NoError(t, gormigrate.New(db, gormigrate.DefaultOptions, config.Migrations).Migrate())
+	scope := promutils.NewTestScope()
+	return NewPostgresRepo(db, errors.NewSQLiteErrorTransformer(scope.NewSubScope("errors")), scope)
+}
+
+func TestSQLiteRepo_Project(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	project := models.Project{Identifier: "flytesnacks", Name: "flytesnacks"}
+	assert.NoError(t, repo.ProjectRepo().Create(ctx, project))
+
+	err := repo.ProjectRepo().Create(ctx, project)
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	saved, err := repo.ProjectRepo().Get(ctx, "flytesnacks")
+	assert.NoError(t, err)
+	assert.Equal(t, "flytesnacks", saved.Name)
+	assert.NotZero(t, saved.ID)
+}
+
+func TestSQLiteRepo_GetBatchTasks(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	ids := make([]interfaces.Identifier, 0)
+	for _, version := range []string{"v1", "v2", "v3"} {
+		id := interfaces.Identifier{Project: "flytesnacks", Domain: "development", Name: "task", Version: version}
+		ids = append(ids, id)
+		assert.NoError(t, repo.TaskRepo().Create(ctx, models.Task{
+			TaskKey: models.TaskKey(id),
+			Closure: []byte(version),
+		}))
+	}
+
+	tasks, err := repo.TaskRepo().GetBatch(ctx, []interfaces.Identifier{ids[0], ids[2], {
+		Project: "flytesnacks", Domain: "development", Name: "task", Version: "missing",
+	}})
+	assert.NoError(t, err)
+	versions := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		versions = append(versions, task.Version)
+	}
+	assert.ElementsMatch(t, []string{"v1", "v3"}, versions)
+}
+
+func TestSQLiteRepo_ConcurrentResourceUpserts(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, repo.ResourceRepo().CreateOrUpdate(ctx, models.Resource{
+				Project:      "flytesnacks",
+				Domain:       "development",
+				ResourceType: "TASK_RESOURCE",
+				Priority:     models.ResourcePriorityProjectDomainLevel,
+				Attributes:   []byte{byte(i)},
+			}))
+		}(i)
+	}
+	wg.Wait()
+
+	resources, err := repo.ResourceRepo().ListAll(ctx, "TASK_RESOURCE")
+	assert.NoError(t, err)
+	assert.Len(t, resources, 1)
+}
+
+func TestSQLiteRepo_ExecutionCursorPagination(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	// Executions created in the same instant are ordered by id.
+	createdAt := time.Date(2021, 8, 20, 10, 0, 0, 0, time.UTC)
+	for i, name := range []string{"a", "b", "c", "d", "e"} {
+		assert.NoError(t, repo.ExecutionRepo().Create(ctx, models.Execution{
+			BaseModel: models.BaseModel{
+				CreatedAt: createdAt.Add(time.Duration(i/2) * time.Minute),
+			},
+			ExecutionKey: models.ExecutionKey{
+				Project: "flytesnacks",
+				Domain:  "development",
+				Name:    name,
+			},
+			Spec: []byte{},
+		}))
+	}
+
+	projectFilter, err := common.NewSingleValueFilter(common.Execution, common.Equal, "project", "flytesnacks")
+	assert.NoError(t, err)
+	cursor := &interfaces.ListCursor{Descending: true}
+	var names []string
+	for {
+		output, err := repo.ExecutionRepo().List(ctx, interfaces.ListResourceInput{
+			Limit:         2,
+			InlineFilters: []common.InlineFilter{projectFilter},
+			Cursor:        cursor,
+		})
+		assert.NoError(t, err)
+		for _, execution := range output.Executions {
+			names = append(names, execution.Name)
+		}
+		if len(output.Executions) < 2 {
+			break
+		}
+		last := output.Executions[len(output.Executions)-1]
+		cursor = &interfaces.ListCursor{Descending: true, CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+	assert.Equal(t, []string{"e", "d", "c", "b", "a"}, names)
+}
+
+func TestSQLiteRepo_ExecutionFilters(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	errorKind := "USER"
+	for _, execution := range []models.Execution{
+		{ExecutionKey: models.ExecutionKey{Name: "Succeeded"}, Phase: "SUCCEEDED", Duration: time.Minute},
+		{ExecutionKey: models.ExecutionKey{Name: "Failed"}, Phase: "FAILED", Duration: time.Hour, ErrorKind: &errorKind},
+		{ExecutionKey: models.ExecutionKey{Name: "Aborted"}, Phase: "ABORTED", Duration: 2 * time.Hour},
+	} {
+		execution.Project = "flytesnacks"
+		execution.Domain = "development"
+		execution.Spec = []byte{}
+		assert.NoError(t, repo.ExecutionRepo().Create(ctx, execution))
+	}
+
+	newFilter := func(function, field string, value interface{}) common.InlineFilter {
+		filter, err := common.NewInlineFilter(common.Execution, function, field, value)
+		assert.NoError(t, err)
+		return filter
+	}
+	newNullCheckFilter := func(function, field string) common.InlineFilter {
+		filter, err := common.NewInlineNullCheckFilter(common.Execution, function, field)
+		assert.NoError(t, err)
+		return filter
+	}
+	newOrGroupFilter := func(filters ...common.InlineFilter) common.InlineFilter {
+		filter, err := common.NewOrGroupFilter(filters)
+		assert.NoError(t, err)
+		return filter
+	}
+
+	for _, test := range []struct {
+		filters       []common.InlineFilter
+		expectedNames []string
+	}{
+		{
+			filters:       []common.InlineFilter{newNullCheckFilter("is_null", "error_kind")},
+			expectedNames: []string{"Succeeded", "Aborted"},
+		},
+		{
+			filters:       []common.InlineFilter{newNullCheckFilter("is_not_null", "error_kind")},
+			expectedNames: []string{"Failed"},
+		},
+		{
+			filters: []common.InlineFilter{
+				newOrGroupFilter(newFilter("eq", "phase", "FAILED"), newFilter("ieq", "name", "aborted")),
+			},
+			expectedNames: []string{"Failed", "Aborted"},
+		},
+		{
+			filters: []common.InlineFilter{
+				newFilter("icontains", "name", "ED"), newFilter("gte", "duration", time.Hour),
+			},
+			expectedNames: []string{"Failed", "Aborted"},
+		},
+		{
+			filters: []common.InlineFilter{
+				newOrGroupFilter(
+					newFilter("value_not_in", "phase", []string{"FAILED", "ABORTED"}),
+					newFilter("lt", "duration", time.Second)),
+			},
+			expectedNames: []string{"Succeeded"},
+		},
+	} {
+		output, err := repo.ExecutionRepo().List(ctx, interfaces.ListResourceInput{
+			Limit:         10,
+			InlineFilters: test.filters,
+		})
+		assert.NoError(t, err)
+		var names []string
+		for _, execution := range output.Executions {
+			names = append(names, execution.Name)
+		}
+		assert.ElementsMatch(t, test.expectedNames, names)
+	}
+}
+
+func TestSQLiteRepo_ExecutionLabelFilters(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	for name, labels := range map[string]map[string]string{
+		"training":  {"team": "ml", "env": "prod"},
+		"inference": {"team": "ml"},
+		"etl":       {"team": "data", "env": "prod"},
+		"unlabeled": nil,
+	} {
+		execution := models.Execution{
+			ExecutionKey: models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: name},
+			Spec:         []byte{},
+		}
+		for key, value := range labels {
+			execution.Labels = append(execution.Labels, models.ExecutionLabel{Key: key, Value: value})
+		}
+		assert.NoError(t, repo.ExecutionRepo().Create(ctx, execution))
+	}
+
+	newFilter := func(function, key string, value interface{}) common.InlineFilter {
+		filter, err := common.NewInlineExecutionLabelFilter(function, key, value)
+		assert.NoError(t, err)
+		return filter
+	}
+	for _, test := range []struct {
+		filters       []common.InlineFilter
+		expectedNames []string
+	}{
+		{
+			filters:       []common.InlineFilter{newFilter("eq", "team", "ml")},
+			expectedNames: []string{"training", "inference"},
+		},
+		{
+			filters:       []common.InlineFilter{newFilter("eq", "team", "ml"), newFilter("is_not_null", "env", nil)},
+			expectedNames: []string{"training"},
+		},
+		{
+			filters:       []common.InlineFilter{newFilter("value_in", "team", []string{"data", "infra"})},
+			expectedNames: []string{"etl"},
+		},
+		{
+			filters:       []common.InlineFilter{newFilter("is_null", "team", nil)},
+			expectedNames: []string{"unlabeled"},
+		},
+	} {
+		output, err := repo.ExecutionRepo().List(ctx, interfaces.ListResourceInput{
+			Limit:         10,
+			InlineFilters: test.filters,
+		})
+		assert.NoError(t, err)
+		var names []string
+		for _, execution := range output.Executions {
+			names = append(names, execution.Name)
+		}
+		assert.ElementsMatch(t, test.expectedNames, names)
+	}
+
+	// Executions can be sorted by the value of a label, and those without it are sorted as nulls.
+	sortParameter, err := common.NewExecutionLabelSortParameter(admin.Sort{
+		Key:       "label.team",
+		Direction: admin.Sort_DESCENDING,
+	}, "team")
+	assert.NoError(t, err)
+	output, err := repo.ExecutionRepo().List(ctx, interfaces.ListResourceInput{
+		Limit:         10,
+		InlineFilters: []common.InlineFilter{newFilter("is_not_null", "team", nil)},
+		SortParameter: sortParameter,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output.Executions, 3)
+	assert.Equal(t, "etl", output.Executions[2].Name)
+
+	keys, err := repo.ExecutionRepo().ListLabels(ctx, interfaces.ListExecutionLabelsInput{
+		Project: "flytesnacks",
+		Domain:  "development",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ExecutionLabelCount{
+		{Key: "team", Count: 3},
+		{Key: "env", Count: 2},
+	}, keys)
+	values, err := repo.ExecutionRepo().ListLabels(ctx, interfaces.ListExecutionLabelsInput{
+		Project: "flytesnacks",
+		Domain:  "development",
+		Key:     "team",
+		Limit:   1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ExecutionLabelCount{{Key: "team", Value: "ml", Count: 2}}, values)
+
+	// Labels are unique per execution.
+	err = repo.ExecutionRepo().Create(ctx, models.Execution{
+		ExecutionKey: models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: "duplicate"},
+		Spec:         []byte{},
+		Labels: []models.ExecutionLabel{
+			{Key: "team", Value: "ml"},
+			{Key: "team", Value: "data"},
+		},
+	})
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+	_, err = repo.ExecutionRepo().Get(ctx, interfaces.Identifier{
+		Project: "flytesnacks", Domain: "development", Name: "duplicate"})
+	assert.Error(t, err)
+}
+
+func TestSQLiteRepo_Retention(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	expired := time.Now().AddDate(0, 0, -60)
+
+	newExecutionKey := func(name string) models.ExecutionKey {
+		return models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: name}
+	}
+	for name, phase := range map[string]core.WorkflowExecution_Phase{
+		"succeeded": core.WorkflowExecution_SUCCEEDED,
+		"running":   core.WorkflowExecution_RUNNING,
+	} {
+		assert.NoError(t, repo.ExecutionRepo().Create(ctx, models.Execution{
+			BaseModel:    models.BaseModel{CreatedAt: expired},
+			ExecutionKey: newExecutionKey(name),
+			Phase:        phase.String(),
+			Spec:         []byte{},
+			Labels:       []models.ExecutionLabel{{Key: "team", Value: "ml"}},
+		}))
+	}
+	assert.NoError(t, repo.ExecutionRepo().Create(ctx, models.Execution{
+		ExecutionKey: newExecutionKey("recent"),
+		Phase:        core.WorkflowExecution_SUCCEEDED.String(),
+		Spec:         []byte{},
+	}))
+	assert.NoError(t, repo.ExecutionEventRepo().Create(ctx, models.ExecutionEvent{
+		BaseModel:    models.BaseModel{CreatedAt: expired},
+		ExecutionKey: newExecutionKey("succeeded"),
+		Phase:        core.WorkflowExecution_SUCCEEDED.String(),
+	}))
+	nodeExecutionKey := models.NodeExecutionKey{ExecutionKey: newExecutionKey("succeeded"), NodeID: "n0"}
+	parentNodeExecution := &models.NodeExecution{
+		BaseModel:        models.BaseModel{CreatedAt: expired},
+		NodeExecutionKey: nodeExecutionKey,
+	}
+	assert.NoError(t, repo.NodeExecutionRepo().Create(ctx, parentNodeExecution))
+	childNodeExecution := &models.NodeExecution{
+		BaseModel:        models.BaseModel{CreatedAt: expired},
+		NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: newExecutionKey("succeeded"), NodeID: "n0-0-n0"},
+		ParentID:         &parentNodeExecution.ID,
+	}
+	assert.NoError(t, repo.NodeExecutionRepo().Create(ctx, childNodeExecution))
+	assert.NoError(t, repo.NodeExecutionEventRepo().Create(ctx, models.NodeExecutionEvent{
+		BaseModel:        models.BaseModel{CreatedAt: expired},
+		NodeExecutionKey: nodeExecutionKey,
+		Phase:            core.NodeExecution_SUCCEEDED.String(),
+	}))
+	retryAttempt := uint32(0)
+	assert.NoError(t, repo.TaskExecutionRepo().Create(ctx, models.TaskExecution{
+		BaseModel: models.BaseModel{CreatedAt: expired},
+		TaskExecutionKey: models.TaskExecutionKey{
+			TaskKey:          models.TaskKey{Project: "flytesnacks", Domain: "development", Name: "task", Version: "v1"},
+			NodeExecutionKey: nodeExecutionKey,
+			RetryAttempt:     &retryAttempt,
+		},
+	}))
+
+	assert.NoError(t, repo.ExecutionCommentRepo().Create(ctx, &models.ExecutionComment{
+		CreatedAt:        expired,
+		ExecutionProject: "flytesnacks",
+		ExecutionDomain:  "development",
+		ExecutionName:    "succeeded",
+		Text:             "re-ran after the outage",
+	}))
+	assert.NoError(t, repo.OffloadedLiteralRepo().Create(ctx, models.OffloadedLiteral{
+		CreatedAt:        expired,
+		ExecutionProject: "flytesnacks",
+		ExecutionDomain:  "development",
+		ExecutionName:    "succeeded",
+		URI:              "s3://bucket/metadata/flytesnacks/development/succeeded/inputs",
+	}))
+
+	// Expired records of executions which haven't terminated are kept.
+	runningNodeExecution := &models.NodeExecution{
+		BaseModel:        models.BaseModel{CreatedAt: expired},
+		NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: newExecutionKey("running"), NodeID: "n0"},
+	}
+	assert.NoError(t, repo.NodeExecutionRepo().Create(ctx, runningNodeExecution))
+	assert.NoError(t, repo.ExecutionEventRepo().Create(ctx, models.ExecutionEvent{
+		BaseModel:    models.BaseModel{CreatedAt: expired},
+		ExecutionKey: newExecutionKey("running"),
+		Phase:        core.WorkflowExecution_RUNNING.String(),
+	}))
+
+	listExpired := func(table string) []interfaces.ExpiredRecord {
+		records, err := repo.RetentionRepo().ListExpired(ctx, interfaces.ListExpiredInput{
+			Table:         table,
+			Project:       "flytesnacks",
+			Domain:        "development",
+			CreatedBefore: time.Now().AddDate(0, 0, -30),
+			Limit:         10,
+		})
+		assert.NoError(t, err)
+		return records
+	}
+
+	// Records are only listed once nothing references them.
+	assert.Empty(t, listExpired(interfaces.ExecutionsTable))
+	nodeExecutions := listExpired(interfaces.NodeExecutionsTable)
+	assert.Len(t, nodeExecutions, 1)
+	assert.Equal(t, childNodeExecution.ID, nodeExecutions[0].ID)
+	assert.Equal(t, "n0-0-n0", nodeExecutions[0].Columns["node_id"])
+
+	for _, table := range interfaces.RetentionTables {
+		for records := listExpired(table); len(records) > 0; records = listExpired(table) {
+			ids := make([]uint, len(records))
+			for i, record := range records {
+				ids[i] = record.ID
+			}
+			deleted, err := repo.RetentionRepo().Delete(ctx, table, ids)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(len(records)), deleted)
+		}
+	}
+
+	_, err := repo.ExecutionRepo().Get(ctx, interfaces.Identifier{
+		Project: "flytesnacks", Domain: "development", Name: "succeeded"})
+	assert.Error(t, err)
+	// The records of pruned executions are pruned before them.
+	literals, err := repo.OffloadedLiteralRepo().ListCollectable(ctx, interfaces.ListCollectableOffloadedLiteralsInput{
+		CreatedBefore: time.Now(),
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, literals)
+	for _, name := range []string{"running", "recent"} {
+		_, err := repo.ExecutionRepo().Get(ctx, interfaces.Identifier{
+			Project: "flytesnacks", Domain: "development", Name: name})
+		assert.NoError(t, err)
+	}
+	_, err = repo.NodeExecutionRepo().Get(ctx, interfaces.NodeExecutionResource{
+		NodeExecutionIdentifier: core.NodeExecutionIdentifier{
+			NodeId: "n0",
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Project: "flytesnacks", Domain: "development", Name: "running"},
+		},
+	})
+	assert.NoError(t, err)
+	// Labels are deleted along with their executions.
+	labelFilter, err := common.NewInlineExecutionLabelFilter("eq", "team", "ml")
+	assert.NoError(t, err)
+	output, err := repo.ExecutionRepo().List(ctx, interfaces.ListResourceInput{
+		Limit:         10,
+		InlineFilters: []common.InlineFilter{labelFilter},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output.Executions, 1)
+	assert.Equal(t, "running", output.Executions[0].Name)
+
+	// Deleting records re-checks whether they can be pruned.
+	running, err := repo.ExecutionRepo().Get(ctx, interfaces.Identifier{
+		Project: "flytesnacks", Domain: "development", Name: "running"})
+	assert.NoError(t, err)
+	deleted, err := repo.RetentionRepo().Delete(ctx, interfaces.ExecutionsTable, []uint{running.ID})
+	assert.NoError(t, err)
+	assert.Zero(t, deleted)
+}
+
+func TestGetRepository_Cache(t *testing.T) {
+	dbConfig := config.DbConfig{SQLiteFile: filepath.Join(t.TempDir(), "flyteadmin.db")}
+	repo := GetRepository(SQLITE, dbConfig, promutils.NewTestScope())
+	assert.IsType(t, &gormimpl.WorkflowRepo{}, repo.WorkflowRepo())
+
+	dbConfig.CacheSize = 10
+	dbConfig.CacheTTL = time.Minute
+	repo = GetRepository(SQLITE, dbConfig, promutils.NewTestScope())
+	assert.IsType(t, &cache.WorkflowRepo{}, repo.WorkflowRepo())
+	assert.IsType(t, &cache.TaskRepo{}, repo.TaskRepo())
+	assert.IsType(t, &cache.LaunchPlanRepo{}, repo.LaunchPlanRepo())
+}
+
+func TestSQLiteRepo_Outbox(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	execution := models.Execution{
+		ExecutionKey: models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: "name"},
+		Phase:        core.WorkflowExecution_RUNNING.String(),
+		Spec:         []byte{},
+	}
+	assert.NoError(t, repo.ExecutionRepo().Create(ctx, execution))
+
+	execution.Phase = core.WorkflowExecution_SUCCEEDED.String()
+	assert.NoError(t, repo.ExecutionRepo().UpdateWithMessages(ctx, execution, []models.OutboxMessage{
+		{Publisher: "notifications", NotificationType: "email", MessageType: "email", Payload: []byte{1}},
+		{Publisher: "events", NotificationType: "event", MessageType: "event", Payload: []byte{2}},
+	}))
+	saved, err := repo.ExecutionRepo().Get(ctx, interfaces.Identifier{
+		Project: "flytesnacks", Domain: "development", Name: "name"})
+	assert.NoError(t, err)
+	assert.Equal(t, core.WorkflowExecution_SUCCEEDED.String(), saved.Phase)
+
+	now := time.Now()
+	claim := interfaces.ClaimOutboxMessagesInput{Now: now, LeasedUntil: now.Add(time.Minute), Limit: 10}
+	claimed, err := repo.OutboxRepo().Claim(ctx, claim)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 2)
+	assert.Equal(t, "notifications", claimed[0].Publisher)
+	assert.Equal(t, []byte{1}, claimed[0].Payload)
+	assert.Equal(t, "flytesnacks", claimed[0].Project)
+
+	// Leased messages aren't claimed again until their lease expires.
+	reclaimed, err := repo.OutboxRepo().Claim(ctx, claim)
+	assert.NoError(t, err)
+	assert.Empty(t, reclaimed)
+
+	assert.NoError(t, repo.OutboxRepo().Delete(ctx, claimed[0].ID))
+	assert.NoError(t, repo.OutboxRepo().MarkFailed(ctx, claimed[1].ID, "topic not found"))
+	counts, err := repo.OutboxRepo().CountByPublisher(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"events": 1}, counts)
+	backlog, err := repo.OutboxRepo().GetBacklog(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, backlog, 1)
+	assert.Equal(t, int64(1), backlog["events"].Count)
+	assert.False(t, backlog["events"].OldestCreatedAt.IsZero())
+	later := now.Add(2 * time.Minute)
+	reclaimed, err = repo.OutboxRepo().Claim(ctx, interfaces.ClaimOutboxMessagesInput{
+		Now: later, LeasedUntil: later.Add(time.Minute), Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, reclaimed, 1)
+	assert.Equal(t, claimed[1].ID, reclaimed[0].ID)
+	assert.Equal(t, 1, reclaimed[0].Attempts)
+	assert.Equal(t, "topic not found", reclaimed[0].LastError)
+	assert.NotEqual(t, claimed[1].LeaseID, reclaimed[0].LeaseID)
+
+	// Dead lettered messages are kept, but neither claimed nor counted as waiting.
+	assert.NoError(t, repo.OutboxRepo().DeadLetter(ctx, reclaimed[0].ID, "topic not found", later))
+	later = later.Add(2 * time.Minute)
+	reclaimed, err = repo.OutboxRepo().Claim(ctx, interfaces.ClaimOutboxMessagesInput{
+		Now: later, LeasedUntil: later.Add(time.Minute), Limit: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, reclaimed)
+	counts, err = repo.OutboxRepo().CountByPublisher(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, counts)
+	backlog, err = repo.OutboxRepo().GetBacklog(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, backlog)
+}
+
+func TestSQLiteRepo_OffloadedLiterals(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	assert.NoError(t, repo.ExecutionRepo().Create(ctx, models.Execution{
+		ExecutionKey: models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: "created"},
+		Spec:         []byte{},
+	}))
+	for _, name := range []string{"created", "orphan"} {
+		assert.NoError(t, repo.OffloadedLiteralRepo().Create(ctx, models.OffloadedLiteral{
+			ExecutionProject: "flytesnacks",
+			ExecutionDomain:  "development",
+			ExecutionName:    name,
+			URI:              "s3://bucket/" + name,
+		}))
+	}
+
+	literals, err := repo.OffloadedLiteralRepo().ListCollectable(ctx, interfaces.ListCollectableOffloadedLiteralsInput{
+		CreatedBefore: time.Now().Add(-time.Hour),
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, literals)
+
+	literals, err = repo.OffloadedLiteralRepo().ListCollectable(ctx, interfaces.ListCollectableOffloadedLiteralsInput{
+		CreatedBefore: time.Now().Add(time.Minute),
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, literals, 2)
+	assert.Equal(t, "s3://bucket/created", literals[0].URI)
+	assert.False(t, literals[0].Orphaned)
+	assert.Equal(t, "s3://bucket/orphan", literals[1].URI)
+	assert.True(t, literals[1].Orphaned)
+
+	assert.NoError(t, repo.OffloadedLiteralRepo().Delete(ctx, []uint{literals[0].ID, literals[1].ID}))
+	literals, err = repo.OffloadedLiteralRepo().ListCollectable(ctx, interfaces.ListCollectableOffloadedLiteralsInput{
+		CreatedBefore: time.Now().Add(time.Minute),
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, literals)
+}
+
+func TestSQLiteRepo_NamedEntitySearch(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"flow", "my_flow", "archived_flow", "other"} {
+		assert.NoError(t, repo.WorkflowRepo().Create(ctx, models.Workflow{
+			WorkflowKey: models.WorkflowKey{Project: "flytesnacks", Domain: "development", Name: name, Version: "v1"},
+		}))
+	}
+	for _, version := range []string{"v1", "v2"} {
+		assert.NoError(t, repo.TaskRepo().Create(ctx, models.Task{
+			TaskKey: models.TaskKey{Project: "flytesnacks", Domain: "development", Name: "flowers", Version: version},
+			Closure: []byte(version),
+		}))
+	}
+	assert.NoError(t, repo.TaskRepo().Create(ctx, models.Task{
+		TaskKey: models.TaskKey{Project: "flytesnacks", Domain: "development", Name: "train", Version: "v1"},
+		Closure: []byte("v1"),
+	}))
+	assert.NoError(t, repo.NamedEntityRepo().Update(ctx, models.NamedEntity{
+		NamedEntityKey: models.NamedEntityKey{ResourceType: core.ResourceType_TASK, Project: "flytesnacks",
+			Domain: "development", Name: "train"},
+		NamedEntityMetadataFields: models.NamedEntityMetadataFields{Description: "Trains the FLOW model"},
+	}))
+	archived := int32(admin.NamedEntityState_NAMED_ENTITY_ARCHIVED)
+	assert.NoError(t, repo.NamedEntityRepo().Update(ctx, models.NamedEntity{
+		NamedEntityKey: models.NamedEntityKey{ResourceType: core.ResourceType_WORKFLOW, Project: "flytesnacks",
+			Domain: "development", Name: "archived_flow"},
+		NamedEntityMetadataFields: models.NamedEntityMetadataFields{State: &archived},
+	}))
+
+	results, err := repo.NamedEntityRepo().Search(ctx, interfaces.SearchNamedEntityInput{
+		Project: "flytesnacks", Domain: "development", Query: "Flow", Limit: 10,
+	})
+	assert.NoError(t, err)
+	names := make([]string, 0, len(results))
+	for _, result := range results {
+		names = append(names, result.Name)
+	}
+	// Each entity is returned once however many versions it has, ranked from the closest match.
+	assert.Equal(t, []string{"flow", "flowers", "my_flow", "train"}, names)
+	assert.Equal(t, core.ResourceType_TASK, results[1].ResourceType)
+	assert.Equal(t, "Trains the FLOW model", results[3].Description)
+
+	// Wildcards in the query are matched literally.
+	results, err = repo.NamedEntityRepo().Search(ctx, interfaces.SearchNamedEntityInput{
+		Project: "flytesnacks", Domain: "development", Query: "_", Limit: 10,
+		ResourceTypes: []core.ResourceType{core.ResourceType_WORKFLOW},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "my_flow", results[0].Name)
+}
+
+func TestSQLiteRepo_LaunchPlanSetActive(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	active, inactive := int32(admin.LaunchPlanState_ACTIVE), int32(admin.LaunchPlanState_INACTIVE)
+	ids := make([]interfaces.Identifier, 0)
+	for _, version := range []string{"v1", "v2", "v3"} {
+		id := interfaces.Identifier{Project: "flytesnacks", Domain: "development", Name: "lp", Version: version}
+		ids = append(ids, id)
+		state := inactive
+		if version == "v1" {
+			state = active
+		}
+		assert.NoError(t, repo.LaunchPlanRepo().Create(ctx, models.LaunchPlan{
+			LaunchPlanKey: models.LaunchPlanKey(id),
+			Spec:          []byte{},
+			Closure:       []byte{},
+			State:         &state,
+		}))
+	}
+	get := func(id interfaces.Identifier) models.LaunchPlan {
+		launchPlan, err := repo.LaunchPlanRepo().Get(ctx, id)
+		assert.NoError(t, err)
+		return launchPlan
+	}
+	withState := func(launchPlan models.LaunchPlan, state int32) models.LaunchPlan {
+		launchPlan.State = &state
+		return launchPlan
+	}
+
+	// Both callers read v1 as the active version.
+	v1 := get(ids[0])
+	v2, v3 := get(ids[1]), get(ids[2])
+	disableV1 := withState(v1, inactive)
+	assert.NoError(t, repo.LaunchPlanRepo().SetActive(ctx, withState(v2, active), &disableV1))
+	err := repo.LaunchPlanRepo().SetActive(ctx, withState(v3, active), &disableV1)
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	assert.Equal(t, inactive, *get(ids[0]).State)
+	assert.Equal(t, active, *get(ids[1]).State)
+	assert.Equal(t, inactive, *get(ids[2]).State)
+	assert.Equal(t, int32(1), get(ids[1]).StateRevision)
+
+	// Re-enabling the active version doesn't conflict with disabling it.
+	v2 = get(ids[1])
+	assert.NoError(t, repo.LaunchPlanRepo().SetActive(ctx, withState(v2, active), &v2))
+	assert.Equal(t, active, *get(ids[1]).State)
+}
+
+func TestSQLiteRepo_ExecutionIdempotencyKey(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	create := func(domain, name string, idempotencyKey *string) error {
+		return repo.ExecutionRepo().Create(ctx, models.Execution{
+			ExecutionKey: models.ExecutionKey{
+				Project: "flytesnacks",
+				Domain:  domain,
+				Name:    name,
+			},
+			Spec:           []byte{},
+			IdempotencyKey: idempotencyKey,
+		})
+	}
+	key := "key"
+	assert.NoError(t, create("development", "a", &key))
+	assert.Error(t, create("development", "b", &key))
+	// Keys are unique within a project and domain, and executions without one never conflict.
+	assert.NoError(t, create("staging", "b", &key))
+	assert.NoError(t, create("development", "c", nil))
+	assert.NoError(t, create("development", "d", nil))
+
+	execution, err := repo.ExecutionRepo().GetByIdempotencyKey(ctx, interfaces.IdempotencyKey{
+		Project: "flytesnacks", Domain: "development", Key: key,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "a", execution.Name)
+
+	_, err = repo.ExecutionRepo().GetByIdempotencyKey(ctx, interfaces.IdempotencyKey{
+		Project: "flytesnacks", Domain: "production", Key: key,
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	// Soft-deleted executions keep their keys.
+	assert.NoError(t, repo.ExecutionRepo().Delete(ctx, interfaces.Identifier{
+		Project: "flytesnacks", Domain: "development", Name: "a",
+	}))
+	execution, err = repo.ExecutionRepo().GetByIdempotencyKey(ctx, interfaces.IdempotencyKey{
+		Project: "flytesnacks", Domain: "development", Key: key,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "a", execution.Name)
+	assert.Error(t, create("development", "e", &key))
+}
+
+func TestSQLiteRepo_ReserveIdempotencyKey(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	key := interfaces.IdempotencyKey{Project: "flytesnacks", Domain: "development", Key: "key"}
+	now := time.Date(2021, 12, 2, 0, 0, 0, 0, time.UTC)
+	reserve := func(reservationID string, now time.Time) error {
+		return repo.ExecutionRepo().ReserveIdempotencyKey(ctx, interfaces.ReserveIdempotencyKeyInput{
+			IdempotencyKey: key,
+			ReservationID:  reservationID,
+			Now:            now,
+			ReservedUntil:  now.Add(time.Minute),
+		})
+	}
+
+	assert.NoError(t, reserve("a", now))
+	err := reserve("b", now.Add(time.Second))
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	// Expired reservations are taken over, and can then only be released by the request which took them over.
+	assert.NoError(t, reserve("b", now.Add(2*time.Minute)))
+	assert.NoError(t, repo.ExecutionRepo().ReleaseIdempotencyKey(ctx, key, "a"))
+	err = reserve("c", now.Add(2*time.Minute))
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	assert.NoError(t, repo.ExecutionRepo().ReleaseIdempotencyKey(ctx, key, "b"))
+	assert.NoError(t, reserve("c", now.Add(2*time.Minute)))
+}
+
+func TestSQLiteRepo_ExecutionAdmission(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	for _, execution := range []struct {
+		name    string
+		phase   core.WorkflowExecution_Phase
+		pending bool
+	}{
+		{"a", core.WorkflowExecution_RUNNING, false},
+		{"b", core.WorkflowExecution_SUCCEEDED, false},
+		{"c", core.WorkflowExecution_UNDEFINED, true},
+		{"d", core.WorkflowExecution_UNDEFINED, true},
+	} {
+		assert.NoError(t, repo.ExecutionRepo().Create(ctx, models.Execution{
+			ExecutionKey: models.ExecutionKey{
+				Project: "flytesnacks",
+				Domain:  "development",
+				Name:    execution.name,
+			},
+			Spec:    []byte{},
+			Phase:   execution.phase.String(),
+			Pending: execution.pending,
+		}))
+	}
+	countPending := func() int64 {
+		filter, err := common.NewSingleValueFilter(common.Execution, common.Equal, "pending", true)
+		assert.NoError(t, err)
+		count, err := repo.ExecutionRepo().Count(ctx, interfaces.CountResourceInput{
+			InlineFilters: []common.InlineFilter{filter},
+		})
+		assert.NoError(t, err)
+		return count
+	}
+	assert.EqualValues(t, 2, countPending())
+
+	id := interfaces.Identifier{Project: "flytesnacks", Domain: "development", Name: "c"}
+	assert.NoError(t, repo.ExecutionRepo().MarkDispatched(ctx, id, "cluster"))
+	execution, err := repo.ExecutionRepo().Get(ctx, id)
+	assert.NoError(t, err)
+	assert.False(t, execution.Pending)
+	assert.Equal(t, "cluster", execution.Cluster)
+	assert.EqualValues(t, 1, countPending())
+
+	// Executions which were already dispatched keep the cluster they were first launched on.
+	assert.NoError(t, repo.ExecutionRepo().MarkDispatched(ctx, id, "other"))
+	execution, err = repo.ExecutionRepo().Get(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster", execution.Cluster)
+
+	// Launch failures are counted until the execution is dispatched, which clears the error.
+	id = interfaces.Identifier{Project: "flytesnacks", Domain: "development", Name: "d"}
+	assert.NoError(t, repo.ExecutionRepo().RecordLaunchFailure(ctx, id, "unavailable"))
+	assert.NoError(t, repo.ExecutionRepo().RecordLaunchFailure(ctx, id, "connection refused"))
+	execution, err = repo.ExecutionRepo().Get(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, execution.LaunchAttempts)
+	assert.Equal(t, "connection refused", execution.LaunchError)
+	assert.NoError(t, repo.ExecutionRepo().MarkDispatched(ctx, id, "cluster"))
+	execution, err = repo.ExecutionRepo().Get(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, execution.LaunchAttempts)
+	assert.Empty(t, execution.LaunchError)
+
+	// Admission of a project and domain is decided by one caller at a time.
+	unlock, err := repo.ExecutionRepo().LockAdmission(ctx, "flytesnacks", "development")
+	assert.NoError(t, err)
+	otherUnlock, err := repo.ExecutionRepo().LockAdmission(ctx, "flytesnacks", "staging")
+	assert.NoError(t, err)
+	otherUnlock()
+	acquired := make(chan struct{})
+	go func() {
+		unlock, err := repo.ExecutionRepo().LockAdmission(ctx, "flytesnacks", "development")
+		assert.NoError(t, err)
+		unlock()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("locked admission while it was locked")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	<-acquired
+}
+
+func TestSQLiteRepo_ExecutionRunAt(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+	for name, runAt := range map[string]*time.Time{"a": nil, "b": &past, "c": &future} {
+		assert.NoError(t, repo.ExecutionRepo().Create(ctx, models.Execution{
+			ExecutionKey: models.ExecutionKey{
+				Project: "flytesnacks",
+				Domain:  "development",
+				Name:    name,
+			},
+			Spec:    []byte{},
+			Pending: true,
+			RunAt:   runAt,
+		}))
+	}
+	unscheduledFilter, err := common.NewNullCheckFilter(common.Execution, common.IsNull, "run_at")
+	assert.NoError(t, err)
+	dueFilter, err := common.NewSingleValueFilter(common.Execution, common.LessThanOrEqual, "run_at", now)
+	assert.NoError(t, err)
+	filter, err := common.NewOrGroupFilter([]common.InlineFilter{unscheduledFilter, dueFilter})
+	assert.NoError(t, err)
+	output, err := repo.ExecutionRepo().List(ctx, interfaces.ListResourceInput{
+		Limit:         10,
+		InlineFilters: []common.InlineFilter{filter},
+	})
+	assert.NoError(t, err)
+	var names []string
+	for _, execution := range output.Executions {
+		names = append(names, execution.Name)
+	}
+	assert.ElementsMatch(t, []string{"a", "b"}, names)
+}
+
+func TestSQLiteRepo_Backfills(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	startTime := time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC)
+	for _, name := range []string{"a", "b"} {
+		assert.NoError(t, repo.BackfillRepo().Create(ctx, models.Backfill{
+			BackfillKey: models.BackfillKey{
+				Project: "flytesnacks",
+				Domain:  "development",
+				Name:    name,
+			},
+			LaunchPlanName:    "lp",
+			LaunchPlanVersion: "v1",
+			StartTime:         startTime,
+			EndTime:           startTime.Add(24 * time.Hour),
+			Parallelism:       2,
+			Total:             24,
+			Phase:             core.WorkflowExecution_RUNNING.String(),
+		}))
+	}
+	err := repo.BackfillRepo().Create(ctx, models.Backfill{
+		BackfillKey: models.BackfillKey{
+			Project: "flytesnacks",
+			Domain:  "development",
+			Name:    "a",
+		},
+	})
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	backfill, err := repo.BackfillRepo().Get(ctx, interfaces.Identifier{
+		Project: "flytesnacks",
+		Domain:  "development",
+		Name:    "a",
+	})
+	assert.NoError(t, err)
+	assert.True(t, startTime.Equal(backfill.StartTime))
+	backfill.Launched = 24
+	backfill.Phase = core.WorkflowExecution_SUCCEEDED.String()
+	assert.NoError(t, repo.BackfillRepo().Update(ctx, backfill))
+
+	phaseFilter, err := common.NewSingleValueFilter(
+		common.Backfill, common.Equal, "phase", core.WorkflowExecution_RUNNING.String())
+	assert.NoError(t, err)
+	output, err := repo.BackfillRepo().List(ctx, interfaces.ListResourceInput{
+		Limit:         10,
+		InlineFilters: []common.InlineFilter{phaseFilter},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output.Backfills, 1)
+	assert.Equal(t, "b", output.Backfills[0].Name)
+
+	// Progress is only recorded while backfills are running, so that stale reads don't overwrite their phase.
+	running := output.Backfills[0]
+	running.Launched = 2
+	updated, err := repo.BackfillRepo().UpdateProgress(ctx, running)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	backfill.Launched = 12
+	backfill.Phase = core.WorkflowExecution_RUNNING.String()
+	updated, err = repo.BackfillRepo().UpdateProgress(ctx, backfill)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+	backfill, err = repo.BackfillRepo().Get(ctx, interfaces.Identifier{
+		Project: "flytesnacks",
+		Domain:  "development",
+		Name:    "a",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 24, backfill.Launched)
+	assert.Equal(t, core.WorkflowExecution_SUCCEEDED.String(), backfill.Phase)
+
+	_, err = repo.BackfillRepo().Get(ctx, interfaces.Identifier{
+		Project: "flytesnacks",
+		Domain:  "development",
+		Name:    "c",
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestSQLiteRepo_Webhooks(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	webhookID := interfaces.Identifier{
+		Project: "flytesnacks",
+		Domain:  "development",
+		Name:    "alerts",
+	}
+	webhook := models.Webhook{
+		WebhookKey: models.WebhookKey{
+			Project: webhookID.Project,
+			Domain:  webhookID.Domain,
+			Name:    webhookID.Name,
+		},
+		URL:      "https://example.com/hooks/alerts",
+		Secret:   "secret",
+		Template: `{"execution": {{ json .Name }}}`,
+		Phases:   "FAILED",
+	}
+	assert.NoError(t, repo.WebhookRepo().Create(ctx, webhook))
+	err := repo.WebhookRepo().Create(ctx, webhook)
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	webhook.Phases = "FAILED,TIMED_OUT"
+	assert.NoError(t, repo.WebhookRepo().Update(ctx, webhook))
+	webhook, err = repo.WebhookRepo().Get(ctx, webhookID)
+	assert.NoError(t, err)
+	assert.Equal(t, "FAILED,TIMED_OUT", webhook.Phases)
+
+	deliveryID, err := repo.WebhookRepo().CreateDelivery(ctx, models.WebhookDelivery{
+		Project:       webhookID.Project,
+		Domain:        webhookID.Domain,
+		WebhookName:   webhookID.Name,
+		ExecutionName: "execution",
+		Phase:         "FAILED",
+		Status:        common.DeliveryPending,
+	})
+	assert.NoError(t, err)
+	assert.NotZero(t, deliveryID)
+	assert.NoError(t, repo.WebhookRepo().UpdateDelivery(ctx, models.WebhookDelivery{
+		ID:           deliveryID,
+		Status:       common.DeliveryFailed,
+		Attempts:     3,
+		ResponseCode: 503,
+		LastError:    "webhook responded with status 503",
+	}))
+
+	// Deliveries are kept when their webhook is deleted, and its name can be registered again.
+	assert.NoError(t, repo.WebhookRepo().Delete(ctx, webhookID))
+	_, err = repo.WebhookRepo().Get(ctx, webhookID)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	err = repo.WebhookRepo().Delete(ctx, webhookID)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.NoError(t, repo.WebhookRepo().Create(ctx, webhook))
+
+	nameFilter, err := common.NewSingleValueFilter(common.WebhookDelivery, common.Equal, "webhook_name", webhookID.Name)
+	assert.NoError(t, err)
+	deliveries, err := repo.WebhookRepo().ListDeliveries(ctx, interfaces.ListResourceInput{
+		Limit:         10,
+		InlineFilters: []common.InlineFilter{nameFilter},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, common.DeliveryFailed, deliveries[0].Status)
+	assert.Equal(t, 3, deliveries[0].Attempts)
+	assert.Equal(t, 503, deliveries[0].ResponseCode)
+
+	projectFilter, err := common.NewSingleValueFilter(common.Webhook, common.Equal, "project", webhookID.Project)
+	assert.NoError(t, err)
+	output, err := repo.WebhookRepo().List(ctx, interfaces.ListResourceInput{
+		Limit:         10,
+		InlineFilters: []common.InlineFilter{projectFilter},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, output.Webhooks, 1)
+}
+
+func TestSQLiteRepo_NotificationDeliveries(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	var ids []uint
+	for _, executionName := range []string{"a", "a", "b"} {
+		id, err := repo.NotificationDeliveryRepo().Create(ctx, models.NotificationDelivery{
+			Project:       "flytesnacks",
+			Domain:        "development",
+			ExecutionName: executionName,
+			Type:          "email",
+			Payload:       []byte("email"),
+			Status:        common.DeliveryPending,
+		})
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	assert.NoError(t, repo.NotificationDeliveryRepo().RecordAttempt(ctx, ids[0], common.DeliveryFailed, "refused"))
+	assert.NoError(t, repo.NotificationDeliveryRepo().RecordAttempt(ctx, ids[1], common.DeliverySucceeded, ""))
+
+	// Only failed deliveries are marked pending, once.
+	pending, err := repo.NotificationDeliveryRepo().MarkPending(ctx, ids[0])
+	assert.NoError(t, err)
+	assert.True(t, pending)
+	pending, err = repo.NotificationDeliveryRepo().MarkPending(ctx, ids[0])
+	assert.NoError(t, err)
+	assert.False(t, pending)
+	pending, err = repo.NotificationDeliveryRepo().MarkPending(ctx, ids[1])
+	assert.NoError(t, err)
+	assert.False(t, pending)
+	assert.NoError(t, repo.NotificationDeliveryRepo().RecordAttempt(ctx, ids[0], common.DeliverySucceeded, ""))
+
+	delivery, err := repo.NotificationDeliveryRepo().Get(ctx, ids[0])
+	assert.NoError(t, err)
+	assert.Equal(t, common.DeliverySucceeded, delivery.Status)
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.Empty(t, delivery.LastError)
+	assert.Equal(t, []byte("email"), delivery.Payload)
+	_, err = repo.NotificationDeliveryRepo().Get(ctx, 100)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	executionFilter, err := common.NewSingleValueFilter(common.NotificationDelivery, common.Equal, "execution_name", "a")
+	assert.NoError(t, err)
+	deliveries, err := repo.NotificationDeliveryRepo().List(ctx, interfaces.ListResourceInput{
+		Limit:         10,
+		InlineFilters: []common.InlineFilter{executionFilter},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 2)
+}
+
+func TestSQLiteRepo_NotificationDigests(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	dueAt := time.Date(2021, time.October, 27, 10, 0, 0, 0, time.UTC)
+	for idx, entry := range []struct {
+		group string
+		dueAt time.Time
+	}{
+		{group: "b", dueAt: dueAt},
+		{group: "a", dueAt: dueAt},
+		{group: "b", dueAt: dueAt.Add(-time.Hour)},
+		{group: "a", dueAt: dueAt.Add(time.Hour)},
+	} {
+		assert.NoError(t, repo.NotificationDigestRepo().Create(ctx, models.NotificationDigestEntry{
+			Project:       "flytesnacks",
+			Domain:        "development",
+			ExecutionName: fmt.Sprintf("e%d", idx),
+			OccurredAt:    entry.dueAt.Add(-time.Minute),
+			GroupKey:      entry.group,
+			DueAt:         entry.dueAt,
+		}))
+	}
+
+	// Due entries are listed by group, then by when their executions finished.
+	entries, err := repo.NotificationDigestRepo().ListDue(ctx, dueAt, 10)
+	assert.NoError(t, err)
+	var executionNames []string
+	var ids []uint
+	for _, entry := range entries {
+		executionNames = append(executionNames, entry.ExecutionName)
+		ids = append(ids, entry.ID)
+	}
+	assert.Equal(t, []string{"e1", "e2", "e0"}, executionNames)
+	entries, err = repo.NotificationDigestRepo().ListDue(ctx, dueAt, 1)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// Entries are only leased by one sender at a time, until they're released or their lease expires.
+	claimed, err := repo.NotificationDigestRepo().Claim(ctx, interfaces.ClaimNotificationDigestEntriesInput{
+		IDs:         ids[1:],
+		Now:         dueAt,
+		LeasedUntil: dueAt.Add(time.Minute),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 2)
+	claimedAgain, err := repo.NotificationDigestRepo().Claim(ctx, interfaces.ClaimNotificationDigestEntriesInput{
+		IDs:         ids,
+		Now:         dueAt,
+		LeasedUntil: dueAt.Add(time.Minute),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, claimedAgain, 1)
+	assert.Equal(t, "e1", claimedAgain[0].ExecutionName)
+	entries, err = repo.NotificationDigestRepo().ListDue(ctx, dueAt, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+	assert.NoError(t, repo.NotificationDigestRepo().Release(ctx, claimedAgain[0].LeaseID))
+	entries, err = repo.NotificationDigestRepo().ListDue(ctx, dueAt, 10)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	entries, err = repo.NotificationDigestRepo().ListDue(ctx, dueAt.Add(2*time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+
+	// Only the entries of a lease are deleted.
+	assert.NoError(t, repo.NotificationDigestRepo().Delete(ctx, claimed[0].LeaseID))
+	entries, err = repo.NotificationDigestRepo().ListDue(ctx, dueAt.Add(time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "e1", entries[0].ExecutionName)
+	assert.Equal(t, "e3", entries[1].ExecutionName)
+}
+
+func TestSQLiteRepo_NotificationSubscriptions(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	for _, key := range []models.NotificationSubscriptionKey{
+		{Project: "flytesnacks", Domain: "development", Type: "email", Address: "alice@example.com"},
+		{Project: "flytesnacks", Domain: "development", LaunchPlan: "daily", Type: "email", Address: "bob@example.com"},
+		{Project: "flytesnacks", Domain: "development", LaunchPlan: "hourly", Type: "email", Address: "bob@example.com"},
+		{Project: "flytesnacks", Domain: "production", Type: "email", Address: "alice@example.com"},
+	} {
+		assert.NoError(t, repo.NotificationSubscriptionRepo().Create(ctx, models.NotificationSubscription{
+			NotificationSubscriptionKey: key,
+			Phases:                      "FAILED",
+		}))
+	}
+	err := repo.NotificationSubscriptionRepo().Create(ctx, models.NotificationSubscription{
+		NotificationSubscriptionKey: models.NotificationSubscriptionKey{
+			Project: "flytesnacks", Domain: "development", Type: "email", Address: "alice@example.com",
+		},
+		Phases: "SUCCEEDED",
+	})
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	// Subscriptions to the whole project and domain are matched by an empty launch plan.
+	subscription, err := repo.NotificationSubscriptionRepo().Get(ctx, models.NotificationSubscriptionKey{
+		Project: "flytesnacks", Domain: "development", Type: "email", Address: "alice@example.com",
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, subscription.LaunchPlan)
+	subscription.Phases = "FAILED,SUCCEEDED"
+	assert.NoError(t, repo.NotificationSubscriptionRepo().Update(ctx, subscription))
+	_, err = repo.NotificationSubscriptionRepo().Get(ctx, models.NotificationSubscriptionKey{
+		Project: "flytesnacks", Domain: "development", Type: "slack", Address: "alice@example.com",
+	})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	subscriptions, err := repo.NotificationSubscriptionRepo().ListForLaunchPlan(
+		ctx, "flytesnacks", "development", "daily")
+	assert.NoError(t, err)
+	assert.Len(t, subscriptions, 2)
+	assert.Equal(t, "alice@example.com", subscriptions[0].Address)
+	assert.Equal(t, "FAILED,SUCCEEDED", subscriptions[0].Phases)
+	assert.Equal(t, "daily", subscriptions[1].LaunchPlan)
+
+	// Subscriptions are only deleted in their own project and domain.
+	err = repo.NotificationSubscriptionRepo().Delete(ctx, "flytesnacks", "production", subscriptions[1].ID)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.NoError(t, repo.NotificationSubscriptionRepo().Delete(
+		ctx, "flytesnacks", "development", subscriptions[1].ID))
+	subscriptions, err = repo.NotificationSubscriptionRepo().ListForLaunchPlan(
+		ctx, "flytesnacks", "development", "daily")
+	assert.NoError(t, err)
+	assert.Len(t, subscriptions, 1)
+}
+
+func TestSQLiteRepo_ScheduleRuns(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	scheduledAt := time.Date(2021, time.October, 30, 3, 0, 0, 0, time.UTC)
+	for i, version := range []string{"v1", "v1", "v2"} {
+		assert.NoError(t, repo.ScheduleRunRepo().Create(ctx, schedulerModels.ScheduleRun{
+			Project:     "flytesnacks",
+			Domain:      "development",
+			Name:        "daily",
+			Version:     version,
+			ScheduledAt: scheduledAt.Add(time.Duration(i) * 24 * time.Hour),
+			FiredAt:     scheduledAt.Add(time.Duration(i) * 24 * time.Hour),
+			Status:      schedulerModels.ScheduleRunSucceeded,
+		}))
+	}
+	// A scheduled time fired again after the scheduler restarts is only recorded once.
+	err := repo.ScheduleRunRepo().Create(ctx, schedulerModels.ScheduleRun{
+		Project:     "flytesnacks",
+		Domain:      "development",
+		Name:        "daily",
+		Version:     "v2",
+		ScheduledAt: scheduledAt.Add(48 * time.Hour),
+		Status:      schedulerModels.ScheduleRunSucceeded,
+	})
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	// Runs of every version are listed, most recently scheduled first.
+	runs, err := repo.ScheduleRunRepo().List(ctx, schedulerModels.SchedulableEntityKey{
+		Project: "flytesnacks",
+		Domain:  "development",
+		Name:    "daily",
+	}, 2, 0)
+	assert.NoError(t, err)
+	assert.Len(t, runs, 2)
+	assert.Equal(t, "v2", runs[0].Version)
+	assert.True(t, scheduledAt.Add(24*time.Hour).Equal(runs[1].ScheduledAt))
+	runs, err = repo.ScheduleRunRepo().List(ctx, schedulerModels.SchedulableEntityKey{
+		Project: "flytesnacks",
+		Domain:  "development",
+		Name:    "daily",
+	}, 2, 2)
+	assert.NoError(t, err)
+	assert.Len(t, runs, 1)
+}
+
+func TestSQLiteRepo_TaskExecutionUsage(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	endedAt := time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC)
+	for idx, usage := range []struct {
+		domain       string
+		execution    string
+		retryAttempt uint32
+		endedAt      time.Time
+	}{
+		{"development", "a", 0, endedAt},
+		{"development", "a", 1, endedAt.Add(time.Hour)},
+		{"development", "b", 0, endedAt.Add(2 * time.Hour)},
+		{"production", "c", 0, endedAt.Add(3 * time.Hour)},
+		// Outside the report window.
+		{"production", "d", 0, endedAt.Add(24 * time.Hour)},
+	} {
+		retryAttempt := usage.retryAttempt
+		assert.NoError(t, repo.TaskExecutionUsageRepo().Create(ctx, models.TaskExecutionUsage{
+			ExecutionKey: models.ExecutionKey{
+				Project: "flytesnacks",
+				Domain:  usage.domain,
+				Name:    usage.execution,
+			},
+			NodeID:        "node",
+			RetryAttempt:  &retryAttempt,
+			TaskProject:   "flytesnacks",
+			TaskDomain:    usage.domain,
+			TaskName:      "task",
+			TaskVersion:   "v1",
+			EndedAt:       usage.endedAt,
+			CPUHours:      float64(idx + 1),
+			MemoryGBHours: 2,
+		}), "usage %d", idx)
+	}
+	retryAttempt := uint32(0)
+	err := repo.TaskExecutionUsageRepo().Create(ctx, models.TaskExecutionUsage{
+		ExecutionKey: models.ExecutionKey{
+			Project: "flytesnacks",
+			Domain:  "development",
+			Name:    "a",
+		},
+		NodeID:       "node",
+		RetryAttempt: &retryAttempt,
+	})
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	projectFilter, err := common.NewSingleValueFilter(
+		common.TaskExecutionUsage, common.Equal, "execution_project", "flytesnacks")
+	assert.NoError(t, err)
+	startFilter, err := common.NewSingleValueFilter(
+		common.TaskExecutionUsage, common.GreaterThanOrEqual, "ended_at", endedAt)
+	assert.NoError(t, err)
+	endFilter, err := common.NewSingleValueFilter(
+		common.TaskExecutionUsage, common.LessThan, "ended_at", endedAt.Add(24*time.Hour))
+	assert.NoError(t, err)
+	sums, err := repo.TaskExecutionUsageRepo().Sum(ctx, interfaces.SumTaskExecutionUsageInput{
+		InlineFilters: []common.InlineFilter{projectFilter, startFilter, endFilter},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.TaskExecutionUsageSum{
+		{
+			ExecutionProject: "flytesnacks",
+			ExecutionDomain:  "development",
+			CPUHours:         6,
+			MemoryGBHours:    6,
+			TaskExecutions:   3,
+			Executions:       2,
+		},
+		{
+			ExecutionProject: "flytesnacks",
+			ExecutionDomain:  "production",
+			CPUHours:         4,
+			MemoryGBHours:    2,
+			TaskExecutions:   1,
+			Executions:       1,
+		},
+	}, sums)
+}
+
+func TestSQLiteRepo_NodeExecutionEventBatchCreate(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	newEvent := func(nodeID string, phase core.NodeExecution_Phase) models.NodeExecutionEvent {
+		return models.NodeExecutionEvent{
+			NodeExecutionKey: models.NodeExecutionKey{
+				ExecutionKey: models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: "name"},
+				NodeID:       nodeID,
+			},
+			Phase: phase.String(),
+		}
+	}
+	assert.NoError(t, repo.NodeExecutionEventRepo().BatchCreate(ctx, []models.NodeExecutionEvent{
+		newEvent("n0", core.NodeExecution_QUEUED),
+		newEvent("n0", core.NodeExecution_RUNNING),
+		newEvent("n1", core.NodeExecution_QUEUED),
+	}))
+
+	// A duplicate event fails the whole batch.
+	err := repo.NodeExecutionEventRepo().BatchCreate(ctx, []models.NodeExecutionEvent{
+		newEvent("n1", core.NodeExecution_RUNNING),
+		newEvent("n0", core.NodeExecution_RUNNING),
+	})
+	assert.Error(t, err)
+	assert.NoError(t, repo.NodeExecutionEventRepo().Create(ctx, newEvent("n1", core.NodeExecution_RUNNING)))
+}
+
+func TestSQLiteRepo_SkippedEvents(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	for idx, execution := range []string{"a", "b", "a"} {
+		assert.NoError(t, repo.SkippedEventRepo().Create(ctx, models.SkippedEvent{
+			ExecutionProject: "flytesnacks",
+			ExecutionDomain:  "development",
+			ExecutionName:    execution,
+			EventType:        "node",
+			NodeID:           "n0",
+			RequestID:        fmt.Sprintf("request-%d", idx),
+			Reason:           "DUPLICATE",
+		}))
+	}
+	filter, err := common.NewSingleValueFilter(common.SkippedEvent, common.Equal, "execution_name", "a")
+	assert.NoError(t, err)
+	sortParameter, err := common.NewSortParameter(admin.Sort{Key: "id", Direction: admin.Sort_ASCENDING})
+	assert.NoError(t, err)
+	skippedEvents, err := repo.SkippedEventRepo().List(ctx, interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{filter},
+		SortParameter: sortParameter,
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, skippedEvents, 2)
+	assert.Equal(t, "request-0", skippedEvents[0].RequestID)
+	assert.Equal(t, "request-2", skippedEvents[1].RequestID)
+}
+
+func TestSQLiteRepo_RecordedEvents(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	var recordedEvents []models.RecordedEvent
+	for idx, phase := range []string{"QUEUED", "RUNNING", "RUNNING", "SUCCEEDED"} {
+		recordedEvents = append(recordedEvents, models.RecordedEvent{
+			ExecutionProject: "flytesnacks",
+			ExecutionDomain:  "development",
+			ExecutionName:    "a",
+			EventType:        "workflow",
+			RequestID:        fmt.Sprintf("request-%d", idx),
+			Phase:            phase,
+		})
+	}
+	assert.NoError(t, repo.RecordedEventRepo().BatchCreate(ctx, recordedEvents))
+	nameFilter, err := common.NewSingleValueFilter(common.RecordedEvent, common.Equal, "execution_name", "a")
+	assert.NoError(t, err)
+	phaseFilter, err := common.NewSingleValueFilter(common.RecordedEvent, common.Equal, "phase", "RUNNING")
+	assert.NoError(t, err)
+	sortParameter, err := common.NewSortParameter(admin.Sort{Key: "id", Direction: admin.Sort_ASCENDING})
+	assert.NoError(t, err)
+	listed, err := repo.RecordedEventRepo().List(ctx, interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{nameFilter, phaseFilter},
+		SortParameter: sortParameter,
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, listed, 2)
+	assert.Equal(t, "request-1", listed[0].RequestID)
+	assert.Equal(t, "request-2", listed[1].RequestID)
+}
+
+func TestSQLiteRepo_Lineage(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	retryAttempt := uint32(0)
+	assert.NoError(t, repo.LineageRepo().CreateArtifacts(ctx, []models.TaskExecutionArtifact{
+		{
+			ExecutionProject: "flytesnacks",
+			ExecutionDomain:  "development",
+			ExecutionName:    "a",
+			NodeID:           "n0",
+			RetryAttempt:     &retryAttempt,
+			Direction:        "INPUT",
+			URI:              "s3://bucket/a/n0/inputs.pb",
+		},
+		{
+			ExecutionProject: "flytesnacks",
+			ExecutionDomain:  "development",
+			ExecutionName:    "a",
+			NodeID:           "n0",
+			RetryAttempt:     &retryAttempt,
+			Direction:        "OUTPUT",
+			URI:              "s3://bucket/a/n0/outputs.pb",
+		},
+	}))
+	for _, execution := range []string{"b", "c"} {
+		assert.NoError(t, repo.LineageRepo().CreateRelationship(ctx, models.ExecutionRelationship{
+			ExecutionProject: "flytesnacks",
+			ExecutionDomain:  "development",
+			ExecutionName:    execution,
+			RelatedProject:   "flytesnacks",
+			RelatedDomain:    "development",
+			RelatedName:      "a",
+			Kind:             "RECOVERED_FROM",
+		}))
+	}
+
+	sortParameter, err := common.NewSortParameter(admin.Sort{Key: "id", Direction: admin.Sort_ASCENDING})
+	assert.NoError(t, err)
+	artifactFilter, err := common.NewSingleValueFilter(common.TaskExecutionArtifact, common.Equal, "execution_name", "a")
+	assert.NoError(t, err)
+	artifacts, err := repo.LineageRepo().ListArtifacts(ctx, interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{artifactFilter},
+		SortParameter: sortParameter,
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, artifacts, 2)
+	assert.Equal(t, "s3://bucket/a/n0/outputs.pb", artifacts[1].URI)
+
+	relationshipFilter, err := common.NewSingleValueFilter(common.ExecutionRelationship, common.Equal, "related_name", "a")
+	assert.NoError(t, err)
+	relationships, err := repo.LineageRepo().ListRelationships(ctx, interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{relationshipFilter},
+		SortParameter: sortParameter,
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, relationships, 2)
+	assert.Equal(t, "b", relationships[0].ExecutionName)
+	assert.Equal(t, "c", relationships[1].ExecutionName)
+}
+
+func TestSQLiteRepo_APIUsage(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	day := time.Date(2021, time.November, 1, 0, 0, 0, 0, time.UTC)
+	for idx, usage := range []models.APIUsage{
+		{Day: day, Method: "CreateExecution", Principal: "user", Project: "flytesnacks", Calls: 2, Errors: 1,
+			TotalLatencyMs: 40, MaxLatencyMs: 30},
+		// Added to the totals above.
+		{Day: day, Method: "CreateExecution", Principal: "user", Project: "flytesnacks", Calls: 1,
+			TotalLatencyMs: 20, MaxLatencyMs: 20},
+		{Day: day.Add(24 * time.Hour), Method: "GetExecution", Principal: "user", Project: "flytesnacks", Calls: 5,
+			TotalLatencyMs: 5, MaxLatencyMs: 2},
+		// Unauthenticated calls which don't target a project.
+		{Day: day, Method: "ListProjects", Calls: 4, TotalLatencyMs: 8, MaxLatencyMs: 3},
+		// Outside the report window.
+		{Day: day.Add(48 * time.Hour), Method: "GetExecution", Principal: "user", Project: "flytesnacks", Calls: 100},
+	} {
+		assert.NoError(t, repo.APIUsageRepo().Increment(ctx, usage), "usage %d", idx)
+	}
+
+	startFilter, err := common.NewSingleValueFilter(common.APIUsage, common.GreaterThanOrEqual, "day", day)
+	assert.NoError(t, err)
+	endFilter, err := common.NewSingleValueFilter(common.APIUsage, common.LessThan, "day", day.Add(48*time.Hour))
+	assert.NoError(t, err)
+	sums, err := repo.APIUsageRepo().Sum(ctx, interfaces.SumAPIUsageInput{
+		InlineFilters: []common.InlineFilter{startFilter, endFilter},
+		GroupBy:       []string{"principal", "project"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.APIUsageSum{
+		{
+			Principal:      "user",
+			Project:        "flytesnacks",
+			Calls:          8,
+			Errors:         1,
+			TotalLatencyMs: 65,
+			MaxLatencyMs:   30,
+		},
+		{
+			Calls:          4,
+			TotalLatencyMs: 8,
+			MaxLatencyMs:   3,
+		},
+	}, sums)
+
+	sums, err = repo.APIUsageRepo().Sum(ctx, interfaces.SumAPIUsageInput{
+		InlineFilters: []common.InlineFilter{startFilter, endFilter},
+		GroupBy:       []string{"method"},
+		Limit:         1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.APIUsageSum{
+		{
+			Method:         "GetExecution",
+			Calls:          5,
+			TotalLatencyMs: 5,
+			MaxLatencyMs:   2,
+		},
+	}, sums)
+}
+
+func TestSQLiteRepo_ProjectPurge(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	assert.NoError(t, repo.ProjectRepo().Create(ctx, models.Project{Identifier: "flytesnacks", Name: "flytesnacks"}))
+	for _, execution := range []models.ExecutionKey{
+		{Project: "flytesnacks", Domain: "development", Name: "a"},
+		{Project: "flytesnacks", Domain: "development", Name: "b"},
+		{Project: "flytesnacks", Domain: "production", Name: "c"},
+		{Project: "other", Domain: "development", Name: "a"},
+	} {
+		assert.NoError(t, repo.ExecutionRepo().Create(ctx, models.Execution{ExecutionKey: execution, Spec: []byte{}}))
+	}
+	// Soft-deleted records are purged too.
+	assert.NoError(t, repo.ExecutionRepo().Delete(ctx, interfaces.Identifier{
+		Project: "flytesnacks", Domain: "production", Name: "c",
+	}))
+	assert.NoError(t, repo.TaskRepo().Create(ctx, models.Task{
+		TaskKey: models.TaskKey{Project: "flytesnacks", Domain: "development", Name: "task", Version: "v1"},
+		Closure: []byte{},
+	}))
+	assert.NoError(t, repo.ResourceRepo().CreateOrUpdate(ctx, models.Resource{
+		Project:      "flytesnacks",
+		Domain:       "development",
+		ResourceType: "TASK_RESOURCE",
+		Priority:     models.ResourcePriorityProjectDomainLevel,
+		Attributes:   []byte{},
+	}))
+
+	purge := models.ProjectPurge{
+		Project:      "flytesnacks",
+		Phase:        models.ProjectPurgeRunning,
+		CurrentTable: interfaces.ProjectPurgeTables[0],
+	}
+	assert.NoError(t, repo.ProjectPurgeRepo().Create(ctx, purge))
+	purge, err := repo.ProjectPurgeRepo().GetLatest(ctx, "flytesnacks")
+	assert.NoError(t, err)
+	assert.NotZero(t, purge.ID)
+
+	var deletedExecutions []int64
+	for {
+		deleted, err := repo.ProjectPurgeRepo().DeleteRecords(ctx, interfaces.DeleteProjectRecordsInput{
+			Table:   interfaces.ExecutionsTable,
+			Project: "flytesnacks",
+			Limit:   2,
+		})
+		assert.NoError(t, err)
+		deletedExecutions = append(deletedExecutions, deleted)
+		if deleted < 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int64{2, 1}, deletedExecutions)
+
+	// Every purged table exists and can be purged of the project's records.
+	var deletedRecords int64
+	for _, table := range interfaces.ProjectPurgeTables {
+		deleted, err := repo.ProjectPurgeRepo().DeleteRecords(ctx, interfaces.DeleteProjectRecordsInput{
+			Table:   table,
+			Project: "flytesnacks",
+			Limit:   100,
+		})
+		assert.NoError(t, err, table)
+		deletedRecords += deleted
+	}
+	assert.Equal(t, int64(2), deletedRecords)
+	assert.NoError(t, repo.ProjectPurgeRepo().DeleteProject(ctx, "flytesnacks"))
+
+	_, err = repo.ProjectRepo().Get(ctx, "flytesnacks")
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	_, err = repo.ExecutionRepo().Get(ctx, interfaces.Identifier{Project: "other", Domain: "development", Name: "a"})
+	assert.NoError(t, err)
+
+	purge.Phase = models.ProjectPurgeSucceeded
+	purge.DeletedRecords = deletedRecords + 3
+	assert.NoError(t, repo.ProjectPurgeRepo().Update(ctx, purge))
+	running, err := repo.ProjectPurgeRepo().ListByPhase(ctx, models.ProjectPurgeRunning, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, running)
+	purge, err = repo.ProjectPurgeRepo().GetLatest(ctx, "flytesnacks")
+	assert.NoError(t, err)
+	assert.Equal(t, models.ProjectPurgeSucceeded, purge.Phase)
+	assert.Equal(t, int64(5), purge.DeletedRecords)
+}
+
+func TestSQLiteRepo_ProjectLabels(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	for _, project := range []models.Project{
+		{Identifier: "flytesnacks", ProjectLabels: []models.ProjectLabel{{Key: "team", Value: "ml"}}},
+		{Identifier: "flytekit", ProjectLabels: []models.ProjectLabel{{Key: "team", Value: "infra"}}},
+		{Identifier: "unlabeled"},
+	} {
+		assert.NoError(t, repo.ProjectRepo().Create(ctx, project))
+	}
+	listProjects := func(filter common.InlineFilter) []string {
+		projects, err := repo.ProjectRepo().List(ctx, interfaces.ListResourceInput{
+			InlineFilters: []common.InlineFilter{filter},
+		})
+		assert.NoError(t, err)
+		identifiers := make([]string, 0, len(projects))
+		for _, project := range projects {
+			identifiers = append(identifiers, project.Identifier)
+		}
+		return identifiers
+	}
+
+	mlFilter, err := common.NewInlineProjectLabelFilter("eq", "team", "ml")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"flytesnacks"}, listProjects(mlFilter))
+	unlabeledFilter, err := common.NewInlineProjectLabelFilter("is_null", "team", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"unlabeled"}, listProjects(unlabeledFilter))
+
+	// Updates without labels leave them unchanged, while updates with labels replace them.
+	assert.NoError(t, repo.ProjectRepo().UpdateProject(ctx, models.Project{Identifier: "flytesnacks", Name: "snacks"}))
+	assert.Equal(t, []string{"flytesnacks"}, listProjects(mlFilter))
+	assert.NoError(t, repo.ProjectRepo().UpdateProject(ctx, models.Project{
+		Identifier:    "flytesnacks",
+		ProjectLabels: []models.ProjectLabel{},
+	}))
+	assert.Empty(t, listProjects(mlFilter))
+
+	assert.NoError(t, repo.ProjectRepo().UpdateMetadata(ctx, models.Project{
+		Identifier: "flytekit",
+		Owner:      "alice",
+		Team:       "infra",
+		Metadata:   []byte(`{"cost-center":"1234"}`),
+	}))
+	teamFilter, err := common.NewSingleValueFilter(common.Project, common.Equal, "team", "infra")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"flytekit"}, listProjects(teamFilter))
+
+	err = repo.ProjectRepo().UpdateMetadata(ctx, models.Project{Identifier: "missing"})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestSQLiteRepo_Domains(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+
+	assert.NoError(t, repo.DomainRepo().Create(ctx, models.Domain{Identifier: "staging", Name: "staging"}))
+	assert.NoError(t, repo.DomainRepo().Create(ctx, models.Domain{Identifier: "development", Name: "development"}))
+	assert.Error(t, repo.DomainRepo().Create(ctx, models.Domain{Identifier: "staging", Name: "staging"}))
+	assert.NoError(t, repo.DomainRepo().Update(ctx, models.Domain{
+		Identifier:  "staging",
+		Name:        "Staging",
+		Description: "pre-production",
+	}))
+	domain, err := repo.DomainRepo().Get(ctx, "staging")
+	assert.NoError(t, err)
+	assert.Equal(t, "Staging", domain.Name)
+	assert.Equal(t, "pre-production", domain.Description)
+
+	// Deleted domains can be created again.
+	assert.NoError(t, repo.DomainRepo().Delete(ctx, "staging"))
+	assert.NoError(t, repo.DomainRepo().Create(ctx, models.Domain{Identifier: "staging", Name: "staging"}))
+	domains, err := repo.DomainRepo().List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, domains, 2)
+	assert.Equal(t, "development", domains[0].Identifier)
+}
+
+func TestSQLiteRepo_Signals(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	key := models.SignalKey{
+		ExecutionKey: models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: "a"},
+		SignalID:     "approve-deploy",
+	}
+
+	created := &models.Signal{SignalKey: key, Type: []byte("bool")}
+	assert.NoError(t, repo.SignalRepo().GetOrCreate(ctx, created))
+	// Signals are only created once, when their gate node first runs.
+	existing := &models.Signal{SignalKey: key, Type: []byte("other")}
+	assert.NoError(t, repo.SignalRepo().GetOrCreate(ctx, existing))
+	assert.Equal(t, created.ID, existing.ID)
+	assert.Equal(t, []byte("bool"), existing.Type)
+
+	setAt := time.Now()
+	assert.NoError(t, repo.SignalRepo().Update(ctx, models.Signal{
+		SignalKey: key,
+		Value:     []byte("true"),
+		SetBy:     "jane",
+		SetAt:     &setAt,
+	}))
+	err := repo.SignalRepo().Update(ctx, models.Signal{SignalKey: key, Value: []byte("false")})
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	signal, err := repo.SignalRepo().Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("true"), signal.Value)
+	assert.Equal(t, "jane", signal.SetBy)
+	signals, err := repo.SignalRepo().List(ctx, interfaces.ListSignalsInput{Execution: key.ExecutionKey, Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, signals, 1)
+}
+
+func TestSQLiteRepo_ExecutionComments(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	execution := models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: "a"}
+
+	for _, text := range []string{"re-ran manually", "upstream data was late"} {
+		comment := &models.ExecutionComment{
+			ExecutionProject: execution.Project,
+			ExecutionDomain:  execution.Domain,
+			ExecutionName:    execution.Name,
+			Author:           "jane",
+			Text:             text,
+		}
+		assert.NoError(t, repo.ExecutionCommentRepo().Create(ctx, comment))
+		assert.NotZero(t, comment.ID)
+	}
+	assert.NoError(t, repo.ExecutionCommentRepo().Create(ctx, &models.ExecutionComment{
+		ExecutionProject: execution.Project,
+		ExecutionDomain:  execution.Domain,
+		ExecutionName:    "b",
+		Text:             "another execution",
+	}))
+
+	comments, err := repo.ExecutionCommentRepo().List(ctx, interfaces.ListExecutionCommentsInput{
+		Execution: execution,
+		Limit:     10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, comments, 2)
+	assert.Equal(t, "upstream data was late", comments[1].Text)
+	comments, err = repo.ExecutionCommentRepo().List(ctx, interfaces.ListExecutionCommentsInput{
+		Execution: execution,
+		Limit:     10,
+		Offset:    1,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+}
+
+func TestSQLiteRepo_SavedViews(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	projectView := models.SavedViewKey{Project: "flytesnacks", Domain: "production", Name: "failed runs"}
+	personalView := models.SavedViewKey{Project: "flytesnacks", Domain: "production", Owner: "jane", Name: "failed runs"}
+
+	for _, key := range []models.SavedViewKey{projectView, personalView,
+		{Project: "flytesnacks", Domain: "production", Owner: "joe", Name: "joe's runs"}} {
+		assert.NoError(t, repo.SavedViewRepo().Create(ctx, models.SavedView{
+			SavedViewKey: key,
+			ResourceType: "executions",
+			Filters:      "eq(phase,FAILED)",
+		}))
+	}
+	err := repo.SavedViewRepo().Create(ctx, models.SavedView{SavedViewKey: personalView, ResourceType: "executions"})
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	// Filters can be cleared.
+	assert.NoError(t, repo.SavedViewRepo().Update(ctx, models.SavedView{
+		SavedViewKey: projectView,
+		ResourceType: "workflows",
+	}))
+	savedView, err := repo.SavedViewRepo().Get(ctx, projectView)
+	assert.NoError(t, err)
+	assert.Equal(t, "workflows", savedView.ResourceType)
+	assert.Empty(t, savedView.Filters)
+	savedView, err = repo.SavedViewRepo().Get(ctx, personalView)
+	assert.NoError(t, err)
+	assert.Equal(t, "eq(phase,FAILED)", savedView.Filters)
+
+	savedViews, err := repo.SavedViewRepo().List(ctx, interfaces.ListSavedViewsInput{
+		Project: "flytesnacks",
+		Domain:  "production",
+		Owner:   "jane",
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, savedViews, 2)
+
+	assert.NoError(t, repo.SavedViewRepo().Delete(ctx, personalView))
+	_, err = repo.SavedViewRepo().Get(ctx, personalView)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	err = repo.SavedViewRepo().Delete(ctx, personalView)
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestSQLiteRepo_OAuthClients(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	for _, clientID := range []string{"etl-bot", "alerts-bot"} {
+		assert.NoError(t, repo.OAuthClientRepo().Create(ctx, models.OAuthClient{
+			ClientID:   clientID,
+			Owner:      "jane",
+			SecretHash: []byte("hash"),
+			GrantTypes: "client_credentials",
+			Scopes:     "all",
+		}))
+	}
+	assert.NoError(t, repo.OAuthClientRepo().Create(ctx, models.OAuthClient{ClientID: "joe-cli", Owner: "joe"}))
+	err := repo.OAuthClientRepo().Create(ctx, models.OAuthClient{ClientID: "etl-bot", Owner: "joe"})
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	assert.NoError(t, repo.OAuthClientRepo().UpdateSecret(ctx, "etl-bot", []byte("rotated")))
+	client, err := repo.OAuthClientRepo().Get(ctx, "etl-bot")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("rotated"), client.SecretHash)
+	assert.Equal(t, "client_credentials", client.GrantTypes)
+
+	clients, err := repo.OAuthClientRepo().List(ctx, interfaces.ListOAuthClientsInput{Owner: "jane", Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, clients, 2)
+	assert.Equal(t, "alerts-bot", clients[0].ClientID)
+
+	assert.NoError(t, repo.OAuthClientRepo().Delete(ctx, "etl-bot"))
+	_, err = repo.OAuthClientRepo().Get(ctx, "etl-bot")
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	err = repo.OAuthClientRepo().UpdateSecret(ctx, "etl-bot", []byte("rotated"))
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func TestSQLiteRepo_FeatureFlags(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	enabled := true
+	assert.NoError(t, repo.FeatureFlagRepo().Set(ctx, models.FeatureFlag{
+		Name:      "admission_control",
+		Project:   "pilot",
+		Enabled:   true,
+		Principal: "jane",
+	}, models.FeatureFlagChange{Name: "admission_control", Project: "pilot", Enabled: &enabled, Principal: "jane"}))
+	// Disabling a flag for every project doesn't replace the override of a project.
+	disabled := false
+	assert.NoError(t, repo.FeatureFlagRepo().Set(ctx, models.FeatureFlag{
+		Name:      "admission_control",
+		Enabled:   false,
+		Principal: "joe",
+	}, models.FeatureFlagChange{Name: "admission_control", Enabled: &disabled, Principal: "joe"}))
+	assert.NoError(t, repo.FeatureFlagRepo().Set(ctx, models.FeatureFlag{
+		Name:      "admission_control",
+		Project:   "pilot",
+		Enabled:   false,
+		Principal: "joe",
+	}, models.FeatureFlagChange{Name: "admission_control", Project: "pilot", Enabled: &disabled, Principal: "joe"}))
+
+	flags, err := repo.FeatureFlagRepo().Get(ctx, "admission_control")
+	assert.NoError(t, err)
+	assert.Len(t, flags, 2)
+	assert.Equal(t, "", flags[0].Project)
+	assert.Equal(t, "pilot", flags[1].Project)
+	assert.False(t, flags[1].Enabled)
+	assert.Equal(t, "joe", flags[1].Principal)
+
+	assert.NoError(t, repo.FeatureFlagRepo().Clear(ctx, "admission_control", "pilot",
+		models.FeatureFlagChange{Name: "admission_control", Project: "pilot", Principal: "joe", Reason: "done"}))
+	err = repo.FeatureFlagRepo().Clear(ctx, "admission_control", "pilot", models.FeatureFlagChange{})
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	flags, err = repo.FeatureFlagRepo().ListAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, flags, 1)
+
+	changes, err := repo.FeatureFlagRepo().ListChanges(ctx, interfaces.ListFeatureFlagChangesInput{
+		Name:  "admission_control",
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, changes, 4)
+	assert.Equal(t, "done", changes[0].Reason)
+	assert.Nil(t, changes[0].Enabled)
+}
+
+func TestSQLiteRepo_CacheEvictions(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	assert.NoError(t, repo.CacheEvictionRepo().Create(ctx, []models.CacheEviction{
+		{
+			DatasetProject:   "project",
+			DatasetDomain:    "development",
+			DatasetName:      "flyte_task-name",
+			DatasetVersion:   "1.0-abcdefgh-ijklmnop",
+			ArtifactID:       "artifact-0",
+			ExecutionProject: "project",
+			ExecutionDomain:  "development",
+			ExecutionName:    "execution",
+			NodeID:           "n0",
+			Principal:        "jane",
+		},
+		{
+			DatasetProject: "project",
+			DatasetDomain:  "development",
+			DatasetName:    "flyte_task-name",
+			DatasetVersion: "1.0-abcdefgh-ijklmnop",
+			ArtifactID:     "artifact-1",
+			Principal:      "jane",
+		},
+	}))
+	assert.NoError(t, repo.CacheEvictionRepo().Create(ctx, []models.CacheEviction{
+		{DatasetProject: "project", DatasetDomain: "production", DatasetName: "flyte_task-name", Principal: "joe"},
+	}))
+
+	evictions, err := repo.CacheEvictionRepo().List(ctx, interfaces.ListCacheEvictionsInput{
+		Project: "project",
+		Domain:  "development",
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, evictions, 2)
+	assert.Equal(t, "artifact-1", evictions[0].ArtifactID)
+	assert.Equal(t, "n0", evictions[1].NodeID)
+}
+
+func TestSQLiteRepo_Orgs(t *testing.T) {
+	repo := newSQLiteRepo(t)
+	ctx := context.Background()
+	assert.NoError(t, repo.OrgRepo().Create(ctx, models.Org{Identifier: "research", Name: "Research"}))
+	err := repo.OrgRepo().Create(ctx, models.Org{Identifier: "research"})
+	assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.NoError(t, repo.OrgRepo().Update(ctx, models.Org{Identifier: "research", Name: "R&D", Principal: "joe"}))
+	org, err := repo.OrgRepo().Get(ctx, "research")
+	assert.NoError(t, err)
+	assert.Equal(t, "R&D", org.Name)
+
+	for _, project := range []string{"flytesnacks", "flytekit"} {
+		assert.NoError(t, repo.ProjectRepo().Create(ctx, models.Project{Identifier: project}))
+	}
+	assert.NoError(t, repo.ProjectRepo().UpdateOrg(ctx, "flytesnacks", "research"))
+	err = repo.ProjectRepo().UpdateOrg(ctx, "missing", "research")
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	orgFilter, err := common.NewSingleValueFilter(common.Project, common.Equal, "org", "research")
+	assert.NoError(t, err)
+	projects, err := repo.ProjectRepo().List(ctx, interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{orgFilter},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, projects, 1)
+	assert.Equal(t, "flytesnacks", projects[0].Identifier)
+
+	// Orgs can't be deleted while projects belong to them.
+	err = repo.OrgRepo().Delete(ctx, "research")
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.NoError(t, repo.ProjectRepo().UpdateOrg(ctx, "flytesnacks", ""))
+	assert.NoError(t, repo.OrgRepo().Delete(ctx, "research"))
+	err = repo.OrgRepo().Delete(ctx, "research")
+	assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	orgs, err := repo.OrgRepo().List(ctx, interfaces.ListResourceInput{Limit: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, orgs)
+}
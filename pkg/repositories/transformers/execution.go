@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -33,6 +34,10 @@ type CreateExecutionModelInput struct {
 	Cluster               string
 	InputsURI             storage.DataReference
 	UserInputsURI         storage.DataReference
+	// The user launching the execution on behalf of the principal set in the spec metadata, if impersonating them.
+	Impersonator string
+	// The labels applied to the execution, including those inherited from the launch plan and project.
+	Labels map[string]string
 }
 
 // Transforms a ExecutionCreateRequest to a Execution model
@@ -87,6 +92,7 @@ func CreateExecutionModel(input CreateExecutionModelInput) (*models.Execution, e
 		InputsURI:             input.InputsURI,
 		UserInputsURI:         input.UserInputsURI,
 		User:                  requestSpec.Metadata.Principal,
+		Impersonator:          input.Impersonator,
 	}
 	// A reference launch entity can be one of either or a task OR launch plan. Traditionally, workflows are executed
 	// with a reference launch plan which is why this behavior is the default below.
@@ -98,10 +104,30 @@ func CreateExecutionModel(input CreateExecutionModelInput) (*models.Execution, e
 	if input.RequestSpec.Metadata != nil {
 		executionModel.Mode = int32(input.RequestSpec.Metadata.Mode)
 	}
+	executionModel.Labels = toExecutionLabelModels(executionModel.ExecutionKey, input.Labels)
 
 	return executionModel, nil
 }
 
+func toExecutionLabelModels(executionKey models.ExecutionKey, labels map[string]string) []models.ExecutionLabel {
+	if len(labels) == 0 {
+		return nil
+	}
+	labelModels := make([]models.ExecutionLabel, 0, len(labels))
+	for key, value := range labels {
+		labelModels = append(labelModels, models.ExecutionLabel{
+			ExecutionKey: executionKey,
+			Key:          key,
+			Value:        value,
+		})
+	}
+	// Sort for a deterministic insertion order.
+	sort.Slice(labelModels, func(i, j int) bool {
+		return labelModels[i].Key < labelModels[j].Key
+	})
+	return labelModels
+}
+
 // Updates an existing model given a WorkflowExecution event.
 func UpdateExecutionModelState(
 	execution *models.Execution, request admin.WorkflowExecutionEventRequest) error {
@@ -154,6 +180,11 @@ func UpdateExecutionModelState(
 		execution.ErrorKind = &k
 		execution.ErrorCode = &request.Event.GetError().Code
 	}
+	// Aborted executions keep the cause recorded when they were terminated.
+	if failureCause := common.GetFailureCause(request.Event.Phase, request.Event.GetError()); len(failureCause) > 0 &&
+		execution.FailureCause == nil {
+		execution.FailureCause = &failureCause
+	}
 	marshaledClosure, err := proto.Marshal(&executionClosure)
 	if err != nil {
 		return errors.NewFlyteAdminErrorf(codes.Internal, "Failed to marshal execution closure: %v", err)
@@ -182,6 +213,8 @@ func SetExecutionAborted(execution *models.Execution, cause, principal string) e
 	}
 	execution.Closure = marshaledClosure
 	execution.AbortCause = cause
+	failureCause := common.FailureCauseAborted
+	execution.FailureCause = &failureCause
 	return nil
 }
 
@@ -214,6 +247,16 @@ func FromExecutionModel(executionModel models.Execution) (*admin.Execution, erro
 			},
 		}
 	}
+	if executionModel.Pending && len(executionModel.LaunchError) > 0 && closure.GetOutputResult() == nil {
+		// Pending executions which failed to launch report why until they're launched.
+		closure.OutputResult = &admin.ExecutionClosure_Error{
+			Error: &core.ExecutionError{
+				Code:    common.LaunchFailedErrorCode,
+				Message: executionModel.LaunchError,
+				Kind:    core.ExecutionError_SYSTEM,
+			},
+		}
+	}
 
 	// TODO: Clear deprecated fields to reduce message size.
 	// spec.Inputs = nil
@@ -13,6 +13,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/testutils"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 )
@@ -71,6 +72,11 @@ func TestCreateExecutionModel(t *testing.T) {
 		ParentNodeExecutionID: nodeID,
 		SourceExecutionID:     sourceID,
 		Cluster:               cluster,
+		Impersonator:          "operator",
+		Labels: map[string]string{
+			"team": "ml",
+			"env":  "prod",
+		},
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, "project", execution.Project)
@@ -91,6 +97,7 @@ func TestCreateExecutionModel(t *testing.T) {
 	expectedSpecBytes, _ := proto.Marshal(expectedSpec)
 	assert.Equal(t, expectedSpecBytes, execution.Spec)
 	assert.Equal(t, execution.User, principal)
+	assert.Equal(t, "operator", execution.Impersonator)
 
 	expectedCreatedAt, _ := ptypes.TimestampProto(createdAt)
 	expectedClosure, _ := proto.Marshal(&admin.ExecutionClosure{
@@ -101,6 +108,16 @@ func TestCreateExecutionModel(t *testing.T) {
 		WorkflowId: workflowIdentifier,
 	})
 	assert.Equal(t, expectedClosure, execution.Closure)
+
+	executionKey := models.ExecutionKey{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	assert.Equal(t, []models.ExecutionLabel{
+		{ExecutionKey: executionKey, Key: "env", Value: "prod"},
+		{ExecutionKey: executionKey, Key: "team", Value: "ml"},
+	}, execution.Labels)
 }
 
 func TestUpdateModelState_UnknownToRunning(t *testing.T) {
@@ -200,6 +217,7 @@ func TestUpdateModelState_RunningToFailed(t *testing.T) {
 	assert.Nil(t, err)
 
 	ekString := ek.String()
+	failureCause := common.FailureCauseAborted
 	durationProto := ptypes.DurationProto(duration)
 	expectedClosure := admin.ExecutionClosure{
 		ComputedInputs: &core.LiteralMap{
@@ -233,10 +251,63 @@ func TestUpdateModelState_RunningToFailed(t *testing.T) {
 		ExecutionUpdatedAt: &occurredAt,
 		ErrorCode:          &ec,
 		ErrorKind:          &ekString,
+		FailureCause:       &failureCause,
 	}
 	assert.EqualValues(t, expectedModel, executionModel)
 }
 
+func TestUpdateModelState_FailureCause(t *testing.T) {
+	existingClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+		Phase: core.WorkflowExecution_RUNNING,
+	})
+	occurredAtProto := ptypes.TimestampNow()
+	getFailedEvent := func(code string) admin.WorkflowExecutionEventRequest {
+		return admin.WorkflowExecutionEventRequest{
+			Event: &event.WorkflowExecutionEvent{
+				Phase:      core.WorkflowExecution_FAILED,
+				OccurredAt: occurredAtProto,
+				OutputResult: &event.WorkflowExecutionEvent_Error{
+					Error: &core.ExecutionError{
+						Code: code,
+						Kind: core.ExecutionError_USER,
+					},
+				},
+			},
+		}
+	}
+
+	executionModel := models.Execution{
+		Phase:   core.WorkflowExecution_RUNNING.String(),
+		Closure: existingClosureBytes,
+	}
+	err := UpdateExecutionModelState(&executionModel, getFailedEvent("OOMKilled"))
+	assert.NoError(t, err)
+	assert.Equal(t, common.FailureCauseOOM, *executionModel.FailureCause)
+
+	// An aborted execution keeps its cause however propeller reports its termination.
+	executionModel = models.Execution{
+		Phase:   core.WorkflowExecution_RUNNING.String(),
+		Closure: existingClosureBytes,
+	}
+	assert.NoError(t, SetExecutionAborted(&executionModel, "cause", "principal"))
+	err = UpdateExecutionModelState(&executionModel, getFailedEvent("OOMKilled"))
+	assert.NoError(t, err)
+	assert.Equal(t, common.FailureCauseAborted, *executionModel.FailureCause)
+
+	executionModel = models.Execution{
+		Phase:   core.WorkflowExecution_RUNNING.String(),
+		Closure: existingClosureBytes,
+	}
+	err = UpdateExecutionModelState(&executionModel, admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:      core.WorkflowExecution_SUCCEEDED,
+			OccurredAt: occurredAtProto,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, executionModel.FailureCause)
+}
+
 func TestUpdateModelState_RunningToSuccess(t *testing.T) {
 	startedAt := time.Now()
 	startedAtProto, _ := ptypes.TimestampProto(startedAt)
@@ -385,6 +456,7 @@ func TestSetExecutionAborted(t *testing.T) {
 		// propagated by flytepropeller.
 		Phase: core.WorkflowExecution_RUNNING,
 	}, &actualClosure))
+	assert.Equal(t, common.FailureCauseAborted, *existingModel.FailureCause)
 }
 
 func TestGetExecutionIdentifier(t *testing.T) {
@@ -470,6 +542,37 @@ func TestFromExecutionModel_Aborted(t *testing.T) {
 	assert.Empty(t, execution.Closure.GetAbortCause())
 }
 
+func TestFromExecutionModel_LaunchError(t *testing.T) {
+	executionClosureBytes, _ := proto.Marshal(&admin.ExecutionClosure{
+		Phase: core.WorkflowExecution_QUEUED,
+	})
+	executionModel := models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Phase:          core.WorkflowExecution_QUEUED.String(),
+		Closure:        executionClosureBytes,
+		Pending:        true,
+		LaunchAttempts: 2,
+		LaunchError:    "cluster unavailable",
+	}
+	execution, err := FromExecutionModel(executionModel)
+	assert.Nil(t, err)
+	assert.Equal(t, core.WorkflowExecution_QUEUED, execution.Closure.Phase)
+	assert.True(t, proto.Equal(&core.ExecutionError{
+		Code:    common.LaunchFailedErrorCode,
+		Message: "cluster unavailable",
+		Kind:    core.ExecutionError_SYSTEM,
+	}, execution.Closure.GetError()))
+
+	executionModel.Pending = false
+	execution, err = FromExecutionModel(executionModel)
+	assert.Nil(t, err)
+	assert.Nil(t, execution.Closure.GetError())
+}
+
 func TestFromExecutionModels(t *testing.T) {
 	spec := testutils.GetExecutionRequest().Spec
 	specBytes, _ := proto.Marshal(spec)
@@ -1,6 +1,8 @@
 package transformers
 
 import (
+	"sort"
+
 	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/golang/protobuf/proto"
@@ -27,12 +29,30 @@ func CreateProjectModel(project *admin.Project) models.Project {
 		return models.Project{}
 	}
 	return models.Project{
-		Identifier:  project.Id,
-		Name:        project.Name,
-		Description: project.Description,
-		Labels:      projectBytes,
-		State:       &stateInt,
+		Identifier:    project.Id,
+		Name:          project.Name,
+		Description:   project.Description,
+		Labels:        projectBytes,
+		State:         &stateInt,
+		ProjectLabels: toProjectLabelModels(project.Id, project.Labels.Values),
+	}
+}
+
+// Returns a non-nil slice for projects with labels, even if empty, so that updates clear the existing labels.
+func toProjectLabelModels(projectID string, labels map[string]string) []models.ProjectLabel {
+	labelModels := make([]models.ProjectLabel, 0, len(labels))
+	for key, value := range labels {
+		labelModels = append(labelModels, models.ProjectLabel{
+			Project: projectID,
+			Key:     key,
+			Value:   value,
+		})
 	}
+	// Sort for a deterministic insertion order.
+	sort.Slice(labelModels, func(i, j int) bool {
+		return labelModels[i].Key < labelModels[j].Key
+	})
+	return labelModels
 }
 
 func FromProjectModel(projectModel models.Project, domains []*admin.Domain) admin.Project {
@@ -34,6 +34,9 @@ func TestCreateProjectModel(t *testing.T) {
 		Description: "project_description",
 		Labels:      projectBytes,
 		State:       &activeState,
+		ProjectLabels: []models.ProjectLabel{
+			{Project: "project_id", Key: "foo", Value: "#badlabel"},
+		},
 	}, projectModel)
 }
 
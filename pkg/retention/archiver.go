@@ -0,0 +1,47 @@
+package retention
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flytestdlib/storage"
+)
+
+const archiveFileFormat = "%d-%d.jsonl"
+
+// Copies records to storage before they are pruned, as JSON lines with one object per record.
+type archiver struct {
+	store  *storage.DataStore
+	prefix string
+}
+
+// Writes a batch of records to <prefix>/<table>/<project>/<domain>/<first id>-<last id>.jsonl. Batches are named
+// after the records they contain so that rewriting a batch which failed to be deleted replaces the earlier copy.
+func (a *archiver) archive(
+	ctx context.Context, input repositoryInterfaces.ListExpiredInput, records []repositoryInterfaces.ExpiredRecord) (
+	storage.DataReference, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, record := range records {
+		if err := encoder.Encode(record.Columns); err != nil {
+			return "", fmt.Errorf("failed to encode %s record %d: %w", input.Table, record.ID, err)
+		}
+	}
+
+	nestedKeys := []string{input.Table, input.Project, input.Domain,
+		fmt.Sprintf(archiveFileFormat, records[0].ID, records[len(records)-1].ID)}
+	if len(a.prefix) > 0 {
+		nestedKeys = append([]string{a.prefix}, nestedKeys...)
+	}
+	reference, err := a.store.ConstructReference(ctx, a.store.GetBaseContainerFQN(ctx), nestedKeys...)
+	if err != nil {
+		return "", err
+	}
+	if err := a.store.WriteRaw(ctx, reference, int64(buf.Len()), storage.Options{}, &buf); err != nil {
+		return "", err
+	}
+	return reference, nil
+}
@@ -0,0 +1,477 @@
+// Package retention prunes executions and their related records once they have outlived the retention policies
+// configured for their project and domain, along with the oldest versions of registered entities beyond the number
+// those policies retain.
+package retention
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyteadmin/pkg/repositories"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const tableLabel = "table"
+
+type Pruner interface {
+	Prune(ctx context.Context) error
+	// Runs periodically until ctx is done.
+	Run(ctx context.Context)
+}
+
+type prunerMetrics struct {
+	Scope         promutils.Scope
+	PruneStarted  prometheus.Counter
+	PruneFailures prometheus.Counter
+	RowsArchived  *prometheus.CounterVec
+	RowsDeleted   *prometheus.CounterVec
+	// Versions of registered entities pruned for exceeding the number retained.
+	VersionsDeleted *prometheus.CounterVec
+	PruneDuration   promutils.StopWatch
+	Panics          prometheus.Counter
+}
+
+type pruner struct {
+	db     repositories.RepositoryInterface
+	config runtimeInterfaces.Configuration
+	// Workflow closures are read from the store to find the tasks used by active launch plans.
+	store *storage.DataStore
+	// Unset when archiving is disabled.
+	archiver *archiver
+	metrics  prunerMetrics
+	now      func() time.Time
+}
+
+// Returns the number of days records in a table are retained for. Since records are only pruned once nothing
+// references them, records are retained no longer than the records they reference.
+func getRetentionDays(policy runtimeInterfaces.RetentionPolicy, table string) int {
+	nodeExecutionDays := minRetentionDays(policy.NodeExecutionDays, policy.ExecutionDays)
+	switch table {
+	case repositoryInterfaces.ExecutionEventsTable, repositoryInterfaces.RecordedEventsTable:
+		return minRetentionDays(policy.EventDays, policy.ExecutionDays)
+	case repositoryInterfaces.NodeExecutionEventsTable:
+		return minRetentionDays(policy.EventDays, nodeExecutionDays)
+	case repositoryInterfaces.TaskExecutionsTable, repositoryInterfaces.NodeExecutionsTable:
+		return nodeExecutionDays
+	case repositoryInterfaces.ExecutionCommentsTable, repositoryInterfaces.SignalsTable,
+		repositoryInterfaces.ExecutionRelationshipsTable, repositoryInterfaces.TaskExecutionUsagesTable,
+		repositoryInterfaces.OffloadedLiteralsTable, repositoryInterfaces.ExecutionsTable:
+		return policy.ExecutionDays
+	}
+	return 0
+}
+
+// Returns the shorter of two retention periods, where zero retains records indefinitely.
+func minRetentionDays(days, parentDays int) int {
+	if days <= 0 || (parentDays > 0 && parentDays < days) {
+		return parentDays
+	}
+	return days
+}
+
+// Returns the inputs for listing expired records in a project and domain, in the order they're pruned. Records which
+// outlive their retention period are pruned first, followed by those soft-deleted for long enough to be purged.
+func getListExpiredInputs(policy runtimeInterfaces.RetentionPolicy, project, domain string, now time.Time,
+	limit int) []repositoryInterfaces.ListExpiredInput {
+	inputs := make([]repositoryInterfaces.ListExpiredInput, 0)
+	for _, table := range repositoryInterfaces.RetentionTables {
+		days := getRetentionDays(policy, table)
+		if days <= 0 {
+			continue
+		}
+		inputs = append(inputs, repositoryInterfaces.ListExpiredInput{
+			Table:         table,
+			Project:       project,
+			Domain:        domain,
+			CreatedBefore: now.AddDate(0, 0, -days),
+			Limit:         limit,
+		})
+	}
+	if policy.DeletedDays <= 0 {
+		return inputs
+	}
+	for _, table := range repositoryInterfaces.PurgeTables {
+		inputs = append(inputs, repositoryInterfaces.ListExpiredInput{
+			Table:         table,
+			Project:       project,
+			Domain:        domain,
+			DeletedBefore: now.AddDate(0, 0, -policy.DeletedDays),
+			Limit:         limit,
+		})
+	}
+	return inputs
+}
+
+// Returns the number of the latest versions of each name retained in a table, where zero retains every version.
+func getMaxVersions(policy runtimeInterfaces.RetentionPolicy, table string) int {
+	switch table {
+	case repositoryInterfaces.LaunchPlansTable:
+		return policy.LaunchPlanVersions
+	case repositoryInterfaces.WorkflowsTable:
+		return policy.WorkflowVersions
+	case repositoryInterfaces.TasksTable:
+		return policy.TaskVersions
+	}
+	return 0
+}
+
+// Returns the inputs for listing the versions of registered entities in a project and domain which exceed the number
+// retained, in the order they're pruned.
+func getListExcessVersionsInputs(policy runtimeInterfaces.RetentionPolicy, project, domain string, now time.Time,
+	limit int) []repositoryInterfaces.ListExcessVersionsInput {
+	var executedAfter time.Time
+	if policy.VersionExecutionDays > 0 {
+		executedAfter = now.AddDate(0, 0, -policy.VersionExecutionDays)
+	}
+	inputs := make([]repositoryInterfaces.ListExcessVersionsInput, 0)
+	for _, table := range repositoryInterfaces.VersionTables {
+		maxVersions := getMaxVersions(policy, table)
+		if maxVersions <= 0 {
+			continue
+		}
+		inputs = append(inputs, repositoryInterfaces.ListExcessVersionsInput{
+			Table:         table,
+			Project:       project,
+			Domain:        domain,
+			MaxVersions:   maxVersions,
+			ExecutedAfter: executedAfter,
+			Limit:         limit,
+		})
+	}
+	return inputs
+}
+
+func getColumnString(record repositoryInterfaces.ExpiredRecord, column string) string {
+	switch value := record.Columns[column].(type) {
+	case string:
+		return value
+	case []byte:
+		return string(value)
+	}
+	return ""
+}
+
+func getVersionIdentifier(record repositoryInterfaces.ExpiredRecord) repositoryInterfaces.Identifier {
+	return repositoryInterfaces.Identifier{
+		Project: getColumnString(record, shared.Project),
+		Domain:  getColumnString(record, shared.Domain),
+		Name:    getColumnString(record, shared.Name),
+		Version: getColumnString(record, shared.Version),
+	}
+}
+
+func getIdentifier(id *core.Identifier) repositoryInterfaces.Identifier {
+	return repositoryInterfaces.Identifier{
+		Project: id.GetProject(),
+		Domain:  id.GetDomain(),
+		Name:    id.GetName(),
+		Version: id.GetVersion(),
+	}
+}
+
+// Returns the tasks used by the workflows of the active launch plans in a project and domain, which aren't otherwise
+// referenced in the database.
+func (p *pruner) listActiveTasks(
+	ctx context.Context, project, domain string) (map[repositoryInterfaces.Identifier]bool, error) {
+	filters, err := util.ListActiveLaunchPlanVersionsFilters(project, domain)
+	if err != nil {
+		return nil, err
+	}
+	limit := p.config.RetentionConfiguration().GetBatchSize()
+	workflowIDs := make([]*core.Identifier, 0)
+	listedWorkflows := make(map[repositoryInterfaces.Identifier]bool)
+	for offset := 0; ; offset += limit {
+		output, err := p.db.LaunchPlanRepo().List(ctx, repositoryInterfaces.ListResourceInput{
+			InlineFilters: filters,
+			Limit:         limit,
+			Offset:        offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, launchPlanModel := range output.LaunchPlans {
+			launchPlan, err := transformers.FromLaunchPlanModel(launchPlanModel)
+			if err != nil {
+				return nil, err
+			}
+			workflowID := launchPlan.GetSpec().GetWorkflowId()
+			if workflowID == nil {
+				continue
+			}
+			key := getIdentifier(workflowID)
+			if listedWorkflows[key] {
+				continue
+			}
+			listedWorkflows[key] = true
+			workflowIDs = append(workflowIDs, workflowID)
+		}
+		if len(output.LaunchPlans) < limit {
+			break
+		}
+	}
+
+	tasks := make(map[repositoryInterfaces.Identifier]bool)
+	if len(workflowIDs) == 0 {
+		return tasks, nil
+	}
+	workflows, err := util.GetWorkflows(ctx, p.db, p.store, workflowIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, workflow := range workflows {
+		for _, task := range workflow.GetClosure().GetCompiledWorkflow().GetTasks() {
+			tasks[getIdentifier(task.GetTemplate().GetId())] = true
+		}
+	}
+	return tasks, nil
+}
+
+func (p *pruner) listProjects(ctx context.Context) ([]string, error) {
+	// Archived projects are included since their executions are still subject to retention.
+	states := make([]int32, 0, len(admin.Project_ProjectState_value))
+	for _, state := range admin.Project_ProjectState_value {
+		states = append(states, state)
+	}
+	filter, err := common.NewRepeatedValueFilter(common.Project, common.ValueIn, "state", states)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := p.db.ProjectRepo().List(ctx, repositoryInterfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{filter},
+	})
+	if err != nil {
+		return nil, err
+	}
+	projectIDs := make([]string, len(projects))
+	for i, project := range projects {
+		projectIDs[i] = project.Identifier
+	}
+	return projectIDs, nil
+}
+
+func getRecordIDs(records []repositoryInterfaces.ExpiredRecord) []uint {
+	ids := make([]uint, len(records))
+	for i, record := range records {
+		ids[i] = record.ID
+	}
+	return ids
+}
+
+// Copies records to storage before they're deleted, when archiving is enabled.
+func (p *pruner) archiveRecords(ctx context.Context, input repositoryInterfaces.ListExpiredInput,
+	records []repositoryInterfaces.ExpiredRecord) error {
+	if p.archiver == nil {
+		return nil
+	}
+	reference, err := p.archiver.archive(ctx, input, records)
+	if err != nil {
+		return err
+	}
+	p.metrics.RowsArchived.WithLabelValues(input.Table).Add(float64(len(records)))
+	logger.Debugf(ctx, "Archived %d %s records for [%s/%s] to %s",
+		len(records), input.Table, input.Project, input.Domain, reference)
+	return nil
+}
+
+// Archives and deletes batches of expired records from a table until none remain.
+func (p *pruner) pruneTable(ctx context.Context, input repositoryInterfaces.ListExpiredInput) error {
+	for {
+		records, err := p.db.RetentionRepo().ListExpired(ctx, input)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := p.archiveRecords(ctx, input, records); err != nil {
+			return err
+		}
+		deleted, err := p.db.RetentionRepo().Delete(ctx, input.Table, getRecordIDs(records))
+		if err != nil {
+			return err
+		}
+		p.metrics.RowsDeleted.WithLabelValues(input.Table).Add(float64(deleted))
+		logger.Debugf(ctx, "Deleted %d expired %s records for [%s/%s]", deleted, input.Table, input.Project, input.Domain)
+		// Records referenced since they were listed are left in place, and would be listed again.
+		if len(records) < input.Limit || deleted == 0 {
+			return nil
+		}
+	}
+}
+
+// Archives and deletes batches of versions exceeding the number retained from a table, skipping those in keep.
+func (p *pruner) pruneVersionTable(ctx context.Context, input repositoryInterfaces.ListExcessVersionsInput,
+	keep map[repositoryInterfaces.Identifier]bool) error {
+	for {
+		records, err := p.db.RetentionRepo().ListExcessVersions(ctx, input)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		input.AfterID = records[len(records)-1].ID
+		pruned := make([]repositoryInterfaces.ExpiredRecord, 0, len(records))
+		for _, record := range records {
+			if !keep[getVersionIdentifier(record)] {
+				pruned = append(pruned, record)
+			}
+		}
+		if len(pruned) > 0 {
+			if err := p.archiveRecords(ctx, repositoryInterfaces.ListExpiredInput{
+				Table:   input.Table,
+				Project: input.Project,
+				Domain:  input.Domain,
+			}, pruned); err != nil {
+				return err
+			}
+			deleted, err := p.db.RetentionRepo().DeleteExcessVersions(ctx, input, getRecordIDs(pruned))
+			if err != nil {
+				return err
+			}
+			p.metrics.VersionsDeleted.WithLabelValues(input.Table).Add(float64(deleted))
+			logger.Debugf(ctx, "Deleted %d excess %s versions for [%s/%s]",
+				deleted, input.Table, input.Project, input.Domain)
+		}
+		if len(records) < input.Limit {
+			return nil
+		}
+	}
+}
+
+// Prunes the versions of registered entities in a project and domain which exceed the number retained.
+func (p *pruner) pruneVersions(ctx context.Context, policy runtimeInterfaces.RetentionPolicy, project, domain string,
+	now time.Time) error {
+	for _, input := range getListExcessVersionsInputs(
+		policy, project, domain, now, p.config.RetentionConfiguration().GetBatchSize()) {
+		var keep map[repositoryInterfaces.Identifier]bool
+		if input.Table == repositoryInterfaces.TasksTable {
+			var err error
+			if keep, err = p.listActiveTasks(ctx, project, domain); err != nil {
+				return err
+			}
+		}
+		if err := p.pruneVersionTable(ctx, input, keep); err != nil {
+			logger.Warningf(ctx, "Failed to prune %s versions for [%s/%s]: %v", input.Table, project, domain, err)
+			// Versions referencing those in later tables haven't all been pruned.
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pruner) Prune(ctx context.Context) error {
+	defer func() {
+		if err := recover(); err != nil {
+			p.metrics.Panics.Inc()
+			logger.Warningf(ctx, fmt.Sprintf("caught panic: %v [%+v]", err, string(debug.Stack())))
+		}
+	}()
+	p.metrics.PruneStarted.Inc()
+	timer := p.metrics.PruneDuration.Start()
+	defer timer.Stop()
+
+	projects, err := p.listProjects(ctx)
+	if err != nil {
+		p.metrics.PruneFailures.Inc()
+		return err
+	}
+	retentionConfig := p.config.RetentionConfiguration()
+	domains, err := shared.GetDomains(ctx, p.db, p.config.ApplicationConfiguration())
+	if err != nil {
+		p.metrics.PruneFailures.Inc()
+		return err
+	}
+	now := p.now()
+	var errs = make([]error, 0)
+	for _, project := range projects {
+		for _, domain := range domains {
+			policy := retentionConfig.GetPolicy(project, domain.ID)
+			inputs := getListExpiredInputs(policy, project, domain.ID, now, retentionConfig.GetBatchSize())
+			for _, input := range inputs {
+				if err := p.pruneTable(ctx, input); err != nil {
+					logger.Warningf(ctx, "Failed to prune %s records for [%s/%s]: %v",
+						input.Table, project, domain.ID, err)
+					errs = append(errs, err)
+					// Records referencing those in later tables haven't all been pruned.
+					break
+				}
+			}
+			if err := p.pruneVersions(ctx, policy, project, domain.ID, now); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		p.metrics.PruneFailures.Inc()
+		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+	}
+	return nil
+}
+
+func (p *pruner) Run(ctx context.Context) {
+	logger.Debugf(ctx, "Running retention pruner")
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		err := p.Prune(ctx)
+		if err != nil {
+			logger.Warningf(ctx, "Failed retention pruning loop with: %v", err)
+		}
+	}, p.config.RetentionConfiguration().GetInterval())
+}
+
+func newMetrics(scope promutils.Scope) prunerMetrics {
+	return prunerMetrics{
+		Scope: scope,
+		PruneStarted: scope.MustNewCounter("prunes",
+			"overall count of the number of invocations of the retention pruner 'prune' method"),
+		PruneFailures: scope.MustNewCounter("prune_failures",
+			"overall count of invocations of the retention pruner which failed to prune some records"),
+		RowsArchived: scope.MustNewCounterVec("rows_archived",
+			"overall count of records copied to storage before being pruned", tableLabel),
+		RowsDeleted: scope.MustNewCounterVec("rows_deleted",
+			"overall count of records deleted once they outlived their retention period", tableLabel),
+		VersionsDeleted: scope.MustNewCounterVec("versions_deleted",
+			"overall count of registered versions deleted once they exceeded the number retained", tableLabel),
+		PruneDuration: scope.MustNewStopWatch("prune_duration",
+			"time taken to prune all expired records", time.Millisecond),
+		Panics: scope.MustNewCounter("panics",
+			"overall count of panics encountered in primary retention pruner loop"),
+	}
+}
+
+// Returns a Pruner which archives expired records to the given store before deleting them, when archiving is
+// enabled.
+func NewPruner(db repositories.RepositoryInterface, config runtimeInterfaces.Configuration,
+	store *storage.DataStore, scope promutils.Scope) Pruner {
+	var recordArchiver *archiver
+	if archiveConfig := config.RetentionConfiguration().GetArchiveConfig(); archiveConfig.Enabled {
+		recordArchiver = &archiver{
+			store:  store,
+			prefix: archiveConfig.Prefix,
+		}
+	}
+	return &pruner{
+		db:       db,
+		config:   config,
+		store:    store,
+		archiver: recordArchiver,
+		metrics:  newMetrics(scope),
+		now:      time.Now,
+	}
+}
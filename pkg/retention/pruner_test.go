@@ -0,0 +1,405 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	repositoryInterfaces "github.com/flyteorg/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytestdlib/contextutils"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/promutils/labeled"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const project = "project"
+const domain = "development"
+
+var now = time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+
+// Serves expired records from memory, removing them once deleted.
+type fakeRetentionRepo struct {
+	records map[string][]repositoryInterfaces.ExpiredRecord
+	inputs  []repositoryInterfaces.ListExpiredInput
+}
+
+func (r *fakeRetentionRepo) listExpired(
+	_ context.Context, input repositoryInterfaces.ListExpiredInput) []repositoryInterfaces.ExpiredRecord {
+	r.inputs = append(r.inputs, input)
+	records := r.records[input.Table]
+	if len(records) > input.Limit {
+		records = records[:input.Limit]
+	}
+	return records
+}
+
+func (r *fakeRetentionRepo) delete(_ context.Context, table string, ids []uint) int64 {
+	r.records[table] = r.records[table][len(ids):]
+	return int64(len(ids))
+}
+
+func newExpiredRecords(ids ...uint) []repositoryInterfaces.ExpiredRecord {
+	records := make([]repositoryInterfaces.ExpiredRecord, len(ids))
+	for i, id := range ids {
+		records[i] = repositoryInterfaces.ExpiredRecord{
+			ID: id,
+			Columns: map[string]interface{}{
+				"id":                id,
+				"execution_project": project,
+			},
+		}
+	}
+	return records
+}
+
+func getMockRepository(retentionRepo *repositoryMocks.RetentionRepoInterface) *repositoryMocks.MockRepository {
+	repository := repositoryMocks.NewMockRepository().(*repositoryMocks.MockRepository)
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListProjectsFunction = func(
+		ctx context.Context, input repositoryInterfaces.ListResourceInput) ([]models.Project, error) {
+		return []models.Project{{Identifier: project}}, nil
+	}
+	repository.RetentionRepoIface = retentionRepo
+	return repository
+}
+
+func getMockConfig(retentionConfig *runtimeMocks.MockRetentionConfiguration) runtimeInterfaces.Configuration {
+	applicationConfig := &runtimeMocks.MockApplicationProvider{}
+	applicationConfig.SetDomainsConfig(runtimeInterfaces.DomainsConfig{
+		{ID: domain, Name: domain},
+	})
+	config := runtimeMocks.NewMockConfigurationProvider(applicationConfig, nil, nil, nil, nil, nil)
+	config.(*runtimeMocks.MockConfigurationProvider).AddRetentionConfiguration(retentionConfig)
+	return config
+}
+
+func newFakeRetentionRepo(records map[string][]repositoryInterfaces.ExpiredRecord) (
+	*fakeRetentionRepo, *repositoryMocks.RetentionRepoInterface) {
+	fake := &fakeRetentionRepo{
+		records: records,
+	}
+	retentionRepo := &repositoryMocks.RetentionRepoInterface{}
+	retentionRepo.OnListExpiredMatch(mock.Anything, mock.Anything).Call.Return(fake.listExpired, nil)
+	retentionRepo.OnDeleteMatch(mock.Anything, mock.Anything, mock.Anything).Call.Return(fake.delete, nil)
+	return fake, retentionRepo
+}
+
+func init() {
+	labeled.SetMetricKeys(contextutils.AppNameKey)
+}
+
+func TestGetRetentionDays(t *testing.T) {
+	policy := runtimeInterfaces.RetentionPolicy{
+		ExecutionDays:     30,
+		NodeExecutionDays: 60,
+		EventDays:         7,
+	}
+	assert.Equal(t, 7, getRetentionDays(policy, repositoryInterfaces.ExecutionEventsTable))
+	assert.Equal(t, 7, getRetentionDays(policy, repositoryInterfaces.NodeExecutionEventsTable))
+	// Node executions can't be retained for longer than their executions.
+	assert.Equal(t, 30, getRetentionDays(policy, repositoryInterfaces.NodeExecutionsTable))
+	assert.Equal(t, 30, getRetentionDays(policy, repositoryInterfaces.TaskExecutionsTable))
+	assert.Equal(t, 30, getRetentionDays(policy, repositoryInterfaces.ExecutionsTable))
+	assert.Equal(t, 7, getRetentionDays(policy, repositoryInterfaces.RecordedEventsTable))
+	// Other records of executions are retained as long as their executions.
+	assert.Equal(t, 30, getRetentionDays(policy, repositoryInterfaces.ExecutionCommentsTable))
+	assert.Equal(t, 30, getRetentionDays(policy, repositoryInterfaces.OffloadedLiteralsTable))
+
+	policy = runtimeInterfaces.RetentionPolicy{
+		NodeExecutionDays: 14,
+	}
+	assert.Equal(t, 14, getRetentionDays(policy, repositoryInterfaces.NodeExecutionEventsTable))
+	assert.Equal(t, 14, getRetentionDays(policy, repositoryInterfaces.TaskExecutionsTable))
+	assert.Equal(t, 0, getRetentionDays(policy, repositoryInterfaces.ExecutionEventsTable))
+	assert.Equal(t, 0, getRetentionDays(policy, repositoryInterfaces.ExecutionsTable))
+}
+
+func TestGetListExpiredInputs(t *testing.T) {
+	now := time.Date(2021, time.September, 30, 0, 0, 0, 0, time.UTC)
+	inputs := getListExpiredInputs(runtimeInterfaces.RetentionPolicy{
+		ExecutionDays: 30,
+	}, "project", "domain", now, 10)
+	assert.Len(t, inputs, len(repositoryInterfaces.RetentionTables))
+	for _, input := range inputs {
+		assert.Equal(t, now.AddDate(0, 0, -30), input.CreatedBefore)
+		assert.True(t, input.DeletedBefore.IsZero())
+	}
+
+	inputs = getListExpiredInputs(runtimeInterfaces.RetentionPolicy{
+		DeletedDays: 7,
+	}, "project", "domain", now, 10)
+	assert.Len(t, inputs, len(repositoryInterfaces.PurgeTables))
+	for i, input := range inputs {
+		assert.Equal(t, repositoryInterfaces.PurgeTables[i], input.Table)
+		assert.Equal(t, "project", input.Project)
+		assert.Equal(t, "domain", input.Domain)
+		assert.Equal(t, now.AddDate(0, 0, -7), input.DeletedBefore)
+		assert.True(t, input.CreatedBefore.IsZero())
+		assert.Equal(t, 10, input.Limit)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	fake, retentionRepo := newFakeRetentionRepo(map[string][]repositoryInterfaces.ExpiredRecord{
+		repositoryInterfaces.ExecutionEventsTable: newExpiredRecords(1, 2, 3),
+		repositoryInterfaces.ExecutionsTable:      newExpiredRecords(4),
+	})
+	store, err := storage.NewDataStore(&storage.Config{
+		Type: storage.TypeMemory,
+	}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	config := getMockConfig(&runtimeMocks.MockRetentionConfiguration{
+		BatchSize: 2,
+		ArchiveConfig: runtimeInterfaces.RetentionArchiveConfig{
+			Enabled: true,
+			Prefix:  "archive",
+		},
+		DefaultPolicy: runtimeInterfaces.RetentionPolicy{
+			ExecutionDays: 30,
+			EventDays:     7,
+		},
+	})
+	retentionPruner := NewPruner(getMockRepository(retentionRepo), config, store, promutils.NewTestScope()).(*pruner)
+	retentionPruner.now = func() time.Time {
+		return now
+	}
+
+	err = retentionPruner.Prune(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, fake.records[repositoryInterfaces.ExecutionEventsTable])
+	assert.Empty(t, fake.records[repositoryInterfaces.ExecutionsTable])
+
+	// Execution events are listed in two batches, and every other table once.
+	assert.Len(t, fake.inputs, 6)
+	assert.Equal(t, repositoryInterfaces.ListExpiredInput{
+		Table:         repositoryInterfaces.ExecutionEventsTable,
+		Project:       project,
+		Domain:        domain,
+		CreatedBefore: now.AddDate(0, 0, -7),
+		Limit:         2,
+	}, fake.inputs[0])
+	assert.Equal(t, fake.inputs[0], fake.inputs[1])
+	assert.Equal(t, now.AddDate(0, 0, -7), fake.inputs[2].CreatedBefore)
+	assert.Equal(t, now.AddDate(0, 0, -30), fake.inputs[3].CreatedBefore)
+	assert.Equal(t, repositoryInterfaces.ExecutionsTable, fake.inputs[5].Table)
+	assert.Equal(t, now.AddDate(0, 0, -30), fake.inputs[5].CreatedBefore)
+
+	reader, err := store.ReadRaw(context.Background(),
+		storage.DataReference(store.GetBaseContainerFQN(context.Background())+"/archive/execution_events/project/development/1-2.jsonl"))
+	assert.NoError(t, err)
+	archived, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"execution_project\":\"project\",\"id\":1}\n{\"execution_project\":\"project\",\"id\":2}\n",
+		string(archived))
+
+	metrics := retentionPruner.metrics
+	assert.Equal(t, 3.0, testutil.ToFloat64(metrics.RowsArchived.WithLabelValues(repositoryInterfaces.ExecutionEventsTable)))
+	assert.Equal(t, 3.0, testutil.ToFloat64(metrics.RowsDeleted.WithLabelValues(repositoryInterfaces.ExecutionEventsTable)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.RowsDeleted.WithLabelValues(repositoryInterfaces.ExecutionsTable)))
+}
+
+func TestPrune_ArchivingDisabled(t *testing.T) {
+	fake, retentionRepo := newFakeRetentionRepo(map[string][]repositoryInterfaces.ExpiredRecord{
+		repositoryInterfaces.ExecutionsTable: newExpiredRecords(1),
+	})
+	config := getMockConfig(&runtimeMocks.MockRetentionConfiguration{
+		BatchSize: 10,
+		Policies: map[string]runtimeInterfaces.RetentionPolicy{
+			project + "/" + domain: {ExecutionDays: 1},
+		},
+	})
+	retentionPruner := NewPruner(getMockRepository(retentionRepo), config, nil, promutils.NewTestScope()).(*pruner)
+
+	err := retentionPruner.Prune(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, fake.records[repositoryInterfaces.ExecutionsTable])
+	assert.Equal(t, 0.0, testutil.ToFloat64(
+		retentionPruner.metrics.RowsArchived.WithLabelValues(repositoryInterfaces.ExecutionsTable)))
+}
+
+func TestPrune_NoPolicy(t *testing.T) {
+	retentionRepo := &repositoryMocks.RetentionRepoInterface{}
+	config := getMockConfig(&runtimeMocks.MockRetentionConfiguration{
+		BatchSize: 10,
+	})
+	retentionPruner := NewPruner(getMockRepository(retentionRepo), config, nil, promutils.NewTestScope())
+
+	err := retentionPruner.Prune(context.Background())
+	assert.NoError(t, err)
+	retentionRepo.AssertNotCalled(t, "ListExpired", mock.Anything, mock.Anything)
+}
+
+func TestPrune_DeleteFailure(t *testing.T) {
+	retentionRepo := &repositoryMocks.RetentionRepoInterface{}
+	retentionRepo.OnListExpiredMatch(mock.Anything, mock.Anything).Return(newExpiredRecords(1), nil)
+	retentionRepo.OnDeleteMatch(mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("foo"))
+	config := getMockConfig(&runtimeMocks.MockRetentionConfiguration{
+		BatchSize: 10,
+		DefaultPolicy: runtimeInterfaces.RetentionPolicy{
+			ExecutionDays: 30,
+		},
+	})
+	retentionPruner := NewPruner(getMockRepository(retentionRepo), config, nil, promutils.NewTestScope()).(*pruner)
+
+	err := retentionPruner.Prune(context.Background())
+	assert.EqualError(t, err, "foo")
+	// Later tables, with records which may be referenced by those which failed to be pruned, are skipped.
+	retentionRepo.AssertNumberOfCalls(t, "ListExpired", 1)
+	retentionRepo.AssertCalled(t, "Delete", mock.Anything, repositoryInterfaces.ExecutionEventsTable, []uint{1})
+	assert.Equal(t, 1.0, testutil.ToFloat64(retentionPruner.metrics.PruneFailures))
+}
+
+func TestGetListExcessVersionsInputs(t *testing.T) {
+	inputs := getListExcessVersionsInputs(runtimeInterfaces.RetentionPolicy{
+		WorkflowVersions:     10,
+		TaskVersions:         20,
+		VersionExecutionDays: 7,
+	}, "project", "domain", now, 100)
+	assert.Equal(t, []repositoryInterfaces.ListExcessVersionsInput{
+		{
+			Table:         repositoryInterfaces.WorkflowsTable,
+			Project:       "project",
+			Domain:        "domain",
+			MaxVersions:   10,
+			ExecutedAfter: now.AddDate(0, 0, -7),
+			Limit:         100,
+		},
+		{
+			Table:         repositoryInterfaces.TasksTable,
+			Project:       "project",
+			Domain:        "domain",
+			MaxVersions:   20,
+			ExecutedAfter: now.AddDate(0, 0, -7),
+			Limit:         100,
+		},
+	}, inputs)
+
+	// Versions referenced by any execution are retained by default.
+	inputs = getListExcessVersionsInputs(runtimeInterfaces.RetentionPolicy{
+		LaunchPlanVersions: 5,
+	}, "project", "domain", now, 100)
+	assert.Len(t, inputs, 1)
+	assert.Equal(t, repositoryInterfaces.LaunchPlansTable, inputs[0].Table)
+	assert.True(t, inputs[0].ExecutedAfter.IsZero())
+}
+
+func newVersionRecord(id uint, name, version string) repositoryInterfaces.ExpiredRecord {
+	return repositoryInterfaces.ExpiredRecord{
+		ID: id,
+		Columns: map[string]interface{}{
+			"id":           id,
+			shared.Project: project,
+			shared.Domain:  domain,
+			shared.Name:    name,
+			// Drivers may return text columns as bytes.
+			shared.Version: []byte(version),
+		},
+	}
+}
+
+func TestPrune_ExcessVersions(t *testing.T) {
+	ctx := context.Background()
+	store, err := storage.NewDataStore(&storage.Config{
+		Type: storage.TypeMemory,
+	}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	closureReference, err := store.ConstructReference(ctx, store.GetBaseContainerFQN(ctx), "workflow")
+	assert.NoError(t, err)
+	activeTaskID := &core.Identifier{
+		ResourceType: core.ResourceType_TASK,
+		Project:      project,
+		Domain:       domain,
+		Name:         "task",
+		Version:      "v1",
+	}
+	err = store.WriteProtobuf(ctx, closureReference, storage.Options{}, &admin.WorkflowClosure{
+		CompiledWorkflow: &core.CompiledWorkflowClosure{
+			Tasks: []*core.CompiledTask{
+				{Template: &core.TaskTemplate{Id: activeTaskID}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	repository := getMockRepository(&repositoryMocks.RetentionRepoInterface{})
+	workflowID := &core.Identifier{
+		ResourceType: core.ResourceType_WORKFLOW,
+		Project:      project,
+		Domain:       domain,
+		Name:         "workflow",
+		Version:      "v1",
+	}
+	spec, err := proto.Marshal(&admin.LaunchPlanSpec{WorkflowId: workflowID})
+	assert.NoError(t, err)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input repositoryInterfaces.ListResourceInput) (repositoryInterfaces.LaunchPlanCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 3)
+			return repositoryInterfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: []models.LaunchPlan{{Spec: spec}},
+			}, nil
+		})
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetGetCallback(
+		func(input repositoryInterfaces.Identifier) (models.Workflow, error) {
+			assert.Equal(t, workflowID.Name, input.Name)
+			return models.Workflow{
+				WorkflowKey:             models.WorkflowKey(input),
+				RemoteClosureIdentifier: closureReference.String(),
+			}, nil
+		})
+
+	retentionRepo := &repositoryMocks.RetentionRepoInterface{}
+	retentionRepo.OnListExcessVersionsMatch(mock.Anything, mock.MatchedBy(
+		func(input repositoryInterfaces.ListExcessVersionsInput) bool {
+			return input.Table == repositoryInterfaces.WorkflowsTable
+		})).Return(nil, nil)
+	retentionRepo.OnListExcessVersionsMatch(mock.Anything, mock.MatchedBy(
+		func(input repositoryInterfaces.ListExcessVersionsInput) bool {
+			return input.Table == repositoryInterfaces.TasksTable && input.AfterID == 0
+		})).Return([]repositoryInterfaces.ExpiredRecord{
+		newVersionRecord(1, "task", "v1"),
+		newVersionRecord(2, "task", "v2"),
+	}, nil)
+	retentionRepo.OnListExcessVersionsMatch(mock.Anything, mock.MatchedBy(
+		func(input repositoryInterfaces.ListExcessVersionsInput) bool {
+			return input.Table == repositoryInterfaces.TasksTable && input.AfterID == 2
+		})).Return([]repositoryInterfaces.ExpiredRecord{newVersionRecord(3, "task", "v3")}, nil)
+	retentionRepo.OnDeleteExcessVersionsMatch(mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ repositoryInterfaces.ListExcessVersionsInput, ids []uint) int64 {
+			return int64(len(ids))
+		}, nil)
+	repository.RetentionRepoIface = retentionRepo
+
+	config := getMockConfig(&runtimeMocks.MockRetentionConfiguration{
+		BatchSize: 2,
+		DefaultPolicy: runtimeInterfaces.RetentionPolicy{
+			WorkflowVersions: 5,
+			TaskVersions:     5,
+		},
+	})
+	retentionPruner := NewPruner(repository, config, store, promutils.NewTestScope()).(*pruner)
+	retentionPruner.now = func() time.Time {
+		return now
+	}
+
+	err = retentionPruner.Prune(ctx)
+	assert.NoError(t, err)
+	// The task used by the workflow of the active launch plan is kept.
+	retentionRepo.AssertCalled(t, "DeleteExcessVersions", mock.Anything, mock.Anything, []uint{2})
+	retentionRepo.AssertCalled(t, "DeleteExcessVersions", mock.Anything, mock.Anything, []uint{3})
+	retentionRepo.AssertNumberOfCalls(t, "DeleteExcessVersions", 2)
+	retentionRepo.AssertNotCalled(t, "ListExpired", mock.Anything, mock.Anything)
+	assert.Equal(t, 2.0, testutil.ToFloat64(
+		retentionPruner.metrics.VersionsDeleted.WithLabelValues(repositoryInterfaces.TasksTable)))
+}
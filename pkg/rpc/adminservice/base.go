@@ -2,45 +2,97 @@ package adminservice
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net/http"
 	"runtime/debug"
+	"time"
 
+	authConfig "github.com/flyteorg/flyteadmin/auth/config"
 	eventWriter "github.com/flyteorg/flyteadmin/pkg/async/events/implementations"
+	eventWriterInterfaces "github.com/flyteorg/flyteadmin/pkg/async/events/interfaces"
 
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/service"
 
+	"github.com/flyteorg/flyteadmin/pkg/leaderelection"
 	"github.com/flyteorg/flyteadmin/pkg/manager/impl/resources"
 
+	"github.com/flyteorg/flyteadmin/pkg/async/cloudevent"
+	"github.com/flyteorg/flyteadmin/pkg/async/lag"
 	"github.com/flyteorg/flyteadmin/pkg/async/notifications"
+	notificationImplementations "github.com/flyteorg/flyteadmin/pkg/async/notifications/implementations"
+	"github.com/flyteorg/flyteadmin/pkg/async/openlineage"
+	"github.com/flyteorg/flyteadmin/pkg/async/outbox"
 	"github.com/flyteorg/flyteadmin/pkg/async/schedule"
+	serverConfig "github.com/flyteorg/flyteadmin/pkg/config"
 	"github.com/flyteorg/flyteadmin/pkg/data"
 	executionCluster "github.com/flyteorg/flyteadmin/pkg/executioncluster/impl"
+	executionClusterInterfaces "github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/logging"
 	manager "github.com/flyteorg/flyteadmin/pkg/manager/impl"
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/executions"
 	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyteadmin/pkg/repositories"
 	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/runtime"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/tracing"
 	workflowengine "github.com/flyteorg/flyteadmin/pkg/workflowengine/impl"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/datacatalog"
+	"github.com/flyteorg/flytestdlib/config"
 	"github.com/flyteorg/flytestdlib/logger"
 	"github.com/flyteorg/flytestdlib/profutils"
 	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/flyteorg/flytestdlib/storage"
 	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 type AdminService struct {
 	service.UnimplementedAdminServiceServer
-	TaskManager          interfaces.TaskInterface
-	WorkflowManager      interfaces.WorkflowInterface
-	LaunchPlanManager    interfaces.LaunchPlanInterface
-	ExecutionManager     interfaces.ExecutionInterface
-	NodeExecutionManager interfaces.NodeExecutionInterface
-	TaskExecutionManager interfaces.TaskExecutionInterface
-	ProjectManager       interfaces.ProjectInterface
-	ResourceManager      interfaces.ResourceInterface
-	NamedEntityManager   interfaces.NamedEntityInterface
-	VersionManager       interfaces.VersionInterface
-	Metrics              AdminMetrics
+	TaskManager                     interfaces.TaskInterface
+	WorkflowManager                 interfaces.WorkflowInterface
+	LaunchPlanManager               interfaces.LaunchPlanInterface
+	ExecutionManager                interfaces.ExecutionInterface
+	NodeExecutionManager            interfaces.NodeExecutionInterface
+	TaskExecutionManager            interfaces.TaskExecutionInterface
+	EventManager                    interfaces.EventInterface
+	ProjectManager                  interfaces.ProjectInterface
+	ResourceManager                 interfaces.ResourceInterface
+	NamedEntityManager              interfaces.NamedEntityInterface
+	VersionManager                  interfaces.VersionInterface
+	BackfillManager                 interfaces.BackfillInterface
+	UsageManager                    interfaces.UsageInterface
+	MetricsManager                  interfaces.MetricsInterface
+	LineageManager                  interfaces.LineageInterface
+	WebhookManager                  interfaces.WebhookInterface
+	NotificationDeliveryManager     interfaces.NotificationDeliveryInterface
+	NotificationDigestManager       interfaces.NotificationDigestInterface
+	NotificationSubscriptionManager interfaces.NotificationSubscriptionInterface
+	ScheduleManager                 interfaces.ScheduleInterface
+	TriggerManager                  interfaces.TriggerInterface
+	LaunchPlanRolloutManager        interfaces.LaunchPlanRolloutInterface
+	ClusterPoolManager              interfaces.ClusterPoolInterface
+	ClusterResourceSyncManager      interfaces.ClusterResourceSyncInterface
+	QuotaManager                    interfaces.QuotaInterface
+	DomainManager                   interfaces.DomainInterface
+	ExecutionRoutingManager         interfaces.ExecutionRoutingInterface
+	APIUsageManager                 interfaces.APIUsageInterface
+	LaunchPlanStatsManager          interfaces.LaunchPlanStatsInterface
+	StatusManager                   interfaces.StatusInterface
+	DataProxyManager                interfaces.DataProxyInterface
+	SignalManager                   interfaces.SignalInterface
+	ExecutionCommentManager         interfaces.ExecutionCommentInterface
+	SavedViewManager                interfaces.SavedViewInterface
+	ExecutionIdentityManager        interfaces.ExecutionIdentityInterface
+	OAuthClientManager              interfaces.OAuthClientInterface
+	ConfigManager                   interfaces.ConfigInterface
+	FeatureFlagManager              interfaces.FeatureFlagInterface
+	CacheManager                    interfaces.CacheInterface
+	OrgManager                      interfaces.OrgInterface
+	Metrics                         AdminMetrics
 }
 
 // Intercepts all admin requests to handle panics during execution.
@@ -56,6 +108,43 @@ func (m *AdminService) interceptPanic(ctx context.Context, request proto.Message
 
 const defaultRetries = 3
 
+// Returns a client of the datacatalog service cached task outputs are looked up in, or nil when the lookups aren't
+// enabled. Connections are established lazily, so admin starts even while datacatalog is unavailable.
+func newDataCatalogClient(cfg runtimeInterfaces.DataCatalogConfig) (datacatalog.DataCatalogClient, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	transportCredentials := grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+	if cfg.Insecure {
+		transportCredentials = grpc.WithInsecure()
+	}
+	conn, err := grpc.Dial(cfg.Endpoint, transportCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return datacatalog.NewDataCatalogClient(conn), nil
+}
+
+// Calls process every interval on whichever replica leads its role, so that processes which act on every project run
+// once at a time however many replicas serve admin.
+func runPeriodicallyWhileLeading(electorFactory leaderelection.ElectorFactory, role, description string,
+	interval time.Duration, process func(ctx context.Context) error) {
+	elector, err := electorFactory.NewElector(role)
+	if err != nil {
+		logger.Errorf(context.Background(), "Failed to create the leader elector of [%s]", role)
+		panic(err)
+	}
+	go func() {
+		logger.Infof(context.Background(), "Started %s once elected the leader of [%s].", description, role)
+		leaderelection.RunPeriodicallyWhileLeading(context.Background(), elector, role, interval,
+			func(ctx context.Context) {
+				if err := process(ctx); err != nil {
+					logger.Warningf(ctx, "Failed %s with err: %v", description, err)
+				}
+			})
+	}()
+}
+
 func NewAdminServer(kubeConfig, master string) *AdminService {
 	configuration := runtime.NewConfigurationProvider()
 	applicationConfiguration := configuration.ApplicationConfiguration().GetTopLevelConfig()
@@ -72,45 +161,100 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 	}()
 
 	dbConfigValues := configuration.ApplicationConfiguration().GetDbConfig()
-	dbConfig := repositoryConfig.DbConfig{
-		BaseConfig: repositoryConfig.BaseConfig{
-			IsDebug: dbConfigValues.Debug,
-		},
-		Host:         dbConfigValues.Host,
-		Port:         dbConfigValues.Port,
-		DbName:       dbConfigValues.DbName,
-		User:         dbConfigValues.User,
-		Password:     dbConfigValues.Password,
-		ExtraOptions: dbConfigValues.ExtraOptions,
-	}
+	dbConfig := repositoryConfig.NewDbConfig(dbConfigValues)
 	db := repositories.GetRepository(
-		repositories.POSTGRES, dbConfig, adminScope.NewSubScope("database"))
+		repositories.GetRepoConfig(dbConfig), dbConfig, adminScope.NewSubScope("database"))
 	storeConfig := storage.GetConfig()
-	execCluster := executionCluster.GetExecutionCluster(
-		adminScope.NewSubScope("executor").NewSubScope("cluster"),
-		kubeConfig,
-		master,
-		configuration,
-		db)
-	workflowExecutor := workflowengine.NewFlytePropeller(
-		applicationConfiguration.GetRoleNameKey(),
-		execCluster,
-		adminScope.NewSubScope("executor").NewSubScope("flytepropeller"),
-		configuration.NamespaceMappingConfiguration(), applicationConfiguration.GetEventVersion())
-	logger.Info(context.Background(), "Successfully created a workflow executor engine")
+	// In sandbox mode admin is served without kubernetes, recording executions rather than launching them.
+	sandbox := serverConfig.GetConfig().Sandbox
+	executorConfig := applicationConfiguration.GetExecutorConfig()
+	var execCluster executionClusterInterfaces.ClusterInterface
+	if sandbox {
+		logger.Info(context.Background(), "Serving in sandbox mode, executions are recorded rather than launched")
+		execCluster = executionCluster.NewSandboxCluster()
+		executorConfig.Type = workflowengine.RecordingExecutor
+	} else {
+		execCluster = executionCluster.GetExecutionCluster(
+			adminScope.NewSubScope("executor").NewSubScope("cluster"),
+			kubeConfig,
+			master,
+			configuration,
+			db)
+	}
+	// The background processes elect their leaders when leader election is enabled, and otherwise run on every replica.
+	electionConfig := serverConfig.GetConfig().LeaderElection
+	if sandbox {
+		// Kubernetes leases aren't available in sandbox mode.
+		electionConfig.LockType = serverConfig.LeaderElectionLockTypeDatabase
+	}
+	electorFactory, err := leaderelection.NewElectorFactory(electionConfig, kubeConfig, master, dbConfig,
+		adminScope.NewSubScope("leader_election"))
+	if err != nil {
+		logger.Error(context.Background(), "Failed to create the leader electors")
+		panic(err)
+	}
+	healthCheckConfig := configuration.ClusterConfiguration().GetHealthCheckConfig()
+	if healthCheckConfig.Enabled && !sandbox {
+		clusterHealthProber := executionCluster.NewClusterHealthProber(execCluster, db, healthCheckConfig,
+			adminScope.NewSubScope("executor").NewSubScope("cluster_health"))
+		runPeriodicallyWhileLeading(electorFactory, leaderelection.RoleClusterHealth,
+			"probing the health of execution clusters", healthCheckConfig.Interval.Duration,
+			clusterHealthProber.ProbeClusters)
+	}
 	dataStorageClient, err := storage.NewDataStore(storeConfig, adminScope.NewSubScope("storage"))
 	if err != nil {
 		logger.Error(context.Background(), "Failed to initialize storage config")
 		panic(err)
 	}
+	if serverConfig.GetConfig().Tracing.Enabled {
+		dataStorageClient.ComposedProtobufStore = tracing.NewStore(dataStorageClient.ComposedProtobufStore)
+	}
+	workflowExecutor, err := workflowengine.NewExecutor(workflowengine.ExecutorDependencies{
+		RoleNameKey:            applicationConfiguration.GetRoleNameKey(),
+		ExecutionCluster:       execCluster,
+		Scope:                  adminScope.NewSubScope("executor"),
+		NamespaceMappingConfig: configuration.NamespaceMappingConfiguration(),
+		EventVersion:           applicationConfiguration.GetEventVersion(),
+		Config:                 executorConfig,
+		DataStore:              dataStorageClient,
+	})
+	if err != nil {
+		logger.Error(context.Background(), "Failed to create the workflow executor")
+		panic(err)
+	}
+	logger.Info(context.Background(), "Successfully created a workflow executor engine")
 
+	if err := lag.Initialize(adminScope.NewSubScope("async")); err != nil {
+		logger.Error(context.Background(), "Failed to register the async processor lag metrics")
+		panic(err)
+	}
 	publisher := notifications.NewNotificationsPublisher(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
-	processor := notifications.NewNotificationsProcessor(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
+	processor := notifications.NewNotificationsProcessor(*configuration.ApplicationConfiguration().GetNotificationsConfig(), db, adminScope)
 	eventPublisher := notifications.NewEventsPublisher(*configuration.ApplicationConfiguration().GetExternalEventsConfig(), adminScope)
+	if cloudEventsConfig := configuration.ApplicationConfiguration().GetCloudEventsConfig(); cloudEventsConfig.Enable {
+		eventPublisher = notificationImplementations.NewCompositePublisher(eventPublisher,
+			cloudevent.NewCloudEventsPublisher(*cloudEventsConfig, adminScope))
+	}
+	if lineageConfig := applicationConfiguration.GetLineageConfig(); lineageConfig.Enabled && lineageConfig.OpenLineage.Enabled {
+		eventPublisher = notificationImplementations.NewCompositePublisher(eventPublisher,
+			openlineage.NewPublisher(lineageConfig.OpenLineage, adminScope))
+	}
 	go func() {
 		logger.Info(context.Background(), "Started processing notifications.")
 		processor.StartProcessing()
 	}()
+	if outboxConfig := applicationConfiguration.GetOutboxConfig(); outboxConfig.Enabled {
+		relay := outbox.NewRelay(db, outboxConfig, publisher, eventPublisher, adminScope.NewSubScope("outbox"))
+		relayElector, err := electorFactory.NewElector(leaderelection.RoleOutbox)
+		if err != nil {
+			logger.Error(context.Background(), "Failed to create the outbox relay leader elector")
+			panic(err)
+		}
+		go func() {
+			logger.Info(context.Background(), "Started relaying outbox messages.")
+			leaderelection.RunWhileLeading(context.Background(), relayElector, leaderelection.RoleOutbox, relay.Run)
+		}()
+	}
 
 	// Configure workflow scheduler async processes.
 	schedulerConfig := configuration.ApplicationConfiguration().GetSchedulerConfig()
@@ -144,11 +288,62 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 	go func() {
 		executionEventWriter.Run()
 	}()
+	var recordedEventWriter eventWriterInterfaces.RecordedEventWriter
+	if applicationConfiguration.GetEventHistoryConfig().Enabled {
+		recordedEventWriter = eventWriter.NewRecordedEventWriter(db, applicationConfiguration.GetAsyncEventsBufferSize())
+		go func() {
+			recordedEventWriter.Run()
+		}()
+	}
 
 	executionManager := manager.NewExecutionManager(db, configuration, dataStorageClient, workflowExecutor,
 		adminScope.NewSubScope("execution_manager"), adminScope.NewSubScope("user_execution_metrics"),
-		publisher, urlData, workflowManager, namedEntityManager, eventPublisher, executionEventWriter)
+		publisher, urlData, workflowManager, namedEntityManager, eventPublisher, executionEventWriter,
+		recordedEventWriter)
 	versionManager := manager.NewVersionManager()
+	runPeriodicallyWhileLeading(electorFactory, leaderelection.RoleExecutionDispatcher, "dispatching pending executions",
+		applicationConfiguration.GetAdmissionConfig().Interval.Duration, executionManager.DispatchPendingExecutions)
+
+	if offloadingConfig := applicationConfiguration.GetLiteralOffloadingConfig(); offloadingConfig.Enabled {
+		remoteDataDeleter, err := data.GetRemoteDataDeleter(storeConfig)
+		if err != nil {
+			logger.Error(context.Background(), "Failed to configure deleting offloaded inputs")
+			panic(err)
+		}
+		offloadedInputsCollector := executions.NewOffloadedInputsCollector(db, configuration, remoteDataDeleter,
+			adminScope.NewSubScope("offloaded_inputs"))
+		runPeriodicallyWhileLeading(electorFactory, leaderelection.RoleOffloadedInputs,
+			"collecting orphaned offloaded inputs", offloadingConfig.CollectionInterval.Duration,
+			offloadedInputsCollector.Collect)
+	}
+
+	backfillManager := manager.NewBackfillManager(db, configuration, executionManager,
+		adminScope.NewSubScope("backfill_manager"))
+	runPeriodicallyWhileLeading(electorFactory, leaderelection.RoleBackfill, "advancing backfills",
+		applicationConfiguration.GetBackfillConfig().Interval.Duration, backfillManager.AdvanceBackfills)
+
+	domainManager := manager.NewDomainManager(db, configuration)
+	if err := domainManager.SeedDomains(context.Background()); err != nil {
+		// The configured domains are used until they're seeded.
+		logger.Warningf(context.Background(), "Failed to seed the configured domains with err: %v", err)
+	}
+
+	projectManager := manager.NewProjectManager(db, configuration)
+	runPeriodicallyWhileLeading(electorFactory, leaderelection.RoleProjectPurge, "advancing project purges",
+		applicationConfiguration.GetProjectPurgeConfig().Interval.Duration, projectManager.AdvanceProjectPurges)
+
+	launchPlanRolloutManager := manager.NewLaunchPlanRolloutManager(db, configuration, launchPlanManager,
+		adminScope.NewSubScope("launch_plan_rollout_manager"))
+	runPeriodicallyWhileLeading(electorFactory, leaderelection.RoleRollout, "advancing launch plan rollouts",
+		applicationConfiguration.GetRolloutConfig().Interval.Duration, launchPlanRolloutManager.AdvanceRollouts)
+
+	notificationDigestManager := manager.NewNotificationDigestManager(db, configuration, publisher,
+		adminScope.NewSubScope("notification_digest_manager"))
+	digestsConfig := configuration.ApplicationConfiguration().GetNotificationsConfig().NotificationsDigestsConfig
+	if len(digestsConfig.Subscriptions) > 0 {
+		runPeriodicallyWhileLeading(electorFactory, leaderelection.RoleDigest, "sending notification digests",
+			digestsConfig.CheckInterval.Duration, notificationDigestManager.SendDueDigests)
+	}
 
 	scheduledWorkflowExecutor := workflowScheduler.GetWorkflowExecutor(executionManager, launchPlanManager)
 	logger.Info(context.Background(), "Successfully initialized a new scheduled workflow executor")
@@ -157,34 +352,119 @@ func NewAdminServer(kubeConfig, master string) *AdminService {
 		scheduledWorkflowExecutor.Run()
 	}()
 
+	statusManager := manager.NewStatusManager(db)
+
+	configReloader := runtime.NewConfigReloader(config.GetRootSection(), adminScope.NewSubScope("config"))
+	go func() {
+		logger.Info(context.Background(), "Started reconciling config reloads.")
+		wait.Forever(func() {
+			configReloader.Reconcile(context.Background())
+		}, applicationConfiguration.GetConfigReloadConfig().Interval.Duration)
+	}()
+	configManager := manager.NewConfigManager(configReloader)
+
+	// Clients are only registered through code embedding admin, since flyteidl has no RPCs for client registration.
+	oauthClientManager := manager.NewOAuthClientManager(db, authConfig.GetConfig().AppAuth.SelfAuthServer.StaticClients,
+		authConfig.GetConfig().Authorization.OAuthClientAdminRole)
+
+	dataCatalogClient, err := newDataCatalogClient(applicationConfiguration.GetDataCatalogConfig())
+	if err != nil {
+		logger.Panicf(context.Background(), "Failed to connect to datacatalog with err: %v", err)
+	}
+
 	// Serve profiling endpoints.
 	go func() {
 		err := profutils.StartProfilingServerWithDefaultHandlers(
-			context.Background(), applicationConfiguration.GetProfilerPort(), nil)
+			context.Background(), applicationConfiguration.GetProfilerPort(),
+			map[string]http.Handler{
+				logging.LevelsPath: http.HandlerFunc(logging.HandleLevels),
+				StatusPath:         newStatusHandler(statusManager),
+				ConfigPath:         newConfigHandler(configManager),
+				lag.LagPath:        http.HandlerFunc(lag.HandleLag),
+			})
 		if err != nil {
 			logger.Panicf(context.Background(), "Failed to Start profiling and Metrics server. Error, %v", err)
 		}
 	}()
 
+	apiUsageManager := manager.NewAPIUsageManager(db, adminScope.NewSubScope("api_usage_manager"))
+	if apiUsageConfig := applicationConfiguration.GetAPIUsageConfig(); apiUsageConfig.Enabled {
+		go func() {
+			logger.Info(context.Background(), "Started persisting api usage.")
+			wait.Forever(func() {
+				if err := apiUsageManager.Flush(context.Background()); err != nil {
+					logger.Warningf(context.Background(), "Failed to persist api usage with err: %v", err)
+				}
+			}, apiUsageConfig.FlushInterval.Duration)
+		}()
+	}
+
+	launchPlanStatsManager := manager.NewLaunchPlanStatsManager(db, configuration,
+		adminScope.NewSubScope("launch_plan_stats"))
+	if launchPlanStatsConfig := applicationConfiguration.GetLaunchPlanStatsConfig(); launchPlanStatsConfig.Enabled {
+		runPeriodicallyWhileLeading(electorFactory, leaderelection.RoleLaunchPlanStats, "computing launch plan stats",
+			launchPlanStatsConfig.Interval.Duration, launchPlanStatsManager.ComputeStats)
+	}
+
+	var usageProvider executions.UsageProvider
+	if applicationConfiguration.GetUsageConfig().Enabled {
+		usageProvider = executions.NewRequestedResourcesUsageProvider(db, configuration)
+	}
+
 	nodeExecutionEventWriter := eventWriter.NewNodeExecutionEventWriter(db, applicationConfiguration.GetAsyncEventsBufferSize())
 	go func() {
 		nodeExecutionEventWriter.Run()
 	}()
+	nodeExecutionManager := manager.NewNodeExecutionManager(db, configuration, applicationConfiguration.GetMetadataStoragePrefix(), dataStorageClient,
+		adminScope.NewSubScope("node_execution_manager"), urlData, eventPublisher, nodeExecutionEventWriter,
+		recordedEventWriter)
+	taskExecutionManager := manager.NewTaskExecutionManager(db, configuration, dataStorageClient,
+		adminScope.NewSubScope("task_execution_manager"), urlData, eventPublisher, usageProvider,
+		recordedEventWriter)
 
 	logger.Info(context.Background(), "Initializing a new AdminService")
 	return &AdminService{
 		TaskManager: manager.NewTaskManager(db, configuration, workflowengine.NewCompiler(),
 			adminScope.NewSubScope("task_manager")),
-		WorkflowManager:    workflowManager,
-		LaunchPlanManager:  launchPlanManager,
-		ExecutionManager:   executionManager,
-		NamedEntityManager: namedEntityManager,
-		VersionManager:     versionManager,
-		NodeExecutionManager: manager.NewNodeExecutionManager(db, configuration, applicationConfiguration.GetMetadataStoragePrefix(), dataStorageClient,
-			adminScope.NewSubScope("node_execution_manager"), urlData, eventPublisher, nodeExecutionEventWriter),
-		TaskExecutionManager: manager.NewTaskExecutionManager(db, configuration, dataStorageClient,
-			adminScope.NewSubScope("task_execution_manager"), urlData, eventPublisher),
-		ProjectManager:  manager.NewProjectManager(db, configuration),
+		WorkflowManager:                 workflowManager,
+		LaunchPlanManager:               launchPlanManager,
+		ExecutionManager:                executionManager,
+		NamedEntityManager:              namedEntityManager,
+		VersionManager:                  versionManager,
+		BackfillManager:                 backfillManager,
+		UsageManager:                    manager.NewUsageManager(db),
+		MetricsManager:                  manager.NewMetricsManager(db),
+		LineageManager:                  manager.NewLineageManager(db),
+		WebhookManager:                  manager.NewWebhookManager(db, configuration),
+		NotificationDeliveryManager:     manager.NewNotificationDeliveryManager(db, publisher),
+		NotificationDigestManager:       notificationDigestManager,
+		NotificationSubscriptionManager: manager.NewNotificationSubscriptionManager(db, configuration),
+		ScheduleManager:                 manager.NewScheduleManager(db),
+		TriggerManager:                  manager.NewTriggerManager(db, configuration, executionManager, launchPlanManager),
+		LaunchPlanRolloutManager:        launchPlanRolloutManager,
+		ClusterPoolManager:              manager.NewClusterPoolManager(db, configuration),
+		ClusterResourceSyncManager:      manager.NewClusterResourceSyncManager(db, configuration),
+		QuotaManager:                    manager.NewQuotaManager(db, configuration),
+		DomainManager:                   domainManager,
+		ExecutionRoutingManager:         manager.NewExecutionRoutingManager(db, configuration, execCluster),
+		APIUsageManager:                 apiUsageManager,
+		LaunchPlanStatsManager:          launchPlanStatsManager,
+		StatusManager:                   statusManager,
+		DataProxyManager:                manager.NewDataProxyManager(db, configuration, urlData),
+		SignalManager:                   manager.NewSignalManager(db, configuration),
+		ExecutionCommentManager:         manager.NewExecutionCommentManager(db),
+		SavedViewManager:                manager.NewSavedViewManager(db, executionManager, workflowManager),
+		ExecutionIdentityManager:        manager.NewExecutionIdentityManager(db, configuration.ApplicationConfiguration()),
+		OAuthClientManager:              oauthClientManager,
+		ConfigManager:                   configManager,
+		FeatureFlagManager:              manager.NewFeatureFlagManager(db, configuration.ApplicationConfiguration()),
+		CacheManager:                    manager.NewCacheManager(db, configuration, dataCatalogClient),
+		OrgManager:                      manager.NewOrgManager(db, configuration),
+		NodeExecutionManager:            nodeExecutionManager,
+		TaskExecutionManager:            taskExecutionManager,
+		EventManager: manager.NewEventManager(db, configuration, nodeExecutionManager, taskExecutionManager,
+			adminScope.NewSubScope("event_manager")),
+		ProjectManager:  projectManager,
 		ResourceManager: resources.NewResourceManager(db, configuration.ApplicationConfiguration()),
 		Metrics:         InitMetrics(adminScope),
 	}
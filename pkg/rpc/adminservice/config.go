@@ -0,0 +1,34 @@
+package adminservice
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// The path the effective config is served on by the profiling server, next to the status.
+const ConfigPath = "/debug/config"
+
+// Returns a handler serving the config admin runs with as JSON, with secrets redacted.
+func newConfigHandler(configManager interfaces.ConfigInterface) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "the config is read with a GET", http.StatusMethodNotAllowed)
+			return
+		}
+		effectiveConfig, err := configManager.GetEffectiveConfig(ctx)
+		if err != nil {
+			logger.Errorf(ctx, "failed to get the effective config with err: %v", err)
+			http.Error(w, "failed to get the config", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(effectiveConfig); err != nil {
+			logger.Warningf(ctx, "failed to write the config with err: %v", err)
+		}
+	}
+}
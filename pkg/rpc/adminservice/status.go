@@ -0,0 +1,34 @@
+package adminservice
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// The path the status is served on by the profiling server, next to the pprof endpoints.
+const StatusPath = "/debug/status"
+
+// Returns a handler serving the status of admin as JSON.
+func newStatusHandler(statusManager interfaces.StatusInterface) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "the status is read with a GET", http.StatusMethodNotAllowed)
+			return
+		}
+		status, err := statusManager.GetStatus(ctx)
+		if err != nil {
+			logger.Errorf(ctx, "failed to get the status with err: %v", err)
+			http.Error(w, "failed to get the status", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			logger.Warningf(ctx, "failed to write the status with err: %v", err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	flyteAdminErrors "github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestHandleFireTrigger(t *testing.T) {
+	var fired interfaces.FireTriggerRequest
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		triggerManager: &mocks.TriggerManager{
+			FireTriggerFunc: func(ctx context.Context, request interfaces.FireTriggerRequest) (
+				*core.WorkflowExecutionIdentifier, error) {
+				fired = request
+				return &core.WorkflowExecutionIdentifier{
+					Project: request.Id.Project,
+					Domain:  request.Id.Domain,
+					Name:    "launched",
+				}, nil
+			},
+		},
+	})
+
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/triggers/project/domain/partition-landed",
+		strings.NewReader(`{"table": "events"}`))
+	request.Header.Set("X-Flyte-Signature", "sha256=signature")
+	request.Header.Set("X-Flyte-Event-Id", "event-1")
+	request.Header.Set("X-Flyte-Timestamp", "1635724800")
+	response := httptest.NewRecorder()
+	mockServer.HandleFireTrigger(response, request)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.JSONEq(t, `{"project": "project", "domain": "domain", "name": "launched"}`, response.Body.String())
+	assert.Equal(t, "partition-landed", fired.Id.Name)
+	assert.Equal(t, `{"table": "events"}`, string(fired.Payload))
+	assert.Equal(t, "sha256=signature", fired.Signature)
+	assert.Equal(t, "event-1", fired.EventId)
+	assert.Equal(t, "1635724800", fired.Timestamp)
+}
+
+func TestHandleFireTrigger_Errors(t *testing.T) {
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		triggerManager: &mocks.TriggerManager{
+			FireTriggerFunc: func(ctx context.Context, request interfaces.FireTriggerRequest) (
+				*core.WorkflowExecutionIdentifier, error) {
+				return nil, flyteAdminErrors.NewFlyteAdminError(codes.Unauthenticated, "invalid payload signature")
+			},
+		},
+	})
+
+	for _, test := range []struct {
+		method string
+		path   string
+		code   int
+	}{
+		{method: http.MethodGet, path: "/api/v1/triggers/project/domain/partition-landed", code: http.StatusMethodNotAllowed},
+		{method: http.MethodPost, path: "/api/v1/triggers/project/domain", code: http.StatusNotFound},
+		{method: http.MethodPost, path: "/api/v1/triggers/project/domain/partition-landed", code: http.StatusUnauthorized},
+	} {
+		response := httptest.NewRecorder()
+		mockServer.HandleFireTrigger(response, httptest.NewRequest(test.method, test.path, strings.NewReader("{}")))
+		assert.Equal(t, test.code, response.Code, test.path)
+	}
+}
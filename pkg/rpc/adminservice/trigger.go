@@ -0,0 +1,90 @@
+package adminservice
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// Event sources fire a trigger by posting a payload to this path followed by the trigger's project, domain and
+	// name, e.g. /api/v1/triggers/flytesnacks/development/partition-landed.
+	TriggerPathPrefix = "/api/v1/triggers/"
+	// The header holding the signature of the timestamp, event id and payload.
+	triggerSignatureHeader = "X-Flyte-Signature"
+	// The header identifying the event, so that redelivering it doesn't launch another execution.
+	triggerEventIDHeader = "X-Flyte-Event-Id"
+	// The header holding when the payload was signed, in seconds since the epoch.
+	triggerTimestampHeader = "X-Flyte-Timestamp"
+	// Payloads larger than this are rejected.
+	maxTriggerPayloadBytes = 1 << 20
+)
+
+type fireTriggerResponse struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Name    string `json:"name"`
+}
+
+// Fires the trigger a payload is posted to, responding with the identifier of the execution launched. Requests aren't
+// authenticated as a user, since only event sources which know the trigger's secret can sign its payloads.
+func (m *AdminService) HandleFireTrigger(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "triggers are fired with a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	path := strings.Split(strings.TrimPrefix(r.URL.Path, TriggerPathPrefix), "/")
+	if len(path) != 3 {
+		http.NotFound(w, r)
+		return
+	}
+	payload, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxTriggerPayloadBytes))
+	if err != nil {
+		http.Error(w, "payloads are limited to 1MiB", http.StatusRequestEntityTooLarge)
+		return
+	}
+	executionID, err := m.TriggerManager.FireTrigger(ctx, interfaces.FireTriggerRequest{
+		Id: admin.NamedEntityIdentifier{
+			Project: path[0],
+			Domain:  path[1],
+			Name:    path[2],
+		},
+		Payload:   payload,
+		Signature: r.Header.Get(triggerSignatureHeader),
+		EventId:   r.Header.Get(triggerEventIDHeader),
+		Timestamp: r.Header.Get(triggerTimestampHeader),
+	})
+	if err != nil {
+		code := codes.Internal
+		if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok {
+			code = flyteAdminErr.Code()
+		}
+		message := err.Error()
+		if code == codes.Internal {
+			logger.Errorf(ctx, "failed to fire trigger [%s] with err: %v", strings.Join(path, "/"), err)
+			message = "failed to fire trigger"
+		}
+		http.Error(w, message, runtime.HTTPStatusFromCode(code))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(fireTriggerResponse{
+		Project: executionID.Project,
+		Domain:  executionID.Domain,
+		Name:    executionID.Name,
+	}); err != nil {
+		logger.Warningf(ctx, "failed to write the response to firing trigger [%s] with err: %v",
+			strings.Join(path, "/"), err)
+	}
+}
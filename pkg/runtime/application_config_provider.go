@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
@@ -18,6 +19,7 @@ const remoteData = "remoteData"
 const notifications = "notifications"
 const domains = "domains"
 const externalEvents = "externalEvents"
+const cloudEvents = "cloudEvents"
 
 const postgres = "postgres"
 
@@ -25,11 +27,18 @@ const KB = 1024
 const MB = KB * KB
 
 var databaseConfig = config.MustRegisterSection(database, &interfaces.DbConfigSection{
-	Port:         5432,
-	User:         postgres,
-	Host:         postgres,
-	DbName:       postgres,
-	ExtraOptions: "sslmode=disable",
+	Port:               5432,
+	User:               postgres,
+	Host:               postgres,
+	DbName:             postgres,
+	ExtraOptions:       "sslmode=disable",
+	MaxOpenConnections: 100,
+	MaxIdleConnections: 10,
+	ConnMaxLifeTime:    config.Duration{Duration: time.Hour},
+	Cache: interfaces.RepositoryCacheConfig{
+		Size: 10000,
+		TTL:  config.Duration{Duration: 5 * time.Minute},
+	},
 })
 var flyteAdminConfig = config.MustRegisterSection(flyteAdmin, &interfaces.ApplicationConfig{
 	ProfilerPort:          10254,
@@ -38,6 +47,72 @@ var flyteAdminConfig = config.MustRegisterSection(flyteAdmin, &interfaces.Applic
 	EventVersion:          2,
 	AsyncEventsBufferSize: 100,
 	MaxParallelism:        25,
+	Outbox: interfaces.OutboxConfig{
+		Interval:           config.Duration{Duration: 5 * time.Second},
+		BatchSize:          100,
+		Lease:              config.Duration{Duration: time.Minute},
+		DeadLetterAttempts: 10,
+	},
+	Admission: interfaces.AdmissionConfig{
+		Interval:  config.Duration{Duration: 10 * time.Second},
+		BatchSize: 100,
+	},
+	Backfill: interfaces.BackfillConfig{
+		Interval:       config.Duration{Duration: 30 * time.Second},
+		MaxExecutions:  1000,
+		MaxParallelism: 100,
+	},
+	Rollout: interfaces.RolloutConfig{
+		Interval: config.Duration{Duration: time.Minute},
+	},
+	Executor: interfaces.ExecutorConfig{
+		Retry: interfaces.ExecutorRetryConfig{
+			Attempts: 3,
+			Delay:    config.Duration{Duration: 200 * time.Millisecond},
+			MaxDelay: config.Duration{Duration: 2 * time.Second},
+		},
+		CircuitBreaker: interfaces.CircuitBreakerConfig{
+			FailureThreshold: 5,
+			OpenDuration:     config.Duration{Duration: 30 * time.Second},
+		},
+		Offloading: interfaces.WorkflowOffloadingConfig{
+			ThresholdBytes:     1 * MB,
+			WarnThresholdBytes: 1 * MB,
+		},
+	},
+	APIUsage: interfaces.APIUsageConfig{
+		FlushInterval: config.Duration{Duration: time.Minute},
+	},
+	LaunchPlanStats: interfaces.LaunchPlanStatsConfig{
+		Interval: config.Duration{Duration: time.Minute},
+		Windows: []config.Duration{
+			{Duration: time.Hour},
+			{Duration: 24 * time.Hour},
+		},
+	},
+	DataProxy: interfaces.DataProxyConfig{
+		MaxSizeBytes:         100 * 1024 * 1024,
+		MaxWorkflowSpecBytes: 100 * 1024 * 1024,
+	},
+	DataCatalog: interfaces.DataCatalogConfig{
+		Timeout: config.Duration{Duration: 10 * time.Second},
+	},
+	LiteralOffloading: interfaces.LiteralOffloadingConfig{
+		MinSizeBytes:       10 * KB,
+		OrphanGracePeriod:  config.Duration{Duration: time.Hour},
+		CollectionInterval: config.Duration{Duration: 10 * time.Minute},
+	},
+	ProjectPurge: interfaces.ProjectPurgeConfig{
+		Interval:   config.Duration{Duration: 30 * time.Second},
+		BatchSize:  1000,
+		MaxBatches: 10,
+	},
+	ConfigReload: interfaces.ConfigReloadConfig{
+		Interval: config.Duration{Duration: 5 * time.Second},
+	},
+	WorkflowClosureOffloading: interfaces.WorkflowClosureOffloadingConfig{
+		ThresholdBytes: 64 * KB,
+	},
 })
 
 var schedulerConfig = config.MustRegisterSection(scheduler, &interfaces.SchedulerConfig{
@@ -57,11 +132,15 @@ var schedulerConfig = config.MustRegisterSection(scheduler, &interfaces.Schedule
 	},
 })
 var remoteDataConfig = config.MustRegisterSection(remoteData, &interfaces.RemoteDataConfig{
-	Scheme:         common.None,
-	MaxSizeInBytes: 2 * MB,
+	Scheme:                     common.None,
+	MaxSizeInBytes:             2 * MB,
+	MaxPartialFetchSizeInBytes: 100 * MB,
 })
 var notificationsConfig = config.MustRegisterSection(notifications, &interfaces.NotificationsConfig{
 	Type: common.Local,
+	NotificationsDigestsConfig: interfaces.NotificationsDigestsConfig{
+		CheckInterval: config.Duration{Duration: time.Minute},
+	},
 })
 var domainsConfig = config.MustRegisterSection(domains, &interfaces.DomainsConfig{
 	{
@@ -80,6 +159,9 @@ var domainsConfig = config.MustRegisterSection(domains, &interfaces.DomainsConfi
 var externalEventsConfig = config.MustRegisterSection(externalEvents, &interfaces.ExternalEventsConfig{
 	Type: common.Local,
 })
+var cloudEventsConfig = config.MustRegisterSection(cloudEvents, &interfaces.CloudEventsConfig{
+	Source: "flyteadmin",
+})
 
 // Implementation of an interfaces.ApplicationConfiguration
 type ApplicationConfigurationProvider struct{}
@@ -107,6 +189,16 @@ func (p *ApplicationConfigurationProvider) GetDbConfig() interfaces.DbConfig {
 		Password:     password,
 		ExtraOptions: dbConfigSection.ExtraOptions,
 		Debug:        dbConfigSection.Debug,
+		SQLite:       dbConfigSection.SQLite,
+
+		MaxOpenConnections: dbConfigSection.MaxOpenConnections,
+		MaxIdleConnections: dbConfigSection.MaxIdleConnections,
+		ConnMaxLifeTime:    dbConfigSection.ConnMaxLifeTime,
+		QueryTimeout:       dbConfigSection.QueryTimeout,
+		SlowQueryThreshold: dbConfigSection.SlowQueryThreshold,
+		AutoMigrate:        dbConfigSection.AutoMigrate,
+		Cache:              dbConfigSection.Cache,
+		Encryption:         dbConfigSection.Encryption,
 	}
 }
 
@@ -134,6 +226,10 @@ func (p *ApplicationConfigurationProvider) GetExternalEventsConfig() *interfaces
 	return externalEventsConfig.GetConfig().(*interfaces.ExternalEventsConfig)
 }
 
+func (p *ApplicationConfigurationProvider) GetCloudEventsConfig() *interfaces.CloudEventsConfig {
+	return cloudEventsConfig.GetConfig().(*interfaces.CloudEventsConfig)
+}
+
 func NewApplicationConfigurationProvider() interfaces.ApplicationConfiguration {
 	return &ApplicationConfigurationProvider{}
 }
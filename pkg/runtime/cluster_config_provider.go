@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"time"
 
 	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
 
@@ -12,7 +13,22 @@ import (
 
 const clustersKey = "clusters"
 
-var clusterConfig = config.MustRegisterSection(clustersKey, &interfaces.Clusters{})
+var clusterConfig = config.MustRegisterSection(clustersKey, &interfaces.Clusters{
+	HealthCheck: interfaces.ClusterHealthCheckConfig{
+		Interval: config.Duration{
+			Duration: 30 * time.Second,
+		},
+		Timeout: config.Duration{
+			Duration: 5 * time.Second,
+		},
+		FailureThreshold:        3,
+		PropellerLeaseNamespace: "flyte",
+		PropellerLeaseName:      "propeller-leader",
+		PropellerHeartbeatTimeout: config.Duration{
+			Duration: 2 * time.Minute,
+		},
+	},
+})
 
 // Implementation of an interfaces.ClusterConfiguration
 type ClusterConfigurationProvider struct{}
@@ -26,6 +42,15 @@ func (p *ClusterConfigurationProvider) GetLabelClusterMap() map[string][]interfa
 	return make(map[string][]interfaces.ClusterEntity)
 }
 
+func (p *ClusterConfigurationProvider) GetHealthCheckConfig() interfaces.ClusterHealthCheckConfig {
+	if clusterConfig != nil {
+		clusters := clusterConfig.GetConfig().(*interfaces.Clusters)
+		return clusters.HealthCheck
+	}
+	logger.Warningf(context.Background(), "Failed to find clusters in config. Returning an empty health check config")
+	return interfaces.ClusterHealthCheckConfig{}
+}
+
 func (p *ClusterConfigurationProvider) GetClusterConfigs() []interfaces.ClusterConfig {
 	if clusterConfig != nil {
 		clusters := clusterConfig.GetConfig().(*interfaces.Clusters)
@@ -15,6 +15,12 @@ var clusterResourceConfig = config.MustRegisterSection(clusterResourceKey, &inte
 		Duration: time.Minute,
 	},
 	CustomData: make(map[interfaces.DomainName]interfaces.TemplateData),
+	NamespaceCleanup: interfaces.NamespaceCleanupConfig{
+		GracePeriod: config.Duration{
+			Duration: 7 * 24 * time.Hour,
+		},
+		Mode: interfaces.NamespaceCleanupModeCordon,
+	},
 })
 
 // Implementation of an interfaces.ClusterResourceConfiguration
@@ -36,6 +42,14 @@ func (p *ClusterResourceConfigurationProvider) GetCustomTemplateData() map[inter
 	return clusterResourceConfig.GetConfig().(*interfaces.ClusterResourceConfig).CustomData
 }
 
+func (p *ClusterResourceConfigurationProvider) GetDriftDetection() bool {
+	return clusterResourceConfig.GetConfig().(*interfaces.ClusterResourceConfig).DriftDetection
+}
+
+func (p *ClusterResourceConfigurationProvider) GetNamespaceCleanupConfig() interfaces.NamespaceCleanupConfig {
+	return clusterResourceConfig.GetConfig().(*interfaces.ClusterResourceConfig).NamespaceCleanup
+}
+
 func NewClusterResourceConfigurationProvider() interfaces.ClusterResourceConfiguration {
 	return &ClusterResourceConfigurationProvider{}
 }
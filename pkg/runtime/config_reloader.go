@@ -0,0 +1,185 @@
+package runtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const loggerKey = "logger"
+
+// The top-level sections whose values are read each time they're used, so that changes to them take effect without a
+// restart. The notifications publisher and processor are still created from the notifications section admin started
+// with, but the email templates are read each time an email is sent. Subsections are reloaded along with their
+// sections.
+var reloadableSections = sets.NewString(
+	queuesKey,
+	taskResourceKey,
+	notifications,
+	registration,
+	whitelistKey,
+	qualityOfServiceKey,
+	retentionKey,
+	loggerKey,
+)
+
+// Writes the config of a section and of its subsections in the order of their keys, so that the same config always
+// hashes to the same checksum.
+func writeConfig(w io.Writer, key string, section config.Section) error {
+	serialized, err := json.Marshal(section.GetConfig())
+	if err != nil {
+		return fmt.Errorf("failed to serialize the config of section [%s]: %v", key, err)
+	}
+	if _, err := fmt.Fprintf(w, "%s=%s\n", key, serialized); err != nil {
+		return err
+	}
+	sections := section.GetSections()
+	keys := make([]string, 0, len(sections))
+	for subsectionKey := range sections {
+		keys = append(keys, subsectionKey)
+	}
+	sort.Strings(keys)
+	for _, subsectionKey := range keys {
+		if err := writeConfig(w, key+"."+subsectionKey, sections[subsectionKey]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns a checksum of the config of a section and of all its subsections.
+func GetConfigChecksum(section config.Section) (string, error) {
+	hash := sha256.New()
+	if err := writeConfig(hash, "", section); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Adds the sections which aren't safe to reload below section to pinned, keyed by their dot-separated paths, along
+// with the configs they hold now.
+func pinSections(path string, section config.Section, pinned map[string]pinnedSection) {
+	for key, subsection := range section.GetSections() {
+		subsectionPath := key
+		if len(path) > 0 {
+			subsectionPath = path + "." + key
+		}
+		if reloadableSections.Has(strings.SplitN(subsectionPath, ".", 2)[0]) {
+			continue
+		}
+		if subsection.GetConfig() != nil {
+			pinned[subsectionPath] = pinnedSection{
+				section: subsection,
+				config:  subsection.GetConfig(),
+			}
+		}
+		pinSections(subsectionPath, subsection, pinned)
+	}
+}
+
+type pinnedSection struct {
+	section config.Section
+	// The config the section held when admin started.
+	config config.Config
+}
+
+type configReloaderMetrics struct {
+	Scope promutils.Scope
+	// The version of the config in use.
+	Version prometheus.Gauge
+	// Counts the versions loaded after the first.
+	Reloads prometheus.Counter
+	// Counts the changes to sections which aren't safe to reload, which were reverted.
+	RevertedChanges prometheus.Counter
+}
+
+// The config files are watched, and re-read when they change, by the flytestdlib config accessor, which replaces the
+// config of every section. ConfigReloader puts back the configs the sections which are only read at start up began
+// with, so that admin never runs with a mix of old and new values for them.
+type ConfigReloader struct {
+	root   config.Section
+	pinned map[string]pinnedSection
+	// The paths of the pinned sections whose changes were reverted.
+	pendingRestart sets.String
+	version        interfaces.ConfigVersion
+	mutex          sync.RWMutex
+	metrics        configReloaderMetrics
+	_clock         clock.Clock
+}
+
+func (r *ConfigReloader) Reconcile(ctx context.Context) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for path, pinned := range r.pinned {
+		if config.DeepEqual(pinned.section.GetConfig(), pinned.config) {
+			continue
+		}
+		logger.Warningf(ctx, "Config section [%s] changed, but it's only read at start up. The change takes effect "+
+			"when admin is restarted.", path)
+		if err := pinned.section.SetConfig(pinned.config); err != nil {
+			logger.Errorf(ctx, "Failed to revert the change to config section [%s] with err: %v", path, err)
+			continue
+		}
+		r.pendingRestart.Insert(path)
+		r.metrics.RevertedChanges.Inc()
+	}
+	r.version.PendingRestart = r.pendingRestart.List()
+
+	checksum, err := GetConfigChecksum(r.root)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to compute the checksum of the config with err: %v", err)
+		return
+	}
+	if checksum == r.version.Checksum {
+		return
+	}
+	if r.version.Version > 0 {
+		r.metrics.Reloads.Inc()
+	}
+	r.version.Version++
+	r.version.Checksum = checksum
+	r.version.LoadedAt = r._clock.Now()
+	r.metrics.Version.Set(float64(r.version.Version))
+	logger.Infof(ctx, "Loaded version [%d] of the config with checksum [%s]", r.version.Version, checksum)
+}
+
+func (r *ConfigReloader) GetVersion() interfaces.ConfigVersion {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.version
+}
+
+// Returns a ConfigReloader for the config root holds now, which must be called after the config files are first read.
+func NewConfigReloader(root config.Section, scope promutils.Scope) interfaces.ConfigReloader {
+	reloader := &ConfigReloader{
+		root:           root,
+		pinned:         make(map[string]pinnedSection),
+		pendingRestart: sets.NewString(),
+		metrics: configReloaderMetrics{
+			Scope:   scope,
+			Version: scope.MustNewGauge("version", "The version of the config in use"),
+			Reloads: scope.MustNewCounter("reloads", "The number of times a changed config was loaded"),
+			RevertedChanges: scope.MustNewCounter("reverted_changes",
+				"The number of changes to config sections which are only read at start up"),
+		},
+		_clock: clock.New(),
+	}
+	pinSections("", root, reloader.pinned)
+	reloader.Reconcile(context.Background())
+	return reloader
+}
@@ -0,0 +1,76 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type reloaderTestConfig struct {
+	Value string `json:"value"`
+}
+
+func newReloaderTestRoot() (root, queues, server, serverGrpc config.Section) {
+	root = config.NewSection(nil, nil)
+	queues = root.MustRegisterSection(queuesKey, &reloaderTestConfig{Value: "a"})
+	server = root.MustRegisterSection("server", &reloaderTestConfig{Value: "b"})
+	serverGrpc = server.MustRegisterSection("grpc", &reloaderTestConfig{Value: "c"})
+	return root, queues, server, serverGrpc
+}
+
+func TestConfigReloader(t *testing.T) {
+	root, queues, server, serverGrpc := newReloaderTestRoot()
+	reloader := NewConfigReloader(root, promutils.NewTestScope())
+	started := reloader.GetVersion()
+	assert.Equal(t, int64(1), started.Version)
+	assert.Len(t, started.Checksum, 64)
+	assert.Empty(t, started.PendingRestart)
+
+	// Nothing changed.
+	reloader.Reconcile(context.Background())
+	assert.Equal(t, started, reloader.GetVersion())
+
+	// The config accessor replaces the configs of sections with copies when the config files change.
+	assert.NoError(t, queues.SetConfig(&reloaderTestConfig{Value: "a2"}))
+	assert.NoError(t, serverGrpc.SetConfig(&reloaderTestConfig{Value: "c2"}))
+	reloader.Reconcile(context.Background())
+	reloaded := reloader.GetVersion()
+	assert.Equal(t, int64(2), reloaded.Version)
+	assert.NotEqual(t, started.Checksum, reloaded.Checksum)
+	assert.Equal(t, []string{"server.grpc"}, reloaded.PendingRestart)
+	assert.Equal(t, "a2", queues.GetConfig().(*reloaderTestConfig).Value)
+	assert.Equal(t, "b", server.GetConfig().(*reloaderTestConfig).Value)
+	assert.Equal(t, "c", serverGrpc.GetConfig().(*reloaderTestConfig).Value)
+}
+
+func TestConfigReloader_OnlyUnsafeChanges(t *testing.T) {
+	root, _, server, _ := newReloaderTestRoot()
+	reloader := NewConfigReloader(root, promutils.NewTestScope())
+	started := reloader.GetVersion()
+
+	assert.NoError(t, server.SetConfig(&reloaderTestConfig{Value: "b2"}))
+	reloader.Reconcile(context.Background())
+	reconciled := reloader.GetVersion()
+	// Reverting the change leaves the config, and so its version, as it was.
+	assert.Equal(t, started.Version, reconciled.Version)
+	assert.Equal(t, started.Checksum, reconciled.Checksum)
+	assert.Equal(t, []string{"server"}, reconciled.PendingRestart)
+	assert.Equal(t, "b", server.GetConfig().(*reloaderTestConfig).Value)
+}
+
+func TestGetConfigChecksum(t *testing.T) {
+	root, queues, _, _ := newReloaderTestRoot()
+	checksum, err := GetConfigChecksum(root)
+	assert.NoError(t, err)
+	unchanged, err := GetConfigChecksum(root)
+	assert.NoError(t, err)
+	assert.Equal(t, checksum, unchanged)
+
+	assert.NoError(t, queues.SetConfig(&reloaderTestConfig{Value: "a2"}))
+	changed, err := GetConfigChecksum(root)
+	assert.NoError(t, err)
+	assert.NotEqual(t, checksum, changed)
+}
@@ -25,19 +25,82 @@ type DbConfigSection struct {
 	ExtraOptions string `json:"options"`
 	// Whether or not to start the database connection with debug mode enabled.
 	Debug bool `json:"debug"`
+	// The maximum number of open connections to the database. Zero means unlimited.
+	MaxOpenConnections int `json:"maxOpenConnections"`
+	// The maximum number of connections kept idle in the pool. Zero means no idle connections are retained.
+	MaxIdleConnections int `json:"maxIdleConnections"`
+	// The maximum amount of time a connection may be reused. Zero means connections are reused forever.
+	ConnMaxLifeTime config.Duration `json:"connMaxLifeTime"`
+	// If set, postgres aborts any statement that takes longer than this. SQLite doesn't support statement timeouts, so
+	// it's ignored there.
+	QueryTimeout config.Duration `json:"queryTimeout"`
+	// If set, statements taking longer than this are logged, without their parameters.
+	SlowQueryThreshold config.Duration `json:"slowQueryThreshold"`
+	// If set, data is stored in a local SQLite database instead of postgres and all other connection settings are
+	// ignored. Intended for single-binary local sandbox deployments, which must be built with CGO_ENABLED=1.
+	SQLite SQLiteConfig `json:"sqlite"`
+	// If set, serve runs pending migrations before it starts serving, and refuses to start against a database migrated
+	// by a newer version of admin.
+	AutoMigrate bool `json:"autoMigrate"`
+	// Caches workflow, task and launch plan gets in memory.
+	Cache RepositoryCacheConfig `json:"cache"`
+	// Encrypts sensitive fields, such as execution specs, before they're stored.
+	Encryption EncryptionConfig `json:"encryption"`
+}
+
+type RepositoryCacheConfig struct {
+	Enabled bool `json:"enabled"`
+	// The maximum number of entries cached for each of the workflow, task and launch plan repositories.
+	Size int `json:"size"`
+	// How long entries are cached for. Launch plan updates made through other admin replicas aren't observed until
+	// their cached entries expire.
+	TTL config.Duration `json:"ttl"`
+}
+
+// Configures the keys sensitive fields are encrypted with. Each stored field is encrypted with a data key of its own,
+// which is wrapped with the key of the project or org it belongs to.
+type EncryptionConfig struct {
+	Enabled bool `json:"enabled"`
+	// The paths of the files holding each base64 encoded 256-bit key, by key id. Keys which were rotated out must
+	// remain configured until every field they wrapped data keys of is rotated.
+	KeyFiles map[string]string `json:"keyFiles"`
+	// The id of the key data keys are wrapped with, unless their project or org has a key of its own.
+	DefaultKeyID string `json:"defaultKeyId"`
+	// The ids of the keys data keys of each org's projects are wrapped with, by org.
+	OrgKeyIDs map[string]string `json:"orgKeyIds"`
+	// The ids of the keys data keys of each project are wrapped with, by project. These take precedence over the
+	// keys of orgs.
+	ProjectKeyIDs map[string]string `json:"projectKeyIds"`
+}
+
+type SQLiteConfig struct {
+	// The path to the SQLite database file. It's created if it doesn't exist.
+	File string `json:"file"`
 }
 
 // This represents a configuration used for initiating database connections much like DbConfigSection, however the
 // password is *resolved* in this struct and therefore it is used as the value the runtime provider returns to callers
 // requesting the database config.
 type DbConfig struct {
-	Host         string `json:"host"`
-	Port         int    `json:"port"`
-	DbName       string `json:"dbname"`
-	User         string `json:"username"`
-	Password     string `json:"password"`
-	ExtraOptions string `json:"options"`
-	Debug        bool   `json:"debug"`
+	Host         string       `json:"host"`
+	Port         int          `json:"port"`
+	DbName       string       `json:"dbname"`
+	User         string       `json:"username"`
+	Password     string       `json:"password"`
+	ExtraOptions string       `json:"options"`
+	Debug        bool         `json:"debug"`
+	SQLite       SQLiteConfig `json:"sqlite"`
+
+	MaxOpenConnections int             `json:"maxOpenConnections"`
+	MaxIdleConnections int             `json:"maxIdleConnections"`
+	ConnMaxLifeTime    config.Duration `json:"connMaxLifeTime"`
+	QueryTimeout       config.Duration `json:"queryTimeout"`
+	SlowQueryThreshold config.Duration `json:"slowQueryThreshold"`
+	AutoMigrate        bool            `json:"autoMigrate"`
+
+	Cache RepositoryCacheConfig `json:"cache"`
+
+	Encryption EncryptionConfig `json:"encryption"`
 }
 
 // This configuration is the base configuration to start admin
@@ -61,6 +124,575 @@ type ApplicationConfig struct {
 	// This is useful to achieve fairness. Note: MapTasks are regarded as one unit,
 	// and parallelism/concurrency of MapTasks is independent from this.
 	MaxParallelism int32 `json:"maxParallelism"`
+	// Configures the outbox workflow execution notifications and events are published through.
+	Outbox OutboxConfig `json:"outbox"`
+	// Configures how many executions each project and domain may run at once.
+	Admission AdmissionConfig `json:"admission"`
+	// Configures how backfills of scheduled launch plans are run.
+	Backfill BackfillConfig `json:"backfill"`
+	// Configures how launch plan rollout policies activate new versions.
+	Rollout RolloutConfig `json:"rollout"`
+	// Configures the priority classes executions may be launched with.
+	Priority PriorityConfig `json:"priority"`
+	// Configures recording the resources task executions use.
+	Usage UsageConfig `json:"usage"`
+	// Configures how duplicate and out-of-order events are handled.
+	EventReconciliation EventReconciliationConfig `json:"eventReconciliation"`
+	// Configures keeping the history of every event received.
+	EventHistory EventHistoryConfig `json:"eventHistory"`
+	// Configures recording the lineage of executions and the data they read and wrote.
+	Lineage LineageConfig `json:"lineage"`
+	// Configures how executions are launched in execution clusters.
+	Executor ExecutorConfig `json:"executor"`
+	// Configures recording how each caller uses the admin API.
+	APIUsage APIUsageConfig `json:"apiUsage"`
+	// Configures computing the success rate and duration of each launch plan's executions.
+	LaunchPlanStats LaunchPlanStatsConfig `json:"launchPlanStats"`
+	// Configures signing URLs for the inputs, outputs and decks of executions.
+	DataProxy DataProxyConfig `json:"dataProxy"`
+	// Configures keeping the inputs of executions out of the database.
+	LiteralOffloading LiteralOffloadingConfig `json:"literalOffloading"`
+	// Configures the log links generated for task executions.
+	TaskLogs TaskLogsConfig `json:"taskLogs"`
+	// Configures how archived projects are purged.
+	ProjectPurge ProjectPurgeConfig `json:"projectPurge"`
+	// Configures how new executions of deprecated workflows and tasks are handled.
+	Deprecation DeprecationConfig `json:"deprecation"`
+	// Configures who can set the signals gate nodes of executions wait on.
+	Signals SignalsConfig `json:"signals"`
+	// Configures applying changes to the config files without a restart.
+	ConfigReload ConfigReloadConfig `json:"configReload"`
+	// Configures the values feature flags take unless they're overridden at runtime.
+	FeatureFlags FeatureFlagsConfig `json:"featureFlags"`
+	// Configures how executions are named when requests don't name them, and which names requests may set.
+	ExecutionNaming ExecutionNamingConfig `json:"executionNaming"`
+	// Configures looking up the outputs tasks cached in datacatalog.
+	DataCatalog DataCatalogConfig `json:"dataCatalog"`
+	// Configures which compiled workflow closures are offloaded to blob storage.
+	WorkflowClosureOffloading WorkflowClosureOffloadingConfig `json:"workflowClosureOffloading"`
+}
+
+// When enabled, workflow execution notifications and events are written to an outbox in the same transaction as the
+// execution updates they belong to, and a relay publishes them once committed. Messages are published at least once,
+// so consumers may see duplicates. Node and task execution events don't go through the outbox: they're still published
+// directly, at most once.
+type OutboxConfig struct {
+	Enabled bool `json:"enabled"`
+	// How often the relay checks the outbox for unpublished messages.
+	Interval config.Duration `json:"interval"`
+	// The maximum number of messages the relay claims at once.
+	BatchSize int `json:"batchSize"`
+	// How long a relay holds the messages it claims for. Messages which fail to publish are retried once their lease
+	// expires.
+	Lease config.Duration `json:"lease"`
+	// Messages which failed to publish this many times are dead lettered: they're kept in the outbox, but no longer
+	// retried. Zero retries messages until they're published.
+	DeadLetterAttempts int `json:"deadLetterAttempts"`
+}
+
+// Overrides the default limit on running executions. An empty project or domain matches all projects or domains
+// respectively.
+type ProjectDomainAdmissionLimit struct {
+	Project              string `json:"project"`
+	Domain               string `json:"domain"`
+	MaxRunningExecutions int    `json:"maxRunningExecutions"`
+}
+
+// When enabled, executions created while their project and domain run as many executions as they're allowed to are
+// held as pending, and a dispatcher launches them in the order they were created as running executions terminate.
+// Executions launched by other executions are never held, since their parents would wait on them while taking up the
+// slots they need. Single task executions aren't held either.
+// For example:
+/*
+	flyteadmin:
+	  admission:
+	    enabled: true
+	    defaultMaxRunningExecutions: 500
+	    limits:
+	      - project: flytesnacks
+	        domain: development
+	        maxRunningExecutions: 20
+*/
+type AdmissionConfig struct {
+	Enabled bool `json:"enabled"`
+	// How often the dispatcher checks for pending executions which can be launched. The dispatcher also launches
+	// executions scheduled to run at a later time, so it runs even when admission control isn't enabled.
+	Interval config.Duration `json:"interval"`
+	// The maximum number of pending executions the dispatcher lists at once.
+	BatchSize int `json:"batchSize"`
+	// The number of executions each project and domain may run at once. Zero values don't limit executions.
+	DefaultMaxRunningExecutions int                           `json:"defaultMaxRunningExecutions"`
+	Limits                      []ProjectDomainAdmissionLimit `json:"limits"`
+}
+
+// Returns the limit configured for a project and domain, preferring limits for both over those for just the project,
+// and those over limits for just the domain.
+func (c AdmissionConfig) GetMaxRunningExecutions(project, domain string) int {
+	maxRunningExecutions := c.DefaultMaxRunningExecutions
+	bestScore := 0
+	for _, candidate := range c.Limits {
+		if score := getProjectDomainMatchScore(candidate.Project, candidate.Domain, project, domain); score > bestScore {
+			maxRunningExecutions = candidate.MaxRunningExecutions
+			bestScore = score
+		}
+	}
+	return maxRunningExecutions
+}
+
+// Scores how specifically a candidate project and domain, either of which may be empty to match any, match a project
+// and domain. Candidates which don't match score zero.
+func getProjectDomainMatchScore(candidateProject, candidateDomain, project, domain string) int {
+	if (len(candidateProject) > 0 && candidateProject != project) ||
+		(len(candidateDomain) > 0 && candidateDomain != domain) {
+		return 0
+	}
+	score := 1
+	if len(candidateProject) > 0 {
+		score += 2
+	}
+	if len(candidateDomain) > 0 {
+		score++
+	}
+	return score
+}
+
+// Backfills launch an execution of a scheduled launch plan for each time it was scheduled in a range, running a few
+// at a time.
+type BackfillConfig struct {
+	// How often running backfills launch their next executions and check whether they're done.
+	Interval config.Duration `json:"interval"`
+	// The maximum number of executions a single backfill may launch.
+	MaxExecutions int `json:"maxExecutions"`
+	// The maximum number of executions a single backfill may run at once.
+	MaxParallelism int `json:"maxParallelism"`
+}
+
+// Rollout policies activate newly registered launch plan versions picked by a label or semantic version constraint
+// once they've soaked.
+type RolloutConfig struct {
+	// How often launch plans with a rollout policy are checked for versions to activate.
+	Interval config.Duration `json:"interval"`
+}
+
+// Overrides the default priority class. An empty project or domain matches all projects or domains respectively.
+type ProjectDomainPriority struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Class   string `json:"class"`
+}
+
+// Priority classes let urgent executions be preferred over others. An execution's class is taken from the
+// flyte-priority request metadata, the flyte.org/priority annotation of its spec or else its launch plan's, or the
+// default for its project and domain, in that order. It's applied to the workflow CRD as the flyte.org/priority label,
+// and pending executions are dispatched in class order. Priorities aren't set when no classes are configured.
+// For example:
+/*
+	flyteadmin:
+	  priority:
+	    classes:
+	      - urgent
+	      - standard
+	      - batch
+	    defaultClass: standard
+	    defaults:
+	      - project: flytesnacks
+	        domain: development
+	        class: batch
+*/
+type PriorityConfig struct {
+	// Ordered from the most to the least urgent. Class names must be valid Kubernetes label values.
+	Classes      []string                `json:"classes"`
+	DefaultClass string                  `json:"defaultClass"`
+	Defaults     []ProjectDomainPriority `json:"defaults"`
+}
+
+// Returns the default class configured for a project and domain, preferring defaults for both over those for just the
+// project, and those over defaults for just the domain.
+func (c PriorityConfig) GetDefaultClass(project, domain string) string {
+	class := c.DefaultClass
+	bestScore := 0
+	for _, candidate := range c.Defaults {
+		if score := getProjectDomainMatchScore(candidate.Project, candidate.Domain, project, domain); score > bestScore {
+			class = candidate.Class
+			bestScore = score
+		}
+	}
+	return class
+}
+
+// Returns whether class is one of the configured classes.
+func (c PriorityConfig) IsClass(class string) bool {
+	for _, candidate := range c.Classes {
+		if candidate == class {
+			return true
+		}
+	}
+	return false
+}
+
+// When enabled, the resources each task execution used are recorded once it terminates, so that usage can be reported
+// for each execution and for each project and domain over time. Usage is estimated from the resources a task's
+// container requests and how long it ran for.
+type UsageConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+// When enabled, the calls, errors and latency of each RPC are aggregated per day for each caller and project, and
+// periodically added to the totals persisted in the database, so that usage can be reported across replicas.
+type APIUsageConfig struct {
+	Enabled bool `json:"enabled"`
+	// How often the usage aggregated in memory is persisted. Usage not yet persisted is lost when admin exits.
+	FlushInterval config.Duration `json:"flushInterval"`
+}
+
+// When enabled, the success rate and duration percentiles of each launch plan's executions are periodically computed
+// over rolling windows, exported as gauges and reported by GetLaunchPlanStats, so that pipeline SLOs can be alerted on.
+// For example:
+/*
+	flyteadmin:
+	  launchPlanStats:
+	    enabled: true
+	    windows: [1h, 6h, 72h]
+	    objectives:
+	      - project: flytesnacks
+	        name: daily_report
+	        successRate: 0.99
+*/
+type LaunchPlanStatsConfig struct {
+	Enabled bool `json:"enabled"`
+	// How often the stats are recomputed.
+	Interval config.Duration `json:"interval"`
+	// The rolling windows stats are computed over, each ending when they're computed.
+	Windows []config.Duration `json:"windows"`
+	// The success rates launch plans are expected to meet, against which the rate their error budget burns is computed.
+	Objectives []LaunchPlanObjective `json:"objectives"`
+}
+
+// When enabled, the data proxy signs URLs for the inputs, outputs and decks of executions with the signing configured
+// under remoteData, so that they can be downloaded without credentials for the bucket they're stored in. URLs are valid
+// for remoteData.signedUrls.durationMinutes.
+type DataProxyConfig struct {
+	Enabled bool `json:"enabled"`
+	// Artifacts larger than this aren't signed. Zero signs artifacts of any size.
+	MaxSizeBytes int64 `json:"maxSizeBytes"`
+	// Where files uploaded through the data proxy, such as fast registered code packages, are stored, e.g.
+	// s3://my-bucket/uploads. Each upload is scoped to <uploadPrefix>/<project>/<domain>/<content md5>/. Uploads aren't
+	// signed when empty.
+	UploadPrefix string `json:"uploadPrefix"`
+	// Workflow specs registered from parts uploaded under uploadPrefix, which may exceed the gRPC message size limit,
+	// are assembled in memory and rejected once they exceed this size. Zero allows specs of any size.
+	MaxWorkflowSpecBytes int64 `json:"maxWorkflowSpecBytes"`
+}
+
+// When enabled, admin looks up the outputs tasks cached in the datacatalog service propeller caches them in.
+type DataCatalogConfig struct {
+	Enabled bool `json:"enabled"`
+	// The host and port of the datacatalog gRPC service, e.g. datacatalog:89.
+	Endpoint string `json:"endpoint"`
+	// Connects without TLS, e.g. to datacatalog in the same cluster.
+	Insecure bool `json:"insecure"`
+	// How long each lookup waits for datacatalog to respond.
+	Timeout config.Duration `json:"timeout"`
+}
+
+// When enabled, the deprecated inline inputs of execution specs and closures are dropped from the database once they're
+// offloaded to blob storage, where GetExecutionData reads them from. Inputs are offloaded before their execution is
+// created, so the offloaded inputs of executions which failed to be created are periodically deleted.
+type LiteralOffloadingConfig struct {
+	Enabled bool `json:"enabled"`
+	// Inline inputs which serialize to at least this many bytes are dropped.
+	MinSizeBytes int64 `json:"minSizeBytes"`
+	// How long after being offloaded inputs whose execution doesn't exist are deleted.
+	OrphanGracePeriod config.Duration `json:"orphanGracePeriod"`
+	// How often orphaned inputs are collected.
+	CollectionInterval config.Duration `json:"collectionInterval"`
+}
+
+// When enabled, only compiled workflow closures which serialize to at least ThresholdBytes are offloaded to blob storage
+// on CreateWorkflow, and smaller ones are stored in the workflows table. Otherwise every closure is offloaded. Closures
+// are read back from wherever they were stored, so changing this only affects workflows created afterwards.
+type WorkflowClosureOffloadingConfig struct {
+	Enabled        bool  `json:"enabled"`
+	ThresholdBytes int64 `json:"thresholdBytes"`
+}
+
+// Log links are generated for task executions from URI templates, so that links such as those to CloudWatch or
+// Stackdriver are configured once in admin rather than in each flytepropeller plugin. Templates are filled in as by
+// flyteplugins' template log plugin, from the generated name of the task execution's pod and the namespace of its
+// project and domain: {{ .podName }}, {{ .namespace }}, {{ .containerName }}, {{ .logName }}, {{ .podUnixStartTime }}
+// and {{ .podUnixFinishTime }}.
+// For example:
+/*
+	flyteadmin:
+	  taskLogs:
+	    templates:
+	      - displayName: CloudWatch Logs
+	        templateUris:
+	          - "https://console.aws.amazon.com/cloudwatch/home?region=us-east-1#logEventViewer:group=/flyte;stream=var.log.containers.{{ .podName }}_{{ .namespace }}_{{ .containerName }}"
+	        messageFormat: JSON
+	        clusters: [aws-us-east-1]
+	      - displayName: Spark UI
+	        templateUris:
+	          - "https://spark-history.example.com/history/{{ .podName }}"
+	        taskTypes: [spark]
+*/
+type TaskLogsConfig struct {
+	Templates []TaskLogTemplate `json:"templates"`
+}
+
+type TaskLogTemplate struct {
+	DisplayName  string   `json:"displayName"`
+	TemplateURIs []string `json:"templateUris"`
+	// One of UNKNOWN, CSV or JSON.
+	MessageFormat string `json:"messageFormat"`
+	// When set, the template only applies to task executions of these task types.
+	TaskTypes []string `json:"taskTypes"`
+	// When set, the template only applies to task executions which ran in these execution clusters.
+	Clusters []string `json:"clusters"`
+}
+
+// Returns whether the template applies to task executions of a task type which ran in a cluster.
+func (t TaskLogTemplate) Matches(taskType, cluster string) bool {
+	return (len(t.TaskTypes) == 0 || containsString(t.TaskTypes, taskType)) &&
+		(len(t.Clusters) == 0 || containsString(t.Clusters, cluster))
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Purges hard delete an archived project along with everything registered and executed in it, a batch of records at a
+// time so that large projects don't hold long-running transactions.
+type ProjectPurgeConfig struct {
+	// How often running purges delete their next batches of records.
+	Interval config.Duration `json:"interval"`
+	// The maximum number of records deleted from a table at once.
+	BatchSize int `json:"batchSize"`
+	// The maximum number of batches a single purge deletes each interval.
+	MaxBatches int `json:"maxBatches"`
+}
+
+// Deprecated workflows and tasks keep running their existing executions and schedules. New executions of them are
+// created with a warning unless rejected.
+type DeprecationConfig struct {
+	// Fails new executions of deprecated workflows and tasks rather than warning about them.
+	RejectExecutions bool `json:"rejectExecutions"`
+}
+
+// Signals, such as the approval of an approve-before-deploy gate, are set by callers who can view the project of the
+// execution waiting on them.
+type SignalsConfig struct {
+	// Further restricts setting signals to callers holding one of these auth roles, unless empty.
+	ApproverRoles []string `json:"approverRoles"`
+}
+
+// The config files are re-read when they change. Changes to the sections which are read each time they're used, such
+// as queues and task_resources, take effect right away. Changes to the others are reverted until admin is restarted.
+type ConfigReloadConfig struct {
+	// How often changes to the config files are checked for sections which aren't safe to reload.
+	Interval config.Duration `json:"interval"`
+}
+
+// Feature flags gradually enable behaviors, e.g. for a pilot project before every project. Overrides set at runtime
+// take precedence over these values: an override for a project over one for every project, and either over config.
+type FeatureFlagsConfig struct {
+	// Keyed by flag, e.g. admission_control.
+	Defaults map[string]FeatureFlagDefault `json:"defaults"`
+}
+
+type FeatureFlagDefault struct {
+	// Whether the flag is enabled for every project.
+	Enabled bool `json:"enabled"`
+	// The projects the flag is enabled for when it isn't enabled for every project.
+	Projects []string `json:"projects"`
+}
+
+// Executions are named randomly unless a policy for their project and domain sets a template, so that they're easier
+// to find in object stores and logs. For example:
+/*
+	flyteadmin:
+	  executionNaming:
+	    policies:
+	      - project: flytesnacks
+	        template: "{launch_plan}-{date}-{seq}"
+	        allowedNames: "^[a-z]+-[a-z0-9-]+$"
+*/
+type ExecutionNamingConfig struct {
+	Policies []ProjectDomainNamingPolicy `json:"policies"`
+}
+
+// How the executions of a project and domain, either of which may be empty to match any, are named.
+type ProjectDomainNamingPolicy struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// Template for the names of executions which requests don't name. It supports {project}, {domain},
+	// {launch_plan} (or the task of single task executions), {date} (yyyymmdd in UTC), {time} (hhmmss in UTC) and {seq},
+	// the lowest number at or after the number of executions named alike which leaves the name unused. Names which are
+	// already used are suffixed with a number when the template has no {seq}. Names are lowercased, with characters
+	// other than letters, digits and dashes replaced by dashes, and {launch_plan} is shortened to fit names within 20
+	// characters. Names must start with a letter, so admin fails to start with templates which don't render one first.
+	// Executions are named randomly when empty.
+	Template string `json:"template"`
+	// Regular expression the names requests set must match, when set. Scheduled and child executions are named by admin
+	// and propeller, so aren't checked.
+	AllowedNames string `json:"allowedNames"`
+}
+
+// Returns the policy configured for a project and domain, preferring policies for both over those for just the
+// project, and those over policies for just the domain.
+func (c ExecutionNamingConfig) GetPolicy(project, domain string) (ProjectDomainNamingPolicy, bool) {
+	var policy ProjectDomainNamingPolicy
+	bestScore := 0
+	for _, candidate := range c.Policies {
+		if score := getProjectDomainMatchScore(candidate.Project, candidate.Domain, project, domain); score > bestScore {
+			policy = candidate
+			bestScore = score
+		}
+	}
+	return policy, bestScore > 0
+}
+
+// The fraction of executions of the matching launch plans expected to succeed. An empty project, domain or name
+// matches all projects, domains or launch plans respectively.
+type LaunchPlanObjective struct {
+	Project     string  `json:"project"`
+	Domain      string  `json:"domain"`
+	Name        string  `json:"name"`
+	SuccessRate float64 `json:"successRate"`
+}
+
+// Returns the objective of a launch plan, preferring the objective which matches it most specifically. Objectives
+// for a launch plan name are preferred over those for just the project and domain.
+func (c LaunchPlanStatsConfig) GetObjective(project, domain, name string) (LaunchPlanObjective, bool) {
+	var objective LaunchPlanObjective
+	bestScore := 0
+	for _, candidate := range c.Objectives {
+		if len(candidate.Name) > 0 && candidate.Name != name {
+			continue
+		}
+		score := getProjectDomainMatchScore(candidate.Project, candidate.Domain, project, domain)
+		if score > 0 && len(candidate.Name) > 0 {
+			score += 4
+		}
+		if score > bestScore {
+			objective = candidate
+			bestScore = score
+		}
+	}
+	return objective, bestScore > 0
+}
+
+// When enabled, events which are replayed or arrive out of order, such as after flytepropeller restarts, are skipped
+// rather than rejected or applied over newer state. Skipped events are recorded with the reason they were skipped so
+// that an execution's event history can be audited.
+type EventReconciliationConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+// When enabled, every workflow, node and task execution event received is recorded, so that the exact sequence of an
+// execution's state transitions can be listed.
+type EventHistoryConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+// When enabled, the input and output artifacts of task executions and the relationships between executions are
+// recorded, so that an execution's upstream and downstream lineage can be traversed.
+type LineageConfig struct {
+	Enabled     bool              `json:"enabled"`
+	OpenLineage OpenLineageConfig `json:"openLineage"`
+}
+
+// Configures exporting task execution runs as OpenLineage (https://openlineage.io) events, for example to Marquez.
+type OpenLineageConfig struct {
+	Enabled bool `json:"enabled"`
+	// The endpoint run events are posted to, e.g. http://marquez/api/v1/lineage.
+	URL string `json:"url"`
+	// The namespace of the jobs, which are Flyte tasks. Defaults to flyte.
+	Namespace string `json:"namespace"`
+	// Headers sent with every request, e.g. for authentication.
+	Headers map[string]string `json:"headers"`
+	// How long to wait for the endpoint to respond. Defaults to 10 seconds.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+}
+
+// Selects the registered executor which launches executions. The default, propeller, creates FlyteWorkflow resources
+// through the K8s API of each execution cluster. The agent executor instead posts them to an agent API, for
+// deployments where admin can't reach the K8s API directly.
+type ExecutorConfig struct {
+	Type  string              `json:"type"`
+	Agent AgentExecutorConfig `json:"agent"`
+	// Retries creating workflows which fail with transient errors, such as those of an unavailable API server.
+	Retry ExecutorRetryConfig `json:"retry"`
+	// Stops launching executions in clusters which repeatedly fail to create workflows, for a while.
+	CircuitBreaker CircuitBreakerConfig `json:"circuitBreaker"`
+	// Launches executions after they're created rather than while they're created.
+	AsyncLaunch AsyncLaunchConfig `json:"asyncLaunch"`
+	// Keeps the FlyteWorkflows of large workflows within the object size limit of etcd.
+	Offloading WorkflowOffloadingConfig `json:"offloading"`
+}
+
+type ExecutorRetryConfig struct {
+	// The maximum number of times creating a workflow is attempted. One or less disables retries.
+	Attempts int `json:"attempts"`
+	// How long to wait before the first retry. The delay doubles with every retry.
+	Delay config.Duration `json:"delay"`
+	// The maximum delay between retries.
+	MaxDelay config.Duration `json:"maxDelay"`
+}
+
+// While a cluster's circuit is open, executions launched in it fail immediately with an unavailable error rather than
+// waiting on its API server. Once it's been open for the open duration, launches are attempted again, and the circuit
+// opens again on the first failure or closes on the first success.
+type CircuitBreakerConfig struct {
+	Enabled bool `json:"enabled"`
+	// The number of consecutive launches in a cluster which fail with transient errors before its circuit opens.
+	FailureThreshold int `json:"failureThreshold"`
+	// How long a cluster's circuit stays open.
+	OpenDuration config.Duration `json:"openDuration"`
+}
+
+// When enabled, executions are created as pending and QUEUED, and launched by the dispatcher of pending executions,
+// which retries them until they launch. Until then, the error they last failed to launch with is reported as their
+// error. Single task executions are still launched while they're created.
+type AsyncLaunchConfig struct {
+	Enabled bool `json:"enabled"`
+	// The number of times launching an execution is attempted before it fails. Zero retries forever.
+	MaxAttempts int `json:"maxAttempts"`
+}
+
+// When enabled, FlyteWorkflows which serialize to at least the threshold are launched without their static spec: the
+// compiled workflow closure they're built from is written to blob storage, and the FlyteWorkflow references it with the
+// flyte.org/offloaded-workflow-closure annotation for propeller to rebuild the spec from. Propeller must be configured to
+// load offloaded specs before it's sent them, so workflows are only offloaded in the clusters listed as ready for them.
+type WorkflowOffloadingConfig struct {
+	Enabled bool `json:"enabled"`
+	// FlyteWorkflows which serialize to at least this many bytes are offloaded.
+	ThresholdBytes int `json:"thresholdBytes"`
+	// The ids of the clusters whose propeller loads offloaded specs. Empty means all clusters.
+	Clusters []string `json:"clusters"`
+	// Registering a workflow whose FlyteWorkflow serializes to at least this many bytes logs a warning and returns it to
+	// the client in the flyte-registration-warning header, unless it's offloaded in all clusters. Zero disables it.
+	WarnThresholdBytes int `json:"warnThresholdBytes"`
+	// FlyteWorkflows whose inputs serialize to at least this many bytes are launched without them, referencing the
+	// inputs admin offloaded to blob storage with the flyte.org/offloaded-inputs annotation instead. Zero disables it.
+	InputsThresholdBytes int `json:"inputsThresholdBytes"`
+}
+
+// Configures the agent API the agent executor posts launch and terminate requests to.
+type AgentExecutorConfig struct {
+	// The endpoint of the agent, e.g. http://flyte-agent:8089.
+	Endpoint string `json:"endpoint"`
+	// Overrides the endpoint for executions launched in specific clusters, keyed by cluster id.
+	ClusterEndpoints map[string]string `json:"clusterEndpoints"`
+	// Headers sent with every request, e.g. for authentication.
+	Headers map[string]string `json:"headers"`
+	// How long to wait for the agent to respond. Defaults to 10 seconds.
+	TimeoutSeconds int `json:"timeoutSeconds"`
 }
 
 func (a *ApplicationConfig) GetRoleNameKey() string {
@@ -91,6 +723,98 @@ func (a *ApplicationConfig) GetMaxParallelism() int32 {
 	return a.MaxParallelism
 }
 
+func (a *ApplicationConfig) GetOutboxConfig() OutboxConfig {
+	return a.Outbox
+}
+
+func (a *ApplicationConfig) GetAdmissionConfig() AdmissionConfig {
+	return a.Admission
+}
+
+func (a *ApplicationConfig) GetBackfillConfig() BackfillConfig {
+	return a.Backfill
+}
+
+func (a *ApplicationConfig) GetRolloutConfig() RolloutConfig {
+	return a.Rollout
+}
+
+func (a *ApplicationConfig) GetPriorityConfig() PriorityConfig {
+	return a.Priority
+}
+
+func (a *ApplicationConfig) GetUsageConfig() UsageConfig {
+	return a.Usage
+}
+
+func (a *ApplicationConfig) GetEventReconciliationConfig() EventReconciliationConfig {
+	return a.EventReconciliation
+}
+
+func (a *ApplicationConfig) GetEventHistoryConfig() EventHistoryConfig {
+	return a.EventHistory
+}
+
+func (a *ApplicationConfig) GetLineageConfig() LineageConfig {
+	return a.Lineage
+}
+
+func (a *ApplicationConfig) GetExecutorConfig() ExecutorConfig {
+	return a.Executor
+}
+
+func (a *ApplicationConfig) GetAPIUsageConfig() APIUsageConfig {
+	return a.APIUsage
+}
+
+func (a *ApplicationConfig) GetLaunchPlanStatsConfig() LaunchPlanStatsConfig {
+	return a.LaunchPlanStats
+}
+
+func (a *ApplicationConfig) GetDataProxyConfig() DataProxyConfig {
+	return a.DataProxy
+}
+
+func (a *ApplicationConfig) GetLiteralOffloadingConfig() LiteralOffloadingConfig {
+	return a.LiteralOffloading
+}
+
+func (a *ApplicationConfig) GetTaskLogsConfig() TaskLogsConfig {
+	return a.TaskLogs
+}
+
+func (a *ApplicationConfig) GetProjectPurgeConfig() ProjectPurgeConfig {
+	return a.ProjectPurge
+}
+
+func (a *ApplicationConfig) GetDeprecationConfig() DeprecationConfig {
+	return a.Deprecation
+}
+
+func (a *ApplicationConfig) GetSignalsConfig() SignalsConfig {
+	return a.Signals
+}
+
+func (a *ApplicationConfig) GetConfigReloadConfig() ConfigReloadConfig {
+	return a.ConfigReload
+}
+
+func (a *ApplicationConfig) GetFeatureFlagsConfig() FeatureFlagsConfig {
+	return a.FeatureFlags
+}
+
+func (a *ApplicationConfig) GetExecutionNamingConfig() ExecutionNamingConfig {
+	return a.ExecutionNaming
+}
+
+func (a *ApplicationConfig) GetDataCatalogConfig() DataCatalogConfig {
+	return a.DataCatalog
+}
+
+func (a *ApplicationConfig) GetWorkflowClosureOffloadingConfig() WorkflowClosureOffloadingConfig {
+	return a.WorkflowClosureOffloading
+}
+
 // This section holds common config for AWS
 type AWSConfig struct {
 	Region string `json:"region"`
@@ -311,6 +1035,9 @@ type RemoteDataConfig struct {
 	SignedURL SignedURL `json:"signedUrls"`
 	// Specifies the max size in bytes for which execution data such as inputs and outputs will be populated in line.
 	MaxSizeInBytes int64 `json:"maxSizeInBytes"`
+	// Specifies the max size in bytes of execution data read to return a subset of its literals, which may exceed
+	// maxSizeInBytes. Zero limits it to maxSizeInBytes.
+	MaxPartialFetchSizeInBytes int64 `json:"maxPartialFetchSizeInBytes"`
 }
 
 // This section handles configuration for the workflow notifications pipeline.
@@ -330,9 +1057,37 @@ type NotificationsProcessorConfig struct {
 
 type EmailServerConfig struct {
 	ServiceName string `json:"serviceName"`
-	// Only one of these should be set.
+	// Only one of these should be set. For SMTP servers, the key is the password of the configured username.
 	APIKeyEnvVar   string `json:"apiKeyEnvVar"`
 	APIKeyFilePath string `json:"apiKeyFilePath"`
+	// Configures sending emails through an SMTP server, when the service name is smtp.
+	SMTPConfig SMTPConfig `json:"smtp"`
+}
+
+type SMTPTLSMode = string
+
+const (
+	// Upgrades connections to TLS with STARTTLS, failing if the server doesn't support it.
+	SMTPStartTLS SMTPTLSMode = "starttls"
+	// Connects with TLS from the start, usually on port 465.
+	SMTPImplicitTLS SMTPTLSMode = "tls"
+	// Sends emails unencrypted, e.g. to a relay on the same host.
+	SMTPNoTLS SMTPTLSMode = "none"
+)
+
+// Configures the SMTP server emails are sent through.
+type SMTPConfig struct {
+	Host string `json:"host"`
+	// Defaults to 587, or 465 with implicit TLS.
+	Port int `json:"port"`
+	// How connections to the server are secured. Defaults to starttls.
+	TLSMode SMTPTLSMode `json:"tlsMode"`
+	// Skips verifying the server's certificate, e.g. for servers with self-signed certificates.
+	InsecureSkipVerify bool `json:"insecureSkipVerify"`
+	// Authenticates with the server when set, using the password read from the API key env var or file.
+	Username string `json:"username"`
+	// The number of connections kept open to the server between emails. Defaults to 2.
+	MaxIdleConnections int `json:"maxIdleConnections"`
 }
 
 // This section handles the configuration of notifications emails.
@@ -370,6 +1125,53 @@ type ExternalEventsConfig struct {
 	ReconnectDelaySeconds int `json:"reconnectDelaySeconds"`
 }
 
+// A destination which cloud events are published to.
+type CloudEventsSinkConfig struct {
+	// One of aws, gcp, kafka or webhook.
+	Type        string      `json:"type"`
+	AWSConfig   AWSConfig   `json:"aws"`
+	GCPConfig   GCPConfig   `json:"gcp"`
+	KafkaConfig KafkaConfig `json:"kafka"`
+	// The SNS, Pub/Sub or Kafka topic events are published to.
+	TopicName     string        `json:"topicName"`
+	WebhookConfig WebhookConfig `json:"webhook"`
+}
+
+// Configuration for publishing cloud events to a Kafka topic.
+type KafkaConfig struct {
+	// The host:port addresses of the brokers the client bootstraps from.
+	Brokers []string `json:"brokers"`
+}
+
+// Configuration for posting cloud events to an HTTP endpoint.
+type WebhookConfig struct {
+	URL string `json:"url"`
+	// Headers sent with every request, e.g. for authentication.
+	Headers map[string]string `json:"headers"`
+	// How long to wait for the endpoint to respond. Defaults to 10 seconds.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+}
+
+// This section handles publishing workflow, node and task execution phase transitions as CloudEvents
+// (https://cloudevents.io) so that downstream systems, such as lineage services and data catalogs, can react to them.
+type CloudEventsConfig struct {
+	Enable bool `json:"enable"`
+	// Identifies this deployment as the source of the events. Defaults to flyteadmin.
+	Source string `json:"source"`
+	// Event types to publish: task, node, workflow or all.
+	EventTypes []string `json:"eventTypes"`
+	// Named destinations which events can be routed to.
+	Sinks map[string]CloudEventsSinkConfig `json:"sinks"`
+	// The sinks which events of projects without their own routes are published to.
+	DefaultSinks []string `json:"defaultSinks"`
+	// Routes the events of individual projects to a different set of sinks than the defaults.
+	ProjectSinks map[string][]string `json:"projectSinks"`
+	// Number of times to attempt creating a sink's client should there be any disruptions.
+	ReconnectAttempts int `json:"reconnectAttempts"`
+	// Specifies the time interval to wait before attempting to recreate a sink's client.
+	ReconnectDelaySeconds int `json:"reconnectDelaySeconds"`
+}
+
 // Configuration specific to notifications handling
 type NotificationsConfig struct {
 	// Defines the cloud provider that backs the scheduler. In the absence of a specification the no-op, 'local'
@@ -386,6 +1188,198 @@ type NotificationsConfig struct {
 	ReconnectAttempts int `json:"reconnectAttempts"`
 	// Specifies the time interval to wait before attempting to reconnect the notifications processor client.
 	ReconnectDelaySeconds int `json:"reconnectDelaySeconds"`
+	// When enabled, the notifications requested for an execution are added to its launch plan's default
+	// notifications, rather than replacing them.
+	MergeLaunchPlanNotifications bool `json:"mergeLaunchPlanNotifications"`
+	// Notifications added to every execution launched in a project and domain, which requests can't disable.
+	MandatoryNotifications       []ProjectDomainNotifications `json:"mandatoryNotifications"`
+	NotificationsWebhooksConfig  NotificationsWebhooksConfig  `json:"webhooks"`
+	NotificationsIncidentsConfig NotificationsIncidentsConfig `json:"incidents"`
+	RegisteredWebhooksConfig     RegisteredWebhooksConfig     `json:"registeredWebhooks"`
+	NotificationsDigestsConfig   NotificationsDigestsConfig   `json:"digests"`
+	// When enabled, the processor records whether each notification published for an execution was delivered, so
+	// that failed notifications can be listed and resent.
+	TrackDeliveries bool `json:"trackDeliveries"`
+	// When enabled, the addresses users subscribe to the executions of a launch plan or project are added to the
+	// recipients of the executions' notifications.
+	EnableSubscriptions bool `json:"enableSubscriptions"`
+}
+
+// Configures posting Slack notifications to a chat webhook instead of emailing them, for the projects and domains
+// which have one.
+type NotificationsWebhooksConfig struct {
+	// Templates for the title and body of messages, which support the same parameters as emails.
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	// Template for a link to the execution, e.g.
+	// https://console.example.com/projects/{{ project }}/domains/{{ domain }}/executions/{{ name }}.
+	ExecutionLink string `json:"executionLink"`
+	// How long to wait for a webhook to respond. Defaults to 10 seconds.
+	TimeoutSeconds int                    `json:"timeoutSeconds"`
+	Endpoints      []ProjectDomainWebhook `json:"endpoints"`
+}
+
+// A chat webhook messages about the executions of a project and domain are posted to. Either may be empty to match any.
+type ProjectDomainWebhook struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// One of slack or teams.
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// Returns the webhook configured for a project and domain, preferring webhooks for both over those for just the
+// project, and those over webhooks for just the domain.
+func (c NotificationsWebhooksConfig) GetWebhook(project, domain string) (ProjectDomainWebhook, bool) {
+	var webhook ProjectDomainWebhook
+	bestScore := 0
+	for _, candidate := range c.Endpoints {
+		if score := getProjectDomainMatchScore(candidate.Project, candidate.Domain, project, domain); score > bestScore {
+			webhook = candidate
+			bestScore = score
+		}
+	}
+	return webhook, bestScore > 0
+}
+
+// Configures paging on-call engineers when executions fail, by opening an incident in an incident paging service for
+// the execution's launch plan and domain, and resolving it once the launch plan succeeds again.
+type NotificationsIncidentsConfig struct {
+	// Template for the summary of incidents, which supports the same parameters as emails.
+	Summary string `json:"summary"`
+	// Template for a link to the failed execution, e.g.
+	// https://console.example.com/projects/{{ project }}/domains/{{ domain }}/executions/{{ name }}.
+	ExecutionLink string `json:"executionLink"`
+	// Failures of a launch plan within this long of the last one which paged don't page again, even if the incident
+	// was resolved in between, so that flaky launch plans don't page repeatedly. Defaults to an hour.
+	DeduplicationWindow config.Duration `json:"deduplicationWindow"`
+	// How long to wait for the paging service to respond. Defaults to 10 seconds.
+	TimeoutSeconds int                      `json:"timeoutSeconds"`
+	Endpoints      []ProjectDomainIncidents `json:"endpoints"`
+}
+
+// The paging service incidents for the executions of a project and domain are opened in. Either may be empty to match
+// any.
+type ProjectDomainIncidents struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// One of pagerDuty or opsgenie.
+	Type string `json:"type"`
+	// The PagerDuty integration key or Opsgenie API key is read from an environment variable or file.
+	APIKeyEnvVar   string `json:"apiKeyEnvVar"`
+	APIKeyFilePath string `json:"apiKeyFilePath"`
+	// Overrides the service's API URL, e.g. https://api.eu.opsgenie.com for Opsgenie's EU instance.
+	URL string `json:"url"`
+}
+
+// Returns the paging service configured for a project and domain, preferring those for both over those for just the
+// project, and those over ones for just the domain.
+func (c NotificationsIncidentsConfig) GetEndpoint(project, domain string) (ProjectDomainIncidents, bool) {
+	var endpoint ProjectDomainIncidents
+	bestScore := 0
+	for _, candidate := range c.Endpoints {
+		if score := getProjectDomainMatchScore(candidate.Project, candidate.Domain, project, domain); score > bestScore {
+			endpoint = candidate
+			bestScore = score
+		}
+	}
+	return endpoint, bestScore > 0
+}
+
+// Configures delivering executions to the webhooks users register for their projects and domains.
+type RegisteredWebhooksConfig struct {
+	// When disabled, executions aren't delivered to registered webhooks.
+	Enabled bool `json:"enabled"`
+	// How many times to attempt delivering an execution to a webhook. Defaults to 3.
+	MaxAttempts int `json:"maxAttempts"`
+	// How long to wait before retrying a failed delivery, which doubles after each attempt. Defaults to a second.
+	RetryDelay config.Duration `json:"retryDelay"`
+	// How long to wait for a webhook to respond. Defaults to 10 seconds.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+	// If set, webhooks may only be registered for and delivered to these hosts. Hosts prefixed with "*." match any
+	// of their subdomains.
+	AllowedHosts []string `json:"allowedHosts"`
+	// Webhooks may not be registered for or delivered to these hosts, which match like AllowedHosts.
+	DeniedHosts []string `json:"deniedHosts"`
+	// Whether webhooks may be delivered to loopback, private and link-local addresses, which are otherwise refused so
+	// that webhooks can't reach internal services or cloud instance metadata.
+	AllowPrivateAddresses bool `json:"allowPrivateAddresses"`
+}
+
+type DigestGroupBy = string
+
+const (
+	// Digests summarize the executions of a single launch plan.
+	DigestByLaunchPlan DigestGroupBy = "launchPlan"
+	// Digests summarize the executions of all the launch plans in a project and domain.
+	DigestByProject DigestGroupBy = "project"
+)
+
+// Configures batching the emails sent for executions into a periodic digest per recipient, rather than an email per
+// execution, so that e.g. backfills don't flood inboxes.
+type NotificationsDigestsConfig struct {
+	// How often digests which are due are sent. Defaults to a minute.
+	CheckInterval config.Duration `json:"checkInterval"`
+	// Template for a link to each execution in digests, which supports the same parameters as emails.
+	ExecutionLink string                `json:"executionLink"`
+	Subscriptions []ProjectDomainDigest `json:"subscriptions"`
+}
+
+// Emails for the executions of a project and domain, either of which may be empty to match any, are batched into
+// digests. The most specific subscription matching an execution applies.
+type ProjectDomainDigest struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// Restricts the subscription to the executions of a launch plan, if set.
+	LaunchPlan string `json:"launchPlan"`
+	// Whether digests summarize each launch plan or the whole project and domain. Defaults to launchPlan.
+	GroupBy DigestGroupBy `json:"groupBy"`
+	// How long executions are collected for before they're sent in a digest. Defaults to an hour.
+	Interval config.Duration `json:"interval"`
+}
+
+// Returns the subscription batching the emails for the executions of a launch plan into digests, if there is one.
+func (c NotificationsDigestsConfig) GetSubscription(project, domain, launchPlan string) (ProjectDomainDigest, bool) {
+	var subscription ProjectDomainDigest
+	bestScore := 0
+	for _, candidate := range c.Subscriptions {
+		if len(candidate.LaunchPlan) > 0 && candidate.LaunchPlan != launchPlan {
+			continue
+		}
+		score := getProjectDomainMatchScore(candidate.Project, candidate.Domain, project, domain)
+		if score > 0 && len(candidate.LaunchPlan) > 0 {
+			// Launch plan subscriptions are more specific than any project and domain subscription.
+			score += 4
+		}
+		if score > bestScore {
+			subscription = candidate
+			bestScore = score
+		}
+	}
+	return subscription, bestScore > 0
+}
+
+// A notification sent for the executions of a project and domain, either of which may be empty to match any.
+type ProjectDomainNotifications struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// The execution phases which trigger the notification, e.g. FAILED or TIMED_OUT.
+	Phases []string `json:"phases"`
+	// One of email, slack or pagerDuty.
+	Type       string   `json:"type"`
+	Recipients []string `json:"recipients"`
+}
+
+// Returns every mandatory notification which applies to a project and domain. Unlike most per-project and domain
+// settings, more specific notifications are sent in addition to, rather than instead of, more general ones.
+func (c NotificationsConfig) GetMandatoryNotifications(project, domain string) []ProjectDomainNotifications {
+	var notifications []ProjectDomainNotifications
+	for _, candidate := range c.MandatoryNotifications {
+		if getProjectDomainMatchScore(candidate.Project, candidate.Domain, project, domain) > 0 {
+			notifications = append(notifications, candidate)
+		}
+	}
+	return notifications
 }
 
 // Domains are always globally set in the application config, whereas individual projects can be individually registered.
@@ -407,4 +1401,5 @@ type ApplicationConfiguration interface {
 	GetNotificationsConfig() *NotificationsConfig
 	GetDomainsConfig() *DomainsConfig
 	GetExternalEventsConfig() *ExternalEventsConfig
+	GetCloudEventsConfig() *CloudEventsConfig
 }
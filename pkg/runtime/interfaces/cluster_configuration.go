@@ -3,6 +3,7 @@ package interfaces
 import (
 	"io/ioutil"
 
+	"github.com/flyteorg/flytestdlib/config"
 	"github.com/pkg/errors"
 )
 
@@ -41,9 +42,28 @@ func (auth Auth) GetToken() (string, error) {
 	return string(token), nil
 }
 
+// Configures the periodic health probes of execution clusters. Clusters which fail consecutive probes are marked
+// unhealthy, so that new executions are routed to the other healthy clusters in their pools, and are marked healthy
+// again once a probe succeeds.
+type ClusterHealthCheckConfig struct {
+	Enabled  bool            `json:"enabled"`
+	Interval config.Duration `json:"interval"`
+	// How long a probe waits for each check to respond.
+	Timeout config.Duration `json:"timeout"`
+	// The number of consecutive failed probes after which a cluster is marked unhealthy.
+	FailureThreshold int `json:"failureThreshold"`
+	// The lease flytepropeller renews while it's the leader in a cluster, which serves as its heartbeat. The heartbeat
+	// isn't checked when the name is empty.
+	PropellerLeaseNamespace string `json:"propellerLeaseNamespace"`
+	PropellerLeaseName      string `json:"propellerLeaseName"`
+	// How recently flytepropeller must have renewed its lease for a cluster to be healthy.
+	PropellerHeartbeatTimeout config.Duration `json:"propellerHeartbeatTimeout"`
+}
+
 type Clusters struct {
 	ClusterConfigs  []ClusterConfig            `json:"clusterConfigs"`
 	LabelClusterMap map[string][]ClusterEntity `json:"labelClusterMap"`
+	HealthCheck     ClusterHealthCheckConfig   `json:"healthCheck"`
 }
 
 // Provides values set in runtime configuration files.
@@ -54,4 +74,7 @@ type ClusterConfiguration interface {
 
 	// Returns label cluster map for routing
 	GetLabelClusterMap() map[string][]ClusterEntity
+
+	// Returns the configuration of cluster health probes.
+	GetHealthCheckConfig() ClusterHealthCheckConfig
 }
@@ -20,6 +20,23 @@ type DomainName = string
 
 type TemplateData = map[string]DataSource
 
+// The ways the namespaces of archived projects and removed domains can be cleaned up.
+const (
+	// Deletes the resources created from templates, including the namespace when a template created it.
+	NamespaceCleanupModeDelete = "DELETE"
+	// Creates a resource quota preventing new pods in the namespace, leaving its resources as they are.
+	NamespaceCleanupModeCordon = "CORDON"
+)
+
+type NamespaceCleanupConfig struct {
+	Enabled bool `json:"enabled"`
+	// How long after a project is found archived, or a domain removed, its namespaces are cleaned up. Restoring the
+	// project or domain within the grace period leaves its namespaces as they are.
+	GracePeriod config.Duration `json:"gracePeriod"`
+	// Either DELETE or CORDON.
+	Mode string `json:"mode"`
+}
+
 type ClusterResourceConfig struct {
 	TemplatePath string `json:"templatePath"`
 	// TemplateData maps template keys e.g. my_super_secret_password to a data source
@@ -43,6 +60,12 @@ type ClusterResourceConfig struct {
 		      value: "baz"
 	*/
 	CustomData map[DomainName]TemplateData `json:"customData"`
+	// Whether to check resources whose templates were already applied for drift on every sync, using server-side
+	// dry-run updates to find the fields which differ from their templates.
+	DriftDetection bool `json:"driftDetection"`
+	// Cleans up the namespaces the templates were synced to once their project is archived or their domain is
+	// removed. Only namespaces with a recorded sync status are cleaned up.
+	NamespaceCleanup NamespaceCleanupConfig `json:"namespaceCleanup"`
 }
 
 type ClusterResourceConfiguration interface {
@@ -50,4 +73,6 @@ type ClusterResourceConfiguration interface {
 	GetTemplateData() map[string]DataSource
 	GetRefreshInterval() time.Duration
 	GetCustomTemplateData() map[DomainName]TemplateData
+	GetDriftDetection() bool
+	GetNamespaceCleanupConfig() NamespaceCleanupConfig
 }
@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Identifies the config admin runs with. Config files are watched for changes, and each change which alters the
+// config in use loads a new version.
+type ConfigVersion struct {
+	// Starts at 1 with the config admin started with.
+	Version int64 `json:"version"`
+	// A checksum of the config, which differs between replicas only if their configs do.
+	Checksum string    `json:"checksum"`
+	LoadedAt time.Time `json:"loadedAt"`
+	// The sections which changed in the config files, but which are only read at start up. They keep the values admin
+	// started with until it's restarted.
+	PendingRestart []string `json:"pendingRestart"`
+}
+
+// Applies the changes made to the config files while admin runs to the sections which are safe to reload.
+type ConfigReloader interface {
+	// Reverts changes to the sections which aren't safe to reload, and loads a new version when the config changed.
+	Reconcile(ctx context.Context)
+	GetVersion() ConfigVersion
+}
@@ -0,0 +1,83 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/flyteorg/flytestdlib/config"
+)
+
+// The number of days records are retained for after they're created. Zero values retain records indefinitely.
+type RetentionPolicy struct {
+	ExecutionDays int `json:"executionDays"`
+	// Applies to both node and task executions.
+	NodeExecutionDays int `json:"nodeExecutionDays"`
+	// Applies to execution and node execution events, and to the recorded events of executions.
+	EventDays int `json:"eventDays"`
+	// The number of days soft-deleted executions and launch plans are kept for before they are purged, along with
+	// the records of purged executions.
+	DeletedDays int `json:"deletedDays"`
+	// The number of the latest versions of each workflow, task and launch plan name retained, which stops
+	// re-registration from growing them without bound. Older versions are pruned unless a launch plan is active,
+	// a remaining launch plan references the workflow, an active launch plan's workflow uses the task, or a recent
+	// execution references them.
+	WorkflowVersions   int `json:"workflowVersions"`
+	TaskVersions       int `json:"taskVersions"`
+	LaunchPlanVersions int `json:"launchPlanVersions"`
+	// Versions referenced by executions created within this many days are retained regardless of their number. Zero
+	// retains versions referenced by any execution.
+	VersionExecutionDays int `json:"versionExecutionDays"`
+}
+
+// Overrides the default retention policy. An empty project or domain matches all projects or domains respectively.
+type ProjectDomainRetentionPolicy struct {
+	Project string          `json:"project"`
+	Domain  string          `json:"domain"`
+	Policy  RetentionPolicy `json:"policy"`
+}
+
+type RetentionArchiveConfig struct {
+	// Whether records are copied to storage before they are deleted.
+	Enabled bool `json:"enabled"`
+	// Storage prefix archived records are written under, within the configured metadata container.
+	Prefix string `json:"prefix"`
+}
+
+// Configures how long records are retained for before they are pruned.
+// For example:
+/*
+	retention:
+	  interval: 1h
+	  default:
+	    executionDays: 90
+	    eventDays: 30
+	  policies:
+	    - project: flytesnacks
+	      domain: development
+	      policy:
+	        executionDays: 7
+	    - project: ci-project
+	      policy:
+	        workflowVersions: 20
+	        taskVersions: 20
+	        launchPlanVersions: 20
+	        versionExecutionDays: 7
+	  archive:
+	    enabled: true
+*/
+type RetentionConfig struct {
+	// How often the pruner runs.
+	Interval config.Duration `json:"interval"`
+	// The maximum number of records archived and deleted at once.
+	BatchSize int                            `json:"batchSize"`
+	Default   RetentionPolicy                `json:"default"`
+	Policies  []ProjectDomainRetentionPolicy `json:"policies"`
+	Archive   RetentionArchiveConfig         `json:"archive"`
+}
+
+type RetentionConfiguration interface {
+	GetInterval() time.Duration
+	GetBatchSize() int
+	GetArchiveConfig() RetentionArchiveConfig
+	// Returns the most specific policy configured for a project and domain.
+	GetPolicy(project, domain string) RetentionPolicy
+}
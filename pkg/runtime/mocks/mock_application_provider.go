@@ -12,6 +12,7 @@ type MockApplicationProvider struct {
 	notificationsConfig  interfaces.NotificationsConfig
 	domainsConfig        interfaces.DomainsConfig
 	externalEventsConfig interfaces.ExternalEventsConfig
+	cloudEventsConfig    interfaces.CloudEventsConfig
 }
 
 func (p *MockApplicationProvider) GetDbConfig() interfaces.DbConfig {
@@ -69,3 +70,11 @@ func (p *MockApplicationProvider) SetExternalEventsConfig(externalEventsConfig i
 func (p *MockApplicationProvider) GetExternalEventsConfig() *interfaces.ExternalEventsConfig {
 	return &p.externalEventsConfig
 }
+
+func (p *MockApplicationProvider) SetCloudEventsConfig(cloudEventsConfig interfaces.CloudEventsConfig) {
+	p.cloudEventsConfig = cloudEventsConfig
+}
+
+func (p *MockApplicationProvider) GetCloudEventsConfig() *interfaces.CloudEventsConfig {
+	return &p.cloudEventsConfig
+}
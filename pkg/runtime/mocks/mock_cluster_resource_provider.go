@@ -11,6 +11,8 @@ type MockClusterResourceConfiguration struct {
 	TemplateData       interfaces.TemplateData
 	RefreshInterval    time.Duration
 	CustomTemplateData map[interfaces.DomainName]interfaces.TemplateData
+	DriftDetection     bool
+	NamespaceCleanup   interfaces.NamespaceCleanupConfig
 }
 
 func (c MockClusterResourceConfiguration) GetTemplatePath() string {
@@ -28,6 +30,14 @@ func (c MockClusterResourceConfiguration) GetCustomTemplateData() map[interfaces
 	return c.CustomTemplateData
 }
 
+func (c MockClusterResourceConfiguration) GetDriftDetection() bool {
+	return c.DriftDetection
+}
+
+func (c MockClusterResourceConfiguration) GetNamespaceCleanupConfig() interfaces.NamespaceCleanupConfig {
+	return c.NamespaceCleanup
+}
+
 func NewMockClusterResourceConfiguration() interfaces.ClusterResourceConfiguration {
 	return &MockClusterResourceConfiguration{}
 }
@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+type MockConfigReloader struct {
+	Version interfaces.ConfigVersion
+}
+
+func (r *MockConfigReloader) Reconcile(ctx context.Context) {}
+
+func (r *MockConfigReloader) GetVersion() interfaces.ConfigVersion {
+	return r.Version
+}
+
+func NewMockConfigReloader() interfaces.ConfigReloader {
+	return &MockConfigReloader{}
+}
@@ -16,6 +16,7 @@ type MockConfigurationProvider struct {
 	clusterResourceConfiguration        interfaces.ClusterResourceConfiguration
 	namespaceMappingConfiguration       interfaces.NamespaceMappingConfiguration
 	qualityOfServiceConfiguration       interfaces.QualityOfServiceConfiguration
+	retentionConfiguration              interfaces.RetentionConfiguration
 }
 
 func (p *MockConfigurationProvider) ApplicationConfiguration() interfaces.ApplicationConfiguration {
@@ -70,6 +71,14 @@ func (p *MockConfigurationProvider) AddQualityOfServiceConfiguration(config inte
 	p.qualityOfServiceConfiguration = config
 }
 
+func (p *MockConfigurationProvider) RetentionConfiguration() interfaces.RetentionConfiguration {
+	return p.retentionConfiguration
+}
+
+func (p *MockConfigurationProvider) AddRetentionConfiguration(config interfaces.RetentionConfiguration) {
+	p.retentionConfiguration = config
+}
+
 func NewMockConfigurationProvider(
 	applicationConfiguration interfaces.ApplicationConfiguration,
 	queueConfiguration interfaces.QueueConfiguration,
@@ -90,5 +99,6 @@ func NewMockConfigurationProvider(
 		whitelistConfiguration:        whitelistConfiguration,
 		namespaceMappingConfiguration: namespaceMappingConfiguration,
 		qualityOfServiceConfiguration: mockQualityOfServiceConfiguration,
+		retentionConfiguration:        NewMockRetentionConfiguration(),
 	}
 }
@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+)
+
+type MockRetentionConfiguration struct {
+	Interval      time.Duration
+	BatchSize     int
+	ArchiveConfig interfaces.RetentionArchiveConfig
+	Policies      map[string]interfaces.RetentionPolicy
+	DefaultPolicy interfaces.RetentionPolicy
+}
+
+func (c *MockRetentionConfiguration) GetInterval() time.Duration {
+	return c.Interval
+}
+
+func (c *MockRetentionConfiguration) GetBatchSize() int {
+	return c.BatchSize
+}
+
+func (c *MockRetentionConfiguration) GetArchiveConfig() interfaces.RetentionArchiveConfig {
+	return c.ArchiveConfig
+}
+
+// Policies are keyed by "project/domain".
+func (c *MockRetentionConfiguration) GetPolicy(project, domain string) interfaces.RetentionPolicy {
+	if policy, ok := c.Policies[project+"/"+domain]; ok {
+		return policy
+	}
+	return c.DefaultPolicy
+}
+
+func NewMockRetentionConfiguration() interfaces.RetentionConfiguration {
+	return &MockRetentionConfiguration{}
+}
@@ -0,0 +1,77 @@
+package runtime
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/config"
+)
+
+const retentionKey = "retention"
+
+const defaultRetentionBatchSize = 500
+
+var retentionConfig = config.MustRegisterSection(retentionKey, &interfaces.RetentionConfig{
+	Interval: config.Duration{
+		Duration: time.Hour,
+	},
+	BatchSize: defaultRetentionBatchSize,
+	Archive: interfaces.RetentionArchiveConfig{
+		Prefix: "archive",
+	},
+})
+
+// Implementation of an interfaces.RetentionConfiguration
+type RetentionConfigurationProvider struct{}
+
+func (p *RetentionConfigurationProvider) getConfig() *interfaces.RetentionConfig {
+	return retentionConfig.GetConfig().(*interfaces.RetentionConfig)
+}
+
+func (p *RetentionConfigurationProvider) GetInterval() time.Duration {
+	return p.getConfig().Interval.Duration
+}
+
+func (p *RetentionConfigurationProvider) GetBatchSize() int {
+	if batchSize := p.getConfig().BatchSize; batchSize > 0 {
+		return batchSize
+	}
+	return defaultRetentionBatchSize
+}
+
+func (p *RetentionConfigurationProvider) GetArchiveConfig() interfaces.RetentionArchiveConfig {
+	return p.getConfig().Archive
+}
+
+func (p *RetentionConfigurationProvider) GetPolicy(project, domain string) interfaces.RetentionPolicy {
+	return GetRetentionPolicy(p.getConfig(), project, domain)
+}
+
+// Returns the policy configured for a project and domain, preferring policies for both over those for just the
+// project, and those over policies for just the domain. The default policy applies when none match.
+func GetRetentionPolicy(retentionConfig *interfaces.RetentionConfig, project, domain string) interfaces.RetentionPolicy {
+	policy := retentionConfig.Default
+	bestScore := 0
+	for _, candidate := range retentionConfig.Policies {
+		if (len(candidate.Project) > 0 && candidate.Project != project) ||
+			(len(candidate.Domain) > 0 && candidate.Domain != domain) {
+			continue
+		}
+		score := 1
+		if len(candidate.Project) > 0 {
+			score += 2
+		}
+		if len(candidate.Domain) > 0 {
+			score++
+		}
+		if score > bestScore {
+			policy = candidate.Policy
+			bestScore = score
+		}
+	}
+	return policy
+}
+
+func NewRetentionConfigurationProvider() interfaces.RetentionConfiguration {
+	return &RetentionConfigurationProvider{}
+}
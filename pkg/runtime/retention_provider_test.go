@@ -0,0 +1,81 @@
+package runtime
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/config/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func initTestRetentionConfig() error {
+	pwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	configAccessor := viper.NewAccessor(config.Options{
+		SearchPaths: []string{filepath.Join(pwd, "testdata/retention_config.yaml")},
+		StrictMode:  false,
+	})
+	return configAccessor.UpdateConfig(context.TODO())
+}
+
+func TestRetentionConfig(t *testing.T) {
+	err := initTestRetentionConfig()
+	assert.NoError(t, err)
+
+	retentionConfig := NewConfigurationProvider().RetentionConfiguration()
+	assert.Equal(t, 30*time.Minute, retentionConfig.GetInterval())
+	assert.Equal(t, 100, retentionConfig.GetBatchSize())
+	assert.Equal(t, interfaces.RetentionArchiveConfig{
+		Enabled: true,
+		Prefix:  "retention-archive",
+	}, retentionConfig.GetArchiveConfig())
+
+	assert.Equal(t, interfaces.RetentionPolicy{
+		ExecutionDays:     7,
+		NodeExecutionDays: 3,
+	}, retentionConfig.GetPolicy("flytesnacks", "development"))
+	assert.Equal(t, interfaces.RetentionPolicy{
+		ExecutionDays:        30,
+		WorkflowVersions:     10,
+		TaskVersions:         20,
+		LaunchPlanVersions:   10,
+		VersionExecutionDays: 7,
+	}, retentionConfig.GetPolicy("flytesnacks", "production"))
+	assert.Equal(t, interfaces.RetentionPolicy{
+		ExecutionDays: 14,
+	}, retentionConfig.GetPolicy("flyteexamples", "development"))
+	assert.Equal(t, interfaces.RetentionPolicy{
+		ExecutionDays: 90,
+		EventDays:     30,
+		DeletedDays:   7,
+	}, retentionConfig.GetPolicy("flyteexamples", "production"))
+}
+
+func TestGetRetentionPolicy_OrderIndependent(t *testing.T) {
+	projectDomainPolicy := interfaces.ProjectDomainRetentionPolicy{
+		Project: "project",
+		Domain:  "domain",
+		Policy:  interfaces.RetentionPolicy{ExecutionDays: 1},
+	}
+	projectPolicy := interfaces.ProjectDomainRetentionPolicy{
+		Project: "project",
+		Policy:  interfaces.RetentionPolicy{ExecutionDays: 2},
+	}
+	retentionConfig := &interfaces.RetentionConfig{
+		Policies: []interfaces.ProjectDomainRetentionPolicy{projectDomainPolicy, projectPolicy},
+	}
+	assert.Equal(t, projectDomainPolicy.Policy, GetRetentionPolicy(retentionConfig, "project", "domain"))
+
+	retentionConfig.Policies = []interfaces.ProjectDomainRetentionPolicy{projectPolicy, projectDomainPolicy}
+	assert.Equal(t, projectDomainPolicy.Policy, GetRetentionPolicy(retentionConfig, "project", "domain"))
+	assert.Equal(t, projectPolicy.Policy, GetRetentionPolicy(retentionConfig, "project", "other"))
+	assert.Equal(t, interfaces.RetentionPolicy{}, GetRetentionPolicy(retentionConfig, "other", "domain"))
+}
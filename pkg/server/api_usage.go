@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+// NewAPIUsageInterceptor returns a unary interceptor that records each call with the API usage manager. It must be
+// chained after the authentication interceptors so that callers are identified, which also means calls rejected
+// before they're authenticated aren't recorded.
+func NewAPIUsageInterceptor(usageManager interfaces.APIUsageInterface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		resp interface{}, err error) {
+
+		start := time.Now()
+		resp, err = handler(ctx, req)
+		project, _ := auth.TargetProjectDomain(req)
+		usageManager.RecordCall(ctx, interfaces.APIUsageCall{
+			Method:    info.FullMethod,
+			Principal: auth.IdentityContextFromContext(ctx).UserID(),
+			Project:   project,
+			Failed:    err != nil,
+			Latency:   time.Since(start),
+		})
+
+		return resp, err
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+type recordingAPIUsageManager struct {
+	interfaces.APIUsageInterface
+	calls []interfaces.APIUsageCall
+}
+
+func (m *recordingAPIUsageManager) RecordCall(ctx context.Context, call interfaces.APIUsageCall) {
+	m.calls = append(m.calls, call)
+}
+
+func TestAPIUsageInterceptor(t *testing.T) {
+	usageManager := &recordingAPIUsageManager{}
+	interceptor := NewAPIUsageInterceptor(usageManager)
+	ctx := auth.NewIdentityContext("", "user", "", time.Now(), nil, nil).WithContext(context.Background())
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/flyteidl.service.AdminService/GetExecution",
+	}
+	request := &admin.WorkflowExecutionGetRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}
+
+	_, err := interceptor(ctx, request, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "missing")
+	})
+	assert.Error(t, err)
+	_, err = interceptor(context.Background(), &admin.ProjectListRequest{}, &grpc.UnaryServerInfo{
+		FullMethod: "/flyteidl.service.AdminService/ListProjects",
+	}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &admin.Projects{}, nil
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, usageManager.calls, 2)
+	assert.Equal(t, info.FullMethod, usageManager.calls[0].Method)
+	assert.Equal(t, "user", usageManager.calls[0].Principal)
+	assert.Equal(t, "project", usageManager.calls[0].Project)
+	assert.True(t, usageManager.calls[0].Failed)
+	assert.Equal(t, "/flyteidl.service.AdminService/ListProjects", usageManager.calls[1].Method)
+	assert.Empty(t, usageManager.calls[1].Principal)
+	assert.Empty(t, usageManager.calls[1].Project)
+	assert.False(t, usageManager.calls[1].Failed)
+}
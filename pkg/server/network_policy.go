@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"net"
+	"path"
+	"strings"
+
+	"github.com/flyteorg/flytestdlib/errors"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+)
+
+const (
+	ErrNetworkPolicy errors.ErrorCode = "NETWORK_POLICY_ERROR"
+
+	metadataXForwardedFor = "x-forwarded-for"
+)
+
+type networks []*net.IPNet
+
+func (n networks) Contains(ip net.IP) bool {
+	for _, network := range n {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseNetworks(cidrs []string) (networks, error) {
+	parsed := make(networks, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(ErrNetworkPolicy, err, "invalid CIDR [%v]", cidr)
+		}
+
+		parsed = append(parsed, network)
+	}
+
+	return parsed, nil
+}
+
+type networkPolicyRule struct {
+	methods []string
+	allow   networks
+	deny    networks
+}
+
+func (r networkPolicyRule) Matches(fullMethod string) bool {
+	for _, pattern := range r.methods {
+		if matched, _ := path.Match(pattern, fullMethod); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r networkPolicyRule) Allows(ip net.IP) bool {
+	if r.deny.Contains(ip) {
+		return false
+	}
+
+	return len(r.allow) == 0 || r.allow.Contains(ip)
+}
+
+// NetworkPolicy decides whether clients are allowed to call RPCs based on their network address.
+type NetworkPolicy struct {
+	trustedProxies networks
+	rules          []networkPolicyRule
+}
+
+// ClientIP returns the address of the client that originated the request. If the peer is a trusted proxy, the
+// X-Forwarded-For header is walked from the right and the first address not belonging to a trusted proxy is returned.
+func (p NetworkPolicy) ClientIP(ctx context.Context) net.IP {
+	peerInfo, ok := peer.FromContext(ctx)
+	if !ok || peerInfo.Addr == nil {
+		return nil
+	}
+
+	host := peerInfo.Addr.String()
+	if splitHost, _, err := net.SplitHostPort(host); err == nil {
+		host = splitHost
+	}
+
+	clientIP := net.ParseIP(host)
+	if clientIP == nil || !p.trustedProxies.Contains(clientIP) {
+		return clientIP
+	}
+
+	forwardedFor := strings.Split(metautils.ExtractIncoming(ctx).Get(metadataXForwardedFor), ",")
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		forwardedIP := net.ParseIP(strings.TrimSpace(forwardedFor[i]))
+		if forwardedIP == nil {
+			break
+		}
+
+		clientIP = forwardedIP
+		if !p.trustedProxies.Contains(forwardedIP) {
+			break
+		}
+	}
+
+	return clientIP
+}
+
+// Allows returns whether the client at ip may call fullMethod. The first rule matching the method is applied and
+// methods matching no rule are allowed.
+func (p NetworkPolicy) Allows(fullMethod string, ip net.IP) bool {
+	for _, rule := range p.rules {
+		if rule.Matches(fullMethod) {
+			return ip != nil && rule.Allows(ip)
+		}
+	}
+
+	return true
+}
+
+// UnaryServerInterceptor rejects requests from clients the policy doesn't allow to call the requested RPC.
+func (p NetworkPolicy) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (resp interface{}, err error) {
+
+	clientIP := p.ClientIP(ctx)
+	if !p.Allows(info.FullMethod, clientIP) {
+		logger.Infof(ctx, "Network policy denied [%v] from [%v]", info.FullMethod, clientIP)
+		return nil, status.Errorf(codes.PermissionDenied, "calls to [%v] are not allowed from this network",
+			info.FullMethod)
+	}
+
+	return handler(ctx, req)
+}
+
+func NewNetworkPolicy(cfg config.NetworkPolicyConfig) (NetworkPolicy, error) {
+	trustedProxies, err := parseNetworks(cfg.TrustedProxies)
+	if err != nil {
+		return NetworkPolicy{}, err
+	}
+
+	rules := make([]networkPolicyRule, 0, len(cfg.Rules))
+	for _, ruleCfg := range cfg.Rules {
+		for _, pattern := range ruleCfg.Methods {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return NetworkPolicy{}, errors.Wrapf(ErrNetworkPolicy, err, "invalid method pattern [%v]", pattern)
+			}
+		}
+
+		allow, err := parseNetworks(ruleCfg.Allow)
+		if err != nil {
+			return NetworkPolicy{}, err
+		}
+
+		deny, err := parseNetworks(ruleCfg.Deny)
+		if err != nil {
+			return NetworkPolicy{}, err
+		}
+
+		rules = append(rules, networkPolicyRule{
+			methods: ruleCfg.Methods,
+			allow:   allow,
+			deny:    deny,
+		})
+	}
+
+	return NetworkPolicy{
+		trustedProxies: trustedProxies,
+		rules:          rules,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+)
+
+const createTaskEventMethod = "/flyteidl.service.AdminService/CreateTaskEvent"
+
+var testNetworkPolicyConfig = config.NetworkPolicyConfig{
+	Enabled:        true,
+	TrustedProxies: []string{"127.0.0.1/32", "10.1.0.0/16"},
+	Rules: []config.NetworkPolicyRule{
+		{
+			Methods: []string{"/flyteidl.service.AdminService/Create*Event"},
+			Allow:   []string{"10.0.0.0/8"},
+			Deny:    []string{"10.2.0.0/16"},
+		},
+	},
+}
+
+func contextFromPeer(ip string, forwardedFor string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+	if len(forwardedFor) > 0 {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(metadataXForwardedFor, forwardedFor))
+	}
+
+	return ctx
+}
+
+func TestNewNetworkPolicy_InvalidConfig(t *testing.T) {
+	_, err := NewNetworkPolicy(config.NetworkPolicyConfig{TrustedProxies: []string{"not-a-cidr"}})
+	assert.Error(t, err)
+
+	_, err = NewNetworkPolicy(config.NetworkPolicyConfig{Rules: []config.NetworkPolicyRule{{Methods: []string{"["}}}})
+	assert.Error(t, err)
+}
+
+func TestNetworkPolicy_ClientIP(t *testing.T) {
+	policy, err := NewNetworkPolicy(testNetworkPolicyConfig)
+	assert.NoError(t, err)
+
+	t.Run("direct", func(t *testing.T) {
+		assert.Equal(t, "192.168.0.1", policy.ClientIP(contextFromPeer("192.168.0.1", "10.0.0.1")).String())
+	})
+
+	t.Run("trusted proxy", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.1", policy.ClientIP(contextFromPeer("127.0.0.1", "10.0.0.1")).String())
+	})
+
+	t.Run("chained trusted proxies", func(t *testing.T) {
+		assert.Equal(t, "192.168.0.1",
+			policy.ClientIP(contextFromPeer("127.0.0.1", "10.0.0.1, 192.168.0.1, 10.1.0.5")).String())
+	})
+
+	t.Run("no peer", func(t *testing.T) {
+		assert.Nil(t, policy.ClientIP(context.Background()))
+	})
+}
+
+func TestNetworkPolicy_Allows(t *testing.T) {
+	policy, err := NewNetworkPolicy(testNetworkPolicyConfig)
+	assert.NoError(t, err)
+
+	assert.True(t, policy.Allows(createTaskEventMethod, net.ParseIP("10.0.0.1")))
+	assert.False(t, policy.Allows(createTaskEventMethod, net.ParseIP("192.168.0.1")))
+	assert.False(t, policy.Allows(createTaskEventMethod, net.ParseIP("10.2.0.1")))
+	assert.False(t, policy.Allows(createTaskEventMethod, nil))
+	assert.True(t, policy.Allows("/flyteidl.service.AdminService/GetExecution", net.ParseIP("192.168.0.1")))
+}
+
+func TestNetworkPolicy_UnaryServerInterceptor(t *testing.T) {
+	policy, err := NewNetworkPolicy(testNetworkPolicyConfig)
+	assert.NoError(t, err)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: createTaskEventMethod}
+	resp, err := policy.UnaryServerInterceptor(contextFromPeer("10.0.0.1", ""), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = policy.UnaryServerInterceptor(contextFromPeer("192.168.0.1", ""), nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"context"
+
+	"github.com/flyteorg/flytestdlib/logger"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/manager/interfaces"
+)
+
+// NewOrgVisibilityInterceptor returns a unary interceptor which lets callers view the projects of the orgs they belong
+// to, in addition to those their roles grant visibility to. It must be chained after the authentication interceptors,
+// which resolve the orgs of callers and the projects they may view. Callers whose visibility isn't restricted are left
+// as they are.
+func NewOrgVisibilityInterceptor(orgManager interfaces.OrgInterface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		resp interface{}, err error) {
+
+		visibleProjects, restricted := auth.VisibleProjectsFromContext(ctx)
+		orgs := auth.OrgsFromContext(ctx)
+		if !restricted || orgs.Len() == 0 {
+			return handler(ctx, req)
+		}
+
+		orgProjects, err := orgManager.GetOrgProjectIDs(ctx, orgs.List())
+		if err != nil {
+			logger.Errorf(ctx, "Failed to look up the projects of orgs %v: %v", orgs.List(), err)
+			return nil, err
+		}
+
+		return handler(auth.WithVisibleProjects(ctx, visibleProjects.Union(sets.NewString(orgProjects...))), req)
+	}
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyteadmin/auth"
+	"github.com/flyteorg/flyteadmin/pkg/manager/mocks"
+)
+
+func TestOrgVisibilityInterceptor(t *testing.T) {
+	orgManager := &mocks.OrgManager{
+		GetOrgProjectIDsFunc: func(ctx context.Context, orgs []string) ([]string, error) {
+			assert.Equal(t, []string{"research"}, orgs)
+			return []string{"flytesnacks", "flyteexamples"}, nil
+		},
+	}
+	interceptor := NewOrgVisibilityInterceptor(orgManager)
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/flyteidl.service.AdminService/ListProjects",
+	}
+	var visibleProjects sets.String
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		visibleProjects, _ = auth.VisibleProjectsFromContext(ctx)
+		return nil, nil
+	}
+
+	ctx := auth.WithVisibleProjects(context.Background(), sets.NewString("sandbox"))
+	_, err := interceptor(auth.WithOrgs(ctx, sets.NewString("research")), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, sets.NewString("sandbox", "flytesnacks", "flyteexamples"), visibleProjects)
+
+	// Callers who belong to no orgs keep the projects their roles grant visibility to.
+	_, err = interceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, sets.NewString("sandbox"), visibleProjects)
+
+	orgManager.GetOrgProjectIDsFunc = func(ctx context.Context, orgs []string) ([]string, error) {
+		return nil, errors.New("failed to list projects")
+	}
+	_, err = interceptor(auth.WithOrgs(ctx, sets.NewString("research")), nil, info, handler)
+	assert.Error(t, err)
+}
@@ -0,0 +1,267 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	"github.com/flyteorg/flytestdlib/logger"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// Spans sampled while this many are waiting to be exported are dropped.
+	maxQueuedSpans        = 4096
+	defaultExportInterval = 5 * time.Second
+	exportTimeout         = 10 * time.Second
+	instrumentationScope  = "github.com/flyteorg/flyteadmin"
+)
+
+// OTLP span kinds and status codes.
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindServer   = 2
+	otlpSpanKindClient   = 3
+	otlpStatusCodeError  = 2
+)
+
+// The JSON encoding of OTLP trace export requests.
+type otlpTraces struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func toOTLPValue(value interface{}) otlpAnyValue {
+	switch v := value.(type) {
+	case string:
+		return otlpAnyValue{StringValue: &v}
+	case bool:
+		return otlpAnyValue{BoolValue: &v}
+	case int64:
+		intValue := strconv.FormatInt(v, 10)
+		return otlpAnyValue{IntValue: &intValue}
+	case float64:
+		return otlpAnyValue{DoubleValue: &v}
+	default:
+		stringValue := fmt.Sprint(v)
+		return otlpAnyValue{StringValue: &stringValue}
+	}
+}
+
+func toOTLPAttributes(attributes map[string]interface{}) []otlpKeyValue {
+	keyValues := make([]otlpKeyValue, 0, len(attributes))
+	for key, value := range attributes {
+		keyValues = append(keyValues, otlpKeyValue{
+			Key:   key,
+			Value: toOTLPValue(value),
+		})
+	}
+	sort.Slice(keyValues, func(i, j int) bool {
+		return keyValues[i].Key < keyValues[j].Key
+	})
+	return keyValues
+}
+
+func toOTLPSpan(span *trace.SpanData) otlpSpan {
+	otlp := otlpSpan{
+		TraceID:           hex.EncodeToString(span.TraceID[:]),
+		SpanID:            hex.EncodeToString(span.SpanID[:]),
+		Name:              span.Name,
+		StartTimeUnixNano: strconv.FormatInt(span.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+		Attributes:        toOTLPAttributes(span.Attributes),
+	}
+	if span.ParentSpanID != (trace.SpanID{}) {
+		otlp.ParentSpanID = hex.EncodeToString(span.ParentSpanID[:])
+	}
+	switch span.SpanKind {
+	case trace.SpanKindServer:
+		otlp.Kind = otlpSpanKindServer
+	case trace.SpanKindClient:
+		otlp.Kind = otlpSpanKindClient
+	default:
+		otlp.Kind = otlpSpanKindInternal
+	}
+	// The codes of spans are gRPC codes, of which only OK isn't an error.
+	if span.Code != 0 {
+		otlp.Status = otlpStatus{
+			Code:    otlpStatusCodeError,
+			Message: span.Message,
+		}
+	}
+	return otlp
+}
+
+// Queues the spans sampled and periodically posts them to an OTLP/HTTP collector as JSON.
+type otlpExporter struct {
+	client      *http.Client
+	endpoint    string
+	headers     map[string]string
+	serviceName string
+
+	lock    sync.Mutex
+	spans   []*trace.SpanData
+	dropped int
+}
+
+func (e *otlpExporter) ExportSpan(span *trace.SpanData) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if len(e.spans) >= maxQueuedSpans {
+		e.dropped++
+		return
+	}
+	e.spans = append(e.spans, span)
+}
+
+func (e *otlpExporter) toTraces(spans []*trace.SpanData) otlpTraces {
+	otlpSpans := make([]otlpSpan, len(spans))
+	for i, span := range spans {
+		otlpSpans[i] = toOTLPSpan(span)
+	}
+	return otlpTraces{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: toOTLPAttributes(map[string]interface{}{
+						"service.name": e.serviceName,
+					}),
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{
+							Name: instrumentationScope,
+						},
+						Spans: otlpSpans,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Posts the queued spans to the collector. Spans which fail to export are dropped.
+func (e *otlpExporter) flush(ctx context.Context) error {
+	e.lock.Lock()
+	spans, dropped := e.spans, e.dropped
+	e.spans, e.dropped = nil, 0
+	e.lock.Unlock()
+	if dropped > 0 {
+		logger.Warningf(ctx, "Dropped %d spans sampled while the export queue was full", dropped)
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(e.toTraces(spans))
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for name, value := range e.headers {
+		request.Header.Set(name, value)
+	}
+	response, err := e.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("collector [%s] responded with status %d to an export of %d spans",
+			e.endpoint, response.StatusCode, len(spans))
+	}
+	return nil
+}
+
+// Exports the queued spans every interval until ctx is done, and then once more.
+func (e *otlpExporter) run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultExportInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := e.flush(context.Background()); err != nil {
+				logger.Warningf(context.Background(), "Failed to export spans with err: %v", err)
+			}
+			return
+		case <-ticker.C:
+			if err := e.flush(ctx); err != nil {
+				logger.Warningf(ctx, "Failed to export spans with err: %v", err)
+			}
+		}
+	}
+}
+
+func newOTLPExporter(cfg config.TracingConfig) *otlpExporter {
+	return &otlpExporter{
+		client: &http.Client{
+			Timeout: exportTimeout,
+		},
+		endpoint:    cfg.Endpoint,
+		headers:     cfg.Headers,
+		serviceName: cfg.ServiceName,
+	}
+}
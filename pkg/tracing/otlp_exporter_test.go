@@ -0,0 +1,126 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/trace"
+)
+
+func getSpanDataForTest() *trace.SpanData {
+	spanContext, _ := ParseTraceparent(sampledTraceparent)
+	startTime := time.Unix(1600000000, 0)
+	return &trace.SpanData{
+		SpanContext:  spanContext,
+		ParentSpanID: trace.SpanID{1},
+		SpanKind:     trace.SpanKindServer,
+		Name:         "/flyteidl.service.AdminService/CreateExecution",
+		StartTime:    startTime,
+		EndTime:      startTime.Add(time.Second),
+		Attributes: map[string]interface{}{
+			"rpc.system":           "grpc",
+			"rpc.grpc.status_code": int64(5),
+		},
+		Status: trace.Status{
+			Code:    5,
+			Message: "missing",
+		},
+	}
+}
+
+func TestToOTLPSpan(t *testing.T) {
+	span := toOTLPSpan(getSpanDataForTest())
+	serialized, err := json.Marshal(span)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"traceId": "4bf92f3577b34da6a3ce929d0e0e4736",
+		"spanId": "00f067aa0ba902b7",
+		"parentSpanId": "0100000000000000",
+		"name": "/flyteidl.service.AdminService/CreateExecution",
+		"kind": 2,
+		"startTimeUnixNano": "1600000000000000000",
+		"endTimeUnixNano": "1600000001000000000",
+		"attributes": [
+			{"key": "rpc.grpc.status_code", "value": {"intValue": "5"}},
+			{"key": "rpc.system", "value": {"stringValue": "grpc"}}
+		],
+		"status": {"code": 2, "message": "missing"}
+	}`, string(serialized))
+}
+
+func TestOTLPExporter_Flush(t *testing.T) {
+	var exports []otlpTraces
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "token", r.Header.Get("Authorization"))
+		body, _ := ioutil.ReadAll(r.Body)
+		var export otlpTraces
+		assert.NoError(t, json.Unmarshal(body, &export))
+		exports = append(exports, export)
+	}))
+	defer server.Close()
+	exporter := newOTLPExporter(config.TracingConfig{
+		Endpoint: server.URL + "/v1/traces",
+		Headers: map[string]string{
+			"Authorization": "token",
+		},
+		ServiceName: "flyteadmin",
+	})
+
+	// Nothing is posted until spans are sampled.
+	assert.NoError(t, exporter.flush(context.Background()))
+	assert.Empty(t, exports)
+
+	exporter.ExportSpan(getSpanDataForTest())
+	exporter.ExportSpan(getSpanDataForTest())
+	assert.NoError(t, exporter.flush(context.Background()))
+	assert.Len(t, exports, 1)
+	assert.Len(t, exports[0].ResourceSpans, 1)
+	assert.Equal(t, "service.name", exports[0].ResourceSpans[0].Resource.Attributes[0].Key)
+	assert.Equal(t, "flyteadmin", *exports[0].ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+	assert.Len(t, exports[0].ResourceSpans[0].ScopeSpans[0].Spans, 2)
+	assert.Empty(t, exporter.spans)
+}
+
+func TestOTLPExporter_QueueIsBounded(t *testing.T) {
+	exporter := newOTLPExporter(config.TracingConfig{})
+	for i := 0; i <= maxQueuedSpans; i++ {
+		exporter.ExportSpan(getSpanDataForTest())
+	}
+	assert.Len(t, exporter.spans, maxQueuedSpans)
+	assert.Equal(t, 1, exporter.dropped)
+}
+
+func TestOTLPExporter_FlushFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	exporter := newOTLPExporter(config.TracingConfig{
+		Endpoint: server.URL,
+	})
+
+	exporter.ExportSpan(getSpanDataForTest())
+	err := exporter.flush(context.Background())
+	assert.EqualError(t, err, "collector ["+server.URL+"] responded with status 503 to an export of 1 spans")
+}
+
+func TestInit_Disabled(t *testing.T) {
+	stop, err := Init(context.Background(), config.TracingConfig{})
+	assert.NoError(t, err)
+	stop()
+
+	_, err = Init(context.Background(), config.TracingConfig{
+		Enabled: true,
+	})
+	assert.EqualError(t, err, "tracing requires the OTLP endpoint of a collector")
+}
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"io"
+
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"go.opencensus.io/trace"
+)
+
+const referenceAttribute = "storage.reference"
+
+// Spans the blob store operations issued while serving traced requests.
+type tracedStore struct {
+	storage.ComposedProtobufStore
+}
+
+func (s *tracedStore) Head(ctx context.Context, reference storage.DataReference) (storage.Metadata, error) {
+	ctx, span := StartChildSpan(ctx, "storage.Head", trace.StringAttribute(referenceAttribute, reference.String()))
+	metadata, err := s.ComposedProtobufStore.Head(ctx, reference)
+	EndSpan(span, err)
+	return metadata, err
+}
+
+func (s *tracedStore) ReadRaw(ctx context.Context, reference storage.DataReference) (io.ReadCloser, error) {
+	ctx, span := StartChildSpan(ctx, "storage.ReadRaw", trace.StringAttribute(referenceAttribute, reference.String()))
+	reader, err := s.ComposedProtobufStore.ReadRaw(ctx, reference)
+	EndSpan(span, err)
+	return reader, err
+}
+
+func (s *tracedStore) WriteRaw(ctx context.Context, reference storage.DataReference, size int64, opts storage.Options,
+	raw io.Reader) error {
+	ctx, span := StartChildSpan(ctx, "storage.WriteRaw", trace.StringAttribute(referenceAttribute, reference.String()),
+		trace.Int64Attribute("storage.size", size))
+	err := s.ComposedProtobufStore.WriteRaw(ctx, reference, size, opts, raw)
+	EndSpan(span, err)
+	return err
+}
+
+func (s *tracedStore) CopyRaw(ctx context.Context, source, destination storage.DataReference,
+	opts storage.Options) error {
+	ctx, span := StartChildSpan(ctx, "storage.CopyRaw", trace.StringAttribute("storage.source", source.String()),
+		trace.StringAttribute(referenceAttribute, destination.String()))
+	err := s.ComposedProtobufStore.CopyRaw(ctx, source, destination, opts)
+	EndSpan(span, err)
+	return err
+}
+
+func (s *tracedStore) ReadProtobuf(ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+	ctx, span := StartChildSpan(ctx, "storage.ReadProtobuf",
+		trace.StringAttribute(referenceAttribute, reference.String()))
+	err := s.ComposedProtobufStore.ReadProtobuf(ctx, reference, msg)
+	EndSpan(span, err)
+	return err
+}
+
+func (s *tracedStore) WriteProtobuf(ctx context.Context, reference storage.DataReference, opts storage.Options,
+	msg proto.Message) error {
+	ctx, span := StartChildSpan(ctx, "storage.WriteProtobuf",
+		trace.StringAttribute(referenceAttribute, reference.String()))
+	err := s.ComposedProtobufStore.WriteProtobuf(ctx, reference, opts, msg)
+	EndSpan(span, err)
+	return err
+}
+
+// Wraps the store so that its operations are spanned.
+func NewStore(store storage.ComposedProtobufStore) storage.ComposedProtobufStore {
+	return &tracedStore{
+		ComposedProtobufStore: store,
+	}
+}
@@ -0,0 +1,168 @@
+// Package tracing traces the RPCs admin serves along with the work they fan out to, and exports the traces to an
+// OpenTelemetry collector.
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/flyteorg/flyteadmin/pkg/config"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// The W3C trace context header clients continue their traces in admin with.
+	traceparentHeader = "traceparent"
+	// Annotates launched workflows with the W3C traceparent of the span which launched them, for propeller to continue
+	// the trace.
+	TraceparentAnnotation = "flyte.org/traceparent"
+
+	traceparentVersion = "00"
+)
+
+// Formats the span context as a W3C traceparent.
+func FormatTraceparent(spanContext trace.SpanContext) string {
+	return fmt.Sprintf("%s-%s-%s-%02x", traceparentVersion, hex.EncodeToString(spanContext.TraceID[:]),
+		hex.EncodeToString(spanContext.SpanID[:]), uint32(spanContext.TraceOptions)&1)
+}
+
+// Parses a W3C traceparent, returning false if it isn't valid.
+func ParseTraceparent(traceparent string) (trace.SpanContext, bool) {
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == traceparentVersion && len(parts) != 4) {
+		return trace.SpanContext{}, false
+	}
+	var spanContext trace.SpanContext
+	traceID, err := hex.DecodeString(parts[1])
+	if err != nil || len(traceID) != len(spanContext.TraceID) {
+		return trace.SpanContext{}, false
+	}
+	spanID, err := hex.DecodeString(parts[2])
+	if err != nil || len(spanID) != len(spanContext.SpanID) {
+		return trace.SpanContext{}, false
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil || len(flags) != 1 {
+		return trace.SpanContext{}, false
+	}
+	copy(spanContext.TraceID[:], traceID)
+	copy(spanContext.SpanID[:], spanID)
+	if spanContext.TraceID == (trace.TraceID{}) || spanContext.SpanID == (trace.SpanID{}) {
+		return trace.SpanContext{}, false
+	}
+	spanContext.TraceOptions = trace.TraceOptions(flags[0] & 1)
+	return spanContext, true
+}
+
+// Starts a span, which is the root of a new trace unless ctx already belongs to one.
+func StartSpan(ctx context.Context, name string, attributes ...trace.Attribute) (context.Context, *trace.Span) {
+	ctx, span := trace.StartSpan(ctx, name)
+	span.AddAttributes(attributes...)
+	return ctx, span
+}
+
+// Starts a span only if ctx belongs to a trace, for operations too frequent to be traced on their own, such as
+// database statements. Returns a nil span otherwise.
+func StartChildSpan(ctx context.Context, name string, attributes ...trace.Attribute) (context.Context, *trace.Span) {
+	if trace.FromContext(ctx) == nil {
+		return ctx, nil
+	}
+	return StartSpan(ctx, name, attributes...)
+}
+
+// Ends the span, recording the error if the operation it spans failed.
+func EndSpan(span *trace.Span, err error) {
+	if span == nil {
+		return
+	}
+	if err != nil {
+		grpcStatus := status.Convert(err)
+		span.SetStatus(trace.Status{
+			Code:    int32(grpcStatus.Code()),
+			Message: grpcStatus.Message(),
+		})
+	}
+	span.End()
+}
+
+// Adds the traceparent of the span in ctx, if any, to the annotations of a workflow being launched.
+func InjectAnnotations(ctx context.Context, annotations map[string]string) {
+	span := trace.FromContext(ctx)
+	if span == nil {
+		return
+	}
+	annotations[TraceparentAnnotation] = FormatTraceparent(span.SpanContext())
+}
+
+// Spans every RPC served, continuing the trace of the client if it sent a traceparent.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	var span *trace.Span
+	if parent, ok := getIncomingSpanContext(ctx); ok {
+		ctx, span = trace.StartSpanWithRemoteParent(ctx, info.FullMethod, parent,
+			trace.WithSpanKind(trace.SpanKindServer))
+	} else {
+		ctx, span = trace.StartSpan(ctx, info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
+	}
+	span.AddAttributes(
+		trace.StringAttribute("rpc.system", "grpc"),
+		trace.StringAttribute("rpc.method", info.FullMethod),
+	)
+	resp, err := handler(ctx, req)
+	span.AddAttributes(trace.Int64Attribute("rpc.grpc.status_code", int64(status.Code(err))))
+	EndSpan(span, err)
+	return resp, err
+}
+
+// Forwards the traceparent of HTTP requests served by the gateway to the gRPC server.
+func ForwardTraceparent(ctx context.Context, request *http.Request) metadata.MD {
+	if traceparent := request.Header.Get(traceparentHeader); len(traceparent) > 0 {
+		return metadata.Pairs(traceparentHeader, traceparent)
+	}
+	return nil
+}
+
+func getIncomingSpanContext(ctx context.Context) (trace.SpanContext, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return trace.SpanContext{}, false
+	}
+	values := md.Get(traceparentHeader)
+	if len(values) == 0 {
+		return trace.SpanContext{}, false
+	}
+	return ParseTraceparent(values[0])
+}
+
+// Starts exporting the spans sampled to the collector. The returned function stops exporting them once those
+// remaining are exported.
+func Init(ctx context.Context, cfg config.TracingConfig) (func(), error) {
+	if !cfg.Enabled {
+		return func() {}, nil
+	}
+	if len(cfg.Endpoint) == 0 {
+		return nil, fmt.Errorf("tracing requires the OTLP endpoint of a collector")
+	}
+	exporter := newOTLPExporter(cfg)
+	trace.RegisterExporter(exporter)
+	trace.ApplyConfig(trace.Config{
+		DefaultSampler: trace.ProbabilitySampler(cfg.SamplingFraction),
+	})
+	ctx, cancel := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		exporter.run(ctx, cfg.ExportInterval.Duration)
+	}()
+	return func() {
+		trace.UnregisterExporter(exporter)
+		cancel()
+		<-stopped
+	}, nil
+}
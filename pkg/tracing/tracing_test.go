@@ -0,0 +1,109 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const sampledTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+type spanRecorder struct {
+	spans []*trace.SpanData
+}
+
+func (r *spanRecorder) ExportSpan(span *trace.SpanData) {
+	r.spans = append(r.spans, span)
+}
+
+func recordSpans(t *testing.T) *spanRecorder {
+	recorder := &spanRecorder{}
+	trace.RegisterExporter(recorder)
+	t.Cleanup(func() {
+		trace.UnregisterExporter(recorder)
+	})
+	return recorder
+}
+
+func TestParseTraceparent(t *testing.T) {
+	spanContext, ok := ParseTraceparent(sampledTraceparent)
+	assert.True(t, ok)
+	assert.True(t, spanContext.IsSampled())
+	assert.Equal(t, sampledTraceparent, FormatTraceparent(spanContext))
+
+	spanContext, ok = ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	assert.True(t, ok)
+	assert.False(t, spanContext.IsSampled())
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"00-xyz92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	} {
+		_, ok := ParseTraceparent(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	recorder := recordSpans(t)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(traceparentHeader, sampledTraceparent))
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/flyteidl.service.AdminService/CreateExecution",
+	}
+	var annotations = map[string]string{}
+
+	_, err := UnaryServerInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		InjectAnnotations(ctx, annotations)
+		return nil, status.Error(codes.NotFound, "missing")
+	})
+	assert.Error(t, err)
+	assert.Len(t, recorder.spans, 1)
+	span := recorder.spans[0]
+	assert.Equal(t, info.FullMethod, span.Name)
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+	assert.True(t, span.HasRemoteParent)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", span.ParentSpanID.String())
+	assert.Equal(t, int32(codes.NotFound), span.Code)
+	assert.Equal(t, "missing", span.Message)
+	assert.Equal(t, FormatTraceparent(span.SpanContext), annotations[TraceparentAnnotation])
+}
+
+func TestStartChildSpan(t *testing.T) {
+	recorder := recordSpans(t)
+
+	_, span := StartChildSpan(context.Background(), "child")
+	assert.Nil(t, span)
+	EndSpan(span, nil)
+
+	ctx, parent := trace.StartSpan(context.Background(), "parent", trace.WithSampler(trace.AlwaysSample()))
+	_, span = StartChildSpan(ctx, "child", trace.StringAttribute("key", "value"))
+	EndSpan(span, nil)
+	parent.End()
+	assert.Len(t, recorder.spans, 2)
+	assert.Equal(t, "child", recorder.spans[0].Name)
+	assert.Equal(t, parent.SpanContext().SpanID, recorder.spans[0].ParentSpanID)
+	assert.Equal(t, "value", recorder.spans[0].Attributes["key"])
+}
+
+func TestForwardTraceparent(t *testing.T) {
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/projects", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, ForwardTraceparent(context.Background(), request))
+
+	request.Header.Set("Traceparent", sampledTraceparent)
+	assert.Equal(t, []string{sampledTraceparent}, ForwardTraceparent(context.Background(), request).Get(traceparentHeader))
+}
@@ -0,0 +1,160 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+)
+
+const (
+	defaultAgentTimeout = 10 * time.Second
+	agentWorkflowsPath  = "/api/v1/workflows"
+)
+
+// The request posted to the agent to launch a workflow. The agent creates the workflow in the namespace of the
+// cluster it runs in.
+type AgentLaunchRequest struct {
+	Cluster   string                  `json:"cluster"`
+	Namespace string                  `json:"namespace"`
+	Workflow  *v1alpha1.FlyteWorkflow `json:"workflow"`
+}
+
+// Returned when the agent responds with an unexpected status.
+type agentStatusError struct {
+	url        string
+	statusCode int
+	message    string
+}
+
+func (e *agentStatusError) Error() string {
+	return fmt.Sprintf("agent [%s] responded with status %d: %s", e.url, e.statusCode, e.message)
+}
+
+// Launches workflows by posting them to an agent which runs in each execution cluster, for deployments where admin
+// can't reach the K8s API of the clusters directly.
+//
+// Workflows are created with POST {endpoint}/api/v1/workflows, to which the agent responds with 409 Conflict if the
+// workflow already exists. They're deleted with DELETE {endpoint}/api/v1/workflows/{namespace}/{name}?cluster={id},
+// to which the agent responds with 404 Not Found if the workflow doesn't exist.
+type agentLauncher struct {
+	client           *http.Client
+	endpoint         string
+	clusterEndpoints map[string]string
+	headers          map[string]string
+}
+
+func (l *agentLauncher) getEndpoint(cluster string) (string, error) {
+	endpoint, ok := l.clusterEndpoints[cluster]
+	if !ok {
+		endpoint = l.endpoint
+	}
+	if len(endpoint) == 0 {
+		return "", fmt.Errorf("no agent endpoint is configured for cluster [%s]", cluster)
+	}
+	return strings.TrimSuffix(endpoint, "/"), nil
+}
+
+// Sends a request to the agent, ignoring the given status which indicates the request has no effect.
+func (l *agentLauncher) do(ctx context.Context, method, requestURL string, body []byte, ignoredStatus int) error {
+	request, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range l.headers {
+		request.Header.Set(name, value)
+	}
+	response, err := l.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	message, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+	if response.StatusCode == ignoredStatus {
+		return nil
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return &agentStatusError{
+			url:        requestURL,
+			statusCode: response.StatusCode,
+			message:    strings.TrimSpace(string(message)),
+		}
+	}
+	return nil
+}
+
+func (l *agentLauncher) Create(ctx context.Context, target *executioncluster.ExecutionTarget, namespace string,
+	flyteWf *v1alpha1.FlyteWorkflow) error {
+	endpoint, err := l.getEndpoint(target.ID)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(AgentLaunchRequest{
+		Cluster:   target.ID,
+		Namespace: namespace,
+		Workflow:  flyteWf,
+	})
+	if err != nil {
+		return err
+	}
+	return l.do(ctx, http.MethodPost, endpoint+agentWorkflowsPath, body, http.StatusConflict)
+}
+
+func (l *agentLauncher) Delete(ctx context.Context, target *executioncluster.ExecutionTarget, namespace, name string) error {
+	endpoint, err := l.getEndpoint(target.ID)
+	if err != nil {
+		return err
+	}
+	requestURL := fmt.Sprintf("%s%s/%s/%s?cluster=%s", endpoint, agentWorkflowsPath, url.PathEscape(namespace),
+		url.PathEscape(name), url.QueryEscape(target.ID))
+	return l.do(ctx, http.MethodDelete, requestURL, nil, http.StatusNotFound)
+}
+
+func newAgentLauncher(config runtimeInterfaces.AgentExecutorConfig) *agentLauncher {
+	timeout := defaultAgentTimeout
+	if config.TimeoutSeconds > 0 {
+		timeout = time.Duration(config.TimeoutSeconds) * time.Second
+	}
+	return &agentLauncher{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		endpoint:         config.Endpoint,
+		clusterEndpoints: config.ClusterEndpoints,
+		headers:          config.Headers,
+	}
+}
+
+// Builds workflows the same way as propeller, but launches them through the agent.
+func newAgentExecutor(dependencies ExecutorDependencies) (interfaces.Executor, error) {
+	agentConfig := dependencies.Config.Agent
+	if len(agentConfig.Endpoint) == 0 && len(agentConfig.ClusterEndpoints) == 0 {
+		return nil, fmt.Errorf("the agent executor requires an agent endpoint")
+	}
+	scope := dependencies.Scope.NewSubScope("agent")
+	propeller := NewFlytePropeller(dependencies.RoleNameKey, dependencies.ExecutionCluster, scope,
+		dependencies.NamespaceMappingConfig, dependencies.EventVersion).(*FlytePropeller)
+	propeller.launcher = newResilientLauncher(newAgentLauncher(agentConfig), dependencies.Config,
+		scope.NewSubScope("launcher"))
+	offloader, err := newWorkflowOffloader(dependencies.DataStore, dependencies.Config.Offloading,
+		scope.NewSubScope("offloading"))
+	if err != nil {
+		return nil, err
+	}
+	propeller.offloader = offloader
+	return propeller, nil
+}
@@ -0,0 +1,168 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func getAgentExecutorForTest(t *testing.T, endpoint string) *FlytePropeller {
+	executor, err := newAgentExecutor(getExecutorDependenciesForTest(runtimeInterfaces.ExecutorConfig{
+		Type: AgentExecutor,
+		Agent: runtimeInterfaces.AgentExecutorConfig{
+			Endpoint: endpoint,
+			Headers: map[string]string{
+				"Authorization": "Bearer token",
+			},
+		},
+	}))
+	assert.NoError(t, err)
+	propeller := executor.(*FlytePropeller)
+	propeller.builder = &FlyteWorkflowBuilderTest{
+		buildCallback: func(wfClosure *core.CompiledWorkflowClosure, inputs *core.LiteralMap,
+			executionID *core.WorkflowExecutionIdentifier, namespace string) (*v1alpha1.FlyteWorkflow, error) {
+			return &v1alpha1.FlyteWorkflow{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      executionID.Name,
+					Namespace: namespace,
+				},
+			}, nil
+		},
+	}
+	return propeller
+}
+
+func getExecuteWorkflowInputForTest() interfaces.ExecuteWorkflowInput {
+	return interfaces.ExecuteWorkflowInput{
+		ExecutionID: &core.WorkflowExecutionIdentifier{
+			Project: "p",
+			Domain:  "d",
+			Name:    "n",
+		},
+		WfClosure: core.CompiledWorkflowClosure{
+			Primary: &core.CompiledWorkflow{
+				Template: &core.WorkflowTemplate{},
+			},
+		},
+		Reference: admin.LaunchPlan{
+			Id: &core.Identifier{
+				Name: "lp",
+			},
+			Spec: &admin.LaunchPlanSpec{
+				WorkflowId: &core.Identifier{
+					Name: "wf",
+				},
+			},
+		},
+		AcceptedAt: acceptedAt,
+		Labels: map[string]string{
+			"customlabel": "labelval",
+		},
+	}
+}
+
+func TestAgentExecutor_ExecuteWorkflow(t *testing.T) {
+	var launchRequests []AgentLaunchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, agentWorkflowsPath, r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		body, _ := ioutil.ReadAll(r.Body)
+		var launchRequest AgentLaunchRequest
+		assert.NoError(t, json.Unmarshal(body, &launchRequest))
+		launchRequests = append(launchRequests, launchRequest)
+		if len(launchRequests) > 1 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	executor := getAgentExecutorForTest(t, server.URL)
+
+	execInfo, err := executor.ExecuteWorkflow(context.Background(), getExecuteWorkflowInputForTest())
+	assert.NoError(t, err)
+	assert.Equal(t, clusterName, execInfo.Cluster)
+	assert.Len(t, launchRequests, 1)
+	assert.Equal(t, clusterName, launchRequests[0].Cluster)
+	assert.Equal(t, "p-d", launchRequests[0].Namespace)
+	assert.Equal(t, "n", launchRequests[0].Workflow.Name)
+	assert.Equal(t, "labelval", launchRequests[0].Workflow.Labels["customlabel"])
+
+	// Launching a workflow the agent already created succeeds.
+	_, err = executor.ExecuteWorkflow(context.Background(), getExecuteWorkflowInputForTest())
+	assert.NoError(t, err)
+	assert.Len(t, launchRequests, 2)
+}
+
+func TestAgentExecutor_ExecuteWorkflowFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("cluster unavailable"))
+	}))
+	defer server.Close()
+	executor := getAgentExecutorForTest(t, server.URL)
+
+	_, err := executor.ExecuteWorkflow(context.Background(), getExecuteWorkflowInputForTest())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "responded with status 503: cluster unavailable")
+}
+
+func TestAgentExecutor_TerminateWorkflowExecution(t *testing.T) {
+	var requestURIs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		requestURIs = append(requestURIs, r.URL.RequestURI())
+		if len(requestURIs) > 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	executor := getAgentExecutorForTest(t, server.URL)
+	input := interfaces.TerminateWorkflowInput{
+		ExecutionID: &core.WorkflowExecutionIdentifier{
+			Project: "p",
+			Domain:  "d",
+			Name:    "n",
+		},
+		Cluster: clusterName,
+	}
+
+	assert.NoError(t, executor.TerminateWorkflowExecution(context.Background(), input))
+	// Terminating a workflow the agent already deleted succeeds.
+	assert.NoError(t, executor.TerminateWorkflowExecution(context.Background(), input))
+	assert.Equal(t, []string{
+		"/api/v1/workflows/p-d/n?cluster=C1",
+		"/api/v1/workflows/p-d/n?cluster=C1",
+	}, requestURIs)
+}
+
+func TestAgentLauncher_ClusterEndpoints(t *testing.T) {
+	launcher := newAgentLauncher(runtimeInterfaces.AgentExecutorConfig{
+		ClusterEndpoints: map[string]string{
+			"C2": "http://agent.c2/",
+		},
+	})
+	endpoint, err := launcher.getEndpoint("C2")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://agent.c2", endpoint)
+
+	err = launcher.Create(context.Background(), &executioncluster.ExecutionTarget{ID: "C1"}, "p-d",
+		&v1alpha1.FlyteWorkflow{})
+	assert.EqualError(t, err, "no agent endpoint is configured for cluster [C1]")
+}
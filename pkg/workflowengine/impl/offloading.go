@@ -0,0 +1,162 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/flyteorg/flyteadmin/pkg/manager/impl/shared"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flytepropeller/pkg/compiler/transformers/k8s"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Annotates FlyteWorkflows launched without their static spec with the location of the compiled workflow closure
+// propeller rebuilds it from.
+const OffloadedWorkflowClosureAnnotation = "flyte.org/offloaded-workflow-closure"
+
+const offloadedWorkflowClosureKey = "offloaded_workflow_closure"
+
+// Annotates FlyteWorkflows launched without their inputs with the location of the inputs admin offloaded, which
+// propeller reads them from.
+const OffloadedInputsAnnotation = "flyte.org/offloaded-inputs"
+
+// The size of the FlyteWorkflow serialized as it's sent to the K8s API.
+func getFlyteWorkflowSize(flyteWf *v1alpha1.FlyteWorkflow) (int, error) {
+	serialized, err := json.Marshal(flyteWf)
+	if err != nil {
+		return 0, err
+	}
+	return len(serialized), nil
+}
+
+// Estimates the size of the FlyteWorkflows the compiled workflow is launched as, excluding their inputs.
+func EstimateFlyteWorkflowSize(wfClosure *core.CompiledWorkflowClosure) (int, error) {
+	flyteWf, err := k8s.BuildFlyteWorkflow(wfClosure, nil, nil, "")
+	if err != nil {
+		return 0, err
+	}
+	return getFlyteWorkflowSize(flyteWf)
+}
+
+type offloaderMetrics struct {
+	Scope                  promutils.Scope
+	FlyteWorkflowSizeBytes prometheus.Summary
+	WorkflowsOffloaded     prometheus.Counter
+	OffloadFailures        prometheus.Counter
+	InputsOffloaded        prometheus.Counter
+}
+
+// Moves the static spec of large FlyteWorkflows to blob storage.
+type workflowOffloader struct {
+	storageClient  *storage.DataStore
+	thresholdBytes int
+	// Zero when inputs aren't offloaded.
+	inputsThresholdBytes int
+	// Empty when workflows are offloaded in all clusters.
+	clusters sets.String
+	metrics  offloaderMetrics
+}
+
+func (o *workflowOffloader) isClusterReady(cluster string) bool {
+	return o.clusters.Len() == 0 || o.clusters.Has(cluster)
+}
+
+func annotate(flyteWf *v1alpha1.FlyteWorkflow, key, value string) {
+	if flyteWf.Annotations == nil {
+		flyteWf.Annotations = map[string]string{}
+	}
+	flyteWf.Annotations[key] = value
+}
+
+// Offloads the spec of the FlyteWorkflow if it's too large and the cluster it's launched in is ready for it, leaving
+// the FlyteWorkflow with only the id of its spec.
+func (o *workflowOffloader) offload(ctx context.Context, cluster string, wfClosure *core.CompiledWorkflowClosure,
+	executionID *core.WorkflowExecutionIdentifier, flyteWf *v1alpha1.FlyteWorkflow) error {
+	if !o.isClusterReady(cluster) {
+		return nil
+	}
+	size, err := getFlyteWorkflowSize(flyteWf)
+	if err != nil {
+		return err
+	}
+	o.metrics.FlyteWorkflowSizeBytes.Observe(float64(size))
+	if size < o.thresholdBytes {
+		return nil
+	}
+	closureRef, err := o.storageClient.ConstructReference(ctx, o.storageClient.GetBaseContainerFQN(ctx),
+		shared.Metadata, executionID.Project, executionID.Domain, executionID.Name, offloadedWorkflowClosureKey)
+	if err != nil {
+		o.metrics.OffloadFailures.Inc()
+		return err
+	}
+	if err = o.storageClient.WriteProtobuf(ctx, closureRef, storage.Options{}, wfClosure); err != nil {
+		o.metrics.OffloadFailures.Inc()
+		return err
+	}
+	flyteWf.WorkflowSpec = &v1alpha1.WorkflowSpec{
+		ID: flyteWf.ID,
+	}
+	flyteWf.Tasks = nil
+	flyteWf.SubWorkflows = nil
+	annotate(flyteWf, OffloadedWorkflowClosureAnnotation, closureRef.String())
+	o.metrics.WorkflowsOffloaded.Inc()
+	logger.Infof(ctx, "Offloaded the spec of workflow [%s] of %d bytes to %s", flyteWf.Name, size, closureRef)
+	return nil
+}
+
+// Drops the inputs of the FlyteWorkflow if they're too large and the cluster it's launched in is ready for it, leaving
+// the FlyteWorkflow with the location admin offloaded them to when the execution was created.
+func (o *workflowOffloader) offloadInputs(ctx context.Context, cluster string, inputsURI storage.DataReference,
+	flyteWf *v1alpha1.FlyteWorkflow) error {
+	if o.inputsThresholdBytes <= 0 || len(inputsURI) == 0 || flyteWf.Inputs == nil || !o.isClusterReady(cluster) {
+		return nil
+	}
+	serialized, err := json.Marshal(flyteWf.Inputs)
+	if err != nil {
+		return err
+	}
+	if len(serialized) < o.inputsThresholdBytes {
+		return nil
+	}
+	flyteWf.Inputs = nil
+	annotate(flyteWf, OffloadedInputsAnnotation, inputsURI.String())
+	o.metrics.InputsOffloaded.Inc()
+	logger.Infof(ctx, "Launching workflow [%s] without its inputs of %d bytes, which are offloaded to %s",
+		flyteWf.Name, len(serialized), inputsURI)
+	return nil
+}
+
+// Returns nil unless offloading is enabled.
+func newWorkflowOffloader(storageClient *storage.DataStore, config runtimeInterfaces.WorkflowOffloadingConfig,
+	scope promutils.Scope) (*workflowOffloader, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	if storageClient == nil {
+		return nil, fmt.Errorf("offloading workflows requires a storage client")
+	}
+	return &workflowOffloader{
+		storageClient:        storageClient,
+		thresholdBytes:       config.ThresholdBytes,
+		inputsThresholdBytes: config.InputsThresholdBytes,
+		clusters:             sets.NewString(config.Clusters...),
+		metrics: offloaderMetrics{
+			Scope: scope,
+			FlyteWorkflowSizeBytes: scope.MustNewSummary("flyte_workflow_size_bytes",
+				"size in bytes of serialized FlyteWorkflows in clusters they may be offloaded in"),
+			WorkflowsOffloaded: scope.MustNewCounter("workflows_offloaded",
+				"count of FlyteWorkflows launched with their spec offloaded to blob storage"),
+			OffloadFailures: scope.MustNewCounter("offload_failures",
+				"count of FlyteWorkflows whose spec failed to offload"),
+			InputsOffloaded: scope.MustNewCounter("inputs_offloaded",
+				"count of FlyteWorkflows launched without their inputs, which are offloaded to blob storage"),
+		},
+	}, nil
+}
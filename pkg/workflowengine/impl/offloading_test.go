@@ -0,0 +1,164 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	commonMocks "github.com/flyteorg/flyteadmin/pkg/common/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+var offloadingExecutionID = &core.WorkflowExecutionIdentifier{
+	Project: "p",
+	Domain:  "d",
+	Name:    "n",
+}
+
+var offloadingClosure = &core.CompiledWorkflowClosure{
+	Primary: &core.CompiledWorkflow{
+		Template: &core.WorkflowTemplate{
+			Id: &core.Identifier{
+				Name: "wf",
+			},
+		},
+	},
+}
+
+func getFlyteWorkflowForOffloadingTest() *v1alpha1.FlyteWorkflow {
+	return &v1alpha1.FlyteWorkflow{
+		WorkflowSpec: &v1alpha1.WorkflowSpec{
+			ID: "wf",
+			Nodes: map[v1alpha1.NodeID]*v1alpha1.NodeSpec{
+				"n1": {
+					ID: "n1",
+				},
+			},
+		},
+		Tasks: map[v1alpha1.TaskID]*v1alpha1.TaskSpec{
+			"t1": {},
+		},
+	}
+}
+
+func getWorkflowOffloaderForTest(t *testing.T, storageClient *storage.DataStore,
+	clusters []string) *workflowOffloader {
+	offloader, err := newWorkflowOffloader(storageClient, runtimeInterfaces.WorkflowOffloadingConfig{
+		Enabled:        true,
+		ThresholdBytes: 1,
+		Clusters:       clusters,
+	}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	return offloader
+}
+
+func TestNewWorkflowOffloader(t *testing.T) {
+	offloader, err := newWorkflowOffloader(nil, runtimeInterfaces.WorkflowOffloadingConfig{}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	assert.Nil(t, offloader)
+
+	_, err = newWorkflowOffloader(nil, runtimeInterfaces.WorkflowOffloadingConfig{
+		Enabled: true,
+	}, promutils.NewTestScope())
+	assert.EqualError(t, err, "offloading workflows requires a storage client")
+}
+
+func TestWorkflowOffloader_Offload(t *testing.T) {
+	storageClient := commonMocks.GetMockStorageClient()
+	var offloadedRef storage.DataReference
+	var offloadedClosure proto.Message
+	storageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).WriteProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, opts storage.Options, msg proto.Message) error {
+		offloadedRef = reference
+		offloadedClosure = msg
+		return nil
+	}
+	offloader := getWorkflowOffloaderForTest(t, storageClient, nil)
+	flyteWf := getFlyteWorkflowForOffloadingTest()
+
+	err := offloader.offload(context.Background(), "C1", offloadingClosure, offloadingExecutionID, flyteWf)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.DataReference("s3://bucket/metadata/p/d/n/offloaded_workflow_closure"), offloadedRef)
+	assert.True(t, proto.Equal(offloadingClosure, offloadedClosure))
+	assert.Equal(t, offloadedRef.String(), flyteWf.Annotations[OffloadedWorkflowClosureAnnotation])
+	assert.Equal(t, v1alpha1.WorkflowID("wf"), flyteWf.ID)
+	assert.Empty(t, flyteWf.Nodes)
+	assert.Empty(t, flyteWf.Tasks)
+}
+
+func TestWorkflowOffloader_BelowThreshold(t *testing.T) {
+	offloader := getWorkflowOffloaderForTest(t, commonMocks.GetMockStorageClient(), nil)
+	offloader.thresholdBytes = 1024 * 1024
+	flyteWf := getFlyteWorkflowForOffloadingTest()
+
+	err := offloader.offload(context.Background(), "C1", offloadingClosure, offloadingExecutionID, flyteWf)
+	assert.NoError(t, err)
+	assert.Equal(t, getFlyteWorkflowForOffloadingTest(), flyteWf)
+}
+
+func TestWorkflowOffloader_ClusterNotReady(t *testing.T) {
+	storageClient := commonMocks.GetMockStorageClient()
+	storageClient.ComposedProtobufStore.(*commonMocks.TestDataStore).WriteProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, opts storage.Options, msg proto.Message) error {
+		return nil
+	}
+	offloader := getWorkflowOffloaderForTest(t, storageClient, []string{"C2"})
+	flyteWf := getFlyteWorkflowForOffloadingTest()
+
+	err := offloader.offload(context.Background(), "C1", offloadingClosure, offloadingExecutionID, flyteWf)
+	assert.NoError(t, err)
+	assert.Equal(t, getFlyteWorkflowForOffloadingTest(), flyteWf)
+
+	err = offloader.offload(context.Background(), "C2", offloadingClosure, offloadingExecutionID, flyteWf)
+	assert.NoError(t, err)
+	assert.Contains(t, flyteWf.Annotations, OffloadedWorkflowClosureAnnotation)
+}
+
+func TestWorkflowOffloader_OffloadInputs(t *testing.T) {
+	offloader := getWorkflowOffloaderForTest(t, commonMocks.GetMockStorageClient(), []string{"C2"})
+	offloader.inputsThresholdBytes = 1
+	inputsURI := storage.DataReference("s3://bucket/metadata/p/d/n/inputs")
+	getFlyteWorkflow := func() *v1alpha1.FlyteWorkflow {
+		flyteWf := getFlyteWorkflowForOffloadingTest()
+		flyteWf.Inputs = &v1alpha1.Inputs{
+			LiteralMap: &core.LiteralMap{
+				Literals: map[string]*core.Literal{
+					"foo": {},
+				},
+			},
+		}
+		return flyteWf
+	}
+
+	t.Run("cluster not ready", func(t *testing.T) {
+		flyteWf := getFlyteWorkflow()
+		err := offloader.offloadInputs(context.Background(), "C1", inputsURI, flyteWf)
+		assert.NoError(t, err)
+		assert.Equal(t, getFlyteWorkflow(), flyteWf)
+	})
+	t.Run("no inputs uri", func(t *testing.T) {
+		flyteWf := getFlyteWorkflow()
+		err := offloader.offloadInputs(context.Background(), "C2", "", flyteWf)
+		assert.NoError(t, err)
+		assert.Equal(t, getFlyteWorkflow(), flyteWf)
+	})
+	t.Run("offloaded", func(t *testing.T) {
+		flyteWf := getFlyteWorkflow()
+		err := offloader.offloadInputs(context.Background(), "C2", inputsURI, flyteWf)
+		assert.NoError(t, err)
+		assert.Nil(t, flyteWf.Inputs)
+		assert.Equal(t, inputsURI.String(), flyteWf.Annotations[OffloadedInputsAnnotation])
+	})
+	t.Run("below threshold", func(t *testing.T) {
+		offloader.inputsThresholdBytes = 1024 * 1024
+		flyteWf := getFlyteWorkflow()
+		err := offloader.offloadInputs(context.Background(), "C2", inputsURI, flyteWf)
+		assert.NoError(t, err)
+		assert.Equal(t, getFlyteWorkflow(), flyteWf)
+	})
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/flyteorg/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
 	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/tracing"
 	"github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
+	"go.opencensus.io/trace"
 
 	"github.com/flyteorg/flytestdlib/promutils"
 	"github.com/prometheus/client_golang/prometheus"
@@ -16,6 +18,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/storage"
 
 	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
@@ -38,13 +41,48 @@ type propellerMetrics struct {
 	TerminateExecutionFailure prometheus.Counter
 }
 
+// Creates and deletes FlyteWorkflow resources in the execution clusters they're launched in.
+type workflowLauncher interface {
+	// Creates the workflow, succeeding if it already exists.
+	Create(ctx context.Context, target *executioncluster.ExecutionTarget, namespace string,
+		flyteWf *v1alpha1.FlyteWorkflow) error
+	// Deletes the workflow, succeeding if it doesn't exist.
+	Delete(ctx context.Context, target *executioncluster.ExecutionTarget, namespace, name string) error
+}
+
+// Launches workflows through the K8s API of their execution cluster.
+type k8sLauncher struct{}
+
+func (l *k8sLauncher) Create(ctx context.Context, target *executioncluster.ExecutionTarget, namespace string,
+	flyteWf *v1alpha1.FlyteWorkflow) error {
+	_, err := target.FlyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(namespace).Create(ctx, flyteWf, v1.CreateOptions{})
+	if err != nil && !k8_api_err.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func (l *k8sLauncher) Delete(ctx context.Context, target *executioncluster.ExecutionTarget, namespace, name string) error {
+	err := target.FlyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(namespace).Delete(ctx, name, v1.DeleteOptions{
+		PropagationPolicy: &deletePropagationBackground,
+	})
+	// An IsNotFound error indicates the resource is already deleted.
+	if err != nil && !k8_api_err.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 type FlytePropeller struct {
 	executionCluster interfaces2.ClusterInterface
 	builder          interfaces.FlyteWorkflowInterface
+	launcher         workflowLauncher
 	roleNameKey      string
 	metrics          propellerMetrics
 	config           runtimeInterfaces.NamespaceMappingConfiguration
 	eventVersion     v1alpha1.EventVersion
+	// Nil unless offloading is enabled.
+	offloader *workflowOffloader
 }
 
 type FlyteWorkflowBuilder struct{}
@@ -146,6 +184,40 @@ func addExecutionOverrides(taskPluginOverrides []*admin.PluginOverride,
 	flyteWf.ExecutionConfig = executionConfig
 }
 
+func (c *FlytePropeller) offloadSpec(ctx context.Context, cluster string, wfClosure *core.CompiledWorkflowClosure,
+	executionID *core.WorkflowExecutionIdentifier, inputsURI storage.DataReference,
+	flyteWf *v1alpha1.FlyteWorkflow) error {
+	if c.offloader == nil {
+		return nil
+	}
+	if err := c.offloader.offload(ctx, cluster, wfClosure, executionID, flyteWf); err != nil {
+		logger.Errorf(ctx, "failed to offload the spec of workflow [%+v] with err %v", wfClosure.Primary.Template.Id, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to offload the workflow spec %v", err)
+	}
+	if err := c.offloader.offloadInputs(ctx, cluster, inputsURI, flyteWf); err != nil {
+		logger.Errorf(ctx, "failed to offload the inputs of workflow [%+v] with err %v",
+			wfClosure.Primary.Template.Id, err)
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to offload the workflow inputs %v", err)
+	}
+	return nil
+}
+
+// Creates the workflow in its cluster. Workflows launched while serving a traced request are annotated with the trace
+// for propeller to continue.
+func (c *FlytePropeller) createWorkflow(ctx context.Context, target *executioncluster.ExecutionTarget,
+	namespace string, flyteWf *v1alpha1.FlyteWorkflow) error {
+	ctx, span := tracing.StartChildSpan(ctx, "executor.CreateWorkflow",
+		trace.StringAttribute("cluster", target.ID),
+		trace.StringAttribute("namespace", namespace),
+		trace.StringAttribute("workflow", flyteWf.Name))
+	if span != nil {
+		tracing.InjectAnnotations(ctx, flyteWf.Annotations)
+	}
+	err := c.launcher.Create(ctx, target, namespace, flyteWf)
+	tracing.EndSpan(span, err)
+	return err
+}
+
 func (c *FlytePropeller) ExecuteWorkflow(ctx context.Context, input interfaces.ExecuteWorkflowInput) (*interfaces.ExecutionInfo, error) {
 	if input.ExecutionID == nil {
 		c.metrics.InvalidExecutionID.Inc()
@@ -205,14 +277,23 @@ func (c *FlytePropeller) ExecuteWorkflow(ctx context.Context, input interfaces.E
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to create workflow in propeller %v", err)
 	}
-	_, err = targetCluster.FlyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(namespace).Create(ctx, flyteWf, v1.CreateOptions{})
+	if input.DryRun {
+		// Dry runs return the workflow as it's built, before its spec and inputs are offloaded.
+		return &interfaces.ExecutionInfo{
+			Cluster:       targetCluster.ID,
+			FlyteWorkflow: flyteWf,
+		}, nil
+	}
+	if err = c.offloadSpec(ctx, targetCluster.ID, &input.WfClosure, input.ExecutionID, input.InputsURI,
+		flyteWf); err != nil {
+		return nil, err
+	}
+	err = c.createWorkflow(ctx, targetCluster, namespace, flyteWf)
 	if err != nil {
-		if !k8_api_err.IsAlreadyExists(err) {
-			logger.Debugf(ctx, "failed to create workflow [%+v] in cluster %s %v",
-				input.WfClosure.Primary.Template.Id, targetCluster.ID, err)
-			c.metrics.ExecutionCreationFailure.Inc()
-			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to create workflow in propeller %v", err)
-		}
+		logger.Debugf(ctx, "failed to create workflow [%+v] in cluster %s %v",
+			input.WfClosure.Primary.Template.Id, targetCluster.ID, err)
+		c.metrics.ExecutionCreationFailure.Inc()
+		return nil, errors.NewFlyteAdminErrorf(getLaunchErrorCode(err), "failed to create workflow in propeller %v", err)
 	}
 
 	logger.Debugf(ctx, "Successfully created workflow execution [%+v]", input.WfClosure.Primary.Template.Id)
@@ -282,14 +363,16 @@ func (c *FlytePropeller) ExecuteTask(ctx context.Context, input interfaces.Execu
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to create workflow in propeller %v", err)
 	}
-	_, err = targetCluster.FlyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(namespace).Create(ctx, flyteWf, v1.CreateOptions{})
+	if err = c.offloadSpec(ctx, targetCluster.ID, &input.WfClosure, input.ExecutionID, input.InputsURI,
+		flyteWf); err != nil {
+		return nil, err
+	}
+	err = c.createWorkflow(ctx, targetCluster, namespace, flyteWf)
 	if err != nil {
-		if !k8_api_err.IsAlreadyExists(err) {
-			logger.Debugf(ctx, "failed to create workflow [%+v] in cluster %s %v",
-				input.WfClosure.Primary.Template.Id, targetCluster.ID, err)
-			c.metrics.ExecutionCreationFailure.Inc()
-			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to create workflow in propeller %v", err)
-		}
+		logger.Debugf(ctx, "failed to create workflow [%+v] in cluster %s %v",
+			input.WfClosure.Primary.Template.Id, targetCluster.ID, err)
+		c.metrics.ExecutionCreationFailure.Inc()
+		return nil, errors.NewFlyteAdminErrorf(getLaunchErrorCode(err), "failed to create workflow in propeller %v", err)
 	}
 
 	logger.Debugf(ctx, "Successfully created workflow execution [%+v]", input.WfClosure.Primary.Template.Id)
@@ -313,11 +396,8 @@ func (c *FlytePropeller) TerminateWorkflowExecution(
 	if err != nil {
 		return errors.NewFlyteAdminErrorf(codes.Internal, err.Error())
 	}
-	err = target.FlyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(namespace).Delete(ctx, input.ExecutionID.GetName(), v1.DeleteOptions{
-		PropagationPolicy: &deletePropagationBackground,
-	})
-	// An IsNotFound error indicates the resource is already deleted.
-	if err != nil && !k8_api_err.IsNotFound(err) {
+	err = c.launcher.Delete(ctx, target, namespace, input.ExecutionID.GetName())
+	if err != nil {
 		c.metrics.TerminateExecutionFailure.Inc()
 		logger.Errorf(ctx, "failed to terminate execution %v", input.ExecutionID)
 		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to terminate execution: %v with err %v", input.ExecutionID, err)
@@ -350,6 +430,7 @@ func NewFlytePropeller(roleNameKey string, executionCluster interfaces2.ClusterI
 	return &FlytePropeller{
 		executionCluster: executionCluster,
 		builder:          &FlyteWorkflowBuilder{},
+		launcher:         &k8sLauncher{},
 		roleNameKey:      roleNameKey,
 		metrics:          newPropellerMetrics(scope),
 		config:           configuration,
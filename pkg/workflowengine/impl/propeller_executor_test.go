@@ -53,6 +53,7 @@ func getFlytePropellerForTest(execCluster interfaces2.ClusterInterface, builder
 	return &FlytePropeller{
 		executionCluster: execCluster,
 		builder:          builder,
+		launcher:         &k8sLauncher{},
 		roleNameKey:      roleNameKey,
 		metrics:          propellerTestMetrics,
 		config:           config,
@@ -332,6 +333,55 @@ func TestExecuteWorkflowAlreadyExistsNoError(t *testing.T) {
 	assert.Equal(t, clusterName, execInfo.Cluster)
 }
 
+func TestExecuteWorkflowDryRun(t *testing.T) {
+	cluster := getFakeExecutionCluster()
+	fakeFlyteWF.flyteWorkflowsCallback = func(namespace string) v1alpha12.FlyteWorkflowInterface {
+		t.Fatal("dry runs shouldn't create workflows")
+		return nil
+	}
+	propeller := getFlytePropellerForTest(cluster, &FlyteWorkflowBuilderTest{})
+
+	execInfo, err := propeller.ExecuteWorkflow(
+		context.Background(),
+		interfaces.ExecuteWorkflowInput{
+			ExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: "p",
+				Domain:  "d",
+				Name:    "n",
+			},
+			WfClosure: core.CompiledWorkflowClosure{
+				Primary: &core.CompiledWorkflow{
+					Template: &core.WorkflowTemplate{},
+				},
+			},
+			Reference: admin.LaunchPlan{
+				Id: &core.Identifier{
+					Project: "p",
+					Domain:  "d",
+				},
+				Spec: &admin.LaunchPlanSpec{
+					WorkflowId: &core.Identifier{
+						Name: "wf",
+					},
+				},
+			},
+			AcceptedAt: acceptedAt,
+			Labels: map[string]string{
+				"customlabel": "labelval",
+			},
+			Auth: &admin.AuthRole{
+				KubernetesServiceAccount: testK8sServiceAccount,
+			},
+			DryRun: true,
+		})
+
+	assert.Nil(t, err)
+	assert.Equal(t, clusterName, execInfo.Cluster)
+	assert.Equal(t, "labelval", execInfo.FlyteWorkflow.Labels["customlabel"])
+	assert.Equal(t, testK8sServiceAccount, execInfo.FlyteWorkflow.ServiceAccountName)
+	assert.Equal(t, "n", execInfo.FlyteWorkflow.ExecutionID.Name)
+}
+
 func TestExecuteWorkflowBuildFailed(t *testing.T) {
 	cluster := getFakeExecutionCluster()
 	builder := FlyteWorkflowBuilderTest{}
@@ -0,0 +1,96 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flytestdlib/logger"
+)
+
+// The number of workflows the recording launcher remembers. The oldest are forgotten first.
+const maxRecordedWorkflows = 1000
+
+// A workflow the recording launcher was asked to create.
+type RecordedWorkflow struct {
+	Cluster   string
+	Namespace string
+	Workflow  *v1alpha1.FlyteWorkflow
+	// Whether the workflow was since asked to be deleted, e.g. when its execution was terminated.
+	Deleted bool
+}
+
+// Records the workflows it's asked to create and delete rather than launching them, for admin served without an
+// execution cluster, e.g. so that frontend and SDK developers can test against admin locally. Executions launched this
+// way are never run, so they remain in the phase they were created in until they're terminated.
+type recordingLauncher struct {
+	lock      sync.Mutex
+	workflows map[string]*RecordedWorkflow
+	// The keys of the recorded workflows, from oldest to newest.
+	order []string
+}
+
+func getRecordedWorkflowKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+func (l *recordingLauncher) Create(ctx context.Context, target *executioncluster.ExecutionTarget, namespace string,
+	flyteWf *v1alpha1.FlyteWorkflow) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	key := getRecordedWorkflowKey(namespace, flyteWf.Name)
+	if _, ok := l.workflows[key]; ok {
+		return nil
+	}
+	if len(l.order) >= maxRecordedWorkflows {
+		delete(l.workflows, l.order[0])
+		l.order = l.order[1:]
+	}
+	l.workflows[key] = &RecordedWorkflow{
+		Cluster:   target.ID,
+		Namespace: namespace,
+		Workflow:  flyteWf,
+	}
+	l.order = append(l.order, key)
+	logger.Infof(ctx, "Recorded workflow [%s] in namespace [%s] rather than launching it", flyteWf.Name, namespace)
+	return nil
+}
+
+func (l *recordingLauncher) Delete(ctx context.Context, target *executioncluster.ExecutionTarget, namespace, name string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if workflow, ok := l.workflows[getRecordedWorkflowKey(namespace, name)]; ok {
+		workflow.Deleted = true
+		logger.Infof(ctx, "Recorded the deletion of workflow [%s] in namespace [%s]", name, namespace)
+	}
+	return nil
+}
+
+// Returns the workflow recorded with the given namespace and name, if it's remembered.
+func (l *recordingLauncher) get(namespace, name string) (RecordedWorkflow, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	workflow, ok := l.workflows[getRecordedWorkflowKey(namespace, name)]
+	if !ok {
+		return RecordedWorkflow{}, false
+	}
+	return *workflow, true
+}
+
+func newRecordingLauncher() *recordingLauncher {
+	return &recordingLauncher{
+		workflows: make(map[string]*RecordedWorkflow),
+	}
+}
+
+// Builds workflows the same way as propeller, so that executions are validated as they would be, but only records them.
+func newRecordingExecutor(dependencies ExecutorDependencies) (interfaces.Executor, error) {
+	propeller := NewFlytePropeller(dependencies.RoleNameKey, dependencies.ExecutionCluster,
+		dependencies.Scope.NewSubScope("recording"), dependencies.NamespaceMappingConfig,
+		dependencies.EventVersion).(*FlytePropeller)
+	propeller.launcher = newRecordingLauncher()
+	return propeller, nil
+}
@@ -0,0 +1,55 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newRecordedFlyteWorkflow(name string) *v1alpha1.FlyteWorkflow {
+	return &v1alpha1.FlyteWorkflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name: name,
+		},
+	}
+}
+
+func TestRecordingLauncher(t *testing.T) {
+	launcher := newRecordingLauncher()
+	target := &executioncluster.ExecutionTarget{ID: "sandbox"}
+
+	assert.NoError(t, launcher.Create(context.Background(), target, "p-d", newRecordedFlyteWorkflow("name")))
+	workflow, ok := launcher.get("p-d", "name")
+	assert.True(t, ok)
+	assert.Equal(t, "sandbox", workflow.Cluster)
+	assert.Equal(t, "name", workflow.Workflow.Name)
+	assert.False(t, workflow.Deleted)
+
+	assert.NoError(t, launcher.Delete(context.Background(), target, "p-d", "name"))
+	workflow, _ = launcher.get("p-d", "name")
+	assert.True(t, workflow.Deleted)
+	// Deleting workflows which weren't recorded succeeds, as it does for those which don't exist.
+	assert.NoError(t, launcher.Delete(context.Background(), target, "p-d", "other"))
+	_, ok = launcher.get("p-d", "other")
+	assert.False(t, ok)
+}
+
+func TestRecordingLauncher_ForgetsOldest(t *testing.T) {
+	launcher := newRecordingLauncher()
+	target := &executioncluster.ExecutionTarget{ID: "sandbox"}
+	for i := 0; i <= maxRecordedWorkflows; i++ {
+		assert.NoError(t, launcher.Create(
+			context.Background(), target, "p-d", newRecordedFlyteWorkflow(fmt.Sprintf("name-%d", i))))
+	}
+
+	_, ok := launcher.get("p-d", "name-0")
+	assert.False(t, ok)
+	_, ok = launcher.get("p-d", fmt.Sprintf("name-%d", maxRecordedWorkflows))
+	assert.True(t, ok)
+	assert.Len(t, launcher.workflows, maxRecordedWorkflows)
+}
@@ -0,0 +1,84 @@
+package impl
+
+import (
+	"fmt"
+	"sync"
+
+	interfaces2 "github.com/flyteorg/flyteadmin/pkg/executioncluster/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/flyteorg/flytestdlib/storage"
+)
+
+// The names executors are registered and configured by.
+const (
+	PropellerExecutor = "propeller"
+	AgentExecutor     = "agent"
+	// Records workflows rather than launching them, for admin served in sandbox mode.
+	RecordingExecutor = "recording"
+)
+
+// The dependencies executors are created with.
+type ExecutorDependencies struct {
+	RoleNameKey            string
+	ExecutionCluster       interfaces2.ClusterInterface
+	Scope                  promutils.Scope
+	NamespaceMappingConfig runtimeInterfaces.NamespaceMappingConfiguration
+	EventVersion           int
+	Config                 runtimeInterfaces.ExecutorConfig
+	// Stores the specs of offloaded workflows.
+	DataStore *storage.DataStore
+}
+
+type ExecutorFactory func(dependencies ExecutorDependencies) (interfaces.Executor, error)
+
+var executorFactoriesLock sync.RWMutex
+var executorFactories = map[string]ExecutorFactory{
+	PropellerExecutor: newPropellerExecutor,
+	AgentExecutor:     newAgentExecutor,
+	RecordingExecutor: newRecordingExecutor,
+}
+
+func newPropellerExecutor(dependencies ExecutorDependencies) (interfaces.Executor, error) {
+	scope := dependencies.Scope.NewSubScope("flytepropeller")
+	propeller := NewFlytePropeller(dependencies.RoleNameKey, dependencies.ExecutionCluster, scope,
+		dependencies.NamespaceMappingConfig, dependencies.EventVersion).(*FlytePropeller)
+	propeller.launcher = newResilientLauncher(propeller.launcher, dependencies.Config, scope.NewSubScope("launcher"))
+	offloader, err := newWorkflowOffloader(dependencies.DataStore, dependencies.Config.Offloading,
+		scope.NewSubScope("offloading"))
+	if err != nil {
+		return nil, err
+	}
+	propeller.offloader = offloader
+	return propeller, nil
+}
+
+// Registers an executor so that deployments can select it by name in the executor config. Registering a name twice
+// panics.
+func RegisterExecutor(name string, factory ExecutorFactory) {
+	executorFactoriesLock.Lock()
+	defer executorFactoriesLock.Unlock()
+	if factory == nil {
+		panic(fmt.Sprintf("executor [%s] registered without a factory", name))
+	}
+	if _, ok := executorFactories[name]; ok {
+		panic(fmt.Sprintf("executor [%s] is already registered", name))
+	}
+	executorFactories[name] = factory
+}
+
+// Creates the executor the executor config selects, which defaults to propeller.
+func NewExecutor(dependencies ExecutorDependencies) (interfaces.Executor, error) {
+	name := dependencies.Config.Type
+	if len(name) == 0 {
+		name = PropellerExecutor
+	}
+	executorFactoriesLock.RLock()
+	factory, ok := executorFactories[name]
+	executorFactoriesLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no executor is registered as [%s]", name)
+	}
+	return factory(dependencies)
+}
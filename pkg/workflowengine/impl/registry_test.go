@@ -0,0 +1,89 @@
+package impl
+
+import (
+	"testing"
+
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/workflowengine/interfaces"
+	"github.com/flyteorg/flyteadmin/pkg/workflowengine/mocks"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func getExecutorDependenciesForTest(executorConfig runtimeInterfaces.ExecutorConfig) ExecutorDependencies {
+	return ExecutorDependencies{
+		RoleNameKey:            roleNameKey,
+		ExecutionCluster:       getFakeExecutionCluster(),
+		Scope:                  promutils.NewTestScope(),
+		NamespaceMappingConfig: config,
+		EventVersion:           2,
+		Config:                 executorConfig,
+	}
+}
+
+func TestNewExecutor_DefaultsToPropeller(t *testing.T) {
+	executor, err := NewExecutor(getExecutorDependenciesForTest(runtimeInterfaces.ExecutorConfig{}))
+	assert.NoError(t, err)
+	propeller, ok := executor.(*FlytePropeller)
+	assert.True(t, ok)
+	assert.IsType(t, &k8sLauncher{}, propeller.launcher)
+}
+
+func TestNewExecutor_Agent(t *testing.T) {
+	executor, err := NewExecutor(getExecutorDependenciesForTest(runtimeInterfaces.ExecutorConfig{
+		Type: AgentExecutor,
+		Agent: runtimeInterfaces.AgentExecutorConfig{
+			Endpoint: "http://agent",
+		},
+	}))
+	assert.NoError(t, err)
+	propeller, ok := executor.(*FlytePropeller)
+	assert.True(t, ok)
+	assert.IsType(t, &agentLauncher{}, propeller.launcher)
+
+	_, err = NewExecutor(getExecutorDependenciesForTest(runtimeInterfaces.ExecutorConfig{
+		Type: AgentExecutor,
+	}))
+	assert.EqualError(t, err, "the agent executor requires an agent endpoint")
+}
+
+func TestNewExecutor_Recording(t *testing.T) {
+	executor, err := NewExecutor(getExecutorDependenciesForTest(runtimeInterfaces.ExecutorConfig{
+		Type: RecordingExecutor,
+	}))
+	assert.NoError(t, err)
+	propeller, ok := executor.(*FlytePropeller)
+	assert.True(t, ok)
+	assert.IsType(t, &recordingLauncher{}, propeller.launcher)
+}
+
+func TestNewExecutor_Unregistered(t *testing.T) {
+	_, err := NewExecutor(getExecutorDependenciesForTest(runtimeInterfaces.ExecutorConfig{
+		Type: "unregistered",
+	}))
+	assert.EqualError(t, err, "no executor is registered as [unregistered]")
+}
+
+func TestRegisterExecutor(t *testing.T) {
+	mockExecutor := &mocks.MockExecutor{}
+	RegisterExecutor("custom", func(dependencies ExecutorDependencies) (interfaces.Executor, error) {
+		return mockExecutor, nil
+	})
+	defer func() {
+		executorFactoriesLock.Lock()
+		delete(executorFactories, "custom")
+		executorFactoriesLock.Unlock()
+	}()
+
+	executor, err := NewExecutor(getExecutorDependenciesForTest(runtimeInterfaces.ExecutorConfig{
+		Type: "custom",
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, mockExecutor, executor)
+
+	assert.Panics(t, func() {
+		RegisterExecutor(PropellerExecutor, func(dependencies ExecutorDependencies) (interfaces.Executor, error) {
+			return mockExecutor, nil
+		})
+	})
+}
@@ -0,0 +1,213 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flytestdlib/logger"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	k8_api_err "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Returned while the circuit of the cluster a workflow is launched in is open.
+type circuitOpenError struct {
+	cluster   string
+	openUntil time.Time
+}
+
+func (e *circuitOpenError) Error() string {
+	return fmt.Sprintf("launching executions in cluster [%s] is paused until %s after repeated failures",
+		e.cluster, e.openUntil.UTC().Format(time.RFC3339))
+}
+
+// Returns whether creating a workflow failed with an error which may not recur, such as those of an unavailable or
+// overloaded API server or agent. Errors other than API responses, such as refused connections, are transient.
+func isTransientLaunchError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var statusCode int
+	var apiStatus k8_api_err.APIStatus
+	var agentErr *agentStatusError
+	switch {
+	case errors.As(err, &apiStatus):
+		statusCode = int(apiStatus.Status().Code)
+	case errors.As(err, &agentErr):
+		statusCode = agentErr.statusCode
+	default:
+		return true
+	}
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// Executions launched in clusters whose circuit is open are rejected as unavailable, so that clients retry them later.
+func getLaunchErrorCode(err error) codes.Code {
+	var circuitErr *circuitOpenError
+	if errors.As(err, &circuitErr) {
+		return codes.Unavailable
+	}
+	return codes.Internal
+}
+
+type clusterCircuit struct {
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+// Tracks the consecutive launch failures in each cluster, and opens a cluster's circuit once they reach the threshold.
+type circuitBreaker struct {
+	lock             sync.Mutex
+	clock            clock.Clock
+	failureThreshold int
+	openDuration     time.Duration
+	circuits         map[string]*clusterCircuit
+}
+
+// Returns an error if the cluster's circuit is open.
+func (b *circuitBreaker) allow(cluster string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	circuit, ok := b.circuits[cluster]
+	if ok && b.clock.Now().Before(circuit.openUntil) {
+		return &circuitOpenError{
+			cluster:   cluster,
+			openUntil: circuit.openUntil,
+		}
+	}
+	return nil
+}
+
+// Records whether a launch in the cluster failed, and returns whether that opened its circuit.
+func (b *circuitBreaker) record(cluster string, failed bool) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if !failed {
+		delete(b.circuits, cluster)
+		return false
+	}
+	circuit, ok := b.circuits[cluster]
+	if !ok {
+		circuit = &clusterCircuit{}
+		b.circuits[cluster] = circuit
+	}
+	circuit.consecutiveFailures++
+	if circuit.consecutiveFailures < b.failureThreshold {
+		return false
+	}
+	circuit.openUntil = b.clock.Now().Add(b.openDuration)
+	return true
+}
+
+func newCircuitBreaker(config runtimeInterfaces.CircuitBreakerConfig, clock clock.Clock) *circuitBreaker {
+	failureThreshold := config.FailureThreshold
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+	return &circuitBreaker{
+		clock:            clock,
+		failureThreshold: failureThreshold,
+		openDuration:     config.OpenDuration.Duration,
+		circuits:         make(map[string]*clusterCircuit),
+	}
+}
+
+type resilientLauncherMetrics struct {
+	Scope                 promutils.Scope
+	CreateAttemptFailures prometheus.Counter
+	CircuitsOpened        prometheus.Counter
+	CircuitRejections     prometheus.Counter
+}
+
+// Retries creating workflows which fail with transient errors, and stops creating them in clusters which keep failing
+// for a while.
+type resilientLauncher struct {
+	launcher    workflowLauncher
+	retryConfig runtimeInterfaces.ExecutorRetryConfig
+	// Nil unless circuit breaking is enabled.
+	breaker *circuitBreaker
+	metrics resilientLauncherMetrics
+}
+
+func (l *resilientLauncher) create(ctx context.Context, target *executioncluster.ExecutionTarget, namespace string,
+	flyteWf *v1alpha1.FlyteWorkflow) error {
+	if l.retryConfig.Attempts <= 1 {
+		return l.launcher.Create(ctx, target, namespace, flyteWf)
+	}
+	return retry.Do(
+		func() error {
+			return l.launcher.Create(ctx, target, namespace, flyteWf)
+		},
+		retry.Context(ctx),
+		retry.Attempts(uint(l.retryConfig.Attempts)),
+		retry.Delay(l.retryConfig.Delay.Duration),
+		retry.MaxDelay(l.retryConfig.MaxDelay.Duration),
+		retry.DelayType(retry.BackOffDelay),
+		retry.LastErrorOnly(true),
+		retry.RetryIf(isTransientLaunchError),
+		retry.OnRetry(func(n uint, err error) {
+			l.metrics.CreateAttemptFailures.Inc()
+			logger.Infof(ctx, "Attempt %d to create workflow [%s] in cluster [%s] failed with err: %v",
+				n+1, flyteWf.Name, target.ID, err)
+		}),
+	)
+}
+
+func (l *resilientLauncher) Create(ctx context.Context, target *executioncluster.ExecutionTarget, namespace string,
+	flyteWf *v1alpha1.FlyteWorkflow) error {
+	if l.breaker == nil {
+		return l.create(ctx, target, namespace, flyteWf)
+	}
+	if err := l.breaker.allow(target.ID); err != nil {
+		l.metrics.CircuitRejections.Inc()
+		return err
+	}
+	err := l.create(ctx, target, namespace, flyteWf)
+	// Errors the API server responds with, such as invalid workflows, show the cluster is reachable.
+	if l.breaker.record(target.ID, err != nil && isTransientLaunchError(err)) {
+		l.metrics.CircuitsOpened.Inc()
+		logger.Warningf(ctx, "Paused launching executions in cluster [%s] after repeated failures, the last with err: %v",
+			target.ID, err)
+	}
+	return err
+}
+
+func (l *resilientLauncher) Delete(ctx context.Context, target *executioncluster.ExecutionTarget, namespace,
+	name string) error {
+	return l.launcher.Delete(ctx, target, namespace, name)
+}
+
+// Wraps the launcher with the retries and circuit breaking the config enables, if any.
+func newResilientLauncher(launcher workflowLauncher, config runtimeInterfaces.ExecutorConfig,
+	scope promutils.Scope) workflowLauncher {
+	if config.Retry.Attempts <= 1 && !config.CircuitBreaker.Enabled {
+		return launcher
+	}
+	resilient := &resilientLauncher{
+		launcher:    launcher,
+		retryConfig: config.Retry,
+		metrics: resilientLauncherMetrics{
+			Scope: scope,
+			CreateAttemptFailures: scope.MustNewCounter("create_attempt_failures",
+				"count of attempts to create workflows which failed with transient errors"),
+			CircuitsOpened: scope.MustNewCounter("circuits_opened",
+				"count of times launching executions in a cluster was paused after repeated failures"),
+			CircuitRejections: scope.MustNewCounter("circuit_rejections",
+				"count of executions rejected because launching executions in their cluster was paused"),
+		},
+	}
+	if config.CircuitBreaker.Enabled {
+		resilient.breaker = newCircuitBreaker(config.CircuitBreaker, clock.New())
+	}
+	return resilient
+}
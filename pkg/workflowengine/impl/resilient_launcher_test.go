@@ -0,0 +1,147 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/executioncluster"
+	runtimeInterfaces "github.com/flyteorg/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	flyteConfig "github.com/flyteorg/flytestdlib/config"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	k8_api_err "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Fails creating workflows with the given errors in turn, and succeeds once they run out.
+type fakeLauncher struct {
+	errs    []error
+	creates int
+}
+
+func (l *fakeLauncher) Create(ctx context.Context, target *executioncluster.ExecutionTarget, namespace string,
+	flyteWf *v1alpha1.FlyteWorkflow) error {
+	l.creates++
+	if len(l.errs) == 0 {
+		return nil
+	}
+	err := l.errs[0]
+	l.errs = l.errs[1:]
+	return err
+}
+
+func (l *fakeLauncher) Delete(ctx context.Context, target *executioncluster.ExecutionTarget, namespace,
+	name string) error {
+	return nil
+}
+
+var retryConfigForTest = runtimeInterfaces.ExecutorRetryConfig{
+	Attempts: 3,
+	Delay:    flyteConfig.Duration{Duration: time.Millisecond},
+	MaxDelay: flyteConfig.Duration{Duration: time.Millisecond},
+}
+
+func TestIsTransientLaunchError(t *testing.T) {
+	assert.True(t, isTransientLaunchError(k8_api_err.NewServiceUnavailable("unavailable")))
+	assert.True(t, isTransientLaunchError(k8_api_err.NewTooManyRequests("throttled", 1)))
+	assert.True(t, isTransientLaunchError(errors.New("connection refused")))
+	assert.True(t, isTransientLaunchError(&agentStatusError{statusCode: 502}))
+	assert.False(t, isTransientLaunchError(k8_api_err.NewBadRequest("invalid")))
+	assert.False(t, isTransientLaunchError(&agentStatusError{statusCode: 400}))
+	assert.False(t, isTransientLaunchError(context.Canceled))
+}
+
+func TestNewResilientLauncher_Disabled(t *testing.T) {
+	launcher := &fakeLauncher{}
+	assert.Equal(t, launcher, newResilientLauncher(launcher, runtimeInterfaces.ExecutorConfig{
+		Retry: runtimeInterfaces.ExecutorRetryConfig{
+			Attempts: 1,
+		},
+	}, promutils.NewTestScope()))
+}
+
+func TestResilientLauncher_RetriesTransientErrors(t *testing.T) {
+	launcher := &fakeLauncher{
+		errs: []error{k8_api_err.NewServiceUnavailable("unavailable"), errors.New("connection refused")},
+	}
+	resilient := newResilientLauncher(launcher, runtimeInterfaces.ExecutorConfig{
+		Retry: retryConfigForTest,
+	}, promutils.NewTestScope())
+
+	err := resilient.Create(context.Background(), &executioncluster.ExecutionTarget{ID: "C1"}, "p-d",
+		&v1alpha1.FlyteWorkflow{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, launcher.creates)
+}
+
+func TestResilientLauncher_RetriesAreBounded(t *testing.T) {
+	unavailable := k8_api_err.NewServiceUnavailable("unavailable")
+	launcher := &fakeLauncher{
+		errs: []error{unavailable, unavailable, unavailable, unavailable},
+	}
+	resilient := newResilientLauncher(launcher, runtimeInterfaces.ExecutorConfig{
+		Retry: retryConfigForTest,
+	}, promutils.NewTestScope())
+
+	err := resilient.Create(context.Background(), &executioncluster.ExecutionTarget{ID: "C1"}, "p-d",
+		&v1alpha1.FlyteWorkflow{})
+	assert.Equal(t, unavailable, err)
+	assert.Equal(t, 3, launcher.creates)
+}
+
+func TestResilientLauncher_DoesNotRetryPermanentErrors(t *testing.T) {
+	invalid := k8_api_err.NewBadRequest("invalid")
+	launcher := &fakeLauncher{
+		errs: []error{invalid},
+	}
+	resilient := newResilientLauncher(launcher, runtimeInterfaces.ExecutorConfig{
+		Retry: retryConfigForTest,
+	}, promutils.NewTestScope())
+
+	err := resilient.Create(context.Background(), &executioncluster.ExecutionTarget{ID: "C1"}, "p-d",
+		&v1alpha1.FlyteWorkflow{})
+	assert.Equal(t, invalid, err)
+	assert.Equal(t, 1, launcher.creates)
+}
+
+func TestResilientLauncher_CircuitBreaker(t *testing.T) {
+	unavailable := k8_api_err.NewServiceUnavailable("unavailable")
+	launcher := &fakeLauncher{
+		errs: []error{unavailable, unavailable},
+	}
+	circuitBreakerConfig := runtimeInterfaces.CircuitBreakerConfig{
+		Enabled:          true,
+		FailureThreshold: 2,
+		OpenDuration:     flyteConfig.Duration{Duration: 30 * time.Second},
+	}
+	resilient := newResilientLauncher(launcher, runtimeInterfaces.ExecutorConfig{
+		CircuitBreaker: circuitBreakerConfig,
+	}, promutils.NewTestScope()).(*resilientLauncher)
+	mockClock := clock.NewMock()
+	resilient.breaker = newCircuitBreaker(circuitBreakerConfig, mockClock)
+	c1 := &executioncluster.ExecutionTarget{ID: "C1"}
+	c2 := &executioncluster.ExecutionTarget{ID: "C2"}
+	create := func(target *executioncluster.ExecutionTarget) error {
+		return resilient.Create(context.Background(), target, "p-d", &v1alpha1.FlyteWorkflow{})
+	}
+
+	assert.Equal(t, unavailable, create(c1))
+	assert.Equal(t, unavailable, create(c1))
+	// The circuit of C1 is open, so launches in it fail without being attempted.
+	err := create(c1)
+	assert.IsType(t, &circuitOpenError{}, err)
+	assert.Equal(t, codes.Unavailable, getLaunchErrorCode(err))
+	assert.Equal(t, 2, launcher.creates)
+	// Other clusters are unaffected.
+	assert.NoError(t, create(c2))
+	assert.Equal(t, 3, launcher.creates)
+
+	mockClock.Add(30 * time.Second)
+	assert.NoError(t, create(c1))
+	assert.Equal(t, 4, launcher.creates)
+	assert.Empty(t, resilient.breaker.circuits)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flytestdlib/storage"
 )
 
 type TaskResources struct {
@@ -20,6 +21,7 @@ type ExecuteWorkflowInput struct {
 	ExecutionID         *core.WorkflowExecutionIdentifier
 	WfClosure           core.CompiledWorkflowClosure
 	Inputs              *core.LiteralMap
+	InputsURI           storage.DataReference
 	Reference           admin.LaunchPlan
 	AcceptedAt          time.Time
 	Labels              map[string]string
@@ -30,12 +32,15 @@ type ExecuteWorkflowInput struct {
 	Auth                *admin.AuthRole
 	RecoveryExecution   *core.WorkflowExecutionIdentifier
 	TaskResources       *TaskResources
+	// When set, the workflow is built and assigned a cluster but isn't launched.
+	DryRun bool
 }
 
 type ExecuteTaskInput struct {
 	ExecutionID         *core.WorkflowExecutionIdentifier
 	WfClosure           core.CompiledWorkflowClosure
 	Inputs              *core.LiteralMap
+	InputsURI           storage.DataReference
 	ReferenceName       string
 	Auth                *admin.AuthRole
 	AcceptedAt          time.Time
@@ -54,6 +59,8 @@ type TerminateWorkflowInput struct {
 
 type ExecutionInfo struct {
 	Cluster string
+	// The workflow which would have been launched, only set for dry runs.
+	FlyteWorkflow *v1alpha1.FlyteWorkflow
 }
 
 type FlyteWorkflowInterface interface {
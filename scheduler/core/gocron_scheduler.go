@@ -3,6 +3,8 @@ package core
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,6 +21,9 @@ import (
 	"golang.org/x/time/rate"
 )
 
+// The prefixes cron schedules are given a timezone with. TZ= is accepted as well for compatibility with cron.
+var cronTimezonePrefixes = []string{"CRON_TZ=", "TZ="}
+
 // goCronMetrics mertrics recorded for go cron.
 type goCronMetrics struct {
 	Scope                     promutils.Scope
@@ -39,6 +44,17 @@ type GoCronScheduler struct {
 
 func (g *GoCronScheduler) GetTimedFuncWithSchedule() TimedFuncWithSchedule {
 	return func(jobCtx context.Context, schedule models.SchedulableEntity, scheduleTime time.Time) error {
+		if schedule.JitterSeconds > 0 {
+			// Executions are still launched for the scheduled time, so jitter doesn't change their names or kickoff
+			// time inputs.
+			// #nosec G404
+			jitter := time.Duration(rand.Int63n(int64(schedule.JitterSeconds) * int64(time.Second)))
+			select {
+			case <-time.After(jitter):
+			case <-jobCtx.Done():
+				return jobCtx.Err()
+			}
+		}
 		_ = g.rateLimiter.Wait(jobCtx)
 		err := g.executor.Execute(jobCtx, scheduleTime, schedule)
 		if err != nil {
@@ -52,7 +68,7 @@ func (g *GoCronScheduler) GetTimedFuncWithSchedule() TimedFuncWithSchedule {
 func (g *GoCronScheduler) BootStrapSchedulesFromSnapShot(ctx context.Context, schedules []models.SchedulableEntity,
 	snapshot snapshoter.Snapshot) {
 	for _, s := range schedules {
-		if *s.Active {
+		if *s.Active && !s.Paused {
 			funcRef := g.GetTimedFuncWithSchedule()
 			nameOfSchedule := identifier.GetScheduleName(ctx, s)
 			// Initialize the lastExectime as the updatedAt time
@@ -77,9 +93,14 @@ func (g *GoCronScheduler) BootStrapSchedulesFromSnapShot(ctx context.Context, sc
 func (g *GoCronScheduler) UpdateSchedules(ctx context.Context, schedules []models.SchedulableEntity) {
 	for _, s := range schedules {
 		// Schedule or Deschedule job from the scheduler based on the activation status
-		if !*s.Active {
+		if !*s.Active || s.Paused {
 			g.DeScheduleJob(ctx, s)
 		} else {
+			// Jobs are scheduled again for changes to their options to take effect.
+			if val, ok := g.jobStore.Load(identifier.GetScheduleName(ctx, s)); ok &&
+				val.(*GoCronJob).schedule.ScheduleOptions != s.ScheduleOptions {
+				g.DeScheduleJob(ctx, s)
+			}
 			// Get the TimedFuncWithSchedule
 			funcRef := g.GetTimedFuncWithSchedule()
 			err := g.ScheduleJob(ctx, s, funcRef, nil)
@@ -196,8 +217,12 @@ func (g *GoCronScheduler) CatchUpSingleSchedule(ctx context.Context, s models.Sc
 	if err != nil {
 		return err
 	}
+	firedTimes := applyCatchUpPolicy(s.CatchUpPolicy, catchUpTimes, toTime)
+	for _, skippedTime := range getSkippedTimes(catchUpTimes, firedTimes, toTime) {
+		g.executor.Skip(ctx, skippedTime, s, fmt.Sprintf("skipped by the %s catch-up policy", s.CatchUpPolicy))
+	}
 	var catchupTime time.Time
-	for _, catchupTime = range catchUpTimes {
+	for _, catchupTime = range firedTimes {
 		_ = g.rateLimiter.Wait(ctx)
 		err := g.executor.Execute(ctx, catchupTime, s)
 		if err != nil {
@@ -223,6 +248,38 @@ func GetCatchUpTimes(s models.SchedulableEntity, from time.Time, to time.Time) (
 	return scheduledTimes, nil
 }
 
+// Returns the missed times a schedule fires while catching up, according to its catch-up policy.
+func applyCatchUpPolicy(policy models.CatchUpPolicy, catchUpTimes []time.Time, until time.Time) []time.Time {
+	switch policy {
+	case models.CatchUpSkip:
+		return nil
+	case models.CatchUpLast:
+		for i := len(catchUpTimes) - 1; i >= 0; i-- {
+			if !catchUpTimes[i].After(until) {
+				return catchUpTimes[i : i+1]
+			}
+		}
+		return nil
+	default:
+		return catchUpTimes
+	}
+}
+
+// Returns the missed times up until the given time which a schedule's catch-up policy doesn't fire.
+func getSkippedTimes(catchUpTimes, firedTimes []time.Time, until time.Time) []time.Time {
+	fired := make(map[int64]bool, len(firedTimes))
+	for _, firedTime := range firedTimes {
+		fired[firedTime.UnixNano()] = true
+	}
+	var skippedTimes []time.Time
+	for _, catchUpTime := range catchUpTimes {
+		if !catchUpTime.After(until) && !fired[catchUpTime.UnixNano()] {
+			skippedTimes = append(skippedTimes, catchUpTime)
+		}
+	}
+	return skippedTimes
+}
+
 func GetScheduledTime(s models.SchedulableEntity, fromTime time.Time) (time.Time, error) {
 	if len(s.CronExpression) > 0 {
 		return getCronScheduledTime(s.CronExpression, fromTime)
@@ -230,8 +287,35 @@ func GetScheduledTime(s models.SchedulableEntity, fromTime time.Time) (time.Time
 	return getFixedIntervalScheduledTime(s.Unit, s.FixedRateValue, fromTime)
 }
 
+// GetCronTimezone returns the IANA timezone a cron schedule is evaluated in, along with the schedule without it.
+// Schedules can be given a timezone by prefixing them with CRON_TZ=<timezone>, as in
+// "CRON_TZ=America/New_York 0 3 * * *", and are otherwise evaluated in UTC.
+func GetCronTimezone(cronString string) (string, string) {
+	for _, prefix := range cronTimezonePrefixes {
+		if strings.HasPrefix(cronString, prefix) {
+			fields := strings.SplitN(strings.TrimPrefix(cronString, prefix), " ", 2)
+			if len(fields) < 2 {
+				return fields[0], ""
+			}
+			return fields[0], strings.TrimSpace(fields[1])
+		}
+	}
+	return time.UTC.String(), cronString
+}
+
+// ParseCronSchedule parses a cron schedule, whose next times are computed in its timezone. Times skipped or repeated
+// by daylight saving transitions follow the wall clock of the timezone.
+func ParseCronSchedule(cronString string) (cron.Schedule, error) {
+	timezone, spec := GetCronTimezone(cronString)
+	// Local would depend on the host the scheduler runs on, so it isn't accepted.
+	if _, err := time.LoadLocation(timezone); err != nil || len(timezone) == 0 || timezone == time.Local.String() {
+		return nil, fmt.Errorf("invalid timezone [%s] in cron schedule [%s]", timezone, cronString)
+	}
+	return cron.ParseStandard(fmt.Sprintf("%s%s %s", cronTimezonePrefixes[0], timezone, spec))
+}
+
 func getCronScheduledTime(cronString string, fromTime time.Time) (time.Time, error) {
-	sched, err := cron.ParseStandard(cronString)
+	sched, err := ParseCronSchedule(cronString)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -275,14 +359,15 @@ func (g *GoCronScheduler) AddCronJob(ctx context.Context, job *GoCronJob) error
 	var jobFunc cron.TimedFuncJob
 	jobFunc = job.Run
 
-	entryID, err := g.cron.AddTimedJob(job.schedule.CronExpression, jobFunc)
-	// Update the enttry id in the job which is handle to be used for removal
-	job.entryID = entryID
-	if err == nil {
-		logger.Infof(ctx, "successfully added the schedule %s to the scheduler for schedule %+v",
-			job.nameOfSchedule, job.schedule)
+	sched, err := ParseCronSchedule(job.schedule.CronExpression)
+	if err != nil {
+		return err
 	}
-	return err
+	// Update the enttry id in the job which is handle to be used for removal
+	job.entryID = g.cron.ScheduleTimedJob(sched, jobFunc)
+	logger.Infof(ctx, "successfully added the schedule %s to the scheduler for schedule %+v",
+		job.nameOfSchedule, job.schedule)
+	return nil
 }
 
 func (g *GoCronScheduler) RemoveCronJob(ctx context.Context, job *GoCronJob) {
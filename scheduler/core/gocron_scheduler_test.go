@@ -0,0 +1,120 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyteadmin/scheduler/identifier"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteadmin/scheduler/snapshoter"
+	"github.com/flyteorg/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func getHourlySchedule(options models.ScheduleOptions) models.SchedulableEntity {
+	active := true
+	return models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "hourly",
+			Version: "v1",
+		},
+		CronExpression:  "0 * * * *",
+		Active:          &active,
+		ScheduleOptions: options,
+	}
+}
+
+func TestApplyCatchUpPolicy(t *testing.T) {
+	from := time.Date(2021, time.October, 28, 0, 30, 0, 0, time.UTC)
+	until := time.Date(2021, time.October, 28, 3, 30, 0, 0, time.UTC)
+	catchUpTimes, err := GetCatchUpTimes(getHourlySchedule(models.ScheduleOptions{}), from, until)
+	assert.NoError(t, err)
+	// The time after until is fired as well, since catching up finishes after it's due.
+	assert.Len(t, catchUpTimes, 4)
+
+	assert.Equal(t, catchUpTimes, applyCatchUpPolicy("", catchUpTimes, until))
+	assert.Equal(t, catchUpTimes, applyCatchUpPolicy(models.CatchUpAll, catchUpTimes, until))
+	assert.Equal(t, []time.Time{time.Date(2021, time.October, 28, 3, 0, 0, 0, time.UTC)},
+		applyCatchUpPolicy(models.CatchUpLast, catchUpTimes, until))
+	assert.Empty(t, applyCatchUpPolicy(models.CatchUpLast, catchUpTimes[3:], until))
+	assert.Empty(t, applyCatchUpPolicy(models.CatchUpSkip, catchUpTimes, until))
+
+	// Skipped times are recorded in the run history of the schedule.
+	assert.Empty(t, getSkippedTimes(catchUpTimes, catchUpTimes, until))
+	assert.Equal(t, catchUpTimes[:2], getSkippedTimes(catchUpTimes,
+		applyCatchUpPolicy(models.CatchUpLast, catchUpTimes, until), until))
+	assert.Equal(t, catchUpTimes[:3], getSkippedTimes(catchUpTimes, nil, until))
+}
+
+func TestUpdateSchedules_PausedAndOptions(t *testing.T) {
+	ctx := context.Background()
+	scheduler := NewGoCronScheduler(ctx, nil, promutils.NewTestScope(),
+		&snapshoter.SnapshotV1{LastTimes: map[string]*time.Time{}}, rate.NewLimiter(1, 1), nil).(*GoCronScheduler)
+	schedule := getHourlySchedule(models.ScheduleOptions{})
+	name := identifier.GetScheduleName(ctx, schedule)
+
+	scheduler.UpdateSchedules(ctx, []models.SchedulableEntity{schedule})
+	_, ok := scheduler.jobStore.Load(name)
+	assert.True(t, ok)
+
+	// Jobs pick up changes to their schedule's options.
+	schedule.JitterSeconds = 30
+	scheduler.UpdateSchedules(ctx, []models.SchedulableEntity{schedule})
+	job, ok := scheduler.jobStore.Load(name)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(30), job.(*GoCronJob).schedule.JitterSeconds)
+
+	// Paused schedules are descheduled until they're resumed.
+	schedule.Paused = true
+	scheduler.UpdateSchedules(ctx, []models.SchedulableEntity{schedule})
+	_, ok = scheduler.jobStore.Load(name)
+	assert.False(t, ok)
+	schedule.Paused = false
+	scheduler.UpdateSchedules(ctx, []models.SchedulableEntity{schedule})
+	_, ok = scheduler.jobStore.Load(name)
+	assert.True(t, ok)
+}
+
+func TestGetCronTimezone(t *testing.T) {
+	timezone, spec := GetCronTimezone("0 3 * * *")
+	assert.Equal(t, "UTC", timezone)
+	assert.Equal(t, "0 3 * * *", spec)
+
+	timezone, spec = GetCronTimezone("CRON_TZ=America/New_York 0 3 * * *")
+	assert.Equal(t, "America/New_York", timezone)
+	assert.Equal(t, "0 3 * * *", spec)
+
+	timezone, spec = GetCronTimezone("TZ=Europe/Paris @daily")
+	assert.Equal(t, "Europe/Paris", timezone)
+	assert.Equal(t, "@daily", spec)
+}
+
+func TestGetCronScheduledTime_Timezone(t *testing.T) {
+	// Daylight saving time ends in New York on the 7th, so the schedule fires an hour later in UTC from then on.
+	from := time.Date(2021, time.November, 6, 0, 0, 0, 0, time.UTC)
+	next, err := getCronScheduledTime("CRON_TZ=America/New_York 0 3 * * *", from)
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2021, time.November, 6, 7, 0, 0, 0, time.UTC).Equal(next))
+	next, err = getCronScheduledTime("CRON_TZ=America/New_York 0 3 * * *", next)
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2021, time.November, 7, 8, 0, 0, 0, time.UTC).Equal(next))
+
+	next, err = getCronScheduledTime("0 3 * * *", from)
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2021, time.November, 6, 3, 0, 0, 0, time.UTC).Equal(next))
+}
+
+func TestParseCronSchedule_InvalidTimezone(t *testing.T) {
+	for _, cronString := range []string{
+		"CRON_TZ=America/Gotham 0 3 * * *",
+		"CRON_TZ=Local 0 3 * * *",
+		"CRON_TZ= 0 3 * * *",
+	} {
+		_, err := ParseCronSchedule(cronString)
+		assert.Error(t, err, cronString)
+	}
+}
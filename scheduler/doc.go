@@ -53,6 +53,8 @@
 //          It accepts
 //   			- Standard crontab specs, e.g. "* * * * ?"
 //   			- Descriptors, e.g. "@midnight", "@every 1h30m"
+//			Schedules are evaluated in UTC unless prefixed with an IANA timezone, e.g. "CRON_TZ=America/New_York 0 3 * * *",
+//			in which case they follow the wall clock of the timezone across daylight saving transitions.
 //		d) Job function :
 //			The job function accepts the scheduleTime and the schedule which is used for creating an execution request
 //			to the admin. Each job function is tied to schedule which gets executed in separate go routine by the gogf
@@ -11,4 +11,6 @@ import (
 type Executor interface {
 	// Execute sends a scheduled execution request to admin
 	Execute(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity) error
+	// Skip records that a scheduled time was skipped without sending an execution request to admin
+	Skip(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity, reason string)
 }
@@ -2,10 +2,15 @@ package executor
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/flyteorg/flyteadmin/pkg/async/lag"
+	"github.com/flyteorg/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyteadmin/scheduler/identifier"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
@@ -21,10 +26,28 @@ import (
 	"k8s.io/client-go/util/retry"
 )
 
+const defaultQueuePollInterval = 30 * time.Second
+
+// The most executions of a launch plan a single scheduled execution replaces.
+const maxReplacedExecutions = 100
+
+// Executions in these phases are no longer running.
+var terminalPhases = []string{
+	core.WorkflowExecution_SUCCEEDED.String(),
+	core.WorkflowExecution_FAILED.String(),
+	core.WorkflowExecution_TIMED_OUT.String(),
+	core.WorkflowExecution_ABORTED.String(),
+}
+
 // executor allows to call the admin with scheduled execution
 type executor struct {
 	adminServiceClient service.AdminServiceClient
+	db                 repositories.SchedulerRepoInterface
 	metrics            executorMetrics
+	// Serializes the scheduled executions of each launch plan with the QUEUE concurrency policy.
+	queueLocks        sync.Map
+	queuePollInterval time.Duration
+	lag               *lag.Tracker
 }
 
 type executorMetrics struct {
@@ -33,7 +56,17 @@ type executorMetrics struct {
 	SuccessfulExecutionCounter prometheus.Counter
 }
 
-func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity) error {
+func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity) (err error) {
+	// Scheduled times wait from when they're due until they're fired, and those which fail to fire are recorded as failed
+	// runs and not retried.
+	message := w.lag.Begin(scheduledTime)
+	defer func() {
+		if err != nil {
+			message.DeadLetter()
+		} else {
+			message.Done()
+		}
+	}()
 
 	literalsInputMap := map[string]*core.Literal{}
 	// Only add kickoff time input arg for cron based schedules
@@ -92,6 +125,27 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 	if !*s.Active {
 		// no longer active
 		logger.Debugf(ctx, "schedule %+v is no longer active", s)
+		w.Skip(ctx, scheduledTime, s, "schedule is no longer active")
+		return nil
+	}
+
+	if s.ConcurrencyPolicy == models.ConcurrencyQueue {
+		lock, _ := w.queueLocks.LoadOrStore(fmt.Sprintf("%s:%s:%s", s.Project, s.Domain, s.Name), &sync.Mutex{})
+		lock.(*sync.Mutex).Lock()
+		defer lock.(*sync.Mutex).Unlock()
+	}
+	launch, err := w.enforceConcurrencyPolicy(ctx, scheduledTime, s, executionRequest.Name)
+	if err != nil {
+		logger.Errorf(ctx, "failed to enforce the concurrency policy of schedule %+v for time %v due to %v",
+			s, scheduledTime, err)
+		w.recordRun(ctx, models.ScheduleRun{
+			ScheduledAt: scheduledTime,
+			Status:      models.ScheduleRunFailed,
+			Error:       err.Error(),
+		}, s)
+		return err
+	}
+	if !launch {
 		return nil
 	}
 
@@ -119,19 +173,134 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 	)
 	if err != nil && status.Code(err) != codes.AlreadyExists {
 		logger.Error(ctx, "failed to create execution create request %+v due to %v after all retries", executionRequest, err)
+		w.recordRun(ctx, models.ScheduleRun{
+			ScheduledAt: scheduledTime,
+			Status:      models.ScheduleRunFailed,
+			Error:       err.Error(),
+		}, s)
 		return err
 	}
+	w.recordRun(ctx, models.ScheduleRun{
+		ScheduledAt:   scheduledTime,
+		Status:        models.ScheduleRunSucceeded,
+		ExecutionName: executionRequest.Name,
+	}, s)
 	w.metrics.SuccessfulExecutionCounter.Inc()
 	logger.Infof(ctx, "successfully fired the request for schedule %+v for time %v", s, scheduledTime)
 	return nil
 }
 
+// getRunningExecutions returns the executions of a schedule's launch plan which are still running, other than the one
+// launched for the scheduled time itself before the scheduler restarted.
+func (w *executor) getRunningExecutions(ctx context.Context, s models.SchedulableEntity, executionName string,
+	limit uint32) ([]*admin.Execution, error) {
+	executions, err := w.adminServiceClient.ListExecutions(ctx, &admin.ResourceListRequest{
+		Id: &admin.NamedEntityIdentifier{
+			Project: s.Project,
+			Domain:  s.Domain,
+		},
+		Limit:   limit,
+		Filters: fmt.Sprintf("eq(launch_plan.name,%s)+value_not_in(phase,%s)", s.Name, strings.Join(terminalPhases, ";")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	running := make([]*admin.Execution, 0, len(executions.GetExecutions()))
+	for _, execution := range executions.GetExecutions() {
+		if execution.GetId().GetName() != executionName {
+			running = append(running, execution)
+		}
+	}
+	return running, nil
+}
+
+// enforceConcurrencyPolicy returns whether to launch the execution for a scheduled time, given the executions of its
+// launch plan which are still running.
+func (w *executor) enforceConcurrencyPolicy(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity,
+	executionName string) (bool, error) {
+	switch s.ConcurrencyPolicy {
+	case models.ConcurrencySkip:
+		running, err := w.getRunningExecutions(ctx, s, executionName, 2)
+		if err != nil {
+			return false, err
+		}
+		if len(running) > 0 {
+			logger.Infof(ctx, "skipping schedule %+v for time %v since %s is still running",
+				s, scheduledTime, running[0].Id.Name)
+			w.Skip(ctx, scheduledTime, s, fmt.Sprintf("execution %s is still running", running[0].Id.Name))
+			return false, nil
+		}
+	case models.ConcurrencyReplace:
+		running, err := w.getRunningExecutions(ctx, s, executionName, maxReplacedExecutions)
+		if err != nil {
+			return false, err
+		}
+		for _, execution := range running {
+			_, err := w.adminServiceClient.TerminateExecution(ctx, &admin.ExecutionTerminateRequest{
+				Id:    execution.Id,
+				Cause: fmt.Sprintf("replaced by the execution scheduled at %v", scheduledTime),
+			})
+			if err != nil && status.Code(err) != codes.NotFound {
+				return false, err
+			}
+			logger.Infof(ctx, "terminated %s to replace it with schedule %+v for time %v",
+				execution.Id.Name, s, scheduledTime)
+		}
+	case models.ConcurrencyQueue:
+		for {
+			running, err := w.getRunningExecutions(ctx, s, executionName, 2)
+			if err != nil {
+				return false, err
+			}
+			if len(running) == 0 {
+				break
+			}
+			logger.Debugf(ctx, "waiting for %s to finish before firing schedule %+v for time %v",
+				running[0].Id.Name, s, scheduledTime)
+			select {
+			case <-time.After(w.queuePollInterval):
+			case <-ctx.Done():
+				return false, ctx.Err()
+			}
+		}
+	}
+	return true, nil
+}
+
+func (w *executor) Skip(ctx context.Context, scheduledTime time.Time, s models.SchedulableEntity, reason string) {
+	w.recordRun(ctx, models.ScheduleRun{
+		ScheduledAt: scheduledTime,
+		Status:      models.ScheduleRunSkipped,
+		Error:       reason,
+	}, s)
+}
+
+// recordRun saves the outcome of firing a schedule at a scheduled time to its run history. Failing to record a run
+// doesn't fail firing the schedule.
+func (w *executor) recordRun(ctx context.Context, run models.ScheduleRun, s models.SchedulableEntity) {
+	run.Project = s.Project
+	run.Domain = s.Domain
+	run.Name = s.Name
+	run.Version = s.Version
+	run.FiredAt = time.Now()
+	err := w.db.ScheduleRunRepo().Create(ctx, run)
+	if flyteAdminErr, ok := err.(errors.FlyteAdminError); ok && flyteAdminErr.Code() == codes.AlreadyExists {
+		// The scheduled time was already fired before the scheduler restarted.
+		logger.Debugf(ctx, "run of schedule %+v for time %v was already recorded", s, run.ScheduledAt)
+	} else if err != nil {
+		logger.Errorf(ctx, "failed to record the run of schedule %+v for time %v due to %v", s, run.ScheduledAt, err)
+	}
+}
+
 func New(scope promutils.Scope,
-	adminServiceClient service.AdminServiceClient) Executor {
+	adminServiceClient service.AdminServiceClient, db repositories.SchedulerRepoInterface) Executor {
 
 	return &executor{
 		adminServiceClient: adminServiceClient,
+		db:                 db,
+		queuePollInterval:  defaultQueuePollInterval,
 		metrics:            getExecutorMetrics(scope),
+		lag:                lag.GetTracker(lag.SchedulerProcessor),
 	}
 }
 
@@ -5,10 +5,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/benbjohnson/clock"
+	"github.com/flyteorg/flyteadmin/pkg/async/lag"
 	"github.com/flyteorg/flyteadmin/pkg/errors"
+	repositoryMocks "github.com/flyteorg/flyteadmin/pkg/repositories/mocks"
+	schedMocks "github.com/flyteorg/flyteadmin/scheduler/repositories/mocks"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
 	adminMocks "github.com/flyteorg/flyteidl/clients/go/admin/mocks"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytestdlib/promutils"
 
 	"github.com/stretchr/testify/assert"
@@ -18,11 +23,22 @@ import (
 
 var (
 	mockAdminClient *adminMocks.AdminServiceClient
+	recordedRuns    []models.ScheduleRun
+	executorLag     *lag.Tracker
 )
 
 func setupExecutor(scope string) Executor {
 	mockAdminClient = new(adminMocks.AdminServiceClient)
-	return New(promutils.NewScope(scope), mockAdminClient)
+	db := repositoryMocks.NewMockRepository()
+	recordedRuns = nil
+	db.ScheduleRunRepo().(*schedMocks.ScheduleRunRepoInterface).OnCreateMatch(mock.Anything, mock.Anything).Return(nil).
+		Run(func(args mock.Arguments) {
+			recordedRuns = append(recordedRuns, args.Get(1).(models.ScheduleRun))
+		})
+	e := New(promutils.NewScope(scope), mockAdminClient, db)
+	executorLag = lag.NewRegistry(clock.New()).Tracker(lag.SchedulerProcessor)
+	e.(*executor).lag = executorLag
+	return e
 }
 
 func TestExecutor(t *testing.T) {
@@ -40,8 +56,16 @@ func TestExecutor(t *testing.T) {
 		Active:              &active,
 	}
 	mockAdminClient.OnCreateExecutionMatch(context.Background(), mock.Anything).Return(&admin.ExecutionCreateResponse{}, nil)
-	err := executor.Execute(context.Background(), time.Now(), schedule)
+	scheduledTime := time.Now()
+	err := executor.Execute(context.Background(), scheduledTime, schedule)
 	assert.Nil(t, err)
+	assert.Len(t, recordedRuns, 1)
+	assert.Equal(t, "cron_schedule", recordedRuns[0].Name)
+	assert.Equal(t, scheduledTime, recordedRuns[0].ScheduledAt)
+	assert.Equal(t, models.ScheduleRunSucceeded, recordedRuns[0].Status)
+	assert.NotEmpty(t, recordedRuns[0].ExecutionName)
+	assert.Equal(t, int64(1), executorLag.GetLag().Processed)
+	assert.Equal(t, int64(0), executorLag.GetLag().QueueDepth)
 }
 
 func TestExecutorAlreadyExists(t *testing.T) {
@@ -62,6 +86,8 @@ func TestExecutorAlreadyExists(t *testing.T) {
 		errors.NewFlyteAdminErrorf(codes.AlreadyExists, "Already exists"))
 	err := executor.Execute(context.Background(), time.Now(), schedule)
 	assert.Nil(t, err)
+	assert.Len(t, recordedRuns, 1)
+	assert.Equal(t, models.ScheduleRunSucceeded, recordedRuns[0].Status)
 }
 
 func TestExecutorInactiveSchedule(t *testing.T) {
@@ -81,4 +107,97 @@ func TestExecutorInactiveSchedule(t *testing.T) {
 	mockAdminClient.OnCreateExecutionMatch(context.Background(), mock.Anything).Return(&admin.ExecutionCreateResponse{}, nil)
 	err := executor.Execute(context.Background(), time.Now(), schedule)
 	assert.Nil(t, err)
+	assert.Len(t, recordedRuns, 1)
+	assert.Equal(t, models.ScheduleRunSkipped, recordedRuns[0].Status)
+	assert.Equal(t, "schedule is no longer active", recordedRuns[0].Error)
+	mockAdminClient.AssertNotCalled(t, "CreateExecution", mock.Anything, mock.Anything)
+}
+
+func getConcurrentSchedule(policy models.ConcurrencyPolicy) models.SchedulableEntity {
+	active := true
+	return models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "hourly",
+			Version: "v1",
+		},
+		CronExpression:      "0 * * * *",
+		KickoffTimeInputArg: "kickoff_time",
+		Active:              &active,
+		ScheduleOptions: models.ScheduleOptions{
+			ConcurrencyPolicy: policy,
+		},
+	}
+}
+
+func getRunningExecutionList() *admin.ExecutionList {
+	return &admin.ExecutionList{
+		Executions: []*admin.Execution{
+			{
+				Id: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "running",
+				},
+			},
+		},
+	}
+}
+
+func TestExecutorConcurrencySkip(t *testing.T) {
+	executor := setupExecutor("testExecutorConcurrencySkip")
+	mockAdminClient.OnListExecutionsMatch(mock.Anything, mock.MatchedBy(func(request *admin.ResourceListRequest) bool {
+		return request.Id.Project == "project" && request.Id.Domain == "domain" && len(request.Id.Name) == 0 &&
+			request.Filters == "eq(launch_plan.name,hourly)+value_not_in(phase,SUCCEEDED;FAILED;TIMED_OUT;ABORTED)"
+	})).Return(getRunningExecutionList(), nil)
+
+	err := executor.Execute(context.Background(), time.Now(), getConcurrentSchedule(models.ConcurrencySkip))
+	assert.Nil(t, err)
+	mockAdminClient.AssertNotCalled(t, "CreateExecution", mock.Anything, mock.Anything)
+	assert.Len(t, recordedRuns, 1)
+	assert.Equal(t, models.ScheduleRunSkipped, recordedRuns[0].Status)
+	assert.Equal(t, "execution running is still running", recordedRuns[0].Error)
+}
+
+func TestExecutorConcurrencyReplace(t *testing.T) {
+	executor := setupExecutor("testExecutorConcurrencyReplace")
+	mockAdminClient.OnListExecutionsMatch(mock.Anything, mock.Anything).Return(getRunningExecutionList(), nil)
+	mockAdminClient.OnTerminateExecutionMatch(mock.Anything, mock.MatchedBy(
+		func(request *admin.ExecutionTerminateRequest) bool {
+			return request.Id.Name == "running"
+		})).Return(&admin.ExecutionTerminateResponse{}, nil).Once()
+	mockAdminClient.OnCreateExecutionMatch(mock.Anything, mock.Anything).Return(&admin.ExecutionCreateResponse{}, nil)
+
+	err := executor.Execute(context.Background(), time.Now(), getConcurrentSchedule(models.ConcurrencyReplace))
+	assert.Nil(t, err)
+	mockAdminClient.AssertExpectations(t)
+	assert.Equal(t, models.ScheduleRunSucceeded, recordedRuns[0].Status)
+}
+
+func TestExecutorConcurrencyQueue(t *testing.T) {
+	scheduleExecutor := setupExecutor("testExecutorConcurrencyQueue")
+	scheduleExecutor.(*executor).queuePollInterval = time.Millisecond
+	mockAdminClient.OnListExecutionsMatch(mock.Anything, mock.Anything).Return(getRunningExecutionList(), nil).Twice()
+	mockAdminClient.OnListExecutionsMatch(mock.Anything, mock.Anything).Return(&admin.ExecutionList{}, nil)
+	mockAdminClient.OnCreateExecutionMatch(mock.Anything, mock.Anything).Return(&admin.ExecutionCreateResponse{}, nil)
+
+	err := scheduleExecutor.Execute(context.Background(), time.Now(), getConcurrentSchedule(models.ConcurrencyQueue))
+	assert.Nil(t, err)
+	mockAdminClient.AssertNumberOfCalls(t, "ListExecutions", 3)
+	mockAdminClient.AssertNumberOfCalls(t, "CreateExecution", 1)
+}
+
+func TestExecutorConcurrencyQueue_Cancelled(t *testing.T) {
+	scheduleExecutor := setupExecutor("testExecutorConcurrencyQueueCancelled")
+	mockAdminClient.OnListExecutionsMatch(mock.Anything, mock.Anything).Return(getRunningExecutionList(), nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Queued executions give up waiting once the scheduler stops.
+	err := scheduleExecutor.Execute(ctx, time.Now(), getConcurrentSchedule(models.ConcurrencyQueue))
+	assert.Equal(t, context.Canceled, err)
+	mockAdminClient.AssertNotCalled(t, "CreateExecution", mock.Anything, mock.Anything)
+	assert.Equal(t, models.ScheduleRunFailed, recordedRuns[0].Status)
+	assert.Equal(t, int64(1), executorLag.GetLag().DeadLetters)
 }
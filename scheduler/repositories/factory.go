@@ -13,10 +13,21 @@ type RepoConfig int32
 
 const (
 	POSTGRES RepoConfig = 0
+	SQLITE   RepoConfig = 1
 )
 
 var RepositoryConfigurationName = map[int32]string{
 	0: "POSTGRES",
+	1: "SQLITE",
+}
+
+// GetRepoConfig returns the repository type matching the database the config points to.
+func GetRepoConfig(dbConfig config.DbConfig) RepoConfig {
+	if dbConfig.IsSQLite() {
+		return SQLITE
+	}
+
+	return POSTGRES
 }
 
 // The SchedulerRepoInterface indicates the methods that each Repository must support.
@@ -27,6 +38,7 @@ var RepositoryConfigurationName = map[int32]string{
 type SchedulerRepoInterface interface {
 	SchedulableEntityRepo() interfaces.SchedulableEntityRepoInterface
 	ScheduleEntitiesSnapshotRepo() interfaces.ScheduleEntitiesSnapShotRepoInterface
+	ScheduleRunRepo() interfaces.ScheduleRunRepoInterface
 }
 
 func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutils.Scope) SchedulerRepoInterface {
@@ -34,10 +46,19 @@ func GetRepository(repoType RepoConfig, dbConfig config.DbConfig, scope promutil
 	case POSTGRES:
 		postgresScope := scope.NewSubScope("postgres")
 		db := config.OpenDbConnection(config.NewPostgresConfigProvider(dbConfig, postgresScope))
+		config.ConfigureConnectionPool(db.DB(), dbConfig)
 		return NewPostgresRepo(
 			db,
 			errors.NewPostgresErrorTransformer(postgresScope.NewSubScope("errors")),
 			postgresScope.NewSubScope("repositories"))
+	case SQLITE:
+		sqliteScope := scope.NewSubScope("sqlite")
+		db := config.OpenDbConnection(config.NewSQLiteConfigProvider(dbConfig, sqliteScope))
+		config.ConfigureConnectionPool(db.DB(), dbConfig)
+		return NewPostgresRepo(
+			db,
+			errors.NewSQLiteErrorTransformer(sqliteScope.NewSubScope("errors")),
+			sqliteScope.NewSubScope("repositories"))
 	default:
 		panic(fmt.Sprintf("Invalid repoType %v", repoType))
 	}
@@ -4,15 +4,23 @@ import (
 	"context"
 	"fmt"
 
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flytestdlib/promutils"
 
 	"github.com/jinzhu/gorm"
 )
 
+// Matches schedulable entities whose launch plan version is active.
+const activeLaunchPlanQuery = "EXISTS (SELECT 1 FROM launch_plans WHERE launch_plans.project = " +
+	"schedulable_entities.project AND launch_plans.domain = schedulable_entities.domain AND launch_plans.name = " +
+	"schedulable_entities.name AND launch_plans.version = schedulable_entities.version AND launch_plans.state = ? " +
+	"AND launch_plans.deleted_at IS NULL)"
+
 // SchedulableEntityRepo Implementation of SchedulableEntityRepoInterface.
 type SchedulableEntityRepo struct {
 	db               *gorm.DB
@@ -23,7 +31,7 @@ type SchedulableEntityRepo struct {
 func (r *SchedulableEntityRepo) Create(ctx context.Context, input models.SchedulableEntity) error {
 	timer := r.metrics.GetDuration.Start()
 	var record models.SchedulableEntity
-	tx := r.db.FirstOrCreate(&record, input)
+	tx := repositoryConfig.WithContext(ctx, r.db).FirstOrCreate(&record, input)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -35,7 +43,7 @@ func (r *SchedulableEntityRepo) Activate(ctx context.Context, input models.Sched
 	var schedulableEntity models.SchedulableEntity
 	timer := r.metrics.GetDuration.Start()
 	// Find the existence of a scheduled entity
-	tx := r.db.Where(&models.SchedulableEntity{
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.SchedulableEntity{
 		SchedulableEntityKey: models.SchedulableEntityKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -47,26 +55,39 @@ func (r *SchedulableEntityRepo) Activate(ctx context.Context, input models.Sched
 
 	if tx.Error != nil {
 		if tx.RecordNotFound() {
-			// Not found and hence create one
+			// Not found and hence create one, keeping the options set on the previous versions of the schedule.
+			var previous models.SchedulableEntity
+			tx = repositoryConfig.WithContext(ctx, r.db).Where(&models.SchedulableEntity{
+				SchedulableEntityKey: models.SchedulableEntityKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+				},
+			}).Order("updated_at desc").Take(&previous)
+			if tx.Error == nil {
+				input.ScheduleOptions = previous.ScheduleOptions
+			} else if !tx.RecordNotFound() {
+				return r.errorTransformer.ToFlyteAdminError(tx.Error)
+			}
 			return r.Create(ctx, input)
 		}
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
 	}
 
 	// Activate the already existing schedule
-	return activateOrDeactivate(r, input.SchedulableEntityKey, true)
+	return activateOrDeactivate(ctx, r, input.SchedulableEntityKey, true)
 }
 
 func (r *SchedulableEntityRepo) Deactivate(ctx context.Context, ID models.SchedulableEntityKey) error {
 	// Activate the schedule
-	return activateOrDeactivate(r, ID, false)
+	return activateOrDeactivate(ctx, r, ID, false)
 }
 
 func (r *SchedulableEntityRepo) GetAll(ctx context.Context) ([]models.SchedulableEntity, error) {
 	var schedulableEntities []models.SchedulableEntity
 	timer := r.metrics.GetDuration.Start()
 
-	tx := r.db.Find(&schedulableEntities)
+	tx := repositoryConfig.WithContext(ctx, r.db).Find(&schedulableEntities)
 
 	timer.Stop()
 
@@ -84,7 +105,7 @@ func (r *SchedulableEntityRepo) GetAll(ctx context.Context) ([]models.Schedulabl
 func (r *SchedulableEntityRepo) Get(ctx context.Context, ID models.SchedulableEntityKey) (models.SchedulableEntity, error) {
 	var schedulableEntity models.SchedulableEntity
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Where(&models.SchedulableEntity{
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.SchedulableEntity{
 		SchedulableEntityKey: models.SchedulableEntityKey{
 			Project: ID.Project,
 			Domain:  ID.Domain,
@@ -110,10 +131,75 @@ func (r *SchedulableEntityRepo) Get(ctx context.Context, ID models.SchedulableEn
 	return schedulableEntity, nil
 }
 
+func (r *SchedulableEntityRepo) UpdateOptions(ctx context.Context, ID models.SchedulableEntityKey,
+	options models.ScheduleOptions) error {
+	return updateAllVersions(ctx, r, ID, map[string]interface{}{
+		"jitter_seconds":     options.JitterSeconds,
+		"catch_up_policy":    options.CatchUpPolicy,
+		"concurrency_policy": options.ConcurrencyPolicy,
+	})
+}
+
+func (r *SchedulableEntityRepo) SetPaused(ctx context.Context, ID models.SchedulableEntityKey, paused bool) error {
+	return updateAllVersions(ctx, r, ID, map[string]interface{}{
+		"paused": paused,
+	})
+}
+
+func (r *SchedulableEntityRepo) DeactivateProject(ctx context.Context, project string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.SchedulableEntity{}).Where(&models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: project,
+		},
+	}).Update("active", false)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *SchedulableEntityRepo) ReactivateProject(ctx context.Context, project string) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.SchedulableEntity{}).Where(
+		"project = ?", project).Where(activeLaunchPlanQuery, int32(admin.LaunchPlanState_ACTIVE)).Update("active", true)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Helper function to update the columns of every version of a schedule
+func updateAllVersions(ctx context.Context, r *SchedulableEntityRepo, ID models.SchedulableEntityKey,
+	columns map[string]interface{}) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.SchedulableEntity{}).Where(&models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: ID.Project,
+			Domain:  ID.Domain,
+			Name:    ID.Name,
+		},
+	}).Updates(columns)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return errors.GetMissingEntityError("schedulable entity", &core.Identifier{
+			Project: ID.Project,
+			Domain:  ID.Domain,
+			Name:    ID.Name,
+		})
+	}
+	return nil
+}
+
 // Helper function to activate and deactivate a schedule
-func activateOrDeactivate(r *SchedulableEntityRepo, ID models.SchedulableEntityKey, activate bool) error {
+func activateOrDeactivate(ctx context.Context, r *SchedulableEntityRepo, ID models.SchedulableEntityKey, activate bool) error {
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Model(&models.SchedulableEntity{}).Where(&models.SchedulableEntity{
+	tx := repositoryConfig.WithContext(ctx, r.db).Model(&models.SchedulableEntity{}).Where(&models.SchedulableEntity{
 		SchedulableEntityKey: models.SchedulableEntityKey{
 			Project: ID.Project,
 			Domain:  ID.Domain,
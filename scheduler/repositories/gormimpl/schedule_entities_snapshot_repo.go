@@ -3,6 +3,7 @@ package gormimpl
 import (
 	"context"
 
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
 	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
 	interfaces2 "github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
 	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
@@ -21,7 +22,7 @@ type ScheduleEntitiesSnapshotRepo struct {
 // TODO : always overwrite the exisiting snapshot instead of creating new rows
 func (r *ScheduleEntitiesSnapshotRepo) Write(ctx context.Context, input models.ScheduleEntitiesSnapshot) error {
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Create(&input)
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
 	timer.Stop()
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
@@ -32,7 +33,7 @@ func (r *ScheduleEntitiesSnapshotRepo) Write(ctx context.Context, input models.S
 func (r *ScheduleEntitiesSnapshotRepo) Read(ctx context.Context) (models.ScheduleEntitiesSnapshot, error) {
 	var schedulableEntitiesSnapshot models.ScheduleEntitiesSnapshot
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.Last(&schedulableEntitiesSnapshot)
+	tx := repositoryConfig.WithContext(ctx, r.db).Last(&schedulableEntitiesSnapshot)
 	timer.Stop()
 
 	if tx.Error != nil {
@@ -0,0 +1,57 @@
+package gormimpl
+
+import (
+	"context"
+
+	repositoryConfig "github.com/flyteorg/flyteadmin/pkg/repositories/config"
+	"github.com/flyteorg/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flytestdlib/promutils"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ScheduleRunRepo Implementation of ScheduleRunRepoInterface.
+type ScheduleRunRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *ScheduleRunRepo) Create(ctx context.Context, input models.ScheduleRun) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Create(&input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ScheduleRunRepo) List(ctx context.Context, ID models.SchedulableEntityKey, limit int,
+	offset int) ([]models.ScheduleRun, error) {
+	var scheduleRuns []models.ScheduleRun
+	timer := r.metrics.ListDuration.Start()
+	tx := repositoryConfig.WithContext(ctx, r.db).Where(&models.ScheduleRun{
+		Project: ID.Project,
+		Domain:  ID.Domain,
+		Name:    ID.Name,
+	}).Order("scheduled_at desc").Limit(limit).Offset(offset).Find(&scheduleRuns)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return scheduleRuns, nil
+}
+
+// NewScheduleRunRepo Returns an instance of ScheduleRunRepoInterface
+func NewScheduleRunRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ScheduleRunRepoInterface {
+	metrics := newMetrics(scope)
+	return &ScheduleRunRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
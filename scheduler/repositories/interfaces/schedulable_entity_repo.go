@@ -25,4 +25,18 @@ type SchedulableEntityRepoInterface interface {
 
 	// GetAll Gets all the active schedulable entities from the db
 	GetAll(ctx context.Context) ([]models.SchedulableEntity, error)
+
+	// UpdateOptions sets the jitter, catch-up and concurrency policies of every version of a schedulable entity. The
+	// version of the ID is ignored.
+	UpdateOptions(ctx context.Context, ID models.SchedulableEntityKey, options models.ScheduleOptions) error
+
+	// SetPaused pauses or resumes every version of a schedulable entity. The version of the ID is ignored.
+	SetPaused(ctx context.Context, ID models.SchedulableEntityKey, paused bool) error
+
+	// DeactivateProject deactivates every schedulable entity of a project, such as when it's archived.
+	DeactivateProject(ctx context.Context, project string) error
+
+	// ReactivateProject activates the schedulable entities of a project whose launch plan version is active, such as
+	// when it's restored.
+	ReactivateProject(ctx context.Context, project string) error
 }
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyteadmin/scheduler/repositories/models"
+)
+
+//go:generate mockery -name=ScheduleRunRepoInterface -output=../mocks -case=underscore
+
+// ScheduleRunRepoInterface : An Interface for interacting with the runs of schedules in the database
+type ScheduleRunRepoInterface interface {
+
+	// Create a schedule run in the database store
+	Create(ctx context.Context, input models.ScheduleRun) error
+
+	// List the runs of every version of a schedule, most recently scheduled first. The version of the ID is ignored.
+	List(ctx context.Context, ID models.SchedulableEntityKey, limit int, offset int) ([]models.ScheduleRun, error)
+}
@@ -111,6 +111,38 @@ func (_m *SchedulableEntityRepoInterface) Deactivate(ctx context.Context, ID mod
 	return r0
 }
 
+type SchedulableEntityRepoInterface_DeactivateProject struct {
+	*mock.Call
+}
+
+func (_m SchedulableEntityRepoInterface_DeactivateProject) Return(_a0 error) *SchedulableEntityRepoInterface_DeactivateProject {
+	return &SchedulableEntityRepoInterface_DeactivateProject{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *SchedulableEntityRepoInterface) OnDeactivateProject(ctx context.Context, project string) *SchedulableEntityRepoInterface_DeactivateProject {
+	c := _m.On("DeactivateProject", ctx, project)
+	return &SchedulableEntityRepoInterface_DeactivateProject{Call: c}
+}
+
+func (_m *SchedulableEntityRepoInterface) OnDeactivateProjectMatch(matchers ...interface{}) *SchedulableEntityRepoInterface_DeactivateProject {
+	c := _m.On("DeactivateProject", matchers...)
+	return &SchedulableEntityRepoInterface_DeactivateProject{Call: c}
+}
+
+// DeactivateProject provides a mock function with given fields: ctx, project
+func (_m *SchedulableEntityRepoInterface) DeactivateProject(ctx context.Context, project string) error {
+	ret := _m.Called(ctx, project)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, project)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type SchedulableEntityRepoInterface_Get struct {
 	*mock.Call
 }
@@ -190,3 +222,99 @@ func (_m *SchedulableEntityRepoInterface) GetAll(ctx context.Context) ([]models.
 
 	return r0, r1
 }
+
+type SchedulableEntityRepoInterface_ReactivateProject struct {
+	*mock.Call
+}
+
+func (_m SchedulableEntityRepoInterface_ReactivateProject) Return(_a0 error) *SchedulableEntityRepoInterface_ReactivateProject {
+	return &SchedulableEntityRepoInterface_ReactivateProject{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *SchedulableEntityRepoInterface) OnReactivateProject(ctx context.Context, project string) *SchedulableEntityRepoInterface_ReactivateProject {
+	c := _m.On("ReactivateProject", ctx, project)
+	return &SchedulableEntityRepoInterface_ReactivateProject{Call: c}
+}
+
+func (_m *SchedulableEntityRepoInterface) OnReactivateProjectMatch(matchers ...interface{}) *SchedulableEntityRepoInterface_ReactivateProject {
+	c := _m.On("ReactivateProject", matchers...)
+	return &SchedulableEntityRepoInterface_ReactivateProject{Call: c}
+}
+
+// ReactivateProject provides a mock function with given fields: ctx, project
+func (_m *SchedulableEntityRepoInterface) ReactivateProject(ctx context.Context, project string) error {
+	ret := _m.Called(ctx, project)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, project)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type SchedulableEntityRepoInterface_SetPaused struct {
+	*mock.Call
+}
+
+func (_m SchedulableEntityRepoInterface_SetPaused) Return(_a0 error) *SchedulableEntityRepoInterface_SetPaused {
+	return &SchedulableEntityRepoInterface_SetPaused{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *SchedulableEntityRepoInterface) OnSetPaused(ctx context.Context, ID models.SchedulableEntityKey, paused bool) *SchedulableEntityRepoInterface_SetPaused {
+	c := _m.On("SetPaused", ctx, ID, paused)
+	return &SchedulableEntityRepoInterface_SetPaused{Call: c}
+}
+
+func (_m *SchedulableEntityRepoInterface) OnSetPausedMatch(matchers ...interface{}) *SchedulableEntityRepoInterface_SetPaused {
+	c := _m.On("SetPaused", matchers...)
+	return &SchedulableEntityRepoInterface_SetPaused{Call: c}
+}
+
+// SetPaused provides a mock function with given fields: ctx, ID, paused
+func (_m *SchedulableEntityRepoInterface) SetPaused(ctx context.Context, ID models.SchedulableEntityKey, paused bool) error {
+	ret := _m.Called(ctx, ID, paused)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SchedulableEntityKey, bool) error); ok {
+		r0 = rf(ctx, ID, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type SchedulableEntityRepoInterface_UpdateOptions struct {
+	*mock.Call
+}
+
+func (_m SchedulableEntityRepoInterface_UpdateOptions) Return(_a0 error) *SchedulableEntityRepoInterface_UpdateOptions {
+	return &SchedulableEntityRepoInterface_UpdateOptions{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *SchedulableEntityRepoInterface) OnUpdateOptions(ctx context.Context, ID models.SchedulableEntityKey, options models.ScheduleOptions) *SchedulableEntityRepoInterface_UpdateOptions {
+	c := _m.On("UpdateOptions", ctx, ID, options)
+	return &SchedulableEntityRepoInterface_UpdateOptions{Call: c}
+}
+
+func (_m *SchedulableEntityRepoInterface) OnUpdateOptionsMatch(matchers ...interface{}) *SchedulableEntityRepoInterface_UpdateOptions {
+	c := _m.On("UpdateOptions", matchers...)
+	return &SchedulableEntityRepoInterface_UpdateOptions{Call: c}
+}
+
+// UpdateOptions provides a mock function with given fields: ctx, ID, options
+func (_m *SchedulableEntityRepoInterface) UpdateOptions(ctx context.Context, ID models.SchedulableEntityKey, options models.ScheduleOptions) error {
+	ret := _m.Called(ctx, ID, options)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SchedulableEntityKey, models.ScheduleOptions) error); ok {
+		r0 = rf(ctx, ID, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
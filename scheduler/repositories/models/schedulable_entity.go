@@ -14,6 +14,44 @@ type SchedulableEntity struct {
 	Unit                admin.FixedRateUnit
 	KickoffTimeInputArg string
 	Active              *bool
+	ScheduleOptions
+}
+
+// How a schedule catches up on the times it was scheduled while the scheduler was down.
+type CatchUpPolicy = string
+
+const (
+	// Fires every missed time. Schedules without a policy catch up on every missed time.
+	CatchUpAll CatchUpPolicy = "ALL"
+	// Fires only the most recent missed time.
+	CatchUpLast CatchUpPolicy = "LAST"
+	// Fires none of the missed times.
+	CatchUpSkip CatchUpPolicy = "SKIP"
+)
+
+// How a schedule fires while executions of its launch plan are still running.
+type ConcurrencyPolicy = string
+
+const (
+	// Launches executions regardless of those still running. Schedules without a policy allow concurrent executions.
+	ConcurrencyAllow ConcurrencyPolicy = "ALLOW"
+	// Skips the scheduled time.
+	ConcurrencySkip ConcurrencyPolicy = "SKIP"
+	// Terminates the running executions before launching.
+	ConcurrencyReplace ConcurrencyPolicy = "REPLACE"
+	// Waits for the running executions to finish before launching.
+	ConcurrencyQueue ConcurrencyPolicy = "QUEUE"
+)
+
+// Options set on the schedule of a launch plan, which apply to each of its versions.
+type ScheduleOptions struct {
+	// Delays firing by a random duration of up to this many seconds, so that schedules due at the same time don't
+	// all launch at once.
+	JitterSeconds     uint32
+	CatchUpPolicy     CatchUpPolicy
+	ConcurrencyPolicy ConcurrencyPolicy
+	// Paused schedules don't fire until they're resumed, even though their launch plan is active.
+	Paused bool
 }
 
 // Schedulable entity primary key
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// The outcome of an attempt of the scheduler to launch an execution for a scheduled time.
+type ScheduleRunStatus = string
+
+const (
+	// The execution was launched, or had already been launched for the scheduled time.
+	ScheduleRunSucceeded ScheduleRunStatus = "SUCCEEDED"
+	// The execution failed to launch after all retries.
+	ScheduleRunFailed ScheduleRunStatus = "FAILED"
+	// No execution was launched, because the schedule was inactive or its catch-up or concurrency policy skipped the
+	// time.
+	ScheduleRunSkipped ScheduleRunStatus = "SKIPPED"
+)
+
+// Database model to record the attempts of the scheduler to launch an execution for a scheduled time
+type ScheduleRun struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Project   string `gorm:"unique_index:idx_schedule_runs_scheduled_at"`
+	Domain    string `gorm:"unique_index:idx_schedule_runs_scheduled_at"`
+	Name      string `gorm:"unique_index:idx_schedule_runs_scheduled_at"`
+	Version   string `gorm:"unique_index:idx_schedule_runs_scheduled_at"`
+	// The time the execution was scheduled for.
+	ScheduledAt time.Time `gorm:"unique_index:idx_schedule_runs_scheduled_at"`
+	// The time the attempt finished, which is later than the scheduled time for late runs.
+	FiredAt time.Time
+	Status  ScheduleRunStatus
+	// The name of the execution launched by succeeded runs.
+	ExecutionName string
+	// Why failed and skipped runs didn't launch an execution.
+	Error string
+}
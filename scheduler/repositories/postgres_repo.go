@@ -11,6 +11,7 @@ import (
 type PostgresRepo struct {
 	schedulableEntityRepo        interfaces.SchedulableEntityRepoInterface
 	scheduleEntitiesSnapshotRepo interfaces.ScheduleEntitiesSnapShotRepoInterface
+	scheduleRunRepo              interfaces.ScheduleRunRepoInterface
 }
 
 func (p *PostgresRepo) SchedulableEntityRepo() interfaces.SchedulableEntityRepoInterface {
@@ -21,9 +22,14 @@ func (p *PostgresRepo) ScheduleEntitiesSnapshotRepo() interfaces.ScheduleEntitie
 	return p.scheduleEntitiesSnapshotRepo
 }
 
+func (p *PostgresRepo) ScheduleRunRepo() interfaces.ScheduleRunRepoInterface {
+	return p.scheduleRunRepo
+}
+
 func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) SchedulerRepoInterface {
 	return &PostgresRepo{
 		schedulableEntityRepo:        gormimpl.NewSchedulableEntityRepo(db, errorTransformer, scope.NewSubScope("schedulable_entity")),
 		scheduleEntitiesSnapshotRepo: gormimpl.NewScheduleEntitiesSnapshotRepo(db, errorTransformer, scope.NewSubScope("schedule_entities_snapshot")),
+		scheduleRunRepo:              gormimpl.NewScheduleRunRepo(db, errorTransformer, scope.NewSubScope("schedule_runs")),
 	}
 }
@@ -61,7 +61,7 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 	rateLimiter := rate.NewLimiter(adminRateLimit.GetTps(), adminRateLimit.GetBurst())
 
 	// Set the executor to send executions to admin
-	executor := executor.New(w.scope, w.adminServiceClient)
+	executor := executor.New(w.scope, w.adminServiceClient, w.db)
 
 	// Create the scheduler using GoCronScheduler implementation
 	// Also Bootstrap the schedules from the snapshot
@@ -58,6 +58,7 @@ func setupScheduleExecutor(t *testing.T, s string) ScheduledExecutor {
 	}
 	snapshotRepo.OnReadMatch(mock.Anything).Return(snapshotModel, nil)
 	snapshotRepo.OnWriteMatch(mock.Anything, mock.Anything).Return(nil)
+	db.ScheduleRunRepo().(*schedMocks.ScheduleRunRepoInterface).OnCreateMatch(mock.Anything, mock.Anything).Return(nil)
 	mockAdminClient.OnCreateExecutionMatch(context.Background(), mock.Anything).
 		Return(&admin.ExecutionCreateResponse{}, nil)
 	return NewScheduledExecutor(db, scheduleExecutorConfig,